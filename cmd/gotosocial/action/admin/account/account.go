@@ -26,9 +26,11 @@
 
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action"
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db/bundb"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
 	userprocessor "code.superseriousbusiness.org/gotosocial/internal/processing/user"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
@@ -46,6 +48,7 @@
 	_ action.GTSAction = Enable
 	_ action.GTSAction = Disable
 	_ action.GTSAction = Password
+	_ action.GTSAction = RotateKeys
 )
 
 func initState(ctx context.Context) (*state.State, error) {
@@ -440,3 +443,61 @@ func Disable2FA(ctx context.Context) error {
 	fmt.Printf("2fa disabled\n")
 	return nil
 }
+
+// RotateKeys rotates the RSA keypair of target account,
+// which may also be the instance account (username = host).
+func RotateKeys(ctx context.Context) error {
+	state, err := initState(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		// Ensure state gets stopped on return.
+		if err := stopState(state); err != nil {
+			log.Error(ctx, err)
+		}
+	}()
+
+	username := config.GetAdminAccountUsername()
+	if username != config.GetHost() {
+		if err := validate.Username(username); err != nil {
+			return err
+		}
+	}
+
+	account, err := state.DB.GetAccountByUsernameDomain(ctx, username, "")
+	if err != nil {
+		return err
+	}
+
+	if err := state.DB.RotateAccountKeys(ctx, account); err != nil {
+		return err
+	}
+
+	// The server isn't running, so persist a worker task to
+	// federate the updated key when the server next starts.
+	msg := &messages.FromClientAPI{
+		APObjectType:   ap.ActorPerson,
+		APActivityType: ap.ActivityUpdate,
+		GTSModel:       account,
+		Origin:         account,
+	}
+
+	data, err := msg.Serialize()
+	if err != nil {
+		return err
+	}
+
+	if err := state.DB.PutWorkerTasks(ctx, []*gtsmodel.WorkerTask{{
+		WorkerType: gtsmodel.ClientWorker,
+		TaskData:   data,
+		CreatedAt:  time.Now(),
+	}}); err != nil {
+		return err
+	}
+
+	log.Info(ctx, "Rotated keys; you must restart the server to use the new keys.")
+	fmt.Printf("new public key id: %s\n", account.PublicKeyURI)
+	return nil
+}
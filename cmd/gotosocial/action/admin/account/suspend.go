@@ -0,0 +1,84 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action"
+	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action/admin/apiclient"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+)
+
+// check function conformance.
+var _ action.GTSAction = Suspend
+
+// Suspend suspends the given local account via
+// the admin API of the running instance, which
+// handles deletion of the account's content and
+// federating the suspension to other instances.
+func Suspend(ctx context.Context) error {
+	client, err := apiclient.New()
+	if err != nil {
+		return err
+	}
+
+	username := config.GetAdminAccountUsername()
+	if username == "" {
+		return fmt.Errorf("username must be provided")
+	}
+
+	// Look up local accounts matching username.
+	var accounts []*apimodel.AdminAccountInfo
+	if err := client.Get(ctx, "/api/v2/admin/accounts", url.Values{
+		"origin":   {"local"},
+		"username": {username},
+	}, &accounts); err != nil {
+		return fmt.Errorf("error looking up account: %w", err)
+	}
+
+	// Find exact username match.
+	var accountID string
+	for _, account := range accounts {
+		if account.Username == username {
+			accountID = account.ID
+			break
+		}
+	}
+
+	if accountID == "" {
+		return fmt.Errorf("no local account found with username %s", username)
+	}
+
+	if err := client.PostForm(ctx,
+		"/api/v1/admin/accounts/"+accountID+"/action",
+		url.Values{
+			"type": {"suspend"},
+			"text": {config.GetAdminReason()},
+		},
+		nil,
+	); err != nil {
+		return fmt.Errorf("error suspending account: %w", err)
+	}
+
+	fmt.Printf("suspending account %s (%s)\n", username, accountID)
+	return nil
+}
@@ -0,0 +1,207 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+)
+
+// Client performs requests against the admin API
+// of a running GoToSocial instance, authenticated
+// with the access token of an admin account.
+type Client struct {
+	baseURL string
+	token   string
+	client  http.Client
+}
+
+// New returns a new Client, configured using the
+// api-url, api-token and api-token-file settings.
+func New() (*Client, error) {
+	baseURL := config.GetAdminAPIURL()
+	if baseURL == "" {
+		// Default to this instance's own host.
+		host := config.GetHost()
+		if host == "" {
+			return nil, errors.New("neither api-url nor host set")
+		}
+		baseURL = config.GetProtocol() + "://" + host
+	}
+
+	token, err := loadToken()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+		client:  http.Client{Timeout: time.Minute},
+	}, nil
+}
+
+// DefaultTokenFile returns the default location of
+// the stored admin access token, ie., admin-token
+// within a gotosocial user config directory.
+func DefaultTokenFile() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("error getting user config dir: %w", err)
+	}
+	return filepath.Join(dir, "gotosocial", "admin-token"), nil
+}
+
+// loadToken loads the admin access token, either
+// directly from config, or from the token file.
+func loadToken() (string, error) {
+	if token := config.GetAdminAPIToken(); token != "" {
+		return token, nil
+	}
+
+	path := config.GetAdminAPITokenFile()
+	if path == "" {
+		var err error
+		path, err = DefaultTokenFile()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("error reading api token file (set api-token or api-token-file): %w", err)
+	}
+
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", fmt.Errorf("api token file %s is empty", path)
+	}
+
+	return token, nil
+}
+
+// Get performs a GET request to the given API path with query,
+// decoding the JSON response into out (if not nil).
+func (c *Client) Get(ctx context.Context, path string, query url.Values, out any) error {
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return c.do(ctx, http.MethodGet, path, nil, "", out)
+}
+
+// PostForm performs a POST request to the given API path with a
+// url-encoded form body, decoding the JSON response into out (if not nil).
+func (c *Client) PostForm(ctx context.Context, path string, form url.Values, out any) error {
+	body := strings.NewReader(form.Encode())
+	return c.do(ctx, http.MethodPost, path, body, "application/x-www-form-urlencoded", out)
+}
+
+// PostFile performs a POST request to the given API path with a multipart
+// form body containing the given form values, and the file at filePath
+// as fileField, decoding the JSON response into out (if not nil).
+func (c *Client) PostFile(ctx context.Context, path string, form url.Values, fileField string, filePath string, out any) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("error opening file: %w", err)
+	}
+	defer file.Close()
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+
+	for key, values := range form {
+		for _, value := range values {
+			if err := mw.WriteField(key, value); err != nil {
+				return fmt.Errorf("error writing form field: %w", err)
+			}
+		}
+	}
+
+	fw, err := mw.CreateFormFile(fileField, filepath.Base(filePath))
+	if err != nil {
+		return fmt.Errorf("error creating form file: %w", err)
+	}
+
+	if _, err := io.Copy(fw, file); err != nil {
+		return fmt.Errorf("error copying file: %w", err)
+	}
+
+	if err := mw.Close(); err != nil {
+		return fmt.Errorf("error closing multipart writer: %w", err)
+	}
+
+	return c.do(ctx, http.MethodPost, path, &body, mw.FormDataContentType(), out)
+}
+
+// do performs an authenticated request to the given API path, returning
+// any error message from the API, else decoding the response into out.
+func (c *Client) do(ctx context.Context, method string, path string, body io.Reader, contentType string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "gotosocial-cli/"+config.GetSoftwareVersion())
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	rsp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error performing request: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode < 200 || rsp.StatusCode > 299 {
+		// Try to get error message from response.
+		var apiErr struct {
+			Error string `json:"error"`
+		}
+		_ = json.NewDecoder(rsp.Body).Decode(&apiErr)
+		if apiErr.Error != "" {
+			return fmt.Errorf("%s %s: %s: %s", method, path, rsp.Status, apiErr.Error)
+		}
+		return fmt.Errorf("%s %s: %s", method, path, rsp.Status)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(rsp.Body).Decode(out); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db/bundb"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+)
+
+// check function conformance.
+var _ action.GTSAction = Backup
+
+// Backup writes a consistent snapshot of the SQLite
+// database to file at the given path. This is safe
+// to run while the GoToSocial server is running.
+func Backup(ctx context.Context) error {
+	if !strings.EqualFold(config.GetDbType(), "sqlite") {
+		return errors.New("backup is only supported for sqlite databases")
+	}
+
+	path := config.GetAdminTransPath()
+	if path == "" {
+		return errors.New("no path set")
+	}
+
+	var state state.State
+
+	// Only set state DB connection.
+	// Don't need Actions or Workers for this.
+	dbConn, err := bundb.NewBunDBService(ctx, &state)
+	if err != nil {
+		return fmt.Errorf("error creating dbservice: %s", err)
+	}
+	state.DB = dbConn
+
+	if err := dbConn.Backup(ctx, path); err != nil {
+		_ = dbConn.Close()
+		return err
+	}
+
+	log.Infof(ctx, "backed up database to %s", path)
+
+	return dbConn.Close()
+}
@@ -0,0 +1,59 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db/bundb"
+)
+
+// check function conformance.
+var _ action.GTSAction = Restore
+
+// Restore replaces the SQLite database with the backup
+// snapshot at the given path. The GoToSocial server
+// MUST be stopped before running this.
+func Restore(ctx context.Context) error {
+	if !strings.EqualFold(config.GetDbType(), "sqlite") {
+		return errors.New("restore is only supported for sqlite databases")
+	}
+
+	path := config.GetAdminTransPath()
+	if path == "" {
+		return errors.New("no path set")
+	}
+
+	moved, err := bundb.RestoreSQLite(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	if moved != "" {
+		log.Infof(ctx, "moved previous database to %s", moved)
+	}
+
+	log.Infof(ctx, "restored database from %s", path)
+
+	return nil
+}
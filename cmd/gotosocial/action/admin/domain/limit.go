@@ -0,0 +1,77 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action"
+	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action/admin/apiclient"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+)
+
+// check function conformance.
+var _ action.GTSAction = LimitCreate
+
+// LimitCreate creates a domain limit with the
+// given policies via the admin API of the running
+// instance. Policies not given default to no_action.
+func LimitCreate(ctx context.Context) error {
+	client, err := apiclient.New()
+	if err != nil {
+		return err
+	}
+
+	domain := config.GetAdminDomain()
+	if domain == "" {
+		return fmt.Errorf("domain must be provided")
+	}
+
+	form := url.Values{"domain": {domain}}
+
+	// Only set those
+	// values provided.
+	for key, value := range map[string]string{
+		"media_policy":    config.GetAdminMediaPolicy(),
+		"follows_policy":  config.GetAdminFollowsPolicy(),
+		"statuses_policy": config.GetAdminStatusesPolicy(),
+		"accounts_policy": config.GetAdminAccountsPolicy(),
+		"content_warning": config.GetAdminContentWarning(),
+		"public_comment":  config.GetAdminPublicComment(),
+		"private_comment": config.GetAdminReason(),
+	} {
+		if value != "" {
+			form.Set(key, value)
+		}
+	}
+
+	var limit apimodel.DomainLimit
+	if err := client.PostForm(ctx,
+		"/api/v1/admin/domain_limits",
+		form,
+		&limit,
+	); err != nil {
+		return fmt.Errorf("error creating domain limit: %w", err)
+	}
+
+	fmt.Printf("created domain limit %s for %s\n", limit.ID, limit.Domain)
+	return nil
+}
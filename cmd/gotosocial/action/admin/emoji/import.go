@@ -0,0 +1,86 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package emoji
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"text/tabwriter"
+
+	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action"
+	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action/admin/apiclient"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+)
+
+// check function conformance.
+var _ action.GTSAction = Import
+
+// Import imports custom emojis from the zipped emoji
+// pack at the given path, via the admin API of the
+// running instance, printing emojis that were skipped.
+func Import(ctx context.Context) error {
+	client, err := apiclient.New()
+	if err != nil {
+		return err
+	}
+
+	path := config.GetAdminTransPath()
+	if path == "" {
+		return fmt.Errorf("path must be provided")
+	}
+
+	form := url.Values{}
+	if category := config.GetAdminEmojiCategory(); category != "" {
+		form.Set("category", category)
+	}
+
+	var result apimodel.EmojiPackImportResult
+	if err := client.PostFile(ctx,
+		"/api/v1/admin/custom_emojis/pack",
+		form,
+		"file",
+		path,
+		&result,
+	); err != nil {
+		return fmt.Errorf("error importing emoji pack: %w", err)
+	}
+
+	fmt.Printf("imported %d emojis, skipped %d\n", len(result.Imported), len(result.Skipped))
+	if len(result.Skipped) == 0 {
+		return nil
+	}
+
+	// Print skipped emojis
+	// in a determinate order.
+	shortcodes := make([]string, 0, len(result.Skipped))
+	for shortcode := range result.Skipped {
+		shortcodes = append(shortcodes, shortcode)
+	}
+	slices.Sort(shortcodes)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	fmt.Fprintln(w, "skipped\treason")
+	for _, shortcode := range shortcodes {
+		fmt.Fprintf(w, "%s\t%s\n", shortcode, result.Skipped[shortcode])
+	}
+	return w.Flush()
+}
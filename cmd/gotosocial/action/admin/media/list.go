@@ -263,6 +263,12 @@ func printMediaPaths(basePath string, media *gtsmodel.MediaAttachment) {
 		_, _ = outbuf.WriteString(path + "\n")
 	}
 
+	// Append sprite path if present.
+	if media.Sprite.Path != "" {
+		path := pb.Join(basePath, media.Sprite.Path)
+		_, _ = outbuf.WriteString(path + "\n")
+	}
+
 	// Only write if any
 	// string was prepared.
 	if outbuf.Len() > 0 {
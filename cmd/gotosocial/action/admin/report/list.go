@@ -0,0 +1,76 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package report
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action"
+	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action/admin/apiclient"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+)
+
+// check function conformance.
+var _ action.GTSAction = List
+
+// List lists unresolved (or resolved) reports,
+// newest first, via the admin API of the running
+// instance, fetching at most 200 reports.
+func List(ctx context.Context) error {
+	client, err := apiclient.New()
+	if err != nil {
+		return err
+	}
+
+	var reports []*apimodel.AdminReport
+	if err := client.Get(ctx, "/api/v1/admin/reports", url.Values{
+		"resolved": {strconv.FormatBool(config.GetAdminReportsResolved())},
+		"limit":    {"200"},
+	}, &reports); err != nil {
+		return fmt.Errorf("error listing reports: %w", err)
+	}
+
+	acct := func(info *apimodel.AdminAccountInfo) string {
+		if info == nil || info.Account == nil {
+			return ""
+		}
+		return info.Account.Acct
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	fmt.Fprintln(w, "id\tcreated\tcategory\treporter\treported\tassigned\tstatuses\tcomment")
+	for _, r := range reports {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%d\t%q\n",
+			r.ID,
+			r.CreatedAt,
+			r.Category,
+			acct(r.Account),
+			acct(r.TargetAccount),
+			acct(r.AssignedAccount),
+			len(r.Statuses),
+			r.Comment,
+		)
+	}
+	return w.Flush()
+}
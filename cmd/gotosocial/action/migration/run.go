@@ -23,6 +23,7 @@
 
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db/bundb"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
 )
@@ -31,7 +32,12 @@
 var _ action.GTSAction = Run
 
 // Run will initialize the database, running any available migrations.
+// If dry run is set, pending migrations are only simulated and printed.
 func Run(ctx context.Context) error {
+	if config.GetAdminMediaPruneDryRun() {
+		return dryRun(ctx)
+	}
+
 	var state state.State
 
 	defer func() {
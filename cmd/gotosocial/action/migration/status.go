@@ -0,0 +1,94 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action"
+	"code.superseriousbusiness.org/gotosocial/internal/db/bundb"
+)
+
+// check function conformance.
+var _ action.GTSAction = Status
+
+// Status lists all known database migrations,
+// and whether or not they have been applied.
+func Status(ctx context.Context) error {
+	statuses, err := bundb.MigrationStatuses(ctx)
+	if err != nil {
+		return err
+	}
+
+	var pending int
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	fmt.Fprintln(w, "migration\tapplied\tgroup\tmigrated at")
+	for _, m := range statuses {
+		if !m.IsApplied() {
+			pending++
+			fmt.Fprintf(w, "%s\tno\t\t\n", m.Name)
+			continue
+		}
+		fmt.Fprintf(w, "%s\tyes\t%d\t%s\n", m.Name, m.GroupID, m.MigratedAt.Format(time.RFC3339))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n%d of %d migrations pending\n", pending, len(statuses))
+	return nil
+}
+
+// dryRun simulates all pending database migrations,
+// printing the tables they'd touch and their SQL.
+func dryRun(ctx context.Context) error {
+	plans, err := bundb.MigrationsDryRun(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(plans) == 0 {
+		fmt.Println("there are no new migrations to run")
+		return nil
+	}
+
+	for _, plan := range plans {
+		fmt.Printf("-- migration: %s\n", plan.Migration.Name)
+		if len(plan.Tables) > 0 {
+			fmt.Printf("-- affected tables (estimated): %s\n", strings.Join(plan.Tables, ", "))
+		}
+		if plan.Err != nil {
+			// Simulation incomplete, likely due to
+			// migration depending on existing data.
+			fmt.Printf("-- WARNING: simulation incomplete: %v\n", plan.Err)
+		}
+		for _, query := range plan.Queries {
+			fmt.Printf("%s;\n", strings.TrimSuffix(query, ";"))
+		}
+		fmt.Println()
+	}
+
+	fmt.Printf("-- %d migrations pending; nothing was executed. Data-dependent queries\n"+
+		"-- (eg., updates to existing rows) may be missing from the above output.\n", len(plans))
+	return nil
+}
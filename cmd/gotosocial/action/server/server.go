@@ -26,6 +26,7 @@
 	"os"
 	"os/signal"
 	"runtime"
+	"slices"
 	"strings"
 	"syscall"
 	"time"
@@ -34,7 +35,9 @@
 	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action"
 	"code.superseriousbusiness.org/gotosocial/internal/admin"
 	"code.superseriousbusiness.org/gotosocial/internal/api"
+	adminapi "code.superseriousbusiness.org/gotosocial/internal/api/client/admin"
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/captcha"
 	"code.superseriousbusiness.org/gotosocial/internal/cleaner"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db/bundb"
@@ -47,6 +50,8 @@
 	"code.superseriousbusiness.org/gotosocial/internal/filter/status"
 	"code.superseriousbusiness.org/gotosocial/internal/filter/visibility"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtslog"
+	"code.superseriousbusiness.org/gotosocial/internal/hooks"
 	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
 	"code.superseriousbusiness.org/gotosocial/internal/media"
 	"code.superseriousbusiness.org/gotosocial/internal/media/ffmpeg"
@@ -57,11 +62,15 @@
 	"code.superseriousbusiness.org/gotosocial/internal/observability"
 	"code.superseriousbusiness.org/gotosocial/internal/oidc"
 	"code.superseriousbusiness.org/gotosocial/internal/processing"
+	"code.superseriousbusiness.org/gotosocial/internal/readonly"
+	"code.superseriousbusiness.org/gotosocial/internal/relme"
 	"code.superseriousbusiness.org/gotosocial/internal/router"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
 	gtsstorage "code.superseriousbusiness.org/gotosocial/internal/storage"
 	"code.superseriousbusiness.org/gotosocial/internal/subscriptions"
+	"code.superseriousbusiness.org/gotosocial/internal/translate"
 	"code.superseriousbusiness.org/gotosocial/internal/transport"
+	"code.superseriousbusiness.org/gotosocial/internal/transport/circuit"
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
 	"code.superseriousbusiness.org/gotosocial/internal/web"
 	"code.superseriousbusiness.org/gotosocial/internal/webpush"
@@ -136,6 +145,10 @@ func Start(ctx context.Context) error {
 			}
 		}
 
+		// Give workers a chance to drain their
+		// queues now no new requests are coming in.
+		drainWorkers(ctx, state)
+
 		// Stop any currently running
 		// worker processes / scheduled
 		// tasks from being executed.
@@ -252,6 +265,18 @@ func Start(ctx context.Context) error {
 		TLSInsecureSkipVerify: config.GetHTTPClientTLSInsecureSkipVerify(),
 	})
 
+	// Prepare any configured moderation hooks.
+	state.Hooks = hooks.New(client)
+
+	// Prepare any configured translation backend.
+	state.Translator = translate.New(client)
+
+	// Prepare any configured sign-up captcha provider.
+	state.Captcha = captcha.New(client)
+
+	// Prepare verifier for profile field links.
+	state.RelMe = relme.New(client)
+
 	// Compile WASM modules ahead of first use
 	// to prevent unexpected initial slowdowns.
 	//
@@ -281,6 +306,14 @@ func Start(ctx context.Context) error {
 	spamFilter := spam.NewFilter(state)
 	federatingDB := federatingdb.New(state, typeConverter, visFilter, intFilter, spamFilter)
 	transportController := transport.NewController(state, federatingDB, client)
+
+	// Initialize the delivery circuit breaker, loading
+	// any domains previously marked as unreachable.
+	breaker := circuit.New(state.DB)
+	if err := breaker.Load(ctx); err != nil {
+		return fmt.Errorf("error loading unreachable domains: %w", err)
+	}
+
 	federator := federation.NewFederator(
 		state,
 		federatingDB,
@@ -334,6 +367,23 @@ func(context.Context, time.Time) {
 		return fmt.Errorf("error scheduling cache sweep: %w", err)
 	}
 
+	// Add a task to the scheduler to clear the
+	// visibility cache, as a consistency sweep
+	// for any stale results not yet invalidated.
+	// Frequency = configured (<= 0 disables)
+	if freq := config.GetCacheVisibilitySweepFrequency(); freq > 0 {
+		if !state.Workers.Scheduler.AddRecurring(
+			"@visibilitysweep",   // id
+			time.Now().Add(freq), // start
+			freq,                 // freq
+			func(context.Context, time.Time) {
+				state.Caches.Visibility.Clear()
+			},
+		) {
+			return errors.New("error scheduling visibility cache sweep")
+		}
+	}
+
 	// Create background cleaner.
 	cleaner := cleaner.New(state)
 
@@ -372,10 +422,23 @@ func(context.Context, time.Time) {
 		return fmt.Errorf("error scheduling subscriptions jobs: %w", err)
 	}
 
+	// Schedule instance directory sync (if configured).
+	process.Suggestions().ScheduleDirectorySync()
+
+	// Schedule follow suggestions refresh.
+	process.Suggestions().ScheduleSuggestionsRefresh()
+
+	// Schedule sitemap generation (if enabled).
+	process.Sitemap().ScheduleSitemapGenerate()
+
+	// Schedule instance nodeinfo refresh (if enabled).
+	process.Admin().ScheduleNodeInfoRefresh()
+
 	// Initialize the specialized workers pools.
 	state.Workers.Client.Init(messages.ClientMsgIndices())
 	state.Workers.Federator.Init(messages.FederatorMsgIndices())
 	state.Workers.Delivery.Init(client)
+	state.Workers.Delivery.Breaker = breaker
 	state.Workers.Client.Process = process.Workers().ProcessFromClientAPI
 	state.Workers.Federator.Process = process.Workers().ProcessFromFediAPI
 
@@ -392,6 +455,11 @@ func(context.Context, time.Time) {
 		return fmt.Errorf("error scheduling status publications: %w", err)
 	}
 
+	// resume any running status bulk deletes.
+	if err := process.Status().BulkDeletesScheduleAll(ctx); err != nil {
+		return fmt.Errorf("error resuming status bulk deletes: %w", err)
+	}
+
 	// Initialize metrics.
 	if err := observability.InitializeMetrics(ctx, state); err != nil {
 		return fmt.Errorf("error initializing metrics: %w", err)
@@ -402,6 +470,14 @@ func(context.Context, time.Time) {
 		return err
 	}
 
+	// Set read-only mode on state, entering it
+	// straight away if configured to do so, which
+	// pauses the workers we just started above.
+	state.ReadOnly = readonly.New(&state.Workers)
+	if config.GetReadOnlyMode() {
+		state.ReadOnly.Enable()
+	}
+
 	/*
 		HTTP router initialization
 	*/
@@ -443,6 +519,10 @@ func(context.Context, time.Time) {
 		middleware.CORS(),
 		middleware.ExtraHeaders(),
 		middleware.Timeout(10 * time.Minute),
+		middleware.ReadOnly(state,
+			config.GetReadOnlyRetryAfter(),
+			"/api"+adminapi.ReadOnlyPath,
+		),
 	}...)
 
 	// Instantiate Content-Security-Policy
@@ -505,21 +585,25 @@ func(context.Context, time.Time) {
 		clientModule      = api.NewClient(state, process)                                              // api client endpoints
 		healthModule      = api.NewHealth(dbService.Ready)                                             // Health check endpoints
 		fileserverModule  = api.NewFileserver(process)                                                 // fileserver endpoints
-		robotsModule      = api.NewRobots()                                                            // robots.txt endpoint
+		robotsModule      = api.NewRobots(process)                                                     // robots.txt endpoint
 		wellKnownModule   = api.NewWellKnown(process)                                                  // .well-known endpoints
 		nodeInfoModule    = api.NewNodeInfo(process)                                                   // nodeinfo endpoint
+		oEmbedModule      = api.NewOEmbed(process)                                                     // oEmbed endpoint
 		activityPubModule = api.NewActivityPub(dbService, process)                                     // ActivityPub endpoints
-		webModule         = web.New(dbService, process, cookiePolicy)                                  // web pages + user profiles + settings panels etc
+		webModule         = web.New(dbService, process, cookiePolicy, state.Captcha)                   // web pages + user profiles + settings panels etc
 	)
 
 	// Create per-route / per-grouping middlewares.
 	// rate limiting
-	rlLimit := config.GetAdvancedRateLimitRequests()
-	exceptions := config.GetAdvancedRateLimitExceptions()
-	clLimit := middleware.RateLimit(rlLimit, exceptions)        // client api
-	s2sLimit := middleware.RateLimit(rlLimit, exceptions)       // server-to-server (AP)
-	fsMainLimit := middleware.RateLimit(rlLimit, exceptions)    // fileserver / web templates
-	fsEmojiLimit := middleware.RateLimit(rlLimit*2, exceptions) // fileserver (emojis only, use high limit)
+	// Rate limits are fetched on each request
+	// so that they can be changed on config reload.
+	rlLimit := config.GetAdvancedRateLimitRequests
+	rlEmojiLimit := func() int { return 2 * rlLimit() }
+	exceptions := func() []netip.Prefix { return config.GetAdvancedRateLimitExceptions() }
+	clLimit := middleware.DynamicRateLimit(rlLimit, exceptions)           // client api
+	s2sLimit := middleware.DynamicRateLimit(rlLimit, exceptions)          // server-to-server (AP)
+	fsMainLimit := middleware.DynamicRateLimit(rlLimit, exceptions)       // fileserver / web templates
+	fsEmojiLimit := middleware.DynamicRateLimit(rlEmojiLimit, exceptions) // fileserver (emojis only, use high limit)
 
 	// throttling
 	cpuMultiplier := config.GetAdvancedThrottlingMultiplier()
@@ -562,10 +646,17 @@ func(context.Context, time.Time) {
 	robotsModule.Route(route, fsMainLimit, fsThrottle, robotsDisallowAIOnly, gzip)
 	wellKnownModule.Route(route, gzip, s2sLimit, s2sThrottle)
 	nodeInfoModule.Route(route, s2sLimit, s2sThrottle, gzip)
+	oEmbedModule.Route(route, clLimit, clThrottle, robotsDisallowAll, gzip)
 	activityPubModule.Route(route, s2sLimit, s2sThrottle, robotsDisallowAll, gzip)
 	activityPubModule.RoutePublicKey(route, s2sLimit, pkThrottle, robotsDisallowAll, gzip)
 	webModule.Route(route, fsMainLimit, fsThrottle, robotsDisallowAIOnly, gzip)
 
+	// Only route admin profiling endpoints if enabled.
+	if config.GetDebugEndpointsEnabled() {
+		pprofModule := api.NewPprof(dbService, process)
+		pprofModule.Route(route, clLimit, clThrottle, robotsDisallowAll)
+	}
+
 	// Finally start the main http server!
 	if err := route.Start(); err != nil {
 		return fmt.Errorf("error starting router: %w", err)
@@ -576,13 +667,74 @@ func(context.Context, time.Time) {
 		return fmt.Errorf("error filling worker queues: %w", err)
 	}
 
-	// catch shutdown signals from the operating system
+	// catch shutdown and reload signals from the operating system
 	sigs := make(chan os.Signal, 1)
-	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
-	sig := <-sigs // block until signal received
-	log.Infof(ctx, "received signal %s, shutting down", sig)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	for {
+		sig := <-sigs // block until signal received
+		if sig == syscall.SIGHUP {
+			reloadConfig(ctx)
+			continue
+		}
+		log.Infof(ctx, "received signal %s, shutting down", sig)
+		return nil
+	}
+}
 
-	return nil
+// drainWorkers waits up to the configured shutdown drain
+// timeout for worker queues to be emptied, logging the result.
+func drainWorkers(ctx context.Context, state *state.State) {
+	timeout := config.GetShutdownDrainTimeout()
+	if timeout <= 0 {
+		// Draining disabled.
+		return
+	}
+
+	if state.ReadOnly.Enabled() {
+		// Workers are paused, there's no
+		// point waiting for them to drain.
+		log.Info(ctx, "in read-only mode, skipping worker queue drain")
+		return
+	}
+
+	// The main ctx is very likely canceled,
+	// so use a new context with our timeout.
+	ctx, cncl := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+	defer cncl()
+
+	log.Infof(ctx, "draining worker queues for up to %s", timeout)
+
+	if !state.Workers.Drain(ctx) {
+		log.Warn(ctx, "timed out draining worker queues, persisting remaining tasks")
+		return
+	}
+
+	log.Info(ctx, "drained worker queues")
+}
+
+// reloadConfig reloads hot-reloadable values from the config
+// file, applying any that aren't simply fetched on each use.
+func reloadConfig(ctx context.Context) {
+	log.Info(ctx, "received signal SIGHUP, reloading config")
+
+	changed, err := config.ReloadConfigFile()
+	if err != nil {
+		log.Errorf(ctx, "error reloading config: %v", err)
+		return
+	}
+
+	if len(changed) == 0 {
+		log.Info(ctx, "config reloaded, no changes")
+		return
+	}
+
+	if slices.Contains(changed, config.LogLevelFlag) {
+		if err := gtslog.ParseLevel(config.GetLogLevel()); err != nil {
+			log.Errorf(ctx, "error parsing log level: %v", err)
+		}
+	}
+
+	log.Infof(ctx, "config reloaded, changed: %s", strings.Join(changed, ", "))
 }
 
 func setLimits(ctx context.Context) {
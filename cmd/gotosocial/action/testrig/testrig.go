@@ -32,6 +32,7 @@
 	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action"
 	"code.superseriousbusiness.org/gotosocial/internal/admin"
 	"code.superseriousbusiness.org/gotosocial/internal/api"
+	adminapi "code.superseriousbusiness.org/gotosocial/internal/api/client/admin"
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
 	"code.superseriousbusiness.org/gotosocial/internal/cleaner"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
@@ -40,6 +41,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/middleware"
 	"code.superseriousbusiness.org/gotosocial/internal/observability"
 	"code.superseriousbusiness.org/gotosocial/internal/oidc"
+	"code.superseriousbusiness.org/gotosocial/internal/readonly"
 	"code.superseriousbusiness.org/gotosocial/internal/router"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
 	"code.superseriousbusiness.org/gotosocial/internal/storage"
@@ -181,6 +183,12 @@ func Start(ctx context.Context) error {
 		return err
 	}
 
+	// Set read-only mode on state.
+	state.ReadOnly = readonly.New(&state.Workers)
+	if config.GetReadOnlyMode() {
+		state.ReadOnly.Enable()
+	}
+
 	/*
 		HTTP router initialization
 	*/
@@ -203,6 +211,10 @@ func Start(ctx context.Context) error {
 		middleware.UserAgent(),
 		middleware.CORS(),
 		middleware.ExtraHeaders(),
+		middleware.ReadOnly(state,
+			config.GetReadOnlyRetryAfter(),
+			"/api"+adminapi.ReadOnlyPath,
+		),
 	}...)
 
 	// Instantiate Content-Security-Policy
@@ -263,11 +275,12 @@ func Start(ctx context.Context) error {
 		clientModule      = api.NewClient(state, processor)                                              // api client endpoints
 		healthModule      = api.NewHealth(state.DB.Ready)                                                // Health check endpoints
 		fileserverModule  = api.NewFileserver(processor)                                                 // fileserver endpoints
-		robotsModule      = api.NewRobots()                                                              // robots.txt endpoint
+		robotsModule      = api.NewRobots(processor)                                                     // robots.txt endpoint
 		wellKnownModule   = api.NewWellKnown(processor)                                                  // .well-known endpoints
 		nodeInfoModule    = api.NewNodeInfo(processor)                                                   // nodeinfo endpoint
+		oEmbedModule      = api.NewOEmbed(processor)                                                     // oEmbed endpoint
 		activityPubModule = api.NewActivityPub(state.DB, processor)                                      // ActivityPub endpoints
-		webModule         = web.New(state.DB, processor, cookiePolicy)                                   // web pages + user profiles + settings panels etc
+		webModule         = web.New(state.DB, processor, cookiePolicy, state.Captcha)                    // web pages + user profiles + settings panels etc
 	)
 
 	// these should be routed in order
@@ -279,6 +292,7 @@ func Start(ctx context.Context) error {
 	robotsModule.Route(route)
 	wellKnownModule.Route(route)
 	nodeInfoModule.Route(route)
+	oEmbedModule.Route(route)
 	activityPubModule.Route(route)
 	activityPubModule.RoutePublicKey(route)
 	webModule.Route(route)
@@ -19,8 +19,12 @@
 
 import (
 	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action/admin/account"
+	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action/admin/db"
+	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action/admin/domain"
+	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action/admin/emoji"
 	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action/admin/media"
 	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action/admin/media/prune"
+	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action/admin/report"
 	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action/admin/trans"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"github.com/spf13/cobra"
@@ -159,6 +163,32 @@ func adminCommands() *cobra.Command {
 	config.AddAdminAccount(adminAccountDisable2FACmd)
 	adminAccountCmd.AddCommand(adminAccountDisable2FACmd)
 
+	adminAccountRotateKeysCmd := &cobra.Command{
+		Use:   "rotate-keys",
+		Short: "rotate the http signature keypair of the given local account, or the instance account if username is the instance host",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), account.RotateKeys)
+		},
+	}
+	config.AddAdminAccount(adminAccountRotateKeysCmd)
+	adminAccountCmd.AddCommand(adminAccountRotateKeysCmd)
+
+	adminAccountSuspendCmd := &cobra.Command{
+		Use:   "suspend",
+		Short: "suspend the given local account via the admin API of the running instance",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd, skipValidation: true}) // only talks to the API
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), account.Suspend)
+		},
+	}
+	config.AddAdminAccountSuspend(adminAccountSuspendCmd)
+	adminAccountCmd.AddCommand(adminAccountSuspendCmd)
+
 	adminCmd.AddCommand(adminAccountCmd)
 
 	/*
@@ -191,6 +221,43 @@ func adminCommands() *cobra.Command {
 	config.AddAdminTrans(adminImportCmd)
 	adminCmd.AddCommand(adminImportCmd)
 
+	/*
+		ADMIN DB COMMANDS
+	*/
+
+	adminDBCmd := &cobra.Command{
+		Use:   "db",
+		Short: "admin commands related to the database",
+	}
+
+	adminDBBackupCmd := &cobra.Command{
+		Use:   "backup",
+		Short: "write a consistent snapshot of the sqlite database to file at the given path; safe to run while the server is running",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), db.Backup)
+		},
+	}
+	config.AddAdminTrans(adminDBBackupCmd)
+	adminDBCmd.AddCommand(adminDBBackupCmd)
+
+	adminDBRestoreCmd := &cobra.Command{
+		Use:   "restore",
+		Short: "replace the sqlite database with the snapshot at the given path; the server MUST be stopped first",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), db.Restore)
+		},
+	}
+	config.AddAdminTrans(adminDBRestoreCmd)
+	adminDBCmd.AddCommand(adminDBRestoreCmd)
+
+	adminCmd.AddCommand(adminDBCmd)
+
 	/*
 		ADMIN MEDIA COMMANDS
 	*/
@@ -281,5 +348,77 @@ func adminCommands() *cobra.Command {
 
 	adminCmd.AddCommand(adminMediaCmd)
 
+	/*
+	   ADMIN DOMAIN LIMIT COMMANDS
+	*/
+
+	adminDomainLimitCmd := &cobra.Command{
+		Use:   "domain-limit",
+		Short: "admin commands related to domain limits, performed via the admin API of the running instance",
+	}
+
+	adminDomainLimitCreateCmd := &cobra.Command{
+		Use:   "create",
+		Short: "create a limit on the given domain",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd, skipValidation: true}) // only talks to the API
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), domain.LimitCreate)
+		},
+	}
+	config.AddAdminDomainLimitCreate(adminDomainLimitCreateCmd)
+	adminDomainLimitCmd.AddCommand(adminDomainLimitCreateCmd)
+
+	adminCmd.AddCommand(adminDomainLimitCmd)
+
+	/*
+	   ADMIN REPORT COMMANDS
+	*/
+
+	adminReportCmd := &cobra.Command{
+		Use:   "report",
+		Short: "admin commands related to reports, performed via the admin API of the running instance",
+	}
+
+	adminReportListCmd := &cobra.Command{
+		Use:   "list",
+		Short: "list unresolved reports, or resolved reports if --resolved is set",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd, skipValidation: true}) // only talks to the API
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), report.List)
+		},
+	}
+	config.AddAdminReportList(adminReportListCmd)
+	adminReportCmd.AddCommand(adminReportListCmd)
+
+	adminCmd.AddCommand(adminReportCmd)
+
+	/*
+	   ADMIN EMOJI COMMANDS
+	*/
+
+	adminEmojiCmd := &cobra.Command{
+		Use:   "emoji",
+		Short: "admin commands related to custom emojis, performed via the admin API of the running instance",
+	}
+
+	adminEmojiImportCmd := &cobra.Command{
+		Use:   "import",
+		Short: "import custom emojis from the zipped emoji pack at the given path",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd, skipValidation: true}) // only talks to the API
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), emoji.Import)
+		},
+	}
+	config.AddAdminEmojiImport(adminEmojiImportCmd)
+	adminEmojiCmd.AddCommand(adminEmojiImportCmd)
+
+	adminCmd.AddCommand(adminEmojiCmd)
+
 	return adminCmd
 }
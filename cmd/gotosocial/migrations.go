@@ -19,6 +19,7 @@
 
 import (
 	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action/migration"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"github.com/spf13/cobra"
 )
 
@@ -38,6 +39,20 @@ func migrationCommands() *cobra.Command {
 			return run(cmd.Context(), migration.Run)
 		},
 	}
+	config.AddMigrationsRun(migrationRunCmd)
 	migrationCmd.AddCommand(migrationRunCmd)
+
+	migrationStatusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "lists all database migrations, and whether or not they have been applied",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), migration.Status)
+		},
+	}
+	migrationCmd.AddCommand(migrationStatusCmd)
+
 	return migrationCmd
 }
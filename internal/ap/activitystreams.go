@@ -111,6 +111,11 @@ func PublicIRI() *url.URL { var u url.URL; u = *publicIRI; return &u }
 	ObjectLikeAuthorization     = "LikeAuthorization"
 	ObjectReplyAuthorization    = "ReplyAuthorization"
 	ObjectAnnounceAuthorization = "AnnounceAuthorization"
+	ObjectInteractionPolicy     = "InteractionPolicy"
+
+	/* LitePub / Pleroma stuff */
+
+	ActivityEmojiReact = "EmojiReact" // LitePubEmojiReact https://docs.pleroma.social/backend/development/ap_extensions/#emojireact
 
 	/* Funkwhale stuff */
 
@@ -147,7 +152,8 @@ func isActivity(typeName string) bool {
 		ActivityDislike,
 		ActivityLikeRequest,
 		ActivityReplyRequest,
-		ActivityAnnounceRequest:
+		ActivityAnnounceRequest,
+		ActivityEmojiReact:
 		return true
 	default:
 		return false
@@ -19,6 +19,7 @@
 
 import (
 	"crypto"
+	"crypto/ed25519"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
@@ -33,6 +34,7 @@
 	"code.superseriousbusiness.org/activity/streams/vocab"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/integrity"
 	"code.superseriousbusiness.org/gotosocial/internal/text"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
 )
@@ -678,6 +680,45 @@ func ExtractFields(i WithAttachment) []*gtsmodel.Field {
 	return fields
 }
 
+// ExtractAssertionMethodKey extracts the first Ed25519 Multikey
+// controlled by the given owner from the `assertionMethod` of an
+// actor, returning the key and its ID. If no such key is present,
+// this will return a nil key and an empty ID.
+func ExtractAssertionMethodKey(i WithUnknownProperties, ownerID string) (ed25519.PublicKey, string) {
+	var methods []interface{}
+	switch t := i.GetUnknownProperties()["assertionMethod"].(type) {
+	case []interface{}:
+		methods = t
+	case map[string]interface{}:
+		methods = []interface{}{t}
+	}
+
+	for _, method := range methods {
+		m, ok := method.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		id, _ := m["id"].(string)
+		controller, _ := m["controller"].(string)
+		if id == "" || m["type"] != integrity.MultikeyType || controller != ownerID {
+			continue
+		}
+
+		multibase, _ := m["publicKeyMultibase"].(string)
+		key, err := integrity.DecodeMultikey(multibase)
+		if err != nil {
+			// Not an Ed25519 key,
+			// look for another one.
+			continue
+		}
+
+		return key, id
+	}
+
+	return nil, ""
+}
+
 // ExtractPubKeyFromActor extracts the public key, public key ID, and public
 // key owner ID from an interface, or an error if something goes wrong.
 func ExtractPubKeyFromActor(i WithPublicKey) (
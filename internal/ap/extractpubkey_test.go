@@ -49,6 +49,30 @@
   "owner": "https://gts.superseriousbusiness.org/users/dumpsterqueer",
   "publicKeyPem": "-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAt7cDz2XfTJXbmmmVXZ3o\nQGB1zu1yP+2/QZZFbLCeM0bMm5cfjJ/olli6kpdcGLh1lFpSgyLE0PlAVNYdSke9\nzcxDao6N16wavFx/bOYhh8HJPPXzlFpNeQQ+EBQ1ivzuLQyzIFTMV4TyZzOREoG9\nizuXuuKDaH/ENDE6qlIDuqtICIjnURjpxnBLldPUxfUvuSO3zY+jTidsxhjUjqkK\nC7RtEVi/D6/CzktVevz5bE/gcAYgKmK0dmkJ9HH6LzOlvkM4Wrq5h/hrM+H1z5e5\nPpdJsl3KlRT4wusuM1Z5xqLQ0oIP4mX/Kd3ypCe150i+jaoCsqBk8OPtl/zKMw1a\nYQIDAQAB\n-----END PUBLIC KEY-----\n"
 }`
+
+	multikeyActor = `{
+  "@context": [
+    "https://www.w3.org/ns/activitystreams",
+    "https://w3id.org/security/multikey/v1"
+  ],
+  "id": "https://gts.superseriousbusiness.org/users/dumpsterqueer",
+  "preferredUsername": "dumpsterqueer",
+  "assertionMethod": [
+    {
+      "id": "https://gts.superseriousbusiness.org/users/someone_else#ed25519-key",
+      "type": "Multikey",
+      "controller": "https://gts.superseriousbusiness.org/users/someone_else",
+      "publicKeyMultibase": "z6MkrJVnaZkeFzdQyMZu1cgjg7k1pZZ6pvBQ7XJPt4swbTQ2"
+    },
+    {
+      "id": "https://gts.superseriousbusiness.org/users/dumpsterqueer#ed25519-key",
+      "type": "Multikey",
+      "controller": "https://gts.superseriousbusiness.org/users/dumpsterqueer",
+      "publicKeyMultibase": "z6MkrJVnaZkeFzdQyMZu1cgjg7k1pZZ6pvBQ7XJPt4swbTQ2"
+    }
+  ],
+  "type": "Person"
+}`
 )
 
 type ExtractPubKeyTestSuite struct {
@@ -102,6 +126,32 @@ func (suite *ExtractPubKeyTestSuite) TestExtractPubKeyFromKey() {
 	suite.Equal("https://gts.superseriousbusiness.org/users/dumpsterqueer", ownerURI.String())
 }
 
+func (suite *ExtractPubKeyTestSuite) TestExtractAssertionMethodKey() {
+	m := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(multikeyActor), &m); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	t, err := streams.ToType(suite.T().Context(), m)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	with, ok := t.(ap.WithUnknownProperties)
+	if !ok {
+		suite.FailNow("", "could not parse %T as WithUnknownProperties", t)
+	}
+
+	// Only the key controlled by the actor should be used.
+	key, keyID := ap.ExtractAssertionMethodKey(with, "https://gts.superseriousbusiness.org/users/dumpsterqueer")
+	suite.Len(key, 32)
+	suite.Equal("https://gts.superseriousbusiness.org/users/dumpsterqueer#ed25519-key", keyID)
+
+	key, keyID = ap.ExtractAssertionMethodKey(with, "https://gts.superseriousbusiness.org/users/nobody")
+	suite.Nil(key)
+	suite.Empty(keyID)
+}
+
 func TestExtractPubKeyTestSuite(t *testing.T) {
 	suite.Run(t, &ExtractPubKeyTestSuite{})
 }
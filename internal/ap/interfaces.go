@@ -387,6 +387,16 @@ type Likeable interface {
 	WithObject
 }
 
+// Reactable represents the minimum interface for an emoji
+// reaction activity, ie., a LitePub 'EmojiReact', or a
+// Misskey-style 'Like' with content set to the emoji.
+type Reactable interface {
+	Likeable
+
+	WithContent
+	WithTag
+}
+
 // Blockable represents the minimum interface for an activitystreams 'block' activity.
 type Blockable interface {
 	WithJSONLDId
@@ -866,3 +876,9 @@ type WithInteractionTarget interface {
 	GetGoToSocialInteractionTarget() vocab.GoToSocialInteractionTargetProperty
 	SetGoToSocialInteractionTarget(vocab.GoToSocialInteractionTargetProperty)
 }
+
+// WithUnknownProperties represents any type which exposes
+// the JSON properties it has no vocabulary definition for.
+type WithUnknownProperties interface {
+	GetUnknownProperties() map[string]interface{}
+}
@@ -18,6 +18,7 @@
 package ap
 
 import (
+	"crypto/ed25519"
 	"fmt"
 	"net/url"
 	"time"
@@ -25,6 +26,7 @@
 	"code.superseriousbusiness.org/activity/streams"
 	"code.superseriousbusiness.org/activity/streams/vocab"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/integrity"
 )
 
 // MustGet performs the given 'Get$Property(with) (T, error)' signature function, panicking on error.
@@ -384,6 +386,38 @@ func SetOutbox(with WithOutbox, outbox *url.URL) {
 	outboxProp.SetIRI(outbox)
 }
 
+// SetAssertionMethodKey sets the given Ed25519 key as a Multikey
+// in the `assertionMethod` property of 'with', controlled by owner.
+func SetAssertionMethodKey(with WithUnknownProperties, keyID string, owner string, key ed25519.PublicKey) {
+	with.GetUnknownProperties()["assertionMethod"] = []interface{}{
+		map[string]interface{}{
+			"id":                 keyID,
+			"type":               integrity.MultikeyType,
+			"controller":         owner,
+			"publicKeyMultibase": integrity.EncodeMultikey(key),
+		},
+	}
+}
+
+// NoIndexContext is the JSON-LD context term defining the
+// `noindex` actor property, as originally used by Misskey.
+var NoIndexContext = map[string]any{
+	"noindex": "https://misskey-hub.net/ns#noindex",
+}
+
+// GetNoIndex returns the boolean contained in the `noindex` property
+// of 'with', and whether it was set at all. This property has no
+// vocabulary definition, so it's read from the unknown properties.
+func GetNoIndex(with WithUnknownProperties) (noindex bool, ok bool) {
+	noindex, ok = with.GetUnknownProperties()["noindex"].(bool)
+	return
+}
+
+// SetNoIndex sets the given boolean on the `noindex` property of 'with'.
+func SetNoIndex(with WithUnknownProperties, noindex bool) {
+	with.GetUnknownProperties()["noindex"] = noindex
+}
+
 // GetFollowers returns the IRI contained in the Following property of 'with'.
 func GetFollowing(with WithFollowing) *url.URL {
 	followProp := with.GetActivityStreamsFollowing()
@@ -29,8 +29,14 @@
 	"code.superseriousbusiness.org/activity/streams"
 	"code.superseriousbusiness.org/activity/streams/vocab"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"codeberg.org/gruf/go-bytesize"
 )
 
+// MaxInboxPostSize is the maximum size in bytes of
+// an activity body we'll read in full from an inbox
+// POST request, eg., to verify a digest or proof.
+const MaxInboxPostSize = int64(bytesize.MiB)
+
 // ResolveActivity is a util function for pulling a pub.Activity type out of an incoming request body,
 // returning the resolved activity type, error and whether to accept activity (false = transient i.e. ignore).
 func ResolveIncomingActivity(r *http.Request) (pub.Activity, bool, gtserror.WithCode) {
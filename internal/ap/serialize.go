@@ -18,9 +18,13 @@
 package ap
 
 import (
+	"maps"
+	"slices"
+
 	"code.superseriousbusiness.org/activity/streams"
 	"code.superseriousbusiness.org/activity/streams/vocab"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/integrity"
 )
 
 // Serialize is a custom serializer for ActivityStreams types.
@@ -36,7 +40,7 @@
 //
 //   - OrderedCollection:       'orderedItems' property will always be made into an array.
 //   - OrderedCollectionPage:   'orderedItems' property will always be made into an array.
-//   - Any Accountable type:    'attachment' property will always be made into an array.
+//   - Any Accountable type:    'attachment' property will always be made into an array; multikey context added for 'assertionMethod', and noindex context for 'noindex'.
 //   - Any Statusable type:     'attachment' property will always be made into an array; 'content', 'contentMap', and 'interactionPolicy' will be normalized.
 //   - Any Activityable type:   any 'object's set on an activity will be custom serialized as above.
 func Serialize(t vocab.Type) (map[string]interface{}, error) {
@@ -94,9 +98,54 @@ func serializeAccountable(t vocab.Type, includeContext bool) (map[string]interfa
 	NormalizeOutgoingAttachmentProp(accountable, data)
 	NormalizeOutgoingAlsoKnownAsProp(accountable, data)
 
+	if _, ok := data["assertionMethod"]; ok && includeContext {
+		// Multikey has no vocabulary definition,
+		// so make sure its context is included.
+		integrity.AddContext(data, integrity.ContextMultikey)
+	}
+
+	if _, ok := data["noindex"]; ok && includeContext {
+		// Likewise noindex has no vocabulary
+		// definition, so include its context.
+		addContextTerms(data, NoIndexContext)
+	}
+
 	return data, nil
 }
 
+// addContextTerms adds the given term definitions to the `@context`
+// of data, merging them into an existing embedded context object
+// of term definitions if there is one, else appending them.
+func addContextTerms(data map[string]any, terms map[string]any) {
+	switch ctx := data["@context"].(type) {
+	case nil:
+		data["@context"] = terms
+
+	case map[string]any:
+		merged := maps.Clone(ctx)
+		maps.Copy(merged, terms)
+		data["@context"] = merged
+
+	case []any:
+		// Copy to ensure we never write
+		// to another document's context.
+		ctx = slices.Clone(ctx)
+		for i, c := range ctx {
+			if m, ok := c.(map[string]any); ok {
+				merged := maps.Clone(m)
+				maps.Copy(merged, terms)
+				ctx[i] = merged
+				data["@context"] = ctx
+				return
+			}
+		}
+		data["@context"] = append(ctx, terms)
+
+	default:
+		data["@context"] = []any{ctx, terms}
+	}
+}
+
 func serializeStatusable(t vocab.Type, includeContext bool) (map[string]interface{}, error) {
 	statusable, ok := t.(Statusable)
 	if !ok {
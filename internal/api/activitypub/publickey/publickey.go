@@ -27,7 +27,13 @@
 	"github.com/gin-gonic/gin"
 )
 
-const PublicKeyPath = "users/:" + apiutil.UsernameKey + "/" + uris.PublicKeyPath
+const (
+	// KeyIDKey is the path parameter identifying
+	// a specific key, given after a key rotation.
+	KeyIDKey = "keyid"
+
+	PublicKeyPath = "users/:" + apiutil.UsernameKey + "/" + uris.PublicKeyPath
+)
 
 type Module struct {
 	processor *processing.Processor
@@ -41,4 +47,5 @@ func New(processor *processing.Processor) *Module {
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	attachHandler(http.MethodGet, "", m.PublicKeyGETHandler)
+	attachHandler(http.MethodGet, "/:"+KeyIDKey, m.PublicKeyGETHandler)
 }
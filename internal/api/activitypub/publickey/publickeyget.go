@@ -57,6 +57,7 @@ func (m *Module) PublicKeyGETHandler(c *gin.Context) {
 	resp, errWithCode := m.processor.Fedi().UserGetMinimal(
 		c.Request.Context(),
 		requestedUser,
+		c.Param(KeyIDKey),
 	)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
@@ -539,6 +539,120 @@ func (suite *InboxPostTestSuite) TestPostFromBlockedAccount() {
 	)
 }
 
+func (suite *InboxPostTestSuite) TestPostCreateRedelivered() {
+	var (
+		ctx               = suite.T().Context()
+		requestingAccount = suite.testAccounts["remote_account_1"]
+		targetAccount     = suite.testAccounts["local_account_1"]
+		activity          = suite.testActivities["dm_for_zork"]
+		activityURI       = "http://fossbros-anonymous.io/users/foss_satan/statuses/5424b153-4553-4f30-9358-7b92f7cd42f6/activity"
+		statusURI         = "http://fossbros-anonymous.io/users/foss_satan/statuses/5424b153-4553-4f30-9358-7b92f7cd42f6"
+	)
+
+	// Post the create once.
+	suite.inboxPost(
+		activity.Activity,
+		requestingAccount,
+		targetAccount,
+		http.StatusAccepted,
+		`{"status":"Accepted"}`,
+		suite.signatureCheck,
+	)
+
+	// Wait for the status to be created.
+	var status *gtsmodel.Status
+	if !testrig.WaitFor(func() bool {
+		status, _ = suite.state.DB.GetStatusByURI(ctx, statusURI)
+		return status != nil
+	}) {
+		suite.FailNow("timed out waiting for status to be created")
+	}
+
+	// The activity should now be marked as processed.
+	exists, err := suite.state.DB.InboxActivityExists(ctx, targetAccount.ID, activityURI)
+	suite.NoError(err)
+	suite.True(exists)
+
+	// Delete the status, so we can tell
+	// if a redelivery gets processed again.
+	if err := suite.state.DB.DeleteStatusByID(ctx, status.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Post the same create again, this should
+	// be accepted but not processed a second time.
+	suite.inboxPost(
+		activity.Activity,
+		requestingAccount,
+		targetAccount,
+		http.StatusAccepted,
+		`{"status":"Accepted"}`,
+		suite.signatureCheck,
+	)
+
+	suite.Never(func() bool {
+		status, _ := suite.state.DB.GetStatusByURI(ctx, statusURI)
+		return status != nil
+	}, time.Second, 100*time.Millisecond)
+}
+
+func (suite *InboxPostTestSuite) TestPostCreateToMultipleInboxes() {
+	var (
+		ctx               = suite.T().Context()
+		requestingAccount = suite.testAccounts["remote_account_1"]
+		otherAccount      = suite.testAccounts["admin_account"]
+		targetAccount     = suite.testAccounts["local_account_1"]
+		activity          = suite.testActivities["dm_for_zork"]
+		activityURI       = "http://fossbros-anonymous.io/users/foss_satan/statuses/5424b153-4553-4f30-9358-7b92f7cd42f6/activity"
+		statusURI         = "http://fossbros-anonymous.io/users/foss_satan/statuses/5424b153-4553-4f30-9358-7b92f7cd42f6"
+	)
+
+	// Post the create to an inbox it isn't
+	// relevant to first, this should be
+	// accepted but not create the status.
+	suite.inboxPost(
+		activity.Activity,
+		requestingAccount,
+		otherAccount,
+		http.StatusAccepted,
+		`{"status":"Accepted"}`,
+		suite.signatureCheck,
+	)
+
+	// The activity should be marked as
+	// processed for that inbox only.
+	exists, err := suite.state.DB.InboxActivityExists(ctx, otherAccount.ID, activityURI)
+	suite.NoError(err)
+	suite.True(exists)
+
+	exists, err = suite.state.DB.InboxActivityExists(ctx, targetAccount.ID, activityURI)
+	suite.NoError(err)
+	suite.False(exists)
+
+	// Now post the same create to the inbox
+	// of the account it's addressed to, this
+	// should be processed and create the status.
+	suite.inboxPost(
+		activity.Activity,
+		requestingAccount,
+		targetAccount,
+		http.StatusAccepted,
+		`{"status":"Accepted"}`,
+		suite.signatureCheck,
+	)
+
+	if !testrig.WaitFor(func() bool {
+		status, _ := suite.state.DB.GetStatusByURI(ctx, statusURI)
+		return status != nil
+	}) {
+		suite.FailNow("timed out waiting for status to be created")
+	}
+
+	exists, err = suite.state.DB.InboxActivityExists(ctx, targetAccount.ID, activityURI)
+	suite.NoError(err)
+	suite.True(exists)
+}
+
 func (suite *InboxPostTestSuite) TestPostFromBlockedAccountToOtherAccount() {
 	var (
 		requestingAccount = suite.testAccounts["remote_account_1"]
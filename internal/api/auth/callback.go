@@ -172,6 +172,14 @@ func (m *Module) CallbackGETHandler(c *gin.Context) {
 		return
 	}
 
+	// Check sign ins are permitted from this address.
+	signInIP := net.ParseIP(c.ClientIP())
+	if errWithCode := m.processor.User().CheckSignInIP(c.Request.Context(), signInIP); errWithCode != nil {
+		m.mustClearSession(s)
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
 	s.Set(sessionUserID, user.ID)
 	if err := s.Save(); err != nil {
 		m.mustClearSession(s)
@@ -246,8 +254,16 @@ func (m *Module) FinalizePOSTHandler(c *gin.Context) {
 		return
 	}
 
+	// ensure sign ups are permitted from this address
+	signUpIP := net.ParseIP(c.ClientIP())
+	if errWithCode := m.processor.User().CheckSignUpIP(c.Request.Context(), signUpIP); errWithCode != nil {
+		m.mustClearSession(s)
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
 	// we're now ready to actually create the user
-	user, errWithCode := m.createUserFromOIDC(c.Request.Context(), claims, form, net.IP(c.ClientIP()), appID)
+	user, errWithCode := m.createUserFromOIDC(c.Request.Context(), claims, form, signUpIP, appID)
 	if errWithCode != nil {
 		m.mustClearSession(s)
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
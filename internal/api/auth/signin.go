@@ -21,6 +21,7 @@
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"slices"
 	"strings"
@@ -109,6 +110,13 @@ func (m *Module) SignInPOSTHandler(c *gin.Context) {
 		return
 	}
 
+	// Check sign ins are permitted from this address.
+	signInIP := net.ParseIP(c.ClientIP())
+	if errWithCode := m.processor.User().CheckSignInIP(c.Request.Context(), signInIP); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
 	user, errWithCode := m.validatePassword(
 		c.Request.Context(),
 		form.Email,
@@ -18,16 +18,20 @@
 package api
 
 import (
+	"net/http"
 	"time"
 
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/accounts"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/admin"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/announcements"
+	"code.superseriousbusiness.org/gotosocial/internal/api/client/antennas"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/apps"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/blocks"
+	"code.superseriousbusiness.org/gotosocial/internal/api/client/bookmarkcollections"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/bookmarks"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/conversations"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/customemojis"
+	"code.superseriousbusiness.org/gotosocial/internal/api/client/drafts"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/exports"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/favourites"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/featuredtags"
@@ -39,6 +43,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/instance"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/interactionpolicies"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/interactionrequests"
+	"code.superseriousbusiness.org/gotosocial/internal/api/client/invites"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/lists"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/markers"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/media"
@@ -50,6 +55,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/reports"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/scheduledstatuses"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/search"
+	"code.superseriousbusiness.org/gotosocial/internal/api/client/serviceaccounts"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/statuses"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/streaming"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/suggestions"
@@ -58,6 +64,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/tokens"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/trends"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/user"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/middleware"
 	"code.superseriousbusiness.org/gotosocial/internal/processing"
@@ -69,15 +76,19 @@
 type Client struct {
 	processor *processing.Processor
 	db        db.DB
+	state     *state.State
 
 	accounts            *accounts.Module            // api/v1/accounts, api/v1/profile
 	admin               *admin.Module               // api/v1/admin
 	announcements       *announcements.Module       // api/v1/announcements
+	antennas            *antennas.Module            // api/v1/antennas
 	apps                *apps.Module                // api/v1/apps
 	blocks              *blocks.Module              // api/v1/blocks
+	bookmarkCollections *bookmarkcollections.Module // api/v1/bookmark_collections
 	bookmarks           *bookmarks.Module           // api/v1/bookmarks
 	conversations       *conversations.Module       // api/v1/conversations
 	customEmojis        *customemojis.Module        // api/v1/custom_emojis
+	drafts              *drafts.Module              // api/v1/drafts
 	exports             *exports.Module             // api/v1/exports
 	favourites          *favourites.Module          // api/v1/favourites
 	featuredTags        *featuredtags.Module        // api/v1/featured_tags
@@ -89,6 +100,7 @@ type Client struct {
 	instance            *instance.Module            // api/v1/instance
 	interactionPolicies *interactionpolicies.Module // api/v1/interaction_policies
 	interactionRequests *interactionrequests.Module // api/v1/interaction_requests
+	invites             *invites.Module             // api/v1/invites
 	lists               *lists.Module               // api/v1/lists
 	markers             *markers.Module             // api/v1/markers
 	media               *media.Module               // api/v1/media, api/v2/media
@@ -100,6 +112,7 @@ type Client struct {
 	reports             *reports.Module             // api/v1/reports
 	scheduledStatuses   *scheduledstatuses.Module   // api/v1/scheduled_statuses
 	search              *search.Module              // api/v1/search, api/v2/search
+	serviceAccounts     *serviceaccounts.Module     // api/v1/service_accounts
 	statuses            *statuses.Module            // api/v1/statuses
 	streaming           *streaming.Module           // api/v1/streaming
 	suggestions         *suggestions.Module         // api/v2/suggestions
@@ -118,6 +131,11 @@ func (c *Client) Route(r *router.Router, m ...gin.HandlerFunc) {
 	apiGroup.Use(m...)
 	apiGroup.Use(
 		middleware.TokenCheck(c.db, c.processor.OAuthValidateBearerToken),
+		middleware.AccountRateLimit(&c.state.Caches.AccountRateLimits, map[string]int{
+			http.MethodPost + " /api" + statuses.BasePath:   config.GetAccountsRateLimitStatuses(),
+			http.MethodPost + " /api" + accounts.FollowPath: config.GetAccountsRateLimitFollows(),
+			http.MethodPost + " /api" + reports.BasePath:    config.GetAccountsRateLimitReports(),
+		}),
 		middleware.CacheControl(middleware.CacheControlConfig{
 			// Never cache client api responses.
 			Directives: []string{"no-store"},
@@ -130,11 +148,14 @@ func (c *Client) Route(r *router.Router, m ...gin.HandlerFunc) {
 	c.accounts.Route(h)
 	c.admin.Route(h)
 	c.announcements.Route(h)
+	c.antennas.Route(h)
 	c.apps.Route(h)
 	c.blocks.Route(h)
+	c.bookmarkCollections.Route(h)
 	c.bookmarks.Route(h)
 	c.conversations.Route(h)
 	c.customEmojis.Route(h)
+	c.drafts.Route(h)
 	c.exports.Route(h)
 	c.favourites.Route(h)
 	c.featuredTags.Route(h)
@@ -146,6 +167,7 @@ func (c *Client) Route(r *router.Router, m ...gin.HandlerFunc) {
 	c.instance.Route(h)
 	c.interactionPolicies.Route(h)
 	c.interactionRequests.Route(h)
+	c.invites.Route(h)
 	c.lists.Route(h)
 	c.markers.Route(h)
 	c.media.Route(h)
@@ -157,6 +179,7 @@ func (c *Client) Route(r *router.Router, m ...gin.HandlerFunc) {
 	c.reports.Route(h)
 	c.scheduledStatuses.Route(h)
 	c.search.Route(h)
+	c.serviceAccounts.Route(h)
 	c.statuses.Route(h)
 	c.streaming.Route(h)
 	c.suggestions.Route(h)
@@ -171,15 +194,19 @@ func NewClient(state *state.State, p *processing.Processor) *Client {
 	return &Client{
 		processor: p,
 		db:        state.DB,
+		state:     state,
 
 		accounts:            accounts.New(p),
 		admin:               admin.New(state, p),
 		announcements:       announcements.New(p),
+		antennas:            antennas.New(p),
 		apps:                apps.New(p),
 		blocks:              blocks.New(p),
+		bookmarkCollections: bookmarkcollections.New(p),
 		bookmarks:           bookmarks.New(p),
 		conversations:       conversations.New(p),
 		customEmojis:        customemojis.New(p),
+		drafts:              drafts.New(p),
 		exports:             exports.New(p),
 		favourites:          favourites.New(p),
 		featuredTags:        featuredtags.New(p),
@@ -191,6 +218,7 @@ func NewClient(state *state.State, p *processing.Processor) *Client {
 		instance:            instance.New(p),
 		interactionPolicies: interactionpolicies.New(p),
 		interactionRequests: interactionrequests.New(p),
+		invites:             invites.New(p),
 		lists:               lists.New(p),
 		markers:             markers.New(p),
 		media:               media.New(p),
@@ -202,6 +230,7 @@ func NewClient(state *state.State, p *processing.Processor) *Client {
 		reports:             reports.New(p),
 		scheduledStatuses:   scheduledstatuses.New(p),
 		search:              search.New(p),
+		serviceAccounts:     serviceaccounts.New(p),
 		statuses:            statuses.New(p),
 		streaming:           streaming.New(p, time.Second*30, 4096),
 		suggestions:         suggestions.New(p),
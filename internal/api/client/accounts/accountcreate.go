@@ -65,6 +65,12 @@
 //			schema:
 //				"$ref": "#/definitions/error"
 //			description: unauthorized
+//		'403':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: >-
+//				Forbidden. Registration is not open, or sign ups via the
+//				client API are disabled as the instance uses a captcha.
 //		'404':
 //			schema:
 //				"$ref": "#/definitions/error"
@@ -40,6 +40,7 @@
 
 	BlockPath         = BasePathWithID + "/block"
 	DeletePath        = BasePath + "/delete"
+	FamiliarPath      = BasePath + "/familiar_followers"
 	FeaturedTagsPath  = BasePathWithID + "/featured_tags"
 	FollowersPath     = BasePathWithID + "/followers"
 	FollowingPath     = BasePathWithID + "/following"
@@ -49,6 +50,9 @@
 	MutePath          = BasePathWithID + "/mute"
 	NotePath          = BasePathWithID + "/note"
 	RelationshipsPath = BasePath + "/relationships"
+	BulkUnfollowPath  = RelationshipsPath + "/unfollow"
+	BulkBlockPath     = RelationshipsPath + "/block"
+	BulkMutePath      = RelationshipsPath + "/mute"
 	SearchPath        = BasePath + "/search"
 	StatusesPath      = BasePathWithID + "/statuses"
 	UnblockPath       = BasePathWithID + "/unblock"
@@ -59,6 +63,7 @@
 	MovePath          = BasePath + "/move"
 	AliasPath         = BasePath + "/alias"
 	ThemesPath        = BasePath + "/themes"
+	RSSTokenPath      = BasePath + "/rss_token"
 
 	// ProfileBasePath for the profile API, an extension of the account update API with a different path.
 	ProfileBasePath = "/v1/profile"
@@ -105,10 +110,16 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	// get following or followers
 	attachHandler(http.MethodGet, FollowersPath, m.AccountFollowersGETHandler)
 	attachHandler(http.MethodGet, FollowingPath, m.AccountFollowingGETHandler)
+	attachHandler(http.MethodGet, FamiliarPath, m.AccountFamiliarFollowersGETHandler)
 
 	// get relationship with account
 	attachHandler(http.MethodGet, RelationshipsPath, m.AccountRelationshipsGETHandler)
 
+	// change relationships with many accounts at once
+	attachHandler(http.MethodPost, BulkUnfollowPath, m.AccountsUnfollowPOSTHandler)
+	attachHandler(http.MethodPost, BulkBlockPath, m.AccountsBlockPOSTHandler)
+	attachHandler(http.MethodPost, BulkMutePath, m.AccountsMutePOSTHandler)
+
 	// follow or unfollow account
 	attachHandler(http.MethodPost, FollowPath, m.AccountFollowPOSTHandler)
 	attachHandler(http.MethodPost, UnfollowPath, m.AccountUnfollowPOSTHandler)
@@ -137,4 +148,8 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 
 	// account themes
 	attachHandler(http.MethodGet, ThemesPath, m.AccountThemesGETHandler)
+
+	// private rss feed token
+	attachHandler(http.MethodPost, RSSTokenPath, m.AccountRSSTokenPOSTHandler)
+	attachHandler(http.MethodDelete, RSSTokenPath, m.AccountRSSTokenDELETEHandler)
 }
@@ -121,7 +121,7 @@
 //	-
 //		name: source[status_content_type]
 //		in: formData
-//		description: Default content type to use for authored statuses (text/plain or text/markdown).
+//		description: Default content type to use for authored statuses (text/plain or text/markdown, or shorthand plain or markdown).
 //		type: string
 //	-
 //		name: theme
@@ -139,6 +139,13 @@
 //			String must be no more than 5,000 characters (~5kb).
 //		type: string
 //	-
+//		name: accent_color
+//		in: formData
+//		description: >-
+//			Hex accent color to use when rendering this account's profile, eg., `#ff00aa`.
+//			Empty string unsets accent color and returns to the theme's default.
+//		type: string
+//	-
 //		name: enable_rss
 //		in: formData
 //		description: Enable RSS feed for this account's Public posts at `/[username]/feed.rss`
@@ -149,6 +156,35 @@
 //		description: Hide the account's following/followers collections.
 //		type: boolean
 //	-
+//		name: directory_opt_in
+//		in: formData
+//		description: |-
+//			Opt in to publishing this account to the instance directory, if one is configured.
+//			The account must also be discoverable to be published.
+//		type: boolean
+//	-
+//		name: show_note_indicators
+//		in: formData
+//		description: |-
+//			Indicate on statuses (via the account_noted field) when you have
+//			a private note set on the status author.
+//		type: boolean
+//	-
+//		name: allow_embeds
+//		in: formData
+//		description: |-
+//			Allow your public posts to be embedded on other websites,
+//			via /embed/{statusID} and the /api/oembed endpoint.
+//		type: boolean
+//	-
+//		name: auto_delete_after_days
+//		in: formData
+//		description: |-
+//			Automatically delete your statuses once they are older than this many days.
+//			Statuses that you have pinned, bookmarked or favourited are never deleted.
+//			Set to 0 to disable. Maximum 3650 days.
+//		type: integer
+//	-
 //		name: web_visibility
 //		in: formData
 //		description: |-
@@ -166,6 +202,14 @@
 //			"gallery": gallery layout with media only.
 //		type: string
 //	-
+//		name: fields_attributes[0][id]
+//		in: formData
+//		description: |-
+//			ID of an existing profile field that the 1st profile field updates (optional).
+//			This keeps the field ID stable when changing its name or value.
+//			Fields submitted without an ID keep the ID of any existing field with the same name and value.
+//		type: string
+//	-
 //		name: fields_attributes[0][name]
 //		in: formData
 //		description: Name of 1st profile field to be added to this account's profile.
@@ -227,6 +271,16 @@
 //		in: formData
 //		description: Value of 6th profile field to be added to this account's profile.
 //		type: string
+//	-
+//		name: fields_order[]
+//		in: formData
+//		description: |-
+//			IDs of all of this account's existing profile fields, in the order they should be shown.
+//			Reorders fields without needing to resubmit their names and values.
+//			Cannot be combined with fields_attributes.
+//		type: array
+//		items:
+//			type: string
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -371,10 +425,16 @@ func parseUpdateAccountForm(c *gin.Context) (*apimodel.UpdateCredentialsRequest,
 			form.Source.Language == nil &&
 			form.Source.StatusContentType == nil &&
 			form.FieldsAttributes == nil &&
+			form.FieldsOrder == nil &&
 			form.Theme == nil &&
 			form.CustomCSS == nil &&
+			form.AccentColor == nil &&
 			form.EnableRSS == nil &&
 			form.HideCollections == nil &&
+			form.DirectoryOptIn == nil &&
+			form.ShowNoteIndicators == nil &&
+			form.AllowEmbeds == nil &&
+			form.AutoDeleteAfterDays == nil &&
 			form.WebVisibility == nil &&
 			form.WebLayout == nil) {
 		return nil, errors.New("empty form submitted")
@@ -398,6 +458,7 @@ func parseFieldsAttributesFromJSON(jsonFieldsAttributes *map[string]apimodel.Upd
 
 		fieldsAttributes = append(fieldsAttributes, apimodel.UpdateField{
 			Key:   key,
+			ID:    updateField.ID,
 			Name:  updateField.Name,
 			Value: updateField.Value,
 		})
@@ -70,7 +70,7 @@
 //				"$ref": "#/definitions/error"
 //			description: internal server error
 func (m *Module) AccountAvatarDELETEHandler(c *gin.Context) {
-	m.accountDeleteProfileAttachment(c, m.processor.Media().DeleteAvatar)
+	m.accountUpdateSelf(c, m.processor.Media().DeleteAvatar)
 }
 
 // AccountHeaderDELETEHandler swagger:operation DELETE /api/v1/profile/header accountHeaderDelete
@@ -115,12 +115,12 @@ func (m *Module) AccountAvatarDELETEHandler(c *gin.Context) {
 //				"$ref": "#/definitions/error"
 //			description: internal server error
 func (m *Module) AccountHeaderDELETEHandler(c *gin.Context) {
-	m.accountDeleteProfileAttachment(c, m.processor.Media().DeleteHeader)
+	m.accountUpdateSelf(c, m.processor.Media().DeleteHeader)
 }
 
-// accountDeleteProfileAttachment checks that an authenticated account is present and allowed to alter itself,
-// runs an attachment deletion processor method, and returns the updated account.
-func (m *Module) accountDeleteProfileAttachment(c *gin.Context, processDelete func(context.Context, *gtsmodel.Account) (*apimodel.Account, gtserror.WithCode)) {
+// accountUpdateSelf checks that an authenticated account is present and allowed to alter itself,
+// runs a processor method updating the account (eg., deleting an attachment), and returns the updated account.
+func (m *Module) accountUpdateSelf(c *gin.Context, processUpdate func(context.Context, *gtsmodel.Account) (*apimodel.Account, gtserror.WithCode)) {
 	authed, errWithCode := apiutil.TokenAuth(c,
 		true, true, true, true,
 		apiutil.ScopeWriteAccounts,
@@ -135,7 +135,7 @@ func (m *Module) accountDeleteProfileAttachment(c *gin.Context, processDelete fu
 		return
 	}
 
-	acctSensitive, errWithCode := processDelete(c, authed.Account)
+	acctSensitive, errWithCode := processUpdate(c, authed.Account)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
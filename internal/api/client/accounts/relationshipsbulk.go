@@ -0,0 +1,349 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package accounts
+
+import (
+	"errors"
+	"net/http"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"github.com/gin-gonic/gin"
+)
+
+// AccountsUnfollowPOSTHandler swagger:operation POST /api/v1/accounts/relationships/unfollow accountsUnfollow
+//
+// Unfollow many accounts at once.
+//
+// Any follows or follow requests from you to the given accounts will be removed.
+// If any of the given accounts cannot be found, no changes will be made.
+//
+//	---
+//	tags:
+//	- accounts
+//
+//	consumes:
+//	- application/json
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id[]
+//		type: array
+//		items:
+//			type: string
+//		description: IDs of the target accounts (maximum 40).
+//		in: formData
+//		collectionFormat: multi
+//		required: true
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:follows
+//
+//	responses:
+//		'200':
+//			name: account relationships
+//			description: Your relationship to each target account.
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/accountRelationship"
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'403':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: forbidden to moved accounts
+//		'404':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not found
+//		'406':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not acceptable
+//		'422':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unprocessable content
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) AccountsUnfollowPOSTHandler(c *gin.Context) {
+	authed, form, errWithCode := m.parseBulkRelationships(c, apiutil.ScopeWriteFollows)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	relationships, errWithCode := m.processor.Account().BulkUnfollow(
+		c.Request.Context(),
+		authed.Account,
+		form.IDs,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, relationships)
+}
+
+// AccountsBlockPOSTHandler swagger:operation POST /api/v1/accounts/relationships/block accountsBlock
+//
+// Block many accounts at once.
+//
+// As with blocking a single account, any follows between you and the
+// given accounts will be removed. If any of the given accounts cannot
+// be found, no changes will be made.
+//
+//	---
+//	tags:
+//	- accounts
+//
+//	consumes:
+//	- application/json
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id[]
+//		type: array
+//		items:
+//			type: string
+//		description: IDs of the target accounts (maximum 40).
+//		in: formData
+//		collectionFormat: multi
+//		required: true
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:blocks
+//
+//	responses:
+//		'200':
+//			name: account relationships
+//			description: Your relationship to each target account.
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/accountRelationship"
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'403':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: forbidden to moved accounts
+//		'404':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not found
+//		'406':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not acceptable
+//		'422':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unprocessable content
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) AccountsBlockPOSTHandler(c *gin.Context) {
+	authed, form, errWithCode := m.parseBulkRelationships(c, apiutil.ScopeWriteBlocks)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	relationships, errWithCode := m.processor.Account().BulkBlock(
+		c.Request.Context(),
+		authed.Account,
+		form.IDs,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, relationships)
+}
+
+// AccountsMutePOSTHandler swagger:operation POST /api/v1/accounts/relationships/mute accountsMute
+//
+// Mute many accounts at once.
+//
+// Existing mutes of any of the given accounts will be updated with the
+// given parameters. If any of the given accounts cannot be found, no
+// changes will be made.
+//
+//	---
+//	tags:
+//	- accounts
+//
+//	consumes:
+//	- application/json
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id[]
+//		type: array
+//		items:
+//			type: string
+//		description: IDs of the target accounts (maximum 40).
+//		in: formData
+//		collectionFormat: multi
+//		required: true
+//	-
+//		name: notifications
+//		type: boolean
+//		description: Mute notifications as well as posts.
+//		in: formData
+//		required: false
+//		default: false
+//	-
+//		name: duration
+//		type: number
+//		description: How long the mutes should last, in seconds. If 0 or not provided, mutes last indefinitely.
+//		in: formData
+//		required: false
+//		default: 0
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:mutes
+//
+//	responses:
+//		'200':
+//			name: account relationships
+//			description: Your relationship to each target account.
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/accountRelationship"
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'403':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: forbidden to moved accounts
+//		'404':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not found
+//		'406':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not acceptable
+//		'422':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unprocessable content
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) AccountsMutePOSTHandler(c *gin.Context) {
+	authed, form, errWithCode := m.parseBulkRelationships(c, apiutil.ScopeWriteMutes)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if err := normalizeCreateUpdateMute(&form.UserMuteCreateUpdateRequest); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnprocessableEntity(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	relationships, errWithCode := m.processor.Account().BulkMute(
+		c.Request.Context(),
+		authed.Account,
+		form.IDs,
+		&form.UserMuteCreateUpdateRequest,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, relationships)
+}
+
+// parseBulkRelationships performs the auth, move, and
+// content negotiation checks shared by the bulk relationship
+// handlers, and parses the bulk relationships form.
+func (m *Module) parseBulkRelationships(
+	c *gin.Context,
+	scope apiutil.Scope,
+) (*apiutil.Auth, *apimodel.RelationshipsBulkRequest, gtserror.WithCode) {
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		scope,
+	)
+	if errWithCode != nil {
+		return nil, nil, errWithCode
+	}
+
+	if authed.Account.IsMoving() {
+		const text = "your account has Moved or is currently Moving; you cannot take create or update type actions"
+		return nil, nil, gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		return nil, nil, gtserror.NewErrorNotAcceptable(err, err.Error())
+	}
+
+	form := &apimodel.RelationshipsBulkRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		return nil, nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	return authed, form, nil
+}
@@ -0,0 +1,110 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package accounts
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// AccountRSSTokenPOSTHandler swagger:operation POST /api/v1/accounts/rss_token accountRSSTokenCreate
+//
+// Generate a new secret token for the authenticated account's private RSS feed.
+//
+// The private feed also includes followers-only posts, and is available by
+// adding the token as the `token` query parameter to the account's feed URL,
+// eg., `https://example.org/@some_user/feed.rss?token=SOME_TOKEN`, regardless
+// of whether the account's public RSS feed is enabled.
+//
+// Any existing token is replaced, so previously shared private feed URLs stop working.
+//
+//	---
+//	tags:
+//	- accounts
+//
+//	produces:
+//	- application/json
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:accounts
+//
+//	responses:
+//		'200':
+//			description: The updated account, including profile source information with the new token.
+//			schema:
+//				"$ref": "#/definitions/account"
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'406':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not acceptable
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) AccountRSSTokenPOSTHandler(c *gin.Context) {
+	m.accountUpdateSelf(c, m.processor.Account().RSSFeedTokenCreate)
+}
+
+// AccountRSSTokenDELETEHandler swagger:operation DELETE /api/v1/accounts/rss_token accountRSSTokenDelete
+//
+// Revoke the secret token for the authenticated account's private RSS feed.
+// If the account doesn't have a token, the call succeeds anyway.
+//
+//	---
+//	tags:
+//	- accounts
+//
+//	produces:
+//	- application/json
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:accounts
+//
+//	responses:
+//		'200':
+//			description: The updated account, including profile source information.
+//			schema:
+//				"$ref": "#/definitions/account"
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'406':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not acceptable
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) AccountRSSTokenDELETEHandler(c *gin.Context) {
+	m.accountUpdateSelf(c, m.processor.Account().RSSFeedTokenDelete)
+}
@@ -84,6 +84,11 @@ func (m *Module) AccountThemesGETHandler(c *gin.Context) {
 	}
 
 	// Retrieve available themes.
-	themes := m.processor.Account().ThemesGet()
+	themes, errWithCode := m.processor.Account().ThemesGet(c.Request.Context())
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
 	apiutil.JSON(c, http.StatusOK, themes)
 }
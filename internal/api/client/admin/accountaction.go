@@ -52,7 +52,7 @@
 //	-
 //		name: type
 //		in: formData
-//		description: Type of action to be taken, currently only supports `suspend`.
+//		description: Type of action to be taken, currently only supports `suspend` and `rotate-keys`. The `rotate-keys` action only applies to local accounts (including the instance account); it generates a new RSA keypair for the account and federates the new public key.
 //		type: string
 //		required: true
 //	-
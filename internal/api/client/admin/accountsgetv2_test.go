@@ -106,11 +106,13 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
       "emojis": [],
       "fields": [
         {
+          "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QA",
           "name": "should you follow me?",
           "value": "maybe!",
           "verified_at": null
         },
         {
+          "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QB",
           "name": "age",
           "value": "120",
           "verified_at": null
@@ -135,7 +137,7 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
       "id": "admin",
       "name": "admin",
       "color": "",
-      "permissions": "546033",
+      "permissions": "613617",
       "highlighted": true
     },
     "confirmed": true,
@@ -278,11 +280,13 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
       "emojis": [],
       "fields": [
         {
+          "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QC",
           "name": "I'm going to post a lot of",
           "value": "media!",
           "verified_at": null
         },
         {
+          "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QD",
           "name": "and there's nothing",
           "value": "you can do about it",
           "verified_at": null
@@ -683,11 +687,13 @@ func (suite *AccountsGetTestSuite) TestAccountsMinID() {
       "emojis": [],
       "fields": [
         {
+          "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QC",
           "name": "I'm going to post a lot of",
           "value": "media!",
           "verified_at": null
         },
         {
+          "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QD",
           "name": "and there's nothing",
           "value": "you can do about it",
           "verified_at": null
@@ -21,6 +21,7 @@
 	"net/http"
 
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/processing"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
 	"codeberg.org/gruf/go-debug"
@@ -32,12 +33,18 @@
 	EmojiPath                                = BasePath + "/custom_emojis"
 	EmojiPathWithID                          = EmojiPath + "/:" + apiutil.IDKey
 	EmojiCategoriesPath                      = EmojiPath + "/categories"
+	EmojiPackPath                            = EmojiPath + "/pack"
 	DomainBlocksPath                         = BasePath + "/domain_blocks"
 	DomainBlocksPathWithID                   = DomainBlocksPath + "/:" + apiutil.IDKey
 	DomainAllowsPath                         = BasePath + "/domain_allows"
 	DomainAllowsPathWithID                   = DomainAllowsPath + "/:" + apiutil.IDKey
 	DomainLimitsPath                         = BasePath + "/domain_limits"
 	DomainLimitsPathWithID                   = DomainLimitsPath + "/:" + apiutil.IDKey
+	DomainLimitsTestPath                     = DomainLimitsPath + "/test"
+	DomainLimitSuggestionsPath               = BasePath + "/domain_limit_suggestions"
+	DomainLimitSuggestionsPathWithID         = DomainLimitSuggestionsPath + "/:" + apiutil.IDKey
+	DomainLimitSuggestionAcceptPath          = DomainLimitSuggestionsPathWithID + "/accept"
+	DomainLimitSuggestionRemovePath          = DomainLimitSuggestionsPathWithID + "/remove"
 	DomainPermissionDraftsPath               = BasePath + "/domain_permission_drafts"
 	DomainPermissionDraftsPathWithID         = DomainPermissionDraftsPath + "/:" + apiutil.IDKey
 	DomainPermissionDraftAcceptPath          = DomainPermissionDraftsPathWithID + "/accept"
@@ -50,10 +57,20 @@
 	DomainPermissionSubscriptionRemovePath   = DomainPermissionSubscriptionsPathWithID + "/remove"
 	DomainPermissionSubscriptionTestPath     = DomainPermissionSubscriptionsPathWithID + "/test"
 	DomainKeysExpirePath                     = BasePath + "/domain_keys_expire"
+	DomainImpactPath                         = BasePath + "/domain_impact"
+	DomainHierarchyPath                      = BasePath + "/domain_hierarchy"
 	HeaderAllowsPath                         = BasePath + "/header_allows"
 	HeaderAllowsPathWithID                   = HeaderAllowsPath + "/:" + apiutil.IDKey
 	HeaderBlocksPath                         = BasePath + "/header_blocks"
 	HeaderBlocksPathWithID                   = HeaderBlocksPath + "/:" + apiutil.IDKey
+	IPBlocksPath                             = BasePath + "/ip_blocks"
+	IPBlocksPathWithID                       = IPBlocksPath + "/:" + apiutil.IDKey
+	InvitesPath                              = BasePath + "/invites"
+	InstancesPath                            = BasePath + "/instances"
+	ThemesPath                               = BasePath + "/themes"
+	ThemesPathWithID                         = ThemesPath + "/:" + apiutil.IDKey
+	WebfingerAliasesPath                     = BasePath + "/webfinger_aliases"
+	WebfingerAliasesPathWithID               = WebfingerAliasesPath + "/:" + apiutil.IDKey
 	AccountsV1Path                           = BasePath + "/accounts"
 	AccountsV2Path                           = "/v2/admin/accounts"
 	AccountsPathWithID                       = AccountsV1Path + "/:" + apiutil.IDKey
@@ -66,18 +83,28 @@
 	ReportsPath                              = BasePath + "/reports"
 	ReportsPathWithID                        = ReportsPath + "/:" + apiutil.IDKey
 	ReportsResolvePath                       = ReportsPathWithID + "/resolve"
+	ReportsAssignToSelfPath                  = ReportsPathWithID + "/assign_to_self"
+	ReportsUnassignPath                      = ReportsPathWithID + "/unassign"
+	ReportsNotesPath                         = ReportsPathWithID + "/notes"
+	ReportsNotesPathWithID                   = ReportsNotesPath + "/:" + NoteIDKey
 	EmailPath                                = BasePath + "/email"
 	EmailTestPath                            = EmailPath + "/test"
 	InstanceRulesPath                        = BasePath + "/instance/rules"
 	InstanceRulesPathWithID                  = InstanceRulesPath + "/:" + apiutil.IDKey
+	InstanceLandingBlocksPath                = BasePath + "/instance/landing_blocks"
+	InstanceLandingBlocksPathWithID          = InstanceLandingBlocksPath + "/:" + apiutil.IDKey
+	ReadOnlyPath                             = BasePath + "/read_only"
 	DebugPath                                = BasePath + "/debug"
 	DebugAPUrlPath                           = DebugPath + "/apurl"
 	DebugClearCachesPath                     = DebugPath + "/caches/clear"
+	DebugRetentionPath                       = DebugPath + "/retention"
+	DebugRuntimePath                         = DebugPath + "/runtime"
 
 	FilterQueryKey        = "filter"
 	MaxShortcodeDomainKey = "max_shortcode_domain"
 	MinShortcodeDomainKey = "min_shortcode_domain"
 	DomainQueryKey        = "domain"
+	NoteIDKey             = "note_id"
 )
 
 type Module struct {
@@ -100,6 +127,8 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, EmojiPathWithID, m.EmojiGETHandler)
 	attachHandler(http.MethodPatch, EmojiPathWithID, m.EmojiPATCHHandler)
 	attachHandler(http.MethodGet, EmojiCategoriesPath, m.EmojiCategoriesGETHandler)
+	attachHandler(http.MethodPost, EmojiPackPath, m.EmojiPackImportPOSTHandler)
+	attachHandler(http.MethodGet, EmojiPackPath, m.EmojiPackExportGETHandler)
 
 	// domain block stuff
 	attachHandler(http.MethodPost, DomainBlocksPath, m.DomainBlocksPOSTHandler)
@@ -118,9 +147,16 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	// domain limits stuff
 	attachHandler(http.MethodGet, DomainLimitsPath, m.DomainLimitsGETHandler)
 	attachHandler(http.MethodPost, DomainLimitsPath, m.DomainLimitsPOSTHandler)
+	attachHandler(http.MethodPost, DomainLimitsTestPath, m.DomainLimitTestPOSTHandler)
 	attachHandler(http.MethodPut, DomainLimitsPathWithID, m.DomainLimitPUTHandler)
 	attachHandler(http.MethodDelete, DomainLimitsPathWithID, m.DomainLimitDELETEHandler)
 
+	// domain limit suggestion stuff
+	attachHandler(http.MethodGet, DomainLimitSuggestionsPath, m.DomainLimitSuggestionsGETHandler)
+	attachHandler(http.MethodGet, DomainLimitSuggestionsPathWithID, m.DomainLimitSuggestionGETHandler)
+	attachHandler(http.MethodPost, DomainLimitSuggestionAcceptPath, m.DomainLimitSuggestionAcceptPOSTHandler)
+	attachHandler(http.MethodPost, DomainLimitSuggestionRemovePath, m.DomainLimitSuggestionRemovePOSTHandler)
+
 	// domain permission draft stuff
 	attachHandler(http.MethodPost, DomainPermissionDraftsPath, m.DomainPermissionDraftsPOSTHandler)
 	attachHandler(http.MethodGet, DomainPermissionDraftsPath, m.DomainPermissionDraftsGETHandler)
@@ -153,8 +189,33 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodDelete, HeaderAllowsPathWithID, m.HeaderFilterAllowDELETE)
 	attachHandler(http.MethodDelete, HeaderBlocksPathWithID, m.HeaderFilterBlockDELETE)
 
+	// ip rules stuff
+	attachHandler(http.MethodGet, IPBlocksPath, m.IPBlocksGETHandler)
+	attachHandler(http.MethodPost, IPBlocksPath, m.IPBlocksPOSTHandler)
+	attachHandler(http.MethodGet, IPBlocksPathWithID, m.IPBlockGETHandler)
+	attachHandler(http.MethodPut, IPBlocksPathWithID, m.IPBlockPUTHandler)
+	attachHandler(http.MethodDelete, IPBlocksPathWithID, m.IPBlockDELETEHandler)
+
+	// webfinger aliases stuff
+	attachHandler(http.MethodGet, WebfingerAliasesPath, m.WebfingerAliasesGETHandler)
+	attachHandler(http.MethodPost, WebfingerAliasesPath, m.WebfingerAliasesPOSTHandler)
+	attachHandler(http.MethodDelete, WebfingerAliasesPathWithID, m.WebfingerAliasDELETEHandler)
+
+	// themes stuff
+	attachHandler(http.MethodGet, ThemesPath, m.ThemesGETHandler)
+	attachHandler(http.MethodPost, ThemesPath, m.ThemesPOSTHandler)
+	attachHandler(http.MethodDelete, ThemesPathWithID, m.ThemeDELETEHandler)
+
+	// invites stuff
+	attachHandler(http.MethodGet, InvitesPath, m.InvitesGETHandler)
+
+	// known instances stuff
+	attachHandler(http.MethodGet, InstancesPath, m.InstancesGETHandler)
+
 	// domain maintenance stuff
 	attachHandler(http.MethodPost, DomainKeysExpirePath, m.DomainKeysExpirePOSTHandler)
+	attachHandler(http.MethodGet, DomainImpactPath, m.DomainImpactGETHandler)
+	attachHandler(http.MethodGet, DomainHierarchyPath, m.DomainHierarchyGETHandler)
 
 	// accounts stuff
 	attachHandler(http.MethodGet, AccountsV1Path, m.AccountsGETV1Handler)
@@ -172,7 +233,13 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	// reports stuff
 	attachHandler(http.MethodGet, ReportsPath, m.ReportsGETHandler)
 	attachHandler(http.MethodGet, ReportsPathWithID, m.ReportGETHandler)
+	attachHandler(http.MethodPut, ReportsPathWithID, m.ReportPUTHandler)
 	attachHandler(http.MethodPost, ReportsResolvePath, m.ReportResolvePOSTHandler)
+	attachHandler(http.MethodPost, ReportsAssignToSelfPath, m.ReportAssignToSelfPOSTHandler)
+	attachHandler(http.MethodPost, ReportsUnassignPath, m.ReportUnassignPOSTHandler)
+	attachHandler(http.MethodGet, ReportsNotesPath, m.ReportNotesGETHandler)
+	attachHandler(http.MethodPost, ReportsNotesPath, m.ReportNotePOSTHandler)
+	attachHandler(http.MethodDelete, ReportsNotesPathWithID, m.ReportNoteDELETEHandler)
 
 	// email stuff
 	attachHandler(http.MethodPost, EmailTestPath, m.EmailTestPOSTHandler)
@@ -184,9 +251,25 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodPatch, InstanceRulesPathWithID, m.RulePATCHHandler)
 	attachHandler(http.MethodDelete, InstanceRulesPathWithID, m.RuleDELETEHandler)
 
+	// instance landing page stuff
+	attachHandler(http.MethodGet, InstanceLandingBlocksPath, m.LandingBlocksGETHandler)
+	attachHandler(http.MethodPost, InstanceLandingBlocksPath, m.LandingBlocksPOSTHandler)
+	attachHandler(http.MethodGet, InstanceLandingBlocksPathWithID, m.LandingBlockGETHandler)
+	attachHandler(http.MethodPatch, InstanceLandingBlocksPathWithID, m.LandingBlockPATCHHandler)
+	attachHandler(http.MethodDelete, InstanceLandingBlocksPathWithID, m.LandingBlockDELETEHandler)
+
+	// read-only mode stuff
+	attachHandler(http.MethodGet, ReadOnlyPath, m.ReadOnlyGETHandler)
+	attachHandler(http.MethodPost, ReadOnlyPath, m.ReadOnlyPOSTHandler)
+
 	// debug stuff
 	if debug.DEBUG {
 		attachHandler(http.MethodGet, DebugAPUrlPath, m.DebugAPUrlHandler)
 		attachHandler(http.MethodPost, DebugClearCachesPath, m.DebugClearCachesHandler)
+		attachHandler(http.MethodGet, DebugRetentionPath, m.DebugRetentionHandler)
+	}
+
+	if config.GetDebugEndpointsEnabled() {
+		attachHandler(http.MethodGet, DebugRuntimePath, m.DebugRuntimeHandler)
 	}
 }
@@ -125,3 +125,47 @@ func (m *Module) DebugAPUrlHandler(c *gin.Context) {}
 //				"$ref": "#/definitions/error"
 //			description: internal server error
 func (m *Module) DebugClearCachesHandler(c *gin.Context) {}
+
+// DebugRetentionHandler swagger:operation GET /api/v1/admin/debug/retention debugRetention
+//
+// View the current size of data subject to retention settings, ie., stored notifications and cached home timelines.
+//
+// Only enabled / exposed if GoToSocial was built and is running with flag DEBUG=1.
+//
+//	---
+//	tags:
+//	- debug
+//
+//	produces:
+//	- application/json
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:read
+//
+//	responses:
+//		'200':
+//			description: Retention debug information.
+//			schema:
+//				"$ref": "#/definitions/debugRetentionResponse"
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'404':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not found
+//		'406':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not acceptable
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) DebugRetentionHandler(c *gin.Context) {}
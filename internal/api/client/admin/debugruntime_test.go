@@ -0,0 +1,123 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/api/client/admin"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/oauth"
+	"github.com/stretchr/testify/suite"
+)
+
+type DebugRuntimeTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *DebugRuntimeTestSuite) debugRuntime(
+	account *gtsmodel.Account,
+	token *gtsmodel.Token,
+	user *gtsmodel.User,
+	expectedHTTPStatus int,
+	expectedBody string,
+) *apimodel.DebugRuntimeResponse {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, "api"+admin.DebugRuntimePath, "")
+	ctx.Request.Method = http.MethodGet
+	ctx.Set(oauth.SessionAuthorizedAccount, account)
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(token))
+	ctx.Set(oauth.SessionAuthorizedUser, user)
+
+	suite.adminModule.DebugRuntimeHandler(ctx)
+
+	b, err := io.ReadAll(recorder.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(expectedHTTPStatus, recorder.Code)
+	if expectedBody != "" {
+		suite.Equal(expectedBody, string(b))
+	}
+
+	if recorder.Code != http.StatusOK {
+		return nil
+	}
+
+	resp := new(apimodel.DebugRuntimeResponse)
+	if err := json.Unmarshal(b, resp); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return resp
+}
+
+func (suite *DebugRuntimeTestSuite) TestDebugRuntime() {
+	resp := suite.debugRuntime(
+		suite.testAccounts["admin_account"],
+		suite.testTokens["admin_account"],
+		suite.testUsers["admin_account"],
+		http.StatusOK, "",
+	)
+	suite.Equal(runtime.Version(), resp.GoVersion)
+	suite.Positive(resp.Goroutines)
+	suite.NotZero(resp.Memory.Sys)
+	suite.Contains(resp.Caches, "DB.Account")
+}
+
+func (suite *DebugRuntimeTestSuite) TestDebugRuntimeNotAdmin() {
+	// Give the token admin scope,
+	// so only the admin check fails.
+	token := new(gtsmodel.Token)
+	*token = *suite.testTokens["local_account_1"]
+	token.Scope = "read admin"
+
+	user := suite.testUsers["local_account_1"]
+	suite.debugRuntime(
+		suite.testAccounts["local_account_1"],
+		token,
+		user,
+		http.StatusForbidden,
+		`{"error":"Forbidden: user `+user.ID+` not an admin"}`,
+	)
+}
+
+func (suite *DebugRuntimeTestSuite) TestDebugRuntimeNoAdminScope() {
+	token := new(gtsmodel.Token)
+	*token = *suite.testTokens["admin_account"]
+	token.Scope = "read"
+
+	suite.debugRuntime(
+		suite.testAccounts["admin_account"],
+		token,
+		suite.testUsers["admin_account"],
+		http.StatusForbidden,
+		`{"error":"Forbidden: token has insufficient scope permission"}`,
+	)
+}
+
+func TestDebugRuntimeTestSuite(t *testing.T) {
+	suite.Run(t, new(DebugRuntimeTestSuite))
+}
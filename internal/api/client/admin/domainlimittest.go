@@ -0,0 +1,189 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+// DomainLimitTestPOSTHandler swagger:operation POST /api/v1/admin/domain_limits/test domainLimitTest
+//
+// Test what would happen if a domain limit were created, without creating it.
+//
+// The response reports whether the limit would take effect, which existing limit (if any)
+// already covers the domain, which existing subdomain limits would be superseded, which
+// known instances would be covered, and whether the domain is blocked. It also previews
+// how the limit's content warning would be applied to a status from the domain.
+//
+// Note that when limits exist for both a domain and one of its subdomains,
+// the limit on the parent (highest-level) domain is the one that applies.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	consumes:
+//	- multipart/form-data
+//	- application/json
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: domain
+//		in: formData
+//		description: Hostname of the domain to test.
+//		type: string
+//		required: true
+//	-
+//		name: media_policy
+//		in: formData
+//		description: Policy to apply to media files originating from the limited domain.
+//		type: string
+//		enum:
+//			- no_action
+//			- mark_sensitive
+//			- reject
+//		default: no_action
+//	-
+//		name: follows_policy
+//		in: formData
+//		description: Policy to apply to follow (requests) originating from the limited domain.
+//		type: string
+//		enum:
+//			- no_action
+//			- manual_approval
+//			- reject_non_mutual
+//			- reject_all
+//		default: no_action
+//	-
+//		name: statuses_policy
+//		in: formData
+//		description: Policy to apply to statuses of non-followed accounts on the limited domain.
+//		type: string
+//		enum:
+//			- no_action
+//			- filter_warn
+//			- filter_hide
+//		default: no_action
+//	-
+//		name: accounts_policy
+//		in: formData
+//		description: Policy to apply to non-followed accounts on the limited domain.
+//		type: string
+//		enum:
+//			- no_action
+//			- mute
+//		default: no_action
+//	-
+//		name: content_warning
+//		in: formData
+//		description: Content warning to prepend to posts from accounts on this instance.
+//		type: string
+//	-
+//		name: sample_content_warning
+//		in: formData
+//		description: >-
+//			Content warning of a sample post from the domain, to preview how the limit would rewrite it.
+//			Leave empty to preview a post without a content warning.
+//		type: string
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:read:domain_limits
+//
+//	responses:
+//		'200':
+//			description: The result of testing the domain limit.
+//			schema:
+//				"$ref": "#/definitions/domainLimitTest"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) DomainLimitTestPOSTHandler(c *gin.Context) {
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		apiutil.ScopeAdminReadDomainLimits,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if authed.Account.IsMoving() {
+		apiutil.ForbiddenAfterMove(c)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	form := new(apimodel.DomainLimitRequest)
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if form.Domain == "" {
+		const errText = "domain must be set"
+		errWithCode := gtserror.NewErrorBadRequest(errors.New(errText), errText)
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	test, errWithCode := m.processor.Admin().DomainLimitTest(
+		c.Request.Context(),
+		form.Domain,
+		util.PtrOrValue(form.MediaPolicy, apimodel.MediaPolicyNoAction),
+		util.PtrOrValue(form.FollowsPolicy, apimodel.FollowsPolicyNoAction),
+		util.PtrOrValue(form.StatusesPolicy, apimodel.StatusesPolicyNoAction),
+		util.PtrOrValue(form.AccountsPolicy, apimodel.AccountsPolicyNoAction),
+		util.PtrOrZero(form.ContentWarning),
+		util.PtrOrZero(form.SampleContentWarning),
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, test)
+}
@@ -72,6 +72,22 @@
 //		type: string
 //		maximumLength: 64
 //		required: false
+//	-
+//		name: license
+//		in: formData
+//		description: >-
+//			License under which the emoji is made available, eg., `CC-BY-SA-4.0`.
+//		type: string
+//		maximumLength: 255
+//		required: false
+//	-
+//		name: attribution
+//		in: formData
+//		description: >-
+//			Attribution / credit for the creator(s) of the emoji.
+//		type: string
+//		maximumLength: 500
+//		required: false
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -170,5 +186,13 @@ func validateCreateEmoji(form *apimodel.EmojiCreateRequest) error {
 		return err
 	}
 
-	return validate.EmojiCategory(form.CategoryName)
+	if err := validate.EmojiCategory(form.CategoryName); err != nil {
+		return err
+	}
+
+	if err := validate.EmojiLicense(form.License); err != nil {
+		return err
+	}
+
+	return validate.EmojiAttribution(form.Attribution)
 }
@@ -0,0 +1,133 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"github.com/gin-gonic/gin"
+)
+
+// EmojiPackExportGETHandler swagger:operation GET /api/v1/admin/custom_emojis/pack emojiPackExport
+//
+// Export enabled local custom emojis as a zipped emoji pack, in Pleroma's emoji pack format.
+//
+// The zip contains a `pack.json` manifest, with a `files` object mapping
+// emoji shortcodes to the paths of their image files within the zip.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	produces:
+//	- application/zip
+//
+//	parameters:
+//	-
+//		name: category
+//		in: query
+//		description: Export only emojis in the category with the given name.
+//		type: string
+//		required: false
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:read:custom_emojis
+//
+//	responses:
+//		'200':
+//			description: Zip file of the emoji pack.
+//			schema:
+//				type: file
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'403':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: forbidden
+//		'404':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: no emojis to export, or category not found
+//		'406':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not acceptable
+//		'422':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: too many emojis to export
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) EmojiPackExportGETHandler(c *gin.Context) {
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		apiutil.ScopeAdminReadCustomEmojis,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.AppZip); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	category := c.Query(apiutil.AdminCategoryKey)
+
+	writeTo, errWithCode := m.processor.Admin().EmojiPackExport(c.Request.Context(), category)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	filename := "emojis"
+	if category != "" {
+		filename = category
+	}
+
+	c.Header("Content-Type", apiutil.AppZip)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename+".zip"))
+	c.Status(http.StatusOK)
+
+	// Stream the pack straight to the client. By
+	// now the status is written, so we can't return
+	// an error response, only log and truncate.
+	if err := writeTo(c.Writer); err != nil {
+		log.Errorf(c.Request.Context(), "error writing emoji pack: %v", err)
+	}
+}
@@ -41,9 +41,9 @@
 //
 // `copy`: copy a REMOTE emoji to this instance. When doing this action, a shortcode MUST be provided, and it must
 // be unique among emojis already present on this instance. A category MAY be provided, and the copied emoji will then
-// be put into the provided category.
+// be put into the provided category. A license and attribution MAY also be provided for the copied emoji.
 //
-// `modify`: modify a LOCAL emoji. You can provide a new image for the emoji and/or update the category.
+// `modify`: modify a LOCAL emoji. You can provide a new image for the emoji and/or update the category, license, and attribution.
 //
 // Local emojis cannot be deleted using this endpoint. To delete a local emoji, check DELETE /api/v1/admin/custom_emojis/{id} instead.
 //
@@ -101,6 +101,22 @@
 //			If a category with the given name doesn't exist yet, it will be created.
 //		type: string
 //		maximumLength: 64
+//	-
+//		name: license
+//		in: formData
+//		description: >-
+//			License under which the emoji is made available, eg., `CC-BY-SA-4.0`.
+//			Provide an empty string to unset. Works for the `copy` and `modify` action types.
+//		type: string
+//		maximumLength: 255
+//	-
+//		name: attribution
+//		in: formData
+//		description: >-
+//			Attribution / credit for the creator(s) of the emoji.
+//			Provide an empty string to unset. Works for the `copy` and `modify` action types.
+//		type: string
+//		maximumLength: 500
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -212,13 +228,28 @@ func validateUpdateEmoji(form *apimodel.EmojiUpdateRequest) error {
 			}
 		}
 
+		// license info optional during copy
+		if form.License != nil {
+			if err := validate.EmojiLicense(*form.License); err != nil {
+				return err
+			}
+		}
+
+		if form.Attribution != nil {
+			if err := validate.EmojiAttribution(*form.Attribution); err != nil {
+				return err
+			}
+		}
+
 		form.Type = apimodel.EmojiUpdateCopy
 	case string(apimodel.EmojiUpdateModify):
-		// need either image or category name for modify
+		// need either image, category name, or license info for modify
 		hasImage := form.Image != nil && form.Image.Size != 0
 		hasCategoryName := form.CategoryName != nil
-		if !hasImage && !hasCategoryName {
-			return errors.New("emoji action type was 'modify' but no image or category name was provided")
+		hasLicense := form.License != nil
+		hasAttribution := form.Attribution != nil
+		if !hasImage && !hasCategoryName && !hasLicense && !hasAttribution {
+			return errors.New("emoji action type was 'modify' but no image, category name, license, or attribution was provided")
 		}
 
 		if hasImage {
@@ -234,6 +265,18 @@ func validateUpdateEmoji(form *apimodel.EmojiUpdateRequest) error {
 			}
 		}
 
+		if hasLicense {
+			if err := validate.EmojiLicense(*form.License); err != nil {
+				return err
+			}
+		}
+
+		if hasAttribution {
+			if err := validate.EmojiAttribution(*form.Attribution); err != nil {
+				return err
+			}
+		}
+
 		form.Type = apimodel.EmojiUpdateModify
 	default:
 		return errors.New("emoji action type must be one of 'disable', 'copy', 'modify'")
@@ -191,6 +191,57 @@ func (suite *EmojiUpdateTestSuite) TestEmojiUpdateSwitchCategory() {
 	suite.Equal(int64(dbEmoji.ImageStaticFileSize), entry.Size)
 }
 
+func (suite *EmojiUpdateTestSuite) TestEmojiUpdateLicense() {
+	testEmoji := &gtsmodel.Emoji{}
+	*testEmoji = *suite.testEmojis["rainbow"]
+
+	// set up the request
+	requestBody, w, err := testrig.CreateMultipartFormData(
+		nil,
+		map[string][]string{
+			"license":     {"CC-BY-SA-4.0"},
+			"attribution": {"rainbow by Example Artist"},
+			"type":        {"modify"},
+		})
+	if err != nil {
+		panic(err)
+	}
+	bodyBytes := requestBody.Bytes()
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, bodyBytes, admin.EmojiPathWithID, w.FormDataContentType())
+	ctx.AddParam(apiutil.IDKey, testEmoji.ID)
+
+	// call the handler
+	suite.adminModule.EmojiPATCHHandler(ctx)
+
+	// we should have OK because our request was valid
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	// check the response
+	b, err := io.ReadAll(result.Body)
+	suite.NoError(err)
+
+	// response should be an admin model emoji
+	adminEmoji := &apimodel.AdminEmoji{}
+	err = json.Unmarshal(b, adminEmoji)
+	suite.NoError(err)
+
+	// license fields should be set
+	suite.Equal("rainbow", adminEmoji.Shortcode)
+	suite.Equal("CC-BY-SA-4.0", adminEmoji.License)
+	suite.Equal("rainbow by Example Artist", adminEmoji.Attribution)
+
+	// and stored in the db, with the image unchanged
+	dbEmoji, err := suite.db.GetEmojiByID(suite.T().Context(), testEmoji.ID)
+	suite.NoError(err)
+	suite.Equal("CC-BY-SA-4.0", dbEmoji.License)
+	suite.Equal("rainbow by Example Artist", dbEmoji.Attribution)
+	suite.Equal(testEmoji.ImagePath, dbEmoji.ImagePath)
+}
+
 func (suite *EmojiUpdateTestSuite) TestEmojiUpdateCopyRemoteToLocal() {
 	testEmoji := &gtsmodel.Emoji{}
 	*testEmoji = *suite.testEmojis["yell"]
@@ -491,7 +542,7 @@ func (suite *EmojiUpdateTestSuite) TestEmojiUpdateModifyNoParams() {
 	b, err := io.ReadAll(result.Body)
 	suite.NoError(err)
 
-	suite.Equal(`{"error":"Bad Request: emoji action type was 'modify' but no image or category name was provided"}`, string(b))
+	suite.Equal(`{"error":"Bad Request: emoji action type was 'modify' but no image, category name, license, or attribution was provided"}`, string(b))
 }
 
 func (suite *EmojiUpdateTestSuite) TestEmojiUpdateCopyLocalToLocal() {
@@ -0,0 +1,105 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/api/client/admin"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/readonly"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReadOnlyTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *ReadOnlyTestSuite) readOnly(method string, body string) (*apimodel.AdminReadOnlyMode, int) {
+	var (
+		recorder = httptest.NewRecorder()
+		ctx      = suite.newContext(recorder, method, []byte(body), admin.ReadOnlyPath, "application/json")
+	)
+
+	ctx.Request.Method = method
+	if method == http.MethodGet {
+		suite.adminModule.ReadOnlyGETHandler(ctx)
+	} else {
+		suite.adminModule.ReadOnlyPOSTHandler(ctx)
+	}
+
+	if recorder.Code != http.StatusOK {
+		return nil, recorder.Code
+	}
+
+	mode := new(apimodel.AdminReadOnlyMode)
+	if err := json.NewDecoder(recorder.Body).Decode(mode); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return mode, recorder.Code
+}
+
+func (suite *ReadOnlyTestSuite) TestReadOnlyToggle() {
+	suite.state.ReadOnly = readonly.New(&suite.state.Workers)
+	defer func() { suite.state.ReadOnly = nil }()
+
+	mode, code := suite.readOnly(http.MethodGet, "")
+	suite.Equal(http.StatusOK, code)
+	suite.False(mode.Enabled)
+	suite.Empty(mode.Since)
+	suite.Equal(300, mode.RetryAfter)
+
+	mode, code = suite.readOnly(http.MethodPost, `{"enabled":true}`)
+	suite.Equal(http.StatusOK, code)
+	suite.True(mode.Enabled)
+	suite.NotEmpty(mode.Since)
+	suite.True(suite.state.ReadOnly.Enabled())
+	suite.Zero(suite.state.Workers.Client.Len())
+
+	mode, code = suite.readOnly(http.MethodGet, "")
+	suite.Equal(http.StatusOK, code)
+	suite.True(mode.Enabled)
+
+	mode, code = suite.readOnly(http.MethodPost, `{"enabled":false}`)
+	suite.Equal(http.StatusOK, code)
+	suite.False(mode.Enabled)
+	suite.Empty(mode.Since)
+	suite.False(suite.state.ReadOnly.Enabled())
+	suite.NotZero(suite.state.Workers.Client.Len())
+
+	// Stop the resumed workers again, as
+	// other tests expect them not running.
+	suite.state.Workers.Pause()
+}
+
+func (suite *ReadOnlyTestSuite) TestReadOnlyNoEnabled() {
+	suite.state.ReadOnly = readonly.New(&suite.state.Workers)
+	defer func() { suite.state.ReadOnly = nil }()
+
+	_, code := suite.readOnly(http.MethodPost, `{}`)
+	suite.Equal(http.StatusBadRequest, code)
+	suite.False(suite.state.ReadOnly.Enabled())
+}
+
+func TestReadOnlyTestSuite(t *testing.T) {
+	suite.Run(t, new(ReadOnlyTestSuite))
+}
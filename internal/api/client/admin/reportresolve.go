@@ -61,6 +61,31 @@
 //
 //			Sample: The reported account was suspended.
 //		type: string
+//	-
+//		name: forward
+//		in: formData
+//		description: >-
+//			Forward the report to the instance of the reported account.
+//			Only possible for reports about remote accounts that were not already forwarded.
+//		type: boolean
+//		default: false
+//	-
+//		name: forward_status_ids[]
+//		in: formData
+//		description: >-
+//			IDs of reported statuses to include in the forwarded report.
+//			If not set, all statuses attached to the report are included.
+//		type: array
+//		items:
+//			type: string
+//	-
+//		name: forward_comment
+//		in: formData
+//		description: >-
+//			Comment to include in the forwarded report, in place of the reporter's original comment,
+//			eg., with any identifying details redacted. Empty string sends no comment.
+//			If not set, the reporter's original comment is sent.
+//		type: string
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -130,7 +155,7 @@ func (m *Module) ReportResolvePOSTHandler(c *gin.Context) {
 		return
 	}
 
-	report, errWithCode := m.processor.Admin().ReportResolve(c.Request.Context(), authed.Account, reportID, form.ActionTakenComment)
+	report, errWithCode := m.processor.Admin().ReportResolve(c.Request.Context(), authed.Account, reportID, form)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
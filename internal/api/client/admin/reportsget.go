@@ -68,6 +68,18 @@
 //		description: Return only reports that target the given account id.
 //		in: query
 //	-
+//		name: category
+//		type: string
+//		description: >-
+//			Return only reports in the given category.
+//			One of: other, spam, legal, violation.
+//		in: query
+//	-
+//		name: assigned_account_id
+//		type: string
+//		description: Return only reports assigned to the given admin / moderator account id.
+//		in: query
+//	-
 //		name: max_id
 //		type: string
 //		description: >-
@@ -176,6 +188,8 @@ func (m *Module) ReportsGETHandler(c *gin.Context) {
 		resolved,
 		c.Query(apiutil.AccountIDKey),
 		c.Query(apiutil.TargetAccountIDKey),
+		c.Query(apiutil.AdminCategoryKey),
+		c.Query(apiutil.AdminAssignedIDKey),
 		page,
 	)
 	if errWithCode != nil {
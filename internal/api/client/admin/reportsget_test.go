@@ -242,11 +242,13 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
         "emojis": [],
         "fields": [
           {
+            "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QA",
             "name": "should you follow me?",
             "value": "maybe!",
             "verified_at": null
           },
           {
+            "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QB",
             "name": "age",
             "value": "120",
             "verified_at": null
@@ -257,64 +259,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
       },
       "created_by_application_id": "01F8MGY43H3N2C8EWPR2FPYEXG"
     },
-    "assigned_account": {
-      "id": "01F8MH17FWEB39HZJ76B6VXSKF",
-      "username": "admin",
-      "domain": null,
-      "created_at": "2022-05-17T13:10:59.000Z",
-      "email": "admin@example.org",
-      "ip": null,
-      "ips": [],
-      "locale": "en",
-      "invite_request": null,
-      "role": {
-        "id": "admin",
-        "name": "admin",
-        "color": "",
-        "permissions": "546033",
-        "highlighted": true
-      },
-      "confirmed": true,
-      "approved": true,
-      "disabled": false,
-      "silenced": false,
-      "suspended": false,
-      "account": {
-        "id": "01F8MH17FWEB39HZJ76B6VXSKF",
-        "username": "admin",
-        "acct": "admin",
-        "display_name": "",
-        "locked": false,
-        "discoverable": true,
-        "indexable": true,
-        "noindex": false,
-        "bot": false,
-        "created_at": "2022-05-17T13:10:59.000Z",
-        "note": "",
-        "url": "http://localhost:8080/@admin",
-        "avatar": "",
-        "avatar_static": "",
-        "header": "http://localhost:8080/assets/default_header.webp",
-        "header_static": "http://localhost:8080/assets/default_header.webp",
-        "header_description": "Flat gray background (default header).",
-        "followers_count": 1,
-        "following_count": 1,
-        "statuses_count": 4,
-        "last_status_at": "2021-10-20",
-        "emojis": [],
-        "fields": [],
-        "enable_rss": true,
-        "roles": [
-          {
-            "id": "admin",
-            "name": "admin",
-            "color": ""
-          }
-        ],
-        "group": false
-      },
-      "created_by_application_id": "01F8MGXQRHYF5QPMTMXP78QC2F"
-    },
+    "assigned_account": null,
     "action_taken_by_account": {
       "id": "01F8MH17FWEB39HZJ76B6VXSKF",
       "username": "admin",
@@ -329,7 +274,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
         "id": "admin",
         "name": "admin",
         "color": "",
-        "permissions": "546033",
+        "permissions": "613617",
         "highlighted": true
       },
       "confirmed": true,
@@ -433,11 +378,13 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
         "emojis": [],
         "fields": [
           {
+            "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QA",
             "name": "should you follow me?",
             "value": "maybe!",
             "verified_at": null
           },
           {
+            "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QB",
             "name": "age",
             "value": "120",
             "verified_at": null
@@ -582,6 +529,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
         "mentions": [],
         "tags": [],
         "emojis": [],
+        "emoji_reactions": [],
         "card": null,
         "poll": null,
         "interaction_policy": {
@@ -696,11 +644,13 @@ func (suite *ReportsGetTestSuite) TestReportsGetCreatedByAccount() {
         "emojis": [],
         "fields": [
           {
+            "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QA",
             "name": "should you follow me?",
             "value": "maybe!",
             "verified_at": null
           },
           {
+            "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QB",
             "name": "age",
             "value": "120",
             "verified_at": null
@@ -845,6 +795,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetCreatedByAccount() {
         "mentions": [],
         "tags": [],
         "emojis": [],
+        "emoji_reactions": [],
         "card": null,
         "poll": null,
         "interaction_policy": {
@@ -959,11 +910,13 @@ func (suite *ReportsGetTestSuite) TestReportsGetTargetAccount() {
         "emojis": [],
         "fields": [
           {
+            "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QA",
             "name": "should you follow me?",
             "value": "maybe!",
             "verified_at": null
           },
           {
+            "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QB",
             "name": "age",
             "value": "120",
             "verified_at": null
@@ -1108,6 +1061,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetTargetAccount() {
         "mentions": [],
         "tags": [],
         "emojis": [],
+        "emoji_reactions": [],
         "card": null,
         "poll": null,
         "interaction_policy": {
@@ -0,0 +1,149 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/api/client/admin"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/stretchr/testify/suite"
+)
+
+const testThemeCSS = `/*
+  theme-title: Hot Pink
+  theme-description: Very pink indeed
+*/
+
+:root {
+  --bg-accent: #ff69b4;
+}
+`
+
+type ThemesTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *ThemesTestSuite) createTheme(fileName string, fields map[string][]string) (*apimodel.Theme, int) {
+	requestBody, w, err := testrig.CreateMultipartFormData(
+		testrig.StringToDataF("theme", fileName, testThemeCSS),
+		fields,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, requestBody.Bytes(), admin.ThemesPath, w.FormDataContentType())
+	suite.adminModule.ThemesPOSTHandler(ctx)
+
+	if recorder.Code != http.StatusOK {
+		return nil, recorder.Code
+	}
+
+	theme := new(apimodel.Theme)
+	if err := json.NewDecoder(recorder.Body).Decode(theme); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return theme, recorder.Code
+}
+
+func (suite *ThemesTestSuite) TestThemeCreateAndDelete() {
+	ctx := suite.T().Context()
+
+	theme, code := suite.createTheme("hot-pink.css", nil)
+	suite.Equal(http.StatusOK, code)
+	suite.NotEmpty(theme.ID)
+	suite.Equal("hot-pink.css", theme.FileName)
+	suite.Equal("Hot Pink", theme.Title)
+	suite.Equal("Very pink indeed", theme.Description)
+
+	// Same file name again should conflict.
+	_, code = suite.createTheme("hot-pink.css", nil)
+	suite.Equal(http.StatusConflict, code)
+
+	// Theme should be selectable by accounts.
+	themes, errWithCode := suite.processor.Account().ThemesGet(ctx)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Contains(themes, *theme)
+
+	// And its CSS should be served.
+	css, errWithCode := suite.processor.Account().ThemeGetUploadedCSS(ctx, "hot-pink.css")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(testThemeCSS, string(css))
+
+	// Delete the theme.
+	recorder := httptest.NewRecorder()
+	ginCtx := suite.newContext(recorder, http.MethodDelete, nil, admin.ThemesPathWithID, "")
+	ginCtx.AddParam(apiutil.IDKey, theme.ID)
+	suite.adminModule.ThemeDELETEHandler(ginCtx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	// It should now be gone from storage.
+	_, errWithCode = suite.processor.Account().ThemeGetUploadedCSS(ctx, "hot-pink.css")
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *ThemesTestSuite) TestThemeCreateTitleOverride() {
+	theme, code := suite.createTheme("Pinker.CSS", map[string][]string{
+		"title": {"Pinker"},
+	})
+	suite.Equal(http.StatusOK, code)
+	suite.Equal("pinker.css", theme.FileName)
+	suite.Equal("Pinker", theme.Title)
+	suite.Equal("Very pink indeed", theme.Description)
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.ThemesPath, "")
+	suite.adminModule.ThemesGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	var themes []apimodel.Theme
+	if err := json.Unmarshal(b, &themes); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]apimodel.Theme{*theme}, themes)
+}
+
+func (suite *ThemesTestSuite) TestThemeCreateBadFileName() {
+	_, code := suite.createTheme("Bad Name.css", nil)
+	suite.Equal(http.StatusBadRequest, code)
+
+	_, code = suite.createTheme("not-css.txt", nil)
+	suite.Equal(http.StatusBadRequest, code)
+}
+
+func TestThemesTestSuite(t *testing.T) {
+	suite.Run(t, new(ThemesTestSuite))
+}
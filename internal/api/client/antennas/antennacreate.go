@@ -0,0 +1,171 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package antennas
+
+import (
+	"net/http"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"github.com/gin-gonic/gin"
+)
+
+// AntennaCreatePOSTHandler swagger:operation POST /api/v1/antennas antennaCreate
+//
+// Create a new antenna.
+//
+// Antennas match incoming public statuses against the given rules,
+// and collect those that match into a dynamic antenna timeline.
+// At least one of keywords, domains, or account patterns must be set.
+//
+//	---
+//	tags:
+//	- antennas
+//
+//	consumes:
+//	- application/json
+//	- application/xml
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: title
+//		type: string
+//		description: |-
+//			Title of this antenna.
+//			Sample: GoToSocial news
+//		in: formData
+//		required: true
+//	-
+//		name: keywords[]
+//		type: array
+//		items:
+//			type: string
+//		description: Statuses must contain any of these keywords (case-insensitive).
+//		in: formData
+//	-
+//		name: exclude_keywords[]
+//		type: array
+//		items:
+//			type: string
+//		description: Statuses must contain none of these keywords (case-insensitive).
+//		in: formData
+//	-
+//		name: domains[]
+//		type: array
+//		items:
+//			type: string
+//		description: Status authors must be on any of these domains, or subdomains thereof.
+//		in: formData
+//	-
+//		name: account_patterns[]
+//		type: array
+//		items:
+//			type: string
+//		description: |-
+//			Status authors must match any of these glob patterns, eg., `*@example.org` or `alice`.
+//			Patterns without a domain part match the username on any domain.
+//		in: formData
+//	-
+//		name: with_replies
+//		in: formData
+//		description: Include replies in the antenna timeline.
+//		type: boolean
+//		default: false
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:lists
+//
+//	responses:
+//		'200':
+//			description: "The newly created antenna."
+//			schema:
+//				"$ref": "#/definitions/antenna"
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'404':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not found
+//		'406':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not acceptable
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) AntennaCreatePOSTHandler(c *gin.Context) {
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		apiutil.ScopeWriteLists,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if authed.Account.IsMoving() {
+		apiutil.ForbiddenAfterMove(c)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	form := &apimodel.AntennaCreateRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if err := validateRules(
+		&form.Title,
+		form.Keywords,
+		form.ExcludeKeywords,
+		form.Domains,
+		form.AccountPatterns,
+	); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	apiAntenna, errWithCode := m.processor.Antenna().Create(
+		c.Request.Context(),
+		authed.Account,
+		form,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, apiAntenna)
+}
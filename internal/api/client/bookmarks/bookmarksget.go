@@ -35,6 +35,8 @@
 	MaxIDKey = "max_id"
 	// MinIDKey is for specifying the minimum ID of the bookmark to retrieve.
 	MinIDKey = "min_id"
+	// CollectionIDKey is for specifying the bookmark collection to retrieve bookmarks from.
+	CollectionIDKey = "collection_id"
 )
 
 // BookmarksGETHandler swagger:operation GET /api/v1/bookmarks bookmarksGet
@@ -73,6 +75,11 @@
 //			Return only bookmarked statuses *NEWER* than the given bookmark ID.
 //			The status with the corresponding bookmark ID will not be included in the response.
 //		in: query
+//	-
+//		name: collection_id
+//		type: string
+//		description: Return only statuses bookmarked in the bookmark collection with the given ID.
+//		in: query
 //
 //	responses:
 //		'200':
@@ -89,6 +96,10 @@
 //			schema:
 //				"$ref": "#/definitions/error"
 //			description: unauthorized
+//		'404':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not found
 //		'406':
 //			schema:
 //				"$ref": "#/definitions/error"
@@ -136,7 +147,9 @@ func (m *Module) BookmarksGETHandler(c *gin.Context) {
 		minID = minIDString
 	}
 
-	resp, errWithCode := m.processor.Account().BookmarksGet(c.Request.Context(), authed.Account, limit, maxID, minID)
+	collectionID := c.Query(CollectionIDKey)
+
+	resp, errWithCode := m.processor.Account().BookmarksGet(c.Request.Context(), authed.Account, collectionID, limit, maxID, minID)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
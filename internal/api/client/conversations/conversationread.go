@@ -20,6 +20,7 @@
 import (
 	"net/http"
 
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"github.com/gin-gonic/gin"
@@ -29,10 +30,22 @@
 //
 // Mark a conversation with the given ID as read.
 //
+// Optionally, `last_read_id` can be provided to mark the conversation as read only up
+// to and including the given status, which is then returned in the conversation's
+// `last_read_id`. This read marker will never be moved backwards by this endpoint.
+//
+// The updated conversation will be streamed to any other open `direct` streams
+// belonging to the requester, so that read state can be synced between clients.
+//
 //	---
 //	tags:
 //	- conversations
 //
+//	consumes:
+//	- application/json
+//	- application/x-www-form-urlencoded
+//	- multipart/form-data
+//
 //	produces:
 //	- application/json
 //
@@ -43,6 +56,11 @@
 //		type: string
 //		required: true
 //		description: ID of the conversation.
+//	-
+//		name: last_read_id
+//		in: formData
+//		type: string
+//		description: ID of the most recent status read in the conversation. Defaults to the last status.
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -99,7 +117,26 @@ func (m *Module) ConversationReadPOSTHandler(c *gin.Context) {
 		return
 	}
 
-	apiConversation, errWithCode := m.processor.Conversations().Read(c.Request.Context(), authed.Account, id)
+	form := &apimodel.ConversationReadRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if form.LastReadID != "" {
+		form.LastReadID, errWithCode = apiutil.ParseID(form.LastReadID)
+		if errWithCode != nil {
+			apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+			return
+		}
+	}
+
+	apiConversation, errWithCode := m.processor.Conversations().Read(
+		c.Request.Context(),
+		authed.Account,
+		id,
+		form.LastReadID,
+	)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
@@ -32,6 +32,8 @@
 	BasePathWithID = BasePath + "/:" + apiutil.IDKey
 	// ReadPathWithID is the path for marking an existing conversation as read.
 	ReadPathWithID = BasePathWithID + "/read"
+	// UnreadPathWithID is the path for marking an existing conversation as unread.
+	UnreadPathWithID = BasePathWithID + "/unread"
 )
 
 type Module struct {
@@ -48,4 +50,5 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, BasePath, m.ConversationsGETHandler)
 	attachHandler(http.MethodDelete, BasePathWithID, m.ConversationDELETEHandler)
 	attachHandler(http.MethodPost, ReadPathWithID, m.ConversationReadPOSTHandler)
+	attachHandler(http.MethodPost, UnreadPathWithID, m.ConversationUnreadPOSTHandler)
 }
@@ -27,6 +27,9 @@
 const (
 	// BasePath is the base path for serving custom emojis, minus the 'api' prefix
 	BasePath = "/v1/custom_emojis"
+
+	// CategoryKey is for filtering emojis by category name.
+	CategoryKey = "category"
 )
 
 type Module struct {
@@ -23,6 +23,7 @@
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
 	"github.com/gin-gonic/gin"
 )
 
@@ -32,6 +33,15 @@
 //
 // If the instance config setting `instance-expose-custom-emojis` is `true` then authentication is not required.
 //
+// Emojis are arranged alphabetically by shortcode. By default all emojis are returned at once,
+// but if any of `limit`, `max_shortcode`, or `min_shortcode` are set, the results will be paged,
+// and the next and previous queries can be parsed from the returned Link header. Example:
+//
+// `<http://localhost:8080/api/v1/custom_emojis?limit=100&max_shortcode=blobcat_uwu>; rel="next", <http://localhost:8080/api/v1/custom_emojis?limit=100&min_shortcode=ablobcat>; rel="prev"`
+//
+// The response includes an ETag header which changes whenever the instance's emojis change.
+// Callers can provide this in an If-None-Match header to receive a 304 if nothing has changed.
+//
 //	---
 //	tags:
 //	- custom_emojis
@@ -39,17 +49,63 @@
 //	produces:
 //	- application/json
 //
+//	parameters:
+//	-
+//		name: category
+//		type: string
+//		description: Show only emojis in the category with the given name (case-insensitive).
+//		in: query
+//	-
+//		name: limit
+//		type: integer
+//		description: Number of emojis to return. Less than 1, or not set, means unlimited (all emojis).
+//		minimum: 0
+//		maximum: 500
+//		in: query
+//	-
+//		name: max_shortcode
+//		type: string
+//		description: >-
+//			Return only emojis with shortcode *HIGHER* (alphabetically) than the given shortcode.
+//			Emoji with the given shortcode will not be included in the result set.
+//		in: query
+//	-
+//		name: min_shortcode
+//		type: string
+//		description: >-
+//			Return only emojis with shortcode *LOWER* (alphabetically) than the given shortcode.
+//			Emoji with the given shortcode will not be included in the result set.
+//		in: query
+//	-
+//		name: If-None-Match
+//		type: string
+//		description: ETag from a previous response. If the emojis are unchanged, a 304 will be returned.
+//		in: header
+//
 //	security:
 //	- OAuth2 Bearer:
 //		- read:custom_emojis
 //
 //	responses:
 //		'200':
-//			description: Array of custom emojis.
+//			headers:
+//				ETag:
+//					type: string
+//					description: Strong ETag of the instance's current custom emojis.
+//				Link:
+//					type: string
+//					description: Links to the next and previous queries, when paging.
+//			description: Array of custom emojis, arranged alphabetically by shortcode.
 //			schema:
 //				type: array
 //				items:
 //					"$ref": "#/definitions/emoji"
+//		'304':
+//			description: Not modified, emojis match the ETag given in If-None-Match.
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
 //		'401':
 //			schema:
 //				"$ref": "#/definitions/error"
@@ -80,11 +136,44 @@ func (m *Module) CustomEmojisGETHandler(c *gin.Context) {
 		return
 	}
 
-	emojis, errWithCode := m.processor.Media().GetCustomEmojis(c)
+	page, errWithCode := paging.ParseShortcodePage(c,
+		1,   // min limit
+		500, // max limit
+		0,   // default = no paging
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	eTag, errWithCode := m.processor.Media().GetCustomEmojisETag(c.Request.Context())
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
-	apiutil.JSON(c, http.StatusOK, emojis)
+	c.Header("ETag", eTag)
+
+	if c.Request.Header.Get("If-None-Match") == eTag {
+		// Emojis unchanged since caller last
+		// fetched them, no need to send again.
+		c.AbortWithStatus(http.StatusNotModified)
+		return
+	}
+
+	resp, errWithCode := m.processor.Media().GetCustomEmojisPage(
+		c.Request.Context(),
+		c.Query(CategoryKey),
+		page,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if resp.LinkHeader != "" {
+		c.Header("Link", resp.LinkHeader)
+	}
+
+	apiutil.JSON(c, http.StatusOK, resp.Items)
 }
@@ -38,6 +38,8 @@
 	RejectPath = BasePathWithID + "/reject"
 	// OutgoingPath is used for fetching the list of accounts you requested to follow.
 	OutgoingPath = BasePath + "/outgoing"
+	// RulesPath is used for getting and updating follow request rules.
+	RulesPath = BasePath + "/rules"
 )
 
 type Module struct {
@@ -53,6 +55,8 @@ func New(processor *processing.Processor) *Module {
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	attachHandler(http.MethodGet, BasePath, m.FollowRequestGETHandler)
 	attachHandler(http.MethodGet, OutgoingPath, m.OutgoingFollowRequestGETHandler)
+	attachHandler(http.MethodGet, RulesPath, m.FollowRequestRulesGETHandler)
+	attachHandler(http.MethodPatch, RulesPath, m.FollowRequestRulesPATCHHandler)
 	attachHandler(http.MethodPost, AuthorizePath, m.FollowRequestAuthorizePOSTHandler)
 	attachHandler(http.MethodPost, RejectPath, m.FollowRequestRejectPOSTHandler)
 }
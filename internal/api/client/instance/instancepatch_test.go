@@ -96,7 +96,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch1() {
   ],
   "registrations": true,
   "approval_required": true,
-  "invites_enabled": false,
+  "invites_enabled": true,
   "configuration": {
     "statuses": {
       "max_characters": 5000,
@@ -242,7 +242,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch2() {
   ],
   "registrations": true,
   "approval_required": true,
-  "invites_enabled": false,
+  "invites_enabled": true,
   "configuration": {
     "statuses": {
       "max_characters": 5000,
@@ -388,7 +388,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch3() {
   ],
   "registrations": true,
   "approval_required": true,
-  "invites_enabled": false,
+  "invites_enabled": true,
   "configuration": {
     "statuses": {
       "max_characters": 5000,
@@ -585,7 +585,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch6() {
   ],
   "registrations": true,
   "approval_required": true,
-  "invites_enabled": false,
+  "invites_enabled": true,
   "configuration": {
     "statuses": {
       "max_characters": 5000,
@@ -753,7 +753,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch8() {
   ],
   "registrations": true,
   "approval_required": true,
-  "invites_enabled": false,
+  "invites_enabled": true,
   "configuration": {
     "statuses": {
       "max_characters": 5000,
@@ -940,7 +940,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch9() {
   ],
   "registrations": true,
   "approval_required": true,
-  "invites_enabled": false,
+  "invites_enabled": true,
   "configuration": {
     "statuses": {
       "max_characters": 5000,
@@ -64,6 +64,8 @@
 //			If filter is `open,allowed`, then allowed domains and known domains not on the blocklist will be shown.
 //
 //			If filter is an empty string or not set, then `open` will be assumed as the default.
+//
+//			If this instance has disabled exposing known peers, then including `open` will result in a 403.
 //		in: query
 //		required: false
 //		default: flat
@@ -89,6 +91,9 @@
 //				If a filter parameter is provided and flat is not true, then an array
 //				of objects with at least a `domain` key set on each object will be returned.
 //
+//				If this instance is configured to expose peer metadata, then open domains
+//				may also have keys `software` and `version` set, as reported by nodeinfo.
+//
 //				Domains that are silenced or suspended will also have a key
 //				`suspended_at` or `silenced_at` that contains an iso8601 date string.
 //				If one of these keys is not present on the domain object, it is open.
@@ -188,6 +193,13 @@ func (m *Module) InstancePeersGETHandler(c *gin.Context) {
 		return
 	}
 
+	if includeOpen && config.GetInstancePeersGranularity() == config.InstancePeersGranularityDisabled {
+		const errText = "peers open query is disabled on this instance"
+		errWithCode := gtserror.NewErrorForbidden(errors.New(errText), errText)
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
 	if includeOpen && isUnauthenticated && !config.GetInstanceExposePeers() {
 		const errText = "peers open query requires an authenticated account/user"
 		errWithCode := gtserror.NewErrorUnauthorized(errors.New(errText), errText)
@@ -20,6 +20,7 @@
 import (
 	"net/http"
 
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
 	"code.superseriousbusiness.org/gotosocial/internal/processing"
 	"github.com/gin-gonic/gin"
 )
@@ -30,6 +31,7 @@
 	BasePath       = "/v1/lists"
 	BasePathWithID = BasePath + "/:" + IDKey
 	AccountsPath   = BasePathWithID + "/accounts"
+	AccountPath    = AccountsPath + "/:" + apiutil.AccountIDKey
 	MaxIDKey       = "max_id"
 	LimitKey       = "limit"
 	SinceIDKey     = "since_id"
@@ -58,4 +60,8 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, AccountsPath, m.ListAccountsGETHandler)
 	attachHandler(http.MethodPost, AccountsPath, m.ListAccountsPOSTHandler)
 	attachHandler(http.MethodDelete, AccountsPath, m.ListAccountsDELETEHandler)
+
+	// get / update list account entry overrides
+	attachHandler(http.MethodGet, AccountPath, m.ListAccountGETHandler)
+	attachHandler(http.MethodPut, AccountPath, m.ListAccountPUTHandler)
 }
@@ -20,10 +20,13 @@
 import (
 	"errors"
 	"net/http"
+	"strings"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/validate"
 	"github.com/gin-gonic/gin"
 )
 
@@ -62,6 +65,26 @@
 //		in: formData
 //		collectionFormat: multi
 //		required: true
+//	-
+//		name: show_reblogs
+//		type: boolean
+//		description: Show boosts by the added accounts in the list timeline.
+//		default: true
+//		in: formData
+//	-
+//		name: replies_policy
+//		type: string
+//		description: |-
+//		  RepliesPolicy override for the added accounts.
+//		  If not set, the list's own replies policy applies.
+//		  followed = Show replies to any followed user
+//		  list = Show replies to members of the list
+//		  none = Show replies to no one
+//		enum:
+//			- followed
+//			- list
+//			- none
+//		in: formData
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -129,7 +152,24 @@ func (m *Module) ListAccountsPOSTHandler(c *gin.Context) {
 		return
 	}
 
-	if errWithCode := m.processor.List().AddToList(c.Request.Context(), authed.Account, targetListID, form.AccountIDs); errWithCode != nil {
+	var repliesPolicy gtsmodel.RepliesPolicy
+	if form.RepliesPolicy != nil {
+		repliesPolicy = gtsmodel.RepliesPolicy(strings.ToLower(*form.RepliesPolicy))
+
+		if err := validate.ListRepliesPolicy(repliesPolicy); err != nil {
+			apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+			return
+		}
+	}
+
+	if errWithCode := m.processor.List().AddToList(
+		c.Request.Context(),
+		authed.Account,
+		targetListID,
+		form.AccountIDs,
+		form.ShowReblogs,
+		repliesPolicy,
+	); errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
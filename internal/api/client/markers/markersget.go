@@ -44,10 +44,9 @@
 //		type: array
 //		items:
 //			type: string
-//			enum:
-//				- home
-//				- notifications
-//		description: Timelines to retrieve.
+//		description: >-
+//			Timelines to retrieve. One or more of `home`, `notifications`,
+//			`public`, `local`, `list:<list_id>`, or `tag:<tag_id>`.
 //		in: query
 //
 //	security:
@@ -84,6 +83,7 @@ func (m *Module) MarkersGETHandler(c *gin.Context) {
 	names, errWithCode := parseMarkerNames(c.QueryArray("timeline[]"))
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
 	}
 
 	marker, errWithCode := m.processor.Markers().Get(c.Request.Context(), authed.Account, names)
@@ -97,7 +97,7 @@ func (m *Module) MarkersGETHandler(c *gin.Context) {
 
 // parseMarkerNames turns a list of strings into a set of valid marker timeline names, or returns an error.
 func parseMarkerNames(nameStrings []string) ([]apimodel.MarkerName, gtserror.WithCode) {
-	nameSet := make(map[apimodel.MarkerName]struct{}, apimodel.MarkerNameNumValues)
+	nameSet := make(map[apimodel.MarkerName]struct{}, len(nameStrings))
 	for _, timelineString := range nameStrings {
 		if err := validate.MarkerName(timelineString); err != nil {
 			return nil, gtserror.NewErrorBadRequest(err, err.Error())
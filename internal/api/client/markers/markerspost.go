@@ -18,24 +18,40 @@
 package markers
 
 import (
+	"errors"
 	"net/http"
+	"slices"
+	"strings"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
+	"code.superseriousbusiness.org/gotosocial/internal/validate"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 )
 
+// maxFormMemory is the maximum memory used
+// for parsing multipart marker form data.
+const maxFormMemory = 1 << 20
+
 // MarkersPOSTHandler swagger:operation POST /api/v1/markers markersPost
 //
-// Update timeline markers by name
+// Update timeline markers by name.
+//
+// As well as the `home` and `notifications` timelines shown below, markers can also be set
+// for the `public`, `local`, `list:<list_id>`, and `tag:<tag_id>` timelines, using the same
+// `<timeline>[last_read_id]` form, e.g. `list:01F8MH82FYRXD2RC6108DAJ5HB[last_read_id]`.
 //
 //	---
 //	tags:
 //	- markers
 //
 //	consumes:
+//	- application/json
+//	- application/x-www-form-urlencoded
 //	- multipart/form-data
 //
 //	produces:
@@ -70,6 +86,10 @@
 //			schema:
 //				"$ref": "#/definitions/error"
 //			description: unauthorized
+//		'404':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: list or tag not found
 //		'409':
 //			schema:
 //				"$ref": "#/definitions/error"
@@ -88,28 +108,35 @@ func (m *Module) MarkersPOSTHandler(c *gin.Context) {
 		return
 	}
 
-	form := &apimodel.MarkerPostRequest{}
-	if err := c.ShouldBind(form); err != nil {
-		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+	form, errWithCode := parseMarkerPostRequest(c)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
-	markers := make([]*gtsmodel.Marker, 0, apimodel.MarkerNameNumValues)
-	if homeLastReadID := form.HomeLastReadID(); homeLastReadID != "" {
-		markers = append(markers, &gtsmodel.Marker{
-			AccountID:  authed.Account.ID,
-			Name:       gtsmodel.MarkerNameHome,
-			LastReadID: homeLastReadID,
-		})
-	}
-	if notificationsLastReadID := form.NotificationsLastReadID(); notificationsLastReadID != "" {
+	markers := make([]*gtsmodel.Marker, 0, len(form))
+	for name, req := range form {
+		if req == nil || req.LastReadID == "" {
+			continue
+		}
+
+		if err := validate.MarkerName(string(name)); err != nil {
+			apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+			return
+		}
+
 		markers = append(markers, &gtsmodel.Marker{
 			AccountID:  authed.Account.ID,
-			Name:       gtsmodel.MarkerNameNotifications,
-			LastReadID: notificationsLastReadID,
+			Name:       typeutils.APIMarkerNameToMarkerName(name),
+			LastReadID: req.LastReadID,
 		})
 	}
 
+	// Update in a determinate order.
+	slices.SortFunc(markers, func(a, b *gtsmodel.Marker) int {
+		return strings.Compare(string(a.Name), string(b.Name))
+	})
+
 	marker, errWithCode := m.processor.Markers().Update(c.Request.Context(), markers)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
@@ -118,3 +145,35 @@ func (m *Module) MarkersPOSTHandler(c *gin.Context) {
 
 	apiutil.JSON(c, http.StatusOK, marker)
 }
+
+// parseMarkerPostRequest parses a marker post request from either a JSON
+// body, or from form data with keys of the form `<timeline>[last_read_id]`.
+func parseMarkerPostRequest(c *gin.Context) (apimodel.MarkerPostRequest, gtserror.WithCode) {
+	form := make(apimodel.MarkerPostRequest)
+
+	if c.ContentType() == binding.MIMEJSON {
+		if err := c.ShouldBindJSON(&form); err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		return form, nil
+	}
+
+	// Parse form data, which may or may not be multipart.
+	if err := c.Request.ParseMultipartForm(maxFormMemory); err != nil &&
+		!errors.Is(err, http.ErrNotMultipart) {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	for key, values := range c.Request.PostForm {
+		name, ok := strings.CutSuffix(key, "[last_read_id]")
+		if !ok || len(values) == 0 {
+			continue
+		}
+
+		form[apimodel.MarkerName(name)] = &apimodel.MarkerPostRequestMarker{
+			LastReadID: values[0],
+		}
+	}
+
+	return form, nil
+}
@@ -0,0 +1,196 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package notifications
+
+import (
+	"net/http"
+
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"github.com/gin-gonic/gin"
+)
+
+// NotificationGroupsGETHandler swagger:operation GET /api/v2/notifications notificationGroups
+//
+// Get grouped notifications for currently authorized user.
+//
+// Favourites and boosts of the same status, and follows, received within a
+// short window of time are returned together in one notification group.
+// Other notifications are returned in a notification group of their own.
+//
+// The notification groups will be returned in descending chronological order
+// of their most recent notification (newest first). The limit applies to the
+// number of notification groups returned, and each group is returned only in
+// the page containing its most recent notification.
+//
+// The next and previous queries can be parsed from the returned Link header.
+// Paging values are notification IDs. Example:
+//
+// ```
+// <https://example.org/api/v2/notifications?limit=40&max_id=01FC0SKA48HNSVR6YKZCQGS2V8>; rel="next", <https://example.org/api/v2/notifications?limit=40&min_id=01FC0SKW5JK2Q4EVAV2B462YY0>; rel="prev"
+// ````
+//
+//	---
+//	tags:
+//	- notifications
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: max_id
+//		type: string
+//		description: >-
+//			Return only notifications *OLDER* than the given max notification ID.
+//			The notification with the specified ID will not be included in the response.
+//		in: query
+//		required: false
+//	-
+//		name: since_id
+//		type: string
+//		description: >-
+//			Return only notifications *newer* than the given since notification ID.
+//			The notification with the specified ID will not be included in the response.
+//		in: query
+//	-
+//		name: min_id
+//		type: string
+//		description: >-
+//			Return only notifications *immediately newer* than the given since notification ID.
+//			The notification with the specified ID will not be included in the response.
+//		in: query
+//		required: false
+//	-
+//		name: limit
+//		type: integer
+//		description: Number of notification groups to return.
+//		default: 40
+//		in: query
+//		required: false
+//	-
+//		name: types[]
+//		type: array
+//		items:
+//			type: string
+//		description: Types of notifications to include. If not provided, all notification types will be included.
+//		in: query
+//		required: false
+//	-
+//		name: exclude_types[]
+//		type: array
+//		items:
+//			type: string
+//		description: Types of notifications to exclude.
+//		in: query
+//		required: false
+//	-
+//		name: grouped_types[]
+//		type: array
+//		items:
+//			type: string
+//			enum:
+//				- favourite
+//				- follow
+//				- reblog
+//		description: >-
+//			Types of notifications to return grouped. If not provided, all of
+//			favourite, follow and reblog notifications will be grouped. Notifications
+//			of other types are returned in notification groups of their own.
+//		in: query
+//		required: false
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- read:notifications
+//
+//	responses:
+//		'200':
+//			headers:
+//				Link:
+//					type: string
+//					description: Links to the next and previous queries.
+//			description: Grouped notifications.
+//			schema:
+//				"$ref": "#/definitions/groupedNotificationsResults"
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'404':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not found
+//		'406':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not acceptable
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) NotificationGroupsGETHandler(c *gin.Context) {
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		apiutil.ScopeReadNotifications,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	page, errWithCode := paging.ParseIDPage(c,
+		1,  // min limit
+		80, // max limit
+		40, // default limit
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	ctx := c.Request.Context()
+	resp, errWithCode := m.processor.Timeline().NotificationGroupsGet(
+		ctx,
+		authed.Account,
+		page,
+		parseNotificationTypes(ctx, c.QueryArray(TypesKey)),        // Include types.
+		parseNotificationTypes(ctx, c.QueryArray(ExcludeTypesKey)), // Exclude types.
+		parseNotificationTypes(ctx, c.QueryArray(GroupedTypesKey)), // Grouped types.
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if resp.LinkHeader != "" {
+		c.Header("Link", resp.LinkHeader)
+	}
+
+	apiutil.JSON(c, http.StatusOK, resp.Results)
+}
@@ -0,0 +1,266 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package notifications_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/api/client/notifications"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/oauth"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+)
+
+func (suite *NotificationsTestSuite) getNotificationGroups(
+	account *gtsmodel.Account,
+	token *gtsmodel.Token,
+	user *gtsmodel.User,
+	maxID string,
+	limit int,
+	groupedTypes []string,
+	expectedHTTPStatus int,
+) (*apimodel.GroupedNotificationsResults, string, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, account)
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(token))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, user)
+
+	// create the request
+	ctx.Request = httptest.NewRequest(http.MethodGet, config.GetProtocol()+"://"+config.GetHost()+"/api"+notifications.GroupedBasePath, nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	query := url.Values{}
+	if maxID != "" {
+		query.Set(notifications.MaxIDKey, maxID)
+	}
+	if limit != 0 {
+		query.Set(notifications.LimitKey, strconv.Itoa(limit))
+	}
+	if len(groupedTypes) > 0 {
+		query[notifications.GroupedTypesKey] = groupedTypes
+	}
+	ctx.Request.URL.RawQuery = query.Encode()
+
+	// trigger the handler
+	suite.notificationsModule.NotificationGroupsGETHandler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// check code
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		return nil, "", gtserror.Newf("expected %d got %d: %s", expectedHTTPStatus, resultCode, string(b))
+	}
+
+	resp := new(apimodel.GroupedNotificationsResults)
+	if err := json.Unmarshal(b, resp); err != nil {
+		return nil, "", err
+	}
+
+	return resp, result.Header.Get("Link"), nil
+}
+
+// Add two grouped favourites of the same status, newer than
+// the fixture's (ungrouped) favourite, returning their group key.
+func (suite *NotificationsTestSuite) addGroupedNotifications(testAccount *gtsmodel.Account) string {
+	const groupKey = "favourite-01F8MHAMCHF6Y650WCRSCP4WMY-1"
+
+	now := time.Now()
+
+	for i, origin := range []string{
+		"local_account_2",
+		"admin_account",
+	} {
+		// Space IDs apart so the
+		// order of faves is fixed.
+		createdAt := now.Add(time.Duration(i) * time.Second)
+		if err := suite.db.Put(suite.T().Context(), &gtsmodel.Notification{
+			ID:               id.NewULIDFromTime(createdAt),
+			NotificationType: gtsmodel.NotificationFavourite,
+			TargetAccountID:  testAccount.ID,
+			OriginAccountID:  suite.testAccounts[origin].ID,
+			StatusOrEditID:   "01F8MHAMCHF6Y650WCRSCP4WMY",
+			GroupKey:         groupKey,
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	return groupKey
+}
+
+func (suite *NotificationsTestSuite) TestGetNotificationGroups() {
+	testAccount := suite.testAccounts["local_account_1"]
+	groupKey := suite.addGroupedNotifications(testAccount)
+
+	results, linkHeader, err := suite.getNotificationGroups(
+		testAccount,
+		suite.testTokens["local_account_1"],
+		suite.testUsers["local_account_1"],
+		"", 10, nil,
+		http.StatusOK,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Should have the group of two
+	// faves first, then the fixture fave.
+	if !suite.Len(results.NotificationGroups, 2) {
+		suite.FailNow("")
+	}
+
+	group := results.NotificationGroups[0]
+	suite.Equal(groupKey, group.GroupKey)
+	suite.Equal("favourite", group.Type)
+	suite.Equal(2, group.NotificationsCount)
+	suite.Equal(group.MostRecentNotificationID, group.PageMaxID)
+	suite.Less(group.PageMinID, group.PageMaxID)
+	suite.Equal([]string{
+		suite.testAccounts["admin_account"].ID,
+		suite.testAccounts["local_account_2"].ID,
+	}, group.SampleAccountIDs)
+	suite.Equal("01F8MHAMCHF6Y650WCRSCP4WMY", group.StatusID)
+
+	group = results.NotificationGroups[1]
+	suite.Equal("ungrouped-01F8Q0ANPTWW10DAKTX7BRPBJP", group.GroupKey)
+	suite.Equal(1, group.NotificationsCount)
+	suite.Equal("01F8MHAMCHF6Y650WCRSCP4WMY", group.StatusID)
+
+	// Accounts and statuses should be deduplicated.
+	suite.Len(results.Accounts, 2)
+	suite.Len(results.Statuses, 1)
+
+	suite.Equal(`<http://localhost:8080/api/v2/notifications?limit=10&max_id=01F8Q0ANPTWW10DAKTX7BRPBJP>; rel="next", <http://localhost:8080/api/v2/notifications?limit=10&min_id=`+results.NotificationGroups[0].PageMaxID+`>; rel="prev"`, linkHeader)
+}
+
+func (suite *NotificationsTestSuite) TestGetNotificationGroupsPaged() {
+	testAccount := suite.testAccounts["local_account_1"]
+	testToken := suite.testTokens["local_account_1"]
+	testUser := suite.testUsers["local_account_1"]
+	groupKey := suite.addGroupedNotifications(testAccount)
+
+	// Get first page of one group.
+	results, _, err := suite.getNotificationGroups(
+		testAccount, testToken, testUser,
+		"", 1, nil,
+		http.StatusOK,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if !suite.Len(results.NotificationGroups, 1) {
+		suite.FailNow("")
+	}
+	suite.Equal(groupKey, results.NotificationGroups[0].GroupKey)
+
+	// Page down from the newest notification in the
+	// group; the group shouldn't be returned again.
+	results, _, err = suite.getNotificationGroups(
+		testAccount, testToken, testUser,
+		results.NotificationGroups[0].PageMaxID, 1, nil,
+		http.StatusOK,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if !suite.Len(results.NotificationGroups, 1) {
+		suite.FailNow("")
+	}
+	suite.Equal("ungrouped-01F8Q0ANPTWW10DAKTX7BRPBJP", results.NotificationGroups[0].GroupKey)
+}
+
+func (suite *NotificationsTestSuite) TestGetNotificationGroupsNotGrouped() {
+	testAccount := suite.testAccounts["local_account_1"]
+	suite.addGroupedNotifications(testAccount)
+
+	// Only group follows, so
+	// faves come back ungrouped.
+	results, _, err := suite.getNotificationGroups(
+		testAccount,
+		suite.testTokens["local_account_1"],
+		suite.testUsers["local_account_1"],
+		"", 10, []string{"follow"},
+		http.StatusOK,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(results.NotificationGroups, 3)
+	for _, group := range results.NotificationGroups {
+		suite.Equal(1, group.NotificationsCount)
+		suite.Equal("ungrouped-"+group.MostRecentNotificationID, group.GroupKey)
+	}
+}
+
+func (suite *NotificationsTestSuite) TestDismissNotificationGroup() {
+	testAccount := suite.testAccounts["local_account_1"]
+	groupKey := suite.addGroupedNotifications(testAccount)
+
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, testAccount)
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Request = httptest.NewRequest(http.MethodPost, config.GetProtocol()+"://"+config.GetHost()+"/api"+notifications.GroupedBasePath+"/"+groupKey+"/dismiss", nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.AddParam(notifications.GroupKeyKey, groupKey)
+
+	// trigger the handler
+	suite.notificationsModule.NotificationGroupDismissPOSTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	// Only the fixture fave should be left.
+	results, _, err := suite.getNotificationGroups(
+		testAccount,
+		suite.testTokens["local_account_1"],
+		suite.testUsers["local_account_1"],
+		"", 10, nil,
+		http.StatusOK,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if suite.Len(results.NotificationGroups, 1) {
+		suite.Equal("ungrouped-01F8Q0ANPTWW10DAKTX7BRPBJP", results.NotificationGroups[0].GroupKey)
+	}
+}
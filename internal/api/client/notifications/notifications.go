@@ -34,10 +34,21 @@
 	BasePathWithID    = BasePath + "/:" + IDKey
 	BasePathWithClear = BasePath + "/clear"
 
+	// GroupKeyKey is for notification group keys.
+	GroupKeyKey = "group_key"
+	// GroupedBasePath is the base path for serving the grouped notifications API, minus the 'api' prefix.
+	GroupedBasePath = "/v2/notifications"
+	// GroupedBasePathWithKey is the grouped notifications base path with the group key in it.
+	GroupedBasePathWithKey      = GroupedBasePath + "/:" + GroupKeyKey
+	GroupedBasePathWithAccounts = GroupedBasePathWithKey + "/accounts"
+	GroupedBasePathWithDismiss  = GroupedBasePathWithKey + "/dismiss"
+
 	// TypesKey names an array param specifying notification types to include.
 	TypesKey = "types[]"
 	// ExcludeTypesKey names an array param specifying notification types to exclude.
 	ExcludeTypesKey = "exclude_types[]"
+	// GroupedTypesKey names an array param specifying notification types to group.
+	GroupedTypesKey = "grouped_types[]"
 	MaxIDKey        = "max_id"
 	LimitKey        = "limit"
 	SinceIDKey      = "since_id"
@@ -58,4 +69,8 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, BasePath, m.NotificationsGETHandler)
 	attachHandler(http.MethodGet, BasePathWithID, m.NotificationGETHandler)
 	attachHandler(http.MethodPost, BasePathWithClear, m.NotificationsClearPOSTHandler)
+	attachHandler(http.MethodGet, GroupedBasePath, m.NotificationGroupsGETHandler)
+	attachHandler(http.MethodGet, GroupedBasePathWithKey, m.NotificationGroupGETHandler)
+	attachHandler(http.MethodGet, GroupedBasePathWithAccounts, m.NotificationGroupAccountsGETHandler)
+	attachHandler(http.MethodPost, GroupedBasePathWithDismiss, m.NotificationGroupDismissPOSTHandler)
 }
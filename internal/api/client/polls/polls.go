@@ -26,10 +26,11 @@
 )
 
 const (
-	IDKey           = "id"                                 // IDKey is the key for poll IDs
-	BasePath        = "/:" + util.APIVersionKey + "/polls" // BasePath is the base API path for making poll requests through v1 or v2 of the api (for mastodon API compatibility)
-	PollWithID      = BasePath + "/:" + IDKey              //
-	PollVotesWithID = BasePath + "/:" + IDKey + "/votes"   //
+	IDKey            = "id"                                 // IDKey is the key for poll IDs
+	BasePath         = "/:" + util.APIVersionKey + "/polls" // BasePath is the base API path for making poll requests through v1 or v2 of the api (for mastodon API compatibility)
+	PollWithID       = BasePath + "/:" + IDKey              //
+	PollVotesWithID  = BasePath + "/:" + IDKey + "/votes"   //
+	PollVotersWithID = BasePath + "/:" + IDKey + "/voters"  //
 )
 
 type Module struct {
@@ -45,4 +46,5 @@ func New(processor *processing.Processor) *Module {
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	attachHandler(http.MethodGet, PollWithID, m.PollGETHandler)
 	attachHandler(http.MethodPost, PollVotesWithID, m.PollVotePOSTHandler)
+	attachHandler(http.MethodGet, PollVotersWithID, m.PollVotersGETHandler)
 }
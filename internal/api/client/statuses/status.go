@@ -27,6 +27,10 @@
 const (
 	// IDKey is for status UUIDs
 	IDKey = "id"
+	// EmojiKey is for specifying the emoji of a reaction.
+	EmojiKey = "emoji"
+	// CollectionIDKey is for specifying a bookmark collection when bookmarking.
+	CollectionIDKey = "collection_id"
 	// BasePath is the base path for serving the statuses API, minus the 'api' prefix
 	BasePath = "/v1/statuses"
 	// BasePathWithID is just the base path with the ID key in it.
@@ -40,6 +44,10 @@
 	// UnfavouritePath is for removing a fave from a status
 	UnfavouritePath = BasePathWithID + "/unfavourite"
 
+	// ReactionsPath is for seeing emoji reactions to a given status
+	ReactionsPath = BasePathWithID + "/reactions"
+	// ReactionPath is for adding or removing an emoji reaction to a given status
+	ReactionPath = ReactionsPath + "/:" + EmojiKey
 	// RebloggedPath is for seeing who's boosted a given status
 	RebloggedPath = BasePathWithID + "/reblogged_by"
 	// ReblogPath is for boosting/reblogging a given status
@@ -68,8 +76,23 @@
 	// HistoryPath is used for fetching history of posts.
 	HistoryPath = BasePathWithID + "/history"
 
+	// HistoryDiffPath is used for fetching computed diffs between revisions of posts.
+	HistoryDiffPath = HistoryPath + "/diff"
+
 	// SourcePath is used for fetching source of a post.
 	SourcePath = BasePathWithID + "/source"
+
+	// RestorePath is used for restoring a deleted post from the trash.
+	RestorePath = BasePathWithID + "/restore"
+
+	// InteractionPolicyPath is used for updating the interaction policy of a post.
+	InteractionPolicyPath = BasePathWithID + "/interaction_policy"
+
+	// TranslatePath is used for translating a post into another language.
+	TranslatePath = BasePathWithID + "/translate"
+
+	// BulkDeletePath is for starting, checking, and aborting bulk deletion of own posts.
+	BulkDeletePath = BasePath + "/bulk_delete"
 )
 
 type Module struct {
@@ -88,12 +111,18 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, BasePathWithID, m.StatusGETHandler)
 	attachHandler(http.MethodPut, BasePathWithID, m.StatusEditPUTHandler)
 	attachHandler(http.MethodDelete, BasePathWithID, m.StatusDELETEHandler)
+	attachHandler(http.MethodPost, RestorePath, m.StatusRestorePOSTHandler)
 
 	// fave stuff
 	attachHandler(http.MethodPost, FavouritePath, m.StatusFavePOSTHandler)
 	attachHandler(http.MethodPost, UnfavouritePath, m.StatusUnfavePOSTHandler)
 	attachHandler(http.MethodGet, FavouritedPath, m.StatusFavedByGETHandler)
 
+	// reaction stuff
+	attachHandler(http.MethodGet, ReactionsPath, m.StatusReactionsGETHandler)
+	attachHandler(http.MethodPut, ReactionPath, m.StatusReactionPUTHandler)
+	attachHandler(http.MethodDelete, ReactionPath, m.StatusReactionDELETEHandler)
+
 	// pin stuff
 	attachHandler(http.MethodPost, PinPath, m.StatusPinPOSTHandler)
 	attachHandler(http.MethodPost, UnpinPath, m.StatusUnpinPOSTHandler)
@@ -114,5 +143,17 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 
 	// history/edit stuff
 	attachHandler(http.MethodGet, HistoryPath, m.StatusHistoryGETHandler)
+	attachHandler(http.MethodGet, HistoryDiffPath, m.StatusHistoryDiffGETHandler)
 	attachHandler(http.MethodGet, SourcePath, m.StatusSourceGETHandler)
+
+	// translation stuff
+	attachHandler(http.MethodPost, TranslatePath, m.StatusTranslatePOSTHandler)
+
+	// interaction policy stuff
+	attachHandler(http.MethodPut, InteractionPolicyPath, m.StatusInteractionPolicyPUTHandler)
+
+	// bulk delete stuff
+	attachHandler(http.MethodPost, BulkDeletePath, m.StatusBulkDeletePOSTHandler)
+	attachHandler(http.MethodGet, BulkDeletePath, m.StatusBulkDeleteGETHandler)
+	attachHandler(http.MethodDelete, BulkDeletePath, m.StatusBulkDeleteDELETEHandler)
 }
@@ -44,6 +44,13 @@
 //		description: Target status ID.
 //		in: path
 //		required: true
+//	-
+//		name: collection_id
+//		type: string
+//		description: >-
+//			ID of a bookmark collection to file the bookmark in.
+//			If the status is already bookmarked, the bookmark is moved to this collection.
+//		in: formData
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -106,7 +113,14 @@ func (m *Module) StatusBookmarkPOSTHandler(c *gin.Context) {
 		return
 	}
 
-	apiStatus, errWithCode := m.processor.Status().BookmarkCreate(c.Request.Context(), authed.Account, targetStatusID)
+	// Collection ID may be given either
+	// as form data or as a query param.
+	collectionID := c.PostForm(CollectionIDKey)
+	if collectionID == "" {
+		collectionID = c.Query(CollectionIDKey)
+	}
+
+	apiStatus, errWithCode := m.processor.Status().BookmarkCreate(c.Request.Context(), authed.Account, targetStatusID, collectionID)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
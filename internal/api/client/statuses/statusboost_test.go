@@ -100,6 +100,7 @@ func (suite *StatusBoostTestSuite) TestPostBoost() {
   "content": "",
   "created_at": "right the hell just now babyee",
   "edited_at": null,
+  "emoji_reactions": [],
   "emojis": [],
   "favourited": true,
   "favourites_count": 0,
@@ -147,6 +148,7 @@ func (suite *StatusBoostTestSuite) TestPostBoost() {
     "content_type": "text/plain",
     "created_at": "right the hell just now babyee",
     "edited_at": null,
+    "emoji_reactions": [],
     "emojis": [
       {
         "category": "reactions",
@@ -283,6 +285,7 @@ func (suite *StatusBoostTestSuite) TestPostBoostOwnFollowersOnly() {
   "content": "",
   "created_at": "right the hell just now babyee",
   "edited_at": null,
+  "emoji_reactions": [],
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -334,6 +337,7 @@ func (suite *StatusBoostTestSuite) TestPostBoostOwnFollowersOnly() {
     "content_type": "text/plain",
     "created_at": "right the hell just now babyee",
     "edited_at": null,
+    "emoji_reactions": [],
     "emojis": [],
     "favourited": false,
     "favourites_count": 0,
@@ -500,6 +504,7 @@ func (suite *StatusBoostTestSuite) TestPostBoostImplicitAccept() {
   "content": "",
   "created_at": "right the hell just now babyee",
   "edited_at": null,
+  "emoji_reactions": [],
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -547,6 +552,7 @@ func (suite *StatusBoostTestSuite) TestPostBoostImplicitAccept() {
     "content_type": "text/markdown",
     "created_at": "right the hell just now babyee",
     "edited_at": null,
+    "emoji_reactions": [],
     "emojis": [],
     "favourited": false,
     "favourites_count": 0,
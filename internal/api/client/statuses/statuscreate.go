@@ -147,7 +147,9 @@
 //	-
 //		name: visibility
 //		x-go-name: Visibility
-//		description: Visibility of the posted status.
+//		description: |-
+//			Visibility of the posted status.
+//			"local" is shorthand for "public" with local_only set to true.
 //		type: string
 //		enum:
 //			- public
@@ -155,6 +157,7 @@
 //			- private
 //			- mutuals_only
 //			- direct
+//			- local
 //		in: formData
 //	-
 //		name: local_only
@@ -189,6 +192,16 @@
 //		format: date-time
 //		in: formData
 //	-
+//		name: expires_in
+//		x-go-name: ExpiresIn
+//		description: |-
+//			Duration in seconds after which this status will be automatically deleted, and the delete federated.
+//			Must be between 300 seconds (5 minutes) and 31622400 seconds (366 days).
+//			Cannot be used when scheduling a status into the future.
+//		type: integer
+//		format: int64
+//		in: formData
+//	-
 //		name: language
 //		x-go-name: Language
 //		description: ISO 639 language code for this status.
@@ -197,11 +210,16 @@
 //	-
 //		name: content_type
 //		x-go-name: ContentType
-//		description: Content type to use when parsing this status.
+//		description: >-
+//			Content type to use when parsing this status.
+//			Shorthand `plain` and `markdown` are also accepted.
+//			If not set, the account's default content type will be used.
 //		type: string
 //		enum:
 //			- text/plain
 //			- text/markdown
+//			- plain
+//			- markdown
 //		in: formData
 //	-
 //		name: interaction_policy[can_favourite][automatic_approval][0]
@@ -412,6 +430,13 @@ func parseStatusCreateForm(c *gin.Context) (*apimodel.StatusCreateRequest, gtser
 		form.LocalOnly = util.Ptr(!*form.Federated) // nolint:staticcheck
 	}
 
+	// "local" visibility is shorthand
+	// for a public, local-only status.
+	if form.Visibility == apimodel.VisibilityLocal {
+		form.Visibility = apimodel.VisibilityPublic
+		form.LocalOnly = util.Ptr(true)
+	}
+
 	// Normalize poll expiry time if a poll was given.
 	if form.Poll != nil && form.Poll.ExpiresInI != nil {
 
@@ -427,6 +452,21 @@ func parseStatusCreateForm(c *gin.Context) (*apimodel.StatusCreateRequest, gtser
 		form.Poll.ExpiresIn = util.PtrOrZero(expiresIn)
 	}
 
+	// Normalize status expiry time if given.
+	if form.ExpiresInI != nil {
+
+		// If we parsed this as JSON, expires_in
+		// may be either a float64 or a string.
+		expiresIn, err := apiutil.ParseDuration(
+			form.ExpiresInI,
+			"expires_in",
+		)
+		if err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		form.ExpiresIn = util.PtrOrZero(expiresIn)
+	}
+
 	// Parse scheduled_at if given.
 	if form.ScheduledAtRaw != "" {
 		// Try RFC3339 initially, which
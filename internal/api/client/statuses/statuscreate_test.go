@@ -29,6 +29,7 @@
 
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/statuses"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/id"
 	"code.superseriousbusiness.org/gotosocial/internal/oauth"
 	"code.superseriousbusiness.org/gotosocial/testrig"
@@ -141,6 +142,7 @@ func (suite *StatusCreateTestSuite) TestPostNewStatus() {
   "content_type": "text/plain",
   "created_at": "right the hell just now babyee",
   "edited_at": null,
+  "emoji_reactions": [],
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -228,6 +230,7 @@ func (suite *StatusCreateTestSuite) TestPostNewStatusIntPolicy() {
   "content_type": "text/plain",
   "created_at": "right the hell just now babyee",
   "edited_at": null,
+  "emoji_reactions": [],
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -325,6 +328,7 @@ func (suite *StatusCreateTestSuite) TestPostNewStatusIntPolicyJSON() {
   "content_type": "text/plain",
   "created_at": "right the hell just now babyee",
   "edited_at": null,
+  "emoji_reactions": [],
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -488,6 +492,27 @@ func (suite *StatusCreateTestSuite) TestPostNewScheduledStatusNonsenseTime() {
 }`, out)
 }
 
+func (suite *StatusCreateTestSuite) TestPostNewStatusLocalVisibility() {
+	status, recorder := suite.postStatusStruct(map[string][]string{
+		"status":     {"this one's just for the locals"},
+		"visibility": {string(apimodel.VisibilityLocal)},
+	}, "")
+
+	// "local" should be accepted as
+	// shorthand for public + local only.
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Equal(apimodel.VisibilityPublic, status.Visibility)
+	suite.True(status.LocalOnly)
+
+	// Check the database model too.
+	dbStatus, err := suite.db.GetStatusByID(suite.T().Context(), status.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.VisibilityPublic, dbStatus.Visibility)
+	suite.True(dbStatus.IsLocalOnly())
+}
+
 func (suite *StatusCreateTestSuite) TestPostNewBackfilledStatus() {
 	// A time in the past.
 	scheduledAtStr := "2020-10-04T15:32:02.018Z"
@@ -601,6 +626,7 @@ func (suite *StatusCreateTestSuite) TestPostNewStatusMarkdown() {
   "content_type": "text/markdown",
   "created_at": "right the hell just now babyee",
   "edited_at": null,
+  "emoji_reactions": [],
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -686,6 +712,7 @@ func (suite *StatusCreateTestSuite) TestMentionUnknownAccount() {
   "content_type": "text/plain",
   "created_at": "right the hell just now babyee",
   "edited_at": null,
+  "emoji_reactions": [],
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -765,6 +792,7 @@ func (suite *StatusCreateTestSuite) TestPostStatusWithLinksAndTags() {
   "content_type": "text/plain",
   "created_at": "right the hell just now babyee",
   "edited_at": null,
+  "emoji_reactions": [],
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -850,6 +878,7 @@ func (suite *StatusCreateTestSuite) TestPostNewStatusWithEmoji() {
   "content_type": "text/plain",
   "created_at": "right the hell just now babyee",
   "edited_at": null,
+  "emoji_reactions": [],
   "emojis": [
     {
       "category": "reactions",
@@ -949,6 +978,7 @@ func (suite *StatusCreateTestSuite) TestReplyToLocalStatus() {
   "content_type": "text/plain",
   "created_at": "right the hell just now babyee",
   "edited_at": null,
+  "emoji_reactions": [],
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -1033,6 +1063,7 @@ func (suite *StatusCreateTestSuite) TestAttachNewMediaSuccess() {
   "content_type": "text/plain",
   "created_at": "right the hell just now babyee",
   "edited_at": null,
+  "emoji_reactions": [],
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -1139,6 +1170,7 @@ func (suite *StatusCreateTestSuite) TestPostNewStatusWithNoncanonicalLanguageTag
   "content_type": "text/plain",
   "created_at": "right the hell just now babyee",
   "edited_at": null,
+  "emoji_reactions": [],
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -1215,6 +1247,7 @@ func (suite *StatusCreateTestSuite) TestPostNewStatusWithPollForm() {
   "content_type": "text/plain",
   "created_at": "right the hell just now babyee",
   "edited_at": null,
+  "emoji_reactions": [],
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -1313,6 +1346,7 @@ func (suite *StatusCreateTestSuite) TestPostNewStatusWithPollJSON() {
   "content_type": "text/plain",
   "created_at": "right the hell just now babyee",
   "edited_at": null,
+  "emoji_reactions": [],
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -33,6 +33,9 @@
 // The deleted status will be returned in the response. The `text` field will contain the original text of the status as it was submitted.
 // This is useful when doing a 'delete and redraft' type operation.
 //
+// If the instance has a statuses trash window configured, the status will be moved to the trash
+// rather than being deleted immediately, and can be restored until the trash window has passed.
+//
 //	---
 //	tags:
 //	- statuses
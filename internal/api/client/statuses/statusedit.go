@@ -129,11 +129,16 @@
 //	-
 //		name: content_type
 //		x-go-name: ContentType
-//		description: Content type to use when parsing this status.
+//		description: >-
+//			Content type to use when parsing this status.
+//			Shorthand `plain` and `markdown` are also accepted.
+//			If not set, the status's existing content type will be used.
 //		type: string
 //		enum:
 //			- text/plain
 //			- text/markdown
+//			- plain
+//			- markdown
 //		in: formData
 //
 //	produces:
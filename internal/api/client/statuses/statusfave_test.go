@@ -106,6 +106,7 @@ func (suite *StatusFaveTestSuite) TestPostFave() {
   "content_type": "text/plain",
   "created_at": "right the hell just now babyee",
   "edited_at": null,
+  "emoji_reactions": [],
   "emojis": [],
   "favourited": true,
   "favourites_count": 1,
@@ -231,6 +232,7 @@ func (suite *StatusFaveTestSuite) TestPostFaveImplicitAccept() {
   "content_type": "text/markdown",
   "created_at": "right the hell just now babyee",
   "edited_at": null,
+  "emoji_reactions": [],
   "emojis": [],
   "favourited": true,
   "favourites_count": 1,
@@ -148,6 +148,7 @@ func (suite *StatusMuteTestSuite) TestMuteUnmuteStatus() {
   "mentions": [],
   "tags": [],
   "emojis": [],
+  "emoji_reactions": [],
   "card": null,
   "poll": null,
   "text": "hello everyone!",
@@ -240,6 +241,7 @@ func (suite *StatusMuteTestSuite) TestMuteUnmuteStatus() {
   "mentions": [],
   "tags": [],
   "emojis": [],
+  "emoji_reactions": [],
   "card": null,
   "poll": null,
   "text": "hello everyone!",
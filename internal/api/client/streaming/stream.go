@@ -79,6 +79,7 @@
 //			- hashtag
 //			- hashtag:local
 //			- list
+//			- antenna
 //			- direct
 //		description: |-
 //			Type of stream to request.
@@ -92,6 +93,7 @@
 //			`hashtag`: receive updates for a given hashtag.
 //			`hashtag:local`: receive local updates for a given hashtag.
 //			`list`: receive updates for a certain list of accounts.
+//			`antenna`: receive updates for a certain antenna.
 //			`direct`: receive updates for direct messages.
 //		in: query
 //		required: true
@@ -103,12 +105,45 @@
 //			Only used if stream type is 'list'.
 //		in: query
 //	-
+//		name: antenna
+//		type: string
+//		description: |-
+//			ID of the antenna to subscribe to.
+//			Only used if stream type is 'antenna'.
+//		in: query
+//	-
 //		name: tag
 //		type: string
 //		description: |-
 //			Name of the tag to subscribe to.
 //			Only used if stream type is 'hashtag' or 'hashtag:local'.
 //		in: query
+//	-
+//		name: language
+//		type: string
+//		description: |-
+//			BCP47 language tag to limit updates to, eg., `en` or `de`.
+//			Only used if stream type is 'public' or 'public:local'.
+//		in: query
+//	-
+//		name: types[]
+//		type: array
+//		items:
+//			type: string
+//		description: |-
+//			Types of notifications to stream, eg., `mention`. If not provided,
+//			all notification types will be streamed. Can be changed on an open
+//			connection by sending a message with type `notifications`.
+//		in: query
+//	-
+//		name: exclude_types[]
+//		type: array
+//		items:
+//			type: string
+//		description: |-
+//			Types of notifications not to stream, eg., `favourite`. Can be changed
+//			on an open connection by sending a message with type `notifications`.
+//		in: query
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -131,6 +166,7 @@
 //							- hashtag
 //							- hashtag:local
 //							- list
+//							- antenna
 //							- direct
 //					event:
 //						description: |-
@@ -219,13 +255,26 @@ func (m *Module) StreamGETHandler(c *gin.Context) {
 	streamType := c.Query(StreamQueryKey)
 
 	// By appending other query params to the streamType, we
-	// can allow streaming for specific list IDs or hashtags.
-	// The streamType in this case will end up looking like
-	// `hashtag:example` or `list:01H3YF48G8B7KTPQFS8D2QBVG8`.
+	// can allow streaming for specific list or antenna IDs,
+	// hashtags or languages. The streamType in this case will
+	// end up looking like `hashtag:example`, `public:local:de`
+	// or `list:01H3YF48G8B7KTPQFS8D2QBVG8`.
 	if list := c.Query(StreamListKey); list != "" {
 		streamType += ":" + list
+	} else if antenna := c.Query(StreamAntennaKey); antenna != "" {
+		streamType += ":" + antenna
 	} else if tag := c.Query(StreamTagKey); tag != "" {
 		streamType += ":" + tag
+	} else if isPublicStreamType(streamType) {
+		lang, errWithCode := apiutil.ParseLanguage(c.Query(StreamLanguageKey))
+		if errWithCode != nil {
+			apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+			return
+		}
+
+		if lang != "" {
+			streamType += ":" + lang
+		}
 	}
 
 	// Open a stream with the processor; this lets processor
@@ -245,6 +294,13 @@ func (m *Module) StreamGETHandler(c *gin.Context) {
 		WithField("streamID", id.NewULID()).
 		WithField("username", account.Username)
 
+	// Filter notification types
+	// streamed, if requested.
+	stream.FilterNotifications(
+		parseNotificationTypes(&l, c.QueryArray(TypesKey)),
+		parseNotificationTypes(&l, c.QueryArray(ExcludeTypesKey)),
+	)
+
 	// Upgrade the incoming HTTP request. This hijacks the
 	// underlying connection and reuses it for the websocket
 	// (non-http) protocol.
@@ -333,9 +389,13 @@ func (m *Module) readFromWSConn(
 
 	for {
 		var msg struct {
-			Type   string `json:"type"`
-			Stream string `json:"stream"`
-			List   string `json:"list,omitempty"`
+			Type         string   `json:"type"`
+			Stream       string   `json:"stream"`
+			List         string   `json:"list,omitempty"`
+			Antenna      string   `json:"antenna,omitempty"`
+			Language     string   `json:"language,omitempty"`
+			Types        []string `json:"types,omitempty"`
+			ExcludeTypes []string `json:"exclude_types,omitempty"`
 		}
 
 		// Read JSON objects from the client and act on them.
@@ -359,6 +419,16 @@ func (m *Module) readFromWSConn(
 		// and usually interesting, so log this at info.
 		l.Infof("received websocket message: %+v", msg)
 
+		if msg.Type == "notifications" {
+			// Replace the filter on notification
+			// types streamed to this connection.
+			stream.FilterNotifications(
+				parseNotificationTypes(l, msg.Types),
+				parseNotificationTypes(l, msg.ExcludeTypes),
+			)
+			continue
+		}
+
 		// Ignore if the updateStreamType is unknown (or missing),
 		// so a bad client can't cause extra memory allocations
 		if !slices.Contains(streampkg.AllStatusTimelines, msg.Stream) {
@@ -371,6 +441,19 @@ func (m *Module) readFromWSConn(
 			// the stream name as this is how we
 			// we track stream types internally.
 			msg.Stream += ":" + msg.List
+		} else if msg.Antenna != "" {
+			// Likewise for a given antenna.
+			msg.Stream += ":" + msg.Antenna
+		} else if msg.Language != "" && isPublicStreamType(msg.Stream) {
+			// If a language is given for a public
+			// stream, add this to the stream name
+			// in the same way as for lists above.
+			lang, errWithCode := apiutil.ParseLanguage(msg.Language)
+			if errWithCode != nil {
+				l.Warnf("invalid 'language' field: %v", msg)
+				continue
+			}
+			msg.Stream += ":" + lang
 		}
 
 		switch msg.Type {
@@ -386,6 +469,29 @@ func (m *Module) readFromWSConn(
 	l.Debug("finished websocket read")
 }
 
+// parseNotificationTypes converts the given values to
+// frontend API notification types, logging + skipping
+// unknown types so a bad client can't cause extra memory
+// allocations with garbage values.
+func parseNotificationTypes(l *log.Entry, values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	ntypes := make([]string, 0, len(values))
+	for _, value := range values {
+		ntype := gtsmodel.ParseNotificationType(value)
+		if ntype == gtsmodel.NotificationUnknown {
+			l.Warnf("ignoring unknown notification type %s", value)
+			continue
+		}
+
+		ntypes = append(ntypes, ntype.String())
+	}
+
+	return ntypes
+}
+
 // writeToWSConn receives messages coming from the processor via the
 // given stream, and writes them into the given websockets connection.
 // This function also handles sending ping messages into the websockets
@@ -456,3 +562,11 @@ func (m *Module) writeToWSConn(
 		}
 	}
 }
+
+// isPublicStreamType returns whether streamType
+// is one of the public (ie., federated or local)
+// timeline stream types that may be language-sharded.
+func isPublicStreamType(streamType string) bool {
+	return streamType == streampkg.TimelinePublic ||
+		streamType == streampkg.TimelineLocal
+}
@@ -30,7 +30,11 @@
 	BasePath            = "/v1/streaming"          // path for the streaming api, minus the 'api' prefix
 	StreamQueryKey      = "stream"                 // type of stream being requested
 	StreamListKey       = "list"                   // id of list being requested
+	StreamAntennaKey    = "antenna"                // id of antenna being requested
 	StreamTagKey        = "tag"                    // name of tag being requested
+	StreamLanguageKey   = "language"               // language of public timeline being requested
+	TypesKey            = "types[]"                // notification types to stream
+	ExcludeTypesKey     = "exclude_types[]"        // notification types not to stream
 	AccessTokenQueryKey = "access_token"           // oauth access token
 	AccessTokenHeader   = "Sec-Websocket-Protocol" //nolint:gosec
 )
@@ -27,7 +27,11 @@
 )
 
 const (
-	BasePath = "/v2/suggestions"
+	AccountIDKey = "account_id"
+	BasePath     = "/v2/suggestions"
+	// DismissPath is the v1 path used to dismiss
+	// suggestions, as there's no v2 equivalent.
+	DismissPath = "/v1/suggestions/:" + AccountIDKey
 )
 
 type Module struct {
@@ -40,11 +44,24 @@ func New(processor *processing.Processor) *Module {
 	}
 }
 
-// SuggestionsGETHandler swagger:operation GET /api/v1/suggestions getSuggestions
+// SuggestionsGETHandler swagger:operation GET /api/v2/suggestions getSuggestions
 //
-// Accounts that are promoted by staff, or that the user has had past positive interactions with, but is not yet following.
+// Accounts suggested for the requester to follow.
 //
-// THIS ENDPOINT IS CURRENTLY NOT FULLY IMPLEMENTED: it will always return an empty array.
+// Suggestions are first drawn from the requester's social graph, and recomputed
+// regularly. Accounts are suggested if they're followed by accounts the requester
+// follows (source `friends_of_friends`), if the requester has recently faved, boosted,
+// or replied to their posts (source `past_interactions`), or if they've recently posted
+// publicly using hashtags that the requester follows or has recently posted with
+// (source `similar_hashtags`). Apart from those the requester has interacted with,
+// only accounts which have opted in to discovery are suggested from the social graph.
+//
+// Suggestions are then drawn from accounts listed in the instance directory
+// configured by the instance admin, if any (source `featured`). Only accounts
+// which have opted in to discovery are listed in such a directory.
+//
+// Accounts the requester already follows, has requested to follow, blocks,
+// mutes, or has dismissed from their suggestions are not included.
 //
 //	---
 //	tags:
@@ -53,6 +70,16 @@ func New(processor *processing.Processor) *Module {
 //	produces:
 //	- application/json
 //
+//	parameters:
+//	-
+//		name: limit
+//		type: integer
+//		description: Maximum number of suggestions to return.
+//		default: 40
+//		maximum: 80
+//		minimum: 1
+//		in: query
+//
 //	security:
 //	- OAuth2 Bearer:
 //		- read
@@ -62,8 +89,11 @@ func New(processor *processing.Processor) *Module {
 //			schema:
 //				type: array
 //				items:
-//					type: object
-//				maxItems: 0
+//					"$ref": "#/definitions/suggestion"
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
 //		'401':
 //			schema:
 //				"$ref": "#/definitions/error"
@@ -76,8 +106,12 @@ func New(processor *processing.Processor) *Module {
 //			schema:
 //				"$ref": "#/definitions/error"
 //			description: not acceptable
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
 func (m *Module) SuggestionsGETHandler(c *gin.Context) {
-	_, errWithCode := apiutil.TokenAuth(c,
+	authed, errWithCode := apiutil.TokenAuth(c,
 		true, true, true, true,
 		apiutil.ScopeRead,
 	)
@@ -91,9 +125,107 @@ func (m *Module) SuggestionsGETHandler(c *gin.Context) {
 		return
 	}
 
-	apiutil.JSON(c, http.StatusOK, apiutil.EmptyJSONArray)
+	limit, errWithCode := apiutil.ParseLimit(c.Query(apiutil.LimitKey), 40, 80, 1)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	suggestions, errWithCode := m.processor.Suggestions().SuggestionsGet(
+		c.Request.Context(),
+		authed.Account,
+		limit,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, suggestions)
+}
+
+// SuggestionDELETEHandler swagger:operation DELETE /api/v1/suggestions/{account_id} dismissSuggestion
+//
+// Remove the given account from the requester's follow suggestions.
+//
+// The account will not be suggested to the requester again.
+//
+//	---
+//	tags:
+//	- suggestions
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: account_id
+//		type: string
+//		description: ID of the account to no longer suggest.
+//		in: path
+//		required: true
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:follows
+//
+//	responses:
+//		'200':
+//			description: Suggestion dismissed. Returns an empty object.
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'404':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not found
+//		'406':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not acceptable
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) SuggestionDELETEHandler(c *gin.Context) {
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		apiutil.ScopeWriteFollows,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	targetAccountID, errWithCode := apiutil.ParseID(c.Param(AccountIDKey))
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if errWithCode := m.processor.Suggestions().SuggestionDismiss(
+		c.Request.Context(),
+		authed.Account,
+		targetAccountID,
+	); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, apiutil.EmptyJSONObject)
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	attachHandler(http.MethodGet, BasePath, m.SuggestionsGETHandler)
+	attachHandler(http.MethodDelete, DismissPath, m.SuggestionDELETEHandler)
 }
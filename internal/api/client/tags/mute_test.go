@@ -0,0 +1,110 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package tags_test
+
+import (
+	"net/http"
+
+	"code.superseriousbusiness.org/gotosocial/internal/api/client/tags"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+)
+
+func (suite *TagsTestSuite) mute(
+	accountFixtureName string,
+	tagName string,
+	expectedHTTPStatus int,
+	expectedBody string,
+) (*apimodel.Tag, error) {
+	return suite.tagAction(
+		accountFixtureName,
+		tagName,
+		http.MethodPost,
+		tags.MutePath,
+		suite.tagsModule.MuteTagPOSTHandler,
+		expectedHTTPStatus,
+		expectedBody,
+	)
+}
+
+func (suite *TagsTestSuite) unmute(
+	accountFixtureName string,
+	tagName string,
+	expectedHTTPStatus int,
+	expectedBody string,
+) (*apimodel.Tag, error) {
+	return suite.tagAction(
+		accountFixtureName,
+		tagName,
+		http.MethodDelete,
+		tags.MutePath,
+		suite.tagsModule.UnmuteTagDELETEHandler,
+		expectedHTTPStatus,
+		expectedBody,
+	)
+}
+
+// Mute a tag, then unmute it again.
+func (suite *TagsTestSuite) TestMuteUnmute() {
+	accountFixtureName := "local_account_2"
+	testAccount := suite.testAccounts[accountFixtureName]
+	testTag := suite.testTags["welcome"]
+
+	apiTag, err := suite.mute(accountFixtureName, testTag.Name, http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(testTag.Name, apiTag.Name)
+	if suite.NotNil(apiTag.Muting) {
+		suite.True(*apiTag.Muting)
+	}
+
+	muting, err := suite.db.IsAccountMutingTag(suite.T().Context(), testAccount.ID, testTag.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(muting)
+
+	// Muting again should be idempotent.
+	if _, err := suite.mute(accountFixtureName, testTag.Name, http.StatusOK, ""); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiTag, err = suite.unmute(accountFixtureName, testTag.Name, http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if suite.NotNil(apiTag.Muting) {
+		suite.False(*apiTag.Muting)
+	}
+
+	muting, err = suite.db.IsAccountMutingTag(suite.T().Context(), testAccount.ID, testTag.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(muting)
+}
+
+// Unmuting a tag that doesn't exist should fail.
+func (suite *TagsTestSuite) TestUnmuteUnknownTag() {
+	_, err := suite.unmute("local_account_2", "nonexistent", http.StatusNotFound, `{"error":"Not Found"}`)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+}
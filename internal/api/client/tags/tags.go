@@ -30,6 +30,7 @@
 	TagPath      = BasePath + "/:" + apiutil.TagNameKey
 	FollowPath   = TagPath + "/follow"
 	UnfollowPath = TagPath + "/unfollow"
+	MutePath     = TagPath + "/mute"
 )
 
 type Module struct {
@@ -46,4 +47,6 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, TagPath, m.TagGETHandler)
 	attachHandler(http.MethodPost, FollowPath, m.FollowTagPOSTHandler)
 	attachHandler(http.MethodPost, UnfollowPath, m.UnfollowTagPOSTHandler)
+	attachHandler(http.MethodPost, MutePath, m.MuteTagPOSTHandler)
+	attachHandler(http.MethodDelete, MutePath, m.UnmuteTagDELETEHandler)
 }
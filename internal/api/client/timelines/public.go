@@ -86,6 +86,14 @@
 //		default: false
 //		in: query
 //		required: false
+//	-
+//		name: language
+//		type: string
+//		description: >-
+//			Show only statuses in the given language, specified as
+//			a BCP47 language tag, eg., `en` or `de`.
+//		in: query
+//		required: false
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -162,11 +170,18 @@ func (m *Module) PublicTimelineGETHandler(c *gin.Context) {
 		return
 	}
 
+	lang, errWithCode := apiutil.ParseLanguage(c.Query(apiutil.LanguageKey))
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
 	resp, errWithCode := m.processor.Timeline().PublicTimelineGet(
 		c.Request.Context(),
 		authed.Account,
 		page,
 		local,
+		lang,
 	)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
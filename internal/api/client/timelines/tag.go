@@ -30,6 +30,8 @@
 //
 // See public statuses that use the given hashtag (case insensitive).
 //
+// Other hashtags can be combined with the given hashtag using the `any[]`, `all[]` and `none[]` parameters.
+//
 // The statuses will be returned in descending chronological order (newest first), with sequential IDs (bigger = newer).
 //
 // The returned Link header can be used to generate the previous and next queries when scrolling up or down a timeline.
@@ -55,6 +57,33 @@
 //		in: path
 //		required: true
 //	-
+//		name: any[]
+//		type: array
+//		items:
+//			type: string
+//		description: >-
+//			Also include statuses that use any of these tags (up to 4).
+//		in: query
+//		required: false
+//	-
+//		name: all[]
+//		type: array
+//		items:
+//			type: string
+//		description: >-
+//			Only include statuses that also use all of these tags (up to 4).
+//		in: query
+//		required: false
+//	-
+//		name: none[]
+//		type: array
+//		items:
+//			type: string
+//		description: >-
+//			Exclude statuses that use any of these tags (up to 4).
+//		in: query
+//		required: false
+//	-
 //		name: max_id
 //		type: string
 //		description: >-
@@ -153,6 +182,9 @@ func (m *Module) TagTimelineGETHandler(c *gin.Context) {
 		c.Request.Context(),
 		authed.Account,
 		tagName,
+		c.QueryArray(apiutil.TagAnyKey),
+		c.QueryArray(apiutil.TagAllKey),
+		c.QueryArray(apiutil.TagNoneKey),
 		page,
 	)
 	if errWithCode != nil {
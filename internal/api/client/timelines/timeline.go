@@ -26,11 +26,13 @@
 )
 
 const (
-	BasePath       = "/v1/timelines"
-	HomeTimeline   = BasePath + "/home"
-	PublicTimeline = BasePath + "/public"
-	ListTimeline   = BasePath + "/list/:" + apiutil.IDKey
-	TagTimeline    = BasePath + "/tag/:" + apiutil.TagNameKey
+	BasePath        = "/v1/timelines"
+	HomeTimeline    = BasePath + "/home"
+	PublicTimeline  = BasePath + "/public"
+	RemoteTimeline  = BasePath + "/remote"
+	ListTimeline    = BasePath + "/list/:" + apiutil.IDKey
+	AntennaTimeline = BasePath + "/antenna/:" + apiutil.IDKey
+	TagTimeline     = BasePath + "/tag/:" + apiutil.TagNameKey
 )
 
 type Module struct {
@@ -46,6 +48,8 @@ func New(processor *processing.Processor) *Module {
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	attachHandler(http.MethodGet, HomeTimeline, m.HomeTimelineGETHandler)
 	attachHandler(http.MethodGet, PublicTimeline, m.PublicTimelineGETHandler)
+	attachHandler(http.MethodGet, RemoteTimeline, m.RemoteTimelineGETHandler)
 	attachHandler(http.MethodGet, ListTimeline, m.ListTimelineGETHandler)
+	attachHandler(http.MethodGet, AntennaTimeline, m.AntennaTimelineGETHandler)
 	attachHandler(http.MethodGet, TagTimeline, m.TagTimelineGETHandler)
 }
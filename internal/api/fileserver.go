@@ -50,7 +50,7 @@ func useFSCacheMiddleware(grp *router.RouterGroup) {
 	//
 	// - https://developer.mozilla.org/en-US/docs/Web/HTTP/Caching#avoiding_revalidation
 	// - https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Cache-Control#immutable
-	servingFromHere := config.GetStorageBackend() == "local" || config.GetStorageS3Proxy()
+	servingFromHere := config.GetStorageBackend() != "s3" || config.GetStorageS3Proxy()
 	if !servingFromHere {
 		return
 	}
@@ -114,6 +114,8 @@ type Account struct {
 	Theme string `json:"theme,omitempty"`
 	// CustomCSS to include when rendering this account's profile or statuses.
 	CustomCSS string `json:"custom_css,omitempty"`
+	// Hex accent color to use when rendering this account's profile. Eg., `#ff00aa`.
+	AccentColor string `json:"accent_color,omitempty"`
 	// Account has enabled RSS feed.
 	// Key/value omitted if false.
 	EnableRSS bool `json:"enable_rss,omitempty"`
@@ -200,10 +202,32 @@ type AccountCreateRequest struct {
 	// swagger:parameters
 	// example: en
 	Locale string `form:"locale" json:"locale" xml:"locale"`
+	// Code of an invite to redeem for this sign up.
+	// Allows sign up even when registration is closed.
+	// swagger:parameters
+	// example: 3XM6BQKUEXG2V3ARDXMRLOCPWM
+	InviteCode string `form:"invite_code" json:"invite_code" xml:"invite_code"`
 	// The IP of the sign up request, will not be parsed from the form.
 	// swagger:parameters
 	// swagger:ignore
 	IP net.IP `form:"-"`
+	// The captcha response submitted along with the sign up
+	// form, will not be parsed from the form directly, as the
+	// form field name depends on the configured captcha provider.
+	// swagger:parameters
+	// swagger:ignore
+	CaptchaResponse string `form:"-"`
+}
+
+// ServiceAccountCreateRequest models creation of
+// a service account owned by the requesting account.
+//
+// swagger:ignore
+type ServiceAccountCreateRequest struct {
+	// The desired username for the service account.
+	Username string `form:"username" json:"username" xml:"username" binding:"required"`
+	// The display name to use for the service account.
+	DisplayName string `form:"display_name" json:"display_name" xml:"display_name"`
 }
 
 // UpdateCredentialsRequest models an update to an account, by the account owner.
@@ -236,16 +260,31 @@ type UpdateCredentialsRequest struct {
 	FieldsAttributes *[]UpdateField `form:"fields_attributes" json:"-"`
 	// Profile metadata names and values, parsed from JSON.
 	JSONFieldsAttributes *map[string]UpdateField `form:"-" json:"fields_attributes"`
+	// IDs of all existing profile fields, in the order
+	// to reorder them to, without changing their values.
+	FieldsOrder *[]string `form:"fields_order[]" json:"fields_order"`
 	// Theme file name to be used when rendering this account's profile or statuses.
 	// Use empty string to unset.
 	Theme *string `form:"theme" json:"theme"`
 	// Custom CSS to be included when rendering this account's profile or statuses.
 	// Use empty string to unset.
 	CustomCSS *string `form:"custom_css" json:"custom_css"`
+	// Hex accent color to use when rendering this account's profile, eg., `#ff00aa`.
+	// Use empty string to unset.
+	AccentColor *string `form:"accent_color" json:"accent_color"`
 	// Enable RSS feed of public toots for this account at /@[username]/feed.rss
 	EnableRSS *bool `form:"enable_rss" json:"enable_rss"`
 	// Hide this account's following/followers collections.
 	HideCollections *bool `form:"hide_collections" json:"hide_collections"`
+	// Opt in to publishing this account to the instance directory (if configured).
+	DirectoryOptIn *bool `form:"directory_opt_in" json:"directory_opt_in"`
+	// Indicate on statuses when the author has a private note set by this account.
+	ShowNoteIndicators *bool `form:"show_note_indicators" json:"show_note_indicators"`
+	// Allow public statuses by this account to be embedded on other websites.
+	AllowEmbeds *bool `form:"allow_embeds" json:"allow_embeds"`
+	// Automatically delete statuses older than this many days,
+	// unless pinned, bookmarked or favourited. 0 to disable.
+	AutoDeleteAfterDays *int `form:"auto_delete_after_days" json:"auto_delete_after_days"`
 	// Visibility of statuses to show via the web view.
 	// "none", "public" (default), or "unlisted" (which includes public as well).
 	WebVisibility *string `form:"web_visibility" json:"web_visibility"`
@@ -277,6 +316,8 @@ type UpdateField struct {
 	// Key this form field was submitted with;
 	// only set if it was submitted as JSON.
 	Key int `form:"-" json:"-"`
+	// ID of the existing field this updates (optional).
+	ID *string `form:"id" json:"id"`
 	// Name of the field
 	Name *string `form:"name" json:"name"`
 	// Value of the field
@@ -422,7 +463,7 @@ func (a *AccountRolePermissions) UnmarshalJSON(b []byte) error {
 	AccountRolePermissionsManageAppeals
 	// AccountRolePermissionsManageUsers indicates that the user can view user details and perform user moderation actions.
 	AccountRolePermissionsManageUsers
-	// AccountRolePermissionsManageInvites is not used by GotoSocial.
+	// AccountRolePermissionsManageInvites indicates that the user can view and revoke invites created by other users.
 	AccountRolePermissionsManageInvites
 	// AccountRolePermissionsManageRules indicates that the user can edit instance rules.
 	AccountRolePermissionsManageRules
@@ -432,7 +473,7 @@ func (a *AccountRolePermissions) UnmarshalJSON(b []byte) error {
 	AccountRolePermissionsManageCustomEmojis
 	// AccountRolePermissionsManageWebhooks is not used by GotoSocial.
 	AccountRolePermissionsManageWebhooks
-	// AccountRolePermissionsInviteUsers is not used by GotoSocial.
+	// AccountRolePermissionsInviteUsers indicates that the user can create invites, regardless of instance settings.
 	AccountRolePermissionsInviteUsers
 	// AccountRolePermissionsManageRoles is not used by GotoSocial.
 	AccountRolePermissionsManageRoles
@@ -451,13 +492,14 @@ func (a *AccountRolePermissions) UnmarshalJSON(b []byte) error {
 		AccountRolePermissionsManageSettings |
 		AccountRolePermissionsManageBlocks |
 		AccountRolePermissionsManageUsers |
+		AccountRolePermissionsManageInvites |
 		AccountRolePermissionsManageRules |
 		AccountRolePermissionsManageCustomEmojis |
+		AccountRolePermissionsInviteUsers |
 		AccountRolePermissionsDeleteUserData
 
 	// AccountRolePermissionsForModeratorRole includes all of the permissions assigned to GotoSocial's built-in moderator role.
-	// (Currently, there aren't any.)
-	AccountRolePermissionsForModeratorRole = AccountRolePermissionsNone
+	AccountRolePermissionsForModeratorRole = AccountRolePermissionsInviteUsers
 )
 
 // AccountNoteRequest models a request to update the private note for an account.
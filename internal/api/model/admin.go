@@ -132,6 +132,53 @@ type AdminReport struct {
 type AdminReportResolveRequest struct {
 	// Comment to show to the creator of the report when an admin marks it as resolved.
 	ActionTakenComment *string `form:"action_taken_comment" json:"action_taken_comment" xml:"action_taken_comment"`
+	// Forward the report to the instance of the (remote) reported account.
+	Forward bool `form:"forward" json:"forward" xml:"forward"`
+	// IDs of reported statuses to include in the forwarded report.
+	// If not set, all statuses attached to the report are included.
+	ForwardStatusIDs []string `form:"forward_status_ids[]" json:"forward_status_ids" xml:"forward_status_ids"`
+	// Comment to include in the forwarded report, in place
+	// of the reporter's original comment, eg., with any
+	// identifying details redacted. Empty string sends no
+	// comment. If not set, the original comment is sent.
+	ForwardComment *string `form:"forward_comment" json:"forward_comment" xml:"forward_comment"`
+}
+
+// AdminReportUpdateRequest can be submitted along with a PUT to /api/v1/admin/reports/{id}
+//
+// swagger:ignore
+type AdminReportUpdateRequest struct {
+	// New category for the report.
+	Category *string `form:"category" json:"category" xml:"category"`
+	// ID of the admin / moderator account to assign the report to.
+	// Empty string unassigns the report.
+	AssignedAccountID *string `form:"assigned_account_id" json:"assigned_account_id" xml:"assigned_account_id"`
+}
+
+// AdminReportNote models an internal note made by an admin
+// or moderator on a report, to coordinate handling it.
+//
+// swagger:model adminReportNote
+type AdminReportNote struct {
+	// ID of the note.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	ID string `json:"id"`
+	// The date when this note was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// The account that created the note.
+	Account *AdminAccountInfo `json:"account"`
+	// Content of the note.
+	// example: Looking into this, it seems to be part of a wider spam wave.
+	Content string `json:"content"`
+}
+
+// AdminReportNoteCreateRequest can be submitted along with a POST to /api/v1/admin/reports/{id}/notes
+//
+// swagger:ignore
+type AdminReportNoteCreateRequest struct {
+	// Content of the note.
+	Content string `form:"content" json:"content" xml:"content"`
 }
 
 // AdminEmoji models the admin view of a custom emoji.
@@ -221,6 +268,62 @@ type AdminInstanceRule struct {
 	Text      string `json:"text"`       // text content of the rule
 }
 
+// AdminInstance models a known remote instance,
+// as viewed by an admin of this instance.
+//
+// swagger:model adminInstance
+type AdminInstance struct {
+	// The ID of the instance.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	ID string `json:"id"`
+	// The hostname of the instance.
+	// example: example.org
+	Domain string `json:"domain"`
+	// Title of the instance, if known.
+	// example: Example Social
+	Title string `json:"title,omitempty"`
+	// Name of the software running on the instance,
+	// as reported by its nodeinfo, if known.
+	// example: mastodon
+	Software string `json:"software,omitempty"`
+	// Version of the software running on the instance, if known.
+	// example: 4.2.1
+	Version string `json:"version,omitempty"`
+	// Names of known federation quirks of the software
+	// running on the instance, which this instance works
+	// around when federating with it. Omitted if none.
+	// example: ["refetch_updated_statuses"]
+	Quirks []string `json:"quirks,omitempty"`
+	// Time at which the instance was first seen (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Time at which the instance was suspended (ISO 8601 Datetime).
+	// Omitted if the instance is not suspended.
+	// example: 2021-07-30T09:20:25+00:00
+	SuspendedAt string `json:"suspended_at,omitempty"`
+	// Time at which nodeinfo was last successfully
+	// fetched for the instance (ISO 8601 Datetime).
+	// Omitted if nodeinfo has never been fetched.
+	// example: 2021-07-30T09:20:25+00:00
+	NodeInfoFetchedAt string `json:"nodeinfo_fetched_at,omitempty"`
+	// Time at which the instance was marked as unreachable
+	// after repeated request failures (ISO 8601 Datetime).
+	// Deliveries to unreachable instances are skipped, save
+	// for occasional probes. Omitted if the instance is reachable.
+	// example: 2021-07-30T09:20:25+00:00
+	UnreachableAt string `json:"unreachable_at,omitempty"`
+	// Time after which the next probe delivery to this
+	// unreachable instance is permitted (ISO 8601 Datetime).
+	// Omitted if the instance is reachable.
+	// example: 2021-07-30T09:25:25+00:00
+	NextProbeAt string `json:"next_probe_at,omitempty"`
+	// Number of consecutive failed requests to this instance,
+	// as of when it was last marked or probed as unreachable.
+	// Omitted if the instance is reachable.
+	// example: 30
+	RequestFailures int `json:"request_failures,omitempty"`
+}
+
 // DebugAPUrlResponse provides detailed debug
 // information for an AP URL dereference request.
 //
@@ -240,6 +343,97 @@ type DebugAPUrlResponse struct {
 	ResponseBody string `json:"response_body"`
 }
 
+// DebugRetentionResponse provides debug information
+// about the size of data subject to retention settings.
+//
+// swagger:model debugRetentionResponse
+type DebugRetentionResponse struct {
+	// Total number of notifications stored in the database.
+	NotificationsTotal int `json:"notifications_total"`
+	// Number of stored notifications older than the configured
+	// max age, which will be deleted on the next prune.
+	NotificationsPrunable int `json:"notifications_prunable"`
+	// Configured notifications max age, as a duration
+	// string. "0s" means notifications are kept forever.
+	NotificationsMaxAge string `json:"notifications_max_age"`
+	// Number of home timelines currently cached in memory.
+	HomeTimelines int `json:"home_timelines"`
+	// Total number of entries across all cached home timelines.
+	HomeTimelineEntries int `json:"home_timeline_entries"`
+	// Configured maximum number of entries in any one cached home timeline.
+	HomeTimelineMaxEntries int `json:"home_timeline_max_entries"`
+}
+
+// DebugRuntimeResponse provides debug information about
+// the Go runtime and in-memory caches of this instance.
+//
+// swagger:model debugRuntimeResponse
+type DebugRuntimeResponse struct {
+	// Version of Go this instance was built with.
+	GoVersion string `json:"go_version"`
+	// Number of logical CPUs usable by this instance.
+	NumCPU int `json:"num_cpu"`
+	// Current GOMAXPROCS setting.
+	GOMAXPROCS int `json:"gomaxprocs"`
+	// Number of goroutines that currently exist.
+	Goroutines int `json:"goroutines"`
+	// Go runtime memory statistics.
+	Memory DebugRuntimeMemory `json:"memory"`
+	// Go garbage collector statistics.
+	GC DebugRuntimeGC `json:"gc"`
+	// Current length and capacity of
+	// in-memory caches, keyed by name.
+	Caches map[string]DebugRuntimeCache `json:"caches"`
+}
+
+// DebugRuntimeMemory provides Go runtime memory statistics.
+// All values are in bytes unless otherwise indicated.
+//
+// swagger:model debugRuntimeMemory
+type DebugRuntimeMemory struct {
+	// Bytes of allocated heap objects.
+	HeapAlloc uint64 `json:"heap_alloc"`
+	// Bytes in in-use heap spans.
+	HeapInuse uint64 `json:"heap_inuse"`
+	// Bytes of idle heap spans.
+	HeapIdle uint64 `json:"heap_idle"`
+	// Bytes of heap memory returned to the OS.
+	HeapReleased uint64 `json:"heap_released"`
+	// Number of allocated heap objects.
+	HeapObjects uint64 `json:"heap_objects"`
+	// Bytes in stack spans.
+	StackInuse uint64 `json:"stack_inuse"`
+	// Total bytes of memory obtained from the OS.
+	Sys uint64 `json:"sys"`
+}
+
+// DebugRuntimeGC provides Go garbage collector statistics.
+//
+// swagger:model debugRuntimeGC
+type DebugRuntimeGC struct {
+	// Number of completed GC cycles.
+	NumGC uint32 `json:"num_gc"`
+	// Target heap size of the next GC cycle, in bytes.
+	NextGC uint64 `json:"next_gc"`
+	// ISO8601 time the last GC cycle finished, if any.
+	LastGC string `json:"last_gc,omitempty"`
+	// Cumulative time spent in GC stop-the-world pauses, as a duration string.
+	PauseTotal string `json:"pause_total"`
+	// Fraction of available CPU time used by the GC since the program started.
+	CPUFraction float64 `json:"cpu_fraction"`
+}
+
+// DebugRuntimeCache provides the current
+// length and capacity of an in-memory cache.
+//
+// swagger:model debugRuntimeCache
+type DebugRuntimeCache struct {
+	// Number of entries currently in the cache.
+	Len int `json:"len"`
+	// Maximum number of entries in the cache.
+	Cap int `json:"cap"`
+}
+
 // AdminGetAccountsRequest models a request
 // to get an admin view of one or more
 // accounts using given parameters.
@@ -288,3 +482,29 @@ type AdminAccountRejectRequest struct {
 	// them that their sign-up has been rejected.
 	SendEmail bool `form:"send_email" json:"send_email"`
 }
+
+// AdminReadOnlyMode models the current state
+// of the instance's read-only maintenance mode.
+//
+// swagger:model adminReadOnlyMode
+type AdminReadOnlyMode struct {
+	// Whether read-only mode is currently enabled.
+	Enabled bool `json:"enabled"`
+	// Time at which read-only mode was enabled (ISO 8601 Datetime).
+	// Omitted if read-only mode is not enabled.
+	// example: 2021-07-30T09:20:25+00:00
+	Since string `json:"since,omitempty"`
+	// Number of seconds clients are asked to wait
+	// (via Retry-After) before retrying rejected requests.
+	// example: 300
+	RetryAfter int `json:"retry_after"`
+}
+
+// AdminReadOnlyModeRequest can be submitted along
+// with a POST to /api/v1/admin/read_only.
+//
+// swagger:ignore
+type AdminReadOnlyModeRequest struct {
+	// Enable (true) or disable (false) read-only mode.
+	Enabled *bool `form:"enabled" json:"enabled" xml:"enabled"`
+}
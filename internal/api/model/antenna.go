@@ -0,0 +1,65 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// Antenna represents a user-created set of rules, that incoming
+// public statuses are matched against to build a dynamic timeline.
+//
+// swagger:model antenna
+type Antenna struct {
+	// The ID of the antenna.
+	ID string `json:"id"`
+	// The user-defined title of the antenna.
+	Title string `json:"title"`
+	// Statuses must contain any of these keywords (case-insensitive).
+	Keywords []string `json:"keywords"`
+	// Statuses must contain none of these keywords (case-insensitive).
+	ExcludeKeywords []string `json:"exclude_keywords"`
+	// Status authors must be on any of these domains, or subdomains thereof.
+	Domains []string `json:"domains"`
+	// Status authors must match any of these 'username@domain'
+	// glob patterns, eg., `*@example.org` or `alice`.
+	AccountPatterns []string `json:"account_patterns"`
+	// Include replies in the antenna timeline.
+	WithReplies bool `json:"with_replies"`
+}
+
+// AntennaCreateRequest models antenna creation parameters.
+//
+// swagger:ignore
+type AntennaCreateRequest struct {
+	Title           string   `form:"title" json:"title" xml:"title"`
+	Keywords        []string `form:"keywords[]" json:"keywords" xml:"keywords"`
+	ExcludeKeywords []string `form:"exclude_keywords[]" json:"exclude_keywords" xml:"exclude_keywords"`
+	Domains         []string `form:"domains[]" json:"domains" xml:"domains"`
+	AccountPatterns []string `form:"account_patterns[]" json:"account_patterns" xml:"account_patterns"`
+	WithReplies     bool     `form:"with_replies" json:"with_replies" xml:"with_replies"`
+}
+
+// AntennaUpdateRequest models antenna update parameters.
+// The rule sets of the antenna are replaced wholesale.
+//
+// swagger:ignore
+type AntennaUpdateRequest struct {
+	Title           *string  `form:"title" json:"title" xml:"title"`
+	Keywords        []string `form:"keywords[]" json:"keywords" xml:"keywords"`
+	ExcludeKeywords []string `form:"exclude_keywords[]" json:"exclude_keywords" xml:"exclude_keywords"`
+	Domains         []string `form:"domains[]" json:"domains" xml:"domains"`
+	AccountPatterns []string `form:"account_patterns[]" json:"account_patterns" xml:"account_patterns"`
+	WithReplies     *bool    `form:"with_replies" json:"with_replies" xml:"with_replies"`
+}
@@ -167,6 +167,38 @@ type MediaMeta struct {
 	Small MediaDimensions `json:"small,omitempty"`
 	// Focus data for the media.
 	Focus *MediaFocus `json:"focus,omitempty"`
+	// Preview frame sprite sheet for video media, if generated.
+	Sprite *MediaSprite `json:"sprite,omitempty"`
+}
+
+// MediaSprite models a sprite sheet of preview frames for a video,
+// for use in showing previews when scrubbing through the video.
+// Frames are evenly spaced through the video's duration, and are
+// tiled left-to-right, top-to-bottom in a grid in the sprite sheet.
+//
+// swagger:model mediaSprite
+type MediaSprite struct {
+	// URL of the sprite sheet image.
+	// example: https://example.org/fileserver/some_id/attachment/sprite/some_id.webp
+	URL string `json:"url"`
+	// Number of frames in the sprite sheet.
+	// example: 50
+	Frames int `json:"frames"`
+	// Number of columns of frames in the sprite sheet.
+	// example: 10
+	Columns int `json:"columns"`
+	// Number of rows of frames in the sprite sheet.
+	// example: 5
+	Rows int `json:"rows"`
+	// Width of each frame in pixels.
+	// example: 160
+	Width int `json:"width"`
+	// Height of each frame in pixels.
+	// example: 90
+	Height int `json:"height"`
+	// Interval in seconds between each frame in the video.
+	// example: 2.5
+	Interval float32 `json:"interval"`
 }
 
 // MediaFocus models the focal point of a piece of media.
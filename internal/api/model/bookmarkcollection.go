@@ -0,0 +1,37 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// BookmarkCollection represents a named
+// collection (folder) of bookmarked statuses.
+//
+// swagger:model bookmarkCollection
+type BookmarkCollection struct {
+	// The ID of the collection.
+	ID string `json:"id"`
+	// The user-defined title of the collection.
+	Title string `json:"title"`
+}
+
+// BookmarkCollectionRequest models bookmark collection
+// creation and update parameters.
+//
+// swagger:ignore
+type BookmarkCollectionRequest struct {
+	Title string `form:"title" json:"title" xml:"title"`
+}
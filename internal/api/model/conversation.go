@@ -34,4 +34,18 @@ type Conversation struct {
 	Accounts []Account `json:"accounts"`
 	// The last status in the conversation. May be `null`.
 	LastStatus *Status `json:"last_status"`
+	// ID of the most recent status in the conversation that
+	// has been marked as read, if any. Clients can use this
+	// to sync read position in the conversation between them.
+	LastReadID string `json:"last_read_id,omitempty"`
+}
+
+// ConversationReadRequest models a request
+// to mark a conversation as read.
+//
+// swagger:ignore
+type ConversationReadRequest struct {
+	// ID of the most recent status in the conversation
+	// that has been read. Defaults to the last status.
+	LastReadID string `form:"last_read_id" json:"last_read_id"`
 }
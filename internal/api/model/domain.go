@@ -43,6 +43,14 @@ type Domain struct {
 	// Severity of this entry.
 	// Only ever set for domain blocks, and if set, always="suspend".
 	Severity string `form:"severity" json:"severity,omitempty"`
+	// Name of the software running on this domain, as reported by its nodeinfo.
+	// Only set for open peers, when this instance exposes peer metadata.
+	// example: mastodon
+	Software string `json:"software,omitempty"`
+	// Version of the software running on this domain, as reported by its nodeinfo.
+	// Only set for open peers, when this instance exposes peer metadata.
+	// example: 4.2.1
+	Version string `json:"version,omitempty"`
 }
 
 // DomainPermission represents a permission applied to one domain (explicit block/allow).
@@ -214,6 +222,144 @@ type DomainLimitRequest struct {
 	// Privately stated reason
 	// for limiting the domain.
 	PrivateComment *string `json:"private_comment" form:"private_comment"`
+
+	// Content warning of a sample status,
+	// to preview how the limit would rewrite
+	// it. Only used when testing a limit.
+	SampleContentWarning *string `json:"sample_content_warning" form:"sample_content_warning"`
+}
+
+// DomainLimitTest represents what would happen if a domain
+// limit with the given policies were created for a domain,
+// taking into account existing limits and blocks.
+//
+// swagger:model domainLimitTest
+type DomainLimitTest struct {
+	// The tested domain, normalized as it would be stored.
+	// example: example.org
+	Domain string `json:"domain"`
+	// Whether creating a limit for the domain would take effect.
+	// False if a limit already exists for the domain itself, if a
+	// limit on a parent domain already covers it (parent domain
+	// limits take precedence), or if the domain is blocked.
+	// example: true
+	WouldApply bool `json:"would_apply"`
+	// Existing domain limit currently covering the tested domain,
+	// either on the domain itself or on a parent domain.
+	// Omitted if the domain is not currently limited.
+	MatchedLimit *DomainLimit `json:"matched_limit,omitempty"`
+	// Existing domain limits on subdomains of the tested domain,
+	// which would no longer take effect if the limit were created.
+	SupersededLimits []*DomainLimit `json:"superseded_limits"`
+	// Known instances (the domain itself and/or its subdomains)
+	// that the limit would cover.
+	// example: ["example.org","social.example.org"]
+	CoveredDomains []string `json:"covered_domains"`
+	// Whether the tested domain is currently blocked. Domain limits
+	// have no effect on blocked domains while the block is in place.
+	// example: false
+	Blocked bool `json:"blocked"`
+	// Human-readable descriptions of any conflicts
+	// or surprises that the limit would run into.
+	// example: ["existing limit on example.org already covers social.example.org, and takes precedence"]
+	Conflicts []string `json:"conflicts"`
+	// Preview of how the limit would rewrite the
+	// content warning of a status from the domain.
+	ContentWarningPreview DomainLimitContentWarningPreview `json:"content_warning_preview"`
+}
+
+// DomainLimitContentWarningPreview shows how a domain limit
+// would rewrite the content warning of a sample status from
+// the limited domain, when the status is shown to users.
+//
+// swagger:model domainLimitContentWarningPreview
+type DomainLimitContentWarningPreview struct {
+	// Content warning of the sample status, as given.
+	// example: spoilers for the new episode
+	Original string `json:"original"`
+	// Content warning of the sample status as it would be
+	// shown to users, with the limit's content warning
+	// prepended to the original content warning (if any).
+	// example: potentially annoying post ahead; spoilers for the new episode
+	Rewritten string `json:"rewritten"`
+	// Whether the sample status would be marked as sensitive,
+	// either because it would have a content warning, or
+	// because the limit marks media from the domain sensitive.
+	// example: true
+	Sensitive bool `json:"sensitive"`
+}
+
+// DomainHierarchyNode represents one blocked and/or limited domain
+// in a tree of blocked and limited domains, where each node's
+// children are blocked and/or limited subdomains of that node.
+//
+// swagger:model domainHierarchyNode
+type DomainHierarchyNode struct {
+	// The hostname of the domain.
+	// example: sub.example.org
+	Domain string `json:"domain"`
+	// ID of the domain block for this domain.
+	// Omitted if the domain is not explicitly blocked.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	BlockID string `json:"block_id,omitempty"`
+	// Domain of the block that shadows this domain's block,
+	// making it redundant. Omitted if not shadowed.
+	// example: example.org
+	BlockShadowedBy string `json:"block_shadowed_by,omitempty"`
+	// ID of the domain limit for this domain.
+	// Omitted if the domain is not explicitly limited.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	LimitID string `json:"limit_id,omitempty"`
+	// Domain of the limit or block that shadows this domain's
+	// limit, making it ineffective. Omitted if not shadowed.
+	// example: example.org
+	LimitShadowedBy string `json:"limit_shadowed_by,omitempty"`
+	// Blocked and/or limited subdomains of this domain.
+	Children []*DomainHierarchyNode `json:"children,omitempty"`
+}
+
+// DomainLimitSuggestion represents a domain limit suggested by a
+// (block) domain permission subscription, pending admin approval.
+//
+// swagger:model domainLimitSuggestion
+type DomainLimitSuggestion struct {
+
+	// The ID of the domain limit suggestion.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	ID string `json:"id"`
+
+	// The hostname of the domain.
+	// example: example.org
+	Domain string `json:"domain"`
+
+	// ID of the subscription that suggested this domain limit.
+	// example: 01FBW25TF5J67JW3HFHZCSD23K
+	SubscriptionID string `json:"subscription_id"`
+
+	// Suggested policy to apply to media files originating from the domain.
+	MediaPolicy MediaPolicy `json:"media_policy"`
+
+	// Suggested policy to apply to follow (requests) originating from the domain.
+	FollowsPolicy FollowsPolicy `json:"follows_policy"`
+
+	// Suggested policy to apply to statuses of non-followed accounts on the domain.
+	StatusesPolicy StatusesPolicy `json:"statuses_policy"`
+
+	// Suggested policy to apply to non-followed accounts on the domain.
+	AccountsPolicy AccountsPolicy `json:"accounts_policy"`
+
+	// Publicly stated reason for the suggestion, as given by the subscribed list.
+	// Omitted if not set.
+	// example: they smell
+	PublicComment *string `json:"public_comment,omitempty"`
+
+	// Time at which the suggestion was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+
+	// Time at which the suggestion was last updated (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	UpdatedAt string `json:"updated_at"`
 }
 
 // DomainPermissionRequest is the form submitted as a POST to create a new domain permission entry (allow/block).
@@ -242,6 +388,50 @@ type DomainPermissionRequest struct {
 	PermissionType string `form:"permission_type" json:"permission_type"`
 }
 
+// DomainImpact represents the impact that a remote domain has
+// on this instance, to help evaluate a domain block or limit.
+//
+// swagger:model domainImpact
+type DomainImpact struct {
+	// The domain, including its subdomains.
+	// example: example.org
+	Domain string `json:"domain"`
+	// Number of known accounts on the domain.
+	// example: 120
+	AccountsCount int `json:"accounts_count"`
+	// Number of local accounts following at
+	// least one account on the domain.
+	// example: 8
+	LocalFollowersCount int `json:"local_followers_count"`
+	// Number of accounts on the domain following
+	// at least one local account.
+	// example: 15
+	RemoteFollowersCount int `json:"remote_followers_count"`
+	// Number of statuses stored from the domain.
+	// example: 4800
+	StatusesCount int `json:"statuses_count"`
+	// Number of media attachments currently
+	// cached locally from the domain.
+	// example: 350
+	MediaCount int `json:"media_count"`
+	// Total size in bytes of media attachments
+	// currently cached locally from the domain.
+	// example: 73400320
+	MediaSize int `json:"media_size"`
+	// Number of faves, boosts, and replies by local accounts
+	// of statuses from the domain, in the last 30 days.
+	// example: 42
+	RecentInteractionsFromLocal int `json:"recent_interactions_from_local"`
+	// Number of faves, boosts, and replies by accounts on
+	// the domain of local statuses, in the last 30 days.
+	// example: 97
+	RecentInteractionsToLocal int `json:"recent_interactions_to_local"`
+	// Time at which these figures were computed (ISO 8601 Datetime).
+	// Figures are cached for a few minutes.
+	// example: 2021-07-30T09:20:25+00:00
+	ComputedAt string `json:"computed_at"`
+}
+
 // DomainKeysExpireRequest is the form submitted as a POST to /api/v1/admin/domain_keys_expire to expire a domain's public keys.
 //
 // swagger:parameters domainKeysExpire
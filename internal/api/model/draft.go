@@ -0,0 +1,93 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// Draft represents a partially composed status,
+// saved server-side so that it syncs across clients.
+//
+// swagger:model draft
+type Draft struct {
+	// The ID of the draft.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	ID string `json:"id"`
+
+	// When the draft was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+
+	// When the draft was last updated (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	UpdatedAt string `json:"updated_at"`
+
+	// Parameters of the status to be created from this draft.
+	Params *DraftParams `json:"params"`
+
+	// Media attached to this draft.
+	MediaAttachments []*Attachment `json:"media_attachments"`
+}
+
+// DraftParams represents the saved parameters of a draft.
+type DraftParams struct {
+	Text        string                     `json:"text"`
+	MediaIDs    []string                   `json:"media_ids,omitempty"`
+	Sensitive   bool                       `json:"sensitive,omitempty"`
+	Poll        *ScheduledStatusParamsPoll `json:"poll,omitempty"`
+	SpoilerText string                     `json:"spoiler_text,omitempty"`
+	Visibility  Visibility                 `json:"visibility,omitempty"`
+	InReplyToID string                     `json:"in_reply_to_id,omitempty"`
+	Language    string                     `json:"language,omitempty"`
+	LocalOnly   bool                       `json:"local_only,omitempty"`
+	ContentType StatusContentType          `json:"content_type,omitempty"`
+}
+
+// DraftRequest models a request to create or update a draft.
+// All fields are optional, and are only validated fully
+// when the draft is published as a status.
+//
+// swagger:ignore
+type DraftRequest struct {
+	// Text content of the draft.
+	Status string `form:"status" json:"status"`
+
+	// Array of Attachment ids to be attached as media.
+	MediaIDs []string `form:"media_ids[]" json:"media_ids"`
+
+	// Poll to include with the status.
+	Poll *PollRequest `form:"poll" json:"poll"`
+
+	// ID of the status being replied to, if status is a reply.
+	InReplyToID string `form:"in_reply_to_id" json:"in_reply_to_id"`
+
+	// Status and attached media should be marked as sensitive.
+	Sensitive bool `form:"sensitive" json:"sensitive"`
+
+	// Text to be shown as a warning or subject before the actual content.
+	SpoilerText string `form:"spoiler_text" json:"spoiler_text"`
+
+	// Visibility of the posted status.
+	Visibility Visibility `form:"visibility" json:"visibility"`
+
+	// If set to true, this status will be "local only" and will NOT be federated beyond the local timeline(s).
+	LocalOnly *bool `form:"local_only" json:"local_only"`
+
+	// ISO 639 language code for this status.
+	Language string `form:"language" json:"language"`
+
+	// Content type to use when parsing this status.
+	ContentType StatusContentType `form:"content_type" json:"content_type"`
+}
@@ -38,6 +38,12 @@ type Emoji struct {
 	// Used for sorting custom emoji in the picker.
 	// example: blobcats
 	Category string `json:"category,omitempty"`
+	// License under which the emoji is made available, if set.
+	// example: CC-BY-SA-4.0
+	License string `json:"license,omitempty"`
+	// Attribution / credit for the creator(s) of the emoji, if set.
+	// example: blobcat by Example Artist
+	Attribution string `json:"attribution,omitempty"`
 }
 
 // EmojiCreateRequest represents a request to create a custom emoji made through the admin API.
@@ -52,6 +58,12 @@ type EmojiCreateRequest struct {
 	// Category in which to place the new emoji. Will be uncategorized by default.
 	// CategoryName length should not exceed 64 characters.
 	CategoryName string `form:"category"`
+	// License under which the emoji is made available.
+	// License length should not exceed 255 characters.
+	License string `form:"license"`
+	// Attribution / credit for the creator(s) of the emoji.
+	// Attribution length should not exceed 500 characters.
+	Attribution string `form:"attribution"`
 }
 
 // EmojiUpdateRequest represents a request to update a custom emoji, made through the admin API.
@@ -68,6 +80,10 @@ type EmojiUpdateRequest struct {
 	Image *multipart.FileHeader `form:"image"`
 	// Category in which to place the emoji.
 	CategoryName *string `form:"category"`
+	// License under which the emoji is made available.
+	License *string `form:"license"`
+	// Attribution / credit for the creator(s) of the emoji.
+	Attribution *string `form:"attribution"`
 }
 
 // EmojiUpdateType models an admin update action to take on a custom emoji.
@@ -78,3 +94,30 @@ type EmojiUpdateRequest struct {
 	EmojiUpdateDisable EmojiUpdateType = "disable" // disable remote emoji
 	EmojiUpdateCopy    EmojiUpdateType = "copy"    // copy remote emoji -> local
 )
+
+// EmojiPackImportRequest represents a request to import a
+// zipped emoji pack in Pleroma pack format, made through
+// the admin API.
+//
+// swagger:ignore
+type EmojiPackImportRequest struct {
+	// Zip file of the emoji pack, containing a pack.json
+	// mapping shortcodes to image files in the archive.
+	File *multipart.FileHeader `form:"file" validation:"required"`
+	// Category in which to place the imported emojis.
+	// Defaults to the name of the uploaded file.
+	CategoryName string `form:"category"`
+}
+
+// EmojiPackImportResult models the result of
+// importing an emoji pack through the admin API.
+//
+// swagger:model emojiPackImportResult
+type EmojiPackImportResult struct {
+	// Emojis that were successfully imported from the pack.
+	Imported []*Emoji `json:"imported"`
+	// Shortcodes of emojis in the pack that were not
+	// imported, mapped to the reason they were skipped.
+	// example: {"blobcat": "Conflict: emoji with shortcode already exists"}
+	Skipped map[string]string `json:"skipped"`
+}
@@ -21,6 +21,10 @@
 //
 // swagger:model field
 type Field struct {
+	// Stable ID of this field, which can be used to
+	// reorder fields. Only set for local accounts.
+	// example: 01JBQXVA3TV3SVKE5BKR5RRQY2
+	ID string `json:"id,omitempty"`
 	// The key/name of this field.
 	// example: pronouns
 	Name string `json:"name"`
@@ -0,0 +1,53 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// FollowRequestRules represents rules set by the requesting
+// account for automatically approving or rejecting incoming
+// follow requests, before the account is notified of them.
+//
+// swagger:model followRequestRules
+type FollowRequestRules struct {
+	// Automatically approve follow requests from accounts
+	// that follow at least one of your followers.
+	ApproveFollowersOfFollowers bool `json:"approve_followers_of_followers"`
+	// Automatically approve follow requests from accounts
+	// on any of these domains (or subdomains of them).
+	ApproveDomains []string `json:"approve_domains"`
+	// Automatically reject follow requests from accounts
+	// on domains that have a domain limit in place.
+	RejectLimited bool `json:"reject_limited"`
+}
+
+// UpdateFollowRequestRulesRequest models a request to
+// update the follow request rules of the requester.
+//
+// Fields left unset will not be changed.
+//
+// swagger:ignore
+type UpdateFollowRequestRulesRequest struct {
+	// Automatically approve follow requests from accounts
+	// that follow at least one of your followers.
+	ApproveFollowersOfFollowers *bool `form:"approve_followers_of_followers" json:"approve_followers_of_followers"`
+	// Automatically approve follow requests from accounts
+	// on any of these domains (or subdomains of them).
+	ApproveDomains *[]string `form:"approve_domains[]" json:"approve_domains"`
+	// Automatically reject follow requests from accounts
+	// on domains that have a domain limit in place.
+	RejectLimited *bool `form:"reject_limited" json:"reject_limited"`
+}
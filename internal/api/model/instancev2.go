@@ -166,7 +166,6 @@ type InstanceV2URLs struct {
 // swagger:model instanceV2ConfigurationTranslation
 type InstanceV2ConfigurationTranslation struct {
 	// Whether the Translations API is available on this instance.
-	// Not implemented so this value is always false.
 	Enabled bool `json:"enabled"`
 }
 
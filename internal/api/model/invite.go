@@ -0,0 +1,82 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// Invite models an invite link which can be
+// used to sign up to this instance, even when
+// open registration is disabled.
+//
+// swagger:model invite
+type Invite struct {
+	// The ID of the invite.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	// readonly: true
+	ID string `json:"id"`
+
+	// Code used to redeem this invite.
+	// example: 3XM6BQKUEXG2V3ARDXMRLOCPWM
+	// readonly: true
+	Code string `json:"code"`
+
+	// Sign-up URL for this invite, which can be shared with invitees.
+	// example: https://example.org/signup?invite=3XM6BQKUEXG2V3ARDXMRLOCPWM
+	// readonly: true
+	URL string `json:"url"`
+
+	// The ID of the account that created this invite.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	// readonly: true
+	CreatedBy string `json:"created_by"`
+
+	// Time at which the invite was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	// readonly: true
+	CreatedAt string `json:"created_at"`
+
+	// Time at which the invite expires (ISO 8601 Datetime), if set.
+	// example: 2021-08-30T09:20:25+00:00
+	// readonly: true
+	ExpiresAt *string `json:"expires_at"`
+
+	// Number of times this invite has been used to sign up.
+	// example: 2
+	// readonly: true
+	Uses int `json:"uses"`
+
+	// Maximum number of times this invite can be used, if set.
+	// example: 10
+	// readonly: true
+	MaxUses *int `json:"max_uses"`
+
+	// Invite can still be used to sign up.
+	// readonly: true
+	Usable bool `json:"usable"`
+}
+
+// InviteCreateRequest is the form submitted as a POST to create a new invite.
+//
+// swagger:ignore
+type InviteCreateRequest struct {
+	// Maximum number of times the invite can
+	// be used. If not set, uses are unlimited.
+	MaxUses *int `form:"max_uses" json:"max_uses"`
+
+	// Number of seconds from now until the invite
+	// expires. If not set, the invite never expires.
+	ExpiresIn *int `form:"expires_in" json:"expires_in"`
+}
@@ -0,0 +1,109 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// AdminIPRule models an admin-managed rule about
+// sign ups / sign ins from a range of IP addresses.
+//
+// swagger:model adminIPRule
+type AdminIPRule struct {
+	// The ID of the IP rule.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	// readonly: true
+	ID string `json:"id"`
+
+	// The IP address range this rule applies to, in CIDR notation.
+	// example: 192.0.2.0/24
+	IP string `json:"ip"`
+
+	// What this rule does to matching sign up / sign in attempts.
+	//
+	// `sign_up_allow`: explicitly allow sign ups, overriding any matching `sign_up_block` rules.
+	// `sign_up_block`: block sign ups.
+	// `no_access`: block both sign ups and sign ins.
+	// enum:
+	//   - sign_up_allow
+	//   - sign_up_block
+	//   - no_access
+	// example: sign_up_block
+	Severity string `json:"severity"`
+
+	// Private comment on this rule, visible to admins.
+	// example: lots of spam signups from this range
+	Comment string `json:"comment"`
+
+	// The ID of the admin account that created this rule.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	// readonly: true
+	CreatedBy string `json:"created_by"`
+
+	// Time at which the rule was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	// readonly: true
+	CreatedAt string `json:"created_at"`
+
+	// Time at which the rule expires (ISO 8601 Datetime), if set.
+	// example: 2021-08-30T09:20:25+00:00
+	ExpiresAt *string `json:"expires_at"`
+
+	// Number of sign up / sign in attempts blocked by this rule.
+	// example: 5
+	// readonly: true
+	Hits int `json:"hits"`
+
+	// Time at which this rule last blocked an attempt (ISO 8601 Datetime), if ever.
+	// example: 2021-08-01T09:20:25+00:00
+	// readonly: true
+	LastHitAt *string `json:"last_hit_at"`
+}
+
+// AdminIPRuleCreateRequest is the form submitted as a POST to create a new IP rule.
+//
+// swagger:ignore
+type AdminIPRuleCreateRequest struct {
+	// IP address or range in CIDR notation.
+	IP string `form:"ip" json:"ip"`
+
+	// Severity of the rule.
+	Severity string `form:"severity" json:"severity"`
+
+	// Private comment on the rule.
+	Comment string `form:"comment" json:"comment"`
+
+	// Number of seconds from now until the rule
+	// expires. If not set, the rule never expires.
+	ExpiresIn *int `form:"expires_in" json:"expires_in"`
+}
+
+// AdminIPRuleUpdateRequest is the form submitted as a PUT to update an existing IP rule.
+//
+// swagger:ignore
+type AdminIPRuleUpdateRequest struct {
+	// IP address or range in CIDR notation.
+	IP *string `form:"ip" json:"ip"`
+
+	// Severity of the rule.
+	Severity *string `form:"severity" json:"severity"`
+
+	// Private comment on the rule.
+	Comment *string `form:"comment" json:"comment"`
+
+	// Number of seconds from now until the
+	// rule expires. 0 removes the expiry.
+	ExpiresIn *int `form:"expires_in" json:"expires_in"`
+}
@@ -0,0 +1,86 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// LandingBlock models an admin-managed block of
+// content shown on the instance landing page.
+//
+// swagger:model landingBlock
+type LandingBlock struct {
+	// The ID of the landing block.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	// readonly: true
+	ID string `json:"id"`
+
+	// Plaintext heading of the block, if set.
+	// example: Who we are
+	Title string `json:"title"`
+
+	// HTML content of the block, rendered from text.
+	// example: <p>We're a small instance for <strong>gardeners</strong>.</p>
+	// readonly: true
+	Content string `json:"content"`
+
+	// Markdown text of the block.
+	// example: We're a small instance for **gardeners**.
+	Text string `json:"text"`
+
+	// Position of the block on the landing page.
+	// Blocks are shown lowest position first.
+	// example: 0
+	Position int `json:"position"`
+
+	// Time at which the block was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	// readonly: true
+	CreatedAt string `json:"created_at"`
+
+	// Time at which the block was last updated (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	// readonly: true
+	UpdatedAt string `json:"updated_at"`
+}
+
+// LandingBlockCreateRequest is the form submitted as a POST to create a new landing block.
+//
+// swagger:ignore
+type LandingBlockCreateRequest struct {
+	// Plaintext heading of the block.
+	Title string `form:"title" json:"title"`
+
+	// Markdown text of the block.
+	Text string `form:"text" json:"text"`
+
+	// Position of the block on the page. If not
+	// set, the block is placed after existing blocks.
+	Position *int `form:"position" json:"position"`
+}
+
+// LandingBlockUpdateRequest is the form submitted as a PATCH to update an existing landing block.
+//
+// swagger:ignore
+type LandingBlockUpdateRequest struct {
+	// Plaintext heading of the block.
+	Title *string `form:"title" json:"title"`
+
+	// Markdown text of the block.
+	Text *string `form:"text" json:"text"`
+
+	// Position of the block on the page.
+	Position *int `form:"position" json:"position"`
+}
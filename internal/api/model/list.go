@@ -89,4 +89,38 @@ type ListUpdateRequest struct {
 // swagger:ignore
 type ListAccountsChangeRequest struct {
 	AccountIDs []string `form:"account_ids[]" json:"account_ids" xml:"account_ids"`
+	// Only used when adding accounts to a list,
+	// see ListEntryUpdateRequest for details.
+	ShowReblogs   *bool   `form:"show_reblogs" json:"show_reblogs" xml:"show_reblogs"`
+	RepliesPolicy *string `form:"replies_policy" json:"replies_policy" xml:"replies_policy"`
+}
+
+// ListEntry represents the membership of one account
+// in a list, including any per-member overrides.
+//
+// swagger:model listEntry
+type ListEntry struct {
+	// The ID of the member account.
+	AccountID string `json:"account_id"`
+	// Show boosts by this member in the list timeline.
+	ShowReblogs bool `json:"show_reblogs"`
+	// RepliesPolicy override for this member.
+	// If null, the list's own replies policy applies.
+	//	followed = Show replies to any followed user
+	//	list = Show replies to members of the list
+	//	none = Show replies to no one
+	RepliesPolicy *string `json:"replies_policy"`
+}
+
+// ListEntryUpdateRequest models list entry update parameters.
+//
+// swagger:ignore
+type ListEntryUpdateRequest struct {
+	// Show boosts by this member in the list timeline.
+	// in: formData
+	ShowReblogs *bool `form:"show_reblogs" json:"show_reblogs" xml:"show_reblogs"`
+	// RepliesPolicy override for this member.
+	// Empty string clears the override.
+	// in: formData
+	RepliesPolicy *string `form:"replies_policy" json:"replies_policy" xml:"replies_policy"`
 }
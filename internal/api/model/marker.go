@@ -17,15 +17,15 @@
 
 package model
 
-// Marker represents the last read position within a user's timelines.
+// Marker represents the last read position within a user's timelines,
+// keyed by timeline name. Timelines for which no marker has been set
+// are not included.
+//
+// Possible keys are `home`, `notifications`, `public`, `local`,
+// `list:<list_id>`, and `tag:<tag_id>`.
 //
 // swagger:model markers
-type Marker struct {
-	// Information about the user's position in the home timeline.
-	Home *TimelineMarker `json:"home,omitempty"`
-	// Information about the user's position in their notifications.
-	Notifications *TimelineMarker `json:"notifications,omitempty"`
-}
+type Marker map[MarkerName]*TimelineMarker
 
 // TimelineMarker contains information about a user's progress through a specific timeline.
 type TimelineMarker struct {
@@ -43,37 +43,24 @@ type TimelineMarker struct {
 const (
 	MarkerNameHome          MarkerName = "home"
 	MarkerNameNotifications MarkerName = "notifications"
-	MarkerNameNumValues                = 2
+	MarkerNamePublic        MarkerName = "public"
+	MarkerNameLocal         MarkerName = "local"
+
+	// Prefixes for markers of list and tag timelines,
+	// which are followed by the list or tag ID. e.g.
+	// "list:01F8MH82FYRXD2RC6108DAJ5HB".
+	MarkerNameListPrefix = "list:"
+	MarkerNameTagPrefix  = "tag:"
 )
 
-// MarkerPostRequest models a request to update one or more markers.
-// This has two sets of fields to support a goofy nested map structure in both form data and JSON bodies.
+// MarkerPostRequest models a JSON request to update one or more markers,
+// keyed by timeline name. Form data requests instead use keys of the form
+// `<timeline name>[last_read_id]`, and are parsed into this same model.
 //
 // swagger:ignore
-type MarkerPostRequest struct {
-	Home                        *MarkerPostRequestMarker `json:"home"`
-	FormHomeLastReadID          string                   `form:"home[last_read_id]"`
-	Notifications               *MarkerPostRequestMarker `json:"notifications"`
-	FormNotificationsLastReadID string                   `form:"notifications[last_read_id]"`
-}
+type MarkerPostRequest map[MarkerName]*MarkerPostRequestMarker
 
 type MarkerPostRequestMarker struct {
 	// The ID of the most recently viewed entity.
 	LastReadID string `json:"last_read_id"`
 }
-
-// HomeLastReadID should be used instead of Home or FormHomeLastReadID.
-func (r *MarkerPostRequest) HomeLastReadID() string {
-	if r.Home != nil {
-		return r.Home.LastReadID
-	}
-	return r.FormHomeLastReadID
-}
-
-// NotificationsLastReadID should be used instead of Notifications or FormNotificationsLastReadID.
-func (r *MarkerPostRequest) NotificationsLastReadID() string {
-	if r.Notifications != nil {
-		return r.Notifications.LastReadID
-	}
-	return r.FormNotificationsLastReadID
-}
@@ -34,6 +34,8 @@ type Notification struct {
 	// 	poll = A poll you have voted in or created has ended. `status` will be set. `account` will be set.
 	// 	status = Someone you enabled notifications for has posted a status. `status` will be set. `account` will be set.
 	// 	admin.sign_up = Someone has signed up for a new account on the instance. `account` will be set.
+	// 	poll.milestone = A poll you created has reached a milestone number of voters. `status` will be set. `account` will be the poll author.
+	// 	move = An account you followed has moved to a new account, and you have been made to follow the new account instead. `account` will be the account that moved, with `moved` set to the new account.
 	Type string `json:"type"`
 	// The timestamp of the notification (ISO 8601 Datetime)
 	CreatedAt string `json:"created_at"`
@@ -46,6 +48,61 @@ type Notification struct {
 	Status *Status `json:"status,omitempty"`
 }
 
+// NotificationGroup represents a group of notifications of
+// the same type, eg., favourites or boosts of the same status,
+// or follows, received within a short window of time.
+//
+// swagger:model notificationGroup
+type NotificationGroup struct {
+	// Key identifying this notification group.
+	// Notifications which do not belong to any group
+	// are returned in a group of their own, with a key
+	// of the form `ungrouped-<notification id>`.
+	GroupKey string `json:"group_key"`
+	// Total number of notifications in this group.
+	NotificationsCount int `json:"notifications_count"`
+	// The type of event that resulted in the notifications
+	// in this group. Same values as for notification type.
+	Type string `json:"type"`
+	// ID of the most recent notification in this group.
+	MostRecentNotificationID string `json:"most_recent_notification_id"`
+	// ID of the oldest notification of this group in the current page.
+	PageMinID string `json:"page_min_id,omitempty"`
+	// ID of the newest notification of this group in the current page.
+	PageMaxID string `json:"page_max_id,omitempty"`
+	// Timestamp of the newest notification of this group in the current page (ISO 8601 Datetime).
+	LatestPageNotificationAt string `json:"latest_page_notification_at,omitempty"`
+	// IDs of some of the accounts that performed the actions which generated
+	// notifications in this group, newest first. Accounts are included
+	// in the `accounts` field of the grouped notifications results.
+	SampleAccountIDs []string `json:"sample_account_ids"`
+	// ID of the status that was the object of the notifications in this
+	// group, if any. Status is included in the `statuses` field of the
+	// grouped notifications results.
+	StatusID string `json:"status_id,omitempty"`
+}
+
+// GroupedNotificationsResults represents a page of notification
+// groups, along with the accounts and statuses referenced by them.
+//
+// swagger:model groupedNotificationsResults
+type GroupedNotificationsResults struct {
+	// Accounts referenced by the notification groups.
+	Accounts []*Account `json:"accounts"`
+	// Statuses referenced by the notification groups.
+	Statuses []*Status `json:"statuses"`
+	// Notification groups, newest first.
+	NotificationGroups []*NotificationGroup `json:"notification_groups"`
+}
+
+// GroupedNotificationsResponse wraps grouped notifications results,
+// ready to be serialized, along with the Link header for the previous
+// and next queries, to be returned to the client.
+type GroupedNotificationsResponse struct {
+	Results    *GroupedNotificationsResults
+	LinkHeader string
+}
+
 /*
 	The below functions are added onto the apimodel notification so that it satisfies
 	the Timelineable interface in internal/timeline.
@@ -0,0 +1,60 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// OEmbed represents an oEmbed response for
+// a status, suitable for embedding the status
+// on another website. See https://oembed.com/
+//
+// swagger:model oEmbed
+type OEmbed struct {
+	// The oEmbed resource type.
+	// Always "rich" for statuses.
+	// example: rich
+	Type string `json:"type"`
+	// The oEmbed version number.
+	// example: 1.0
+	Version string `json:"version"`
+	// Title for the embedded resource.
+	// example: example person!! (@example_account@example.org)
+	Title string `json:"title,omitempty"`
+	// Name of the status author.
+	// example: example person!!
+	AuthorName string `json:"author_name"`
+	// Link to the profile of the status author.
+	// example: https://example.org/@example_account
+	AuthorURL string `json:"author_url"`
+	// Name of this instance.
+	// example: example.org
+	ProviderName string `json:"provider_name"`
+	// Link to this instance.
+	// example: https://example.org/
+	ProviderURL string `json:"provider_url"`
+	// Suggested cache lifetime for
+	// this resource, in seconds.
+	// example: 86400
+	CacheAge int `json:"cache_age"`
+	// HTML iframe element for embedding the status.
+	HTML string `json:"html"`
+	// Width of the iframe in pixels.
+	// example: 400
+	Width int `json:"width"`
+	// Height of the iframe in pixels,
+	// or null to let the embedder decide.
+	Height *int `json:"height"`
+}
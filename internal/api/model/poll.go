@@ -71,6 +71,19 @@ type PollOption struct {
 	VotesCount *int `json:"votes_count"`
 }
 
+// PollOptionVoters represents the local accounts
+// that have voted for one option of a poll.
+//
+// swagger:model pollOptionVoters
+type PollOptionVoters struct {
+	// The text value of the poll option. String.
+	Title string `json:"title"`
+
+	// Accounts on this instance that voted for this option.
+	// Votes from accounts on other instances are not included.
+	Accounts []*Account `json:"accounts"`
+}
+
 // PollRequest models a request to create a poll.
 //
 // swagger:ignore
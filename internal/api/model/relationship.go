@@ -51,3 +51,28 @@ type Relationship struct {
 	// Your note on this account.
 	Note string `json:"note"`
 }
+
+// RelationshipsBulkRequest captures params for
+// changing relationships with many accounts at once.
+//
+// swagger:ignore
+type RelationshipsBulkRequest struct {
+	// IDs of the target accounts.
+	IDs []string `form:"id[]" json:"id"`
+
+	// Mute parameters, only
+	// used when bulk muting.
+	UserMuteCreateUpdateRequest
+}
+
+// FamiliarFollowers represents followers of an account
+// which are also followed by the requesting account.
+//
+// swagger:model familiarFollowers
+type FamiliarFollowers struct {
+	// The account id.
+	// example: 01FBW9XGEP7G6K88VY4S9MPE1R
+	ID string `json:"id"`
+	// Accounts you follow that also follow this account.
+	Accounts []*Account `json:"accounts"`
+}
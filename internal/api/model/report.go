@@ -82,10 +82,10 @@ type ReportCreateRequest struct {
 	// default: false
 	// in: formData
 	Forward bool `form:"forward" json:"forward" xml:"forward"`
-	// Specify if the report is due to spam, violation of enumerated instance rules, or some other reason.
-	// Currently only 'other' is supported.
+	// Specify if the report is due to spam, illegal content, violation of enumerated instance rules, or some other reason.
+	// One of: other, spam, legal, violation.
+	// If not set, defaults to 'violation' if any rule IDs are given, else 'other'.
 	// Sample: other
-	// default: other
 	// in: formData
 	Category string `form:"category" json:"category" xml:"category"`
 	// IDs of rules on this instance which have been broken according to the reporter.
@@ -52,4 +52,23 @@ type Source struct {
 	//
 	// Omitted from json if empty / not set.
 	AlsoKnownAsURIs []string `json:"also_known_as_uris,omitempty"`
+	// Account has opted in to being published to the
+	// instance directory (if configured, and discoverable).
+	DirectoryOptIn bool `json:"directory_opt_in"`
+	// Statuses viewed by this account indicate whether
+	// this account has a private note set on the author.
+	ShowNoteIndicators bool `json:"show_note_indicators"`
+	// Public statuses by this account can be embedded
+	// on other websites, via /embed and /api/oembed.
+	AllowEmbeds bool `json:"allow_embeds"`
+	// Statuses by this account older than this many days are
+	// automatically deleted, unless pinned, bookmarked or
+	// favourited by this account. 0 means disabled.
+	AutoDeleteAfterDays int `json:"auto_delete_after_days"`
+	// Secret token for this account's private RSS feed, which also
+	// includes followers-only posts. Use as the `token` query parameter
+	// of the account's feed URL. To set this, use `/api/v1/accounts/rss_token`.
+	//
+	// Omitted from json if empty / not set.
+	RSSFeedToken string `json:"rss_feed_token,omitempty"`
 }
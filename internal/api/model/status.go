@@ -37,6 +37,14 @@ type Status struct {
 	// example: 2021-07-30T09:20:25+00:00
 	// nullable: true
 	EditedAt *string `json:"edited_at"`
+	// Timestamp of when the status will be automatically deleted (ISO 8601 Datetime).
+	// Omitted if the status does not expire.
+	// example: 2021-07-30T09:20:25+00:00
+	ExpiresAt *string `json:"expires_at,omitempty"`
+	// Remaining lifetime of the status in seconds, before it is automatically deleted.
+	// Omitted if the status does not expire.
+	// example: 3600
+	ExpiresIn *int64 `json:"expires_in,omitempty"`
 	// ID of the status being replied to.
 	// example: 01FBVD42CQ3ZEEVMW180SBX03B
 	// nullable: true
@@ -55,11 +63,17 @@ type Status struct {
 	// example: unlisted
 	Visibility Visibility `json:"visibility"`
 	// Set to "true" if status is not federated, ie., a "local only" status; omitted from response otherwise.
+	// Local only statuses are shown on the local timeline (if public), but are never delivered
+	// to remote instances nor served to them via ActivityPub.
 	LocalOnly bool `json:"local_only,omitempty"`
 	// Primary language of this status (ISO 639 Part 1 two-letter language code).
 	// Will be null if language is not known.
 	// example: en
 	Language *string `json:"language"`
+	// Set to "true" if the language of this status was detected
+	// by the instance, rather than set by the author; omitted
+	// from response otherwise.
+	LanguageDetected bool `json:"language_detected,omitempty"`
 	// ActivityPub URI of the status. Equivalent to the status's activitypub ID.
 	// example: https://example.org/users/some_user/statuses/01FBVD42CQ3ZEEVMW180SBX03B
 	URI string `json:"uri"`
@@ -82,6 +96,9 @@ type Status struct {
 	Bookmarked bool `json:"bookmarked"`
 	// This status has been pinned by the account viewing it (only relevant for your own statuses).
 	Pinned bool `json:"pinned"`
+	// The account viewing this status has a private note set on the author of this status.
+	// Only included when the viewing account has enabled show_note_indicators in their settings.
+	AccountNoted bool `json:"account_noted,omitempty"`
 	// The content of this status. Should be HTML, but might also be plaintext in some cases.
 	// example: <p>Hey this is a status!</p>
 	Content string `json:"content"`
@@ -100,6 +117,8 @@ type Status struct {
 	Tags []Tag `json:"tags"`
 	// Custom emoji to be used when rendering status content.
 	Emojis []Emoji `json:"emojis"`
+	// Emoji reactions to this status, grouped by emoji.
+	EmojiReactions []StatusReaction `json:"emoji_reactions"`
 	// Preview card for links included within status content.
 	// nullable: true
 	Card *Card `json:"card"`
@@ -261,6 +280,16 @@ type StatusCreateRequest struct {
 	// of scheduled_at, if scheduled_at was set.
 	ScheduledAt *time.Time `form:"-" json:"-"`
 
+	// Duration in seconds after which this
+	// status will be automatically deleted.
+	// If not set, the status does not expire.
+	ExpiresIn int `form:"expires_in" json:"-"`
+
+	// Duration in seconds after which this
+	// status will be automatically deleted.
+	// If not set, the status does not expire.
+	ExpiresInI interface{} `form:"-" json:"expires_in"`
+
 	// ISO 639 language code for this status.
 	Language string `form:"language" json:"language"`
 
@@ -281,6 +310,16 @@ type StatusInteractionPolicyForm struct {
 	InteractionPolicy *InteractionPolicy `form:"interaction_policy" json:"-"`
 }
 
+// StatusInteractionPolicyRequest is the form submitted as
+// a PUT to update the interaction policy of a status.
+//
+// swagger:ignore
+type StatusInteractionPolicyRequest struct {
+
+	// Interaction policy to use for this status.
+	InteractionPolicy *InteractionPolicy `form:"-" json:"interaction_policy"`
+}
+
 // Visibility models the visibility of a status.
 //
 // swagger:enum statusVisibility
@@ -305,6 +344,11 @@ type StatusInteractionPolicyForm struct {
 
 	// VisibilityDirect is visible only to accounts tagged in the status. It is equivalent to a direct message.
 	VisibilityDirect Visibility = "direct"
+
+	// VisibilityLocal is visible to everyone on this instance, but is never federated.
+	// Only accepted when creating statuses, as shorthand for "public" with local_only
+	// set; local statuses are returned with visibility "public" and local_only true.
+	VisibilityLocal Visibility = "local"
 )
 
 // StatusContentType is the content type with which to parse the submitted status.
@@ -380,6 +424,60 @@ type StatusEdit struct {
 	Emojis []Emoji `json:"emojis"`
 }
 
+// StatusEditDiff represents the computed changes
+// between two consecutive revisions of a status.
+//
+// swagger:model statusEditDiff
+type StatusEditDiff struct {
+
+	// The date when the earlier revision was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	FromCreatedAt string `json:"from_created_at"`
+
+	// The date when the later revision was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:25:25+00:00
+	ToCreatedAt string `json:"to_created_at"`
+
+	// Word-level diff of the plaintext content between revisions.
+	Content []DiffSegment `json:"content"`
+
+	// Word-level diff of the spoiler text between revisions.
+	SpoilerText []DiffSegment `json:"spoiler_text"`
+
+	// Sensitive flag was changed between revisions.
+	// example: false
+	SensitiveChanged bool `json:"sensitive_changed"`
+
+	// IDs of media attachments added in the later revision.
+	MediaAttachmentsAdded []string `json:"media_attachments_added"`
+
+	// IDs of media attachments removed in the later revision.
+	MediaAttachmentsRemoved []string `json:"media_attachments_removed"`
+
+	// Poll options were changed between revisions.
+	// example: false
+	PollChanged bool `json:"poll_changed"`
+}
+
+// DiffSegment is one contiguous run of text in a
+// diff that was either kept, inserted, or deleted.
+//
+// swagger:model diffSegment
+type DiffSegment struct {
+
+	// Operation on this run of text.
+	// enum:
+	// - equal
+	// - insert
+	// - delete
+	// example: insert
+	Op string `json:"op"`
+
+	// Text of this segment, including whitespace.
+	// example: edited
+	Text string `json:"text"`
+}
+
 // StatusEditRequest models status edit parameters.
 //
 // swagger:ignore
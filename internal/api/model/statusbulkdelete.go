@@ -0,0 +1,73 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// StatusBulkDeleteRequest models a request to
+// delete many of the requester's own statuses
+// at once, selected using the given filters.
+//
+// swagger:ignore
+type StatusBulkDeleteRequest struct {
+	// Only delete statuses created at or after this time.
+	// Either a date (`2006-01-02`) or an ISO 8601 datetime.
+	MinDate string `form:"min_date" json:"min_date"`
+	// Only delete statuses created before this time.
+	// Either a date (`2006-01-02`) or an ISO 8601 datetime.
+	MaxDate string `form:"max_date" json:"max_date"`
+	// Only delete statuses with this visibility.
+	Visibility Visibility `form:"visibility" json:"visibility"`
+	// Only delete statuses using this hashtag (without the leading #).
+	Tag string `form:"tag" json:"tag"`
+	// If true, don't delete anything, just return
+	// the number of statuses that would be deleted.
+	Preview bool `form:"preview" json:"preview"`
+}
+
+// StatusBulkDelete models the progress
+// of a bulk deletion of statuses.
+//
+// swagger:model statusBulkDelete
+type StatusBulkDelete struct {
+	// State of the bulk deletion. One of:
+	//
+	//	- `preview` - no statuses were deleted, total is the amount that would be.
+	//	- `running` - statuses are currently being deleted.
+	//	- `completed` - all selected statuses were deleted or skipped.
+	//	- `aborted` - bulk deletion was aborted before completion.
+	//
+	// example: running
+	State string `json:"state"`
+	// Total number of statuses selected for deletion.
+	// example: 500
+	Total int `json:"total"`
+	// Number of statuses deleted so far.
+	// example: 120
+	Deleted int `json:"deleted"`
+	// Number of statuses skipped so far, because
+	// their deletion failed to complete in time.
+	// example: 0
+	Skipped int `json:"skipped"`
+	// Time at which the bulk deletion was started (ISO 8601 Datetime).
+	// Not set for previews.
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at,omitempty"`
+	// Time at which the bulk deletion completed or was aborted (ISO 8601 Datetime).
+	// Not set while still running.
+	// example: 2021-07-30T09:20:25+00:00
+	CompletedAt string `json:"completed_at,omitempty"`
+}
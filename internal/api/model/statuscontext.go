@@ -66,4 +66,23 @@ type WebThreadContext struct {
 
 	// Number of replies hidden.
 	ThreadRepliesHidden int
+
+	// Number of the hidden replies
+	// that were hidden because they
+	// come from a domain whose domain
+	// limit filters statuses.
+	ThreadRepliesLimited int
+
+	// Offset (within shown replies)
+	// of the first reply on this page.
+	ThreadRepliesOffset int
+
+	// Offset of the previous page
+	// of replies, only meaningful
+	// if ThreadRepliesOffset > 0.
+	ThreadRepliesPrevOffset int
+
+	// Offset of the next page of
+	// replies, or 0 if none remain.
+	ThreadRepliesNextOffset int
 }
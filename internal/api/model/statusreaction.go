@@ -0,0 +1,44 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// StatusReaction represents a group of emoji reactions on a status, all using the same emoji.
+//
+// swagger:model statusReaction
+type StatusReaction struct {
+	// Name of the emoji used for this reaction. For unicode
+	// emoji this is the emoji itself, for custom emoji this is
+	// the shortcode, followed by @domain if the emoji is remote.
+	// example: blobcat_uwu
+	Name string `json:"name"`
+	// Number of accounts that reacted with this emoji.
+	// example: 3
+	Count int `json:"count"`
+	// Whether the account viewing the status reacted with this emoji.
+	// example: true
+	Me bool `json:"me"`
+	// Web URL of the custom emoji, if this is a custom emoji reaction.
+	// example: https://example.org/fileserver/emojis/blogcat_uwu.gif
+	URL string `json:"url,omitempty"`
+	// A link to a static copy of the custom emoji, if this is a custom emoji reaction.
+	// example: https://example.org/fileserver/emojis/blogcat_uwu.png
+	StaticURL string `json:"static_url,omitempty"`
+	// Accounts that reacted with this emoji.
+	// Only set when listing reactions of a status.
+	Accounts []*Account `json:"accounts,omitempty"`
+}
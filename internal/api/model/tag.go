@@ -34,4 +34,8 @@ type Tag struct {
 	// Following is true if the user is following this tag, false if they're not,
 	// and not present if there is no currently authenticated user.
 	Following *bool `json:"following,omitempty"`
+	// Muting is true if the user is muting this tag, false if they're not,
+	// and not present if there is no currently authenticated user.
+	// Statuses containing muted tags are hidden from home and list timelines.
+	Muting *bool `json:"muting,omitempty"`
 }
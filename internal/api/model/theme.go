@@ -17,10 +17,16 @@
 
 package model
 
+import "mime/multipart"
+
 // Theme represents one user-selectable preset CSS theme.
 //
 // swagger:model theme
 type Theme struct {
+	// ID of this theme, only set for
+	// themes uploaded by an admin.
+	ID string `json:"id,omitempty"`
+
 	// User-facing title of this theme.
 	Title string `json:"title"`
 
@@ -30,3 +36,18 @@ type Theme struct {
 	// FileName of this theme in the themes directory.
 	FileName string `json:"file_name"`
 }
+
+// AdminThemeCreateRequest represents a request to upload a theme made through the admin API.
+//
+// swagger:ignore
+type AdminThemeCreateRequest struct {
+	// CSS file of the theme. The file name (eg., `my-theme.css`)
+	// is used as the theme's file name, and must be unique.
+	Theme *multipart.FileHeader `form:"theme" validation:"required"`
+	// User-facing title of the theme. If not set, the `theme-title`
+	// comment in the CSS is used, falling back to the file name.
+	Title string `form:"title"`
+	// User-facing description of the theme. If not set,
+	// the `theme-description` comment in the CSS is used.
+	Description string `form:"description"`
+}
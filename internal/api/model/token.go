@@ -34,6 +34,18 @@ type Token struct {
 	CreatedAt int64 `json:"created_at"`
 }
 
+// TokenCreateRequest models creation of
+// a personal access token by a user.
+//
+// swagger:ignore
+type TokenCreateRequest struct {
+	// Name for the token, used as the
+	// name of the application it belongs to.
+	Name string `form:"name" json:"name" xml:"name" binding:"required"`
+	// Space separated list of scopes to grant the token.
+	Scopes string `form:"scopes" json:"scopes" xml:"scopes" binding:"required"`
+}
+
 // TokenInfo represents metadata about one user-level access token.
 // The actual access token itself will never be sent via the API.
 //
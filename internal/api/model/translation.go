@@ -0,0 +1,82 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// Translation represents the translation
+// of a status into another language.
+//
+// swagger:model translation
+type Translation struct {
+	// The translated HTML content of the status.
+	Content string `json:"content"`
+	// The translated spoiler text / content warning of the status.
+	SpoilerText string `json:"spoiler_text"`
+	// The translated poll options of the status, if any.
+	Poll *TranslationPoll `json:"poll,omitempty"`
+	// The translated media descriptions of the status.
+	MediaAttachments []TranslationAttachment `json:"media_attachments"`
+	// The language of the source text, as detected by the translation service.
+	// example: de
+	DetectedSourceLanguage string `json:"detected_source_language"`
+	// The target language of the translation.
+	// example: en
+	Language string `json:"language"`
+	// The name of the service that provided the translation.
+	// example: DeepL.com
+	Provider string `json:"provider"`
+}
+
+// TranslationPoll represents a
+// translated poll of a status.
+//
+// swagger:model translationPoll
+type TranslationPoll struct {
+	// The ID of the poll.
+	ID string `json:"id"`
+	// The translated poll options.
+	Options []TranslationPollOption `json:"options"`
+}
+
+// TranslationPollOption represents a
+// translated poll option of a status.
+//
+// swagger:model translationPollOption
+type TranslationPollOption struct {
+	// The translated title of the poll option.
+	Title string `json:"title"`
+}
+
+// TranslationAttachment represents a translated
+// media attachment description of a status.
+//
+// swagger:model translationAttachment
+type TranslationAttachment struct {
+	// The ID of the media attachment.
+	ID string `json:"id"`
+	// The translated description of the media attachment.
+	Description string `json:"description"`
+}
+
+// TranslateRequest models status translation parameters.
+//
+// swagger:ignore
+type TranslateRequest struct {
+	// BCP47 language tag to translate into.
+	// Defaults to the requester's posting language.
+	Lang string `form:"lang" json:"lang" xml:"lang"`
+}
@@ -0,0 +1,65 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// AdminWebfingerAlias models an admin-managed mapping of an
+// additional webfinger name to a canonical local account.
+//
+// swagger:model adminWebfingerAlias
+type AdminWebfingerAlias struct {
+	// The ID of the webfinger alias.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	// readonly: true
+	ID string `json:"id"`
+
+	// Username part of the alias.
+	// example: me
+	Username string `json:"username"`
+
+	// Domain part of the alias.
+	// example: gts.example
+	Domain string `json:"domain"`
+
+	// The ID of the local account this alias resolves to.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	AccountID string `json:"account_id"`
+
+	// The ID of the admin account that created this alias.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	// readonly: true
+	CreatedBy string `json:"created_by"`
+
+	// Time at which the alias was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	// readonly: true
+	CreatedAt string `json:"created_at"`
+}
+
+// AdminWebfingerAliasCreateRequest is the form submitted as a POST to create a new webfinger alias.
+//
+// swagger:ignore
+type AdminWebfingerAliasCreateRequest struct {
+	// Username part of the alias.
+	Username string `form:"username" json:"username"`
+
+	// Domain part of the alias.
+	Domain string `form:"domain" json:"domain"`
+
+	// ID of the local account to resolve to.
+	AccountID string `form:"account_id" json:"account_id"`
+}
@@ -49,4 +49,27 @@ type WebPushNotification struct {
 	// I don't know why this is sent, given that the client should know that already,
 	// but Feditext does use it.
 	AccessToken string `json:"access_token"`
+
+	// PollResults contains the final results of an ended poll,
+	// so that clients can show them without fetching the poll.
+	// Only set for notifications of type "poll".
+	PollResults *WebPushPollResults `json:"poll_results,omitempty"`
+}
+
+// WebPushPollResults is a summary of the final results of a poll, delivered in
+// a Web Push notification when a poll the receiving user created or voted in ends.
+//
+// swagger:model webPushPollResults
+type WebPushPollResults struct {
+	// PollID is the Poll.ID of the ended poll.
+	PollID string `json:"poll_id"`
+
+	// VotesCount is the total number of votes received.
+	VotesCount int `json:"votes_count"`
+
+	// VotersCount is the number of unique accounts that voted.
+	VotersCount *int `json:"voters_count"`
+
+	// Options contains the final vote counts for each poll option.
+	Options []PollOption `json:"options"`
 }
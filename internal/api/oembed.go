@@ -0,0 +1,53 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"code.superseriousbusiness.org/gotosocial/internal/api/oembed"
+	"code.superseriousbusiness.org/gotosocial/internal/middleware"
+	"code.superseriousbusiness.org/gotosocial/internal/processing"
+	"code.superseriousbusiness.org/gotosocial/internal/router"
+	"github.com/gin-gonic/gin"
+)
+
+type OEmbed struct {
+	oEmbed *oembed.Module
+}
+
+func (o *OEmbed) Route(r *router.Router, m ...gin.HandlerFunc) {
+	// Create a group so we can attach middlewares.
+	oEmbedGroup := r.AttachGroup(oembed.BasePath)
+
+	// attach middlewares appropriate for this group
+	oEmbedGroup.Use(m...)
+	oEmbedGroup.Use(
+		// Allow public cache for 1 hour.
+		middleware.CacheControl(middleware.CacheControlConfig{
+			Directives: []string{"public", "max-age=3600"},
+			Vary:       []string{"Accept-Encoding"},
+		}),
+	)
+
+	o.oEmbed.Route(oEmbedGroup.Handle)
+}
+
+func NewOEmbed(p *processing.Processor) *OEmbed {
+	return &OEmbed{
+		oEmbed: oembed.New(p),
+	}
+}
@@ -0,0 +1,142 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package oembed
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"github.com/gin-gonic/gin"
+)
+
+// OEmbedGETHandler swagger:operation GET /api/oembed oEmbedGet
+//
+// Get an oEmbed representation of a public status, for embedding it on another website.
+//
+// Only public posts by local accounts which haven't opted out of embeds can be embedded.
+// The returned html will be an iframe pointing to /embed/{statusID}.
+//
+// See: https://oembed.com/
+//
+//	---
+//	tags:
+//	- oembed
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: url
+//		type: string
+//		description: URL of the status to embed.
+//		in: query
+//		required: true
+//	-
+//		name: format
+//		type: string
+//		description: Format of the response. Only json is supported.
+//		in: query
+//		default: json
+//	-
+//		name: maxwidth
+//		type: integer
+//		description: Maximum width of the embed, in pixels.
+//		in: query
+//	-
+//		name: maxheight
+//		type: integer
+//		description: Maximum height of the embed, in pixels.
+//		in: query
+//
+//	responses:
+//		'200':
+//			schema:
+//				"$ref": "#/definitions/oEmbed"
+//		'400':
+//			description: bad request
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'501':
+//			description: format not implemented
+func (m *Module) OEmbedGETHandler(c *gin.Context) {
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	statusURL := c.Query(URLKey)
+	if statusURL == "" {
+		const text = "no url given"
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(errors.New(text), text), m.processor.InstanceGetV1)
+		return
+	}
+
+	if format := c.Query(FormatKey); format != "" && format != "json" {
+		const text = "only json format is supported"
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotImplemented(errors.New(text), text), m.processor.InstanceGetV1)
+		return
+	}
+
+	maxWidth, errWithCode := parseDimension(c.Query(MaxWidthKey), MaxWidthKey)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	maxHeight, errWithCode := parseDimension(c.Query(MaxHeightKey), MaxHeightKey)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	oEmbed, errWithCode := m.processor.Status().OEmbedGet(
+		c.Request.Context(),
+		statusURL,
+		maxWidth,
+		maxHeight,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, oEmbed)
+}
+
+// parseDimension parses the given optional
+// maxwidth / maxheight query value, returning
+// 0 if not set, or a bad request error if invalid.
+func parseDimension(value string, key string) (int, gtserror.WithCode) {
+	if value == "" {
+		return 0, nil
+	}
+
+	i, err := strconv.Atoi(value)
+	if err != nil || i < 0 {
+		text := key + " must be a positive integer"
+		return 0, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	return i, nil
+}
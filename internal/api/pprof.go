@@ -0,0 +1,57 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"code.superseriousbusiness.org/gotosocial/internal/api/pprof"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/middleware"
+	"code.superseriousbusiness.org/gotosocial/internal/processing"
+	"code.superseriousbusiness.org/gotosocial/internal/router"
+	"github.com/gin-gonic/gin"
+)
+
+type Pprof struct {
+	processor *processing.Processor
+	db        db.DB
+
+	pprof *pprof.Module
+}
+
+func (p *Pprof) Route(r *router.Router, m ...gin.HandlerFunc) {
+	// Create new group on top level prefix.
+	pprofGroup := r.AttachGroup("")
+	pprofGroup.Use(m...)
+	pprofGroup.Use(
+		middleware.TokenCheck(p.db, p.processor.OAuthValidateBearerToken),
+		middleware.CacheControl(middleware.CacheControlConfig{
+			// Never cache profiling responses.
+			Directives: []string{"no-store"},
+		}),
+	)
+
+	p.pprof.Route(pprofGroup.Handle)
+}
+
+func NewPprof(db db.DB, p *processing.Processor) *Pprof {
+	return &Pprof{
+		processor: p,
+		db:        db,
+		pprof:     pprof.New(p),
+	}
+}
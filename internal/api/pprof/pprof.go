@@ -0,0 +1,238 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pprof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+	"strconv"
+	"strings"
+	"time"
+
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/processing"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// ProfileKey is the wildcard path
+	// key for the named pprof profile.
+	ProfileKey = "profile"
+
+	BasePath    = "/debug/pprof"
+	ProfilePath = BasePath + "/*" + ProfileKey
+)
+
+type Module struct {
+	processor *processing.Processor
+}
+
+func New(processor *processing.Processor) *Module {
+	return &Module{
+		processor: processor,
+	}
+}
+
+func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	attachHandler(http.MethodGet, ProfilePath, m.PprofHandler)
+	attachHandler(http.MethodPost, ProfilePath, m.PprofHandler)
+}
+
+// PprofHandler serves Go pprof profiles, CPU profiles
+// and execution traces, in the same format as the
+// standard "net/http/pprof" endpoints, to admins only.
+//
+// Handlers are implemented directly on top of "runtime/pprof"
+// and "runtime/trace", as importing "net/http/pprof" would
+// also register its handlers on http.DefaultServeMux.
+func (m *Module) PprofHandler(c *gin.Context) {
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		apiutil.ScopeAdminRead,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	switch name := strings.TrimPrefix(c.Param(ProfileKey), "/"); name {
+	case "":
+		errWithCode = serveIndex(c)
+	case "cmdline":
+		errWithCode = serveCmdline(c)
+	case "profile":
+		errWithCode = serveCPUProfile(c)
+	case "trace":
+		errWithCode = serveTrace(c)
+	default:
+		errWithCode = serveProfile(c, name)
+	}
+
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+	}
+}
+
+// serveIndex serves an HTML page listing available profiles.
+func serveIndex(c *gin.Context) gtserror.WithCode {
+	var buf bytes.Buffer
+	buf.WriteString("<html><head><title>/debug/pprof/</title></head><body>\n")
+	buf.WriteString("<p>Profiles:</p><ul>\n")
+	for _, p := range pprof.Profiles() {
+		name := html.EscapeString(p.Name())
+		fmt.Fprintf(&buf, "<li><a href=\"%s?debug=1\">%s</a> (%d)</li>\n", name, name, p.Count())
+	}
+	buf.WriteString("<li><a href=\"profile?seconds=10\">profile</a> (CPU profile)</li>\n")
+	buf.WriteString("<li><a href=\"trace?seconds=1\">trace</a> (execution trace)</li>\n")
+	buf.WriteString("<li><a href=\"cmdline\">cmdline</a></li>\n")
+	buf.WriteString("</ul></body></html>\n")
+	c.Data(http.StatusOK, "text/html; charset=utf-8", buf.Bytes())
+	return nil
+}
+
+// serveCmdline serves the running program's
+// command line, with arguments NUL separated.
+func serveCmdline(c *gin.Context) gtserror.WithCode {
+	cmdline := strings.Join(os.Args, "\x00")
+	c.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(cmdline))
+	return nil
+}
+
+// serveProfile serves the named runtime/pprof profile, in
+// the protobuf format unless a debug query param is given.
+func serveProfile(c *gin.Context, name string) gtserror.WithCode {
+	profile := pprof.Lookup(name)
+	if profile == nil {
+		err := fmt.Errorf("unknown profile: %s", name)
+		return gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	debug, errWithCode := parseIntParam(c, "debug", 0)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if name == "heap" && c.Query("gc") != "" {
+		// Run GC first, to get
+		// an up-to-date profile.
+		runtime.GC()
+	}
+
+	var buf bytes.Buffer
+	if err := profile.WriteTo(&buf, debug); err != nil {
+		err := gtserror.Newf("error writing profile %s: %w", name, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	contentType := "application/octet-stream"
+	if debug > 0 {
+		contentType = "text/plain; charset=utf-8"
+	} else {
+		c.Header("Content-Disposition", `attachment; filename="`+name+`"`)
+	}
+	c.Data(http.StatusOK, contentType, buf.Bytes())
+	return nil
+}
+
+// serveCPUProfile serves a CPU profile, collected
+// over the duration given by the seconds query param.
+func serveCPUProfile(c *gin.Context) gtserror.WithCode {
+	seconds, errWithCode := parseIntParam(c, "seconds", 30)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	var buf bytes.Buffer
+	if err := pprof.StartCPUProfile(&buf); err != nil {
+		// Most likely a profile
+		// is already in progress.
+		err := gtserror.Newf("error starting cpu profile: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+	sleep(c, seconds)
+	pprof.StopCPUProfile()
+
+	c.Header("Content-Disposition", `attachment; filename="profile"`)
+	c.Data(http.StatusOK, "application/octet-stream", buf.Bytes())
+	return nil
+}
+
+// serveTrace serves an execution trace, collected
+// over the duration given by the seconds query param.
+func serveTrace(c *gin.Context) gtserror.WithCode {
+	seconds, errWithCode := parseIntParam(c, "seconds", 1)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	var buf bytes.Buffer
+	if err := trace.Start(&buf); err != nil {
+		// Most likely a trace
+		// is already in progress.
+		err := gtserror.Newf("error starting trace: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+	sleep(c, seconds)
+	trace.Stop()
+
+	c.Header("Content-Disposition", `attachment; filename="trace"`)
+	c.Data(http.StatusOK, "application/octet-stream", buf.Bytes())
+	return nil
+}
+
+// parseIntParam parses the query param with key as
+// a non-negative integer, returning def if not set.
+func parseIntParam(c *gin.Context, key string, def int) (int, gtserror.WithCode) {
+	str := c.Query(key)
+	if str == "" {
+		return def, nil
+	}
+
+	i, err := strconv.Atoi(str)
+	if err != nil || i < 0 {
+		text := key + " must be a non-negative integer"
+		return 0, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	return i, nil
+}
+
+// sleep waits for given number of seconds,
+// or until the request context is cancelled.
+func sleep(c *gin.Context, seconds int) {
+	t := time.NewTimer(time.Duration(seconds) * time.Second)
+	defer t.Stop()
+	select {
+	case <-c.Request.Context().Done():
+	case <-t.C:
+	}
+}
@@ -0,0 +1,185 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pprof_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/admin"
+	"code.superseriousbusiness.org/gotosocial/internal/api/pprof"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/oauth"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"code.superseriousbusiness.org/gotosocial/internal/storage"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+)
+
+type PprofTestSuite struct {
+	suite.Suite
+	db      db.DB
+	state   state.State
+	storage *storage.Driver
+
+	testTokens       map[string]*gtsmodel.Token
+	testApplications map[string]*gtsmodel.Application
+	testUsers        map[string]*gtsmodel.User
+	testAccounts     map[string]*gtsmodel.Account
+
+	pprofModule *pprof.Module
+}
+
+func (suite *PprofTestSuite) SetupSuite() {
+	suite.testTokens = testrig.NewTestTokens()
+	suite.testApplications = testrig.NewTestApplications()
+	suite.testUsers = testrig.NewTestUsers()
+	suite.testAccounts = testrig.NewTestAccounts()
+}
+
+func (suite *PprofTestSuite) SetupTest() {
+	suite.state.Caches.Init()
+	testrig.StartNoopWorkers(&suite.state)
+
+	testrig.InitTestLog()
+	testrig.InitTestConfig()
+
+	suite.db = testrig.NewTestDB(&suite.state)
+	suite.state.DB = suite.db
+	suite.state.AdminActions = admin.New(suite.state.DB, &suite.state.Workers)
+	suite.storage = testrig.NewInMemoryStorage()
+	suite.state.Storage = suite.storage
+
+	mediaManager := testrig.NewTestMediaManager(&suite.state)
+	federator := testrig.NewTestFederator(&suite.state, testrig.NewTestTransportController(&suite.state, testrig.NewMockHTTPClient(nil, "../../../testrig/media")), mediaManager)
+	processor := testrig.NewTestProcessor(
+		&suite.state,
+		federator,
+		testrig.NewEmailSender("../../../web/template/", nil),
+		testrig.NewNoopWebPushSender(),
+		mediaManager,
+	)
+	suite.pprofModule = pprof.New(processor)
+	testrig.StandardDBSetup(suite.db, nil)
+}
+
+func (suite *PprofTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.db)
+	testrig.StopWorkers(&suite.state)
+}
+
+// pprof calls the pprof handler for given profile path as
+// the given test account, returning response code + body.
+func (suite *PprofTestSuite) pprof(
+	accountKey string,
+	scope string,
+	profile string,
+	query string,
+) (int, string) {
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+
+	// Copy the account's token, with
+	// given scope, to check scope and
+	// admin status separately.
+	token := new(gtsmodel.Token)
+	*token = *suite.testTokens[accountKey]
+	token.Scope = scope
+
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts[accountKey])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(token))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers[accountKey])
+
+	requestURI := config.GetProtocol() + "://" + config.GetHost() + pprof.BasePath + profile
+	if query != "" {
+		requestURI += "?" + query
+	}
+	ctx.Request = httptest.NewRequest(http.MethodGet, requestURI, nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Params = gin.Params{{Key: pprof.ProfileKey, Value: profile}}
+
+	suite.pprofModule.PprofHandler(ctx)
+
+	b, err := io.ReadAll(recorder.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return recorder.Code, string(b)
+}
+
+func (suite *PprofTestSuite) TestPprofIndex() {
+	code, body := suite.pprof("admin_account", "admin:read", "/", "")
+	suite.Equal(http.StatusOK, code)
+	suite.Contains(body, `<a href="heap?debug=1">heap</a>`)
+	suite.Contains(body, `<a href="goroutine?debug=1">goroutine</a>`)
+}
+
+func (suite *PprofTestSuite) TestPprofProfileDebug() {
+	code, body := suite.pprof("admin_account", "admin:read", "/goroutine", "debug=1")
+	suite.Equal(http.StatusOK, code)
+	suite.Contains(body, "goroutine profile: total")
+}
+
+func (suite *PprofTestSuite) TestPprofProfile() {
+	code, body := suite.pprof("admin_account", "admin:read", "/heap", "")
+	suite.Equal(http.StatusOK, code)
+
+	// Profile should be gzipped protobuf.
+	suite.True(len(body) > 2 && body[0] == 0x1f && body[1] == 0x8b)
+}
+
+func (suite *PprofTestSuite) TestPprofCPUProfile() {
+	code, body := suite.pprof("admin_account", "admin:read", "/profile", "seconds=0")
+	suite.Equal(http.StatusOK, code)
+	suite.True(len(body) > 2 && body[0] == 0x1f && body[1] == 0x8b)
+}
+
+func (suite *PprofTestSuite) TestPprofUnknownProfile() {
+	code, body := suite.pprof("admin_account", "admin:read", "/nope", "")
+	suite.Equal(http.StatusNotFound, code)
+	suite.Equal(`{"error":"Not Found: unknown profile: nope"}`, body)
+}
+
+func (suite *PprofTestSuite) TestPprofBadParam() {
+	code, body := suite.pprof("admin_account", "admin:read", "/heap", "debug=bleh")
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Equal(`{"error":"Bad Request: debug must be a non-negative integer"}`, body)
+}
+
+func (suite *PprofTestSuite) TestPprofNotAdmin() {
+	code, body := suite.pprof("local_account_1", "admin:read", "/heap", "")
+	suite.Equal(http.StatusForbidden, code)
+	suite.Equal(`{"error":"Forbidden: user `+suite.testUsers["local_account_1"].ID+` not an admin"}`, body)
+}
+
+func (suite *PprofTestSuite) TestPprofNoAdminScope() {
+	code, body := suite.pprof("admin_account", "read write", "/heap", "")
+	suite.Equal(http.StatusForbidden, code)
+	suite.Equal(`{"error":"Forbidden: token has insufficient scope permission"}`, body)
+}
+
+func TestPprofTestSuite(t *testing.T) {
+	suite.Run(t, new(PprofTestSuite))
+}
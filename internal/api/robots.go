@@ -20,6 +20,7 @@
 import (
 	"code.superseriousbusiness.org/gotosocial/internal/api/robots"
 	"code.superseriousbusiness.org/gotosocial/internal/middleware"
+	"code.superseriousbusiness.org/gotosocial/internal/processing"
 	"code.superseriousbusiness.org/gotosocial/internal/router"
 	"github.com/gin-gonic/gin"
 )
@@ -45,8 +46,23 @@ func (rb *Robots) Route(r *router.Router, m ...gin.HandlerFunc) {
 	)
 
 	rb.robots.Route(robotsGroup.Handle)
+
+	// Create a group for sitemap.xml,
+	// attaching the same middlewares.
+	sitemapGroup := r.AttachGroup(robots.SitemapPath)
+	sitemapGroup.Use(m...)
+	sitemapGroup.Use(
+		middleware.CacheControl(middleware.CacheControlConfig{
+			Directives: []string{"public", "no-cache"},
+			Vary:       []string{"Accept-Encoding"},
+		}),
+	)
+
+	rb.robots.RouteSitemap(sitemapGroup.Handle)
 }
 
-func NewRobots() *Robots {
-	return &Robots{}
+func NewRobots(p *processing.Processor) *Robots {
+	return &Robots{
+		robots: robots.New(p),
+	}
 }
@@ -18,17 +18,25 @@
 package robots
 
 import (
+	// nolint:gosec
+	"crypto/md5"
+	"encoding/hex"
 	"net/http"
 
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/processing"
 	"github.com/gin-gonic/gin"
 )
 
-type Module struct{}
+type Module struct {
+	processor *processing.Processor
+}
 
-func New() *Module {
-	return &Module{}
+func New(processor *processing.Processor) *Module {
+	return &Module{
+		processor: processor,
+	}
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
@@ -36,42 +44,40 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	// stats mode: Don't disallow scraping nodeinfo if admin
 	// has opted in to serving accurate stats there. In all
 	// other cases, disallow scraping nodeinfo.
-	var handler gin.HandlerFunc
+	var robotsTxt, eTag string
 	if config.GetInstanceStatsMode() == config.InstanceStatsModeServe {
-		handler = m.robotsGETHandler
+		robotsTxt, eTag = apiutil.RobotsTxt, apiutil.RobotsTxtETag
 	} else {
-		handler = m.robotsGETHandlerDisallowNodeInfo
+		robotsTxt, eTag = apiutil.RobotsTxtDisallowNodeInfo, apiutil.RobotsTxtDisallowNodeInfoETag
 	}
 
-	// Attach handler at empty path as this
-	// is already grouped under /robots.txt.
-	attachHandler(http.MethodGet, "", handler)
-}
-
-func (m *Module) robotsGETHandler(c *gin.Context) {
-	const ETag = "\"" + apiutil.RobotsTxtETag + "\""
-	c.Header("ETag", ETag)
+	// Point crawlers to the
+	// sitemap, if it's served.
+	if config.GetInstanceExposeSitemap() {
+		sitemapURL := config.GetProtocol() + "://" + config.GetHost() + "/" + SitemapPath
+		robotsTxt += apiutil.RobotsTxtSitemap(sitemapURL)
 
-	if c.Request.Header.Get("If-None-Match") == ETag {
-		// Cached.
-		c.AbortWithStatus(http.StatusNotModified)
-		return
+		sum := md5.Sum([]byte(robotsTxt)) // nolint:gosec
+		eTag = hex.EncodeToString(sum[:])
 	}
 
-	// Not cached, serve.
-	c.String(http.StatusOK, apiutil.RobotsTxt)
+	// Attach handler at empty path as this
+	// is already grouped under /robots.txt.
+	attachHandler(http.MethodGet, "", m.robotsGETHandler(robotsTxt, eTag))
 }
 
-func (m *Module) robotsGETHandlerDisallowNodeInfo(c *gin.Context) {
-	const ETag = "\"" + apiutil.RobotsTxtDisallowNodeInfoETag + "\""
-	c.Header("ETag", ETag)
+func (m *Module) robotsGETHandler(robotsTxt string, eTag string) gin.HandlerFunc {
+	eTag = "\"" + eTag + "\""
+	return func(c *gin.Context) {
+		c.Header("ETag", eTag)
 
-	if c.Request.Header.Get("If-None-Match") == ETag {
-		// Cached.
-		c.AbortWithStatus(http.StatusNotModified)
-		return
-	}
+		if c.Request.Header.Get("If-None-Match") == eTag {
+			// Cached.
+			c.AbortWithStatus(http.StatusNotModified)
+			return
+		}
 
-	// Not cached, serve.
-	c.String(http.StatusOK, apiutil.RobotsTxtDisallowNodeInfo)
+		// Not cached, serve.
+		c.String(http.StatusOK, robotsTxt)
+	}
 }
@@ -0,0 +1,54 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package robots
+
+import (
+	"net/http"
+
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"github.com/gin-gonic/gin"
+)
+
+// SitemapPath is the path at which
+// sitemap.xml is served, relative to root.
+const SitemapPath = "sitemap.xml"
+
+func (m *Module) RouteSitemap(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	// Attach handler at empty path as this
+	// is already grouped under /sitemap.xml.
+	attachHandler(http.MethodGet, "", m.sitemapGETHandler)
+}
+
+func (m *Module) sitemapGETHandler(c *gin.Context) {
+	sitemap, errWithCode := m.processor.Sitemap().Get(c.Request.Context())
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	c.Header("ETag", sitemap.ETag)
+
+	if c.Request.Header.Get("If-None-Match") == sitemap.ETag {
+		// Cached.
+		c.AbortWithStatus(http.StatusNotModified)
+		return
+	}
+
+	// Not cached, serve.
+	c.Data(http.StatusOK, "application/xml; charset=utf-8", sitemap.XML)
+}
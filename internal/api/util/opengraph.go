@@ -63,6 +63,15 @@ type OGMeta struct {
 	// Set to media URL for media posts.
 	TwitterSummaryLargeImage string
 	TwitterImageAlt          string
+
+	// Set for posts where the first piece
+	// of media is a video or audio file, so
+	// that previews can embed a playable player.
+	TwitterPlayer                  string // twitter:player
+	TwitterPlayerWidth             string // twitter:player:width
+	TwitterPlayerHeight            string // twitter:player:height
+	TwitterPlayerStream            string // twitter:player:stream
+	TwitterPlayerStreamContentType string // twitter:player:stream:content_type
 }
 
 // OGMedia represents one OpenGraph media
@@ -298,12 +307,13 @@ func OGStatus(
 		media                    []OGMedia
 		twitterSummaryLargeImage string
 		twitterImageAlt          string
+		twitterPlayer            ogTwitterPlayer
 	)
 
 	// Only append media to
 	// preview if not sensitive.
 	if !status.Sensitive {
-		for _, a := range status.MediaAttachments {
+		for i, a := range status.MediaAttachments {
 			if a.Type == "unknown" {
 				// Skip unknown.
 				continue
@@ -338,10 +348,34 @@ func OGStatus(
 			case "audio":
 				ogMedia.OGType = "audio"
 
+				// If this audio is the first piece of
+				// media, embed it as a twitter player.
+				// Audio has no dimensions of its own,
+				// so use a sensible player bar size.
+				if i == 0 {
+					twitterPlayer = ogTwitterPlayer{
+						url:         *a.URL,
+						width:       audioPlayerWidth,
+						height:      audioPlayerHeight,
+						contentType: a.MIMEType,
+					}
+				}
+
 			case "video", "gifv":
 				ogMedia.OGType = "video"
 				ogMedia.Width = strconv.Itoa(a.Meta.Original.Width)
 				ogMedia.Height = strconv.Itoa(a.Meta.Original.Height)
+
+				// If this video is the first piece of
+				// media, embed it as a twitter player.
+				if i == 0 {
+					twitterPlayer = ogTwitterPlayer{
+						url:         *a.URL,
+						width:       ogMedia.Width,
+						height:      ogMedia.Height,
+						contentType: a.MIMEType,
+					}
+				}
 			}
 
 			// Add this to our gathered entries.
@@ -383,9 +417,32 @@ func OGStatus(
 		ProfileUsername:          profileUsername,
 		TwitterSummaryLargeImage: twitterSummaryLargeImage,
 		TwitterImageAlt:          twitterImageAlt,
+
+		TwitterPlayer:                  twitterPlayer.url,
+		TwitterPlayerWidth:             twitterPlayer.width,
+		TwitterPlayerHeight:            twitterPlayer.height,
+		TwitterPlayerStream:            twitterPlayer.url,
+		TwitterPlayerStreamContentType: twitterPlayer.contentType,
 	}
 }
 
+// Dimensions of the twitter:player
+// embed for audio attachments, which
+// don't have any dimensions themselves.
+const (
+	audioPlayerWidth  = "480"
+	audioPlayerHeight = "80"
+)
+
+// ogTwitterPlayer wraps
+// twitter:player details.
+type ogTwitterPlayer struct {
+	url         string
+	width       string
+	height      string
+	contentType string
+}
+
 // AccountTitle parses a page title
 // from account and accountDomain.
 func AccountTitle(
@@ -288,6 +288,101 @@ func (suite *OpenGraphTestSuite) TestWithStatusWithImage() {
 	}, *statusMeta)
 }
 
+func (suite *OpenGraphTestSuite) TestWithStatusWithVideo() {
+	instance := &apimodel.InstanceV1{
+		AccountDomain: "example.org",
+		Languages:     []string{"en"},
+	}
+
+	acct := &apimodel.Account{
+		Acct:        "example_account",
+		DisplayName: "example person!!",
+		URL:         "https://example.org/@example_account",
+		Username:    "example_account",
+		Avatar:      "https://example.org/avatar.jpg",
+	}
+
+	videoAttachment := &apimodel.Attachment{
+		ID:         "00VIDEO00",
+		Type:       "video",
+		URL:        util.Ptr("https://example.org/@example_account/12345/example.mp4"),
+		TextURL:    util.Ptr("https://example.org/@example_account/12345/example.mp4"),
+		PreviewURL: util.Ptr("https://example.org/@example_account/12345/small/example.webp"),
+		Meta: &apimodel.MediaMeta{
+			Original: apimodel.MediaDimensions{
+				Width:  1280,
+				Height: 720,
+			},
+			Small: apimodel.MediaDimensions{
+				Width:  512,
+				Height: 288,
+			},
+		},
+		Description: util.Ptr("an example video"),
+	}
+
+	apiStatus := &apimodel.Status{
+		ID:               "12345",
+		CreatedAt:        "2025-01-18T00:00:00+00:00",
+		URL:              "https://example.org/@example_account/12345",
+		Content:          "<p>look at this</p>",
+		Account:          acct,
+		MediaAttachments: []*apimodel.Attachment{videoAttachment},
+	}
+
+	webAttachment := &apimodel.WebAttachment{
+		Attachment:       videoAttachment,
+		MIMEType:         "video/mp4",
+		PreviewMIMEType:  "image/webp",
+		ParentStatusLink: "https://example.org/@example_account/12345",
+	}
+
+	status := &apimodel.WebStatus{
+		Status:           apiStatus,
+		MediaAttachments: []*apimodel.WebAttachment{webAttachment},
+		Account:          &apimodel.WebAccount{Account: acct},
+	}
+
+	statusMeta := OGStatus(instance, status.Account, status)
+
+	suite.EqualValues(OGMeta{
+		Title:       "example person!! (@example_account@example.org)",
+		Type:        "article",
+		Locale:      "en",
+		URL:         "https://example.org/@example_account/12345",
+		SiteName:    "example.org",
+		Description: "[1 media attachment] look at this",
+		Media: []OGMedia{
+			{
+				OGType:   "video",
+				Alt:      "an example video",
+				URL:      "https://example.org/@example_account/12345/example.mp4",
+				MIMEType: "video/mp4",
+				Width:    "1280",
+				Height:   "720",
+			},
+			{
+				OGType:   "image",
+				Alt:      "an example video",
+				URL:      "https://example.org/@example_account/12345/small/example.webp",
+				MIMEType: "image/webp",
+				Width:    "512",
+				Height:   "288",
+			},
+		},
+		ArticlePublisher:               "https://example.org/@example_account",
+		ArticleAuthor:                  "https://example.org/@example_account",
+		ArticleModifiedTime:            "2025-01-18T00:00:00+00:00",
+		ArticlePublishedTime:           "2025-01-18T00:00:00+00:00",
+		ProfileUsername:                "example_account@example.org",
+		TwitterPlayer:                  "https://example.org/@example_account/12345/example.mp4",
+		TwitterPlayerWidth:             "1280",
+		TwitterPlayerHeight:            "720",
+		TwitterPlayerStream:            "https://example.org/@example_account/12345/example.mp4",
+		TwitterPlayerStreamContentType: "video/mp4",
+	}, *statusMeta)
+}
+
 func TestOpenGraphTestSuite(t *testing.T) {
 	suite.Run(t, &OpenGraphTestSuite{})
 }
@@ -23,6 +23,7 @@
 	"strings"
 
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/language"
 )
 
 const (
@@ -37,6 +38,8 @@
 	IDKey              = "id"
 	LimitKey           = "limit"
 	LocalKey           = "local"
+	LanguageKey        = "language"
+	DomainKey          = "domain"
 	MaxIDKey           = "max_id"
 	SinceIDKey         = "since_id"
 	MinIDKey           = "min_id"
@@ -62,6 +65,9 @@
 	/* Tag keys */
 
 	TagNameKey = "tag_name"
+	TagAnyKey  = "any[]"
+	TagAllKey  = "all[]"
+	TagNoneKey = "none[]"
 
 	/* Domain permission keys */
 
@@ -90,6 +96,9 @@
 	AdminPermissionsKey = "permissions"
 	AdminRoleIDsKey     = "role_ids[]"
 	AdminInvitedByKey   = "invited_by"
+	AdminCategoryKey    = "category"
+	AdminAssignedIDKey  = "assigned_account_id"
+	AdminSoftwareKey    = "software"
 
 	/* Interaction policy + request keys */
 
@@ -97,6 +106,10 @@
 	InteractionFavouritesKey = "favourites"
 	InteractionRepliesKey    = "replies"
 	InteractionReblogsKey    = "reblogs"
+
+	/* Web keys */
+
+	WebRepliesOffsetKey = "replies_offset"
 )
 
 /*
@@ -156,6 +169,10 @@ func ParseSearchOffset(value string, defaultValue int, max, min int) (int, gtser
 	return parseInt(value, defaultValue, max, min, SearchOffsetKey)
 }
 
+func ParseWebRepliesOffset(value string, defaultValue int, max, min int) (int, gtserror.WithCode) {
+	return parseInt(value, defaultValue, max, min, WebRepliesOffsetKey)
+}
+
 func ParseSearchResolve(value string, defaultValue bool) (bool, gtserror.WithCode) {
 	return parseBool(value, defaultValue, SearchResolveKey)
 }
@@ -196,6 +213,10 @@ func ParseAdminSuspended(value string, defaultValue bool) (bool, gtserror.WithCo
 	return parseBool(value, defaultValue, AdminSuspendedKey)
 }
 
+func ParseAdminInstanceSuspended(value string, defaultValue *bool) (*bool, gtserror.WithCode) {
+	return parseBoolPtr(value, defaultValue, AdminSuspendedKey)
+}
+
 func ParseAdminStaff(value string, defaultValue bool) (bool, gtserror.WithCode) {
 	return parseBool(value, defaultValue, AdminStaffKey)
 }
@@ -212,6 +233,21 @@ func ParseInteractionReblogs(value string, defaultValue bool) (bool, gtserror.Wi
 	return parseBool(value, defaultValue, InteractionReblogsKey)
 }
 
+// ParseLanguage parses the given optional BCP47 language tag,
+// returning its normalized form, or empty string if not set.
+func ParseLanguage(value string) (string, gtserror.WithCode) {
+	if value == "" {
+		return "", nil
+	}
+
+	lang, err := language.Parse(value)
+	if err != nil {
+		return "", parseError(LanguageKey, value, "", err)
+	}
+
+	return lang.TagStr, nil
+}
+
 /*
 	Parse functions for *REQUIRED* parameters.
 */
@@ -52,6 +52,9 @@
 	ErrorRateLimited = mustJSON(map[string]string{
 		"error": "rate limit reached",
 	})
+	ErrorReadOnly = mustJSON(map[string]string{
+		"error": "instance is in read-only mode for maintenance",
+	})
 	EmptyJSONObject = json.RawMessage(`{}`)
 	EmptyJSONArray  = json.RawMessage(`[]`)
 
@@ -223,3 +223,12 @@
 	// MD5 hash of robots.txt with NodeInfo disallowed.
 	RobotsTxtDisallowNodeInfoETag = `c2c1e5cceb09d0801708dadd3fa42a49`
 )
+
+// RobotsTxtSitemap returns a robots.txt section
+// pointing crawlers to the sitemap at given URL.
+func RobotsTxtSitemap(sitemapURL string) string {
+	return `
+# Sitemap of discoverable + indexable profiles and posts.
+Sitemap: ` + sitemapURL + `
+`
+}
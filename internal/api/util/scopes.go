@@ -18,6 +18,7 @@
 package util
 
 import (
+	"slices"
 	"strings"
 )
 
@@ -99,6 +100,69 @@
 	ScopeAdminWriteReports      Scope = ScopeAdminWrite + ":" + scopeReports
 )
 
+// knownScopes contains every
+// top-level and granular scope.
+var knownScopes = []Scope{
+	ScopeProfile,
+	ScopePush,
+	ScopeRead,
+	ScopeWrite,
+	ScopeAdmin,
+	ScopeAdminRead,
+	ScopeAdminWrite,
+	ScopeReadAccounts,
+	ScopeWriteAccounts,
+	ScopeReadApplications,
+	ScopeWriteApplications,
+	ScopeReadBlocks,
+	ScopeWriteBlocks,
+	ScopeReadBookmarks,
+	ScopeWriteBookmarks,
+	ScopeWriteConversations,
+	ScopeReadCustomEmojis,
+	ScopeReadFavourites,
+	ScopeWriteFavourites,
+	ScopeReadFilters,
+	ScopeWriteFilters,
+	ScopeReadFollows,
+	ScopeWriteFollows,
+	ScopeReadLists,
+	ScopeWriteLists,
+	ScopeWriteMedia,
+	ScopeReadMutes,
+	ScopeWriteMutes,
+	ScopeReadNotifications,
+	ScopeWriteNotifications,
+	ScopeWriteReports,
+	ScopeReadSearch,
+	ScopeReadStatuses,
+	ScopeWriteStatuses,
+	ScopeAdminReadAccounts,
+	ScopeAdminWriteAccounts,
+	ScopeAdminReadCustomEmojis,
+	ScopeAdminWriteCustomEmojis,
+	ScopeAdminReadDomainAllows,
+	ScopeAdminWriteDomainAllows,
+	ScopeAdminReadDomainBlocks,
+	ScopeAdminWriteDomainBlocks,
+	ScopeAdminReadDomainLimits,
+	ScopeAdminWriteDomainLimits,
+	ScopeAdminReadReports,
+	ScopeAdminWriteReports,
+}
+
+// Known returns true if the scope is
+// a recognized top-level or granular scope.
+func (s Scope) Known() bool {
+	return slices.Contains(knownScopes, s)
+}
+
+// IsAdmin returns true if the scope
+// is, or falls under, the admin scope.
+func (s Scope) IsAdmin() bool {
+	return ScopeAdmin.Permits(s)
+}
+
 // Permits returns true if the
 // scope permits the wanted scope.
 func (has Scope) Permits(wanted Scope) bool {
@@ -109,3 +109,27 @@ func TestScopes(t *testing.T) {
 		}
 	}
 }
+
+func TestScopeKnown(t *testing.T) {
+	for _, test := range []struct {
+		Scope       util.Scope
+		ExpectKnown bool
+		ExpectAdmin bool
+	}{
+		{Scope: util.ScopeRead, ExpectKnown: true},
+		{Scope: util.ScopeReadStatuses, ExpectKnown: true},
+		{Scope: util.ScopeWriteMedia, ExpectKnown: true},
+		{Scope: util.ScopeAdmin, ExpectKnown: true, ExpectAdmin: true},
+		{Scope: util.ScopeAdminReadReports, ExpectKnown: true, ExpectAdmin: true},
+		{Scope: util.Scope("read:nonsense")},
+		{Scope: util.Scope("admin:read:nonsense"), ExpectAdmin: true},
+		{Scope: util.Scope("")},
+	} {
+		if known := test.Scope.Known(); known != test.ExpectKnown {
+			t.Errorf("expected Known() %v for scope %q, got %v", test.ExpectKnown, test.Scope, known)
+		}
+		if admin := test.Scope.IsAdmin(); admin != test.ExpectAdmin {
+			t.Errorf("expected IsAdmin() %v for scope %q, got %v", test.ExpectAdmin, test.Scope, admin)
+		}
+	}
+}
@@ -21,6 +21,7 @@
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
@@ -74,13 +75,15 @@ func (m *Module) WebfingerGETRequest(c *gin.Context) {
 		return
 	}
 
-	if requestedHost != config.GetHost() && requestedHost != config.GetAccountDomain() {
+	if requestedHost != config.GetHost() &&
+		requestedHost != config.GetAccountDomain() &&
+		!slices.Contains(config.GetInstanceWebfingerAliasDomains(), requestedHost) {
 		err := fmt.Errorf("requested host %s does not belong to this instance", requestedHost)
 		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
 		return
 	}
 
-	resp, errWithCode := m.processor.Fedi().WebfingerGet(c.Request.Context(), requestedUser)
+	resp, errWithCode := m.processor.Fedi().WebfingerGet(c.Request.Context(), requestedUser, requestedHost)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
@@ -288,6 +288,90 @@ func (suite *WebfingerGetTestSuite) TestFingerUserWithoutAcct() {
 }`, resp)
 }
 
+func (suite *WebfingerGetTestSuite) TestFingerUserByAliasDomain() {
+	// Any local username should resolve
+	// on a configured webfinger alias domain.
+	config.SetInstanceWebfingerAliasDomains([]string{"gts.example"})
+
+	targetAccount := suite.testAccounts["local_account_1"]
+	requestPath := fmt.Sprintf("/%s?resource=acct:%s@gts.example", webfinger.WebfingerBasePath, targetAccount.Username)
+
+	resp := suite.finger(requestPath)
+	suite.Equal(`{
+  "subject": "acct:the_mighty_zork@localhost:8080",
+  "aliases": [
+    "http://localhost:8080/users/the_mighty_zork",
+    "http://localhost:8080/@the_mighty_zork",
+    "acct:the_mighty_zork@gts.example"
+  ],
+  "links": [
+    {
+      "rel": "http://webfinger.net/rel/profile-page",
+      "type": "text/html",
+      "href": "http://localhost:8080/@the_mighty_zork"
+    },
+    {
+      "rel": "self",
+      "type": "application/activity+json",
+      "href": "http://localhost:8080/users/the_mighty_zork"
+    }
+  ]
+}`, resp)
+}
+
+func (suite *WebfingerGetTestSuite) TestFingerUserByAlias() {
+	config.SetInstanceWebfingerAliasDomains([]string{"gts.example"})
+
+	// Map a custom name onto zork.
+	targetAccount := suite.testAccounts["local_account_1"]
+	if err := suite.db.PutWebfingerAlias(suite.T().Context(), &gtsmodel.WebfingerAlias{
+		ID:        "01JAAAAAAAAAAAAAAAAAAAAAAA",
+		Username:  "me",
+		Domain:    "gts.example",
+		AccountID: targetAccount.ID,
+		AuthorID:  suite.testAccounts["admin_account"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	requestPath := fmt.Sprintf("/%s?resource=acct:me@gts.example", webfinger.WebfingerBasePath)
+
+	resp := suite.finger(requestPath)
+	suite.Equal(`{
+  "subject": "acct:the_mighty_zork@localhost:8080",
+  "aliases": [
+    "http://localhost:8080/users/the_mighty_zork",
+    "http://localhost:8080/@the_mighty_zork",
+    "acct:me@gts.example"
+  ],
+  "links": [
+    {
+      "rel": "http://webfinger.net/rel/profile-page",
+      "type": "text/html",
+      "href": "http://localhost:8080/@the_mighty_zork"
+    },
+    {
+      "rel": "self",
+      "type": "application/activity+json",
+      "href": "http://localhost:8080/users/the_mighty_zork"
+    }
+  ]
+}`, resp)
+}
+
+func (suite *WebfingerGetTestSuite) TestFingerUserUnknownDomain() {
+	targetAccount := suite.testAccounts["local_account_1"]
+	requestPath := fmt.Sprintf("/%s?resource=acct:%s@gts.example", webfinger.WebfingerBasePath, targetAccount.Username)
+
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Request = httptest.NewRequest(http.MethodGet, requestPath, nil)
+	ctx.Request.Header.Set("accept", "application/jrd+json")
+
+	suite.webfingerModule.WebfingerGETRequest(ctx)
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+}
+
 func TestWebfingerGetTestSuite(t *testing.T) {
 	suite.Run(t, new(WebfingerGetTestSuite))
 }
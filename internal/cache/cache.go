@@ -18,10 +18,13 @@
 package cache
 
 import (
+	"reflect"
 	"time"
 
 	"code.superseriousbusiness.org/gopkg/log"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/cache/headerfilter"
+	"code.superseriousbusiness.org/gotosocial/internal/cache/iprule"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"codeberg.org/gruf/go-cache/v3/ttl"
@@ -33,6 +36,10 @@ type Caches struct {
 	// gtsmodel object caches. (used by the database).
 	DB DBCaches
 
+	// AccountRateLimits provides access to the
+	// per-account rate limited action counters.
+	AccountRateLimits AccountRateLimits
+
 	// AllowHeaderFilters provides access to
 	// the allow []headerfilter.Filter cache.
 	AllowHeaderFilters headerfilter.Cache
@@ -41,6 +48,10 @@ type Caches struct {
 	// the block []headerfilter.Filter cache.
 	BlockHeaderFilters headerfilter.Cache
 
+	// IPRules provides access to the
+	// cache of sign up / sign in IP rules.
+	IPRules iprule.Cache
+
 	// Timelines provides access to the
 	// collection of timeline object caches,
 	// used in timeline lookups and streaming.
@@ -58,6 +69,23 @@ type Caches struct {
 	// cache. (used by the visibility filter).
 	Visibility StructCache[*CachedVisibility]
 
+	// DomainImpact provides access to the cache of
+	// computed local impact of remote domains, by domain.
+	DomainImpact *ttl.Cache[string, *apimodel.DomainImpact] // TTL=5min, sweep=1min
+
+	// InboxActivities provides access to the cache of
+	// recently processed incoming activity IDs, by
+	// receiving account ID + activity ID.
+	InboxActivities *ttl.Cache[string, struct{}] // TTL=1hr, sweep=5min
+
+	// RemoteTimeline provides access to the cache of
+	// fetched remote instance public timelines, by domain.
+	RemoteTimeline *ttl.Cache[string, []*apimodel.Status] // TTL=1min, sweep=1min
+
+	// Translation provides access to the cache of
+	// status translations, by status ID and language.
+	Translation *ttl.Cache[string, *CachedTranslation] // TTL=24hr, sweep=5min
+
 	// Webfinger provides access to the webfinger URL cache.
 	Webfinger *ttl.Cache[string, string] // TTL=24hr, sweep=5min
 
@@ -72,16 +100,23 @@ func (c *Caches) Init() {
 
 	c.initAccount()
 	c.initAccountNote()
+	c.initAccountRateLimits()
 	c.initAccountSettings()
 	c.initAccountStats()
+	c.initAntenna()
+	c.initAntennaIDs()
+	c.initAntennaTimelines()
 	c.initApplication()
 	c.initBlock()
 	c.initBlockIDs()
+	c.initBookmarkCollection()
+	c.initBookmarkCollectionIDs()
 	c.initBoostOfIDs()
 	c.initConversation()
 	c.initConversationLastStatusIDs()
 	c.initDomainAllow()
 	c.initDomainBlock()
+	c.initDomainImpact()
 	c.initDomainLimit()
 	c.initDomainLimited()
 	c.initDomainPermissionDraft()
@@ -98,11 +133,13 @@ func (c *Caches) Init() {
 	c.initFollowRequest()
 	c.initFollowRequestIDs()
 	c.initFollowingTagIDs()
+	c.initInboxActivities()
 	c.initHomeAccountIDs()
 	c.initHomeTimelines()
 	c.initInReplyToIDs()
 	c.initInstance()
 	c.initInteractionRequest()
+	c.initLanguageTimelines()
 	c.initList()
 	c.initListIDs()
 	c.initListedIDs()
@@ -112,11 +149,13 @@ func (c *Caches) Init() {
 	c.initMedia()
 	c.initMention()
 	c.initMove()
+	c.initMutedTagIDs()
 	c.initNotification()
 	c.initPoll()
 	c.initPollVote()
 	c.initPollVoteIDs()
 	c.initPublicTimeline()
+	c.initRemoteTimeline()
 	c.initReport()
 	c.initScheduledStatus()
 	c.initSinBinStatus()
@@ -126,11 +165,14 @@ func (c *Caches) Init() {
 	c.initStatusEdit()
 	c.initStatusFave()
 	c.initStatusFaveIDs()
+	c.initStatusReaction()
+	c.initStatusReactionIDs()
 	c.initTag()
 	c.initTagTimelines()
 	c.initThreadMute()
 	c.initToken()
 	c.initTombstone()
+	c.initTranslation()
 	c.initUser()
 	c.initUserMute()
 	c.initUserMuteIDs()
@@ -151,6 +193,22 @@ func (c *Caches) Start() error {
 		return gtserror.New("could not start webfinger cache")
 	}
 
+	if !c.DomainImpact.Start(time.Minute) {
+		return gtserror.New("could not start domain impact cache")
+	}
+
+	if !c.InboxActivities.Start(5 * time.Minute) {
+		return gtserror.New("could not start inbox activities cache")
+	}
+
+	if !c.RemoteTimeline.Start(time.Minute) {
+		return gtserror.New("could not start remote timeline cache")
+	}
+
+	if !c.Translation.Start(5 * time.Minute) {
+		return gtserror.New("could not start translation cache")
+	}
+
 	return nil
 }
 
@@ -162,6 +220,68 @@ func (c *Caches) Stop() {
 	if c.Webfinger != nil {
 		_ = c.Webfinger.Stop()
 	}
+
+	if c.DomainImpact != nil {
+		_ = c.DomainImpact.Stop()
+	}
+
+	if c.InboxActivities != nil {
+		_ = c.InboxActivities.Stop()
+	}
+
+	if c.RemoteTimeline != nil {
+		_ = c.RemoteTimeline.Stop()
+	}
+
+	if c.Translation != nil {
+		_ = c.Translation.Stop()
+	}
+}
+
+// CacheSize contains the current
+// length and capacity of a cache.
+type CacheSize struct {
+	Len int
+	Cap int
+}
+
+// Sizes returns the current length and capacity of each of
+// the database caches and other sized caches, keyed by name
+// (eg., "DB.Account"). This is intended for debugging memory
+// usage, and makes use of reflection so should not be hot.
+func (c *Caches) Sizes() map[string]CacheSize {
+	sizes := make(map[string]CacheSize)
+	addSizes(sizes, "", reflect.ValueOf(c).Elem())
+	addSizes(sizes, "DB.", reflect.ValueOf(&c.DB).Elem())
+	return sizes
+}
+
+// addSizes adds the size of each field
+// of struct value v that is a sized cache.
+func addSizes(sizes map[string]CacheSize, prefix string, v reflect.Value) {
+	type sized interface {
+		Len() int
+		Cap() int
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if !field.CanAddr() || !field.Addr().CanInterface() {
+			continue
+		}
+
+		// Check whether field is a sized cache.
+		c, ok := field.Addr().Interface().(sized)
+		if !ok {
+			continue
+		}
+
+		name := prefix + v.Type().Field(i).Name
+		sizes[name] = CacheSize{
+			Len: c.Len(),
+			Cap: c.Cap(),
+		}
+	}
 }
 
 // Sweep will sweep all the available caches to ensure none
@@ -175,9 +295,13 @@ func (c *Caches) Sweep(threshold float64) {
 	c.DB.AccountNote.Trim(threshold)
 	c.DB.AccountSettings.Trim(threshold)
 	c.DB.AccountStats.Trim(threshold)
+	c.DB.Antenna.Trim(threshold)
+	c.DB.AntennaIDs.Trim(threshold)
 	c.DB.Application.Trim(threshold)
 	c.DB.Block.Trim(threshold)
 	c.DB.BlockIDs.Trim(threshold)
+	c.DB.BookmarkCollection.Trim(threshold)
+	c.DB.BookmarkCollectionIDs.Trim(threshold)
 	c.DB.BoostOfIDs.Trim(threshold)
 	c.DB.Conversation.Trim(threshold)
 	c.DB.ConversationLastStatusIDs.Trim(threshold)
@@ -202,6 +326,7 @@ func (c *Caches) Sweep(threshold float64) {
 	c.DB.Media.Trim(threshold)
 	c.DB.Mention.Trim(threshold)
 	c.DB.Move.Trim(threshold)
+	c.DB.MutedTagIDs.Trim(threshold)
 	c.DB.Notification.Trim(threshold)
 	c.DB.Poll.Trim(threshold)
 	c.DB.PollVote.Trim(threshold)
@@ -214,6 +339,8 @@ func (c *Caches) Sweep(threshold float64) {
 	c.DB.StatusBookmarkIDs.Trim(threshold)
 	c.DB.StatusFave.Trim(threshold)
 	c.DB.StatusFaveIDs.Trim(threshold)
+	c.DB.StatusReaction.Trim(threshold)
+	c.DB.StatusReactionIDs.Trim(threshold)
 	c.DB.Tag.Trim(threshold)
 	c.DB.ThreadMute.Trim(threshold)
 	c.DB.Token.Trim(threshold)
@@ -223,11 +350,63 @@ func (c *Caches) Sweep(threshold float64) {
 	c.DB.UserMuteIDs.Trim(threshold)
 	c.Mutes.Trim(threshold)
 	c.StatusFilter.Trim(threshold)
+	c.Timelines.Antenna.Trim()
 	c.Timelines.Home.Trim()
 	c.Timelines.List.Trim()
 	c.Visibility.Trim(threshold)
 }
 
+func (c *Caches) initDomainImpact() {
+	// Domain impacts are only cached for
+	// a few minutes, and only for the few
+	// domains being evaluated by admins at
+	// any one time, so a small fixed cap.
+	cap := 100
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.DomainImpact = new(ttl.Cache[string, *apimodel.DomainImpact])
+	c.DomainImpact.Init(
+		0,
+		cap,
+		5*time.Minute,
+	)
+}
+
+func (c *Caches) initInboxActivities() {
+	// Activity IDs are small, and only need
+	// to be kept for a short while as the
+	// database backs longer redelivery windows,
+	// so a fixed cap covers even busy inboxes.
+	cap := 10000
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.InboxActivities = new(ttl.Cache[string, struct{}])
+	c.InboxActivities.Init(
+		0,
+		cap,
+		time.Hour,
+	)
+}
+
+func (c *Caches) initRemoteTimeline() {
+	// Remote timelines are only cached
+	// very briefly, and only for the small
+	// number of domains being browsed at
+	// any one time, so a small fixed cap.
+	cap := 100
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.RemoteTimeline = new(ttl.Cache[string, []*apimodel.Status])
+	c.RemoteTimeline.Init(
+		0,
+		cap,
+		time.Minute,
+	)
+}
+
 func (c *Caches) initWebfinger() {
 	// Calculate maximum cache size.
 	cap := calculateCacheMax(
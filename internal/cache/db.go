@@ -40,6 +40,22 @@ type DBCaches struct {
 	// AccountStats provides access to the gtsmodel AccountStats database cache.
 	AccountStats StructCache[*gtsmodel.AccountStats]
 
+	// Antenna provides access to the gtsmodel Antenna database cache.
+	Antenna StructCache[*gtsmodel.Antenna]
+
+	// AntennaIDs provides access to the antenna IDs db cache.
+	// THIS CACHE IS KEYED AS FOLLOWING {prefix}{id} WHERE:
+	//
+	// - 'a{$accountID}' for antenna IDs owned by account
+	//   e.g. AntennaIDs.Load("a" + account.ID, func() {})
+	//   which will load a slice of antenna IDs owned by account.
+	//
+	// - '*' for the IDs of all antennas on the instance
+	//   e.g. AntennaIDs.Load("*", func() {})
+	//   which will load a slice of all antenna IDs.
+	//
+	AntennaIDs SliceCache[string]
+
 	// Application provides access to the gtsmodel Application database cache.
 	Application StructCache[*gtsmodel.Application]
 
@@ -52,6 +68,13 @@ type DBCaches struct {
 	// BoostOfIDs provides access to the boost of IDs list database cache.
 	BoostOfIDs SliceCache[string]
 
+	// BookmarkCollection provides access to the gtsmodel BookmarkCollection database cache.
+	BookmarkCollection StructCache[*gtsmodel.BookmarkCollection]
+
+	// BookmarkCollectionIDs provides access to the bookmark collection IDs list database cache.
+	// This cache is keyed as: {accountID} -> []{collectionIDs}
+	BookmarkCollectionIDs SliceCache[string]
+
 	// Conversation provides access to the gtsmodel Conversation database cache.
 	Conversation StructCache[*gtsmodel.Conversation]
 
@@ -85,6 +108,10 @@ type DBCaches struct {
 	// EmojiCategory provides access to the gtsmodel EmojiCategory database cache.
 	EmojiCategory StructCache[*gtsmodel.EmojiCategory]
 
+	// UseableEmojiIDs caches the IDs of local emojis
+	// that are enabled and visible in the emoji picker.
+	UseableEmojiIDs atomic.Pointer[[]string]
+
 	// Filter provides access to the gtsmodel Filter database cache.
 	Filter StructCache[*gtsmodel.Filter]
 
@@ -214,6 +241,10 @@ type DBCaches struct {
 	// Move provides access to the gtsmodel Move database cache.
 	Move StructCache[*gtsmodel.Move]
 
+	// MutedTagIDs provides access to the tag IDs muted by account db cache.
+	// This cache is keyed as: {accountID} -> []{tagIDs}
+	MutedTagIDs SliceCache[string]
+
 	// Notification provides access to the gtsmodel Notification database cache.
 	Notification StructCache[*gtsmodel.Notification]
 
@@ -253,6 +284,13 @@ type DBCaches struct {
 	// StatusFaveIDs provides access to the status fave IDs list database cache.
 	StatusFaveIDs SliceCache[string]
 
+	// StatusReaction provides access to the gtsmodel StatusReaction database cache.
+	StatusReaction StructCache[*gtsmodel.StatusReaction]
+
+	// StatusReactionIDs provides access to the status reaction IDs list database cache.
+	// This cache is keyed as: {statusID} -> []{reactionIDs}
+	StatusReactionIDs SliceCache[string]
+
 	// Tag provides access to the gtsmodel Tag database cache.
 	Tag StructCache[*gtsmodel.Tag]
 
@@ -387,6 +425,7 @@ func (c *Caches) initAccountSettings() {
 			*s2 = *s1
 			return s2
 		},
+		Invalidate: c.OnInvalidateAccountSettings,
 	})
 }
 
@@ -413,6 +452,49 @@ func (c *Caches) initAccountStats() {
 	})
 }
 
+func (c *Caches) initAntenna() {
+	// Calculate maximum cache size.
+	cap := calculateResultCacheMax(
+		sizeofAntenna(), // model in-mem size.
+		config.GetCacheAntennaMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	copyF := func(a1 *gtsmodel.Antenna) *gtsmodel.Antenna {
+		a2 := new(gtsmodel.Antenna)
+		*a2 = *a1
+
+		// Don't include ptr fields that
+		// will be populated separately.
+		// See internal/db/bundb/antenna.go.
+		a2.Account = nil
+
+		return a2
+	}
+
+	c.DB.Antenna.Init(structr.CacheConfig[*gtsmodel.Antenna]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ID"},
+		},
+		MaxSize:    cap,
+		IgnoreErr:  ignoreErrors,
+		Copy:       copyF,
+		Invalidate: c.OnInvalidateAntenna,
+	})
+}
+
+func (c *Caches) initAntennaIDs() {
+	// Calculate maximum cache size.
+	cap := calculateSliceCacheMax(
+		config.GetCacheAntennaIDsMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.DB.AntennaIDs.Init(0, cap)
+}
+
 func (c *Caches) initApplication() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -499,6 +581,49 @@ func (c *Caches) initBoostOfIDs() {
 	c.DB.BoostOfIDs.Init(0, cap)
 }
 
+func (c *Caches) initBookmarkCollection() {
+	// Calculate maximum cache size.
+	cap := calculateResultCacheMax(
+		sizeofBookmarkCollection(), // model in-mem size.
+		config.GetCacheBookmarkCollectionMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	copyF := func(c1 *gtsmodel.BookmarkCollection) *gtsmodel.BookmarkCollection {
+		c2 := new(gtsmodel.BookmarkCollection)
+		*c2 = *c1
+
+		// Don't include ptr fields that
+		// will be populated separately.
+		// See internal/db/bundb/statusbookmark.go.
+		c2.Account = nil
+
+		return c2
+	}
+
+	c.DB.BookmarkCollection.Init(structr.CacheConfig[*gtsmodel.BookmarkCollection]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ID"},
+		},
+		MaxSize:    cap,
+		IgnoreErr:  ignoreErrors,
+		Copy:       copyF,
+		Invalidate: c.OnInvalidateBookmarkCollection,
+	})
+}
+
+func (c *Caches) initBookmarkCollectionIDs() {
+	// Calculate maximum cache size.
+	cap := calculateSliceCacheMax(
+		config.GetCacheBookmarkCollectionIDsMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.DB.BookmarkCollectionIDs.Init(0, cap)
+}
+
 func (c *Caches) initConversation() {
 	cap := calculateResultCacheMax(
 		sizeofConversation(), // model in-mem size.
@@ -685,9 +810,10 @@ func (c *Caches) initEmoji() {
 			{Fields: "ImageStaticURL"},
 			{Fields: "CategoryID", Multiple: true},
 		},
-		MaxSize:   cap,
-		IgnoreErr: ignoreErrors,
-		Copy:      copyF,
+		MaxSize:    cap,
+		IgnoreErr:  ignoreErrors,
+		Copy:       copyF,
+		Invalidate: c.OnInvalidateEmoji,
 	})
 }
 
@@ -1196,6 +1322,17 @@ func (c *Caches) initMove() {
 	})
 }
 
+func (c *Caches) initMutedTagIDs() {
+	// Calculate maximum cache size.
+	cap := calculateSliceCacheMax(
+		config.GetCacheMutedTagIDsMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.DB.MutedTagIDs.Init(0, cap)
+}
+
 func (c *Caches) initNotification() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -1335,6 +1472,7 @@ func (c *Caches) initReport() {
 		r2.Statuses = nil
 		r2.Rules = nil
 		r2.ActionTakenByAccount = nil
+		r2.AssignedAccount = nil
 
 		return r2
 	}
@@ -1585,6 +1723,55 @@ func (c *Caches) initStatusFaveIDs() {
 	c.DB.StatusFaveIDs.Init(0, cap)
 }
 
+func (c *Caches) initStatusReaction() {
+	// Calculate maximum cache size.
+	cap := calculateResultCacheMax(
+		sizeofStatusReaction(), // model in-mem size.
+		config.GetCacheStatusReactionMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	copyF := func(r1 *gtsmodel.StatusReaction) *gtsmodel.StatusReaction {
+		r2 := new(gtsmodel.StatusReaction)
+		*r2 = *r1
+
+		// Don't include ptr fields that
+		// will be populated separately.
+		// See internal/db/bundb/statusreaction.go.
+		r2.Account = nil
+		r2.TargetAccount = nil
+		r2.Status = nil
+		r2.Emoji = nil
+
+		return r2
+	}
+
+	c.DB.StatusReaction.Init(structr.CacheConfig[*gtsmodel.StatusReaction]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ID"},
+			{Fields: "URI"},
+			{Fields: "AccountID,StatusID,Content"},
+			{Fields: "StatusID", Multiple: true},
+		},
+		MaxSize:    cap,
+		IgnoreErr:  ignoreErrors,
+		Copy:       copyF,
+		Invalidate: c.OnInvalidateStatusReaction,
+	})
+}
+
+func (c *Caches) initStatusReactionIDs() {
+	// Calculate maximum cache size.
+	cap := calculateSliceCacheMax(
+		config.GetCacheStatusReactionIDsMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.DB.StatusReactionIDs.Init(0, cap)
+}
+
 func (c *Caches) initTag() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -36,6 +36,12 @@ func (c *Caches) OnInvalidateAccount(account *gtsmodel.Account) {
 	// Invalidate as possible visibility target result.
 	c.Visibility.Invalidate("ItemID", account.ID)
 
+	// Invalidate visibility results of statuses authored
+	// (or boosted) by account, as changes to the account
+	// (e.g. suspension, web visibility) may affect these.
+	c.Visibility.Invalidate("AccountID", account.ID)
+	c.Visibility.Invalidate("BoostOfAccountID", account.ID)
+
 	// If account is local, invalidate as
 	// possible visibility result requester,
 	// also, invalidate any cached stats.
@@ -70,6 +76,23 @@ func (c *Caches) OnInvalidateAccount(account *gtsmodel.Account) {
 	c.DB.Move.Invalidate("TargetURI", account.URI)
 }
 
+func (c *Caches) OnInvalidateAccountSettings(settings *gtsmodel.AccountSettings) {
+	// Invalidate visibility results of the account and
+	// its authored statuses, as settings changes (e.g.
+	// web visibility) may affect these visibilities.
+	c.Visibility.Invalidate("ItemID", settings.AccountID)
+	c.Visibility.Invalidate("AccountID", settings.AccountID)
+}
+
+func (c *Caches) OnInvalidateAntenna(antenna *gtsmodel.Antenna) {
+	// Invalidate antenna IDs cache of owning
+	// account, and that of all antenna IDs.
+	c.DB.AntennaIDs.Invalidate(
+		"a"+antenna.AccountID,
+		"*",
+	)
+}
+
 func (c *Caches) OnInvalidateApplication(app *gtsmodel.Application) {
 	// TODO: invalidate tokens?
 }
@@ -104,11 +127,23 @@ func (c *Caches) OnInvalidateBlock(block *gtsmodel.Block) {
 	c.DB.BlockIDs.Invalidate(block.AccountID)
 }
 
+func (c *Caches) OnInvalidateBookmarkCollection(collection *gtsmodel.BookmarkCollection) {
+	// Invalidate collection IDs cache of owning account.
+	c.DB.BookmarkCollectionIDs.Invalidate(collection.AccountID)
+}
+
 func (c *Caches) OnInvalidateConversation(conversation *gtsmodel.Conversation) {
 	// Invalidate owning account's conversation list.
 	c.DB.ConversationLastStatusIDs.Invalidate(conversation.AccountID)
 }
 
+func (c *Caches) OnInvalidateEmoji(emoji *gtsmodel.Emoji) {
+	if emoji.IsLocal() {
+		// Invalidate the useable local emoji IDs.
+		c.DB.UseableEmojiIDs.Store(nil)
+	}
+}
+
 func (c *Caches) OnInvalidateEmojiCategory(category *gtsmodel.EmojiCategory) {
 	// Invalidate any emoji in this category.
 	c.DB.Emoji.Invalidate("CategoryID", category.ID)
@@ -380,6 +415,11 @@ func (c *Caches) OnInvalidateStatusFave(fave *gtsmodel.StatusFave) {
 	c.DB.StatusFaveIDs.Invalidate(fave.StatusID)
 }
 
+func (c *Caches) OnInvalidateStatusReaction(reaction *gtsmodel.StatusReaction) {
+	// Invalidate status reaction ID list for this status.
+	c.DB.StatusReactionIDs.Invalidate(reaction.StatusID)
+}
+
 func (c *Caches) OnInvalidateThreadMute(mute *gtsmodel.ThreadMute) {
 	// Invalidate cached mute ressults encapsulating this thread and account.
 	c.Mutes.Invalidate("RequesterID,ThreadID", mute.AccountID, mute.ThreadID)
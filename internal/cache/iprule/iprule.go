@@ -0,0 +1,107 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package iprule
+
+import (
+	"fmt"
+	"net/netip"
+	"sync/atomic"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// Cache provides a means of caching parsed IP rules in
+// memory to reduce load on an underlying storage mechanism.
+type Cache struct {
+	// current cached IP rules slice.
+	ptr atomic.Pointer[[]rule]
+}
+
+// rule wraps a gtsmodel.IPRule
+// with its pre-parsed IP prefix.
+type rule struct {
+	prefix netip.Prefix
+	rule   *gtsmodel.IPRule
+}
+
+// Match returns all unexpired rules containing the given IP address, loading using callback if necessary.
+func (c *Cache) Match(ip netip.Addr, load func() ([]*gtsmodel.IPRule, error)) ([]*gtsmodel.IPRule, error) {
+	// Load ptr value.
+	ptr := c.ptr.Load()
+
+	if ptr == nil {
+		// Cache is not hydrated.
+		// Load rules from callback.
+		rules, err := loadRules(load)
+		if err != nil {
+			return nil, err
+		}
+
+		// Store the new
+		// IP rules.
+		ptr = &rules
+		c.ptr.Store(ptr)
+	}
+
+	// Ensure we compare IPv4-mapped
+	// IPv6 addresses as plain IPv4.
+	ip = ip.Unmap()
+	now := time.Now()
+
+	var matches []*gtsmodel.IPRule
+	for _, r := range *ptr {
+		if r.rule.Expired(now) {
+			continue
+		}
+		if r.prefix.Contains(ip) {
+			matches = append(matches, r.rule)
+		}
+	}
+
+	return matches, nil
+}
+
+// Clear will drop the currently loaded rules,
+// triggering a reload on next call to .Match().
+func (c *Cache) Clear() { c.ptr.Store(nil) }
+
+// loadRules will load rules from given load callback, parsing their IP prefixes.
+func loadRules(load func() ([]*gtsmodel.IPRule, error)) ([]rule, error) {
+	// Load rules from callback.
+	ipRules, err := load()
+	if err != nil {
+		return nil, fmt.Errorf("error reloading cache: %w", err)
+	}
+
+	// Allocate new rule slice to store parsed prefixes.
+	rules := make([]rule, 0, len(ipRules))
+
+	for _, ipRule := range ipRules {
+		prefix, err := netip.ParsePrefix(ipRule.IP)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing ip rule %s: %w", ipRule.ID, err)
+		}
+		rules = append(rules, rule{
+			prefix: prefix,
+			rule:   ipRule,
+		})
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,58 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/ulule/limiter/v3"
+	"github.com/ulule/limiter/v3/drivers/store/memory"
+)
+
+// AccountRateLimitPeriod is the fixed window
+// over which per-account rate limits apply.
+const AccountRateLimitPeriod = time.Hour
+
+func (c *Caches) initAccountRateLimits() {
+	c.AccountRateLimits.Init()
+}
+
+// AccountRateLimits provides access to per-account
+// counters of rate limited actions, such as posting
+// statuses, following accounts or creating reports.
+type AccountRateLimits struct {
+	store limiter.Store
+}
+
+// Init will (re)initialize the underlying counter store.
+func (r *AccountRateLimits) Init() {
+	r.store = memory.NewStoreWithOptions(limiter.StoreOptions{
+		Prefix:          "account",
+		CleanUpInterval: 5 * time.Minute,
+	})
+}
+
+// Increment increments the counter for action performed by account
+// with ID, returning the updated rate limit context given limit.
+func (r *AccountRateLimits) Increment(ctx context.Context, accountID string, action string, limit int) (limiter.Context, error) {
+	return r.store.Get(ctx, action+":"+accountID, limiter.Rate{
+		Period: AccountRateLimitPeriod,
+		Limit:  int64(limit),
+	})
+}
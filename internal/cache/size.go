@@ -18,6 +18,7 @@
 package cache
 
 import (
+	"crypto/ed25519"
 	"crypto/rsa"
 	"regexp"
 	"strings"
@@ -176,35 +177,41 @@ func calculateCacheMax(keySz, valSz uintptr, ratio float64) int {
 
 func sizeofAccount() uintptr {
 	return uintptr(size.Of(&gtsmodel.Account{
-		ID:                      exampleID,
-		Username:                exampleUsername,
-		AvatarMediaAttachmentID: exampleID,
-		HeaderMediaAttachmentID: exampleID,
-		DisplayName:             exampleUsername,
-		Note:                    exampleText,
-		NoteRaw:                 exampleText,
-		MemorializedAt:          exampleTime,
-		CreatedAt:               exampleTime,
-		UpdatedAt:               exampleTime,
-		FetchedAt:               exampleTime,
-		Locked:                  util.Ptr(true),
-		Discoverable:            util.Ptr(false),
-		Indexable:               util.Ptr(false),
-		URI:                     exampleURI,
-		URL:                     exampleURI,
-		InboxURI:                exampleURI,
-		OutboxURI:               exampleURI,
-		FollowersURI:            exampleURI,
-		FollowingURI:            exampleURI,
-		FeaturedCollectionURI:   exampleURI,
-		ActorType:               gtsmodel.AccountActorTypePerson,
-		PrivateKey:              &rsa.PrivateKey{},
-		PublicKey:               &rsa.PublicKey{},
-		PublicKeyURI:            exampleURI,
-		SensitizedAt:            exampleTime,
-		SilencedAt:              exampleTime,
-		SuspendedAt:             exampleTime,
-		SuspensionOrigin:        exampleID,
+		ID:                         exampleID,
+		Username:                   exampleUsername,
+		AvatarMediaAttachmentID:    exampleID,
+		HeaderMediaAttachmentID:    exampleID,
+		DisplayName:                exampleUsername,
+		Note:                       exampleText,
+		NoteRaw:                    exampleText,
+		MemorializedAt:             exampleTime,
+		CreatedAt:                  exampleTime,
+		UpdatedAt:                  exampleTime,
+		FetchedAt:                  exampleTime,
+		Locked:                     util.Ptr(true),
+		Discoverable:               util.Ptr(false),
+		Indexable:                  util.Ptr(false),
+		URI:                        exampleURI,
+		URL:                        exampleURI,
+		InboxURI:                   exampleURI,
+		OutboxURI:                  exampleURI,
+		FollowersURI:               exampleURI,
+		FollowingURI:               exampleURI,
+		FeaturedCollectionURI:      exampleURI,
+		ActorType:                  gtsmodel.AccountActorTypePerson,
+		PrivateKey:                 &rsa.PrivateKey{},
+		PublicKey:                  &rsa.PublicKey{},
+		PublicKeyURI:               exampleURI,
+		PreviousPublicKey:          &rsa.PublicKey{},
+		PreviousPublicKeyURI:       exampleURI,
+		PreviousPublicKeyExpiresAt: exampleTime,
+		Ed25519PrivateKey:          make(ed25519.PrivateKey, ed25519.PrivateKeySize),
+		Ed25519PublicKey:           make(ed25519.PublicKey, ed25519.PublicKeySize),
+		Ed25519PublicKeyURI:        exampleURI,
+		SensitizedAt:               exampleTime,
+		SilencedAt:                 exampleTime,
+		SuspendedAt:                exampleTime,
+		SuspensionOrigin:           exampleID,
 	}))
 }
 
@@ -219,16 +226,25 @@ func sizeofAccountNote() uintptr {
 
 func sizeofAccountSettings() uintptr {
 	return uintptr(size.Of(&gtsmodel.AccountSettings{
-		AccountID:         exampleID,
-		CreatedAt:         exampleTime,
-		UpdatedAt:         exampleTime,
-		Privacy:           gtsmodel.VisibilityFollowersOnly,
-		Sensitive:         util.Ptr(true),
-		Language:          "fr",
-		StatusContentType: "text/plain",
-		CustomCSS:         exampleText,
-		EnableRSS:         util.Ptr(true),
-		HideCollections:   util.Ptr(false),
+		AccountID:           exampleID,
+		CreatedAt:           exampleTime,
+		UpdatedAt:           exampleTime,
+		Privacy:             gtsmodel.VisibilityFollowersOnly,
+		Sensitive:           util.Ptr(true),
+		Language:            "fr",
+		StatusContentType:   "text/plain",
+		CustomCSS:           exampleText,
+		EnableRSS:           util.Ptr(true),
+		RSSFeedToken:        exampleID,
+		HideCollections:     util.Ptr(false),
+		DirectoryOptIn:      util.Ptr(false),
+		ShowNoteIndicators:  util.Ptr(false),
+		AllowEmbeds:         util.Ptr(true),
+		AutoDeleteAfterDays: 30,
+		FollowRequestRules: &gtsmodel.FollowRequestRules{
+			ApproveFollowersOfFollowers: true,
+			ApproveDomains:              []string{"example.org"},
+		},
 	}))
 }
 
@@ -243,6 +259,21 @@ func sizeofAccountStats() uintptr {
 	}))
 }
 
+func sizeofAntenna() uintptr {
+	return uintptr(size.Of(&gtsmodel.Antenna{
+		ID:              exampleID,
+		CreatedAt:       exampleTime,
+		UpdatedAt:       exampleTime,
+		Title:           exampleTextSmall,
+		AccountID:       exampleID,
+		Keywords:        []string{exampleTextSmall, exampleTextSmall},
+		ExcludeKeywords: []string{exampleTextSmall},
+		Domains:         []string{exampleTextSmall},
+		AccountPatterns: []string{exampleTextSmall},
+		WithReplies:     util.Ptr(false),
+	}))
+}
+
 func sizeofApplication() uintptr {
 	return uintptr(size.Of(&gtsmodel.Application{
 		ID:              exampleID,
@@ -267,6 +298,16 @@ func sizeofBlock() uintptr {
 	}))
 }
 
+func sizeofBookmarkCollection() uintptr {
+	return uintptr(size.Of(&gtsmodel.BookmarkCollection{
+		ID:        exampleID,
+		CreatedAt: exampleTime,
+		UpdatedAt: exampleTime,
+		Title:     exampleTextSmall,
+		AccountID: exampleID,
+	}))
+}
+
 func sizeofConversation() uintptr {
 	return uintptr(size.Of(&gtsmodel.Conversation{
 		ID:               exampleID,
@@ -476,14 +517,17 @@ func sizeofMedia() uintptr {
 		AccountID:         exampleID,
 		Description:       exampleText,
 		ScheduledStatusID: exampleID,
+		DraftID:           exampleID,
 		Blurhash:          exampleTextSmall,
 		File: gtsmodel.File{
 			Path:        exampleURI,
 			ContentType: "image/jpeg",
+			Checksum:    exampleTextSmall,
 		},
 		Thumbnail: gtsmodel.Thumbnail{
 			Path:        exampleURI,
 			ContentType: "image/jpeg",
+			Checksum:    exampleTextSmall,
 			URL:         exampleURI,
 			RemoteURL:   exampleURI,
 		},
@@ -526,6 +570,7 @@ func sizeofNotification() uintptr {
 		OriginAccountID:  exampleID,
 		StatusOrEditID:   exampleID,
 		Read:             func() *bool { ok := false; return &ok }(),
+		GroupKey:         "favourite-" + exampleID + "-123456",
 	}))
 }
 
@@ -564,6 +609,8 @@ func sizeofReport() uintptr {
 		ActionTaken:            exampleText,
 		ActionTakenAt:          exampleTime,
 		ActionTakenByAccountID: exampleID,
+		Category:               gtsmodel.ReportCategorySpam,
+		AssignedAccountID:      exampleID,
 	}))
 }
 
@@ -686,6 +733,19 @@ func sizeofStatusFave() uintptr {
 	}))
 }
 
+func sizeofStatusReaction() uintptr {
+	return uintptr(size.Of(&gtsmodel.StatusReaction{
+		ID:              exampleID,
+		CreatedAt:       exampleTime,
+		AccountID:       exampleID,
+		TargetAccountID: exampleID,
+		StatusID:        exampleID,
+		Content:         ":blobcat:",
+		EmojiID:         exampleID,
+		URI:             exampleURI,
+	}))
+}
+
 func sizeofStatusFilterResults() uintptr {
 	return uintptr(size.Of(&CachedStatusFilterResults{
 		StatusID:    exampleID,
@@ -767,10 +827,12 @@ func sizeofMute() uintptr {
 
 func sizeofVisibility() uintptr {
 	return uintptr(size.Of(&CachedVisibility{
-		ItemID:      exampleID,
-		RequesterID: exampleID,
-		Type:        VisibilityTypeAccount,
-		Value:       false,
+		ItemID:           exampleID,
+		RequesterID:      exampleID,
+		AccountID:        exampleID,
+		BoostOfAccountID: exampleID,
+		Type:             VisibilityTypeStatus,
+		Value:            false,
 	}))
 }
 
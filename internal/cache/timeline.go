@@ -36,6 +36,10 @@ type TimelineCaches struct {
 	// caches for home timelines, keyed by home's account ID.
 	Home timeline.StatusTimelines
 
+	// Antenna provides a concurrency-safe map of status
+	// timeline caches for antennas, keyed by antenna ID.
+	Antenna timeline.StatusTimelines
+
 	// List provides a concurrency-safe map of status
 	// timeline caches for lists, keyed by list ID.
 	List timeline.StatusTimelines
@@ -43,6 +47,16 @@ type TimelineCaches struct {
 	// Tag provides a concurrency-safe map of status
 	// timeline caches for tags, keyed by tag ID.
 	Tag timeline.StatusTimelines
+
+	// PublicLanguage provides a concurrency-safe map of
+	// status timeline caches for the public timeline
+	// sharded by language, keyed by language tag.
+	PublicLanguage timeline.StatusTimelines
+
+	// LocalLanguage provides a concurrency-safe map of
+	// status timeline caches for the local timeline
+	// sharded by language, keyed by language tag.
+	LocalLanguage timeline.StatusTimelines
 }
 
 func (c *Caches) initPublicTimeline() {
@@ -64,8 +78,7 @@ func (c *Caches) initLocalTimeline() {
 }
 
 func (c *Caches) initHomeTimelines() {
-	// TODO: configurable
-	cap := 800
+	cap := config.GetCacheHomeTimelineMaxEntries()
 
 	timeout := config.GetCacheHomeTimelineTimeout()
 	log.Infof(nil, "cache size = %d, timeout = %s", cap, timeout)
@@ -73,6 +86,16 @@ func (c *Caches) initHomeTimelines() {
 	c.Timelines.Home.Init(cap, timeout)
 }
 
+func (c *Caches) initAntennaTimelines() {
+	// TODO: configurable
+	cap := 400
+
+	timeout := config.GetCacheAntennaTimelineTimeout()
+	log.Infof(nil, "cache size = %d, timeout = %s", cap, timeout)
+
+	c.Timelines.Antenna.Init(cap, timeout)
+}
+
 func (c *Caches) initListTimelines() {
 	// TODO: configurable
 	cap := 800
@@ -92,3 +115,14 @@ func (c *Caches) initTagTimelines() {
 
 	c.Timelines.Tag.Init(cap, timeout)
 }
+
+func (c *Caches) initLanguageTimelines() {
+	// TODO: configurable
+	cap := 400
+
+	timeout := config.GetCacheLanguageTimelineTimeout()
+	log.Infof(nil, "cache size = %d, timeout = %s", cap, timeout)
+
+	c.Timelines.PublicLanguage.Init(cap, timeout)
+	c.Timelines.LocalLanguage.Init(cap, timeout)
+}
@@ -0,0 +1,34 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package timeline
+
+import "sync/atomic"
+
+// Metrics contains running counts of status timeline
+// page loads, for use in instance metrics. Together
+// these give the hit ratio of the timeline caches.
+var Metrics struct {
+
+	// Hits is the number of page loads
+	// served entirely from timeline cache.
+	Hits atomic.Int64
+
+	// Misses is the number of page loads that
+	// needed to fall back to the database.
+	Misses atomic.Int64
+}
@@ -393,6 +393,9 @@ func (t *StatusTimeline) Load(
 	// statuses were found for page,
 	// we need to call to database.
 	if len(apiStatuses) < limit {
+		if t != nil {
+			Metrics.Misses.Add(1)
+		}
 
 		// Pass to main timeline db load function.
 		apiStatuses, err = loadStatusTimeline(ctx,
@@ -406,6 +409,8 @@ func (t *StatusTimeline) Load(
 		if err != nil {
 			return nil, "", "", err
 		}
+	} else if t != nil {
+		Metrics.Hits.Add(1)
 	}
 
 	// Reset values.
@@ -626,6 +631,9 @@ func (t *StatusTimeline) RemoveByAccountIDs(accountIDs ...string) {
 // trim from the bottom-up to prioritize streamed inserts.
 func (t *StatusTimeline) Trim() { t.cache.Trim(t.cut, structr.Asc) }
 
+// Len returns the current number of entries in the timeline.
+func (t *StatusTimeline) Len() int { return t.cache.Len() }
+
 // Clear will mark the entire timeline as requiring preload,
 // which will trigger a clear and reload of the entire thing.
 func (t *StatusTimeline) Clear() { t.preloader.Clear() }
@@ -259,6 +259,18 @@ func (t *StatusTimelines) trim() {
 	})
 }
 
+// Stats returns the number of StatusTimeline{}s currently
+// stored, and the total number of entries across them all.
+func (t *StatusTimelines) Stats() (timelines int, entries int) {
+	if p := t.ptr.Load(); p != nil {
+		timelines = len(*p)
+		for _, tt := range *p {
+			entries += tt.Len()
+		}
+	}
+	return
+}
+
 // Clear attempts to call Clear() for StatusTimeline{} under key.
 func (t *StatusTimelines) Clear(key string) {
 	if p := t.ptr.Load(); p != nil {
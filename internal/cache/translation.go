@@ -0,0 +1,62 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cache
+
+import (
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"codeberg.org/gruf/go-cache/v3/ttl"
+)
+
+func (c *Caches) initTranslation() {
+	// Translations are relatively large
+	// but requested for only a small set
+	// of popular statuses, so a small fixed
+	// cap keeps this from growing unbounded.
+	cap := 1000
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.Translation = new(ttl.Cache[string, *CachedTranslation])
+	c.Translation.Init(
+		0,
+		cap,
+		24*time.Hour,
+	)
+}
+
+// TranslationKey returns the translation cache
+// key for given status ID and target language.
+func TranslationKey(statusID string, lang string) string {
+	return statusID + ":" + lang
+}
+
+// CachedTranslation represents a cached
+// translation of a status into a language.
+type CachedTranslation struct {
+
+	// StatusEditedAt is the last edit time of the status
+	// when translated, used to detect stale translations.
+	StatusEditedAt time.Time
+
+	// Translation is the translated
+	// status content for the frontend.
+	Translation *apimodel.Translation
+}
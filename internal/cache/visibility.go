@@ -42,6 +42,8 @@ func (c *Caches) initVisibility() {
 		Indices: []structr.IndexConfig{
 			{Fields: "ItemID", Multiple: true},
 			{Fields: "RequesterID", Multiple: true},
+			{Fields: "AccountID", Multiple: true},
+			{Fields: "BoostOfAccountID", Multiple: true},
 			{Fields: "Type,RequesterID,ItemID"},
 		},
 		MaxSize: cap,
@@ -81,6 +83,16 @@ type CachedVisibility struct {
 	// account for this visibility lookup.
 	RequesterID string
 
+	// AccountID is the ID of the account that
+	// owns the item in question, i.e. a status
+	// author. This allows invalidating all cached
+	// status visibilities when author changes.
+	AccountID string
+
+	// BoostOfAccountID is the ID of the boosted
+	// status author, if item is a boost status.
+	BoostOfAccountID string
+
 	// Type is the visibility lookup type.
 	Type VisibilityType
 
@@ -0,0 +1,154 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package captcha
+
+import (
+	"context"
+	"errors"
+
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
+)
+
+// ErrVerificationFailed is returned by Captcha.Verify() when
+// the captcha provider rejects the given captcha response.
+var ErrVerificationFailed = errors.New("captcha verification failed")
+
+// Provider is a single captcha service provider.
+type Provider interface {
+	// Name returns the name of the captcha
+	// provider, as used in configuration.
+	Name() string
+
+	// ScriptURL returns the URL of the provider's JS,
+	// which renders the captcha widget in the browser.
+	ScriptURL() string
+
+	// WidgetClass returns the HTML class name of the
+	// element in which the provider's JS will render
+	// its captcha widget.
+	WidgetClass() string
+
+	// ResponseField returns the name of the form field
+	// in which the provider's widget will submit its
+	// captcha response along with the rest of the form.
+	ResponseField() string
+
+	// CSPSources returns the sources which must be allowed
+	// by the Content-Security-Policy of pages embedding the
+	// provider's captcha widget.
+	CSPSources() []string
+
+	// Verify verifies the given captcha response server-side
+	// with the provider, returning ErrVerificationFailed if it
+	// was rejected. RemoteIP may be empty if not known.
+	Verify(ctx context.Context, response string, remoteIP string) error
+}
+
+// Captcha wraps a captcha Provider along with the
+// configured public site key. A nil Captcha is safe
+// to use, and simply reports that captcha is disabled.
+type Captcha struct {
+	provider Provider
+	siteKey  string
+}
+
+// New returns a new Captcha using the configured captcha
+// provider, making requests using the given HTTP client. If
+// no captcha provider is configured, this returns nil.
+func New(client *httpclient.Client) *Captcha {
+	var provider Provider
+
+	switch config.GetAccountsCaptchaProvider() {
+	case "hcaptcha":
+		provider = NewHCaptcha(client,
+			config.GetAccountsCaptchaSecretKey(),
+			"",
+		)
+
+	case "turnstile":
+		provider = NewTurnstile(client,
+			config.GetAccountsCaptchaSecretKey(),
+			"",
+		)
+
+	default:
+		return nil
+	}
+
+	return NewWithProvider(provider, config.GetAccountsCaptchaSiteKey())
+}
+
+// NewWithProvider returns a new Captcha
+// using the given Provider and site key.
+func NewWithProvider(provider Provider, siteKey string) *Captcha {
+	return &Captcha{
+		provider: provider,
+		siteKey:  siteKey,
+	}
+}
+
+// Enabled returns whether captcha is enabled.
+func (c *Captcha) Enabled() bool {
+	return c != nil && c.provider != nil
+}
+
+// Provider returns the captcha provider,
+// or nil if captcha is disabled.
+func (c *Captcha) Provider() Provider {
+	if !c.Enabled() {
+		return nil
+	}
+	return c.provider
+}
+
+// SiteKey returns the public site key for
+// the captcha provider, to be embedded in
+// forms protected by captcha.
+func (c *Captcha) SiteKey() string {
+	if !c.Enabled() {
+		return ""
+	}
+	return c.siteKey
+}
+
+// Verify verifies the given captcha response with the captcha
+// provider, returning ErrVerificationFailed if it was rejected.
+// If captcha is disabled, this always returns nil.
+func (c *Captcha) Verify(ctx context.Context, response string, remoteIP string) error {
+	if !c.Enabled() {
+		return nil
+	}
+
+	if response == "" {
+		// Don't bother asking the
+		// provider about an empty
+		// (ie., missing) response.
+		return ErrVerificationFailed
+	}
+
+	if err := c.provider.Verify(ctx, response, remoteIP); err != nil {
+		if errors.Is(err, ErrVerificationFailed) {
+			return err
+		}
+		return gtserror.Newf("error verifying with %s: %w", c.provider.Name(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package captcha_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/captcha"
+	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/stretchr/testify/suite"
+)
+
+type CaptchaTestSuite struct {
+	suite.Suite
+}
+
+func (suite *CaptchaTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+}
+
+func (suite *CaptchaTestSuite) TestNilCaptcha() {
+	var c *captcha.Captcha
+	suite.False(c.Enabled())
+	suite.Nil(c.Provider())
+	suite.Empty(c.SiteKey())
+
+	// Disabled captcha always verifies.
+	suite.NoError(c.Verify(suite.T().Context(), "", ""))
+}
+
+func (suite *CaptchaTestSuite) TestHCaptcha() {
+	suite.testSiteVerify(captcha.NewHCaptcha, "h-captcha-response")
+}
+
+func (suite *CaptchaTestSuite) TestTurnstile() {
+	suite.testSiteVerify(captcha.NewTurnstile, "cf-turnstile-response")
+}
+
+func (suite *CaptchaTestSuite) testSiteVerify(
+	newProvider func(*httpclient.Client, string, string) captcha.Provider,
+	responseField string,
+) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodPost, r.Method)
+		suite.Equal("secret-key", r.PostFormValue("secret"))
+		suite.Equal("192.0.2.1", r.PostFormValue("remoteip"))
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.PostFormValue("response") == "good" {
+			_, _ = w.Write([]byte(`{"success":true}`))
+		} else {
+			_, _ = w.Write([]byte(`{"success":false,"error-codes":["invalid-input-response"]}`))
+		}
+	}))
+	defer server.Close()
+
+	provider := newProvider(testClient(), "secret-key", server.URL)
+	suite.Equal(responseField, provider.ResponseField())
+
+	c := captcha.NewWithProvider(provider, "site-key")
+	suite.True(c.Enabled())
+	suite.Equal("site-key", c.SiteKey())
+
+	ctx := suite.T().Context()
+	suite.NoError(c.Verify(ctx, "good", "192.0.2.1"))
+	suite.ErrorIs(c.Verify(ctx, "bad", "192.0.2.1"), captcha.ErrVerificationFailed)
+
+	// Missing response fails without even asking the provider.
+	suite.ErrorIs(c.Verify(ctx, "", "192.0.2.1"), captcha.ErrVerificationFailed)
+}
+
+// testClient returns an httpclient
+// allowed to connect to localhost.
+func testClient() *httpclient.Client {
+	return httpclient.New(httpclient.Config{
+		AllowRanges: []netip.Prefix{
+			netip.MustParsePrefix("127.0.0.1/32"),
+			netip.MustParsePrefix("::1/128"),
+		},
+	})
+}
+
+func TestCaptchaTestSuite(t *testing.T) {
+	suite.Run(t, &CaptchaTestSuite{})
+}
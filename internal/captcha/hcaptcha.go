@@ -0,0 +1,61 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package captcha
+
+import "code.superseriousbusiness.org/gotosocial/internal/httpclient"
+
+// hCaptchaVerifyURL is the default
+// hCaptcha verification endpoint.
+const hCaptchaVerifyURL = "https://api.hcaptcha.com/siteverify"
+
+// hCaptcha is a Provider implementation
+// using hCaptcha, as documented at
+// https://docs.hcaptcha.com.
+type hCaptcha struct{ siteVerify }
+
+// NewHCaptcha returns a new hCaptcha Provider using the given secret
+// key. If verifyURL is empty, the default hCaptcha endpoint is used.
+func NewHCaptcha(client *httpclient.Client, secret string, verifyURL string) Provider {
+	if verifyURL == "" {
+		verifyURL = hCaptchaVerifyURL
+	}
+	return &hCaptcha{newSiteVerify(client, secret, verifyURL)}
+}
+
+func (h *hCaptcha) Name() string {
+	return "hcaptcha"
+}
+
+func (h *hCaptcha) ScriptURL() string {
+	return "https://js.hcaptcha.com/1/api.js"
+}
+
+func (h *hCaptcha) WidgetClass() string {
+	return "h-captcha"
+}
+
+func (h *hCaptcha) ResponseField() string {
+	return "h-captcha-response"
+}
+
+func (h *hCaptcha) CSPSources() []string {
+	return []string{
+		"https://hcaptcha.com",
+		"https://*.hcaptcha.com",
+	}
+}
@@ -0,0 +1,121 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
+)
+
+// maxResponseSize is the max size of response
+// body read from a captcha verification endpoint.
+const maxResponseSize = 64 * 1024
+
+// siteVerify contains the common logic for captcha
+// providers implementing a "siteverify" style API, ie.,
+// accepting a form-encoded POST of secret, response and
+// remoteip, and responding with a JSON success field.
+//
+// Both hCaptcha and Cloudflare Turnstile do this.
+type siteVerify struct {
+	client    *http.Client
+	userAgent string
+	secret    string
+	verifyURL string
+}
+
+func newSiteVerify(client *httpclient.Client, secret string, verifyURL string) siteVerify {
+	return siteVerify{
+		client: &http.Client{
+			// Pass in our wrapped httpclient.Client{}
+			// type as http.Transport{} in order to take
+			// advantage of retries, SSF protection etc.
+			Transport: client,
+		},
+		userAgent: fmt.Sprintf("gotosocial/%s (+%s://%s)",
+			config.GetSoftwareVersion(),
+			config.GetProtocol(),
+			config.GetHost(),
+		),
+		secret:    secret,
+		verifyURL: verifyURL,
+	}
+}
+
+func (s *siteVerify) Verify(ctx context.Context, response string, remoteIP string) error {
+	form := url.Values{
+		"secret":   {s.secret},
+		"response": {response},
+	}
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+
+	r, err := http.NewRequestWithContext(ctx,
+		http.MethodPost,
+		s.verifyURL,
+		strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return gtserror.Newf("error building request: %w", err)
+	}
+
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.Header.Set("Accept", "application/json")
+	r.Header.Set("User-Agent", s.userAgent)
+
+	rsp, err := s.client.Do(r)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode < 200 || rsp.StatusCode > 299 {
+		return gtserror.NewFromResponse(rsp)
+	}
+
+	var res struct {
+		Success    bool     `json:"success"`
+		ErrorCodes []string `json:"error-codes"`
+	}
+
+	// Decode (limited) response body.
+	lr := io.LimitReader(rsp.Body, maxResponseSize)
+	if err := json.NewDecoder(lr).Decode(&res); err != nil {
+		return gtserror.Newf("error decoding response: %w", err)
+	}
+
+	if !res.Success {
+		if len(res.ErrorCodes) > 0 {
+			return fmt.Errorf("%w: %s", ErrVerificationFailed,
+				strings.Join(res.ErrorCodes, ", "))
+		}
+		return ErrVerificationFailed
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package captcha
+
+import "code.superseriousbusiness.org/gotosocial/internal/httpclient"
+
+// turnstileVerifyURL is the default
+// Turnstile verification endpoint.
+const turnstileVerifyURL = "https://challenges.cloudflare.com/turnstile/v0/siteverify"
+
+// turnstile is a Provider implementation
+// using Cloudflare Turnstile, as documented at
+// https://developers.cloudflare.com/turnstile.
+type turnstile struct{ siteVerify }
+
+// NewTurnstile returns a new Turnstile Provider using the given secret
+// key. If verifyURL is empty, the default Turnstile endpoint is used.
+func NewTurnstile(client *httpclient.Client, secret string, verifyURL string) Provider {
+	if verifyURL == "" {
+		verifyURL = turnstileVerifyURL
+	}
+	return &turnstile{newSiteVerify(client, secret, verifyURL)}
+}
+
+func (t *turnstile) Name() string {
+	return "turnstile"
+}
+
+func (t *turnstile) ScriptURL() string {
+	return "https://challenges.cloudflare.com/turnstile/v0/api.js"
+}
+
+func (t *turnstile) WidgetClass() string {
+	return "cf-turnstile"
+}
+
+func (t *turnstile) ResponseField() string {
+	return "cf-turnstile-response"
+}
+
+func (t *turnstile) CSPSources() []string {
+	return []string{
+		"https://challenges.cloudflare.com",
+	}
+}
@@ -19,6 +19,7 @@
 
 import (
 	"context"
+	"strings"
 	"time"
 	"unsafe"
 
@@ -44,6 +45,15 @@ func New(state *state.State) *Cleaner {
 	return c
 }
 
+// Database returns the database set of cleaner utilities.
+func (c *Cleaner) Database() *Database {
+	if unsafe.Sizeof(Database{}) != unsafe.Sizeof(Cleaner{}) ||
+		unsafe.Offsetof(Database{}.Cleaner) != 0 {
+		panic(gtserror.New("compile time unsafe pointer assertion"))
+	}
+	return (*Database)(unsafe.Pointer(c))
+}
+
 // Emoji returns the emoji set of cleaner utilities.
 func (c *Cleaner) Emoji() *Emoji {
 	if unsafe.Sizeof(Emoji{}) != unsafe.Sizeof(Cleaner{}) ||
@@ -62,6 +72,24 @@ func (c *Cleaner) Media() *Media {
 	return (*Media)(unsafe.Pointer(c))
 }
 
+// Notification returns the notification set of cleaner utilities.
+func (c *Cleaner) Notification() *Notification {
+	if unsafe.Sizeof(Notification{}) != unsafe.Sizeof(Cleaner{}) ||
+		unsafe.Offsetof(Notification{}.Cleaner) != 0 {
+		panic(gtserror.New("compile time unsafe pointer assertion"))
+	}
+	return (*Notification)(unsafe.Pointer(c))
+}
+
+// Status returns the status set of cleaner utilities.
+func (c *Cleaner) Status() *Status {
+	if unsafe.Sizeof(Status{}) != unsafe.Sizeof(Cleaner{}) ||
+		unsafe.Offsetof(Status{}.Cleaner) != 0 {
+		panic(gtserror.New("compile time unsafe pointer assertion"))
+	}
+	return (*Status)(unsafe.Pointer(c))
+}
+
 // haveFiles returns whether all of the provided files exist within current storage.
 func (c *Cleaner) haveFiles(ctx context.Context, files ...string) (bool, error) {
 	for _, path := range files {
@@ -126,7 +154,8 @@ func (c *Cleaner) removeFiles(ctx context.Context, files ...string) (int, error)
 // jobs using configured parameters.
 //
 // Returns an error if `MediaCleanupFrom`
-// is not a valid format (hh:mm:ss).
+// is not a valid format (hh:mm:ss), or if
+// database backups are enabled without a dir.
 func (c *Cleaner) ScheduleJobs() error {
 	const hourMinute = "15:04"
 
@@ -186,5 +215,284 @@ func (c *Cleaner) ScheduleJobs() error {
 		panic("failed to schedule @mediacleanup")
 	}
 
+	// Schedule purging of the
+	// statuses trash, if enabled.
+	c.scheduleTrashPurge()
+
+	// Schedule deletion of
+	// expired statuses.
+	c.scheduleStatusExpiry()
+
+	// Schedule automatic deletion of
+	// statuses for accounts that opted in.
+	c.scheduleStatusAutoDelete()
+
+	// Schedule pruning of
+	// old notifications, if enabled.
+	c.scheduleNotificationPrune()
+
+	// Schedule pruning of old
+	// deduplicated inbox activities.
+	c.scheduleInboxActivityPrune()
+
+	// Schedule reconciling of
+	// status interaction counts.
+	c.scheduleStatusCountsReconcile()
+
+	// Schedule backups of
+	// the database, if enabled.
+	return c.scheduleDatabaseBackup()
+}
+
+// scheduleTrashPurge schedules permanent deletion of
+// statuses whose trash window has passed, if enabled.
+func (c *Cleaner) scheduleTrashPurge() {
+	window := config.GetStatusesTrashWindow()
+	if window <= 0 {
+		// Trash disabled.
+		return
+	}
+
+	// Purge at most hourly, or more often
+	// for trash windows shorter than that.
+	purgeEvery := min(window, time.Hour)
+
+	fn := func(ctx context.Context, start time.Time) {
+		log.Info(ctx, "starting statuses trash purge")
+		c.Status().LogPurgeTrash(ctx, start.Add(-window))
+		log.Infof(ctx, "finished statuses trash purge after %s", time.Since(start))
+	}
+
+	log.Infof(nil,
+		"scheduling statuses trash purge to run every %s",
+		purgeEvery,
+	)
+
+	// Schedule the purge to execute according to schedule.
+	if !c.state.Workers.Scheduler.AddRecurring(
+		"@statustrashpurge",
+		time.Now().Add(purgeEvery),
+		purgeEvery,
+		fn,
+	) {
+		panic("failed to schedule @statustrashpurge")
+	}
+}
+
+// scheduleStatusExpiry schedules permanent deletion
+// of statuses whose author-set expiry time has passed.
+func (c *Cleaner) scheduleStatusExpiry() {
+	// Check every minute; expiry times
+	// are not expected to be exact, and
+	// the query is cheap with the index.
+	const expireEvery = time.Minute
+
+	// This runs often, so only
+	// log when there's work done.
+	fn := func(ctx context.Context, start time.Time) {
+		n, err := c.Status().DeleteExpired(ctx, start)
+		if err != nil {
+			log.Errorf(ctx, "error deleting expired statuses: %v", err)
+		} else if n > 0 {
+			log.Infof(ctx, "queued %d expired statuses for deletion", n)
+		}
+	}
+
+	log.Infof(nil,
+		"scheduling expired statuses delete to run every %s",
+		expireEvery,
+	)
+
+	// Schedule the delete to execute according to schedule.
+	if !c.state.Workers.Scheduler.AddRecurring(
+		"@statusexpiry",
+		time.Now().Add(expireEvery),
+		expireEvery,
+		fn,
+	) {
+		panic("failed to schedule @statusexpiry")
+	}
+}
+
+// scheduleStatusAutoDelete schedules permanent deletion of
+// statuses for accounts with an automatic deletion policy.
+func (c *Cleaner) scheduleStatusAutoDelete() {
+	// Policies are set in days,
+	// so hourly is plenty often.
+	const autoDeleteEvery = time.Hour
+
+	fn := func(ctx context.Context, start time.Time) {
+		log.Info(ctx, "starting statuses auto-delete")
+		n, err := c.Status().AutoDelete(ctx, start)
+		if err != nil {
+			log.Errorf(ctx, "error auto-deleting statuses: %v", err)
+		}
+		log.Infof(ctx, "finished statuses auto-delete after %s, queued %d", time.Since(start), n)
+	}
+
+	log.Infof(nil,
+		"scheduling statuses auto-delete to run every %s",
+		autoDeleteEvery,
+	)
+
+	// Schedule the delete to execute according to schedule.
+	if !c.state.Workers.Scheduler.AddRecurring(
+		"@statusautodelete",
+		time.Now().Add(autoDeleteEvery),
+		autoDeleteEvery,
+		fn,
+	) {
+		panic("failed to schedule @statusautodelete")
+	}
+}
+
+// scheduleNotificationPrune schedules permanent deletion
+// of notifications older than configured max age, if set.
+func (c *Cleaner) scheduleNotificationPrune() {
+	maxAge := config.GetNotificationsMaxAge()
+	if maxAge <= 0 {
+		// Pruning disabled.
+		return
+	}
+
+	// Prune at most hourly, or more often
+	// for max ages shorter than that.
+	pruneEvery := min(maxAge, time.Hour)
+
+	fn := func(ctx context.Context, start time.Time) {
+		log.Info(ctx, "starting notifications prune")
+		c.Notification().LogPruneOld(ctx, start.Add(-maxAge))
+		log.Infof(ctx, "finished notifications prune after %s", time.Since(start))
+	}
+
+	log.Infof(nil,
+		"scheduling notifications prune to run every %s",
+		pruneEvery,
+	)
+
+	// Schedule the prune to execute according to schedule.
+	if !c.state.Workers.Scheduler.AddRecurring(
+		"@notificationprune",
+		time.Now().Add(pruneEvery),
+		pruneEvery,
+		fn,
+	) {
+		panic("failed to schedule @notificationprune")
+	}
+}
+
+// scheduleInboxActivityPrune schedules deletion of processed
+// inbox activity IDs older than the redelivery window.
+func (c *Cleaner) scheduleInboxActivityPrune() {
+	// Remotes generally give up redelivering
+	// an activity after a few days, so a week
+	// is plenty to catch all redeliveries.
+	const maxAge = 7 * 24 * time.Hour
+
+	// Activity IDs are only
+	// small, so daily is fine.
+	const pruneEvery = 24 * time.Hour
+
+	fn := func(ctx context.Context, start time.Time) {
+		n, err := c.state.DB.DeleteInboxActivitiesOlderThan(ctx, start.Add(-maxAge))
+		if err != nil {
+			log.Errorf(ctx, "error pruning inbox activities: %v", err)
+			return
+		}
+		log.Infof(ctx, "pruned %d inbox activities after %s", n, time.Since(start))
+	}
+
+	log.Infof(nil,
+		"scheduling inbox activities prune to run every %s",
+		pruneEvery,
+	)
+
+	// Schedule the prune to execute according to schedule.
+	if !c.state.Workers.Scheduler.AddRecurring(
+		"@inboxactivityprune",
+		time.Now().Add(pruneEvery),
+		pruneEvery,
+		fn,
+	) {
+		panic("failed to schedule @inboxactivityprune")
+	}
+}
+
+// scheduleStatusCountsReconcile schedules recalculation of
+// denormalized status interaction counts, to correct drift.
+func (c *Cleaner) scheduleStatusCountsReconcile() {
+	// Counts are maintained on each
+	// interaction, so drift should only
+	// be small; daily is plenty often.
+	const reconcileEvery = 24 * time.Hour
+
+	fn := func(ctx context.Context, start time.Time) {
+		log.Info(ctx, "starting status counts reconcile")
+		if err := c.Status().ReconcileCounts(ctx); err != nil {
+			log.Errorf(ctx, "error reconciling status counts: %v", err)
+		}
+		log.Infof(ctx, "finished status counts reconcile after %s", time.Since(start))
+	}
+
+	log.Infof(nil,
+		"scheduling status counts reconcile to run every %s",
+		reconcileEvery,
+	)
+
+	// Schedule the reconcile to execute according to schedule.
+	if !c.state.Workers.Scheduler.AddRecurring(
+		"@statuscountsreconcile",
+		time.Now().Add(reconcileEvery),
+		reconcileEvery,
+		fn,
+	) {
+		panic("failed to schedule @statuscountsreconcile")
+	}
+}
+
+// scheduleDatabaseBackup schedules backup snapshots
+// of the SQLite database into configured dir, if set.
+func (c *Cleaner) scheduleDatabaseBackup() error {
+	backupEvery := config.GetDbSqliteBackupEvery()
+	if backupEvery <= 0 {
+		// Backups disabled.
+		return nil
+	}
+
+	if !strings.EqualFold(config.GetDbType(), "sqlite") {
+		log.Warnf(nil, "%s is set but %s is not sqlite; database backups will not be scheduled",
+			config.DbSqliteBackupEveryFlag, config.DbTypeFlag)
+		return nil
+	}
+
+	backupDir := config.GetDbSqliteBackupDir()
+	if backupDir == "" {
+		return gtserror.Newf("%s is set but %s is not",
+			config.DbSqliteBackupEveryFlag, config.DbSqliteBackupDirFlag)
+	}
+
+	backupKeep := config.GetDbSqliteBackupKeep()
+
+	fn := func(ctx context.Context, start time.Time) {
+		log.Info(ctx, "starting database backup")
+		c.Database().LogBackup(ctx, backupDir, backupKeep)
+		log.Infof(ctx, "finished database backup after %s", time.Since(start))
+	}
+
+	log.Infof(nil,
+		"scheduling database backup to %s to run every %s",
+		backupDir, backupEvery,
+	)
+
+	// Schedule the backup to execute according to schedule.
+	if !c.state.Workers.Scheduler.AddRecurring(
+		"@databasebackup",
+		time.Now().Add(backupEvery),
+		backupEvery,
+		fn,
+	) {
+		panic("failed to schedule @databasebackup")
+	}
+
 	return nil
 }
@@ -0,0 +1,110 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+)
+
+const (
+	// backupPrefix and backupSuffix surround
+	// the timestamp in names of scheduled backups.
+	backupPrefix = "gotosocial-"
+	backupSuffix = ".db"
+
+	// backupTimeFormat sorts lexically in time order.
+	backupTimeFormat = "20060102T150405"
+)
+
+// Database encompasses a set of
+// database cleanup / admin utils.
+type Database struct{ Cleaner }
+
+// LogBackup performs Database.Backup(...), logging the start and outcome.
+func (d *Database) LogBackup(ctx context.Context, dir string, keep int) {
+	log.Infof(ctx, "start backup to: %s", dir)
+	if path, removed, err := d.Backup(ctx, dir, keep); err != nil {
+		log.Error(ctx, err)
+	} else {
+		log.Infof(ctx, "backed up to: %s, removed old: %d", path, removed)
+	}
+}
+
+// Backup writes a new timestamped backup snapshot of the database
+// into dir, then removes all but the newest keep snapshots in dir
+// (if keep > 0). Returns the new snapshot path and number removed.
+func (d *Database) Backup(ctx context.Context, dir string, keep int) (string, int, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", 0, gtserror.Newf("error creating backup dir: %w", err)
+	}
+
+	name := backupPrefix + time.Now().UTC().Format(backupTimeFormat) + backupSuffix
+	path := filepath.Join(dir, name)
+
+	if err := d.state.DB.Backup(ctx, path); err != nil {
+		return "", 0, err
+	}
+
+	if keep <= 0 {
+		// Keep all.
+		return path, 0, nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return path, 0, gtserror.Newf("error reading backup dir: %w", err)
+	}
+
+	// Gather names of our own backups only,
+	// in case dir is shared with other files.
+	var names []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.Type().IsRegular() &&
+			strings.HasPrefix(name, backupPrefix) &&
+			strings.HasSuffix(name, backupSuffix) {
+			names = append(names, name)
+		}
+	}
+
+	if len(names) <= keep {
+		return path, 0, nil
+	}
+
+	// Sort oldest first, and
+	// drop all but newest keep.
+	slices.Sort(names)
+	var removed int
+	for _, name := range names[:len(names)-keep] {
+		if err := os.Remove(filepath.Join(dir, name)); err != nil {
+			log.Errorf(ctx, "error removing old backup %s: %v", name, err)
+			continue
+		}
+		removed++
+	}
+
+	return path, removed, nil
+}
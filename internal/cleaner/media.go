@@ -32,6 +32,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/media"
 	"code.superseriousbusiness.org/gotosocial/internal/paging"
 	"code.superseriousbusiness.org/gotosocial/internal/regexes"
+	"code.superseriousbusiness.org/gotosocial/internal/storage"
 	"code.superseriousbusiness.org/gotosocial/internal/uris"
 )
 
@@ -53,6 +54,7 @@ func (m *Media) All(ctx context.Context, maxRemoteDays int) {
 // is done this way round so Storage.Clean() is performed last.
 func (m *Media) AllAndFix(ctx context.Context, maxRemoteDays int) {
 	m.LogFixCacheStates(ctx)
+	m.LogVerifyChecksums(ctx)
 	m.All(ctx, maxRemoteDays)
 }
 
@@ -106,6 +108,16 @@ func (m *Media) LogFixCacheStates(ctx context.Context) {
 	}
 }
 
+// LogVerifyChecksums performs Media.VerifyChecksums(...), logging the start and outcome.
+func (m *Media) LogVerifyChecksums(ctx context.Context) {
+	log.Info(ctx, "start")
+	if n, err := m.VerifyChecksums(ctx); err != nil {
+		log.Error(ctx, err)
+	} else {
+		log.Infof(ctx, "failed: %d", n)
+	}
+}
+
 // PruneOrphaned will delete orphaned files from storage (i.e. media missing a database entry).
 // Context will be checked for `gtscontext.DryRun()` in order to actually perform the action.
 func (m *Media) PruneOrphaned(ctx context.Context) (int, error) {
@@ -357,6 +369,7 @@ func (m *Media) stubAttachment(ctx context.Context, a *gtsmodel.MediaAttachment)
 	// Remove any attachment files.
 	if _, err := m.removeFiles(ctx,
 		a.Thumbnail.Path,
+		a.Sprite.Path,
 		a.File.Path,
 	); err != nil {
 		log.Error(ctx, err)
@@ -425,6 +438,56 @@ func (m *Media) FixCacheStates(ctx context.Context) (int, error) {
 	return total, nil
 }
 
+// VerifyChecksums will check all cached media with stored checksums against the
+// files currently in storage. Remote media failing verification will be uncached,
+// so that it may be re-fetched on demand. Local media failing verification cannot
+// be recovered, and so will only be logged. Returns count of media that failed.
+// Context will be checked for `gtscontext.DryRun()` in order to actually perform the action.
+func (m *Media) VerifyChecksums(ctx context.Context) (int, error) {
+	var total int
+	var page paging.Page
+
+	// Setup page w/ select limit.
+	page.Max = paging.MaxID("")
+	page.Limit = selectLimit
+
+	for {
+		// Fetch the next batch of media attachments up to next max ID.
+		attachments, err := m.state.DB.GetAttachments(ctx, &page)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return total, gtserror.Newf("error getting attachments: %w", err)
+		}
+
+		// Get current max ID.
+		maxID := page.Max.Value
+
+		// If no attachments or the same group is returned, we reached the end.
+		if len(attachments) == 0 || maxID == attachments[len(attachments)-1].ID {
+			break
+		}
+
+		// Use last ID as the next 'maxID' value.
+		maxID = attachments[len(attachments)-1].ID
+		page.Max.Value = maxID
+
+		for _, media := range attachments {
+			// Verify stored media file checksums.
+			failed, err := m.verifyChecksums(ctx, media)
+			if err != nil {
+				return total, err
+			}
+
+			if failed {
+				// Update
+				// count.
+				total++
+			}
+		}
+	}
+
+	return total, nil
+}
+
 func (m *Media) isOrphaned(ctx context.Context, path string) (bool, error) {
 	pathParts := regexes.FilePath.FindStringSubmatch(path)
 	if len(pathParts) != 6 {
@@ -554,6 +617,25 @@ func (m *Media) pruneUnused(ctx context.Context, media *gtsmodel.MediaAttachment
 		}
 	}
 
+	// Check whether we have the required draft for media.
+	draft, missing, err := m.getRelatedDraft(ctx, media)
+	if err != nil {
+		return false, err
+	} else if missing {
+		l.Debug("deleting due to missing draft")
+		return true, m.delete(ctx, media)
+	}
+
+	if draft != nil {
+		// Check whether still attached to draft.
+		for _, id := range draft.MediaIDs {
+			if id == media.ID {
+				l.Debug("skippping as attached to draft")
+				return false, nil
+			}
+		}
+	}
+
 	// Media totally unused, delete it.
 	l.Debug("deleting unused media")
 	return true, m.delete(ctx, media)
@@ -602,6 +684,7 @@ func (m *Media) fixCacheState(ctx context.Context, media *gtsmodel.MediaAttachme
 		l.Debug("cached=false exists=true => deleting")
 		_, err := m.removeFiles(ctx,
 			media.Thumbnail.Path,
+			media.Sprite.Path,
 			media.File.Path,
 		)
 		return true, err
@@ -611,6 +694,61 @@ func (m *Media) fixCacheState(ctx context.Context, media *gtsmodel.MediaAttachme
 	}
 }
 
+func (m *Media) verifyChecksums(ctx context.Context, media *gtsmodel.MediaAttachment) (bool, error) {
+	if !media.Cached() {
+		// Nothing
+		// to check.
+		return false, nil
+	}
+
+	// Start a log entry for media.
+	l := log.WithContext(ctx).
+		WithField("media", media.ID)
+
+	for _, file := range []struct {
+		path string
+		sum  string
+	}{
+		{media.File.Path, media.File.Checksum},
+		{media.Thumbnail.Path, media.Thumbnail.Checksum},
+		{media.Sprite.Path, media.Sprite.Checksum},
+	} {
+		if file.path == "" || file.sum == "" {
+			// No checksum
+			// to compare.
+			continue
+		}
+
+		// Check stored file against checksum.
+		ok, err := m.state.Storage.Verify(ctx,
+			file.path,
+			file.sum,
+		)
+		if err != nil && !storage.IsNotFound(err) {
+			return false, gtserror.Newf("error verifying %s: %w", file.path, err)
+		}
+
+		if ok {
+			// File
+			// intact.
+			continue
+		}
+
+		if !media.IsRemote() {
+			// Local media cannot be re-fetched, the best we can do is
+			// inform the admin so it can be restored from a backup.
+			l.Errorf("checksum verification failed for local media file %s", file.path)
+			return true, nil
+		}
+
+		// Remote media can be re-fetched, so uncache it.
+		l.Warnf("checksum verification failed for %s => uncaching", file.path)
+		return true, m.uncache(ctx, media)
+	}
+
+	return false, nil
+}
+
 func (m *Media) uncacheRemote(ctx context.Context, after time.Time, media *gtsmodel.MediaAttachment) (bool, error) {
 	if !media.Cached() {
 		// Already uncached.
@@ -745,16 +883,40 @@ func (m *Media) getRelatedScheduledStatus(ctx context.Context, media *gtsmodel.M
 	return status, false, nil
 }
 
+func (m *Media) getRelatedDraft(ctx context.Context, media *gtsmodel.MediaAttachment) (*gtsmodel.Draft, bool, error) {
+	if media.DraftID == "" {
+		// no related draft.
+		return nil, false, nil
+	}
+
+	// Load the draft related to this media.
+	draft, err := m.state.DB.GetDraftByID(
+		gtscontext.SetBarebones(ctx),
+		media.DraftID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, false, gtserror.Newf("error fetching draft by id %s: %w", media.DraftID, err)
+	}
+
+	if draft == nil {
+		// draft is missing.
+		return nil, true, nil
+	}
+
+	return draft, false, nil
+}
+
 func (m *Media) uncache(ctx context.Context, media *gtsmodel.MediaAttachment) error {
 	if gtscontext.DryRun(ctx) {
 		// Dry run, do nothing.
 		return nil
 	}
 
-	// Remove media and thumbnail.
+	// Remove media, thumbnail and sprite.
 	_, err := m.removeFiles(ctx,
 		media.File.Path,
 		media.Thumbnail.Path,
+		media.Sprite.Path,
 	)
 	if err != nil {
 		return gtserror.Newf("error removing media files: %w", err)
@@ -762,10 +924,15 @@ func (m *Media) uncache(ctx context.Context, media *gtsmodel.MediaAttachment) er
 
 	// Update attachment to reflect that we no longer have it cached.
 	log.Debugf(ctx, "marking media attachment as uncached: %s", media.ID)
-	media.File.Path, media.Thumbnail.Path = "", ""
+	media.File.Path, media.Thumbnail.Path, media.Sprite.Path = "", "", ""
+	media.File.Checksum, media.Thumbnail.Checksum, media.Sprite.Checksum = "", "", ""
 	if err := m.state.DB.UpdateAttachment(ctx, media,
 		"thumbnail_path",
+		"thumbnail_checksum",
+		"sprite_path",
+		"sprite_checksum",
 		"file_path",
+		"file_checksum",
 	); err != nil {
 		return gtserror.Newf("error updating media: %w", err)
 	}
@@ -779,10 +946,11 @@ func (m *Media) delete(ctx context.Context, media *gtsmodel.MediaAttachment) err
 		return nil
 	}
 
-	// Remove media and thumbnail.
+	// Remove media, thumbnail and sprite.
 	_, err := m.removeFiles(ctx,
 		media.File.Path,
 		media.Thumbnail.Path,
+		media.Sprite.Path,
 	)
 	if err != nil {
 		return gtserror.Newf("error removing media files: %w", err)
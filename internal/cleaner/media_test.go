@@ -109,6 +109,39 @@ func (suite *MediaTestSuite) TestUncacheRemote() {
 	suite.False(uncachedAttachment.Cached())
 }
 
+func (suite *MediaTestSuite) TestVerifyChecksums() {
+	ctx := suite.T().Context()
+
+	// Give a remote attachment a checksum that won't match its file.
+	remoteAttachment := new(gtsmodel.MediaAttachment)
+	*remoteAttachment = *suite.testAttachments["remote_account_1_status_1_attachment_1"]
+	remoteAttachment.File.Checksum = "not a real checksum"
+	err := suite.db.UpdateAttachment(ctx, remoteAttachment, "file_checksum")
+	suite.NoError(err)
+
+	// Do the same for a local attachment.
+	localAttachment := new(gtsmodel.MediaAttachment)
+	*localAttachment = *suite.testAttachments["local_account_1_status_4_attachment_1"]
+	localAttachment.File.Checksum = "not a real checksum"
+	err = suite.db.UpdateAttachment(ctx, localAttachment, "file_checksum")
+	suite.NoError(err)
+
+	totalFailed, err := suite.cleaner.Media().VerifyChecksums(ctx)
+	suite.NoError(err)
+	suite.Equal(2, totalFailed)
+
+	// Remote attachment should now be uncached.
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, remoteAttachment.ID)
+	suite.NoError(err)
+	suite.False(dbAttachment.Cached())
+	suite.Empty(dbAttachment.File.Checksum)
+
+	// Local attachment can't be re-fetched, so should be left as-is.
+	dbAttachment, err = suite.db.GetAttachmentByID(ctx, localAttachment.ID)
+	suite.NoError(err)
+	suite.True(dbAttachment.Cached())
+}
+
 func (suite *MediaTestSuite) TestPurgeRemote() {
 	var (
 		ctx = suite.T().Context()
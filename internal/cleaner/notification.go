@@ -0,0 +1,78 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner
+
+import (
+	"context"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+)
+
+// Notification encompasses a set of
+// notification cleanup / admin utils.
+type Notification struct{ Cleaner }
+
+// LogPruneOld performs Notification.PruneOld(...), logging the start and outcome.
+func (n *Notification) LogPruneOld(ctx context.Context, olderThan time.Time) {
+	log.Infof(ctx, "start older than: %s", olderThan.Format(time.Stamp))
+	if total, err := n.PruneOld(ctx, olderThan); err != nil {
+		log.Error(ctx, err)
+	} else {
+		log.Infof(ctx, "pruned: %d", total)
+	}
+}
+
+// PruneOld permanently deletes all notifications created
+// before the given time, returning the number deleted.
+// Context will be checked for `gtscontext.DryRun()` in
+// order to actually perform the action.
+func (n *Notification) PruneOld(ctx context.Context, olderThan time.Time) (int, error) {
+	if gtscontext.DryRun(ctx) {
+		// Dry run, just count what would be deleted.
+		total, err := n.state.DB.CountNotifications(ctx, olderThan)
+		if err != nil {
+			return 0, gtserror.Newf("error counting notifications: %w", err)
+		}
+		return total, nil
+	}
+
+	var total int
+
+	for {
+		// Delete the next batch of old notifications.
+		count, err := n.state.DB.DeleteNotificationsOlderThan(ctx,
+			olderThan,
+			selectLimit,
+		)
+		if err != nil {
+			return total, gtserror.Newf("error deleting notifications: %w", err)
+		}
+
+		if count == 0 {
+			// reached end.
+			break
+		}
+
+		total += count
+	}
+
+	return total, nil
+}
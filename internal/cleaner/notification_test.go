@@ -0,0 +1,65 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner_test
+
+import (
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+)
+
+func (suite *CleanerTestSuite) TestNotificationPruneOld() {
+	ctx := suite.T().Context()
+
+	// Count all test notifications.
+	total, err := suite.state.DB.CountNotifications(ctx, time.Time{})
+	suite.NoError(err)
+	suite.NotZero(total)
+
+	// Nothing should be older than the year 2000.
+	pruned, err := suite.cleaner.Notification().PruneOld(ctx, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	suite.NoError(err)
+	suite.Zero(pruned)
+
+	// Everything should be older than now.
+	pruned, err = suite.cleaner.Notification().PruneOld(ctx, time.Now())
+	suite.NoError(err)
+	suite.Equal(total, pruned)
+
+	// And so nothing should be left.
+	remaining, err := suite.state.DB.CountNotifications(ctx, time.Time{})
+	suite.NoError(err)
+	suite.Zero(remaining)
+}
+
+func (suite *CleanerTestSuite) TestNotificationPruneOldDryRun() {
+	ctx := gtscontext.SetDryRun(suite.T().Context())
+
+	total, err := suite.state.DB.CountNotifications(ctx, time.Time{})
+	suite.NoError(err)
+
+	// Dry run should report everything as prunable.
+	pruned, err := suite.cleaner.Notification().PruneOld(ctx, time.Now())
+	suite.NoError(err)
+	suite.Equal(total, pruned)
+
+	// But nothing should actually be deleted.
+	remaining, err := suite.state.DB.CountNotifications(ctx, time.Time{})
+	suite.NoError(err)
+	suite.Equal(total, remaining)
+}
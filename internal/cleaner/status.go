@@ -0,0 +1,305 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner
+
+import (
+	"context"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
+)
+
+const (
+	// autoDeleteMaxPerAccount is the maximum number of statuses
+	// queued for automatic deletion per account in a single run,
+	// so that enabling the policy on an account with a long history
+	// doesn't flood the workers (and federation) all at once.
+	autoDeleteMaxPerAccount = 500
+
+	// autoDeleteBatchPause is the time to wait
+	// between batches of automatic deletions.
+	autoDeleteBatchPause = time.Second
+)
+
+// Status encompasses a set of
+// status cleanup / admin utils.
+type Status struct{ Cleaner }
+
+// LogPurgeTrash performs Status.PurgeTrash(...), logging the start and outcome.
+func (s *Status) LogPurgeTrash(ctx context.Context, deletedBefore time.Time) {
+	log.Infof(ctx, "start deleted before: %s", deletedBefore.Format(time.Stamp))
+	if n, err := s.PurgeTrash(ctx, deletedBefore); err != nil {
+		log.Error(ctx, err)
+	} else {
+		log.Infof(ctx, "purged: %d", n)
+	}
+}
+
+// PurgeTrash queues permanent deletion of all statuses in
+// the trash that were deleted before the given time, ie.,
+// whose trash window has passed. Returns number queued.
+// Context will be checked for `gtscontext.DryRun()` in
+// order to actually perform the action.
+func (s *Status) PurgeTrash(ctx context.Context, deletedBefore time.Time) (int, error) {
+	var total int
+
+	for {
+		// Fetch the next batch of trashed statuses.
+		statuses, err := s.state.DB.GetTrashedStatuses(ctx,
+			deletedBefore,
+			selectLimit,
+		)
+		if err != nil {
+			return total, gtserror.Newf("error getting trashed statuses: %w", err)
+		}
+
+		if len(statuses) == 0 {
+			// reached end.
+			break
+		}
+
+		// Use last as the next 'deletedBefore' value.
+		deletedBefore = statuses[len(statuses)-1].DeletedAt
+
+		for _, status := range statuses {
+			if !gtscontext.DryRun(ctx) {
+				// Drop any existing queued messages about
+				// this status, in case a previous purge
+				// has already queued its deletion.
+				s.state.Workers.Client.Queue.Delete("TargetURI", status.URI)
+
+				// Queue the status for permanent deletion,
+				// where the delete will also be federated.
+				s.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
+					APObjectType:   ap.ObjectNote,
+					APActivityType: ap.ActivityDelete,
+					GTSModel:       status,
+					TargetURI:      status.URI,
+					Origin:         status.Account,
+					Target:         status.Account,
+				})
+			}
+
+			total++
+		}
+	}
+
+	return total, nil
+}
+
+// DeleteExpired queues permanent deletion of all statuses
+// which have expired before the given time, ie., whose
+// author-set lifetime has passed. Returns number queued.
+// Context will be checked for `gtscontext.DryRun()` in
+// order to actually perform the action.
+func (s *Status) DeleteExpired(ctx context.Context, expiredBefore time.Time) (int, error) {
+	var total int
+
+	for {
+		// Fetch the next batch of expired statuses.
+		statuses, err := s.state.DB.GetExpiredStatuses(ctx,
+			expiredBefore,
+			selectLimit,
+		)
+		if err != nil {
+			return total, gtserror.Newf("error getting expired statuses: %w", err)
+		}
+
+		if len(statuses) == 0 {
+			// reached end.
+			break
+		}
+
+		// Use last as the next 'expiredBefore' value.
+		expiredBefore = statuses[len(statuses)-1].ExpiresAt
+
+		for _, status := range statuses {
+			if !gtscontext.DryRun(ctx) {
+				// Drop any existing queued messages about
+				// this status, in case a previous run
+				// has already queued its deletion.
+				s.state.Workers.Client.Queue.Delete("TargetURI", status.URI)
+
+				// Queue the status for permanent deletion,
+				// where the delete will also be federated.
+				// Expired statuses skip the trash, as
+				// DeletedAt is never set on them here.
+				s.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
+					APObjectType:   ap.ObjectNote,
+					APActivityType: ap.ActivityDelete,
+					GTSModel:       status,
+					TargetURI:      status.URI,
+					Origin:         status.Account,
+					Target:         status.Account,
+				})
+			}
+
+			total++
+		}
+	}
+
+	return total, nil
+}
+
+// AutoDelete queues permanent deletion of statuses by local
+// accounts which have an automatic deletion policy set, and
+// which are older than the policy allows. Statuses that are
+// pinned, or bookmarked / faved by their author, are kept.
+// At most autoDeleteMaxPerAccount statuses are queued per
+// account per run, pausing between batches. Returns number
+// queued. Context will be checked for `gtscontext.DryRun()`
+// in order to actually perform the action.
+func (s *Status) AutoDelete(ctx context.Context, now time.Time) (int, error) {
+	settings, err := s.state.DB.GetAutoDeleteAccountSettings(ctx)
+	if err != nil {
+		return 0, gtserror.Newf("error getting auto-delete account settings: %w", err)
+	}
+
+	var total int
+
+	for _, setting := range settings {
+		n, err := s.autoDeleteForAccount(ctx, setting, now)
+		total += n
+		if err != nil {
+			return total, err
+		}
+	}
+
+	return total, nil
+}
+
+// autoDeleteForAccount performs AutoDelete()
+// for the account owning the given settings.
+func (s *Status) autoDeleteForAccount(
+	ctx context.Context,
+	settings *gtsmodel.AccountSettings,
+	now time.Time,
+) (int, error) {
+	// Any status with an ID lower than
+	// this was created before the cutoff.
+	age := time.Duration(settings.AutoDeleteAfterDays) * 24 * time.Hour
+	maxID := id.ZeroULIDForTime(now.Add(-age))
+
+	var (
+		minID string
+		total int
+	)
+
+	for total < autoDeleteMaxPerAccount {
+		// Fetch the next batch of statuses eligible for deletion.
+		statuses, err := s.state.DB.GetStatusesToAutoDelete(ctx,
+			settings.AccountID,
+			minID,
+			maxID,
+			min(selectLimit, autoDeleteMaxPerAccount-total),
+		)
+		if err != nil {
+			return total, gtserror.Newf("error getting statuses to auto-delete: %w", err)
+		}
+
+		if len(statuses) == 0 {
+			// reached end.
+			break
+		}
+
+		// Use last as the next 'minID' value.
+		minID = statuses[len(statuses)-1].ID
+
+		for _, status := range statuses {
+			if !gtscontext.DryRun(ctx) {
+				// Drop any existing queued messages about
+				// this status, in case a previous run
+				// has already queued its deletion.
+				s.state.Workers.Client.Queue.Delete("TargetURI", status.URI)
+
+				// Queue the status for permanent deletion,
+				// where the delete will also be federated.
+				s.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
+					APObjectType:   ap.ObjectNote,
+					APActivityType: ap.ActivityDelete,
+					GTSModel:       status,
+					TargetURI:      status.URI,
+					Origin:         status.Account,
+					Target:         status.Account,
+				})
+			}
+
+			total++
+		}
+
+		if len(statuses) < selectLimit {
+			// reached end.
+			break
+		}
+
+		// Rate limit between batches, giving
+		// the workers a chance to catch up.
+		select {
+		case <-ctx.Done():
+			return total, ctx.Err()
+		case <-time.After(autoDeleteBatchPause):
+		}
+	}
+
+	return total, nil
+}
+
+// ReconcileCounts recalculates from scratch the denormalized
+// interaction counts of all statuses which have been counted,
+// correcting any that have drifted from the true counts.
+func (s *Status) ReconcileCounts(ctx context.Context) error {
+	const (
+		// Counts are regenerated in a single
+		// UPDATE per batch, so this can be
+		// a fair bit larger than select limit.
+		batchSize = 500
+
+		// Time to wait between batches,
+		// so as not to hog the database.
+		batchPause = 100 * time.Millisecond
+	)
+
+	var maxID string
+
+	for {
+		next, err := s.state.DB.ReconcileStatusCounts(ctx, maxID, batchSize)
+		if err != nil {
+			return gtserror.Newf("error reconciling status counts: %w", err)
+		}
+
+		if next == "" {
+			// reached end.
+			return nil
+		}
+
+		// Use last as the next 'maxID' value.
+		maxID = next
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(batchPause):
+		}
+	}
+}
@@ -0,0 +1,195 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner_test
+
+import (
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+)
+
+func (suite *CleanerTestSuite) TestStatusPurgeTrash() {
+	ctx := suite.T().Context()
+	now := time.Now()
+
+	// Put two statuses in the trash
+	// long ago, and one only recently.
+	expired1 := suite.trashStatus("local_account_1_status_1", now.Add(-3*time.Hour))
+	expired2 := suite.trashStatus("local_account_1_status_2", now.Add(-2*time.Hour))
+	_ = suite.trashStatus("local_account_1_status_3", now.Add(-10*time.Minute))
+
+	// Purge statuses trashed over an hour ago.
+	total, err := suite.cleaner.Status().PurgeTrash(ctx, now.Add(-time.Hour))
+	suite.NoError(err)
+	suite.Equal(2, total)
+
+	// Only the expired statuses should have
+	// been queued for deletion, once each.
+	queued := suite.popQueuedDeletes()
+	if suite.Len(queued, 2) {
+		suite.Equal(expired2.ID, queued[0].ID)
+		suite.Equal(expired1.ID, queued[1].ID)
+	}
+}
+
+func (suite *CleanerTestSuite) TestStatusPurgeTrashDryRun() {
+	ctx := gtscontext.SetDryRun(suite.T().Context())
+	now := time.Now()
+
+	// Put status in the trash long ago.
+	_ = suite.trashStatus("local_account_1_status_1", now.Add(-2*time.Hour))
+
+	// Dry run purge of statuses trashed over an hour ago.
+	total, err := suite.cleaner.Status().PurgeTrash(ctx, now.Add(-time.Hour))
+	suite.NoError(err)
+	suite.Equal(1, total)
+
+	// Nothing should have been queued.
+	_, ok := suite.state.Workers.Client.Queue.Pop()
+	suite.False(ok)
+}
+
+func (suite *CleanerTestSuite) TestStatusDeleteExpired() {
+	ctx := suite.T().Context()
+	now := time.Now()
+
+	// Set two statuses to have expired,
+	// and another to expire later on.
+	expired1 := suite.expireStatus("local_account_1_status_1", now.Add(-time.Hour))
+	expired2 := suite.expireStatus("local_account_1_status_2", now.Add(-time.Minute))
+	_ = suite.expireStatus("local_account_1_status_3", now.Add(time.Hour))
+
+	// Delete statuses expired as of now.
+	total, err := suite.cleaner.Status().DeleteExpired(ctx, now)
+	suite.NoError(err)
+	suite.Equal(2, total)
+
+	// Only the expired statuses should have
+	// been queued for deletion, once each,
+	// skipping the trash.
+	queued := suite.popQueuedDeletes()
+	if suite.Len(queued, 2) {
+		suite.Equal(expired2.ID, queued[0].ID)
+		suite.Equal(expired1.ID, queued[1].ID)
+		suite.False(queued[0].InTrash())
+		suite.False(queued[1].InTrash())
+	}
+}
+
+func (suite *CleanerTestSuite) TestStatusAutoDelete() {
+	ctx := suite.T().Context()
+	now := time.Now()
+	account := testrig.NewTestAccounts()["admin_account"]
+
+	// Enable auto-delete of statuses older than a day.
+	settings, err := suite.state.DB.GetAccountSettings(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	settings.AutoDeleteAfterDays = 1
+	if err := suite.state.DB.UpdateAccountSettings(ctx, settings, "auto_delete_after_days"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Gather statuses the author
+	// has bookmarked or faved.
+	keep := make(map[string]bool)
+	for _, bookmark := range testrig.NewTestBookmarks() {
+		if bookmark.AccountID == account.ID {
+			keep[bookmark.StatusID] = true
+		}
+	}
+	for _, fave := range testrig.NewTestFaves() {
+		if fave.AccountID == account.ID {
+			keep[fave.StatusID] = true
+		}
+	}
+
+	// Work out which statuses should be deleted.
+	maxID := id.ZeroULIDForTime(now.Add(-24 * time.Hour))
+	expect := make(map[string]bool)
+	for _, status := range testrig.NewTestStatuses() {
+		if status.AccountID != account.ID ||
+			status.ID >= maxID ||
+			status.BoostOfID != "" ||
+			!status.PinnedAt.IsZero() ||
+			keep[status.ID] {
+			continue
+		}
+		expect[status.ID] = true
+	}
+	suite.NotEmpty(expect)
+
+	total, err := suite.cleaner.Status().AutoDelete(ctx, now)
+	suite.NoError(err)
+	suite.Equal(len(expect), total)
+
+	// Exactly the expected statuses should
+	// have been queued for deletion.
+	for range total {
+		msg, ok := suite.state.Workers.Client.Queue.Pop()
+		suite.True(ok)
+		suite.Equal(ap.ActivityDelete, msg.APActivityType)
+		suite.True(expect[msg.GTSModel.(*gtsmodel.Status).ID])
+	}
+
+	_, ok := suite.state.Workers.Client.Queue.Pop()
+	suite.False(ok)
+}
+
+// expireStatus sets the test status with
+// given key to expire at the given time.
+func (suite *CleanerTestSuite) expireStatus(key string, expiresAt time.Time) *gtsmodel.Status {
+	status := testrig.NewTestStatuses()[key]
+	status.ExpiresAt = expiresAt
+	if err := suite.state.DB.UpdateStatus(suite.T().Context(), status, "expires_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+	return status
+}
+
+// trashStatus moves the test status with
+// given key to the trash at the given time.
+func (suite *CleanerTestSuite) trashStatus(key string, deletedAt time.Time) *gtsmodel.Status {
+	status := testrig.NewTestStatuses()[key]
+	status.DeletedAt = deletedAt
+	if err := suite.state.DB.UpdateStatus(suite.T().Context(), status, "deleted_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+	return status
+}
+
+// popQueuedDeletes pops all messages from the client API
+// queue, checking that each is a status delete, and returns
+// the statuses queued for deletion in order.
+func (suite *CleanerTestSuite) popQueuedDeletes() []*gtsmodel.Status {
+	var statuses []*gtsmodel.Status
+	for {
+		msg, ok := suite.state.Workers.Client.Queue.Pop()
+		if !ok {
+			return statuses
+		}
+		suite.Equal(ap.ObjectNote, msg.APObjectType)
+		suite.Equal(ap.ActivityDelete, msg.APActivityType)
+		statuses = append(statuses, msg.GTSModel.(*gtsmodel.Status))
+	}
+}
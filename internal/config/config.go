@@ -61,61 +61,91 @@ type Configuration struct {
 	LogClientIP        bool   `name:"log-client-ip" usage:"Include the client IP in logs"`
 	RequestIDHeader    string `name:"request-id-header" usage:"Header to extract the Request ID from. Eg.,'X-Request-Id'."`
 
-	ConfigPath                 string        `name:"config-path" usage:"Path to a file containing gotosocial configuration. Values set in this file will be overwritten by values set as env vars or arguments"`
-	ApplicationName            string        `name:"application-name" usage:"Name of the application, used in various places internally"`
-	LandingPageUser            string        `name:"landing-page-user" usage:"the user that should be shown on the instance's landing page"`
-	Host                       string        `name:"host" usage:"Hostname to use for the server (eg., example.org, gotosocial.whatever.com). DO NOT change this on a server that's already run!"`
-	AccountDomain              string        `name:"account-domain" usage:"Domain to use in account names (eg., example.org, whatever.com). If not set, will default to the setting for host. DO NOT change this on a server that's already run!"`
-	Protocol                   string        `name:"protocol" usage:"Protocol to use for the REST api of the server (only use http if you are debugging; https should be used even if running behind a reverse proxy!)"`
-	BindAddress                string        `name:"bind-address" usage:"Bind address to use for the GoToSocial server (eg., 0.0.0.0, 172.138.0.9, [::], localhost). For ipv6, enclose the address in square brackets, eg [2001:db8::fed1]. Default binds to all interfaces."`
-	Port                       int           `name:"port" usage:"Port to use for GoToSocial. Change this to 443 if you're running the binary directly on the host machine."`
-	TrustedProxies             []string      `name:"trusted-proxies" usage:"Proxies to trust when parsing x-forwarded headers into real IPs."`
-	SoftwareVersion            string        `name:"software-version" usage:""`
-	DbType                     string        `name:"db-type" usage:"Database type: eg., postgres"`
-	DbAddress                  string        `name:"db-address" usage:"Database ipv4 address, hostname, or filename"`
-	DbPort                     int           `name:"db-port" usage:"Database port"`
-	DbUser                     string        `name:"db-user" usage:"Database username"`
-	DbPassword                 string        `name:"db-password" usage:"Database password"`
-	DbDatabase                 string        `name:"db-database" usage:"Database name"`
-	DbTLSMode                  string        `name:"db-tls-mode" usage:"Database tls mode"`
-	DbTLSCACert                string        `name:"db-tls-ca-cert" usage:"Path to CA cert for db tls connection"`
-	DbMaxOpenConnsMultiplier   int           `name:"db-max-open-conns-multiplier" usage:"Multiplier to use per cpu for max open database connections. 0 or less is normalized to 1."`
-	DbSqliteJournalMode        string        `name:"db-sqlite-journal-mode" usage:"Sqlite only: see https://www.sqlite.org/pragma.html#pragma_journal_mode"`
-	DbSqliteSynchronous        string        `name:"db-sqlite-synchronous" usage:"Sqlite only: see https://www.sqlite.org/pragma.html#pragma_synchronous"`
-	DbSqliteCacheSize          bytesize.Size `name:"db-sqlite-cache-size" usage:"Sqlite only: see https://www.sqlite.org/pragma.html#pragma_cache_size"`
-	DbSqliteBusyTimeout        time.Duration `name:"db-sqlite-busy-timeout" usage:"Sqlite only: see https://www.sqlite.org/pragma.html#pragma_busy_timeout"`
-	DbPostgresConnectionString string        `name:"db-postgres-connection-string" usage:"Full Database URL for connection to postgres"`
+	ConfigPath                        string        `name:"config-path" usage:"Path to a file containing gotosocial configuration. Values set in this file will be overwritten by values set as env vars or arguments"`
+	ApplicationName                   string        `name:"application-name" usage:"Name of the application, used in various places internally"`
+	LandingPageUser                   string        `name:"landing-page-user" usage:"the user that should be shown on the instance's landing page"`
+	Host                              string        `name:"host" usage:"Hostname to use for the server (eg., example.org, gotosocial.whatever.com). DO NOT change this on a server that's already run!"`
+	AccountDomain                     string        `name:"account-domain" usage:"Domain to use in account names (eg., example.org, whatever.com). If not set, will default to the setting for host. DO NOT change this on a server that's already run!"`
+	Protocol                          string        `name:"protocol" usage:"Protocol to use for the REST api of the server (only use http if you are debugging; https should be used even if running behind a reverse proxy!)"`
+	BindAddress                       string        `name:"bind-address" usage:"Bind address to use for the GoToSocial server (eg., 0.0.0.0, 172.138.0.9, [::], localhost). For ipv6, enclose the address in square brackets, eg [2001:db8::fed1]. Default binds to all interfaces."`
+	Port                              int           `name:"port" usage:"Port to use for GoToSocial. Change this to 443 if you're running the binary directly on the host machine."`
+	TrustedProxies                    []string      `name:"trusted-proxies" usage:"Proxies to trust when parsing x-forwarded headers into real IPs."`
+	SoftwareVersion                   string        `name:"software-version" usage:""`
+	DbType                            string        `name:"db-type" usage:"Database type: eg., postgres"`
+	DbAddress                         string        `name:"db-address" usage:"Database ipv4 address, hostname, or filename"`
+	DbPort                            int           `name:"db-port" usage:"Database port"`
+	DbUser                            string        `name:"db-user" usage:"Database username"`
+	DbPassword                        string        `name:"db-password" usage:"Database password"`
+	DbDatabase                        string        `name:"db-database" usage:"Database name"`
+	DbTLSMode                         string        `name:"db-tls-mode" usage:"Database tls mode"`
+	DbTLSCACert                       string        `name:"db-tls-ca-cert" usage:"Path to CA cert for db tls connection"`
+	DbMaxOpenConnsMultiplier          int           `name:"db-max-open-conns-multiplier" usage:"Multiplier to use per cpu for max open database connections. 0 or less is normalized to 1."`
+	DbSqliteJournalMode               string        `name:"db-sqlite-journal-mode" usage:"Sqlite only: see https://www.sqlite.org/pragma.html#pragma_journal_mode"`
+	DbSqliteSynchronous               string        `name:"db-sqlite-synchronous" usage:"Sqlite only: see https://www.sqlite.org/pragma.html#pragma_synchronous"`
+	DbSqliteCacheSize                 bytesize.Size `name:"db-sqlite-cache-size" usage:"Sqlite only: see https://www.sqlite.org/pragma.html#pragma_cache_size"`
+	DbSqliteBusyTimeout               time.Duration `name:"db-sqlite-busy-timeout" usage:"Sqlite only: see https://www.sqlite.org/pragma.html#pragma_busy_timeout"`
+	DbSqliteBackupEvery               time.Duration `name:"db-sqlite-backup-every" usage:"Sqlite only: if set, take a backup snapshot of the database to db-sqlite-backup-dir this often. 0 disables scheduled backups."`
+	DbSqliteBackupDir                 string        `name:"db-sqlite-backup-dir" usage:"Sqlite only: directory in which to store scheduled backup snapshots of the database."`
+	DbSqliteBackupKeep                int           `name:"db-sqlite-backup-keep" usage:"Sqlite only: number of scheduled backup snapshots to keep in db-sqlite-backup-dir. Older snapshots are removed. 0 keeps all snapshots."`
+	DbPostgresConnectionString        string        `name:"db-postgres-connection-string" usage:"Full Database URL for connection to postgres"`
+	DbPostgresReplicaConnectionString string        `name:"db-postgres-replica-connection-string" usage:"Postgres only: optional connection string for a read-only replica, used for heavy read queries (timelines, search, RSS)."`
 
 	WebTemplateBaseDir string `name:"web-template-base-dir" usage:"Basedir for html templating files for rendering pages and composing emails."`
 	WebAssetBaseDir    string `name:"web-asset-base-dir" usage:"Directory to serve static assets from, accessible at example.org/assets/"`
 
-	InstanceFederationMode            string             `name:"instance-federation-mode" usage:"Set instance federation mode."`
-	InstanceFederationSpamFilter      bool               `name:"instance-federation-spam-filter" usage:"Enable basic spam filter heuristics for messages coming from other instances, and drop messages identified as spam"`
-	InstanceExposePeers               bool               `name:"instance-expose-peers" usage:"Allow unauthenticated users to query /api/v1/instance/peers?filter=open"`
-	InstanceExposeBlocklist           bool               `name:"instance-expose-blocklist" usage:"Expose list of blocked domains via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=blocked and /api/v1/instance/domain_blocks"`
-	InstanceExposeBlocklistWeb        bool               `name:"instance-expose-blocklist-web" usage:"Expose list of explicitly blocked domains as webpage on /about/domain_blocks"`
-	InstanceExposeAllowlist           bool               `name:"instance-expose-allowlist" usage:"Expose list of allowed domains via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=allowed and /api/v1/instance/domain_allows"`
-	InstanceExposeAllowlistWeb        bool               `name:"instance-expose-allowlist-web" usage:"Expose list of explicitly allowed domains as webpage on /about/domain_allows"`
-	InstanceExposePublicTimeline      bool               `name:"instance-expose-public-timeline" usage:"Allow unauthenticated users to query /api/v1/timelines/public"`
-	InstanceExposeCustomEmojis        bool               `name:"instance-expose-custom-emojis" usage:"Allow unauthenticated access to /api/v1/custom_emojis"`
-	InstanceDeliverToSharedInboxes    bool               `name:"instance-deliver-to-shared-inboxes" usage:"Deliver federated messages to shared inboxes, if they're available."`
-	InstanceInjectMastodonVersion     bool               `name:"instance-inject-mastodon-version" usage:"This injects a Mastodon compatible version in /api/v1/instance to help Mastodon clients that use that version for feature detection"`
-	InstanceLanguages                 language.Languages `name:"instance-languages" usage:"BCP47 language tags for the instance. Used to indicate the preferred languages of instance residents (in order from most-preferred to least-preferred)."`
-	InstanceSubscriptionsProcessFrom  string             `name:"instance-subscriptions-process-from" usage:"Time of day from which to start running instance subscriptions processing jobs. Should be in the format 'hh:mm:ss', eg., '15:04:05'."`
-	InstanceSubscriptionsProcessEvery time.Duration      `name:"instance-subscriptions-process-every" usage:"Period to elapse between instance subscriptions processing jobs, starting from instance-subscriptions-process-from."`
-	InstanceStatsMode                 string             `name:"instance-stats-mode" usage:"Allows you to customize the way stats are served to crawlers: one of '', 'serve', 'zero', 'baffle'. Home page stats remain unchanged."`
-	InstanceAllowBackdatingStatuses   bool               `name:"instance-allow-backdating-statuses" usage:"Allow local accounts to backdate statuses using the scheduled_at param to /api/v1/statuses"`
-
-	AccountsRegistrationOpen         bool `name:"accounts-registration-open" usage:"Allow anyone to submit an account signup request. If false, server will be invite-only."`
-	AccountsReasonRequired           bool `name:"accounts-reason-required" usage:"Do new account signups require a reason to be submitted on registration?"`
-	AccountsRegistrationDailyLimit   int  `name:"accounts-registration-daily-limit" usage:"Limit amount of approved account sign-ups allowed per 24hrs before registration is closed. 0 or less = no limit."`
-	AccountsRegistrationBacklogLimit int  `name:"accounts-registration-backlog-limit" usage:"Limit how big the 'accounts pending approval' queue can grow before registration is closed. 0 or less = no limit."`
-	AccountsAllowCustomCSS           bool `name:"accounts-allow-custom-css" usage:"Allow accounts to enable custom CSS for their profile pages and statuses."`
-	AccountsCustomCSSLength          int  `name:"accounts-custom-css-length" usage:"Maximum permitted length (characters) of custom CSS for accounts."`
-	AccountsMaxProfileFields         int  `name:"accounts-max-profile-fields" usage:"Maximum number of profile fields allowed for each account."`
+	InstanceFederationMode              string             `name:"instance-federation-mode" usage:"Set instance federation mode."`
+	InstanceFederationSpamFilter        bool               `name:"instance-federation-spam-filter" usage:"Enable basic spam filter heuristics for messages coming from other instances, and drop messages identified as spam"`
+	InstanceFederationIntegrityProofs   bool               `name:"instance-federation-integrity-proofs" usage:"Sign outgoing activities with Ed25519 object integrity proofs (FEP-8b32), and accept unsigned incoming activities that carry a valid proof."`
+	InstanceFederationThreadMaxDepth    int                `name:"instance-federation-thread-max-depth" usage:"Maximum number of replies to follow up (ancestors) or down (descendants) a thread when dereferencing remote threads."`
+	InstanceFederationThreadFetchBudget int                `name:"instance-federation-thread-fetch-budget" usage:"Maximum number of remote fetches to make when dereferencing a single remote thread."`
+	InstanceExposePeers                 bool               `name:"instance-expose-peers" usage:"Allow unauthenticated users to query /api/v1/instance/peers?filter=open"`
+	InstancePeersGranularity            string             `name:"instance-peers-granularity" usage:"Granularity of known peers exposed via /api/v1/instance/peers?filter=open: one of 'open' (domains only), 'metadata' (domains plus software name and version), or 'disabled' (not exposed at all)."`
+	InstanceExposeBlocklist             bool               `name:"instance-expose-blocklist" usage:"Expose list of blocked domains via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=blocked and /api/v1/instance/domain_blocks"`
+	InstanceExposeBlocklistWeb          bool               `name:"instance-expose-blocklist-web" usage:"Expose list of explicitly blocked domains as webpage on /about/domain_blocks"`
+	InstanceExposeAllowlist             bool               `name:"instance-expose-allowlist" usage:"Expose list of allowed domains via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=allowed and /api/v1/instance/domain_allows"`
+	InstanceExposeAllowlistWeb          bool               `name:"instance-expose-allowlist-web" usage:"Expose list of explicitly allowed domains as webpage on /about/domain_allows"`
+	InstanceExposePublicTimeline        bool               `name:"instance-expose-public-timeline" usage:"Allow unauthenticated users to query /api/v1/timelines/public"`
+	InstanceExposeCustomEmojis          bool               `name:"instance-expose-custom-emojis" usage:"Allow unauthenticated access to /api/v1/custom_emojis"`
+	InstanceDeliverToSharedInboxes      bool               `name:"instance-deliver-to-shared-inboxes" usage:"Deliver federated messages to shared inboxes, if they're available."`
+	InstanceInjectMastodonVersion       bool               `name:"instance-inject-mastodon-version" usage:"This injects a Mastodon compatible version in /api/v1/instance to help Mastodon clients that use that version for feature detection"`
+	InstanceLanguages                   language.Languages `name:"instance-languages" usage:"BCP47 language tags for the instance. Used to indicate the preferred languages of instance residents (in order from most-preferred to least-preferred)."`
+	InstanceSubscriptionsProcessFrom    string             `name:"instance-subscriptions-process-from" usage:"Time of day from which to start running instance subscriptions processing jobs. Should be in the format 'hh:mm:ss', eg., '15:04:05'."`
+	InstanceSubscriptionsProcessEvery   time.Duration      `name:"instance-subscriptions-process-every" usage:"Period to elapse between instance subscriptions processing jobs, starting from instance-subscriptions-process-from."`
+	InstanceStatsMode                   string             `name:"instance-stats-mode" usage:"Allows you to customize the way stats are served to crawlers: one of '', 'serve', 'zero', 'baffle'. Home page stats remain unchanged."`
+	InstanceAllowBackdatingStatuses     bool               `name:"instance-allow-backdating-statuses" usage:"Allow local accounts to backdate statuses using the scheduled_at param to /api/v1/statuses"`
+	InstanceDirectoryURL                string             `name:"instance-directory-url" usage:"URL of a shared account directory service to publish opted-in local accounts to, and to fetch suggested accounts from. Leave empty to disable."`
+	InstanceDirectorySyncEvery          time.Duration      `name:"instance-directory-sync-every" usage:"Period to elapse between syncs with the instance directory service."`
+	InstanceDirectoryMaxAccounts        int                `name:"instance-directory-max-accounts" usage:"Maximum number of accounts to fetch from the instance directory service for suggestions."`
+	InstanceNodeInfoRefreshEvery        time.Duration      `name:"instance-nodeinfo-refresh-every" usage:"Period to elapse between refreshes of the software name and version of known instances via nodeinfo. Set to 0 to disable."`
+	InstanceRemoteTimelinesEnabled      bool               `name:"instance-remote-timelines-enabled" usage:"Allow local users to browse the public local timelines of other instances via /api/v1/timelines/remote."`
+	InstanceOutboxIncludeBoosts         bool               `name:"instance-outbox-include-boosts" usage:"Include public boosts (as Announce activities) in the ActivityPub outboxes of local accounts, alongside their public posts."`
+	InstanceWebfingerAliasDomains       []string           `name:"instance-webfinger-alias-domains" usage:"Additional domains (eg., short vanity domains) for which this instance answers webfinger requests, so that @user@alias.domain resolves to the local account user, or to the account given in a webfinger alias mapping."`
+	InstanceExposeSitemap               bool               `name:"instance-expose-sitemap" usage:"Serve a sitemap of discoverable and indexable local profiles and their recent public posts at /sitemap.xml, for search engines."`
+	InstanceSitemapRegenerateEvery      time.Duration      `name:"instance-sitemap-regenerate-every" usage:"Period to elapse between regenerations of the sitemap served at /sitemap.xml."`
+
+	AccountsRegistrationOpen             bool     `name:"accounts-registration-open" usage:"Allow anyone to submit an account signup request. If false, server will be invite-only."`
+	AccountsReasonRequired               bool     `name:"accounts-reason-required" usage:"Do new account signups require a reason to be submitted on registration?"`
+	AccountsRegistrationDailyLimit       int      `name:"accounts-registration-daily-limit" usage:"Limit amount of approved account sign-ups allowed per 24hrs before registration is closed. 0 or less = no limit."`
+	AccountsRegistrationBacklogLimit     int      `name:"accounts-registration-backlog-limit" usage:"Limit how big the 'accounts pending approval' queue can grow before registration is closed. 0 or less = no limit."`
+	AccountsAllowUserInvites             bool     `name:"accounts-allow-user-invites" usage:"Allow non-staff users to create invite links that can be used to sign up while registration is closed. Admins and moderators can always create invites."`
+	AccountsCaptchaProvider              string   `name:"accounts-captcha-provider" usage:"Captcha provider used to protect the sign-up form. Options: [hcaptcha, turnstile]. Leave empty to disable captcha."`
+	AccountsCaptchaSiteKey               string   `name:"accounts-captcha-site-key" usage:"Public site key for the configured captcha provider."`
+	AccountsCaptchaSecretKey             string   `name:"accounts-captcha-secret-key" usage:"Secret key for the configured captcha provider, used for server-side verification of captcha responses."`
+	AccountsAllowCustomCSS               bool     `name:"accounts-allow-custom-css" usage:"Allow accounts to enable custom CSS for their profile pages and statuses."`
+	AccountsCustomCSSLength              int      `name:"accounts-custom-css-length" usage:"Maximum permitted length (characters) of custom CSS for accounts."`
+	AccountsCustomCSSStrict              bool     `name:"accounts-custom-css-strict" usage:"Sanitize account custom CSS strictly: only allow properties from accounts-custom-css-allowed-properties, and drop all url() references."`
+	AccountsCustomCSSAllowedProperties   []string `name:"accounts-custom-css-allowed-properties" usage:"CSS properties allowed in account custom CSS when accounts-custom-css-strict is enabled. Custom properties (--*) are always allowed."`
+	AccountsMaxProfileFields             int      `name:"accounts-max-profile-fields" usage:"Maximum number of profile fields allowed for each account."`
+	AccountsMaxServiceAccounts           int      `name:"accounts-max-service-accounts" usage:"Maximum number of service (bot) accounts that each user may create and manage. 0 or less = users cannot create service accounts."`
+	AccountsRateLimitStatuses            int      `name:"accounts-rate-limit-statuses" usage:"Maximum number of statuses a user may post per hour. 0 or less = no limit."`
+	AccountsRateLimitFollows             int      `name:"accounts-rate-limit-follows" usage:"Maximum number of follow requests a user may send per hour. 0 or less = no limit."`
+	AccountsRateLimitReports             int      `name:"accounts-rate-limit-reports" usage:"Maximum number of reports a user may create per hour. 0 or less = no limit."`
+	AccountsRateLimitModeratorMultiplier int      `name:"accounts-rate-limit-moderator-multiplier" usage:"Multiplier applied to per-account rate limits for moderators. 0 or less = moderators are exempt."`
+	AccountsRateLimitAdminMultiplier     int      `name:"accounts-rate-limit-admin-multiplier" usage:"Multiplier applied to per-account rate limits for admins. 0 or less = admins are exempt."`
 
 	StorageBackend        string `name:"storage-backend" usage:"Storage backend to use for media attachments"`
 	StorageLocalBasePath  string `name:"storage-local-base-path" usage:"Full path to an already-created directory where gts should store/retrieve media files. Subfolders will be created within this dir."`
+	StorageLocalChecksums bool   `name:"storage-local-checksums" usage:"Calculate SHA-256 checksums of media files as they are written to local storage, allowing the media cleaner to detect corrupted or truncated files."`
 	StorageS3Endpoint     string `name:"storage-s3-endpoint" usage:"S3 Endpoint URL (e.g 'minio.example.org:9000')"`
 	StorageS3AccessKey    string `name:"storage-s3-access-key" usage:"S3 Access Key"`
 	StorageS3SecretKey    string `name:"storage-s3-secret-key" usage:"S3 Secret Key"`
@@ -125,15 +155,23 @@ type Configuration struct {
 	StorageS3RedirectURL  string `name:"storage-s3-redirect-url" usage:"Custom URL to use for redirecting S3 media links. If set, this will be used instead of the S3 bucket URL."`
 	StorageS3BucketLookup string `name:"storage-s3-bucket-lookup" usage:"S3 bucket lookup type to use. Can be 'auto', 'dns' or 'path'. Defaults to 'auto'."`
 	StorageS3KeyPrefix    string `name:"storage-s3-key-prefix" usage:"Prefix to use for S3 keys. This is useful for separating multiple instances sharing the same S3 bucket."`
+	StorageWebDAVURL      string `name:"storage-webdav-url" usage:"URL of the WebDAV collection to store media in (e.g 'https://dav.example.org/gotosocial/')"`
+	StorageWebDAVUsername string `name:"storage-webdav-username" usage:"Username for WebDAV basic authentication"`
+	StorageWebDAVPassword string `name:"storage-webdav-password" usage:"Password for WebDAV basic authentication"`
 
-	StatusesMaxChars           int `name:"statuses-max-chars" usage:"Max permitted characters for posted statuses, including content warning"`
-	StatusesPollMaxOptions     int `name:"statuses-poll-max-options" usage:"Max amount of options permitted on a poll"`
-	StatusesPollOptionMaxChars int `name:"statuses-poll-option-max-chars" usage:"Max amount of characters for a poll option"`
-	StatusesMediaMaxFiles      int `name:"statuses-media-max-files" usage:"Maximum number of media files/attachments per status"`
+	StatusesMaxChars           int           `name:"statuses-max-chars" usage:"Max permitted characters for posted statuses, including content warning"`
+	StatusesPollMaxOptions     int           `name:"statuses-poll-max-options" usage:"Max amount of options permitted on a poll"`
+	StatusesPollOptionMaxChars int           `name:"statuses-poll-option-max-chars" usage:"Max amount of characters for a poll option"`
+	StatusesPollLocalVoters    bool          `name:"statuses-poll-local-voters" usage:"Allow poll authors to see which local accounts voted for each option of their polls"`
+	StatusesMediaMaxFiles      int           `name:"statuses-media-max-files" usage:"Maximum number of media files/attachments per status"`
+	StatusesTrashWindow        time.Duration `name:"statuses-trash-window" usage:"Duration for which deleted statuses are kept in the trash, restorable by their author, before being permanently deleted. 0 disables the trash, deleting statuses immediately."`
+	StatusesDetectLanguage     bool          `name:"statuses-detect-language" usage:"Detect the language of new statuses that don't have one set by the client, instead of falling back to the account's default post language."`
 
 	ScheduledStatusesMaxTotal int `name:"scheduled-statuses-max-total" usage:"Maximum number of scheduled statuses per user"`
 	ScheduledStatusesMaxDaily int `name:"scheduled-statuses-max-daily" usage:"Maximum number of scheduled statuses per user for a single day"`
 
+	NotificationsMaxAge time.Duration `name:"notifications-max-age" usage:"Age after which notifications are permanently deleted from the database. 0 keeps notifications forever."`
+
 	LetsEncryptEnabled      bool   `name:"letsencrypt-enabled" usage:"Enable letsencrypt TLS certs for this server. If set to true, then cert dir also needs to be set (or take the default)."`
 	LetsEncryptPort         int    `name:"letsencrypt-port" usage:"Port to listen on for letsencrypt certificate challenges. Must not be the same as the GtS webserver/API port."`
 	LetsEncryptCertDir      string `name:"letsencrypt-cert-dir" usage:"Directory to store acquired letsencrypt certificates."`
@@ -142,18 +180,19 @@ type Configuration struct {
 	TLSCertificateChain string `name:"tls-certificate-chain" usage:"Filesystem path to the certificate chain including any intermediate CAs and the TLS public key"`
 	TLSCertificateKey   string `name:"tls-certificate-key" usage:"Filesystem path to the TLS private key"`
 
-	OIDCEnabled          bool     `name:"oidc-enabled" usage:"Enabled OIDC authorization for this instance. If set to true, then the other OIDC flags must also be set."`
-	OIDCIdpName          string   `name:"oidc-idp-name" usage:"Name of the OIDC identity provider. Will be shown to the user when logging in."`
-	OIDCSkipVerification bool     `name:"oidc-skip-verification" usage:"Skip verification of tokens returned by the OIDC provider. Should only be set to 'true' for testing purposes, never in a production environment!"`
-	OIDCIssuer           string   `name:"oidc-issuer" usage:"Address of the OIDC issuer. Should be the web address, including protocol, at which the issuer can be reached. Eg., 'https://example.org/auth'"`
-	OIDCClientID         string   `name:"oidc-client-id" usage:"ClientID of GoToSocial, as registered with the OIDC provider."`
-	OIDCClientSecret     string   `name:"oidc-client-secret" usage:"ClientSecret of GoToSocial, as registered with the OIDC provider."`
-	OIDCScopes           []string `name:"oidc-scopes" usage:"OIDC scopes."`
-	OIDCLinkExisting     bool     `name:"oidc-link-existing" usage:"link existing user accounts to OIDC logins based on the stored email value"`
-	OIDCAllowedGroups    []string `name:"oidc-allowed-groups" usage:"Membership of one of the listed groups allows access to GtS. If this is empty, all groups are allowed."`
-	OIDCAdminGroups      []string `name:"oidc-admin-groups" usage:"Membership of one of the listed groups makes someone a GtS admin"`
-	TracingEnabled       bool     `name:"tracing-enabled" usage:"Enable OTLP Tracing"`
-	MetricsEnabled       bool     `name:"metrics-enabled" usage:"Enable OpenTelemetry based metrics support."`
+	OIDCEnabled           bool     `name:"oidc-enabled" usage:"Enabled OIDC authorization for this instance. If set to true, then the other OIDC flags must also be set."`
+	OIDCIdpName           string   `name:"oidc-idp-name" usage:"Name of the OIDC identity provider. Will be shown to the user when logging in."`
+	OIDCSkipVerification  bool     `name:"oidc-skip-verification" usage:"Skip verification of tokens returned by the OIDC provider. Should only be set to 'true' for testing purposes, never in a production environment!"`
+	OIDCIssuer            string   `name:"oidc-issuer" usage:"Address of the OIDC issuer. Should be the web address, including protocol, at which the issuer can be reached. Eg., 'https://example.org/auth'"`
+	OIDCClientID          string   `name:"oidc-client-id" usage:"ClientID of GoToSocial, as registered with the OIDC provider."`
+	OIDCClientSecret      string   `name:"oidc-client-secret" usage:"ClientSecret of GoToSocial, as registered with the OIDC provider."`
+	OIDCScopes            []string `name:"oidc-scopes" usage:"OIDC scopes."`
+	OIDCLinkExisting      bool     `name:"oidc-link-existing" usage:"link existing user accounts to OIDC logins based on the stored email value"`
+	OIDCAllowedGroups     []string `name:"oidc-allowed-groups" usage:"Membership of one of the listed groups allows access to GtS. If this is empty, all groups are allowed."`
+	OIDCAdminGroups       []string `name:"oidc-admin-groups" usage:"Membership of one of the listed groups makes someone a GtS admin"`
+	TracingEnabled        bool     `name:"tracing-enabled" usage:"Enable OTLP Tracing"`
+	MetricsEnabled        bool     `name:"metrics-enabled" usage:"Enable OpenTelemetry based metrics support."`
+	DebugEndpointsEnabled bool     `name:"debug-endpoints-enabled" usage:"Enable admin-authenticated debug endpoints (/debug/pprof and /api/v1/admin/debug/runtime) for diagnosing performance and memory issues."`
 
 	SMTPHost               string `name:"smtp-host" usage:"Host of the smtp server. Eg., 'smtp.eu.mailgun.org'"`
 	SMTPPort               int    `name:"smtp-port" usage:"Port of the smtp server. Eg., 587"`
@@ -166,6 +205,22 @@ type Configuration struct {
 	SyslogProtocol string `name:"syslog-protocol" usage:"Protocol to use when directing logs to syslog. Leave empty to connect to local syslog."`
 	SyslogAddress  string `name:"syslog-address" usage:"Address:port to send syslog logs to. Leave empty to connect to local syslog."`
 
+	ModerationHookStatusCreateURL     string        `name:"moderation-hook-status-create-url" usage:"URL of an external moderation service to call before creating or editing statuses from local accounts. Leave empty to disable."`
+	ModerationHookFederationIngestURL string        `name:"moderation-hook-federation-ingest-url" usage:"URL of an external moderation service to call before handling activities received from remote instances. Leave empty to disable."`
+	ModerationHookMediaStoreURL       string        `name:"moderation-hook-media-store-url" usage:"URL of an external moderation service to call before storing processed media. Leave empty to disable."`
+	ModerationHookTimeout             time.Duration `name:"moderation-hook-timeout" usage:"Timeout for each call to an external moderation service."`
+	ModerationHookFailClosed          bool          `name:"moderation-hook-fail-closed" usage:"If true, reject content when an external moderation service cannot be reached or returns an error. If false, such content is allowed through."`
+
+	TranslationBackend   string `name:"translation-backend" usage:"Translation service backend used to translate statuses. Options: [libretranslate, deepl]. Leave empty to disable translation."`
+	TranslationURL       string `name:"translation-url" usage:"Base URL of the translation service API. Required for libretranslate. For deepl, defaults to https://api.deepl.com if empty."`
+	TranslationAPIKey    string `name:"translation-api-key" usage:"API key used to authenticate with the translation service, if required."`
+	TranslationRateLimit int    `name:"translation-rate-limit" usage:"Maximum number of translation requests this instance will make to the translation service per minute. 0 means no limit."`
+
+	ReadOnlyMode       bool          `name:"read-only-mode" usage:"Start in read-only mode, in which write requests and incoming federation are rejected with code 503 and background workers are paused, to allow for safe database maintenance. Admins can also toggle this at runtime."`
+	ReadOnlyRetryAfter time.Duration `name:"read-only-retry-after" usage:"Retry-After duration to send with responses to requests rejected in read-only mode."`
+
+	ShutdownDrainTimeout time.Duration `name:"shutdown-drain-timeout" usage:"Maximum duration to wait on shutdown, after incoming HTTP requests have stopped, for queued worker tasks to be processed. Any remaining tasks are persisted to the database where possible, to be processed on next startup. 0 disables draining."`
+
 	// Advanced flags.
 	Advanced AdvancedConfig `name:"advanced"`
 
@@ -186,6 +241,19 @@ type Configuration struct {
 	AdminMediaPruneDryRun    bool   `name:"dry-run" usage:"perform a dry run and only log number of items eligible for pruning" ephemeral:"yes"`
 	AdminMediaListLocalOnly  bool   `name:"local-only" usage:"list only local attachments/emojis; if specified then remote-only cannot also be true" ephemeral:"yes"`
 	AdminMediaListRemoteOnly bool   `name:"remote-only" usage:"list only remote attachments/emojis; if specified then local-only cannot also be true" ephemeral:"yes"`
+	AdminAPIURL              string `name:"api-url" usage:"base URL of the running instance to make admin API requests to; defaults to the protocol and host from config" ephemeral:"yes"`
+	AdminAPIToken            string `name:"api-token" usage:"admin access token to authenticate admin API requests with; if not set, the token is read from api-token-file" ephemeral:"yes"`
+	AdminAPITokenFile        string `name:"api-token-file" usage:"path of a file containing the admin access token to use; defaults to gotosocial/admin-token in the user's config directory" ephemeral:"yes"`
+	AdminReason              string `name:"reason" usage:"the (private) reason for taking the action" ephemeral:"yes"`
+	AdminDomain              string `name:"domain" usage:"the domain to create the domain limit for" ephemeral:"yes"`
+	AdminMediaPolicy         string `name:"media-policy" usage:"policy to apply to media from the domain: [no_action, mark_sensitive, reject]" ephemeral:"yes"`
+	AdminFollowsPolicy       string `name:"follows-policy" usage:"policy to apply to follows from the domain: [no_action, manual_approval, reject_non_mutual, reject_all]" ephemeral:"yes"`
+	AdminStatusesPolicy      string `name:"statuses-policy" usage:"policy to apply to statuses of non-followed accounts on the domain: [no_action, filter_warn, filter_hide]" ephemeral:"yes"`
+	AdminAccountsPolicy      string `name:"accounts-policy" usage:"policy to apply to non-followed accounts on the domain: [no_action, mute]" ephemeral:"yes"`
+	AdminContentWarning      string `name:"content-warning" usage:"content warning to prepend to statuses from the domain" ephemeral:"yes"`
+	AdminPublicComment       string `name:"public-comment" usage:"the publicly stated reason for taking the action" ephemeral:"yes"`
+	AdminReportsResolved     bool   `name:"resolved" usage:"list resolved reports, instead of unresolved reports" ephemeral:"yes"`
+	AdminEmojiCategory       string `name:"category" usage:"category in which to place the imported emojis; defaults to the file name of the pack" ephemeral:"yes"`
 	TestrigSkipDBSetup       bool   `name:"skip-db-setup" usage:"skip testrig database setup with population of test models" ephemeral:"yes"`
 	TestrigSkipDBTeardown    bool   `name:"skip-db-teardown" usage:"skip testrig database teardown (i.e. data deletion and tables dropped)" ephemeral:"yes"`
 }
@@ -212,22 +280,32 @@ type MediaConfiguration struct {
 	CleanupEvery        time.Duration `name:"cleanup-every" usage:"Period to elapse between cleanups, starting from media-cleanup-at."`
 	FfmpegPoolSize      int           `name:"ffmpeg-pool-size" usage:"Number of instances of the embedded ffmpeg WASM binary to add to the media processing pool. 0 or less uses GOMAXPROCS."`
 	ThumbMaxPixels      int           `name:"thumb-max-pixels" usage:"Max size in pixels of any one dimension of a thumbnail (as input media ratio is preserved)."`
+	ThumbFormat         string        `name:"thumb-format" usage:"Encoding format to use for generated thumbnails: jpeg (falling back to webp where necessary), or webp."`
+	VideoSpriteFrames   int           `name:"video-sprite-frames" usage:"Number of frames to include in scrubbing preview sprite sheets generated for video attachments. 0 disables sprite sheet generation."`
 }
 
 type CacheConfiguration struct {
 	S3ObjectInfo                         int           `name:"s3-object-info" usage:"Enables caching of S3 object information in the storage driver to reduce S3 calls, value is cache capacity."`
 	HomeTimelineTimeout                  time.Duration `name:"home-timeline-timeout" usage:"Duration before any one home timeline cache is unloaded from memory. Values <= 0 disable unloading."`
+	HomeTimelineMaxEntries               int           `name:"home-timeline-max-entries" usage:"Maximum number of entries kept in any one home timeline cache. Timelines are periodically trimmed back to below this size."`
 	ListTimelineTimeout                  time.Duration `name:"list-timeline-timeout" usage:"Duration before any one list timeline cache is unloaded from memory. Values <= 0 disable unloading."`
 	TagTimelineTimeout                   time.Duration `name:"tag-timeline-timeout" usage:"Duration before any one tag timeline cache is unloaded from memory. Values <= 0 disable unloading."`
+	LanguageTimelineTimeout              time.Duration `name:"language-timeline-timeout" usage:"Duration before any one language-sharded public or local timeline cache is unloaded from memory. Values <= 0 disable unloading."`
+	AntennaTimelineTimeout               time.Duration `name:"antenna-timeline-timeout" usage:"Duration before any one antenna timeline cache is unloaded from memory. Values <= 0 disable unloading."`
+	VisibilitySweepFrequency             time.Duration `name:"visibility-sweep-frequency" usage:"Period to elapse between full sweeps of the visibility cache, catching any stale results missed by targeted invalidation. Values <= 0 disable sweeping."`
 	MemoryTarget                         bytesize.Size `name:"memory-target"`
 	AccountMemRatio                      float64       `name:"account-mem-ratio"`
 	AccountNoteMemRatio                  float64       `name:"account-note-mem-ratio"`
 	AccountSettingsMemRatio              float64       `name:"account-settings-mem-ratio"`
 	AccountStatsMemRatio                 float64       `name:"account-stats-mem-ratio"`
+	AntennaMemRatio                      float64       `name:"antenna-mem-ratio"`
+	AntennaIDsMemRatio                   float64       `name:"antenna-ids-mem-ratio"`
 	ApplicationMemRatio                  float64       `name:"application-mem-ratio"`
 	BlockMemRatio                        float64       `name:"block-mem-ratio"`
 	BlockIDsMemRatio                     float64       `name:"block-ids-mem-ratio"`
 	BoostOfIDsMemRatio                   float64       `name:"boost-of-ids-mem-ratio"`
+	BookmarkCollectionMemRatio           float64       `name:"bookmark-collection-mem-ratio"`
+	BookmarkCollectionIDsMemRatio        float64       `name:"bookmark-collection-ids-mem-ratio"`
 	ClientMemRatio                       float64       `name:"client-mem-ratio"`
 	ConversationMemRatio                 float64       `name:"conversation-mem-ratio"`
 	ConversationLastStatusIDsMemRatio    float64       `name:"conversation-last-status-ids-mem-ratio"`
@@ -256,6 +334,7 @@ type CacheConfiguration struct {
 	MediaMemRatio                        float64       `name:"media-mem-ratio"`
 	MentionMemRatio                      float64       `name:"mention-mem-ratio"`
 	MoveMemRatio                         float64       `name:"move-mem-ratio"`
+	MutedTagIDsMemRatio                  float64       `name:"muted-tag-ids-mem-ratio"`
 	NotificationMemRatio                 float64       `name:"notification-mem-ratio"`
 	PollMemRatio                         float64       `name:"poll-mem-ratio"`
 	PollVoteMemRatio                     float64       `name:"poll-vote-mem-ratio"`
@@ -269,6 +348,8 @@ type CacheConfiguration struct {
 	StatusEditMemRatio                   float64       `name:"status-edit-mem-ratio"`
 	StatusFaveMemRatio                   float64       `name:"status-fave-mem-ratio"`
 	StatusFaveIDsMemRatio                float64       `name:"status-fave-ids-mem-ratio"`
+	StatusReactionMemRatio               float64       `name:"status-reaction-mem-ratio"`
+	StatusReactionIDsMemRatio            float64       `name:"status-reaction-ids-mem-ratio"`
 	TagMemRatio                          float64       `name:"tag-mem-ratio"`
 	ThreadMuteMemRatio                   float64       `name:"thread-mute-mem-ratio"`
 	TokenMemRatio                        float64       `name:"token-mem-ratio"`
@@ -211,3 +211,35 @@ type testcase struct {
 		})
 	}
 }
+
+func TestReloadConfigFile(t *testing.T) {
+	path := t.TempDir() + "/config.yaml"
+	write := func(data string) {
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write("host: \"gts.example.org\"\nlog-level: \"info\"\nmedia-local-max-size: \"10MiB\"\n")
+
+	state := config.NewState()
+	state.SetConfigPath(path)
+	if err := state.LoadConfigFile(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Change a reloadable key, drop another
+	// back to default, and change a key that
+	// must not be reloaded while running.
+	write("host: \"other.example.org\"\nlog-level: \"debug\"\n")
+
+	changed, err := state.ReloadConfigFile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.ElementsMatch(t, []string{"log-level", "media-local-max-size"}, changed)
+	assert.Equal(t, "debug", state.GetLogLevel())
+	assert.Equal(t, config.Defaults.Media.LocalMaxSize, state.GetMediaLocalMaxSize())
+	assert.Equal(t, "gts.example.org", state.GetHost())
+}
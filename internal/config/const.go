@@ -42,3 +42,19 @@
 	InstanceStatsModeZero    = "zero"
 	InstanceStatsModeBaffle  = "baffle"
 )
+
+// Instance peers granularity determines if and
+// how much detail about known peer instances is
+// served at /api/v1/instance/peers?filter=open.
+const (
+	InstancePeersGranularityOpen     = "open"
+	InstancePeersGranularityMetadata = "metadata"
+	InstancePeersGranularityDisabled = "disabled"
+)
+
+// Media thumb format determines the encoding
+// used for generated media attachment thumbnails.
+const (
+	MediaThumbFormatJPEG = "jpeg"
+	MediaThumbFormatWebP = "webp"
+)
@@ -54,21 +54,32 @@
 	DbSqliteSynchronous:      "NORMAL",
 	DbSqliteCacheSize:        8 * bytesize.MiB,
 	DbSqliteBusyTimeout:      time.Minute * 30,
+	DbSqliteBackupKeep:       7,
 
 	WebTemplateBaseDir: "./web/template/",
 	WebAssetBaseDir:    "./web/assets/",
 
-	InstanceFederationMode:            InstanceFederationModeDefault,
-	InstanceFederationSpamFilter:      false,
-	InstanceExposePeers:               false,
-	InstanceExposeBlocklist:           false,
-	InstanceExposeBlocklistWeb:        false,
-	InstanceExposeCustomEmojis:        false,
-	InstanceDeliverToSharedInboxes:    true,
-	InstanceLanguages:                 make(language.Languages, 0),
-	InstanceSubscriptionsProcessFrom:  "23:00",        // 11pm,
-	InstanceSubscriptionsProcessEvery: 24 * time.Hour, // 1/day.
-	InstanceAllowBackdatingStatuses:   true,
+	InstanceFederationMode:              InstanceFederationModeDefault,
+	InstanceFederationSpamFilter:        false,
+	InstanceFederationThreadMaxDepth:    100,
+	InstanceFederationThreadFetchBudget: 500,
+	InstanceExposePeers:                 false,
+	InstancePeersGranularity:            InstancePeersGranularityOpen,
+	InstanceExposeBlocklist:             false,
+	InstanceExposeBlocklistWeb:          false,
+	InstanceExposeCustomEmojis:          false,
+	InstanceDeliverToSharedInboxes:      true,
+	InstanceLanguages:                   make(language.Languages, 0),
+	InstanceSubscriptionsProcessFrom:    "23:00",        // 11pm,
+	InstanceSubscriptionsProcessEvery:   24 * time.Hour, // 1/day.
+	InstanceDirectoryURL:                "",
+	InstanceDirectorySyncEvery:          6 * time.Hour,
+	InstanceDirectoryMaxAccounts:        200,
+	InstanceNodeInfoRefreshEvery:        24 * time.Hour,
+	InstanceRemoteTimelinesEnabled:      false,
+	InstanceAllowBackdatingStatuses:     true,
+	InstanceExposeSitemap:               true,
+	InstanceSitemapRegenerateEvery:      6 * time.Hour,
 
 	AccountsRegistrationOpen:         false,
 	AccountsReasonRequired:           true,
@@ -76,7 +87,35 @@
 	AccountsRegistrationBacklogLimit: 20,
 	AccountsAllowCustomCSS:           false,
 	AccountsCustomCSSLength:          10000,
-	AccountsMaxProfileFields:         6,
+	AccountsCustomCSSStrict:          false,
+	AccountsCustomCSSAllowedProperties: []string{
+		"background",
+		"background-color",
+		"border",
+		"border-color",
+		"border-radius",
+		"border-style",
+		"border-width",
+		"box-shadow",
+		"color",
+		"font-family",
+		"font-size",
+		"font-style",
+		"font-weight",
+		"letter-spacing",
+		"line-height",
+		"margin",
+		"opacity",
+		"outline",
+		"padding",
+		"text-align",
+		"text-decoration",
+		"text-shadow",
+		"text-transform",
+		"word-spacing",
+	},
+	AccountsMaxProfileFields:   6,
+	AccountsMaxServiceAccounts: 5,
 
 	Media: MediaConfiguration{
 		DescriptionMinChars: 0,
@@ -90,10 +129,13 @@
 		CleanupEvery:        24 * time.Hour, // 1/day.
 		FfmpegPoolSize:      1,
 		ThumbMaxPixels:      512,
+		ThumbFormat:         MediaThumbFormatJPEG,
+		VideoSpriteFrames:   0,
 	},
 
 	StorageBackend:        "local",
 	StorageLocalBasePath:  "/gotosocial/storage",
+	StorageLocalChecksums: false,
 	StorageS3UseSSL:       true,
 	StorageS3Proxy:        false,
 	StorageS3RedirectURL:  "",
@@ -102,7 +144,10 @@
 	StatusesMaxChars:           5000,
 	StatusesPollMaxOptions:     6,
 	StatusesPollOptionMaxChars: 50,
+	StatusesPollLocalVoters:    false,
 	StatusesMediaMaxFiles:      6,
+	StatusesTrashWindow:        0,
+	StatusesDetectLanguage:     false,
 
 	ScheduledStatusesMaxTotal: 300,
 	ScheduledStatusesMaxDaily: 25,
@@ -138,6 +183,22 @@
 	SyslogProtocol: "udp",
 	SyslogAddress:  "localhost:514",
 
+	ModerationHookStatusCreateURL:     "",
+	ModerationHookFederationIngestURL: "",
+	ModerationHookMediaStoreURL:       "",
+	ModerationHookTimeout:             5 * time.Second,
+	ModerationHookFailClosed:          false,
+
+	TranslationBackend:   "",
+	TranslationURL:       "",
+	TranslationAPIKey:    "",
+	TranslationRateLimit: 30,
+
+	ReadOnlyMode:       false,
+	ReadOnlyRetryAfter: 5 * time.Minute,
+
+	ShutdownDrainTimeout: 30 * time.Second,
+
 	Advanced: AdvancedConfig{
 		SenderMultiplier: 2, // 2 senders per CPU
 		CSPExtraURIs:     []string{},
@@ -169,9 +230,17 @@
 		S3ObjectInfo: 0,
 
 		// Timeline trim eviction timeouts.
-		HomeTimelineTimeout: 6 * time.Hour,
-		ListTimelineTimeout: 2 * time.Hour,
-		TagTimelineTimeout:  10 * time.Minute,
+		HomeTimelineTimeout:     6 * time.Hour,
+		ListTimelineTimeout:     2 * time.Hour,
+		TagTimelineTimeout:      10 * time.Minute,
+		LanguageTimelineTimeout: 10 * time.Minute,
+		AntennaTimelineTimeout:  2 * time.Hour,
+
+		// Home timeline cache max length.
+		HomeTimelineMaxEntries: 800,
+
+		// Visibility cache consistency sweep.
+		VisibilitySweepFrequency: time.Hour,
 
 		// Rough memory target that the total
 		// size of all State.Caches will attempt
@@ -196,10 +265,14 @@
 		AccountNoteMemRatio:                  1,
 		AccountSettingsMemRatio:              0.1,
 		AccountStatsMemRatio:                 2,
+		AntennaMemRatio:                      0.5,
+		AntennaIDsMemRatio:                   0.5,
 		ApplicationMemRatio:                  0.1,
 		BlockMemRatio:                        2,
 		BlockIDsMemRatio:                     3,
 		BoostOfIDsMemRatio:                   3,
+		BookmarkCollectionMemRatio:           0.5,
+		BookmarkCollectionIDsMemRatio:        0.5,
 		ClientMemRatio:                       0.1,
 		ConversationMemRatio:                 1,
 		ConversationLastStatusIDsMemRatio:    2,
@@ -228,6 +301,7 @@
 		MediaMemRatio:                        4,
 		MentionMemRatio:                      2,
 		MoveMemRatio:                         0.1,
+		MutedTagIDsMemRatio:                  0.5,
 		NotificationMemRatio:                 2,
 		PollMemRatio:                         1,
 		PollVoteMemRatio:                     2,
@@ -241,6 +315,8 @@
 		StatusEditMemRatio:                   2,
 		StatusFaveMemRatio:                   2,
 		StatusFaveIDsMemRatio:                3,
+		StatusReactionMemRatio:               1,
+		StatusReactionIDsMemRatio:            1,
 		TagMemRatio:                          2,
 		ThreadMuteMemRatio:                   0.2,
 		TokenMemRatio:                        0.75,
@@ -85,6 +85,84 @@ func AddAdminMediaPrune(cmd *cobra.Command) {
 	cmd.Flags().Bool(name, true, usage)
 }
 
+// AddAdminAPI attaches flags pertaining to commands
+// performed via the admin API of a running instance.
+func AddAdminAPI(cmd *cobra.Command) {
+	apiURL := AdminAPIURLFlag
+	apiURLUsage := fieldtag("AdminAPIURL", "usage")
+	cmd.Flags().String(apiURL, "", apiURLUsage)
+
+	apiToken := AdminAPITokenFlag
+	apiTokenUsage := fieldtag("AdminAPIToken", "usage")
+	cmd.Flags().String(apiToken, "", apiTokenUsage)
+
+	apiTokenFile := AdminAPITokenFileFlag
+	apiTokenFileUsage := fieldtag("AdminAPITokenFile", "usage")
+	cmd.Flags().String(apiTokenFile, "", apiTokenFileUsage)
+}
+
+// AddAdminAccountSuspend attaches flags pertaining to admin account suspension.
+func AddAdminAccountSuspend(cmd *cobra.Command) {
+	AddAdminAPI(cmd)
+	AddAdminAccount(cmd)
+
+	name := AdminReasonFlag
+	usage := fieldtag("AdminReason", "usage")
+	cmd.Flags().String(name, "", usage)
+}
+
+// AddAdminDomainLimitCreate attaches flags pertaining to domain limit creation.
+func AddAdminDomainLimitCreate(cmd *cobra.Command) {
+	AddAdminAPI(cmd)
+
+	name := AdminDomainFlag
+	usage := fieldtag("AdminDomain", "usage")
+	cmd.Flags().String(name, "", usage) // REQUIRED
+	if err := cmd.MarkFlagRequired(name); err != nil {
+		panic(err)
+	}
+
+	for _, f := range []struct{ name, field string }{
+		{AdminMediaPolicyFlag, "AdminMediaPolicy"},
+		{AdminFollowsPolicyFlag, "AdminFollowsPolicy"},
+		{AdminStatusesPolicyFlag, "AdminStatusesPolicy"},
+		{AdminAccountsPolicyFlag, "AdminAccountsPolicy"},
+		{AdminContentWarningFlag, "AdminContentWarning"},
+		{AdminPublicCommentFlag, "AdminPublicComment"},
+		{AdminReasonFlag, "AdminReason"},
+	} {
+		cmd.Flags().String(f.name, "", fieldtag(f.field, "usage"))
+	}
+}
+
+// AddAdminReportList attaches flags pertaining to report list commands.
+func AddAdminReportList(cmd *cobra.Command) {
+	AddAdminAPI(cmd)
+
+	name := AdminReportsResolvedFlag
+	usage := fieldtag("AdminReportsResolved", "usage")
+	cmd.Flags().Bool(name, false, usage)
+}
+
+// AddAdminEmojiImport attaches flags pertaining to emoji pack import commands.
+func AddAdminEmojiImport(cmd *cobra.Command) {
+	AddAdminAPI(cmd)
+	AddAdminTrans(cmd)
+
+	name := AdminEmojiCategoryFlag
+	usage := fieldtag("AdminEmojiCategory", "usage")
+	cmd.Flags().String(name, "", usage)
+}
+
+// AddMigrationsRun attaches flags pertaining to the migrations run command.
+func AddMigrationsRun(cmd *cobra.Command) {
+	// Shares the "dry-run" config key with media prune,
+	// but defaults to false here so migrations run as normal.
+	name := AdminMediaPruneDryRunFlag
+	usage := "list pending migrations and print the SQL they would run, without executing anything"
+	cmd.Flags().Bool(name, false, usage)
+}
+
 // AddTestrig attaches flags pertaining to testrig commands.
 func AddTestrig(cmd *cobra.Command) {
 	skipDBSetup := TestrigSkipDBSetupFlag
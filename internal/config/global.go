@@ -43,6 +43,10 @@ func BindFlags(cmd *cobra.Command) error { return global.BindFlags(cmd) }
 // LoadConfigFile loads the currently set configuration file into the global viper instance.
 func LoadConfigFile() error { return global.LoadConfigFile() }
 
+// ReloadConfigFile re-reads the currently set configuration file into
+// the global configuration, applying only changes to ReloadableKeys.
+func ReloadConfigFile() ([]string, error) { return global.ReloadConfigFile() }
+
 // Reset will totally clear global
 // ConfigState{}, loading defaults.
 func Reset() { global.Reset() }
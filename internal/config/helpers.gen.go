@@ -58,12 +58,20 @@
 	DbSqliteSynchronousFlag                       = "db-sqlite-synchronous"
 	DbSqliteCacheSizeFlag                         = "db-sqlite-cache-size"
 	DbSqliteBusyTimeoutFlag                       = "db-sqlite-busy-timeout"
+	DbSqliteBackupEveryFlag                       = "db-sqlite-backup-every"
+	DbSqliteBackupDirFlag                         = "db-sqlite-backup-dir"
+	DbSqliteBackupKeepFlag                        = "db-sqlite-backup-keep"
 	DbPostgresConnectionStringFlag                = "db-postgres-connection-string"
+	DbPostgresReplicaConnectionStringFlag         = "db-postgres-replica-connection-string"
 	WebTemplateBaseDirFlag                        = "web-template-base-dir"
 	WebAssetBaseDirFlag                           = "web-asset-base-dir"
 	InstanceFederationModeFlag                    = "instance-federation-mode"
 	InstanceFederationSpamFilterFlag              = "instance-federation-spam-filter"
+	InstanceFederationIntegrityProofsFlag         = "instance-federation-integrity-proofs"
+	InstanceFederationThreadMaxDepthFlag          = "instance-federation-thread-max-depth"
+	InstanceFederationThreadFetchBudgetFlag       = "instance-federation-thread-fetch-budget"
 	InstanceExposePeersFlag                       = "instance-expose-peers"
+	InstancePeersGranularityFlag                  = "instance-peers-granularity"
 	InstanceExposeBlocklistFlag                   = "instance-expose-blocklist"
 	InstanceExposeBlocklistWebFlag                = "instance-expose-blocklist-web"
 	InstanceExposeAllowlistFlag                   = "instance-expose-allowlist"
@@ -77,15 +85,37 @@
 	InstanceSubscriptionsProcessEveryFlag         = "instance-subscriptions-process-every"
 	InstanceStatsModeFlag                         = "instance-stats-mode"
 	InstanceAllowBackdatingStatusesFlag           = "instance-allow-backdating-statuses"
+	InstanceDirectoryURLFlag                      = "instance-directory-url"
+	InstanceDirectorySyncEveryFlag                = "instance-directory-sync-every"
+	InstanceDirectoryMaxAccountsFlag              = "instance-directory-max-accounts"
+	InstanceNodeInfoRefreshEveryFlag              = "instance-nodeinfo-refresh-every"
+	InstanceRemoteTimelinesEnabledFlag            = "instance-remote-timelines-enabled"
+	InstanceOutboxIncludeBoostsFlag               = "instance-outbox-include-boosts"
+	InstanceWebfingerAliasDomainsFlag             = "instance-webfinger-alias-domains"
+	InstanceExposeSitemapFlag                     = "instance-expose-sitemap"
+	InstanceSitemapRegenerateEveryFlag            = "instance-sitemap-regenerate-every"
 	AccountsRegistrationOpenFlag                  = "accounts-registration-open"
 	AccountsReasonRequiredFlag                    = "accounts-reason-required"
 	AccountsRegistrationDailyLimitFlag            = "accounts-registration-daily-limit"
 	AccountsRegistrationBacklogLimitFlag          = "accounts-registration-backlog-limit"
+	AccountsAllowUserInvitesFlag                  = "accounts-allow-user-invites"
+	AccountsCaptchaProviderFlag                   = "accounts-captcha-provider"
+	AccountsCaptchaSiteKeyFlag                    = "accounts-captcha-site-key"
+	AccountsCaptchaSecretKeyFlag                  = "accounts-captcha-secret-key"
 	AccountsAllowCustomCSSFlag                    = "accounts-allow-custom-css"
 	AccountsCustomCSSLengthFlag                   = "accounts-custom-css-length"
+	AccountsCustomCSSStrictFlag                   = "accounts-custom-css-strict"
+	AccountsCustomCSSAllowedPropertiesFlag        = "accounts-custom-css-allowed-properties"
 	AccountsMaxProfileFieldsFlag                  = "accounts-max-profile-fields"
+	AccountsMaxServiceAccountsFlag                = "accounts-max-service-accounts"
+	AccountsRateLimitStatusesFlag                 = "accounts-rate-limit-statuses"
+	AccountsRateLimitFollowsFlag                  = "accounts-rate-limit-follows"
+	AccountsRateLimitReportsFlag                  = "accounts-rate-limit-reports"
+	AccountsRateLimitModeratorMultiplierFlag      = "accounts-rate-limit-moderator-multiplier"
+	AccountsRateLimitAdminMultiplierFlag          = "accounts-rate-limit-admin-multiplier"
 	StorageBackendFlag                            = "storage-backend"
 	StorageLocalBasePathFlag                      = "storage-local-base-path"
+	StorageLocalChecksumsFlag                     = "storage-local-checksums"
 	StorageS3EndpointFlag                         = "storage-s3-endpoint"
 	StorageS3AccessKeyFlag                        = "storage-s3-access-key"
 	StorageS3SecretKeyFlag                        = "storage-s3-secret-key"
@@ -95,12 +125,19 @@
 	StorageS3RedirectURLFlag                      = "storage-s3-redirect-url"
 	StorageS3BucketLookupFlag                     = "storage-s3-bucket-lookup"
 	StorageS3KeyPrefixFlag                        = "storage-s3-key-prefix"
+	StorageWebDAVURLFlag                          = "storage-webdav-url"
+	StorageWebDAVUsernameFlag                     = "storage-webdav-username"
+	StorageWebDAVPasswordFlag                     = "storage-webdav-password"
 	StatusesMaxCharsFlag                          = "statuses-max-chars"
 	StatusesPollMaxOptionsFlag                    = "statuses-poll-max-options"
 	StatusesPollOptionMaxCharsFlag                = "statuses-poll-option-max-chars"
+	StatusesPollLocalVotersFlag                   = "statuses-poll-local-voters"
 	StatusesMediaMaxFilesFlag                     = "statuses-media-max-files"
+	StatusesTrashWindowFlag                       = "statuses-trash-window"
+	StatusesDetectLanguageFlag                    = "statuses-detect-language"
 	ScheduledStatusesMaxTotalFlag                 = "scheduled-statuses-max-total"
 	ScheduledStatusesMaxDailyFlag                 = "scheduled-statuses-max-daily"
+	NotificationsMaxAgeFlag                       = "notifications-max-age"
 	LetsEncryptEnabledFlag                        = "letsencrypt-enabled"
 	LetsEncryptPortFlag                           = "letsencrypt-port"
 	LetsEncryptCertDirFlag                        = "letsencrypt-cert-dir"
@@ -119,6 +156,7 @@
 	OIDCAdminGroupsFlag                           = "oidc-admin-groups"
 	TracingEnabledFlag                            = "tracing-enabled"
 	MetricsEnabledFlag                            = "metrics-enabled"
+	DebugEndpointsEnabledFlag                     = "debug-endpoints-enabled"
 	SMTPHostFlag                                  = "smtp-host"
 	SMTPPortFlag                                  = "smtp-port"
 	SMTPUsernameFlag                              = "smtp-username"
@@ -128,6 +166,18 @@
 	SyslogEnabledFlag                             = "syslog-enabled"
 	SyslogProtocolFlag                            = "syslog-protocol"
 	SyslogAddressFlag                             = "syslog-address"
+	ModerationHookStatusCreateURLFlag             = "moderation-hook-status-create-url"
+	ModerationHookFederationIngestURLFlag         = "moderation-hook-federation-ingest-url"
+	ModerationHookMediaStoreURLFlag               = "moderation-hook-media-store-url"
+	ModerationHookTimeoutFlag                     = "moderation-hook-timeout"
+	ModerationHookFailClosedFlag                  = "moderation-hook-fail-closed"
+	TranslationBackendFlag                        = "translation-backend"
+	TranslationURLFlag                            = "translation-url"
+	TranslationAPIKeyFlag                         = "translation-api-key"
+	TranslationRateLimitFlag                      = "translation-rate-limit"
+	ReadOnlyModeFlag                              = "read-only-mode"
+	ReadOnlyRetryAfterFlag                        = "read-only-retry-after"
+	ShutdownDrainTimeoutFlag                      = "shutdown-drain-timeout"
 	AdvancedCookiesSamesiteFlag                   = "advanced-cookies-samesite"
 	AdvancedSenderMultiplierFlag                  = "advanced-sender-multiplier"
 	AdvancedCSPExtraURIsFlag                      = "advanced-csp-extra-uris"
@@ -154,19 +204,29 @@
 	MediaCleanupEveryFlag                         = "media-cleanup-every"
 	MediaFfmpegPoolSizeFlag                       = "media-ffmpeg-pool-size"
 	MediaThumbMaxPixelsFlag                       = "media-thumb-max-pixels"
+	MediaThumbFormatFlag                          = "media-thumb-format"
+	MediaVideoSpriteFramesFlag                    = "media-video-sprite-frames"
 	CacheS3ObjectInfoFlag                         = "cache-s3-object-info"
 	CacheHomeTimelineTimeoutFlag                  = "cache-home-timeline-timeout"
+	CacheHomeTimelineMaxEntriesFlag               = "cache-home-timeline-max-entries"
 	CacheListTimelineTimeoutFlag                  = "cache-list-timeline-timeout"
 	CacheTagTimelineTimeoutFlag                   = "cache-tag-timeline-timeout"
+	CacheLanguageTimelineTimeoutFlag              = "cache-language-timeline-timeout"
+	CacheAntennaTimelineTimeoutFlag               = "cache-antenna-timeline-timeout"
+	CacheVisibilitySweepFrequencyFlag             = "cache-visibility-sweep-frequency"
 	CacheMemoryTargetFlag                         = "cache-memory-target"
 	CacheAccountMemRatioFlag                      = "cache-account-mem-ratio"
 	CacheAccountNoteMemRatioFlag                  = "cache-account-note-mem-ratio"
 	CacheAccountSettingsMemRatioFlag              = "cache-account-settings-mem-ratio"
 	CacheAccountStatsMemRatioFlag                 = "cache-account-stats-mem-ratio"
+	CacheAntennaMemRatioFlag                      = "cache-antenna-mem-ratio"
+	CacheAntennaIDsMemRatioFlag                   = "cache-antenna-ids-mem-ratio"
 	CacheApplicationMemRatioFlag                  = "cache-application-mem-ratio"
 	CacheBlockMemRatioFlag                        = "cache-block-mem-ratio"
 	CacheBlockIDsMemRatioFlag                     = "cache-block-ids-mem-ratio"
 	CacheBoostOfIDsMemRatioFlag                   = "cache-boost-of-ids-mem-ratio"
+	CacheBookmarkCollectionMemRatioFlag           = "cache-bookmark-collection-mem-ratio"
+	CacheBookmarkCollectionIDsMemRatioFlag        = "cache-bookmark-collection-ids-mem-ratio"
 	CacheClientMemRatioFlag                       = "cache-client-mem-ratio"
 	CacheConversationMemRatioFlag                 = "cache-conversation-mem-ratio"
 	CacheConversationLastStatusIDsMemRatioFlag    = "cache-conversation-last-status-ids-mem-ratio"
@@ -195,6 +255,7 @@
 	CacheMediaMemRatioFlag                        = "cache-media-mem-ratio"
 	CacheMentionMemRatioFlag                      = "cache-mention-mem-ratio"
 	CacheMoveMemRatioFlag                         = "cache-move-mem-ratio"
+	CacheMutedTagIDsMemRatioFlag                  = "cache-muted-tag-ids-mem-ratio"
 	CacheNotificationMemRatioFlag                 = "cache-notification-mem-ratio"
 	CachePollMemRatioFlag                         = "cache-poll-mem-ratio"
 	CachePollVoteMemRatioFlag                     = "cache-poll-vote-mem-ratio"
@@ -208,6 +269,8 @@
 	CacheStatusEditMemRatioFlag                   = "cache-status-edit-mem-ratio"
 	CacheStatusFaveMemRatioFlag                   = "cache-status-fave-mem-ratio"
 	CacheStatusFaveIDsMemRatioFlag                = "cache-status-fave-ids-mem-ratio"
+	CacheStatusReactionMemRatioFlag               = "cache-status-reaction-mem-ratio"
+	CacheStatusReactionIDsMemRatioFlag            = "cache-status-reaction-ids-mem-ratio"
 	CacheTagMemRatioFlag                          = "cache-tag-mem-ratio"
 	CacheThreadMuteMemRatioFlag                   = "cache-thread-mute-mem-ratio"
 	CacheTokenMemRatioFlag                        = "cache-token-mem-ratio"
@@ -228,6 +291,19 @@
 	AdminMediaPruneDryRunFlag                     = "dry-run"
 	AdminMediaListLocalOnlyFlag                   = "local-only"
 	AdminMediaListRemoteOnlyFlag                  = "remote-only"
+	AdminAPIURLFlag                               = "api-url"
+	AdminAPITokenFlag                             = "api-token"
+	AdminAPITokenFileFlag                         = "api-token-file"
+	AdminReasonFlag                               = "reason"
+	AdminDomainFlag                               = "domain"
+	AdminMediaPolicyFlag                          = "media-policy"
+	AdminFollowsPolicyFlag                        = "follows-policy"
+	AdminStatusesPolicyFlag                       = "statuses-policy"
+	AdminAccountsPolicyFlag                       = "accounts-policy"
+	AdminContentWarningFlag                       = "content-warning"
+	AdminPublicCommentFlag                        = "public-comment"
+	AdminReportsResolvedFlag                      = "resolved"
+	AdminEmojiCategoryFlag                        = "category"
 	TestrigSkipDBSetupFlag                        = "skip-db-setup"
 	TestrigSkipDBTeardownFlag                     = "skip-db-teardown"
 )
@@ -262,12 +338,20 @@ func (cfg *Configuration) RegisterFlags(flags *pflag.FlagSet) {
 	flags.String("db-sqlite-synchronous", cfg.DbSqliteSynchronous, "Sqlite only: see https://www.sqlite.org/pragma.html#pragma_synchronous")
 	flags.String("db-sqlite-cache-size", cfg.DbSqliteCacheSize.String(), "Sqlite only: see https://www.sqlite.org/pragma.html#pragma_cache_size")
 	flags.Duration("db-sqlite-busy-timeout", cfg.DbSqliteBusyTimeout, "Sqlite only: see https://www.sqlite.org/pragma.html#pragma_busy_timeout")
+	flags.Duration("db-sqlite-backup-every", cfg.DbSqliteBackupEvery, "Sqlite only: if set, take a backup snapshot of the database to db-sqlite-backup-dir this often. 0 disables scheduled backups.")
+	flags.String("db-sqlite-backup-dir", cfg.DbSqliteBackupDir, "Sqlite only: directory in which to store scheduled backup snapshots of the database.")
+	flags.Int("db-sqlite-backup-keep", cfg.DbSqliteBackupKeep, "Sqlite only: number of scheduled backup snapshots to keep in db-sqlite-backup-dir. Older snapshots are removed. 0 keeps all snapshots.")
 	flags.String("db-postgres-connection-string", cfg.DbPostgresConnectionString, "Full Database URL for connection to postgres")
+	flags.String("db-postgres-replica-connection-string", cfg.DbPostgresReplicaConnectionString, "Postgres only: optional connection string for a read-only replica, used for heavy read queries (timelines, search, RSS).")
 	flags.String("web-template-base-dir", cfg.WebTemplateBaseDir, "Basedir for html templating files for rendering pages and composing emails.")
 	flags.String("web-asset-base-dir", cfg.WebAssetBaseDir, "Directory to serve static assets from, accessible at example.org/assets/")
 	flags.String("instance-federation-mode", cfg.InstanceFederationMode, "Set instance federation mode.")
 	flags.Bool("instance-federation-spam-filter", cfg.InstanceFederationSpamFilter, "Enable basic spam filter heuristics for messages coming from other instances, and drop messages identified as spam")
+	flags.Bool("instance-federation-integrity-proofs", cfg.InstanceFederationIntegrityProofs, "Sign outgoing activities with Ed25519 object integrity proofs (FEP-8b32), and accept unsigned incoming activities that carry a valid proof.")
+	flags.Int("instance-federation-thread-max-depth", cfg.InstanceFederationThreadMaxDepth, "Maximum number of replies to follow up (ancestors) or down (descendants) a thread when dereferencing remote threads.")
+	flags.Int("instance-federation-thread-fetch-budget", cfg.InstanceFederationThreadFetchBudget, "Maximum number of remote fetches to make when dereferencing a single remote thread.")
 	flags.Bool("instance-expose-peers", cfg.InstanceExposePeers, "Allow unauthenticated users to query /api/v1/instance/peers?filter=open")
+	flags.String("instance-peers-granularity", cfg.InstancePeersGranularity, "Granularity of known peers exposed via /api/v1/instance/peers?filter=open: one of 'open' (domains only), 'metadata' (domains plus software name and version), or 'disabled' (not exposed at all).")
 	flags.Bool("instance-expose-blocklist", cfg.InstanceExposeBlocklist, "Expose list of blocked domains via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=blocked and /api/v1/instance/domain_blocks")
 	flags.Bool("instance-expose-blocklist-web", cfg.InstanceExposeBlocklistWeb, "Expose list of explicitly blocked domains as webpage on /about/domain_blocks")
 	flags.Bool("instance-expose-allowlist", cfg.InstanceExposeAllowlist, "Expose list of allowed domains via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=allowed and /api/v1/instance/domain_allows")
@@ -281,15 +365,37 @@ func (cfg *Configuration) RegisterFlags(flags *pflag.FlagSet) {
 	flags.Duration("instance-subscriptions-process-every", cfg.InstanceSubscriptionsProcessEvery, "Period to elapse between instance subscriptions processing jobs, starting from instance-subscriptions-process-from.")
 	flags.String("instance-stats-mode", cfg.InstanceStatsMode, "Allows you to customize the way stats are served to crawlers: one of '', 'serve', 'zero', 'baffle'. Home page stats remain unchanged.")
 	flags.Bool("instance-allow-backdating-statuses", cfg.InstanceAllowBackdatingStatuses, "Allow local accounts to backdate statuses using the scheduled_at param to /api/v1/statuses")
+	flags.String("instance-directory-url", cfg.InstanceDirectoryURL, "URL of a shared account directory service to publish opted-in local accounts to, and to fetch suggested accounts from. Leave empty to disable.")
+	flags.Duration("instance-directory-sync-every", cfg.InstanceDirectorySyncEvery, "Period to elapse between syncs with the instance directory service.")
+	flags.Int("instance-directory-max-accounts", cfg.InstanceDirectoryMaxAccounts, "Maximum number of accounts to fetch from the instance directory service for suggestions.")
+	flags.Duration("instance-nodeinfo-refresh-every", cfg.InstanceNodeInfoRefreshEvery, "Period to elapse between refreshes of the software name and version of known instances via nodeinfo. Set to 0 to disable.")
+	flags.Bool("instance-remote-timelines-enabled", cfg.InstanceRemoteTimelinesEnabled, "Allow local users to browse the public local timelines of other instances via /api/v1/timelines/remote.")
+	flags.Bool("instance-outbox-include-boosts", cfg.InstanceOutboxIncludeBoosts, "Include public boosts (as Announce activities) in the ActivityPub outboxes of local accounts, alongside their public posts.")
+	flags.StringSlice("instance-webfinger-alias-domains", cfg.InstanceWebfingerAliasDomains, "Additional domains (eg., short vanity domains) for which this instance answers webfinger requests, so that @user@alias.domain resolves to the local account user, or to the account given in a webfinger alias mapping.")
+	flags.Bool("instance-expose-sitemap", cfg.InstanceExposeSitemap, "Serve a sitemap of discoverable and indexable local profiles and their recent public posts at /sitemap.xml, for search engines.")
+	flags.Duration("instance-sitemap-regenerate-every", cfg.InstanceSitemapRegenerateEvery, "Period to elapse between regenerations of the sitemap served at /sitemap.xml.")
 	flags.Bool("accounts-registration-open", cfg.AccountsRegistrationOpen, "Allow anyone to submit an account signup request. If false, server will be invite-only.")
 	flags.Bool("accounts-reason-required", cfg.AccountsReasonRequired, "Do new account signups require a reason to be submitted on registration?")
 	flags.Int("accounts-registration-daily-limit", cfg.AccountsRegistrationDailyLimit, "Limit amount of approved account sign-ups allowed per 24hrs before registration is closed. 0 or less = no limit.")
 	flags.Int("accounts-registration-backlog-limit", cfg.AccountsRegistrationBacklogLimit, "Limit how big the 'accounts pending approval' queue can grow before registration is closed. 0 or less = no limit.")
+	flags.Bool("accounts-allow-user-invites", cfg.AccountsAllowUserInvites, "Allow non-staff users to create invite links that can be used to sign up while registration is closed. Admins and moderators can always create invites.")
+	flags.String("accounts-captcha-provider", cfg.AccountsCaptchaProvider, "Captcha provider used to protect the sign-up form. Options: [hcaptcha, turnstile]. Leave empty to disable captcha.")
+	flags.String("accounts-captcha-site-key", cfg.AccountsCaptchaSiteKey, "Public site key for the configured captcha provider.")
+	flags.String("accounts-captcha-secret-key", cfg.AccountsCaptchaSecretKey, "Secret key for the configured captcha provider, used for server-side verification of captcha responses.")
 	flags.Bool("accounts-allow-custom-css", cfg.AccountsAllowCustomCSS, "Allow accounts to enable custom CSS for their profile pages and statuses.")
 	flags.Int("accounts-custom-css-length", cfg.AccountsCustomCSSLength, "Maximum permitted length (characters) of custom CSS for accounts.")
+	flags.Bool("accounts-custom-css-strict", cfg.AccountsCustomCSSStrict, "Sanitize account custom CSS strictly: only allow properties from accounts-custom-css-allowed-properties, and drop all url() references.")
+	flags.StringSlice("accounts-custom-css-allowed-properties", cfg.AccountsCustomCSSAllowedProperties, "CSS properties allowed in account custom CSS when accounts-custom-css-strict is enabled. Custom properties (--*) are always allowed.")
 	flags.Int("accounts-max-profile-fields", cfg.AccountsMaxProfileFields, "Maximum number of profile fields allowed for each account.")
+	flags.Int("accounts-max-service-accounts", cfg.AccountsMaxServiceAccounts, "Maximum number of service (bot) accounts that each user may create and manage. 0 or less = users cannot create service accounts.")
+	flags.Int("accounts-rate-limit-statuses", cfg.AccountsRateLimitStatuses, "Maximum number of statuses a user may post per hour. 0 or less = no limit.")
+	flags.Int("accounts-rate-limit-follows", cfg.AccountsRateLimitFollows, "Maximum number of follow requests a user may send per hour. 0 or less = no limit.")
+	flags.Int("accounts-rate-limit-reports", cfg.AccountsRateLimitReports, "Maximum number of reports a user may create per hour. 0 or less = no limit.")
+	flags.Int("accounts-rate-limit-moderator-multiplier", cfg.AccountsRateLimitModeratorMultiplier, "Multiplier applied to per-account rate limits for moderators. 0 or less = moderators are exempt.")
+	flags.Int("accounts-rate-limit-admin-multiplier", cfg.AccountsRateLimitAdminMultiplier, "Multiplier applied to per-account rate limits for admins. 0 or less = admins are exempt.")
 	flags.String("storage-backend", cfg.StorageBackend, "Storage backend to use for media attachments")
 	flags.String("storage-local-base-path", cfg.StorageLocalBasePath, "Full path to an already-created directory where gts should store/retrieve media files. Subfolders will be created within this dir.")
+	flags.Bool("storage-local-checksums", cfg.StorageLocalChecksums, "Calculate SHA-256 checksums of media files as they are written to local storage, allowing the media cleaner to detect corrupted or truncated files.")
 	flags.String("storage-s3-endpoint", cfg.StorageS3Endpoint, "S3 Endpoint URL (e.g 'minio.example.org:9000')")
 	flags.String("storage-s3-access-key", cfg.StorageS3AccessKey, "S3 Access Key")
 	flags.String("storage-s3-secret-key", cfg.StorageS3SecretKey, "S3 Secret Key")
@@ -299,12 +405,19 @@ func (cfg *Configuration) RegisterFlags(flags *pflag.FlagSet) {
 	flags.String("storage-s3-redirect-url", cfg.StorageS3RedirectURL, "Custom URL to use for redirecting S3 media links. If set, this will be used instead of the S3 bucket URL.")
 	flags.String("storage-s3-bucket-lookup", cfg.StorageS3BucketLookup, "S3 bucket lookup type to use. Can be 'auto', 'dns' or 'path'. Defaults to 'auto'.")
 	flags.String("storage-s3-key-prefix", cfg.StorageS3KeyPrefix, "Prefix to use for S3 keys. This is useful for separating multiple instances sharing the same S3 bucket.")
+	flags.String("storage-webdav-url", cfg.StorageWebDAVURL, "URL of the WebDAV collection to store media in (e.g 'https://dav.example.org/gotosocial/')")
+	flags.String("storage-webdav-username", cfg.StorageWebDAVUsername, "Username for WebDAV basic authentication")
+	flags.String("storage-webdav-password", cfg.StorageWebDAVPassword, "Password for WebDAV basic authentication")
 	flags.Int("statuses-max-chars", cfg.StatusesMaxChars, "Max permitted characters for posted statuses, including content warning")
 	flags.Int("statuses-poll-max-options", cfg.StatusesPollMaxOptions, "Max amount of options permitted on a poll")
 	flags.Int("statuses-poll-option-max-chars", cfg.StatusesPollOptionMaxChars, "Max amount of characters for a poll option")
+	flags.Bool("statuses-poll-local-voters", cfg.StatusesPollLocalVoters, "Allow poll authors to see which local accounts voted for each option of their polls")
 	flags.Int("statuses-media-max-files", cfg.StatusesMediaMaxFiles, "Maximum number of media files/attachments per status")
+	flags.Duration("statuses-trash-window", cfg.StatusesTrashWindow, "Duration for which deleted statuses are kept in the trash, restorable by their author, before being permanently deleted. 0 disables the trash, deleting statuses immediately.")
+	flags.Bool("statuses-detect-language", cfg.StatusesDetectLanguage, "Detect the language of new statuses that don't have one set by the client, instead of falling back to the account's default post language.")
 	flags.Int("scheduled-statuses-max-total", cfg.ScheduledStatusesMaxTotal, "Maximum number of scheduled statuses per user")
 	flags.Int("scheduled-statuses-max-daily", cfg.ScheduledStatusesMaxDaily, "Maximum number of scheduled statuses per user for a single day")
+	flags.Duration("notifications-max-age", cfg.NotificationsMaxAge, "Age after which notifications are permanently deleted from the database. 0 keeps notifications forever.")
 	flags.Bool("letsencrypt-enabled", cfg.LetsEncryptEnabled, "Enable letsencrypt TLS certs for this server. If set to true, then cert dir also needs to be set (or take the default).")
 	flags.Int("letsencrypt-port", cfg.LetsEncryptPort, "Port to listen on for letsencrypt certificate challenges. Must not be the same as the GtS webserver/API port.")
 	flags.String("letsencrypt-cert-dir", cfg.LetsEncryptCertDir, "Directory to store acquired letsencrypt certificates.")
@@ -323,6 +436,7 @@ func (cfg *Configuration) RegisterFlags(flags *pflag.FlagSet) {
 	flags.StringSlice("oidc-admin-groups", cfg.OIDCAdminGroups, "Membership of one of the listed groups makes someone a GtS admin")
 	flags.Bool("tracing-enabled", cfg.TracingEnabled, "Enable OTLP Tracing")
 	flags.Bool("metrics-enabled", cfg.MetricsEnabled, "Enable OpenTelemetry based metrics support.")
+	flags.Bool("debug-endpoints-enabled", cfg.DebugEndpointsEnabled, "Enable admin-authenticated debug endpoints (/debug/pprof and /api/v1/admin/debug/runtime) for diagnosing performance and memory issues.")
 	flags.String("smtp-host", cfg.SMTPHost, "Host of the smtp server. Eg., 'smtp.eu.mailgun.org'")
 	flags.Int("smtp-port", cfg.SMTPPort, "Port of the smtp server. Eg., 587")
 	flags.String("smtp-username", cfg.SMTPUsername, "Username to authenticate with the smtp server as. Eg., 'postmaster@mail.example.org'")
@@ -332,6 +446,18 @@ func (cfg *Configuration) RegisterFlags(flags *pflag.FlagSet) {
 	flags.Bool("syslog-enabled", cfg.SyslogEnabled, "Enable the syslog logging hook. Logs will be mirrored to the configured destination.")
 	flags.String("syslog-protocol", cfg.SyslogProtocol, "Protocol to use when directing logs to syslog. Leave empty to connect to local syslog.")
 	flags.String("syslog-address", cfg.SyslogAddress, "Address:port to send syslog logs to. Leave empty to connect to local syslog.")
+	flags.String("moderation-hook-status-create-url", cfg.ModerationHookStatusCreateURL, "URL of an external moderation service to call before creating or editing statuses from local accounts. Leave empty to disable.")
+	flags.String("moderation-hook-federation-ingest-url", cfg.ModerationHookFederationIngestURL, "URL of an external moderation service to call before handling activities received from remote instances. Leave empty to disable.")
+	flags.String("moderation-hook-media-store-url", cfg.ModerationHookMediaStoreURL, "URL of an external moderation service to call before storing processed media. Leave empty to disable.")
+	flags.Duration("moderation-hook-timeout", cfg.ModerationHookTimeout, "Timeout for each call to an external moderation service.")
+	flags.Bool("moderation-hook-fail-closed", cfg.ModerationHookFailClosed, "If true, reject content when an external moderation service cannot be reached or returns an error. If false, such content is allowed through.")
+	flags.String("translation-backend", cfg.TranslationBackend, "Translation service backend used to translate statuses. Options: [libretranslate, deepl]. Leave empty to disable translation.")
+	flags.String("translation-url", cfg.TranslationURL, "Base URL of the translation service API. Required for libretranslate. For deepl, defaults to https://api.deepl.com if empty.")
+	flags.String("translation-api-key", cfg.TranslationAPIKey, "API key used to authenticate with the translation service, if required.")
+	flags.Int("translation-rate-limit", cfg.TranslationRateLimit, "Maximum number of translation requests this instance will make to the translation service per minute. 0 means no limit.")
+	flags.Bool("read-only-mode", cfg.ReadOnlyMode, "Start in read-only mode, in which write requests and incoming federation are rejected with code 503 and background workers are paused, to allow for safe database maintenance. Admins can also toggle this at runtime.")
+	flags.Duration("read-only-retry-after", cfg.ReadOnlyRetryAfter, "Retry-After duration to send with responses to requests rejected in read-only mode.")
+	flags.Duration("shutdown-drain-timeout", cfg.ShutdownDrainTimeout, "Maximum duration to wait on shutdown, after incoming HTTP requests have stopped, for queued worker tasks to be processed. Any remaining tasks are persisted to the database where possible, to be processed on next startup. 0 disables draining.")
 	flags.String("advanced-cookies-samesite", cfg.Advanced.CookiesSamesite, "'strict' or 'lax', see https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Set-Cookie/SameSite")
 	flags.Int("advanced-sender-multiplier", cfg.Advanced.SenderMultiplier, "Multiplier to use per cpu for batching outgoing fedi messages. 0 or less turns batching off (not recommended).")
 	flags.StringSlice("advanced-csp-extra-uris", cfg.Advanced.CSPExtraURIs, "Additional URIs to allow when building content-security-policy for media + images.")
@@ -358,19 +484,29 @@ func (cfg *Configuration) RegisterFlags(flags *pflag.FlagSet) {
 	flags.Duration("media-cleanup-every", cfg.Media.CleanupEvery, "Period to elapse between cleanups, starting from media-cleanup-at.")
 	flags.Int("media-ffmpeg-pool-size", cfg.Media.FfmpegPoolSize, "Number of instances of the embedded ffmpeg WASM binary to add to the media processing pool. 0 or less uses GOMAXPROCS.")
 	flags.Int("media-thumb-max-pixels", cfg.Media.ThumbMaxPixels, "Max size in pixels of any one dimension of a thumbnail (as input media ratio is preserved).")
+	flags.String("media-thumb-format", cfg.Media.ThumbFormat, "Encoding format to use for generated thumbnails: jpeg (falling back to webp where necessary), or webp.")
+	flags.Int("media-video-sprite-frames", cfg.Media.VideoSpriteFrames, "Number of frames to include in scrubbing preview sprite sheets generated for video attachments. 0 disables sprite sheet generation.")
 	flags.Int("cache-s3-object-info", cfg.Cache.S3ObjectInfo, "Enables caching of S3 object information in the storage driver to reduce S3 calls, value is cache capacity.")
 	flags.Duration("cache-home-timeline-timeout", cfg.Cache.HomeTimelineTimeout, "Duration before any one home timeline cache is unloaded from memory. Values <= 0 disable unloading.")
+	flags.Int("cache-home-timeline-max-entries", cfg.Cache.HomeTimelineMaxEntries, "Maximum number of entries kept in any one home timeline cache. Timelines are periodically trimmed back to below this size.")
 	flags.Duration("cache-list-timeline-timeout", cfg.Cache.ListTimelineTimeout, "Duration before any one list timeline cache is unloaded from memory. Values <= 0 disable unloading.")
 	flags.Duration("cache-tag-timeline-timeout", cfg.Cache.TagTimelineTimeout, "Duration before any one tag timeline cache is unloaded from memory. Values <= 0 disable unloading.")
+	flags.Duration("cache-language-timeline-timeout", cfg.Cache.LanguageTimelineTimeout, "Duration before any one language-sharded public or local timeline cache is unloaded from memory. Values <= 0 disable unloading.")
+	flags.Duration("cache-antenna-timeline-timeout", cfg.Cache.AntennaTimelineTimeout, "Duration before any one antenna timeline cache is unloaded from memory. Values <= 0 disable unloading.")
+	flags.Duration("cache-visibility-sweep-frequency", cfg.Cache.VisibilitySweepFrequency, "Period to elapse between full sweeps of the visibility cache, catching any stale results missed by targeted invalidation. Values <= 0 disable sweeping.")
 	flags.String("cache-memory-target", cfg.Cache.MemoryTarget.String(), "")
 	flags.Float64("cache-account-mem-ratio", cfg.Cache.AccountMemRatio, "")
 	flags.Float64("cache-account-note-mem-ratio", cfg.Cache.AccountNoteMemRatio, "")
 	flags.Float64("cache-account-settings-mem-ratio", cfg.Cache.AccountSettingsMemRatio, "")
 	flags.Float64("cache-account-stats-mem-ratio", cfg.Cache.AccountStatsMemRatio, "")
+	flags.Float64("cache-antenna-mem-ratio", cfg.Cache.AntennaMemRatio, "")
+	flags.Float64("cache-antenna-ids-mem-ratio", cfg.Cache.AntennaIDsMemRatio, "")
 	flags.Float64("cache-application-mem-ratio", cfg.Cache.ApplicationMemRatio, "")
 	flags.Float64("cache-block-mem-ratio", cfg.Cache.BlockMemRatio, "")
 	flags.Float64("cache-block-ids-mem-ratio", cfg.Cache.BlockIDsMemRatio, "")
 	flags.Float64("cache-boost-of-ids-mem-ratio", cfg.Cache.BoostOfIDsMemRatio, "")
+	flags.Float64("cache-bookmark-collection-mem-ratio", cfg.Cache.BookmarkCollectionMemRatio, "")
+	flags.Float64("cache-bookmark-collection-ids-mem-ratio", cfg.Cache.BookmarkCollectionIDsMemRatio, "")
 	flags.Float64("cache-client-mem-ratio", cfg.Cache.ClientMemRatio, "")
 	flags.Float64("cache-conversation-mem-ratio", cfg.Cache.ConversationMemRatio, "")
 	flags.Float64("cache-conversation-last-status-ids-mem-ratio", cfg.Cache.ConversationLastStatusIDsMemRatio, "")
@@ -399,6 +535,7 @@ func (cfg *Configuration) RegisterFlags(flags *pflag.FlagSet) {
 	flags.Float64("cache-media-mem-ratio", cfg.Cache.MediaMemRatio, "")
 	flags.Float64("cache-mention-mem-ratio", cfg.Cache.MentionMemRatio, "")
 	flags.Float64("cache-move-mem-ratio", cfg.Cache.MoveMemRatio, "")
+	flags.Float64("cache-muted-tag-ids-mem-ratio", cfg.Cache.MutedTagIDsMemRatio, "")
 	flags.Float64("cache-notification-mem-ratio", cfg.Cache.NotificationMemRatio, "")
 	flags.Float64("cache-poll-mem-ratio", cfg.Cache.PollMemRatio, "")
 	flags.Float64("cache-poll-vote-mem-ratio", cfg.Cache.PollVoteMemRatio, "")
@@ -412,6 +549,8 @@ func (cfg *Configuration) RegisterFlags(flags *pflag.FlagSet) {
 	flags.Float64("cache-status-edit-mem-ratio", cfg.Cache.StatusEditMemRatio, "")
 	flags.Float64("cache-status-fave-mem-ratio", cfg.Cache.StatusFaveMemRatio, "")
 	flags.Float64("cache-status-fave-ids-mem-ratio", cfg.Cache.StatusFaveIDsMemRatio, "")
+	flags.Float64("cache-status-reaction-mem-ratio", cfg.Cache.StatusReactionMemRatio, "")
+	flags.Float64("cache-status-reaction-ids-mem-ratio", cfg.Cache.StatusReactionIDsMemRatio, "")
 	flags.Float64("cache-tag-mem-ratio", cfg.Cache.TagMemRatio, "")
 	flags.Float64("cache-thread-mute-mem-ratio", cfg.Cache.ThreadMuteMemRatio, "")
 	flags.Float64("cache-token-mem-ratio", cfg.Cache.TokenMemRatio, "")
@@ -428,7 +567,7 @@ func (cfg *Configuration) RegisterFlags(flags *pflag.FlagSet) {
 }
 
 func (cfg *Configuration) MarshalMap() map[string]any {
-	cfgmap := make(map[string]any, 201)
+	cfgmap := make(map[string]any, 277)
 	cfgmap["log-level"] = cfg.LogLevel
 	cfgmap["log-format"] = cfg.LogFormat
 	cfgmap["log-timestamp-format"] = cfg.LogTimestampFormat
@@ -458,12 +597,20 @@ func (cfg *Configuration) MarshalMap() map[string]any {
 	cfgmap["db-sqlite-synchronous"] = cfg.DbSqliteSynchronous
 	cfgmap["db-sqlite-cache-size"] = cfg.DbSqliteCacheSize.String()
 	cfgmap["db-sqlite-busy-timeout"] = cfg.DbSqliteBusyTimeout
+	cfgmap["db-sqlite-backup-every"] = cfg.DbSqliteBackupEvery
+	cfgmap["db-sqlite-backup-dir"] = cfg.DbSqliteBackupDir
+	cfgmap["db-sqlite-backup-keep"] = cfg.DbSqliteBackupKeep
 	cfgmap["db-postgres-connection-string"] = cfg.DbPostgresConnectionString
+	cfgmap["db-postgres-replica-connection-string"] = cfg.DbPostgresReplicaConnectionString
 	cfgmap["web-template-base-dir"] = cfg.WebTemplateBaseDir
 	cfgmap["web-asset-base-dir"] = cfg.WebAssetBaseDir
 	cfgmap["instance-federation-mode"] = cfg.InstanceFederationMode
 	cfgmap["instance-federation-spam-filter"] = cfg.InstanceFederationSpamFilter
+	cfgmap["instance-federation-integrity-proofs"] = cfg.InstanceFederationIntegrityProofs
+	cfgmap["instance-federation-thread-max-depth"] = cfg.InstanceFederationThreadMaxDepth
+	cfgmap["instance-federation-thread-fetch-budget"] = cfg.InstanceFederationThreadFetchBudget
 	cfgmap["instance-expose-peers"] = cfg.InstanceExposePeers
+	cfgmap["instance-peers-granularity"] = cfg.InstancePeersGranularity
 	cfgmap["instance-expose-blocklist"] = cfg.InstanceExposeBlocklist
 	cfgmap["instance-expose-blocklist-web"] = cfg.InstanceExposeBlocklistWeb
 	cfgmap["instance-expose-allowlist"] = cfg.InstanceExposeAllowlist
@@ -477,15 +624,37 @@ func (cfg *Configuration) MarshalMap() map[string]any {
 	cfgmap["instance-subscriptions-process-every"] = cfg.InstanceSubscriptionsProcessEvery
 	cfgmap["instance-stats-mode"] = cfg.InstanceStatsMode
 	cfgmap["instance-allow-backdating-statuses"] = cfg.InstanceAllowBackdatingStatuses
+	cfgmap["instance-directory-url"] = cfg.InstanceDirectoryURL
+	cfgmap["instance-directory-sync-every"] = cfg.InstanceDirectorySyncEvery
+	cfgmap["instance-directory-max-accounts"] = cfg.InstanceDirectoryMaxAccounts
+	cfgmap["instance-nodeinfo-refresh-every"] = cfg.InstanceNodeInfoRefreshEvery
+	cfgmap["instance-remote-timelines-enabled"] = cfg.InstanceRemoteTimelinesEnabled
+	cfgmap["instance-outbox-include-boosts"] = cfg.InstanceOutboxIncludeBoosts
+	cfgmap["instance-webfinger-alias-domains"] = cfg.InstanceWebfingerAliasDomains
+	cfgmap["instance-expose-sitemap"] = cfg.InstanceExposeSitemap
+	cfgmap["instance-sitemap-regenerate-every"] = cfg.InstanceSitemapRegenerateEvery
 	cfgmap["accounts-registration-open"] = cfg.AccountsRegistrationOpen
 	cfgmap["accounts-reason-required"] = cfg.AccountsReasonRequired
 	cfgmap["accounts-registration-daily-limit"] = cfg.AccountsRegistrationDailyLimit
 	cfgmap["accounts-registration-backlog-limit"] = cfg.AccountsRegistrationBacklogLimit
+	cfgmap["accounts-allow-user-invites"] = cfg.AccountsAllowUserInvites
+	cfgmap["accounts-captcha-provider"] = cfg.AccountsCaptchaProvider
+	cfgmap["accounts-captcha-site-key"] = cfg.AccountsCaptchaSiteKey
+	cfgmap["accounts-captcha-secret-key"] = cfg.AccountsCaptchaSecretKey
 	cfgmap["accounts-allow-custom-css"] = cfg.AccountsAllowCustomCSS
 	cfgmap["accounts-custom-css-length"] = cfg.AccountsCustomCSSLength
+	cfgmap["accounts-custom-css-strict"] = cfg.AccountsCustomCSSStrict
+	cfgmap["accounts-custom-css-allowed-properties"] = cfg.AccountsCustomCSSAllowedProperties
 	cfgmap["accounts-max-profile-fields"] = cfg.AccountsMaxProfileFields
+	cfgmap["accounts-max-service-accounts"] = cfg.AccountsMaxServiceAccounts
+	cfgmap["accounts-rate-limit-statuses"] = cfg.AccountsRateLimitStatuses
+	cfgmap["accounts-rate-limit-follows"] = cfg.AccountsRateLimitFollows
+	cfgmap["accounts-rate-limit-reports"] = cfg.AccountsRateLimitReports
+	cfgmap["accounts-rate-limit-moderator-multiplier"] = cfg.AccountsRateLimitModeratorMultiplier
+	cfgmap["accounts-rate-limit-admin-multiplier"] = cfg.AccountsRateLimitAdminMultiplier
 	cfgmap["storage-backend"] = cfg.StorageBackend
 	cfgmap["storage-local-base-path"] = cfg.StorageLocalBasePath
+	cfgmap["storage-local-checksums"] = cfg.StorageLocalChecksums
 	cfgmap["storage-s3-endpoint"] = cfg.StorageS3Endpoint
 	cfgmap["storage-s3-access-key"] = cfg.StorageS3AccessKey
 	cfgmap["storage-s3-secret-key"] = cfg.StorageS3SecretKey
@@ -495,12 +664,19 @@ func (cfg *Configuration) MarshalMap() map[string]any {
 	cfgmap["storage-s3-redirect-url"] = cfg.StorageS3RedirectURL
 	cfgmap["storage-s3-bucket-lookup"] = cfg.StorageS3BucketLookup
 	cfgmap["storage-s3-key-prefix"] = cfg.StorageS3KeyPrefix
+	cfgmap["storage-webdav-url"] = cfg.StorageWebDAVURL
+	cfgmap["storage-webdav-username"] = cfg.StorageWebDAVUsername
+	cfgmap["storage-webdav-password"] = cfg.StorageWebDAVPassword
 	cfgmap["statuses-max-chars"] = cfg.StatusesMaxChars
 	cfgmap["statuses-poll-max-options"] = cfg.StatusesPollMaxOptions
 	cfgmap["statuses-poll-option-max-chars"] = cfg.StatusesPollOptionMaxChars
+	cfgmap["statuses-poll-local-voters"] = cfg.StatusesPollLocalVoters
 	cfgmap["statuses-media-max-files"] = cfg.StatusesMediaMaxFiles
+	cfgmap["statuses-trash-window"] = cfg.StatusesTrashWindow
+	cfgmap["statuses-detect-language"] = cfg.StatusesDetectLanguage
 	cfgmap["scheduled-statuses-max-total"] = cfg.ScheduledStatusesMaxTotal
 	cfgmap["scheduled-statuses-max-daily"] = cfg.ScheduledStatusesMaxDaily
+	cfgmap["notifications-max-age"] = cfg.NotificationsMaxAge
 	cfgmap["letsencrypt-enabled"] = cfg.LetsEncryptEnabled
 	cfgmap["letsencrypt-port"] = cfg.LetsEncryptPort
 	cfgmap["letsencrypt-cert-dir"] = cfg.LetsEncryptCertDir
@@ -519,6 +695,7 @@ func (cfg *Configuration) MarshalMap() map[string]any {
 	cfgmap["oidc-admin-groups"] = cfg.OIDCAdminGroups
 	cfgmap["tracing-enabled"] = cfg.TracingEnabled
 	cfgmap["metrics-enabled"] = cfg.MetricsEnabled
+	cfgmap["debug-endpoints-enabled"] = cfg.DebugEndpointsEnabled
 	cfgmap["smtp-host"] = cfg.SMTPHost
 	cfgmap["smtp-port"] = cfg.SMTPPort
 	cfgmap["smtp-username"] = cfg.SMTPUsername
@@ -528,6 +705,18 @@ func (cfg *Configuration) MarshalMap() map[string]any {
 	cfgmap["syslog-enabled"] = cfg.SyslogEnabled
 	cfgmap["syslog-protocol"] = cfg.SyslogProtocol
 	cfgmap["syslog-address"] = cfg.SyslogAddress
+	cfgmap["moderation-hook-status-create-url"] = cfg.ModerationHookStatusCreateURL
+	cfgmap["moderation-hook-federation-ingest-url"] = cfg.ModerationHookFederationIngestURL
+	cfgmap["moderation-hook-media-store-url"] = cfg.ModerationHookMediaStoreURL
+	cfgmap["moderation-hook-timeout"] = cfg.ModerationHookTimeout
+	cfgmap["moderation-hook-fail-closed"] = cfg.ModerationHookFailClosed
+	cfgmap["translation-backend"] = cfg.TranslationBackend
+	cfgmap["translation-url"] = cfg.TranslationURL
+	cfgmap["translation-api-key"] = cfg.TranslationAPIKey
+	cfgmap["translation-rate-limit"] = cfg.TranslationRateLimit
+	cfgmap["read-only-mode"] = cfg.ReadOnlyMode
+	cfgmap["read-only-retry-after"] = cfg.ReadOnlyRetryAfter
+	cfgmap["shutdown-drain-timeout"] = cfg.ShutdownDrainTimeout
 	cfgmap["advanced-cookies-samesite"] = cfg.Advanced.CookiesSamesite
 	cfgmap["advanced-sender-multiplier"] = cfg.Advanced.SenderMultiplier
 	cfgmap["advanced-csp-extra-uris"] = cfg.Advanced.CSPExtraURIs
@@ -554,19 +743,29 @@ func (cfg *Configuration) MarshalMap() map[string]any {
 	cfgmap["media-cleanup-every"] = cfg.Media.CleanupEvery
 	cfgmap["media-ffmpeg-pool-size"] = cfg.Media.FfmpegPoolSize
 	cfgmap["media-thumb-max-pixels"] = cfg.Media.ThumbMaxPixels
+	cfgmap["media-thumb-format"] = cfg.Media.ThumbFormat
+	cfgmap["media-video-sprite-frames"] = cfg.Media.VideoSpriteFrames
 	cfgmap["cache-s3-object-info"] = cfg.Cache.S3ObjectInfo
 	cfgmap["cache-home-timeline-timeout"] = cfg.Cache.HomeTimelineTimeout
+	cfgmap["cache-home-timeline-max-entries"] = cfg.Cache.HomeTimelineMaxEntries
 	cfgmap["cache-list-timeline-timeout"] = cfg.Cache.ListTimelineTimeout
 	cfgmap["cache-tag-timeline-timeout"] = cfg.Cache.TagTimelineTimeout
+	cfgmap["cache-language-timeline-timeout"] = cfg.Cache.LanguageTimelineTimeout
+	cfgmap["cache-antenna-timeline-timeout"] = cfg.Cache.AntennaTimelineTimeout
+	cfgmap["cache-visibility-sweep-frequency"] = cfg.Cache.VisibilitySweepFrequency
 	cfgmap["cache-memory-target"] = cfg.Cache.MemoryTarget.String()
 	cfgmap["cache-account-mem-ratio"] = cfg.Cache.AccountMemRatio
 	cfgmap["cache-account-note-mem-ratio"] = cfg.Cache.AccountNoteMemRatio
 	cfgmap["cache-account-settings-mem-ratio"] = cfg.Cache.AccountSettingsMemRatio
 	cfgmap["cache-account-stats-mem-ratio"] = cfg.Cache.AccountStatsMemRatio
+	cfgmap["cache-antenna-mem-ratio"] = cfg.Cache.AntennaMemRatio
+	cfgmap["cache-antenna-ids-mem-ratio"] = cfg.Cache.AntennaIDsMemRatio
 	cfgmap["cache-application-mem-ratio"] = cfg.Cache.ApplicationMemRatio
 	cfgmap["cache-block-mem-ratio"] = cfg.Cache.BlockMemRatio
 	cfgmap["cache-block-ids-mem-ratio"] = cfg.Cache.BlockIDsMemRatio
 	cfgmap["cache-boost-of-ids-mem-ratio"] = cfg.Cache.BoostOfIDsMemRatio
+	cfgmap["cache-bookmark-collection-mem-ratio"] = cfg.Cache.BookmarkCollectionMemRatio
+	cfgmap["cache-bookmark-collection-ids-mem-ratio"] = cfg.Cache.BookmarkCollectionIDsMemRatio
 	cfgmap["cache-client-mem-ratio"] = cfg.Cache.ClientMemRatio
 	cfgmap["cache-conversation-mem-ratio"] = cfg.Cache.ConversationMemRatio
 	cfgmap["cache-conversation-last-status-ids-mem-ratio"] = cfg.Cache.ConversationLastStatusIDsMemRatio
@@ -595,6 +794,7 @@ func (cfg *Configuration) MarshalMap() map[string]any {
 	cfgmap["cache-media-mem-ratio"] = cfg.Cache.MediaMemRatio
 	cfgmap["cache-mention-mem-ratio"] = cfg.Cache.MentionMemRatio
 	cfgmap["cache-move-mem-ratio"] = cfg.Cache.MoveMemRatio
+	cfgmap["cache-muted-tag-ids-mem-ratio"] = cfg.Cache.MutedTagIDsMemRatio
 	cfgmap["cache-notification-mem-ratio"] = cfg.Cache.NotificationMemRatio
 	cfgmap["cache-poll-mem-ratio"] = cfg.Cache.PollMemRatio
 	cfgmap["cache-poll-vote-mem-ratio"] = cfg.Cache.PollVoteMemRatio
@@ -608,6 +808,8 @@ func (cfg *Configuration) MarshalMap() map[string]any {
 	cfgmap["cache-status-edit-mem-ratio"] = cfg.Cache.StatusEditMemRatio
 	cfgmap["cache-status-fave-mem-ratio"] = cfg.Cache.StatusFaveMemRatio
 	cfgmap["cache-status-fave-ids-mem-ratio"] = cfg.Cache.StatusFaveIDsMemRatio
+	cfgmap["cache-status-reaction-mem-ratio"] = cfg.Cache.StatusReactionMemRatio
+	cfgmap["cache-status-reaction-ids-mem-ratio"] = cfg.Cache.StatusReactionIDsMemRatio
 	cfgmap["cache-tag-mem-ratio"] = cfg.Cache.TagMemRatio
 	cfgmap["cache-thread-mute-mem-ratio"] = cfg.Cache.ThreadMuteMemRatio
 	cfgmap["cache-token-mem-ratio"] = cfg.Cache.TokenMemRatio
@@ -628,6 +830,19 @@ func (cfg *Configuration) MarshalMap() map[string]any {
 	cfgmap["dry-run"] = cfg.AdminMediaPruneDryRun
 	cfgmap["local-only"] = cfg.AdminMediaListLocalOnly
 	cfgmap["remote-only"] = cfg.AdminMediaListRemoteOnly
+	cfgmap["api-url"] = cfg.AdminAPIURL
+	cfgmap["api-token"] = cfg.AdminAPIToken
+	cfgmap["api-token-file"] = cfg.AdminAPITokenFile
+	cfgmap["reason"] = cfg.AdminReason
+	cfgmap["domain"] = cfg.AdminDomain
+	cfgmap["media-policy"] = cfg.AdminMediaPolicy
+	cfgmap["follows-policy"] = cfg.AdminFollowsPolicy
+	cfgmap["statuses-policy"] = cfg.AdminStatusesPolicy
+	cfgmap["accounts-policy"] = cfg.AdminAccountsPolicy
+	cfgmap["content-warning"] = cfg.AdminContentWarning
+	cfgmap["public-comment"] = cfg.AdminPublicComment
+	cfgmap["resolved"] = cfg.AdminReportsResolved
+	cfgmap["category"] = cfg.AdminEmojiCategory
 	cfgmap["skip-db-setup"] = cfg.TestrigSkipDBSetup
 	cfgmap["skip-db-teardown"] = cfg.TestrigSkipDBTeardown
 	return cfgmap
@@ -874,6 +1089,30 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["db-sqlite-backup-every"]; ok {
+		var err error
+		cfg.DbSqliteBackupEvery, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'db-sqlite-backup-every': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["db-sqlite-backup-dir"]; ok {
+		var err error
+		cfg.DbSqliteBackupDir, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'db-sqlite-backup-dir': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["db-sqlite-backup-keep"]; ok {
+		var err error
+		cfg.DbSqliteBackupKeep, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'db-sqlite-backup-keep': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["db-postgres-connection-string"]; ok {
 		var err error
 		cfg.DbPostgresConnectionString, err = cast.ToStringE(ival)
@@ -882,6 +1121,14 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["db-postgres-replica-connection-string"]; ok {
+		var err error
+		cfg.DbPostgresReplicaConnectionString, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'db-postgres-replica-connection-string': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["web-template-base-dir"]; ok {
 		var err error
 		cfg.WebTemplateBaseDir, err = cast.ToStringE(ival)
@@ -914,6 +1161,30 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["instance-federation-integrity-proofs"]; ok {
+		var err error
+		cfg.InstanceFederationIntegrityProofs, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'instance-federation-integrity-proofs': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["instance-federation-thread-max-depth"]; ok {
+		var err error
+		cfg.InstanceFederationThreadMaxDepth, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'instance-federation-thread-max-depth': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["instance-federation-thread-fetch-budget"]; ok {
+		var err error
+		cfg.InstanceFederationThreadFetchBudget, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'instance-federation-thread-fetch-budget': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["instance-expose-peers"]; ok {
 		var err error
 		cfg.InstanceExposePeers, err = cast.ToBoolE(ival)
@@ -922,6 +1193,14 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["instance-peers-granularity"]; ok {
+		var err error
+		cfg.InstancePeersGranularity, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'instance-peers-granularity': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["instance-expose-blocklist"]; ok {
 		var err error
 		cfg.InstanceExposeBlocklist, err = cast.ToBoolE(ival)
@@ -1031,6 +1310,78 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["instance-directory-url"]; ok {
+		var err error
+		cfg.InstanceDirectoryURL, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'instance-directory-url': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["instance-directory-sync-every"]; ok {
+		var err error
+		cfg.InstanceDirectorySyncEvery, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'instance-directory-sync-every': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["instance-directory-max-accounts"]; ok {
+		var err error
+		cfg.InstanceDirectoryMaxAccounts, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'instance-directory-max-accounts': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["instance-nodeinfo-refresh-every"]; ok {
+		var err error
+		cfg.InstanceNodeInfoRefreshEvery, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'instance-nodeinfo-refresh-every': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["instance-remote-timelines-enabled"]; ok {
+		var err error
+		cfg.InstanceRemoteTimelinesEnabled, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'instance-remote-timelines-enabled': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["instance-outbox-include-boosts"]; ok {
+		var err error
+		cfg.InstanceOutboxIncludeBoosts, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'instance-outbox-include-boosts': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["instance-webfinger-alias-domains"]; ok {
+		var err error
+		cfg.InstanceWebfingerAliasDomains, err = toStringSlice(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> []string for 'instance-webfinger-alias-domains': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["instance-expose-sitemap"]; ok {
+		var err error
+		cfg.InstanceExposeSitemap, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'instance-expose-sitemap': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["instance-sitemap-regenerate-every"]; ok {
+		var err error
+		cfg.InstanceSitemapRegenerateEvery, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'instance-sitemap-regenerate-every': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["accounts-registration-open"]; ok {
 		var err error
 		cfg.AccountsRegistrationOpen, err = cast.ToBoolE(ival)
@@ -1063,6 +1414,38 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["accounts-allow-user-invites"]; ok {
+		var err error
+		cfg.AccountsAllowUserInvites, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'accounts-allow-user-invites': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["accounts-captcha-provider"]; ok {
+		var err error
+		cfg.AccountsCaptchaProvider, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'accounts-captcha-provider': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["accounts-captcha-site-key"]; ok {
+		var err error
+		cfg.AccountsCaptchaSiteKey, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'accounts-captcha-site-key': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["accounts-captcha-secret-key"]; ok {
+		var err error
+		cfg.AccountsCaptchaSecretKey, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'accounts-captcha-secret-key': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["accounts-allow-custom-css"]; ok {
 		var err error
 		cfg.AccountsAllowCustomCSS, err = cast.ToBoolE(ival)
@@ -1079,6 +1462,22 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["accounts-custom-css-strict"]; ok {
+		var err error
+		cfg.AccountsCustomCSSStrict, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'accounts-custom-css-strict': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["accounts-custom-css-allowed-properties"]; ok {
+		var err error
+		cfg.AccountsCustomCSSAllowedProperties, err = toStringSlice(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> []string for 'accounts-custom-css-allowed-properties': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["accounts-max-profile-fields"]; ok {
 		var err error
 		cfg.AccountsMaxProfileFields, err = cast.ToIntE(ival)
@@ -1087,6 +1486,54 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["accounts-max-service-accounts"]; ok {
+		var err error
+		cfg.AccountsMaxServiceAccounts, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'accounts-max-service-accounts': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["accounts-rate-limit-statuses"]; ok {
+		var err error
+		cfg.AccountsRateLimitStatuses, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'accounts-rate-limit-statuses': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["accounts-rate-limit-follows"]; ok {
+		var err error
+		cfg.AccountsRateLimitFollows, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'accounts-rate-limit-follows': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["accounts-rate-limit-reports"]; ok {
+		var err error
+		cfg.AccountsRateLimitReports, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'accounts-rate-limit-reports': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["accounts-rate-limit-moderator-multiplier"]; ok {
+		var err error
+		cfg.AccountsRateLimitModeratorMultiplier, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'accounts-rate-limit-moderator-multiplier': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["accounts-rate-limit-admin-multiplier"]; ok {
+		var err error
+		cfg.AccountsRateLimitAdminMultiplier, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'accounts-rate-limit-admin-multiplier': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["storage-backend"]; ok {
 		var err error
 		cfg.StorageBackend, err = cast.ToStringE(ival)
@@ -1103,6 +1550,14 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["storage-local-checksums"]; ok {
+		var err error
+		cfg.StorageLocalChecksums, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'storage-local-checksums': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["storage-s3-endpoint"]; ok {
 		var err error
 		cfg.StorageS3Endpoint, err = cast.ToStringE(ival)
@@ -1175,6 +1630,30 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["storage-webdav-url"]; ok {
+		var err error
+		cfg.StorageWebDAVURL, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'storage-webdav-url': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["storage-webdav-username"]; ok {
+		var err error
+		cfg.StorageWebDAVUsername, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'storage-webdav-username': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["storage-webdav-password"]; ok {
+		var err error
+		cfg.StorageWebDAVPassword, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'storage-webdav-password': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["statuses-max-chars"]; ok {
 		var err error
 		cfg.StatusesMaxChars, err = cast.ToIntE(ival)
@@ -1199,6 +1678,14 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["statuses-poll-local-voters"]; ok {
+		var err error
+		cfg.StatusesPollLocalVoters, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'statuses-poll-local-voters': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["statuses-media-max-files"]; ok {
 		var err error
 		cfg.StatusesMediaMaxFiles, err = cast.ToIntE(ival)
@@ -1207,6 +1694,22 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["statuses-trash-window"]; ok {
+		var err error
+		cfg.StatusesTrashWindow, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'statuses-trash-window': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["statuses-detect-language"]; ok {
+		var err error
+		cfg.StatusesDetectLanguage, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'statuses-detect-language': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["scheduled-statuses-max-total"]; ok {
 		var err error
 		cfg.ScheduledStatusesMaxTotal, err = cast.ToIntE(ival)
@@ -1223,6 +1726,14 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["notifications-max-age"]; ok {
+		var err error
+		cfg.NotificationsMaxAge, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'notifications-max-age': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["letsencrypt-enabled"]; ok {
 		var err error
 		cfg.LetsEncryptEnabled, err = cast.ToBoolE(ival)
@@ -1367,6 +1878,14 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["debug-endpoints-enabled"]; ok {
+		var err error
+		cfg.DebugEndpointsEnabled, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'debug-endpoints-enabled': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["smtp-host"]; ok {
 		var err error
 		cfg.SMTPHost, err = cast.ToStringE(ival)
@@ -1439,6 +1958,102 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["moderation-hook-status-create-url"]; ok {
+		var err error
+		cfg.ModerationHookStatusCreateURL, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'moderation-hook-status-create-url': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["moderation-hook-federation-ingest-url"]; ok {
+		var err error
+		cfg.ModerationHookFederationIngestURL, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'moderation-hook-federation-ingest-url': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["moderation-hook-media-store-url"]; ok {
+		var err error
+		cfg.ModerationHookMediaStoreURL, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'moderation-hook-media-store-url': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["moderation-hook-timeout"]; ok {
+		var err error
+		cfg.ModerationHookTimeout, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'moderation-hook-timeout': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["moderation-hook-fail-closed"]; ok {
+		var err error
+		cfg.ModerationHookFailClosed, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'moderation-hook-fail-closed': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["translation-backend"]; ok {
+		var err error
+		cfg.TranslationBackend, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'translation-backend': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["translation-url"]; ok {
+		var err error
+		cfg.TranslationURL, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'translation-url': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["translation-api-key"]; ok {
+		var err error
+		cfg.TranslationAPIKey, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'translation-api-key': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["translation-rate-limit"]; ok {
+		var err error
+		cfg.TranslationRateLimit, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'translation-rate-limit': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["read-only-mode"]; ok {
+		var err error
+		cfg.ReadOnlyMode, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'read-only-mode': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["read-only-retry-after"]; ok {
+		var err error
+		cfg.ReadOnlyRetryAfter, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'read-only-retry-after': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["shutdown-drain-timeout"]; ok {
+		var err error
+		cfg.ShutdownDrainTimeout, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'shutdown-drain-timeout': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["advanced-cookies-samesite"]; ok {
 		var err error
 		cfg.Advanced.CookiesSamesite, err = cast.ToStringE(ival)
@@ -1670,19 +2285,43 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
-	if ival, ok := cfgmap["cache-s3-object-info"]; ok {
+	if ival, ok := cfgmap["media-thumb-format"]; ok {
 		var err error
-		cfg.Cache.S3ObjectInfo, err = cast.ToIntE(ival)
+		cfg.Media.ThumbFormat, err = cast.ToStringE(ival)
 		if err != nil {
-			return fmt.Errorf("error casting %#v -> int for 'cache-s3-object-info': %w", ival, err)
+			return fmt.Errorf("error casting %#v -> string for 'media-thumb-format': %w", ival, err)
 		}
 	}
 
-	if ival, ok := cfgmap["cache-home-timeline-timeout"]; ok {
+	if ival, ok := cfgmap["media-video-sprite-frames"]; ok {
 		var err error
-		cfg.Cache.HomeTimelineTimeout, err = cast.ToDurationE(ival)
+		cfg.Media.VideoSpriteFrames, err = cast.ToIntE(ival)
 		if err != nil {
-			return fmt.Errorf("error casting %#v -> time.Duration for 'cache-home-timeline-timeout': %w", ival, err)
+			return fmt.Errorf("error casting %#v -> int for 'media-video-sprite-frames': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["cache-s3-object-info"]; ok {
+		var err error
+		cfg.Cache.S3ObjectInfo, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'cache-s3-object-info': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["cache-home-timeline-timeout"]; ok {
+		var err error
+		cfg.Cache.HomeTimelineTimeout, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'cache-home-timeline-timeout': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["cache-home-timeline-max-entries"]; ok {
+		var err error
+		cfg.Cache.HomeTimelineMaxEntries, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'cache-home-timeline-max-entries': %w", ival, err)
 		}
 	}
 
@@ -1702,6 +2341,30 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["cache-language-timeline-timeout"]; ok {
+		var err error
+		cfg.Cache.LanguageTimelineTimeout, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'cache-language-timeline-timeout': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["cache-antenna-timeline-timeout"]; ok {
+		var err error
+		cfg.Cache.AntennaTimelineTimeout, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'cache-antenna-timeline-timeout': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["cache-visibility-sweep-frequency"]; ok {
+		var err error
+		cfg.Cache.VisibilitySweepFrequency, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'cache-visibility-sweep-frequency': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["cache-memory-target"]; ok {
 		t, err := cast.ToStringE(ival)
 		if err != nil {
@@ -1745,6 +2408,22 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["cache-antenna-mem-ratio"]; ok {
+		var err error
+		cfg.Cache.AntennaMemRatio, err = cast.ToFloat64E(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> float64 for 'cache-antenna-mem-ratio': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["cache-antenna-ids-mem-ratio"]; ok {
+		var err error
+		cfg.Cache.AntennaIDsMemRatio, err = cast.ToFloat64E(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> float64 for 'cache-antenna-ids-mem-ratio': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["cache-application-mem-ratio"]; ok {
 		var err error
 		cfg.Cache.ApplicationMemRatio, err = cast.ToFloat64E(ival)
@@ -1777,6 +2456,22 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["cache-bookmark-collection-mem-ratio"]; ok {
+		var err error
+		cfg.Cache.BookmarkCollectionMemRatio, err = cast.ToFloat64E(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> float64 for 'cache-bookmark-collection-mem-ratio': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["cache-bookmark-collection-ids-mem-ratio"]; ok {
+		var err error
+		cfg.Cache.BookmarkCollectionIDsMemRatio, err = cast.ToFloat64E(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> float64 for 'cache-bookmark-collection-ids-mem-ratio': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["cache-client-mem-ratio"]; ok {
 		var err error
 		cfg.Cache.ClientMemRatio, err = cast.ToFloat64E(ival)
@@ -2001,6 +2696,14 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["cache-muted-tag-ids-mem-ratio"]; ok {
+		var err error
+		cfg.Cache.MutedTagIDsMemRatio, err = cast.ToFloat64E(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> float64 for 'cache-muted-tag-ids-mem-ratio': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["cache-notification-mem-ratio"]; ok {
 		var err error
 		cfg.Cache.NotificationMemRatio, err = cast.ToFloat64E(ival)
@@ -2105,6 +2808,22 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["cache-status-reaction-mem-ratio"]; ok {
+		var err error
+		cfg.Cache.StatusReactionMemRatio, err = cast.ToFloat64E(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> float64 for 'cache-status-reaction-mem-ratio': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["cache-status-reaction-ids-mem-ratio"]; ok {
+		var err error
+		cfg.Cache.StatusReactionIDsMemRatio, err = cast.ToFloat64E(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> float64 for 'cache-status-reaction-ids-mem-ratio': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["cache-tag-mem-ratio"]; ok {
 		var err error
 		cfg.Cache.TagMemRatio, err = cast.ToFloat64E(ival)
@@ -2265,6 +2984,110 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["api-url"]; ok {
+		var err error
+		cfg.AdminAPIURL, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'api-url': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["api-token"]; ok {
+		var err error
+		cfg.AdminAPIToken, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'api-token': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["api-token-file"]; ok {
+		var err error
+		cfg.AdminAPITokenFile, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'api-token-file': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["reason"]; ok {
+		var err error
+		cfg.AdminReason, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'reason': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["domain"]; ok {
+		var err error
+		cfg.AdminDomain, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'domain': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-policy"]; ok {
+		var err error
+		cfg.AdminMediaPolicy, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'media-policy': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["follows-policy"]; ok {
+		var err error
+		cfg.AdminFollowsPolicy, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'follows-policy': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["statuses-policy"]; ok {
+		var err error
+		cfg.AdminStatusesPolicy, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'statuses-policy': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["accounts-policy"]; ok {
+		var err error
+		cfg.AdminAccountsPolicy, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'accounts-policy': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["content-warning"]; ok {
+		var err error
+		cfg.AdminContentWarning, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'content-warning': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["public-comment"]; ok {
+		var err error
+		cfg.AdminPublicComment, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'public-comment': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["resolved"]; ok {
+		var err error
+		cfg.AdminReportsResolved, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'resolved': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["category"]; ok {
+		var err error
+		cfg.AdminEmojiCategory, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'category': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["skip-db-setup"]; ok {
 		var err error
 		cfg.TestrigSkipDBSetup, err = cast.ToBoolE(ival)
@@ -2922,6 +3745,72 @@ func GetDbSqliteBusyTimeout() time.Duration { return global.GetDbSqliteBusyTimeo
 // SetDbSqliteBusyTimeout safely sets the value for global configuration 'DbSqliteBusyTimeout' field
 func SetDbSqliteBusyTimeout(v time.Duration) { global.SetDbSqliteBusyTimeout(v) }
 
+// GetDbSqliteBackupEvery safely fetches the Configuration value for state's 'DbSqliteBackupEvery' field
+func (st *ConfigState) GetDbSqliteBackupEvery() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.DbSqliteBackupEvery
+	st.mutex.RUnlock()
+	return
+}
+
+// SetDbSqliteBackupEvery safely sets the Configuration value for state's 'DbSqliteBackupEvery' field
+func (st *ConfigState) SetDbSqliteBackupEvery(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.DbSqliteBackupEvery = v
+	st.reloadToViper()
+}
+
+// GetDbSqliteBackupEvery safely fetches the value for global configuration 'DbSqliteBackupEvery' field
+func GetDbSqliteBackupEvery() time.Duration { return global.GetDbSqliteBackupEvery() }
+
+// SetDbSqliteBackupEvery safely sets the value for global configuration 'DbSqliteBackupEvery' field
+func SetDbSqliteBackupEvery(v time.Duration) { global.SetDbSqliteBackupEvery(v) }
+
+// GetDbSqliteBackupDir safely fetches the Configuration value for state's 'DbSqliteBackupDir' field
+func (st *ConfigState) GetDbSqliteBackupDir() (v string) {
+	st.mutex.RLock()
+	v = st.config.DbSqliteBackupDir
+	st.mutex.RUnlock()
+	return
+}
+
+// SetDbSqliteBackupDir safely sets the Configuration value for state's 'DbSqliteBackupDir' field
+func (st *ConfigState) SetDbSqliteBackupDir(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.DbSqliteBackupDir = v
+	st.reloadToViper()
+}
+
+// GetDbSqliteBackupDir safely fetches the value for global configuration 'DbSqliteBackupDir' field
+func GetDbSqliteBackupDir() string { return global.GetDbSqliteBackupDir() }
+
+// SetDbSqliteBackupDir safely sets the value for global configuration 'DbSqliteBackupDir' field
+func SetDbSqliteBackupDir(v string) { global.SetDbSqliteBackupDir(v) }
+
+// GetDbSqliteBackupKeep safely fetches the Configuration value for state's 'DbSqliteBackupKeep' field
+func (st *ConfigState) GetDbSqliteBackupKeep() (v int) {
+	st.mutex.RLock()
+	v = st.config.DbSqliteBackupKeep
+	st.mutex.RUnlock()
+	return
+}
+
+// SetDbSqliteBackupKeep safely sets the Configuration value for state's 'DbSqliteBackupKeep' field
+func (st *ConfigState) SetDbSqliteBackupKeep(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.DbSqliteBackupKeep = v
+	st.reloadToViper()
+}
+
+// GetDbSqliteBackupKeep safely fetches the value for global configuration 'DbSqliteBackupKeep' field
+func GetDbSqliteBackupKeep() int { return global.GetDbSqliteBackupKeep() }
+
+// SetDbSqliteBackupKeep safely sets the value for global configuration 'DbSqliteBackupKeep' field
+func SetDbSqliteBackupKeep(v int) { global.SetDbSqliteBackupKeep(v) }
+
 // GetDbPostgresConnectionString safely fetches the Configuration value for state's 'DbPostgresConnectionString' field
 func (st *ConfigState) GetDbPostgresConnectionString() (v string) {
 	st.mutex.RLock()
@@ -2944,6 +3833,30 @@ func GetDbPostgresConnectionString() string { return global.GetDbPostgresConnect
 // SetDbPostgresConnectionString safely sets the value for global configuration 'DbPostgresConnectionString' field
 func SetDbPostgresConnectionString(v string) { global.SetDbPostgresConnectionString(v) }
 
+// GetDbPostgresReplicaConnectionString safely fetches the Configuration value for state's 'DbPostgresReplicaConnectionString' field
+func (st *ConfigState) GetDbPostgresReplicaConnectionString() (v string) {
+	st.mutex.RLock()
+	v = st.config.DbPostgresReplicaConnectionString
+	st.mutex.RUnlock()
+	return
+}
+
+// SetDbPostgresReplicaConnectionString safely sets the Configuration value for state's 'DbPostgresReplicaConnectionString' field
+func (st *ConfigState) SetDbPostgresReplicaConnectionString(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.DbPostgresReplicaConnectionString = v
+	st.reloadToViper()
+}
+
+// GetDbPostgresReplicaConnectionString safely fetches the value for global configuration 'DbPostgresReplicaConnectionString' field
+func GetDbPostgresReplicaConnectionString() string {
+	return global.GetDbPostgresReplicaConnectionString()
+}
+
+// SetDbPostgresReplicaConnectionString safely sets the value for global configuration 'DbPostgresReplicaConnectionString' field
+func SetDbPostgresReplicaConnectionString(v string) { global.SetDbPostgresReplicaConnectionString(v) }
+
 // GetWebTemplateBaseDir safely fetches the Configuration value for state's 'WebTemplateBaseDir' field
 func (st *ConfigState) GetWebTemplateBaseDir() (v string) {
 	st.mutex.RLock()
@@ -3032,6 +3945,76 @@ func GetInstanceFederationSpamFilter() bool { return global.GetInstanceFederatio
 // SetInstanceFederationSpamFilter safely sets the value for global configuration 'InstanceFederationSpamFilter' field
 func SetInstanceFederationSpamFilter(v bool) { global.SetInstanceFederationSpamFilter(v) }
 
+// GetInstanceFederationIntegrityProofs safely fetches the Configuration value for state's 'InstanceFederationIntegrityProofs' field
+func (st *ConfigState) GetInstanceFederationIntegrityProofs() (v bool) {
+	st.mutex.RLock()
+	v = st.config.InstanceFederationIntegrityProofs
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceFederationIntegrityProofs safely sets the Configuration value for state's 'InstanceFederationIntegrityProofs' field
+func (st *ConfigState) SetInstanceFederationIntegrityProofs(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceFederationIntegrityProofs = v
+	st.reloadToViper()
+}
+
+// GetInstanceFederationIntegrityProofs safely fetches the value for global configuration 'InstanceFederationIntegrityProofs' field
+func GetInstanceFederationIntegrityProofs() bool {
+	return global.GetInstanceFederationIntegrityProofs()
+}
+
+// SetInstanceFederationIntegrityProofs safely sets the value for global configuration 'InstanceFederationIntegrityProofs' field
+func SetInstanceFederationIntegrityProofs(v bool) { global.SetInstanceFederationIntegrityProofs(v) }
+
+// GetInstanceFederationThreadMaxDepth safely fetches the Configuration value for state's 'InstanceFederationThreadMaxDepth' field
+func (st *ConfigState) GetInstanceFederationThreadMaxDepth() (v int) {
+	st.mutex.RLock()
+	v = st.config.InstanceFederationThreadMaxDepth
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceFederationThreadMaxDepth safely sets the Configuration value for state's 'InstanceFederationThreadMaxDepth' field
+func (st *ConfigState) SetInstanceFederationThreadMaxDepth(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceFederationThreadMaxDepth = v
+	st.reloadToViper()
+}
+
+// GetInstanceFederationThreadMaxDepth safely fetches the value for global configuration 'InstanceFederationThreadMaxDepth' field
+func GetInstanceFederationThreadMaxDepth() int { return global.GetInstanceFederationThreadMaxDepth() }
+
+// SetInstanceFederationThreadMaxDepth safely sets the value for global configuration 'InstanceFederationThreadMaxDepth' field
+func SetInstanceFederationThreadMaxDepth(v int) { global.SetInstanceFederationThreadMaxDepth(v) }
+
+// GetInstanceFederationThreadFetchBudget safely fetches the Configuration value for state's 'InstanceFederationThreadFetchBudget' field
+func (st *ConfigState) GetInstanceFederationThreadFetchBudget() (v int) {
+	st.mutex.RLock()
+	v = st.config.InstanceFederationThreadFetchBudget
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceFederationThreadFetchBudget safely sets the Configuration value for state's 'InstanceFederationThreadFetchBudget' field
+func (st *ConfigState) SetInstanceFederationThreadFetchBudget(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceFederationThreadFetchBudget = v
+	st.reloadToViper()
+}
+
+// GetInstanceFederationThreadFetchBudget safely fetches the value for global configuration 'InstanceFederationThreadFetchBudget' field
+func GetInstanceFederationThreadFetchBudget() int {
+	return global.GetInstanceFederationThreadFetchBudget()
+}
+
+// SetInstanceFederationThreadFetchBudget safely sets the value for global configuration 'InstanceFederationThreadFetchBudget' field
+func SetInstanceFederationThreadFetchBudget(v int) { global.SetInstanceFederationThreadFetchBudget(v) }
+
 // GetInstanceExposePeers safely fetches the Configuration value for state's 'InstanceExposePeers' field
 func (st *ConfigState) GetInstanceExposePeers() (v bool) {
 	st.mutex.RLock()
@@ -3054,6 +4037,28 @@ func GetInstanceExposePeers() bool { return global.GetInstanceExposePeers() }
 // SetInstanceExposePeers safely sets the value for global configuration 'InstanceExposePeers' field
 func SetInstanceExposePeers(v bool) { global.SetInstanceExposePeers(v) }
 
+// GetInstancePeersGranularity safely fetches the Configuration value for state's 'InstancePeersGranularity' field
+func (st *ConfigState) GetInstancePeersGranularity() (v string) {
+	st.mutex.RLock()
+	v = st.config.InstancePeersGranularity
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstancePeersGranularity safely sets the Configuration value for state's 'InstancePeersGranularity' field
+func (st *ConfigState) SetInstancePeersGranularity(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstancePeersGranularity = v
+	st.reloadToViper()
+}
+
+// GetInstancePeersGranularity safely fetches the value for global configuration 'InstancePeersGranularity' field
+func GetInstancePeersGranularity() string { return global.GetInstancePeersGranularity() }
+
+// SetInstancePeersGranularity safely sets the value for global configuration 'InstancePeersGranularity' field
+func SetInstancePeersGranularity(v string) { global.SetInstancePeersGranularity(v) }
+
 // GetInstanceExposeBlocklist safely fetches the Configuration value for state's 'InstanceExposeBlocklist' field
 func (st *ConfigState) GetInstanceExposeBlocklist() (v bool) {
 	st.mutex.RLock()
@@ -3346,3345 +4351,4859 @@ func GetInstanceAllowBackdatingStatuses() bool { return global.GetInstanceAllowB
 // SetInstanceAllowBackdatingStatuses safely sets the value for global configuration 'InstanceAllowBackdatingStatuses' field
 func SetInstanceAllowBackdatingStatuses(v bool) { global.SetInstanceAllowBackdatingStatuses(v) }
 
-// GetAccountsRegistrationOpen safely fetches the Configuration value for state's 'AccountsRegistrationOpen' field
-func (st *ConfigState) GetAccountsRegistrationOpen() (v bool) {
+// GetInstanceDirectoryURL safely fetches the Configuration value for state's 'InstanceDirectoryURL' field
+func (st *ConfigState) GetInstanceDirectoryURL() (v string) {
 	st.mutex.RLock()
-	v = st.config.AccountsRegistrationOpen
+	v = st.config.InstanceDirectoryURL
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAccountsRegistrationOpen safely sets the Configuration value for state's 'AccountsRegistrationOpen' field
-func (st *ConfigState) SetAccountsRegistrationOpen(v bool) {
+// SetInstanceDirectoryURL safely sets the Configuration value for state's 'InstanceDirectoryURL' field
+func (st *ConfigState) SetInstanceDirectoryURL(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AccountsRegistrationOpen = v
+	st.config.InstanceDirectoryURL = v
 	st.reloadToViper()
 }
 
-// GetAccountsRegistrationOpen safely fetches the value for global configuration 'AccountsRegistrationOpen' field
-func GetAccountsRegistrationOpen() bool { return global.GetAccountsRegistrationOpen() }
+// GetInstanceDirectoryURL safely fetches the value for global configuration 'InstanceDirectoryURL' field
+func GetInstanceDirectoryURL() string { return global.GetInstanceDirectoryURL() }
 
-// SetAccountsRegistrationOpen safely sets the value for global configuration 'AccountsRegistrationOpen' field
-func SetAccountsRegistrationOpen(v bool) { global.SetAccountsRegistrationOpen(v) }
+// SetInstanceDirectoryURL safely sets the value for global configuration 'InstanceDirectoryURL' field
+func SetInstanceDirectoryURL(v string) { global.SetInstanceDirectoryURL(v) }
 
-// GetAccountsReasonRequired safely fetches the Configuration value for state's 'AccountsReasonRequired' field
-func (st *ConfigState) GetAccountsReasonRequired() (v bool) {
+// GetInstanceDirectorySyncEvery safely fetches the Configuration value for state's 'InstanceDirectorySyncEvery' field
+func (st *ConfigState) GetInstanceDirectorySyncEvery() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.AccountsReasonRequired
+	v = st.config.InstanceDirectorySyncEvery
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAccountsReasonRequired safely sets the Configuration value for state's 'AccountsReasonRequired' field
-func (st *ConfigState) SetAccountsReasonRequired(v bool) {
+// SetInstanceDirectorySyncEvery safely sets the Configuration value for state's 'InstanceDirectorySyncEvery' field
+func (st *ConfigState) SetInstanceDirectorySyncEvery(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AccountsReasonRequired = v
+	st.config.InstanceDirectorySyncEvery = v
 	st.reloadToViper()
 }
 
-// GetAccountsReasonRequired safely fetches the value for global configuration 'AccountsReasonRequired' field
-func GetAccountsReasonRequired() bool { return global.GetAccountsReasonRequired() }
+// GetInstanceDirectorySyncEvery safely fetches the value for global configuration 'InstanceDirectorySyncEvery' field
+func GetInstanceDirectorySyncEvery() time.Duration { return global.GetInstanceDirectorySyncEvery() }
 
-// SetAccountsReasonRequired safely sets the value for global configuration 'AccountsReasonRequired' field
-func SetAccountsReasonRequired(v bool) { global.SetAccountsReasonRequired(v) }
+// SetInstanceDirectorySyncEvery safely sets the value for global configuration 'InstanceDirectorySyncEvery' field
+func SetInstanceDirectorySyncEvery(v time.Duration) { global.SetInstanceDirectorySyncEvery(v) }
 
-// GetAccountsRegistrationDailyLimit safely fetches the Configuration value for state's 'AccountsRegistrationDailyLimit' field
-func (st *ConfigState) GetAccountsRegistrationDailyLimit() (v int) {
+// GetInstanceDirectoryMaxAccounts safely fetches the Configuration value for state's 'InstanceDirectoryMaxAccounts' field
+func (st *ConfigState) GetInstanceDirectoryMaxAccounts() (v int) {
 	st.mutex.RLock()
-	v = st.config.AccountsRegistrationDailyLimit
+	v = st.config.InstanceDirectoryMaxAccounts
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAccountsRegistrationDailyLimit safely sets the Configuration value for state's 'AccountsRegistrationDailyLimit' field
-func (st *ConfigState) SetAccountsRegistrationDailyLimit(v int) {
+// SetInstanceDirectoryMaxAccounts safely sets the Configuration value for state's 'InstanceDirectoryMaxAccounts' field
+func (st *ConfigState) SetInstanceDirectoryMaxAccounts(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AccountsRegistrationDailyLimit = v
+	st.config.InstanceDirectoryMaxAccounts = v
 	st.reloadToViper()
 }
 
-// GetAccountsRegistrationDailyLimit safely fetches the value for global configuration 'AccountsRegistrationDailyLimit' field
-func GetAccountsRegistrationDailyLimit() int { return global.GetAccountsRegistrationDailyLimit() }
+// GetInstanceDirectoryMaxAccounts safely fetches the value for global configuration 'InstanceDirectoryMaxAccounts' field
+func GetInstanceDirectoryMaxAccounts() int { return global.GetInstanceDirectoryMaxAccounts() }
 
-// SetAccountsRegistrationDailyLimit safely sets the value for global configuration 'AccountsRegistrationDailyLimit' field
-func SetAccountsRegistrationDailyLimit(v int) { global.SetAccountsRegistrationDailyLimit(v) }
+// SetInstanceDirectoryMaxAccounts safely sets the value for global configuration 'InstanceDirectoryMaxAccounts' field
+func SetInstanceDirectoryMaxAccounts(v int) { global.SetInstanceDirectoryMaxAccounts(v) }
 
-// GetAccountsRegistrationBacklogLimit safely fetches the Configuration value for state's 'AccountsRegistrationBacklogLimit' field
-func (st *ConfigState) GetAccountsRegistrationBacklogLimit() (v int) {
+// GetInstanceNodeInfoRefreshEvery safely fetches the Configuration value for state's 'InstanceNodeInfoRefreshEvery' field
+func (st *ConfigState) GetInstanceNodeInfoRefreshEvery() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.AccountsRegistrationBacklogLimit
+	v = st.config.InstanceNodeInfoRefreshEvery
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAccountsRegistrationBacklogLimit safely sets the Configuration value for state's 'AccountsRegistrationBacklogLimit' field
-func (st *ConfigState) SetAccountsRegistrationBacklogLimit(v int) {
+// SetInstanceNodeInfoRefreshEvery safely sets the Configuration value for state's 'InstanceNodeInfoRefreshEvery' field
+func (st *ConfigState) SetInstanceNodeInfoRefreshEvery(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AccountsRegistrationBacklogLimit = v
+	st.config.InstanceNodeInfoRefreshEvery = v
 	st.reloadToViper()
 }
 
-// GetAccountsRegistrationBacklogLimit safely fetches the value for global configuration 'AccountsRegistrationBacklogLimit' field
-func GetAccountsRegistrationBacklogLimit() int { return global.GetAccountsRegistrationBacklogLimit() }
+// GetInstanceNodeInfoRefreshEvery safely fetches the value for global configuration 'InstanceNodeInfoRefreshEvery' field
+func GetInstanceNodeInfoRefreshEvery() time.Duration { return global.GetInstanceNodeInfoRefreshEvery() }
 
-// SetAccountsRegistrationBacklogLimit safely sets the value for global configuration 'AccountsRegistrationBacklogLimit' field
-func SetAccountsRegistrationBacklogLimit(v int) { global.SetAccountsRegistrationBacklogLimit(v) }
+// SetInstanceNodeInfoRefreshEvery safely sets the value for global configuration 'InstanceNodeInfoRefreshEvery' field
+func SetInstanceNodeInfoRefreshEvery(v time.Duration) { global.SetInstanceNodeInfoRefreshEvery(v) }
 
-// GetAccountsAllowCustomCSS safely fetches the Configuration value for state's 'AccountsAllowCustomCSS' field
-func (st *ConfigState) GetAccountsAllowCustomCSS() (v bool) {
+// GetInstanceRemoteTimelinesEnabled safely fetches the Configuration value for state's 'InstanceRemoteTimelinesEnabled' field
+func (st *ConfigState) GetInstanceRemoteTimelinesEnabled() (v bool) {
 	st.mutex.RLock()
-	v = st.config.AccountsAllowCustomCSS
+	v = st.config.InstanceRemoteTimelinesEnabled
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAccountsAllowCustomCSS safely sets the Configuration value for state's 'AccountsAllowCustomCSS' field
-func (st *ConfigState) SetAccountsAllowCustomCSS(v bool) {
+// SetInstanceRemoteTimelinesEnabled safely sets the Configuration value for state's 'InstanceRemoteTimelinesEnabled' field
+func (st *ConfigState) SetInstanceRemoteTimelinesEnabled(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AccountsAllowCustomCSS = v
+	st.config.InstanceRemoteTimelinesEnabled = v
 	st.reloadToViper()
 }
 
-// GetAccountsAllowCustomCSS safely fetches the value for global configuration 'AccountsAllowCustomCSS' field
-func GetAccountsAllowCustomCSS() bool { return global.GetAccountsAllowCustomCSS() }
+// GetInstanceRemoteTimelinesEnabled safely fetches the value for global configuration 'InstanceRemoteTimelinesEnabled' field
+func GetInstanceRemoteTimelinesEnabled() bool { return global.GetInstanceRemoteTimelinesEnabled() }
 
-// SetAccountsAllowCustomCSS safely sets the value for global configuration 'AccountsAllowCustomCSS' field
-func SetAccountsAllowCustomCSS(v bool) { global.SetAccountsAllowCustomCSS(v) }
+// SetInstanceRemoteTimelinesEnabled safely sets the value for global configuration 'InstanceRemoteTimelinesEnabled' field
+func SetInstanceRemoteTimelinesEnabled(v bool) { global.SetInstanceRemoteTimelinesEnabled(v) }
 
-// GetAccountsCustomCSSLength safely fetches the Configuration value for state's 'AccountsCustomCSSLength' field
-func (st *ConfigState) GetAccountsCustomCSSLength() (v int) {
+// GetInstanceOutboxIncludeBoosts safely fetches the Configuration value for state's 'InstanceOutboxIncludeBoosts' field
+func (st *ConfigState) GetInstanceOutboxIncludeBoosts() (v bool) {
 	st.mutex.RLock()
-	v = st.config.AccountsCustomCSSLength
+	v = st.config.InstanceOutboxIncludeBoosts
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAccountsCustomCSSLength safely sets the Configuration value for state's 'AccountsCustomCSSLength' field
-func (st *ConfigState) SetAccountsCustomCSSLength(v int) {
+// SetInstanceOutboxIncludeBoosts safely sets the Configuration value for state's 'InstanceOutboxIncludeBoosts' field
+func (st *ConfigState) SetInstanceOutboxIncludeBoosts(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AccountsCustomCSSLength = v
+	st.config.InstanceOutboxIncludeBoosts = v
 	st.reloadToViper()
 }
 
-// GetAccountsCustomCSSLength safely fetches the value for global configuration 'AccountsCustomCSSLength' field
-func GetAccountsCustomCSSLength() int { return global.GetAccountsCustomCSSLength() }
+// GetInstanceOutboxIncludeBoosts safely fetches the value for global configuration 'InstanceOutboxIncludeBoosts' field
+func GetInstanceOutboxIncludeBoosts() bool { return global.GetInstanceOutboxIncludeBoosts() }
 
-// SetAccountsCustomCSSLength safely sets the value for global configuration 'AccountsCustomCSSLength' field
-func SetAccountsCustomCSSLength(v int) { global.SetAccountsCustomCSSLength(v) }
+// SetInstanceOutboxIncludeBoosts safely sets the value for global configuration 'InstanceOutboxIncludeBoosts' field
+func SetInstanceOutboxIncludeBoosts(v bool) { global.SetInstanceOutboxIncludeBoosts(v) }
 
-// GetAccountsMaxProfileFields safely fetches the Configuration value for state's 'AccountsMaxProfileFields' field
-func (st *ConfigState) GetAccountsMaxProfileFields() (v int) {
+// GetInstanceWebfingerAliasDomains safely fetches the Configuration value for state's 'InstanceWebfingerAliasDomains' field
+func (st *ConfigState) GetInstanceWebfingerAliasDomains() (v []string) {
 	st.mutex.RLock()
-	v = st.config.AccountsMaxProfileFields
+	v = st.config.InstanceWebfingerAliasDomains
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAccountsMaxProfileFields safely sets the Configuration value for state's 'AccountsMaxProfileFields' field
-func (st *ConfigState) SetAccountsMaxProfileFields(v int) {
+// SetInstanceWebfingerAliasDomains safely sets the Configuration value for state's 'InstanceWebfingerAliasDomains' field
+func (st *ConfigState) SetInstanceWebfingerAliasDomains(v []string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AccountsMaxProfileFields = v
+	st.config.InstanceWebfingerAliasDomains = v
 	st.reloadToViper()
 }
 
-// GetAccountsMaxProfileFields safely fetches the value for global configuration 'AccountsMaxProfileFields' field
-func GetAccountsMaxProfileFields() int { return global.GetAccountsMaxProfileFields() }
+// GetInstanceWebfingerAliasDomains safely fetches the value for global configuration 'InstanceWebfingerAliasDomains' field
+func GetInstanceWebfingerAliasDomains() []string { return global.GetInstanceWebfingerAliasDomains() }
 
-// SetAccountsMaxProfileFields safely sets the value for global configuration 'AccountsMaxProfileFields' field
-func SetAccountsMaxProfileFields(v int) { global.SetAccountsMaxProfileFields(v) }
+// SetInstanceWebfingerAliasDomains safely sets the value for global configuration 'InstanceWebfingerAliasDomains' field
+func SetInstanceWebfingerAliasDomains(v []string) { global.SetInstanceWebfingerAliasDomains(v) }
 
-// GetStorageBackend safely fetches the Configuration value for state's 'StorageBackend' field
-func (st *ConfigState) GetStorageBackend() (v string) {
+// GetInstanceExposeSitemap safely fetches the Configuration value for state's 'InstanceExposeSitemap' field
+func (st *ConfigState) GetInstanceExposeSitemap() (v bool) {
 	st.mutex.RLock()
-	v = st.config.StorageBackend
+	v = st.config.InstanceExposeSitemap
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageBackend safely sets the Configuration value for state's 'StorageBackend' field
-func (st *ConfigState) SetStorageBackend(v string) {
+// SetInstanceExposeSitemap safely sets the Configuration value for state's 'InstanceExposeSitemap' field
+func (st *ConfigState) SetInstanceExposeSitemap(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageBackend = v
+	st.config.InstanceExposeSitemap = v
 	st.reloadToViper()
 }
 
-// GetStorageBackend safely fetches the value for global configuration 'StorageBackend' field
-func GetStorageBackend() string { return global.GetStorageBackend() }
+// GetInstanceExposeSitemap safely fetches the value for global configuration 'InstanceExposeSitemap' field
+func GetInstanceExposeSitemap() bool { return global.GetInstanceExposeSitemap() }
 
-// SetStorageBackend safely sets the value for global configuration 'StorageBackend' field
-func SetStorageBackend(v string) { global.SetStorageBackend(v) }
+// SetInstanceExposeSitemap safely sets the value for global configuration 'InstanceExposeSitemap' field
+func SetInstanceExposeSitemap(v bool) { global.SetInstanceExposeSitemap(v) }
 
-// GetStorageLocalBasePath safely fetches the Configuration value for state's 'StorageLocalBasePath' field
-func (st *ConfigState) GetStorageLocalBasePath() (v string) {
+// GetInstanceSitemapRegenerateEvery safely fetches the Configuration value for state's 'InstanceSitemapRegenerateEvery' field
+func (st *ConfigState) GetInstanceSitemapRegenerateEvery() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.StorageLocalBasePath
+	v = st.config.InstanceSitemapRegenerateEvery
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageLocalBasePath safely sets the Configuration value for state's 'StorageLocalBasePath' field
-func (st *ConfigState) SetStorageLocalBasePath(v string) {
+// SetInstanceSitemapRegenerateEvery safely sets the Configuration value for state's 'InstanceSitemapRegenerateEvery' field
+func (st *ConfigState) SetInstanceSitemapRegenerateEvery(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageLocalBasePath = v
+	st.config.InstanceSitemapRegenerateEvery = v
 	st.reloadToViper()
 }
 
-// GetStorageLocalBasePath safely fetches the value for global configuration 'StorageLocalBasePath' field
-func GetStorageLocalBasePath() string { return global.GetStorageLocalBasePath() }
+// GetInstanceSitemapRegenerateEvery safely fetches the value for global configuration 'InstanceSitemapRegenerateEvery' field
+func GetInstanceSitemapRegenerateEvery() time.Duration {
+	return global.GetInstanceSitemapRegenerateEvery()
+}
 
-// SetStorageLocalBasePath safely sets the value for global configuration 'StorageLocalBasePath' field
-func SetStorageLocalBasePath(v string) { global.SetStorageLocalBasePath(v) }
+// SetInstanceSitemapRegenerateEvery safely sets the value for global configuration 'InstanceSitemapRegenerateEvery' field
+func SetInstanceSitemapRegenerateEvery(v time.Duration) { global.SetInstanceSitemapRegenerateEvery(v) }
 
-// GetStorageS3Endpoint safely fetches the Configuration value for state's 'StorageS3Endpoint' field
-func (st *ConfigState) GetStorageS3Endpoint() (v string) {
+// GetAccountsRegistrationOpen safely fetches the Configuration value for state's 'AccountsRegistrationOpen' field
+func (st *ConfigState) GetAccountsRegistrationOpen() (v bool) {
 	st.mutex.RLock()
-	v = st.config.StorageS3Endpoint
+	v = st.config.AccountsRegistrationOpen
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageS3Endpoint safely sets the Configuration value for state's 'StorageS3Endpoint' field
-func (st *ConfigState) SetStorageS3Endpoint(v string) {
+// SetAccountsRegistrationOpen safely sets the Configuration value for state's 'AccountsRegistrationOpen' field
+func (st *ConfigState) SetAccountsRegistrationOpen(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3Endpoint = v
+	st.config.AccountsRegistrationOpen = v
 	st.reloadToViper()
 }
 
-// GetStorageS3Endpoint safely fetches the value for global configuration 'StorageS3Endpoint' field
-func GetStorageS3Endpoint() string { return global.GetStorageS3Endpoint() }
+// GetAccountsRegistrationOpen safely fetches the value for global configuration 'AccountsRegistrationOpen' field
+func GetAccountsRegistrationOpen() bool { return global.GetAccountsRegistrationOpen() }
 
-// SetStorageS3Endpoint safely sets the value for global configuration 'StorageS3Endpoint' field
-func SetStorageS3Endpoint(v string) { global.SetStorageS3Endpoint(v) }
+// SetAccountsRegistrationOpen safely sets the value for global configuration 'AccountsRegistrationOpen' field
+func SetAccountsRegistrationOpen(v bool) { global.SetAccountsRegistrationOpen(v) }
 
-// GetStorageS3AccessKey safely fetches the Configuration value for state's 'StorageS3AccessKey' field
-func (st *ConfigState) GetStorageS3AccessKey() (v string) {
+// GetAccountsReasonRequired safely fetches the Configuration value for state's 'AccountsReasonRequired' field
+func (st *ConfigState) GetAccountsReasonRequired() (v bool) {
 	st.mutex.RLock()
-	v = st.config.StorageS3AccessKey
+	v = st.config.AccountsReasonRequired
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageS3AccessKey safely sets the Configuration value for state's 'StorageS3AccessKey' field
-func (st *ConfigState) SetStorageS3AccessKey(v string) {
+// SetAccountsReasonRequired safely sets the Configuration value for state's 'AccountsReasonRequired' field
+func (st *ConfigState) SetAccountsReasonRequired(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3AccessKey = v
+	st.config.AccountsReasonRequired = v
 	st.reloadToViper()
 }
 
-// GetStorageS3AccessKey safely fetches the value for global configuration 'StorageS3AccessKey' field
-func GetStorageS3AccessKey() string { return global.GetStorageS3AccessKey() }
+// GetAccountsReasonRequired safely fetches the value for global configuration 'AccountsReasonRequired' field
+func GetAccountsReasonRequired() bool { return global.GetAccountsReasonRequired() }
 
-// SetStorageS3AccessKey safely sets the value for global configuration 'StorageS3AccessKey' field
-func SetStorageS3AccessKey(v string) { global.SetStorageS3AccessKey(v) }
+// SetAccountsReasonRequired safely sets the value for global configuration 'AccountsReasonRequired' field
+func SetAccountsReasonRequired(v bool) { global.SetAccountsReasonRequired(v) }
 
-// GetStorageS3SecretKey safely fetches the Configuration value for state's 'StorageS3SecretKey' field
-func (st *ConfigState) GetStorageS3SecretKey() (v string) {
+// GetAccountsRegistrationDailyLimit safely fetches the Configuration value for state's 'AccountsRegistrationDailyLimit' field
+func (st *ConfigState) GetAccountsRegistrationDailyLimit() (v int) {
 	st.mutex.RLock()
-	v = st.config.StorageS3SecretKey
+	v = st.config.AccountsRegistrationDailyLimit
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageS3SecretKey safely sets the Configuration value for state's 'StorageS3SecretKey' field
-func (st *ConfigState) SetStorageS3SecretKey(v string) {
+// SetAccountsRegistrationDailyLimit safely sets the Configuration value for state's 'AccountsRegistrationDailyLimit' field
+func (st *ConfigState) SetAccountsRegistrationDailyLimit(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3SecretKey = v
+	st.config.AccountsRegistrationDailyLimit = v
 	st.reloadToViper()
 }
 
-// GetStorageS3SecretKey safely fetches the value for global configuration 'StorageS3SecretKey' field
-func GetStorageS3SecretKey() string { return global.GetStorageS3SecretKey() }
+// GetAccountsRegistrationDailyLimit safely fetches the value for global configuration 'AccountsRegistrationDailyLimit' field
+func GetAccountsRegistrationDailyLimit() int { return global.GetAccountsRegistrationDailyLimit() }
 
-// SetStorageS3SecretKey safely sets the value for global configuration 'StorageS3SecretKey' field
-func SetStorageS3SecretKey(v string) { global.SetStorageS3SecretKey(v) }
+// SetAccountsRegistrationDailyLimit safely sets the value for global configuration 'AccountsRegistrationDailyLimit' field
+func SetAccountsRegistrationDailyLimit(v int) { global.SetAccountsRegistrationDailyLimit(v) }
 
-// GetStorageS3UseSSL safely fetches the Configuration value for state's 'StorageS3UseSSL' field
-func (st *ConfigState) GetStorageS3UseSSL() (v bool) {
+// GetAccountsRegistrationBacklogLimit safely fetches the Configuration value for state's 'AccountsRegistrationBacklogLimit' field
+func (st *ConfigState) GetAccountsRegistrationBacklogLimit() (v int) {
 	st.mutex.RLock()
-	v = st.config.StorageS3UseSSL
+	v = st.config.AccountsRegistrationBacklogLimit
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageS3UseSSL safely sets the Configuration value for state's 'StorageS3UseSSL' field
-func (st *ConfigState) SetStorageS3UseSSL(v bool) {
+// SetAccountsRegistrationBacklogLimit safely sets the Configuration value for state's 'AccountsRegistrationBacklogLimit' field
+func (st *ConfigState) SetAccountsRegistrationBacklogLimit(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3UseSSL = v
+	st.config.AccountsRegistrationBacklogLimit = v
 	st.reloadToViper()
 }
 
-// GetStorageS3UseSSL safely fetches the value for global configuration 'StorageS3UseSSL' field
-func GetStorageS3UseSSL() bool { return global.GetStorageS3UseSSL() }
+// GetAccountsRegistrationBacklogLimit safely fetches the value for global configuration 'AccountsRegistrationBacklogLimit' field
+func GetAccountsRegistrationBacklogLimit() int { return global.GetAccountsRegistrationBacklogLimit() }
 
-// SetStorageS3UseSSL safely sets the value for global configuration 'StorageS3UseSSL' field
-func SetStorageS3UseSSL(v bool) { global.SetStorageS3UseSSL(v) }
+// SetAccountsRegistrationBacklogLimit safely sets the value for global configuration 'AccountsRegistrationBacklogLimit' field
+func SetAccountsRegistrationBacklogLimit(v int) { global.SetAccountsRegistrationBacklogLimit(v) }
 
-// GetStorageS3BucketName safely fetches the Configuration value for state's 'StorageS3BucketName' field
-func (st *ConfigState) GetStorageS3BucketName() (v string) {
+// GetAccountsAllowUserInvites safely fetches the Configuration value for state's 'AccountsAllowUserInvites' field
+func (st *ConfigState) GetAccountsAllowUserInvites() (v bool) {
 	st.mutex.RLock()
-	v = st.config.StorageS3BucketName
+	v = st.config.AccountsAllowUserInvites
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageS3BucketName safely sets the Configuration value for state's 'StorageS3BucketName' field
-func (st *ConfigState) SetStorageS3BucketName(v string) {
+// SetAccountsAllowUserInvites safely sets the Configuration value for state's 'AccountsAllowUserInvites' field
+func (st *ConfigState) SetAccountsAllowUserInvites(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3BucketName = v
+	st.config.AccountsAllowUserInvites = v
 	st.reloadToViper()
 }
 
-// GetStorageS3BucketName safely fetches the value for global configuration 'StorageS3BucketName' field
-func GetStorageS3BucketName() string { return global.GetStorageS3BucketName() }
+// GetAccountsAllowUserInvites safely fetches the value for global configuration 'AccountsAllowUserInvites' field
+func GetAccountsAllowUserInvites() bool { return global.GetAccountsAllowUserInvites() }
 
-// SetStorageS3BucketName safely sets the value for global configuration 'StorageS3BucketName' field
-func SetStorageS3BucketName(v string) { global.SetStorageS3BucketName(v) }
+// SetAccountsAllowUserInvites safely sets the value for global configuration 'AccountsAllowUserInvites' field
+func SetAccountsAllowUserInvites(v bool) { global.SetAccountsAllowUserInvites(v) }
 
-// GetStorageS3Proxy safely fetches the Configuration value for state's 'StorageS3Proxy' field
-func (st *ConfigState) GetStorageS3Proxy() (v bool) {
+// GetAccountsCaptchaProvider safely fetches the Configuration value for state's 'AccountsCaptchaProvider' field
+func (st *ConfigState) GetAccountsCaptchaProvider() (v string) {
 	st.mutex.RLock()
-	v = st.config.StorageS3Proxy
+	v = st.config.AccountsCaptchaProvider
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageS3Proxy safely sets the Configuration value for state's 'StorageS3Proxy' field
-func (st *ConfigState) SetStorageS3Proxy(v bool) {
+// SetAccountsCaptchaProvider safely sets the Configuration value for state's 'AccountsCaptchaProvider' field
+func (st *ConfigState) SetAccountsCaptchaProvider(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3Proxy = v
+	st.config.AccountsCaptchaProvider = v
 	st.reloadToViper()
 }
 
-// GetStorageS3Proxy safely fetches the value for global configuration 'StorageS3Proxy' field
-func GetStorageS3Proxy() bool { return global.GetStorageS3Proxy() }
+// GetAccountsCaptchaProvider safely fetches the value for global configuration 'AccountsCaptchaProvider' field
+func GetAccountsCaptchaProvider() string { return global.GetAccountsCaptchaProvider() }
 
-// SetStorageS3Proxy safely sets the value for global configuration 'StorageS3Proxy' field
-func SetStorageS3Proxy(v bool) { global.SetStorageS3Proxy(v) }
+// SetAccountsCaptchaProvider safely sets the value for global configuration 'AccountsCaptchaProvider' field
+func SetAccountsCaptchaProvider(v string) { global.SetAccountsCaptchaProvider(v) }
 
-// GetStorageS3RedirectURL safely fetches the Configuration value for state's 'StorageS3RedirectURL' field
-func (st *ConfigState) GetStorageS3RedirectURL() (v string) {
+// GetAccountsCaptchaSiteKey safely fetches the Configuration value for state's 'AccountsCaptchaSiteKey' field
+func (st *ConfigState) GetAccountsCaptchaSiteKey() (v string) {
 	st.mutex.RLock()
-	v = st.config.StorageS3RedirectURL
+	v = st.config.AccountsCaptchaSiteKey
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageS3RedirectURL safely sets the Configuration value for state's 'StorageS3RedirectURL' field
-func (st *ConfigState) SetStorageS3RedirectURL(v string) {
+// SetAccountsCaptchaSiteKey safely sets the Configuration value for state's 'AccountsCaptchaSiteKey' field
+func (st *ConfigState) SetAccountsCaptchaSiteKey(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3RedirectURL = v
+	st.config.AccountsCaptchaSiteKey = v
 	st.reloadToViper()
 }
 
-// GetStorageS3RedirectURL safely fetches the value for global configuration 'StorageS3RedirectURL' field
-func GetStorageS3RedirectURL() string { return global.GetStorageS3RedirectURL() }
+// GetAccountsCaptchaSiteKey safely fetches the value for global configuration 'AccountsCaptchaSiteKey' field
+func GetAccountsCaptchaSiteKey() string { return global.GetAccountsCaptchaSiteKey() }
 
-// SetStorageS3RedirectURL safely sets the value for global configuration 'StorageS3RedirectURL' field
-func SetStorageS3RedirectURL(v string) { global.SetStorageS3RedirectURL(v) }
+// SetAccountsCaptchaSiteKey safely sets the value for global configuration 'AccountsCaptchaSiteKey' field
+func SetAccountsCaptchaSiteKey(v string) { global.SetAccountsCaptchaSiteKey(v) }
 
-// GetStorageS3BucketLookup safely fetches the Configuration value for state's 'StorageS3BucketLookup' field
-func (st *ConfigState) GetStorageS3BucketLookup() (v string) {
+// GetAccountsCaptchaSecretKey safely fetches the Configuration value for state's 'AccountsCaptchaSecretKey' field
+func (st *ConfigState) GetAccountsCaptchaSecretKey() (v string) {
 	st.mutex.RLock()
-	v = st.config.StorageS3BucketLookup
+	v = st.config.AccountsCaptchaSecretKey
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageS3BucketLookup safely sets the Configuration value for state's 'StorageS3BucketLookup' field
-func (st *ConfigState) SetStorageS3BucketLookup(v string) {
+// SetAccountsCaptchaSecretKey safely sets the Configuration value for state's 'AccountsCaptchaSecretKey' field
+func (st *ConfigState) SetAccountsCaptchaSecretKey(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3BucketLookup = v
+	st.config.AccountsCaptchaSecretKey = v
 	st.reloadToViper()
 }
 
-// GetStorageS3BucketLookup safely fetches the value for global configuration 'StorageS3BucketLookup' field
-func GetStorageS3BucketLookup() string { return global.GetStorageS3BucketLookup() }
+// GetAccountsCaptchaSecretKey safely fetches the value for global configuration 'AccountsCaptchaSecretKey' field
+func GetAccountsCaptchaSecretKey() string { return global.GetAccountsCaptchaSecretKey() }
 
-// SetStorageS3BucketLookup safely sets the value for global configuration 'StorageS3BucketLookup' field
-func SetStorageS3BucketLookup(v string) { global.SetStorageS3BucketLookup(v) }
+// SetAccountsCaptchaSecretKey safely sets the value for global configuration 'AccountsCaptchaSecretKey' field
+func SetAccountsCaptchaSecretKey(v string) { global.SetAccountsCaptchaSecretKey(v) }
 
-// GetStorageS3KeyPrefix safely fetches the Configuration value for state's 'StorageS3KeyPrefix' field
-func (st *ConfigState) GetStorageS3KeyPrefix() (v string) {
+// GetAccountsAllowCustomCSS safely fetches the Configuration value for state's 'AccountsAllowCustomCSS' field
+func (st *ConfigState) GetAccountsAllowCustomCSS() (v bool) {
 	st.mutex.RLock()
-	v = st.config.StorageS3KeyPrefix
+	v = st.config.AccountsAllowCustomCSS
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageS3KeyPrefix safely sets the Configuration value for state's 'StorageS3KeyPrefix' field
-func (st *ConfigState) SetStorageS3KeyPrefix(v string) {
+// SetAccountsAllowCustomCSS safely sets the Configuration value for state's 'AccountsAllowCustomCSS' field
+func (st *ConfigState) SetAccountsAllowCustomCSS(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3KeyPrefix = v
+	st.config.AccountsAllowCustomCSS = v
 	st.reloadToViper()
 }
 
-// GetStorageS3KeyPrefix safely fetches the value for global configuration 'StorageS3KeyPrefix' field
-func GetStorageS3KeyPrefix() string { return global.GetStorageS3KeyPrefix() }
+// GetAccountsAllowCustomCSS safely fetches the value for global configuration 'AccountsAllowCustomCSS' field
+func GetAccountsAllowCustomCSS() bool { return global.GetAccountsAllowCustomCSS() }
 
-// SetStorageS3KeyPrefix safely sets the value for global configuration 'StorageS3KeyPrefix' field
-func SetStorageS3KeyPrefix(v string) { global.SetStorageS3KeyPrefix(v) }
+// SetAccountsAllowCustomCSS safely sets the value for global configuration 'AccountsAllowCustomCSS' field
+func SetAccountsAllowCustomCSS(v bool) { global.SetAccountsAllowCustomCSS(v) }
 
-// GetStatusesMaxChars safely fetches the Configuration value for state's 'StatusesMaxChars' field
-func (st *ConfigState) GetStatusesMaxChars() (v int) {
+// GetAccountsCustomCSSLength safely fetches the Configuration value for state's 'AccountsCustomCSSLength' field
+func (st *ConfigState) GetAccountsCustomCSSLength() (v int) {
 	st.mutex.RLock()
-	v = st.config.StatusesMaxChars
+	v = st.config.AccountsCustomCSSLength
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStatusesMaxChars safely sets the Configuration value for state's 'StatusesMaxChars' field
-func (st *ConfigState) SetStatusesMaxChars(v int) {
+// SetAccountsCustomCSSLength safely sets the Configuration value for state's 'AccountsCustomCSSLength' field
+func (st *ConfigState) SetAccountsCustomCSSLength(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StatusesMaxChars = v
+	st.config.AccountsCustomCSSLength = v
 	st.reloadToViper()
 }
 
-// GetStatusesMaxChars safely fetches the value for global configuration 'StatusesMaxChars' field
-func GetStatusesMaxChars() int { return global.GetStatusesMaxChars() }
+// GetAccountsCustomCSSLength safely fetches the value for global configuration 'AccountsCustomCSSLength' field
+func GetAccountsCustomCSSLength() int { return global.GetAccountsCustomCSSLength() }
 
-// SetStatusesMaxChars safely sets the value for global configuration 'StatusesMaxChars' field
-func SetStatusesMaxChars(v int) { global.SetStatusesMaxChars(v) }
+// SetAccountsCustomCSSLength safely sets the value for global configuration 'AccountsCustomCSSLength' field
+func SetAccountsCustomCSSLength(v int) { global.SetAccountsCustomCSSLength(v) }
 
-// GetStatusesPollMaxOptions safely fetches the Configuration value for state's 'StatusesPollMaxOptions' field
-func (st *ConfigState) GetStatusesPollMaxOptions() (v int) {
+// GetAccountsCustomCSSStrict safely fetches the Configuration value for state's 'AccountsCustomCSSStrict' field
+func (st *ConfigState) GetAccountsCustomCSSStrict() (v bool) {
 	st.mutex.RLock()
-	v = st.config.StatusesPollMaxOptions
+	v = st.config.AccountsCustomCSSStrict
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStatusesPollMaxOptions safely sets the Configuration value for state's 'StatusesPollMaxOptions' field
-func (st *ConfigState) SetStatusesPollMaxOptions(v int) {
+// SetAccountsCustomCSSStrict safely sets the Configuration value for state's 'AccountsCustomCSSStrict' field
+func (st *ConfigState) SetAccountsCustomCSSStrict(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StatusesPollMaxOptions = v
+	st.config.AccountsCustomCSSStrict = v
 	st.reloadToViper()
 }
 
-// GetStatusesPollMaxOptions safely fetches the value for global configuration 'StatusesPollMaxOptions' field
-func GetStatusesPollMaxOptions() int { return global.GetStatusesPollMaxOptions() }
+// GetAccountsCustomCSSStrict safely fetches the value for global configuration 'AccountsCustomCSSStrict' field
+func GetAccountsCustomCSSStrict() bool { return global.GetAccountsCustomCSSStrict() }
 
-// SetStatusesPollMaxOptions safely sets the value for global configuration 'StatusesPollMaxOptions' field
-func SetStatusesPollMaxOptions(v int) { global.SetStatusesPollMaxOptions(v) }
+// SetAccountsCustomCSSStrict safely sets the value for global configuration 'AccountsCustomCSSStrict' field
+func SetAccountsCustomCSSStrict(v bool) { global.SetAccountsCustomCSSStrict(v) }
 
-// GetStatusesPollOptionMaxChars safely fetches the Configuration value for state's 'StatusesPollOptionMaxChars' field
-func (st *ConfigState) GetStatusesPollOptionMaxChars() (v int) {
+// GetAccountsCustomCSSAllowedProperties safely fetches the Configuration value for state's 'AccountsCustomCSSAllowedProperties' field
+func (st *ConfigState) GetAccountsCustomCSSAllowedProperties() (v []string) {
 	st.mutex.RLock()
-	v = st.config.StatusesPollOptionMaxChars
+	v = st.config.AccountsCustomCSSAllowedProperties
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStatusesPollOptionMaxChars safely sets the Configuration value for state's 'StatusesPollOptionMaxChars' field
-func (st *ConfigState) SetStatusesPollOptionMaxChars(v int) {
+// SetAccountsCustomCSSAllowedProperties safely sets the Configuration value for state's 'AccountsCustomCSSAllowedProperties' field
+func (st *ConfigState) SetAccountsCustomCSSAllowedProperties(v []string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StatusesPollOptionMaxChars = v
+	st.config.AccountsCustomCSSAllowedProperties = v
 	st.reloadToViper()
 }
 
-// GetStatusesPollOptionMaxChars safely fetches the value for global configuration 'StatusesPollOptionMaxChars' field
-func GetStatusesPollOptionMaxChars() int { return global.GetStatusesPollOptionMaxChars() }
+// GetAccountsCustomCSSAllowedProperties safely fetches the value for global configuration 'AccountsCustomCSSAllowedProperties' field
+func GetAccountsCustomCSSAllowedProperties() []string {
+	return global.GetAccountsCustomCSSAllowedProperties()
+}
 
-// SetStatusesPollOptionMaxChars safely sets the value for global configuration 'StatusesPollOptionMaxChars' field
-func SetStatusesPollOptionMaxChars(v int) { global.SetStatusesPollOptionMaxChars(v) }
+// SetAccountsCustomCSSAllowedProperties safely sets the value for global configuration 'AccountsCustomCSSAllowedProperties' field
+func SetAccountsCustomCSSAllowedProperties(v []string) {
+	global.SetAccountsCustomCSSAllowedProperties(v)
+}
 
-// GetStatusesMediaMaxFiles safely fetches the Configuration value for state's 'StatusesMediaMaxFiles' field
-func (st *ConfigState) GetStatusesMediaMaxFiles() (v int) {
+// GetAccountsMaxProfileFields safely fetches the Configuration value for state's 'AccountsMaxProfileFields' field
+func (st *ConfigState) GetAccountsMaxProfileFields() (v int) {
 	st.mutex.RLock()
-	v = st.config.StatusesMediaMaxFiles
+	v = st.config.AccountsMaxProfileFields
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStatusesMediaMaxFiles safely sets the Configuration value for state's 'StatusesMediaMaxFiles' field
-func (st *ConfigState) SetStatusesMediaMaxFiles(v int) {
+// SetAccountsMaxProfileFields safely sets the Configuration value for state's 'AccountsMaxProfileFields' field
+func (st *ConfigState) SetAccountsMaxProfileFields(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StatusesMediaMaxFiles = v
+	st.config.AccountsMaxProfileFields = v
 	st.reloadToViper()
 }
 
-// GetStatusesMediaMaxFiles safely fetches the value for global configuration 'StatusesMediaMaxFiles' field
-func GetStatusesMediaMaxFiles() int { return global.GetStatusesMediaMaxFiles() }
+// GetAccountsMaxProfileFields safely fetches the value for global configuration 'AccountsMaxProfileFields' field
+func GetAccountsMaxProfileFields() int { return global.GetAccountsMaxProfileFields() }
 
-// SetStatusesMediaMaxFiles safely sets the value for global configuration 'StatusesMediaMaxFiles' field
-func SetStatusesMediaMaxFiles(v int) { global.SetStatusesMediaMaxFiles(v) }
+// SetAccountsMaxProfileFields safely sets the value for global configuration 'AccountsMaxProfileFields' field
+func SetAccountsMaxProfileFields(v int) { global.SetAccountsMaxProfileFields(v) }
 
-// GetScheduledStatusesMaxTotal safely fetches the Configuration value for state's 'ScheduledStatusesMaxTotal' field
-func (st *ConfigState) GetScheduledStatusesMaxTotal() (v int) {
+// GetAccountsMaxServiceAccounts safely fetches the Configuration value for state's 'AccountsMaxServiceAccounts' field
+func (st *ConfigState) GetAccountsMaxServiceAccounts() (v int) {
 	st.mutex.RLock()
-	v = st.config.ScheduledStatusesMaxTotal
+	v = st.config.AccountsMaxServiceAccounts
 	st.mutex.RUnlock()
 	return
 }
 
-// SetScheduledStatusesMaxTotal safely sets the Configuration value for state's 'ScheduledStatusesMaxTotal' field
-func (st *ConfigState) SetScheduledStatusesMaxTotal(v int) {
+// SetAccountsMaxServiceAccounts safely sets the Configuration value for state's 'AccountsMaxServiceAccounts' field
+func (st *ConfigState) SetAccountsMaxServiceAccounts(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.ScheduledStatusesMaxTotal = v
+	st.config.AccountsMaxServiceAccounts = v
 	st.reloadToViper()
 }
 
-// GetScheduledStatusesMaxTotal safely fetches the value for global configuration 'ScheduledStatusesMaxTotal' field
-func GetScheduledStatusesMaxTotal() int { return global.GetScheduledStatusesMaxTotal() }
+// GetAccountsMaxServiceAccounts safely fetches the value for global configuration 'AccountsMaxServiceAccounts' field
+func GetAccountsMaxServiceAccounts() int { return global.GetAccountsMaxServiceAccounts() }
 
-// SetScheduledStatusesMaxTotal safely sets the value for global configuration 'ScheduledStatusesMaxTotal' field
-func SetScheduledStatusesMaxTotal(v int) { global.SetScheduledStatusesMaxTotal(v) }
+// SetAccountsMaxServiceAccounts safely sets the value for global configuration 'AccountsMaxServiceAccounts' field
+func SetAccountsMaxServiceAccounts(v int) { global.SetAccountsMaxServiceAccounts(v) }
 
-// GetScheduledStatusesMaxDaily safely fetches the Configuration value for state's 'ScheduledStatusesMaxDaily' field
-func (st *ConfigState) GetScheduledStatusesMaxDaily() (v int) {
+// GetAccountsRateLimitStatuses safely fetches the Configuration value for state's 'AccountsRateLimitStatuses' field
+func (st *ConfigState) GetAccountsRateLimitStatuses() (v int) {
 	st.mutex.RLock()
-	v = st.config.ScheduledStatusesMaxDaily
+	v = st.config.AccountsRateLimitStatuses
 	st.mutex.RUnlock()
 	return
 }
 
-// SetScheduledStatusesMaxDaily safely sets the Configuration value for state's 'ScheduledStatusesMaxDaily' field
-func (st *ConfigState) SetScheduledStatusesMaxDaily(v int) {
+// SetAccountsRateLimitStatuses safely sets the Configuration value for state's 'AccountsRateLimitStatuses' field
+func (st *ConfigState) SetAccountsRateLimitStatuses(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.ScheduledStatusesMaxDaily = v
+	st.config.AccountsRateLimitStatuses = v
 	st.reloadToViper()
 }
 
-// GetScheduledStatusesMaxDaily safely fetches the value for global configuration 'ScheduledStatusesMaxDaily' field
-func GetScheduledStatusesMaxDaily() int { return global.GetScheduledStatusesMaxDaily() }
+// GetAccountsRateLimitStatuses safely fetches the value for global configuration 'AccountsRateLimitStatuses' field
+func GetAccountsRateLimitStatuses() int { return global.GetAccountsRateLimitStatuses() }
 
-// SetScheduledStatusesMaxDaily safely sets the value for global configuration 'ScheduledStatusesMaxDaily' field
-func SetScheduledStatusesMaxDaily(v int) { global.SetScheduledStatusesMaxDaily(v) }
+// SetAccountsRateLimitStatuses safely sets the value for global configuration 'AccountsRateLimitStatuses' field
+func SetAccountsRateLimitStatuses(v int) { global.SetAccountsRateLimitStatuses(v) }
 
-// GetLetsEncryptEnabled safely fetches the Configuration value for state's 'LetsEncryptEnabled' field
-func (st *ConfigState) GetLetsEncryptEnabled() (v bool) {
+// GetAccountsRateLimitFollows safely fetches the Configuration value for state's 'AccountsRateLimitFollows' field
+func (st *ConfigState) GetAccountsRateLimitFollows() (v int) {
 	st.mutex.RLock()
-	v = st.config.LetsEncryptEnabled
+	v = st.config.AccountsRateLimitFollows
 	st.mutex.RUnlock()
 	return
 }
 
-// SetLetsEncryptEnabled safely sets the Configuration value for state's 'LetsEncryptEnabled' field
-func (st *ConfigState) SetLetsEncryptEnabled(v bool) {
+// SetAccountsRateLimitFollows safely sets the Configuration value for state's 'AccountsRateLimitFollows' field
+func (st *ConfigState) SetAccountsRateLimitFollows(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.LetsEncryptEnabled = v
+	st.config.AccountsRateLimitFollows = v
 	st.reloadToViper()
 }
 
-// GetLetsEncryptEnabled safely fetches the value for global configuration 'LetsEncryptEnabled' field
-func GetLetsEncryptEnabled() bool { return global.GetLetsEncryptEnabled() }
+// GetAccountsRateLimitFollows safely fetches the value for global configuration 'AccountsRateLimitFollows' field
+func GetAccountsRateLimitFollows() int { return global.GetAccountsRateLimitFollows() }
 
-// SetLetsEncryptEnabled safely sets the value for global configuration 'LetsEncryptEnabled' field
-func SetLetsEncryptEnabled(v bool) { global.SetLetsEncryptEnabled(v) }
+// SetAccountsRateLimitFollows safely sets the value for global configuration 'AccountsRateLimitFollows' field
+func SetAccountsRateLimitFollows(v int) { global.SetAccountsRateLimitFollows(v) }
 
-// GetLetsEncryptPort safely fetches the Configuration value for state's 'LetsEncryptPort' field
-func (st *ConfigState) GetLetsEncryptPort() (v int) {
+// GetAccountsRateLimitReports safely fetches the Configuration value for state's 'AccountsRateLimitReports' field
+func (st *ConfigState) GetAccountsRateLimitReports() (v int) {
 	st.mutex.RLock()
-	v = st.config.LetsEncryptPort
+	v = st.config.AccountsRateLimitReports
 	st.mutex.RUnlock()
 	return
 }
 
-// SetLetsEncryptPort safely sets the Configuration value for state's 'LetsEncryptPort' field
-func (st *ConfigState) SetLetsEncryptPort(v int) {
+// SetAccountsRateLimitReports safely sets the Configuration value for state's 'AccountsRateLimitReports' field
+func (st *ConfigState) SetAccountsRateLimitReports(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.LetsEncryptPort = v
+	st.config.AccountsRateLimitReports = v
 	st.reloadToViper()
 }
 
-// GetLetsEncryptPort safely fetches the value for global configuration 'LetsEncryptPort' field
-func GetLetsEncryptPort() int { return global.GetLetsEncryptPort() }
+// GetAccountsRateLimitReports safely fetches the value for global configuration 'AccountsRateLimitReports' field
+func GetAccountsRateLimitReports() int { return global.GetAccountsRateLimitReports() }
 
-// SetLetsEncryptPort safely sets the value for global configuration 'LetsEncryptPort' field
-func SetLetsEncryptPort(v int) { global.SetLetsEncryptPort(v) }
+// SetAccountsRateLimitReports safely sets the value for global configuration 'AccountsRateLimitReports' field
+func SetAccountsRateLimitReports(v int) { global.SetAccountsRateLimitReports(v) }
 
-// GetLetsEncryptCertDir safely fetches the Configuration value for state's 'LetsEncryptCertDir' field
-func (st *ConfigState) GetLetsEncryptCertDir() (v string) {
+// GetAccountsRateLimitModeratorMultiplier safely fetches the Configuration value for state's 'AccountsRateLimitModeratorMultiplier' field
+func (st *ConfigState) GetAccountsRateLimitModeratorMultiplier() (v int) {
 	st.mutex.RLock()
-	v = st.config.LetsEncryptCertDir
+	v = st.config.AccountsRateLimitModeratorMultiplier
 	st.mutex.RUnlock()
 	return
 }
 
-// SetLetsEncryptCertDir safely sets the Configuration value for state's 'LetsEncryptCertDir' field
-func (st *ConfigState) SetLetsEncryptCertDir(v string) {
+// SetAccountsRateLimitModeratorMultiplier safely sets the Configuration value for state's 'AccountsRateLimitModeratorMultiplier' field
+func (st *ConfigState) SetAccountsRateLimitModeratorMultiplier(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.LetsEncryptCertDir = v
+	st.config.AccountsRateLimitModeratorMultiplier = v
 	st.reloadToViper()
 }
 
-// GetLetsEncryptCertDir safely fetches the value for global configuration 'LetsEncryptCertDir' field
-func GetLetsEncryptCertDir() string { return global.GetLetsEncryptCertDir() }
+// GetAccountsRateLimitModeratorMultiplier safely fetches the value for global configuration 'AccountsRateLimitModeratorMultiplier' field
+func GetAccountsRateLimitModeratorMultiplier() int {
+	return global.GetAccountsRateLimitModeratorMultiplier()
+}
 
-// SetLetsEncryptCertDir safely sets the value for global configuration 'LetsEncryptCertDir' field
-func SetLetsEncryptCertDir(v string) { global.SetLetsEncryptCertDir(v) }
+// SetAccountsRateLimitModeratorMultiplier safely sets the value for global configuration 'AccountsRateLimitModeratorMultiplier' field
+func SetAccountsRateLimitModeratorMultiplier(v int) {
+	global.SetAccountsRateLimitModeratorMultiplier(v)
+}
 
-// GetLetsEncryptEmailAddress safely fetches the Configuration value for state's 'LetsEncryptEmailAddress' field
-func (st *ConfigState) GetLetsEncryptEmailAddress() (v string) {
+// GetAccountsRateLimitAdminMultiplier safely fetches the Configuration value for state's 'AccountsRateLimitAdminMultiplier' field
+func (st *ConfigState) GetAccountsRateLimitAdminMultiplier() (v int) {
 	st.mutex.RLock()
-	v = st.config.LetsEncryptEmailAddress
+	v = st.config.AccountsRateLimitAdminMultiplier
 	st.mutex.RUnlock()
 	return
 }
 
-// SetLetsEncryptEmailAddress safely sets the Configuration value for state's 'LetsEncryptEmailAddress' field
-func (st *ConfigState) SetLetsEncryptEmailAddress(v string) {
+// SetAccountsRateLimitAdminMultiplier safely sets the Configuration value for state's 'AccountsRateLimitAdminMultiplier' field
+func (st *ConfigState) SetAccountsRateLimitAdminMultiplier(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.LetsEncryptEmailAddress = v
+	st.config.AccountsRateLimitAdminMultiplier = v
 	st.reloadToViper()
 }
 
-// GetLetsEncryptEmailAddress safely fetches the value for global configuration 'LetsEncryptEmailAddress' field
-func GetLetsEncryptEmailAddress() string { return global.GetLetsEncryptEmailAddress() }
+// GetAccountsRateLimitAdminMultiplier safely fetches the value for global configuration 'AccountsRateLimitAdminMultiplier' field
+func GetAccountsRateLimitAdminMultiplier() int { return global.GetAccountsRateLimitAdminMultiplier() }
 
-// SetLetsEncryptEmailAddress safely sets the value for global configuration 'LetsEncryptEmailAddress' field
-func SetLetsEncryptEmailAddress(v string) { global.SetLetsEncryptEmailAddress(v) }
+// SetAccountsRateLimitAdminMultiplier safely sets the value for global configuration 'AccountsRateLimitAdminMultiplier' field
+func SetAccountsRateLimitAdminMultiplier(v int) { global.SetAccountsRateLimitAdminMultiplier(v) }
 
-// GetTLSCertificateChain safely fetches the Configuration value for state's 'TLSCertificateChain' field
-func (st *ConfigState) GetTLSCertificateChain() (v string) {
+// GetStorageBackend safely fetches the Configuration value for state's 'StorageBackend' field
+func (st *ConfigState) GetStorageBackend() (v string) {
 	st.mutex.RLock()
-	v = st.config.TLSCertificateChain
+	v = st.config.StorageBackend
 	st.mutex.RUnlock()
 	return
 }
 
-// SetTLSCertificateChain safely sets the Configuration value for state's 'TLSCertificateChain' field
-func (st *ConfigState) SetTLSCertificateChain(v string) {
+// SetStorageBackend safely sets the Configuration value for state's 'StorageBackend' field
+func (st *ConfigState) SetStorageBackend(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.TLSCertificateChain = v
+	st.config.StorageBackend = v
 	st.reloadToViper()
 }
 
-// GetTLSCertificateChain safely fetches the value for global configuration 'TLSCertificateChain' field
-func GetTLSCertificateChain() string { return global.GetTLSCertificateChain() }
+// GetStorageBackend safely fetches the value for global configuration 'StorageBackend' field
+func GetStorageBackend() string { return global.GetStorageBackend() }
 
-// SetTLSCertificateChain safely sets the value for global configuration 'TLSCertificateChain' field
-func SetTLSCertificateChain(v string) { global.SetTLSCertificateChain(v) }
+// SetStorageBackend safely sets the value for global configuration 'StorageBackend' field
+func SetStorageBackend(v string) { global.SetStorageBackend(v) }
 
-// GetTLSCertificateKey safely fetches the Configuration value for state's 'TLSCertificateKey' field
-func (st *ConfigState) GetTLSCertificateKey() (v string) {
+// GetStorageLocalBasePath safely fetches the Configuration value for state's 'StorageLocalBasePath' field
+func (st *ConfigState) GetStorageLocalBasePath() (v string) {
 	st.mutex.RLock()
-	v = st.config.TLSCertificateKey
+	v = st.config.StorageLocalBasePath
 	st.mutex.RUnlock()
 	return
 }
 
-// SetTLSCertificateKey safely sets the Configuration value for state's 'TLSCertificateKey' field
-func (st *ConfigState) SetTLSCertificateKey(v string) {
+// SetStorageLocalBasePath safely sets the Configuration value for state's 'StorageLocalBasePath' field
+func (st *ConfigState) SetStorageLocalBasePath(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.TLSCertificateKey = v
+	st.config.StorageLocalBasePath = v
 	st.reloadToViper()
 }
 
-// GetTLSCertificateKey safely fetches the value for global configuration 'TLSCertificateKey' field
-func GetTLSCertificateKey() string { return global.GetTLSCertificateKey() }
+// GetStorageLocalBasePath safely fetches the value for global configuration 'StorageLocalBasePath' field
+func GetStorageLocalBasePath() string { return global.GetStorageLocalBasePath() }
 
-// SetTLSCertificateKey safely sets the value for global configuration 'TLSCertificateKey' field
-func SetTLSCertificateKey(v string) { global.SetTLSCertificateKey(v) }
+// SetStorageLocalBasePath safely sets the value for global configuration 'StorageLocalBasePath' field
+func SetStorageLocalBasePath(v string) { global.SetStorageLocalBasePath(v) }
 
-// GetOIDCEnabled safely fetches the Configuration value for state's 'OIDCEnabled' field
-func (st *ConfigState) GetOIDCEnabled() (v bool) {
+// GetStorageLocalChecksums safely fetches the Configuration value for state's 'StorageLocalChecksums' field
+func (st *ConfigState) GetStorageLocalChecksums() (v bool) {
 	st.mutex.RLock()
-	v = st.config.OIDCEnabled
+	v = st.config.StorageLocalChecksums
 	st.mutex.RUnlock()
 	return
 }
 
-// SetOIDCEnabled safely sets the Configuration value for state's 'OIDCEnabled' field
-func (st *ConfigState) SetOIDCEnabled(v bool) {
+// SetStorageLocalChecksums safely sets the Configuration value for state's 'StorageLocalChecksums' field
+func (st *ConfigState) SetStorageLocalChecksums(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCEnabled = v
+	st.config.StorageLocalChecksums = v
 	st.reloadToViper()
 }
 
-// GetOIDCEnabled safely fetches the value for global configuration 'OIDCEnabled' field
-func GetOIDCEnabled() bool { return global.GetOIDCEnabled() }
+// GetStorageLocalChecksums safely fetches the value for global configuration 'StorageLocalChecksums' field
+func GetStorageLocalChecksums() bool { return global.GetStorageLocalChecksums() }
 
-// SetOIDCEnabled safely sets the value for global configuration 'OIDCEnabled' field
-func SetOIDCEnabled(v bool) { global.SetOIDCEnabled(v) }
+// SetStorageLocalChecksums safely sets the value for global configuration 'StorageLocalChecksums' field
+func SetStorageLocalChecksums(v bool) { global.SetStorageLocalChecksums(v) }
 
-// GetOIDCIdpName safely fetches the Configuration value for state's 'OIDCIdpName' field
-func (st *ConfigState) GetOIDCIdpName() (v string) {
+// GetStorageS3Endpoint safely fetches the Configuration value for state's 'StorageS3Endpoint' field
+func (st *ConfigState) GetStorageS3Endpoint() (v string) {
 	st.mutex.RLock()
-	v = st.config.OIDCIdpName
+	v = st.config.StorageS3Endpoint
 	st.mutex.RUnlock()
 	return
 }
 
-// SetOIDCIdpName safely sets the Configuration value for state's 'OIDCIdpName' field
-func (st *ConfigState) SetOIDCIdpName(v string) {
+// SetStorageS3Endpoint safely sets the Configuration value for state's 'StorageS3Endpoint' field
+func (st *ConfigState) SetStorageS3Endpoint(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCIdpName = v
+	st.config.StorageS3Endpoint = v
 	st.reloadToViper()
 }
 
-// GetOIDCIdpName safely fetches the value for global configuration 'OIDCIdpName' field
-func GetOIDCIdpName() string { return global.GetOIDCIdpName() }
+// GetStorageS3Endpoint safely fetches the value for global configuration 'StorageS3Endpoint' field
+func GetStorageS3Endpoint() string { return global.GetStorageS3Endpoint() }
 
-// SetOIDCIdpName safely sets the value for global configuration 'OIDCIdpName' field
-func SetOIDCIdpName(v string) { global.SetOIDCIdpName(v) }
+// SetStorageS3Endpoint safely sets the value for global configuration 'StorageS3Endpoint' field
+func SetStorageS3Endpoint(v string) { global.SetStorageS3Endpoint(v) }
 
-// GetOIDCSkipVerification safely fetches the Configuration value for state's 'OIDCSkipVerification' field
-func (st *ConfigState) GetOIDCSkipVerification() (v bool) {
+// GetStorageS3AccessKey safely fetches the Configuration value for state's 'StorageS3AccessKey' field
+func (st *ConfigState) GetStorageS3AccessKey() (v string) {
 	st.mutex.RLock()
-	v = st.config.OIDCSkipVerification
+	v = st.config.StorageS3AccessKey
 	st.mutex.RUnlock()
 	return
 }
 
-// SetOIDCSkipVerification safely sets the Configuration value for state's 'OIDCSkipVerification' field
-func (st *ConfigState) SetOIDCSkipVerification(v bool) {
+// SetStorageS3AccessKey safely sets the Configuration value for state's 'StorageS3AccessKey' field
+func (st *ConfigState) SetStorageS3AccessKey(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCSkipVerification = v
+	st.config.StorageS3AccessKey = v
 	st.reloadToViper()
 }
 
-// GetOIDCSkipVerification safely fetches the value for global configuration 'OIDCSkipVerification' field
-func GetOIDCSkipVerification() bool { return global.GetOIDCSkipVerification() }
+// GetStorageS3AccessKey safely fetches the value for global configuration 'StorageS3AccessKey' field
+func GetStorageS3AccessKey() string { return global.GetStorageS3AccessKey() }
 
-// SetOIDCSkipVerification safely sets the value for global configuration 'OIDCSkipVerification' field
-func SetOIDCSkipVerification(v bool) { global.SetOIDCSkipVerification(v) }
+// SetStorageS3AccessKey safely sets the value for global configuration 'StorageS3AccessKey' field
+func SetStorageS3AccessKey(v string) { global.SetStorageS3AccessKey(v) }
 
-// GetOIDCIssuer safely fetches the Configuration value for state's 'OIDCIssuer' field
-func (st *ConfigState) GetOIDCIssuer() (v string) {
+// GetStorageS3SecretKey safely fetches the Configuration value for state's 'StorageS3SecretKey' field
+func (st *ConfigState) GetStorageS3SecretKey() (v string) {
 	st.mutex.RLock()
-	v = st.config.OIDCIssuer
+	v = st.config.StorageS3SecretKey
 	st.mutex.RUnlock()
 	return
 }
 
-// SetOIDCIssuer safely sets the Configuration value for state's 'OIDCIssuer' field
-func (st *ConfigState) SetOIDCIssuer(v string) {
+// SetStorageS3SecretKey safely sets the Configuration value for state's 'StorageS3SecretKey' field
+func (st *ConfigState) SetStorageS3SecretKey(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCIssuer = v
+	st.config.StorageS3SecretKey = v
 	st.reloadToViper()
 }
 
-// GetOIDCIssuer safely fetches the value for global configuration 'OIDCIssuer' field
-func GetOIDCIssuer() string { return global.GetOIDCIssuer() }
+// GetStorageS3SecretKey safely fetches the value for global configuration 'StorageS3SecretKey' field
+func GetStorageS3SecretKey() string { return global.GetStorageS3SecretKey() }
 
-// SetOIDCIssuer safely sets the value for global configuration 'OIDCIssuer' field
-func SetOIDCIssuer(v string) { global.SetOIDCIssuer(v) }
+// SetStorageS3SecretKey safely sets the value for global configuration 'StorageS3SecretKey' field
+func SetStorageS3SecretKey(v string) { global.SetStorageS3SecretKey(v) }
 
-// GetOIDCClientID safely fetches the Configuration value for state's 'OIDCClientID' field
-func (st *ConfigState) GetOIDCClientID() (v string) {
+// GetStorageS3UseSSL safely fetches the Configuration value for state's 'StorageS3UseSSL' field
+func (st *ConfigState) GetStorageS3UseSSL() (v bool) {
 	st.mutex.RLock()
-	v = st.config.OIDCClientID
+	v = st.config.StorageS3UseSSL
 	st.mutex.RUnlock()
 	return
 }
 
-// SetOIDCClientID safely sets the Configuration value for state's 'OIDCClientID' field
-func (st *ConfigState) SetOIDCClientID(v string) {
+// SetStorageS3UseSSL safely sets the Configuration value for state's 'StorageS3UseSSL' field
+func (st *ConfigState) SetStorageS3UseSSL(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCClientID = v
+	st.config.StorageS3UseSSL = v
 	st.reloadToViper()
 }
 
-// GetOIDCClientID safely fetches the value for global configuration 'OIDCClientID' field
-func GetOIDCClientID() string { return global.GetOIDCClientID() }
+// GetStorageS3UseSSL safely fetches the value for global configuration 'StorageS3UseSSL' field
+func GetStorageS3UseSSL() bool { return global.GetStorageS3UseSSL() }
 
-// SetOIDCClientID safely sets the value for global configuration 'OIDCClientID' field
-func SetOIDCClientID(v string) { global.SetOIDCClientID(v) }
+// SetStorageS3UseSSL safely sets the value for global configuration 'StorageS3UseSSL' field
+func SetStorageS3UseSSL(v bool) { global.SetStorageS3UseSSL(v) }
 
-// GetOIDCClientSecret safely fetches the Configuration value for state's 'OIDCClientSecret' field
-func (st *ConfigState) GetOIDCClientSecret() (v string) {
+// GetStorageS3BucketName safely fetches the Configuration value for state's 'StorageS3BucketName' field
+func (st *ConfigState) GetStorageS3BucketName() (v string) {
 	st.mutex.RLock()
-	v = st.config.OIDCClientSecret
+	v = st.config.StorageS3BucketName
 	st.mutex.RUnlock()
 	return
 }
 
-// SetOIDCClientSecret safely sets the Configuration value for state's 'OIDCClientSecret' field
-func (st *ConfigState) SetOIDCClientSecret(v string) {
+// SetStorageS3BucketName safely sets the Configuration value for state's 'StorageS3BucketName' field
+func (st *ConfigState) SetStorageS3BucketName(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCClientSecret = v
+	st.config.StorageS3BucketName = v
 	st.reloadToViper()
 }
 
-// GetOIDCClientSecret safely fetches the value for global configuration 'OIDCClientSecret' field
-func GetOIDCClientSecret() string { return global.GetOIDCClientSecret() }
+// GetStorageS3BucketName safely fetches the value for global configuration 'StorageS3BucketName' field
+func GetStorageS3BucketName() string { return global.GetStorageS3BucketName() }
 
-// SetOIDCClientSecret safely sets the value for global configuration 'OIDCClientSecret' field
-func SetOIDCClientSecret(v string) { global.SetOIDCClientSecret(v) }
+// SetStorageS3BucketName safely sets the value for global configuration 'StorageS3BucketName' field
+func SetStorageS3BucketName(v string) { global.SetStorageS3BucketName(v) }
 
-// GetOIDCScopes safely fetches the Configuration value for state's 'OIDCScopes' field
-func (st *ConfigState) GetOIDCScopes() (v []string) {
+// GetStorageS3Proxy safely fetches the Configuration value for state's 'StorageS3Proxy' field
+func (st *ConfigState) GetStorageS3Proxy() (v bool) {
 	st.mutex.RLock()
-	v = st.config.OIDCScopes
+	v = st.config.StorageS3Proxy
 	st.mutex.RUnlock()
 	return
 }
 
-// SetOIDCScopes safely sets the Configuration value for state's 'OIDCScopes' field
-func (st *ConfigState) SetOIDCScopes(v []string) {
+// SetStorageS3Proxy safely sets the Configuration value for state's 'StorageS3Proxy' field
+func (st *ConfigState) SetStorageS3Proxy(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCScopes = v
+	st.config.StorageS3Proxy = v
 	st.reloadToViper()
 }
 
-// GetOIDCScopes safely fetches the value for global configuration 'OIDCScopes' field
-func GetOIDCScopes() []string { return global.GetOIDCScopes() }
+// GetStorageS3Proxy safely fetches the value for global configuration 'StorageS3Proxy' field
+func GetStorageS3Proxy() bool { return global.GetStorageS3Proxy() }
 
-// SetOIDCScopes safely sets the value for global configuration 'OIDCScopes' field
-func SetOIDCScopes(v []string) { global.SetOIDCScopes(v) }
+// SetStorageS3Proxy safely sets the value for global configuration 'StorageS3Proxy' field
+func SetStorageS3Proxy(v bool) { global.SetStorageS3Proxy(v) }
 
-// GetOIDCLinkExisting safely fetches the Configuration value for state's 'OIDCLinkExisting' field
-func (st *ConfigState) GetOIDCLinkExisting() (v bool) {
+// GetStorageS3RedirectURL safely fetches the Configuration value for state's 'StorageS3RedirectURL' field
+func (st *ConfigState) GetStorageS3RedirectURL() (v string) {
 	st.mutex.RLock()
-	v = st.config.OIDCLinkExisting
+	v = st.config.StorageS3RedirectURL
 	st.mutex.RUnlock()
 	return
 }
 
-// SetOIDCLinkExisting safely sets the Configuration value for state's 'OIDCLinkExisting' field
-func (st *ConfigState) SetOIDCLinkExisting(v bool) {
+// SetStorageS3RedirectURL safely sets the Configuration value for state's 'StorageS3RedirectURL' field
+func (st *ConfigState) SetStorageS3RedirectURL(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCLinkExisting = v
+	st.config.StorageS3RedirectURL = v
 	st.reloadToViper()
 }
 
-// GetOIDCLinkExisting safely fetches the value for global configuration 'OIDCLinkExisting' field
-func GetOIDCLinkExisting() bool { return global.GetOIDCLinkExisting() }
+// GetStorageS3RedirectURL safely fetches the value for global configuration 'StorageS3RedirectURL' field
+func GetStorageS3RedirectURL() string { return global.GetStorageS3RedirectURL() }
 
-// SetOIDCLinkExisting safely sets the value for global configuration 'OIDCLinkExisting' field
-func SetOIDCLinkExisting(v bool) { global.SetOIDCLinkExisting(v) }
+// SetStorageS3RedirectURL safely sets the value for global configuration 'StorageS3RedirectURL' field
+func SetStorageS3RedirectURL(v string) { global.SetStorageS3RedirectURL(v) }
 
-// GetOIDCAllowedGroups safely fetches the Configuration value for state's 'OIDCAllowedGroups' field
-func (st *ConfigState) GetOIDCAllowedGroups() (v []string) {
+// GetStorageS3BucketLookup safely fetches the Configuration value for state's 'StorageS3BucketLookup' field
+func (st *ConfigState) GetStorageS3BucketLookup() (v string) {
 	st.mutex.RLock()
-	v = st.config.OIDCAllowedGroups
+	v = st.config.StorageS3BucketLookup
 	st.mutex.RUnlock()
 	return
 }
 
-// SetOIDCAllowedGroups safely sets the Configuration value for state's 'OIDCAllowedGroups' field
-func (st *ConfigState) SetOIDCAllowedGroups(v []string) {
+// SetStorageS3BucketLookup safely sets the Configuration value for state's 'StorageS3BucketLookup' field
+func (st *ConfigState) SetStorageS3BucketLookup(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCAllowedGroups = v
+	st.config.StorageS3BucketLookup = v
 	st.reloadToViper()
 }
 
-// GetOIDCAllowedGroups safely fetches the value for global configuration 'OIDCAllowedGroups' field
-func GetOIDCAllowedGroups() []string { return global.GetOIDCAllowedGroups() }
+// GetStorageS3BucketLookup safely fetches the value for global configuration 'StorageS3BucketLookup' field
+func GetStorageS3BucketLookup() string { return global.GetStorageS3BucketLookup() }
 
-// SetOIDCAllowedGroups safely sets the value for global configuration 'OIDCAllowedGroups' field
-func SetOIDCAllowedGroups(v []string) { global.SetOIDCAllowedGroups(v) }
+// SetStorageS3BucketLookup safely sets the value for global configuration 'StorageS3BucketLookup' field
+func SetStorageS3BucketLookup(v string) { global.SetStorageS3BucketLookup(v) }
 
-// GetOIDCAdminGroups safely fetches the Configuration value for state's 'OIDCAdminGroups' field
-func (st *ConfigState) GetOIDCAdminGroups() (v []string) {
+// GetStorageS3KeyPrefix safely fetches the Configuration value for state's 'StorageS3KeyPrefix' field
+func (st *ConfigState) GetStorageS3KeyPrefix() (v string) {
 	st.mutex.RLock()
-	v = st.config.OIDCAdminGroups
+	v = st.config.StorageS3KeyPrefix
 	st.mutex.RUnlock()
 	return
 }
 
-// SetOIDCAdminGroups safely sets the Configuration value for state's 'OIDCAdminGroups' field
-func (st *ConfigState) SetOIDCAdminGroups(v []string) {
+// SetStorageS3KeyPrefix safely sets the Configuration value for state's 'StorageS3KeyPrefix' field
+func (st *ConfigState) SetStorageS3KeyPrefix(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCAdminGroups = v
+	st.config.StorageS3KeyPrefix = v
 	st.reloadToViper()
 }
 
-// GetOIDCAdminGroups safely fetches the value for global configuration 'OIDCAdminGroups' field
-func GetOIDCAdminGroups() []string { return global.GetOIDCAdminGroups() }
+// GetStorageS3KeyPrefix safely fetches the value for global configuration 'StorageS3KeyPrefix' field
+func GetStorageS3KeyPrefix() string { return global.GetStorageS3KeyPrefix() }
 
-// SetOIDCAdminGroups safely sets the value for global configuration 'OIDCAdminGroups' field
-func SetOIDCAdminGroups(v []string) { global.SetOIDCAdminGroups(v) }
+// SetStorageS3KeyPrefix safely sets the value for global configuration 'StorageS3KeyPrefix' field
+func SetStorageS3KeyPrefix(v string) { global.SetStorageS3KeyPrefix(v) }
 
-// GetTracingEnabled safely fetches the Configuration value for state's 'TracingEnabled' field
-func (st *ConfigState) GetTracingEnabled() (v bool) {
+// GetStorageWebDAVURL safely fetches the Configuration value for state's 'StorageWebDAVURL' field
+func (st *ConfigState) GetStorageWebDAVURL() (v string) {
 	st.mutex.RLock()
-	v = st.config.TracingEnabled
+	v = st.config.StorageWebDAVURL
 	st.mutex.RUnlock()
 	return
 }
 
-// SetTracingEnabled safely sets the Configuration value for state's 'TracingEnabled' field
-func (st *ConfigState) SetTracingEnabled(v bool) {
+// SetStorageWebDAVURL safely sets the Configuration value for state's 'StorageWebDAVURL' field
+func (st *ConfigState) SetStorageWebDAVURL(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.TracingEnabled = v
+	st.config.StorageWebDAVURL = v
 	st.reloadToViper()
 }
 
-// GetTracingEnabled safely fetches the value for global configuration 'TracingEnabled' field
-func GetTracingEnabled() bool { return global.GetTracingEnabled() }
+// GetStorageWebDAVURL safely fetches the value for global configuration 'StorageWebDAVURL' field
+func GetStorageWebDAVURL() string { return global.GetStorageWebDAVURL() }
 
-// SetTracingEnabled safely sets the value for global configuration 'TracingEnabled' field
-func SetTracingEnabled(v bool) { global.SetTracingEnabled(v) }
+// SetStorageWebDAVURL safely sets the value for global configuration 'StorageWebDAVURL' field
+func SetStorageWebDAVURL(v string) { global.SetStorageWebDAVURL(v) }
 
-// GetMetricsEnabled safely fetches the Configuration value for state's 'MetricsEnabled' field
-func (st *ConfigState) GetMetricsEnabled() (v bool) {
+// GetStorageWebDAVUsername safely fetches the Configuration value for state's 'StorageWebDAVUsername' field
+func (st *ConfigState) GetStorageWebDAVUsername() (v string) {
 	st.mutex.RLock()
-	v = st.config.MetricsEnabled
+	v = st.config.StorageWebDAVUsername
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMetricsEnabled safely sets the Configuration value for state's 'MetricsEnabled' field
-func (st *ConfigState) SetMetricsEnabled(v bool) {
+// SetStorageWebDAVUsername safely sets the Configuration value for state's 'StorageWebDAVUsername' field
+func (st *ConfigState) SetStorageWebDAVUsername(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MetricsEnabled = v
+	st.config.StorageWebDAVUsername = v
 	st.reloadToViper()
 }
 
-// GetMetricsEnabled safely fetches the value for global configuration 'MetricsEnabled' field
-func GetMetricsEnabled() bool { return global.GetMetricsEnabled() }
+// GetStorageWebDAVUsername safely fetches the value for global configuration 'StorageWebDAVUsername' field
+func GetStorageWebDAVUsername() string { return global.GetStorageWebDAVUsername() }
 
-// SetMetricsEnabled safely sets the value for global configuration 'MetricsEnabled' field
-func SetMetricsEnabled(v bool) { global.SetMetricsEnabled(v) }
+// SetStorageWebDAVUsername safely sets the value for global configuration 'StorageWebDAVUsername' field
+func SetStorageWebDAVUsername(v string) { global.SetStorageWebDAVUsername(v) }
 
-// GetSMTPHost safely fetches the Configuration value for state's 'SMTPHost' field
-func (st *ConfigState) GetSMTPHost() (v string) {
+// GetStorageWebDAVPassword safely fetches the Configuration value for state's 'StorageWebDAVPassword' field
+func (st *ConfigState) GetStorageWebDAVPassword() (v string) {
 	st.mutex.RLock()
-	v = st.config.SMTPHost
+	v = st.config.StorageWebDAVPassword
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSMTPHost safely sets the Configuration value for state's 'SMTPHost' field
-func (st *ConfigState) SetSMTPHost(v string) {
+// SetStorageWebDAVPassword safely sets the Configuration value for state's 'StorageWebDAVPassword' field
+func (st *ConfigState) SetStorageWebDAVPassword(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SMTPHost = v
+	st.config.StorageWebDAVPassword = v
 	st.reloadToViper()
 }
 
-// GetSMTPHost safely fetches the value for global configuration 'SMTPHost' field
-func GetSMTPHost() string { return global.GetSMTPHost() }
+// GetStorageWebDAVPassword safely fetches the value for global configuration 'StorageWebDAVPassword' field
+func GetStorageWebDAVPassword() string { return global.GetStorageWebDAVPassword() }
 
-// SetSMTPHost safely sets the value for global configuration 'SMTPHost' field
-func SetSMTPHost(v string) { global.SetSMTPHost(v) }
+// SetStorageWebDAVPassword safely sets the value for global configuration 'StorageWebDAVPassword' field
+func SetStorageWebDAVPassword(v string) { global.SetStorageWebDAVPassword(v) }
 
-// GetSMTPPort safely fetches the Configuration value for state's 'SMTPPort' field
-func (st *ConfigState) GetSMTPPort() (v int) {
+// GetStatusesMaxChars safely fetches the Configuration value for state's 'StatusesMaxChars' field
+func (st *ConfigState) GetStatusesMaxChars() (v int) {
 	st.mutex.RLock()
-	v = st.config.SMTPPort
+	v = st.config.StatusesMaxChars
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSMTPPort safely sets the Configuration value for state's 'SMTPPort' field
-func (st *ConfigState) SetSMTPPort(v int) {
+// SetStatusesMaxChars safely sets the Configuration value for state's 'StatusesMaxChars' field
+func (st *ConfigState) SetStatusesMaxChars(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SMTPPort = v
+	st.config.StatusesMaxChars = v
 	st.reloadToViper()
 }
 
-// GetSMTPPort safely fetches the value for global configuration 'SMTPPort' field
-func GetSMTPPort() int { return global.GetSMTPPort() }
+// GetStatusesMaxChars safely fetches the value for global configuration 'StatusesMaxChars' field
+func GetStatusesMaxChars() int { return global.GetStatusesMaxChars() }
 
-// SetSMTPPort safely sets the value for global configuration 'SMTPPort' field
-func SetSMTPPort(v int) { global.SetSMTPPort(v) }
+// SetStatusesMaxChars safely sets the value for global configuration 'StatusesMaxChars' field
+func SetStatusesMaxChars(v int) { global.SetStatusesMaxChars(v) }
 
-// GetSMTPUsername safely fetches the Configuration value for state's 'SMTPUsername' field
-func (st *ConfigState) GetSMTPUsername() (v string) {
+// GetStatusesPollMaxOptions safely fetches the Configuration value for state's 'StatusesPollMaxOptions' field
+func (st *ConfigState) GetStatusesPollMaxOptions() (v int) {
 	st.mutex.RLock()
-	v = st.config.SMTPUsername
+	v = st.config.StatusesPollMaxOptions
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSMTPUsername safely sets the Configuration value for state's 'SMTPUsername' field
-func (st *ConfigState) SetSMTPUsername(v string) {
+// SetStatusesPollMaxOptions safely sets the Configuration value for state's 'StatusesPollMaxOptions' field
+func (st *ConfigState) SetStatusesPollMaxOptions(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SMTPUsername = v
+	st.config.StatusesPollMaxOptions = v
 	st.reloadToViper()
 }
 
-// GetSMTPUsername safely fetches the value for global configuration 'SMTPUsername' field
-func GetSMTPUsername() string { return global.GetSMTPUsername() }
+// GetStatusesPollMaxOptions safely fetches the value for global configuration 'StatusesPollMaxOptions' field
+func GetStatusesPollMaxOptions() int { return global.GetStatusesPollMaxOptions() }
 
-// SetSMTPUsername safely sets the value for global configuration 'SMTPUsername' field
-func SetSMTPUsername(v string) { global.SetSMTPUsername(v) }
+// SetStatusesPollMaxOptions safely sets the value for global configuration 'StatusesPollMaxOptions' field
+func SetStatusesPollMaxOptions(v int) { global.SetStatusesPollMaxOptions(v) }
 
-// GetSMTPPassword safely fetches the Configuration value for state's 'SMTPPassword' field
-func (st *ConfigState) GetSMTPPassword() (v string) {
+// GetStatusesPollOptionMaxChars safely fetches the Configuration value for state's 'StatusesPollOptionMaxChars' field
+func (st *ConfigState) GetStatusesPollOptionMaxChars() (v int) {
 	st.mutex.RLock()
-	v = st.config.SMTPPassword
+	v = st.config.StatusesPollOptionMaxChars
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSMTPPassword safely sets the Configuration value for state's 'SMTPPassword' field
-func (st *ConfigState) SetSMTPPassword(v string) {
+// SetStatusesPollOptionMaxChars safely sets the Configuration value for state's 'StatusesPollOptionMaxChars' field
+func (st *ConfigState) SetStatusesPollOptionMaxChars(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SMTPPassword = v
+	st.config.StatusesPollOptionMaxChars = v
 	st.reloadToViper()
 }
 
-// GetSMTPPassword safely fetches the value for global configuration 'SMTPPassword' field
-func GetSMTPPassword() string { return global.GetSMTPPassword() }
+// GetStatusesPollOptionMaxChars safely fetches the value for global configuration 'StatusesPollOptionMaxChars' field
+func GetStatusesPollOptionMaxChars() int { return global.GetStatusesPollOptionMaxChars() }
 
-// SetSMTPPassword safely sets the value for global configuration 'SMTPPassword' field
-func SetSMTPPassword(v string) { global.SetSMTPPassword(v) }
+// SetStatusesPollOptionMaxChars safely sets the value for global configuration 'StatusesPollOptionMaxChars' field
+func SetStatusesPollOptionMaxChars(v int) { global.SetStatusesPollOptionMaxChars(v) }
 
-// GetSMTPFrom safely fetches the Configuration value for state's 'SMTPFrom' field
-func (st *ConfigState) GetSMTPFrom() (v string) {
+// GetStatusesPollLocalVoters safely fetches the Configuration value for state's 'StatusesPollLocalVoters' field
+func (st *ConfigState) GetStatusesPollLocalVoters() (v bool) {
 	st.mutex.RLock()
-	v = st.config.SMTPFrom
+	v = st.config.StatusesPollLocalVoters
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSMTPFrom safely sets the Configuration value for state's 'SMTPFrom' field
-func (st *ConfigState) SetSMTPFrom(v string) {
+// SetStatusesPollLocalVoters safely sets the Configuration value for state's 'StatusesPollLocalVoters' field
+func (st *ConfigState) SetStatusesPollLocalVoters(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SMTPFrom = v
+	st.config.StatusesPollLocalVoters = v
 	st.reloadToViper()
 }
 
-// GetSMTPFrom safely fetches the value for global configuration 'SMTPFrom' field
-func GetSMTPFrom() string { return global.GetSMTPFrom() }
+// GetStatusesPollLocalVoters safely fetches the value for global configuration 'StatusesPollLocalVoters' field
+func GetStatusesPollLocalVoters() bool { return global.GetStatusesPollLocalVoters() }
 
-// SetSMTPFrom safely sets the value for global configuration 'SMTPFrom' field
-func SetSMTPFrom(v string) { global.SetSMTPFrom(v) }
+// SetStatusesPollLocalVoters safely sets the value for global configuration 'StatusesPollLocalVoters' field
+func SetStatusesPollLocalVoters(v bool) { global.SetStatusesPollLocalVoters(v) }
 
-// GetSMTPDiscloseRecipients safely fetches the Configuration value for state's 'SMTPDiscloseRecipients' field
-func (st *ConfigState) GetSMTPDiscloseRecipients() (v bool) {
+// GetStatusesMediaMaxFiles safely fetches the Configuration value for state's 'StatusesMediaMaxFiles' field
+func (st *ConfigState) GetStatusesMediaMaxFiles() (v int) {
 	st.mutex.RLock()
-	v = st.config.SMTPDiscloseRecipients
+	v = st.config.StatusesMediaMaxFiles
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSMTPDiscloseRecipients safely sets the Configuration value for state's 'SMTPDiscloseRecipients' field
-func (st *ConfigState) SetSMTPDiscloseRecipients(v bool) {
+// SetStatusesMediaMaxFiles safely sets the Configuration value for state's 'StatusesMediaMaxFiles' field
+func (st *ConfigState) SetStatusesMediaMaxFiles(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SMTPDiscloseRecipients = v
+	st.config.StatusesMediaMaxFiles = v
 	st.reloadToViper()
 }
 
-// GetSMTPDiscloseRecipients safely fetches the value for global configuration 'SMTPDiscloseRecipients' field
-func GetSMTPDiscloseRecipients() bool { return global.GetSMTPDiscloseRecipients() }
+// GetStatusesMediaMaxFiles safely fetches the value for global configuration 'StatusesMediaMaxFiles' field
+func GetStatusesMediaMaxFiles() int { return global.GetStatusesMediaMaxFiles() }
 
-// SetSMTPDiscloseRecipients safely sets the value for global configuration 'SMTPDiscloseRecipients' field
-func SetSMTPDiscloseRecipients(v bool) { global.SetSMTPDiscloseRecipients(v) }
+// SetStatusesMediaMaxFiles safely sets the value for global configuration 'StatusesMediaMaxFiles' field
+func SetStatusesMediaMaxFiles(v int) { global.SetStatusesMediaMaxFiles(v) }
 
-// GetSyslogEnabled safely fetches the Configuration value for state's 'SyslogEnabled' field
-func (st *ConfigState) GetSyslogEnabled() (v bool) {
+// GetStatusesTrashWindow safely fetches the Configuration value for state's 'StatusesTrashWindow' field
+func (st *ConfigState) GetStatusesTrashWindow() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.SyslogEnabled
+	v = st.config.StatusesTrashWindow
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSyslogEnabled safely sets the Configuration value for state's 'SyslogEnabled' field
-func (st *ConfigState) SetSyslogEnabled(v bool) {
+// SetStatusesTrashWindow safely sets the Configuration value for state's 'StatusesTrashWindow' field
+func (st *ConfigState) SetStatusesTrashWindow(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SyslogEnabled = v
+	st.config.StatusesTrashWindow = v
 	st.reloadToViper()
 }
 
-// GetSyslogEnabled safely fetches the value for global configuration 'SyslogEnabled' field
-func GetSyslogEnabled() bool { return global.GetSyslogEnabled() }
+// GetStatusesTrashWindow safely fetches the value for global configuration 'StatusesTrashWindow' field
+func GetStatusesTrashWindow() time.Duration { return global.GetStatusesTrashWindow() }
 
-// SetSyslogEnabled safely sets the value for global configuration 'SyslogEnabled' field
-func SetSyslogEnabled(v bool) { global.SetSyslogEnabled(v) }
+// SetStatusesTrashWindow safely sets the value for global configuration 'StatusesTrashWindow' field
+func SetStatusesTrashWindow(v time.Duration) { global.SetStatusesTrashWindow(v) }
 
-// GetSyslogProtocol safely fetches the Configuration value for state's 'SyslogProtocol' field
-func (st *ConfigState) GetSyslogProtocol() (v string) {
+// GetStatusesDetectLanguage safely fetches the Configuration value for state's 'StatusesDetectLanguage' field
+func (st *ConfigState) GetStatusesDetectLanguage() (v bool) {
 	st.mutex.RLock()
-	v = st.config.SyslogProtocol
+	v = st.config.StatusesDetectLanguage
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSyslogProtocol safely sets the Configuration value for state's 'SyslogProtocol' field
-func (st *ConfigState) SetSyslogProtocol(v string) {
+// SetStatusesDetectLanguage safely sets the Configuration value for state's 'StatusesDetectLanguage' field
+func (st *ConfigState) SetStatusesDetectLanguage(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SyslogProtocol = v
+	st.config.StatusesDetectLanguage = v
 	st.reloadToViper()
 }
 
-// GetSyslogProtocol safely fetches the value for global configuration 'SyslogProtocol' field
-func GetSyslogProtocol() string { return global.GetSyslogProtocol() }
+// GetStatusesDetectLanguage safely fetches the value for global configuration 'StatusesDetectLanguage' field
+func GetStatusesDetectLanguage() bool { return global.GetStatusesDetectLanguage() }
 
-// SetSyslogProtocol safely sets the value for global configuration 'SyslogProtocol' field
-func SetSyslogProtocol(v string) { global.SetSyslogProtocol(v) }
+// SetStatusesDetectLanguage safely sets the value for global configuration 'StatusesDetectLanguage' field
+func SetStatusesDetectLanguage(v bool) { global.SetStatusesDetectLanguage(v) }
 
-// GetSyslogAddress safely fetches the Configuration value for state's 'SyslogAddress' field
-func (st *ConfigState) GetSyslogAddress() (v string) {
+// GetScheduledStatusesMaxTotal safely fetches the Configuration value for state's 'ScheduledStatusesMaxTotal' field
+func (st *ConfigState) GetScheduledStatusesMaxTotal() (v int) {
 	st.mutex.RLock()
-	v = st.config.SyslogAddress
+	v = st.config.ScheduledStatusesMaxTotal
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSyslogAddress safely sets the Configuration value for state's 'SyslogAddress' field
-func (st *ConfigState) SetSyslogAddress(v string) {
+// SetScheduledStatusesMaxTotal safely sets the Configuration value for state's 'ScheduledStatusesMaxTotal' field
+func (st *ConfigState) SetScheduledStatusesMaxTotal(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SyslogAddress = v
+	st.config.ScheduledStatusesMaxTotal = v
 	st.reloadToViper()
 }
 
-// GetSyslogAddress safely fetches the value for global configuration 'SyslogAddress' field
-func GetSyslogAddress() string { return global.GetSyslogAddress() }
+// GetScheduledStatusesMaxTotal safely fetches the value for global configuration 'ScheduledStatusesMaxTotal' field
+func GetScheduledStatusesMaxTotal() int { return global.GetScheduledStatusesMaxTotal() }
 
-// SetSyslogAddress safely sets the value for global configuration 'SyslogAddress' field
-func SetSyslogAddress(v string) { global.SetSyslogAddress(v) }
+// SetScheduledStatusesMaxTotal safely sets the value for global configuration 'ScheduledStatusesMaxTotal' field
+func SetScheduledStatusesMaxTotal(v int) { global.SetScheduledStatusesMaxTotal(v) }
 
-// GetAdvancedCookiesSamesite safely fetches the Configuration value for state's 'Advanced.CookiesSamesite' field
-func (st *ConfigState) GetAdvancedCookiesSamesite() (v string) {
+// GetScheduledStatusesMaxDaily safely fetches the Configuration value for state's 'ScheduledStatusesMaxDaily' field
+func (st *ConfigState) GetScheduledStatusesMaxDaily() (v int) {
 	st.mutex.RLock()
-	v = st.config.Advanced.CookiesSamesite
+	v = st.config.ScheduledStatusesMaxDaily
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAdvancedCookiesSamesite safely sets the Configuration value for state's 'Advanced.CookiesSamesite' field
-func (st *ConfigState) SetAdvancedCookiesSamesite(v string) {
+// SetScheduledStatusesMaxDaily safely sets the Configuration value for state's 'ScheduledStatusesMaxDaily' field
+func (st *ConfigState) SetScheduledStatusesMaxDaily(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Advanced.CookiesSamesite = v
+	st.config.ScheduledStatusesMaxDaily = v
 	st.reloadToViper()
 }
 
-// GetAdvancedCookiesSamesite safely fetches the value for global configuration 'Advanced.CookiesSamesite' field
-func GetAdvancedCookiesSamesite() string { return global.GetAdvancedCookiesSamesite() }
+// GetScheduledStatusesMaxDaily safely fetches the value for global configuration 'ScheduledStatusesMaxDaily' field
+func GetScheduledStatusesMaxDaily() int { return global.GetScheduledStatusesMaxDaily() }
 
-// SetAdvancedCookiesSamesite safely sets the value for global configuration 'Advanced.CookiesSamesite' field
-func SetAdvancedCookiesSamesite(v string) { global.SetAdvancedCookiesSamesite(v) }
+// SetScheduledStatusesMaxDaily safely sets the value for global configuration 'ScheduledStatusesMaxDaily' field
+func SetScheduledStatusesMaxDaily(v int) { global.SetScheduledStatusesMaxDaily(v) }
 
-// GetAdvancedSenderMultiplier safely fetches the Configuration value for state's 'Advanced.SenderMultiplier' field
-func (st *ConfigState) GetAdvancedSenderMultiplier() (v int) {
+// GetNotificationsMaxAge safely fetches the Configuration value for state's 'NotificationsMaxAge' field
+func (st *ConfigState) GetNotificationsMaxAge() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.Advanced.SenderMultiplier
+	v = st.config.NotificationsMaxAge
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAdvancedSenderMultiplier safely sets the Configuration value for state's 'Advanced.SenderMultiplier' field
-func (st *ConfigState) SetAdvancedSenderMultiplier(v int) {
+// SetNotificationsMaxAge safely sets the Configuration value for state's 'NotificationsMaxAge' field
+func (st *ConfigState) SetNotificationsMaxAge(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Advanced.SenderMultiplier = v
+	st.config.NotificationsMaxAge = v
 	st.reloadToViper()
 }
 
-// GetAdvancedSenderMultiplier safely fetches the value for global configuration 'Advanced.SenderMultiplier' field
-func GetAdvancedSenderMultiplier() int { return global.GetAdvancedSenderMultiplier() }
+// GetNotificationsMaxAge safely fetches the value for global configuration 'NotificationsMaxAge' field
+func GetNotificationsMaxAge() time.Duration { return global.GetNotificationsMaxAge() }
 
-// SetAdvancedSenderMultiplier safely sets the value for global configuration 'Advanced.SenderMultiplier' field
-func SetAdvancedSenderMultiplier(v int) { global.SetAdvancedSenderMultiplier(v) }
+// SetNotificationsMaxAge safely sets the value for global configuration 'NotificationsMaxAge' field
+func SetNotificationsMaxAge(v time.Duration) { global.SetNotificationsMaxAge(v) }
 
-// GetAdvancedCSPExtraURIs safely fetches the Configuration value for state's 'Advanced.CSPExtraURIs' field
-func (st *ConfigState) GetAdvancedCSPExtraURIs() (v []string) {
+// GetLetsEncryptEnabled safely fetches the Configuration value for state's 'LetsEncryptEnabled' field
+func (st *ConfigState) GetLetsEncryptEnabled() (v bool) {
 	st.mutex.RLock()
-	v = st.config.Advanced.CSPExtraURIs
+	v = st.config.LetsEncryptEnabled
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAdvancedCSPExtraURIs safely sets the Configuration value for state's 'Advanced.CSPExtraURIs' field
-func (st *ConfigState) SetAdvancedCSPExtraURIs(v []string) {
+// SetLetsEncryptEnabled safely sets the Configuration value for state's 'LetsEncryptEnabled' field
+func (st *ConfigState) SetLetsEncryptEnabled(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Advanced.CSPExtraURIs = v
+	st.config.LetsEncryptEnabled = v
 	st.reloadToViper()
 }
 
-// GetAdvancedCSPExtraURIs safely fetches the value for global configuration 'Advanced.CSPExtraURIs' field
-func GetAdvancedCSPExtraURIs() []string { return global.GetAdvancedCSPExtraURIs() }
+// GetLetsEncryptEnabled safely fetches the value for global configuration 'LetsEncryptEnabled' field
+func GetLetsEncryptEnabled() bool { return global.GetLetsEncryptEnabled() }
 
-// SetAdvancedCSPExtraURIs safely sets the value for global configuration 'Advanced.CSPExtraURIs' field
-func SetAdvancedCSPExtraURIs(v []string) { global.SetAdvancedCSPExtraURIs(v) }
+// SetLetsEncryptEnabled safely sets the value for global configuration 'LetsEncryptEnabled' field
+func SetLetsEncryptEnabled(v bool) { global.SetLetsEncryptEnabled(v) }
 
-// GetAdvancedHeaderFilterMode safely fetches the Configuration value for state's 'Advanced.HeaderFilterMode' field
-func (st *ConfigState) GetAdvancedHeaderFilterMode() (v string) {
+// GetLetsEncryptPort safely fetches the Configuration value for state's 'LetsEncryptPort' field
+func (st *ConfigState) GetLetsEncryptPort() (v int) {
 	st.mutex.RLock()
-	v = st.config.Advanced.HeaderFilterMode
+	v = st.config.LetsEncryptPort
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAdvancedHeaderFilterMode safely sets the Configuration value for state's 'Advanced.HeaderFilterMode' field
-func (st *ConfigState) SetAdvancedHeaderFilterMode(v string) {
+// SetLetsEncryptPort safely sets the Configuration value for state's 'LetsEncryptPort' field
+func (st *ConfigState) SetLetsEncryptPort(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Advanced.HeaderFilterMode = v
+	st.config.LetsEncryptPort = v
 	st.reloadToViper()
 }
 
-// GetAdvancedHeaderFilterMode safely fetches the value for global configuration 'Advanced.HeaderFilterMode' field
-func GetAdvancedHeaderFilterMode() string { return global.GetAdvancedHeaderFilterMode() }
+// GetLetsEncryptPort safely fetches the value for global configuration 'LetsEncryptPort' field
+func GetLetsEncryptPort() int { return global.GetLetsEncryptPort() }
 
-// SetAdvancedHeaderFilterMode safely sets the value for global configuration 'Advanced.HeaderFilterMode' field
-func SetAdvancedHeaderFilterMode(v string) { global.SetAdvancedHeaderFilterMode(v) }
+// SetLetsEncryptPort safely sets the value for global configuration 'LetsEncryptPort' field
+func SetLetsEncryptPort(v int) { global.SetLetsEncryptPort(v) }
 
-// GetAdvancedRateLimitRequests safely fetches the Configuration value for state's 'Advanced.RateLimit.Requests' field
-func (st *ConfigState) GetAdvancedRateLimitRequests() (v int) {
+// GetLetsEncryptCertDir safely fetches the Configuration value for state's 'LetsEncryptCertDir' field
+func (st *ConfigState) GetLetsEncryptCertDir() (v string) {
 	st.mutex.RLock()
-	v = st.config.Advanced.RateLimit.Requests
+	v = st.config.LetsEncryptCertDir
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAdvancedRateLimitRequests safely sets the Configuration value for state's 'Advanced.RateLimit.Requests' field
-func (st *ConfigState) SetAdvancedRateLimitRequests(v int) {
+// SetLetsEncryptCertDir safely sets the Configuration value for state's 'LetsEncryptCertDir' field
+func (st *ConfigState) SetLetsEncryptCertDir(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Advanced.RateLimit.Requests = v
+	st.config.LetsEncryptCertDir = v
 	st.reloadToViper()
 }
 
-// GetAdvancedRateLimitRequests safely fetches the value for global configuration 'Advanced.RateLimit.Requests' field
-func GetAdvancedRateLimitRequests() int { return global.GetAdvancedRateLimitRequests() }
+// GetLetsEncryptCertDir safely fetches the value for global configuration 'LetsEncryptCertDir' field
+func GetLetsEncryptCertDir() string { return global.GetLetsEncryptCertDir() }
 
-// SetAdvancedRateLimitRequests safely sets the value for global configuration 'Advanced.RateLimit.Requests' field
-func SetAdvancedRateLimitRequests(v int) { global.SetAdvancedRateLimitRequests(v) }
+// SetLetsEncryptCertDir safely sets the value for global configuration 'LetsEncryptCertDir' field
+func SetLetsEncryptCertDir(v string) { global.SetLetsEncryptCertDir(v) }
 
-// GetAdvancedRateLimitExceptions safely fetches the Configuration value for state's 'Advanced.RateLimit.Exceptions' field
-func (st *ConfigState) GetAdvancedRateLimitExceptions() (v IPPrefixes) {
+// GetLetsEncryptEmailAddress safely fetches the Configuration value for state's 'LetsEncryptEmailAddress' field
+func (st *ConfigState) GetLetsEncryptEmailAddress() (v string) {
 	st.mutex.RLock()
-	v = st.config.Advanced.RateLimit.Exceptions
+	v = st.config.LetsEncryptEmailAddress
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAdvancedRateLimitExceptions safely sets the Configuration value for state's 'Advanced.RateLimit.Exceptions' field
-func (st *ConfigState) SetAdvancedRateLimitExceptions(v IPPrefixes) {
+// SetLetsEncryptEmailAddress safely sets the Configuration value for state's 'LetsEncryptEmailAddress' field
+func (st *ConfigState) SetLetsEncryptEmailAddress(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Advanced.RateLimit.Exceptions = v
+	st.config.LetsEncryptEmailAddress = v
 	st.reloadToViper()
 }
 
-// GetAdvancedRateLimitExceptions safely fetches the value for global configuration 'Advanced.RateLimit.Exceptions' field
-func GetAdvancedRateLimitExceptions() IPPrefixes { return global.GetAdvancedRateLimitExceptions() }
+// GetLetsEncryptEmailAddress safely fetches the value for global configuration 'LetsEncryptEmailAddress' field
+func GetLetsEncryptEmailAddress() string { return global.GetLetsEncryptEmailAddress() }
 
-// SetAdvancedRateLimitExceptions safely sets the value for global configuration 'Advanced.RateLimit.Exceptions' field
-func SetAdvancedRateLimitExceptions(v IPPrefixes) { global.SetAdvancedRateLimitExceptions(v) }
+// SetLetsEncryptEmailAddress safely sets the value for global configuration 'LetsEncryptEmailAddress' field
+func SetLetsEncryptEmailAddress(v string) { global.SetLetsEncryptEmailAddress(v) }
 
-// GetAdvancedThrottlingMultiplier safely fetches the Configuration value for state's 'Advanced.Throttling.Multiplier' field
-func (st *ConfigState) GetAdvancedThrottlingMultiplier() (v int) {
+// GetTLSCertificateChain safely fetches the Configuration value for state's 'TLSCertificateChain' field
+func (st *ConfigState) GetTLSCertificateChain() (v string) {
 	st.mutex.RLock()
-	v = st.config.Advanced.Throttling.Multiplier
+	v = st.config.TLSCertificateChain
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAdvancedThrottlingMultiplier safely sets the Configuration value for state's 'Advanced.Throttling.Multiplier' field
-func (st *ConfigState) SetAdvancedThrottlingMultiplier(v int) {
+// SetTLSCertificateChain safely sets the Configuration value for state's 'TLSCertificateChain' field
+func (st *ConfigState) SetTLSCertificateChain(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Advanced.Throttling.Multiplier = v
+	st.config.TLSCertificateChain = v
 	st.reloadToViper()
 }
 
-// GetAdvancedThrottlingMultiplier safely fetches the value for global configuration 'Advanced.Throttling.Multiplier' field
-func GetAdvancedThrottlingMultiplier() int { return global.GetAdvancedThrottlingMultiplier() }
+// GetTLSCertificateChain safely fetches the value for global configuration 'TLSCertificateChain' field
+func GetTLSCertificateChain() string { return global.GetTLSCertificateChain() }
 
-// SetAdvancedThrottlingMultiplier safely sets the value for global configuration 'Advanced.Throttling.Multiplier' field
-func SetAdvancedThrottlingMultiplier(v int) { global.SetAdvancedThrottlingMultiplier(v) }
+// SetTLSCertificateChain safely sets the value for global configuration 'TLSCertificateChain' field
+func SetTLSCertificateChain(v string) { global.SetTLSCertificateChain(v) }
 
-// GetAdvancedThrottlingRetryAfter safely fetches the Configuration value for state's 'Advanced.Throttling.RetryAfter' field
-func (st *ConfigState) GetAdvancedThrottlingRetryAfter() (v time.Duration) {
+// GetTLSCertificateKey safely fetches the Configuration value for state's 'TLSCertificateKey' field
+func (st *ConfigState) GetTLSCertificateKey() (v string) {
 	st.mutex.RLock()
-	v = st.config.Advanced.Throttling.RetryAfter
+	v = st.config.TLSCertificateKey
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAdvancedThrottlingRetryAfter safely sets the Configuration value for state's 'Advanced.Throttling.RetryAfter' field
-func (st *ConfigState) SetAdvancedThrottlingRetryAfter(v time.Duration) {
+// SetTLSCertificateKey safely sets the Configuration value for state's 'TLSCertificateKey' field
+func (st *ConfigState) SetTLSCertificateKey(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Advanced.Throttling.RetryAfter = v
+	st.config.TLSCertificateKey = v
 	st.reloadToViper()
 }
 
-// GetAdvancedThrottlingRetryAfter safely fetches the value for global configuration 'Advanced.Throttling.RetryAfter' field
-func GetAdvancedThrottlingRetryAfter() time.Duration { return global.GetAdvancedThrottlingRetryAfter() }
+// GetTLSCertificateKey safely fetches the value for global configuration 'TLSCertificateKey' field
+func GetTLSCertificateKey() string { return global.GetTLSCertificateKey() }
 
-// SetAdvancedThrottlingRetryAfter safely sets the value for global configuration 'Advanced.Throttling.RetryAfter' field
-func SetAdvancedThrottlingRetryAfter(v time.Duration) { global.SetAdvancedThrottlingRetryAfter(v) }
+// SetTLSCertificateKey safely sets the value for global configuration 'TLSCertificateKey' field
+func SetTLSCertificateKey(v string) { global.SetTLSCertificateKey(v) }
 
-// GetHTTPClientAllowIPs safely fetches the Configuration value for state's 'HTTPClient.AllowIPs' field
-func (st *ConfigState) GetHTTPClientAllowIPs() (v []string) {
+// GetOIDCEnabled safely fetches the Configuration value for state's 'OIDCEnabled' field
+func (st *ConfigState) GetOIDCEnabled() (v bool) {
 	st.mutex.RLock()
-	v = st.config.HTTPClient.AllowIPs
+	v = st.config.OIDCEnabled
 	st.mutex.RUnlock()
 	return
 }
 
-// SetHTTPClientAllowIPs safely sets the Configuration value for state's 'HTTPClient.AllowIPs' field
-func (st *ConfigState) SetHTTPClientAllowIPs(v []string) {
+// SetOIDCEnabled safely sets the Configuration value for state's 'OIDCEnabled' field
+func (st *ConfigState) SetOIDCEnabled(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.HTTPClient.AllowIPs = v
+	st.config.OIDCEnabled = v
 	st.reloadToViper()
 }
 
-// GetHTTPClientAllowIPs safely fetches the value for global configuration 'HTTPClient.AllowIPs' field
-func GetHTTPClientAllowIPs() []string { return global.GetHTTPClientAllowIPs() }
+// GetOIDCEnabled safely fetches the value for global configuration 'OIDCEnabled' field
+func GetOIDCEnabled() bool { return global.GetOIDCEnabled() }
 
-// SetHTTPClientAllowIPs safely sets the value for global configuration 'HTTPClient.AllowIPs' field
-func SetHTTPClientAllowIPs(v []string) { global.SetHTTPClientAllowIPs(v) }
+// SetOIDCEnabled safely sets the value for global configuration 'OIDCEnabled' field
+func SetOIDCEnabled(v bool) { global.SetOIDCEnabled(v) }
 
-// GetHTTPClientBlockIPs safely fetches the Configuration value for state's 'HTTPClient.BlockIPs' field
-func (st *ConfigState) GetHTTPClientBlockIPs() (v []string) {
+// GetOIDCIdpName safely fetches the Configuration value for state's 'OIDCIdpName' field
+func (st *ConfigState) GetOIDCIdpName() (v string) {
 	st.mutex.RLock()
-	v = st.config.HTTPClient.BlockIPs
+	v = st.config.OIDCIdpName
 	st.mutex.RUnlock()
 	return
 }
 
-// SetHTTPClientBlockIPs safely sets the Configuration value for state's 'HTTPClient.BlockIPs' field
-func (st *ConfigState) SetHTTPClientBlockIPs(v []string) {
+// SetOIDCIdpName safely sets the Configuration value for state's 'OIDCIdpName' field
+func (st *ConfigState) SetOIDCIdpName(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.HTTPClient.BlockIPs = v
+	st.config.OIDCIdpName = v
 	st.reloadToViper()
 }
 
-// GetHTTPClientBlockIPs safely fetches the value for global configuration 'HTTPClient.BlockIPs' field
-func GetHTTPClientBlockIPs() []string { return global.GetHTTPClientBlockIPs() }
+// GetOIDCIdpName safely fetches the value for global configuration 'OIDCIdpName' field
+func GetOIDCIdpName() string { return global.GetOIDCIdpName() }
 
-// SetHTTPClientBlockIPs safely sets the value for global configuration 'HTTPClient.BlockIPs' field
-func SetHTTPClientBlockIPs(v []string) { global.SetHTTPClientBlockIPs(v) }
+// SetOIDCIdpName safely sets the value for global configuration 'OIDCIdpName' field
+func SetOIDCIdpName(v string) { global.SetOIDCIdpName(v) }
 
-// GetHTTPClientTimeout safely fetches the Configuration value for state's 'HTTPClient.Timeout' field
-func (st *ConfigState) GetHTTPClientTimeout() (v time.Duration) {
+// GetOIDCSkipVerification safely fetches the Configuration value for state's 'OIDCSkipVerification' field
+func (st *ConfigState) GetOIDCSkipVerification() (v bool) {
 	st.mutex.RLock()
-	v = st.config.HTTPClient.Timeout
+	v = st.config.OIDCSkipVerification
 	st.mutex.RUnlock()
 	return
 }
 
-// SetHTTPClientTimeout safely sets the Configuration value for state's 'HTTPClient.Timeout' field
-func (st *ConfigState) SetHTTPClientTimeout(v time.Duration) {
+// SetOIDCSkipVerification safely sets the Configuration value for state's 'OIDCSkipVerification' field
+func (st *ConfigState) SetOIDCSkipVerification(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.HTTPClient.Timeout = v
+	st.config.OIDCSkipVerification = v
 	st.reloadToViper()
 }
 
-// GetHTTPClientTimeout safely fetches the value for global configuration 'HTTPClient.Timeout' field
-func GetHTTPClientTimeout() time.Duration { return global.GetHTTPClientTimeout() }
+// GetOIDCSkipVerification safely fetches the value for global configuration 'OIDCSkipVerification' field
+func GetOIDCSkipVerification() bool { return global.GetOIDCSkipVerification() }
 
-// SetHTTPClientTimeout safely sets the value for global configuration 'HTTPClient.Timeout' field
-func SetHTTPClientTimeout(v time.Duration) { global.SetHTTPClientTimeout(v) }
+// SetOIDCSkipVerification safely sets the value for global configuration 'OIDCSkipVerification' field
+func SetOIDCSkipVerification(v bool) { global.SetOIDCSkipVerification(v) }
 
-// GetHTTPClientTLSInsecureSkipVerify safely fetches the Configuration value for state's 'HTTPClient.TLSInsecureSkipVerify' field
-func (st *ConfigState) GetHTTPClientTLSInsecureSkipVerify() (v bool) {
+// GetOIDCIssuer safely fetches the Configuration value for state's 'OIDCIssuer' field
+func (st *ConfigState) GetOIDCIssuer() (v string) {
 	st.mutex.RLock()
-	v = st.config.HTTPClient.TLSInsecureSkipVerify
+	v = st.config.OIDCIssuer
 	st.mutex.RUnlock()
 	return
 }
 
-// SetHTTPClientTLSInsecureSkipVerify safely sets the Configuration value for state's 'HTTPClient.TLSInsecureSkipVerify' field
-func (st *ConfigState) SetHTTPClientTLSInsecureSkipVerify(v bool) {
+// SetOIDCIssuer safely sets the Configuration value for state's 'OIDCIssuer' field
+func (st *ConfigState) SetOIDCIssuer(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.HTTPClient.TLSInsecureSkipVerify = v
+	st.config.OIDCIssuer = v
 	st.reloadToViper()
 }
 
-// GetHTTPClientTLSInsecureSkipVerify safely fetches the value for global configuration 'HTTPClient.TLSInsecureSkipVerify' field
-func GetHTTPClientTLSInsecureSkipVerify() bool { return global.GetHTTPClientTLSInsecureSkipVerify() }
+// GetOIDCIssuer safely fetches the value for global configuration 'OIDCIssuer' field
+func GetOIDCIssuer() string { return global.GetOIDCIssuer() }
 
-// SetHTTPClientTLSInsecureSkipVerify safely sets the value for global configuration 'HTTPClient.TLSInsecureSkipVerify' field
-func SetHTTPClientTLSInsecureSkipVerify(v bool) { global.SetHTTPClientTLSInsecureSkipVerify(v) }
+// SetOIDCIssuer safely sets the value for global configuration 'OIDCIssuer' field
+func SetOIDCIssuer(v string) { global.SetOIDCIssuer(v) }
 
-// GetHTTPClientInsecureOutgoing safely fetches the Configuration value for state's 'HTTPClient.InsecureOutgoing' field
-func (st *ConfigState) GetHTTPClientInsecureOutgoing() (v bool) {
+// GetOIDCClientID safely fetches the Configuration value for state's 'OIDCClientID' field
+func (st *ConfigState) GetOIDCClientID() (v string) {
 	st.mutex.RLock()
-	v = st.config.HTTPClient.InsecureOutgoing
+	v = st.config.OIDCClientID
 	st.mutex.RUnlock()
 	return
 }
 
-// SetHTTPClientInsecureOutgoing safely sets the Configuration value for state's 'HTTPClient.InsecureOutgoing' field
-func (st *ConfigState) SetHTTPClientInsecureOutgoing(v bool) {
+// SetOIDCClientID safely sets the Configuration value for state's 'OIDCClientID' field
+func (st *ConfigState) SetOIDCClientID(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.HTTPClient.InsecureOutgoing = v
+	st.config.OIDCClientID = v
 	st.reloadToViper()
 }
 
-// GetHTTPClientInsecureOutgoing safely fetches the value for global configuration 'HTTPClient.InsecureOutgoing' field
-func GetHTTPClientInsecureOutgoing() bool { return global.GetHTTPClientInsecureOutgoing() }
+// GetOIDCClientID safely fetches the value for global configuration 'OIDCClientID' field
+func GetOIDCClientID() string { return global.GetOIDCClientID() }
 
-// SetHTTPClientInsecureOutgoing safely sets the value for global configuration 'HTTPClient.InsecureOutgoing' field
-func SetHTTPClientInsecureOutgoing(v bool) { global.SetHTTPClientInsecureOutgoing(v) }
+// SetOIDCClientID safely sets the value for global configuration 'OIDCClientID' field
+func SetOIDCClientID(v string) { global.SetOIDCClientID(v) }
 
-// GetMediaDescriptionMinChars safely fetches the Configuration value for state's 'Media.DescriptionMinChars' field
-func (st *ConfigState) GetMediaDescriptionMinChars() (v int) {
+// GetOIDCClientSecret safely fetches the Configuration value for state's 'OIDCClientSecret' field
+func (st *ConfigState) GetOIDCClientSecret() (v string) {
 	st.mutex.RLock()
-	v = st.config.Media.DescriptionMinChars
+	v = st.config.OIDCClientSecret
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaDescriptionMinChars safely sets the Configuration value for state's 'Media.DescriptionMinChars' field
-func (st *ConfigState) SetMediaDescriptionMinChars(v int) {
+// SetOIDCClientSecret safely sets the Configuration value for state's 'OIDCClientSecret' field
+func (st *ConfigState) SetOIDCClientSecret(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Media.DescriptionMinChars = v
+	st.config.OIDCClientSecret = v
 	st.reloadToViper()
 }
 
-// GetMediaDescriptionMinChars safely fetches the value for global configuration 'Media.DescriptionMinChars' field
-func GetMediaDescriptionMinChars() int { return global.GetMediaDescriptionMinChars() }
+// GetOIDCClientSecret safely fetches the value for global configuration 'OIDCClientSecret' field
+func GetOIDCClientSecret() string { return global.GetOIDCClientSecret() }
 
-// SetMediaDescriptionMinChars safely sets the value for global configuration 'Media.DescriptionMinChars' field
-func SetMediaDescriptionMinChars(v int) { global.SetMediaDescriptionMinChars(v) }
+// SetOIDCClientSecret safely sets the value for global configuration 'OIDCClientSecret' field
+func SetOIDCClientSecret(v string) { global.SetOIDCClientSecret(v) }
 
-// GetMediaDescriptionMaxChars safely fetches the Configuration value for state's 'Media.DescriptionMaxChars' field
-func (st *ConfigState) GetMediaDescriptionMaxChars() (v int) {
+// GetOIDCScopes safely fetches the Configuration value for state's 'OIDCScopes' field
+func (st *ConfigState) GetOIDCScopes() (v []string) {
 	st.mutex.RLock()
-	v = st.config.Media.DescriptionMaxChars
+	v = st.config.OIDCScopes
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaDescriptionMaxChars safely sets the Configuration value for state's 'Media.DescriptionMaxChars' field
-func (st *ConfigState) SetMediaDescriptionMaxChars(v int) {
+// SetOIDCScopes safely sets the Configuration value for state's 'OIDCScopes' field
+func (st *ConfigState) SetOIDCScopes(v []string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Media.DescriptionMaxChars = v
+	st.config.OIDCScopes = v
 	st.reloadToViper()
 }
 
-// GetMediaDescriptionMaxChars safely fetches the value for global configuration 'Media.DescriptionMaxChars' field
-func GetMediaDescriptionMaxChars() int { return global.GetMediaDescriptionMaxChars() }
+// GetOIDCScopes safely fetches the value for global configuration 'OIDCScopes' field
+func GetOIDCScopes() []string { return global.GetOIDCScopes() }
 
-// SetMediaDescriptionMaxChars safely sets the value for global configuration 'Media.DescriptionMaxChars' field
-func SetMediaDescriptionMaxChars(v int) { global.SetMediaDescriptionMaxChars(v) }
+// SetOIDCScopes safely sets the value for global configuration 'OIDCScopes' field
+func SetOIDCScopes(v []string) { global.SetOIDCScopes(v) }
 
-// GetMediaRemoteCacheDays safely fetches the Configuration value for state's 'Media.RemoteCacheDays' field
-func (st *ConfigState) GetMediaRemoteCacheDays() (v int) {
+// GetOIDCLinkExisting safely fetches the Configuration value for state's 'OIDCLinkExisting' field
+func (st *ConfigState) GetOIDCLinkExisting() (v bool) {
 	st.mutex.RLock()
-	v = st.config.Media.RemoteCacheDays
+	v = st.config.OIDCLinkExisting
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaRemoteCacheDays safely sets the Configuration value for state's 'Media.RemoteCacheDays' field
-func (st *ConfigState) SetMediaRemoteCacheDays(v int) {
+// SetOIDCLinkExisting safely sets the Configuration value for state's 'OIDCLinkExisting' field
+func (st *ConfigState) SetOIDCLinkExisting(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Media.RemoteCacheDays = v
+	st.config.OIDCLinkExisting = v
 	st.reloadToViper()
 }
 
-// GetMediaRemoteCacheDays safely fetches the value for global configuration 'Media.RemoteCacheDays' field
-func GetMediaRemoteCacheDays() int { return global.GetMediaRemoteCacheDays() }
+// GetOIDCLinkExisting safely fetches the value for global configuration 'OIDCLinkExisting' field
+func GetOIDCLinkExisting() bool { return global.GetOIDCLinkExisting() }
 
-// SetMediaRemoteCacheDays safely sets the value for global configuration 'Media.RemoteCacheDays' field
-func SetMediaRemoteCacheDays(v int) { global.SetMediaRemoteCacheDays(v) }
+// SetOIDCLinkExisting safely sets the value for global configuration 'OIDCLinkExisting' field
+func SetOIDCLinkExisting(v bool) { global.SetOIDCLinkExisting(v) }
 
-// GetMediaEmojiLocalMaxSize safely fetches the Configuration value for state's 'Media.EmojiLocalMaxSize' field
-func (st *ConfigState) GetMediaEmojiLocalMaxSize() (v bytesize.Size) {
+// GetOIDCAllowedGroups safely fetches the Configuration value for state's 'OIDCAllowedGroups' field
+func (st *ConfigState) GetOIDCAllowedGroups() (v []string) {
 	st.mutex.RLock()
-	v = st.config.Media.EmojiLocalMaxSize
+	v = st.config.OIDCAllowedGroups
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaEmojiLocalMaxSize safely sets the Configuration value for state's 'Media.EmojiLocalMaxSize' field
-func (st *ConfigState) SetMediaEmojiLocalMaxSize(v bytesize.Size) {
+// SetOIDCAllowedGroups safely sets the Configuration value for state's 'OIDCAllowedGroups' field
+func (st *ConfigState) SetOIDCAllowedGroups(v []string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Media.EmojiLocalMaxSize = v
+	st.config.OIDCAllowedGroups = v
 	st.reloadToViper()
 }
 
-// GetMediaEmojiLocalMaxSize safely fetches the value for global configuration 'Media.EmojiLocalMaxSize' field
-func GetMediaEmojiLocalMaxSize() bytesize.Size { return global.GetMediaEmojiLocalMaxSize() }
+// GetOIDCAllowedGroups safely fetches the value for global configuration 'OIDCAllowedGroups' field
+func GetOIDCAllowedGroups() []string { return global.GetOIDCAllowedGroups() }
 
-// SetMediaEmojiLocalMaxSize safely sets the value for global configuration 'Media.EmojiLocalMaxSize' field
-func SetMediaEmojiLocalMaxSize(v bytesize.Size) { global.SetMediaEmojiLocalMaxSize(v) }
+// SetOIDCAllowedGroups safely sets the value for global configuration 'OIDCAllowedGroups' field
+func SetOIDCAllowedGroups(v []string) { global.SetOIDCAllowedGroups(v) }
 
-// GetMediaEmojiRemoteMaxSize safely fetches the Configuration value for state's 'Media.EmojiRemoteMaxSize' field
-func (st *ConfigState) GetMediaEmojiRemoteMaxSize() (v bytesize.Size) {
+// GetOIDCAdminGroups safely fetches the Configuration value for state's 'OIDCAdminGroups' field
+func (st *ConfigState) GetOIDCAdminGroups() (v []string) {
 	st.mutex.RLock()
-	v = st.config.Media.EmojiRemoteMaxSize
+	v = st.config.OIDCAdminGroups
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaEmojiRemoteMaxSize safely sets the Configuration value for state's 'Media.EmojiRemoteMaxSize' field
-func (st *ConfigState) SetMediaEmojiRemoteMaxSize(v bytesize.Size) {
+// SetOIDCAdminGroups safely sets the Configuration value for state's 'OIDCAdminGroups' field
+func (st *ConfigState) SetOIDCAdminGroups(v []string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Media.EmojiRemoteMaxSize = v
+	st.config.OIDCAdminGroups = v
 	st.reloadToViper()
 }
 
-// GetMediaEmojiRemoteMaxSize safely fetches the value for global configuration 'Media.EmojiRemoteMaxSize' field
-func GetMediaEmojiRemoteMaxSize() bytesize.Size { return global.GetMediaEmojiRemoteMaxSize() }
+// GetOIDCAdminGroups safely fetches the value for global configuration 'OIDCAdminGroups' field
+func GetOIDCAdminGroups() []string { return global.GetOIDCAdminGroups() }
 
-// SetMediaEmojiRemoteMaxSize safely sets the value for global configuration 'Media.EmojiRemoteMaxSize' field
-func SetMediaEmojiRemoteMaxSize(v bytesize.Size) { global.SetMediaEmojiRemoteMaxSize(v) }
+// SetOIDCAdminGroups safely sets the value for global configuration 'OIDCAdminGroups' field
+func SetOIDCAdminGroups(v []string) { global.SetOIDCAdminGroups(v) }
 
-// GetMediaImageSizeHint safely fetches the Configuration value for state's 'Media.ImageSizeHint' field
-func (st *ConfigState) GetMediaImageSizeHint() (v bytesize.Size) {
+// GetTracingEnabled safely fetches the Configuration value for state's 'TracingEnabled' field
+func (st *ConfigState) GetTracingEnabled() (v bool) {
 	st.mutex.RLock()
-	v = st.config.Media.ImageSizeHint
+	v = st.config.TracingEnabled
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaImageSizeHint safely sets the Configuration value for state's 'Media.ImageSizeHint' field
-func (st *ConfigState) SetMediaImageSizeHint(v bytesize.Size) {
+// SetTracingEnabled safely sets the Configuration value for state's 'TracingEnabled' field
+func (st *ConfigState) SetTracingEnabled(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Media.ImageSizeHint = v
+	st.config.TracingEnabled = v
 	st.reloadToViper()
 }
 
-// GetMediaImageSizeHint safely fetches the value for global configuration 'Media.ImageSizeHint' field
-func GetMediaImageSizeHint() bytesize.Size { return global.GetMediaImageSizeHint() }
+// GetTracingEnabled safely fetches the value for global configuration 'TracingEnabled' field
+func GetTracingEnabled() bool { return global.GetTracingEnabled() }
 
-// SetMediaImageSizeHint safely sets the value for global configuration 'Media.ImageSizeHint' field
-func SetMediaImageSizeHint(v bytesize.Size) { global.SetMediaImageSizeHint(v) }
+// SetTracingEnabled safely sets the value for global configuration 'TracingEnabled' field
+func SetTracingEnabled(v bool) { global.SetTracingEnabled(v) }
 
-// GetMediaVideoSizeHint safely fetches the Configuration value for state's 'Media.VideoSizeHint' field
-func (st *ConfigState) GetMediaVideoSizeHint() (v bytesize.Size) {
+// GetMetricsEnabled safely fetches the Configuration value for state's 'MetricsEnabled' field
+func (st *ConfigState) GetMetricsEnabled() (v bool) {
 	st.mutex.RLock()
-	v = st.config.Media.VideoSizeHint
+	v = st.config.MetricsEnabled
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaVideoSizeHint safely sets the Configuration value for state's 'Media.VideoSizeHint' field
-func (st *ConfigState) SetMediaVideoSizeHint(v bytesize.Size) {
+// SetMetricsEnabled safely sets the Configuration value for state's 'MetricsEnabled' field
+func (st *ConfigState) SetMetricsEnabled(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Media.VideoSizeHint = v
+	st.config.MetricsEnabled = v
 	st.reloadToViper()
 }
 
-// GetMediaVideoSizeHint safely fetches the value for global configuration 'Media.VideoSizeHint' field
-func GetMediaVideoSizeHint() bytesize.Size { return global.GetMediaVideoSizeHint() }
+// GetMetricsEnabled safely fetches the value for global configuration 'MetricsEnabled' field
+func GetMetricsEnabled() bool { return global.GetMetricsEnabled() }
 
-// SetMediaVideoSizeHint safely sets the value for global configuration 'Media.VideoSizeHint' field
-func SetMediaVideoSizeHint(v bytesize.Size) { global.SetMediaVideoSizeHint(v) }
+// SetMetricsEnabled safely sets the value for global configuration 'MetricsEnabled' field
+func SetMetricsEnabled(v bool) { global.SetMetricsEnabled(v) }
 
-// GetMediaLocalMaxSize safely fetches the Configuration value for state's 'Media.LocalMaxSize' field
-func (st *ConfigState) GetMediaLocalMaxSize() (v bytesize.Size) {
+// GetDebugEndpointsEnabled safely fetches the Configuration value for state's 'DebugEndpointsEnabled' field
+func (st *ConfigState) GetDebugEndpointsEnabled() (v bool) {
 	st.mutex.RLock()
-	v = st.config.Media.LocalMaxSize
+	v = st.config.DebugEndpointsEnabled
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaLocalMaxSize safely sets the Configuration value for state's 'Media.LocalMaxSize' field
-func (st *ConfigState) SetMediaLocalMaxSize(v bytesize.Size) {
+// SetDebugEndpointsEnabled safely sets the Configuration value for state's 'DebugEndpointsEnabled' field
+func (st *ConfigState) SetDebugEndpointsEnabled(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Media.LocalMaxSize = v
+	st.config.DebugEndpointsEnabled = v
 	st.reloadToViper()
 }
 
-// GetMediaLocalMaxSize safely fetches the value for global configuration 'Media.LocalMaxSize' field
-func GetMediaLocalMaxSize() bytesize.Size { return global.GetMediaLocalMaxSize() }
+// GetDebugEndpointsEnabled safely fetches the value for global configuration 'DebugEndpointsEnabled' field
+func GetDebugEndpointsEnabled() bool { return global.GetDebugEndpointsEnabled() }
 
-// SetMediaLocalMaxSize safely sets the value for global configuration 'Media.LocalMaxSize' field
-func SetMediaLocalMaxSize(v bytesize.Size) { global.SetMediaLocalMaxSize(v) }
+// SetDebugEndpointsEnabled safely sets the value for global configuration 'DebugEndpointsEnabled' field
+func SetDebugEndpointsEnabled(v bool) { global.SetDebugEndpointsEnabled(v) }
 
-// GetMediaRemoteMaxSize safely fetches the Configuration value for state's 'Media.RemoteMaxSize' field
-func (st *ConfigState) GetMediaRemoteMaxSize() (v bytesize.Size) {
+// GetSMTPHost safely fetches the Configuration value for state's 'SMTPHost' field
+func (st *ConfigState) GetSMTPHost() (v string) {
 	st.mutex.RLock()
-	v = st.config.Media.RemoteMaxSize
+	v = st.config.SMTPHost
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaRemoteMaxSize safely sets the Configuration value for state's 'Media.RemoteMaxSize' field
-func (st *ConfigState) SetMediaRemoteMaxSize(v bytesize.Size) {
+// SetSMTPHost safely sets the Configuration value for state's 'SMTPHost' field
+func (st *ConfigState) SetSMTPHost(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Media.RemoteMaxSize = v
+	st.config.SMTPHost = v
 	st.reloadToViper()
 }
 
-// GetMediaRemoteMaxSize safely fetches the value for global configuration 'Media.RemoteMaxSize' field
-func GetMediaRemoteMaxSize() bytesize.Size { return global.GetMediaRemoteMaxSize() }
+// GetSMTPHost safely fetches the value for global configuration 'SMTPHost' field
+func GetSMTPHost() string { return global.GetSMTPHost() }
 
-// SetMediaRemoteMaxSize safely sets the value for global configuration 'Media.RemoteMaxSize' field
-func SetMediaRemoteMaxSize(v bytesize.Size) { global.SetMediaRemoteMaxSize(v) }
+// SetSMTPHost safely sets the value for global configuration 'SMTPHost' field
+func SetSMTPHost(v string) { global.SetSMTPHost(v) }
 
-// GetMediaCleanupFrom safely fetches the Configuration value for state's 'Media.CleanupFrom' field
-func (st *ConfigState) GetMediaCleanupFrom() (v string) {
+// GetSMTPPort safely fetches the Configuration value for state's 'SMTPPort' field
+func (st *ConfigState) GetSMTPPort() (v int) {
 	st.mutex.RLock()
-	v = st.config.Media.CleanupFrom
+	v = st.config.SMTPPort
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaCleanupFrom safely sets the Configuration value for state's 'Media.CleanupFrom' field
-func (st *ConfigState) SetMediaCleanupFrom(v string) {
+// SetSMTPPort safely sets the Configuration value for state's 'SMTPPort' field
+func (st *ConfigState) SetSMTPPort(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Media.CleanupFrom = v
+	st.config.SMTPPort = v
 	st.reloadToViper()
 }
 
-// GetMediaCleanupFrom safely fetches the value for global configuration 'Media.CleanupFrom' field
-func GetMediaCleanupFrom() string { return global.GetMediaCleanupFrom() }
+// GetSMTPPort safely fetches the value for global configuration 'SMTPPort' field
+func GetSMTPPort() int { return global.GetSMTPPort() }
 
-// SetMediaCleanupFrom safely sets the value for global configuration 'Media.CleanupFrom' field
-func SetMediaCleanupFrom(v string) { global.SetMediaCleanupFrom(v) }
+// SetSMTPPort safely sets the value for global configuration 'SMTPPort' field
+func SetSMTPPort(v int) { global.SetSMTPPort(v) }
 
-// GetMediaCleanupEvery safely fetches the Configuration value for state's 'Media.CleanupEvery' field
-func (st *ConfigState) GetMediaCleanupEvery() (v time.Duration) {
+// GetSMTPUsername safely fetches the Configuration value for state's 'SMTPUsername' field
+func (st *ConfigState) GetSMTPUsername() (v string) {
 	st.mutex.RLock()
-	v = st.config.Media.CleanupEvery
+	v = st.config.SMTPUsername
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaCleanupEvery safely sets the Configuration value for state's 'Media.CleanupEvery' field
-func (st *ConfigState) SetMediaCleanupEvery(v time.Duration) {
+// SetSMTPUsername safely sets the Configuration value for state's 'SMTPUsername' field
+func (st *ConfigState) SetSMTPUsername(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Media.CleanupEvery = v
+	st.config.SMTPUsername = v
 	st.reloadToViper()
 }
 
-// GetMediaCleanupEvery safely fetches the value for global configuration 'Media.CleanupEvery' field
-func GetMediaCleanupEvery() time.Duration { return global.GetMediaCleanupEvery() }
+// GetSMTPUsername safely fetches the value for global configuration 'SMTPUsername' field
+func GetSMTPUsername() string { return global.GetSMTPUsername() }
 
-// SetMediaCleanupEvery safely sets the value for global configuration 'Media.CleanupEvery' field
-func SetMediaCleanupEvery(v time.Duration) { global.SetMediaCleanupEvery(v) }
+// SetSMTPUsername safely sets the value for global configuration 'SMTPUsername' field
+func SetSMTPUsername(v string) { global.SetSMTPUsername(v) }
 
-// GetMediaFfmpegPoolSize safely fetches the Configuration value for state's 'Media.FfmpegPoolSize' field
-func (st *ConfigState) GetMediaFfmpegPoolSize() (v int) {
+// GetSMTPPassword safely fetches the Configuration value for state's 'SMTPPassword' field
+func (st *ConfigState) GetSMTPPassword() (v string) {
 	st.mutex.RLock()
-	v = st.config.Media.FfmpegPoolSize
+	v = st.config.SMTPPassword
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaFfmpegPoolSize safely sets the Configuration value for state's 'Media.FfmpegPoolSize' field
-func (st *ConfigState) SetMediaFfmpegPoolSize(v int) {
+// SetSMTPPassword safely sets the Configuration value for state's 'SMTPPassword' field
+func (st *ConfigState) SetSMTPPassword(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Media.FfmpegPoolSize = v
+	st.config.SMTPPassword = v
 	st.reloadToViper()
 }
 
-// GetMediaFfmpegPoolSize safely fetches the value for global configuration 'Media.FfmpegPoolSize' field
-func GetMediaFfmpegPoolSize() int { return global.GetMediaFfmpegPoolSize() }
+// GetSMTPPassword safely fetches the value for global configuration 'SMTPPassword' field
+func GetSMTPPassword() string { return global.GetSMTPPassword() }
 
-// SetMediaFfmpegPoolSize safely sets the value for global configuration 'Media.FfmpegPoolSize' field
-func SetMediaFfmpegPoolSize(v int) { global.SetMediaFfmpegPoolSize(v) }
+// SetSMTPPassword safely sets the value for global configuration 'SMTPPassword' field
+func SetSMTPPassword(v string) { global.SetSMTPPassword(v) }
 
-// GetMediaThumbMaxPixels safely fetches the Configuration value for state's 'Media.ThumbMaxPixels' field
-func (st *ConfigState) GetMediaThumbMaxPixels() (v int) {
+// GetSMTPFrom safely fetches the Configuration value for state's 'SMTPFrom' field
+func (st *ConfigState) GetSMTPFrom() (v string) {
 	st.mutex.RLock()
-	v = st.config.Media.ThumbMaxPixels
+	v = st.config.SMTPFrom
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaThumbMaxPixels safely sets the Configuration value for state's 'Media.ThumbMaxPixels' field
-func (st *ConfigState) SetMediaThumbMaxPixels(v int) {
+// SetSMTPFrom safely sets the Configuration value for state's 'SMTPFrom' field
+func (st *ConfigState) SetSMTPFrom(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Media.ThumbMaxPixels = v
+	st.config.SMTPFrom = v
 	st.reloadToViper()
 }
 
-// GetMediaThumbMaxPixels safely fetches the value for global configuration 'Media.ThumbMaxPixels' field
-func GetMediaThumbMaxPixels() int { return global.GetMediaThumbMaxPixels() }
+// GetSMTPFrom safely fetches the value for global configuration 'SMTPFrom' field
+func GetSMTPFrom() string { return global.GetSMTPFrom() }
 
-// SetMediaThumbMaxPixels safely sets the value for global configuration 'Media.ThumbMaxPixels' field
-func SetMediaThumbMaxPixels(v int) { global.SetMediaThumbMaxPixels(v) }
+// SetSMTPFrom safely sets the value for global configuration 'SMTPFrom' field
+func SetSMTPFrom(v string) { global.SetSMTPFrom(v) }
 
-// GetCacheS3ObjectInfo safely fetches the Configuration value for state's 'Cache.S3ObjectInfo' field
-func (st *ConfigState) GetCacheS3ObjectInfo() (v int) {
+// GetSMTPDiscloseRecipients safely fetches the Configuration value for state's 'SMTPDiscloseRecipients' field
+func (st *ConfigState) GetSMTPDiscloseRecipients() (v bool) {
 	st.mutex.RLock()
-	v = st.config.Cache.S3ObjectInfo
+	v = st.config.SMTPDiscloseRecipients
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheS3ObjectInfo safely sets the Configuration value for state's 'Cache.S3ObjectInfo' field
-func (st *ConfigState) SetCacheS3ObjectInfo(v int) {
+// SetSMTPDiscloseRecipients safely sets the Configuration value for state's 'SMTPDiscloseRecipients' field
+func (st *ConfigState) SetSMTPDiscloseRecipients(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.S3ObjectInfo = v
+	st.config.SMTPDiscloseRecipients = v
 	st.reloadToViper()
 }
 
-// GetCacheS3ObjectInfo safely fetches the value for global configuration 'Cache.S3ObjectInfo' field
-func GetCacheS3ObjectInfo() int { return global.GetCacheS3ObjectInfo() }
+// GetSMTPDiscloseRecipients safely fetches the value for global configuration 'SMTPDiscloseRecipients' field
+func GetSMTPDiscloseRecipients() bool { return global.GetSMTPDiscloseRecipients() }
 
-// SetCacheS3ObjectInfo safely sets the value for global configuration 'Cache.S3ObjectInfo' field
-func SetCacheS3ObjectInfo(v int) { global.SetCacheS3ObjectInfo(v) }
+// SetSMTPDiscloseRecipients safely sets the value for global configuration 'SMTPDiscloseRecipients' field
+func SetSMTPDiscloseRecipients(v bool) { global.SetSMTPDiscloseRecipients(v) }
 
-// GetCacheHomeTimelineTimeout safely fetches the Configuration value for state's 'Cache.HomeTimelineTimeout' field
-func (st *ConfigState) GetCacheHomeTimelineTimeout() (v time.Duration) {
+// GetSyslogEnabled safely fetches the Configuration value for state's 'SyslogEnabled' field
+func (st *ConfigState) GetSyslogEnabled() (v bool) {
 	st.mutex.RLock()
-	v = st.config.Cache.HomeTimelineTimeout
+	v = st.config.SyslogEnabled
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheHomeTimelineTimeout safely sets the Configuration value for state's 'Cache.HomeTimelineTimeout' field
-func (st *ConfigState) SetCacheHomeTimelineTimeout(v time.Duration) {
+// SetSyslogEnabled safely sets the Configuration value for state's 'SyslogEnabled' field
+func (st *ConfigState) SetSyslogEnabled(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.HomeTimelineTimeout = v
+	st.config.SyslogEnabled = v
 	st.reloadToViper()
 }
 
-// GetCacheHomeTimelineTimeout safely fetches the value for global configuration 'Cache.HomeTimelineTimeout' field
-func GetCacheHomeTimelineTimeout() time.Duration { return global.GetCacheHomeTimelineTimeout() }
+// GetSyslogEnabled safely fetches the value for global configuration 'SyslogEnabled' field
+func GetSyslogEnabled() bool { return global.GetSyslogEnabled() }
 
-// SetCacheHomeTimelineTimeout safely sets the value for global configuration 'Cache.HomeTimelineTimeout' field
-func SetCacheHomeTimelineTimeout(v time.Duration) { global.SetCacheHomeTimelineTimeout(v) }
+// SetSyslogEnabled safely sets the value for global configuration 'SyslogEnabled' field
+func SetSyslogEnabled(v bool) { global.SetSyslogEnabled(v) }
 
-// GetCacheListTimelineTimeout safely fetches the Configuration value for state's 'Cache.ListTimelineTimeout' field
-func (st *ConfigState) GetCacheListTimelineTimeout() (v time.Duration) {
+// GetSyslogProtocol safely fetches the Configuration value for state's 'SyslogProtocol' field
+func (st *ConfigState) GetSyslogProtocol() (v string) {
 	st.mutex.RLock()
-	v = st.config.Cache.ListTimelineTimeout
+	v = st.config.SyslogProtocol
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheListTimelineTimeout safely sets the Configuration value for state's 'Cache.ListTimelineTimeout' field
-func (st *ConfigState) SetCacheListTimelineTimeout(v time.Duration) {
+// SetSyslogProtocol safely sets the Configuration value for state's 'SyslogProtocol' field
+func (st *ConfigState) SetSyslogProtocol(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.ListTimelineTimeout = v
+	st.config.SyslogProtocol = v
 	st.reloadToViper()
 }
 
-// GetCacheListTimelineTimeout safely fetches the value for global configuration 'Cache.ListTimelineTimeout' field
-func GetCacheListTimelineTimeout() time.Duration { return global.GetCacheListTimelineTimeout() }
+// GetSyslogProtocol safely fetches the value for global configuration 'SyslogProtocol' field
+func GetSyslogProtocol() string { return global.GetSyslogProtocol() }
 
-// SetCacheListTimelineTimeout safely sets the value for global configuration 'Cache.ListTimelineTimeout' field
-func SetCacheListTimelineTimeout(v time.Duration) { global.SetCacheListTimelineTimeout(v) }
+// SetSyslogProtocol safely sets the value for global configuration 'SyslogProtocol' field
+func SetSyslogProtocol(v string) { global.SetSyslogProtocol(v) }
 
-// GetCacheTagTimelineTimeout safely fetches the Configuration value for state's 'Cache.TagTimelineTimeout' field
-func (st *ConfigState) GetCacheTagTimelineTimeout() (v time.Duration) {
+// GetSyslogAddress safely fetches the Configuration value for state's 'SyslogAddress' field
+func (st *ConfigState) GetSyslogAddress() (v string) {
 	st.mutex.RLock()
-	v = st.config.Cache.TagTimelineTimeout
+	v = st.config.SyslogAddress
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheTagTimelineTimeout safely sets the Configuration value for state's 'Cache.TagTimelineTimeout' field
-func (st *ConfigState) SetCacheTagTimelineTimeout(v time.Duration) {
+// SetSyslogAddress safely sets the Configuration value for state's 'SyslogAddress' field
+func (st *ConfigState) SetSyslogAddress(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.TagTimelineTimeout = v
+	st.config.SyslogAddress = v
 	st.reloadToViper()
 }
 
-// GetCacheTagTimelineTimeout safely fetches the value for global configuration 'Cache.TagTimelineTimeout' field
-func GetCacheTagTimelineTimeout() time.Duration { return global.GetCacheTagTimelineTimeout() }
+// GetSyslogAddress safely fetches the value for global configuration 'SyslogAddress' field
+func GetSyslogAddress() string { return global.GetSyslogAddress() }
 
-// SetCacheTagTimelineTimeout safely sets the value for global configuration 'Cache.TagTimelineTimeout' field
-func SetCacheTagTimelineTimeout(v time.Duration) { global.SetCacheTagTimelineTimeout(v) }
+// SetSyslogAddress safely sets the value for global configuration 'SyslogAddress' field
+func SetSyslogAddress(v string) { global.SetSyslogAddress(v) }
 
-// GetCacheMemoryTarget safely fetches the Configuration value for state's 'Cache.MemoryTarget' field
-func (st *ConfigState) GetCacheMemoryTarget() (v bytesize.Size) {
+// GetModerationHookStatusCreateURL safely fetches the Configuration value for state's 'ModerationHookStatusCreateURL' field
+func (st *ConfigState) GetModerationHookStatusCreateURL() (v string) {
 	st.mutex.RLock()
-	v = st.config.Cache.MemoryTarget
+	v = st.config.ModerationHookStatusCreateURL
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheMemoryTarget safely sets the Configuration value for state's 'Cache.MemoryTarget' field
-func (st *ConfigState) SetCacheMemoryTarget(v bytesize.Size) {
+// SetModerationHookStatusCreateURL safely sets the Configuration value for state's 'ModerationHookStatusCreateURL' field
+func (st *ConfigState) SetModerationHookStatusCreateURL(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.MemoryTarget = v
+	st.config.ModerationHookStatusCreateURL = v
 	st.reloadToViper()
 }
 
-// GetCacheMemoryTarget safely fetches the value for global configuration 'Cache.MemoryTarget' field
-func GetCacheMemoryTarget() bytesize.Size { return global.GetCacheMemoryTarget() }
+// GetModerationHookStatusCreateURL safely fetches the value for global configuration 'ModerationHookStatusCreateURL' field
+func GetModerationHookStatusCreateURL() string { return global.GetModerationHookStatusCreateURL() }
 
-// SetCacheMemoryTarget safely sets the value for global configuration 'Cache.MemoryTarget' field
-func SetCacheMemoryTarget(v bytesize.Size) { global.SetCacheMemoryTarget(v) }
+// SetModerationHookStatusCreateURL safely sets the value for global configuration 'ModerationHookStatusCreateURL' field
+func SetModerationHookStatusCreateURL(v string) { global.SetModerationHookStatusCreateURL(v) }
 
-// GetCacheAccountMemRatio safely fetches the Configuration value for state's 'Cache.AccountMemRatio' field
-func (st *ConfigState) GetCacheAccountMemRatio() (v float64) {
+// GetModerationHookFederationIngestURL safely fetches the Configuration value for state's 'ModerationHookFederationIngestURL' field
+func (st *ConfigState) GetModerationHookFederationIngestURL() (v string) {
 	st.mutex.RLock()
-	v = st.config.Cache.AccountMemRatio
+	v = st.config.ModerationHookFederationIngestURL
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheAccountMemRatio safely sets the Configuration value for state's 'Cache.AccountMemRatio' field
-func (st *ConfigState) SetCacheAccountMemRatio(v float64) {
+// SetModerationHookFederationIngestURL safely sets the Configuration value for state's 'ModerationHookFederationIngestURL' field
+func (st *ConfigState) SetModerationHookFederationIngestURL(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.AccountMemRatio = v
+	st.config.ModerationHookFederationIngestURL = v
 	st.reloadToViper()
 }
 
-// GetCacheAccountMemRatio safely fetches the value for global configuration 'Cache.AccountMemRatio' field
-func GetCacheAccountMemRatio() float64 { return global.GetCacheAccountMemRatio() }
+// GetModerationHookFederationIngestURL safely fetches the value for global configuration 'ModerationHookFederationIngestURL' field
+func GetModerationHookFederationIngestURL() string {
+	return global.GetModerationHookFederationIngestURL()
+}
 
-// SetCacheAccountMemRatio safely sets the value for global configuration 'Cache.AccountMemRatio' field
-func SetCacheAccountMemRatio(v float64) { global.SetCacheAccountMemRatio(v) }
+// SetModerationHookFederationIngestURL safely sets the value for global configuration 'ModerationHookFederationIngestURL' field
+func SetModerationHookFederationIngestURL(v string) { global.SetModerationHookFederationIngestURL(v) }
 
-// GetCacheAccountNoteMemRatio safely fetches the Configuration value for state's 'Cache.AccountNoteMemRatio' field
-func (st *ConfigState) GetCacheAccountNoteMemRatio() (v float64) {
+// GetModerationHookMediaStoreURL safely fetches the Configuration value for state's 'ModerationHookMediaStoreURL' field
+func (st *ConfigState) GetModerationHookMediaStoreURL() (v string) {
 	st.mutex.RLock()
-	v = st.config.Cache.AccountNoteMemRatio
+	v = st.config.ModerationHookMediaStoreURL
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheAccountNoteMemRatio safely sets the Configuration value for state's 'Cache.AccountNoteMemRatio' field
-func (st *ConfigState) SetCacheAccountNoteMemRatio(v float64) {
+// SetModerationHookMediaStoreURL safely sets the Configuration value for state's 'ModerationHookMediaStoreURL' field
+func (st *ConfigState) SetModerationHookMediaStoreURL(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.AccountNoteMemRatio = v
+	st.config.ModerationHookMediaStoreURL = v
 	st.reloadToViper()
 }
 
-// GetCacheAccountNoteMemRatio safely fetches the value for global configuration 'Cache.AccountNoteMemRatio' field
-func GetCacheAccountNoteMemRatio() float64 { return global.GetCacheAccountNoteMemRatio() }
+// GetModerationHookMediaStoreURL safely fetches the value for global configuration 'ModerationHookMediaStoreURL' field
+func GetModerationHookMediaStoreURL() string { return global.GetModerationHookMediaStoreURL() }
 
-// SetCacheAccountNoteMemRatio safely sets the value for global configuration 'Cache.AccountNoteMemRatio' field
-func SetCacheAccountNoteMemRatio(v float64) { global.SetCacheAccountNoteMemRatio(v) }
+// SetModerationHookMediaStoreURL safely sets the value for global configuration 'ModerationHookMediaStoreURL' field
+func SetModerationHookMediaStoreURL(v string) { global.SetModerationHookMediaStoreURL(v) }
 
-// GetCacheAccountSettingsMemRatio safely fetches the Configuration value for state's 'Cache.AccountSettingsMemRatio' field
-func (st *ConfigState) GetCacheAccountSettingsMemRatio() (v float64) {
+// GetModerationHookTimeout safely fetches the Configuration value for state's 'ModerationHookTimeout' field
+func (st *ConfigState) GetModerationHookTimeout() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.Cache.AccountSettingsMemRatio
+	v = st.config.ModerationHookTimeout
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheAccountSettingsMemRatio safely sets the Configuration value for state's 'Cache.AccountSettingsMemRatio' field
-func (st *ConfigState) SetCacheAccountSettingsMemRatio(v float64) {
+// SetModerationHookTimeout safely sets the Configuration value for state's 'ModerationHookTimeout' field
+func (st *ConfigState) SetModerationHookTimeout(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.AccountSettingsMemRatio = v
+	st.config.ModerationHookTimeout = v
 	st.reloadToViper()
 }
 
-// GetCacheAccountSettingsMemRatio safely fetches the value for global configuration 'Cache.AccountSettingsMemRatio' field
-func GetCacheAccountSettingsMemRatio() float64 { return global.GetCacheAccountSettingsMemRatio() }
+// GetModerationHookTimeout safely fetches the value for global configuration 'ModerationHookTimeout' field
+func GetModerationHookTimeout() time.Duration { return global.GetModerationHookTimeout() }
 
-// SetCacheAccountSettingsMemRatio safely sets the value for global configuration 'Cache.AccountSettingsMemRatio' field
-func SetCacheAccountSettingsMemRatio(v float64) { global.SetCacheAccountSettingsMemRatio(v) }
+// SetModerationHookTimeout safely sets the value for global configuration 'ModerationHookTimeout' field
+func SetModerationHookTimeout(v time.Duration) { global.SetModerationHookTimeout(v) }
 
-// GetCacheAccountStatsMemRatio safely fetches the Configuration value for state's 'Cache.AccountStatsMemRatio' field
-func (st *ConfigState) GetCacheAccountStatsMemRatio() (v float64) {
+// GetModerationHookFailClosed safely fetches the Configuration value for state's 'ModerationHookFailClosed' field
+func (st *ConfigState) GetModerationHookFailClosed() (v bool) {
 	st.mutex.RLock()
-	v = st.config.Cache.AccountStatsMemRatio
+	v = st.config.ModerationHookFailClosed
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheAccountStatsMemRatio safely sets the Configuration value for state's 'Cache.AccountStatsMemRatio' field
-func (st *ConfigState) SetCacheAccountStatsMemRatio(v float64) {
+// SetModerationHookFailClosed safely sets the Configuration value for state's 'ModerationHookFailClosed' field
+func (st *ConfigState) SetModerationHookFailClosed(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.AccountStatsMemRatio = v
+	st.config.ModerationHookFailClosed = v
 	st.reloadToViper()
 }
 
-// GetCacheAccountStatsMemRatio safely fetches the value for global configuration 'Cache.AccountStatsMemRatio' field
-func GetCacheAccountStatsMemRatio() float64 { return global.GetCacheAccountStatsMemRatio() }
+// GetModerationHookFailClosed safely fetches the value for global configuration 'ModerationHookFailClosed' field
+func GetModerationHookFailClosed() bool { return global.GetModerationHookFailClosed() }
 
-// SetCacheAccountStatsMemRatio safely sets the value for global configuration 'Cache.AccountStatsMemRatio' field
-func SetCacheAccountStatsMemRatio(v float64) { global.SetCacheAccountStatsMemRatio(v) }
+// SetModerationHookFailClosed safely sets the value for global configuration 'ModerationHookFailClosed' field
+func SetModerationHookFailClosed(v bool) { global.SetModerationHookFailClosed(v) }
 
-// GetCacheApplicationMemRatio safely fetches the Configuration value for state's 'Cache.ApplicationMemRatio' field
-func (st *ConfigState) GetCacheApplicationMemRatio() (v float64) {
+// GetTranslationBackend safely fetches the Configuration value for state's 'TranslationBackend' field
+func (st *ConfigState) GetTranslationBackend() (v string) {
 	st.mutex.RLock()
-	v = st.config.Cache.ApplicationMemRatio
+	v = st.config.TranslationBackend
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheApplicationMemRatio safely sets the Configuration value for state's 'Cache.ApplicationMemRatio' field
-func (st *ConfigState) SetCacheApplicationMemRatio(v float64) {
+// SetTranslationBackend safely sets the Configuration value for state's 'TranslationBackend' field
+func (st *ConfigState) SetTranslationBackend(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.ApplicationMemRatio = v
+	st.config.TranslationBackend = v
 	st.reloadToViper()
 }
 
-// GetCacheApplicationMemRatio safely fetches the value for global configuration 'Cache.ApplicationMemRatio' field
-func GetCacheApplicationMemRatio() float64 { return global.GetCacheApplicationMemRatio() }
+// GetTranslationBackend safely fetches the value for global configuration 'TranslationBackend' field
+func GetTranslationBackend() string { return global.GetTranslationBackend() }
 
-// SetCacheApplicationMemRatio safely sets the value for global configuration 'Cache.ApplicationMemRatio' field
-func SetCacheApplicationMemRatio(v float64) { global.SetCacheApplicationMemRatio(v) }
+// SetTranslationBackend safely sets the value for global configuration 'TranslationBackend' field
+func SetTranslationBackend(v string) { global.SetTranslationBackend(v) }
 
-// GetCacheBlockMemRatio safely fetches the Configuration value for state's 'Cache.BlockMemRatio' field
-func (st *ConfigState) GetCacheBlockMemRatio() (v float64) {
+// GetTranslationURL safely fetches the Configuration value for state's 'TranslationURL' field
+func (st *ConfigState) GetTranslationURL() (v string) {
 	st.mutex.RLock()
-	v = st.config.Cache.BlockMemRatio
+	v = st.config.TranslationURL
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheBlockMemRatio safely sets the Configuration value for state's 'Cache.BlockMemRatio' field
-func (st *ConfigState) SetCacheBlockMemRatio(v float64) {
+// SetTranslationURL safely sets the Configuration value for state's 'TranslationURL' field
+func (st *ConfigState) SetTranslationURL(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.BlockMemRatio = v
+	st.config.TranslationURL = v
 	st.reloadToViper()
 }
 
-// GetCacheBlockMemRatio safely fetches the value for global configuration 'Cache.BlockMemRatio' field
-func GetCacheBlockMemRatio() float64 { return global.GetCacheBlockMemRatio() }
+// GetTranslationURL safely fetches the value for global configuration 'TranslationURL' field
+func GetTranslationURL() string { return global.GetTranslationURL() }
 
-// SetCacheBlockMemRatio safely sets the value for global configuration 'Cache.BlockMemRatio' field
-func SetCacheBlockMemRatio(v float64) { global.SetCacheBlockMemRatio(v) }
+// SetTranslationURL safely sets the value for global configuration 'TranslationURL' field
+func SetTranslationURL(v string) { global.SetTranslationURL(v) }
 
-// GetCacheBlockIDsMemRatio safely fetches the Configuration value for state's 'Cache.BlockIDsMemRatio' field
-func (st *ConfigState) GetCacheBlockIDsMemRatio() (v float64) {
+// GetTranslationAPIKey safely fetches the Configuration value for state's 'TranslationAPIKey' field
+func (st *ConfigState) GetTranslationAPIKey() (v string) {
 	st.mutex.RLock()
-	v = st.config.Cache.BlockIDsMemRatio
+	v = st.config.TranslationAPIKey
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheBlockIDsMemRatio safely sets the Configuration value for state's 'Cache.BlockIDsMemRatio' field
-func (st *ConfigState) SetCacheBlockIDsMemRatio(v float64) {
+// SetTranslationAPIKey safely sets the Configuration value for state's 'TranslationAPIKey' field
+func (st *ConfigState) SetTranslationAPIKey(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.BlockIDsMemRatio = v
+	st.config.TranslationAPIKey = v
 	st.reloadToViper()
 }
 
-// GetCacheBlockIDsMemRatio safely fetches the value for global configuration 'Cache.BlockIDsMemRatio' field
-func GetCacheBlockIDsMemRatio() float64 { return global.GetCacheBlockIDsMemRatio() }
+// GetTranslationAPIKey safely fetches the value for global configuration 'TranslationAPIKey' field
+func GetTranslationAPIKey() string { return global.GetTranslationAPIKey() }
 
-// SetCacheBlockIDsMemRatio safely sets the value for global configuration 'Cache.BlockIDsMemRatio' field
-func SetCacheBlockIDsMemRatio(v float64) { global.SetCacheBlockIDsMemRatio(v) }
+// SetTranslationAPIKey safely sets the value for global configuration 'TranslationAPIKey' field
+func SetTranslationAPIKey(v string) { global.SetTranslationAPIKey(v) }
 
-// GetCacheBoostOfIDsMemRatio safely fetches the Configuration value for state's 'Cache.BoostOfIDsMemRatio' field
-func (st *ConfigState) GetCacheBoostOfIDsMemRatio() (v float64) {
+// GetTranslationRateLimit safely fetches the Configuration value for state's 'TranslationRateLimit' field
+func (st *ConfigState) GetTranslationRateLimit() (v int) {
 	st.mutex.RLock()
-	v = st.config.Cache.BoostOfIDsMemRatio
+	v = st.config.TranslationRateLimit
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheBoostOfIDsMemRatio safely sets the Configuration value for state's 'Cache.BoostOfIDsMemRatio' field
-func (st *ConfigState) SetCacheBoostOfIDsMemRatio(v float64) {
+// SetTranslationRateLimit safely sets the Configuration value for state's 'TranslationRateLimit' field
+func (st *ConfigState) SetTranslationRateLimit(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.BoostOfIDsMemRatio = v
+	st.config.TranslationRateLimit = v
 	st.reloadToViper()
 }
 
-// GetCacheBoostOfIDsMemRatio safely fetches the value for global configuration 'Cache.BoostOfIDsMemRatio' field
-func GetCacheBoostOfIDsMemRatio() float64 { return global.GetCacheBoostOfIDsMemRatio() }
+// GetTranslationRateLimit safely fetches the value for global configuration 'TranslationRateLimit' field
+func GetTranslationRateLimit() int { return global.GetTranslationRateLimit() }
 
-// SetCacheBoostOfIDsMemRatio safely sets the value for global configuration 'Cache.BoostOfIDsMemRatio' field
-func SetCacheBoostOfIDsMemRatio(v float64) { global.SetCacheBoostOfIDsMemRatio(v) }
+// SetTranslationRateLimit safely sets the value for global configuration 'TranslationRateLimit' field
+func SetTranslationRateLimit(v int) { global.SetTranslationRateLimit(v) }
 
-// GetCacheClientMemRatio safely fetches the Configuration value for state's 'Cache.ClientMemRatio' field
-func (st *ConfigState) GetCacheClientMemRatio() (v float64) {
+// GetReadOnlyMode safely fetches the Configuration value for state's 'ReadOnlyMode' field
+func (st *ConfigState) GetReadOnlyMode() (v bool) {
 	st.mutex.RLock()
-	v = st.config.Cache.ClientMemRatio
+	v = st.config.ReadOnlyMode
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheClientMemRatio safely sets the Configuration value for state's 'Cache.ClientMemRatio' field
-func (st *ConfigState) SetCacheClientMemRatio(v float64) {
+// SetReadOnlyMode safely sets the Configuration value for state's 'ReadOnlyMode' field
+func (st *ConfigState) SetReadOnlyMode(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.ClientMemRatio = v
+	st.config.ReadOnlyMode = v
 	st.reloadToViper()
 }
 
-// GetCacheClientMemRatio safely fetches the value for global configuration 'Cache.ClientMemRatio' field
-func GetCacheClientMemRatio() float64 { return global.GetCacheClientMemRatio() }
+// GetReadOnlyMode safely fetches the value for global configuration 'ReadOnlyMode' field
+func GetReadOnlyMode() bool { return global.GetReadOnlyMode() }
 
-// SetCacheClientMemRatio safely sets the value for global configuration 'Cache.ClientMemRatio' field
-func SetCacheClientMemRatio(v float64) { global.SetCacheClientMemRatio(v) }
+// SetReadOnlyMode safely sets the value for global configuration 'ReadOnlyMode' field
+func SetReadOnlyMode(v bool) { global.SetReadOnlyMode(v) }
 
-// GetCacheConversationMemRatio safely fetches the Configuration value for state's 'Cache.ConversationMemRatio' field
-func (st *ConfigState) GetCacheConversationMemRatio() (v float64) {
+// GetReadOnlyRetryAfter safely fetches the Configuration value for state's 'ReadOnlyRetryAfter' field
+func (st *ConfigState) GetReadOnlyRetryAfter() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.Cache.ConversationMemRatio
+	v = st.config.ReadOnlyRetryAfter
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheConversationMemRatio safely sets the Configuration value for state's 'Cache.ConversationMemRatio' field
-func (st *ConfigState) SetCacheConversationMemRatio(v float64) {
+// SetReadOnlyRetryAfter safely sets the Configuration value for state's 'ReadOnlyRetryAfter' field
+func (st *ConfigState) SetReadOnlyRetryAfter(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.ConversationMemRatio = v
+	st.config.ReadOnlyRetryAfter = v
 	st.reloadToViper()
 }
 
-// GetCacheConversationMemRatio safely fetches the value for global configuration 'Cache.ConversationMemRatio' field
-func GetCacheConversationMemRatio() float64 { return global.GetCacheConversationMemRatio() }
+// GetReadOnlyRetryAfter safely fetches the value for global configuration 'ReadOnlyRetryAfter' field
+func GetReadOnlyRetryAfter() time.Duration { return global.GetReadOnlyRetryAfter() }
 
-// SetCacheConversationMemRatio safely sets the value for global configuration 'Cache.ConversationMemRatio' field
-func SetCacheConversationMemRatio(v float64) { global.SetCacheConversationMemRatio(v) }
+// SetReadOnlyRetryAfter safely sets the value for global configuration 'ReadOnlyRetryAfter' field
+func SetReadOnlyRetryAfter(v time.Duration) { global.SetReadOnlyRetryAfter(v) }
 
-// GetCacheConversationLastStatusIDsMemRatio safely fetches the Configuration value for state's 'Cache.ConversationLastStatusIDsMemRatio' field
-func (st *ConfigState) GetCacheConversationLastStatusIDsMemRatio() (v float64) {
+// GetShutdownDrainTimeout safely fetches the Configuration value for state's 'ShutdownDrainTimeout' field
+func (st *ConfigState) GetShutdownDrainTimeout() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.Cache.ConversationLastStatusIDsMemRatio
+	v = st.config.ShutdownDrainTimeout
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheConversationLastStatusIDsMemRatio safely sets the Configuration value for state's 'Cache.ConversationLastStatusIDsMemRatio' field
-func (st *ConfigState) SetCacheConversationLastStatusIDsMemRatio(v float64) {
+// SetShutdownDrainTimeout safely sets the Configuration value for state's 'ShutdownDrainTimeout' field
+func (st *ConfigState) SetShutdownDrainTimeout(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.ConversationLastStatusIDsMemRatio = v
+	st.config.ShutdownDrainTimeout = v
 	st.reloadToViper()
 }
 
-// GetCacheConversationLastStatusIDsMemRatio safely fetches the value for global configuration 'Cache.ConversationLastStatusIDsMemRatio' field
-func GetCacheConversationLastStatusIDsMemRatio() float64 {
-	return global.GetCacheConversationLastStatusIDsMemRatio()
-}
+// GetShutdownDrainTimeout safely fetches the value for global configuration 'ShutdownDrainTimeout' field
+func GetShutdownDrainTimeout() time.Duration { return global.GetShutdownDrainTimeout() }
 
-// SetCacheConversationLastStatusIDsMemRatio safely sets the value for global configuration 'Cache.ConversationLastStatusIDsMemRatio' field
-func SetCacheConversationLastStatusIDsMemRatio(v float64) {
-	global.SetCacheConversationLastStatusIDsMemRatio(v)
-}
+// SetShutdownDrainTimeout safely sets the value for global configuration 'ShutdownDrainTimeout' field
+func SetShutdownDrainTimeout(v time.Duration) { global.SetShutdownDrainTimeout(v) }
 
-// GetCacheDomainPermissionDraftMemRatio safely fetches the Configuration value for state's 'Cache.DomainPermissionDraftMemRatio' field
-func (st *ConfigState) GetCacheDomainPermissionDraftMemRatio() (v float64) {
+// GetAdvancedCookiesSamesite safely fetches the Configuration value for state's 'Advanced.CookiesSamesite' field
+func (st *ConfigState) GetAdvancedCookiesSamesite() (v string) {
 	st.mutex.RLock()
-	v = st.config.Cache.DomainPermissionDraftMemRatio
+	v = st.config.Advanced.CookiesSamesite
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheDomainPermissionDraftMemRatio safely sets the Configuration value for state's 'Cache.DomainPermissionDraftMemRatio' field
-func (st *ConfigState) SetCacheDomainPermissionDraftMemRatio(v float64) {
+// SetAdvancedCookiesSamesite safely sets the Configuration value for state's 'Advanced.CookiesSamesite' field
+func (st *ConfigState) SetAdvancedCookiesSamesite(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.DomainPermissionDraftMemRatio = v
+	st.config.Advanced.CookiesSamesite = v
 	st.reloadToViper()
 }
 
-// GetCacheDomainPermissionDraftMemRatio safely fetches the value for global configuration 'Cache.DomainPermissionDraftMemRatio' field
-func GetCacheDomainPermissionDraftMemRatio() float64 {
-	return global.GetCacheDomainPermissionDraftMemRatio()
-}
+// GetAdvancedCookiesSamesite safely fetches the value for global configuration 'Advanced.CookiesSamesite' field
+func GetAdvancedCookiesSamesite() string { return global.GetAdvancedCookiesSamesite() }
 
-// SetCacheDomainPermissionDraftMemRatio safely sets the value for global configuration 'Cache.DomainPermissionDraftMemRatio' field
-func SetCacheDomainPermissionDraftMemRatio(v float64) {
-	global.SetCacheDomainPermissionDraftMemRatio(v)
-}
+// SetAdvancedCookiesSamesite safely sets the value for global configuration 'Advanced.CookiesSamesite' field
+func SetAdvancedCookiesSamesite(v string) { global.SetAdvancedCookiesSamesite(v) }
 
-// GetCacheDomainLimitMemRatio safely fetches the Configuration value for state's 'Cache.DomainLimitMemRatio' field
-func (st *ConfigState) GetCacheDomainLimitMemRatio() (v float64) {
+// GetAdvancedSenderMultiplier safely fetches the Configuration value for state's 'Advanced.SenderMultiplier' field
+func (st *ConfigState) GetAdvancedSenderMultiplier() (v int) {
 	st.mutex.RLock()
-	v = st.config.Cache.DomainLimitMemRatio
+	v = st.config.Advanced.SenderMultiplier
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheDomainLimitMemRatio safely sets the Configuration value for state's 'Cache.DomainLimitMemRatio' field
-func (st *ConfigState) SetCacheDomainLimitMemRatio(v float64) {
+// SetAdvancedSenderMultiplier safely sets the Configuration value for state's 'Advanced.SenderMultiplier' field
+func (st *ConfigState) SetAdvancedSenderMultiplier(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.DomainLimitMemRatio = v
+	st.config.Advanced.SenderMultiplier = v
 	st.reloadToViper()
 }
 
-// GetCacheDomainLimitMemRatio safely fetches the value for global configuration 'Cache.DomainLimitMemRatio' field
-func GetCacheDomainLimitMemRatio() float64 { return global.GetCacheDomainLimitMemRatio() }
+// GetAdvancedSenderMultiplier safely fetches the value for global configuration 'Advanced.SenderMultiplier' field
+func GetAdvancedSenderMultiplier() int { return global.GetAdvancedSenderMultiplier() }
 
-// SetCacheDomainLimitMemRatio safely sets the value for global configuration 'Cache.DomainLimitMemRatio' field
-func SetCacheDomainLimitMemRatio(v float64) { global.SetCacheDomainLimitMemRatio(v) }
+// SetAdvancedSenderMultiplier safely sets the value for global configuration 'Advanced.SenderMultiplier' field
+func SetAdvancedSenderMultiplier(v int) { global.SetAdvancedSenderMultiplier(v) }
 
-// GetCacheDomainPermissionSubscriptionMemRatio safely fetches the Configuration value for state's 'Cache.DomainPermissionSubscriptionMemRatio' field
-func (st *ConfigState) GetCacheDomainPermissionSubscriptionMemRatio() (v float64) {
+// GetAdvancedCSPExtraURIs safely fetches the Configuration value for state's 'Advanced.CSPExtraURIs' field
+func (st *ConfigState) GetAdvancedCSPExtraURIs() (v []string) {
 	st.mutex.RLock()
-	v = st.config.Cache.DomainPermissionSubscriptionMemRatio
+	v = st.config.Advanced.CSPExtraURIs
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheDomainPermissionSubscriptionMemRatio safely sets the Configuration value for state's 'Cache.DomainPermissionSubscriptionMemRatio' field
-func (st *ConfigState) SetCacheDomainPermissionSubscriptionMemRatio(v float64) {
+// SetAdvancedCSPExtraURIs safely sets the Configuration value for state's 'Advanced.CSPExtraURIs' field
+func (st *ConfigState) SetAdvancedCSPExtraURIs(v []string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.DomainPermissionSubscriptionMemRatio = v
+	st.config.Advanced.CSPExtraURIs = v
 	st.reloadToViper()
 }
 
-// GetCacheDomainPermissionSubscriptionMemRatio safely fetches the value for global configuration 'Cache.DomainPermissionSubscriptionMemRatio' field
-func GetCacheDomainPermissionSubscriptionMemRatio() float64 {
-	return global.GetCacheDomainPermissionSubscriptionMemRatio()
-}
+// GetAdvancedCSPExtraURIs safely fetches the value for global configuration 'Advanced.CSPExtraURIs' field
+func GetAdvancedCSPExtraURIs() []string { return global.GetAdvancedCSPExtraURIs() }
 
-// SetCacheDomainPermissionSubscriptionMemRatio safely sets the value for global configuration 'Cache.DomainPermissionSubscriptionMemRatio' field
-func SetCacheDomainPermissionSubscriptionMemRatio(v float64) {
-	global.SetCacheDomainPermissionSubscriptionMemRatio(v)
-}
+// SetAdvancedCSPExtraURIs safely sets the value for global configuration 'Advanced.CSPExtraURIs' field
+func SetAdvancedCSPExtraURIs(v []string) { global.SetAdvancedCSPExtraURIs(v) }
 
-// GetCacheEmojiMemRatio safely fetches the Configuration value for state's 'Cache.EmojiMemRatio' field
-func (st *ConfigState) GetCacheEmojiMemRatio() (v float64) {
+// GetAdvancedHeaderFilterMode safely fetches the Configuration value for state's 'Advanced.HeaderFilterMode' field
+func (st *ConfigState) GetAdvancedHeaderFilterMode() (v string) {
 	st.mutex.RLock()
-	v = st.config.Cache.EmojiMemRatio
+	v = st.config.Advanced.HeaderFilterMode
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheEmojiMemRatio safely sets the Configuration value for state's 'Cache.EmojiMemRatio' field
-func (st *ConfigState) SetCacheEmojiMemRatio(v float64) {
+// SetAdvancedHeaderFilterMode safely sets the Configuration value for state's 'Advanced.HeaderFilterMode' field
+func (st *ConfigState) SetAdvancedHeaderFilterMode(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.EmojiMemRatio = v
+	st.config.Advanced.HeaderFilterMode = v
 	st.reloadToViper()
 }
 
-// GetCacheEmojiMemRatio safely fetches the value for global configuration 'Cache.EmojiMemRatio' field
-func GetCacheEmojiMemRatio() float64 { return global.GetCacheEmojiMemRatio() }
+// GetAdvancedHeaderFilterMode safely fetches the value for global configuration 'Advanced.HeaderFilterMode' field
+func GetAdvancedHeaderFilterMode() string { return global.GetAdvancedHeaderFilterMode() }
 
-// SetCacheEmojiMemRatio safely sets the value for global configuration 'Cache.EmojiMemRatio' field
-func SetCacheEmojiMemRatio(v float64) { global.SetCacheEmojiMemRatio(v) }
+// SetAdvancedHeaderFilterMode safely sets the value for global configuration 'Advanced.HeaderFilterMode' field
+func SetAdvancedHeaderFilterMode(v string) { global.SetAdvancedHeaderFilterMode(v) }
 
-// GetCacheEmojiCategoryMemRatio safely fetches the Configuration value for state's 'Cache.EmojiCategoryMemRatio' field
-func (st *ConfigState) GetCacheEmojiCategoryMemRatio() (v float64) {
+// GetAdvancedRateLimitRequests safely fetches the Configuration value for state's 'Advanced.RateLimit.Requests' field
+func (st *ConfigState) GetAdvancedRateLimitRequests() (v int) {
 	st.mutex.RLock()
-	v = st.config.Cache.EmojiCategoryMemRatio
+	v = st.config.Advanced.RateLimit.Requests
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheEmojiCategoryMemRatio safely sets the Configuration value for state's 'Cache.EmojiCategoryMemRatio' field
-func (st *ConfigState) SetCacheEmojiCategoryMemRatio(v float64) {
+// SetAdvancedRateLimitRequests safely sets the Configuration value for state's 'Advanced.RateLimit.Requests' field
+func (st *ConfigState) SetAdvancedRateLimitRequests(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.EmojiCategoryMemRatio = v
+	st.config.Advanced.RateLimit.Requests = v
 	st.reloadToViper()
 }
 
-// GetCacheEmojiCategoryMemRatio safely fetches the value for global configuration 'Cache.EmojiCategoryMemRatio' field
-func GetCacheEmojiCategoryMemRatio() float64 { return global.GetCacheEmojiCategoryMemRatio() }
-
-// SetCacheEmojiCategoryMemRatio safely sets the value for global configuration 'Cache.EmojiCategoryMemRatio' field
-func SetCacheEmojiCategoryMemRatio(v float64) { global.SetCacheEmojiCategoryMemRatio(v) }
+// GetAdvancedRateLimitRequests safely fetches the value for global configuration 'Advanced.RateLimit.Requests' field
+func GetAdvancedRateLimitRequests() int { return global.GetAdvancedRateLimitRequests() }
 
-// GetCacheFilterMemRatio safely fetches the Configuration value for state's 'Cache.FilterMemRatio' field
-func (st *ConfigState) GetCacheFilterMemRatio() (v float64) {
+// SetAdvancedRateLimitRequests safely sets the value for global configuration 'Advanced.RateLimit.Requests' field
+func SetAdvancedRateLimitRequests(v int) { global.SetAdvancedRateLimitRequests(v) }
+
+// GetAdvancedRateLimitExceptions safely fetches the Configuration value for state's 'Advanced.RateLimit.Exceptions' field
+func (st *ConfigState) GetAdvancedRateLimitExceptions() (v IPPrefixes) {
 	st.mutex.RLock()
-	v = st.config.Cache.FilterMemRatio
+	v = st.config.Advanced.RateLimit.Exceptions
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheFilterMemRatio safely sets the Configuration value for state's 'Cache.FilterMemRatio' field
-func (st *ConfigState) SetCacheFilterMemRatio(v float64) {
+// SetAdvancedRateLimitExceptions safely sets the Configuration value for state's 'Advanced.RateLimit.Exceptions' field
+func (st *ConfigState) SetAdvancedRateLimitExceptions(v IPPrefixes) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.FilterMemRatio = v
+	st.config.Advanced.RateLimit.Exceptions = v
 	st.reloadToViper()
 }
 
-// GetCacheFilterMemRatio safely fetches the value for global configuration 'Cache.FilterMemRatio' field
-func GetCacheFilterMemRatio() float64 { return global.GetCacheFilterMemRatio() }
+// GetAdvancedRateLimitExceptions safely fetches the value for global configuration 'Advanced.RateLimit.Exceptions' field
+func GetAdvancedRateLimitExceptions() IPPrefixes { return global.GetAdvancedRateLimitExceptions() }
 
-// SetCacheFilterMemRatio safely sets the value for global configuration 'Cache.FilterMemRatio' field
-func SetCacheFilterMemRatio(v float64) { global.SetCacheFilterMemRatio(v) }
+// SetAdvancedRateLimitExceptions safely sets the value for global configuration 'Advanced.RateLimit.Exceptions' field
+func SetAdvancedRateLimitExceptions(v IPPrefixes) { global.SetAdvancedRateLimitExceptions(v) }
 
-// GetCacheFilterIDsMemRatio safely fetches the Configuration value for state's 'Cache.FilterIDsMemRatio' field
-func (st *ConfigState) GetCacheFilterIDsMemRatio() (v float64) {
+// GetAdvancedThrottlingMultiplier safely fetches the Configuration value for state's 'Advanced.Throttling.Multiplier' field
+func (st *ConfigState) GetAdvancedThrottlingMultiplier() (v int) {
 	st.mutex.RLock()
-	v = st.config.Cache.FilterIDsMemRatio
+	v = st.config.Advanced.Throttling.Multiplier
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheFilterIDsMemRatio safely sets the Configuration value for state's 'Cache.FilterIDsMemRatio' field
-func (st *ConfigState) SetCacheFilterIDsMemRatio(v float64) {
+// SetAdvancedThrottlingMultiplier safely sets the Configuration value for state's 'Advanced.Throttling.Multiplier' field
+func (st *ConfigState) SetAdvancedThrottlingMultiplier(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.FilterIDsMemRatio = v
+	st.config.Advanced.Throttling.Multiplier = v
 	st.reloadToViper()
 }
 
-// GetCacheFilterIDsMemRatio safely fetches the value for global configuration 'Cache.FilterIDsMemRatio' field
-func GetCacheFilterIDsMemRatio() float64 { return global.GetCacheFilterIDsMemRatio() }
+// GetAdvancedThrottlingMultiplier safely fetches the value for global configuration 'Advanced.Throttling.Multiplier' field
+func GetAdvancedThrottlingMultiplier() int { return global.GetAdvancedThrottlingMultiplier() }
 
-// SetCacheFilterIDsMemRatio safely sets the value for global configuration 'Cache.FilterIDsMemRatio' field
-func SetCacheFilterIDsMemRatio(v float64) { global.SetCacheFilterIDsMemRatio(v) }
+// SetAdvancedThrottlingMultiplier safely sets the value for global configuration 'Advanced.Throttling.Multiplier' field
+func SetAdvancedThrottlingMultiplier(v int) { global.SetAdvancedThrottlingMultiplier(v) }
 
-// GetCacheFilterKeywordMemRatio safely fetches the Configuration value for state's 'Cache.FilterKeywordMemRatio' field
-func (st *ConfigState) GetCacheFilterKeywordMemRatio() (v float64) {
+// GetAdvancedThrottlingRetryAfter safely fetches the Configuration value for state's 'Advanced.Throttling.RetryAfter' field
+func (st *ConfigState) GetAdvancedThrottlingRetryAfter() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.Cache.FilterKeywordMemRatio
+	v = st.config.Advanced.Throttling.RetryAfter
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheFilterKeywordMemRatio safely sets the Configuration value for state's 'Cache.FilterKeywordMemRatio' field
-func (st *ConfigState) SetCacheFilterKeywordMemRatio(v float64) {
+// SetAdvancedThrottlingRetryAfter safely sets the Configuration value for state's 'Advanced.Throttling.RetryAfter' field
+func (st *ConfigState) SetAdvancedThrottlingRetryAfter(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.FilterKeywordMemRatio = v
+	st.config.Advanced.Throttling.RetryAfter = v
 	st.reloadToViper()
 }
 
-// GetCacheFilterKeywordMemRatio safely fetches the value for global configuration 'Cache.FilterKeywordMemRatio' field
-func GetCacheFilterKeywordMemRatio() float64 { return global.GetCacheFilterKeywordMemRatio() }
+// GetAdvancedThrottlingRetryAfter safely fetches the value for global configuration 'Advanced.Throttling.RetryAfter' field
+func GetAdvancedThrottlingRetryAfter() time.Duration { return global.GetAdvancedThrottlingRetryAfter() }
 
-// SetCacheFilterKeywordMemRatio safely sets the value for global configuration 'Cache.FilterKeywordMemRatio' field
-func SetCacheFilterKeywordMemRatio(v float64) { global.SetCacheFilterKeywordMemRatio(v) }
+// SetAdvancedThrottlingRetryAfter safely sets the value for global configuration 'Advanced.Throttling.RetryAfter' field
+func SetAdvancedThrottlingRetryAfter(v time.Duration) { global.SetAdvancedThrottlingRetryAfter(v) }
 
-// GetCacheFilterStatusMemRatio safely fetches the Configuration value for state's 'Cache.FilterStatusMemRatio' field
-func (st *ConfigState) GetCacheFilterStatusMemRatio() (v float64) {
+// GetHTTPClientAllowIPs safely fetches the Configuration value for state's 'HTTPClient.AllowIPs' field
+func (st *ConfigState) GetHTTPClientAllowIPs() (v []string) {
 	st.mutex.RLock()
-	v = st.config.Cache.FilterStatusMemRatio
+	v = st.config.HTTPClient.AllowIPs
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheFilterStatusMemRatio safely sets the Configuration value for state's 'Cache.FilterStatusMemRatio' field
-func (st *ConfigState) SetCacheFilterStatusMemRatio(v float64) {
+// SetHTTPClientAllowIPs safely sets the Configuration value for state's 'HTTPClient.AllowIPs' field
+func (st *ConfigState) SetHTTPClientAllowIPs(v []string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.FilterStatusMemRatio = v
+	st.config.HTTPClient.AllowIPs = v
 	st.reloadToViper()
 }
 
-// GetCacheFilterStatusMemRatio safely fetches the value for global configuration 'Cache.FilterStatusMemRatio' field
-func GetCacheFilterStatusMemRatio() float64 { return global.GetCacheFilterStatusMemRatio() }
+// GetHTTPClientAllowIPs safely fetches the value for global configuration 'HTTPClient.AllowIPs' field
+func GetHTTPClientAllowIPs() []string { return global.GetHTTPClientAllowIPs() }
 
-// SetCacheFilterStatusMemRatio safely sets the value for global configuration 'Cache.FilterStatusMemRatio' field
-func SetCacheFilterStatusMemRatio(v float64) { global.SetCacheFilterStatusMemRatio(v) }
+// SetHTTPClientAllowIPs safely sets the value for global configuration 'HTTPClient.AllowIPs' field
+func SetHTTPClientAllowIPs(v []string) { global.SetHTTPClientAllowIPs(v) }
 
-// GetCacheFollowMemRatio safely fetches the Configuration value for state's 'Cache.FollowMemRatio' field
-func (st *ConfigState) GetCacheFollowMemRatio() (v float64) {
+// GetHTTPClientBlockIPs safely fetches the Configuration value for state's 'HTTPClient.BlockIPs' field
+func (st *ConfigState) GetHTTPClientBlockIPs() (v []string) {
 	st.mutex.RLock()
-	v = st.config.Cache.FollowMemRatio
+	v = st.config.HTTPClient.BlockIPs
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheFollowMemRatio safely sets the Configuration value for state's 'Cache.FollowMemRatio' field
-func (st *ConfigState) SetCacheFollowMemRatio(v float64) {
+// SetHTTPClientBlockIPs safely sets the Configuration value for state's 'HTTPClient.BlockIPs' field
+func (st *ConfigState) SetHTTPClientBlockIPs(v []string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.FollowMemRatio = v
+	st.config.HTTPClient.BlockIPs = v
 	st.reloadToViper()
 }
 
-// GetCacheFollowMemRatio safely fetches the value for global configuration 'Cache.FollowMemRatio' field
-func GetCacheFollowMemRatio() float64 { return global.GetCacheFollowMemRatio() }
+// GetHTTPClientBlockIPs safely fetches the value for global configuration 'HTTPClient.BlockIPs' field
+func GetHTTPClientBlockIPs() []string { return global.GetHTTPClientBlockIPs() }
 
-// SetCacheFollowMemRatio safely sets the value for global configuration 'Cache.FollowMemRatio' field
-func SetCacheFollowMemRatio(v float64) { global.SetCacheFollowMemRatio(v) }
+// SetHTTPClientBlockIPs safely sets the value for global configuration 'HTTPClient.BlockIPs' field
+func SetHTTPClientBlockIPs(v []string) { global.SetHTTPClientBlockIPs(v) }
 
-// GetCacheFollowIDsMemRatio safely fetches the Configuration value for state's 'Cache.FollowIDsMemRatio' field
-func (st *ConfigState) GetCacheFollowIDsMemRatio() (v float64) {
+// GetHTTPClientTimeout safely fetches the Configuration value for state's 'HTTPClient.Timeout' field
+func (st *ConfigState) GetHTTPClientTimeout() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.Cache.FollowIDsMemRatio
+	v = st.config.HTTPClient.Timeout
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheFollowIDsMemRatio safely sets the Configuration value for state's 'Cache.FollowIDsMemRatio' field
-func (st *ConfigState) SetCacheFollowIDsMemRatio(v float64) {
+// SetHTTPClientTimeout safely sets the Configuration value for state's 'HTTPClient.Timeout' field
+func (st *ConfigState) SetHTTPClientTimeout(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.FollowIDsMemRatio = v
+	st.config.HTTPClient.Timeout = v
 	st.reloadToViper()
 }
 
-// GetCacheFollowIDsMemRatio safely fetches the value for global configuration 'Cache.FollowIDsMemRatio' field
-func GetCacheFollowIDsMemRatio() float64 { return global.GetCacheFollowIDsMemRatio() }
+// GetHTTPClientTimeout safely fetches the value for global configuration 'HTTPClient.Timeout' field
+func GetHTTPClientTimeout() time.Duration { return global.GetHTTPClientTimeout() }
 
-// SetCacheFollowIDsMemRatio safely sets the value for global configuration 'Cache.FollowIDsMemRatio' field
-func SetCacheFollowIDsMemRatio(v float64) { global.SetCacheFollowIDsMemRatio(v) }
+// SetHTTPClientTimeout safely sets the value for global configuration 'HTTPClient.Timeout' field
+func SetHTTPClientTimeout(v time.Duration) { global.SetHTTPClientTimeout(v) }
 
-// GetCacheFollowRequestMemRatio safely fetches the Configuration value for state's 'Cache.FollowRequestMemRatio' field
-func (st *ConfigState) GetCacheFollowRequestMemRatio() (v float64) {
+// GetHTTPClientTLSInsecureSkipVerify safely fetches the Configuration value for state's 'HTTPClient.TLSInsecureSkipVerify' field
+func (st *ConfigState) GetHTTPClientTLSInsecureSkipVerify() (v bool) {
 	st.mutex.RLock()
-	v = st.config.Cache.FollowRequestMemRatio
+	v = st.config.HTTPClient.TLSInsecureSkipVerify
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheFollowRequestMemRatio safely sets the Configuration value for state's 'Cache.FollowRequestMemRatio' field
-func (st *ConfigState) SetCacheFollowRequestMemRatio(v float64) {
+// SetHTTPClientTLSInsecureSkipVerify safely sets the Configuration value for state's 'HTTPClient.TLSInsecureSkipVerify' field
+func (st *ConfigState) SetHTTPClientTLSInsecureSkipVerify(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.FollowRequestMemRatio = v
+	st.config.HTTPClient.TLSInsecureSkipVerify = v
 	st.reloadToViper()
 }
 
-// GetCacheFollowRequestMemRatio safely fetches the value for global configuration 'Cache.FollowRequestMemRatio' field
-func GetCacheFollowRequestMemRatio() float64 { return global.GetCacheFollowRequestMemRatio() }
+// GetHTTPClientTLSInsecureSkipVerify safely fetches the value for global configuration 'HTTPClient.TLSInsecureSkipVerify' field
+func GetHTTPClientTLSInsecureSkipVerify() bool { return global.GetHTTPClientTLSInsecureSkipVerify() }
 
-// SetCacheFollowRequestMemRatio safely sets the value for global configuration 'Cache.FollowRequestMemRatio' field
-func SetCacheFollowRequestMemRatio(v float64) { global.SetCacheFollowRequestMemRatio(v) }
+// SetHTTPClientTLSInsecureSkipVerify safely sets the value for global configuration 'HTTPClient.TLSInsecureSkipVerify' field
+func SetHTTPClientTLSInsecureSkipVerify(v bool) { global.SetHTTPClientTLSInsecureSkipVerify(v) }
 
-// GetCacheFollowRequestIDsMemRatio safely fetches the Configuration value for state's 'Cache.FollowRequestIDsMemRatio' field
-func (st *ConfigState) GetCacheFollowRequestIDsMemRatio() (v float64) {
+// GetHTTPClientInsecureOutgoing safely fetches the Configuration value for state's 'HTTPClient.InsecureOutgoing' field
+func (st *ConfigState) GetHTTPClientInsecureOutgoing() (v bool) {
 	st.mutex.RLock()
-	v = st.config.Cache.FollowRequestIDsMemRatio
+	v = st.config.HTTPClient.InsecureOutgoing
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheFollowRequestIDsMemRatio safely sets the Configuration value for state's 'Cache.FollowRequestIDsMemRatio' field
-func (st *ConfigState) SetCacheFollowRequestIDsMemRatio(v float64) {
+// SetHTTPClientInsecureOutgoing safely sets the Configuration value for state's 'HTTPClient.InsecureOutgoing' field
+func (st *ConfigState) SetHTTPClientInsecureOutgoing(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.FollowRequestIDsMemRatio = v
+	st.config.HTTPClient.InsecureOutgoing = v
 	st.reloadToViper()
 }
 
-// GetCacheFollowRequestIDsMemRatio safely fetches the value for global configuration 'Cache.FollowRequestIDsMemRatio' field
-func GetCacheFollowRequestIDsMemRatio() float64 { return global.GetCacheFollowRequestIDsMemRatio() }
+// GetHTTPClientInsecureOutgoing safely fetches the value for global configuration 'HTTPClient.InsecureOutgoing' field
+func GetHTTPClientInsecureOutgoing() bool { return global.GetHTTPClientInsecureOutgoing() }
 
-// SetCacheFollowRequestIDsMemRatio safely sets the value for global configuration 'Cache.FollowRequestIDsMemRatio' field
-func SetCacheFollowRequestIDsMemRatio(v float64) { global.SetCacheFollowRequestIDsMemRatio(v) }
+// SetHTTPClientInsecureOutgoing safely sets the value for global configuration 'HTTPClient.InsecureOutgoing' field
+func SetHTTPClientInsecureOutgoing(v bool) { global.SetHTTPClientInsecureOutgoing(v) }
 
-// GetCacheFollowingTagIDsMemRatio safely fetches the Configuration value for state's 'Cache.FollowingTagIDsMemRatio' field
-func (st *ConfigState) GetCacheFollowingTagIDsMemRatio() (v float64) {
+// GetMediaDescriptionMinChars safely fetches the Configuration value for state's 'Media.DescriptionMinChars' field
+func (st *ConfigState) GetMediaDescriptionMinChars() (v int) {
 	st.mutex.RLock()
-	v = st.config.Cache.FollowingTagIDsMemRatio
+	v = st.config.Media.DescriptionMinChars
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheFollowingTagIDsMemRatio safely sets the Configuration value for state's 'Cache.FollowingTagIDsMemRatio' field
-func (st *ConfigState) SetCacheFollowingTagIDsMemRatio(v float64) {
+// SetMediaDescriptionMinChars safely sets the Configuration value for state's 'Media.DescriptionMinChars' field
+func (st *ConfigState) SetMediaDescriptionMinChars(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.FollowingTagIDsMemRatio = v
+	st.config.Media.DescriptionMinChars = v
 	st.reloadToViper()
 }
 
-// GetCacheFollowingTagIDsMemRatio safely fetches the value for global configuration 'Cache.FollowingTagIDsMemRatio' field
-func GetCacheFollowingTagIDsMemRatio() float64 { return global.GetCacheFollowingTagIDsMemRatio() }
+// GetMediaDescriptionMinChars safely fetches the value for global configuration 'Media.DescriptionMinChars' field
+func GetMediaDescriptionMinChars() int { return global.GetMediaDescriptionMinChars() }
 
-// SetCacheFollowingTagIDsMemRatio safely sets the value for global configuration 'Cache.FollowingTagIDsMemRatio' field
-func SetCacheFollowingTagIDsMemRatio(v float64) { global.SetCacheFollowingTagIDsMemRatio(v) }
+// SetMediaDescriptionMinChars safely sets the value for global configuration 'Media.DescriptionMinChars' field
+func SetMediaDescriptionMinChars(v int) { global.SetMediaDescriptionMinChars(v) }
 
-// GetCacheHomeAccountIDsMemRatio safely fetches the Configuration value for state's 'Cache.HomeAccountIDsMemRatio' field
-func (st *ConfigState) GetCacheHomeAccountIDsMemRatio() (v float64) {
+// GetMediaDescriptionMaxChars safely fetches the Configuration value for state's 'Media.DescriptionMaxChars' field
+func (st *ConfigState) GetMediaDescriptionMaxChars() (v int) {
 	st.mutex.RLock()
-	v = st.config.Cache.HomeAccountIDsMemRatio
+	v = st.config.Media.DescriptionMaxChars
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheHomeAccountIDsMemRatio safely sets the Configuration value for state's 'Cache.HomeAccountIDsMemRatio' field
-func (st *ConfigState) SetCacheHomeAccountIDsMemRatio(v float64) {
+// SetMediaDescriptionMaxChars safely sets the Configuration value for state's 'Media.DescriptionMaxChars' field
+func (st *ConfigState) SetMediaDescriptionMaxChars(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.HomeAccountIDsMemRatio = v
+	st.config.Media.DescriptionMaxChars = v
 	st.reloadToViper()
 }
 
-// GetCacheHomeAccountIDsMemRatio safely fetches the value for global configuration 'Cache.HomeAccountIDsMemRatio' field
-func GetCacheHomeAccountIDsMemRatio() float64 { return global.GetCacheHomeAccountIDsMemRatio() }
+// GetMediaDescriptionMaxChars safely fetches the value for global configuration 'Media.DescriptionMaxChars' field
+func GetMediaDescriptionMaxChars() int { return global.GetMediaDescriptionMaxChars() }
 
-// SetCacheHomeAccountIDsMemRatio safely sets the value for global configuration 'Cache.HomeAccountIDsMemRatio' field
-func SetCacheHomeAccountIDsMemRatio(v float64) { global.SetCacheHomeAccountIDsMemRatio(v) }
+// SetMediaDescriptionMaxChars safely sets the value for global configuration 'Media.DescriptionMaxChars' field
+func SetMediaDescriptionMaxChars(v int) { global.SetMediaDescriptionMaxChars(v) }
 
-// GetCacheInReplyToIDsMemRatio safely fetches the Configuration value for state's 'Cache.InReplyToIDsMemRatio' field
-func (st *ConfigState) GetCacheInReplyToIDsMemRatio() (v float64) {
+// GetMediaRemoteCacheDays safely fetches the Configuration value for state's 'Media.RemoteCacheDays' field
+func (st *ConfigState) GetMediaRemoteCacheDays() (v int) {
 	st.mutex.RLock()
-	v = st.config.Cache.InReplyToIDsMemRatio
+	v = st.config.Media.RemoteCacheDays
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheInReplyToIDsMemRatio safely sets the Configuration value for state's 'Cache.InReplyToIDsMemRatio' field
-func (st *ConfigState) SetCacheInReplyToIDsMemRatio(v float64) {
+// SetMediaRemoteCacheDays safely sets the Configuration value for state's 'Media.RemoteCacheDays' field
+func (st *ConfigState) SetMediaRemoteCacheDays(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.InReplyToIDsMemRatio = v
+	st.config.Media.RemoteCacheDays = v
 	st.reloadToViper()
 }
 
-// GetCacheInReplyToIDsMemRatio safely fetches the value for global configuration 'Cache.InReplyToIDsMemRatio' field
-func GetCacheInReplyToIDsMemRatio() float64 { return global.GetCacheInReplyToIDsMemRatio() }
+// GetMediaRemoteCacheDays safely fetches the value for global configuration 'Media.RemoteCacheDays' field
+func GetMediaRemoteCacheDays() int { return global.GetMediaRemoteCacheDays() }
 
-// SetCacheInReplyToIDsMemRatio safely sets the value for global configuration 'Cache.InReplyToIDsMemRatio' field
-func SetCacheInReplyToIDsMemRatio(v float64) { global.SetCacheInReplyToIDsMemRatio(v) }
+// SetMediaRemoteCacheDays safely sets the value for global configuration 'Media.RemoteCacheDays' field
+func SetMediaRemoteCacheDays(v int) { global.SetMediaRemoteCacheDays(v) }
 
-// GetCacheInstanceMemRatio safely fetches the Configuration value for state's 'Cache.InstanceMemRatio' field
-func (st *ConfigState) GetCacheInstanceMemRatio() (v float64) {
+// GetMediaEmojiLocalMaxSize safely fetches the Configuration value for state's 'Media.EmojiLocalMaxSize' field
+func (st *ConfigState) GetMediaEmojiLocalMaxSize() (v bytesize.Size) {
 	st.mutex.RLock()
-	v = st.config.Cache.InstanceMemRatio
+	v = st.config.Media.EmojiLocalMaxSize
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheInstanceMemRatio safely sets the Configuration value for state's 'Cache.InstanceMemRatio' field
-func (st *ConfigState) SetCacheInstanceMemRatio(v float64) {
+// SetMediaEmojiLocalMaxSize safely sets the Configuration value for state's 'Media.EmojiLocalMaxSize' field
+func (st *ConfigState) SetMediaEmojiLocalMaxSize(v bytesize.Size) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.InstanceMemRatio = v
+	st.config.Media.EmojiLocalMaxSize = v
 	st.reloadToViper()
 }
 
-// GetCacheInstanceMemRatio safely fetches the value for global configuration 'Cache.InstanceMemRatio' field
-func GetCacheInstanceMemRatio() float64 { return global.GetCacheInstanceMemRatio() }
+// GetMediaEmojiLocalMaxSize safely fetches the value for global configuration 'Media.EmojiLocalMaxSize' field
+func GetMediaEmojiLocalMaxSize() bytesize.Size { return global.GetMediaEmojiLocalMaxSize() }
 
-// SetCacheInstanceMemRatio safely sets the value for global configuration 'Cache.InstanceMemRatio' field
-func SetCacheInstanceMemRatio(v float64) { global.SetCacheInstanceMemRatio(v) }
+// SetMediaEmojiLocalMaxSize safely sets the value for global configuration 'Media.EmojiLocalMaxSize' field
+func SetMediaEmojiLocalMaxSize(v bytesize.Size) { global.SetMediaEmojiLocalMaxSize(v) }
 
-// GetCacheInteractionRequestMemRatio safely fetches the Configuration value for state's 'Cache.InteractionRequestMemRatio' field
-func (st *ConfigState) GetCacheInteractionRequestMemRatio() (v float64) {
+// GetMediaEmojiRemoteMaxSize safely fetches the Configuration value for state's 'Media.EmojiRemoteMaxSize' field
+func (st *ConfigState) GetMediaEmojiRemoteMaxSize() (v bytesize.Size) {
 	st.mutex.RLock()
-	v = st.config.Cache.InteractionRequestMemRatio
+	v = st.config.Media.EmojiRemoteMaxSize
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheInteractionRequestMemRatio safely sets the Configuration value for state's 'Cache.InteractionRequestMemRatio' field
-func (st *ConfigState) SetCacheInteractionRequestMemRatio(v float64) {
+// SetMediaEmojiRemoteMaxSize safely sets the Configuration value for state's 'Media.EmojiRemoteMaxSize' field
+func (st *ConfigState) SetMediaEmojiRemoteMaxSize(v bytesize.Size) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.InteractionRequestMemRatio = v
+	st.config.Media.EmojiRemoteMaxSize = v
 	st.reloadToViper()
 }
 
-// GetCacheInteractionRequestMemRatio safely fetches the value for global configuration 'Cache.InteractionRequestMemRatio' field
-func GetCacheInteractionRequestMemRatio() float64 { return global.GetCacheInteractionRequestMemRatio() }
+// GetMediaEmojiRemoteMaxSize safely fetches the value for global configuration 'Media.EmojiRemoteMaxSize' field
+func GetMediaEmojiRemoteMaxSize() bytesize.Size { return global.GetMediaEmojiRemoteMaxSize() }
 
-// SetCacheInteractionRequestMemRatio safely sets the value for global configuration 'Cache.InteractionRequestMemRatio' field
-func SetCacheInteractionRequestMemRatio(v float64) { global.SetCacheInteractionRequestMemRatio(v) }
+// SetMediaEmojiRemoteMaxSize safely sets the value for global configuration 'Media.EmojiRemoteMaxSize' field
+func SetMediaEmojiRemoteMaxSize(v bytesize.Size) { global.SetMediaEmojiRemoteMaxSize(v) }
 
-// GetCacheListMemRatio safely fetches the Configuration value for state's 'Cache.ListMemRatio' field
-func (st *ConfigState) GetCacheListMemRatio() (v float64) {
+// GetMediaImageSizeHint safely fetches the Configuration value for state's 'Media.ImageSizeHint' field
+func (st *ConfigState) GetMediaImageSizeHint() (v bytesize.Size) {
 	st.mutex.RLock()
-	v = st.config.Cache.ListMemRatio
+	v = st.config.Media.ImageSizeHint
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheListMemRatio safely sets the Configuration value for state's 'Cache.ListMemRatio' field
-func (st *ConfigState) SetCacheListMemRatio(v float64) {
+// SetMediaImageSizeHint safely sets the Configuration value for state's 'Media.ImageSizeHint' field
+func (st *ConfigState) SetMediaImageSizeHint(v bytesize.Size) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.ListMemRatio = v
+	st.config.Media.ImageSizeHint = v
 	st.reloadToViper()
 }
 
-// GetCacheListMemRatio safely fetches the value for global configuration 'Cache.ListMemRatio' field
-func GetCacheListMemRatio() float64 { return global.GetCacheListMemRatio() }
+// GetMediaImageSizeHint safely fetches the value for global configuration 'Media.ImageSizeHint' field
+func GetMediaImageSizeHint() bytesize.Size { return global.GetMediaImageSizeHint() }
 
-// SetCacheListMemRatio safely sets the value for global configuration 'Cache.ListMemRatio' field
-func SetCacheListMemRatio(v float64) { global.SetCacheListMemRatio(v) }
+// SetMediaImageSizeHint safely sets the value for global configuration 'Media.ImageSizeHint' field
+func SetMediaImageSizeHint(v bytesize.Size) { global.SetMediaImageSizeHint(v) }
 
-// GetCacheListIDsMemRatio safely fetches the Configuration value for state's 'Cache.ListIDsMemRatio' field
-func (st *ConfigState) GetCacheListIDsMemRatio() (v float64) {
+// GetMediaVideoSizeHint safely fetches the Configuration value for state's 'Media.VideoSizeHint' field
+func (st *ConfigState) GetMediaVideoSizeHint() (v bytesize.Size) {
 	st.mutex.RLock()
-	v = st.config.Cache.ListIDsMemRatio
+	v = st.config.Media.VideoSizeHint
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheListIDsMemRatio safely sets the Configuration value for state's 'Cache.ListIDsMemRatio' field
-func (st *ConfigState) SetCacheListIDsMemRatio(v float64) {
+// SetMediaVideoSizeHint safely sets the Configuration value for state's 'Media.VideoSizeHint' field
+func (st *ConfigState) SetMediaVideoSizeHint(v bytesize.Size) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.ListIDsMemRatio = v
+	st.config.Media.VideoSizeHint = v
 	st.reloadToViper()
 }
 
-// GetCacheListIDsMemRatio safely fetches the value for global configuration 'Cache.ListIDsMemRatio' field
-func GetCacheListIDsMemRatio() float64 { return global.GetCacheListIDsMemRatio() }
+// GetMediaVideoSizeHint safely fetches the value for global configuration 'Media.VideoSizeHint' field
+func GetMediaVideoSizeHint() bytesize.Size { return global.GetMediaVideoSizeHint() }
 
-// SetCacheListIDsMemRatio safely sets the value for global configuration 'Cache.ListIDsMemRatio' field
-func SetCacheListIDsMemRatio(v float64) { global.SetCacheListIDsMemRatio(v) }
+// SetMediaVideoSizeHint safely sets the value for global configuration 'Media.VideoSizeHint' field
+func SetMediaVideoSizeHint(v bytesize.Size) { global.SetMediaVideoSizeHint(v) }
 
-// GetCacheListedIDsMemRatio safely fetches the Configuration value for state's 'Cache.ListedIDsMemRatio' field
-func (st *ConfigState) GetCacheListedIDsMemRatio() (v float64) {
+// GetMediaLocalMaxSize safely fetches the Configuration value for state's 'Media.LocalMaxSize' field
+func (st *ConfigState) GetMediaLocalMaxSize() (v bytesize.Size) {
 	st.mutex.RLock()
-	v = st.config.Cache.ListedIDsMemRatio
+	v = st.config.Media.LocalMaxSize
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheListedIDsMemRatio safely sets the Configuration value for state's 'Cache.ListedIDsMemRatio' field
-func (st *ConfigState) SetCacheListedIDsMemRatio(v float64) {
+// SetMediaLocalMaxSize safely sets the Configuration value for state's 'Media.LocalMaxSize' field
+func (st *ConfigState) SetMediaLocalMaxSize(v bytesize.Size) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.ListedIDsMemRatio = v
+	st.config.Media.LocalMaxSize = v
 	st.reloadToViper()
 }
 
-// GetCacheListedIDsMemRatio safely fetches the value for global configuration 'Cache.ListedIDsMemRatio' field
-func GetCacheListedIDsMemRatio() float64 { return global.GetCacheListedIDsMemRatio() }
+// GetMediaLocalMaxSize safely fetches the value for global configuration 'Media.LocalMaxSize' field
+func GetMediaLocalMaxSize() bytesize.Size { return global.GetMediaLocalMaxSize() }
 
-// SetCacheListedIDsMemRatio safely sets the value for global configuration 'Cache.ListedIDsMemRatio' field
-func SetCacheListedIDsMemRatio(v float64) { global.SetCacheListedIDsMemRatio(v) }
+// SetMediaLocalMaxSize safely sets the value for global configuration 'Media.LocalMaxSize' field
+func SetMediaLocalMaxSize(v bytesize.Size) { global.SetMediaLocalMaxSize(v) }
 
-// GetCacheMarkerMemRatio safely fetches the Configuration value for state's 'Cache.MarkerMemRatio' field
-func (st *ConfigState) GetCacheMarkerMemRatio() (v float64) {
+// GetMediaRemoteMaxSize safely fetches the Configuration value for state's 'Media.RemoteMaxSize' field
+func (st *ConfigState) GetMediaRemoteMaxSize() (v bytesize.Size) {
 	st.mutex.RLock()
-	v = st.config.Cache.MarkerMemRatio
+	v = st.config.Media.RemoteMaxSize
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheMarkerMemRatio safely sets the Configuration value for state's 'Cache.MarkerMemRatio' field
-func (st *ConfigState) SetCacheMarkerMemRatio(v float64) {
+// SetMediaRemoteMaxSize safely sets the Configuration value for state's 'Media.RemoteMaxSize' field
+func (st *ConfigState) SetMediaRemoteMaxSize(v bytesize.Size) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.MarkerMemRatio = v
+	st.config.Media.RemoteMaxSize = v
 	st.reloadToViper()
 }
 
-// GetCacheMarkerMemRatio safely fetches the value for global configuration 'Cache.MarkerMemRatio' field
-func GetCacheMarkerMemRatio() float64 { return global.GetCacheMarkerMemRatio() }
+// GetMediaRemoteMaxSize safely fetches the value for global configuration 'Media.RemoteMaxSize' field
+func GetMediaRemoteMaxSize() bytesize.Size { return global.GetMediaRemoteMaxSize() }
 
-// SetCacheMarkerMemRatio safely sets the value for global configuration 'Cache.MarkerMemRatio' field
-func SetCacheMarkerMemRatio(v float64) { global.SetCacheMarkerMemRatio(v) }
+// SetMediaRemoteMaxSize safely sets the value for global configuration 'Media.RemoteMaxSize' field
+func SetMediaRemoteMaxSize(v bytesize.Size) { global.SetMediaRemoteMaxSize(v) }
 
-// GetCacheMediaMemRatio safely fetches the Configuration value for state's 'Cache.MediaMemRatio' field
-func (st *ConfigState) GetCacheMediaMemRatio() (v float64) {
+// GetMediaCleanupFrom safely fetches the Configuration value for state's 'Media.CleanupFrom' field
+func (st *ConfigState) GetMediaCleanupFrom() (v string) {
 	st.mutex.RLock()
-	v = st.config.Cache.MediaMemRatio
+	v = st.config.Media.CleanupFrom
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheMediaMemRatio safely sets the Configuration value for state's 'Cache.MediaMemRatio' field
-func (st *ConfigState) SetCacheMediaMemRatio(v float64) {
+// SetMediaCleanupFrom safely sets the Configuration value for state's 'Media.CleanupFrom' field
+func (st *ConfigState) SetMediaCleanupFrom(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.MediaMemRatio = v
+	st.config.Media.CleanupFrom = v
 	st.reloadToViper()
 }
 
-// GetCacheMediaMemRatio safely fetches the value for global configuration 'Cache.MediaMemRatio' field
-func GetCacheMediaMemRatio() float64 { return global.GetCacheMediaMemRatio() }
+// GetMediaCleanupFrom safely fetches the value for global configuration 'Media.CleanupFrom' field
+func GetMediaCleanupFrom() string { return global.GetMediaCleanupFrom() }
 
-// SetCacheMediaMemRatio safely sets the value for global configuration 'Cache.MediaMemRatio' field
-func SetCacheMediaMemRatio(v float64) { global.SetCacheMediaMemRatio(v) }
+// SetMediaCleanupFrom safely sets the value for global configuration 'Media.CleanupFrom' field
+func SetMediaCleanupFrom(v string) { global.SetMediaCleanupFrom(v) }
 
-// GetCacheMentionMemRatio safely fetches the Configuration value for state's 'Cache.MentionMemRatio' field
-func (st *ConfigState) GetCacheMentionMemRatio() (v float64) {
+// GetMediaCleanupEvery safely fetches the Configuration value for state's 'Media.CleanupEvery' field
+func (st *ConfigState) GetMediaCleanupEvery() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.Cache.MentionMemRatio
+	v = st.config.Media.CleanupEvery
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheMentionMemRatio safely sets the Configuration value for state's 'Cache.MentionMemRatio' field
-func (st *ConfigState) SetCacheMentionMemRatio(v float64) {
+// SetMediaCleanupEvery safely sets the Configuration value for state's 'Media.CleanupEvery' field
+func (st *ConfigState) SetMediaCleanupEvery(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.MentionMemRatio = v
+	st.config.Media.CleanupEvery = v
 	st.reloadToViper()
 }
 
-// GetCacheMentionMemRatio safely fetches the value for global configuration 'Cache.MentionMemRatio' field
-func GetCacheMentionMemRatio() float64 { return global.GetCacheMentionMemRatio() }
+// GetMediaCleanupEvery safely fetches the value for global configuration 'Media.CleanupEvery' field
+func GetMediaCleanupEvery() time.Duration { return global.GetMediaCleanupEvery() }
 
-// SetCacheMentionMemRatio safely sets the value for global configuration 'Cache.MentionMemRatio' field
-func SetCacheMentionMemRatio(v float64) { global.SetCacheMentionMemRatio(v) }
+// SetMediaCleanupEvery safely sets the value for global configuration 'Media.CleanupEvery' field
+func SetMediaCleanupEvery(v time.Duration) { global.SetMediaCleanupEvery(v) }
 
-// GetCacheMoveMemRatio safely fetches the Configuration value for state's 'Cache.MoveMemRatio' field
-func (st *ConfigState) GetCacheMoveMemRatio() (v float64) {
+// GetMediaFfmpegPoolSize safely fetches the Configuration value for state's 'Media.FfmpegPoolSize' field
+func (st *ConfigState) GetMediaFfmpegPoolSize() (v int) {
 	st.mutex.RLock()
-	v = st.config.Cache.MoveMemRatio
+	v = st.config.Media.FfmpegPoolSize
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheMoveMemRatio safely sets the Configuration value for state's 'Cache.MoveMemRatio' field
-func (st *ConfigState) SetCacheMoveMemRatio(v float64) {
+// SetMediaFfmpegPoolSize safely sets the Configuration value for state's 'Media.FfmpegPoolSize' field
+func (st *ConfigState) SetMediaFfmpegPoolSize(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.MoveMemRatio = v
+	st.config.Media.FfmpegPoolSize = v
 	st.reloadToViper()
 }
 
-// GetCacheMoveMemRatio safely fetches the value for global configuration 'Cache.MoveMemRatio' field
-func GetCacheMoveMemRatio() float64 { return global.GetCacheMoveMemRatio() }
+// GetMediaFfmpegPoolSize safely fetches the value for global configuration 'Media.FfmpegPoolSize' field
+func GetMediaFfmpegPoolSize() int { return global.GetMediaFfmpegPoolSize() }
 
-// SetCacheMoveMemRatio safely sets the value for global configuration 'Cache.MoveMemRatio' field
-func SetCacheMoveMemRatio(v float64) { global.SetCacheMoveMemRatio(v) }
+// SetMediaFfmpegPoolSize safely sets the value for global configuration 'Media.FfmpegPoolSize' field
+func SetMediaFfmpegPoolSize(v int) { global.SetMediaFfmpegPoolSize(v) }
 
-// GetCacheNotificationMemRatio safely fetches the Configuration value for state's 'Cache.NotificationMemRatio' field
-func (st *ConfigState) GetCacheNotificationMemRatio() (v float64) {
+// GetMediaThumbMaxPixels safely fetches the Configuration value for state's 'Media.ThumbMaxPixels' field
+func (st *ConfigState) GetMediaThumbMaxPixels() (v int) {
 	st.mutex.RLock()
-	v = st.config.Cache.NotificationMemRatio
+	v = st.config.Media.ThumbMaxPixels
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheNotificationMemRatio safely sets the Configuration value for state's 'Cache.NotificationMemRatio' field
-func (st *ConfigState) SetCacheNotificationMemRatio(v float64) {
+// SetMediaThumbMaxPixels safely sets the Configuration value for state's 'Media.ThumbMaxPixels' field
+func (st *ConfigState) SetMediaThumbMaxPixels(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.NotificationMemRatio = v
+	st.config.Media.ThumbMaxPixels = v
 	st.reloadToViper()
 }
 
-// GetCacheNotificationMemRatio safely fetches the value for global configuration 'Cache.NotificationMemRatio' field
-func GetCacheNotificationMemRatio() float64 { return global.GetCacheNotificationMemRatio() }
+// GetMediaThumbMaxPixels safely fetches the value for global configuration 'Media.ThumbMaxPixels' field
+func GetMediaThumbMaxPixels() int { return global.GetMediaThumbMaxPixels() }
 
-// SetCacheNotificationMemRatio safely sets the value for global configuration 'Cache.NotificationMemRatio' field
-func SetCacheNotificationMemRatio(v float64) { global.SetCacheNotificationMemRatio(v) }
+// SetMediaThumbMaxPixels safely sets the value for global configuration 'Media.ThumbMaxPixels' field
+func SetMediaThumbMaxPixels(v int) { global.SetMediaThumbMaxPixels(v) }
 
-// GetCachePollMemRatio safely fetches the Configuration value for state's 'Cache.PollMemRatio' field
-func (st *ConfigState) GetCachePollMemRatio() (v float64) {
+// GetMediaThumbFormat safely fetches the Configuration value for state's 'Media.ThumbFormat' field
+func (st *ConfigState) GetMediaThumbFormat() (v string) {
 	st.mutex.RLock()
-	v = st.config.Cache.PollMemRatio
+	v = st.config.Media.ThumbFormat
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCachePollMemRatio safely sets the Configuration value for state's 'Cache.PollMemRatio' field
-func (st *ConfigState) SetCachePollMemRatio(v float64) {
+// SetMediaThumbFormat safely sets the Configuration value for state's 'Media.ThumbFormat' field
+func (st *ConfigState) SetMediaThumbFormat(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.PollMemRatio = v
+	st.config.Media.ThumbFormat = v
 	st.reloadToViper()
 }
 
-// GetCachePollMemRatio safely fetches the value for global configuration 'Cache.PollMemRatio' field
-func GetCachePollMemRatio() float64 { return global.GetCachePollMemRatio() }
+// GetMediaThumbFormat safely fetches the value for global configuration 'Media.ThumbFormat' field
+func GetMediaThumbFormat() string { return global.GetMediaThumbFormat() }
 
-// SetCachePollMemRatio safely sets the value for global configuration 'Cache.PollMemRatio' field
-func SetCachePollMemRatio(v float64) { global.SetCachePollMemRatio(v) }
+// SetMediaThumbFormat safely sets the value for global configuration 'Media.ThumbFormat' field
+func SetMediaThumbFormat(v string) { global.SetMediaThumbFormat(v) }
 
-// GetCachePollVoteMemRatio safely fetches the Configuration value for state's 'Cache.PollVoteMemRatio' field
-func (st *ConfigState) GetCachePollVoteMemRatio() (v float64) {
+// GetMediaVideoSpriteFrames safely fetches the Configuration value for state's 'Media.VideoSpriteFrames' field
+func (st *ConfigState) GetMediaVideoSpriteFrames() (v int) {
 	st.mutex.RLock()
-	v = st.config.Cache.PollVoteMemRatio
+	v = st.config.Media.VideoSpriteFrames
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCachePollVoteMemRatio safely sets the Configuration value for state's 'Cache.PollVoteMemRatio' field
-func (st *ConfigState) SetCachePollVoteMemRatio(v float64) {
+// SetMediaVideoSpriteFrames safely sets the Configuration value for state's 'Media.VideoSpriteFrames' field
+func (st *ConfigState) SetMediaVideoSpriteFrames(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.PollVoteMemRatio = v
+	st.config.Media.VideoSpriteFrames = v
 	st.reloadToViper()
 }
 
-// GetCachePollVoteMemRatio safely fetches the value for global configuration 'Cache.PollVoteMemRatio' field
-func GetCachePollVoteMemRatio() float64 { return global.GetCachePollVoteMemRatio() }
+// GetMediaVideoSpriteFrames safely fetches the value for global configuration 'Media.VideoSpriteFrames' field
+func GetMediaVideoSpriteFrames() int { return global.GetMediaVideoSpriteFrames() }
 
-// SetCachePollVoteMemRatio safely sets the value for global configuration 'Cache.PollVoteMemRatio' field
-func SetCachePollVoteMemRatio(v float64) { global.SetCachePollVoteMemRatio(v) }
+// SetMediaVideoSpriteFrames safely sets the value for global configuration 'Media.VideoSpriteFrames' field
+func SetMediaVideoSpriteFrames(v int) { global.SetMediaVideoSpriteFrames(v) }
 
-// GetCachePollVoteIDsMemRatio safely fetches the Configuration value for state's 'Cache.PollVoteIDsMemRatio' field
-func (st *ConfigState) GetCachePollVoteIDsMemRatio() (v float64) {
+// GetCacheS3ObjectInfo safely fetches the Configuration value for state's 'Cache.S3ObjectInfo' field
+func (st *ConfigState) GetCacheS3ObjectInfo() (v int) {
 	st.mutex.RLock()
-	v = st.config.Cache.PollVoteIDsMemRatio
+	v = st.config.Cache.S3ObjectInfo
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCachePollVoteIDsMemRatio safely sets the Configuration value for state's 'Cache.PollVoteIDsMemRatio' field
-func (st *ConfigState) SetCachePollVoteIDsMemRatio(v float64) {
+// SetCacheS3ObjectInfo safely sets the Configuration value for state's 'Cache.S3ObjectInfo' field
+func (st *ConfigState) SetCacheS3ObjectInfo(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.PollVoteIDsMemRatio = v
+	st.config.Cache.S3ObjectInfo = v
 	st.reloadToViper()
 }
 
-// GetCachePollVoteIDsMemRatio safely fetches the value for global configuration 'Cache.PollVoteIDsMemRatio' field
-func GetCachePollVoteIDsMemRatio() float64 { return global.GetCachePollVoteIDsMemRatio() }
+// GetCacheS3ObjectInfo safely fetches the value for global configuration 'Cache.S3ObjectInfo' field
+func GetCacheS3ObjectInfo() int { return global.GetCacheS3ObjectInfo() }
 
-// SetCachePollVoteIDsMemRatio safely sets the value for global configuration 'Cache.PollVoteIDsMemRatio' field
-func SetCachePollVoteIDsMemRatio(v float64) { global.SetCachePollVoteIDsMemRatio(v) }
+// SetCacheS3ObjectInfo safely sets the value for global configuration 'Cache.S3ObjectInfo' field
+func SetCacheS3ObjectInfo(v int) { global.SetCacheS3ObjectInfo(v) }
 
-// GetCacheReportMemRatio safely fetches the Configuration value for state's 'Cache.ReportMemRatio' field
-func (st *ConfigState) GetCacheReportMemRatio() (v float64) {
+// GetCacheHomeTimelineTimeout safely fetches the Configuration value for state's 'Cache.HomeTimelineTimeout' field
+func (st *ConfigState) GetCacheHomeTimelineTimeout() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.Cache.ReportMemRatio
+	v = st.config.Cache.HomeTimelineTimeout
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheReportMemRatio safely sets the Configuration value for state's 'Cache.ReportMemRatio' field
-func (st *ConfigState) SetCacheReportMemRatio(v float64) {
+// SetCacheHomeTimelineTimeout safely sets the Configuration value for state's 'Cache.HomeTimelineTimeout' field
+func (st *ConfigState) SetCacheHomeTimelineTimeout(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.ReportMemRatio = v
+	st.config.Cache.HomeTimelineTimeout = v
 	st.reloadToViper()
 }
 
-// GetCacheReportMemRatio safely fetches the value for global configuration 'Cache.ReportMemRatio' field
-func GetCacheReportMemRatio() float64 { return global.GetCacheReportMemRatio() }
+// GetCacheHomeTimelineTimeout safely fetches the value for global configuration 'Cache.HomeTimelineTimeout' field
+func GetCacheHomeTimelineTimeout() time.Duration { return global.GetCacheHomeTimelineTimeout() }
 
-// SetCacheReportMemRatio safely sets the value for global configuration 'Cache.ReportMemRatio' field
-func SetCacheReportMemRatio(v float64) { global.SetCacheReportMemRatio(v) }
+// SetCacheHomeTimelineTimeout safely sets the value for global configuration 'Cache.HomeTimelineTimeout' field
+func SetCacheHomeTimelineTimeout(v time.Duration) { global.SetCacheHomeTimelineTimeout(v) }
 
-// GetCacheScheduledStatusMemRatio safely fetches the Configuration value for state's 'Cache.ScheduledStatusMemRatio' field
-func (st *ConfigState) GetCacheScheduledStatusMemRatio() (v float64) {
+// GetCacheHomeTimelineMaxEntries safely fetches the Configuration value for state's 'Cache.HomeTimelineMaxEntries' field
+func (st *ConfigState) GetCacheHomeTimelineMaxEntries() (v int) {
 	st.mutex.RLock()
-	v = st.config.Cache.ScheduledStatusMemRatio
+	v = st.config.Cache.HomeTimelineMaxEntries
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheScheduledStatusMemRatio safely sets the Configuration value for state's 'Cache.ScheduledStatusMemRatio' field
-func (st *ConfigState) SetCacheScheduledStatusMemRatio(v float64) {
+// SetCacheHomeTimelineMaxEntries safely sets the Configuration value for state's 'Cache.HomeTimelineMaxEntries' field
+func (st *ConfigState) SetCacheHomeTimelineMaxEntries(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.ScheduledStatusMemRatio = v
+	st.config.Cache.HomeTimelineMaxEntries = v
 	st.reloadToViper()
 }
 
-// GetCacheScheduledStatusMemRatio safely fetches the value for global configuration 'Cache.ScheduledStatusMemRatio' field
-func GetCacheScheduledStatusMemRatio() float64 { return global.GetCacheScheduledStatusMemRatio() }
+// GetCacheHomeTimelineMaxEntries safely fetches the value for global configuration 'Cache.HomeTimelineMaxEntries' field
+func GetCacheHomeTimelineMaxEntries() int { return global.GetCacheHomeTimelineMaxEntries() }
 
-// SetCacheScheduledStatusMemRatio safely sets the value for global configuration 'Cache.ScheduledStatusMemRatio' field
-func SetCacheScheduledStatusMemRatio(v float64) { global.SetCacheScheduledStatusMemRatio(v) }
+// SetCacheHomeTimelineMaxEntries safely sets the value for global configuration 'Cache.HomeTimelineMaxEntries' field
+func SetCacheHomeTimelineMaxEntries(v int) { global.SetCacheHomeTimelineMaxEntries(v) }
 
-// GetCacheSinBinStatusMemRatio safely fetches the Configuration value for state's 'Cache.SinBinStatusMemRatio' field
-func (st *ConfigState) GetCacheSinBinStatusMemRatio() (v float64) {
+// GetCacheListTimelineTimeout safely fetches the Configuration value for state's 'Cache.ListTimelineTimeout' field
+func (st *ConfigState) GetCacheListTimelineTimeout() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.Cache.SinBinStatusMemRatio
+	v = st.config.Cache.ListTimelineTimeout
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheSinBinStatusMemRatio safely sets the Configuration value for state's 'Cache.SinBinStatusMemRatio' field
-func (st *ConfigState) SetCacheSinBinStatusMemRatio(v float64) {
+// SetCacheListTimelineTimeout safely sets the Configuration value for state's 'Cache.ListTimelineTimeout' field
+func (st *ConfigState) SetCacheListTimelineTimeout(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.SinBinStatusMemRatio = v
+	st.config.Cache.ListTimelineTimeout = v
 	st.reloadToViper()
 }
 
-// GetCacheSinBinStatusMemRatio safely fetches the value for global configuration 'Cache.SinBinStatusMemRatio' field
-func GetCacheSinBinStatusMemRatio() float64 { return global.GetCacheSinBinStatusMemRatio() }
-
-// SetCacheSinBinStatusMemRatio safely sets the value for global configuration 'Cache.SinBinStatusMemRatio' field
-func SetCacheSinBinStatusMemRatio(v float64) { global.SetCacheSinBinStatusMemRatio(v) }
+// GetCacheListTimelineTimeout safely fetches the value for global configuration 'Cache.ListTimelineTimeout' field
+func GetCacheListTimelineTimeout() time.Duration { return global.GetCacheListTimelineTimeout() }
 
-// GetCacheStatusMemRatio safely fetches the Configuration value for state's 'Cache.StatusMemRatio' field
-func (st *ConfigState) GetCacheStatusMemRatio() (v float64) {
+// SetCacheListTimelineTimeout safely sets the value for global configuration 'Cache.ListTimelineTimeout' field
+func SetCacheListTimelineTimeout(v time.Duration) { global.SetCacheListTimelineTimeout(v) }
+
+// GetCacheTagTimelineTimeout safely fetches the Configuration value for state's 'Cache.TagTimelineTimeout' field
+func (st *ConfigState) GetCacheTagTimelineTimeout() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.Cache.StatusMemRatio
+	v = st.config.Cache.TagTimelineTimeout
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheStatusMemRatio safely sets the Configuration value for state's 'Cache.StatusMemRatio' field
-func (st *ConfigState) SetCacheStatusMemRatio(v float64) {
+// SetCacheTagTimelineTimeout safely sets the Configuration value for state's 'Cache.TagTimelineTimeout' field
+func (st *ConfigState) SetCacheTagTimelineTimeout(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.StatusMemRatio = v
+	st.config.Cache.TagTimelineTimeout = v
 	st.reloadToViper()
 }
 
-// GetCacheStatusMemRatio safely fetches the value for global configuration 'Cache.StatusMemRatio' field
-func GetCacheStatusMemRatio() float64 { return global.GetCacheStatusMemRatio() }
+// GetCacheTagTimelineTimeout safely fetches the value for global configuration 'Cache.TagTimelineTimeout' field
+func GetCacheTagTimelineTimeout() time.Duration { return global.GetCacheTagTimelineTimeout() }
 
-// SetCacheStatusMemRatio safely sets the value for global configuration 'Cache.StatusMemRatio' field
-func SetCacheStatusMemRatio(v float64) { global.SetCacheStatusMemRatio(v) }
+// SetCacheTagTimelineTimeout safely sets the value for global configuration 'Cache.TagTimelineTimeout' field
+func SetCacheTagTimelineTimeout(v time.Duration) { global.SetCacheTagTimelineTimeout(v) }
 
-// GetCacheStatusBookmarkMemRatio safely fetches the Configuration value for state's 'Cache.StatusBookmarkMemRatio' field
-func (st *ConfigState) GetCacheStatusBookmarkMemRatio() (v float64) {
+// GetCacheLanguageTimelineTimeout safely fetches the Configuration value for state's 'Cache.LanguageTimelineTimeout' field
+func (st *ConfigState) GetCacheLanguageTimelineTimeout() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.Cache.StatusBookmarkMemRatio
+	v = st.config.Cache.LanguageTimelineTimeout
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheStatusBookmarkMemRatio safely sets the Configuration value for state's 'Cache.StatusBookmarkMemRatio' field
-func (st *ConfigState) SetCacheStatusBookmarkMemRatio(v float64) {
+// SetCacheLanguageTimelineTimeout safely sets the Configuration value for state's 'Cache.LanguageTimelineTimeout' field
+func (st *ConfigState) SetCacheLanguageTimelineTimeout(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.StatusBookmarkMemRatio = v
+	st.config.Cache.LanguageTimelineTimeout = v
 	st.reloadToViper()
 }
 
-// GetCacheStatusBookmarkMemRatio safely fetches the value for global configuration 'Cache.StatusBookmarkMemRatio' field
-func GetCacheStatusBookmarkMemRatio() float64 { return global.GetCacheStatusBookmarkMemRatio() }
+// GetCacheLanguageTimelineTimeout safely fetches the value for global configuration 'Cache.LanguageTimelineTimeout' field
+func GetCacheLanguageTimelineTimeout() time.Duration { return global.GetCacheLanguageTimelineTimeout() }
 
-// SetCacheStatusBookmarkMemRatio safely sets the value for global configuration 'Cache.StatusBookmarkMemRatio' field
-func SetCacheStatusBookmarkMemRatio(v float64) { global.SetCacheStatusBookmarkMemRatio(v) }
+// SetCacheLanguageTimelineTimeout safely sets the value for global configuration 'Cache.LanguageTimelineTimeout' field
+func SetCacheLanguageTimelineTimeout(v time.Duration) { global.SetCacheLanguageTimelineTimeout(v) }
 
-// GetCacheStatusBookmarkIDsMemRatio safely fetches the Configuration value for state's 'Cache.StatusBookmarkIDsMemRatio' field
-func (st *ConfigState) GetCacheStatusBookmarkIDsMemRatio() (v float64) {
+// GetCacheAntennaTimelineTimeout safely fetches the Configuration value for state's 'Cache.AntennaTimelineTimeout' field
+func (st *ConfigState) GetCacheAntennaTimelineTimeout() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.Cache.StatusBookmarkIDsMemRatio
+	v = st.config.Cache.AntennaTimelineTimeout
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheStatusBookmarkIDsMemRatio safely sets the Configuration value for state's 'Cache.StatusBookmarkIDsMemRatio' field
-func (st *ConfigState) SetCacheStatusBookmarkIDsMemRatio(v float64) {
+// SetCacheAntennaTimelineTimeout safely sets the Configuration value for state's 'Cache.AntennaTimelineTimeout' field
+func (st *ConfigState) SetCacheAntennaTimelineTimeout(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.StatusBookmarkIDsMemRatio = v
+	st.config.Cache.AntennaTimelineTimeout = v
 	st.reloadToViper()
 }
 
-// GetCacheStatusBookmarkIDsMemRatio safely fetches the value for global configuration 'Cache.StatusBookmarkIDsMemRatio' field
-func GetCacheStatusBookmarkIDsMemRatio() float64 { return global.GetCacheStatusBookmarkIDsMemRatio() }
+// GetCacheAntennaTimelineTimeout safely fetches the value for global configuration 'Cache.AntennaTimelineTimeout' field
+func GetCacheAntennaTimelineTimeout() time.Duration { return global.GetCacheAntennaTimelineTimeout() }
 
-// SetCacheStatusBookmarkIDsMemRatio safely sets the value for global configuration 'Cache.StatusBookmarkIDsMemRatio' field
-func SetCacheStatusBookmarkIDsMemRatio(v float64) { global.SetCacheStatusBookmarkIDsMemRatio(v) }
+// SetCacheAntennaTimelineTimeout safely sets the value for global configuration 'Cache.AntennaTimelineTimeout' field
+func SetCacheAntennaTimelineTimeout(v time.Duration) { global.SetCacheAntennaTimelineTimeout(v) }
 
-// GetCacheStatusEditMemRatio safely fetches the Configuration value for state's 'Cache.StatusEditMemRatio' field
-func (st *ConfigState) GetCacheStatusEditMemRatio() (v float64) {
+// GetCacheVisibilitySweepFrequency safely fetches the Configuration value for state's 'Cache.VisibilitySweepFrequency' field
+func (st *ConfigState) GetCacheVisibilitySweepFrequency() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.Cache.StatusEditMemRatio
+	v = st.config.Cache.VisibilitySweepFrequency
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheStatusEditMemRatio safely sets the Configuration value for state's 'Cache.StatusEditMemRatio' field
-func (st *ConfigState) SetCacheStatusEditMemRatio(v float64) {
+// SetCacheVisibilitySweepFrequency safely sets the Configuration value for state's 'Cache.VisibilitySweepFrequency' field
+func (st *ConfigState) SetCacheVisibilitySweepFrequency(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.StatusEditMemRatio = v
+	st.config.Cache.VisibilitySweepFrequency = v
 	st.reloadToViper()
 }
 
-// GetCacheStatusEditMemRatio safely fetches the value for global configuration 'Cache.StatusEditMemRatio' field
-func GetCacheStatusEditMemRatio() float64 { return global.GetCacheStatusEditMemRatio() }
+// GetCacheVisibilitySweepFrequency safely fetches the value for global configuration 'Cache.VisibilitySweepFrequency' field
+func GetCacheVisibilitySweepFrequency() time.Duration {
+	return global.GetCacheVisibilitySweepFrequency()
+}
 
-// SetCacheStatusEditMemRatio safely sets the value for global configuration 'Cache.StatusEditMemRatio' field
-func SetCacheStatusEditMemRatio(v float64) { global.SetCacheStatusEditMemRatio(v) }
+// SetCacheVisibilitySweepFrequency safely sets the value for global configuration 'Cache.VisibilitySweepFrequency' field
+func SetCacheVisibilitySweepFrequency(v time.Duration) { global.SetCacheVisibilitySweepFrequency(v) }
 
-// GetCacheStatusFaveMemRatio safely fetches the Configuration value for state's 'Cache.StatusFaveMemRatio' field
-func (st *ConfigState) GetCacheStatusFaveMemRatio() (v float64) {
+// GetCacheMemoryTarget safely fetches the Configuration value for state's 'Cache.MemoryTarget' field
+func (st *ConfigState) GetCacheMemoryTarget() (v bytesize.Size) {
 	st.mutex.RLock()
-	v = st.config.Cache.StatusFaveMemRatio
+	v = st.config.Cache.MemoryTarget
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheStatusFaveMemRatio safely sets the Configuration value for state's 'Cache.StatusFaveMemRatio' field
-func (st *ConfigState) SetCacheStatusFaveMemRatio(v float64) {
+// SetCacheMemoryTarget safely sets the Configuration value for state's 'Cache.MemoryTarget' field
+func (st *ConfigState) SetCacheMemoryTarget(v bytesize.Size) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.StatusFaveMemRatio = v
+	st.config.Cache.MemoryTarget = v
 	st.reloadToViper()
 }
 
-// GetCacheStatusFaveMemRatio safely fetches the value for global configuration 'Cache.StatusFaveMemRatio' field
-func GetCacheStatusFaveMemRatio() float64 { return global.GetCacheStatusFaveMemRatio() }
+// GetCacheMemoryTarget safely fetches the value for global configuration 'Cache.MemoryTarget' field
+func GetCacheMemoryTarget() bytesize.Size { return global.GetCacheMemoryTarget() }
 
-// SetCacheStatusFaveMemRatio safely sets the value for global configuration 'Cache.StatusFaveMemRatio' field
-func SetCacheStatusFaveMemRatio(v float64) { global.SetCacheStatusFaveMemRatio(v) }
+// SetCacheMemoryTarget safely sets the value for global configuration 'Cache.MemoryTarget' field
+func SetCacheMemoryTarget(v bytesize.Size) { global.SetCacheMemoryTarget(v) }
 
-// GetCacheStatusFaveIDsMemRatio safely fetches the Configuration value for state's 'Cache.StatusFaveIDsMemRatio' field
-func (st *ConfigState) GetCacheStatusFaveIDsMemRatio() (v float64) {
+// GetCacheAccountMemRatio safely fetches the Configuration value for state's 'Cache.AccountMemRatio' field
+func (st *ConfigState) GetCacheAccountMemRatio() (v float64) {
 	st.mutex.RLock()
-	v = st.config.Cache.StatusFaveIDsMemRatio
+	v = st.config.Cache.AccountMemRatio
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheStatusFaveIDsMemRatio safely sets the Configuration value for state's 'Cache.StatusFaveIDsMemRatio' field
-func (st *ConfigState) SetCacheStatusFaveIDsMemRatio(v float64) {
+// SetCacheAccountMemRatio safely sets the Configuration value for state's 'Cache.AccountMemRatio' field
+func (st *ConfigState) SetCacheAccountMemRatio(v float64) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.StatusFaveIDsMemRatio = v
+	st.config.Cache.AccountMemRatio = v
 	st.reloadToViper()
 }
 
-// GetCacheStatusFaveIDsMemRatio safely fetches the value for global configuration 'Cache.StatusFaveIDsMemRatio' field
-func GetCacheStatusFaveIDsMemRatio() float64 { return global.GetCacheStatusFaveIDsMemRatio() }
+// GetCacheAccountMemRatio safely fetches the value for global configuration 'Cache.AccountMemRatio' field
+func GetCacheAccountMemRatio() float64 { return global.GetCacheAccountMemRatio() }
 
-// SetCacheStatusFaveIDsMemRatio safely sets the value for global configuration 'Cache.StatusFaveIDsMemRatio' field
-func SetCacheStatusFaveIDsMemRatio(v float64) { global.SetCacheStatusFaveIDsMemRatio(v) }
+// SetCacheAccountMemRatio safely sets the value for global configuration 'Cache.AccountMemRatio' field
+func SetCacheAccountMemRatio(v float64) { global.SetCacheAccountMemRatio(v) }
 
-// GetCacheTagMemRatio safely fetches the Configuration value for state's 'Cache.TagMemRatio' field
-func (st *ConfigState) GetCacheTagMemRatio() (v float64) {
+// GetCacheAccountNoteMemRatio safely fetches the Configuration value for state's 'Cache.AccountNoteMemRatio' field
+func (st *ConfigState) GetCacheAccountNoteMemRatio() (v float64) {
 	st.mutex.RLock()
-	v = st.config.Cache.TagMemRatio
+	v = st.config.Cache.AccountNoteMemRatio
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheTagMemRatio safely sets the Configuration value for state's 'Cache.TagMemRatio' field
-func (st *ConfigState) SetCacheTagMemRatio(v float64) {
+// SetCacheAccountNoteMemRatio safely sets the Configuration value for state's 'Cache.AccountNoteMemRatio' field
+func (st *ConfigState) SetCacheAccountNoteMemRatio(v float64) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.TagMemRatio = v
+	st.config.Cache.AccountNoteMemRatio = v
 	st.reloadToViper()
 }
 
-// GetCacheTagMemRatio safely fetches the value for global configuration 'Cache.TagMemRatio' field
-func GetCacheTagMemRatio() float64 { return global.GetCacheTagMemRatio() }
+// GetCacheAccountNoteMemRatio safely fetches the value for global configuration 'Cache.AccountNoteMemRatio' field
+func GetCacheAccountNoteMemRatio() float64 { return global.GetCacheAccountNoteMemRatio() }
 
-// SetCacheTagMemRatio safely sets the value for global configuration 'Cache.TagMemRatio' field
-func SetCacheTagMemRatio(v float64) { global.SetCacheTagMemRatio(v) }
+// SetCacheAccountNoteMemRatio safely sets the value for global configuration 'Cache.AccountNoteMemRatio' field
+func SetCacheAccountNoteMemRatio(v float64) { global.SetCacheAccountNoteMemRatio(v) }
 
-// GetCacheThreadMuteMemRatio safely fetches the Configuration value for state's 'Cache.ThreadMuteMemRatio' field
-func (st *ConfigState) GetCacheThreadMuteMemRatio() (v float64) {
+// GetCacheAccountSettingsMemRatio safely fetches the Configuration value for state's 'Cache.AccountSettingsMemRatio' field
+func (st *ConfigState) GetCacheAccountSettingsMemRatio() (v float64) {
 	st.mutex.RLock()
-	v = st.config.Cache.ThreadMuteMemRatio
+	v = st.config.Cache.AccountSettingsMemRatio
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheThreadMuteMemRatio safely sets the Configuration value for state's 'Cache.ThreadMuteMemRatio' field
-func (st *ConfigState) SetCacheThreadMuteMemRatio(v float64) {
+// SetCacheAccountSettingsMemRatio safely sets the Configuration value for state's 'Cache.AccountSettingsMemRatio' field
+func (st *ConfigState) SetCacheAccountSettingsMemRatio(v float64) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.ThreadMuteMemRatio = v
+	st.config.Cache.AccountSettingsMemRatio = v
 	st.reloadToViper()
 }
 
-// GetCacheThreadMuteMemRatio safely fetches the value for global configuration 'Cache.ThreadMuteMemRatio' field
-func GetCacheThreadMuteMemRatio() float64 { return global.GetCacheThreadMuteMemRatio() }
+// GetCacheAccountSettingsMemRatio safely fetches the value for global configuration 'Cache.AccountSettingsMemRatio' field
+func GetCacheAccountSettingsMemRatio() float64 { return global.GetCacheAccountSettingsMemRatio() }
 
-// SetCacheThreadMuteMemRatio safely sets the value for global configuration 'Cache.ThreadMuteMemRatio' field
-func SetCacheThreadMuteMemRatio(v float64) { global.SetCacheThreadMuteMemRatio(v) }
+// SetCacheAccountSettingsMemRatio safely sets the value for global configuration 'Cache.AccountSettingsMemRatio' field
+func SetCacheAccountSettingsMemRatio(v float64) { global.SetCacheAccountSettingsMemRatio(v) }
 
-// GetCacheTokenMemRatio safely fetches the Configuration value for state's 'Cache.TokenMemRatio' field
-func (st *ConfigState) GetCacheTokenMemRatio() (v float64) {
+// GetCacheAccountStatsMemRatio safely fetches the Configuration value for state's 'Cache.AccountStatsMemRatio' field
+func (st *ConfigState) GetCacheAccountStatsMemRatio() (v float64) {
 	st.mutex.RLock()
-	v = st.config.Cache.TokenMemRatio
+	v = st.config.Cache.AccountStatsMemRatio
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheTokenMemRatio safely sets the Configuration value for state's 'Cache.TokenMemRatio' field
-func (st *ConfigState) SetCacheTokenMemRatio(v float64) {
+// SetCacheAccountStatsMemRatio safely sets the Configuration value for state's 'Cache.AccountStatsMemRatio' field
+func (st *ConfigState) SetCacheAccountStatsMemRatio(v float64) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.TokenMemRatio = v
+	st.config.Cache.AccountStatsMemRatio = v
 	st.reloadToViper()
 }
 
-// GetCacheTokenMemRatio safely fetches the value for global configuration 'Cache.TokenMemRatio' field
-func GetCacheTokenMemRatio() float64 { return global.GetCacheTokenMemRatio() }
+// GetCacheAccountStatsMemRatio safely fetches the value for global configuration 'Cache.AccountStatsMemRatio' field
+func GetCacheAccountStatsMemRatio() float64 { return global.GetCacheAccountStatsMemRatio() }
 
-// SetCacheTokenMemRatio safely sets the value for global configuration 'Cache.TokenMemRatio' field
-func SetCacheTokenMemRatio(v float64) { global.SetCacheTokenMemRatio(v) }
+// SetCacheAccountStatsMemRatio safely sets the value for global configuration 'Cache.AccountStatsMemRatio' field
+func SetCacheAccountStatsMemRatio(v float64) { global.SetCacheAccountStatsMemRatio(v) }
 
-// GetCacheTombstoneMemRatio safely fetches the Configuration value for state's 'Cache.TombstoneMemRatio' field
-func (st *ConfigState) GetCacheTombstoneMemRatio() (v float64) {
+// GetCacheAntennaMemRatio safely fetches the Configuration value for state's 'Cache.AntennaMemRatio' field
+func (st *ConfigState) GetCacheAntennaMemRatio() (v float64) {
 	st.mutex.RLock()
-	v = st.config.Cache.TombstoneMemRatio
+	v = st.config.Cache.AntennaMemRatio
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheTombstoneMemRatio safely sets the Configuration value for state's 'Cache.TombstoneMemRatio' field
-func (st *ConfigState) SetCacheTombstoneMemRatio(v float64) {
+// SetCacheAntennaMemRatio safely sets the Configuration value for state's 'Cache.AntennaMemRatio' field
+func (st *ConfigState) SetCacheAntennaMemRatio(v float64) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.TombstoneMemRatio = v
+	st.config.Cache.AntennaMemRatio = v
 	st.reloadToViper()
 }
 
-// GetCacheTombstoneMemRatio safely fetches the value for global configuration 'Cache.TombstoneMemRatio' field
-func GetCacheTombstoneMemRatio() float64 { return global.GetCacheTombstoneMemRatio() }
+// GetCacheAntennaMemRatio safely fetches the value for global configuration 'Cache.AntennaMemRatio' field
+func GetCacheAntennaMemRatio() float64 { return global.GetCacheAntennaMemRatio() }
 
-// SetCacheTombstoneMemRatio safely sets the value for global configuration 'Cache.TombstoneMemRatio' field
-func SetCacheTombstoneMemRatio(v float64) { global.SetCacheTombstoneMemRatio(v) }
+// SetCacheAntennaMemRatio safely sets the value for global configuration 'Cache.AntennaMemRatio' field
+func SetCacheAntennaMemRatio(v float64) { global.SetCacheAntennaMemRatio(v) }
 
-// GetCacheUserMemRatio safely fetches the Configuration value for state's 'Cache.UserMemRatio' field
-func (st *ConfigState) GetCacheUserMemRatio() (v float64) {
+// GetCacheAntennaIDsMemRatio safely fetches the Configuration value for state's 'Cache.AntennaIDsMemRatio' field
+func (st *ConfigState) GetCacheAntennaIDsMemRatio() (v float64) {
 	st.mutex.RLock()
-	v = st.config.Cache.UserMemRatio
+	v = st.config.Cache.AntennaIDsMemRatio
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheUserMemRatio safely sets the Configuration value for state's 'Cache.UserMemRatio' field
-func (st *ConfigState) SetCacheUserMemRatio(v float64) {
+// SetCacheAntennaIDsMemRatio safely sets the Configuration value for state's 'Cache.AntennaIDsMemRatio' field
+func (st *ConfigState) SetCacheAntennaIDsMemRatio(v float64) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.UserMemRatio = v
+	st.config.Cache.AntennaIDsMemRatio = v
 	st.reloadToViper()
 }
 
-// GetCacheUserMemRatio safely fetches the value for global configuration 'Cache.UserMemRatio' field
-func GetCacheUserMemRatio() float64 { return global.GetCacheUserMemRatio() }
+// GetCacheAntennaIDsMemRatio safely fetches the value for global configuration 'Cache.AntennaIDsMemRatio' field
+func GetCacheAntennaIDsMemRatio() float64 { return global.GetCacheAntennaIDsMemRatio() }
 
-// SetCacheUserMemRatio safely sets the value for global configuration 'Cache.UserMemRatio' field
-func SetCacheUserMemRatio(v float64) { global.SetCacheUserMemRatio(v) }
+// SetCacheAntennaIDsMemRatio safely sets the value for global configuration 'Cache.AntennaIDsMemRatio' field
+func SetCacheAntennaIDsMemRatio(v float64) { global.SetCacheAntennaIDsMemRatio(v) }
 
-// GetCacheUserMuteMemRatio safely fetches the Configuration value for state's 'Cache.UserMuteMemRatio' field
-func (st *ConfigState) GetCacheUserMuteMemRatio() (v float64) {
+// GetCacheApplicationMemRatio safely fetches the Configuration value for state's 'Cache.ApplicationMemRatio' field
+func (st *ConfigState) GetCacheApplicationMemRatio() (v float64) {
 	st.mutex.RLock()
-	v = st.config.Cache.UserMuteMemRatio
+	v = st.config.Cache.ApplicationMemRatio
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheUserMuteMemRatio safely sets the Configuration value for state's 'Cache.UserMuteMemRatio' field
-func (st *ConfigState) SetCacheUserMuteMemRatio(v float64) {
+// SetCacheApplicationMemRatio safely sets the Configuration value for state's 'Cache.ApplicationMemRatio' field
+func (st *ConfigState) SetCacheApplicationMemRatio(v float64) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.UserMuteMemRatio = v
+	st.config.Cache.ApplicationMemRatio = v
 	st.reloadToViper()
 }
 
-// GetCacheUserMuteMemRatio safely fetches the value for global configuration 'Cache.UserMuteMemRatio' field
-func GetCacheUserMuteMemRatio() float64 { return global.GetCacheUserMuteMemRatio() }
+// GetCacheApplicationMemRatio safely fetches the value for global configuration 'Cache.ApplicationMemRatio' field
+func GetCacheApplicationMemRatio() float64 { return global.GetCacheApplicationMemRatio() }
 
-// SetCacheUserMuteMemRatio safely sets the value for global configuration 'Cache.UserMuteMemRatio' field
-func SetCacheUserMuteMemRatio(v float64) { global.SetCacheUserMuteMemRatio(v) }
+// SetCacheApplicationMemRatio safely sets the value for global configuration 'Cache.ApplicationMemRatio' field
+func SetCacheApplicationMemRatio(v float64) { global.SetCacheApplicationMemRatio(v) }
 
-// GetCacheUserMuteIDsMemRatio safely fetches the Configuration value for state's 'Cache.UserMuteIDsMemRatio' field
-func (st *ConfigState) GetCacheUserMuteIDsMemRatio() (v float64) {
+// GetCacheBlockMemRatio safely fetches the Configuration value for state's 'Cache.BlockMemRatio' field
+func (st *ConfigState) GetCacheBlockMemRatio() (v float64) {
 	st.mutex.RLock()
-	v = st.config.Cache.UserMuteIDsMemRatio
+	v = st.config.Cache.BlockMemRatio
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheUserMuteIDsMemRatio safely sets the Configuration value for state's 'Cache.UserMuteIDsMemRatio' field
-func (st *ConfigState) SetCacheUserMuteIDsMemRatio(v float64) {
+// SetCacheBlockMemRatio safely sets the Configuration value for state's 'Cache.BlockMemRatio' field
+func (st *ConfigState) SetCacheBlockMemRatio(v float64) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.UserMuteIDsMemRatio = v
+	st.config.Cache.BlockMemRatio = v
 	st.reloadToViper()
 }
 
-// GetCacheUserMuteIDsMemRatio safely fetches the value for global configuration 'Cache.UserMuteIDsMemRatio' field
-func GetCacheUserMuteIDsMemRatio() float64 { return global.GetCacheUserMuteIDsMemRatio() }
+// GetCacheBlockMemRatio safely fetches the value for global configuration 'Cache.BlockMemRatio' field
+func GetCacheBlockMemRatio() float64 { return global.GetCacheBlockMemRatio() }
 
-// SetCacheUserMuteIDsMemRatio safely sets the value for global configuration 'Cache.UserMuteIDsMemRatio' field
-func SetCacheUserMuteIDsMemRatio(v float64) { global.SetCacheUserMuteIDsMemRatio(v) }
+// SetCacheBlockMemRatio safely sets the value for global configuration 'Cache.BlockMemRatio' field
+func SetCacheBlockMemRatio(v float64) { global.SetCacheBlockMemRatio(v) }
+
+// GetCacheBlockIDsMemRatio safely fetches the Configuration value for state's 'Cache.BlockIDsMemRatio' field
+func (st *ConfigState) GetCacheBlockIDsMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.BlockIDsMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheBlockIDsMemRatio safely sets the Configuration value for state's 'Cache.BlockIDsMemRatio' field
+func (st *ConfigState) SetCacheBlockIDsMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.BlockIDsMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheBlockIDsMemRatio safely fetches the value for global configuration 'Cache.BlockIDsMemRatio' field
+func GetCacheBlockIDsMemRatio() float64 { return global.GetCacheBlockIDsMemRatio() }
+
+// SetCacheBlockIDsMemRatio safely sets the value for global configuration 'Cache.BlockIDsMemRatio' field
+func SetCacheBlockIDsMemRatio(v float64) { global.SetCacheBlockIDsMemRatio(v) }
+
+// GetCacheBoostOfIDsMemRatio safely fetches the Configuration value for state's 'Cache.BoostOfIDsMemRatio' field
+func (st *ConfigState) GetCacheBoostOfIDsMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.BoostOfIDsMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheBoostOfIDsMemRatio safely sets the Configuration value for state's 'Cache.BoostOfIDsMemRatio' field
+func (st *ConfigState) SetCacheBoostOfIDsMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.BoostOfIDsMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheBoostOfIDsMemRatio safely fetches the value for global configuration 'Cache.BoostOfIDsMemRatio' field
+func GetCacheBoostOfIDsMemRatio() float64 { return global.GetCacheBoostOfIDsMemRatio() }
+
+// SetCacheBoostOfIDsMemRatio safely sets the value for global configuration 'Cache.BoostOfIDsMemRatio' field
+func SetCacheBoostOfIDsMemRatio(v float64) { global.SetCacheBoostOfIDsMemRatio(v) }
+
+// GetCacheBookmarkCollectionMemRatio safely fetches the Configuration value for state's 'Cache.BookmarkCollectionMemRatio' field
+func (st *ConfigState) GetCacheBookmarkCollectionMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.BookmarkCollectionMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheBookmarkCollectionMemRatio safely sets the Configuration value for state's 'Cache.BookmarkCollectionMemRatio' field
+func (st *ConfigState) SetCacheBookmarkCollectionMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.BookmarkCollectionMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheBookmarkCollectionMemRatio safely fetches the value for global configuration 'Cache.BookmarkCollectionMemRatio' field
+func GetCacheBookmarkCollectionMemRatio() float64 { return global.GetCacheBookmarkCollectionMemRatio() }
+
+// SetCacheBookmarkCollectionMemRatio safely sets the value for global configuration 'Cache.BookmarkCollectionMemRatio' field
+func SetCacheBookmarkCollectionMemRatio(v float64) { global.SetCacheBookmarkCollectionMemRatio(v) }
+
+// GetCacheBookmarkCollectionIDsMemRatio safely fetches the Configuration value for state's 'Cache.BookmarkCollectionIDsMemRatio' field
+func (st *ConfigState) GetCacheBookmarkCollectionIDsMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.BookmarkCollectionIDsMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheBookmarkCollectionIDsMemRatio safely sets the Configuration value for state's 'Cache.BookmarkCollectionIDsMemRatio' field
+func (st *ConfigState) SetCacheBookmarkCollectionIDsMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.BookmarkCollectionIDsMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheBookmarkCollectionIDsMemRatio safely fetches the value for global configuration 'Cache.BookmarkCollectionIDsMemRatio' field
+func GetCacheBookmarkCollectionIDsMemRatio() float64 {
+	return global.GetCacheBookmarkCollectionIDsMemRatio()
+}
+
+// SetCacheBookmarkCollectionIDsMemRatio safely sets the value for global configuration 'Cache.BookmarkCollectionIDsMemRatio' field
+func SetCacheBookmarkCollectionIDsMemRatio(v float64) {
+	global.SetCacheBookmarkCollectionIDsMemRatio(v)
+}
+
+// GetCacheClientMemRatio safely fetches the Configuration value for state's 'Cache.ClientMemRatio' field
+func (st *ConfigState) GetCacheClientMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.ClientMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheClientMemRatio safely sets the Configuration value for state's 'Cache.ClientMemRatio' field
+func (st *ConfigState) SetCacheClientMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.ClientMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheClientMemRatio safely fetches the value for global configuration 'Cache.ClientMemRatio' field
+func GetCacheClientMemRatio() float64 { return global.GetCacheClientMemRatio() }
+
+// SetCacheClientMemRatio safely sets the value for global configuration 'Cache.ClientMemRatio' field
+func SetCacheClientMemRatio(v float64) { global.SetCacheClientMemRatio(v) }
+
+// GetCacheConversationMemRatio safely fetches the Configuration value for state's 'Cache.ConversationMemRatio' field
+func (st *ConfigState) GetCacheConversationMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.ConversationMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheConversationMemRatio safely sets the Configuration value for state's 'Cache.ConversationMemRatio' field
+func (st *ConfigState) SetCacheConversationMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.ConversationMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheConversationMemRatio safely fetches the value for global configuration 'Cache.ConversationMemRatio' field
+func GetCacheConversationMemRatio() float64 { return global.GetCacheConversationMemRatio() }
+
+// SetCacheConversationMemRatio safely sets the value for global configuration 'Cache.ConversationMemRatio' field
+func SetCacheConversationMemRatio(v float64) { global.SetCacheConversationMemRatio(v) }
+
+// GetCacheConversationLastStatusIDsMemRatio safely fetches the Configuration value for state's 'Cache.ConversationLastStatusIDsMemRatio' field
+func (st *ConfigState) GetCacheConversationLastStatusIDsMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.ConversationLastStatusIDsMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheConversationLastStatusIDsMemRatio safely sets the Configuration value for state's 'Cache.ConversationLastStatusIDsMemRatio' field
+func (st *ConfigState) SetCacheConversationLastStatusIDsMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.ConversationLastStatusIDsMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheConversationLastStatusIDsMemRatio safely fetches the value for global configuration 'Cache.ConversationLastStatusIDsMemRatio' field
+func GetCacheConversationLastStatusIDsMemRatio() float64 {
+	return global.GetCacheConversationLastStatusIDsMemRatio()
+}
+
+// SetCacheConversationLastStatusIDsMemRatio safely sets the value for global configuration 'Cache.ConversationLastStatusIDsMemRatio' field
+func SetCacheConversationLastStatusIDsMemRatio(v float64) {
+	global.SetCacheConversationLastStatusIDsMemRatio(v)
+}
+
+// GetCacheDomainPermissionDraftMemRatio safely fetches the Configuration value for state's 'Cache.DomainPermissionDraftMemRatio' field
+func (st *ConfigState) GetCacheDomainPermissionDraftMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.DomainPermissionDraftMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheDomainPermissionDraftMemRatio safely sets the Configuration value for state's 'Cache.DomainPermissionDraftMemRatio' field
+func (st *ConfigState) SetCacheDomainPermissionDraftMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.DomainPermissionDraftMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheDomainPermissionDraftMemRatio safely fetches the value for global configuration 'Cache.DomainPermissionDraftMemRatio' field
+func GetCacheDomainPermissionDraftMemRatio() float64 {
+	return global.GetCacheDomainPermissionDraftMemRatio()
+}
+
+// SetCacheDomainPermissionDraftMemRatio safely sets the value for global configuration 'Cache.DomainPermissionDraftMemRatio' field
+func SetCacheDomainPermissionDraftMemRatio(v float64) {
+	global.SetCacheDomainPermissionDraftMemRatio(v)
+}
+
+// GetCacheDomainLimitMemRatio safely fetches the Configuration value for state's 'Cache.DomainLimitMemRatio' field
+func (st *ConfigState) GetCacheDomainLimitMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.DomainLimitMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheDomainLimitMemRatio safely sets the Configuration value for state's 'Cache.DomainLimitMemRatio' field
+func (st *ConfigState) SetCacheDomainLimitMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.DomainLimitMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheDomainLimitMemRatio safely fetches the value for global configuration 'Cache.DomainLimitMemRatio' field
+func GetCacheDomainLimitMemRatio() float64 { return global.GetCacheDomainLimitMemRatio() }
+
+// SetCacheDomainLimitMemRatio safely sets the value for global configuration 'Cache.DomainLimitMemRatio' field
+func SetCacheDomainLimitMemRatio(v float64) { global.SetCacheDomainLimitMemRatio(v) }
+
+// GetCacheDomainPermissionSubscriptionMemRatio safely fetches the Configuration value for state's 'Cache.DomainPermissionSubscriptionMemRatio' field
+func (st *ConfigState) GetCacheDomainPermissionSubscriptionMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.DomainPermissionSubscriptionMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheDomainPermissionSubscriptionMemRatio safely sets the Configuration value for state's 'Cache.DomainPermissionSubscriptionMemRatio' field
+func (st *ConfigState) SetCacheDomainPermissionSubscriptionMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.DomainPermissionSubscriptionMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheDomainPermissionSubscriptionMemRatio safely fetches the value for global configuration 'Cache.DomainPermissionSubscriptionMemRatio' field
+func GetCacheDomainPermissionSubscriptionMemRatio() float64 {
+	return global.GetCacheDomainPermissionSubscriptionMemRatio()
+}
+
+// SetCacheDomainPermissionSubscriptionMemRatio safely sets the value for global configuration 'Cache.DomainPermissionSubscriptionMemRatio' field
+func SetCacheDomainPermissionSubscriptionMemRatio(v float64) {
+	global.SetCacheDomainPermissionSubscriptionMemRatio(v)
+}
+
+// GetCacheEmojiMemRatio safely fetches the Configuration value for state's 'Cache.EmojiMemRatio' field
+func (st *ConfigState) GetCacheEmojiMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.EmojiMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheEmojiMemRatio safely sets the Configuration value for state's 'Cache.EmojiMemRatio' field
+func (st *ConfigState) SetCacheEmojiMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.EmojiMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheEmojiMemRatio safely fetches the value for global configuration 'Cache.EmojiMemRatio' field
+func GetCacheEmojiMemRatio() float64 { return global.GetCacheEmojiMemRatio() }
+
+// SetCacheEmojiMemRatio safely sets the value for global configuration 'Cache.EmojiMemRatio' field
+func SetCacheEmojiMemRatio(v float64) { global.SetCacheEmojiMemRatio(v) }
+
+// GetCacheEmojiCategoryMemRatio safely fetches the Configuration value for state's 'Cache.EmojiCategoryMemRatio' field
+func (st *ConfigState) GetCacheEmojiCategoryMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.EmojiCategoryMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheEmojiCategoryMemRatio safely sets the Configuration value for state's 'Cache.EmojiCategoryMemRatio' field
+func (st *ConfigState) SetCacheEmojiCategoryMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.EmojiCategoryMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheEmojiCategoryMemRatio safely fetches the value for global configuration 'Cache.EmojiCategoryMemRatio' field
+func GetCacheEmojiCategoryMemRatio() float64 { return global.GetCacheEmojiCategoryMemRatio() }
+
+// SetCacheEmojiCategoryMemRatio safely sets the value for global configuration 'Cache.EmojiCategoryMemRatio' field
+func SetCacheEmojiCategoryMemRatio(v float64) { global.SetCacheEmojiCategoryMemRatio(v) }
+
+// GetCacheFilterMemRatio safely fetches the Configuration value for state's 'Cache.FilterMemRatio' field
+func (st *ConfigState) GetCacheFilterMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.FilterMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheFilterMemRatio safely sets the Configuration value for state's 'Cache.FilterMemRatio' field
+func (st *ConfigState) SetCacheFilterMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.FilterMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheFilterMemRatio safely fetches the value for global configuration 'Cache.FilterMemRatio' field
+func GetCacheFilterMemRatio() float64 { return global.GetCacheFilterMemRatio() }
+
+// SetCacheFilterMemRatio safely sets the value for global configuration 'Cache.FilterMemRatio' field
+func SetCacheFilterMemRatio(v float64) { global.SetCacheFilterMemRatio(v) }
+
+// GetCacheFilterIDsMemRatio safely fetches the Configuration value for state's 'Cache.FilterIDsMemRatio' field
+func (st *ConfigState) GetCacheFilterIDsMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.FilterIDsMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheFilterIDsMemRatio safely sets the Configuration value for state's 'Cache.FilterIDsMemRatio' field
+func (st *ConfigState) SetCacheFilterIDsMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.FilterIDsMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheFilterIDsMemRatio safely fetches the value for global configuration 'Cache.FilterIDsMemRatio' field
+func GetCacheFilterIDsMemRatio() float64 { return global.GetCacheFilterIDsMemRatio() }
+
+// SetCacheFilterIDsMemRatio safely sets the value for global configuration 'Cache.FilterIDsMemRatio' field
+func SetCacheFilterIDsMemRatio(v float64) { global.SetCacheFilterIDsMemRatio(v) }
+
+// GetCacheFilterKeywordMemRatio safely fetches the Configuration value for state's 'Cache.FilterKeywordMemRatio' field
+func (st *ConfigState) GetCacheFilterKeywordMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.FilterKeywordMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheFilterKeywordMemRatio safely sets the Configuration value for state's 'Cache.FilterKeywordMemRatio' field
+func (st *ConfigState) SetCacheFilterKeywordMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.FilterKeywordMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheFilterKeywordMemRatio safely fetches the value for global configuration 'Cache.FilterKeywordMemRatio' field
+func GetCacheFilterKeywordMemRatio() float64 { return global.GetCacheFilterKeywordMemRatio() }
+
+// SetCacheFilterKeywordMemRatio safely sets the value for global configuration 'Cache.FilterKeywordMemRatio' field
+func SetCacheFilterKeywordMemRatio(v float64) { global.SetCacheFilterKeywordMemRatio(v) }
+
+// GetCacheFilterStatusMemRatio safely fetches the Configuration value for state's 'Cache.FilterStatusMemRatio' field
+func (st *ConfigState) GetCacheFilterStatusMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.FilterStatusMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheFilterStatusMemRatio safely sets the Configuration value for state's 'Cache.FilterStatusMemRatio' field
+func (st *ConfigState) SetCacheFilterStatusMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.FilterStatusMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheFilterStatusMemRatio safely fetches the value for global configuration 'Cache.FilterStatusMemRatio' field
+func GetCacheFilterStatusMemRatio() float64 { return global.GetCacheFilterStatusMemRatio() }
+
+// SetCacheFilterStatusMemRatio safely sets the value for global configuration 'Cache.FilterStatusMemRatio' field
+func SetCacheFilterStatusMemRatio(v float64) { global.SetCacheFilterStatusMemRatio(v) }
+
+// GetCacheFollowMemRatio safely fetches the Configuration value for state's 'Cache.FollowMemRatio' field
+func (st *ConfigState) GetCacheFollowMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.FollowMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheFollowMemRatio safely sets the Configuration value for state's 'Cache.FollowMemRatio' field
+func (st *ConfigState) SetCacheFollowMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.FollowMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheFollowMemRatio safely fetches the value for global configuration 'Cache.FollowMemRatio' field
+func GetCacheFollowMemRatio() float64 { return global.GetCacheFollowMemRatio() }
+
+// SetCacheFollowMemRatio safely sets the value for global configuration 'Cache.FollowMemRatio' field
+func SetCacheFollowMemRatio(v float64) { global.SetCacheFollowMemRatio(v) }
+
+// GetCacheFollowIDsMemRatio safely fetches the Configuration value for state's 'Cache.FollowIDsMemRatio' field
+func (st *ConfigState) GetCacheFollowIDsMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.FollowIDsMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheFollowIDsMemRatio safely sets the Configuration value for state's 'Cache.FollowIDsMemRatio' field
+func (st *ConfigState) SetCacheFollowIDsMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.FollowIDsMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheFollowIDsMemRatio safely fetches the value for global configuration 'Cache.FollowIDsMemRatio' field
+func GetCacheFollowIDsMemRatio() float64 { return global.GetCacheFollowIDsMemRatio() }
+
+// SetCacheFollowIDsMemRatio safely sets the value for global configuration 'Cache.FollowIDsMemRatio' field
+func SetCacheFollowIDsMemRatio(v float64) { global.SetCacheFollowIDsMemRatio(v) }
+
+// GetCacheFollowRequestMemRatio safely fetches the Configuration value for state's 'Cache.FollowRequestMemRatio' field
+func (st *ConfigState) GetCacheFollowRequestMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.FollowRequestMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheFollowRequestMemRatio safely sets the Configuration value for state's 'Cache.FollowRequestMemRatio' field
+func (st *ConfigState) SetCacheFollowRequestMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.FollowRequestMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheFollowRequestMemRatio safely fetches the value for global configuration 'Cache.FollowRequestMemRatio' field
+func GetCacheFollowRequestMemRatio() float64 { return global.GetCacheFollowRequestMemRatio() }
+
+// SetCacheFollowRequestMemRatio safely sets the value for global configuration 'Cache.FollowRequestMemRatio' field
+func SetCacheFollowRequestMemRatio(v float64) { global.SetCacheFollowRequestMemRatio(v) }
+
+// GetCacheFollowRequestIDsMemRatio safely fetches the Configuration value for state's 'Cache.FollowRequestIDsMemRatio' field
+func (st *ConfigState) GetCacheFollowRequestIDsMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.FollowRequestIDsMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheFollowRequestIDsMemRatio safely sets the Configuration value for state's 'Cache.FollowRequestIDsMemRatio' field
+func (st *ConfigState) SetCacheFollowRequestIDsMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.FollowRequestIDsMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheFollowRequestIDsMemRatio safely fetches the value for global configuration 'Cache.FollowRequestIDsMemRatio' field
+func GetCacheFollowRequestIDsMemRatio() float64 { return global.GetCacheFollowRequestIDsMemRatio() }
+
+// SetCacheFollowRequestIDsMemRatio safely sets the value for global configuration 'Cache.FollowRequestIDsMemRatio' field
+func SetCacheFollowRequestIDsMemRatio(v float64) { global.SetCacheFollowRequestIDsMemRatio(v) }
+
+// GetCacheFollowingTagIDsMemRatio safely fetches the Configuration value for state's 'Cache.FollowingTagIDsMemRatio' field
+func (st *ConfigState) GetCacheFollowingTagIDsMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.FollowingTagIDsMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheFollowingTagIDsMemRatio safely sets the Configuration value for state's 'Cache.FollowingTagIDsMemRatio' field
+func (st *ConfigState) SetCacheFollowingTagIDsMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.FollowingTagIDsMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheFollowingTagIDsMemRatio safely fetches the value for global configuration 'Cache.FollowingTagIDsMemRatio' field
+func GetCacheFollowingTagIDsMemRatio() float64 { return global.GetCacheFollowingTagIDsMemRatio() }
+
+// SetCacheFollowingTagIDsMemRatio safely sets the value for global configuration 'Cache.FollowingTagIDsMemRatio' field
+func SetCacheFollowingTagIDsMemRatio(v float64) { global.SetCacheFollowingTagIDsMemRatio(v) }
+
+// GetCacheHomeAccountIDsMemRatio safely fetches the Configuration value for state's 'Cache.HomeAccountIDsMemRatio' field
+func (st *ConfigState) GetCacheHomeAccountIDsMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.HomeAccountIDsMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheHomeAccountIDsMemRatio safely sets the Configuration value for state's 'Cache.HomeAccountIDsMemRatio' field
+func (st *ConfigState) SetCacheHomeAccountIDsMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.HomeAccountIDsMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheHomeAccountIDsMemRatio safely fetches the value for global configuration 'Cache.HomeAccountIDsMemRatio' field
+func GetCacheHomeAccountIDsMemRatio() float64 { return global.GetCacheHomeAccountIDsMemRatio() }
+
+// SetCacheHomeAccountIDsMemRatio safely sets the value for global configuration 'Cache.HomeAccountIDsMemRatio' field
+func SetCacheHomeAccountIDsMemRatio(v float64) { global.SetCacheHomeAccountIDsMemRatio(v) }
+
+// GetCacheInReplyToIDsMemRatio safely fetches the Configuration value for state's 'Cache.InReplyToIDsMemRatio' field
+func (st *ConfigState) GetCacheInReplyToIDsMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.InReplyToIDsMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheInReplyToIDsMemRatio safely sets the Configuration value for state's 'Cache.InReplyToIDsMemRatio' field
+func (st *ConfigState) SetCacheInReplyToIDsMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.InReplyToIDsMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheInReplyToIDsMemRatio safely fetches the value for global configuration 'Cache.InReplyToIDsMemRatio' field
+func GetCacheInReplyToIDsMemRatio() float64 { return global.GetCacheInReplyToIDsMemRatio() }
+
+// SetCacheInReplyToIDsMemRatio safely sets the value for global configuration 'Cache.InReplyToIDsMemRatio' field
+func SetCacheInReplyToIDsMemRatio(v float64) { global.SetCacheInReplyToIDsMemRatio(v) }
+
+// GetCacheInstanceMemRatio safely fetches the Configuration value for state's 'Cache.InstanceMemRatio' field
+func (st *ConfigState) GetCacheInstanceMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.InstanceMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheInstanceMemRatio safely sets the Configuration value for state's 'Cache.InstanceMemRatio' field
+func (st *ConfigState) SetCacheInstanceMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.InstanceMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheInstanceMemRatio safely fetches the value for global configuration 'Cache.InstanceMemRatio' field
+func GetCacheInstanceMemRatio() float64 { return global.GetCacheInstanceMemRatio() }
+
+// SetCacheInstanceMemRatio safely sets the value for global configuration 'Cache.InstanceMemRatio' field
+func SetCacheInstanceMemRatio(v float64) { global.SetCacheInstanceMemRatio(v) }
+
+// GetCacheInteractionRequestMemRatio safely fetches the Configuration value for state's 'Cache.InteractionRequestMemRatio' field
+func (st *ConfigState) GetCacheInteractionRequestMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.InteractionRequestMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheInteractionRequestMemRatio safely sets the Configuration value for state's 'Cache.InteractionRequestMemRatio' field
+func (st *ConfigState) SetCacheInteractionRequestMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.InteractionRequestMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheInteractionRequestMemRatio safely fetches the value for global configuration 'Cache.InteractionRequestMemRatio' field
+func GetCacheInteractionRequestMemRatio() float64 { return global.GetCacheInteractionRequestMemRatio() }
+
+// SetCacheInteractionRequestMemRatio safely sets the value for global configuration 'Cache.InteractionRequestMemRatio' field
+func SetCacheInteractionRequestMemRatio(v float64) { global.SetCacheInteractionRequestMemRatio(v) }
+
+// GetCacheListMemRatio safely fetches the Configuration value for state's 'Cache.ListMemRatio' field
+func (st *ConfigState) GetCacheListMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.ListMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheListMemRatio safely sets the Configuration value for state's 'Cache.ListMemRatio' field
+func (st *ConfigState) SetCacheListMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.ListMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheListMemRatio safely fetches the value for global configuration 'Cache.ListMemRatio' field
+func GetCacheListMemRatio() float64 { return global.GetCacheListMemRatio() }
+
+// SetCacheListMemRatio safely sets the value for global configuration 'Cache.ListMemRatio' field
+func SetCacheListMemRatio(v float64) { global.SetCacheListMemRatio(v) }
+
+// GetCacheListIDsMemRatio safely fetches the Configuration value for state's 'Cache.ListIDsMemRatio' field
+func (st *ConfigState) GetCacheListIDsMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.ListIDsMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheListIDsMemRatio safely sets the Configuration value for state's 'Cache.ListIDsMemRatio' field
+func (st *ConfigState) SetCacheListIDsMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.ListIDsMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheListIDsMemRatio safely fetches the value for global configuration 'Cache.ListIDsMemRatio' field
+func GetCacheListIDsMemRatio() float64 { return global.GetCacheListIDsMemRatio() }
+
+// SetCacheListIDsMemRatio safely sets the value for global configuration 'Cache.ListIDsMemRatio' field
+func SetCacheListIDsMemRatio(v float64) { global.SetCacheListIDsMemRatio(v) }
+
+// GetCacheListedIDsMemRatio safely fetches the Configuration value for state's 'Cache.ListedIDsMemRatio' field
+func (st *ConfigState) GetCacheListedIDsMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.ListedIDsMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheListedIDsMemRatio safely sets the Configuration value for state's 'Cache.ListedIDsMemRatio' field
+func (st *ConfigState) SetCacheListedIDsMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.ListedIDsMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheListedIDsMemRatio safely fetches the value for global configuration 'Cache.ListedIDsMemRatio' field
+func GetCacheListedIDsMemRatio() float64 { return global.GetCacheListedIDsMemRatio() }
+
+// SetCacheListedIDsMemRatio safely sets the value for global configuration 'Cache.ListedIDsMemRatio' field
+func SetCacheListedIDsMemRatio(v float64) { global.SetCacheListedIDsMemRatio(v) }
+
+// GetCacheMarkerMemRatio safely fetches the Configuration value for state's 'Cache.MarkerMemRatio' field
+func (st *ConfigState) GetCacheMarkerMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.MarkerMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheMarkerMemRatio safely sets the Configuration value for state's 'Cache.MarkerMemRatio' field
+func (st *ConfigState) SetCacheMarkerMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.MarkerMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheMarkerMemRatio safely fetches the value for global configuration 'Cache.MarkerMemRatio' field
+func GetCacheMarkerMemRatio() float64 { return global.GetCacheMarkerMemRatio() }
+
+// SetCacheMarkerMemRatio safely sets the value for global configuration 'Cache.MarkerMemRatio' field
+func SetCacheMarkerMemRatio(v float64) { global.SetCacheMarkerMemRatio(v) }
+
+// GetCacheMediaMemRatio safely fetches the Configuration value for state's 'Cache.MediaMemRatio' field
+func (st *ConfigState) GetCacheMediaMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.MediaMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheMediaMemRatio safely sets the Configuration value for state's 'Cache.MediaMemRatio' field
+func (st *ConfigState) SetCacheMediaMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.MediaMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheMediaMemRatio safely fetches the value for global configuration 'Cache.MediaMemRatio' field
+func GetCacheMediaMemRatio() float64 { return global.GetCacheMediaMemRatio() }
+
+// SetCacheMediaMemRatio safely sets the value for global configuration 'Cache.MediaMemRatio' field
+func SetCacheMediaMemRatio(v float64) { global.SetCacheMediaMemRatio(v) }
+
+// GetCacheMentionMemRatio safely fetches the Configuration value for state's 'Cache.MentionMemRatio' field
+func (st *ConfigState) GetCacheMentionMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.MentionMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheMentionMemRatio safely sets the Configuration value for state's 'Cache.MentionMemRatio' field
+func (st *ConfigState) SetCacheMentionMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.MentionMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheMentionMemRatio safely fetches the value for global configuration 'Cache.MentionMemRatio' field
+func GetCacheMentionMemRatio() float64 { return global.GetCacheMentionMemRatio() }
+
+// SetCacheMentionMemRatio safely sets the value for global configuration 'Cache.MentionMemRatio' field
+func SetCacheMentionMemRatio(v float64) { global.SetCacheMentionMemRatio(v) }
+
+// GetCacheMoveMemRatio safely fetches the Configuration value for state's 'Cache.MoveMemRatio' field
+func (st *ConfigState) GetCacheMoveMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.MoveMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheMoveMemRatio safely sets the Configuration value for state's 'Cache.MoveMemRatio' field
+func (st *ConfigState) SetCacheMoveMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.MoveMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheMoveMemRatio safely fetches the value for global configuration 'Cache.MoveMemRatio' field
+func GetCacheMoveMemRatio() float64 { return global.GetCacheMoveMemRatio() }
+
+// SetCacheMoveMemRatio safely sets the value for global configuration 'Cache.MoveMemRatio' field
+func SetCacheMoveMemRatio(v float64) { global.SetCacheMoveMemRatio(v) }
+
+// GetCacheMutedTagIDsMemRatio safely fetches the Configuration value for state's 'Cache.MutedTagIDsMemRatio' field
+func (st *ConfigState) GetCacheMutedTagIDsMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.MutedTagIDsMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheMutedTagIDsMemRatio safely sets the Configuration value for state's 'Cache.MutedTagIDsMemRatio' field
+func (st *ConfigState) SetCacheMutedTagIDsMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.MutedTagIDsMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheMutedTagIDsMemRatio safely fetches the value for global configuration 'Cache.MutedTagIDsMemRatio' field
+func GetCacheMutedTagIDsMemRatio() float64 { return global.GetCacheMutedTagIDsMemRatio() }
+
+// SetCacheMutedTagIDsMemRatio safely sets the value for global configuration 'Cache.MutedTagIDsMemRatio' field
+func SetCacheMutedTagIDsMemRatio(v float64) { global.SetCacheMutedTagIDsMemRatio(v) }
+
+// GetCacheNotificationMemRatio safely fetches the Configuration value for state's 'Cache.NotificationMemRatio' field
+func (st *ConfigState) GetCacheNotificationMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.NotificationMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheNotificationMemRatio safely sets the Configuration value for state's 'Cache.NotificationMemRatio' field
+func (st *ConfigState) SetCacheNotificationMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.NotificationMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheNotificationMemRatio safely fetches the value for global configuration 'Cache.NotificationMemRatio' field
+func GetCacheNotificationMemRatio() float64 { return global.GetCacheNotificationMemRatio() }
+
+// SetCacheNotificationMemRatio safely sets the value for global configuration 'Cache.NotificationMemRatio' field
+func SetCacheNotificationMemRatio(v float64) { global.SetCacheNotificationMemRatio(v) }
+
+// GetCachePollMemRatio safely fetches the Configuration value for state's 'Cache.PollMemRatio' field
+func (st *ConfigState) GetCachePollMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.PollMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCachePollMemRatio safely sets the Configuration value for state's 'Cache.PollMemRatio' field
+func (st *ConfigState) SetCachePollMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.PollMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCachePollMemRatio safely fetches the value for global configuration 'Cache.PollMemRatio' field
+func GetCachePollMemRatio() float64 { return global.GetCachePollMemRatio() }
+
+// SetCachePollMemRatio safely sets the value for global configuration 'Cache.PollMemRatio' field
+func SetCachePollMemRatio(v float64) { global.SetCachePollMemRatio(v) }
+
+// GetCachePollVoteMemRatio safely fetches the Configuration value for state's 'Cache.PollVoteMemRatio' field
+func (st *ConfigState) GetCachePollVoteMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.PollVoteMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCachePollVoteMemRatio safely sets the Configuration value for state's 'Cache.PollVoteMemRatio' field
+func (st *ConfigState) SetCachePollVoteMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.PollVoteMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCachePollVoteMemRatio safely fetches the value for global configuration 'Cache.PollVoteMemRatio' field
+func GetCachePollVoteMemRatio() float64 { return global.GetCachePollVoteMemRatio() }
+
+// SetCachePollVoteMemRatio safely sets the value for global configuration 'Cache.PollVoteMemRatio' field
+func SetCachePollVoteMemRatio(v float64) { global.SetCachePollVoteMemRatio(v) }
+
+// GetCachePollVoteIDsMemRatio safely fetches the Configuration value for state's 'Cache.PollVoteIDsMemRatio' field
+func (st *ConfigState) GetCachePollVoteIDsMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.PollVoteIDsMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCachePollVoteIDsMemRatio safely sets the Configuration value for state's 'Cache.PollVoteIDsMemRatio' field
+func (st *ConfigState) SetCachePollVoteIDsMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.PollVoteIDsMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCachePollVoteIDsMemRatio safely fetches the value for global configuration 'Cache.PollVoteIDsMemRatio' field
+func GetCachePollVoteIDsMemRatio() float64 { return global.GetCachePollVoteIDsMemRatio() }
+
+// SetCachePollVoteIDsMemRatio safely sets the value for global configuration 'Cache.PollVoteIDsMemRatio' field
+func SetCachePollVoteIDsMemRatio(v float64) { global.SetCachePollVoteIDsMemRatio(v) }
+
+// GetCacheReportMemRatio safely fetches the Configuration value for state's 'Cache.ReportMemRatio' field
+func (st *ConfigState) GetCacheReportMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.ReportMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheReportMemRatio safely sets the Configuration value for state's 'Cache.ReportMemRatio' field
+func (st *ConfigState) SetCacheReportMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.ReportMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheReportMemRatio safely fetches the value for global configuration 'Cache.ReportMemRatio' field
+func GetCacheReportMemRatio() float64 { return global.GetCacheReportMemRatio() }
+
+// SetCacheReportMemRatio safely sets the value for global configuration 'Cache.ReportMemRatio' field
+func SetCacheReportMemRatio(v float64) { global.SetCacheReportMemRatio(v) }
+
+// GetCacheScheduledStatusMemRatio safely fetches the Configuration value for state's 'Cache.ScheduledStatusMemRatio' field
+func (st *ConfigState) GetCacheScheduledStatusMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.ScheduledStatusMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheScheduledStatusMemRatio safely sets the Configuration value for state's 'Cache.ScheduledStatusMemRatio' field
+func (st *ConfigState) SetCacheScheduledStatusMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.ScheduledStatusMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheScheduledStatusMemRatio safely fetches the value for global configuration 'Cache.ScheduledStatusMemRatio' field
+func GetCacheScheduledStatusMemRatio() float64 { return global.GetCacheScheduledStatusMemRatio() }
+
+// SetCacheScheduledStatusMemRatio safely sets the value for global configuration 'Cache.ScheduledStatusMemRatio' field
+func SetCacheScheduledStatusMemRatio(v float64) { global.SetCacheScheduledStatusMemRatio(v) }
+
+// GetCacheSinBinStatusMemRatio safely fetches the Configuration value for state's 'Cache.SinBinStatusMemRatio' field
+func (st *ConfigState) GetCacheSinBinStatusMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.SinBinStatusMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheSinBinStatusMemRatio safely sets the Configuration value for state's 'Cache.SinBinStatusMemRatio' field
+func (st *ConfigState) SetCacheSinBinStatusMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.SinBinStatusMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheSinBinStatusMemRatio safely fetches the value for global configuration 'Cache.SinBinStatusMemRatio' field
+func GetCacheSinBinStatusMemRatio() float64 { return global.GetCacheSinBinStatusMemRatio() }
+
+// SetCacheSinBinStatusMemRatio safely sets the value for global configuration 'Cache.SinBinStatusMemRatio' field
+func SetCacheSinBinStatusMemRatio(v float64) { global.SetCacheSinBinStatusMemRatio(v) }
+
+// GetCacheStatusMemRatio safely fetches the Configuration value for state's 'Cache.StatusMemRatio' field
+func (st *ConfigState) GetCacheStatusMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.StatusMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheStatusMemRatio safely sets the Configuration value for state's 'Cache.StatusMemRatio' field
+func (st *ConfigState) SetCacheStatusMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.StatusMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheStatusMemRatio safely fetches the value for global configuration 'Cache.StatusMemRatio' field
+func GetCacheStatusMemRatio() float64 { return global.GetCacheStatusMemRatio() }
+
+// SetCacheStatusMemRatio safely sets the value for global configuration 'Cache.StatusMemRatio' field
+func SetCacheStatusMemRatio(v float64) { global.SetCacheStatusMemRatio(v) }
+
+// GetCacheStatusBookmarkMemRatio safely fetches the Configuration value for state's 'Cache.StatusBookmarkMemRatio' field
+func (st *ConfigState) GetCacheStatusBookmarkMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.StatusBookmarkMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheStatusBookmarkMemRatio safely sets the Configuration value for state's 'Cache.StatusBookmarkMemRatio' field
+func (st *ConfigState) SetCacheStatusBookmarkMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.StatusBookmarkMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheStatusBookmarkMemRatio safely fetches the value for global configuration 'Cache.StatusBookmarkMemRatio' field
+func GetCacheStatusBookmarkMemRatio() float64 { return global.GetCacheStatusBookmarkMemRatio() }
+
+// SetCacheStatusBookmarkMemRatio safely sets the value for global configuration 'Cache.StatusBookmarkMemRatio' field
+func SetCacheStatusBookmarkMemRatio(v float64) { global.SetCacheStatusBookmarkMemRatio(v) }
+
+// GetCacheStatusBookmarkIDsMemRatio safely fetches the Configuration value for state's 'Cache.StatusBookmarkIDsMemRatio' field
+func (st *ConfigState) GetCacheStatusBookmarkIDsMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.StatusBookmarkIDsMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheStatusBookmarkIDsMemRatio safely sets the Configuration value for state's 'Cache.StatusBookmarkIDsMemRatio' field
+func (st *ConfigState) SetCacheStatusBookmarkIDsMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.StatusBookmarkIDsMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheStatusBookmarkIDsMemRatio safely fetches the value for global configuration 'Cache.StatusBookmarkIDsMemRatio' field
+func GetCacheStatusBookmarkIDsMemRatio() float64 { return global.GetCacheStatusBookmarkIDsMemRatio() }
+
+// SetCacheStatusBookmarkIDsMemRatio safely sets the value for global configuration 'Cache.StatusBookmarkIDsMemRatio' field
+func SetCacheStatusBookmarkIDsMemRatio(v float64) { global.SetCacheStatusBookmarkIDsMemRatio(v) }
+
+// GetCacheStatusEditMemRatio safely fetches the Configuration value for state's 'Cache.StatusEditMemRatio' field
+func (st *ConfigState) GetCacheStatusEditMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.StatusEditMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheStatusEditMemRatio safely sets the Configuration value for state's 'Cache.StatusEditMemRatio' field
+func (st *ConfigState) SetCacheStatusEditMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.StatusEditMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheStatusEditMemRatio safely fetches the value for global configuration 'Cache.StatusEditMemRatio' field
+func GetCacheStatusEditMemRatio() float64 { return global.GetCacheStatusEditMemRatio() }
+
+// SetCacheStatusEditMemRatio safely sets the value for global configuration 'Cache.StatusEditMemRatio' field
+func SetCacheStatusEditMemRatio(v float64) { global.SetCacheStatusEditMemRatio(v) }
+
+// GetCacheStatusFaveMemRatio safely fetches the Configuration value for state's 'Cache.StatusFaveMemRatio' field
+func (st *ConfigState) GetCacheStatusFaveMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.StatusFaveMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheStatusFaveMemRatio safely sets the Configuration value for state's 'Cache.StatusFaveMemRatio' field
+func (st *ConfigState) SetCacheStatusFaveMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.StatusFaveMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheStatusFaveMemRatio safely fetches the value for global configuration 'Cache.StatusFaveMemRatio' field
+func GetCacheStatusFaveMemRatio() float64 { return global.GetCacheStatusFaveMemRatio() }
+
+// SetCacheStatusFaveMemRatio safely sets the value for global configuration 'Cache.StatusFaveMemRatio' field
+func SetCacheStatusFaveMemRatio(v float64) { global.SetCacheStatusFaveMemRatio(v) }
+
+// GetCacheStatusFaveIDsMemRatio safely fetches the Configuration value for state's 'Cache.StatusFaveIDsMemRatio' field
+func (st *ConfigState) GetCacheStatusFaveIDsMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.StatusFaveIDsMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheStatusFaveIDsMemRatio safely sets the Configuration value for state's 'Cache.StatusFaveIDsMemRatio' field
+func (st *ConfigState) SetCacheStatusFaveIDsMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.StatusFaveIDsMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheStatusFaveIDsMemRatio safely fetches the value for global configuration 'Cache.StatusFaveIDsMemRatio' field
+func GetCacheStatusFaveIDsMemRatio() float64 { return global.GetCacheStatusFaveIDsMemRatio() }
+
+// SetCacheStatusFaveIDsMemRatio safely sets the value for global configuration 'Cache.StatusFaveIDsMemRatio' field
+func SetCacheStatusFaveIDsMemRatio(v float64) { global.SetCacheStatusFaveIDsMemRatio(v) }
+
+// GetCacheStatusReactionMemRatio safely fetches the Configuration value for state's 'Cache.StatusReactionMemRatio' field
+func (st *ConfigState) GetCacheStatusReactionMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.StatusReactionMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheStatusReactionMemRatio safely sets the Configuration value for state's 'Cache.StatusReactionMemRatio' field
+func (st *ConfigState) SetCacheStatusReactionMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.StatusReactionMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheStatusReactionMemRatio safely fetches the value for global configuration 'Cache.StatusReactionMemRatio' field
+func GetCacheStatusReactionMemRatio() float64 { return global.GetCacheStatusReactionMemRatio() }
+
+// SetCacheStatusReactionMemRatio safely sets the value for global configuration 'Cache.StatusReactionMemRatio' field
+func SetCacheStatusReactionMemRatio(v float64) { global.SetCacheStatusReactionMemRatio(v) }
+
+// GetCacheStatusReactionIDsMemRatio safely fetches the Configuration value for state's 'Cache.StatusReactionIDsMemRatio' field
+func (st *ConfigState) GetCacheStatusReactionIDsMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.StatusReactionIDsMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheStatusReactionIDsMemRatio safely sets the Configuration value for state's 'Cache.StatusReactionIDsMemRatio' field
+func (st *ConfigState) SetCacheStatusReactionIDsMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.StatusReactionIDsMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheStatusReactionIDsMemRatio safely fetches the value for global configuration 'Cache.StatusReactionIDsMemRatio' field
+func GetCacheStatusReactionIDsMemRatio() float64 { return global.GetCacheStatusReactionIDsMemRatio() }
+
+// SetCacheStatusReactionIDsMemRatio safely sets the value for global configuration 'Cache.StatusReactionIDsMemRatio' field
+func SetCacheStatusReactionIDsMemRatio(v float64) { global.SetCacheStatusReactionIDsMemRatio(v) }
+
+// GetCacheTagMemRatio safely fetches the Configuration value for state's 'Cache.TagMemRatio' field
+func (st *ConfigState) GetCacheTagMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.TagMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheTagMemRatio safely sets the Configuration value for state's 'Cache.TagMemRatio' field
+func (st *ConfigState) SetCacheTagMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.TagMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheTagMemRatio safely fetches the value for global configuration 'Cache.TagMemRatio' field
+func GetCacheTagMemRatio() float64 { return global.GetCacheTagMemRatio() }
+
+// SetCacheTagMemRatio safely sets the value for global configuration 'Cache.TagMemRatio' field
+func SetCacheTagMemRatio(v float64) { global.SetCacheTagMemRatio(v) }
+
+// GetCacheThreadMuteMemRatio safely fetches the Configuration value for state's 'Cache.ThreadMuteMemRatio' field
+func (st *ConfigState) GetCacheThreadMuteMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.ThreadMuteMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheThreadMuteMemRatio safely sets the Configuration value for state's 'Cache.ThreadMuteMemRatio' field
+func (st *ConfigState) SetCacheThreadMuteMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.ThreadMuteMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheThreadMuteMemRatio safely fetches the value for global configuration 'Cache.ThreadMuteMemRatio' field
+func GetCacheThreadMuteMemRatio() float64 { return global.GetCacheThreadMuteMemRatio() }
+
+// SetCacheThreadMuteMemRatio safely sets the value for global configuration 'Cache.ThreadMuteMemRatio' field
+func SetCacheThreadMuteMemRatio(v float64) { global.SetCacheThreadMuteMemRatio(v) }
+
+// GetCacheTokenMemRatio safely fetches the Configuration value for state's 'Cache.TokenMemRatio' field
+func (st *ConfigState) GetCacheTokenMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.TokenMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheTokenMemRatio safely sets the Configuration value for state's 'Cache.TokenMemRatio' field
+func (st *ConfigState) SetCacheTokenMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.TokenMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheTokenMemRatio safely fetches the value for global configuration 'Cache.TokenMemRatio' field
+func GetCacheTokenMemRatio() float64 { return global.GetCacheTokenMemRatio() }
+
+// SetCacheTokenMemRatio safely sets the value for global configuration 'Cache.TokenMemRatio' field
+func SetCacheTokenMemRatio(v float64) { global.SetCacheTokenMemRatio(v) }
+
+// GetCacheTombstoneMemRatio safely fetches the Configuration value for state's 'Cache.TombstoneMemRatio' field
+func (st *ConfigState) GetCacheTombstoneMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.TombstoneMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheTombstoneMemRatio safely sets the Configuration value for state's 'Cache.TombstoneMemRatio' field
+func (st *ConfigState) SetCacheTombstoneMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.TombstoneMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheTombstoneMemRatio safely fetches the value for global configuration 'Cache.TombstoneMemRatio' field
+func GetCacheTombstoneMemRatio() float64 { return global.GetCacheTombstoneMemRatio() }
+
+// SetCacheTombstoneMemRatio safely sets the value for global configuration 'Cache.TombstoneMemRatio' field
+func SetCacheTombstoneMemRatio(v float64) { global.SetCacheTombstoneMemRatio(v) }
+
+// GetCacheUserMemRatio safely fetches the Configuration value for state's 'Cache.UserMemRatio' field
+func (st *ConfigState) GetCacheUserMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.UserMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheUserMemRatio safely sets the Configuration value for state's 'Cache.UserMemRatio' field
+func (st *ConfigState) SetCacheUserMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.UserMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheUserMemRatio safely fetches the value for global configuration 'Cache.UserMemRatio' field
+func GetCacheUserMemRatio() float64 { return global.GetCacheUserMemRatio() }
+
+// SetCacheUserMemRatio safely sets the value for global configuration 'Cache.UserMemRatio' field
+func SetCacheUserMemRatio(v float64) { global.SetCacheUserMemRatio(v) }
+
+// GetCacheUserMuteMemRatio safely fetches the Configuration value for state's 'Cache.UserMuteMemRatio' field
+func (st *ConfigState) GetCacheUserMuteMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.UserMuteMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheUserMuteMemRatio safely sets the Configuration value for state's 'Cache.UserMuteMemRatio' field
+func (st *ConfigState) SetCacheUserMuteMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.UserMuteMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheUserMuteMemRatio safely fetches the value for global configuration 'Cache.UserMuteMemRatio' field
+func GetCacheUserMuteMemRatio() float64 { return global.GetCacheUserMuteMemRatio() }
+
+// SetCacheUserMuteMemRatio safely sets the value for global configuration 'Cache.UserMuteMemRatio' field
+func SetCacheUserMuteMemRatio(v float64) { global.SetCacheUserMuteMemRatio(v) }
+
+// GetCacheUserMuteIDsMemRatio safely fetches the Configuration value for state's 'Cache.UserMuteIDsMemRatio' field
+func (st *ConfigState) GetCacheUserMuteIDsMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.UserMuteIDsMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheUserMuteIDsMemRatio safely sets the Configuration value for state's 'Cache.UserMuteIDsMemRatio' field
+func (st *ConfigState) SetCacheUserMuteIDsMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.UserMuteIDsMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheUserMuteIDsMemRatio safely fetches the value for global configuration 'Cache.UserMuteIDsMemRatio' field
+func GetCacheUserMuteIDsMemRatio() float64 { return global.GetCacheUserMuteIDsMemRatio() }
+
+// SetCacheUserMuteIDsMemRatio safely sets the value for global configuration 'Cache.UserMuteIDsMemRatio' field
+func SetCacheUserMuteIDsMemRatio(v float64) { global.SetCacheUserMuteIDsMemRatio(v) }
+
+// GetCacheWebfingerMemRatio safely fetches the Configuration value for state's 'Cache.WebfingerMemRatio' field
+func (st *ConfigState) GetCacheWebfingerMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.WebfingerMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheWebfingerMemRatio safely sets the Configuration value for state's 'Cache.WebfingerMemRatio' field
+func (st *ConfigState) SetCacheWebfingerMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.WebfingerMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheWebfingerMemRatio safely fetches the value for global configuration 'Cache.WebfingerMemRatio' field
+func GetCacheWebfingerMemRatio() float64 { return global.GetCacheWebfingerMemRatio() }
+
+// SetCacheWebfingerMemRatio safely sets the value for global configuration 'Cache.WebfingerMemRatio' field
+func SetCacheWebfingerMemRatio(v float64) { global.SetCacheWebfingerMemRatio(v) }
+
+// GetCacheWebPushSubscriptionMemRatio safely fetches the Configuration value for state's 'Cache.WebPushSubscriptionMemRatio' field
+func (st *ConfigState) GetCacheWebPushSubscriptionMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.WebPushSubscriptionMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheWebPushSubscriptionMemRatio safely sets the Configuration value for state's 'Cache.WebPushSubscriptionMemRatio' field
+func (st *ConfigState) SetCacheWebPushSubscriptionMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.WebPushSubscriptionMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheWebPushSubscriptionMemRatio safely fetches the value for global configuration 'Cache.WebPushSubscriptionMemRatio' field
+func GetCacheWebPushSubscriptionMemRatio() float64 {
+	return global.GetCacheWebPushSubscriptionMemRatio()
+}
+
+// SetCacheWebPushSubscriptionMemRatio safely sets the value for global configuration 'Cache.WebPushSubscriptionMemRatio' field
+func SetCacheWebPushSubscriptionMemRatio(v float64) { global.SetCacheWebPushSubscriptionMemRatio(v) }
+
+// GetCacheWebPushSubscriptionIDsMemRatio safely fetches the Configuration value for state's 'Cache.WebPushSubscriptionIDsMemRatio' field
+func (st *ConfigState) GetCacheWebPushSubscriptionIDsMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.WebPushSubscriptionIDsMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheWebPushSubscriptionIDsMemRatio safely sets the Configuration value for state's 'Cache.WebPushSubscriptionIDsMemRatio' field
+func (st *ConfigState) SetCacheWebPushSubscriptionIDsMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.WebPushSubscriptionIDsMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheWebPushSubscriptionIDsMemRatio safely fetches the value for global configuration 'Cache.WebPushSubscriptionIDsMemRatio' field
+func GetCacheWebPushSubscriptionIDsMemRatio() float64 {
+	return global.GetCacheWebPushSubscriptionIDsMemRatio()
+}
+
+// SetCacheWebPushSubscriptionIDsMemRatio safely sets the value for global configuration 'Cache.WebPushSubscriptionIDsMemRatio' field
+func SetCacheWebPushSubscriptionIDsMemRatio(v float64) {
+	global.SetCacheWebPushSubscriptionIDsMemRatio(v)
+}
+
+// GetCacheMutesMemRatio safely fetches the Configuration value for state's 'Cache.MutesMemRatio' field
+func (st *ConfigState) GetCacheMutesMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.MutesMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheMutesMemRatio safely sets the Configuration value for state's 'Cache.MutesMemRatio' field
+func (st *ConfigState) SetCacheMutesMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.MutesMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheMutesMemRatio safely fetches the value for global configuration 'Cache.MutesMemRatio' field
+func GetCacheMutesMemRatio() float64 { return global.GetCacheMutesMemRatio() }
+
+// SetCacheMutesMemRatio safely sets the value for global configuration 'Cache.MutesMemRatio' field
+func SetCacheMutesMemRatio(v float64) { global.SetCacheMutesMemRatio(v) }
+
+// GetCacheStatusFilterMemRatio safely fetches the Configuration value for state's 'Cache.StatusFilterMemRatio' field
+func (st *ConfigState) GetCacheStatusFilterMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.StatusFilterMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheStatusFilterMemRatio safely sets the Configuration value for state's 'Cache.StatusFilterMemRatio' field
+func (st *ConfigState) SetCacheStatusFilterMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.StatusFilterMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheStatusFilterMemRatio safely fetches the value for global configuration 'Cache.StatusFilterMemRatio' field
+func GetCacheStatusFilterMemRatio() float64 { return global.GetCacheStatusFilterMemRatio() }
+
+// SetCacheStatusFilterMemRatio safely sets the value for global configuration 'Cache.StatusFilterMemRatio' field
+func SetCacheStatusFilterMemRatio(v float64) { global.SetCacheStatusFilterMemRatio(v) }
+
+// GetCacheVisibilityMemRatio safely fetches the Configuration value for state's 'Cache.VisibilityMemRatio' field
+func (st *ConfigState) GetCacheVisibilityMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.VisibilityMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheVisibilityMemRatio safely sets the Configuration value for state's 'Cache.VisibilityMemRatio' field
+func (st *ConfigState) SetCacheVisibilityMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.VisibilityMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheVisibilityMemRatio safely fetches the value for global configuration 'Cache.VisibilityMemRatio' field
+func GetCacheVisibilityMemRatio() float64 { return global.GetCacheVisibilityMemRatio() }
+
+// SetCacheVisibilityMemRatio safely sets the value for global configuration 'Cache.VisibilityMemRatio' field
+func SetCacheVisibilityMemRatio(v float64) { global.SetCacheVisibilityMemRatio(v) }
+
+// GetAdminAccountUsername safely fetches the Configuration value for state's 'AdminAccountUsername' field
+func (st *ConfigState) GetAdminAccountUsername() (v string) {
+	st.mutex.RLock()
+	v = st.config.AdminAccountUsername
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminAccountUsername safely sets the Configuration value for state's 'AdminAccountUsername' field
+func (st *ConfigState) SetAdminAccountUsername(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminAccountUsername = v
+	st.reloadToViper()
+}
+
+// GetAdminAccountUsername safely fetches the value for global configuration 'AdminAccountUsername' field
+func GetAdminAccountUsername() string { return global.GetAdminAccountUsername() }
+
+// SetAdminAccountUsername safely sets the value for global configuration 'AdminAccountUsername' field
+func SetAdminAccountUsername(v string) { global.SetAdminAccountUsername(v) }
+
+// GetAdminAccountEmail safely fetches the Configuration value for state's 'AdminAccountEmail' field
+func (st *ConfigState) GetAdminAccountEmail() (v string) {
+	st.mutex.RLock()
+	v = st.config.AdminAccountEmail
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminAccountEmail safely sets the Configuration value for state's 'AdminAccountEmail' field
+func (st *ConfigState) SetAdminAccountEmail(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminAccountEmail = v
+	st.reloadToViper()
+}
+
+// GetAdminAccountEmail safely fetches the value for global configuration 'AdminAccountEmail' field
+func GetAdminAccountEmail() string { return global.GetAdminAccountEmail() }
+
+// SetAdminAccountEmail safely sets the value for global configuration 'AdminAccountEmail' field
+func SetAdminAccountEmail(v string) { global.SetAdminAccountEmail(v) }
+
+// GetAdminAccountPassword safely fetches the Configuration value for state's 'AdminAccountPassword' field
+func (st *ConfigState) GetAdminAccountPassword() (v string) {
+	st.mutex.RLock()
+	v = st.config.AdminAccountPassword
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminAccountPassword safely sets the Configuration value for state's 'AdminAccountPassword' field
+func (st *ConfigState) SetAdminAccountPassword(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminAccountPassword = v
+	st.reloadToViper()
+}
+
+// GetAdminAccountPassword safely fetches the value for global configuration 'AdminAccountPassword' field
+func GetAdminAccountPassword() string { return global.GetAdminAccountPassword() }
+
+// SetAdminAccountPassword safely sets the value for global configuration 'AdminAccountPassword' field
+func SetAdminAccountPassword(v string) { global.SetAdminAccountPassword(v) }
+
+// GetAdminTransPath safely fetches the Configuration value for state's 'AdminTransPath' field
+func (st *ConfigState) GetAdminTransPath() (v string) {
+	st.mutex.RLock()
+	v = st.config.AdminTransPath
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminTransPath safely sets the Configuration value for state's 'AdminTransPath' field
+func (st *ConfigState) SetAdminTransPath(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminTransPath = v
+	st.reloadToViper()
+}
+
+// GetAdminTransPath safely fetches the value for global configuration 'AdminTransPath' field
+func GetAdminTransPath() string { return global.GetAdminTransPath() }
+
+// SetAdminTransPath safely sets the value for global configuration 'AdminTransPath' field
+func SetAdminTransPath(v string) { global.SetAdminTransPath(v) }
+
+// GetAdminMediaPruneDryRun safely fetches the Configuration value for state's 'AdminMediaPruneDryRun' field
+func (st *ConfigState) GetAdminMediaPruneDryRun() (v bool) {
+	st.mutex.RLock()
+	v = st.config.AdminMediaPruneDryRun
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminMediaPruneDryRun safely sets the Configuration value for state's 'AdminMediaPruneDryRun' field
+func (st *ConfigState) SetAdminMediaPruneDryRun(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminMediaPruneDryRun = v
+	st.reloadToViper()
+}
+
+// GetAdminMediaPruneDryRun safely fetches the value for global configuration 'AdminMediaPruneDryRun' field
+func GetAdminMediaPruneDryRun() bool { return global.GetAdminMediaPruneDryRun() }
+
+// SetAdminMediaPruneDryRun safely sets the value for global configuration 'AdminMediaPruneDryRun' field
+func SetAdminMediaPruneDryRun(v bool) { global.SetAdminMediaPruneDryRun(v) }
+
+// GetAdminMediaListLocalOnly safely fetches the Configuration value for state's 'AdminMediaListLocalOnly' field
+func (st *ConfigState) GetAdminMediaListLocalOnly() (v bool) {
+	st.mutex.RLock()
+	v = st.config.AdminMediaListLocalOnly
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminMediaListLocalOnly safely sets the Configuration value for state's 'AdminMediaListLocalOnly' field
+func (st *ConfigState) SetAdminMediaListLocalOnly(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminMediaListLocalOnly = v
+	st.reloadToViper()
+}
+
+// GetAdminMediaListLocalOnly safely fetches the value for global configuration 'AdminMediaListLocalOnly' field
+func GetAdminMediaListLocalOnly() bool { return global.GetAdminMediaListLocalOnly() }
+
+// SetAdminMediaListLocalOnly safely sets the value for global configuration 'AdminMediaListLocalOnly' field
+func SetAdminMediaListLocalOnly(v bool) { global.SetAdminMediaListLocalOnly(v) }
+
+// GetAdminMediaListRemoteOnly safely fetches the Configuration value for state's 'AdminMediaListRemoteOnly' field
+func (st *ConfigState) GetAdminMediaListRemoteOnly() (v bool) {
+	st.mutex.RLock()
+	v = st.config.AdminMediaListRemoteOnly
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminMediaListRemoteOnly safely sets the Configuration value for state's 'AdminMediaListRemoteOnly' field
+func (st *ConfigState) SetAdminMediaListRemoteOnly(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminMediaListRemoteOnly = v
+	st.reloadToViper()
+}
+
+// GetAdminMediaListRemoteOnly safely fetches the value for global configuration 'AdminMediaListRemoteOnly' field
+func GetAdminMediaListRemoteOnly() bool { return global.GetAdminMediaListRemoteOnly() }
 
-// GetCacheWebfingerMemRatio safely fetches the Configuration value for state's 'Cache.WebfingerMemRatio' field
-func (st *ConfigState) GetCacheWebfingerMemRatio() (v float64) {
+// SetAdminMediaListRemoteOnly safely sets the value for global configuration 'AdminMediaListRemoteOnly' field
+func SetAdminMediaListRemoteOnly(v bool) { global.SetAdminMediaListRemoteOnly(v) }
+
+// GetAdminAPIURL safely fetches the Configuration value for state's 'AdminAPIURL' field
+func (st *ConfigState) GetAdminAPIURL() (v string) {
 	st.mutex.RLock()
-	v = st.config.Cache.WebfingerMemRatio
+	v = st.config.AdminAPIURL
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheWebfingerMemRatio safely sets the Configuration value for state's 'Cache.WebfingerMemRatio' field
-func (st *ConfigState) SetCacheWebfingerMemRatio(v float64) {
+// SetAdminAPIURL safely sets the Configuration value for state's 'AdminAPIURL' field
+func (st *ConfigState) SetAdminAPIURL(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.WebfingerMemRatio = v
+	st.config.AdminAPIURL = v
 	st.reloadToViper()
 }
 
-// GetCacheWebfingerMemRatio safely fetches the value for global configuration 'Cache.WebfingerMemRatio' field
-func GetCacheWebfingerMemRatio() float64 { return global.GetCacheWebfingerMemRatio() }
+// GetAdminAPIURL safely fetches the value for global configuration 'AdminAPIURL' field
+func GetAdminAPIURL() string { return global.GetAdminAPIURL() }
 
-// SetCacheWebfingerMemRatio safely sets the value for global configuration 'Cache.WebfingerMemRatio' field
-func SetCacheWebfingerMemRatio(v float64) { global.SetCacheWebfingerMemRatio(v) }
+// SetAdminAPIURL safely sets the value for global configuration 'AdminAPIURL' field
+func SetAdminAPIURL(v string) { global.SetAdminAPIURL(v) }
 
-// GetCacheWebPushSubscriptionMemRatio safely fetches the Configuration value for state's 'Cache.WebPushSubscriptionMemRatio' field
-func (st *ConfigState) GetCacheWebPushSubscriptionMemRatio() (v float64) {
+// GetAdminAPIToken safely fetches the Configuration value for state's 'AdminAPIToken' field
+func (st *ConfigState) GetAdminAPIToken() (v string) {
 	st.mutex.RLock()
-	v = st.config.Cache.WebPushSubscriptionMemRatio
+	v = st.config.AdminAPIToken
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheWebPushSubscriptionMemRatio safely sets the Configuration value for state's 'Cache.WebPushSubscriptionMemRatio' field
-func (st *ConfigState) SetCacheWebPushSubscriptionMemRatio(v float64) {
+// SetAdminAPIToken safely sets the Configuration value for state's 'AdminAPIToken' field
+func (st *ConfigState) SetAdminAPIToken(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.WebPushSubscriptionMemRatio = v
+	st.config.AdminAPIToken = v
 	st.reloadToViper()
 }
 
-// GetCacheWebPushSubscriptionMemRatio safely fetches the value for global configuration 'Cache.WebPushSubscriptionMemRatio' field
-func GetCacheWebPushSubscriptionMemRatio() float64 {
-	return global.GetCacheWebPushSubscriptionMemRatio()
-}
+// GetAdminAPIToken safely fetches the value for global configuration 'AdminAPIToken' field
+func GetAdminAPIToken() string { return global.GetAdminAPIToken() }
 
-// SetCacheWebPushSubscriptionMemRatio safely sets the value for global configuration 'Cache.WebPushSubscriptionMemRatio' field
-func SetCacheWebPushSubscriptionMemRatio(v float64) { global.SetCacheWebPushSubscriptionMemRatio(v) }
+// SetAdminAPIToken safely sets the value for global configuration 'AdminAPIToken' field
+func SetAdminAPIToken(v string) { global.SetAdminAPIToken(v) }
 
-// GetCacheWebPushSubscriptionIDsMemRatio safely fetches the Configuration value for state's 'Cache.WebPushSubscriptionIDsMemRatio' field
-func (st *ConfigState) GetCacheWebPushSubscriptionIDsMemRatio() (v float64) {
+// GetAdminAPITokenFile safely fetches the Configuration value for state's 'AdminAPITokenFile' field
+func (st *ConfigState) GetAdminAPITokenFile() (v string) {
 	st.mutex.RLock()
-	v = st.config.Cache.WebPushSubscriptionIDsMemRatio
+	v = st.config.AdminAPITokenFile
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheWebPushSubscriptionIDsMemRatio safely sets the Configuration value for state's 'Cache.WebPushSubscriptionIDsMemRatio' field
-func (st *ConfigState) SetCacheWebPushSubscriptionIDsMemRatio(v float64) {
+// SetAdminAPITokenFile safely sets the Configuration value for state's 'AdminAPITokenFile' field
+func (st *ConfigState) SetAdminAPITokenFile(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.WebPushSubscriptionIDsMemRatio = v
+	st.config.AdminAPITokenFile = v
 	st.reloadToViper()
 }
 
-// GetCacheWebPushSubscriptionIDsMemRatio safely fetches the value for global configuration 'Cache.WebPushSubscriptionIDsMemRatio' field
-func GetCacheWebPushSubscriptionIDsMemRatio() float64 {
-	return global.GetCacheWebPushSubscriptionIDsMemRatio()
-}
+// GetAdminAPITokenFile safely fetches the value for global configuration 'AdminAPITokenFile' field
+func GetAdminAPITokenFile() string { return global.GetAdminAPITokenFile() }
 
-// SetCacheWebPushSubscriptionIDsMemRatio safely sets the value for global configuration 'Cache.WebPushSubscriptionIDsMemRatio' field
-func SetCacheWebPushSubscriptionIDsMemRatio(v float64) {
-	global.SetCacheWebPushSubscriptionIDsMemRatio(v)
-}
+// SetAdminAPITokenFile safely sets the value for global configuration 'AdminAPITokenFile' field
+func SetAdminAPITokenFile(v string) { global.SetAdminAPITokenFile(v) }
 
-// GetCacheMutesMemRatio safely fetches the Configuration value for state's 'Cache.MutesMemRatio' field
-func (st *ConfigState) GetCacheMutesMemRatio() (v float64) {
+// GetAdminReason safely fetches the Configuration value for state's 'AdminReason' field
+func (st *ConfigState) GetAdminReason() (v string) {
 	st.mutex.RLock()
-	v = st.config.Cache.MutesMemRatio
+	v = st.config.AdminReason
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheMutesMemRatio safely sets the Configuration value for state's 'Cache.MutesMemRatio' field
-func (st *ConfigState) SetCacheMutesMemRatio(v float64) {
+// SetAdminReason safely sets the Configuration value for state's 'AdminReason' field
+func (st *ConfigState) SetAdminReason(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.MutesMemRatio = v
+	st.config.AdminReason = v
 	st.reloadToViper()
 }
 
-// GetCacheMutesMemRatio safely fetches the value for global configuration 'Cache.MutesMemRatio' field
-func GetCacheMutesMemRatio() float64 { return global.GetCacheMutesMemRatio() }
+// GetAdminReason safely fetches the value for global configuration 'AdminReason' field
+func GetAdminReason() string { return global.GetAdminReason() }
 
-// SetCacheMutesMemRatio safely sets the value for global configuration 'Cache.MutesMemRatio' field
-func SetCacheMutesMemRatio(v float64) { global.SetCacheMutesMemRatio(v) }
+// SetAdminReason safely sets the value for global configuration 'AdminReason' field
+func SetAdminReason(v string) { global.SetAdminReason(v) }
 
-// GetCacheStatusFilterMemRatio safely fetches the Configuration value for state's 'Cache.StatusFilterMemRatio' field
-func (st *ConfigState) GetCacheStatusFilterMemRatio() (v float64) {
+// GetAdminDomain safely fetches the Configuration value for state's 'AdminDomain' field
+func (st *ConfigState) GetAdminDomain() (v string) {
 	st.mutex.RLock()
-	v = st.config.Cache.StatusFilterMemRatio
+	v = st.config.AdminDomain
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheStatusFilterMemRatio safely sets the Configuration value for state's 'Cache.StatusFilterMemRatio' field
-func (st *ConfigState) SetCacheStatusFilterMemRatio(v float64) {
+// SetAdminDomain safely sets the Configuration value for state's 'AdminDomain' field
+func (st *ConfigState) SetAdminDomain(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.StatusFilterMemRatio = v
+	st.config.AdminDomain = v
 	st.reloadToViper()
 }
 
-// GetCacheStatusFilterMemRatio safely fetches the value for global configuration 'Cache.StatusFilterMemRatio' field
-func GetCacheStatusFilterMemRatio() float64 { return global.GetCacheStatusFilterMemRatio() }
+// GetAdminDomain safely fetches the value for global configuration 'AdminDomain' field
+func GetAdminDomain() string { return global.GetAdminDomain() }
 
-// SetCacheStatusFilterMemRatio safely sets the value for global configuration 'Cache.StatusFilterMemRatio' field
-func SetCacheStatusFilterMemRatio(v float64) { global.SetCacheStatusFilterMemRatio(v) }
+// SetAdminDomain safely sets the value for global configuration 'AdminDomain' field
+func SetAdminDomain(v string) { global.SetAdminDomain(v) }
 
-// GetCacheVisibilityMemRatio safely fetches the Configuration value for state's 'Cache.VisibilityMemRatio' field
-func (st *ConfigState) GetCacheVisibilityMemRatio() (v float64) {
+// GetAdminMediaPolicy safely fetches the Configuration value for state's 'AdminMediaPolicy' field
+func (st *ConfigState) GetAdminMediaPolicy() (v string) {
 	st.mutex.RLock()
-	v = st.config.Cache.VisibilityMemRatio
+	v = st.config.AdminMediaPolicy
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheVisibilityMemRatio safely sets the Configuration value for state's 'Cache.VisibilityMemRatio' field
-func (st *ConfigState) SetCacheVisibilityMemRatio(v float64) {
+// SetAdminMediaPolicy safely sets the Configuration value for state's 'AdminMediaPolicy' field
+func (st *ConfigState) SetAdminMediaPolicy(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.VisibilityMemRatio = v
+	st.config.AdminMediaPolicy = v
 	st.reloadToViper()
 }
 
-// GetCacheVisibilityMemRatio safely fetches the value for global configuration 'Cache.VisibilityMemRatio' field
-func GetCacheVisibilityMemRatio() float64 { return global.GetCacheVisibilityMemRatio() }
+// GetAdminMediaPolicy safely fetches the value for global configuration 'AdminMediaPolicy' field
+func GetAdminMediaPolicy() string { return global.GetAdminMediaPolicy() }
 
-// SetCacheVisibilityMemRatio safely sets the value for global configuration 'Cache.VisibilityMemRatio' field
-func SetCacheVisibilityMemRatio(v float64) { global.SetCacheVisibilityMemRatio(v) }
+// SetAdminMediaPolicy safely sets the value for global configuration 'AdminMediaPolicy' field
+func SetAdminMediaPolicy(v string) { global.SetAdminMediaPolicy(v) }
 
-// GetAdminAccountUsername safely fetches the Configuration value for state's 'AdminAccountUsername' field
-func (st *ConfigState) GetAdminAccountUsername() (v string) {
+// GetAdminFollowsPolicy safely fetches the Configuration value for state's 'AdminFollowsPolicy' field
+func (st *ConfigState) GetAdminFollowsPolicy() (v string) {
 	st.mutex.RLock()
-	v = st.config.AdminAccountUsername
+	v = st.config.AdminFollowsPolicy
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAdminAccountUsername safely sets the Configuration value for state's 'AdminAccountUsername' field
-func (st *ConfigState) SetAdminAccountUsername(v string) {
+// SetAdminFollowsPolicy safely sets the Configuration value for state's 'AdminFollowsPolicy' field
+func (st *ConfigState) SetAdminFollowsPolicy(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AdminAccountUsername = v
+	st.config.AdminFollowsPolicy = v
 	st.reloadToViper()
 }
 
-// GetAdminAccountUsername safely fetches the value for global configuration 'AdminAccountUsername' field
-func GetAdminAccountUsername() string { return global.GetAdminAccountUsername() }
+// GetAdminFollowsPolicy safely fetches the value for global configuration 'AdminFollowsPolicy' field
+func GetAdminFollowsPolicy() string { return global.GetAdminFollowsPolicy() }
 
-// SetAdminAccountUsername safely sets the value for global configuration 'AdminAccountUsername' field
-func SetAdminAccountUsername(v string) { global.SetAdminAccountUsername(v) }
+// SetAdminFollowsPolicy safely sets the value for global configuration 'AdminFollowsPolicy' field
+func SetAdminFollowsPolicy(v string) { global.SetAdminFollowsPolicy(v) }
 
-// GetAdminAccountEmail safely fetches the Configuration value for state's 'AdminAccountEmail' field
-func (st *ConfigState) GetAdminAccountEmail() (v string) {
+// GetAdminStatusesPolicy safely fetches the Configuration value for state's 'AdminStatusesPolicy' field
+func (st *ConfigState) GetAdminStatusesPolicy() (v string) {
 	st.mutex.RLock()
-	v = st.config.AdminAccountEmail
+	v = st.config.AdminStatusesPolicy
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAdminAccountEmail safely sets the Configuration value for state's 'AdminAccountEmail' field
-func (st *ConfigState) SetAdminAccountEmail(v string) {
+// SetAdminStatusesPolicy safely sets the Configuration value for state's 'AdminStatusesPolicy' field
+func (st *ConfigState) SetAdminStatusesPolicy(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AdminAccountEmail = v
+	st.config.AdminStatusesPolicy = v
 	st.reloadToViper()
 }
 
-// GetAdminAccountEmail safely fetches the value for global configuration 'AdminAccountEmail' field
-func GetAdminAccountEmail() string { return global.GetAdminAccountEmail() }
+// GetAdminStatusesPolicy safely fetches the value for global configuration 'AdminStatusesPolicy' field
+func GetAdminStatusesPolicy() string { return global.GetAdminStatusesPolicy() }
 
-// SetAdminAccountEmail safely sets the value for global configuration 'AdminAccountEmail' field
-func SetAdminAccountEmail(v string) { global.SetAdminAccountEmail(v) }
+// SetAdminStatusesPolicy safely sets the value for global configuration 'AdminStatusesPolicy' field
+func SetAdminStatusesPolicy(v string) { global.SetAdminStatusesPolicy(v) }
 
-// GetAdminAccountPassword safely fetches the Configuration value for state's 'AdminAccountPassword' field
-func (st *ConfigState) GetAdminAccountPassword() (v string) {
+// GetAdminAccountsPolicy safely fetches the Configuration value for state's 'AdminAccountsPolicy' field
+func (st *ConfigState) GetAdminAccountsPolicy() (v string) {
 	st.mutex.RLock()
-	v = st.config.AdminAccountPassword
+	v = st.config.AdminAccountsPolicy
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAdminAccountPassword safely sets the Configuration value for state's 'AdminAccountPassword' field
-func (st *ConfigState) SetAdminAccountPassword(v string) {
+// SetAdminAccountsPolicy safely sets the Configuration value for state's 'AdminAccountsPolicy' field
+func (st *ConfigState) SetAdminAccountsPolicy(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AdminAccountPassword = v
+	st.config.AdminAccountsPolicy = v
 	st.reloadToViper()
 }
 
-// GetAdminAccountPassword safely fetches the value for global configuration 'AdminAccountPassword' field
-func GetAdminAccountPassword() string { return global.GetAdminAccountPassword() }
+// GetAdminAccountsPolicy safely fetches the value for global configuration 'AdminAccountsPolicy' field
+func GetAdminAccountsPolicy() string { return global.GetAdminAccountsPolicy() }
 
-// SetAdminAccountPassword safely sets the value for global configuration 'AdminAccountPassword' field
-func SetAdminAccountPassword(v string) { global.SetAdminAccountPassword(v) }
+// SetAdminAccountsPolicy safely sets the value for global configuration 'AdminAccountsPolicy' field
+func SetAdminAccountsPolicy(v string) { global.SetAdminAccountsPolicy(v) }
 
-// GetAdminTransPath safely fetches the Configuration value for state's 'AdminTransPath' field
-func (st *ConfigState) GetAdminTransPath() (v string) {
+// GetAdminContentWarning safely fetches the Configuration value for state's 'AdminContentWarning' field
+func (st *ConfigState) GetAdminContentWarning() (v string) {
 	st.mutex.RLock()
-	v = st.config.AdminTransPath
+	v = st.config.AdminContentWarning
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAdminTransPath safely sets the Configuration value for state's 'AdminTransPath' field
-func (st *ConfigState) SetAdminTransPath(v string) {
+// SetAdminContentWarning safely sets the Configuration value for state's 'AdminContentWarning' field
+func (st *ConfigState) SetAdminContentWarning(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AdminTransPath = v
+	st.config.AdminContentWarning = v
 	st.reloadToViper()
 }
 
-// GetAdminTransPath safely fetches the value for global configuration 'AdminTransPath' field
-func GetAdminTransPath() string { return global.GetAdminTransPath() }
+// GetAdminContentWarning safely fetches the value for global configuration 'AdminContentWarning' field
+func GetAdminContentWarning() string { return global.GetAdminContentWarning() }
 
-// SetAdminTransPath safely sets the value for global configuration 'AdminTransPath' field
-func SetAdminTransPath(v string) { global.SetAdminTransPath(v) }
+// SetAdminContentWarning safely sets the value for global configuration 'AdminContentWarning' field
+func SetAdminContentWarning(v string) { global.SetAdminContentWarning(v) }
 
-// GetAdminMediaPruneDryRun safely fetches the Configuration value for state's 'AdminMediaPruneDryRun' field
-func (st *ConfigState) GetAdminMediaPruneDryRun() (v bool) {
+// GetAdminPublicComment safely fetches the Configuration value for state's 'AdminPublicComment' field
+func (st *ConfigState) GetAdminPublicComment() (v string) {
 	st.mutex.RLock()
-	v = st.config.AdminMediaPruneDryRun
+	v = st.config.AdminPublicComment
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAdminMediaPruneDryRun safely sets the Configuration value for state's 'AdminMediaPruneDryRun' field
-func (st *ConfigState) SetAdminMediaPruneDryRun(v bool) {
+// SetAdminPublicComment safely sets the Configuration value for state's 'AdminPublicComment' field
+func (st *ConfigState) SetAdminPublicComment(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AdminMediaPruneDryRun = v
+	st.config.AdminPublicComment = v
 	st.reloadToViper()
 }
 
-// GetAdminMediaPruneDryRun safely fetches the value for global configuration 'AdminMediaPruneDryRun' field
-func GetAdminMediaPruneDryRun() bool { return global.GetAdminMediaPruneDryRun() }
+// GetAdminPublicComment safely fetches the value for global configuration 'AdminPublicComment' field
+func GetAdminPublicComment() string { return global.GetAdminPublicComment() }
 
-// SetAdminMediaPruneDryRun safely sets the value for global configuration 'AdminMediaPruneDryRun' field
-func SetAdminMediaPruneDryRun(v bool) { global.SetAdminMediaPruneDryRun(v) }
+// SetAdminPublicComment safely sets the value for global configuration 'AdminPublicComment' field
+func SetAdminPublicComment(v string) { global.SetAdminPublicComment(v) }
 
-// GetAdminMediaListLocalOnly safely fetches the Configuration value for state's 'AdminMediaListLocalOnly' field
-func (st *ConfigState) GetAdminMediaListLocalOnly() (v bool) {
+// GetAdminReportsResolved safely fetches the Configuration value for state's 'AdminReportsResolved' field
+func (st *ConfigState) GetAdminReportsResolved() (v bool) {
 	st.mutex.RLock()
-	v = st.config.AdminMediaListLocalOnly
+	v = st.config.AdminReportsResolved
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAdminMediaListLocalOnly safely sets the Configuration value for state's 'AdminMediaListLocalOnly' field
-func (st *ConfigState) SetAdminMediaListLocalOnly(v bool) {
+// SetAdminReportsResolved safely sets the Configuration value for state's 'AdminReportsResolved' field
+func (st *ConfigState) SetAdminReportsResolved(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AdminMediaListLocalOnly = v
+	st.config.AdminReportsResolved = v
 	st.reloadToViper()
 }
 
-// GetAdminMediaListLocalOnly safely fetches the value for global configuration 'AdminMediaListLocalOnly' field
-func GetAdminMediaListLocalOnly() bool { return global.GetAdminMediaListLocalOnly() }
+// GetAdminReportsResolved safely fetches the value for global configuration 'AdminReportsResolved' field
+func GetAdminReportsResolved() bool { return global.GetAdminReportsResolved() }
 
-// SetAdminMediaListLocalOnly safely sets the value for global configuration 'AdminMediaListLocalOnly' field
-func SetAdminMediaListLocalOnly(v bool) { global.SetAdminMediaListLocalOnly(v) }
+// SetAdminReportsResolved safely sets the value for global configuration 'AdminReportsResolved' field
+func SetAdminReportsResolved(v bool) { global.SetAdminReportsResolved(v) }
 
-// GetAdminMediaListRemoteOnly safely fetches the Configuration value for state's 'AdminMediaListRemoteOnly' field
-func (st *ConfigState) GetAdminMediaListRemoteOnly() (v bool) {
+// GetAdminEmojiCategory safely fetches the Configuration value for state's 'AdminEmojiCategory' field
+func (st *ConfigState) GetAdminEmojiCategory() (v string) {
 	st.mutex.RLock()
-	v = st.config.AdminMediaListRemoteOnly
+	v = st.config.AdminEmojiCategory
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAdminMediaListRemoteOnly safely sets the Configuration value for state's 'AdminMediaListRemoteOnly' field
-func (st *ConfigState) SetAdminMediaListRemoteOnly(v bool) {
+// SetAdminEmojiCategory safely sets the Configuration value for state's 'AdminEmojiCategory' field
+func (st *ConfigState) SetAdminEmojiCategory(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AdminMediaListRemoteOnly = v
+	st.config.AdminEmojiCategory = v
 	st.reloadToViper()
 }
 
-// GetAdminMediaListRemoteOnly safely fetches the value for global configuration 'AdminMediaListRemoteOnly' field
-func GetAdminMediaListRemoteOnly() bool { return global.GetAdminMediaListRemoteOnly() }
+// GetAdminEmojiCategory safely fetches the value for global configuration 'AdminEmojiCategory' field
+func GetAdminEmojiCategory() string { return global.GetAdminEmojiCategory() }
 
-// SetAdminMediaListRemoteOnly safely sets the value for global configuration 'AdminMediaListRemoteOnly' field
-func SetAdminMediaListRemoteOnly(v bool) { global.SetAdminMediaListRemoteOnly(v) }
+// SetAdminEmojiCategory safely sets the value for global configuration 'AdminEmojiCategory' field
+func SetAdminEmojiCategory(v string) { global.SetAdminEmojiCategory(v) }
 
 // GetTestrigSkipDBSetup safely fetches the Configuration value for state's 'TestrigSkipDBSetup' field
 func (st *ConfigState) GetTestrigSkipDBSetup() (v bool) {
@@ -6737,10 +9256,14 @@ func (st *ConfigState) GetTotalOfMemRatios() (total float64) {
 	total += st.config.Cache.AccountNoteMemRatio
 	total += st.config.Cache.AccountSettingsMemRatio
 	total += st.config.Cache.AccountStatsMemRatio
+	total += st.config.Cache.AntennaMemRatio
+	total += st.config.Cache.AntennaIDsMemRatio
 	total += st.config.Cache.ApplicationMemRatio
 	total += st.config.Cache.BlockMemRatio
 	total += st.config.Cache.BlockIDsMemRatio
 	total += st.config.Cache.BoostOfIDsMemRatio
+	total += st.config.Cache.BookmarkCollectionMemRatio
+	total += st.config.Cache.BookmarkCollectionIDsMemRatio
 	total += st.config.Cache.ClientMemRatio
 	total += st.config.Cache.ConversationMemRatio
 	total += st.config.Cache.ConversationLastStatusIDsMemRatio
@@ -6769,6 +9292,7 @@ func (st *ConfigState) GetTotalOfMemRatios() (total float64) {
 	total += st.config.Cache.MediaMemRatio
 	total += st.config.Cache.MentionMemRatio
 	total += st.config.Cache.MoveMemRatio
+	total += st.config.Cache.MutedTagIDsMemRatio
 	total += st.config.Cache.NotificationMemRatio
 	total += st.config.Cache.PollMemRatio
 	total += st.config.Cache.PollVoteMemRatio
@@ -6782,6 +9306,8 @@ func (st *ConfigState) GetTotalOfMemRatios() (total float64) {
 	total += st.config.Cache.StatusEditMemRatio
 	total += st.config.Cache.StatusFaveMemRatio
 	total += st.config.Cache.StatusFaveIDsMemRatio
+	total += st.config.Cache.StatusReactionMemRatio
+	total += st.config.Cache.StatusReactionIDsMemRatio
 	total += st.config.Cache.TagMemRatio
 	total += st.config.Cache.ThreadMuteMemRatio
 	total += st.config.Cache.TokenMemRatio
@@ -7094,6 +9620,28 @@ func flattenConfigMap(cfgmap map[string]any) {
 		}
 	}
 
+	for _, key := range [][]string{
+		{"media", "thumb-format"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["media-thumb-format"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"media", "video-sprite-frames"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["media-video-sprite-frames"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
 	for _, key := range [][]string{
 		{"cache", "s3-object-info"},
 	} {
@@ -7116,6 +9664,17 @@ func flattenConfigMap(cfgmap map[string]any) {
 		}
 	}
 
+	for _, key := range [][]string{
+		{"cache", "home-timeline-max-entries"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["cache-home-timeline-max-entries"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
 	for _, key := range [][]string{
 		{"cache", "list-timeline-timeout"},
 	} {
@@ -7138,6 +9697,39 @@ func flattenConfigMap(cfgmap map[string]any) {
 		}
 	}
 
+	for _, key := range [][]string{
+		{"cache", "language-timeline-timeout"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["cache-language-timeline-timeout"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"cache", "antenna-timeline-timeout"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["cache-antenna-timeline-timeout"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"cache", "visibility-sweep-frequency"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["cache-visibility-sweep-frequency"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
 	for _, key := range [][]string{
 		{"cache", "memory-target"},
 	} {
@@ -7193,6 +9785,28 @@ func flattenConfigMap(cfgmap map[string]any) {
 		}
 	}
 
+	for _, key := range [][]string{
+		{"cache", "antenna-mem-ratio"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["cache-antenna-mem-ratio"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"cache", "antenna-ids-mem-ratio"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["cache-antenna-ids-mem-ratio"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
 	for _, key := range [][]string{
 		{"cache", "application-mem-ratio"},
 	} {
@@ -7237,6 +9851,28 @@ func flattenConfigMap(cfgmap map[string]any) {
 		}
 	}
 
+	for _, key := range [][]string{
+		{"cache", "bookmark-collection-mem-ratio"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["cache-bookmark-collection-mem-ratio"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"cache", "bookmark-collection-ids-mem-ratio"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["cache-bookmark-collection-ids-mem-ratio"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
 	for _, key := range [][]string{
 		{"cache", "client-mem-ratio"},
 	} {
@@ -7545,6 +10181,17 @@ func flattenConfigMap(cfgmap map[string]any) {
 		}
 	}
 
+	for _, key := range [][]string{
+		{"cache", "muted-tag-ids-mem-ratio"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["cache-muted-tag-ids-mem-ratio"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
 	for _, key := range [][]string{
 		{"cache", "notification-mem-ratio"},
 	} {
@@ -7688,6 +10335,28 @@ func flattenConfigMap(cfgmap map[string]any) {
 		}
 	}
 
+	for _, key := range [][]string{
+		{"cache", "status-reaction-mem-ratio"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["cache-status-reaction-mem-ratio"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"cache", "status-reaction-ids-mem-ratio"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["cache-status-reaction-ids-mem-ratio"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
 	for _, key := range [][]string{
 		{"cache", "tag-mem-ratio"},
 	} {
@@ -18,15 +18,30 @@
 package config
 
 import (
+	"errors"
 	"os"
 	"path"
+	"reflect"
 	"strings"
 	"sync"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 )
 
+// ReloadableKeys are the configuration keys whose values
+// may be safely changed at runtime by ReloadConfigFile().
+var ReloadableKeys = []string{
+	LogLevelFlag,
+	AdvancedRateLimitRequestsFlag,
+	AdvancedRateLimitExceptionsFlag,
+	MediaLocalMaxSizeFlag,
+	MediaRemoteMaxSizeFlag,
+	MediaEmojiLocalMaxSizeFlag,
+	MediaEmojiRemoteMaxSizeFlag,
+}
+
 // ConfigState manages safe concurrent access to Configuration{} values,
 // and provides ease of linking them (including reloading) via viper to
 // environment, CLI and configuration file variables.
@@ -34,6 +49,11 @@ type ConfigState struct {
 	viper  *viper.Viper
 	config Configuration
 	mutex  sync.RWMutex
+
+	// flags contains names of
+	// any CLI flags explicitly
+	// set on the bound command.
+	flags map[string]struct{}
 }
 
 // NewState returns a new initialized ConfigState instance.
@@ -72,6 +92,13 @@ func (st *ConfigState) RegisterGlobalFlags(root *cobra.Command) {
 func (st *ConfigState) BindFlags(cmd *cobra.Command) (err error) {
 	st.Viper(func(v *viper.Viper) {
 		err = v.BindPFlags(cmd.Flags())
+
+		// Note which flags were explicitly set,
+		// these take precedence over config file.
+		st.flags = make(map[string]struct{})
+		cmd.Flags().Visit(func(f *pflag.Flag) {
+			st.flags[f.Name] = struct{}{}
+		})
 	})
 	return
 }
@@ -98,6 +125,72 @@ func (st *ConfigState) LoadConfigFile() (err error) {
 	return
 }
 
+// ReloadConfigFile re-reads the currently set configuration file, applying
+// any changed values of ReloadableKeys, and returns the keys that changed.
+// Keys that are absent from the file are reset to their default values.
+// Keys set by environment variable or CLI flag take precedence over the
+// configuration file, and so are never changed by a reload.
+func (st *ConfigState) ReloadConfigFile() ([]string, error) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+
+	path := st.config.ConfigPath
+	if path == "" {
+		return nil, errors.New("no config file set")
+	}
+
+	cfgmap, err := readConfigMap(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		defaults = Defaults.MarshalMap()
+		current  = st.config.MarshalMap()
+		reload   = make(map[string]any, len(ReloadableKeys))
+	)
+
+	for _, key := range ReloadableKeys {
+		if _, ok := st.flags[key]; ok {
+			// Set by CLI flag.
+			continue
+		}
+
+		env := "GTS_" + strings.ToUpper(strings.ReplaceAll(key, "-", "_"))
+		if _, ok := os.LookupEnv(env); ok {
+			// Set by env var.
+			continue
+		}
+
+		if val, ok := cfgmap[key]; ok {
+			reload[key] = val
+		} else {
+			reload[key] = defaults[key]
+		}
+	}
+
+	// Unmarshal into a copy first, so
+	// nothing changes on parse error.
+	cfg := st.config
+	if err := cfg.UnmarshalMap(reload); err != nil {
+		return nil, err
+	}
+
+	// Check which values actually changed.
+	var changed []string
+	updated := cfg.MarshalMap()
+	for _, key := range ReloadableKeys {
+		if !reflect.DeepEqual(current[key], updated[key]) {
+			changed = append(changed, key)
+		}
+	}
+
+	st.config = cfg
+	st.reloadToViper()
+
+	return changed, nil
+}
+
 // Reset will totally clear
 // ConfigState{}, loading defaults.
 func (st *ConfigState) Reset() {
@@ -118,6 +211,7 @@ func (st *ConfigState) Reset() {
 
 	// Set default config.
 	st.config = Defaults
+	st.flags = nil
 
 	// Load into viper.
 	st.reloadToViper()
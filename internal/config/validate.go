@@ -28,6 +28,10 @@
 	"github.com/miekg/dns"
 )
 
+// maxProfileFieldsLimit is the highest value
+// allowed for accounts-max-profile-fields.
+const maxProfileFieldsLimit = 50
+
 // Validate validates global config settings.
 func Validate() error {
 	// Gather all validation errors in
@@ -116,6 +120,40 @@ func Validate() error {
 		)
 	}
 
+	// `instance-peers-granularity` should
+	// be "open", "metadata", or "disabled".
+	switch granularity := GetInstancePeersGranularity(); granularity {
+	case InstancePeersGranularityOpen, InstancePeersGranularityMetadata, InstancePeersGranularityDisabled:
+		// No problem.
+
+	default:
+		errf("%s must be set to open, metadata, or disabled, provided value was %s",
+			InstancePeersGranularityFlag, granularity,
+		)
+	}
+
+	if GetInstanceNodeInfoRefreshEvery() < 0 {
+		errf("%s must not be negative", InstanceNodeInfoRefreshEveryFlag)
+	}
+
+	if GetInstanceFederationThreadMaxDepth() <= 0 {
+		errf("%s must be greater than 0", InstanceFederationThreadMaxDepthFlag)
+	}
+
+	if GetInstanceFederationThreadFetchBudget() <= 0 {
+		errf("%s must be greater than 0", InstanceFederationThreadFetchBudgetFlag)
+	}
+
+	if GetInstanceExposeSitemap() && GetInstanceSitemapRegenerateEvery() <= 0 {
+		errf("%s must be greater than 0", InstanceSitemapRegenerateEveryFlag)
+	}
+
+	if n := GetAccountsMaxProfileFields(); n < 0 || n > maxProfileFieldsLimit {
+		errf("%s must be between 0 and %d, provided value was %d",
+			AccountsMaxProfileFieldsFlag, maxProfileFieldsLimit, n,
+		)
+	}
+
 	// `web-assets-base-dir`.
 	webAssetsBaseDir := GetWebAssetBaseDir()
 	if webAssetsBaseDir == "" {
@@ -182,5 +220,91 @@ func Validate() error {
 		log.Warnf(nil, "%s larger than max recommended thumbsize %d", MediaThumbMaxPixelsFlag, maxThumbRecc)
 	}
 
+	// Check configured thumb format.
+	switch format := GetMediaThumbFormat(); format {
+	case MediaThumbFormatJPEG, MediaThumbFormatWebP:
+		// No problem.
+
+	default:
+		errf("%s must be set to either jpeg or webp, provided value was %s",
+			MediaThumbFormatFlag, format,
+		)
+	}
+
+	// Check configured video sprite frame count.
+	const maxSpriteFrames = 100
+	switch frames := GetMediaVideoSpriteFrames(); {
+	case frames < 0:
+		errf("%s must not be negative", MediaVideoSpriteFramesFlag)
+	case frames > maxSpriteFrames:
+		errf("%s must not be greater than %d", MediaVideoSpriteFramesFlag, maxSpriteFrames)
+	}
+
+	// `instance-directory-url`
+	if directoryURL := GetInstanceDirectoryURL(); directoryURL != "" {
+		if url, err := url.Parse(directoryURL); err != nil {
+			errf("%s invalid: %w", InstanceDirectoryURLFlag, err)
+		} else if url.Scheme != "https" && url.Scheme != "http" {
+			errf("%s scheme must be https or http", InstanceDirectoryURLFlag)
+		}
+
+		if GetInstanceDirectorySyncEvery() <= 0 {
+			errf("%s must be greater than zero", InstanceDirectorySyncEveryFlag)
+		}
+	}
+
+	if GetInstanceDirectoryMaxAccounts() < 0 {
+		errf("%s must not be negative", InstanceDirectoryMaxAccountsFlag)
+	}
+
+	// Check any configured moderation hook URLs.
+	for flag, hookURL := range map[string]string{
+		ModerationHookStatusCreateURLFlag:     GetModerationHookStatusCreateURL(),
+		ModerationHookFederationIngestURLFlag: GetModerationHookFederationIngestURL(),
+		ModerationHookMediaStoreURLFlag:       GetModerationHookMediaStoreURL(),
+	} {
+		if hookURL == "" {
+			continue
+		}
+
+		if url, err := url.Parse(hookURL); err != nil {
+			errf("%s invalid: %w", flag, err)
+		} else if url.Scheme != "https" && url.Scheme != "http" {
+			errf("%s scheme must be https or http", flag)
+		}
+	}
+
+	if GetModerationHookTimeout() <= 0 {
+		errf("%s must be greater than zero", ModerationHookTimeoutFlag)
+	}
+
+	// Check translation backend config.
+	switch backend := GetTranslationBackend(); backend {
+	case "":
+		// Translation disabled.
+
+	case "libretranslate", "deepl":
+		translationURL := GetTranslationURL()
+		if translationURL == "" {
+			if backend == "libretranslate" {
+				errf("%s must be set when %s is libretranslate", TranslationURLFlag, TranslationBackendFlag)
+			}
+			break
+		}
+
+		if url, err := url.Parse(translationURL); err != nil {
+			errf("%s invalid: %w", TranslationURLFlag, err)
+		} else if url.Scheme != "https" && url.Scheme != "http" {
+			errf("%s scheme must be https or http", TranslationURLFlag)
+		}
+
+	default:
+		errf("%s must be one of: libretranslate, deepl", TranslationBackendFlag)
+	}
+
+	if GetTranslationRateLimit() < 0 {
+		errf("%s must not be negative", TranslationRateLimitFlag)
+	}
+
 	return errs.Combine()
 }
@@ -60,6 +60,9 @@ type Account interface {
 	// GetAccountsByMovedToURI returns any accounts with given moved_to_uri set.
 	GetAccountsByMovedToURI(ctx context.Context, uri string) ([]*gtsmodel.Account, error)
 
+	// GetAccountsByOwnerAccountID returns any non-suspended service accounts owned by the given account ID.
+	GetAccountsByOwnerAccountID(ctx context.Context, ownerAccountID string) ([]*gtsmodel.Account, error)
+
 	// GetAccounts returns accounts
 	// with the given parameters.
 	GetAccounts(
@@ -93,15 +96,20 @@ type Account interface {
 	// account as suspended instead, rather than deleting from the db entirely.
 	DeleteAccount(ctx context.Context, id string) error
 
-	// GetAccountCustomCSSByUsername returns the custom css of an account on this instance with the given username.
-	GetAccountCustomCSSByUsername(ctx context.Context, username string) (string, error)
-
 	// GetAccountFaves fetches faves/likes created by the target accountID.
 	GetAccountFaves(ctx context.Context, accountID string) ([]*gtsmodel.StatusFave, error)
 
 	// GetAccountsUsingEmoji fetches all account models using emoji with given ID stored in their 'emojis' column.
 	GetAccountsUsingEmoji(ctx context.Context, emojiID string) ([]*gtsmodel.Account, error)
 
+	// GetDirectoryAccounts returns local, non-suspended accounts which are both
+	// discoverable and have opted in to being published to the instance directory.
+	GetDirectoryAccounts(ctx context.Context, limit int) ([]*gtsmodel.Account, error)
+
+	// GetIndexableAccounts returns local, non-suspended accounts which are
+	// both discoverable and indexable, ie., which may be shown to search engines.
+	GetIndexableAccounts(ctx context.Context, limit int) ([]*gtsmodel.Account, error)
+
 	// GetAccountStatuses is a shortcut for getting the most recent statuses. accountID is optional, if not provided
 	// then all statuses will be returned. If limit is set to 0, the size of the returned slice will not be limited. This can
 	// be very memory intensive so you probably shouldn't do this!
@@ -109,6 +117,16 @@ type Account interface {
 	// In the case of no statuses, this function will return db.ErrNoEntries.
 	GetAccountStatuses(ctx context.Context, accountID string, limit int, excludeReplies bool, excludeReblogs bool, maxID string, minID string, mediaOnly bool, publicOnly bool) ([]*gtsmodel.Status, error)
 
+	// GetAccountStatusIDsForBulkDelete returns the IDs of up to limit statuses owned by the given accountID that match the
+	// given (optional) filters, ordered by ID descending. minID is inclusive, maxID is exclusive. Boosts and trashed statuses are not included.
+	//
+	// In the case of no statuses, this function will return db.ErrNoEntries.
+	GetAccountStatusIDsForBulkDelete(ctx context.Context, accountID string, minID string, maxID string, visibility gtsmodel.Visibility, tagID string, limit int) ([]string, error)
+
+	// CountAccountStatusesForBulkDelete returns the number of statuses owned by the given accountID that match
+	// the given (optional) filters, with the same semantics as GetAccountStatusIDsForBulkDelete.
+	CountAccountStatusesForBulkDelete(ctx context.Context, accountID string, minID string, maxID string, visibility gtsmodel.Visibility, tagID string) (int, error)
+
 	// GetAccountPinnedStatuses returns ONLY statuses owned by the give accountID for which a corresponding StatusPin
 	// exists in the database. Statuses which are not pinned will not be returned by this function.
 	//
@@ -123,6 +141,13 @@ type Account interface {
 	// In the case of no statuses, this function will return db.ErrNoEntries.
 	GetAccountWebStatuses(ctx context.Context, account *gtsmodel.Account, page *paging.Page, mediaOnly bool) ([]*gtsmodel.Status, error)
 
+	// GetAccountPrivateFeedStatuses is similar to GetAccountWebStatuses, but it's specifically
+	// for returning statuses that should be visible via the token-protected private RSS feed
+	// of a *LOCAL* account, ie., also including followers-only statuses.
+	//
+	// In the case of no statuses, this function will return db.ErrNoEntries.
+	GetAccountPrivateFeedStatuses(ctx context.Context, account *gtsmodel.Account, page *paging.Page, mediaOnly bool) ([]*gtsmodel.Status, error)
+
 	// GetInstanceAccount returns the instance account for the given domain.
 	// If domain is empty, this instance account will be returned.
 	GetInstanceAccount(ctx context.Context, domain string) (*gtsmodel.Account, error)
@@ -136,6 +161,10 @@ type Account interface {
 	// Update local account settings.
 	UpdateAccountSettings(ctx context.Context, settings *gtsmodel.AccountSettings, columns ...string) error
 
+	// GetAutoDeleteAccountSettings returns settings for all local
+	// accounts which have automatic status deletion enabled.
+	GetAutoDeleteAccountSettings(ctx context.Context) ([]*gtsmodel.AccountSettings, error)
+
 	// PopulateAccountStats either creates account stats for the given
 	// account by performing COUNT(*) database queries, or retrieves
 	// existing stats from the database, and attaches stats to account.
@@ -46,6 +46,12 @@ type Admin interface {
 	// This is needed for things like serving files that belong to the instance and not an individual user/account.
 	CreateInstanceAccount(ctx context.Context) error
 
+	// RotateAccountKeys generates a new RSA keypair for the given local account (or instance
+	// account), under a new public key URI. The current public key is retained as the previous
+	// public key for a grace period, so that requests signed with it can still be verified.
+	// The account is updated in the database, and the given account model updated in place.
+	RotateAccountKeys(ctx context.Context, account *gtsmodel.Account) error
+
 	// CreateInstanceInstance creates an instance in the database with the same domain as the instance host value.
 	// Ie., if the instance is hosted at 'example.org' the instance will have a domain of 'example.org'.
 	// This is needed for things like serving instance information through /api/v1/instance
@@ -78,6 +84,10 @@ type Admin interface {
 	// GetAdminActions gets all admin actions from the database.
 	GetAdminActions(ctx context.Context) ([]*gtsmodel.AdminAction, error)
 
+	// GetLatestAdminActionForTarget returns the most recent admin action
+	// of the given type taken against the given target category + ID.
+	GetLatestAdminActionForTarget(ctx context.Context, category gtsmodel.AdminActionCategory, targetID string, actionType gtsmodel.AdminActionType) (*gtsmodel.AdminAction, error)
+
 	// PutAdminAction puts one admin action in the database.
 	PutAdminAction(ctx context.Context, action *gtsmodel.AdminAction) error
 
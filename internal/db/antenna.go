@@ -0,0 +1,57 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+type Antenna interface {
+	// GetAntennaByID gets one antenna with the given id.
+	GetAntennaByID(ctx context.Context, id string) (*gtsmodel.Antenna, error)
+
+	// GetAntennasByIDs fetches all antennas with the provided IDs.
+	GetAntennasByIDs(ctx context.Context, ids []string) ([]*gtsmodel.Antenna, error)
+
+	// GetAntennasByAccountID gets all antennas owned by the given accountID.
+	GetAntennasByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.Antenna, error)
+
+	// CountAntennasByAccountID counts the number of antennas owned by the given accountID.
+	CountAntennasByAccountID(ctx context.Context, accountID string) (int, error)
+
+	// GetAllAntennas gets all antennas on the instance, for matching incoming statuses against.
+	GetAllAntennas(ctx context.Context) ([]*gtsmodel.Antenna, error)
+
+	// PopulateAntenna ensures that the antenna's struct fields are populated.
+	PopulateAntenna(ctx context.Context, antenna *gtsmodel.Antenna) error
+
+	// PutAntenna puts a new antenna in the database.
+	PutAntenna(ctx context.Context, antenna *gtsmodel.Antenna) error
+
+	// UpdateAntenna updates the given antenna.
+	// Columns is optional, if not specified all will be updated.
+	UpdateAntenna(ctx context.Context, antenna *gtsmodel.Antenna, columns ...string) error
+
+	// DeleteAntennaByID deletes one antenna with the given ID.
+	DeleteAntennaByID(ctx context.Context, id string) error
+
+	// DeleteAntennasByAccountID deletes all antennas owned by the given accountID.
+	DeleteAntennasByAccountID(ctx context.Context, accountID string) error
+}
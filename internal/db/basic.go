@@ -36,6 +36,10 @@ type Basic interface {
 	// Ready returns nil if the database connection is ready, or an error if not.
 	Ready(ctx context.Context) error
 
+	// Backup writes a consistent snapshot of the database to a new file at the given path,
+	// while the database remains available for use. This is only supported for SQLite.
+	Backup(ctx context.Context, path string) error
+
 	// GetByID gets one entry by its id. In a database like postgres, this might be the 'id' field of the entry,
 	// for other implementations (for example, in-memory) it might just be the key of a map.
 	// The given interface i will be set to the result of the query, whatever it is. Use a pointer or a slice.
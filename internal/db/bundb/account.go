@@ -44,6 +44,11 @@
 type accountDB struct {
 	db    *bun.DB
 	state *state.State
+
+	// readDB is the read-only replica, if
+	// configured, else equal to db. Used only
+	// for heavy web / RSS status page queries.
+	readDB *bun.DB
 }
 
 func (a *accountDB) GetAccountByID(ctx context.Context, id string) (*gtsmodel.Account, error) {
@@ -293,6 +298,30 @@ func (a *accountDB) GetAccountsByMovedToURI(ctx context.Context, uri string) ([]
 	return a.GetAccountsByIDs(ctx, accountIDs)
 }
 
+func (a *accountDB) GetAccountsByOwnerAccountID(ctx context.Context, ownerAccountID string) ([]*gtsmodel.Account, error) {
+	var accountIDs []string
+
+	// Find all account IDs with given
+	// owner_account_id column, skipping
+	// any which are already suspended.
+	if err := a.db.NewSelect().
+		Table("accounts").
+		Column("id").
+		Where("? = ?", bun.Ident("owner_account_id"), ownerAccountID).
+		Where("? IS NULL", bun.Ident("suspended_at")).
+		Order("id ASC").
+		Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	if len(accountIDs) == 0 {
+		return nil, nil
+	}
+
+	// Return account models for all found IDs.
+	return a.GetAccountsByIDs(ctx, accountIDs)
+}
+
 // GetAccounts selects accounts using the given parameters.
 // Unlike with other functions, the paging for GetAccounts
 // is done not by ID, but by a concatenation of `[domain]/@[username]`,
@@ -483,7 +512,23 @@ func (a *accountDB) GetAccounts(
 		useAccountIDIn = true
 	}
 
-	// TODO: invitedBy
+	if invitedBy != "" {
+		// Get only accounts that signed up
+		// with an invite created by invitedBy.
+		q = q.Where("? IN (?)",
+			bun.Ident("account.id"),
+			a.db.NewSelect().
+				TableExpr("? AS ?", bun.Ident("users"), bun.Ident("user")).
+				Column("user.account_id").
+				Where("? IN (?)",
+					bun.Ident("user.invite_id"),
+					a.db.NewSelect().
+						TableExpr("? AS ?", bun.Ident("invites"), bun.Ident("invite")).
+						Column("invite.id").
+						Where("? = ?", bun.Ident("invite.created_by_account_id"), invitedBy),
+				),
+		)
+	}
 
 	if username != "" {
 		q = q.Where("? = ?", bun.Ident("account.username"), username)
@@ -850,25 +895,6 @@ func (a *accountDB) DeleteAccount(ctx context.Context, id string) error {
 	return nil
 }
 
-func (a *accountDB) GetAccountCustomCSSByUsername(ctx context.Context, username string) (string, error) {
-	// Get local account.
-	account, err := a.GetAccountByUsernameDomain(ctx, username, "")
-	if err != nil {
-		return "", err
-	}
-
-	// Ensure settings populated, in case
-	// barebones context was passed.
-	if account.Settings == nil {
-		account.Settings, err = a.GetAccountSettings(ctx, account.ID)
-		if err != nil {
-			return "", err
-		}
-	}
-
-	return account.Settings.CustomCSS, nil
-}
-
 func (a *accountDB) GetAccountsUsingEmoji(ctx context.Context, emojiID string) ([]*gtsmodel.Account, error) {
 	var accountIDs []string
 
@@ -886,6 +912,66 @@ func (a *accountDB) GetAccountsUsingEmoji(ctx context.Context, emojiID string) (
 	return a.GetAccountsByIDs(ctx, accountIDs)
 }
 
+func (a *accountDB) GetDirectoryAccounts(ctx context.Context, limit int) ([]*gtsmodel.Account, error) {
+	var accountIDs []string
+
+	// SELECT all local, discoverable, non-suspended
+	// accounts that have explicitly opted in to being
+	// published to the instance directory.
+	q := a.db.NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		Column("account.id").
+		Join(
+			"JOIN ? AS ? ON ? = ?",
+			bun.Ident("account_settings"), bun.Ident("account_settings"),
+			bun.Ident("account_settings.account_id"), bun.Ident("account.id"),
+		).
+		Where("? IS NULL", bun.Ident("account.domain")).
+		Where("? != ?", bun.Ident("account.username"), config.GetHost()).
+		Where("? IS NULL", bun.Ident("account.suspended_at")).
+		Where("? = ?", bun.Ident("account.discoverable"), true).
+		Where("? = ?", bun.Ident("account_settings.directory_opt_in"), true).
+		Order("account.id ASC")
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	// Convert account IDs into account objects.
+	return a.GetAccountsByIDs(ctx, accountIDs)
+}
+
+func (a *accountDB) GetIndexableAccounts(ctx context.Context, limit int) ([]*gtsmodel.Account, error) {
+	var accountIDs []string
+
+	// SELECT all local, non-suspended accounts
+	// that are both discoverable and indexable.
+	q := a.db.NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		Column("account.id").
+		Where("? IS NULL", bun.Ident("account.domain")).
+		Where("? != ?", bun.Ident("account.username"), config.GetHost()).
+		Where("? IS NULL", bun.Ident("account.suspended_at")).
+		Where("? = ?", bun.Ident("account.discoverable"), true).
+		Where("? = ?", bun.Ident("account.indexable"), true).
+		Order("account.id ASC")
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	// Convert account IDs into account objects.
+	return a.GetAccountsByIDs(ctx, accountIDs)
+}
+
 func (a *accountDB) GetAccountFaves(ctx context.Context, accountID string) ([]*gtsmodel.StatusFave, error) {
 	faves := new([]*gtsmodel.StatusFave)
 
@@ -1019,6 +1105,81 @@ func (a *accountDB) GetAccountStatuses(ctx context.Context, accountID string, li
 	return a.state.DB.GetStatusesByIDs(ctx, statusIDs)
 }
 
+func (a *accountDB) GetAccountStatusIDsForBulkDelete(ctx context.Context, accountID string, minID string, maxID string, visibility gtsmodel.Visibility, tagID string, limit int) ([]string, error) {
+	var statusIDs []string
+
+	q := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		// Select only IDs from table
+		Column("status.id")
+
+	q = selectBulkDeleteStatuses(q, accountID, minID, maxID, visibility, tagID).
+		Order("status.id DESC")
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &statusIDs); err != nil {
+		return nil, err
+	}
+
+	if len(statusIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return statusIDs, nil
+}
+
+func (a *accountDB) CountAccountStatusesForBulkDelete(ctx context.Context, accountID string, minID string, maxID string, visibility gtsmodel.Visibility, tagID string) (int, error) {
+	q := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status"))
+
+	return selectBulkDeleteStatuses(q, accountID, minID, maxID, visibility, tagID).
+		Count(ctx)
+}
+
+// selectBulkDeleteStatuses limits the given select query on "statuses"
+// AS "status" to statuses owned by accountID that match the given
+// (optional) bulk delete filters, excluding boosts and trashed statuses.
+func selectBulkDeleteStatuses(q *bun.SelectQuery, accountID string, minID string, maxID string, visibility gtsmodel.Visibility, tagID string) *bun.SelectQuery {
+	q = q.
+		Where("? = ?", bun.Ident("status.account_id"), accountID).
+		// Boosts are undone, not deleted.
+		Where("? IS NULL", bun.Ident("status.boost_of_id")).
+		// Statuses in the trash are already
+		// deleted, pending permanent removal.
+		Where("? IS NULL", bun.Ident("status.deleted_at"))
+
+	if minID != "" {
+		// return only statuses with ID >= minID
+		q = q.Where("? >= ?", bun.Ident("status.id"), minID)
+	}
+
+	if maxID != "" {
+		// return only statuses with ID < maxID
+		q = q.Where("? < ?", bun.Ident("status.id"), maxID)
+	}
+
+	if visibility != 0 {
+		q = q.Where("? = ?", bun.Ident("status.visibility"), visibility)
+	}
+
+	if tagID != "" {
+		// Join on status_to_tags to select
+		// only statuses that use given tag.
+		q = q.Join(
+			"INNER JOIN ? AS ? ON ? = ?",
+			bun.Ident("status_to_tags"), bun.Ident("status_to_tag"),
+			bun.Ident("status.id"), bun.Ident("status_to_tag.status_id"),
+		).Where("? = ?", bun.Ident("status_to_tag.tag_id"), tagID)
+	}
+
+	return q
+}
+
 func (a *accountDB) GetAccountPinnedStatuses(ctx context.Context, accountID string) ([]*gtsmodel.Status, error) {
 	statusIDs := []string{}
 
@@ -1075,7 +1236,7 @@ func (a *accountDB) GetAccountWebStatuses(
 		return nil, nil
 	}
 
-	return loadStatusTimelinePage(ctx, a.db, a.state,
+	return loadStatusTimelinePage(ctx, a.readDB, a.state,
 
 		// Paging
 		// params.
@@ -1096,6 +1257,58 @@ func(q *bun.SelectQuery) (*bun.SelectQuery, error) {
 				Where("? IS NULL", bun.Ident("boost_of_id")).
 				Where("? = ?", bun.Ident("federated"), true)
 
+			// Don't show statuses in the trash.
+			q = q.Where("? IS NULL", bun.Ident("deleted_at"))
+
+			if mediaOnly {
+				// Respect mediaOnly pref.
+				q = selectOnlyWithMedia(q)
+			}
+
+			return q, nil
+		},
+	)
+}
+
+// privateFeedStatusVisibilities are the visibilities
+// of statuses shown in an account's private RSS feed.
+var privateFeedStatusVisibilities = bun.In([]gtsmodel.Visibility{
+	gtsmodel.VisibilityPublic,
+	gtsmodel.VisibilityUnlocked,
+	gtsmodel.VisibilityFollowersOnly,
+})
+
+func (a *accountDB) GetAccountPrivateFeedStatuses(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	page *paging.Page,
+	mediaOnly bool,
+) ([]*gtsmodel.Status, error) {
+	if account.Username == config.GetHost() {
+		// Instance account
+		// doesn't post statuses.
+		return nil, nil
+	}
+
+	return loadStatusTimelinePage(ctx, a.readDB, a.state,
+
+		// Paging
+		// params.
+		page,
+
+		// The actual meat of the account private feed statuses query.
+		func(q *bun.SelectQuery) (*bun.SelectQuery, error) {
+			q = q.Where("? = ?", bun.Ident("account_id"), account.ID).
+				Where("? IN (?)", bun.Ident("visibility"), privateFeedStatusVisibilities)
+
+			// Don't show replies, boosts, or local-only in feed.
+			q = q.Where("? IS NULL", bun.Ident("in_reply_to_uri")).
+				Where("? IS NULL", bun.Ident("boost_of_id")).
+				Where("? = ?", bun.Ident("federated"), true)
+
+			// Don't show statuses in the trash.
+			q = q.Where("? IS NULL", bun.Ident("deleted_at"))
+
 			if mediaOnly {
 				// Respect mediaOnly pref.
 				q = selectOnlyWithMedia(q)
@@ -1153,31 +1366,16 @@ func (a *accountDB) UpdateAccountSettings(
 	return a.state.Caches.DB.AccountSettings.Store(settings, func() error {
 		settings.UpdatedAt = time.Now()
 
-		switch {
-
-		case len(columns) != 0:
+		if len(columns) != 0 {
 			// If we're updating by column,
 			// ensure "updated_at" is included.
 			columns = append(columns, "updated_at")
-
-			// If we're updating web_visibility we should
-			// fall through + invalidate visibility cache.
-			if !slices.Contains(columns, "web_visibility") {
-				break // No need to invalidate.
-			}
-
-			// Fallthrough
-			// to invalidate.
-			fallthrough
-
-		case len(columns) == 0:
-			// Status visibility may be changing for this account.
-			// Clear the visibility cache for unauthed requesters.
-			//
-			// todo: invalidate JUST this account's statuses.
-			defer a.state.Caches.Visibility.Clear()
 		}
 
+		// Note: status visibility may be changing for this
+		// account, but this gets handled by the cache's
+		// OnInvalidateAccountSettings() hook on store.
+
 		if _, err := a.db.
 			NewUpdate().
 			Model(settings).
@@ -1191,6 +1389,31 @@ func (a *accountDB) UpdateAccountSettings(
 	})
 }
 
+func (a *accountDB) GetAutoDeleteAccountSettings(ctx context.Context) ([]*gtsmodel.AccountSettings, error) {
+	var accountIDs []string
+
+	// SELECT all accounts that
+	// have auto-delete enabled.
+	if err := a.db.NewSelect().
+		Table("account_settings").
+		Column("account_id").
+		Where("? > 0", bun.Ident("auto_delete_after_days")).
+		Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	settings := make([]*gtsmodel.AccountSettings, 0, len(accountIDs))
+	for _, id := range accountIDs {
+		s, err := a.GetAccountSettings(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		settings = append(settings, s)
+	}
+
+	return settings, nil
+}
+
 func (a *accountDB) PopulateAccountStats(ctx context.Context, account *gtsmodel.Account) error {
 	if account.Stats != nil {
 		// Already populated!
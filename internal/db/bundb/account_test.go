@@ -94,6 +94,31 @@ func (suite *AccountTestSuite) TestGetAccountStatusesExcludeRepliesAndReblogsPub
 	suite.Len(statuses, 4)
 }
 
+func (suite *AccountTestSuite) TestGetAccountStatusIDsForBulkDelete() {
+	ctx := suite.T().Context()
+	accountID := suite.testAccounts["local_account_1"].ID
+
+	// Count all of the account's (non-boost) statuses.
+	count, err := suite.db.CountAccountStatusesForBulkDelete(ctx, accountID, "", "", 0, "")
+	suite.NoError(err)
+	suite.Greater(count, 2)
+
+	// All IDs should match the count.
+	statusIDs, err := suite.db.GetAccountStatusIDsForBulkDelete(ctx, accountID, "", "", 0, "", 0)
+	suite.NoError(err)
+	suite.Len(statusIDs, count)
+
+	// Limited selection should return only
+	// the newest IDs, and page down from there.
+	page, err := suite.db.GetAccountStatusIDsForBulkDelete(ctx, accountID, "", "", 0, "", 2)
+	suite.NoError(err)
+	suite.Equal(statusIDs[:2], page)
+
+	page, err = suite.db.GetAccountStatusIDsForBulkDelete(ctx, accountID, "", page[1], 0, "", 2)
+	suite.NoError(err)
+	suite.Equal(statusIDs[2:min(4, count)], page)
+}
+
 // populateTestStatus adds mandatory fields to a partially populated status.
 func (suite *AccountTestSuite) populateTestStatus(testAccountKey string, status *gtsmodel.Status, inReplyTo *gtsmodel.Status) *gtsmodel.Status {
 	testAccount := suite.testAccounts[testAccountKey]
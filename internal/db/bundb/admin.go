@@ -19,6 +19,7 @@
 
 import (
 	"context"
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/rsa"
 	"errors"
@@ -44,6 +45,11 @@
 // generate RSA keys of this length
 const rsaKeyBits = 2048
 
+// keyRotationGracePeriod is the length of time
+// a previous public key will still be served
+// for after an account's keys are rotated.
+const keyRotationGracePeriod = 24 * time.Hour
+
 type adminDB struct {
 	db    *bun.DB
 	state *state.State
@@ -114,6 +120,12 @@ func (a *adminDB) NewSignup(ctx context.Context, newSignup gtsmodel.NewSignup) (
 			return nil, err
 		}
 
+		ed25519PubKey, ed25519PrivKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			err := gtserror.Newf("error creating new ed25519 key: %w", err)
+			return nil, err
+		}
+
 		account = &gtsmodel.Account{
 			ID:                           accountID,
 			Username:                     newSignup.Username,
@@ -129,6 +141,9 @@ func (a *adminDB) NewSignup(ctx context.Context, newSignup gtsmodel.NewSignup) (
 			PrivateKey:                   privKey,
 			PublicKey:                    &privKey.PublicKey,
 			PublicKeyURI:                 uris.PublicKeyURI,
+			Ed25519PrivateKey:            ed25519PrivKey,
+			Ed25519PublicKey:             ed25519PubKey,
+			Ed25519PublicKeyURI:          uris.Ed25519PublicKeyURI,
 			HidesCcPublicFromUnauthedWeb: util.Ptr(true), // GtS default to hide unlisted.
 		}
 
@@ -229,6 +244,7 @@ func (a *adminDB) NewSignup(ctx context.Context, newSignup gtsmodel.NewSignup) (
 		UnconfirmedEmail:       newSignup.Email,
 		CreatedByApplicationID: newSignup.AppID,
 		ExternalID:             newSignup.ExternalID,
+		InviteID:               newSignup.InviteID,
 	}
 
 	if newSignup.EmailVerified {
@@ -282,6 +298,12 @@ func (a *adminDB) CreateInstanceAccount(ctx context.Context) error {
 		return err
 	}
 
+	ed25519PubKey, ed25519PrivKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		log.Errorf(ctx, "error creating new ed25519 key: %s", err)
+		return err
+	}
+
 	newAccountURIs := uris.GenerateURIsForAccount(username)
 	acct := &gtsmodel.Account{
 		ID:                    id.NewRandomULID(),
@@ -291,6 +313,9 @@ func (a *adminDB) CreateInstanceAccount(ctx context.Context) error {
 		PrivateKey:            key,
 		PublicKey:             &key.PublicKey,
 		PublicKeyURI:          newAccountURIs.PublicKeyURI,
+		Ed25519PrivateKey:     ed25519PrivKey,
+		Ed25519PublicKey:      ed25519PubKey,
+		Ed25519PublicKeyURI:   newAccountURIs.Ed25519PublicKeyURI,
 		ActorType:             gtsmodel.AccountActorTypeApplication,
 		URI:                   newAccountURIs.UserURI,
 		InboxURI:              newAccountURIs.InboxURI,
@@ -309,6 +334,38 @@ func (a *adminDB) CreateInstanceAccount(ctx context.Context) error {
 	return nil
 }
 
+func (a *adminDB) RotateAccountKeys(ctx context.Context, account *gtsmodel.Account) error {
+	if account.IsRemote() {
+		return gtserror.Newf("account %s is not local", account.ID)
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	if err != nil {
+		return gtserror.Newf("error creating new rsa key: %w", err)
+	}
+
+	// Retain the current public key.
+	account.PreviousPublicKey = account.PublicKey
+	account.PreviousPublicKeyURI = account.PublicKeyURI
+	account.PreviousPublicKeyExpiresAt = time.Now().Add(keyRotationGracePeriod)
+
+	// Set the new keypair, served under a new unique
+	// key URI so remotes don't confuse it with the old.
+	account.PrivateKey = key
+	account.PublicKey = &key.PublicKey
+	account.PublicKeyURI = account.URI + "/" + uris.PublicKeyPath + "/" + id.NewULID()
+
+	return a.state.DB.UpdateAccount(ctx,
+		account,
+		"private_key",
+		"public_key",
+		"public_key_uri",
+		"previous_public_key",
+		"previous_public_key_uri",
+		"previous_public_key_expires_at",
+	)
+}
+
 func (a *adminDB) CreateInstanceInstance(ctx context.Context) error {
 	protocol := config.GetProtocol()
 	host := config.GetHost()
@@ -475,6 +532,29 @@ func (a *adminDB) GetAdminActions(ctx context.Context) ([]*gtsmodel.AdminAction,
 	return actions, nil
 }
 
+func (a *adminDB) GetLatestAdminActionForTarget(
+	ctx context.Context,
+	category gtsmodel.AdminActionCategory,
+	targetID string,
+	actionType gtsmodel.AdminActionType,
+) (*gtsmodel.AdminAction, error) {
+	action := new(gtsmodel.AdminAction)
+
+	if err := a.db.
+		NewSelect().
+		Model(action).
+		Where("? = ?", bun.Ident("admin_action.target_category"), category).
+		Where("? = ?", bun.Ident("admin_action.target_id"), targetID).
+		Where("? = ?", bun.Ident("admin_action.type"), actionType).
+		OrderExpr("? DESC", bun.Ident("admin_action.id")).
+		Limit(1).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return action, nil
+}
+
 func (a *adminDB) PutAdminAction(ctx context.Context, action *gtsmodel.AdminAction) error {
 	_, err := a.db.
 		NewInsert().
@@ -0,0 +1,281 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gopkg/xslices"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type antennaDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (a *antennaDB) GetAntennaByID(ctx context.Context, id string) (*gtsmodel.Antenna, error) {
+	antenna, err := a.state.Caches.DB.Antenna.LoadOne("ID", func() (*gtsmodel.Antenna, error) {
+		var antenna gtsmodel.Antenna
+
+		// Not cached! Perform database query.
+		if err := a.db.NewSelect().
+			Model(&antenna).
+			Where("? = ?", bun.Ident("antenna.id"), id).
+			Scan(ctx); err != nil {
+			return nil, err
+		}
+
+		return &antenna, nil
+	}, id)
+	if err != nil {
+		// already processed
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// Only a barebones model was requested.
+		return antenna, nil
+	}
+
+	if err := a.PopulateAntenna(ctx, antenna); err != nil {
+		return nil, err
+	}
+
+	return antenna, nil
+}
+
+func (a *antennaDB) GetAntennasByIDs(ctx context.Context, ids []string) ([]*gtsmodel.Antenna, error) {
+	// Load all antenna IDs via cache loader callbacks.
+	antennas, err := a.state.Caches.DB.Antenna.LoadIDs("ID",
+		ids,
+		func(uncached []string) ([]*gtsmodel.Antenna, error) {
+			// Preallocate expected length of uncached antennas.
+			antennas := make([]*gtsmodel.Antenna, 0, len(uncached))
+
+			// Perform database query scanning
+			// the remaining (uncached) IDs.
+			if err := a.db.NewSelect().
+				Model(&antennas).
+				Where("? IN (?)", bun.Ident("id"), bun.In(uncached)).
+				Scan(ctx); err != nil {
+				return nil, err
+			}
+
+			return antennas, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reorder the antennas by their
+	// IDs to ensure in correct order.
+	getID := func(a *gtsmodel.Antenna) string { return a.ID }
+	xslices.OrderBy(antennas, ids, getID)
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return antennas, nil
+	}
+
+	// Populate all loaded antennas, removing those we fail to
+	// populate (removes needing so many nil checks everywhere).
+	antennas = slices.DeleteFunc(antennas, func(antenna *gtsmodel.Antenna) bool {
+		if err := a.PopulateAntenna(ctx, antenna); err != nil {
+			log.Errorf(ctx, "error populating antenna %s: %v", antenna.ID, err)
+			return true
+		}
+		return false
+	})
+
+	return antennas, nil
+}
+
+func (a *antennaDB) GetAntennasByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.Antenna, error) {
+	antennaIDs, err := a.getAntennaIDsByAccountID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	return a.GetAntennasByIDs(ctx, antennaIDs)
+}
+
+func (a *antennaDB) CountAntennasByAccountID(ctx context.Context, accountID string) (int, error) {
+	antennaIDs, err := a.getAntennaIDsByAccountID(ctx, accountID)
+	return len(antennaIDs), err
+}
+
+func (a *antennaDB) GetAllAntennas(ctx context.Context) ([]*gtsmodel.Antenna, error) {
+	antennaIDs, err := a.state.Caches.DB.AntennaIDs.Load("*", func() ([]string, error) {
+		var antennaIDs []string
+
+		// Antenna IDs not in cache.
+		// Perform the DB query.
+		if _, err := a.db.NewSelect().
+			Table("antennas").
+			Column("id").
+			OrderExpr("? DESC", bun.Ident("created_at")).
+			Exec(ctx, &antennaIDs); err != nil &&
+			!errors.Is(err, db.ErrNoEntries) {
+			return nil, err
+		}
+
+		return antennaIDs, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a.GetAntennasByIDs(ctx, antennaIDs)
+}
+
+func (a *antennaDB) getAntennaIDsByAccountID(ctx context.Context, accountID string) ([]string, error) {
+	return a.state.Caches.DB.AntennaIDs.Load("a"+accountID, func() ([]string, error) {
+		var antennaIDs []string
+
+		// Antenna IDs not in cache.
+		// Perform the DB query.
+		if _, err := a.db.NewSelect().
+			Table("antennas").
+			Column("id").
+			Where("? = ?", bun.Ident("account_id"), accountID).
+			OrderExpr("? DESC", bun.Ident("created_at")).
+			Exec(ctx, &antennaIDs); err != nil &&
+			!errors.Is(err, db.ErrNoEntries) {
+			return nil, err
+		}
+
+		return antennaIDs, nil
+	})
+}
+
+func (a *antennaDB) PopulateAntenna(ctx context.Context, antenna *gtsmodel.Antenna) error {
+	var (
+		err  error
+		errs gtserror.MultiError
+	)
+
+	if antenna.Account == nil {
+		// Antenna account is not set, fetch from the database.
+		antenna.Account, err = a.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			antenna.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating antenna account: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (a *antennaDB) PutAntenna(ctx context.Context, antenna *gtsmodel.Antenna) error {
+	// note that inserting antenna will call OnInvalidateAntenna()
+	// which will handle clearing caches other than Antenna cache.
+	return a.state.Caches.DB.Antenna.Store(antenna, func() error {
+		_, err := a.db.NewInsert().Model(antenna).Exec(ctx)
+		return err
+	})
+}
+
+func (a *antennaDB) UpdateAntenna(ctx context.Context, antenna *gtsmodel.Antenna, columns ...string) error {
+	antenna.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	// Update antenna in the database, invalidating main antenna cache.
+	if err := a.state.Caches.DB.Antenna.Store(antenna, func() error {
+		_, err := a.db.NewUpdate().
+			Model(antenna).
+			Where("? = ?", bun.Ident("antenna.id"), antenna.ID).
+			Column(columns...).
+			Exec(ctx)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	// Antenna rules may have changed, so
+	// clear cached timeline of antenna ID.
+	a.state.Caches.Timelines.Antenna.Clear(antenna.ID)
+
+	return nil
+}
+
+func (a *antennaDB) DeleteAntennaByID(ctx context.Context, id string) error {
+	// Acquire antenna owner ID.
+	var accountID string
+
+	if _, err := a.db.NewDelete().
+		Table("antennas").
+		Where("? = ?", bun.Ident("id"), id).
+		Returning("?", bun.Ident("account_id")).
+		Exec(ctx, &accountID); err != nil {
+		return err
+	}
+
+	// Invalidate the main antenna database cache.
+	a.state.Caches.DB.Antenna.Invalidate("ID", id)
+
+	// Invalidate cache of antenna IDs owned
+	// by account, and of all antenna IDs.
+	a.state.Caches.DB.AntennaIDs.Invalidate("a"+accountID, "*")
+
+	// Delete the cached timeline of antenna.
+	a.state.Caches.Timelines.Antenna.Delete(id)
+
+	return nil
+}
+
+func (a *antennaDB) DeleteAntennasByAccountID(ctx context.Context, accountID string) error {
+	// Gather IDs of antennas owned by account.
+	var antennaIDs []string
+
+	if _, err := a.db.NewDelete().
+		Table("antennas").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Returning("?", bun.Ident("id")).
+		Exec(ctx, &antennaIDs); err != nil &&
+		!errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	// Invalidate the main antenna database cache.
+	a.state.Caches.DB.Antenna.InvalidateIDs("ID", antennaIDs)
+
+	// Invalidate cache of antenna IDs owned
+	// by account, and of all antenna IDs.
+	a.state.Caches.DB.AntennaIDs.Invalidate("a"+accountID, "*")
+
+	// Delete the cached timelines of antennas.
+	for _, id := range antennaIDs {
+		a.state.Caches.Timelines.Antenna.Delete(id)
+	}
+
+	return nil
+}
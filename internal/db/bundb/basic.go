@@ -23,11 +23,17 @@
 
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
 )
 
 type basicDB struct {
 	db *bun.DB
+
+	// readDB is the read-only replica,
+	// if configured, else equal to db.
+	readDB *bun.DB
 }
 
 func (b *basicDB) Put(ctx context.Context, i interface{}) error {
@@ -134,7 +140,29 @@ func (b *basicDB) Ready(ctx context.Context) error {
 	return nil
 }
 
+func (b *basicDB) Backup(ctx context.Context, path string) error {
+	if d := b.db.Dialect().Name(); d != dialect.SQLite {
+		return gtserror.Newf("backup not supported for %s databases", d)
+	}
+
+	// VACUUM INTO writes a compacted copy of the
+	// database in a single read transaction, so the
+	// snapshot is consistent even with concurrent
+	// writes. It refuses to overwrite existing files.
+	// See: https://www.sqlite.org/lang_vacuum.html#vacuuminto
+	if _, err := b.db.ExecContext(ctx, "VACUUM INTO ?", path); err != nil {
+		return gtserror.Newf("error writing backup to %s: %w", path, err)
+	}
+
+	return nil
+}
+
 func (b *basicDB) Close() error {
 	log.Info(nil, "closing db connection")
+	if b.readDB != nil && b.readDB != b.db {
+		if err := b.readDB.Close(); err != nil {
+			log.Errorf(nil, "error closing replica db connection: %v", err)
+		}
+	}
 	return b.db.Close()
 }
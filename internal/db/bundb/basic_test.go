@@ -18,11 +18,15 @@
 package bundb_test
 
 import (
+	"bytes"
 	"crypto/rand"
 	"crypto/rsa"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"github.com/stretchr/testify/suite"
@@ -134,6 +138,34 @@ func (suite *BasicTestSuite) TestGetAllNotNull() {
 	}
 }
 
+func (suite *BasicTestSuite) TestBackup() {
+	if config.GetDbType() != "sqlite" {
+		suite.T().Skip("backup is only supported for sqlite")
+	}
+
+	if config.GetDbAddress() == ":memory:" {
+		// In-memory databases are backed up
+		// to the in-memory VFS, not to disk.
+		suite.T().Skip("backup test requires on-disk sqlite")
+	}
+
+	ctx := suite.T().Context()
+	path := filepath.Join(suite.T().TempDir(), "backup.db")
+
+	// Take a backup snapshot of the database.
+	err := suite.db.Backup(ctx, path)
+	suite.NoError(err)
+
+	// Snapshot should be a valid sqlite database.
+	b, err := os.ReadFile(path)
+	suite.NoError(err)
+	suite.True(bytes.HasPrefix(b, []byte("SQLite format 3\x00")))
+
+	// Should refuse to overwrite an existing backup.
+	err = suite.db.Backup(ctx, path)
+	suite.Error(err)
+}
+
 func TestBasicTestSuite(t *testing.T) {
 	suite.Run(t, new(BasicTestSuite))
 }
@@ -43,14 +43,20 @@ type DBService struct {
 	db.Account
 	db.Admin
 	db.AdvancedMigration
+	db.Antenna
 	db.Application
 	db.Basic
 	db.Conversation
 	db.Domain
+	db.Draft
 	db.Emoji
 	db.HeaderFilter
+	db.InboxActivity
 	db.Instance
+	db.Invite
+	db.IPRule
 	db.Interaction
+	db.LandingBlock
 	db.Filter
 	db.List
 	db.Marker
@@ -68,13 +74,18 @@ type DBService struct {
 	db.SinBinStatus
 	db.Status
 	db.StatusBookmark
+	db.StatusBulkDelete
 	db.StatusEdit
 	db.StatusFave
+	db.StatusReaction
+	db.Suggestion
 	db.Tag
+	db.Theme
 	db.Thread
 	db.Timeline
 	db.User
 	db.Tombstone
+	db.WebfingerAlias
 	db.WebPush
 	db.WorkerTask
 	db *bun.DB
@@ -127,23 +138,19 @@ func doMigration(ctx context.Context, db *bun.DB) error {
 // NewBunDBService returns a bunDB derived from the provided config, which implements the go-fed DB interface.
 // Under the hood, it uses https://github.com/uptrace/bun to create and maintain a database connection.
 func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
-	var sqldb *sql.DB
-	var dialect func() schema.Dialect
-	var err error
+	sqldb, dialect, err := dbConn(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	switch t := strings.ToLower(config.GetDbType()); t {
-	case "postgres":
-		sqldb, dialect, err = pgConn(ctx)
-		if err != nil {
-			return nil, err
-		}
-	case "sqlite":
-		sqldb, dialect, err = sqliteConn(ctx)
+	var replicadb *sql.DB
+	if strings.EqualFold(config.GetDbType(), "postgres") {
+		// Optional read-only replica.
+		replicadb, err = pgReplicaConn(ctx)
 		if err != nil {
+			_ = sqldb.Close()
 			return nil, err
 		}
-	default:
-		return nil, fmt.Errorf("database type %s not supported for bundb", t)
 	}
 
 	// perform any pending database migrations: this includes the first
@@ -160,10 +167,19 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 	// adding any connection hooks.
 	db := bunDB(sqldb, dialect)
 
+	// Heavy read-only queries (timelines,
+	// search, RSS) use the replica if set,
+	// all other queries use the primary.
+	readDB := db
+	if replicadb != nil {
+		readDB = bunDB(replicadb, dialect)
+	}
+
 	ps := &DBService{
 		Account: &accountDB{
-			db:    db,
-			state: state,
+			db:     db,
+			state:  state,
+			readDB: readDB,
 		},
 		Admin: &adminDB{
 			db:    db,
@@ -173,12 +189,17 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		Antenna: &antennaDB{
+			db:    db,
+			state: state,
+		},
 		Application: &applicationDB{
 			db:    db,
 			state: state,
 		},
 		Basic: &basicDB{
-			db: db,
+			db:     db,
+			readDB: readDB,
 		},
 		Conversation: &conversationDB{
 			db:    db,
@@ -188,6 +209,10 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		Draft: &draftDB{
+			db:    db,
+			state: state,
+		},
 		Emoji: &emojiDB{
 			db:    db,
 			state: state,
@@ -196,14 +221,28 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		InboxActivity: &inboxActivityDB{
+			db: db,
+		},
 		Instance: &instanceDB{
 			db:    db,
 			state: state,
 		},
+		Invite: &inviteDB{
+			db:    db,
+			state: state,
+		},
+		IPRule: &ipRuleDB{
+			db:    db,
+			state: state,
+		},
 		Interaction: &interactionDB{
 			db:    db,
 			state: state,
 		},
+		LandingBlock: &landingBlockDB{
+			db: db,
+		},
 		Filter: &filterDB{
 			db:    db,
 			state: state,
@@ -253,7 +292,7 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			state: state,
 		},
 		Search: &searchDB{
-			db:    db,
+			db:    readDB,
 			state: state,
 		},
 		Session: &sessionDB{
@@ -271,6 +310,9 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		StatusBulkDelete: &statusBulkDeleteDB{
+			db: db,
+		},
 		StatusEdit: &statusEditDB{
 			db:    db,
 			state: state,
@@ -279,16 +321,28 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		StatusReaction: &statusReactionDB{
+			db:    db,
+			state: state,
+		},
+		Suggestion: &suggestionDB{
+			db:    db,
+			state: state,
+		},
 		Tag: &tagDB{
 			db:    db,
 			state: state,
 		},
+		Theme: &themeDB{
+			db:    db,
+			state: state,
+		},
 		Thread: &threadDB{
 			db:    db,
 			state: state,
 		},
 		Timeline: &timelineDB{
-			db:    db,
+			db:    readDB,
 			state: state,
 		},
 		User: &userDB{
@@ -299,6 +353,10 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		WebfingerAlias: &webfingerAliasDB{
+			db:    db,
+			state: state,
+		},
 		WebPush: &webPushDB{
 			db:    db,
 			state: state,
@@ -313,6 +371,19 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 	return ps, nil
 }
 
+// dbConn opens a new connection pool to the configured
+// database, returning it with the relevant bun dialect.
+func dbConn(ctx context.Context) (*sql.DB, func() schema.Dialect, error) {
+	switch t := strings.ToLower(config.GetDbType()); t {
+	case "postgres":
+		return pgConn(ctx)
+	case "sqlite":
+		return sqliteConn(ctx)
+	default:
+		return nil, nil, fmt.Errorf("database type %s not supported for bundb", t)
+	}
+}
+
 // bunDB returns a new bun.DB for given sql.DB connection pool and dialect
 // function. This can be used to apply any necessary opts / hooks as we
 // initialize a bun.DB object both before and after performing migrations.
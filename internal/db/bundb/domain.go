@@ -54,6 +54,9 @@ func (d *domainDB) PutDomainAllow(ctx context.Context, allow *gtsmodel.DomainAll
 	// Clear the domain allow cache (for later reload)
 	d.state.Caches.DB.DomainAllow.Clear()
 
+	// Clear results derived from domain permissions.
+	d.clearDomainPermissionResults()
+
 	return nil
 }
 
@@ -152,6 +155,9 @@ func (d *domainDB) UpdateDomainAllow(ctx context.Context, allow *gtsmodel.Domain
 	// Clear the domain allow cache (for later reload)
 	d.state.Caches.DB.DomainAllow.Clear()
 
+	// Clear results derived from domain permissions.
+	d.clearDomainPermissionResults()
+
 	return nil
 }
 
@@ -173,6 +179,9 @@ func (d *domainDB) DeleteDomainAllow(ctx context.Context, domain string) error {
 	// Clear the domain allow cache (for later reload)
 	d.state.Caches.DB.DomainAllow.Clear()
 
+	// Clear results derived from domain permissions.
+	d.clearDomainPermissionResults()
+
 	return nil
 }
 
@@ -196,6 +205,9 @@ func (d *domainDB) PutDomainBlock(ctx context.Context, block *gtsmodel.DomainBlo
 	// Clear the domain block cache (for later reload)
 	d.state.Caches.DB.DomainBlock.Clear()
 
+	// Clear results derived from domain permissions.
+	d.clearDomainPermissionResults()
+
 	return nil
 }
 
@@ -296,6 +308,9 @@ func (d *domainDB) UpdateDomainBlock(ctx context.Context, block *gtsmodel.Domain
 	// Clear the domain block cache (for later reload)
 	d.state.Caches.DB.DomainBlock.Clear()
 
+	// Clear results derived from domain permissions.
+	d.clearDomainPermissionResults()
+
 	return nil
 }
 
@@ -317,6 +332,9 @@ func (d *domainDB) DeleteDomainBlock(ctx context.Context, domain string) error {
 	// Clear the domain block cache (for later reload)
 	d.state.Caches.DB.DomainBlock.Clear()
 
+	// Clear results derived from domain permissions.
+	d.clearDomainPermissionResults()
+
 	return nil
 }
 
@@ -421,3 +439,13 @@ func (d *domainDB) AreURIsBlocked(ctx context.Context, uris []*url.URL) (bool, e
 	}
 	return false, nil
 }
+
+// clearDomainPermissionResults clears the caches of results
+// derived from domain permissions (blocks, allows and limits),
+// ie., visibility, mute and status filter results, to be
+// recalculated on demand with the updated permissions.
+func (d *domainDB) clearDomainPermissionResults() {
+	d.state.Caches.Visibility.Clear()
+	d.state.Caches.Mutes.Clear()
+	d.state.Caches.StatusFilter.Clear()
+}
@@ -302,6 +302,9 @@ func() error {
 	// will be reloaded later on demand.
 	d.state.Caches.DB.DomainLimited.Clear()
 
+	// Clear results derived from domain permissions.
+	d.clearDomainPermissionResults()
+
 	return nil
 }
 
@@ -339,6 +342,9 @@ func() error {
 	// will be reloaded later on demand.
 	d.state.Caches.DB.DomainLimited.Clear()
 
+	// Clear results derived from domain permissions.
+	d.clearDomainPermissionResults()
+
 	return nil
 }
 
@@ -371,5 +377,8 @@ func (d *domainDB) DeleteDomainLimit(
 	// will be reloaded later on demand.
 	d.state.Caches.DB.DomainLimited.Clear()
 
+	// Clear results derived from domain permissions.
+	d.clearDomainPermissionResults()
+
 	return nil
 }
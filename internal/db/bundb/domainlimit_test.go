@@ -20,6 +20,7 @@
 import (
 	"testing"
 
+	"code.superseriousbusiness.org/gotosocial/internal/cache"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"github.com/stretchr/testify/suite"
 )
@@ -67,6 +68,70 @@ func (suite *DomainLimitTestSuite) TestCreateMatchDomainLimit() {
 	suite.Equal("", limit.ContentWarning)
 }
 
+func (suite *DomainLimitTestSuite) TestDomainLimitClearsDerivedCaches() {
+	var (
+		ctx       = suite.T().Context()
+		requester = suite.testAccounts["local_account_1"]
+		status    = suite.testStatuses["remote_account_1_status_1"]
+		limit     = &gtsmodel.DomainLimit{
+			ID:                 "01JCZN614XG85GCGAMSV9ZZAEJ",
+			Domain:             "example.org",
+			CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		}
+	)
+
+	// fillCaches stores results derived
+	// from domain limits for the status.
+	fillCaches := func() {
+		suite.state.Caches.Visibility.Put(&cache.CachedVisibility{
+			ItemID:      status.ID,
+			RequesterID: requester.ID,
+			AccountID:   status.AccountID,
+			Type:        cache.VisibilityTypeStatus,
+			Value:       true,
+		})
+		suite.state.Caches.Mutes.Put(&cache.CachedMute{
+			StatusID:    status.ID,
+			ThreadID:    status.ThreadID,
+			RequesterID: requester.ID,
+		})
+		suite.state.Caches.StatusFilter.Put(&cache.CachedStatusFilterResults{
+			StatusID:    status.ID,
+			RequesterID: requester.ID,
+		})
+	}
+
+	// checkCleared checks that all
+	// derived results were cleared.
+	checkCleared := func() {
+		suite.Zero(suite.state.Caches.Visibility.Len())
+		suite.Zero(suite.state.Caches.Mutes.Len())
+		suite.Zero(suite.state.Caches.StatusFilter.Len())
+	}
+
+	// Creating the limit clears results.
+	fillCaches()
+	if err := suite.state.DB.PutDomainLimit(ctx, limit); err != nil {
+		suite.FailNow(err.Error())
+	}
+	checkCleared()
+
+	// Updating the limit clears results.
+	fillCaches()
+	limit.StatusesPolicy = gtsmodel.StatusesPolicyFilterHide
+	if err := suite.state.DB.UpdateDomainLimit(ctx, limit, "statuses_policy"); err != nil {
+		suite.FailNow(err.Error())
+	}
+	checkCleared()
+
+	// Deleting the limit clears results.
+	fillCaches()
+	if err := suite.state.DB.DeleteDomainLimit(ctx, limit.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+	checkCleared()
+}
+
 func TestDomainLimitTestSuite(t *testing.T) {
 	suite.Run(t, new(DomainLimitTestSuite))
 }
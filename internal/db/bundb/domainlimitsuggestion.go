@@ -0,0 +1,245 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/uptrace/bun"
+)
+
+func (d *domainDB) GetDomainLimitSuggestionByID(
+	ctx context.Context,
+	id string,
+) (*gtsmodel.DomainLimitSuggestion, error) {
+	suggestion := new(gtsmodel.DomainLimitSuggestion)
+
+	if err := d.db.
+		NewSelect().
+		Model(suggestion).
+		Where("? = ?", bun.Ident("domain_limit_suggestion.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return suggestion, nil
+}
+
+func (d *domainDB) GetDomainLimitSuggestions(
+	ctx context.Context,
+	permSubID string,
+	domain string,
+	page *paging.Page,
+) (
+	[]*gtsmodel.DomainLimitSuggestion,
+	error,
+) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		suggestions = make([]*gtsmodel.DomainLimitSuggestion, 0, limit)
+	)
+
+	q := d.db.
+		NewSelect().
+		Model(&suggestions)
+
+	// Return only items with id
+	// lower than provided maxID.
+	if maxID != "" {
+		q = q.Where(
+			"? < ?",
+			bun.Ident("domain_limit_suggestion.id"),
+			maxID,
+		)
+	}
+
+	// Return only items with id
+	// greater than provided minID.
+	if minID != "" {
+		q = q.Where(
+			"? > ?",
+			bun.Ident("domain_limit_suggestion.id"),
+			minID,
+		)
+	}
+
+	// Return only items with
+	// given subscription ID.
+	if permSubID != "" {
+		q = q.Where(
+			"? = ?",
+			bun.Ident("domain_limit_suggestion.subscription_id"),
+			permSubID,
+		)
+	}
+
+	// Return only items
+	// with given domain.
+	if domain != "" {
+		var err error
+
+		// Normalize domain as punycode for lookup.
+		domain, err = util.Punify(domain)
+		if err != nil {
+			return nil, gtserror.Newf("error punifying domain %s: %w", domain, err)
+		}
+
+		q = q.Where(
+			"? = ?",
+			bun.Ident("domain_limit_suggestion.domain"),
+			domain,
+		)
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// items returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr(
+			"? ASC",
+			bun.Ident("domain_limit_suggestion.id"),
+		)
+	} else {
+		// Page down.
+		q = q.OrderExpr(
+			"? DESC",
+			bun.Ident("domain_limit_suggestion.id"),
+		)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	// Catch case of no items early
+	if len(suggestions) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want items
+	// to be sorted by ID desc, so reverse slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(suggestions)
+	}
+
+	return suggestions, nil
+}
+
+func (d *domainDB) PutDomainLimitSuggestion(
+	ctx context.Context,
+	suggestion *gtsmodel.DomainLimitSuggestion,
+) error {
+	var err error
+
+	// Normalize the domain as punycode, note the extra
+	// validation step for domain name write operations.
+	suggestion.Domain, err = util.PunifySafely(suggestion.Domain)
+	if err != nil {
+		return gtserror.Newf("error punifying domain %s: %w", suggestion.Domain, err)
+	}
+
+	_, err = d.db.
+		NewInsert().
+		Model(suggestion).
+		Exec(ctx)
+	return err
+}
+
+func (d *domainDB) UpdateDomainLimitSuggestion(
+	ctx context.Context,
+	suggestion *gtsmodel.DomainLimitSuggestion,
+	columns ...string,
+) error {
+	// Update the suggestion's last-updated.
+	suggestion.UpdatedAt = time.Now()
+	if len(columns) != 0 {
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := d.db.
+		NewUpdate().
+		Model(suggestion).
+		Where("? = ?", bun.Ident("domain_limit_suggestion.id"), suggestion.ID).
+		Column(columns...).
+		Exec(ctx)
+	return err
+}
+
+func (d *domainDB) DeleteDomainLimitSuggestion(
+	ctx context.Context,
+	id string,
+) error {
+	_, err := d.db.NewDelete().
+		TableExpr(
+			"? AS ?",
+			bun.Ident("domain_limit_suggestions"),
+			bun.Ident("domain_limit_suggestion"),
+		).
+		Where(
+			"? = ?",
+			bun.Ident("domain_limit_suggestion.id"),
+			id,
+		).
+		Exec(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	return nil
+}
+
+func (d *domainDB) DeleteDomainLimitSuggestionsBySubscriptionID(
+	ctx context.Context,
+	permSubID string,
+) error {
+	_, err := d.db.NewDelete().
+		TableExpr(
+			"? AS ?",
+			bun.Ident("domain_limit_suggestions"),
+			bun.Ident("domain_limit_suggestion"),
+		).
+		Where(
+			"? = ?",
+			bun.Ident("domain_limit_suggestion.subscription_id"),
+			permSubID,
+		).
+		Exec(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"slices"
+
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type draftDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (d *draftDB) GetDraftByID(ctx context.Context, id string) (*gtsmodel.Draft, error) {
+	var draft gtsmodel.Draft
+
+	if err := d.db.
+		NewSelect().
+		Model(&draft).
+		Where("? = ?", bun.Ident("draft.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// Only a barebones model was requested.
+		return &draft, nil
+	}
+
+	if err := d.PopulateDraft(ctx, &draft); err != nil {
+		return nil, err
+	}
+
+	return &draft, nil
+}
+
+func (d *draftDB) GetDraftsForAccount(
+	ctx context.Context,
+	accountID string,
+	page *paging.Page,
+) ([]*gtsmodel.Draft, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		drafts = make([]*gtsmodel.Draft, 0, limit)
+	)
+
+	q := d.db.
+		NewSelect().
+		Model(&drafts).
+		Where("? = ?", bun.Ident("draft.account_id"), accountID)
+
+	// Add paging param max ID.
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("draft.id"), maxID)
+	}
+
+	// Add paging param min ID.
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("draft.id"), minID)
+	}
+
+	// Add paging param order.
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("draft.id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("draft.id"))
+	}
+
+	// Add paging param limit.
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	// Catch case of no items early
+	if len(drafts) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want drafts
+	// to be sorted by ID desc, so reverse slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(drafts)
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return drafts, nil
+	}
+
+	for _, draft := range drafts {
+		if err := d.PopulateDraft(ctx, draft); err != nil {
+			return nil, err
+		}
+	}
+
+	return drafts, nil
+}
+
+func (d *draftDB) PopulateDraft(ctx context.Context, draft *gtsmodel.Draft) error {
+	var (
+		err  error
+		errs = gtserror.NewMultiError(2)
+	)
+
+	if draft.Account == nil {
+		draft.Account, err = d.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			draft.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating draft author account: %w", err)
+		}
+	}
+
+	if !draft.AttachmentsPopulated() {
+		// Draft attachments are out-of-date with IDs, repopulate.
+		draft.MediaAttachments, err = d.state.DB.GetAttachmentsByIDs(
+			gtscontext.SetBarebones(ctx),
+			draft.MediaIDs,
+		)
+		if err != nil {
+			errs.Appendf("error populating draft attachments: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (d *draftDB) PutDraft(ctx context.Context, draft *gtsmodel.Draft) error {
+	return d.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().
+			Model(draft).
+			Exec(ctx); err != nil {
+			return gtserror.Newf("error inserting draft: %w", err)
+		}
+
+		return d.attachMedia(ctx, tx, draft)
+	})
+}
+
+func (d *draftDB) UpdateDraft(ctx context.Context, draft *gtsmodel.Draft, columns ...string) error {
+	if len(columns) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	return d.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewUpdate().
+			Model(draft).
+			Column(columns...).
+			Where("? = ?", bun.Ident("draft.id"), draft.ID).
+			Exec(ctx); err != nil {
+			return gtserror.Newf("error updating draft: %w", err)
+		}
+
+		return d.attachMedia(ctx, tx, draft)
+	})
+}
+
+// attachMedia marks the draft's media attachments as belonging to
+// it, so they're not pruned as unused while the draft still exists.
+func (d *draftDB) attachMedia(ctx context.Context, tx bun.Tx, draft *gtsmodel.Draft) error {
+	for _, a := range draft.MediaAttachments {
+		if a.DraftID == draft.ID {
+			// Already attached.
+			continue
+		}
+
+		a.DraftID = draft.ID
+		if _, err := tx.
+			NewUpdate().
+			Model(a).
+			Column("draft_id").
+			Where("? = ?", bun.Ident("media_attachment.id"), a.ID).
+			Exec(ctx); err != nil {
+			return gtserror.Newf("error updating media: %w", err)
+		}
+
+		// Invalidate cached copy of this media.
+		d.state.Caches.DB.Media.Invalidate("ID", a.ID)
+	}
+
+	return nil
+}
+
+func (d *draftDB) DeleteDraftByID(ctx context.Context, id string) error {
+	_, err := d.db.NewDelete().
+		TableExpr("? AS ?", bun.Ident("drafts"), bun.Ident("draft")).
+		Where("? = ?", bun.Ident("draft.id"), id).
+		Exec(ctx)
+	return err
+}
+
+func (d *draftDB) DeleteDraftsByAccountID(ctx context.Context, accountID string) error {
+	_, err := d.db.NewDelete().
+		TableExpr("? AS ?", bun.Ident("drafts"), bun.Ident("draft")).
+		Where("? = ?", bun.Ident("draft.account_id"), accountID).
+		Exec(ctx)
+	return err
+}
@@ -30,3 +30,7 @@
 func pgConn(ctx context.Context) (*sql.DB, func() schema.Dialect, error) {
 	return nil, nil, errors.New("gotosocial was compiled without postgres support")
 }
+
+func pgReplicaConn(ctx context.Context) (*sql.DB, error) {
+	return nil, errors.New("gotosocial was compiled without postgres support")
+}
@@ -30,3 +30,7 @@
 func sqliteConn(ctx context.Context) (*sql.DB, func() schema.Dialect, error) {
 	return nil, nil, errors.New("gotosocial was compiled without sqlite support")
 }
+
+func RestoreSQLite(ctx context.Context, backup string) (string, error) {
+	return "", errors.New("gotosocial was compiled without sqlite support")
+}
@@ -51,11 +51,45 @@ func pgConn(ctx context.Context) (*sql.DB, func() schema.Dialect, error) {
 		return nil, nil, fmt.Errorf("could not create bundb postgres options: %w", err)
 	}
 
+	sqldb, err := pgOpen(ctx, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log.Info(ctx, "connected to POSTGRES database")
+	return sqldb, func() schema.Dialect { return pgdialect.New() }, nil
+}
+
+// pgReplicaConn opens a connection to the configured read-only
+// postgres replica, returning nil if no replica is configured.
+func pgReplicaConn(ctx context.Context) (*sql.DB, error) {
+	url := config.GetDbPostgresReplicaConnectionString()
+	if url == "" {
+		return nil, nil
+	}
+
+	opts, err := pgx.ParseConfig(url)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse postgres replica connection string: %w", err)
+	}
+
+	sqldb, err := pgOpen(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("replica: %w", err)
+	}
+
+	log.Info(ctx, "connected to POSTGRES read-only replica")
+	return sqldb, nil
+}
+
+// pgOpen opens and pings a new postgres
+// connection pool with the given options.
+func pgOpen(ctx context.Context, opts *pgx.ConnConfig) (*sql.DB, error) {
 	cfg := stdlib.RegisterConnConfig(opts)
 
 	sqldb, err := sql.Open("pgx-gts", cfg)
 	if err != nil {
-		return nil, nil, fmt.Errorf("could not open postgres db: %w", err)
+		return nil, fmt.Errorf("could not open postgres db: %w", err)
 	}
 
 	// Tune db connections for postgres, see:
@@ -67,11 +101,11 @@ func pgConn(ctx context.Context) (*sql.DB, func() schema.Dialect, error) {
 
 	// ping to check the db is there and listening
 	if err := sqldb.PingContext(ctx); err != nil {
-		return nil, nil, fmt.Errorf("postgres ping: %w", err)
+		_ = sqldb.Close()
+		return nil, fmt.Errorf("postgres ping: %w", err)
 	}
 
-	log.Info(ctx, "connected to POSTGRES database")
-	return sqldb, func() schema.Dialect { return pgdialect.New() }, nil
+	return sqldb, nil
 }
 
 // deriveBunDBPGOptions takes an application config and returns either a ready-to-use set of options
@@ -22,8 +22,12 @@
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"net/url"
+	"os"
 	"strings"
 	"time"
 
@@ -80,6 +84,112 @@ func sqliteConn(ctx context.Context) (*sql.DB, func() schema.Dialect, error) {
 	return sqldb, func() schema.Dialect { return sqlitedialect.New() }, nil
 }
 
+// RestoreSQLite replaces the configured SQLite database file with
+// the backup snapshot at given path, after checking its integrity.
+// Any existing database file (and its -wal / -shm files) is moved
+// aside rather than deleted; the returned string is its new path,
+// or empty if there was no existing database. This MUST NOT be
+// called while GoToSocial is running against the database.
+func RestoreSQLite(ctx context.Context, backup string) (string, error) {
+	// Drop anything fancy from DB address,
+	// the same as in buildSQLiteAddress().
+	addr := config.GetDbAddress()
+	addr = strings.Split(addr, "?")[0]
+	addr = strings.TrimPrefix(addr, "file:")
+	if addr == "" || addr == ":memory:" {
+		return "", fmt.Errorf("'%s' must be set to an sqlite database file to restore", config.DbAddressFlag)
+	}
+
+	// Ensure backup is a sound sqlite database
+	// before we go anywhere near the live one.
+	if err := sqliteIntegrityCheck(ctx, backup); err != nil {
+		return "", err
+	}
+
+	// Copy the backup alongside the database first, so the
+	// final swap into place is an atomic rename on one disk.
+	tmp := addr + ".restore"
+	if err := copyFile(backup, tmp); err != nil {
+		return "", fmt.Errorf("error copying backup: %w", err)
+	}
+
+	// Move any existing database files aside, keeping
+	// the -wal and -shm suffixes so that moved database
+	// can still be opened with its write-ahead log intact.
+	var moved string
+	if _, err := os.Stat(addr); err == nil {
+		moved = addr + ".pre-restore-" + time.Now().Format("20060102T150405")
+		for _, suffix := range []string{"", "-wal", "-shm"} {
+			err := os.Rename(addr+suffix, moved+suffix)
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return "", fmt.Errorf("error moving existing database aside: %w", err)
+			}
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("error checking existing database: %w", err)
+	}
+
+	if err := os.Rename(tmp, addr); err != nil {
+		return moved, fmt.Errorf("error moving restored database into place: %w", err)
+	}
+
+	return moved, nil
+}
+
+// sqliteIntegrityCheck opens the SQLite database file
+// at path read-only, and runs an integrity check on it.
+func sqliteIntegrityCheck(ctx context.Context, path string) error {
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("error checking backup file: %w", err)
+	}
+
+	sqldb, err := sql.Open("sqlite-gts", "file:"+path+"?mode=ro")
+	if err != nil {
+		return fmt.Errorf("could not open sqlite backup %s: %w", path, err)
+	}
+	defer sqldb.Close()
+
+	var result string
+	if err := sqldb.QueryRowContext(ctx, "PRAGMA integrity_check").Scan(&result); err != nil {
+		return fmt.Errorf("error checking integrity of sqlite backup %s: %w", path, err)
+	}
+
+	if result != "ok" {
+		return fmt.Errorf("sqlite backup %s failed integrity check: %s", path, result)
+	}
+
+	return nil
+}
+
+// copyFile copies the file at src to a new file at dst,
+// syncing it to disk before returning. Fails if dst exists.
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		_ = os.Remove(dst)
+		return err
+	}
+
+	if err := out.Sync(); err != nil {
+		_ = out.Close()
+		_ = os.Remove(dst)
+		return err
+	}
+
+	return out.Close()
+}
+
 // buildSQLiteAddress will build an SQLite address string from given config input,
 // appending user defined SQLite connection preferences (e.g. cache_size, journal_mode etc).
 // The returned bool indicates whether this is an in-memory address or not.
@@ -42,10 +42,19 @@ type emojiDB struct {
 }
 
 func (e *emojiDB) PutEmoji(ctx context.Context, emoji *gtsmodel.Emoji) error {
-	return e.state.Caches.DB.Emoji.Store(emoji, func() error {
+	if err := e.state.Caches.DB.Emoji.Store(emoji, func() error {
 		_, err := e.db.NewInsert().Model(emoji).Exec(ctx)
 		return err
-	})
+	}); err != nil {
+		return err
+	}
+
+	if emoji.IsLocal() {
+		// Invalidate the useable local emoji IDs.
+		e.state.Caches.DB.UseableEmojiIDs.Store(nil)
+	}
+
+	return nil
 }
 
 func (e *emojiDB) UpdateEmoji(ctx context.Context, emoji *gtsmodel.Emoji, columns ...string) error {
@@ -56,7 +65,7 @@ func (e *emojiDB) UpdateEmoji(ctx context.Context, emoji *gtsmodel.Emoji, column
 	}
 
 	// Update the emoji model in the database.
-	return e.state.Caches.DB.Emoji.Store(emoji, func() error {
+	if err := e.state.Caches.DB.Emoji.Store(emoji, func() error {
 		_, err := e.db.
 			NewUpdate().
 			Model(emoji).
@@ -64,7 +73,16 @@ func (e *emojiDB) UpdateEmoji(ctx context.Context, emoji *gtsmodel.Emoji, column
 			Column(columns...).
 			Exec(ctx)
 		return err
-	})
+	}); err != nil {
+		return err
+	}
+
+	if emoji.IsLocal() {
+		// Invalidate the useable local emoji IDs.
+		e.state.Caches.DB.UseableEmojiIDs.Store(nil)
+	}
+
+	return nil
 }
 
 func (e *emojiDB) DeleteEmojiByID(ctx context.Context, id string) error {
@@ -184,6 +202,10 @@ func(emojiID string) bool {
 	e.state.Caches.DB.Account.InvalidateIDs("ID", accountIDs)
 	e.state.Caches.DB.Status.InvalidateIDs("ID", statusIDs)
 
+	// We don't know whether this emoji was
+	// local, so invalidate useable emoji IDs.
+	e.state.Caches.DB.UseableEmojiIDs.Store(nil)
+
 	return nil
 }
 
@@ -375,6 +397,19 @@ func (e *emojiDB) getEmojisPagedByID(ctx context.Context, query func(*bun.Select
 }
 
 func (e *emojiDB) GetUseableEmojis(ctx context.Context) ([]*gtsmodel.Emoji, error) {
+	emojiIDs, err := e.getUseableEmojiIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.GetEmojisByIDs(ctx, emojiIDs)
+}
+
+func (e *emojiDB) getUseableEmojiIDs(ctx context.Context) ([]string, error) {
+	if p := e.state.Caches.DB.UseableEmojiIDs.Load(); p != nil {
+		return slices.Clone(*p), nil
+	}
+
 	emojiIDs := []string{}
 
 	q := e.db.
@@ -390,7 +425,9 @@ func (e *emojiDB) GetUseableEmojis(ctx context.Context) ([]*gtsmodel.Emoji, erro
 		return nil, err
 	}
 
-	return e.GetEmojisByIDs(ctx, emojiIDs)
+	// Store the scanned emoji IDs in our local cache ptr.
+	e.state.Caches.DB.UseableEmojiIDs.Store(&emojiIDs)
+	return slices.Clone(emojiIDs), nil
 }
 
 func (e *emojiDB) GetEmojiByID(ctx context.Context, id string) (*gtsmodel.Emoji, error) {
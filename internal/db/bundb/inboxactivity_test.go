@@ -0,0 +1,113 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"github.com/stretchr/testify/suite"
+)
+
+type InboxActivityTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *InboxActivityTestSuite) TestPutInboxActivity() {
+	var (
+		ctx       = suite.T().Context()
+		accountID = suite.testAccounts["local_account_1"].ID
+		otherID   = suite.testAccounts["local_account_2"].ID
+		uri       = "http://fossbros-anonymous.io/users/foss_satan/activities/01JBQXVA3TV3SVKE5BKR5RRQY2"
+	)
+
+	exists, err := suite.state.DB.InboxActivityExists(ctx, accountID, uri)
+	suite.NoError(err)
+	suite.False(exists)
+
+	if err := suite.state.DB.PutInboxActivity(ctx, &gtsmodel.InboxActivity{
+		ID:        id.NewULID(),
+		AccountID: accountID,
+		URI:       uri,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	exists, err = suite.state.DB.InboxActivityExists(ctx, accountID, uri)
+	suite.NoError(err)
+	suite.True(exists)
+
+	// Should not exist for another receiving account.
+	exists, err = suite.state.DB.InboxActivityExists(ctx, otherID, uri)
+	suite.NoError(err)
+	suite.False(exists)
+
+	// Storing the same URI for another account is fine.
+	if err := suite.state.DB.PutInboxActivity(ctx, &gtsmodel.InboxActivity{
+		ID:        id.NewULID(),
+		AccountID: otherID,
+		URI:       uri,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Storing the same URI again for same account should conflict.
+	err = suite.state.DB.PutInboxActivity(ctx, &gtsmodel.InboxActivity{
+		ID:        id.NewULID(),
+		AccountID: accountID,
+		URI:       uri,
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+}
+
+func (suite *InboxActivityTestSuite) TestDeleteInboxActivitiesOlderThan() {
+	var (
+		ctx       = suite.T().Context()
+		accountID = suite.testAccounts["local_account_1"].ID
+		oldURI    = "http://fossbros-anonymous.io/users/foss_satan/activities/old"
+		newURI    = "http://fossbros-anonymous.io/users/foss_satan/activities/new"
+	)
+
+	for _, activity := range []*gtsmodel.InboxActivity{
+		{ID: id.NewULIDFromTime(time.Now().Add(-48 * time.Hour)), AccountID: accountID, URI: oldURI},
+		{ID: id.NewULID(), AccountID: accountID, URI: newURI},
+	} {
+		if err := suite.state.DB.PutInboxActivity(ctx, activity); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	count, err := suite.state.DB.DeleteInboxActivitiesOlderThan(ctx, time.Now().Add(-24*time.Hour))
+	suite.NoError(err)
+	suite.Equal(1, count)
+
+	exists, err := suite.state.DB.InboxActivityExists(ctx, accountID, oldURI)
+	suite.NoError(err)
+	suite.False(exists)
+
+	exists, err = suite.state.DB.InboxActivityExists(ctx, accountID, newURI)
+	suite.NoError(err)
+	suite.True(exists)
+}
+
+func TestInboxActivityTestSuite(t *testing.T) {
+	suite.Run(t, new(InboxActivityTestSuite))
+}
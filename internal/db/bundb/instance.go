@@ -165,6 +165,154 @@ func (i *instanceDB) CountInstanceDomains(ctx context.Context, domain string) (i
 	return count, nil
 }
 
+func (i *instanceDB) CountDomainAccounts(ctx context.Context, domain string) (int, error) {
+	q := i.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account"))
+	q = whereDomainOrSubdomain(q, "account.domain", domain)
+	return q.Count(ctx)
+}
+
+func (i *instanceDB) CountDomainStatuses(ctx context.Context, domain string) (int, error) {
+	return i.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Where("? IN (?)", bun.Ident("status.account_id"), i.newSelectDomainAccountIDs(domain)).
+		Count(ctx)
+}
+
+func (i *instanceDB) CountDomainMedia(ctx context.Context, domain string) (int, int, error) {
+	var count, size int
+
+	if err := i.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("media_attachments"), bun.Ident("media_attachment")).
+		ColumnExpr("COUNT(*)").
+		ColumnExpr("COALESCE(SUM(? + ?), 0)",
+			bun.Ident("media_attachment.file_file_size"),
+			bun.Ident("media_attachment.thumbnail_file_size"),
+		).
+		Where("? IN (?)", bun.Ident("media_attachment.account_id"), i.newSelectDomainAccountIDs(domain)).
+		// Only count locally cached media.
+		Where("? != ?", bun.Ident("media_attachment.file_path"), "").
+		Scan(ctx, &count, &size); err != nil {
+		return 0, 0, err
+	}
+
+	return count, size, nil
+}
+
+func (i *instanceDB) CountDomainFollows(ctx context.Context, domain string) (int, int, error) {
+	// countFollowing counts distinct accounts
+	// selected by from that follow any account
+	// selected by to.
+	countFollowing := func(from, to *bun.SelectQuery) (int, error) {
+		var count int
+		err := i.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("follows"), bun.Ident("follow")).
+			ColumnExpr("COUNT(DISTINCT ?)", bun.Ident("follow.account_id")).
+			Where("? IN (?)", bun.Ident("follow.account_id"), from).
+			Where("? IN (?)", bun.Ident("follow.target_account_id"), to).
+			Scan(ctx, &count)
+		return count, err
+	}
+
+	localFollowers, err := countFollowing(
+		i.newSelectLocalAccountIDs(),
+		i.newSelectDomainAccountIDs(domain),
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	remoteFollowers, err := countFollowing(
+		i.newSelectDomainAccountIDs(domain),
+		i.newSelectLocalAccountIDs(),
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return localFollowers, remoteFollowers, nil
+}
+
+func (i *instanceDB) CountDomainInteractions(ctx context.Context, domain string, since time.Time) (int, int, error) {
+	// IDs are ULIDs, so we can use the (indexed)
+	// IDs to select interactions by creation time.
+	sinceID := id.ZeroULIDForTime(since)
+
+	// countInteractions counts faves, boosts and
+	// replies by accounts selected by from, of
+	// statuses by accounts selected by to.
+	countInteractions := func(from, to func() *bun.SelectQuery) (int, error) {
+		faves, err := i.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("status_faves"), bun.Ident("status_fave")).
+			Where("? >= ?", bun.Ident("status_fave.id"), sinceID).
+			Where("? IN (?)", bun.Ident("status_fave.account_id"), from()).
+			Where("? IN (?)", bun.Ident("status_fave.target_account_id"), to()).
+			Count(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		statuses, err := i.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+			Where("? >= ?", bun.Ident("status.id"), sinceID).
+			Where("? IN (?)", bun.Ident("status.account_id"), from()).
+			WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+				return q.
+					Where("? IN (?)", bun.Ident("status.boost_of_account_id"), to()).
+					WhereOr("? IN (?)", bun.Ident("status.in_reply_to_account_id"), to())
+			}).
+			Count(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		return faves + statuses, nil
+	}
+
+	local := i.newSelectLocalAccountIDs
+	remote := func() *bun.SelectQuery {
+		return i.newSelectDomainAccountIDs(domain)
+	}
+
+	fromLocal, err := countInteractions(local, remote)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	toLocal, err := countInteractions(remote, local)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return fromLocal, toLocal, nil
+}
+
+// newSelectLocalAccountIDs returns a new
+// select query for the IDs of local accounts.
+func (i *instanceDB) newSelectLocalAccountIDs() *bun.SelectQuery {
+	return i.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("local_account")).
+		Column("local_account.id").
+		Where("? IS NULL", bun.Ident("local_account.domain"))
+}
+
+// newSelectDomainAccountIDs returns a new select query for
+// the IDs of accounts on the given domain, or its subdomains.
+func (i *instanceDB) newSelectDomainAccountIDs(domain string) *bun.SelectQuery {
+	q := i.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("domain_account")).
+		Column("domain_account.id")
+	return whereDomainOrSubdomain(q, "domain_account.domain", domain)
+}
+
 func (i *instanceDB) GetInstance(ctx context.Context, domain string) (*gtsmodel.Instance, error) {
 	var err error
 
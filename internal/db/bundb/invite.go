@@ -0,0 +1,197 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type inviteDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (i *inviteDB) GetInviteByID(ctx context.Context, id string) (*gtsmodel.Invite, error) {
+	invite := new(gtsmodel.Invite)
+	if err := i.db.NewSelect().
+		Model(invite).
+		Where("? = ?", bun.Ident("id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return invite, nil
+}
+
+func (i *inviteDB) GetInviteByCode(ctx context.Context, code string) (*gtsmodel.Invite, error) {
+	invite := new(gtsmodel.Invite)
+	if err := i.db.NewSelect().
+		Model(invite).
+		Where("? = ?", bun.Ident("code"), code).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return invite, nil
+}
+
+func (i *inviteDB) GetInvites(
+	ctx context.Context,
+	createdByAccountID string,
+	page *paging.Page,
+) ([]*gtsmodel.Invite, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size.
+		invites = make([]*gtsmodel.Invite, 0, limit)
+	)
+
+	q := i.db.
+		NewSelect().
+		Model(&invites)
+
+	if createdByAccountID != "" {
+		// Return only invites created by given account.
+		q = q.Where("? = ?", bun.Ident("created_by_account_id"), createdByAccountID)
+	}
+
+	if maxID != "" {
+		// Return only invites LOWER (ie., older) than maxID.
+		q = q.Where("? < ?", bun.Ident("id"), maxID)
+	}
+
+	if minID != "" {
+		// Return only invites HIGHER (ie., newer) than minID.
+		q = q.Where("? > ?", bun.Ident("id"), minID)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("id"))
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	// If we're paging up, we still want invites
+	// to be sorted by ID desc (ie., newest to
+	// oldest), so reverse the slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(invites)
+	}
+
+	return invites, nil
+}
+
+func (i *inviteDB) PutInvite(ctx context.Context, invite *gtsmodel.Invite) error {
+	_, err := i.db.NewInsert().
+		Model(invite).
+		Exec(ctx)
+	return err
+}
+
+func (i *inviteDB) UpdateInvite(ctx context.Context, invite *gtsmodel.Invite, cols ...string) error {
+	invite.UpdatedAt = time.Now()
+	if len(cols) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		cols = append(cols, "updated_at")
+	}
+	_, err := i.db.NewUpdate().
+		Model(invite).
+		Column(cols...).
+		Where("? = ?", bun.Ident("id"), invite.ID).
+		Exec(ctx)
+	return err
+}
+
+func (i *inviteDB) ConsumeInvite(ctx context.Context, id string) error {
+	now := time.Now()
+
+	// Increment uses only if the invite is
+	// still usable at the time of the update,
+	// so that concurrent sign ups can't push
+	// an invite beyond its max uses.
+	res, err := i.db.NewUpdate().
+		Table("invites").
+		Set("? = ? + 1", bun.Ident("uses"), bun.Ident("uses")).
+		Set("? = ?", bun.Ident("updated_at"), now).
+		Where("? = ?", bun.Ident("id"), id).
+		WhereGroup(" AND ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+			return q.
+				Where("? IS NULL", bun.Ident("expires_at")).
+				WhereOr("? > ?", bun.Ident("expires_at"), now)
+		}).
+		WhereGroup(" AND ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+			return q.
+				Where("? IS NULL", bun.Ident("max_uses")).
+				WhereOr("? < ?", bun.Ident("uses"), bun.Ident("max_uses"))
+		}).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		// Invite doesn't exist,
+		// or is no longer usable.
+		return db.ErrNoEntries
+	}
+
+	return nil
+}
+
+func (i *inviteDB) ExpireInvitesByAccountID(ctx context.Context, accountID string) error {
+	now := time.Now()
+	_, err := i.db.NewUpdate().
+		Table("invites").
+		Set("? = ?", bun.Ident("expires_at"), now).
+		Set("? = ?", bun.Ident("updated_at"), now).
+		Where("? = ?", bun.Ident("created_by_account_id"), accountID).
+		WhereGroup(" AND ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+			return q.
+				Where("? IS NULL", bun.Ident("expires_at")).
+				WhereOr("? > ?", bun.Ident("expires_at"), now)
+		}).
+		Exec(ctx)
+	return err
+}
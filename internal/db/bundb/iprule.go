@@ -0,0 +1,110 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"net/netip"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type ipRuleDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (i *ipRuleDB) MatchIPRules(ctx context.Context, ip netip.Addr) ([]*gtsmodel.IPRule, error) {
+	return i.state.Caches.IPRules.Match(ip, func() ([]*gtsmodel.IPRule, error) {
+		return i.GetIPRules(ctx)
+	})
+}
+
+func (i *ipRuleDB) GetIPRuleByID(ctx context.Context, id string) (*gtsmodel.IPRule, error) {
+	rule := new(gtsmodel.IPRule)
+	if err := i.db.NewSelect().
+		Model(rule).
+		Where("? = ?", bun.Ident("id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (i *ipRuleDB) GetIPRules(ctx context.Context) ([]*gtsmodel.IPRule, error) {
+	var rules []*gtsmodel.IPRule
+	err := i.db.NewSelect().
+		Model(&rules).
+		OrderExpr("? DESC", bun.Ident("id")).
+		Scan(ctx)
+	return rules, err
+}
+
+func (i *ipRuleDB) PutIPRule(ctx context.Context, rule *gtsmodel.IPRule) error {
+	if _, err := i.db.NewInsert().
+		Model(rule).
+		Exec(ctx); err != nil {
+		return err
+	}
+	i.state.Caches.IPRules.Clear()
+	return nil
+}
+
+func (i *ipRuleDB) UpdateIPRule(ctx context.Context, rule *gtsmodel.IPRule, cols ...string) error {
+	rule.UpdatedAt = time.Now()
+	if len(cols) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		cols = append(cols, "updated_at")
+	}
+	if _, err := i.db.NewUpdate().
+		Model(rule).
+		Column(cols...).
+		Where("? = ?", bun.Ident("id"), rule.ID).
+		Exec(ctx); err != nil {
+		return err
+	}
+	i.state.Caches.IPRules.Clear()
+	return nil
+}
+
+func (i *ipRuleDB) IncrementIPRuleHits(ctx context.Context, id string) error {
+	// Hit counts are not used for matching,
+	// so there's no need to clear the cache.
+	_, err := i.db.NewUpdate().
+		Table("ip_rules").
+		Set("? = ? + 1", bun.Ident("hits"), bun.Ident("hits")).
+		Set("? = ?", bun.Ident("last_hit_at"), time.Now()).
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return err
+}
+
+func (i *ipRuleDB) DeleteIPRuleByID(ctx context.Context, id string) error {
+	if _, err := i.db.NewDelete().
+		Table("ip_rules").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx); err != nil {
+		return err
+	}
+	i.state.Caches.IPRules.Clear()
+	return nil
+}
@@ -0,0 +1,83 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type landingBlockDB struct {
+	db *bun.DB
+}
+
+func (l *landingBlockDB) GetLandingBlockByID(ctx context.Context, id string) (*gtsmodel.LandingBlock, error) {
+	block := new(gtsmodel.LandingBlock)
+	if err := l.db.NewSelect().
+		Model(block).
+		Where("? = ?", bun.Ident("id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+func (l *landingBlockDB) GetLandingBlocks(ctx context.Context) ([]*gtsmodel.LandingBlock, error) {
+	var blocks []*gtsmodel.LandingBlock
+	err := l.db.NewSelect().
+		Model(&blocks).
+		// Blocks with the same position
+		// are shown in creation order.
+		OrderExpr("? ASC", bun.Ident("position")).
+		OrderExpr("? ASC", bun.Ident("id")).
+		Scan(ctx)
+	return blocks, err
+}
+
+func (l *landingBlockDB) PutLandingBlock(ctx context.Context, block *gtsmodel.LandingBlock) error {
+	_, err := l.db.NewInsert().
+		Model(block).
+		Exec(ctx)
+	return err
+}
+
+func (l *landingBlockDB) UpdateLandingBlock(ctx context.Context, block *gtsmodel.LandingBlock, cols ...string) error {
+	block.UpdatedAt = time.Now()
+	if len(cols) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		cols = append(cols, "updated_at")
+	}
+	_, err := l.db.NewUpdate().
+		Model(block).
+		Column(cols...).
+		Where("? = ?", bun.Ident("id"), block.ID).
+		Exec(ctx)
+	return err
+}
+
+func (l *landingBlockDB) DeleteLandingBlockByID(ctx context.Context, id string) error {
+	_, err := l.db.NewDelete().
+		Table("landing_blocks").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return err
+}
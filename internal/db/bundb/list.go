@@ -370,6 +370,58 @@ func (l *listDB) PopulateListEntry(ctx context.Context, listEntry *gtsmodel.List
 	return nil
 }
 
+func (l *listDB) GetListEntry(ctx context.Context, listID string, followID string) (*gtsmodel.ListEntry, error) {
+	var listEntry gtsmodel.ListEntry
+
+	if err := l.db.NewSelect().
+		Model(&listEntry).
+		Where("? = ?", bun.Ident("list_id"), listID).
+		Where("? = ?", bun.Ident("follow_id"), followID).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return &listEntry, nil
+}
+
+func (l *listDB) GetListEntries(ctx context.Context, listID string) ([]*gtsmodel.ListEntry, error) {
+	var listEntries []*gtsmodel.ListEntry
+
+	if err := l.db.NewSelect().
+		Model(&listEntries).
+		Where("? = ?", bun.Ident("list_id"), listID).
+		OrderExpr("? DESC", bun.Ident("created_at")).
+		Scan(ctx); err != nil &&
+		!errors.Is(err, db.ErrNoEntries) {
+		return nil, err
+	}
+
+	return listEntries, nil
+}
+
+func (l *listDB) UpdateListEntry(ctx context.Context, listEntry *gtsmodel.ListEntry, columns ...string) error {
+	listEntry.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	if _, err := l.db.NewUpdate().
+		Model(listEntry).
+		Where("? = ?", bun.Ident("list_entry.id"), listEntry.ID).
+		Column(columns...).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// Entry overrides affect which statuses
+	// are shown, so invalidate list timeline.
+	l.state.Caches.Timelines.List.Clear(listEntry.ListID)
+
+	return nil
+}
+
 func (l *listDB) PutListEntries(ctx context.Context, entries []*gtsmodel.ListEntry) error {
 	// Insert all entries into the database in a single transaction (all or nothing!).
 	if err := l.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
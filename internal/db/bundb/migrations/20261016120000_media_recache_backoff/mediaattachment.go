@@ -0,0 +1,29 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// MediaAttachment is a minimal copy of the
+// media attachment model, containing only
+// the new recache backoff columns to be added.
+type MediaAttachment struct {
+	RecacheFailures int           `bun:",notnull,default:0"`        // Number of consecutive failed attempts to (re)cache remote media.
+	RecacheFailedAt time.Time     `bun:"type:timestamptz,nullzero"` // When did the last attempt to (re)cache remote media fail.
+	RecacheBackoff  time.Duration `bun:",nullzero"`                 // How long after RecacheFailedAt to wait before attempting (re)cache again.
+}
@@ -0,0 +1,34 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+// AccountSettings is a minimal copy of the
+// account settings model, containing only
+// the new follow request rules column to be added.
+type AccountSettings struct {
+	FollowRequestRules *FollowRequestRules `bun:""` // Rules for automatically handling incoming follow requests. If null, assume no rules.
+}
+
+// FollowRequestRules is a copy of the
+// follow request rules model at time
+// of the migration, stored as JSON.
+type FollowRequestRules struct {
+	ApproveFollowersOfFollowers bool     `json:"approve_followers_of_followers,omitempty"`
+	ApproveDomains              []string `json:"approve_domains,omitempty"`
+	RejectLimited               bool     `json:"reject_limited,omitempty"`
+}
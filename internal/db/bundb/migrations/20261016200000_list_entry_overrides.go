@@ -0,0 +1,74 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	gtsmodel "code.superseriousbusiness.org/gotosocial/internal/db/bundb/migrations/20261016200000_list_entry_overrides"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+
+			for _, col := range []struct {
+				name  string
+				field string
+			}{
+				// Per-entry show reblogs column. This
+				// defaults to true, so existing list
+				// entries see no change.
+				{name: "show_reblogs", field: "ShowReblogs"},
+
+				// Per-entry replies policy override
+				// column. This is nullable, where null
+				// means the list's own policy applies.
+				{name: "replies_policy", field: "RepliesPolicy"},
+			} {
+				// The list entries table may have been created
+				// from the current model, check if it exists.
+				if exists, err := doesColumnExist(ctx, tx, "list_entries", col.name); err != nil {
+					return err
+				} else if exists {
+					continue
+				}
+
+				if err := addColumn(ctx, tx,
+					(*gtsmodel.ListEntry)(nil),
+					col.field,
+				); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
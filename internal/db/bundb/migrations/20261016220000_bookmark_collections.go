@@ -0,0 +1,82 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	newmodel "code.superseriousbusiness.org/gotosocial/internal/db/bundb/migrations/20261016220000_bookmark_collections"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+
+			// Create the bookmark_collections table.
+			if _, err := tx.
+				NewCreateTable().
+				Model((*newmodel.BookmarkCollection)(nil)).
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			// Index collections by owning account ID.
+			if err := createIndex(ctx, tx,
+				"bookmark_collections_account_id_idx",
+				"bookmark_collections",
+				"?", bun.Ident("account_id"),
+			); err != nil {
+				return err
+			}
+
+			// Add nullable collection ID column to
+			// status bookmarks, where null means
+			// the bookmark is in no collection.
+			if err := addColumn(ctx, tx,
+				(*newmodel.StatusBookmark)(nil),
+				"CollectionID",
+			); err != nil {
+				return err
+			}
+
+			// Index bookmarks by collection ID,
+			// for paging through a collection.
+			if err := createIndex(ctx, tx,
+				"status_bookmarks_collection_id_idx",
+				"status_bookmarks",
+				"?", bun.Ident("collection_id"),
+			); err != nil {
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
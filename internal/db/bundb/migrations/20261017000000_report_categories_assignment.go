@@ -0,0 +1,112 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	newmodel "code.superseriousbusiness.org/gotosocial/internal/db/bundb/migrations/20261017000000_report_categories_assignment"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+
+			for _, col := range []struct {
+				name  string
+				field string
+			}{
+				// Report category column, where
+				// existing reports default to "other".
+				{name: "category", field: "Category"},
+
+				// Nullable assigned account ID
+				// column, where null means unassigned.
+				{name: "assigned_account_id", field: "AssignedAccountID"},
+			} {
+				// The reports table may have been created
+				// from the current model, check if it exists.
+				if exists, err := doesColumnExist(ctx, tx, "reports", col.name); err != nil {
+					return err
+				} else if exists {
+					continue
+				}
+
+				if err := addColumn(ctx, tx,
+					(*newmodel.Report)(nil),
+					col.field,
+				); err != nil {
+					return err
+				}
+			}
+
+			// Assign already resolved reports to the
+			// account that resolved them, so they don't
+			// show up as unassigned after migrating.
+			if _, err := tx.NewUpdate().
+				Table("reports").
+				Where("? IS NULL", bun.Ident("assigned_account_id")).
+				Where("? IS NOT NULL", bun.Ident("action_taken_by_account_id")).
+				Set("? = ?", bun.Ident("assigned_account_id"), bun.Ident("action_taken_by_account_id")).
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			// Index reports by assigned account ID,
+			// for filtering reports by assignee.
+			if err := createIndex(ctx, tx,
+				"reports_assigned_account_id_idx",
+				"reports",
+				"?", bun.Ident("assigned_account_id"),
+			); err != nil {
+				return err
+			}
+
+			// Create the report_notes table.
+			if _, err := tx.
+				NewCreateTable().
+				Model((*newmodel.ReportNote)(nil)).
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			// Index notes by report ID.
+			if err := createIndex(ctx, tx,
+				"report_notes_report_id_idx",
+				"report_notes",
+				"?", bun.Ident("report_id"),
+			); err != nil {
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
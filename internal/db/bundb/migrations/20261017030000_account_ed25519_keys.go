@@ -0,0 +1,97 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	gtsmodel "code.superseriousbusiness.org/gotosocial/internal/db/bundb/migrations/20261017030000_account_ed25519_keys"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+
+			// Add new Ed25519 key columns to accounts table.
+			for _, field := range []string{
+				"Ed25519PrivateKey",
+				"Ed25519PublicKey",
+				"Ed25519PublicKeyURI",
+			} {
+				if err := addColumn(ctx, tx,
+					(*gtsmodel.Account)(nil),
+					field,
+				); err != nil {
+					return err
+				}
+			}
+
+			// Select all local accounts,
+			// which need keys generating.
+			var accounts []*gtsmodel.Account
+			if err := tx.NewSelect().
+				Model(&accounts).
+				Column("id", "uri").
+				Where("? IS NULL", bun.Ident("domain")).
+				Scan(ctx); err != nil {
+				return err
+			}
+
+			log.Infof(ctx, "generating ed25519 keys for %d local accounts", len(accounts))
+
+			for _, account := range accounts {
+				pub, priv, err := ed25519.GenerateKey(rand.Reader)
+				if err != nil {
+					return err
+				}
+
+				account.Ed25519PrivateKey = priv
+				account.Ed25519PublicKey = pub
+				account.Ed25519PublicKeyURI = account.URI + "#ed25519-key"
+
+				if _, err := tx.NewUpdate().
+					Model(account).
+					Column(
+						"ed25519_private_key",
+						"ed25519_public_key",
+						"ed25519_public_key_uri",
+					).
+					Where("? = ?", bun.Ident("id"), account.ID).
+					Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,32 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "crypto/ed25519"
+
+// Account is a minimal copy of the account
+// model, containing only the columns needed
+// to add and populate the new Ed25519 keys.
+type Account struct {
+	ID                  string             `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`
+	Domain              string             `bun:",nullzero"`
+	URI                 string             `bun:",nullzero,notnull"`
+	Ed25519PrivateKey   ed25519.PrivateKey `bun:",nullzero"`
+	Ed25519PublicKey    ed25519.PublicKey  `bun:",nullzero"`
+	Ed25519PublicKeyURI string             `bun:",nullzero"`
+}
@@ -0,0 +1,33 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// WebfingerAlias represents an admin-managed mapping of
+// an additional webfinger name (username@domain) to the
+// canonical local account it should resolve to, such as
+// a name on a short vanity domain.
+type WebfingerAlias struct {
+	ID        string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                        // id of this item in the database
+	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`     // when was item created
+	Username  string    `bun:",nullzero,notnull,unique:webfinger_aliases_username_domain_uniq"` // Username part of the alias, lowercase.
+	Domain    string    `bun:",nullzero,notnull,unique:webfinger_aliases_username_domain_uniq"` // Domain part of the alias, lowercase.
+	AccountID string    `bun:"type:CHAR(26),nullzero,notnull"`                                  // ID of the local account this alias resolves to.
+	AuthorID  string    `bun:"type:CHAR(26),nullzero,notnull"`                                  // Account ID of the creator of this alias.
+}
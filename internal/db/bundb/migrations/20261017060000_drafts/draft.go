@@ -0,0 +1,53 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Draft is a copy of the draft
+// model as it was when introduced.
+type Draft struct {
+	ID          string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`
+	CreatedAt   time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt   time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`
+	AccountID   string    `bun:"type:CHAR(26),nullzero,notnull"`
+	Text        string    `bun:""`
+	SpoilerText string    `bun:""`
+	Poll        DraftPoll `bun:",embed:poll_,notnull,nullzero"`
+	MediaIDs    []string  `bun:"attachments,array"`
+	InReplyToID string    `bun:"type:CHAR(26),nullzero"`
+	Sensitive   *bool     `bun:",nullzero,notnull,default:false"`
+	Visibility  int16     `bun:",nullzero"`
+	Language    string    `bun:",nullzero"`
+	LocalOnly   *bool     `bun:",nullzero,notnull,default:false"`
+	ContentType string    `bun:",nullzero"`
+}
+
+type DraftPoll struct {
+	Options    []string `bun:",nullzero,array"`
+	ExpiresIn  int      `bun:",nullzero"`
+	Multiple   *bool    `bun:",nullzero,notnull,default:false"`
+	HideTotals *bool    `bun:",nullzero,notnull,default:false"`
+}
+
+// MediaAttachment is a minimal copy of
+// the media attachment model, containing
+// only the new column linking to a draft.
+type MediaAttachment struct {
+	DraftID string `bun:"type:CHAR(26),nullzero"`
+}
@@ -0,0 +1,78 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	gtsmodel "code.superseriousbusiness.org/gotosocial/internal/db/bundb/migrations/20261017070000_status_expiry"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+
+			// Add new expires_at column to statuses
+			// table. This is nullable, and null means
+			// the status never expires, so existing
+			// statuses see no change.
+			if exists, err := doesColumnExist(ctx, tx,
+				"statuses", "expires_at",
+			); err != nil {
+				return err
+			} else if !exists {
+				if err := addColumn(ctx, tx,
+					(*gtsmodel.Status)(nil),
+					"ExpiresAt",
+				); err != nil {
+					return err
+				}
+			}
+
+			log.Info(ctx, "creating \"statuses_expires_at_idx\", this may take a minute...")
+
+			// CREATE INDEX IF NOT EXISTS "statuses_expires_at_idx"
+			// ON "statuses" ("expires_at")
+			// WHERE ("expires_at" IS NOT NULL)
+			//
+			// Used by the cleaner when selecting
+			// expired statuses due to be deleted.
+			_, err := tx.NewCreateIndex().
+				Table("statuses").
+				Index("statuses_expires_at_idx").
+				Column("expires_at").
+				Where("? IS NOT NULL", bun.Ident("expires_at")).
+				IfNotExists().
+				Exec(ctx)
+
+			return err
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,29 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Instance is a minimal copy of the
+// instance model, containing only the
+// new reachability columns to be added.
+type Instance struct {
+	RequestFailures int       `bun:",notnull,default:0"`        // Number of consecutive failed requests to this instance, as of when it was last marked unreachable.
+	UnreachableAt   time.Time `bun:"type:timestamptz,nullzero"` // When was this instance marked as unreachable, if at all?
+	NextProbeAt     time.Time `bun:"type:timestamptz,nullzero"` // When may deliveries next be attempted to this instance, if unreachable?
+}
@@ -0,0 +1,80 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	gtsmodel "code.superseriousbusiness.org/gotosocial/internal/db/bundb/migrations/20261017180000_account_field_ids"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+
+			// Select all local accounts with
+			// fields, which need IDs generating.
+			var accounts []*gtsmodel.Account
+			if err := tx.NewSelect().
+				Model(&accounts).
+				Column("id", "fields", "fields_raw").
+				Where("? IS NULL", bun.Ident("domain")).
+				Where("? IS NOT NULL", bun.Ident("fields_raw")).
+				Scan(ctx); err != nil {
+				return err
+			}
+
+			log.Infof(ctx, "generating profile field ids for %d local accounts", len(accounts))
+
+			for _, account := range accounts {
+				for i, fieldRaw := range account.FieldsRaw {
+					fieldRaw.ID = id.NewULID()
+
+					// Processed fields are
+					// in the same order as raw.
+					if i < len(account.Fields) {
+						account.Fields[i].ID = fieldRaw.ID
+					}
+				}
+
+				if _, err := tx.NewUpdate().
+					Model(account).
+					Column("fields", "fields_raw").
+					Where("? = ?", bun.Ident("id"), account.ID).
+					Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,27 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+// Status is a minimal copy of the
+// status model, containing only the
+// new interaction count columns to be added.
+type Status struct {
+	RepliesCount *int `bun:",nullzero"` // Denormalized number of stored direct replies to this status. Null if not yet counted.
+	BoostsCount  *int `bun:",nullzero"` // Denormalized number of stored boosts of this status. Null if not yet counted.
+	FavesCount   *int `bun:",nullzero"` // Denormalized number of stored faves of this status. Null if not yet counted.
+}
@@ -0,0 +1,76 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	gtsmodel "code.superseriousbusiness.org/gotosocial/internal/db/bundb/migrations/20261017230000_notification_group_keys"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+
+			// The notifications table may have been
+			// created from the current model, check first.
+			exists, err := doesColumnExist(ctx, tx,
+				"notifications", "group_key",
+			)
+			if err != nil {
+				return err
+			}
+
+			if !exists {
+				// Add new group key column to notifications
+				// table. This is left null for existing
+				// notifications, which are then returned
+				// ungrouped from the grouped notifications API.
+				if err := addColumn(ctx, tx,
+					(*gtsmodel.Notification)(nil),
+					"GroupKey",
+				); err != nil {
+					return err
+				}
+			}
+
+			// CREATE INDEX IF NOT EXISTS "notifications_target_account_id_group_key_idx"
+			// ON "notifications" ("target_account_id", "group_key")
+			//
+			// Used when looking up existing notification groups.
+			_, err = tx.NewCreateIndex().
+				Table("notifications").
+				Index("notifications_target_account_id_group_key_idx").
+				Column("target_account_id", "group_key").
+				IfNotExists().
+				Exec(ctx)
+			return err
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,240 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"code.superseriousbusiness.org/gotosocial/internal/db/bundb/migrations"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+	"github.com/uptrace/bun/migrate"
+)
+
+// MigrationPlan describes the SQL a pending
+// migration would run, as simulated by MigrationsDryRun.
+type MigrationPlan struct {
+	// Migration is the pending migration.
+	Migration migrate.Migration
+
+	// Queries are the non-SELECT statements
+	// the migration issued during simulation.
+	Queries []string
+
+	// Tables are the (estimated) names of
+	// tables touched by those statements.
+	Tables []string
+
+	// Err is any error returned by the migration during
+	// simulation. Simulated reads return no rows, so any
+	// migration that depends on existing data may fail
+	// here, or issue fewer queries than it really would.
+	Err error
+}
+
+// MigrationStatuses returns all known database migrations in
+// ascending order, marking those already applied to the database.
+// This does not run any migrations, or otherwise modify the database.
+func MigrationStatuses(ctx context.Context) (migrate.MigrationSlice, error) {
+	sqldb, dialect, err := dbConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer sqldb.Close()
+
+	return migrationStatuses(ctx, bun.NewDB(sqldb, dialect()))
+}
+
+// MigrationsDryRun simulates each pending database migration
+// against a connection that records, but does not execute,
+// the SQL statements issued, returning a plan per migration.
+// This does not run any migrations, or otherwise modify the database.
+func MigrationsDryRun(ctx context.Context) ([]MigrationPlan, error) {
+	sqldb, dialect, err := dbConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer sqldb.Close()
+
+	statuses, err := migrationStatuses(ctx, bun.NewDB(sqldb, dialect()))
+	if err != nil {
+		return nil, err
+	}
+
+	var plans []MigrationPlan
+	for _, migration := range statuses {
+		if migration.IsApplied() {
+			continue
+		}
+
+		// Run the migration against a
+		// recording-only connection pool.
+		rec := new(dryRunConnector)
+		dryDB := bunDB(sql.OpenDB(rec), dialect)
+		migrator := migrate.NewMigrator(dryDB, migrations.Migrations)
+		err := migration.Up(ctx, migrator, &migration)
+		_ = dryDB.Close()
+
+		plans = append(plans, MigrationPlan{
+			Migration: migration,
+			Queries:   rec.queries,
+			Tables:    affectedTables(rec.queries),
+			Err:       err,
+		})
+	}
+
+	return plans, nil
+}
+
+// migrationStatuses returns all known migrations with applied status,
+// without initializing migration tables if they don't already exist.
+func migrationStatuses(ctx context.Context, db *bun.DB) (migrate.MigrationSlice, error) {
+	var query string
+	switch d := db.Dialect().Name(); d {
+	case dialect.PG:
+		query = "SELECT to_regclass('bun_migrations') IS NOT NULL"
+	case dialect.SQLite:
+		query = "SELECT COUNT(*) > 0 FROM sqlite_master WHERE type = 'table' AND name = 'bun_migrations'"
+	default:
+		return nil, gtserror.Newf("unsupported dialect %s", d)
+	}
+
+	var exists bool
+	if err := db.QueryRowContext(ctx, query).Scan(&exists); err != nil {
+		return nil, gtserror.Newf("error checking for migrations table: %w", err)
+	}
+
+	if !exists {
+		// Fresh database,
+		// nothing applied.
+		return migrations.Migrations.Sorted(), nil
+	}
+
+	migrator := migrate.NewMigrator(db, migrations.Migrations)
+	statuses, err := migrator.MigrationsWithStatus(ctx)
+	if err != nil {
+		return nil, gtserror.Newf("error getting migration statuses: %w", err)
+	}
+
+	return statuses, nil
+}
+
+// tableRegexp matches the table name
+// following common DDL / DML keywords.
+var tableRegexp = regexp.MustCompile(`(?i)\b(?:` +
+	`CREATE\s+(?:UNIQUE\s+)?INDEX\s+(?:CONCURRENTLY\s+)?(?:IF\s+NOT\s+EXISTS\s+)?\S+\s+ON|` +
+	`CREATE\s+TABLE(?:\s+IF\s+NOT\s+EXISTS)?|` +
+	`ALTER\s+TABLE(?:\s+IF\s+EXISTS)?|` +
+	`DROP\s+TABLE(?:\s+IF\s+EXISTS)?|` +
+	`INSERT\s+INTO|UPDATE|DELETE\s+FROM` +
+	`)\s+("?[\w.]+"?)`)
+
+// affectedTables estimates the names
+// of tables touched by given queries.
+func affectedTables(queries []string) []string {
+	var tables []string
+	for _, query := range queries {
+		for _, match := range tableRegexp.FindAllStringSubmatch(query, -1) {
+			table := strings.Trim(match[1], `"`)
+			if !slices.Contains(tables, table) {
+				tables = append(tables, table)
+			}
+		}
+	}
+	slices.Sort(tables)
+	return tables
+}
+
+// dryRunConnector implements driver.Connector
+// (and driver.Driver) to provide connections
+// that record non-SELECT queries without ever
+// executing them. All reads return zero rows.
+type dryRunConnector struct {
+	queries []string
+	mu      sync.Mutex
+}
+
+func (c *dryRunConnector) Connect(context.Context) (driver.Conn, error) {
+	return &dryRunConn{c}, nil
+}
+
+func (c *dryRunConnector) Driver() driver.Driver {
+	return c
+}
+
+func (c *dryRunConnector) Open(string) (driver.Conn, error) {
+	return &dryRunConn{c}, nil
+}
+
+func (c *dryRunConnector) record(query string) {
+	query = strings.TrimSpace(query)
+	if len(query) >= 6 && strings.EqualFold(query[:6], "SELECT") {
+		return
+	}
+	c.mu.Lock()
+	c.queries = append(c.queries, query)
+	c.mu.Unlock()
+}
+
+type dryRunConn struct{ c *dryRunConnector }
+
+func (c *dryRunConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, gtserror.New("prepared statements not supported in dry run")
+}
+
+func (c *dryRunConn) Close() error { return nil }
+
+func (c *dryRunConn) Begin() (driver.Tx, error) { return dryRunTx{}, nil }
+
+func (c *dryRunConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	return dryRunTx{}, nil
+}
+
+func (c *dryRunConn) CheckNamedValue(*driver.NamedValue) error { return nil }
+
+func (c *dryRunConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	c.c.record(query)
+	return driver.RowsAffected(0), nil
+}
+
+func (c *dryRunConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	c.c.record(query)
+	return dryRunRows{}, nil
+}
+
+type dryRunTx struct{}
+
+func (dryRunTx) Commit() error { return nil }
+
+func (dryRunTx) Rollback() error { return nil }
+
+type dryRunRows struct{}
+
+func (dryRunRows) Columns() []string { return nil }
+
+func (dryRunRows) Close() error { return nil }
+
+func (dryRunRows) Next([]driver.Value) error { return io.EOF }
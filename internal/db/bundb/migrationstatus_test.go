@@ -0,0 +1,79 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db/bundb"
+	"code.superseriousbusiness.org/gotosocial/internal/db/bundb/migrations"
+	"github.com/stretchr/testify/suite"
+)
+
+type MigrationStatusTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *MigrationStatusTestSuite) TestMigrationStatuses() {
+	statuses, err := bundb.MigrationStatuses(suite.T().Context())
+	suite.NoError(err)
+	suite.Len(statuses, len(migrations.Migrations.Sorted()))
+
+	if config.GetDbAddress() == ":memory:" {
+		// Each in-memory connection
+		// opens a fresh database, so
+		// nothing should be applied.
+		for _, migration := range statuses {
+			suite.False(migration.IsApplied())
+		}
+	}
+}
+
+func (suite *MigrationStatusTestSuite) TestMigrationsDryRun() {
+	plans, err := bundb.MigrationsDryRun(suite.T().Context())
+	suite.NoError(err)
+
+	if config.GetDbAddress() != ":memory:" {
+		// Existing database may
+		// be fully migrated already.
+		return
+	}
+
+	// Fresh database, so every migration is pending.
+	suite.Len(plans, len(migrations.Migrations.Sorted()))
+
+	// The very first migration
+	// should create some tables.
+	first := plans[0]
+	suite.NoError(first.Err)
+	suite.NotEmpty(first.Queries)
+	suite.Contains(first.Tables, "accounts")
+
+	// Dry run should not have
+	// touched the real database.
+	statuses, err := bundb.MigrationStatuses(suite.T().Context())
+	suite.NoError(err)
+	for _, migration := range statuses {
+		suite.False(migration.IsApplied())
+	}
+}
+
+func TestMigrationStatusTestSuite(t *testing.T) {
+	suite.Run(t, new(MigrationStatusTestSuite))
+}
@@ -21,6 +21,7 @@
 	"context"
 	"errors"
 	"slices"
+	"time"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gopkg/xslices"
@@ -28,6 +29,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
 	"code.superseriousbusiness.org/gotosocial/internal/paging"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
 	"github.com/uptrace/bun"
@@ -358,3 +360,176 @@ func (n *notificationDB) DeleteNotificationsForStatus(ctx context.Context, statu
 	n.state.Caches.DB.Notification.InvalidateIDs("ID", notifIDs)
 	return nil
 }
+
+func (n *notificationDB) CountNotifications(ctx context.Context, olderThan time.Time) (int, error) {
+	q := n.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("notifications"), bun.Ident("notification"))
+
+	if !olderThan.IsZero() {
+		// Notification IDs are ULIDs, so we can use
+		// the (indexed) ID to select by creation time.
+		q = q.Where("? < ?", bun.Ident("notification.id"), id.ZeroULIDForTime(olderThan))
+	}
+
+	return q.Count(ctx)
+}
+
+func (n *notificationDB) DeleteNotificationsOlderThan(ctx context.Context, olderThan time.Time, limit int) (int, error) {
+	// Select the IDs of the oldest notifications
+	// first, so we can delete in limited batches.
+	var notifIDs []string
+	if err := n.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("notifications"), bun.Ident("notification")).
+		Column("notification.id").
+		Where("? < ?", bun.Ident("notification.id"), id.ZeroULIDForTime(olderThan)).
+		OrderExpr("? ASC", bun.Ident("notification.id")).
+		Limit(limit).
+		Scan(ctx, &notifIDs); err != nil {
+		return 0, err
+	}
+
+	if len(notifIDs) == 0 {
+		// Nothing to do.
+		return 0, nil
+	}
+
+	// Delete selected notifications from DB.
+	if _, err := n.db.
+		NewDelete().
+		Table("notifications").
+		Where("? IN (?)", bun.Ident("id"), bun.In(notifIDs)).
+		Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	// Invalidate all deleted notifications by IDs.
+	n.state.Caches.DB.Notification.InvalidateIDs("ID", notifIDs)
+	return len(notifIDs), nil
+}
+
+func (n *notificationDB) GetNotificationGroupKey(
+	ctx context.Context,
+	notifType gtsmodel.NotificationType,
+	targetAcctID string,
+	statusOrEditID string,
+	since time.Time,
+) (string, error) {
+	q := n.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("notifications"), bun.Ident("notification")).
+		Column("notification.group_key").
+		Where("? = ?", bun.Ident("notification.notification_type"), notifType).
+		Where("? = ?", bun.Ident("notification.target_account_id"), targetAcctID).
+		Where("? IS NOT NULL", bun.Ident("notification.group_key")).
+		// Notification IDs are ULIDs, so we can use
+		// the (indexed) ID to select by creation time.
+		Where("? >= ?", bun.Ident("notification.id"), id.ZeroULIDForTime(since))
+
+	if statusOrEditID != "" {
+		q = q.Where("? = ?", bun.Ident("notification.status_id"), statusOrEditID)
+	} else {
+		q = q.Where("? IS NULL", bun.Ident("notification.status_id"))
+	}
+
+	var groupKey string
+	if err := q.
+		OrderExpr("? DESC", bun.Ident("notification.id")).
+		Limit(1).
+		Scan(ctx, &groupKey); err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// No existing group.
+			return "", nil
+		}
+		return "", err
+	}
+
+	return groupKey, nil
+}
+
+func (n *notificationDB) GetNotificationGroup(ctx context.Context, accountID string, groupKey string, page *paging.Page) ([]*gtsmodel.Notification, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		notifIDs = make([]string, 0, limit)
+	)
+
+	q := n.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("notifications"), bun.Ident("notification")).
+		Column("notification.id").
+		Where("? = ?", bun.Ident("notification.target_account_id"), accountID).
+		Where("? = ?", bun.Ident("notification.group_key"), groupKey)
+
+	if maxID != "" {
+		// Return only notifs LOWER (ie., older) than maxID.
+		q = q.Where("? < ?", bun.Ident("notification.id"), maxID)
+	}
+
+	if minID != "" {
+		// Return only notifs HIGHER (ie., newer) than minID.
+		q = q.Where("? > ?", bun.Ident("notification.id"), minID)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.Order("notification.id ASC")
+	} else {
+		// Page down.
+		q = q.Order("notification.id DESC")
+	}
+
+	if err := q.Scan(ctx, &notifIDs); err != nil {
+		return nil, err
+	}
+
+	if len(notifIDs) == 0 {
+		return nil, nil
+	}
+
+	// If we're paging up, we still want notifications
+	// to be sorted by ID desc, so reverse ids slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(notifIDs)
+	}
+
+	// Fetch notification models by their IDs.
+	return n.GetNotificationsByIDs(ctx, notifIDs)
+}
+
+func (n *notificationDB) CountNotificationGroup(ctx context.Context, accountID string, groupKey string) (int, error) {
+	return n.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("notifications"), bun.Ident("notification")).
+		Where("? = ?", bun.Ident("notification.target_account_id"), accountID).
+		Where("? = ?", bun.Ident("notification.group_key"), groupKey).
+		Count(ctx)
+}
+
+func (n *notificationDB) DeleteNotificationGroup(ctx context.Context, accountID string, groupKey string) error {
+	var notifIDs []string
+
+	if _, err := n.db.
+		NewDelete().
+		Table("notifications").
+		Where("? = ?", bun.Ident("target_account_id"), accountID).
+		Where("? = ?", bun.Ident("group_key"), groupKey).
+		Returning("?", bun.Ident("id")).
+		Exec(ctx, &notifIDs); err != nil {
+		return err
+	}
+
+	// Invalidate all deleted notifications by IDs.
+	n.state.Caches.DB.Notification.InvalidateIDs("ID", notifIDs)
+	return nil
+}
@@ -214,6 +214,27 @@ func (r *relationshipDB) PutBlock(ctx context.Context, block *gtsmodel.Block) er
 	})
 }
 
+func (r *relationshipDB) PutBlocks(ctx context.Context, blocks ...*gtsmodel.Block) error {
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	// Insert all blocks in one transaction,
+	// so either all blocks are placed or none.
+	if err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewInsert().Model(&blocks).Exec(ctx)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	// Store new blocks in cache, this
+	// calls invalidate hook for each.
+	r.state.Caches.DB.Block.Put(blocks...)
+
+	return nil
+}
+
 func (r *relationshipDB) DeleteBlockByID(ctx context.Context, id string) error {
 	// Gather necessary fields from
 	// deleted for cache invaliation.
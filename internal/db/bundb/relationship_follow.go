@@ -157,6 +157,89 @@ func (r *relationshipDB) IsMutualFollowing(ctx context.Context, accountID1 strin
 	return true, nil
 }
 
+func (r *relationshipDB) IsFollowingFollowerOf(ctx context.Context, sourceAccountID string, targetAccountID string) (bool, error) {
+	// Subquery selecting IDs
+	// of target's followers.
+	followersQ := r.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("follows"), bun.Ident("target_follow")).
+		Column("target_follow.account_id").
+		Where("? = ?", bun.Ident("target_follow.target_account_id"), targetAccountID)
+
+	// Check if source follows
+	// any of target's followers.
+	q := r.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("follows"), bun.Ident("follow")).
+		Column("follow.id").
+		Where("? = ?", bun.Ident("follow.account_id"), sourceAccountID).
+		Where("? IN (?)", bun.Ident("follow.target_account_id"), followersQ)
+
+	return exists(ctx, q)
+}
+
+func (r *relationshipDB) GetFamiliarFollowers(ctx context.Context, sourceAccountID string, targetAccountID string) ([]*gtsmodel.Follow, error) {
+	// Load IDs of follows from source,
+	// and of follows targeting target.
+	// These will likely both be cached.
+	followIDs, err := r.GetAccountFollowIDs(ctx, sourceAccountID, nil)
+	if err != nil {
+		return nil, gtserror.Newf("error getting follow ids: %w", err)
+	}
+
+	followerIDs, err := r.GetAccountFollowerIDs(ctx, targetAccountID, nil)
+	if err != nil {
+		return nil, gtserror.Newf("error getting follower ids: %w", err)
+	}
+
+	if len(followIDs) == 0 || len(followerIDs) == 0 {
+		// Nothing to join.
+		return nil, nil
+	}
+
+	// Load barebones follows from source,
+	// gathering the IDs of followed accounts.
+	follows, err := r.GetFollowsByIDs(gtscontext.SetBarebones(ctx), followIDs)
+	if err != nil {
+		return nil, gtserror.Newf("error getting follows: %w", err)
+	}
+
+	following := make(map[string]struct{}, len(follows))
+	for _, follow := range follows {
+		following[follow.TargetAccountID] = struct{}{}
+	}
+
+	// Load barebones follows targeting target.
+	followers, err := r.GetFollowsByIDs(gtscontext.SetBarebones(ctx), followerIDs)
+	if err != nil {
+		return nil, gtserror.Newf("error getting followers: %w", err)
+	}
+
+	// Join on the follower account IDs, dropping
+	// followers that source doesn't follow itself.
+	followers = slices.DeleteFunc(followers, func(follow *gtsmodel.Follow) bool {
+		_, ok := following[follow.AccountID]
+		return !ok
+	})
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return followers, nil
+	}
+
+	// Populate the remaining follows, removing those we fail to
+	// populate (removes needing so many nil checks everywhere).
+	followers = slices.DeleteFunc(followers, func(follow *gtsmodel.Follow) bool {
+		if err := r.PopulateFollow(ctx, follow); err != nil {
+			log.Errorf(ctx, "error populating follow %s: %v", follow.ID, err)
+			return true
+		}
+		return false
+	})
+
+	return followers, nil
+}
+
 func (r *relationshipDB) getFollow(ctx context.Context, lookup string, dbQuery func(*gtsmodel.Follow) error, keyParts ...any) (*gtsmodel.Follow, error) {
 	// Fetch follow from database cache with loader callback
 	follow, err := r.state.Caches.DB.Follow.LoadOne(lookup, func() (*gtsmodel.Follow, error) {
@@ -288,6 +371,83 @@ func (r *relationshipDB) DeleteFollowByID(ctx context.Context, id string) error
 	})
 }
 
+func (r *relationshipDB) DeleteFollowsByIDs(ctx context.Context, ids ...string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// Gather necessary fields from
+	// deleted for cache invaliation.
+	var deleted []*gtsmodel.Follow
+
+	if err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Delete all follows with
+		// given IDs, returning the
+		// deleted models.
+		if _, err := tx.NewDelete().
+			Model(&deleted).
+			Where("? IN (?)", bun.Ident("id"), bun.In(ids)).
+			Returning("?, ?, ?",
+				bun.Ident("id"),
+				bun.Ident("account_id"),
+				bun.Ident("target_account_id"),
+			).
+			Exec(ctx); err != nil {
+
+			// the RETURNING here will cause an ErrNoRows
+			// to be returned on DELETE, which is caught
+			// outside this RunInTx() func, and ensures we
+			// return early here to *not* update statistics.
+			return err
+		}
+
+		for _, follow := range deleted {
+			// Decrement origin following statistic.
+			if err := decrementAccountStats(ctx, tx,
+				"following_count",
+				follow.AccountID,
+			); err != nil {
+				return err
+			}
+
+			// Decrement target followers statistic.
+			if err := decrementAccountStats(ctx, tx,
+				"followers_count",
+				follow.TargetAccountID,
+			); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	if len(deleted) == 0 {
+		return nil
+	}
+
+	// Invalidate cached follows with IDs, manually
+	// call invalidate hooks in case not cached.
+	r.state.Caches.DB.Follow.InvalidateIDs("ID", ids)
+	for _, follow := range deleted {
+		r.state.Caches.OnInvalidateFollow(follow)
+	}
+
+	// Gather the follow IDs that were deleted for removing related list entries.
+	followIDs := xslices.Gather(nil, deleted, func(follow *gtsmodel.Follow) string {
+		return follow.ID
+	})
+
+	// Delete every list entry that was created targetting any of these follow IDs.
+	if err := r.state.DB.DeleteAllListEntriesByFollows(ctx, followIDs...); err != nil {
+		return gtserror.Newf("error deleting list entries: %w", err)
+	}
+
+	return nil
+}
+
 func (r *relationshipDB) DeleteFollowByURI(ctx context.Context, uri string) error {
 	return r.deleteFollow(ctx, func(tx bun.Tx) (*gtsmodel.Follow, error) {
 		var deleted gtsmodel.Follow
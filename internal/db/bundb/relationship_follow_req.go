@@ -317,6 +317,61 @@ func (r *relationshipDB) DeleteFollowRequestByID(ctx context.Context, id string)
 	})
 }
 
+func (r *relationshipDB) DeleteFollowRequestsByIDs(ctx context.Context, ids ...string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// Gather necessary fields from
+	// deleted for cache invaliation.
+	var deleted []*gtsmodel.FollowRequest
+
+	if err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Delete all follow requests
+		// with given IDs, returning
+		// the deleted models.
+		if _, err := tx.NewDelete().
+			Model(&deleted).
+			Where("? IN (?)", bun.Ident("id"), bun.In(ids)).
+			Returning("?, ?, ?",
+				bun.Ident("id"),
+				bun.Ident("account_id"),
+				bun.Ident("target_account_id"),
+			).
+			Exec(ctx); err != nil {
+
+			// the RETURNING here will cause an ErrNoRows
+			// to be returned on DELETE, which is caught
+			// outside this RunInTx() func, and ensures we
+			// return early here to *not* update statistics.
+			return err
+		}
+
+		for _, followReq := range deleted {
+			// Decrement target follow requests count.
+			if err := decrementAccountStats(ctx, tx,
+				"follow_requests_count",
+				followReq.TargetAccountID,
+			); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	// Invalidate cached follow requests with IDs,
+	// manually call invalidate hooks in case not cached.
+	r.state.Caches.DB.FollowRequest.InvalidateIDs("ID", ids)
+	for _, followReq := range deleted {
+		r.state.Caches.OnInvalidateFollowRequest(followReq)
+	}
+
+	return nil
+}
+
 func (r *relationshipDB) DeleteFollowRequestByURI(ctx context.Context, uri string) error {
 	return r.deleteFollowRequest(ctx, func(tx bun.Tx) (*gtsmodel.FollowRequest, error) {
 		var deleted gtsmodel.FollowRequest
@@ -201,6 +201,40 @@ func (r *relationshipDB) PutMute(ctx context.Context, mute *gtsmodel.UserMute) e
 	})
 }
 
+func (r *relationshipDB) PutMutes(ctx context.Context, mutes ...*gtsmodel.UserMute) error {
+	if len(mutes) == 0 {
+		return nil
+	}
+
+	// Upsert all mutes in one transaction,
+	// so either all mutes are placed or none.
+	if err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for _, mute := range mutes {
+			if _, err := NewUpsert(tx).
+				Model(mute).
+				Constraint("id").
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// Drop any stale versions of
+	// updated mutes, then store all
+	// (this calls invalidate hooks).
+	r.state.Caches.DB.UserMute.InvalidateIDs("ID",
+		xslices.Gather(nil, mutes, func(mute *gtsmodel.UserMute) string {
+			return mute.ID
+		}),
+	)
+	r.state.Caches.DB.UserMute.Put(mutes...)
+
+	return nil
+}
+
 func (r *relationshipDB) DeleteMuteByID(ctx context.Context, id string) error {
 	// Gather necessary fields from
 	// deleted for cache invaliation.
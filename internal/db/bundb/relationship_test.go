@@ -601,6 +601,24 @@ func (suite *RelationshipTestSuite) TestIsMutualFollowingNo() {
 	suite.True(isMutualFollowing)
 }
 
+func (suite *RelationshipTestSuite) TestIsFollowingFollowerOf() {
+	// admin_account follows local_account_1,
+	// who follows local_account_2.
+	requestingAccount := suite.testAccounts["admin_account"]
+	targetAccount := suite.testAccounts["local_account_2"]
+	isFollowingFollower, err := suite.db.IsFollowingFollowerOf(suite.T().Context(), requestingAccount.ID, targetAccount.ID)
+	suite.NoError(err)
+	suite.True(isFollowingFollower)
+}
+
+func (suite *RelationshipTestSuite) TestIsFollowingFollowerOfNo() {
+	requestingAccount := suite.testAccounts["remote_account_1"]
+	targetAccount := suite.testAccounts["local_account_2"]
+	isFollowingFollower, err := suite.db.IsFollowingFollowerOf(suite.T().Context(), requestingAccount.ID, targetAccount.ID)
+	suite.NoError(err)
+	suite.False(isFollowingFollower)
+}
+
 func (suite *RelationshipTestSuite) TestAcceptFollowRequestOK() {
 	ctx := suite.T().Context()
 	account := suite.testAccounts["admin_account"]
@@ -53,7 +53,7 @@ func(report *gtsmodel.Report) error {
 	)
 }
 
-func (r *reportDB) GetReports(ctx context.Context, resolved *bool, accountID string, targetAccountID string, page *paging.Page) ([]*gtsmodel.Report, error) {
+func (r *reportDB) GetReports(ctx context.Context, resolved *bool, accountID string, targetAccountID string, category gtsmodel.ReportCategory, assignedAccountID string, page *paging.Page) ([]*gtsmodel.Report, error) {
 	var (
 		// Get paging params.
 		minID = page.GetMin()
@@ -88,6 +88,14 @@ func (r *reportDB) GetReports(ctx context.Context, resolved *bool, accountID str
 		q = q.Where("? = ?", bun.Ident("report.target_account_id"), targetAccountID)
 	}
 
+	if category != gtsmodel.ReportCategoryUnknown {
+		q = q.Where("? = ?", bun.Ident("report.category"), category)
+	}
+
+	if assignedAccountID != "" {
+		q = q.Where("? = ?", bun.Ident("report.assigned_account_id"), assignedAccountID)
+	}
+
 	// Return only reports with id
 	// lower than provided maxID.
 	if maxID != "" {
@@ -177,7 +185,7 @@ func (r *reportDB) getReport(ctx context.Context, lookup string, dbQuery func(*g
 func (r *reportDB) PopulateReport(ctx context.Context, report *gtsmodel.Report) error {
 	var (
 		err  error
-		errs = gtserror.NewMultiError(4)
+		errs = gtserror.NewMultiError(6)
 	)
 
 	if report.Account == nil {
@@ -238,6 +246,18 @@ func (r *reportDB) PopulateReport(ctx context.Context, report *gtsmodel.Report)
 		}
 	}
 
+	if report.AssignedAccountID != "" &&
+		report.AssignedAccount == nil {
+		// Report assigned account is not set, fetch from the database.
+		report.AssignedAccount, err = r.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			report.AssignedAccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating report assigned account: %w", err)
+		}
+	}
+
 	return errs.Combine()
 }
 
@@ -276,8 +296,88 @@ func (r *reportDB) DeleteReportByID(ctx context.Context, id string) error {
 		return err
 	}
 
+	// Delete any notes made on the report.
+	if _, err := r.db.NewDelete().
+		TableExpr("? AS ?", bun.Ident("report_notes"), bun.Ident("report_note")).
+		Where("? = ?", bun.Ident("report_note.report_id"), id).
+		Exec(ctx); err != nil &&
+		!errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
 	// Invalidate any cached report model by ID.
 	r.state.Caches.DB.Report.Invalidate("ID", id)
 
 	return nil
 }
+
+func (r *reportDB) GetReportNoteByID(ctx context.Context, id string) (*gtsmodel.ReportNote, error) {
+	note := new(gtsmodel.ReportNote)
+	if err := r.db.
+		NewSelect().
+		Model(note).
+		Where("? = ?", bun.Ident("report_note.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := r.populateReportNote(ctx, note); err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
+func (r *reportDB) GetReportNotes(ctx context.Context, reportID string) ([]*gtsmodel.ReportNote, error) {
+	var notes []*gtsmodel.ReportNote
+	if err := r.db.
+		NewSelect().
+		Model(&notes).
+		Where("? = ?", bun.Ident("report_note.report_id"), reportID).
+		OrderExpr("? ASC", bun.Ident("report_note.id")).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, note := range notes {
+		if err := r.populateReportNote(ctx, note); err != nil {
+			log.Errorf(ctx, "error populating report note %q: %v", note.ID, err)
+		}
+	}
+
+	return notes, nil
+}
+
+func (r *reportDB) populateReportNote(ctx context.Context, note *gtsmodel.ReportNote) error {
+	if note.Account != nil {
+		// Already populated.
+		return nil
+	}
+
+	var err error
+	note.Account, err = r.state.DB.GetAccountByID(
+		gtscontext.SetBarebones(ctx),
+		note.AccountID,
+	)
+	if err != nil {
+		return gtserror.Newf("error populating report note account: %w", err)
+	}
+
+	return nil
+}
+
+func (r *reportDB) PutReportNote(ctx context.Context, note *gtsmodel.ReportNote) error {
+	_, err := r.db.NewInsert().Model(note).Exec(ctx)
+	return err
+}
+
+func (r *reportDB) DeleteReportNoteByID(ctx context.Context, id string) error {
+	_, err := r.db.NewDelete().
+		TableExpr("? AS ?", bun.Ident("report_notes"), bun.Ident("report_note")).
+		Where("? = ?", bun.Ident("report_note.id"), id).
+		Exec(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+	return nil
+}
@@ -67,6 +67,8 @@ func (suite *ReportTestSuite) TestGetAllReports() {
 		nil,
 		"",
 		"",
+		gtsmodel.ReportCategoryUnknown,
+		"",
 		&paging.Page{},
 	)
 	suite.NoError(err)
@@ -80,6 +82,8 @@ func (suite *ReportTestSuite) TestReportPagingDown() {
 		nil,
 		"",
 		"",
+		gtsmodel.ReportCategoryUnknown,
+		"",
 		&paging.Page{
 			Limit: 1,
 		},
@@ -98,6 +102,8 @@ func (suite *ReportTestSuite) TestReportPagingDown() {
 		nil,
 		"",
 		"",
+		gtsmodel.ReportCategoryUnknown,
+		"",
 		&paging.Page{
 			Limit: 1,
 			Max:   paging.MaxID(id1),
@@ -121,6 +127,8 @@ func (suite *ReportTestSuite) TestReportPagingUp() {
 		nil,
 		"",
 		"",
+		gtsmodel.ReportCategoryUnknown,
+		"",
 		&paging.Page{
 			Limit: 1,
 			Min:   paging.MinID(id.Lowest),
@@ -140,6 +148,8 @@ func (suite *ReportTestSuite) TestReportPagingUp() {
 		nil,
 		"",
 		"",
+		gtsmodel.ReportCategoryUnknown,
+		"",
 		&paging.Page{
 			Limit: 1,
 			Min:   paging.MinID(id1),
@@ -163,6 +173,8 @@ func (suite *ReportTestSuite) TestGetAllReportsByAccountID() {
 		nil,
 		accountID,
 		"",
+		gtsmodel.ReportCategoryUnknown,
+		"",
 		&paging.Page{},
 	)
 	suite.NoError(err)
@@ -254,6 +266,90 @@ func (suite *ReportTestSuite) TestDeleteReport() {
 	suite.Nil(report)
 }
 
+func (suite *ReportTestSuite) TestGetReportsByCategoryAndAssignee() {
+	ctx := suite.T().Context()
+	adminID := suite.testAccounts["admin_account"].ID
+
+	report := &gtsmodel.Report{}
+	*report = *suite.testReports["local_account_2_report_remote_account_1"]
+	report.Category = gtsmodel.ReportCategorySpam
+	report.AssignedAccountID = adminID
+
+	if err := suite.db.UpdateReport(ctx, report, "category", "assigned_account_id"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	reports, err := suite.db.GetReports(
+		ctx,
+		nil,
+		"",
+		"",
+		gtsmodel.ReportCategorySpam,
+		adminID,
+		&paging.Page{},
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(reports, 1)
+	suite.Equal(report.ID, reports[0].ID)
+	suite.Equal(gtsmodel.ReportCategorySpam, reports[0].Category)
+	suite.NotNil(reports[0].AssignedAccount)
+
+	// No reports in the legal category.
+	reports, err = suite.db.GetReports(
+		ctx,
+		nil,
+		"",
+		"",
+		gtsmodel.ReportCategoryLegal,
+		"",
+		&paging.Page{},
+	)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Empty(reports)
+}
+
+func (suite *ReportTestSuite) TestReportNotes() {
+	ctx := suite.T().Context()
+	reportID := suite.testReports["local_account_2_report_remote_account_1"].ID
+
+	for _, noteID := range []string{
+		"01JAMQ3V3YFAJ9P2W0BCA1M0WZ",
+		"01JAMQ4A2Z1V2P8M8AX2J1J6QN",
+	} {
+		if err := suite.db.PutReportNote(ctx, &gtsmodel.ReportNote{
+			ID:        noteID,
+			ReportID:  reportID,
+			AccountID: suite.testAccounts["admin_account"].ID,
+			Content:   "looking into this one",
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	notes, err := suite.db.GetReportNotes(ctx, reportID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(notes, 2)
+	suite.Equal("01JAMQ3V3YFAJ9P2W0BCA1M0WZ", notes[0].ID)
+	suite.NotNil(notes[0].Account)
+
+	if err := suite.db.DeleteReportNoteByID(ctx, notes[0].ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Deleting the report deletes remaining notes.
+	if err := suite.db.DeleteReportByID(ctx, reportID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	note, err := suite.db.GetReportNoteByID(ctx, notes[1].ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Nil(note)
+}
+
 func TestReportTestSuite(t *testing.T) {
 	suite.Run(t, new(ReportTestSuite))
 }
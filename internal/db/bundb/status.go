@@ -22,6 +22,7 @@
 	"errors"
 	"slices"
 	"strings"
+	"time"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gopkg/xslices"
@@ -31,6 +32,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/id"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
 	"github.com/uptrace/bun"
 )
 
@@ -332,7 +334,7 @@ func (s *statusDB) PopulateStatusEdits(ctx context.Context, status *gtsmodel.Sta
 }
 
 func (s *statusDB) PutStatus(ctx context.Context, status *gtsmodel.Status) error {
-	return s.state.Caches.DB.Status.Store(status, func() error {
+	if err := s.state.Caches.DB.Status.Store(status, func() error {
 		// It is safe to run this database transaction within cache.Store
 		// as the cache does not attempt a mutex lock until AFTER hook.
 		//
@@ -356,7 +358,15 @@ func (s *statusDB) PutStatus(ctx context.Context, status *gtsmodel.Status) error
 
 				// They also require no further
 				// checks! Simply insert status here.
-				return insertStatus(ctx, tx, status)
+				if err := insertStatus(ctx, tx, status); err != nil {
+					return err
+				}
+
+				// Increment boosted status boosts count.
+				return incrementStatusCount(ctx, tx,
+					"boosts_count",
+					status.BoostOfID,
+				)
 			}
 
 			// Gather a list of possible thread IDs
@@ -458,9 +468,42 @@ func (s *statusDB) PutStatus(ctx context.Context, status *gtsmodel.Status) error
 
 			// And after threading, insert status.
 			// This will error if ThreadID is unset.
-			return insertStatus(ctx, tx, status)
+			if err := insertStatus(ctx, tx, status); err != nil {
+				return err
+			}
+
+			if status.InReplyToID != "" {
+				// Increment parent status replies count.
+				return incrementStatusCount(ctx, tx,
+					"replies_count",
+					status.InReplyToID,
+				)
+			}
+
+			return nil
 		})
-	})
+	}); err != nil {
+		return err
+	}
+
+	// Invalidate any boosted or replied-to
+	// status, now its interaction counts changed.
+	s.invalidateInteractedStatus(status)
+
+	return nil
+}
+
+// invalidateInteractedStatus invalidates the cached boosted or replied-to
+// status of the given status, whose denormalized interaction counts change
+// when the given status is inserted or deleted.
+func (s *statusDB) invalidateInteractedStatus(status *gtsmodel.Status) {
+	if status.BoostOfID != "" {
+		s.state.Caches.DB.Status.Invalidate("ID", status.BoostOfID)
+	}
+
+	if status.InReplyToID != "" {
+		s.state.Caches.DB.Status.Invalidate("ID", status.InReplyToID)
+	}
 }
 
 // fixStatusThreading can be called to reconcile statuses in the same thread but known to be using multiple given threads.
@@ -529,6 +572,19 @@ func (s *statusDB) fixStatusThreading(ctx context.Context, tx bun.Tx, threadIDs
 // any intermediary table links, and updating media attachments to point to status.
 func insertStatus(ctx context.Context, tx bun.Tx, status *gtsmodel.Status) error {
 
+	// A freshly inserted status can't
+	// yet have any stored interactions,
+	// so its counts can start at zero.
+	if status.RepliesCount == nil {
+		status.RepliesCount = util.Ptr(0)
+	}
+	if status.BoostsCount == nil {
+		status.BoostsCount = util.Ptr(0)
+	}
+	if status.FavesCount == nil {
+		status.FavesCount = util.Ptr(0)
+	}
+
 	// create links between this
 	// status and any emojis it uses
 	for _, id := range status.EmojiIDs {
@@ -603,7 +659,17 @@ func (s *statusDB) UpdateStatus(ctx context.Context, status *gtsmodel.Status, co
 		}
 	}
 
-	return s.state.Caches.DB.Status.Store(status, func() error {
+	// Check if the status parent might
+	// be changing, in which case the replies
+	// counts of old and new parents change.
+	updatingParent := len(columns) == 0 ||
+		slices.Contains(columns, "in_reply_to_id")
+
+	// Replies counts of status
+	// parents (old and new) to reset.
+	var parentIDs []string
+
+	if err := s.state.Caches.DB.Status.Store(status, func() error {
 		// It is safe to run this database transaction within cache.Store
 		// as the cache does not attempt a mutex lock until AFTER hook.
 		//
@@ -653,12 +719,61 @@ func (s *statusDB) UpdateStatus(ctx context.Context, status *gtsmodel.Status, co
 				}
 			}
 
+			if updatingParent {
+				var oldParentID string
+
+				// Select existing parent
+				// ID before it's updated.
+				if err := tx.
+					NewSelect().
+					Table("statuses").
+					Column("in_reply_to_id").
+					Where("? = ?", bun.Ident("id"), status.ID).
+					Scan(ctx, &oldParentID); err != nil &&
+					!errors.Is(err, db.ErrNoEntries) {
+					return err
+				}
+
+				if oldParentID != status.InReplyToID {
+					// Parent changing, gather the old
+					// and new parents to reset counts.
+					parentIDs = append(parentIDs,
+						oldParentID,
+						status.InReplyToID,
+					)
+					parentIDs = slices.DeleteFunc(parentIDs,
+						func(id string) bool { return id == "" },
+					)
+				}
+			}
+
 			// Finally, update the status
-			if _, err := tx.NewUpdate().
+			q := tx.NewUpdate().
 				Model(status).
 				Column(columns...).
-				Where("? = ?", bun.Ident("status.id"), status.ID).
-				Exec(ctx); err != nil {
+				Where("? = ?", bun.Ident("status.id"), status.ID)
+
+			if len(columns) == 0 {
+				// When updating all columns, leave
+				// the interaction counts alone, as
+				// the model may hold stale values.
+				q = q.ExcludeColumn(
+					"replies_count",
+					"boosts_count",
+					"faves_count",
+				)
+			}
+
+			if _, err := q.Exec(ctx); err != nil {
+				return err
+			}
+
+			// Reset replies counts of old and new
+			// parents, to be lazily regenerated.
+			if err := resetStatusCount(ctx, tx,
+				"replies_count",
+				parentIDs...,
+			); err != nil {
 				return err
 			}
 
@@ -683,7 +798,15 @@ func (s *statusDB) UpdateStatus(ctx context.Context, status *gtsmodel.Status, co
 				return nil
 			}
 		})
-	})
+	}); err != nil {
+		return err
+	}
+
+	// Invalidate any old / new parent
+	// statuses, now their counts changed.
+	s.state.Caches.DB.Status.InvalidateIDs("ID", parentIDs)
+
+	return nil
 }
 
 func (s *statusDB) DeleteStatusByID(ctx context.Context, id string) error {
@@ -742,6 +865,26 @@ func (s *statusDB) DeleteStatusByID(ctx context.Context, id string) error {
 			return err
 		}
 
+		if deleted.BoostOfID != "" {
+			// decrement boosted status boosts count.
+			if err := decrementStatusCount(ctx, tx,
+				"boosts_count",
+				deleted.BoostOfID,
+			); err != nil {
+				return err
+			}
+		}
+
+		if deleted.InReplyToID != "" {
+			// decrement parent status replies count.
+			if err := decrementStatusCount(ctx, tx,
+				"replies_count",
+				deleted.InReplyToID,
+			); err != nil {
+				return err
+			}
+		}
+
 		if !deleted.PinnedAt.IsZero() {
 			// decrement author pinned statistics.
 			if err := decrementAccountStats(ctx, tx,
@@ -762,9 +905,103 @@ func (s *statusDB) DeleteStatusByID(ctx context.Context, id string) error {
 	s.state.Caches.DB.Status.Invalidate("ID", id)
 	s.state.Caches.OnInvalidateStatus(&deleted)
 
+	// Invalidate any boosted or replied-to
+	// status, now its interaction counts changed.
+	s.invalidateInteractedStatus(&deleted)
+
 	return nil
 }
 
+func (s *statusDB) GetTrashedStatuses(ctx context.Context, deletedBefore time.Time, limit int) ([]*gtsmodel.Status, error) {
+	var statusIDs []string
+
+	// SELECT all statuses deleted
+	// before the given time, newest first.
+	if err := s.db.NewSelect().
+		Table("statuses").
+		Column("id").
+		Where("? IS NOT NULL", bun.Ident("deleted_at")).
+		Where("? < ?", bun.Ident("deleted_at"), deletedBefore).
+		OrderExpr("? DESC", bun.Ident("deleted_at")).
+		Limit(limit).
+		Scan(ctx, &statusIDs); err != nil {
+		return nil, err
+	}
+
+	// Convert status IDs into status objects.
+	return s.GetStatusesByIDs(ctx, statusIDs)
+}
+
+func (s *statusDB) GetExpiredStatuses(ctx context.Context, expiredBefore time.Time, limit int) ([]*gtsmodel.Status, error) {
+	var statusIDs []string
+
+	// SELECT all statuses expiring
+	// before the given time, newest first.
+	if err := s.db.NewSelect().
+		Table("statuses").
+		Column("id").
+		Where("? IS NOT NULL", bun.Ident("expires_at")).
+		Where("? < ?", bun.Ident("expires_at"), expiredBefore).
+		Where("? IS NULL", bun.Ident("deleted_at")).
+		OrderExpr("? DESC", bun.Ident("expires_at")).
+		Limit(limit).
+		Scan(ctx, &statusIDs); err != nil {
+		return nil, err
+	}
+
+	// Convert status IDs into status objects.
+	return s.GetStatusesByIDs(ctx, statusIDs)
+}
+
+func (s *statusDB) GetStatusesToAutoDelete(
+	ctx context.Context,
+	accountID string,
+	minID string,
+	maxID string,
+	limit int,
+) ([]*gtsmodel.Status, error) {
+	var statusIDs []string
+
+	q := s.db.NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Column("status.id").
+		Where("? = ?", bun.Ident("status.account_id"), accountID).
+		Where("? < ?", bun.Ident("status.id"), maxID).
+		Where("? IS NULL", bun.Ident("status.boost_of_id")).
+		Where("? IS NULL", bun.Ident("status.pinned_at")).
+		Where("? IS NULL", bun.Ident("status.deleted_at"))
+
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("status.id"), minID)
+	}
+
+	// Exclude statuses bookmarked by the author.
+	q = q.Where("NOT EXISTS (?)", s.db.NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_bookmarks"), bun.Ident("status_bookmark")).
+		Column("status_bookmark.id").
+		Where("? = ?", bun.Ident("status_bookmark.status_id"), bun.Ident("status.id")).
+		Where("? = ?", bun.Ident("status_bookmark.account_id"), bun.Ident("status.account_id")),
+	)
+
+	// Exclude statuses faved by the author.
+	q = q.Where("NOT EXISTS (?)", s.db.NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_faves"), bun.Ident("status_fave")).
+		Column("status_fave.id").
+		Where("? = ?", bun.Ident("status_fave.status_id"), bun.Ident("status.id")).
+		Where("? = ?", bun.Ident("status_fave.account_id"), bun.Ident("status.account_id")),
+	)
+
+	if err := q.
+		OrderExpr("? ASC", bun.Ident("status.id")).
+		Limit(limit).
+		Scan(ctx, &statusIDs); err != nil {
+		return nil, err
+	}
+
+	// Convert status IDs into status objects.
+	return s.GetStatusesByIDs(ctx, statusIDs)
+}
+
 func (s *statusDB) GetStatusesUsingEmoji(ctx context.Context, emojiID string) ([]*gtsmodel.Status, error) {
 	var statusIDs []string
 
@@ -911,6 +1148,152 @@ func (s *statusDB) getStatusBoostIDs(ctx context.Context, statusID string) ([]st
 	})
 }
 
+// statusCounts is a helper type for selecting
+// the denormalized interaction counts of a status.
+type statusCounts struct {
+	ID           string `bun:"id"`
+	RepliesCount *int   `bun:"replies_count"`
+	BoostsCount  *int   `bun:"boosts_count"`
+	FavesCount   *int   `bun:"faves_count"`
+}
+
+func (s *statusDB) RegenerateStatusCounts(ctx context.Context, status *gtsmodel.Status) error {
+	counts, err := s.regenerateStatusCounts(ctx, status.ID)
+	if err != nil {
+		return err
+	}
+
+	if len(counts) == 0 {
+		// Status was deleted.
+		return db.ErrNoEntries
+	}
+
+	// Set regenerated counts on status.
+	status.RepliesCount = counts[0].RepliesCount
+	status.BoostsCount = counts[0].BoostsCount
+	status.FavesCount = counts[0].FavesCount
+
+	// Invalidate cached status
+	// now its counts changed.
+	s.state.Caches.DB.Status.Invalidate("ID", status.ID)
+
+	return nil
+}
+
+func (s *statusDB) ReconcileStatusCounts(ctx context.Context, maxID string, limit int) (string, error) {
+	var current []statusCounts
+
+	// Select the current counts of a page
+	// of statuses that have been counted.
+	q := s.db.
+		NewSelect().
+		Table("statuses").
+		Column("id", "replies_count", "boosts_count", "faves_count").
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? IS NOT NULL", bun.Ident("replies_count")).
+				WhereOr("? IS NOT NULL", bun.Ident("boosts_count")).
+				WhereOr("? IS NOT NULL", bun.Ident("faves_count"))
+		}).
+		Order("id DESC").
+		Limit(limit)
+
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("id"), maxID)
+	}
+
+	if err := q.Scan(ctx, &current); err != nil {
+		return "", err
+	}
+
+	if len(current) == 0 {
+		// Reached the end.
+		return "", nil
+	}
+
+	// Regenerate counts of each of the selected statuses.
+	ids := xslices.Gather(nil, current, func(c statusCounts) string {
+		return c.ID
+	})
+	counts, err := s.regenerateStatusCounts(ctx, ids...)
+	if err != nil {
+		return "", err
+	}
+
+	// Key the previously selected
+	// counts by ID for comparison.
+	prev := make(map[string]statusCounts, len(current))
+	for _, c := range current {
+		prev[c.ID] = c
+	}
+
+	// Gather the IDs of statuses whose
+	// counts had drifted, and were changed.
+	changed := xslices.GatherIf(nil, counts, func(c statusCounts) (string, bool) {
+		p := prev[c.ID]
+		return c.ID, !util.EqualPtrs(c.RepliesCount, p.RepliesCount) ||
+			!util.EqualPtrs(c.BoostsCount, p.BoostsCount) ||
+			!util.EqualPtrs(c.FavesCount, p.FavesCount)
+	})
+
+	if len(changed) > 0 {
+		log.Infof(ctx, "reconciled drifted interaction counts of %d statuses", len(changed))
+
+		// Invalidate cached statuses whose counts changed.
+		s.state.Caches.DB.Status.InvalidateIDs("ID", changed)
+	}
+
+	return current[len(current)-1].ID, nil
+}
+
+// regenerateStatusCounts recalculates from scratch the denormalized interaction
+// counts of statuses with given IDs, in a single UPDATE so as not to race with
+// concurrent increments / decrements, returning the newly set counts.
+func (s *statusDB) regenerateStatusCounts(ctx context.Context, statusIDs ...string) ([]statusCounts, error) {
+	var counts []statusCounts
+
+	// Count subquery for direct replies.
+	repliesQ := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("reply")).
+		ColumnExpr("COUNT(*)").
+		Where("? = ?", bun.Ident("reply.in_reply_to_id"), bun.Ident("statuses.id"))
+
+	// Count subquery for boosts.
+	boostsQ := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("boost")).
+		ColumnExpr("COUNT(*)").
+		Where("? = ?", bun.Ident("boost.boost_of_id"), bun.Ident("statuses.id"))
+
+	// Count subquery for faves.
+	favesQ := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_faves"), bun.Ident("fave")).
+		ColumnExpr("COUNT(*)").
+		Where("? = ?", bun.Ident("fave.status_id"), bun.Ident("statuses.id"))
+
+	if _, err := s.db.
+		NewUpdate().
+		Table("statuses").
+		Set("? = (?)", bun.Ident("replies_count"), repliesQ).
+		Set("? = (?)", bun.Ident("boosts_count"), boostsQ).
+		Set("? = (?)", bun.Ident("faves_count"), favesQ).
+		Where("? IN (?)", bun.Ident("statuses.id"), bun.In(statusIDs)).
+		Returning("?, ?, ?, ?",
+			bun.Ident("id"),
+			bun.Ident("replies_count"),
+			bun.Ident("boosts_count"),
+			bun.Ident("faves_count"),
+		).
+		Exec(ctx, &counts); err != nil &&
+		!errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("error regenerating status counts: %w", err)
+	}
+
+	return counts, nil
+}
+
 func (s *statusDB) MaxDirectStatusID(ctx context.Context) (string, error) {
 	maxID := ""
 	if err := s.db.
@@ -553,6 +553,126 @@ func (suite *StatusTestSuite) TestPutStatusThreadingReconcile() {
 	}
 }
 
+func (suite *StatusTestSuite) TestStatusInteractionCounts() {
+	ctx := suite.T().Context()
+
+	// Fake account details.
+	accountID := id.NewULID()
+	accountURI := "https://example.com/users/" + accountID
+
+	// newStatus prepares a new fake status.
+	newStatus := func() *gtsmodel.Status {
+		statusID := id.NewULID()
+		return &gtsmodel.Status{
+			ID:                  statusID,
+			URI:                 accountURI + "/statuses/" + statusID,
+			AccountID:           accountID,
+			AccountURI:          accountURI,
+			Local:               util.Ptr(false),
+			Federated:           util.Ptr(true),
+			ActivityStreamsType: ap.ObjectNote,
+		}
+	}
+
+	// checkCounts fetches the original status
+	// from the db and checks interaction counts.
+	checkCounts := func(statusID string, replies, boosts, faves int) {
+		status, err := suite.db.GetStatusByID(
+			gtscontext.SetBarebones(ctx),
+			statusID,
+		)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		suite.Equal(util.Ptr(replies), status.RepliesCount)
+		suite.Equal(util.Ptr(boosts), status.BoostsCount)
+		suite.Equal(util.Ptr(faves), status.FavesCount)
+	}
+
+	// Insert original status into database.
+	status := newStatus()
+	if err := suite.db.PutStatus(ctx, status); err != nil {
+		suite.FailNow(err.Error())
+	}
+	checkCounts(status.ID, 0, 0, 0)
+
+	// Insert a reply to the status.
+	reply := newStatus()
+	reply.InReplyToID = status.ID
+	reply.InReplyToURI = status.URI
+	reply.InReplyToAccountID = accountID
+	if err := suite.db.PutStatus(ctx, reply); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Insert a boost of the status.
+	boost := newStatus()
+	boost.BoostOfID = status.ID
+	boost.BoostOfAccountID = accountID
+	if err := suite.db.PutStatus(ctx, boost); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Insert a fave of the status.
+	faveID := id.NewULID()
+	if err := suite.db.PutStatusFave(ctx, &gtsmodel.StatusFave{
+		ID:              faveID,
+		AccountID:       accountID,
+		TargetAccountID: accountID,
+		StatusID:        status.ID,
+		URI:             accountURI + "/faves/" + faveID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+	checkCounts(status.ID, 1, 1, 1)
+
+	// Delete the reply and fave again.
+	if err := suite.db.DeleteStatusByID(ctx, reply.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+	if err := suite.db.DeleteStatusFaveByID(ctx, faveID); err != nil {
+		suite.FailNow(err.Error())
+	}
+	checkCounts(status.ID, 0, 1, 0)
+
+	// Reconciling the counts from
+	// scratch should change nothing.
+	if _, err := suite.db.ReconcileStatusCounts(ctx, "", 100); err != nil {
+		suite.FailNow(err.Error())
+	}
+	checkCounts(status.ID, 0, 1, 0)
+}
+
+func (suite *StatusTestSuite) TestRegenerateStatusCounts() {
+	ctx := suite.T().Context()
+
+	// Take a testrig status, whose counts
+	// won't be stored as it wasn't inserted
+	// via PutStatus, and count from scratch.
+	status, err := suite.db.GetStatusByID(ctx, suite.testStatuses["admin_account_status_1"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Nil(status.RepliesCount)
+
+	if err := suite.db.RegenerateStatusCounts(ctx, status); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Counts should match those from the ID lists.
+	replies, err := suite.db.CountStatusReplies(ctx, status.ID)
+	suite.NoError(err)
+	boosts, err := suite.db.CountStatusBoosts(ctx, status.ID)
+	suite.NoError(err)
+	faves, err := suite.db.CountStatusFaves(ctx, status.ID)
+	suite.NoError(err)
+
+	suite.Equal(util.Ptr(replies), status.RepliesCount)
+	suite.Equal(util.Ptr(boosts), status.BoostsCount)
+	suite.Equal(util.Ptr(faves), status.FavesCount)
+	suite.NotZero(replies + boosts + faves)
+}
+
 func TestStatusTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusTestSuite))
 }
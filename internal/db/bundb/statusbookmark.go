@@ -21,6 +21,7 @@
 	"context"
 	"errors"
 	"slices"
+	"time"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gopkg/xslices"
@@ -191,7 +192,7 @@ func (s *statusBookmarkDB) PopulateStatusBookmark(ctx context.Context, bookmark
 	return errs.Combine()
 }
 
-func (s *statusBookmarkDB) GetStatusBookmarks(ctx context.Context, accountID string, limit int, maxID string, minID string) ([]*gtsmodel.StatusBookmark, error) {
+func (s *statusBookmarkDB) GetStatusBookmarks(ctx context.Context, accountID string, collectionID string, limit int, maxID string, minID string) ([]*gtsmodel.StatusBookmark, error) {
 	// Ensure reasonable
 	if limit < 0 {
 		limit = 0
@@ -211,6 +212,10 @@ func (s *statusBookmarkDB) GetStatusBookmarks(ctx context.Context, accountID str
 		return nil, errors.New("must provide an account")
 	}
 
+	if collectionID != "" {
+		q = q.Where("? = ?", bun.Ident("status_bookmark.collection_id"), collectionID)
+	}
+
 	if maxID != "" {
 		q = q.Where("? < ?", bun.Ident("status_bookmark.id"), maxID)
 	}
@@ -256,6 +261,23 @@ func (s *statusBookmarkDB) PutStatusBookmark(ctx context.Context, bookmark *gtsm
 	})
 }
 
+func (s *statusBookmarkDB) UpdateStatusBookmark(ctx context.Context, bookmark *gtsmodel.StatusBookmark, columns ...string) error {
+	bookmark.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	return s.state.Caches.DB.StatusBookmark.Store(bookmark, func() error {
+		_, err := s.db.NewUpdate().
+			Model(bookmark).
+			Where("? = ?", bun.Ident("status_bookmark.id"), bookmark.ID).
+			Column(columns...).
+			Exec(ctx)
+		return err
+	})
+}
+
 func (s *statusBookmarkDB) DeleteStatusBookmarkByID(ctx context.Context, id string) error {
 	// Gather necessary fields from
 	// deleted for cache invaliation.
@@ -340,3 +362,213 @@ func (s *statusBookmarkDB) DeleteStatusBookmarksForStatus(ctx context.Context, s
 
 	return nil
 }
+
+func (s *statusBookmarkDB) GetBookmarkCollectionByID(ctx context.Context, id string) (*gtsmodel.BookmarkCollection, error) {
+	collection, err := s.state.Caches.DB.BookmarkCollection.LoadOne("ID", func() (*gtsmodel.BookmarkCollection, error) {
+		var collection gtsmodel.BookmarkCollection
+
+		// Not cached! Perform database query.
+		if err := s.db.NewSelect().
+			Model(&collection).
+			Where("? = ?", bun.Ident("bookmark_collection.id"), id).
+			Scan(ctx); err != nil {
+			return nil, err
+		}
+
+		return &collection, nil
+	}, id)
+	if err != nil {
+		// already processed
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// Only a barebones model was requested.
+		return collection, nil
+	}
+
+	if err := s.populateBookmarkCollection(ctx, collection); err != nil {
+		return nil, err
+	}
+
+	return collection, nil
+}
+
+func (s *statusBookmarkDB) GetBookmarkCollectionsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.BookmarkCollection, error) {
+	collectionIDs, err := s.state.Caches.DB.BookmarkCollectionIDs.Load(accountID, func() ([]string, error) {
+		var collectionIDs []string
+
+		// Collection IDs not in cache.
+		// Perform the DB query.
+		if _, err := s.db.NewSelect().
+			Table("bookmark_collections").
+			Column("id").
+			Where("? = ?", bun.Ident("account_id"), accountID).
+			OrderExpr("? ASC", bun.Ident("title")).
+			Exec(ctx, &collectionIDs); err != nil &&
+			!errors.Is(err, db.ErrNoEntries) {
+			return nil, err
+		}
+
+		return collectionIDs, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Load all collection IDs via cache loader callbacks.
+	collections, err := s.state.Caches.DB.BookmarkCollection.LoadIDs("ID",
+		collectionIDs,
+		func(uncached []string) ([]*gtsmodel.BookmarkCollection, error) {
+			// Preallocate expected length of uncached collections.
+			collections := make([]*gtsmodel.BookmarkCollection, 0, len(uncached))
+
+			// Perform database query scanning
+			// the remaining (uncached) IDs.
+			if err := s.db.NewSelect().
+				Model(&collections).
+				Where("? IN (?)", bun.Ident("id"), bun.In(uncached)).
+				Scan(ctx); err != nil {
+				return nil, err
+			}
+
+			return collections, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reorder the collections by their
+	// IDs to ensure in correct order.
+	getID := func(c *gtsmodel.BookmarkCollection) string { return c.ID }
+	xslices.OrderBy(collections, collectionIDs, getID)
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return collections, nil
+	}
+
+	// Populate all loaded collections, removing those we fail
+	// to populate (removes needing so many later nil checks).
+	collections = slices.DeleteFunc(collections, func(collection *gtsmodel.BookmarkCollection) bool {
+		if err := s.populateBookmarkCollection(ctx, collection); err != nil {
+			log.Errorf(ctx, "error populating bookmark collection %s: %v", collection.ID, err)
+			return true
+		}
+		return false
+	})
+
+	return collections, nil
+}
+
+func (s *statusBookmarkDB) populateBookmarkCollection(ctx context.Context, collection *gtsmodel.BookmarkCollection) error {
+	var err error
+
+	if collection.Account == nil {
+		// Collection account is not set, fetch from the database.
+		collection.Account, err = s.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			collection.AccountID,
+		)
+		if err != nil {
+			return gtserror.Newf("error populating bookmark collection account: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *statusBookmarkDB) PutBookmarkCollection(ctx context.Context, collection *gtsmodel.BookmarkCollection) error {
+	// note that inserting collection will call OnInvalidateBookmarkCollection()
+	// which will handle clearing the account's cached collection IDs.
+	return s.state.Caches.DB.BookmarkCollection.Store(collection, func() error {
+		_, err := s.db.NewInsert().Model(collection).Exec(ctx)
+		return err
+	})
+}
+
+func (s *statusBookmarkDB) UpdateBookmarkCollection(ctx context.Context, collection *gtsmodel.BookmarkCollection, columns ...string) error {
+	collection.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	return s.state.Caches.DB.BookmarkCollection.Store(collection, func() error {
+		_, err := s.db.NewUpdate().
+			Model(collection).
+			Where("? = ?", bun.Ident("bookmark_collection.id"), collection.ID).
+			Column(columns...).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (s *statusBookmarkDB) DeleteBookmarkCollectionByID(ctx context.Context, id string) error {
+	var (
+		// Acquire collection owner ID.
+		accountID string
+
+		// Gather IDs of bookmarks
+		// that were in collection.
+		bookmarkIDs []string
+	)
+
+	if err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Unset collection ID of all bookmarks
+		// filed in this collection, keeping them.
+		if _, err := tx.NewUpdate().
+			Table("status_bookmarks").
+			Set("? = NULL", bun.Ident("collection_id")).
+			Where("? = ?", bun.Ident("collection_id"), id).
+			Returning("?", bun.Ident("id")).
+			Exec(ctx, &bookmarkIDs); err != nil &&
+			!errors.Is(err, db.ErrNoEntries) {
+			return err
+		}
+
+		// Delete the collection itself.
+		_, err := tx.NewDelete().
+			Table("bookmark_collections").
+			Where("? = ?", bun.Ident("id"), id).
+			Returning("?", bun.Ident("account_id")).
+			Exec(ctx, &accountID)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	// Invalidate cached bookmarks that were in collection.
+	s.state.Caches.DB.StatusBookmark.InvalidateIDs("ID", bookmarkIDs)
+
+	// Invalidate the main collection database cache.
+	s.state.Caches.DB.BookmarkCollection.Invalidate("ID", id)
+
+	// Invalidate cache of collection IDs owned by account.
+	s.state.Caches.DB.BookmarkCollectionIDs.Invalidate(accountID)
+
+	return nil
+}
+
+func (s *statusBookmarkDB) DeleteBookmarkCollectionsByAccountID(ctx context.Context, accountID string) error {
+	// Gather IDs of collections owned by account.
+	var collectionIDs []string
+
+	if _, err := s.db.NewDelete().
+		Table("bookmark_collections").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Returning("?", bun.Ident("id")).
+		Exec(ctx, &collectionIDs); err != nil &&
+		!errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	// Invalidate the main collection database cache.
+	s.state.Caches.DB.BookmarkCollection.InvalidateIDs("ID", collectionIDs)
+
+	// Invalidate cache of collection IDs owned by account.
+	s.state.Caches.DB.BookmarkCollectionIDs.Invalidate(accountID)
+
+	return nil
+}
@@ -131,6 +131,53 @@ func (suite *StatusBookmarkTestSuite) TestDeleteStatusBookmarkByIDNonExisting()
 	suite.NoError(err)
 }
 
+func (suite *StatusBookmarkTestSuite) TestBookmarkCollection() {
+	ctx := suite.T().Context()
+	testBookmark := suite.testBookmarks["local_account_1_admin_account_status_1"]
+
+	collection := &gtsmodel.BookmarkCollection{
+		ID:        "01J9ZW3DAJ1TQ0Q5Q5C2K8VDBS",
+		Title:     "recipes",
+		AccountID: testBookmark.AccountID,
+	}
+	if err := suite.db.PutBookmarkCollection(ctx, collection); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// File the bookmark in the new collection.
+	bookmark, err := suite.db.GetStatusBookmarkByID(ctx, testBookmark.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	bookmark.CollectionID = collection.ID
+	if err := suite.db.UpdateStatusBookmark(ctx, bookmark, "collection_id"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Only the filed bookmark should be in the collection.
+	bookmarks, err := suite.db.GetStatusBookmarks(ctx, testBookmark.AccountID, collection.ID, 0, "", "")
+	suite.NoError(err)
+	suite.Len(bookmarks, 1)
+	suite.Equal(testBookmark.ID, bookmarks[0].ID)
+
+	collections, err := suite.db.GetBookmarkCollectionsByAccountID(ctx, testBookmark.AccountID)
+	suite.NoError(err)
+	suite.Len(collections, 1)
+
+	// Deleting the collection should keep the bookmark.
+	if err := suite.db.DeleteBookmarkCollectionByID(ctx, collection.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	bookmark, err = suite.db.GetStatusBookmarkByID(ctx, testBookmark.ID)
+	suite.NoError(err)
+	suite.Empty(bookmark.CollectionID)
+
+	collections, err = suite.db.GetBookmarkCollectionsByAccountID(ctx, testBookmark.AccountID)
+	suite.NoError(err)
+	suite.Empty(collections)
+}
+
 func TestStatusBookmarkTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusBookmarkTestSuite))
 }
@@ -0,0 +1,94 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type statusBulkDeleteDB struct {
+	db *bun.DB
+}
+
+func (s *statusBulkDeleteDB) GetStatusBulkDeleteByID(ctx context.Context, id string) (*gtsmodel.StatusBulkDelete, error) {
+	bulkDelete := new(gtsmodel.StatusBulkDelete)
+	if err := s.db.NewSelect().
+		Model(bulkDelete).
+		Where("? = ?", bun.Ident("id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return bulkDelete, nil
+}
+
+func (s *statusBulkDeleteDB) GetLatestStatusBulkDelete(ctx context.Context, accountID string) (*gtsmodel.StatusBulkDelete, error) {
+	bulkDelete := new(gtsmodel.StatusBulkDelete)
+	if err := s.db.NewSelect().
+		Model(bulkDelete).
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		OrderExpr("? DESC", bun.Ident("id")).
+		Limit(1).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return bulkDelete, nil
+}
+
+func (s *statusBulkDeleteDB) GetRunningStatusBulkDeletes(ctx context.Context) ([]*gtsmodel.StatusBulkDelete, error) {
+	var bulkDeletes []*gtsmodel.StatusBulkDelete
+	err := s.db.NewSelect().
+		Model(&bulkDeletes).
+		Where("? IS NULL", bun.Ident("completed_at")).
+		OrderExpr("? ASC", bun.Ident("id")).
+		Scan(ctx)
+	return bulkDeletes, err
+}
+
+func (s *statusBulkDeleteDB) PutStatusBulkDelete(ctx context.Context, bulkDelete *gtsmodel.StatusBulkDelete) error {
+	_, err := s.db.NewInsert().
+		Model(bulkDelete).
+		Exec(ctx)
+	return err
+}
+
+func (s *statusBulkDeleteDB) UpdateStatusBulkDelete(ctx context.Context, bulkDelete *gtsmodel.StatusBulkDelete, cols ...string) error {
+	bulkDelete.UpdatedAt = time.Now()
+	if len(cols) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		cols = append(cols, "updated_at")
+	}
+	_, err := s.db.NewUpdate().
+		Model(bulkDelete).
+		Column(cols...).
+		Where("? = ?", bun.Ident("id"), bulkDelete.ID).
+		Exec(ctx)
+	return err
+}
+
+func (s *statusBulkDeleteDB) DeleteStatusBulkDeletesByAccountID(ctx context.Context, accountID string) error {
+	_, err := s.db.NewDelete().
+		Table("status_bulk_deletes").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Exec(ctx)
+	return err
+}
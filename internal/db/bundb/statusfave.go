@@ -249,13 +249,30 @@ func (s *statusFaveDB) PopulateStatusFave(ctx context.Context, statusFave *gtsmo
 }
 
 func (s *statusFaveDB) PutStatusFave(ctx context.Context, fave *gtsmodel.StatusFave) error {
-	return s.state.Caches.DB.StatusFave.Store(fave, func() error {
-		_, err := s.db.
-			NewInsert().
-			Model(fave).
-			Exec(ctx)
+	if err := s.state.Caches.DB.StatusFave.Store(fave, func() error {
+		return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.
+				NewInsert().
+				Model(fave).
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			// Increment faved status faves count.
+			return incrementStatusCount(ctx, tx,
+				"faves_count",
+				fave.StatusID,
+			)
+		})
+	}); err != nil {
 		return err
-	})
+	}
+
+	// Invalidate faved status
+	// now its faves count changed.
+	s.state.Caches.DB.Status.Invalidate("ID", fave.StatusID)
+
+	return nil
 }
 
 func (s *statusFaveDB) UpdateStatusFave(ctx context.Context, fave *gtsmodel.StatusFave, columns ...string) error {
@@ -281,13 +298,23 @@ func (s *statusFaveDB) UpdateStatusFave(ctx context.Context, fave *gtsmodel.Stat
 func (s *statusFaveDB) DeleteStatusFaveByID(ctx context.Context, id string) error {
 	var statusID string
 
-	// Perform DELETE on status fave,
-	// returning the status ID it was for.
-	if _, err := s.db.NewDelete().
-		Table("status_faves").
-		Where("id = ?", id).
-		Returning("status_id").
-		Exec(ctx, &statusID); err != nil {
+	if err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Perform DELETE on status fave,
+		// returning the status ID it was for.
+		if _, err := tx.NewDelete().
+			Table("status_faves").
+			Where("id = ?", id).
+			Returning("status_id").
+			Exec(ctx, &statusID); err != nil {
+			return err
+		}
+
+		// Decrement faved status faves count.
+		return decrementStatusCount(ctx, tx,
+			"faves_count",
+			statusID,
+		)
+	}); err != nil {
 		if err == sql.ErrNoRows {
 			// Not an issue, only due
 			// to us doing a RETURNING.
@@ -302,6 +329,10 @@ func (s *statusFaveDB) DeleteStatusFaveByID(ctx context.Context, id string) erro
 
 		// Invalidate any cached status fave IDs for this status.
 		s.state.Caches.DB.StatusFaveIDs.Invalidate(statusID)
+
+		// Invalidate faved status
+		// now its faves count changed.
+		s.state.Caches.DB.Status.Invalidate("ID", statusID)
 	}
 
 	return nil
@@ -314,22 +345,36 @@ func (s *statusFaveDB) DeleteStatusFaves(ctx context.Context, targetAccountID st
 
 	var statusIDs []string
 
-	// Prepare DELETE query returning
-	// the deleted faves for status IDs.
-	q := s.db.NewDelete().
-		Table("status_faves").
-		Returning("status_id")
+	if err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Prepare DELETE query returning
+		// the deleted faves for status IDs.
+		q := tx.NewDelete().
+			Table("status_faves").
+			Returning("status_id")
 
-	if targetAccountID != "" {
-		q = q.Where("? = ?", bun.Ident("target_account_id"), targetAccountID)
-	}
+		if targetAccountID != "" {
+			q = q.Where("? = ?", bun.Ident("target_account_id"), targetAccountID)
+		}
 
-	if originAccountID != "" {
-		q = q.Where("? = ?", bun.Ident("account_id"), originAccountID)
-	}
+		if originAccountID != "" {
+			q = q.Where("? = ?", bun.Ident("account_id"), originAccountID)
+		}
+
+		// Execute query, store favourited status IDs.
+		if _, err := q.Exec(ctx, &statusIDs); err != nil {
+			return err
+		}
+
+		// Deduplicate determined status IDs.
+		statusIDs = xslices.Deduplicate(statusIDs)
 
-	// Execute query, store favourited status IDs.
-	if _, err := q.Exec(ctx, &statusIDs); err != nil {
+		// Reset faves counts of affected
+		// statuses, to be lazily regenerated.
+		return resetStatusCount(ctx, tx,
+			"faves_count",
+			statusIDs...,
+		)
+	}); err != nil {
 		if err == sql.ErrNoRows {
 			// Not an issue, only due
 			// to us doing a RETURNING.
@@ -338,24 +383,35 @@ func (s *statusFaveDB) DeleteStatusFaves(ctx context.Context, targetAccountID st
 		return err
 	}
 
-	// Deduplicate determined status IDs.
-	statusIDs = xslices.Deduplicate(statusIDs)
-
 	// Invalidate any cached status faves for this status ID.
 	s.state.Caches.DB.StatusFave.InvalidateIDs("ID", statusIDs)
 
 	// Invalidate any cached status fave IDs for this status ID.
 	s.state.Caches.DB.StatusFaveIDs.Invalidate(statusIDs...)
 
+	// Invalidate faved statuses now their faves counts changed.
+	s.state.Caches.DB.Status.InvalidateIDs("ID", statusIDs)
+
 	return nil
 }
 
 func (s *statusFaveDB) DeleteStatusFavesForStatus(ctx context.Context, statusID string) error {
-	// Delete all status faves for status.
-	if _, err := s.db.NewDelete().
-		Table("status_faves").
-		Where("status_id = ?", statusID).
-		Exec(ctx); err != nil {
+	if err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Delete all status faves for status.
+		if _, err := tx.NewDelete().
+			Table("status_faves").
+			Where("status_id = ?", statusID).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Reset faves count of status,
+		// to be lazily regenerated.
+		return resetStatusCount(ctx, tx,
+			"faves_count",
+			statusID,
+		)
+	}); err != nil {
 		return err
 	}
 
@@ -365,5 +421,8 @@ func (s *statusFaveDB) DeleteStatusFavesForStatus(ctx context.Context, statusID
 	// Invalidate any cached status fave IDs for this status.
 	s.state.Caches.DB.StatusFaveIDs.Invalidate(statusID)
 
+	// Invalidate status now its faves count changed.
+	s.state.Caches.DB.Status.Invalidate("ID", statusID)
+
 	return nil
 }
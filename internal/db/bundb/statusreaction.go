@@ -0,0 +1,342 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gopkg/xslices"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type statusReactionDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (s *statusReactionDB) GetStatusReaction(ctx context.Context, accountID string, statusID string, content string) (*gtsmodel.StatusReaction, error) {
+	return s.getStatusReaction(
+		ctx,
+		"AccountID,StatusID,Content",
+		func(reaction *gtsmodel.StatusReaction) error {
+			return s.db.
+				NewSelect().
+				Model(reaction).
+				Where("? = ?", bun.Ident("status_reaction.account_id"), accountID).
+				Where("? = ?", bun.Ident("status_reaction.status_id"), statusID).
+				Where("? = ?", bun.Ident("status_reaction.content"), content).
+				Scan(ctx)
+		},
+		accountID,
+		statusID,
+		content,
+	)
+}
+
+func (s *statusReactionDB) GetStatusReactionByID(ctx context.Context, id string) (*gtsmodel.StatusReaction, error) {
+	return s.getStatusReaction(
+		ctx,
+		"ID",
+		func(reaction *gtsmodel.StatusReaction) error {
+			return s.db.
+				NewSelect().
+				Model(reaction).
+				Where("? = ?", bun.Ident("id"), id).
+				Scan(ctx)
+		},
+		id,
+	)
+}
+
+func (s *statusReactionDB) GetStatusReactionByURI(ctx context.Context, uri string) (*gtsmodel.StatusReaction, error) {
+	return s.getStatusReaction(
+		ctx,
+		"URI",
+		func(reaction *gtsmodel.StatusReaction) error {
+			return s.db.
+				NewSelect().
+				Model(reaction).
+				Where("? = ?", bun.Ident("uri"), uri).
+				Scan(ctx)
+		},
+		uri,
+	)
+}
+
+func (s *statusReactionDB) getStatusReaction(ctx context.Context, lookup string, dbQuery func(*gtsmodel.StatusReaction) error, keyParts ...any) (*gtsmodel.StatusReaction, error) {
+	// Fetch status reaction from database cache with loader callback
+	reaction, err := s.state.Caches.DB.StatusReaction.LoadOne(lookup, func() (*gtsmodel.StatusReaction, error) {
+		var reaction gtsmodel.StatusReaction
+
+		// Not cached! Perform database query.
+		if err := dbQuery(&reaction); err != nil {
+			return nil, err
+		}
+
+		return &reaction, nil
+	}, keyParts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return reaction, nil
+	}
+
+	// Populate the status reaction model.
+	if err := s.PopulateStatusReaction(ctx, reaction); err != nil {
+		return nil, fmt.Errorf("error(s) populating status reaction: %w", err)
+	}
+
+	return reaction, nil
+}
+
+func (s *statusReactionDB) GetStatusReactions(ctx context.Context, statusID string) ([]*gtsmodel.StatusReaction, error) {
+	// Fetch the status reaction IDs for status.
+	reactionIDs, err := s.getStatusReactionIDs(ctx, statusID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load all reaction IDs via cache loader callbacks.
+	reactions, err := s.state.Caches.DB.StatusReaction.LoadIDs("ID",
+		reactionIDs,
+		func(uncached []string) ([]*gtsmodel.StatusReaction, error) {
+			// Preallocate expected length of uncached reactions.
+			reactions := make([]*gtsmodel.StatusReaction, 0, len(uncached))
+
+			// Perform database query scanning
+			// the remaining (uncached) reaction IDs.
+			if err := s.db.NewSelect().
+				Model(&reactions).
+				Where("? IN (?)", bun.Ident("id"), bun.In(uncached)).
+				Scan(ctx); err != nil {
+				return nil, err
+			}
+
+			return reactions, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reorder the reactions by their
+	// IDs to ensure in correct order.
+	getID := func(r *gtsmodel.StatusReaction) string { return r.ID }
+	xslices.OrderBy(reactions, reactionIDs, getID)
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return reactions, nil
+	}
+
+	// Populate all loaded reactions, removing those we fail to
+	// populate (removes needing so many nil checks everywhere).
+	reactions = slices.DeleteFunc(reactions, func(reaction *gtsmodel.StatusReaction) bool {
+		if err := s.PopulateStatusReaction(ctx, reaction); err != nil {
+			log.Errorf(ctx, "error populating reaction %s: %v", reaction.ID, err)
+			return true
+		}
+		return false
+	})
+
+	return reactions, nil
+}
+
+func (s *statusReactionDB) getStatusReactionIDs(ctx context.Context, statusID string) ([]string, error) {
+	return s.state.Caches.DB.StatusReactionIDs.Load(statusID, func() ([]string, error) {
+		var reactionIDs []string
+
+		// Status reaction IDs not in cache, perform DB query!
+		if err := s.db.
+			NewSelect().
+			Table("status_reactions").
+			Column("id").
+			Where("? = ?", bun.Ident("status_id"), statusID).
+			Order("id ASC").
+			Scan(ctx, &reactionIDs); err != nil {
+			return nil, err
+		}
+
+		return reactionIDs, nil
+	})
+}
+
+func (s *statusReactionDB) PopulateStatusReaction(ctx context.Context, reaction *gtsmodel.StatusReaction) error {
+	var (
+		err  error
+		errs = gtserror.NewMultiError(4)
+	)
+
+	if reaction.Account == nil {
+		// StatusReaction author is not set, fetch from database.
+		reaction.Account, err = s.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			reaction.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating status reaction author: %w", err)
+		}
+	}
+
+	if reaction.TargetAccount == nil {
+		// StatusReaction target account is not set, fetch from database.
+		reaction.TargetAccount, err = s.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			reaction.TargetAccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating status reaction target account: %w", err)
+		}
+	}
+
+	if reaction.Status == nil {
+		// StatusReaction status is not set, fetch from database.
+		reaction.Status, err = s.state.DB.GetStatusByID(
+			gtscontext.SetBarebones(ctx),
+			reaction.StatusID,
+		)
+		if err != nil {
+			errs.Appendf("error populating status reaction status: %w", err)
+		}
+	}
+
+	if reaction.EmojiID != "" && reaction.Emoji == nil {
+		// StatusReaction emoji is not set, fetch from database.
+		reaction.Emoji, err = s.state.DB.GetEmojiByID(
+			gtscontext.SetBarebones(ctx),
+			reaction.EmojiID,
+		)
+		if err != nil {
+			errs.Appendf("error populating status reaction emoji: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (s *statusReactionDB) PutStatusReaction(ctx context.Context, reaction *gtsmodel.StatusReaction) error {
+	return s.state.Caches.DB.StatusReaction.Store(reaction, func() error {
+		_, err := s.db.
+			NewInsert().
+			Model(reaction).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (s *statusReactionDB) DeleteStatusReactionByID(ctx context.Context, id string) error {
+	var statusID string
+
+	// Perform DELETE on status reaction,
+	// returning the status ID it was for.
+	if _, err := s.db.NewDelete().
+		Table("status_reactions").
+		Where("? = ?", bun.Ident("id"), id).
+		Returning("status_id").
+		Exec(ctx, &statusID); err != nil {
+		if err == sql.ErrNoRows {
+			// Not an issue, only due
+			// to us doing a RETURNING.
+			err = nil
+		}
+		return err
+	}
+
+	if statusID != "" {
+		// Invalidate any cached status reaction with this ID.
+		s.state.Caches.DB.StatusReaction.Invalidate("ID", id)
+
+		// Invalidate any cached status reaction IDs for this status.
+		s.state.Caches.DB.StatusReactionIDs.Invalidate(statusID)
+	}
+
+	return nil
+}
+
+func (s *statusReactionDB) DeleteStatusReactions(ctx context.Context, targetAccountID string, originAccountID string) error {
+	if targetAccountID == "" && originAccountID == "" {
+		return errors.New("DeleteStatusReactions: one of targetAccountID or originAccountID must be set")
+	}
+
+	var statusIDs []string
+
+	// Prepare DELETE query returning
+	// the deleted reactions for status IDs.
+	q := s.db.NewDelete().
+		Table("status_reactions").
+		Returning("status_id")
+
+	if targetAccountID != "" {
+		q = q.Where("? = ?", bun.Ident("target_account_id"), targetAccountID)
+	}
+
+	if originAccountID != "" {
+		q = q.Where("? = ?", bun.Ident("account_id"), originAccountID)
+	}
+
+	// Execute query, store reacted-to status IDs.
+	if _, err := q.Exec(ctx, &statusIDs); err != nil {
+		if err == sql.ErrNoRows {
+			// Not an issue, only due
+			// to us doing a RETURNING.
+			err = nil
+		}
+		return err
+	}
+
+	// Deduplicate determined status IDs.
+	statusIDs = xslices.Deduplicate(statusIDs)
+
+	// Invalidate any cached status reactions for these status IDs.
+	s.state.Caches.DB.StatusReaction.InvalidateIDs("StatusID", statusIDs)
+
+	// Invalidate any cached status reaction IDs for these status IDs.
+	s.state.Caches.DB.StatusReactionIDs.Invalidate(statusIDs...)
+
+	return nil
+}
+
+func (s *statusReactionDB) DeleteStatusReactionsForStatus(ctx context.Context, statusID string) error {
+	// Delete all status reactions for status.
+	if _, err := s.db.NewDelete().
+		Table("status_reactions").
+		Where("? = ?", bun.Ident("status_id"), statusID).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// Invalidate any cached status reactions for this status.
+	s.state.Caches.DB.StatusReaction.Invalidate("StatusID", statusID)
+
+	// Invalidate any cached status reaction IDs for this status.
+	s.state.Caches.DB.StatusReactionIDs.Invalidate(statusID)
+
+	return nil
+}
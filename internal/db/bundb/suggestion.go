@@ -0,0 +1,328 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/uptrace/bun"
+)
+
+type suggestionDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+// accountCount is used to scan account
+// IDs grouped with a count of some kind.
+type accountCount struct {
+	AccountID string `bun:"account_id"`
+	Count     int    `bun:"count"`
+}
+
+func (s *suggestionDB) GetSuggestions(ctx context.Context, accountID string, limit int) ([]*gtsmodel.Suggestion, error) {
+	var suggestions []*gtsmodel.Suggestion
+
+	if err := s.db.
+		NewSelect().
+		Model(&suggestions).
+		Where("? = ?", bun.Ident("suggestion.account_id"), accountID).
+		Where("? = ?", bun.Ident("suggestion.dismissed"), false).
+		OrderExpr("? DESC, ? DESC", bun.Ident("suggestion.score"), bun.Ident("suggestion.id")).
+		Limit(limit).
+		Scan(ctx); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, err
+	}
+
+	// Populate target accounts, dropping
+	// any suggestions for which the target
+	// account can no longer be found.
+	suggestions = slices.DeleteFunc(suggestions, func(suggestion *gtsmodel.Suggestion) bool {
+		var err error
+		suggestion.TargetAccount, err = s.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			suggestion.TargetAccountID,
+		)
+		if err != nil {
+			log.Errorf(ctx, "error getting suggested account %s: %v", suggestion.TargetAccountID, err)
+			return true
+		}
+		return false
+	})
+
+	return suggestions, nil
+}
+
+func (s *suggestionDB) IsSuggestionDismissed(ctx context.Context, accountID string, targetAccountID string) (bool, error) {
+	return s.db.
+		NewSelect().
+		Model((*gtsmodel.Suggestion)(nil)).
+		Where("? = ?", bun.Ident("suggestion.account_id"), accountID).
+		Where("? = ?", bun.Ident("suggestion.target_account_id"), targetAccountID).
+		Where("? = ?", bun.Ident("suggestion.dismissed"), true).
+		Exists(ctx)
+}
+
+func (s *suggestionDB) ReplaceSuggestions(ctx context.Context, accountID string, suggestions []*gtsmodel.Suggestion) error {
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Drop all existing non-dismissed suggestions.
+		if _, err := tx.
+			NewDelete().
+			Table("suggestions").
+			Where("? = ?", bun.Ident("account_id"), accountID).
+			Where("? = ?", bun.Ident("dismissed"), false).
+			Exec(ctx); err != nil {
+			return gtserror.Newf("error deleting suggestions for account %s: %w", accountID, err)
+		}
+
+		if len(suggestions) == 0 {
+			// Nothing
+			// to insert.
+			return nil
+		}
+
+		// Insert the new suggestions, skipping any
+		// that conflict with dismissed suggestions.
+		if _, err := tx.
+			NewInsert().
+			Model(&suggestions).
+			On("CONFLICT (?, ?) DO NOTHING", bun.Ident("account_id"), bun.Ident("target_account_id")).
+			Exec(ctx); err != nil {
+			return gtserror.Newf("error inserting suggestions for account %s: %w", accountID, err)
+		}
+
+		return nil
+	})
+}
+
+func (s *suggestionDB) DismissSuggestion(ctx context.Context, accountID string, targetAccountID string) error {
+	// Insert a dismissed suggestion, or mark
+	// an existing suggestion as dismissed.
+	if _, err := s.db.
+		NewInsert().
+		Model(&gtsmodel.Suggestion{
+			ID:              id.NewULID(),
+			AccountID:       accountID,
+			TargetAccountID: targetAccountID,
+			Dismissed:       util.Ptr(true),
+		}).
+		On("CONFLICT (?, ?) DO UPDATE", bun.Ident("account_id"), bun.Ident("target_account_id")).
+		Set("? = ?", bun.Ident("dismissed"), true).
+		Exec(ctx); err != nil {
+		return gtserror.Newf("error dismissing suggestion of %s for account %s: %w", targetAccountID, accountID, err)
+	}
+	return nil
+}
+
+func (s *suggestionDB) DeleteSuggestionsByAccountID(ctx context.Context, accountID string) error {
+	if _, err := s.db.
+		NewDelete().
+		Table("suggestions").
+		WhereOr("? = ?", bun.Ident("account_id"), accountID).
+		WhereOr("? = ?", bun.Ident("target_account_id"), accountID).
+		Exec(ctx); err != nil {
+		return gtserror.Newf("error deleting suggestions for account %s: %w", accountID, err)
+	}
+	return nil
+}
+
+func (s *suggestionDB) CountFollowsOfFollows(ctx context.Context, accountID string, limit int) (map[string]int, error) {
+	// Select target accounts of follows owned by
+	// the target accounts of the account's follows.
+	q := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("follows"), bun.Ident("follow")).
+		Join("INNER JOIN ? AS ? ON ? = ?",
+			bun.Ident("follows"), bun.Ident("fof"),
+			bun.Ident("fof.account_id"), bun.Ident("follow.target_account_id"),
+		).
+		ColumnExpr("? AS ?", bun.Ident("fof.target_account_id"), bun.Ident("account_id")).
+		ColumnExpr("COUNT(*) AS ?", bun.Ident("count")).
+		Where("? = ?", bun.Ident("follow.account_id"), accountID).
+		Where("? != ?", bun.Ident("fof.target_account_id"), accountID).
+		// Exclude accounts already followed.
+		Where("? NOT IN (?)",
+			bun.Ident("fof.target_account_id"),
+			s.db.NewSelect().
+				Table("follows").
+				Column("target_account_id").
+				Where("? = ?", bun.Ident("account_id"), accountID),
+		).
+		GroupExpr("?", bun.Ident("fof.target_account_id")).
+		OrderExpr("? DESC", bun.Ident("count")).
+		Limit(limit)
+
+	return scanAccountCounts(ctx, q, nil)
+}
+
+func (s *suggestionDB) CountInteractedAccounts(ctx context.Context, accountID string, since time.Time, limit int) (map[string]int, error) {
+	// IDs are ULIDs, so we can use the (indexed)
+	// IDs to select interactions by creation time.
+	sinceID := id.ZeroULIDForTime(since)
+	counts := make(map[string]int)
+
+	// Count faves of other accounts' statuses.
+	if _, err := scanAccountCounts(ctx, s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_faves"), bun.Ident("status_fave")).
+		ColumnExpr("? AS ?", bun.Ident("status_fave.target_account_id"), bun.Ident("account_id")).
+		ColumnExpr("COUNT(*) AS ?", bun.Ident("count")).
+		Where("? = ?", bun.Ident("status_fave.account_id"), accountID).
+		Where("? != ?", bun.Ident("status_fave.target_account_id"), accountID).
+		Where("? >= ?", bun.Ident("status_fave.id"), sinceID).
+		GroupExpr("?", bun.Ident("status_fave.target_account_id")).
+		OrderExpr("? DESC", bun.Ident("count")).
+		Limit(limit),
+		counts,
+	); err != nil {
+		return nil, err
+	}
+
+	// Count boosts of, and replies
+	// to, other accounts' statuses.
+	for _, column := range []string{
+		"status.boost_of_account_id",
+		"status.in_reply_to_account_id",
+	} {
+		if _, err := scanAccountCounts(ctx, s.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+			ColumnExpr("? AS ?", bun.Ident(column), bun.Ident("account_id")).
+			ColumnExpr("COUNT(*) AS ?", bun.Ident("count")).
+			Where("? = ?", bun.Ident("status.account_id"), accountID).
+			Where("? IS NOT NULL", bun.Ident(column)).
+			Where("? != ?", bun.Ident(column), accountID).
+			Where("? >= ?", bun.Ident("status.id"), sinceID).
+			GroupExpr("?", bun.Ident(column)).
+			OrderExpr("? DESC", bun.Ident("count")).
+			Limit(limit),
+			counts,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return topCounts(counts, limit), nil
+}
+
+func (s *suggestionDB) CountTagOverlapAccounts(ctx context.Context, accountID string, since time.Time, limit int) (map[string]int, error) {
+	// IDs are ULIDs, so we can use the (indexed)
+	// IDs to select statuses by creation time.
+	sinceID := id.ZeroULIDForTime(since)
+
+	// Select IDs of tags
+	// followed by account.
+	followedTagIDs := s.db.
+		NewSelect().
+		Table("followed_tags").
+		Column("tag_id").
+		Where("? = ?", bun.Ident("account_id"), accountID)
+
+	// Select IDs of tags recently
+	// used by account's own statuses.
+	usedTagIDs := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("own_status_to_tag")).
+		Join("INNER JOIN ? AS ? ON ? = ?",
+			bun.Ident("statuses"), bun.Ident("own_status"),
+			bun.Ident("own_status.id"), bun.Ident("own_status_to_tag.status_id"),
+		).
+		Column("own_status_to_tag.tag_id").
+		Where("? = ?", bun.Ident("own_status.account_id"), accountID).
+		Where("? >= ?", bun.Ident("own_status.id"), sinceID)
+
+	// Count recent public statuses of
+	// other accounts using those tags.
+	q := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+		Join("INNER JOIN ? AS ? ON ? = ?",
+			bun.Ident("statuses"), bun.Ident("status"),
+			bun.Ident("status.id"), bun.Ident("status_to_tag.status_id"),
+		).
+		ColumnExpr("? AS ?", bun.Ident("status.account_id"), bun.Ident("account_id")).
+		ColumnExpr("COUNT(*) AS ?", bun.Ident("count")).
+		Where("? != ?", bun.Ident("status.account_id"), accountID).
+		Where("? >= ?", bun.Ident("status.id"), sinceID).
+		Where("? = ?", bun.Ident("status.visibility"), gtsmodel.VisibilityPublic).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				WhereOr("? IN (?)", bun.Ident("status_to_tag.tag_id"), followedTagIDs).
+				WhereOr("? IN (?)", bun.Ident("status_to_tag.tag_id"), usedTagIDs)
+		}).
+		GroupExpr("?", bun.Ident("status.account_id")).
+		OrderExpr("? DESC", bun.Ident("count")).
+		Limit(limit)
+
+	return scanAccountCounts(ctx, q, nil)
+}
+
+// scanAccountCounts scans the account IDs and counts selected by
+// given query into counts, adding to any existing count for each
+// account. If counts is nil, a new map is allocated and returned.
+func scanAccountCounts(ctx context.Context, q *bun.SelectQuery, counts map[string]int) (map[string]int, error) {
+	var rows []accountCount
+	if err := q.Scan(ctx, &rows); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, err
+	}
+
+	if counts == nil {
+		counts = make(map[string]int, len(rows))
+	}
+
+	for _, row := range rows {
+		counts[row.AccountID] += row.Count
+	}
+
+	return counts, nil
+}
+
+// topCounts trims given counts
+// to at most limit highest counts.
+func topCounts(counts map[string]int, limit int) map[string]int {
+	if len(counts) <= limit {
+		return counts
+	}
+
+	rows := make([]accountCount, 0, len(counts))
+	for accountID, count := range counts {
+		rows = append(rows, accountCount{accountID, count})
+	}
+
+	slices.SortFunc(rows, func(a, b accountCount) int {
+		return b.Count - a.Count
+	})
+
+	trimmed := make(map[string]int, limit)
+	for _, row := range rows[:limit] {
+		trimmed[row.AccountID] = row.Count
+	}
+
+	return trimmed
+}
@@ -303,3 +303,79 @@ func (t *tagDB) GetAccountIDsFollowingTagIDs(ctx context.Context, tagIDs []strin
 	// but we only want to return each account once.
 	return xslices.Deduplicate(accountIDs), nil
 }
+
+func (t *tagDB) GetMutedTagIDs(ctx context.Context, accountID string) ([]string, error) {
+	return t.state.Caches.DB.MutedTagIDs.Load(accountID, func() ([]string, error) {
+		var tagIDs []string
+
+		// Tag IDs not in cache. Perform DB query.
+		if _, err := t.db.
+			NewSelect().
+			Model((*gtsmodel.MutedTag)(nil)).
+			Column("tag_id").
+			Where("? = ?", bun.Ident("account_id"), accountID).
+			OrderExpr("? DESC", bun.Ident("tag_id")).
+			Exec(ctx, &tagIDs); // nocollapse
+		err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.Newf("error getting tag IDs muted by account %s: %w", accountID, err)
+		}
+
+		return tagIDs, nil
+	})
+}
+
+func (t *tagDB) IsAccountMutingTag(ctx context.Context, accountID string, tagID string) (bool, error) {
+	mutedTagIDs, err := t.GetMutedTagIDs(ctx, accountID)
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(mutedTagIDs, tagID), nil
+}
+
+func (t *tagDB) PutMutedTag(ctx context.Context, accountID string, tagID string) error {
+	// Insert the muted tag, ignoring
+	// if account already mutes the tag.
+	if _, err := t.db.NewInsert().
+		Model(&gtsmodel.MutedTag{
+			AccountID: accountID,
+			TagID:     tagID,
+		}).
+		On("CONFLICT (?, ?) DO NOTHING", bun.Ident("account_id"), bun.Ident("tag_id")).
+		Exec(ctx); err != nil {
+		return gtserror.Newf("error inserting muted tag: %w", err)
+	}
+
+	// Invalidate tag IDs muted by account.
+	t.state.Caches.DB.MutedTagIDs.Invalidate(accountID)
+
+	return nil
+}
+
+func (t *tagDB) DeleteMutedTag(ctx context.Context, accountID string, tagID string) error {
+	if _, err := t.db.NewDelete().
+		Model((*gtsmodel.MutedTag)(nil)).
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Where("? = ?", bun.Ident("tag_id"), tagID).
+		Exec(ctx); err != nil {
+		return gtserror.Newf("error deleting muted tag %s for account %s: %w", tagID, accountID, err)
+	}
+
+	// Invalidate tag IDs muted by account.
+	t.state.Caches.DB.MutedTagIDs.Invalidate(accountID)
+
+	return nil
+}
+
+func (t *tagDB) DeleteMutedTagsByAccountID(ctx context.Context, accountID string) error {
+	if _, err := t.db.NewDelete().
+		Model((*gtsmodel.MutedTag)(nil)).
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Exec(ctx); err != nil {
+		return gtserror.Newf("error deleting muted tags for account %s: %w", accountID, err)
+	}
+
+	// Invalidate tag IDs muted by account.
+	t.state.Caches.DB.MutedTagIDs.Invalidate(accountID)
+
+	return nil
+}
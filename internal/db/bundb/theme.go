@@ -0,0 +1,77 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type themeDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (t *themeDB) GetThemes(ctx context.Context) ([]*gtsmodel.Theme, error) {
+	var themes []*gtsmodel.Theme
+	err := t.db.NewSelect().
+		Model(&themes).
+		OrderExpr("? ASC", bun.Ident("title")).
+		Scan(ctx)
+	return themes, err
+}
+
+func (t *themeDB) GetThemeByID(ctx context.Context, id string) (*gtsmodel.Theme, error) {
+	theme := new(gtsmodel.Theme)
+	if err := t.db.NewSelect().
+		Model(theme).
+		Where("? = ?", bun.Ident("id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return theme, nil
+}
+
+func (t *themeDB) GetThemeByFileName(ctx context.Context, fileName string) (*gtsmodel.Theme, error) {
+	theme := new(gtsmodel.Theme)
+	if err := t.db.NewSelect().
+		Model(theme).
+		Where("? = ?", bun.Ident("file_name"), fileName).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return theme, nil
+}
+
+func (t *themeDB) PutTheme(ctx context.Context, theme *gtsmodel.Theme) error {
+	_, err := t.db.NewInsert().
+		Model(theme).
+		Exec(ctx)
+	return err
+}
+
+func (t *themeDB) DeleteThemeByID(ctx context.Context, id string) error {
+	_, err := t.db.NewDelete().
+		Table("themes").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return err
+}
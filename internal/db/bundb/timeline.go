@@ -116,6 +116,59 @@ func(q *bun.SelectQuery) (*bun.SelectQuery, error) {
 	)
 }
 
+func (t *timelineDB) GetPublicLanguageTimeline(ctx context.Context, language string, page *paging.Page) ([]*gtsmodel.Status, error) {
+	return loadStatusTimelinePage(ctx, t.db, t.state,
+
+		// Paging
+		// params.
+		page,
+
+		func(q *bun.SelectQuery) (*bun.SelectQuery, error) {
+			// This language only.
+			q = q.Where("? = ?", bun.Ident("language"), language)
+
+			// Public only.
+			q = q.Where("? = ?", bun.Ident("visibility"), gtsmodel.VisibilityPublic)
+
+			// Ignore boosts.
+			q = q.Where("? IS NULL", bun.Ident("boost_of_id"))
+
+			// Only include statuses that aren't pending approval.
+			q = q.Where("? = ?", bun.Ident("pending_approval"), false)
+
+			return q, nil
+		},
+	)
+}
+
+func (t *timelineDB) GetLocalLanguageTimeline(ctx context.Context, language string, page *paging.Page) ([]*gtsmodel.Status, error) {
+	return loadStatusTimelinePage(ctx, t.db, t.state,
+
+		// Paging
+		// params.
+		page,
+
+		func(q *bun.SelectQuery) (*bun.SelectQuery, error) {
+			// This language only.
+			q = q.Where("? = ?", bun.Ident("language"), language)
+
+			// Local only.
+			q = q.Where("? = ?", bun.Ident("local"), true)
+
+			// Public only.
+			q = q.Where("? = ?", bun.Ident("visibility"), gtsmodel.VisibilityPublic)
+
+			// Only include statuses that aren't pending approval.
+			q = q.Where("? = ?", bun.Ident("pending_approval"), false)
+
+			// Ignore boosts.
+			q = q.Where("? IS NULL", bun.Ident("boost_of_id"))
+
+			return q, nil
+		},
+	)
+}
+
 // TODO optimize this query and the logic here, because it's slow as balls -- it takes like a literal second to return with a limit of 20!
 // It might be worth serving it through a timeline instead of raw DB queries, like we do for Home feeds.
 func (t *timelineDB) GetFavedTimeline(ctx context.Context, accountID string, maxID string, minID string, limit int) ([]*gtsmodel.Status, string, string, error) {
@@ -252,6 +305,58 @@ func(q *bun.SelectQuery) (*bun.SelectQuery, error) {
 	)
 }
 
+func (t *timelineDB) GetTagsTimeline(
+	ctx context.Context,
+	anyTagIDs []string,
+	allTagIDs []string,
+	noneTagIDs []string,
+	page *paging.Page,
+) ([]*gtsmodel.Status, error) {
+	if len(anyTagIDs) == 0 {
+		return nil, gtserror.New("no any tag IDs provided")
+	}
+
+	// newTaggedIDs returns a subquery selecting
+	// IDs of statuses using any of given tagIDs.
+	newTaggedIDs := func(tagIDs []string) *bun.SelectQuery {
+		return t.db.NewSelect().
+			Table("status_to_tags").
+			Column("status_id").
+			Where("? IN (?)", bun.Ident("tag_id"), bun.In(tagIDs))
+	}
+
+	return loadStatusTimelinePage(ctx, t.db, t.state,
+
+		// Paging
+		// params.
+		page,
+
+		// Selects public statuses by the intersection of the status
+		// ID sets for each tag combination, using subqueries rather
+		// than joins to avoid duplicate rows for multi-tag statuses.
+		func(q *bun.SelectQuery) (*bun.SelectQuery, error) {
+
+			// Any of these tags.
+			q = q.Where("? IN (?)", bun.Ident("statuses.id"), newTaggedIDs(anyTagIDs))
+
+			// All of these tags.
+			for _, tagID := range allTagIDs {
+				q = q.Where("? IN (?)", bun.Ident("statuses.id"), newTaggedIDs([]string{tagID}))
+			}
+
+			// None of these tags.
+			if len(noneTagIDs) > 0 {
+				q = q.Where("? NOT IN (?)", bun.Ident("statuses.id"), newTaggedIDs(noneTagIDs))
+			}
+
+			// Public only.
+			q = q.Where("? = ?", bun.Ident("visibility"), gtsmodel.VisibilityPublic)
+
+			return q, nil
+		},
+	)
+}
+
 func (t *timelineDB) getHomeAccountIDs(ctx context.Context, accountID string) ([]string, error) {
 	return t.state.Caches.DB.HomeAccountIDs.Load(accountID, func() ([]string, error) {
 		// As this is the home timeline, it should be
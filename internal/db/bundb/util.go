@@ -131,6 +131,26 @@ func whereStartsLike(
 	)
 }
 
+// whereDomainOrSubdomain appends a WHERE clause
+// to the given SelectQuery, which matches the given
+// domain column against domain, or any subdomain of it.
+func whereDomainOrSubdomain(
+	query *bun.SelectQuery,
+	column string,
+	domain string,
+) *bun.SelectQuery {
+	// Escape existing wildcard + escape
+	// chars in domain, then match on any
+	// zero or more chars before a '.'.
+	subdomains := `%.` + likeEscaper.Replace(domain)
+
+	return query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.
+			Where("? = ?", bun.Ident(column), domain).
+			WhereOr("? LIKE ? ESCAPE ?", bun.Ident(column), subdomains, `\`)
+	})
+}
+
 // exists checks the results of a SelectQuery for the existence of the data in question, masking ErrNoEntries errors.
 func exists(ctx context.Context, query *bun.SelectQuery) (bool, error) {
 	exists, err := query.Exists(ctx)
@@ -212,6 +232,65 @@ func decrementAccountStats(ctx context.Context, tx bun.Tx, col bun.Ident, accoun
 	return nil
 }
 
+// incrementStatusCount will increment the given denormalized interaction
+// count column in the `statuses` table matching `id`. Columns which are
+// not yet counted (ie., null) are left for lazy regeneration later.
+func incrementStatusCount(ctx context.Context, tx bun.Tx, col bun.Ident, statusID string) error {
+	if _, err := tx.NewUpdate().
+		Table("statuses").
+		Where("? = ?", bun.Ident("id"), statusID).
+		Where("? IS NOT NULL", bun.Ident(col)).
+		Set("? = (? + 1)", bun.Ident(col), bun.Ident(col)).
+		Exec(ctx); err != nil {
+		return gtserror.Newf("error updating %s: %w", col, err)
+	}
+	return nil
+}
+
+// decrementStatusCount will decrement the given denormalized interaction count
+// column in the `statuses` table matching `id`, not allowing the value to
+// decrement below 0. Columns which are not yet counted (ie., null) are left
+// for lazy regeneration later.
+func decrementStatusCount(ctx context.Context, tx bun.Tx, col bun.Ident, statusID string) error {
+	q := tx.NewUpdate().
+		Table("statuses").
+		Where("? = ?", bun.Ident("id"), statusID).
+		Where("? IS NOT NULL", bun.Ident(col))
+
+	// Set either to zero or to [col]-1 using
+	// MAX (sqlite) or GREATEST (postgres) funcs.
+	switch d := tx.Dialect().Name(); d {
+	case dialect.SQLite:
+		q = q.Set("? = MAX(0, ? - 1)", bun.Ident(col), bun.Ident(col))
+	case dialect.PG:
+		q = q.Set("? = GREATEST(0, ? - 1)", bun.Ident(col), bun.Ident(col))
+	default:
+		panic("dialect " + d.String() + " was neither pg nor sqlite")
+	}
+
+	if _, err := q.Exec(ctx); err != nil {
+		return gtserror.Newf("error updating %s: %w", col, err)
+	}
+	return nil
+}
+
+// resetStatusCount will set the given denormalized interaction count column in
+// the `statuses` table matching `id` to null, for when the change in count can't
+// easily be determined, so it will instead be lazily regenerated when next needed.
+func resetStatusCount(ctx context.Context, tx bun.Tx, col bun.Ident, statusIDs ...string) error {
+	if len(statusIDs) == 0 {
+		return nil
+	}
+	if _, err := tx.NewUpdate().
+		Table("statuses").
+		Where("? IN (?)", bun.Ident("id"), bun.In(statusIDs)).
+		Set("? = NULL", bun.Ident(col)).
+		Exec(ctx); err != nil {
+		return gtserror.Newf("error updating %s: %w", col, err)
+	}
+	return nil
+}
+
 // updateWhere parses []db.Where and adds it to the given update query.
 func updateWhere(q *bun.UpdateQuery, where []db.Where) {
 	for _, w := range where {
@@ -0,0 +1,78 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type webfingerAliasDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (w *webfingerAliasDB) GetWebfingerAliasByID(ctx context.Context, id string) (*gtsmodel.WebfingerAlias, error) {
+	alias := new(gtsmodel.WebfingerAlias)
+	if err := w.db.NewSelect().
+		Model(alias).
+		Where("? = ?", bun.Ident("id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return alias, nil
+}
+
+func (w *webfingerAliasDB) GetWebfingerAliasByUsernameDomain(ctx context.Context, username string, domain string) (*gtsmodel.WebfingerAlias, error) {
+	alias := new(gtsmodel.WebfingerAlias)
+	if err := w.db.NewSelect().
+		Model(alias).
+		Where("? = ?", bun.Ident("username"), username).
+		Where("? = ?", bun.Ident("domain"), domain).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return alias, nil
+}
+
+func (w *webfingerAliasDB) GetWebfingerAliases(ctx context.Context) ([]*gtsmodel.WebfingerAlias, error) {
+	var aliases []*gtsmodel.WebfingerAlias
+	err := w.db.NewSelect().
+		Model(&aliases).
+		OrderExpr("? DESC", bun.Ident("id")).
+		Scan(ctx)
+	return aliases, err
+}
+
+func (w *webfingerAliasDB) PutWebfingerAlias(ctx context.Context, alias *gtsmodel.WebfingerAlias) error {
+	_, err := w.db.NewInsert().
+		Model(alias).
+		Exec(ctx)
+	return err
+}
+
+func (w *webfingerAliasDB) DeleteWebfingerAliasByID(ctx context.Context, id string) error {
+	_, err := w.db.NewDelete().
+		Table("webfinger_aliases").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return err
+}
@@ -27,14 +27,20 @@ type DB interface {
 	Account
 	Admin
 	AdvancedMigration
+	Antenna
 	Application
 	Basic
 	Conversation
 	Domain
+	Draft
 	Emoji
 	HeaderFilter
+	InboxActivity
 	Instance
+	Invite
+	IPRule
 	Interaction
+	LandingBlock
 	Filter
 	List
 	Marker
@@ -52,13 +58,18 @@ type DB interface {
 	SinBinStatus
 	Status
 	StatusBookmark
+	StatusBulkDelete
 	StatusEdit
 	StatusFave
+	StatusReaction
+	Suggestion
 	Tag
+	Theme
 	Thread
 	Timeline
 	User
 	Tombstone
+	WebfingerAlias
 	WebPush
 	WorkerTask
 }
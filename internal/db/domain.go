@@ -141,6 +141,40 @@ type Domain interface {
 	// DeleteDomainPermissionDraft deletes one DomainPermissionDraft with the given id.
 	DeleteDomainPermissionDraft(ctx context.Context, id string) error
 
+	/*
+		Domain limit suggestion stuff.
+	*/
+
+	// GetDomainLimitSuggestionByID gets one DomainLimitSuggestion with the given ID.
+	GetDomainLimitSuggestionByID(ctx context.Context, id string) (*gtsmodel.DomainLimitSuggestion, error)
+
+	// GetDomainLimitSuggestions returns a page of
+	// DomainLimitSuggestions using the given parameters.
+	GetDomainLimitSuggestions(
+		ctx context.Context,
+		permSubID string,
+		domain string,
+		page *paging.Page,
+	) ([]*gtsmodel.DomainLimitSuggestion, error)
+
+	// PutDomainLimitSuggestion stores one DomainLimitSuggestion.
+	PutDomainLimitSuggestion(ctx context.Context, suggestion *gtsmodel.DomainLimitSuggestion) error
+
+	// UpdateDomainLimitSuggestion updates the provided
+	// columns of one DomainLimitSuggestion.
+	UpdateDomainLimitSuggestion(
+		ctx context.Context,
+		suggestion *gtsmodel.DomainLimitSuggestion,
+		columns ...string,
+	) error
+
+	// DeleteDomainLimitSuggestion deletes one DomainLimitSuggestion with the given id.
+	DeleteDomainLimitSuggestion(ctx context.Context, id string) error
+
+	// DeleteDomainLimitSuggestionsBySubscriptionID deletes all
+	// DomainLimitSuggestions created by the given subscription ID.
+	DeleteDomainLimitSuggestionsBySubscriptionID(ctx context.Context, permSubID string) error
+
 	/*
 		Domain permission exclude stuff.
 	*/
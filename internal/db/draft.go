@@ -0,0 +1,52 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+)
+
+// Draft handles getting/creation/updating/deletion of status drafts.
+type Draft interface {
+	// GetDraftByID gets one draft with the given id.
+	GetDraftByID(ctx context.Context, id string) (*gtsmodel.Draft, error)
+
+	// GetDraftsForAccount returns a page of drafts authored by the given account, newest first.
+	GetDraftsForAccount(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.Draft, error)
+
+	// PopulateDraft ensures that the draft's struct fields are populated.
+	PopulateDraft(ctx context.Context, draft *gtsmodel.Draft) error
+
+	// PutDraft puts the given draft in the database,
+	// marking any of its media attachments as belonging to it.
+	PutDraft(ctx context.Context, draft *gtsmodel.Draft) error
+
+	// UpdateDraft updates the given draft in the database, marking any
+	// (new) media attachments as belonging to it. If no columns are
+	// specified, every column is updated.
+	UpdateDraft(ctx context.Context, draft *gtsmodel.Draft, columns ...string) error
+
+	// DeleteDraftByID deletes one draft from the database.
+	DeleteDraftByID(ctx context.Context, id string) error
+
+	// DeleteDraftsByAccountID deletes all drafts by the given account from the database.
+	DeleteDraftsByAccountID(ctx context.Context, accountID string) error
+}
@@ -0,0 +1,41 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// InboxActivity contains functionality for tracking recently
+// processed incoming activities by receiving account and URI.
+type InboxActivity interface {
+	// InboxActivityExists returns true if an inbox activity with
+	// the given URI exists for the given receiving account ID.
+	InboxActivityExists(ctx context.Context, accountID string, uri string) (bool, error)
+
+	// PutInboxActivity stores a new inbox activity in the database, returning
+	// ErrAlreadyExists if the URI was already stored for the receiving account.
+	PutInboxActivity(ctx context.Context, activity *gtsmodel.InboxActivity) error
+
+	// DeleteInboxActivitiesOlderThan deletes all inbox activities
+	// created before the given time, returning the number deleted.
+	DeleteInboxActivitiesOlderThan(ctx context.Context, olderThan time.Time) (int, error)
+}
@@ -19,6 +19,7 @@
 
 import (
 	"context"
+	"time"
 
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 )
@@ -34,6 +35,29 @@ type Instance interface {
 	// CountInstanceDomains returns the number of known instances known that the given domain federates with.
 	CountInstanceDomains(ctx context.Context, domain string) (int, error)
 
+	// CountDomainAccounts returns the number of known accounts
+	// from the given remote domain, including its subdomains.
+	CountDomainAccounts(ctx context.Context, domain string) (int, error)
+
+	// CountDomainStatuses returns the number of statuses stored from
+	// accounts on the given remote domain, including its subdomains.
+	CountDomainStatuses(ctx context.Context, domain string) (int, error)
+
+	// CountDomainMedia returns the number and total size in bytes of media
+	// attachments currently cached locally from accounts on the given remote
+	// domain, including its subdomains.
+	CountDomainMedia(ctx context.Context, domain string) (count int, size int, err error)
+
+	// CountDomainFollows returns the number of local accounts following at least one account
+	// on the given remote domain (including its subdomains), and the number of accounts on that
+	// domain following at least one local account.
+	CountDomainFollows(ctx context.Context, domain string) (localFollowers int, remoteFollowers int, err error)
+
+	// CountDomainInteractions returns the number of interactions (faves, boosts, and replies)
+	// since the given time by local accounts with statuses from the given remote domain
+	// (including its subdomains), and by accounts on that domain with local statuses.
+	CountDomainInteractions(ctx context.Context, domain string, since time.Time) (fromLocal int, toLocal int, err error)
+
 	// GetInstance returns the instance entry for the given domain, if it exists.
 	GetInstance(ctx context.Context, domain string) (*gtsmodel.Instance, error)
 
@@ -0,0 +1,53 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+)
+
+// Invite handles getting/creation/updating of sign up invites.
+type Invite interface {
+	// GetInviteByID fetches the invite with ID from the database.
+	GetInviteByID(ctx context.Context, id string) (*gtsmodel.Invite, error)
+
+	// GetInviteByCode fetches the invite with the given code from the database.
+	GetInviteByCode(ctx context.Context, code string) (*gtsmodel.Invite, error)
+
+	// GetInvites fetches a page of invites created by the given account ID,
+	// newest first. If createdByAccountID is empty, all invites are returned.
+	GetInvites(ctx context.Context, createdByAccountID string, page *paging.Page) ([]*gtsmodel.Invite, error)
+
+	// PutInvite inserts the given invite into the database.
+	PutInvite(ctx context.Context, invite *gtsmodel.Invite) error
+
+	// UpdateInvite updates the given invite in the database, only updating given columns if provided.
+	UpdateInvite(ctx context.Context, invite *gtsmodel.Invite, cols ...string) error
+
+	// ConsumeInvite atomically increments the uses of the invite with ID,
+	// provided it is still usable (ie., not expired and not exhausted).
+	// Returns ErrNoEntries if the invite could not be consumed.
+	ConsumeInvite(ctx context.Context, id string) error
+
+	// ExpireInvitesByAccountID sets all unexpired invites created
+	// by the given account ID to expire now, making them unusable.
+	ExpireInvitesByAccountID(ctx context.Context, accountID string) error
+}
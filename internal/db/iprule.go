@@ -0,0 +1,51 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"net/netip"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// IPRule handles getting/creation/deletion/updating of sign up / sign in IP rules.
+type IPRule interface {
+	// MatchIPRules returns all unexpired IP rules containing the given IP address.
+	// (Note: matching is performed against cached, pre-parsed IP rules).
+	MatchIPRules(ctx context.Context, ip netip.Addr) ([]*gtsmodel.IPRule, error)
+
+	// GetIPRuleByID fetches the IP rule with ID from the database.
+	GetIPRuleByID(ctx context.Context, id string) (*gtsmodel.IPRule, error)
+
+	// GetIPRules fetches all IP rules from the database, newest first.
+	GetIPRules(ctx context.Context) ([]*gtsmodel.IPRule, error)
+
+	// PutIPRule inserts the given IP rule into the database.
+	PutIPRule(ctx context.Context, rule *gtsmodel.IPRule) error
+
+	// UpdateIPRule updates the given IP rule in the database, only updating given columns if provided.
+	UpdateIPRule(ctx context.Context, rule *gtsmodel.IPRule, cols ...string) error
+
+	// IncrementIPRuleHits increments the hit counter of the IP rule with
+	// ID, and sets its last hit time, to record a blocked attempt.
+	IncrementIPRuleHits(ctx context.Context, id string) error
+
+	// DeleteIPRuleByID deletes the IP rule with ID from the database.
+	DeleteIPRuleByID(ctx context.Context, id string) error
+}
@@ -0,0 +1,42 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// LandingBlock handles getting/creation/deletion/updating of instance landing page blocks.
+type LandingBlock interface {
+	// GetLandingBlockByID fetches the landing block with ID from the database.
+	GetLandingBlockByID(ctx context.Context, id string) (*gtsmodel.LandingBlock, error)
+
+	// GetLandingBlocks fetches all landing blocks from the database, in page order.
+	GetLandingBlocks(ctx context.Context) ([]*gtsmodel.LandingBlock, error)
+
+	// PutLandingBlock inserts the given landing block into the database.
+	PutLandingBlock(ctx context.Context, block *gtsmodel.LandingBlock) error
+
+	// UpdateLandingBlock updates the given landing block in the database, only updating given columns if provided.
+	UpdateLandingBlock(ctx context.Context, block *gtsmodel.LandingBlock, cols ...string) error
+
+	// DeleteLandingBlockByID deletes the landing block with ID from the database.
+	DeleteLandingBlockByID(ctx context.Context, id string) error
+}
@@ -71,6 +71,16 @@ type List interface {
 	// DeleteListByID deletes one list with the given ID.
 	DeleteListByID(ctx context.Context, id string) error
 
+	// GetListEntry gets the list entry with given list ID and follow ID.
+	GetListEntry(ctx context.Context, listID string, followID string) (*gtsmodel.ListEntry, error)
+
+	// GetListEntries gets all the list entries contained within given list ID.
+	GetListEntries(ctx context.Context, listID string) ([]*gtsmodel.ListEntry, error)
+
+	// UpdateListEntry updates the given list entry.
+	// Columns is optional, if not specified all will be updated.
+	UpdateListEntry(ctx context.Context, listEntry *gtsmodel.ListEntry, columns ...string) error
+
 	// PutListEntries inserts a slice of listEntries into the database.
 	// It uses a transaction to ensure no partial updates.
 	PutListEntries(ctx context.Context, listEntries []*gtsmodel.ListEntry) error
@@ -19,6 +19,7 @@
 
 import (
 	"context"
+	"time"
 
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/paging"
@@ -77,4 +78,40 @@ type Notification interface {
 	// the given statusID. This function is useful when a status has been deleted,
 	// and so notifications relating to that status must also be deleted.
 	DeleteNotificationsForStatus(ctx context.Context, statusID string) error
+
+	// CountNotifications returns the number of notifications stored in
+	// the database created before olderThan. If olderThan is zero, all
+	// notifications are counted.
+	CountNotifications(ctx context.Context, olderThan time.Time) (int, error)
+
+	// DeleteNotificationsOlderThan deletes up to limit of the oldest
+	// notifications created before olderThan, returning the number
+	// of notifications deleted. Call repeatedly until it returns 0
+	// to delete all notifications created before olderThan.
+	DeleteNotificationsOlderThan(ctx context.Context, olderThan time.Time, limit int) (int, error)
+
+	// GetNotificationGroupKey returns the group key of the newest grouped
+	// notification of the given type, targeting targetAcctID and pertaining
+	// to statusOrEditID, that was created at or after since. Returns an empty
+	// string if no such notification exists. statusOrEditID can be empty.
+	GetNotificationGroupKey(
+		ctx context.Context,
+		notifType gtsmodel.NotificationType,
+		targetAcctID string,
+		statusOrEditID string,
+		since time.Time,
+	) (string, error)
+
+	// GetNotificationGroup returns a page of notifications targeting
+	// the given accountID with the given group key, ordered ID descending.
+	GetNotificationGroup(ctx context.Context, accountID string, groupKey string, page *paging.Page) ([]*gtsmodel.Notification, error)
+
+	// CountNotificationGroup returns the number of notifications
+	// targeting the given accountID with the given group key.
+	CountNotificationGroup(ctx context.Context, accountID string, groupKey string) (int, error)
+
+	// DeleteNotificationGroup deletes all notifications targeting the
+	// given accountID with the given group key, and removes them from
+	// the in-memory cache.
+	DeleteNotificationGroup(ctx context.Context, accountID string, groupKey string) error
 }
@@ -47,6 +47,9 @@ type Relationship interface {
 	// PutBlock attempts to place the given account block in the database.
 	PutBlock(ctx context.Context, block *gtsmodel.Block) error
 
+	// PutBlocks is like PutBlock, but places all given blocks in a single transaction.
+	PutBlocks(ctx context.Context, blocks ...*gtsmodel.Block) error
+
 	// DeleteBlockByID removes block with given ID from the database.
 	DeleteBlockByID(ctx context.Context, id string) error
 
@@ -92,6 +95,14 @@ type Relationship interface {
 	// IsMutualFollowing returns true if account1 and account2 both follow each other, or an error if something goes wrong while finding out.
 	IsMutualFollowing(ctx context.Context, sourceAccountID string, targetAccountID string) (bool, error)
 
+	// IsFollowingFollowerOf returns true if sourceAccount follows any account which follows targetAccount,
+	// ie., if sourceAccount is a follower of one of targetAccount's followers, as far as this instance knows.
+	IsFollowingFollowerOf(ctx context.Context, sourceAccountID string, targetAccountID string) (bool, error)
+
+	// GetFamiliarFollowers returns follows targeting targetAccount from accounts that sourceAccount follows,
+	// ie., those of targetAccount's followers that sourceAccount also follows, as far as this instance knows.
+	GetFamiliarFollowers(ctx context.Context, sourceAccountID string, targetAccountID string) ([]*gtsmodel.Follow, error)
+
 	// IsFollowRequested returns true if sourceAccount has requested to follow target account, or an error if something goes wrong while finding out.
 	IsFollowRequested(ctx context.Context, sourceAccountID string, targetAccountID string) (bool, error)
 
@@ -113,6 +124,9 @@ type Relationship interface {
 	// DeleteFollowByID deletes a follow from the database with the given ID.
 	DeleteFollowByID(ctx context.Context, id string) error
 
+	// DeleteFollowsByIDs is like DeleteFollowByID, but deletes all given follows in a single transaction.
+	DeleteFollowsByIDs(ctx context.Context, ids ...string) error
+
 	// DeleteFollowByURI deletes a follow from the database with the given URI.
 	DeleteFollowByURI(ctx context.Context, uri string) error
 
@@ -122,6 +136,9 @@ type Relationship interface {
 	// DeleteFollowRequestByID deletes a follow request from the database with the given ID.
 	DeleteFollowRequestByID(ctx context.Context, id string) error
 
+	// DeleteFollowRequestsByIDs is like DeleteFollowRequestByID, but deletes all given follow requests in a single transaction.
+	DeleteFollowRequestsByIDs(ctx context.Context, ids ...string) error
+
 	// DeleteFollowRequestByURI deletes a follow request from the database with the given URI.
 	DeleteFollowRequestByURI(ctx context.Context, uri string) error
 
@@ -215,6 +232,9 @@ type Relationship interface {
 	// PutMute attempts to insert or update the given account mute in the database.
 	PutMute(ctx context.Context, mute *gtsmodel.UserMute) error
 
+	// PutMutes is like PutMute, but inserts or updates all given mutes in a single transaction.
+	PutMutes(ctx context.Context, mutes ...*gtsmodel.UserMute) error
+
 	// DeleteMuteByID removes mute with given ID from the database.
 	DeleteMuteByID(ctx context.Context, id string) error
 
@@ -31,7 +31,7 @@ type Report interface {
 
 	// GetReports gets limit n reports using the given parameters.
 	// Parameters that are empty / zero are ignored.
-	GetReports(ctx context.Context, resolved *bool, accountID string, targetAccountID string, page *paging.Page) ([]*gtsmodel.Report, error)
+	GetReports(ctx context.Context, resolved *bool, accountID string, targetAccountID string, category gtsmodel.ReportCategory, assignedAccountID string, page *paging.Page) ([]*gtsmodel.Report, error)
 
 	// PopulateReport populates the struct pointers on the given report.
 	PopulateReport(ctx context.Context, report *gtsmodel.Report) error
@@ -46,6 +46,20 @@ type Report interface {
 	// as a specific column.
 	UpdateReport(ctx context.Context, report *gtsmodel.Report, columns ...string) error
 
-	// DeleteReportByID deletes report with the given id.
+	// DeleteReportByID deletes report with the given id,
+	// along with any notes made on the report.
 	DeleteReportByID(ctx context.Context, id string) error
+
+	// GetReportNoteByID gets one report note by its db id.
+	GetReportNoteByID(ctx context.Context, id string) (*gtsmodel.ReportNote, error)
+
+	// GetReportNotes gets all notes made on the report
+	// with the given id, in ascending chronological order.
+	GetReportNotes(ctx context.Context, reportID string) ([]*gtsmodel.ReportNote, error)
+
+	// PutReportNote puts the given report note in the database.
+	PutReportNote(ctx context.Context, note *gtsmodel.ReportNote) error
+
+	// DeleteReportNoteByID deletes report note with the given id.
+	DeleteReportNoteByID(ctx context.Context, id string) error
 }
@@ -19,6 +19,7 @@
 
 import (
 	"context"
+	"time"
 
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 )
@@ -59,6 +60,19 @@ type Status interface {
 	// GetStatuses gets a slice of statuses corresponding to the given status IDs.
 	GetStatusesByIDs(ctx context.Context, ids []string) ([]*gtsmodel.Status, error)
 
+	// GetTrashedStatuses fetches up to limit statuses whose deleted_at column is set to before the given time,
+	// ie., statuses in the trash which were deleted before the given time, ordered by deleted_at descending.
+	GetTrashedStatuses(ctx context.Context, deletedBefore time.Time, limit int) ([]*gtsmodel.Status, error)
+
+	// GetExpiredStatuses fetches up to limit statuses whose expires_at column is set to before the given time,
+	// ie., statuses due to be automatically deleted, ordered by expires_at descending. Trashed statuses are excluded.
+	GetExpiredStatuses(ctx context.Context, expiredBefore time.Time, limit int) ([]*gtsmodel.Status, error)
+
+	// GetStatusesToAutoDelete fetches up to limit statuses authored by the given account with IDs between minID and
+	// maxID (both exclusive, minID may be empty), which are eligible for automatic deletion, ie., not boosts, not pinned,
+	// not in the trash, and not bookmarked or faved by the author. Statuses are ordered by ID ascending (oldest first).
+	GetStatusesToAutoDelete(ctx context.Context, accountID string, minID string, maxID string, limit int) ([]*gtsmodel.Status, error)
+
 	// GetStatusesUsingEmoji fetches all status models using emoji with given ID stored in their 'emojis' column.
 	GetStatusesUsingEmoji(ctx context.Context, emojiID string) ([]*gtsmodel.Status, error)
 
@@ -74,6 +88,15 @@ type Status interface {
 	// CountStatusBoosts returns the number of stored boosts for status ID.
 	CountStatusBoosts(ctx context.Context, statusID string) (int, error)
 
+	// RegenerateStatusCounts recalculates the denormalized replies, boosts and faves
+	// counts of the given status from scratch using COUNTs, setting them on the model.
+	RegenerateStatusCounts(ctx context.Context, status *gtsmodel.Status) error
+
+	// ReconcileStatusCounts recalculates the denormalized interaction counts of up to limit
+	// already-counted statuses with ID lower than maxID (or from the newest, if empty), ordered
+	// DESC by ID. Returns the lowest status ID reconciled for paging, or empty string when done.
+	ReconcileStatusCounts(ctx context.Context, maxID string, limit int) (string, error)
+
 	// IsStatusBoostedBy checks whether the given status ID is boosted by account ID.
 	IsStatusBoostedBy(ctx context.Context, statusID string, accountID string) (bool, error)
 
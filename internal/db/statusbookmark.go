@@ -38,14 +38,19 @@ type StatusBookmark interface {
 
 	// GetStatusBookmarks retrieves status bookmarks created by the given accountID,
 	// and using the provided parameters. If limit is < 0 then no limit will be set.
+	// If collectionID is set, only bookmarks filed in that collection are returned.
 	//
 	// This function is primarily useful for paging through bookmarks in a sort of
 	// timeline view.
-	GetStatusBookmarks(ctx context.Context, accountID string, limit int, maxID string, minID string) ([]*gtsmodel.StatusBookmark, error)
+	GetStatusBookmarks(ctx context.Context, accountID string, collectionID string, limit int, maxID string, minID string) ([]*gtsmodel.StatusBookmark, error)
 
 	// PutStatusBookmark inserts the given statusBookmark into the database.
 	PutStatusBookmark(ctx context.Context, statusBookmark *gtsmodel.StatusBookmark) error
 
+	// UpdateStatusBookmark updates the given statusBookmark in the database.
+	// Columns is optional, if not specified all will be updated.
+	UpdateStatusBookmark(ctx context.Context, statusBookmark *gtsmodel.StatusBookmark, columns ...string) error
+
 	// DeleteStatusBookmark deletes one status bookmark with the given ID.
 	DeleteStatusBookmarkByID(ctx context.Context, id string) error
 
@@ -68,4 +73,24 @@ type StatusBookmark interface {
 	// given status ID. This is useful when a status has been deleted, and you need
 	// to clean up after it.
 	DeleteStatusBookmarksForStatus(ctx context.Context, statusID string) error
+
+	// GetBookmarkCollectionByID gets one bookmark collection with the given ID.
+	GetBookmarkCollectionByID(ctx context.Context, id string) (*gtsmodel.BookmarkCollection, error)
+
+	// GetBookmarkCollectionsByAccountID gets all bookmark collections owned by the given accountID.
+	GetBookmarkCollectionsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.BookmarkCollection, error)
+
+	// PutBookmarkCollection inserts the given bookmark collection into the database.
+	PutBookmarkCollection(ctx context.Context, collection *gtsmodel.BookmarkCollection) error
+
+	// UpdateBookmarkCollection updates the given bookmark collection.
+	// Columns is optional, if not specified all will be updated.
+	UpdateBookmarkCollection(ctx context.Context, collection *gtsmodel.BookmarkCollection, columns ...string) error
+
+	// DeleteBookmarkCollectionByID deletes one bookmark collection with the given ID.
+	// Bookmarks filed in the collection are kept, but no longer in any collection.
+	DeleteBookmarkCollectionByID(ctx context.Context, id string) error
+
+	// DeleteBookmarkCollectionsByAccountID deletes all bookmark collections owned by the given accountID.
+	DeleteBookmarkCollectionsByAccountID(ctx context.Context, accountID string) error
 }
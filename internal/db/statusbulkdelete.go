@@ -0,0 +1,45 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// StatusBulkDelete handles getting/creation/updating of status bulk deletes.
+type StatusBulkDelete interface {
+	// GetStatusBulkDeleteByID fetches the status bulk delete with ID from the database.
+	GetStatusBulkDeleteByID(ctx context.Context, id string) (*gtsmodel.StatusBulkDelete, error)
+
+	// GetLatestStatusBulkDelete fetches the most recently started status bulk delete for the given account ID.
+	GetLatestStatusBulkDelete(ctx context.Context, accountID string) (*gtsmodel.StatusBulkDelete, error)
+
+	// GetRunningStatusBulkDeletes fetches all status bulk deletes that have not yet completed or been aborted.
+	GetRunningStatusBulkDeletes(ctx context.Context) ([]*gtsmodel.StatusBulkDelete, error)
+
+	// PutStatusBulkDelete inserts the given status bulk delete into the database.
+	PutStatusBulkDelete(ctx context.Context, bulkDelete *gtsmodel.StatusBulkDelete) error
+
+	// UpdateStatusBulkDelete updates the given status bulk delete in the database, only updating given columns if provided.
+	UpdateStatusBulkDelete(ctx context.Context, bulkDelete *gtsmodel.StatusBulkDelete, cols ...string) error
+
+	// DeleteStatusBulkDeletesByAccountID deletes all status bulk deletes for the given account ID.
+	DeleteStatusBulkDeletesByAccountID(ctx context.Context, accountID string) error
+}
@@ -0,0 +1,63 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+type Suggestion interface {
+	// GetSuggestions returns up to limit follow suggestions for the given
+	// account which have not been dismissed, ordered by score descending.
+	GetSuggestions(ctx context.Context, accountID string, limit int) ([]*gtsmodel.Suggestion, error)
+
+	// IsSuggestionDismissed returns whether the given account
+	// has dismissed suggestions of the given target account.
+	IsSuggestionDismissed(ctx context.Context, accountID string, targetAccountID string) (bool, error)
+
+	// ReplaceSuggestions replaces all non-dismissed follow suggestions for the
+	// given account with the given suggestions. Suggestions of target accounts
+	// which the account has previously dismissed are left untouched.
+	ReplaceSuggestions(ctx context.Context, accountID string, suggestions []*gtsmodel.Suggestion) error
+
+	// DismissSuggestion marks the given target account as dismissed for
+	// the given account, so that it will no longer be suggested to them.
+	DismissSuggestion(ctx context.Context, accountID string, targetAccountID string) error
+
+	// DeleteSuggestionsByAccountID deletes all follow suggestions
+	// either for, or of, the account with the given ID.
+	DeleteSuggestionsByAccountID(ctx context.Context, accountID string) error
+
+	// CountFollowsOfFollows returns up to limit IDs of accounts followed by
+	// accounts that the given account follows, mapped to the number of such
+	// follows. Accounts already followed by the given account are excluded.
+	CountFollowsOfFollows(ctx context.Context, accountID string, limit int) (map[string]int, error)
+
+	// CountInteractedAccounts returns up to limit IDs of accounts whose statuses
+	// the given account has faved, boosted or replied to since the given time,
+	// mapped to the number of such interactions.
+	CountInteractedAccounts(ctx context.Context, accountID string, since time.Time, limit int) (map[string]int, error)
+
+	// CountTagOverlapAccounts returns up to limit IDs of accounts which have posted
+	// since the given time using hashtags that the given account either follows,
+	// or has itself posted with since then, mapped to the number of such statuses.
+	CountTagOverlapAccounts(ctx context.Context, accountID string, since time.Time, limit int) (map[string]int, error)
+}
@@ -57,4 +57,21 @@ type Tag interface {
 
 	// GetAccountIDsFollowingTagIDs returns the account IDs of any followers of the given tag IDs.
 	GetAccountIDsFollowingTagIDs(ctx context.Context, tagIDs []string) ([]string, error)
+
+	// GetMutedTagIDs returns the IDs of all tags muted by the given account.
+	GetMutedTagIDs(ctx context.Context, accountID string) ([]string, error)
+
+	// IsAccountMutingTag returns whether the account mutes the given tag.
+	IsAccountMutingTag(ctx context.Context, accountID string, tagID string) (bool, error)
+
+	// PutMutedTag creates a new muted tag for the given user.
+	// If it already exists, it returns without an error.
+	PutMutedTag(ctx context.Context, accountID string, tagID string) error
+
+	// DeleteMutedTag deletes a muted tag for the given user.
+	// If no such muted tag exists, it returns without an error.
+	DeleteMutedTag(ctx context.Context, accountID string, tagID string) error
+
+	// DeleteMutedTagsByAccountID deletes all of an account's muted tags.
+	DeleteMutedTagsByAccountID(ctx context.Context, accountID string) error
 }
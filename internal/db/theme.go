@@ -0,0 +1,42 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// Theme handles getting/creation/deletion of admin-uploaded themes.
+type Theme interface {
+	// GetThemes fetches all uploaded themes from the database.
+	GetThemes(ctx context.Context) ([]*gtsmodel.Theme, error)
+
+	// GetThemeByID fetches the uploaded theme with ID from the database.
+	GetThemeByID(ctx context.Context, id string) (*gtsmodel.Theme, error)
+
+	// GetThemeByFileName fetches the uploaded theme with file name from the database.
+	GetThemeByFileName(ctx context.Context, fileName string) (*gtsmodel.Theme, error)
+
+	// PutTheme inserts the given uploaded theme into the database.
+	PutTheme(ctx context.Context, theme *gtsmodel.Theme) error
+
+	// DeleteThemeByID deletes the uploaded theme with ID from the database.
+	DeleteThemeByID(ctx context.Context, id string) error
+}
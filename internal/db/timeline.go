@@ -36,6 +36,12 @@ type Timeline interface {
 	// GetLocalTimeline fetches the account's LOCAL timeline -- i.e. PUBLIC posts by LOCAL users.
 	GetLocalTimeline(ctx context.Context, page *paging.Page) ([]*gtsmodel.Status, error)
 
+	// GetPublicLanguageTimeline is like GetPublicTimeline, but only returns statuses in the given language.
+	GetPublicLanguageTimeline(ctx context.Context, language string, page *paging.Page) ([]*gtsmodel.Status, error)
+
+	// GetLocalLanguageTimeline is like GetLocalTimeline, but only returns statuses in the given language.
+	GetLocalLanguageTimeline(ctx context.Context, language string, page *paging.Page) ([]*gtsmodel.Status, error)
+
 	// GetFavedTimeline fetches the account's FAVED timeline -- ie., posts and replies that the requesting account has faved.
 	// It will use the given filters and try to return as many statuses as possible up to the limit.
 	//
@@ -50,4 +56,8 @@ type Timeline interface {
 
 	// GetTagTimeline returns a slice of public-visibility statuses that use the given tagID.
 	GetTagTimeline(ctx context.Context, tagID string, page *paging.Page) ([]*gtsmodel.Status, error)
+
+	// GetTagsTimeline returns a slice of public-visibility statuses that use any of anyTagIDs,
+	// and all of allTagIDs, and none of noneTagIDs. At least one tag in anyTagIDs is required.
+	GetTagsTimeline(ctx context.Context, anyTagIDs []string, allTagIDs []string, noneTagIDs []string, page *paging.Page) ([]*gtsmodel.Status, error)
 }
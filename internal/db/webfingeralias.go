@@ -0,0 +1,43 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// WebfingerAlias handles getting/creation/deletion of webfinger alias mappings.
+type WebfingerAlias interface {
+	// GetWebfingerAliasByID fetches the webfinger alias with ID from the database.
+	GetWebfingerAliasByID(ctx context.Context, id string) (*gtsmodel.WebfingerAlias, error)
+
+	// GetWebfingerAliasByUsernameDomain fetches the webfinger alias
+	// with the given (lowercase) username and domain from the database.
+	GetWebfingerAliasByUsernameDomain(ctx context.Context, username string, domain string) (*gtsmodel.WebfingerAlias, error)
+
+	// GetWebfingerAliases fetches all webfinger aliases from the database, newest first.
+	GetWebfingerAliases(ctx context.Context) ([]*gtsmodel.WebfingerAlias, error)
+
+	// PutWebfingerAlias inserts the given webfinger alias into the database.
+	PutWebfingerAlias(ctx context.Context, alias *gtsmodel.WebfingerAlias) error
+
+	// DeleteWebfingerAliasByID deletes the webfinger alias with ID from the database.
+	DeleteWebfingerAliasByID(ctx context.Context, id string) error
+}
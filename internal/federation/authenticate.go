@@ -38,6 +38,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/msgsig"
 	"code.superseriousbusiness.org/httpsig"
 	"codeberg.org/gruf/go-kv/v2"
 )
@@ -554,6 +555,13 @@ func (f *Federator) fetchAccountInstance(
 		return gtserror.Newf("error inserting instance %s into database: %w", accountURI.Host, err)
 	}
 
+	if instance.NodeInfoFetchedAt.IsZero() {
+		// Software wasn't gleaned from nodeinfo while
+		// dereferencing the instance, fetch it async
+		// so that we know which quirks (if any) apply.
+		f.RefreshInstanceSoftwareAsync(ctx, requestedUser, instance, nil)
+	}
+
 	return nil
 }
 
@@ -657,6 +665,18 @@ func verifyAuth(
 		return false
 	}
 
+	// RFC 9421 signatures declare their own
+	// algorithm, so there's nothing to loop.
+	if v, ok := verifier.(*msgsig.Verifier); ok {
+		if err := v.Verify(pubKey, ""); err != nil {
+			l.Tracef("authentication NOT PASSED with rfc9421: %v", err)
+			return false
+		}
+
+		l.Trace("authenticated PASSED with rfc9421")
+		return true
+	}
+
 	// Loop through supported algorithms.
 	for _, algo := range signingAlgorithms {
 
@@ -0,0 +1,125 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federation
+
+import (
+	"context"
+	"errors"
+
+	"code.superseriousbusiness.org/activity/pub"
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+)
+
+// claimActivity checks whether the given incoming activity
+// has already been (or is currently being) processed from the
+// receiving account's inbox, to deduplicate redeliveries of
+// Create and Announce activities from remotes that didn't
+// see our earlier response.
+//
+// Activities are tracked per receiving account, as we don't
+// have a shared inbox, so a remote delivers the same activity
+// to each local recipient and relevance is decided per receiver.
+//
+// If the activity should be processed, returns true along with
+// its inbox activity model, which must then be passed to one of
+// either storeActivity or releaseActivity once processing is done.
+// Activity types that aren't deduplicated return nil model.
+func (f *federatingActor) claimActivity(ctx context.Context, activity pub.Activity) (*gtsmodel.InboxActivity, bool) {
+	switch activity.GetTypeName() {
+	case ap.ActivityCreate, ap.ActivityAnnounce:
+		// Deduplicated.
+	default:
+		return nil, true
+	}
+
+	receiver := gtscontext.ReceivingAccount(ctx)
+	if receiver == nil {
+		// Can't dedupe without
+		// a receiver, just process.
+		return nil, true
+	}
+
+	iri := ap.GetJSONLDId(activity)
+	if iri == nil {
+		// Can't dedupe without
+		// an ID, just process.
+		return nil, true
+	}
+
+	inboxActivity := &gtsmodel.InboxActivity{
+		AccountID: receiver.ID,
+		URI:       iri.String(),
+	}
+
+	// Add to cache first, this both checks recently
+	// seen and guards against concurrent deliveries.
+	if !f.state.Caches.InboxActivities.Add(inboxActivityKey(inboxActivity), struct{}{}) {
+		return nil, false
+	}
+
+	// Check database for activities
+	// processed outside the cache TTL.
+	exists, err := f.state.DB.InboxActivityExists(ctx,
+		inboxActivity.AccountID,
+		inboxActivity.URI,
+	)
+	if err != nil {
+		// Not a show-stopper, worst
+		// case we process it twice.
+		log.Errorf(ctx, "error checking inbox activity %s: %v", inboxActivity.URI, err)
+	} else if exists {
+		return nil, false
+	}
+
+	return inboxActivity, true
+}
+
+// storeActivity marks activity (from claimActivity) as successfully
+// processed, so redeliveries to the same inbox will be dropped.
+func (f *federatingActor) storeActivity(ctx context.Context, inboxActivity *gtsmodel.InboxActivity) {
+	if inboxActivity == nil {
+		return
+	}
+
+	inboxActivity.ID = id.NewULID()
+	if err := f.state.DB.PutInboxActivity(ctx, inboxActivity); err != nil &&
+		!errors.Is(err, db.ErrAlreadyExists) {
+		log.Errorf(ctx, "error storing inbox activity %s: %v", inboxActivity.URI, err)
+	}
+}
+
+// releaseActivity drops claim on activity (from claimActivity)
+// after failed processing, so redeliveries are retried.
+func (f *federatingActor) releaseActivity(inboxActivity *gtsmodel.InboxActivity) {
+	if inboxActivity == nil {
+		return
+	}
+
+	f.state.Caches.InboxActivities.Invalidate(inboxActivityKey(inboxActivity))
+}
+
+// inboxActivityKey returns the InboxActivities
+// cache key for the given inbox activity.
+func inboxActivityKey(inboxActivity *gtsmodel.InboxActivity) string {
+	return inboxActivity.AccountID + " " + inboxActivity.URI
+}
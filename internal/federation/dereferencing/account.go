@@ -34,6 +34,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/id"
 	"code.superseriousbusiness.org/gotosocial/internal/media"
+	"code.superseriousbusiness.org/gotosocial/internal/relme"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
 	errorsv2 "codeberg.org/gruf/go-errors/v2"
 )
@@ -120,7 +121,7 @@ func (d *Dereferencer) GetAccountByURI(
 
 	if accountable != nil {
 		// This account was updated, enqueue re-dereference featured posts + stats.
-		d.state.Workers.Dereference.Queue.Push(func(ctx context.Context) {
+		d.state.Workers.Dereference.Push(ctx, func(ctx context.Context) {
 			if err := d.dereferenceAccountFeatured(ctx, requestUser, account); err != nil {
 				log.Errorf(ctx, "error fetching account featured collection: %v", err)
 			}
@@ -245,7 +246,7 @@ func (d *Dereferencer) GetAccountByUsernameDomain(ctx context.Context, requestUs
 
 	if accountable != nil {
 		// This account was updated, enqueue re-dereference featured posts + stats.
-		d.state.Workers.Dereference.Queue.Push(func(ctx context.Context) {
+		d.state.Workers.Dereference.Push(ctx, func(ctx context.Context) {
 			if err := d.dereferenceAccountFeatured(ctx, requestUser, account); err != nil {
 				log.Errorf(ctx, "error fetching account featured collection: %v", err)
 			}
@@ -376,7 +377,7 @@ func (d *Dereferencer) RefreshAccount(
 
 	if accountable != nil {
 		// This account was updated, enqueue re-dereference featured posts + stats.
-		d.state.Workers.Dereference.Queue.Push(func(ctx context.Context) {
+		d.state.Workers.Dereference.Push(ctx, func(ctx context.Context) {
 			if err := d.dereferenceAccountFeatured(ctx, requestUser, latest); err != nil {
 				log.Errorf(ctx, "error fetching account featured collection: %v", err)
 			}
@@ -420,7 +421,7 @@ func (d *Dereferencer) RefreshAccountAsync(
 	}
 
 	// Enqueue a worker function to enrich this account async.
-	d.state.Workers.Dereference.Queue.Push(func(ctx context.Context) {
+	d.state.Workers.Dereference.Push(ctx, func(ctx context.Context) {
 		latest, accountable, err := d.enrichAccountSafely(ctx, requestUser, uri, account, accountable)
 		if err != nil {
 			log.Errorf(ctx, "error enriching remote account: %v", err)
@@ -636,7 +637,7 @@ func (d *Dereferencer) enrichAccount(
 		// We were not given any (partial) ActivityPub
 		// version of this account as a parameter.
 		// Dereference latest version of the account.
-		rsp, err := tsport.Dereference(ctx, uri)
+		rsp, err := dereference(ctx, tsport, "account", uri)
 		if err != nil {
 			err := gtserror.Newf("error dereferencing %s: %w", uri, err)
 			return nil, nil, gtserror.SetUnretrievable(err)
@@ -863,6 +864,10 @@ func (d *Dereferencer) enrichAccount(
 		log.Errorf(ctx, "error fetching remote emojis for account %s: %v", uri, err)
 	}
 
+	// Keep verification of any unchanged profile
+	// field links, noting whether any have changed.
+	fieldsChanged := relme.CarryVerified(account.Fields, latestAcc.Fields)
+
 	if account.IsNew() {
 		// Prefer published/created time from
 		// apubAcc, fall back to current time.
@@ -888,6 +893,17 @@ func (d *Dereferencer) enrichAccount(
 		}
 	}
 
+	if fieldsChanged &&
+		d.state.RelMe != nil &&
+		relme.NeedsVerify(latestAcc.Fields) {
+		// Verify any new links in fields async,
+		// as this requires fetching linked pages.
+		accountID := latestAcc.ID
+		d.state.Workers.Dereference.Push(ctx, func(ctx context.Context) {
+			d.state.RelMe.VerifyAccount(ctx, d.state.DB, accountID)
+		})
+	}
+
 	return latestAcc, apubAcc, nil
 }
 
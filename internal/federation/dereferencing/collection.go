@@ -38,7 +38,7 @@ func (d *Dereferencer) dereferenceCollection(ctx context.Context, username strin
 		return nil, gtserror.Newf("error creating transport: %w", err)
 	}
 
-	rsp, err := transport.Dereference(ctx, pageIRI)
+	rsp, err := dereference(ctx, transport, "collection", pageIRI)
 	if err != nil {
 		return nil, gtserror.Newf("error dereferencing %s: %w", pageIRI.String(), err)
 	}
@@ -66,7 +66,7 @@ func (d *Dereferencer) dereferenceCollectionPage(ctx context.Context, username s
 		return nil, gtserror.Newf("error creating transport: %w", err)
 	}
 
-	rsp, err := transport.Dereference(ctx, pageIRI)
+	rsp, err := dereference(ctx, transport, "collection", pageIRI)
 	if err != nil {
 		return nil, gtserror.Newf("error deferencing %s: %w", pageIRI.String(), err)
 	}
@@ -93,6 +93,12 @@ type Dereferencer struct {
 	derefEmojis   keyedList[*media.ProcessingEmoji]
 	derefEmojisMu sync.Mutex
 
+	// in-progress emoji fetches from fetchEmojis(),
+	// shared between concurrent status and account
+	// dereferences, keyed by emoji shortcode@domain.
+	emojiFetches   map[string]*emojiFetch
+	emojiFetchesMu sync.Mutex
+
 	// handshakes marks current in-progress handshakes
 	// occurring, useful to prevent a deadlock between
 	// gotosocial instances attempting to dereference
@@ -121,6 +127,7 @@ func NewDereferencer(
 		mediaManager:        mediaManager,
 		visFilter:           visFilter,
 		intFilter:           intFilter,
+		emojiFetches:        make(map[string]*emojiFetch),
 		handshakes:          make(map[string][]*url.URL),
 	}
 }
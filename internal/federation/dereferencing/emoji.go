@@ -29,6 +29,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/media"
+	"code.superseriousbusiness.org/gotosocial/internal/observability"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
 )
 
@@ -367,7 +368,7 @@ func (d *Dereferencer) processEmojiSafely(
 		emoji = processing.Placeholder()
 
 		// Enqueue the processing emoji load logic for background processing.
-		d.state.Workers.Dereference.Queue.Push(func(ctx context.Context) {
+		d.state.Workers.Dereference.Push(ctx, func(ctx context.Context) {
 			if !existing {
 				defer func() {
 					// We started the processing,
@@ -448,12 +449,18 @@ func (d *Dereferencer) fetchEmojis(
 
 			// Ensure that the existing emoji
 			// model is up-to-date and cached.
-			existing, err := d.RefreshEmoji(
-				ctx,
-				existing,
-				info,
-				force,
-				true, // async
+			existing, err := d.fetchEmojiOnce(ctx,
+				placeholder,
+				rejectReason != nil,
+				func() (*gtsmodel.Emoji, error) {
+					return d.RefreshEmoji(
+						ctx,
+						existing,
+						info,
+						force,
+						true, // async
+					)
+				},
 			)
 			if err != nil {
 				log.Errorf(ctx, "error refreshing emoji: %v", err)
@@ -488,13 +495,19 @@ func (d *Dereferencer) fetchEmojis(
 		// this function handles the case
 		// of existing cached emojis and
 		// new ones requiring dereference.
-		emoji, err := d.GetEmoji(ctx,
-			placeholder.Shortcode,
-			placeholder.Domain,
-			placeholder.ImageRemoteURL,
-			info,
-			false, // refresh
-			true,  // async
+		emoji, err := d.fetchEmojiOnce(ctx,
+			placeholder,
+			rejectReason != nil,
+			func() (*gtsmodel.Emoji, error) {
+				return d.GetEmoji(ctx,
+					placeholder.Shortcode,
+					placeholder.Domain,
+					placeholder.ImageRemoteURL,
+					info,
+					false, // refresh
+					true,  // async
+				)
+			},
 		)
 		if err != nil {
 			if emoji == nil {
@@ -522,3 +535,97 @@ func (d *Dereferencer) fetchEmojis(
 
 	return emojis, changed, nil
 }
+
+// emojiFetch represents one in-progress
+// emoji fetch within fetchEmojis(), which
+// may be awaited by concurrent callers.
+type emojiFetch struct {
+	remoteURL string
+	reject    bool
+	done      chan struct{}
+	emoji     *gtsmodel.Emoji
+	err       error
+}
+
+// fetchEmojiOnce calls the given fetch function for the emoji
+// with shortcode@domain of placeholder, unless an equivalent
+// fetch is already in-flight from a concurrent dereference, in
+// which case it waits on and returns the result of that instead.
+//
+// This prevents, for example, a burst of statuses from the same
+// remote instance all performing their own database lookups and
+// refresh checks for the same handful of custom emojis at once.
+func (d *Dereferencer) fetchEmojiOnce(
+	ctx context.Context,
+	placeholder *gtsmodel.Emoji,
+	reject bool,
+	fetch func() (*gtsmodel.Emoji, error),
+) (*gtsmodel.Emoji, error) {
+	key := placeholder.ShortcodeDomain()
+
+	// Acquire map lock.
+	d.emojiFetchesMu.Lock()
+
+	if f, ok := d.emojiFetches[key]; ok {
+		// Unlock map.
+		d.emojiFetchesMu.Unlock()
+
+		if f.remoteURL != placeholder.ImageRemoteURL ||
+			f.reject != reject {
+			// In-flight fetch of the same shortcode@domain
+			// but with different details, e.g. the emoji was
+			// just updated, so this one can't be shared.
+			observability.RecordEmojiFetch(ctx, false)
+			return fetch()
+		}
+
+		observability.RecordEmojiFetch(ctx, true)
+
+		// Wait on in-flight fetch.
+		select {
+		case <-f.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		if f.emoji == nil {
+			return nil, f.err
+		}
+
+		// Return a copy, as this
+		// model is shared with
+		// other fetching callers.
+		emoji := new(gtsmodel.Emoji)
+		*emoji = *f.emoji
+		return emoji, f.err
+	}
+
+	// Add new fetch for others to await.
+	f := &emojiFetch{
+		remoteURL: placeholder.ImageRemoteURL,
+		reject:    reject,
+		done:      make(chan struct{}),
+
+		// Set a default error in case of panic
+		// so awaiting callers don't see nil, nil.
+		err: gtserror.Newf("fetch of emoji %s did not complete", key),
+	}
+	d.emojiFetches[key] = f
+
+	// Unlock map.
+	d.emojiFetchesMu.Unlock()
+
+	observability.RecordEmojiFetch(ctx, false)
+
+	defer func() {
+		// Remove from map, then
+		// wake awaiting callers.
+		d.emojiFetchesMu.Lock()
+		delete(d.emojiFetches, key)
+		d.emojiFetchesMu.Unlock()
+		close(f.done)
+	}()
+
+	f.emoji, f.err = fetch()
+	return f.emoji, f.err
+}
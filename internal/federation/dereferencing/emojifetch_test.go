@@ -0,0 +1,114 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dereferencing
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+func TestFetchEmojiOnce(t *testing.T) {
+	d := &Dereferencer{emojiFetches: make(map[string]*emojiFetch)}
+	ctx := context.Background()
+
+	placeholder := &gtsmodel.Emoji{
+		Shortcode:      "blobcat",
+		Domain:         "example.org",
+		ImageRemoteURL: "https://example.org/emoji/blobcat.png",
+	}
+
+	var (
+		calls   atomic.Int32
+		started = make(chan struct{})
+		release = make(chan struct{})
+	)
+
+	fetch := func() (*gtsmodel.Emoji, error) {
+		if calls.Add(1) == 1 {
+			// Hold the first fetch
+			// open until released.
+			close(started)
+			<-release
+		}
+		return &gtsmodel.Emoji{
+			ID:        "01JQ8RR2KW46QK9QAKHN1AB2XA",
+			Shortcode: "blobcat",
+			Domain:    "example.org",
+		}, nil
+	}
+
+	var (
+		wg      sync.WaitGroup
+		results [3]*gtsmodel.Emoji
+	)
+
+	// Start the first fetch.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		results[0], _ = d.fetchEmojiOnce(ctx, placeholder, false, fetch)
+	}()
+	<-started
+
+	// Start more equivalent fetches, which
+	// should join the first one in-flight.
+	for i := 1; i < len(results); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], _ = d.fetchEmojiOnce(ctx, placeholder, false, fetch)
+		}()
+	}
+
+	// Wait for the joining fetches to be
+	// waiting, there's no hook for this.
+	time.Sleep(100 * time.Millisecond)
+
+	// A fetch with different details
+	// should not join the one in-flight.
+	other := *placeholder
+	other.ImageRemoteURL = "https://example.org/emoji/blobcat_v2.png"
+	if _, err := d.fetchEmojiOnce(ctx, &other, false, fetch); err != nil {
+		t.Fatal(err)
+	}
+
+	close(release)
+	wg.Wait()
+
+	if n := calls.Load(); n != 2 {
+		t.Fatalf("expected 2 fetches, got %d", n)
+	}
+
+	for i, emoji := range results {
+		if emoji == nil || emoji.ID != "01JQ8RR2KW46QK9QAKHN1AB2XA" {
+			t.Fatalf("unexpected result %d: %+v", i, emoji)
+		}
+		if i > 0 && emoji == results[0] {
+			t.Fatalf("result %d shares model with first fetch", i)
+		}
+	}
+
+	if len(d.emojiFetches) != 0 {
+		t.Fatalf("expected no in-flight fetches, got %d", len(d.emojiFetches))
+	}
+}
@@ -20,7 +20,10 @@
 import (
 	"context"
 	"net/url"
+	"time"
 
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 )
@@ -37,3 +40,85 @@ func (d *Dereferencer) GetRemoteInstance(ctx context.Context, username string, r
 
 	return transport.DereferenceInstance(ctx, remoteInstanceURI)
 }
+
+// RefreshInstanceSoftware fetches nodeinfo for the given remote
+// instance, if it's considered stale / not fresh based on
+// Instance.NodeInfoFetchedAt and desired freshness, and updates
+// the software name and version stored for the instance. A nil
+// window indicates that nodeinfo should always be fetched.
+func (d *Dereferencer) RefreshInstanceSoftware(
+	ctx context.Context,
+	requestUser string,
+	instance *gtsmodel.Instance,
+	window *FreshnessWindow,
+) error {
+	if window != nil &&
+		time.Since(instance.NodeInfoFetchedAt) < time.Duration(*window) {
+		// Still fresh.
+		return nil
+	}
+
+	blocked, err := d.state.DB.IsDomainBlocked(ctx, instance.Domain)
+	if err != nil {
+		return gtserror.Newf("db error checking domain block: %w", err)
+	}
+
+	if blocked {
+		// Don't contact
+		// blocked domains.
+		return nil
+	}
+
+	iri, err := url.Parse(instance.URI)
+	if err != nil {
+		return gtserror.Newf("invalid instance uri %q: %w", instance.URI, err)
+	}
+
+	tsport, err := d.transportController.NewTransportForUsername(ctx, requestUser)
+	if err != nil {
+		return gtserror.Newf("transport err: %w", err)
+	}
+
+	ni, err := tsport.DereferenceNodeInfo(gtscontext.SetFastFail(ctx), iri)
+	if err != nil {
+		return gtserror.Newf("error dereferencing nodeinfo: %w", err)
+	}
+
+	instance.Software = ni.Software.Name
+	instance.Version = ni.Software.Version
+	instance.NodeInfoFetchedAt = time.Now()
+
+	if err := d.state.DB.UpdateInstance(ctx,
+		instance,
+		"software",
+		"version",
+		"node_info_fetched_at",
+	); err != nil {
+		return gtserror.Newf("db error updating instance: %w", err)
+	}
+
+	return nil
+}
+
+// RefreshInstanceSoftwareAsync enqueues the given
+// remote instance for an asynchronous nodeinfo
+// fetch, if it's considered stale / not fresh.
+func (d *Dereferencer) RefreshInstanceSoftwareAsync(
+	ctx context.Context,
+	requestUser string,
+	instance *gtsmodel.Instance,
+	window *FreshnessWindow,
+) {
+	if window != nil &&
+		time.Since(instance.NodeInfoFetchedAt) < time.Duration(*window) {
+		// Still fresh.
+		return
+	}
+
+	// Enqueue a worker function to fetch nodeinfo async.
+	d.state.Workers.Dereference.Push(ctx, func(ctx context.Context) {
+		if err := d.RefreshInstanceSoftware(ctx, requestUser, instance, window); err != nil {
+			log.Debugf(ctx, "error refreshing software for %s: %v", instance.Domain, err)
+		}
+	})
+}
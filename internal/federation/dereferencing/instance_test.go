@@ -20,7 +20,9 @@
 import (
 	"net/url"
 	"testing"
+	"time"
 
+	"code.superseriousbusiness.org/gotosocial/internal/federation/dereferencing"
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/testrig"
 	"github.com/stretchr/testify/suite"
@@ -34,6 +36,7 @@ func (suite *InstanceTestSuite) TestDerefInstance() {
 	type testCase struct {
 		instanceIRI      *url.URL
 		expectedSoftware string
+		expectedVersion  string
 	}
 
 	for _, tc := range []testCase{
@@ -41,7 +44,8 @@ type testCase struct {
 			// Fossbros anonymous doesn't shield their nodeinfo or
 			// well-known or anything so we should be able to fetch.
 			instanceIRI:      testrig.URLMustParse("https://fossbros-anonymous.io"),
-			expectedSoftware: "Hellsoft 6.6.6",
+			expectedSoftware: "Hellsoft",
+			expectedVersion:  "6.6.6",
 		},
 		{
 			// Furtive nerds forbids /nodeinfo using
@@ -84,10 +88,55 @@ type testCase struct {
 			suite.FailNow(err.Error())
 		}
 
-		suite.Equal(tc.expectedSoftware, instance.Version)
+		suite.Equal(tc.expectedSoftware, instance.Software)
+		suite.Equal(tc.expectedVersion, instance.Version)
 	}
 }
 
+func (suite *InstanceTestSuite) TestRefreshInstanceSoftware() {
+	ctx := suite.T().Context()
+
+	instance, err := suite.db.GetInstance(ctx, "fossbros-anonymous.io")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Testrig only serves nodeinfo
+	// for fossbros over https.
+	instance.URI = "https://fossbros-anonymous.io"
+
+	// Pretend nodeinfo was fetched
+	// recently; nothing should change.
+	instance.NodeInfoFetchedAt = time.Now()
+	if err := suite.dereferencer.RefreshInstanceSoftware(ctx,
+		suite.testAccounts["admin_account"].Username,
+		instance,
+		dereferencing.Fresh,
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(instance.Software)
+	suite.Empty(instance.Version)
+
+	// Nil window forces a fetch.
+	if err := suite.dereferencer.RefreshInstanceSoftware(ctx,
+		suite.testAccounts["admin_account"].Username,
+		instance,
+		nil,
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Check the updated software is stored.
+	dbInstance, err := suite.db.GetInstance(ctx, "fossbros-anonymous.io")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("Hellsoft", dbInstance.Software)
+	suite.Equal("6.6.6", dbInstance.Version)
+	suite.False(dbInstance.NodeInfoFetchedAt.IsZero())
+}
+
 func TestInstanceTestSuite(t *testing.T) {
 	suite.Run(t, new(InstanceTestSuite))
 }
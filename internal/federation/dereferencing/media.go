@@ -242,7 +242,7 @@ func (d *Dereferencer) processMediaSafely(
 		attach = processing.Placeholder()
 
 		// Enqueue the processing media load logic for background processing.
-		d.state.Workers.Dereference.Queue.Push(func(ctx context.Context) {
+		d.state.Workers.Dereference.Push(ctx, func(ctx context.Context) {
 			if !existing {
 				defer func() {
 					// We started the processing,
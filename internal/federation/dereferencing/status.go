@@ -403,7 +403,7 @@ func (d *Dereferencer) enrichStatus(
 
 	if statusable == nil {
 		// Dereference latest version of the status.
-		rsp, err := tsport.Dereference(ctx, uri)
+		rsp, err := dereference(ctx, tsport, "status", uri)
 		if err != nil {
 			err := gtserror.Newf("error dereferencing %s: %w", uri, err)
 			return nil, nil, gtserror.SetUnretrievable(err)
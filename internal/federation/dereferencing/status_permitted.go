@@ -691,7 +691,7 @@ func (d *Dereferencer) isValidAuthURI(
 	// Make the call to the authIRI.
 	// Log any error encountered here but don't
 	// return it as it's not *our* error.
-	rsp, err := tsport.Dereference(ctx, authIRI)
+	rsp, err := dereference(ctx, tsport, "authorization", authIRI)
 	if err != nil {
 		l.Errorf("error dereferencing authIRI: %v", err)
 		return false, nil
@@ -19,11 +19,13 @@
 
 import (
 	"fmt"
+	"net/url"
 	"testing"
 	"time"
 
 	"code.superseriousbusiness.org/activity/streams"
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/federation/dereferencing"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
@@ -448,6 +450,89 @@ func (suite *StatusTestSuite) TestDereferencerRefreshStatusRace() {
 	suite.Equal(afterEdit.EditIDs, afterBodge.EditIDs)
 }
 
+func (suite *StatusTestSuite) TestDereferenceThreadMaxDepth() {
+	ctx := suite.T().Context()
+	fetchingAccount := suite.testAccounts["local_account_1"]
+
+	// Only follow two parents up the thread.
+	config.SetInstanceFederationThreadMaxDepth(2)
+
+	uris := suite.addRemoteReplyChain(4)
+
+	// Dereference the bottom of the chain.
+	_, _, _, err := suite.dereferencer.GetStatusByURI(ctx,
+		fetchingAccount.Username,
+		testrig.URLMustParse(uris[3]),
+		nil,
+	)
+	suite.NoError(err)
+
+	// The two direct ancestors should have been
+	// dereferenced, but not the top of the chain.
+	for _, uri := range uris[1:] {
+		_, err := suite.db.GetStatusByURI(ctx, uri)
+		suite.NoError(err)
+	}
+	_, err = suite.db.GetStatusByURI(ctx, uris[0])
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *StatusTestSuite) TestDereferenceThreadFetchBudget() {
+	ctx := suite.T().Context()
+	fetchingAccount := suite.testAccounts["local_account_1"]
+
+	// Only allow a single remote fetch for the thread.
+	config.SetInstanceFederationThreadFetchBudget(1)
+
+	uris := suite.addRemoteReplyChain(4)
+
+	// Dereference the bottom of the chain.
+	_, _, _, err := suite.dereferencer.GetStatusByURI(ctx,
+		fetchingAccount.Username,
+		testrig.URLMustParse(uris[3]),
+		nil,
+	)
+	suite.NoError(err)
+
+	// Only the direct parent should have been dereferenced.
+	_, err = suite.db.GetStatusByURI(ctx, uris[2])
+	suite.NoError(err)
+	for _, uri := range uris[:2] {
+		_, err := suite.db.GetStatusByURI(ctx, uri)
+		suite.ErrorIs(err, db.ErrNoEntries)
+	}
+}
+
+// addRemoteReplyChain adds a chain of n remote statuses to the
+// mock http client, each replying to the one before, returning
+// their URIs in order from the top of the chain to the bottom.
+func (suite *StatusTestSuite) addRemoteReplyChain(n int) []string {
+	const base = "https://unknown-instance.com/users/brand_new_person/statuses/"
+
+	uris := make([]string, n)
+	for i := range n {
+		uris[i] = base + fmt.Sprintf("thread%d", i)
+
+		var inReplyTo *url.URL
+		if i > 0 {
+			inReplyTo = testrig.URLMustParse(uris[i-1])
+		}
+
+		suite.client.TestRemoteStatuses[uris[i]] = testrig.NewAPNote(
+			&testrig.NewAPNoteParams{
+				ID:           testrig.URLMustParse(uris[i]),
+				CreatedAt:    testrig.TimeMustParse("2022-07-13T12:13:12+02:00"),
+				Content:      fmt.Sprintf("reply number %d", i),
+				AttributedTo: testrig.URLMustParse("https://unknown-instance.com/users/brand_new_person"),
+				To:           []*url.URL{ap.PublicIRI()},
+				InReplyTo:    inReplyTo,
+			},
+		)
+	}
+
+	return uris
+}
+
 // editStatusable updates the given statusable attributes.
 // note that this acts on the original object, no copying.
 func (suite *StatusTestSuite) editStatusable(
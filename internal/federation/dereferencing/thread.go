@@ -21,6 +21,7 @@
 	"context"
 	"net/http"
 	"net/url"
+	"sync/atomic"
 
 	"code.superseriousbusiness.org/activity/pub"
 	"code.superseriousbusiness.org/gopkg/log"
@@ -28,13 +29,73 @@
 	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/observability"
 	"codeberg.org/gruf/go-kv/v2"
 )
 
-// maxIter defines how many iterations of descendants or
-// ancesters we are willing to follow before returning error.
+// maxIter defines how many iterations of descendants
+// we are willing to follow before returning error.
 const maxIter = 512
 
+// threadLimits tracks the configured depth and fetch
+// budget limits while dereferencing a single remote
+// thread. It's shared between ancestor and (async)
+// descendant dereferencing, so must be concurrency safe.
+type threadLimits struct {
+	// maxDepth is the maximum number of
+	// replies to follow up or down a thread.
+	maxDepth int
+
+	// fetches is the remaining
+	// budget of remote fetches.
+	fetches atomic.Int64
+
+	// depthHit and budgetHit mark whether each limit
+	// was hit, so it's only recorded once per thread.
+	depthHit  atomic.Bool
+	budgetHit atomic.Bool
+}
+
+// newThreadLimits returns a new threadLimits
+// initialized according to configuration.
+func newThreadLimits() *threadLimits {
+	limits := &threadLimits{
+		maxDepth: config.GetInstanceFederationThreadMaxDepth(),
+	}
+	limits.fetches.Store(int64(config.GetInstanceFederationThreadFetchBudget()))
+	return limits
+}
+
+// canFetch returns whether there is fetch budget remaining,
+// recording the budget as exhausted in metrics if not.
+func (t *threadLimits) canFetch(ctx context.Context) bool {
+	if t.fetches.Load() > 0 {
+		return true
+	}
+	if t.budgetHit.CompareAndSwap(false, true) {
+		observability.RecordThreadLimit(ctx, "budget")
+	}
+	return false
+}
+
+// fetched marks one remote
+// fetch as spent from budget.
+func (t *threadLimits) fetched() {
+	t.fetches.Add(-1)
+}
+
+// depthOK returns whether the given depth is within the
+// maximum thread depth, recording the limit in metrics if not.
+func (t *threadLimits) depthOK(ctx context.Context, depth int) bool {
+	if depth <= t.maxDepth {
+		return true
+	}
+	if t.depthHit.CompareAndSwap(false, true) {
+		observability.RecordThreadLimit(ctx, "depth")
+	}
+	return false
+}
+
 // dereferenceThread handles dereferencing status thread after
 // fetch. Passing off appropriate parts to be enqueued for async
 // processing, or handling some parts synchronously when required.
@@ -50,28 +111,32 @@ func (d *Dereferencer) dereferenceThread(
 	isNew bool,
 	newThreadEntryCallback func(context.Context, *gtsmodel.Status) error,
 ) {
+	// Limits shared by both halves
+	// of dereferencing this thread.
+	limits := newThreadLimits()
+
 	if isNew {
 		// This is a new status that we need the ancestors of in
 		// order to determine visibility. Perform the initial part
 		// of thread dereferencing, i.e. parents, synchronously.
-		err := d.dereferenceStatusAncestors(ctx, requestUser, status, newThreadEntryCallback)
+		err := d.dereferenceStatusAncestors(ctx, requestUser, status, limits, newThreadEntryCallback)
 		if err != nil {
 			log.Error(ctx, err)
 		}
 
 		// Enqueue dereferencing remaining status thread, (children), asychronously .
-		d.state.Workers.Dereference.Queue.Push(func(ctx context.Context) {
-			if err := d.dereferenceStatusDescendants(ctx, requestUser, uri, statusable, newThreadEntryCallback); err != nil {
+		d.state.Workers.Dereference.Push(ctx, func(ctx context.Context) {
+			if err := d.dereferenceStatusDescendants(ctx, requestUser, uri, statusable, limits, newThreadEntryCallback); err != nil {
 				log.Error(ctx, err)
 			}
 		})
 	} else {
 		// This is an existing status, dereference the WHOLE thread asynchronously.
-		d.state.Workers.Dereference.Queue.Push(func(ctx context.Context) {
-			if err := d.dereferenceStatusAncestors(ctx, requestUser, status, newThreadEntryCallback); err != nil {
+		d.state.Workers.Dereference.Push(ctx, func(ctx context.Context) {
+			if err := d.dereferenceStatusAncestors(ctx, requestUser, status, limits, newThreadEntryCallback); err != nil {
 				log.Error(ctx, err)
 			}
-			if err := d.dereferenceStatusDescendants(ctx, requestUser, uri, statusable, newThreadEntryCallback); err != nil {
+			if err := d.dereferenceStatusDescendants(ctx, requestUser, uri, statusable, limits, newThreadEntryCallback); err != nil {
 				log.Error(ctx, err)
 			}
 		})
@@ -80,7 +145,8 @@ func (d *Dereferencer) dereferenceThread(
 
 // dereferenceStatusAncestors iterates upwards from the
 // given status, using InReplyToURI, to ensure that as
-// many parent statuses as possible are dereferenced.
+// many parent statuses as possible are dereferenced,
+// within the configured thread depth and fetch budget.
 //
 // If set, newThreadEntryCallback will be called for
 // each *new* status dereferenced in this way.
@@ -88,6 +154,7 @@ func (d *Dereferencer) dereferenceStatusAncestors(
 	ctx context.Context,
 	username string,
 	status *gtsmodel.Status,
+	limits *threadLimits,
 	newThreadEntryCallback func(context.Context, *gtsmodel.Status) error,
 ) error {
 	// Start log entry with fields
@@ -105,13 +172,27 @@ func (d *Dereferencer) dereferenceStatusAncestors(
 	// we're currently working on.
 	current := status
 
-	for i := 0; i < maxIter; i++ {
+	for depth := 1; ; depth++ {
 		if current.InReplyToURI == "" {
 			// Status has no parent, we've
 			// reached the top of the chain.
 			return nil
 		}
 
+		if !limits.depthOK(ctx, depth) {
+			// Thread is deeper than we're
+			// willing to go, stop here.
+			l.Debugf("reached max thread depth %d", limits.maxDepth)
+			return nil
+		}
+
+		if !limits.canFetch(ctx) {
+			// We've made as many remote
+			// fetches as we're willing to.
+			l.Debug("thread fetch budget exhausted")
+			return nil
+		}
+
 		// Apparent current parent URI to log fields.
 		l = l.WithField("parent", current.InReplyToURI)
 		l.Trace("following status ancestor")
@@ -134,7 +215,12 @@ func (d *Dereferencer) dereferenceStatusAncestors(
 
 		// Fetch parent status by current's reply URI, this handles
 		// case of existing (updating if necessary) or a new status.
-		parent, _, isNew, err := d.getStatusByURI(ctx, username, uri)
+		parent, parentable, isNew, err := d.getStatusByURI(ctx, username, uri)
+		if parentable != nil || err != nil {
+			// A remote fetch was (attempted to be)
+			// made, take it from the fetch budget.
+			limits.fetched()
+		}
 
 		// Check for a returned HTTP code via error.
 		switch code := gtserror.StatusCode(err); {
@@ -236,13 +322,12 @@ func (d *Dereferencer) dereferenceStatusAncestors(
 		current.InReplyTo = parent
 		current = current.InReplyTo
 	}
-
-	return gtserror.Newf("reached %d ancestor iterations for %q", maxIter, status.URI)
 }
 
 // DereferenceStatusDescendents iterates downwards from
 // the given status, using its replies, to ensure that
-// as many children statuses as possible are dereferenced.
+// as many children statuses as possible are dereferenced,
+// within the configured thread depth and fetch budget.
 //
 // If set, newThreadEntryCallback will be called for
 // each *new* status dereferenced in this way.
@@ -251,6 +336,7 @@ func (d *Dereferencer) dereferenceStatusDescendants(
 	username string,
 	statusIRI *url.URL,
 	parent ap.Statusable,
+	limits *threadLimits,
 	newThreadEntryCallback func(context.Context, *gtsmodel.Status) error,
 ) error {
 	statusIRIStr := statusIRI.String()
@@ -284,6 +370,11 @@ type frame struct {
 		// the frame's collection page
 		// (is useful for logging).
 		pageURI string
+
+		// depth is the depth in the thread
+		// of statuses in the frame's collection,
+		// relative to the starting status.
+		depth int
 	}
 
 	var (
@@ -302,7 +393,7 @@ func() *frame {
 				if page == nil {
 					return nil
 				}
-				return &frame{page: page, pageURI: pageURI}
+				return &frame{page: page, pageURI: pageURI, depth: 1}
 			}(),
 		}
 
@@ -354,12 +445,25 @@ func() *frame {
 					continue itemLoop
 				}
 
+				if !limits.canFetch(ctx) {
+					// We've made as many remote
+					// fetches as we're willing to.
+					l.Debug("thread fetch budget exhausted")
+					return nil
+				}
+
 				// Dereference the remote status and store in the database.
 				// getStatusByURI guards against the following conditions:
 				//   - refetching recently fetched statuses (recursion!)
 				//   - remote domain is blocked (will return unretrievable)
 				//   - any http type error for a new status returns unretrievable
 				status, statusable, isNew, err := d.getStatusByURI(ctx, username, itemIRI)
+				if statusable != nil || err != nil {
+					// A remote fetch was (attempted to be)
+					// made, take it from the fetch budget.
+					limits.fetched()
+				}
+
 				if err != nil {
 					l.Errorf("error dereferencing remote status %s: %v", itemIRI, err)
 					continue itemLoop
@@ -382,6 +486,13 @@ func() *frame {
 					}
 				}
 
+				// Check we're willing to go
+				// any deeper into this thread.
+				if !limits.depthOK(ctx, current.depth+1) {
+					l.Debugf("reached max thread depth %d", limits.maxDepth)
+					continue itemLoop
+				}
+
 				// Extract any attached collection + ID URI from status.
 				page, pageURI := getAttachedStatusCollectionPage(statusable)
 				if page == nil {
@@ -392,6 +503,7 @@ func() *frame {
 				stack = append(stack, current, &frame{
 					pageURI: pageURI,
 					page:    page,
+					depth:   current.depth + 1,
 				})
 
 				// Now start at top of loop
@@ -417,11 +529,19 @@ func() *frame {
 			// Mark this collection page as deref'd.
 			derefdPages[nextURIStr] = struct{}{}
 
+			if !limits.canFetch(ctx) {
+				// We've made as many remote
+				// fetches as we're willing to.
+				l.Debug("thread fetch budget exhausted")
+				return nil
+			}
+
 			// Dereference this next collection page by its IRI.
 			collectionPage, err := d.dereferenceCollectionPage(ctx,
 				username,
 				nextURI,
 			)
+			limits.fetched()
 			if err != nil {
 				l.Errorf("error dereferencing collection page %q: %s", nextURIStr, err)
 				continue stackLoop
@@ -18,12 +18,33 @@
 package dereferencing
 
 import (
+	"context"
+	"net/http"
+	"net/url"
 	"slices"
+	"time"
 
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/media"
+	"code.superseriousbusiness.org/gotosocial/internal/observability"
+	"code.superseriousbusiness.org/gotosocial/internal/transport"
 )
 
+// dereference wraps tsport.Dereference() to record
+// the latency of dereferencing given kind of object
+// (eg., "account", "status") in instance metrics.
+func dereference(
+	ctx context.Context,
+	tsport transport.Transport,
+	kind string,
+	iri *url.URL,
+) (*http.Response, error) {
+	start := time.Now()
+	rsp, err := tsport.Dereference(ctx, iri)
+	observability.RecordDereference(ctx, kind, start, err)
+	return rsp, err
+}
+
 // getEmojiByShortcodeDomain searches input slice
 // for emoji with given shortcode and domain.
 func getEmojiByShortcodeDomain(
@@ -36,6 +36,9 @@
 	"code.superseriousbusiness.org/gotosocial/internal/federation/federatingdb"
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/hooks"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
 	"code.superseriousbusiness.org/gotosocial/internal/uris"
 	errorsv2 "codeberg.org/gruf/go-errors/v2"
 	"codeberg.org/gruf/go-kv/v2"
@@ -44,6 +47,7 @@
 // federatingActor wraps the pub.FederatingActor
 // with some custom GoToSocial-specific logic.
 type federatingActor struct {
+	state           *state.State
 	sideEffectActor pub.DelegateActor
 	wrapped         pub.FederatingActor
 }
@@ -97,7 +101,7 @@ func(a *url.URL, b *url.URL) int {
 }
 
 // newFederatingActor returns a federatingActor.
-func newFederatingActor(c pub.CommonBehavior, s2s pub.FederatingProtocol, db pub.Database, clock pub.Clock) pub.FederatingActor {
+func newFederatingActor(state *state.State, c pub.CommonBehavior, s2s pub.FederatingProtocol, db pub.Database, clock pub.Clock) pub.FederatingActor {
 	sideEffectActor := pub.NewSideEffectActor(c, s2s, nil, db, clock)
 
 	// Hook in our own custom Serialize function.
@@ -107,6 +111,7 @@ func newFederatingActor(c pub.CommonBehavior, s2s pub.FederatingProtocol, db pub
 	sideEffectActor.DeliveryRecipientPreSort = deliveryRecipientPreSort
 
 	return &federatingActor{
+		state:           state,
 		sideEffectActor: sideEffectActor,
 		wrapped:         pub.NewCustomActor(sideEffectActor, false, true, clock),
 	}
@@ -260,6 +265,19 @@ func (f *federatingActor) PostInboxScheme(ctx context.Context, w http.ResponseWr
 		return false, gtserror.NewErrorForbidden(errors.New(text), text)
 	}
 
+	// Pass activity through any configured moderation hook.
+	if errWithCode := f.callIngestHook(ctx, requester, activity); errWithCode != nil {
+		return false, errWithCode
+	}
+
+	// Check we haven't already processed this activity,
+	// ie., it isn't a redelivery from a flaky remote.
+	inboxActivity, ok := f.claimActivity(ctx, activity)
+	if !ok {
+		l.Debug("ignoring already processed activity")
+		return true, nil
+	}
+
 	// Copy existing URL + add
 	// request host and scheme.
 	inboxID := func() *url.URL {
@@ -277,6 +295,10 @@ func (f *federatingActor) PostInboxScheme(ctx context.Context, w http.ResponseWr
 	// Post the activity to the Actor's inbox and trigger side effects.
 	if err := f.sideEffectActor.PostInbox(ctx, inboxID, activity); err != nil {
 
+		// Processing didn't complete, allow
+		// any redelivery to try it again.
+		f.releaseActivity(inboxActivity)
+
 		// Check if it's a bad request because the
 		// object or target props weren't populated,
 		// or we failed parsing activity details.
@@ -339,6 +361,10 @@ func (f *federatingActor) PostInboxScheme(ctx context.Context, w http.ResponseWr
 		return false, gtserror.NewErrorInternalError(err)
 	}
 
+	// Mark activity as processed
+	// to drop any redeliveries.
+	f.storeActivity(ctx, inboxActivity)
+
 	// Side effects are complete. Now delegate determining whether
 	// to do inbox forwarding, as well as the action to do it.
 	if err := f.sideEffectActor.InboxForwarding(ctx, inboxID, activity); err != nil {
@@ -403,3 +429,45 @@ func (f *federatingActor) PostOutboxScheme(c context.Context, w http.ResponseWri
 func (f *federatingActor) GetOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
 	return f.wrapped.GetOutbox(c, w, r)
 }
+
+// callIngestHook calls any configured pre-federation-ingest moderation
+// hook with the incoming activity, returning an appropriate error if
+// the hook rejected the activity, or failed (when closed).
+func (f *federatingActor) callIngestHook(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	activity pub.Activity,
+) gtserror.WithCode {
+	if !f.state.Hooks.Enabled(hooks.PreFederationIngest) {
+		return nil
+	}
+
+	// Serialize activity to pass to hook.
+	data, err := ap.Serialize(activity)
+	if err != nil {
+		err := gtserror.Newf("error serializing activity: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	err = f.state.Hooks.Call(ctx, &hooks.Request{
+		Point:    hooks.PreFederationIngest,
+		Account:  requester.URI,
+		Activity: data,
+	})
+
+	if errorsv2.AsV2[*hooks.RejectError](err) != nil {
+		// Rejected, return 403 so
+		// the remote doesn't retry.
+		const text = "activity rejected by moderation"
+		return gtserror.NewErrorForbidden(err, text)
+	}
+
+	if err != nil {
+		// Hook unavailable, return 503
+		// so the remote retries later.
+		const text = "moderation service unavailable"
+		return gtserror.NewWithCodeSafe(http.StatusServiceUnavailable, err, text)
+	}
+
+	return nil
+}
@@ -280,7 +280,7 @@ func (f *DB) acceptFollowType(
 
 	// Send the accepted follow through
 	// the processor to do side effects.
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APObjectType:   ap.ActivityFollow,
 		APActivityType: ap.ActivityAccept,
 		GTSModel:       follow,
@@ -343,7 +343,7 @@ func (f *DB) acceptFollowIRI(
 
 	// Send the accepted follow through
 	// the processor to do side effects.
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APObjectType:   ap.ActivityFollow,
 		APActivityType: ap.ActivityAccept,
 		GTSModel:       follow,
@@ -425,7 +425,7 @@ func (f *DB) acceptOtherIRI(
 	}
 
 	// Pass to the processor and let them handle side effects.
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APObjectType:   apObjectType,
 		APActivityType: ap.ActivityAccept,
 		APIRI:          approvedByURI,
@@ -495,7 +495,7 @@ func (f *DB) acceptStoredStatus(
 
 	// Send the now-approved status through to the
 	// fedi worker again to process side effects.
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APObjectType:   apObjectType,
 		APActivityType: ap.ActivityAccept,
 		GTSModel:       status,
@@ -583,7 +583,7 @@ func (f *DB) acceptLikeIRI(
 
 	// Send the now-approved fave through to the
 	// fedi worker again to process side effects.
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APObjectType:   ap.ActivityLike,
 		APActivityType: ap.ActivityAccept,
 		GTSModel:       fave,
@@ -820,7 +820,7 @@ func (f *DB) acceptPoliteReplyRequest(
 		// the interaction request as nil. We don't need to
 		// create an int req for remote accepts of remote
 		// replies, we can just validate + store the auth URI.
-		f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+		f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 			APObjectType:   ap.ActivityReplyRequest,
 			APActivityType: ap.ActivityAccept,
 			APIRI:          partial.authURI,
@@ -888,7 +888,7 @@ func (f *DB) acceptPoliteReplyRequest(
 	}
 
 	// Handle any remaining side effects in the processor.
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APObjectType:   ap.ActivityReplyRequest,
 		APActivityType: ap.ActivityAccept,
 		APIRI:          partial.authURI,
@@ -72,7 +72,7 @@ func (f *DB) Announce(ctx context.Context, announce vocab.ActivityStreamsAnnounc
 	}
 
 	// This is a new boost. Process side effects asynchronously.
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APObjectType:   ap.ActivityAnnounce,
 		APActivityType: ap.ActivityCreate,
 		GTSModel:       boost,
@@ -75,7 +75,7 @@ func (f *DB) Block(ctx context.Context, blockable vocab.ActivityStreamsBlock) er
 	}
 
 	// Push message to worker queue to handle block side-effects.
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APObjectType:   ap.ActivityBlock,
 		APActivityType: ap.ActivityCreate,
 		GTSModel:       block,
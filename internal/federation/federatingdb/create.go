@@ -50,7 +50,7 @@ func (f *DB) Create(ctx context.Context, asType vocab.Type) error {
 
 	// Cache entry for this activity type's ID for later
 	// checks in the Exist() function if we see it again.
-	f.activityIDs.Set(ap.GetJSONLDId(asType).String(), struct{}{})
+	f.storeActivityID(ctx, asType)
 
 	// Extract relevant values from passed ctx.
 	activityContext := getActivityContext(ctx)
@@ -212,12 +212,23 @@ func (f *DB) createPollOptionables(
 		choices = append(choices, choice)
 	}
 
+	// Multi-choice votes may arrive as one Note
+	// per option, possibly repeated, so drop any
+	// duplicate choices within this activity.
+	slices.Sort(choices)
+	choices = slices.Compact(choices)
+
 	// Ensure status is set on the poll,
 	// which will be sent in some manner to
 	// the workers for further processing.
 	poll := inReplyTo.Poll
 	poll.Status = inReplyTo
 
+	if !*poll.Multiple && len(choices) > 1 {
+		log.Warnf(ctx, "%s voted for multiple options in single-choice poll %s", requester.URI, inReplyTo.URI)
+		return nil // this is a useful warning for admins to report to us from logs
+	}
+
 	if vote != nil {
 		// Ensure this isn't a multiple vote
 		// by same account in the same poll.
@@ -231,6 +242,12 @@ func (f *DB) createPollOptionables(
 			return slices.Contains(vote.Choices, choice)
 		})
 
+		if len(choices) == 0 {
+			// Nothing new to add,
+			// e.g. a redelivery.
+			return nil
+		}
+
 		// Update poll with new choices but *not* voters.
 		inReplyTo.Poll.IncrementVotes(choices, false)
 
@@ -245,7 +262,7 @@ func (f *DB) createPollOptionables(
 		vote.Poll = poll
 
 		// Enqueue an update event for poll vote to fedi API worker.
-		f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+		f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 			APActivityType: ap.ActivityUpdate,
 			APObjectType:   ap.ActivityQuestion,
 			GTSModel:       vote,
@@ -254,7 +271,7 @@ func (f *DB) createPollOptionables(
 		})
 	} else {
 		// Create new poll vote and enqueue create to fedi API worker.
-		f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+		f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 			APActivityType: ap.ActivityCreate,
 			APObjectType:   ap.ActivityQuestion,
 			GTSModel: &gtsmodel.PollVote{
@@ -308,7 +325,7 @@ func (f *DB) createStatusable(
 
 		// Pass the statusable URI (APIri) into the processor
 		// worker and do the rest of the processing asynchronously.
-		f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+		f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 			APObjectType:   ap.ObjectNote,
 			APActivityType: ap.ActivityCreate,
 			APIRI:          ap.GetJSONLDId(statusable),
@@ -322,7 +339,7 @@ func (f *DB) createStatusable(
 
 	// Do the rest of the processing asynchronously. The processor
 	// will handle inserting/updating + further dereferencing the status.
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APObjectType:   ap.ObjectNote,
 		APActivityType: ap.ActivityCreate,
 		APIRI:          nil,
@@ -27,6 +27,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/filter/interaction"
 	"code.superseriousbusiness.org/gotosocial/internal/filter/spam"
 	"code.superseriousbusiness.org/gotosocial/internal/filter/visibility"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
 	"codeberg.org/gruf/go-cache/v3/simple"
@@ -67,8 +68,9 @@ type DB struct {
 	intFilter  *interaction.Filter
 	spamFilter *spam.Filter
 
-	// tracks Activity IDs we have handled creates for,
-	// for use in the Exists() function during forwarding.
+	// tracks Activity IDs we have handled creates for, per
+	// receiving account, for use in the Exists() function
+	// during forwarding. See activityIDKey() for keys.
 	activityIDs simple.Cache[string, struct{}]
 }
 
@@ -94,6 +96,18 @@ func New(
 
 // storeActivityID stores an entry in the .activityIDs cache for this
 // type's JSON-LD ID, for later checks in Exist() to mark it as seen.
-func (f *DB) storeActivityID(asType vocab.Type) {
-	f.activityIDs.Set(ap.GetJSONLDId(asType).String(), struct{}{})
+func (f *DB) storeActivityID(ctx context.Context, asType vocab.Type) {
+	key := activityIDKey(ctx, ap.GetJSONLDId(asType).String())
+	f.activityIDs.Set(key, struct{}{})
+}
+
+// activityIDKey returns the .activityIDs cache key for given
+// activity ID. As we have no shared inbox, remotes deliver the
+// same activity to each local recipient, so we key by receiving
+// account to ensure each delivery gets handled for its receiver.
+func activityIDKey(ctx context.Context, activityID string) string {
+	if receiver := gtscontext.ReceivingAccount(ctx); receiver != nil {
+		return receiver.ID + " " + activityID
+	}
+	return activityID
 }
@@ -0,0 +1,147 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federatingdb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"code.superseriousbusiness.org/activity/streams/vocab"
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
+)
+
+func (f *DB) EmojiReact(ctx context.Context, reactable vocab.LitePubEmojiReact) error {
+	log.DebugKV(ctx, "emojiReact", Serialize{reactable})
+
+	// Mark activity as handled.
+	f.storeActivityID(ctx, reactable)
+
+	// Extract relevant values from passed ctx.
+	activityContext := getActivityContext(ctx)
+	if activityContext.internal {
+		return nil // Already processed.
+	}
+
+	return f.react(ctx,
+		activityContext.receivingAcct,
+		activityContext.requestingAcct,
+		reactable,
+	)
+}
+
+// react handles an incoming emoji reaction, which may
+// be either a LitePub EmojiReact, or a Misskey-style
+// Like with the reaction emoji set as its content.
+func (f *DB) react(
+	ctx context.Context,
+	receiving *gtsmodel.Account,
+	requesting *gtsmodel.Account,
+	reactable ap.Reactable,
+) error {
+	if requesting.IsMoving() {
+		// A Moving account
+		// can't do this.
+		return nil
+	}
+
+	// Convert received AS type to internal reaction model.
+	reaction, err := f.converter.ASReactToStatusReaction(ctx, reactable)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("error converting from AS type: %w", err)
+		return gtserror.WrapWithCode(http.StatusBadRequest, err)
+	}
+
+	// If the converted reaction is nil that
+	// means we didn't have the target status
+	// or account in the DB, so we don't need
+	// to do anything with this reaction.
+	if reaction == nil {
+		return nil
+	}
+
+	// Ensure reaction enacted by correct account.
+	if reaction.AccountID != requesting.ID {
+		return gtserror.NewfWithCode(http.StatusForbidden, "requester %s is not expected actor %s",
+			requesting.URI, reaction.Account.URI)
+	}
+
+	// If the reaction doesn't target the
+	// account receiving the message, or
+	// isn't of a local status, just toss it.
+	if reaction.TargetAccountID != receiving.ID ||
+		!*reaction.Status.Local {
+		return nil
+	}
+
+	// Check whether the reaction is already stored.
+	existing, err := f.state.DB.GetStatusReactionByURI(
+		gtscontext.SetBarebones(ctx),
+		reaction.URI,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error checking for reaction %s: %w", reaction.URI, err)
+	}
+
+	if existing != nil {
+		// Already handled.
+		return nil
+	}
+
+	// Reactions are treated like Likes for
+	// the purposes of interaction policy.
+	policyResult, err := f.intFilter.StatusLikeable(ctx,
+		requesting,
+		reaction.Status,
+	)
+	if err != nil {
+		return gtserror.Newf("error seeing if status %s is likeable: %w", reaction.Status.URI, err)
+	}
+
+	if policyResult.Forbidden() {
+		return gtserror.NewWithCode(http.StatusForbidden, "requester does not have permission to react to status")
+	}
+
+	if !policyResult.AutomaticApproval() {
+		// We don't support reactions
+		// pending approval, just drop it.
+		return nil
+	}
+
+	// Storing the reaction is left to the fedi
+	// worker, as any custom emoji used may
+	// need to be dereferenced beforehand.
+	reaction.ID = id.NewULID()
+
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
+		APObjectType:   ap.ActivityEmojiReact,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       reaction,
+		Receiving:      receiving,
+		Requesting:     requesting,
+	})
+
+	return nil
+}
@@ -0,0 +1,171 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federatingdb_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/activity/streams"
+	"code.superseriousbusiness.org/activity/streams/vocab"
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"github.com/stretchr/testify/suite"
+)
+
+type EmojiReactTestSuite struct {
+	FederatingDBTestSuite
+}
+
+func (suite *EmojiReactTestSuite) toType(ctx context.Context, jsonStr string) vocab.Type {
+	raw := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(jsonStr), &raw); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	t, err := streams.ToType(ctx, raw)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return t
+}
+
+func (suite *EmojiReactTestSuite) TestEmojiReactCustomEmoji() {
+	var (
+		receiving  = suite.testAccounts["admin_account"]
+		requesting = suite.testAccounts["remote_account_1"]
+		testStatus = suite.testStatuses["admin_account_status_1"]
+		ctx        = createTestContext(suite.T(), receiving, requesting)
+		reactURI   = "http://fossbros-anonymous.io/reactions/4a4f8ab2-1df0-4bd3-a7c4-d1e1f6a0d1b3"
+	)
+
+	t := suite.toType(ctx, `{
+  "@context": [
+    "https://www.w3.org/ns/activitystreams",
+    "http://litepub.social/ns#"
+  ],
+  "type": "EmojiReact",
+  "id": "`+reactURI+`",
+  "actor": "`+requesting.URI+`",
+  "object": "`+testStatus.URI+`",
+  "to": "`+receiving.URI+`",
+  "content": ":blobhaj:",
+  "tag": [
+    {
+      "type": "Emoji",
+      "id": "http://fossbros-anonymous.io/emoji/blobhaj",
+      "name": ":blobhaj:",
+      "icon": {
+        "type": "Image",
+        "mediaType": "image/png",
+        "url": "http://fossbros-anonymous.io/emoji/blobhaj.png"
+      }
+    }
+  ]
+}`)
+
+	err := suite.federatingDB.EmojiReact(ctx, t.(vocab.LitePubEmojiReact))
+	suite.NoError(err)
+
+	msg, ok := suite.getFederatorMsg(5 * time.Second)
+	suite.True(ok)
+	suite.Equal(ap.ActivityEmojiReact, msg.APObjectType)
+	suite.Equal(ap.ActivityCreate, msg.APActivityType)
+
+	reaction, ok := msg.GTSModel.(*gtsmodel.StatusReaction)
+	suite.True(ok)
+	suite.NotEmpty(reaction.ID)
+	suite.Equal(reactURI, reaction.URI)
+	suite.Equal(requesting.ID, reaction.AccountID)
+	suite.Equal(testStatus.ID, reaction.StatusID)
+	suite.Equal(":blobhaj:", reaction.Content)
+
+	// Emoji should be set as a placeholder,
+	// to be dereferenced by the processor.
+	suite.Empty(reaction.EmojiID)
+	suite.NotNil(reaction.Emoji)
+	suite.Equal("blobhaj", reaction.Emoji.Shortcode)
+	suite.Equal("fossbros-anonymous.io", reaction.Emoji.Domain)
+	suite.Equal("http://fossbros-anonymous.io/emoji/blobhaj.png", reaction.Emoji.ImageRemoteURL)
+}
+
+func (suite *EmojiReactTestSuite) TestMisskeyLikeReactAndUndo() {
+	var (
+		receiving  = suite.testAccounts["admin_account"]
+		requesting = suite.testAccounts["remote_account_1"]
+		testStatus = suite.testStatuses["admin_account_status_1"]
+		ctx        = createTestContext(suite.T(), receiving, requesting)
+		likeURI    = "http://fossbros-anonymous.io/likes/9qcmb3tq1k"
+		likeJSON   = `{
+    "@context": "https://www.w3.org/ns/activitystreams",
+    "type": "Like",
+    "id": "` + likeURI + `",
+    "actor": "` + requesting.URI + `",
+    "object": "` + testStatus.URI + `",
+    "content": "🦥"
+  }`
+	)
+
+	t := suite.toType(ctx, likeJSON)
+
+	err := suite.federatingDB.Like(ctx, t.(vocab.ActivityStreamsLike))
+	suite.NoError(err)
+
+	msg, ok := suite.getFederatorMsg(5 * time.Second)
+	suite.True(ok)
+	suite.Equal(ap.ActivityEmojiReact, msg.APObjectType)
+	suite.Equal(ap.ActivityCreate, msg.APActivityType)
+
+	reaction, ok := msg.GTSModel.(*gtsmodel.StatusReaction)
+	suite.True(ok)
+	suite.Equal("🦥", reaction.Content)
+	suite.Nil(reaction.Emoji)
+
+	// Store the reaction to emulate processor handling.
+	if err := suite.db.PutStatusReaction(ctx, reaction); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Now undo the reaction.
+	t = suite.toType(ctx, `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Undo",
+  "id": "http://fossbros-anonymous.io/undos/9qcmb3tq1k",
+  "actor": "`+requesting.URI+`",
+  "object": `+likeJSON+`
+}`)
+
+	err = suite.federatingDB.Undo(ctx, t.(vocab.ActivityStreamsUndo))
+	suite.NoError(err)
+
+	msg, ok = suite.getFederatorMsg(5 * time.Second)
+	suite.True(ok)
+	suite.Equal(ap.ActivityEmojiReact, msg.APObjectType)
+	suite.Equal(ap.ActivityUndo, msg.APActivityType)
+
+	_, err = suite.db.GetStatusReactionByURI(ctx, likeURI)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func TestEmojiReactTestSuite(t *testing.T) {
+	suite.Run(t, &EmojiReactTestSuite{})
+}
@@ -0,0 +1,165 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federatingdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"code.superseriousbusiness.org/activity/streams/vocab"
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
+)
+
+// Add handles an incoming Add activity. The only
+// Add we currently support is a remote account adding
+// one of its own statuses to its featured collection,
+// ie., pinning a status. Other Adds are ignored.
+func (f *DB) Add(ctx context.Context, add vocab.ActivityStreamsAdd) error {
+	log.DebugKV(ctx, "add", Serialize{add})
+	return f.featured(ctx, add, ap.ActivityAdd)
+}
+
+// Remove handles an incoming Remove activity. The
+// only Remove we currently support is a remote account
+// removing one of its own statuses from its featured
+// collection, ie., unpinning a status. Other Removes
+// are ignored.
+func (f *DB) Remove(ctx context.Context, remove vocab.ActivityStreamsRemove) error {
+	log.DebugKV(ctx, "remove", Serialize{remove})
+	return f.featured(ctx, remove, ap.ActivityRemove)
+}
+
+// featured contains shared logic for handling
+// Add and Remove activities targeting the
+// featured collection of the requesting account.
+func (f *DB) featured(
+	ctx context.Context,
+	activity interface {
+		vocab.Type
+		ap.WithActor
+		ap.WithObject
+		ap.WithTarget
+	},
+	activityType string,
+) error {
+	// Mark activity as handled.
+	f.storeActivityID(ctx, activity)
+
+	// Extract relevant values from passed ctx.
+	activityContext := getActivityContext(ctx)
+	if activityContext.internal {
+		return nil // Already processed.
+	}
+
+	requesting := activityContext.requestingAcct
+	receiving := activityContext.receivingAcct
+
+	if requesting.IsLocal() {
+		// We should not be processing
+		// an Add or Remove sent from our
+		// own instance in the federatingDB.
+		return nil
+	}
+
+	if requesting.IsMoving() {
+		// A Moving account
+		// can't do this.
+		return nil
+	}
+
+	// Only handle Add / Remove targeting the
+	// featured collection of the requester.
+	if !targetsFeatured(activity, requesting) {
+		log.Debugf(ctx, "%s did not target featured collection of %s, ignoring", activityType, requesting.URI)
+		return nil
+	}
+
+	// Ensure actor is the requester.
+	actors := ap.GetActorIRIs(activity)
+	if l := len(actors); l != 1 {
+		err := fmt.Errorf("%s requires exactly 1 actor, had %d", activityType, l)
+		return gtserror.SetMalformed(err)
+	}
+
+	if actorStr := actors[0].String(); actorStr != requesting.URI {
+		err := fmt.Errorf(
+			"%s was signed by %s but actor was %s",
+			activityType, requesting.URI, actorStr,
+		)
+		return gtserror.SetMalformed(err)
+	}
+
+	// Extract the status IRI.
+	objects := ap.GetObjectIRIs(activity)
+	if l := len(objects); l != 1 {
+		err := fmt.Errorf("%s requires exactly 1 object, had %d", activityType, l)
+		return gtserror.SetMalformed(err)
+	}
+	statusIRI := objects[0]
+
+	// Accounts can only feature their own statuses,
+	// so the status should live on the requester's host.
+	if !sameHost(statusIRI, requesting.URI) {
+		err := errors.New("featured status must be on the same host as the requester")
+		return gtserror.SetMalformed(err)
+	}
+
+	// Pass to the processor to
+	// dereference status + update pin.
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: activityType,
+		APIRI:          statusIRI,
+		Receiving:      receiving,
+		Requesting:     requesting,
+	})
+
+	return nil
+}
+
+// targetsFeatured returns whether the
+// given activity targets exactly the
+// featured collection of account.
+func targetsFeatured(with ap.WithTarget, account *gtsmodel.Account) bool {
+	if account.FeaturedCollectionURI == "" {
+		return false
+	}
+
+	targets := ap.GetTargetIRIs(with)
+	if len(targets) != 1 {
+		return false
+	}
+
+	return targets[0].String() == account.FeaturedCollectionURI
+}
+
+// sameHost returns whether iri shares
+// a host with the given uri string.
+func sameHost(iri *url.URL, uri string) bool {
+	other, err := url.Parse(uri)
+	if err != nil {
+		return false
+	}
+	return iri.Host == other.Host
+}
@@ -0,0 +1,157 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federatingdb_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/activity/streams"
+	"code.superseriousbusiness.org/activity/streams/vocab"
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"github.com/stretchr/testify/suite"
+)
+
+type FeaturedTestSuite struct {
+	FederatingDBTestSuite
+}
+
+func (suite *FeaturedTestSuite) toType(activityStr string) vocab.Type {
+	raw := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(activityStr), &raw); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	t, err := streams.ToType(suite.T().Context(), raw)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return t
+}
+
+func (suite *FeaturedTestSuite) add(
+	receivingAcct *gtsmodel.Account,
+	requestingAcct *gtsmodel.Account,
+	addStr string,
+) error {
+	ctx := createTestContext(suite.T(), receivingAcct, requestingAcct)
+
+	add, ok := suite.toType(addStr).(vocab.ActivityStreamsAdd)
+	if !ok {
+		suite.FailNow("couldn't cast to Add")
+	}
+
+	return suite.federatingDB.Add(ctx, add)
+}
+
+func (suite *FeaturedTestSuite) remove(
+	receivingAcct *gtsmodel.Account,
+	requestingAcct *gtsmodel.Account,
+	removeStr string,
+) error {
+	ctx := createTestContext(suite.T(), receivingAcct, requestingAcct)
+
+	remove, ok := suite.toType(removeStr).(vocab.ActivityStreamsRemove)
+	if !ok {
+		suite.FailNow("couldn't cast to Remove")
+	}
+
+	return suite.federatingDB.Remove(ctx, remove)
+}
+
+func (suite *FeaturedTestSuite) TestAddRemoveFeatured() {
+	var (
+		receivingAcct  = suite.testAccounts["local_account_1"]
+		requestingAcct = suite.testAccounts["remote_account_1"]
+		statusURI      = suite.testStatuses["remote_account_1_status_1"].URI
+	)
+
+	addStr := `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "http://fossbros-anonymous.io/users/foss_satan#adds/1",
+  "actor": "http://fossbros-anonymous.io/users/foss_satan",
+  "type": "Add",
+  "object": "` + statusURI + `",
+  "target": "http://fossbros-anonymous.io/users/foss_satan/collections/featured",
+  "to": "https://www.w3.org/ns/activitystreams#Public"
+}`
+
+	if err := suite.add(receivingAcct, requestingAcct, addStr); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Should be a message heading to the processor.
+	msg, _ := suite.getFederatorMsg(5 * time.Second)
+	suite.Equal(ap.ObjectNote, msg.APObjectType)
+	suite.Equal(ap.ActivityAdd, msg.APActivityType)
+	suite.Equal(statusURI, msg.APIRI.String())
+
+	removeStr := `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "http://fossbros-anonymous.io/users/foss_satan#removes/1",
+  "actor": "http://fossbros-anonymous.io/users/foss_satan",
+  "type": "Remove",
+  "object": "` + statusURI + `",
+  "target": "http://fossbros-anonymous.io/users/foss_satan/collections/featured",
+  "to": "https://www.w3.org/ns/activitystreams#Public"
+}`
+
+	if err := suite.remove(receivingAcct, requestingAcct, removeStr); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	msg, _ = suite.getFederatorMsg(5 * time.Second)
+	suite.Equal(ap.ObjectNote, msg.APObjectType)
+	suite.Equal(ap.ActivityRemove, msg.APActivityType)
+	suite.Equal(statusURI, msg.APIRI.String())
+}
+
+func (suite *FeaturedTestSuite) TestAddOtherCollection() {
+	var (
+		receivingAcct  = suite.testAccounts["local_account_1"]
+		requestingAcct = suite.testAccounts["remote_account_1"]
+		statusURI      = suite.testStatuses["remote_account_1_status_1"].URI
+	)
+
+	// Add targeting some collection
+	// that isn't featured is ignored.
+	addStr := `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "http://fossbros-anonymous.io/users/foss_satan#adds/2",
+  "actor": "http://fossbros-anonymous.io/users/foss_satan",
+  "type": "Add",
+  "object": "` + statusURI + `",
+  "target": "http://fossbros-anonymous.io/users/foss_satan/collections/something_else",
+  "to": "https://www.w3.org/ns/activitystreams#Public"
+}`
+
+	if err := suite.add(receivingAcct, requestingAcct, addStr); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// No message should be heading to the processor.
+	_, ok := suite.getFederatorMsg(time.Second)
+	suite.False(ok)
+}
+
+func TestFeaturedTestSuite(t *testing.T) {
+	suite.Run(t, &FeaturedTestSuite{})
+}
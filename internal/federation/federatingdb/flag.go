@@ -34,7 +34,7 @@ func (f *DB) Flag(ctx context.Context, flaggable vocab.ActivityStreamsFlag) erro
 	log.DebugKV(ctx, "flag", Serialize{flaggable})
 
 	// Mark activity as handled.
-	f.storeActivityID(flaggable)
+	f.storeActivityID(ctx, flaggable)
 
 	// Extract relevant values from passed ctx.
 	activityContext := getActivityContext(ctx)
@@ -79,7 +79,7 @@ func (f *DB) Flag(ctx context.Context, flaggable vocab.ActivityStreamsFlag) erro
 	}
 
 	// Push message to worker queue to handle report side-effects.
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APObjectType:   ap.ActivityFlag,
 		APActivityType: ap.ActivityCreate,
 		GTSModel:       report,
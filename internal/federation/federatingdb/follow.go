@@ -33,7 +33,7 @@ func (f *DB) Follow(ctx context.Context, followable vocab.ActivityStreamsFollow)
 	log.DebugKV(ctx, "follow", Serialize{followable})
 
 	// Mark activity as handled.
-	f.storeActivityID(followable)
+	f.storeActivityID(ctx, followable)
 
 	// Extract relevant values from passed ctx.
 	activityContext := getActivityContext(ctx)
@@ -72,7 +72,7 @@ func (f *DB) Follow(ctx context.Context, followable vocab.ActivityStreamsFollow)
 	}
 
 	// Push message to worker queue to handle followreq side-effects.
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APObjectType:   ap.ActivityFollow,
 		APActivityType: ap.ActivityCreate,
 		GTSModel:       followreq,
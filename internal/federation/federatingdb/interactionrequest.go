@@ -103,7 +103,7 @@ func (f *DB) parseInteractionRequest(ctx context.Context, intRequest ap.Interact
 	// Get and stringify the ID/URI of interaction request once,
 	// and mark this particular activity as handled in ID cache.
 	intRequestURI := ap.GetJSONLDId(intRequest).String()
-	f.activityIDs.Set(intRequestURI, struct{}{})
+	f.activityIDs.Set(activityIDKey(ctx, intRequestURI), struct{}{})
 
 	// Extract relevant values from passed ctx.
 	activityContext := getActivityContext(ctx)
@@ -317,7 +317,7 @@ func (f *DB) LikeRequest(ctx context.Context, likeReq vocab.GoToSocialLikeReques
 
 	// Further processing will be carried out
 	// asynchronously, and our caller will return 202 Accepted.
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APActivityType: ap.ActivityCreate,
 		APObjectType:   ap.ActivityLikeRequest,
 		GTSModel:       intReq,
@@ -449,7 +449,7 @@ func (f *DB) ReplyRequest(ctx context.Context, replyReq vocab.GoToSocialReplyReq
 
 	// Further processing will be carried out
 	// asynchronously, return 202 Accepted.
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APActivityType: ap.ActivityCreate,
 		APObjectType:   ap.ActivityReplyRequest,
 		GTSModel:       intReq,
@@ -560,7 +560,7 @@ func (f *DB) AnnounceRequest(ctx context.Context, announceReq vocab.GoToSocialAn
 
 	// Further processing will be carried out
 	// asynchronously, return 202 Accepted.
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APActivityType: ap.ActivityCreate,
 		APObjectType:   ap.ActivityAnnounceRequest,
 		GTSModel:       intReq,
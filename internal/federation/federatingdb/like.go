@@ -35,7 +35,7 @@ func (f *DB) Like(ctx context.Context, likeable vocab.ActivityStreamsLike) error
 	log.DebugKV(ctx, "like", Serialize{likeable})
 
 	// Mark activity as handled.
-	f.storeActivityID(likeable)
+	f.storeActivityID(ctx, likeable)
 
 	// Extract relevant values from passed ctx.
 	activityContext := getActivityContext(ctx)
@@ -46,6 +46,12 @@ func (f *DB) Like(ctx context.Context, likeable vocab.ActivityStreamsLike) error
 	requesting := activityContext.requestingAcct
 	receiving := activityContext.receivingAcct
 
+	if ap.ExtractContent(likeable).Content != "" {
+		// Misskey-style emoji reaction,
+		// with the emoji set as content.
+		return f.react(ctx, receiving, requesting, likeable)
+	}
+
 	if requesting.IsMoving() {
 		// A Moving account
 		// can't do this.
@@ -145,7 +151,7 @@ func (f *DB) Like(ctx context.Context, likeable vocab.ActivityStreamsLike) error
 		return gtserror.Newf("error inserting %s into db: %w", fave.URI, err)
 	}
 
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APObjectType:   ap.ActivityLike,
 		APActivityType: ap.ActivityCreate,
 		GTSModel:       fave,
@@ -39,7 +39,7 @@ func (f *DB) Move(ctx context.Context, move vocab.ActivityStreamsMove) error {
 	log.DebugKV(ctx, "move", Serialize{move})
 
 	// Mark activity as handled.
-	f.storeActivityID(move)
+	f.storeActivityID(ctx, move)
 
 	activityContext := getActivityContext(ctx)
 	if activityContext.internal {
@@ -164,7 +164,7 @@ func (f *DB) Move(ctx context.Context, move vocab.ActivityStreamsMove) error {
 
 	// We had a Move already or stored a new Move.
 	// Pass back to a worker for async processing.
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APObjectType:   ap.ActorPerson,
 		APActivityType: ap.ActivityMove,
 		GTSModel:       stubMove,
@@ -122,6 +122,10 @@ func (f *DB) Owns(ctx context.Context, id *url.URL) (bool, error) {
 		return f.ownsLike(ctx, id)
 	}
 
+	if uris.IsReactionPath(id) {
+		return f.ownsReaction(ctx, id)
+	}
+
 	if uris.IsBlockPath(id) {
 		username, blockID, err := uris.ParseBlockPath(id)
 		if err != nil {
@@ -185,3 +189,39 @@ func (f *DB) ownsLike(ctx context.Context, uri *url.URL) (bool, error) {
 	log.Tracef(ctx, "we own Like %s", uri.String())
 	return true, nil
 }
+
+func (f *DB) ownsReaction(ctx context.Context, uri *url.URL) (bool, error) {
+	username, id, err := uris.ParseReactionPath(uri)
+	if err != nil {
+		return false, fmt.Errorf("error parsing reaction path for url %s: %w", uri.String(), err)
+	}
+
+	// We're only checking for existence,
+	// so use barebones context.
+	bbCtx := gtscontext.SetBarebones(ctx)
+
+	if _, err := f.state.DB.GetAccountByUsernameDomain(bbCtx, username, ""); err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// No entries for this acct,
+			// we don't own this item.
+			return false, nil
+		}
+
+		// Actual error.
+		return false, fmt.Errorf("database error fetching account with username %s: %w", username, err)
+	}
+
+	if _, err := f.state.DB.GetStatusReactionByID(bbCtx, id); err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// No entries for this ID,
+			// we don't own this item.
+			return false, nil
+		}
+
+		// Actual error.
+		return false, fmt.Errorf("database error fetching status reaction with id %s: %w", id, err)
+	}
+
+	log.Tracef(ctx, "we own reaction %s", uri.String())
+	return true, nil
+}
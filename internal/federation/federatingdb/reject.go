@@ -360,7 +360,7 @@ func (f *DB) rejectStatusIRI(
 
 	// Send the rejected request through to
 	// the fedi worker to process side effects.
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APObjectType:   apObjectType,
 		APActivityType: ap.ActivityReject,
 		GTSModel:       req,
@@ -481,7 +481,7 @@ func (f *DB) rejectLikeIRI(
 
 	// Send the rejected request through to
 	// the fedi worker to process side effects.
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APObjectType:   ap.ActivityLike,
 		APActivityType: ap.ActivityReject,
 		GTSModel:       req,
@@ -78,6 +78,18 @@ func (f *DB) Undo(ctx context.Context, undo vocab.ActivityStreamsUndo) error {
 				return err
 			}
 
+		// UNDO EMOJIREACT
+		case ap.ActivityEmojiReact:
+			if err := f.undoReaction(
+				ctx,
+				receivingAcct,
+				requestingAcct,
+				undo,
+				asType,
+			); err != nil {
+				return err
+			}
+
 		// UNDO BLOCK
 		case ap.ActivityBlock:
 			if err := f.undoBlock(
@@ -177,7 +189,7 @@ func (f *DB) undoFollow(
 
 	// Send the deleted follow through to
 	// the fedi worker to process side effects.
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APObjectType:   ap.ActivityFollow,
 		APActivityType: ap.ActivityUndo,
 		GTSModel:       follow,
@@ -201,6 +213,18 @@ func (f *DB) undoLike(
 		return gtserror.SetMalformed(err)
 	}
 
+	if ap.ExtractContent(asLike).Content != "" {
+		// Misskey-style emoji reaction,
+		// with the emoji set as content.
+		return f.undoReaction(
+			ctx,
+			receivingAcct,
+			requestingAcct,
+			undo,
+			t,
+		)
+	}
+
 	// Make sure the Undo
 	// actor owns the target.
 	if !sameActor(
@@ -277,7 +301,7 @@ func (f *DB) undoLike(
 
 	// Send the deleted block through to
 	// the fedi worker to process side effects.
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APObjectType:   ap.ActivityLike,
 		APActivityType: ap.ActivityUndo,
 		GTSModel:       fave,
@@ -288,6 +312,84 @@ func (f *DB) undoLike(
 	return nil
 }
 
+func (f *DB) undoReaction(
+	ctx context.Context,
+	receivingAcct *gtsmodel.Account,
+	requestingAcct *gtsmodel.Account,
+	undo vocab.ActivityStreamsUndo,
+	t vocab.Type,
+) error {
+	asReact, ok := t.(ap.Reactable)
+	if !ok {
+		err := fmt.Errorf("%T not parseable as ap.Reactable", t)
+		return gtserror.SetMalformed(err)
+	}
+
+	// Make sure the Undo
+	// actor owns the target.
+	if !sameActor(
+		undo.GetActivityStreamsActor(),
+		asReact.GetActivityStreamsActor(),
+	) {
+		// Ignore this Activity.
+		return nil
+	}
+
+	uri := ap.GetJSONLDId(asReact)
+	if uri == nil {
+		err := gtserror.New("unusable iri property")
+		return gtserror.SetMalformed(err)
+	}
+
+	// Reactions are looked up by URI, as an account
+	// may have several reactions on the same status.
+	reaction, err := f.state.DB.GetStatusReactionByURI(
+		gtscontext.SetBarebones(ctx),
+		uri.String(),
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting reaction %s: %w", uri, err)
+		return err
+	}
+
+	if reaction == nil {
+		// We didn't have this reaction
+		// stored anyway, so we can't
+		// Undo it, just ignore.
+		return nil
+	}
+
+	// Ensure addressee is reaction target.
+	if reaction.TargetAccountID != receivingAcct.ID {
+		const text = "receivingAcct was not reaction target"
+		return gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	// Ensure requester is reaction origin.
+	if reaction.AccountID != requestingAcct.ID {
+		const text = "requestingAcct was not reaction origin"
+		return gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	// Delete the reaction.
+	if err := f.state.DB.DeleteStatusReactionByID(ctx, reaction.ID); err != nil {
+		err := gtserror.Newf("db error deleting reaction %s: %w", reaction.ID, err)
+		return err
+	}
+
+	// Send the deleted reaction through to
+	// the fedi worker to process side effects.
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
+		APObjectType:   ap.ActivityEmojiReact,
+		APActivityType: ap.ActivityUndo,
+		GTSModel:       reaction,
+		Receiving:      receivingAcct,
+		Requesting:     requestingAcct,
+	})
+
+	return nil
+}
+
 func (f *DB) undoBlock(
 	ctx context.Context,
 	receivingAcct *gtsmodel.Account,
@@ -347,7 +449,7 @@ func (f *DB) undoBlock(
 
 	// Send the deleted block through to
 	// the fedi worker to process side effects.
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APObjectType:   ap.ActivityBlock,
 		APActivityType: ap.ActivityUndo,
 		GTSModel:       block,
@@ -416,7 +518,7 @@ func (f *DB) undoAnnounce(
 	}
 
 	// Looks valid. Process side effects asynchronously.
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APObjectType:   ap.ActivityAnnounce,
 		APActivityType: ap.ActivityUndo,
 		GTSModel:       boost,
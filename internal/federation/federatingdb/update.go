@@ -44,7 +44,7 @@ func (f *DB) Update(ctx context.Context, asType vocab.Type) error {
 	log.DebugKV(ctx, "update", Serialize{asType})
 
 	// Mark activity as handled.
-	f.storeActivityID(asType)
+	f.storeActivityID(ctx, asType)
 
 	// Extract relevant values from passed ctx.
 	activityContext := getActivityContext(ctx)
@@ -93,7 +93,7 @@ func (f *DB) updateAccountable(ctx context.Context, receivingAcct *gtsmodel.Acco
 	// was delivered along with the Update, for further asynchronous
 	// updating of eg., avatar/header, emojis, etc. The actual db
 	// inserts/updates will take place there.
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APObjectType:   ap.ActorPerson,
 		APActivityType: ap.ActivityUpdate,
 		GTSModel:       requestingAcct,
@@ -146,11 +146,16 @@ func (f *DB) updateStatusable(ctx context.Context, receivingAcct *gtsmodel.Accou
 		// For forwarded updates, set a nil AS
 		// status to force refresh from remote.
 		statusable = nil
+	} else if f.softwareQuirks(ctx, requestingAcct).RefetchUpdatedStatuses {
+		// Sender's software is known not to embed
+		// complete statuses in updates, so set a nil
+		// AS status to force refresh from remote.
+		statusable = nil
 	}
 
 	// Queue an UPDATE NOTE activity to our fedi API worker,
 	// this will handle necessary database insertions, etc.
-	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+	f.state.Workers.Federator.Push(ctx, &messages.FromFediAPI{
 		APObjectType:   ap.ObjectNote,
 		APActivityType: ap.ActivityUpdate,
 		GTSModel:       status, // original status
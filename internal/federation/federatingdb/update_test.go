@@ -70,6 +70,46 @@ func (suite *UpdateTestSuite) TestUpdateNewMention() {
 	suite.NotNil(msg.APObject)
 }
 
+func (suite *UpdateTestSuite) TestUpdateRefetchQuirk() {
+	var (
+		ctx            = suite.T().Context()
+		update         = suite.testActivities["remote_account_2_status_1_update"]
+		receivingAcct  = suite.testAccounts["local_account_1"]
+		requestingAcct = suite.testAccounts["remote_account_2"]
+	)
+
+	// Pretend the requester's instance
+	// runs software with update quirks.
+	instance, err := suite.db.GetInstance(ctx, "example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	instance.Software = "Misskey"
+	if err := suite.db.UpdateInstance(ctx, instance, "software"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	ctx = gtscontext.SetReceivingAccount(ctx, receivingAcct)
+	ctx = gtscontext.SetRequestingAccount(ctx, requestingAcct)
+
+	note := update.Activity.GetActivityStreamsObject().At(0).GetActivityStreamsNote()
+	if err := suite.federatingDB.Update(ctx, note); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Should be a message heading to the processor.
+	msg, ok := suite.getFederatorMsg(5 * time.Second)
+	if !ok {
+		suite.FailNow("no federator message after 5s")
+	}
+
+	// Embedded status should have been dropped,
+	// to force the status to be refetched.
+	suite.Equal(ap.ObjectNote, msg.APObjectType)
+	suite.Equal(ap.ActivityUpdate, msg.APActivityType)
+	suite.Nil(msg.APObject)
+}
+
 func TestUpdateTestSuite(t *testing.T) {
 	suite.Run(t, new(UpdateTestSuite))
 }
@@ -20,6 +20,7 @@
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 
@@ -28,6 +29,8 @@
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/federation/quirks"
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
@@ -284,3 +287,27 @@ func (f *DB) statusableOK(
 		return false, gtserror.Newf("error checking relevancy/spam: %w", err)
 	}
 }
+
+// softwareQuirks returns known federation quirks of
+// the software run by the given remote account's
+// instance. If the instance or its software isn't
+// known, the zero value (ie., no quirks) is returned.
+func (f *DB) softwareQuirks(ctx context.Context, account *gtsmodel.Account) quirks.Quirks {
+	// Instances are stored by host of
+	// account URI, not account domain.
+	uri, err := url.Parse(account.URI)
+	if err != nil {
+		log.Errorf(ctx, "invalid account uri %q: %v", account.URI, err)
+		return quirks.Quirks{}
+	}
+
+	instance, err := f.state.DB.GetInstance(ctx, uri.Host)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			log.Errorf(ctx, "db error getting instance %s: %v", uri.Host, err)
+		}
+		return quirks.Quirks{}
+	}
+
+	return quirks.For(instance.Software)
+}
@@ -224,7 +224,16 @@ func (f *Federator) AuthenticatePostInbox(ctx context.Context, w http.ResponseWr
 	}
 
 	// Check who's trying to deliver to us by inspecting the http signature.
-	pubKeyAuth, errWithCode := f.AuthenticateFederatedRequest(ctx, receiver.Username)
+	var pubKeyAuth *PubKeyAuth
+	var errWithCode gtserror.WithCode
+	if gtscontext.HTTPSignatureVerifier(ctx) == nil &&
+		config.GetInstanceFederationIntegrityProofs() {
+		// Request wasn't http-signed, so
+		// check for an integrity proof instead.
+		pubKeyAuth, errWithCode = f.AuthenticateIntegrityProof(ctx, receiver.Username, r)
+	} else {
+		pubKeyAuth, errWithCode = f.AuthenticateFederatedRequest(ctx, receiver.Username)
+	}
 	if errWithCode != nil {
 
 		// Check if we got code 410 Gone from a remote
@@ -20,17 +20,20 @@
 import (
 	"bytes"
 	"context"
+	"crypto/ed25519"
 	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/integrity"
 	"code.superseriousbusiness.org/gotosocial/testrig"
 	"code.superseriousbusiness.org/httpsig"
 	errorsv2 "codeberg.org/gruf/go-errors/v2"
@@ -293,6 +296,69 @@ func (suite *FederatingProtocolTestSuite) TestAuthenticatePostInboxKeyExpired()
 	suite.Equal(http.StatusOK, code)
 }
 
+func (suite *FederatingProtocolTestSuite) authenticateIntegrityProof(
+	receivingAccount *gtsmodel.Account,
+	body []byte,
+) gtserror.WithCode {
+	request := httptest.NewRequest(http.MethodPost, receivingAccount.InboxURI, bytes.NewReader(body))
+	_, errWithCode := suite.federator.AuthenticateIntegrityProof(
+		suite.T().Context(),
+		receivingAccount.Username,
+		request,
+	)
+	return errWithCode
+}
+
+func (suite *FederatingProtocolTestSuite) TestAuthenticateIntegrityProofTooOld() {
+	var (
+		receivingAccount = suite.testAccounts["local_account_1"]
+		actor            = suite.testAccounts["remote_account_1"]
+	)
+
+	_, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Sign an activity with a proof
+	// created longer ago than accepted.
+	doc := map[string]any{
+		"@context": "https://www.w3.org/ns/activitystreams",
+		"id":       actor.URI + "/follow/01J0000000000000000000000",
+		"type":     "Follow",
+		"actor":    actor.URI,
+		"object":   receivingAccount.URI,
+	}
+	if err := integrity.Sign(doc,
+		priv,
+		actor.URI+"#ed25519-key",
+		time.Now().Add(-13*time.Hour),
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	body, err := json.Marshal(doc)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	errWithCode := suite.authenticateIntegrityProof(receivingAccount, body)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+	suite.Equal("Unauthorized: proof too old", errWithCode.Safe())
+}
+
+func (suite *FederatingProtocolTestSuite) TestAuthenticateIntegrityProofTooLarge() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+
+	// Post a body exceeding the max size.
+	body := bytes.Repeat([]byte{' '}, int(ap.MaxInboxPostSize)+1)
+
+	errWithCode := suite.authenticateIntegrityProof(receivingAccount, body)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusRequestEntityTooLarge, errWithCode.Code())
+}
+
 func (suite *FederatingProtocolTestSuite) TestAuthenticatePostGoneWithTombstone() {
 	var (
 		activity         = suite.testActivities["delete_https://somewhere.mysterious/users/rest_in_piss#main-key"]
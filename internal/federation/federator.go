@@ -91,6 +91,7 @@ func NewFederator(
 		},
 		callback: []any{
 			federatingDB.Like,
+			federatingDB.EmojiReact,
 			federatingDB.Block,
 			federatingDB.Follow,
 			federatingDB.Undo,
@@ -102,9 +103,11 @@ func NewFederator(
 			federatingDB.LikeRequest,
 			federatingDB.ReplyRequest,
 			federatingDB.AnnounceRequest,
+			federatingDB.Add,
+			federatingDB.Remove,
 		},
 	}
-	actor := newFederatingActor(f, f, federatingDB, clock)
+	actor := newFederatingActor(state, f, f, federatingDB, clock)
 	f.actor = actor
 	return f
 }
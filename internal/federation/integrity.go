@@ -0,0 +1,205 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/federation/dereferencing"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/integrity"
+)
+
+const (
+	// proofMaxAge is the maximum age of an
+	// integrity proof's created time we accept.
+	proofMaxAge = 12 * time.Hour
+
+	// proofClockSkew is the leeway given
+	// for proofs created in the future.
+	proofClockSkew = time.Hour
+)
+
+// AuthenticateIntegrityProof authenticates an incoming POST request
+// which was NOT http-signed, by instead verifying the object integrity
+// proof (FEP-8b32) embedded in the posted activity. The proof must have
+// been made with a key belonging to the activity's actor.
+//
+// The request body is read in full, up to ap.MaxInboxPostSize,
+// and replaced so that it can be read again by the caller afterwards.
+// The proof must have been created within the last 12 hours.
+//
+// As with AuthenticateFederatedRequest, the caller MUST CHECK AT SOME
+// POINT WHETHER THE KEY OWNER HAS BEEN SUSPENDED, and handle it.
+func (f *Federator) AuthenticateIntegrityProof(
+	ctx context.Context,
+	requestedUser string,
+	r *http.Request,
+) (*PubKeyAuth, gtserror.WithCode) {
+	b, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, ap.MaxInboxPostSize))
+	_ = r.Body.Close()
+	if err != nil {
+		if errors.As(err, new(*http.MaxBytesError)) {
+			return nil, gtserror.WrapWithCode(http.StatusRequestEntityTooLarge, err)
+		}
+		err := gtserror.Newf("error reading request body: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(b))
+
+	// Decode body as generic JSON,
+	// preserving any number values.
+	var doc map[string]any
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		const text = "request body was not valid json"
+		return nil, gtserror.NewErrorBadRequest(err, text)
+	}
+
+	keyID, err := integrity.VerificationMethod(doc)
+	if err != nil {
+		err := gtserror.Newf("%w: %w", errUnsigned, err)
+		return nil, gtserror.NewErrorUnauthorized(err, errUnsigned.Error(), "(proof)")
+	}
+
+	// Proof must be recent, the same as
+	// for the created time of an http
+	// signature, so that captured requests
+	// can't be replayed indefinitely.
+	if errWithCode := checkProofCreated(doc); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// The key should be identified by a fragment
+	// on the actor, eg., https://example.org/users/someone#ed25519-key.
+	ownerURI, err := url.Parse(keyID)
+	if err != nil || ownerURI.Fragment == "" {
+		const text = "proof verificationMethod was not a key fragment on an actor"
+		return nil, gtserror.NewErrorUnauthorized(errors.New(text), text)
+	}
+	ownerURI.Fragment = ""
+
+	// And that actor must have
+	// posted the activity itself.
+	if actorID, _ := doc["actor"].(string); actorID != ownerURI.String() {
+		const text = "proof not made by activity actor"
+		return nil, gtserror.NewErrorUnauthorized(errors.New(text), text)
+	}
+
+	if ownerURI.Host == config.GetHost() {
+		// We never deliver to ourselves,
+		// so this can't be legitimate.
+		const text = "proof made by local actor"
+		return nil, gtserror.NewErrorUnauthorized(errors.New(text), text)
+	}
+
+	// Since the signature check middleware only looks
+	// at http signatures, check for domain block here.
+	blocked, err := f.db.IsURIBlocked(ctx, ownerURI)
+	if err != nil {
+		err := gtserror.Newf("error checking block for %s: %w", ownerURI, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if blocked {
+		const text = "proof made by blocked actor"
+		return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	pubKeyAuth := &PubKeyAuth{OwnerURI: ownerURI}
+
+	// As in AuthenticateFederatedRequest, avoid
+	// deadlocking with an ongoing handshake.
+	if f.Handshaking(requestedUser, ownerURI) {
+		pubKeyAuth.Handshaking = true
+		return pubKeyAuth, nil
+	}
+
+	// Get (or dereference) the key owner.
+	owner, _, err := f.GetAccountByURI(ctx,
+		requestedUser,
+		ownerURI,
+		false,
+	)
+	if err != nil {
+		return nil, keyOwnerFetchError(err, ownerURI)
+	}
+
+	if !verifyProof(doc, owner, keyID) {
+		// The owner's key may have been changed
+		// since we last fetched them, so refresh
+		// the account and try one more time.
+		owner, _, err = f.RefreshAccount(ctx,
+			requestedUser,
+			owner,
+			nil,
+			dereferencing.Freshest,
+		)
+		if err != nil {
+			return nil, keyOwnerFetchError(err, ownerURI)
+		}
+
+		if !verifyProof(doc, owner, keyID) {
+			const text = "integrity proof verification failed"
+			return nil, gtserror.NewErrorUnauthorized(errors.New(text), text)
+		}
+	}
+
+	pubKeyAuth.Owner = owner
+	return pubKeyAuth, nil
+}
+
+// checkProofCreated checks the created time of the
+// integrity proof on doc is within the accepted window.
+func checkProofCreated(doc map[string]any) gtserror.WithCode {
+	created, err := integrity.Created(doc)
+	if err != nil {
+		return gtserror.NewErrorUnauthorized(err, err.Error())
+	}
+
+	now := time.Now()
+	switch {
+	case created.After(now.Add(proofClockSkew)):
+		const text = "proof created in the future"
+		return gtserror.NewErrorUnauthorized(errors.New(text), text)
+	case created.Before(now.Add(-proofMaxAge)):
+		const text = "proof too old"
+		return gtserror.NewErrorUnauthorized(errors.New(text), text)
+	}
+
+	return nil
+}
+
+// verifyProof returns whether the integrity proof on doc
+// was made by the given owner's Ed25519 key with keyID.
+func verifyProof(doc map[string]any, owner *gtsmodel.Account, keyID string) bool {
+	return owner.Ed25519PublicKey != nil &&
+		owner.Ed25519PublicKeyURI == keyID &&
+		integrity.Verify(doc, owner.Ed25519PublicKey) == nil
+}
@@ -0,0 +1,69 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package quirks contains knowledge of how particular
+// pieces of fediverse software deviate from what we
+// expect when federating, so that the federation
+// layer can work around these deviations per-instance.
+package quirks
+
+import (
+	"strings"
+)
+
+// Quirks models known federation quirks
+// of a particular piece of fediverse software.
+//
+// The zero value indicates no known quirks.
+type Quirks struct {
+	// RefetchUpdatedStatuses indicates that statuses
+	// embedded in Update activities from this software
+	// may not be complete, so rather than trusting the
+	// embedded status, it should be refetched from remote.
+	RefetchUpdatedStatuses bool
+}
+
+// known contains quirks of known software,
+// keyed by lowercase nodeinfo software name.
+var known = map[string]Quirks{
+	// Misskey and its forks embed statuses in
+	// Update activities that omit fields that
+	// are present when dereferenced directly.
+	"misskey":    {RefetchUpdatedStatuses: true},
+	"cherrypick": {RefetchUpdatedStatuses: true},
+	"firefish":   {RefetchUpdatedStatuses: true},
+	"foundkey":   {RefetchUpdatedStatuses: true},
+	"iceshrimp":  {RefetchUpdatedStatuses: true},
+	"sharkey":    {RefetchUpdatedStatuses: true},
+}
+
+// For returns known quirks of the given software,
+// as named in nodeinfo. Name matching is case-insensitive.
+// If software is empty or unknown, the zero value is returned.
+func For(software string) Quirks {
+	return known[strings.ToLower(software)]
+}
+
+// Names returns the names of the quirks
+// that are set on q, for display purposes.
+func (q Quirks) Names() []string {
+	var names []string
+	if q.RefetchUpdatedStatuses {
+		names = append(names, "refetch_updated_statuses")
+	}
+	return names
+}
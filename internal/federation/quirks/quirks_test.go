@@ -0,0 +1,52 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package quirks_test
+
+import (
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/federation/quirks"
+)
+
+func TestFor(t *testing.T) {
+	for _, test := range []struct {
+		software string
+		expect   quirks.Quirks
+	}{
+		{software: "", expect: quirks.Quirks{}},
+		{software: "gotosocial", expect: quirks.Quirks{}},
+		{software: "mastodon", expect: quirks.Quirks{}},
+		{software: "misskey", expect: quirks.Quirks{RefetchUpdatedStatuses: true}},
+		{software: "Sharkey", expect: quirks.Quirks{RefetchUpdatedStatuses: true}},
+	} {
+		if got := quirks.For(test.software); got != test.expect {
+			t.Errorf("unexpected quirks for %q: got %+v, expected %+v", test.software, got, test.expect)
+		}
+	}
+}
+
+func TestNames(t *testing.T) {
+	if names := (quirks.Quirks{}).Names(); len(names) != 0 {
+		t.Errorf("expected no names for zero quirks, got %v", names)
+	}
+
+	names := quirks.For("misskey").Names()
+	if len(names) != 1 || names[0] != "refetch_updated_statuses" {
+		t.Errorf("unexpected names for misskey quirks: %v", names)
+	}
+}
@@ -0,0 +1,188 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package antenna
+
+import (
+	"path"
+	"strings"
+
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/text"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// Matcher matches a single status against any number of
+// antennas, preparing the status' matchable text and
+// author details only once for all antennas checked.
+type Matcher struct {
+	reply    bool
+	fields   []string
+	username string
+	domain   string
+}
+
+// NewMatcher prepares a new Matcher for given status.
+// The status is expected to have its account populated.
+func NewMatcher(status *gtsmodel.Status) *Matcher {
+	m := new(Matcher)
+	m.reply = (status.InReplyToURI != "")
+	m.fields = getMatchableFields(status)
+
+	if status.Account != nil {
+		m.username = strings.ToLower(status.Account.Username)
+		if status.Account.IsLocal() {
+			// Local accounts have no domain set,
+			// so use the domain used in their acct.
+			m.domain = config.GetAccountDomain()
+		} else {
+			m.domain = strings.ToLower(status.Account.Domain)
+		}
+	}
+
+	return m
+}
+
+// Match returns whether the prepared status matches the rules of the given
+// antenna. An antenna with none of keywords, domains, or account patterns
+// set is considered invalid, and will never match any status.
+func (m *Matcher) Match(antenna *gtsmodel.Antenna) bool {
+	if len(antenna.Keywords) == 0 &&
+		len(antenna.Domains) == 0 &&
+		len(antenna.AccountPatterns) == 0 {
+		// No positive rules set.
+		return false
+	}
+
+	if m.reply && !util.PtrOrZero(antenna.WithReplies) {
+		// Antenna excludes replies.
+		return false
+	}
+
+	if len(antenna.Domains) > 0 &&
+		!matchDomain(m.domain, antenna.Domains) {
+		// Author not on any domain.
+		return false
+	}
+
+	if len(antenna.AccountPatterns) > 0 &&
+		!matchAccount(m.username, m.domain, antenna.AccountPatterns) {
+		// Author matches no pattern.
+		return false
+	}
+
+	if len(antenna.Keywords) > 0 &&
+		!m.containsAny(antenna.Keywords) {
+		// Status contains no keyword.
+		return false
+	}
+
+	// Finally, ensure that the status
+	// contains none of excluded keywords.
+	return !m.containsAny(antenna.ExcludeKeywords)
+}
+
+// containsAny returns whether any of the prepared
+// status fields contain any of the given keywords.
+func (m *Matcher) containsAny(keywords []string) bool {
+	for _, keyword := range keywords {
+		keyword = strings.ToLower(keyword)
+		for _, field := range m.fields {
+			if strings.Contains(field, keyword) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchDomain returns whether domain is any of,
+// or a subdomain of any of, the given domains.
+func matchDomain(domain string, domains []string) bool {
+	for _, d := range domains {
+		d = strings.ToLower(d)
+		if domain == d || strings.HasSuffix(domain, "."+d) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchAccount returns whether 'username@domain' matches any of the given
+// glob patterns. Patterns without a domain part match username on any domain.
+func matchAccount(username string, domain string, patterns []string) bool {
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(strings.TrimPrefix(pattern, "@"))
+		if !strings.Contains(pattern, "@") {
+			pattern += "@*"
+		}
+
+		// Only error returned by path.Match is for
+		// a malformed pattern, which simply won't match.
+		if ok, _ := path.Match(pattern, username+"@"+domain); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidAccountPattern returns whether given
+// account pattern is syntactically valid.
+func ValidAccountPattern(pattern string) bool {
+	_, err := path.Match(pattern, "")
+	return err == nil
+}
+
+// getMatchableFields returns the lowercased text fields
+// of a status that antenna keywords are matched against.
+func getMatchableFields(status *gtsmodel.Status) []string {
+	fields := make([]string, 0, 2+len(status.Attachments))
+
+	// Append content warning / title.
+	if status.ContentWarning != "" {
+		fields = append(fields, strings.ToLower(status.ContentWarning))
+	}
+
+	// Status content, using the plaintext version
+	// to remove any formatting characters as for
+	// status filtering. See internal/filter/status.
+	if status.Content != "" {
+		text := text.ParseHTMLToPlain(status.Content)
+		if text != "" {
+			fields = append(fields, strings.ToLower(text))
+		}
+	}
+
+	// Media descriptions, only where they are set.
+	for _, attachment := range status.Attachments {
+		if attachment.Description != "" {
+			fields = append(fields, strings.ToLower(attachment.Description))
+		}
+	}
+
+	// Non-empty poll options.
+	if status.Poll != nil {
+		for _, opt := range status.Poll.Options {
+			if opt != "" {
+				fields = append(fields, strings.ToLower(opt))
+			}
+		}
+	}
+
+	return fields
+}
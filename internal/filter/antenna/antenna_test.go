@@ -0,0 +1,153 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package antenna_test
+
+import (
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/filter/antenna"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+	status := &gtsmodel.Status{
+		ContentWarning: "Nerd stuff",
+		Content:        `<p>Latest graphs for <a href="https://gts.superseriousbusiness.org/tags/gotosocial" class="mention hashtag" rel="tag nofollow noreferrer noopener" target="_blank">#<span>GoToSocial</span></a> on Wasm sqlite3.</p>`,
+		Account: &gtsmodel.Account{
+			Username: "Zlatko",
+			Domain:   "social.example.org",
+		},
+	}
+
+	reply := &gtsmodel.Status{
+		Content:      `<p>sqlite rocks</p>`,
+		InReplyToURI: "https://example.org/statuses/01F8MH75CBF9JFX4ZAD54N0W0R",
+		Account:      status.Account,
+	}
+
+	type testcase struct {
+		name    string
+		status  *gtsmodel.Status
+		antenna *gtsmodel.Antenna
+		expect  bool
+	}
+
+	for _, test := range []testcase{
+		{
+			name:    "no rules",
+			status:  status,
+			antenna: &gtsmodel.Antenna{},
+			expect:  false,
+		},
+		{
+			name:    "keyword in content",
+			status:  status,
+			antenna: &gtsmodel.Antenna{Keywords: []string{"SQLite"}},
+			expect:  true,
+		},
+		{
+			name:    "keyword in content warning",
+			status:  status,
+			antenna: &gtsmodel.Antenna{Keywords: []string{"nerd"}},
+			expect:  true,
+		},
+		{
+			name:    "keyword not present",
+			status:  status,
+			antenna: &gtsmodel.Antenna{Keywords: []string{"postgres"}},
+			expect:  false,
+		},
+		{
+			name:   "excluded keyword present",
+			status: status,
+			antenna: &gtsmodel.Antenna{
+				Keywords:        []string{"sqlite"},
+				ExcludeKeywords: []string{"wasm"},
+			},
+			expect: false,
+		},
+		{
+			name:    "subdomain of domain",
+			status:  status,
+			antenna: &gtsmodel.Antenna{Domains: []string{"example.org"}},
+			expect:  true,
+		},
+		{
+			name:    "other domain",
+			status:  status,
+			antenna: &gtsmodel.Antenna{Domains: []string{"example.com"}},
+			expect:  false,
+		},
+		{
+			name:    "domain suffix but not subdomain",
+			status:  status,
+			antenna: &gtsmodel.Antenna{Domains: []string{"ample.org"}},
+			expect:  false,
+		},
+		{
+			name:    "account pattern username only",
+			status:  status,
+			antenna: &gtsmodel.Antenna{AccountPatterns: []string{"zlatko"}},
+			expect:  true,
+		},
+		{
+			name:    "account pattern wildcard username",
+			status:  status,
+			antenna: &gtsmodel.Antenna{AccountPatterns: []string{"@*@social.example.org"}},
+			expect:  true,
+		},
+		{
+			name:    "account pattern no match",
+			status:  status,
+			antenna: &gtsmodel.Antenna{AccountPatterns: []string{"zlatko@example.com"}},
+			expect:  false,
+		},
+		{
+			name:   "all rules match",
+			status: status,
+			antenna: &gtsmodel.Antenna{
+				Keywords:        []string{"gotosocial"},
+				Domains:         []string{"social.example.org"},
+				AccountPatterns: []string{"zlat*"},
+			},
+			expect: true,
+		},
+		{
+			name:    "reply without replies",
+			status:  reply,
+			antenna: &gtsmodel.Antenna{Keywords: []string{"sqlite"}},
+			expect:  false,
+		},
+		{
+			name:   "reply with replies",
+			status: reply,
+			antenna: &gtsmodel.Antenna{
+				Keywords:    []string{"sqlite"},
+				WithReplies: util.Ptr(true),
+			},
+			expect: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			matcher := antenna.NewMatcher(test.status)
+			assert.Equal(t, test.expect, matcher.Match(test.antenna))
+		})
+	}
+}
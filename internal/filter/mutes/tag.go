@@ -0,0 +1,78 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package mutes
+
+import (
+	"context"
+	"slices"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// StatusTagMuted returns whether given target status (or the status it boosts)
+// contains a tag muted by requester, hiding it from their home and list timelines.
+func (f *Filter) StatusTagMuted(ctx context.Context, requester *gtsmodel.Account, status *gtsmodel.Status) (muted bool, err error) {
+	if requester == nil {
+		// Without auth, there will be
+		// no possible tag mutes to exist.
+		return false, nil
+	}
+
+	// Load the (cached) tag IDs muted by requester.
+	mutedTagIDs, err := f.state.DB.GetMutedTagIDs(ctx, requester.ID)
+	if err != nil {
+		return false, gtserror.Newf("error getting muted tag IDs: %w", err)
+	}
+
+	if len(mutedTagIDs) == 0 {
+		// Nothing muted,
+		// nothing to check.
+		return false, nil
+	}
+
+	if status.BoostOfID != "" {
+		// Check boosted status instead.
+		boostOf := status.BoostOf
+		if boostOf == nil {
+			boostOf, err = f.state.DB.GetStatusByID(
+				gtscontext.SetBarebones(ctx),
+				status.BoostOfID,
+			)
+			if err != nil {
+				return false, gtserror.Newf("error getting boosted status %s: %w", status.BoostOfURI, err)
+			}
+		}
+		status = boostOf
+	}
+
+	if requester.ID == status.AccountID {
+		// Never hide requester's
+		// own statuses from them.
+		return false, nil
+	}
+
+	for _, tagID := range status.TagIDs {
+		if slices.Contains(mutedTagIDs, tagID) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -22,6 +22,7 @@
 	"time"
 
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/cache"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
 	"code.superseriousbusiness.org/gotosocial/testrig"
@@ -97,6 +98,46 @@ func (suite *StatusStatusHomeTimelineableTestSuite) TestNotFollowingStatusHomeTi
 	suite.False(timelineable)
 }
 
+func (suite *StatusStatusHomeTimelineableTestSuite) TestStatusHomeTimelineableCachedUntilUnfollow() {
+	testStatus := suite.testStatuses["local_account_2_status_1"]
+	testAccount := suite.testAccounts["local_account_1"]
+	ctx := suite.T().Context()
+
+	timelineable, err := suite.filter.StatusHomeTimelineable(ctx, testAccount, testStatus)
+	suite.NoError(err)
+	suite.True(timelineable)
+
+	// Result should now be cached for this requester + status,
+	// so repeated checks (eg., during fan-out) hit the cache.
+	cached, ok := suite.state.Caches.Visibility.GetOne("Type,RequesterID,ItemID",
+		cache.VisibilityTypeHome,
+		testAccount.ID,
+		testStatus.ID,
+	)
+	suite.True(ok)
+	suite.True(cached.Value)
+
+	// Unfollowing the status author should
+	// invalidate the cached visibility result.
+	if err := suite.db.DeleteFollow(ctx,
+		testAccount.ID,
+		testStatus.AccountID,
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, ok = suite.state.Caches.Visibility.GetOne("Type,RequesterID,ItemID",
+		cache.VisibilityTypeHome,
+		testAccount.ID,
+		testStatus.ID,
+	)
+	suite.False(ok)
+
+	timelineable, err = suite.filter.StatusHomeTimelineable(ctx, testAccount, testStatus)
+	suite.NoError(err)
+	suite.False(timelineable)
+}
+
 func (suite *StatusStatusHomeTimelineableTestSuite) TestStatusTooNewNotTimelineable() {
 	testStatus := &gtsmodel.Status{}
 	*testStatus = *suite.testStatuses["local_account_1_status_1"]
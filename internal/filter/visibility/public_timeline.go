@@ -49,10 +49,12 @@ func (f *Filter) StatusPublicTimelineable(ctx context.Context, requester *gtsmod
 
 		// Return visibility value.
 		return &cache.CachedVisibility{
-			ItemID:      status.ID,
-			RequesterID: requesterID,
-			Type:        vtype,
-			Value:       visible,
+			ItemID:           status.ID,
+			RequesterID:      requesterID,
+			AccountID:        status.AccountID,
+			BoostOfAccountID: status.BoostOfAccountID,
+			Type:             vtype,
+			Value:            visible,
 		}, nil
 	}, vtype, requesterID, status.ID)
 	if err != nil {
@@ -44,8 +44,8 @@ func (f *Filter) StatusesVisible(ctx context.Context, requester *gtsmodel.Accoun
 
 // StatusVisible will check if status is visible to requester,
 // accounting for requester with no auth (i.e is nil), suspensions,
-// disabled local users, pending approvals, account blocks,
-// and status visibility settings.
+// disabled local users, pending approvals, account blocks, statuses
+// in the trash, and status visibility settings.
 func (f *Filter) StatusVisible(
 	ctx context.Context,
 	requester *gtsmodel.Account,
@@ -70,10 +70,12 @@ func (f *Filter) StatusVisible(
 
 		// Return visibility value.
 		return &cache.CachedVisibility{
-			ItemID:      status.ID,
-			RequesterID: requesterID,
-			Type:        vtype,
-			Value:       visible,
+			ItemID:           status.ID,
+			RequesterID:      requesterID,
+			AccountID:        status.AccountID,
+			BoostOfAccountID: status.BoostOfAccountID,
+			Type:             vtype,
+			Value:            visible,
 		}, nil
 	}, vtype, requesterID, status.ID)
 	if err != nil {
@@ -96,6 +98,14 @@ func (f *Filter) isStatusVisible(
 		return false, gtserror.Newf("error populating status %s: %w", status.ID, err)
 	}
 
+	if status.InTrash() ||
+		(status.BoostOf != nil && status.BoostOf.InTrash()) {
+		// Statuses (and boosts of statuses) in the
+		// trash aren't visible to anyone, not even
+		// their author, until they're restored.
+		return false, nil
+	}
+
 	// Shortcut to check up-front for owner of their own status.
 	if requester != nil && status.AccountID == requester.ID {
 		return true, nil
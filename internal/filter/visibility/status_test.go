@@ -19,6 +19,7 @@
 
 import (
 	"testing"
+	"time"
 
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
@@ -156,6 +157,35 @@ func (suite *StatusVisibleTestSuite) TestStatusNotVisibleIfNotFollowingCached()
 	suite.False(visible)
 }
 
+func (suite *StatusVisibleTestSuite) TestStatusNotVisibleIfAuthorSuspendedCached() {
+	ctx := suite.T().Context()
+	testStatusID := suite.testStatuses["local_account_1_status_1"].ID
+	testStatus, err := suite.db.GetStatusByID(ctx, testStatusID)
+	suite.NoError(err)
+	testAccount := suite.testAccounts["local_account_2"]
+
+	// Perform a status visibility check before suspension, this should be true.
+	visible, err := suite.filter.StatusVisible(ctx, testAccount, testStatus)
+	suite.NoError(err)
+	suite.True(visible)
+
+	// Suspend the status author.
+	author := new(gtsmodel.Account)
+	*author = *testStatus.Account
+	author.SuspendedAt = time.Now()
+	err = suite.db.UpdateAccount(ctx, author, "suspended_at")
+	suite.NoError(err)
+
+	// Refetch status to get updated author model.
+	testStatus, err = suite.db.GetStatusByID(ctx, testStatusID)
+	suite.NoError(err)
+
+	// Perform a status visibility check after suspension, this should be false.
+	visible, err = suite.filter.StatusVisible(ctx, testAccount, testStatus)
+	suite.NoError(err)
+	suite.False(visible)
+}
+
 func (suite *StatusVisibleTestSuite) TestVisiblePending() {
 	ctx := suite.T().Context()
 
@@ -167,7 +197,7 @@ func (suite *StatusVisibleTestSuite) TestVisiblePending() {
 	testStatus := new(gtsmodel.Status)
 	*testStatus = *suite.testStatuses["admin_account_status_3"]
 	testStatus.PendingApproval = util.Ptr(true)
-	if err := suite.state.DB.UpdateStatus(ctx, testStatus); err != nil {
+	if err := suite.state.DB.UpdateStatus(ctx, testStatus, "pending_approval"); err != nil {
 		suite.FailNow(err.Error())
 	}
 
@@ -204,7 +234,7 @@ func (suite *StatusVisibleTestSuite) TestVisiblePending() {
 	// Update the status to mark it as approved.
 	testStatus.PendingApproval = util.Ptr(false)
 	testStatus.ApprovedByURI = "http://localhost:8080/some/accept/uri"
-	if err := suite.state.DB.UpdateStatus(ctx, testStatus); err != nil {
+	if err := suite.state.DB.UpdateStatus(ctx, testStatus, "pending_approval", "approved_by_uri"); err != nil {
 		suite.FailNow(err.Error())
 	}
 
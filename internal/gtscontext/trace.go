@@ -0,0 +1,85 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtscontext
+
+import "context"
+
+// tracePropagator contains the hooks used to carry
+// tracing spans across async boundaries, set by the
+// observability package when tracing is compiled in.
+var tracePropagator struct {
+	extract func(ctx context.Context) any
+	resume  func(ctx context.Context, span any, name string) (context.Context, func(error))
+}
+
+// SetTracePropagator sets the functions used by CarryTrace()
+// and ResumeTrace() to extract an opaque span from a context,
+// and to start a new child span of it in another context.
+// This MUST only be called during package initialization.
+func SetTracePropagator(
+	extract func(ctx context.Context) any,
+	resume func(ctx context.Context, span any, name string) (context.Context, func(error)),
+) {
+	tracePropagator.extract = extract
+	tracePropagator.resume = resume
+}
+
+// Trace contains trace information carried
+// from one context to another, across an async
+// boundary such as a worker queue.
+type Trace struct {
+	requestID string
+	span      any
+}
+
+// CarryTrace returns the trace information of ctx, ie. the
+// request ID and (if tracing is enabled) the current span,
+// to be resumed with ResumeTrace() after crossing an async
+// boundary. Returns nil if there is nothing to carry.
+func CarryTrace(ctx context.Context) *Trace {
+	var span any
+	if tracePropagator.extract != nil {
+		span = tracePropagator.extract(ctx)
+	}
+	requestID := RequestID(ctx)
+	if requestID == "" && span == nil {
+		return nil
+	}
+	return &Trace{
+		requestID: requestID,
+		span:      span,
+	}
+}
+
+// ResumeTrace returns ctx wrapped with the trace information
+// from CarryTrace(), starting a new span of name as a child of
+// the carried span if any. The returned function must be called
+// with the result of the traced operation to end the span.
+func ResumeTrace(ctx context.Context, trace *Trace, name string) (context.Context, func(error)) {
+	end := func(error) {}
+	if trace == nil {
+		return ctx, end
+	}
+	if trace.requestID != "" {
+		ctx = SetRequestID(ctx, trace.requestID)
+	}
+	if trace.span != nil && tracePropagator.resume != nil {
+		ctx, end = tracePropagator.resume(ctx, trace.span, name)
+	}
+	return ctx, end
+}
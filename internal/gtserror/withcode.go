@@ -245,6 +245,19 @@ func NewErrorNotImplemented(original error, helpText ...string) WithCode {
 	}
 }
 
+// NewErrorBadGateway returns an ErrorWithCode 502 with the given original error and optional help text.
+func NewErrorBadGateway(original error, helpText ...string) WithCode {
+	safe := http.StatusText(http.StatusBadGateway)
+	if len(helpText) > 0 {
+		safe = safe + ": " + strings.Join(helpText, ": ")
+	}
+	return &withCode{
+		err:  original,
+		safe: safe,
+		code: http.StatusBadGateway,
+	}
+}
+
 // NewErrorClientClosedRequest returns an ErrorWithCode 499 with the given original error.
 // This error type should only be used when an http caller has already hung up their request.
 // See: https://en.wikipedia.org/wiki/List_of_HTTP_status_codes#nginx
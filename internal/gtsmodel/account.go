@@ -22,6 +22,7 @@
 package gtsmodel
 
 import (
+	"crypto/ed25519"
 	"crypto/rsa"
 	"slices"
 	"strings"
@@ -229,6 +230,13 @@ type Account struct {
 	// Application, Group, Organization, Person, or Service.
 	ActorType AccountActorType `bun:",nullzero,notnull"`
 
+	// ID of the local account that owns and
+	// manages this account, if this is a
+	// service account created by a user.
+	//
+	// Only defined for local accounts.
+	OwnerAccountID string `bun:"type:CHAR(26),nullzero"`
+
 	// Private key for signing http requests.
 	//
 	// Only defined for local accounts
@@ -250,6 +258,40 @@ type Account struct {
 	// Only ever set for remote accounts.
 	PublicKeyExpiresAt time.Time `bun:"type:timestamptz,nullzero"`
 
+	// Previous public key for authorizing signed http
+	// requests, retained after a key rotation so that
+	// requests signed just before the rotation verify.
+	//
+	// Only ever set for local accounts.
+	PreviousPublicKey *rsa.PublicKey `bun:""`
+
+	// Dereferenceable location of this actor's previous public key.
+	//
+	// Only ever set for local accounts.
+	PreviousPublicKeyURI string `bun:",nullzero"`
+
+	// Datetime after which the previous public
+	// key will no longer be served to remotes.
+	//
+	// Only ever set for local accounts.
+	PreviousPublicKeyExpiresAt time.Time `bun:"type:timestamptz,nullzero"`
+
+	// Ed25519 private key for signing object integrity proofs.
+	//
+	// Only defined for local accounts.
+	Ed25519PrivateKey ed25519.PrivateKey `bun:",nullzero"`
+
+	// Ed25519 public key for verifying object integrity proofs.
+	//
+	// Defined for local accounts, and for remote
+	// accounts that publish a Multikey assertionMethod.
+	Ed25519PublicKey ed25519.PublicKey `bun:",nullzero"`
+
+	// Dereferenceable location of this actor's Ed25519 public key.
+	//
+	// Corresponds to https://w3id.org/security/multikey/v1 `assertionMethod.id`.
+	Ed25519PublicKeyURI string `bun:",nullzero"`
+
 	// Datetime at which account was marked as a "memorial",
 	// ie., user owning the account has passed away.
 	MemorializedAt time.Time `bun:"type:timestamptz,nullzero"`
@@ -385,6 +427,18 @@ func (a *Account) PubKeyExpired() bool {
 		a.PublicKeyExpiresAt.Before(time.Now())
 }
 
+// PreviousPubKeyValid returns true if the account has
+// a previous public key retained after a key rotation,
+// and its grace period has not yet passed.
+func (a *Account) PreviousPubKeyValid() bool {
+	if a == nil {
+		return false
+	}
+
+	return a.PreviousPublicKey != nil &&
+		a.PreviousPublicKeyExpiresAt.After(time.Now())
+}
+
 // IsAliasedTo returns true if account
 // is aliased to the given account URI.
 func (a *Account) IsAliasedTo(uri string) bool {
@@ -409,6 +463,12 @@ func (a *Account) IsMoving() bool {
 	return a.MovedToURI != "" || a.MoveID != ""
 }
 
+// IsServiceAccount returns true if account is
+// a service account owned by another account.
+func (a *Account) IsServiceAccount() bool {
+	return a.OwnerAccountID != ""
+}
+
 // HeaderSet returns true if acct
 // has a HeaderMediaAttachmentID set.
 func (a *Account) HeaderSet() bool {
@@ -433,6 +493,7 @@ type AccountToEmoji struct {
 // VerifiedAt is optional, to be used only if Value is a URL to a webpage that contains the
 // username of the user.
 type Field struct {
+	ID         string    // Stable ID of this field (local accounts only).
 	Name       string    // Name of this field.
 	Value      string    // Value of this field.
 	VerifiedAt time.Time `bun:",nullzero"` // This field was verified at (optional).
@@ -509,16 +570,45 @@ type Relationship struct {
 	Note                string // Your note on this account.
 }
 
-// Theme represents a user-selected
-// CSS theme for an account.
+// Theme represents a user-selectable CSS theme
+// for an account. Themes are either bundled in the
+// web assets themes directory, or uploaded by an
+// admin, in which case they're stored in the db.
 type Theme struct {
+	// ID of this theme in the database.
+	// Empty for themes bundled in the
+	// web assets themes directory.
+	ID string `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`
+
+	// When was this theme uploaded.
+	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`
+
 	// User-facing title of this theme.
-	Title string
+	Title string `bun:",nullzero,notnull"`
 
 	// User-facing description of this theme.
-	Description string
+	Description string `bun:",nullzero"`
 
 	// FileName of this theme in the themes
-	// directory (eg., `light-blurple.css`).
-	FileName string
+	// directory (eg., `light-blurple.css`),
+	// or in storage for uploaded themes.
+	FileName string `bun:",nullzero,notnull,unique"`
+
+	// Account ID of the admin who uploaded
+	// this theme. Empty for bundled themes.
+	CreatedByAccountID string `bun:"type:CHAR(26),nullzero"`
+}
+
+// Uploaded returns true if this theme was
+// uploaded by an admin and is kept in storage,
+// rather than bundled in the web assets dir.
+func (t *Theme) Uploaded() bool {
+	return t.ID != ""
+}
+
+// StoragePath returns the storage
+// key at which an uploaded theme's
+// CSS file is kept, eg., `themes/x.css`.
+func (t *Theme) StoragePath() string {
+	return "themes/" + t.FileName
 }
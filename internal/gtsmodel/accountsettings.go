@@ -24,23 +24,60 @@
 
 // AccountSettings models settings / preferences for a local, non-instance account.
 type AccountSettings struct {
-	AccountID                      string             `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // AccountID that owns this settings.
-	CreatedAt                      time.Time          `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created.
-	UpdatedAt                      time.Time          `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item was last updated.
-	Privacy                        Visibility         `bun:",nullzero,default:3"`                                         // Default post privacy for this account
-	Sensitive                      *bool              `bun:",nullzero,notnull,default:false"`                             // Set posts from this account to sensitive by default?
-	Language                       string             `bun:",nullzero,notnull,default:'en'"`                              // What language does this account post in?
-	StatusContentType              string             `bun:",nullzero"`                                                   // What is the default format for statuses posted by this account (only for local accounts).
-	Theme                          string             `bun:",nullzero"`                                                   // Preset CSS theme filename selected by this Account (empty string if nothing set).
-	CustomCSS                      string             `bun:",nullzero"`                                                   // Custom CSS that should be displayed for this Account's profile and statuses.
-	EnableRSS                      *bool              `bun:",nullzero,notnull,default:false"`                             // enable RSS feed subscription for this account's public posts at [URL]/feed
-	HideCollections                *bool              `bun:",nullzero,notnull,default:false"`                             // Hide this account's followers/following collections.
-	WebLayout                      WebLayout          `bun:",nullzero,notnull,default:1"`                                 // Layout to use when showing this profile via the web.
-	InteractionPolicyDirect        *InteractionPolicy `bun:""`                                                            // Interaction policy to use for new direct visibility statuses by this account. If null, assume default policy.
-	InteractionPolicyMutualsOnly   *InteractionPolicy `bun:""`                                                            // Interaction policy to use for new mutuals only visibility statuses. If null, assume default policy.
-	InteractionPolicyFollowersOnly *InteractionPolicy `bun:""`                                                            // Interaction policy to use for new followers only visibility statuses. If null, assume default policy.
-	InteractionPolicyUnlocked      *InteractionPolicy `bun:""`                                                            // Interaction policy to use for new unlocked visibility statuses. If null, assume default policy.
-	InteractionPolicyPublic        *InteractionPolicy `bun:""`                                                            // Interaction policy to use for new public visibility statuses. If null, assume default policy.
+	AccountID                      string              `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // AccountID that owns this settings.
+	CreatedAt                      time.Time           `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created.
+	UpdatedAt                      time.Time           `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item was last updated.
+	Privacy                        Visibility          `bun:",nullzero,default:3"`                                         // Default post privacy for this account
+	Sensitive                      *bool               `bun:",nullzero,notnull,default:false"`                             // Set posts from this account to sensitive by default?
+	Language                       string              `bun:",nullzero,notnull,default:'en'"`                              // What language does this account post in?
+	StatusContentType              string              `bun:",nullzero"`                                                   // What is the default format for statuses posted by this account (only for local accounts).
+	Theme                          string              `bun:",nullzero"`                                                   // Preset CSS theme filename selected by this Account (empty string if nothing set).
+	CustomCSS                      string              `bun:",nullzero"`                                                   // Custom CSS that should be displayed for this Account's profile and statuses.
+	AccentColor                    string              `bun:",nullzero"`                                                   // Hex accent color chosen by this Account for its web profile, eg., "#ff00aa" (empty string if nothing set).
+	EnableRSS                      *bool               `bun:",nullzero,notnull,default:false"`                             // enable RSS feed subscription for this account's public posts at [URL]/feed
+	RSSFeedToken                   string              `bun:",nullzero"`                                                   // Secret token granting access to a private RSS feed of this account's posts, including followers-only. Empty = no private feed.
+	HideCollections                *bool               `bun:",nullzero,notnull,default:false"`                             // Hide this account's followers/following collections.
+	DirectoryOptIn                 *bool               `bun:",nullzero,notnull,default:false"`                             // Publish this account to the configured instance directory (if also discoverable).
+	ShowNoteIndicators             *bool               `bun:",nullzero,notnull,default:false"`                             // Indicate on statuses when this account has a private note set on the status author.
+	AllowEmbeds                    *bool               `bun:",nullzero,notnull,default:true"`                              // Allow public statuses by this account to be embedded on other websites via /embed and oEmbed.
+	AutoDeleteAfterDays            int                 `bun:",nullzero"`                                                   // Automatically delete statuses by this account older than this many days, unless pinned, bookmarked or faved by this account. 0 = disabled.
+	WebLayout                      WebLayout           `bun:",nullzero,notnull,default:1"`                                 // Layout to use when showing this profile via the web.
+	InteractionPolicyDirect        *InteractionPolicy  `bun:""`                                                            // Interaction policy to use for new direct visibility statuses by this account. If null, assume default policy.
+	InteractionPolicyMutualsOnly   *InteractionPolicy  `bun:""`                                                            // Interaction policy to use for new mutuals only visibility statuses. If null, assume default policy.
+	InteractionPolicyFollowersOnly *InteractionPolicy  `bun:""`                                                            // Interaction policy to use for new followers only visibility statuses. If null, assume default policy.
+	InteractionPolicyUnlocked      *InteractionPolicy  `bun:""`                                                            // Interaction policy to use for new unlocked visibility statuses. If null, assume default policy.
+	InteractionPolicyPublic        *InteractionPolicy  `bun:""`                                                            // Interaction policy to use for new public visibility statuses. If null, assume default policy.
+	FollowRequestRules             *FollowRequestRules `bun:""`                                                            // Rules for automatically handling incoming follow requests. If null, assume no rules.
+}
+
+// FollowRequestRules models rules for automatically
+// approving or rejecting follow requests received by
+// an account, evaluated before the account is notified.
+type FollowRequestRules struct {
+	// Automatically approve follow requests from accounts
+	// that follow at least one of this account's followers.
+	ApproveFollowersOfFollowers bool `json:"approve_followers_of_followers,omitempty"`
+
+	// Automatically approve follow requests from accounts
+	// on any of these domains (or subdomains of them).
+	ApproveDomains []string `json:"approve_domains,omitempty"`
+
+	// Automatically reject follow requests from accounts
+	// on domains that have a domain limit in place.
+	RejectLimited bool `json:"reject_limited,omitempty"`
+}
+
+// ApprovesDomain returns true if given
+// domain is (a subdomain of) one of the
+// domains in rules.ApproveDomains.
+func (r *FollowRequestRules) ApprovesDomain(domain string) bool {
+	for _, approve := range r.ApproveDomains {
+		if domain == approve ||
+			strings.HasSuffix(domain, "."+approve) {
+			return true
+		}
+	}
+	return false
 }
 
 // WebLayout represents an account owner's
@@ -75,6 +75,9 @@ func ParseAdminActionCategory(in string) AdminActionCategory {
 	AdminActionUnsuspend
 	AdminActionExpireKeys
 	AdminActionUnallow
+	AdminActionRotateKeys
+	AdminActionRejectFollow
+	AdminActionHoldFollow
 )
 
 func (t AdminActionType) String() string {
@@ -95,6 +98,12 @@ func (t AdminActionType) String() string {
 		return "expire-keys"
 	case AdminActionUnallow:
 		return "unallow"
+	case AdminActionRotateKeys:
+		return "rotate-keys"
+	case AdminActionRejectFollow:
+		return "reject-follow"
+	case AdminActionHoldFollow:
+		return "hold-follow"
 	default:
 		return "unknown"
 	}
@@ -118,6 +127,12 @@ func ParseAdminActionType(in string) AdminActionType {
 		return AdminActionExpireKeys
 	case "unallow":
 		return AdminActionUnallow
+	case "rotate-keys":
+		return AdminActionRotateKeys
+	case "reject-follow":
+		return AdminActionRejectFollow
+	case "hold-follow":
+		return AdminActionHoldFollow
 	default:
 		return AdminActionUnknown
 	}
@@ -0,0 +1,45 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Antenna refers to a set of user-defined rules, owned by a local
+// account, which incoming public statuses are matched against in
+// order to build a dynamic timeline of matching posts.
+//
+// A status matches an antenna when all of the following hold:
+//   - it contains any of Keywords (or Keywords is empty)
+//   - it contains none of ExcludeKeywords
+//   - its author is on any of Domains (or Domains is empty)
+//   - its author matches any of AccountPatterns (or it is empty)
+//
+// At least one of Keywords, Domains, AccountPatterns must be set.
+type Antenna struct {
+	ID              string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt       time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt       time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Title           string    `bun:",nullzero,notnull,unique:antennaaccounttitle"`                // Title of this antenna.
+	AccountID       string    `bun:"type:CHAR(26),notnull,nullzero,unique:antennaaccounttitle"`   // Account that created/owns the antenna.
+	Account         *Account  `bun:"-"`                                                           // Account corresponding to accountID.
+	Keywords        []string  `bun:"keywords,array"`                                              // Status text must contain any of these keywords (case-insensitive).
+	ExcludeKeywords []string  `bun:"exclude_keywords,array"`                                      // Status text must contain none of these keywords (case-insensitive).
+	Domains         []string  `bun:"domains,array"`                                               // Status author must be on any of these domains (or subdomains thereof).
+	AccountPatterns []string  `bun:"account_patterns,array"`                                      // Status author 'username@domain' must match any of these glob patterns.
+	WithReplies     *bool     `bun:",nullzero,notnull,default:false"`                             // Include replies in this antenna.
+}
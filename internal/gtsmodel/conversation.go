@@ -58,6 +58,12 @@ type Conversation struct {
 
 	// Has the owner read all statuses in this conversation?
 	Read *bool `bun:",default:false"`
+
+	// ID of the most recent status in this conversation
+	// that the owner has read, if any. This acts as a
+	// per-conversation read marker, allowing multiple
+	// clients to sync exactly how far they've read.
+	LastReadStatusID string `bun:"type:CHAR(26),nullzero"`
 }
 
 // ConversationOtherAccountsKey creates an OtherAccountsKey from a list of OtherAccountIDs.
@@ -176,3 +176,19 @@ func (l *DomainLimit) StatusesFilter() bool {
 func (l *DomainLimit) AccountsMute() bool {
 	return l != nil && l.AccountsPolicy == AccountsPolicyMute
 }
+
+// ApplyContentWarning returns the content warning to show
+// for a status from the limited domain that has the given
+// content warning, ie., the limit's content warning prepended
+// to the status's own, if any. If this domain limit is nil or
+// has no content warning, contentWarning is returned as-is.
+func (l *DomainLimit) ApplyContentWarning(contentWarning string) string {
+	switch {
+	case l == nil || l.ContentWarning == "":
+		return contentWarning
+	case contentWarning == "":
+		return l.ContentWarning
+	default:
+		return l.ContentWarning + "; " + contentWarning
+	}
+}
@@ -0,0 +1,71 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// DomainLimitSuggestion models a domain limit discovered
+// via a (block) domain permission subscription, pending
+// acceptance or rejection by an admin.
+type DomainLimitSuggestion struct {
+
+	// ID of this item in the database.
+	ID string `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`
+
+	// Time when this item was created.
+	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`
+
+	// Time when this item was last updated.
+	UpdatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`
+
+	// Domain to limit. Eg. 'whatever.com'.
+	Domain string `bun:",nullzero,notnull,unique:domain_limit_suggestions_domain_subscription_id_uniq"`
+
+	// ID of the subscription that
+	// suggested this domain limit.
+	SubscriptionID string `bun:"type:CHAR(26),nullzero,notnull,unique:domain_limit_suggestions_domain_subscription_id_uniq"`
+
+	// Public comment on this suggestion,
+	// as given by the subscribed list.
+	PublicComment string `bun:",nullzero"`
+
+	// Suggested policy to apply to media
+	// files originating from the domain.
+	MediaPolicy MediaPolicy `bun:",nullzero,notnull,default:1"`
+
+	// Suggested policy to apply to follow
+	// (requests) originating from the domain.
+	FollowsPolicy FollowsPolicy `bun:",nullzero,notnull,default:1"`
+
+	// Suggested policy to apply to statuses
+	// from non-followed accounts on the domain.
+	StatusesPolicy StatusesPolicy `bun:",nullzero,notnull,default:1"`
+
+	// Suggested policy to apply to
+	// non-followed accounts on the domain.
+	AccountsPolicy AccountsPolicy `bun:",nullzero,notnull,default:1"`
+}
+
+// SamePolicies returns true if the suggested
+// policies of both suggestions are the same.
+func (s *DomainLimitSuggestion) SamePolicies(other *DomainLimitSuggestion) bool {
+	return s.MediaPolicy == other.MediaPolicy &&
+		s.FollowsPolicy == other.FollowsPolicy &&
+		s.StatusesPolicy == other.StatusesPolicy &&
+		s.AccountsPolicy == other.AccountsPolicy
+}
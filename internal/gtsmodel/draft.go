@@ -0,0 +1,57 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Draft represents a partially composed status, saved
+// server-side by a local user so that it can be picked
+// up again from any client and later published.
+type Draft struct {
+	ID               string              `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt        time.Time           `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt        time.Time           `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	AccountID        string              `bun:"type:CHAR(26),nullzero,notnull"`                              // which account authored this draft
+	Account          *Account            `bun:"-"`                                                           // Account corresponding to AccountID
+	Text             string              `bun:""`                                                            // Text content of the draft
+	SpoilerText      string              `bun:""`                                                            // Original text of the content warning without formatting
+	Poll             ScheduledStatusPoll `bun:",embed:poll_,notnull,nullzero"`                               // Poll parameters, if any, in the same shape as for scheduled statuses
+	MediaIDs         []string            `bun:"attachments,array"`                                           // Database IDs of any media attachments associated with this draft
+	MediaAttachments []*MediaAttachment  `bun:"-"`                                                           // Attachments corresponding to media IDs
+	InReplyToID      string              `bun:"type:CHAR(26),nullzero"`                                      // id of the status this draft replies to
+	Sensitive        *bool               `bun:",nullzero,notnull,default:false"`                             // mark the status as sensitive?
+	Visibility       Visibility          `bun:",nullzero"`                                                   // visibility entry for this draft, zero means account default
+	Language         string              `bun:",nullzero"`                                                   // what language is this draft written in?
+	LocalOnly        *bool               `bun:",nullzero,notnull,default:false"`                             // Whether the status should not be federated
+	ContentType      string              `bun:",nullzero"`                                                   // Content type to use to process the text of the draft
+}
+
+// AttachmentsPopulated returns whether media attachments
+// are populated according to current AttachmentIDs.
+func (d *Draft) AttachmentsPopulated() bool {
+	if len(d.MediaIDs) != len(d.MediaAttachments) {
+		// this is the quickest indicator.
+		return false
+	}
+	for i, id := range d.MediaIDs {
+		if d.MediaAttachments[i].ID != id {
+			return false
+		}
+	}
+	return true
+}
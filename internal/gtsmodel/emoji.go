@@ -43,6 +43,8 @@ type Emoji struct {
 	VisibleInPicker        *bool             `bun:",nullzero,notnull,default:true"`                              // Is this emoji visible in the admin emoji picker?
 	Category               *EmojiCategory    `bun:"rel:belongs-to"`                                              // In which emoji category is this emoji visible?
 	CategoryID             string            `bun:"type:CHAR(26),nullzero"`                                      // ID of the category this emoji belongs to.
+	License                string            `bun:",nullzero"`                                                   // License under which this emoji is made available, eg 'CC-BY-SA-4.0'.
+	Attribution            string            `bun:",nullzero"`                                                   // Attribution / credit for the creator(s) of this emoji.
 }
 
 // IsLocal returns true if the emoji is
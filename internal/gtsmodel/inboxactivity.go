@@ -0,0 +1,31 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// InboxActivity represents the ID of an incoming Create or
+// Announce activity that has been processed from a local
+// account's inbox, kept for a while so that redeliveries
+// to that inbox can be deduplicated.
+type InboxActivity struct {
+	ID        string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                                   // id of this item in the database
+	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                // when was item created
+	AccountID string    `bun:"type:CHAR(26),nullzero,notnull,unique:inbox_activities_account_id_uri_uniq"` // ID of the local account whose inbox received the activity
+	URI       string    `bun:",nullzero,notnull,unique:inbox_activities_account_id_uri_uniq"`              // ActivityPub ID of the processed activity
+}
@@ -43,5 +43,10 @@ type Instance struct {
 	ContactAccount         *Account     `bun:"rel:belongs-to"`                                              // account corresponding to contactAccountID
 	Reputation             int64        `bun:",notnull,default:0"`                                          // Reputation score of this instance
 	Version                string       `bun:",nullzero"`                                                   // Version of the software used on this instance
+	Software               string       `bun:",nullzero"`                                                   // Name of the software used on this instance, as reported by its nodeinfo
+	NodeInfoFetchedAt      time.Time    `bun:"type:timestamptz,nullzero"`                                   // When was nodeinfo last successfully fetched for this instance?
+	RequestFailures        int          `bun:",notnull,default:0"`                                          // Number of consecutive failed requests to this instance, as of when it was last marked unreachable.
+	UnreachableAt          time.Time    `bun:"type:timestamptz,nullzero"`                                   // When was this instance marked as unreachable, if at all?
+	NextProbeAt            time.Time    `bun:"type:timestamptz,nullzero"`                                   // When may deliveries next be attempted to this instance, if unreachable?
 	Rules                  []Rule       `bun:"-"`                                                           // List of instance rules
 }
@@ -0,0 +1,54 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Invite represents an invite link generated by a local
+// account, which can be used to sign up to this instance
+// even when open registration is disabled.
+type Invite struct {
+	ID                 string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Code               string    `bun:",nullzero,notnull,unique"`                                    // Random code used to redeem this invite.
+	CreatedByAccountID string    `bun:"type:CHAR(26),nullzero,notnull"`                              // ID of the local account that created this invite.
+	CreatedByAccount   *Account  `bun:"-"`                                                           // Account corresponding to CreatedByAccountID.
+	Uses               int       `bun:",notnull,default:0"`                                          // Number of times this invite has been used to sign up.
+	MaxUses            int       `bun:",nullzero"`                                                   // Maximum number of times this invite can be used, 0 = unlimited.
+	ExpiresAt          time.Time `bun:"type:timestamptz,nullzero"`                                   // Time at which this invite stops being usable, if set.
+}
+
+// Expired returns whether this invite has
+// an expiry time set, and it has passed.
+func (i *Invite) Expired(now time.Time) bool {
+	return !i.ExpiresAt.IsZero() && !now.Before(i.ExpiresAt)
+}
+
+// Exhausted returns whether this invite has
+// a maximum number of uses set, and has been
+// used that many times already.
+func (i *Invite) Exhausted() bool {
+	return i.MaxUses > 0 && i.Uses >= i.MaxUses
+}
+
+// Usable returns whether this invite
+// can still be used to sign up.
+func (i *Invite) Usable(now time.Time) bool {
+	return !i.Expired(now) && !i.Exhausted()
+}
@@ -0,0 +1,104 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import (
+	"strings"
+	"time"
+)
+
+// IPRule represents an admin-managed rule about a range
+// of IP addresses, which is checked when a new user signs
+// up from one of those IP addresses, or when an existing
+// user signs in, depending on the severity of the rule.
+type IPRule struct {
+	ID        string         `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt time.Time      `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt time.Time      `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	IP        string         `bun:",nullzero,notnull,unique"`                                    // CIDR range this rule applies to, eg., '192.0.2.0/24' or '2001:db8::/32'.
+	Severity  IPRuleSeverity `bun:",nullzero,notnull"`                                           // What this rule does when an IP address matches.
+	Comment   string         `bun:",nullzero"`                                                   // Private comment on this rule, visible to admins.
+	ExpiresAt time.Time      `bun:"type:timestamptz,nullzero"`                                   // Time at which this rule stops applying, if set.
+	AuthorID  string         `bun:"type:CHAR(26),nullzero,notnull"`                              // Account ID of the creator of this rule.
+	Author    *Account       `bun:"-"`                                                           // Account corresponding to AuthorID.
+	Hits      int            `bun:",notnull,default:0"`                                          // Number of sign up / sign in attempts blocked by this rule.
+	LastHitAt time.Time      `bun:"type:timestamptz,nullzero"`                                   // Time at which this rule last blocked an attempt.
+}
+
+// Expired returns whether this rule has
+// an expiry time set, and it has passed.
+func (r *IPRule) Expired(now time.Time) bool {
+	return !r.ExpiresAt.IsZero() && !now.Before(r.ExpiresAt)
+}
+
+// IPRuleSeverity denotes what an
+// IPRule does to matching requests.
+type IPRuleSeverity enumType
+
+const (
+	// IPRuleSeverityUnknown is
+	// an unrecognized severity.
+	IPRuleSeverityUnknown IPRuleSeverity = 0
+
+	// IPRuleSeveritySignUpAllow explicitly
+	// allows sign ups from matching addresses,
+	// taking precedence over any matching
+	// IPRuleSeveritySignUpBlock rules. This
+	// can be used to carve out exceptions
+	// from a wider blocked range.
+	IPRuleSeveritySignUpAllow IPRuleSeverity = 1
+
+	// IPRuleSeveritySignUpBlock blocks
+	// sign ups from matching addresses.
+	IPRuleSeveritySignUpBlock IPRuleSeverity = 2
+
+	// IPRuleSeverityNoAccess blocks both sign ups
+	// and sign ins from matching addresses. This
+	// is not affected by IPRuleSeveritySignUpAllow.
+	IPRuleSeverityNoAccess IPRuleSeverity = 3
+)
+
+// String returns a stringified, frontend
+// API compatible form of IPRuleSeverity.
+func (s IPRuleSeverity) String() string {
+	switch s {
+	case IPRuleSeveritySignUpAllow:
+		return "sign_up_allow"
+	case IPRuleSeveritySignUpBlock:
+		return "sign_up_block"
+	case IPRuleSeverityNoAccess:
+		return "no_access"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseIPRuleSeverity returns an IP
+// rule severity from the given value.
+func ParseIPRuleSeverity(in string) IPRuleSeverity {
+	switch strings.ToLower(in) {
+	case "sign_up_allow":
+		return IPRuleSeveritySignUpAllow
+	case "sign_up_block":
+		return IPRuleSeveritySignUpBlock
+	case "no_access":
+		return IPRuleSeverityNoAccess
+	default:
+		return IPRuleSeverityUnknown
+	}
+}
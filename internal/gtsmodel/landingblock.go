@@ -0,0 +1,33 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// LandingBlock represents an admin-managed block of
+// content shown on the instance landing (index) page,
+// allowing admins to customize the page's contents.
+type LandingBlock struct {
+	ID        string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Title     string    `bun:",nullzero"`                                                   // Optional plaintext heading of this block.
+	Content   string    `bun:",nullzero"`                                                   // HTML content of this block, as rendered from Text.
+	Text      string    `bun:",nullzero,notnull"`                                           // Markdown text of this block, as submitted by the admin.
+	Position  int       `bun:",notnull,default:0"`                                          // Position of this block on the page, lowest first.
+}
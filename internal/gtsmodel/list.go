@@ -33,12 +33,24 @@ type List struct {
 
 // ListEntry refers to a single follow entry in a list.
 type ListEntry struct {
-	ID        string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
-	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
-	UpdatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
-	ListID    string    `bun:"type:CHAR(26),notnull,nullzero,unique:listentrylistfollow"`   // ID of the list that this entry belongs to.
-	FollowID  string    `bun:"type:CHAR(26),notnull,nullzero,unique:listentrylistfollow"`   // Follow that the account owning this entry wants to see posts of in the timeline.
-	Follow    *Follow   `bun:"-"`                                                           // Follow corresponding to followID.
+	ID            string        `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt     time.Time     `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt     time.Time     `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	ListID        string        `bun:"type:CHAR(26),notnull,nullzero,unique:listentrylistfollow"`   // ID of the list that this entry belongs to.
+	FollowID      string        `bun:"type:CHAR(26),notnull,nullzero,unique:listentrylistfollow"`   // Follow that the account owning this entry wants to see posts of in the timeline.
+	Follow        *Follow       `bun:"-"`                                                           // Follow corresponding to followID.
+	ShowReblogs   *bool         `bun:",nullzero,notnull,default:true"`                              // Show boosts by this member in the list timeline.
+	RepliesPolicy RepliesPolicy `bun:",nullzero"`                                                   // Override of the list's RepliesPolicy for this member; if empty, the list's policy applies.
+}
+
+// GetRepliesPolicy returns the replies policy
+// that applies to this entry in the given list,
+// taking account of any per-entry override.
+func (e *ListEntry) GetRepliesPolicy(list *List) RepliesPolicy {
+	if e != nil && e.RepliesPolicy != "" {
+		return e.RepliesPolicy
+	}
+	return list.RepliesPolicy
 }
 
 // RepliesPolicy denotes which replies should be shown in the list.
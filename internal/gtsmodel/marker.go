@@ -25,7 +25,7 @@ type Marker struct {
 	Name       MarkerName `bun:",nullzero,notnull,pk,unique:markers_account_id_timeline_uniq"`              // Name of the marked timeline
 	UpdatedAt  time.Time  `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`               // When marker was last updated
 	Version    int        `bun:",nullzero,notnull,default:0"`                                               // For optimistic concurrency control
-	LastReadID string     `bun:"type:CHAR(26),notnull,nullzero"`                                            // Last ID read on this timeline (status ID for home, notification ID for notifications)
+	LastReadID string     `bun:"type:CHAR(26),notnull,nullzero"`                                            // Last ID read on this timeline (notification ID for notifications, else status ID)
 }
 
 // MarkerName is the name of one of the timelines we can store markers for.
@@ -34,4 +34,12 @@ type Marker struct {
 const (
 	MarkerNameHome          MarkerName = "home"
 	MarkerNameNotifications MarkerName = "notifications"
+	MarkerNamePublic        MarkerName = "public"
+	MarkerNameLocal         MarkerName = "local"
+
+	// Prefixes for markers of list and tag timelines,
+	// which are followed by the list or tag ID. e.g.
+	// "list:01F8MH82FYRXD2RC6108DAJ5HB".
+	MarkerNameListPrefix = "list:"
+	MarkerNameTagPrefix  = "tag:"
 )
@@ -35,11 +35,16 @@ type MediaAttachment struct {
 	AccountID         string            `bun:"type:CHAR(26),nullzero,notnull"`                              // To which account does this attachment belong
 	Description       string            `bun:""`                                                            // Description of the attachment (for screenreaders)
 	ScheduledStatusID string            `bun:"type:CHAR(26),nullzero"`                                      // To which scheduled status does this attachment belong
+	DraftID           string            `bun:"type:CHAR(26),nullzero"`                                      // To which draft does this attachment belong
 	Blurhash          string            `bun:",nullzero"`                                                   // What is the generated blurhash of this attachment
 	File              File              `bun:",embed:file_,notnull,nullzero"`                               // metadata for the whole file
 	Thumbnail         Thumbnail         `bun:",embed:thumbnail_,notnull,nullzero"`                          // small image thumbnail derived from a larger image, video, or audio file.
+	Sprite            Sprite            `bun:",embed:sprite_,nullzero"`                                     // sprite sheet of preview frames derived from a video file (if enabled).
 	Avatar            *bool             `bun:",nullzero,notnull,default:false"`                             // Is this attachment being used as an avatar?
 	Header            *bool             `bun:",nullzero,notnull,default:false"`                             // Is this attachment being used as a header?
+	RecacheFailures   int               `bun:",notnull,default:0"`                                          // Number of consecutive failed attempts to (re)cache remote media.
+	RecacheFailedAt   time.Time         `bun:"type:timestamptz,nullzero"`                                   // When did the last attempt to (re)cache remote media fail.
+	RecacheBackoff    time.Duration     `bun:",nullzero"`                                                   // How long after RecacheFailedAt to wait before attempting (re)cache again.
 }
 
 // IsLocal returns whether media attachment is local.
@@ -52,6 +57,14 @@ func (m *MediaAttachment) IsRemote() bool {
 	return m.RemoteURL != ""
 }
 
+// RecacheBackingOff returns whether a previous attempt to (re)cache
+// this remote media failed recently, such that it should not yet be
+// attempted again, i.e. we're still within the recache backoff period.
+func (m *MediaAttachment) RecacheBackingOff() bool {
+	return !m.RecacheFailedAt.IsZero() &&
+		time.Now().Before(m.RecacheFailedAt.Add(m.RecacheBackoff))
+}
+
 // Cached returns whether MediaAttachment is cached locally.
 func (m *MediaAttachment) Cached() bool {
 	return m.File.Cached() && m.Thumbnail.Cached()
@@ -68,16 +81,23 @@ func (m *MediaAttachment) Stub() {
 	m.File.ContentType = ""
 	m.File.FileSize = 0
 	m.File.Path = ""
+	m.File.Checksum = ""
 	m.Thumbnail.FileSize = 0
 	m.Thumbnail.ContentType = ""
 	m.Thumbnail.Path = ""
+	m.Thumbnail.Checksum = ""
+	m.Sprite.FileSize = 0
+	m.Sprite.ContentType = ""
+	m.Sprite.Path = ""
+	m.Sprite.Checksum = ""
 }
 
 // File refers to the metadata for the whole file.
 type File struct {
-	Path        string `bun:",notnull"` // Path of the file in storage.
-	ContentType string `bun:",notnull"` // MIME content type of the file.
-	FileSize    int    `bun:",notnull"` // File size in bytes
+	Path        string `bun:",notnull"`  // Path of the file in storage.
+	ContentType string `bun:",notnull"`  // MIME content type of the file.
+	FileSize    int    `bun:",notnull"`  // File size in bytes
+	Checksum    string `bun:",nullzero"` // Hex encoded SHA-256 checksum of file in storage (if enabled).
 }
 
 // Cached returns whether this File is cached locally.
@@ -90,11 +110,30 @@ type Thumbnail struct {
 	FileSize    int    `bun:",notnull"`  // File size in bytes
 	URL         string `bun:",nullzero"` // What is the URL of the thumbnail on the local server
 	RemoteURL   string `bun:",nullzero"` // What is the remote URL of the thumbnail (empty for local media)
+	Checksum    string `bun:",nullzero"` // Hex encoded SHA-256 checksum of thumbnail in storage (if enabled).
 }
 
 // Cached returns whether this Thumbnail is cached locally.
 func (t Thumbnail) Cached() bool { return t.Path != "" }
 
+// Sprite refers to a sprite sheet of preview frames derived from a video
+// file, for use in hover scrubbing previews. Frames are evenly spaced
+// throughout the video duration, tiled left-to-right, top-to-bottom.
+type Sprite struct {
+	Path        string `bun:",nullzero"` // Path of the file in storage.
+	ContentType string `bun:",nullzero"` // MIME content type of the file.
+	FileSize    int    `bun:",nullzero"` // File size in bytes
+	URL         string `bun:",nullzero"` // What is the URL of the sprite sheet on the local server
+	Checksum    string `bun:",nullzero"` // Hex encoded SHA-256 checksum of sprite sheet in storage (if enabled).
+	Frames      int    `bun:",nullzero"` // Number of frames in the sprite sheet.
+	Columns     int    `bun:",nullzero"` // Number of columns of frames in the sprite sheet.
+	Width       int    `bun:",nullzero"` // Width in pixels of each frame.
+	Height      int    `bun:",nullzero"` // Height in pixels of each frame.
+}
+
+// Cached returns whether this Sprite is cached locally.
+func (s Sprite) Cached() bool { return s.Path != "" }
+
 // FileType refers to the file
 // type of the media attaachment.
 type FileType enumType
@@ -37,9 +37,10 @@
 	MediaErrorTypeNone MediaErrorType = 0
 
 	// MediaErrorTypePolicy: file(s) not downloaded due to configured policy.
-	MediaErrorTypePolicy        MediaErrorType = 1
-	MediaErrorTypePolicy_Size   uint16         = 1 // nolint:revive
-	MediaErrorTypePolicy_Domain uint16         = 2 // nolint:revive
+	MediaErrorTypePolicy            MediaErrorType = 1
+	MediaErrorTypePolicy_Size       uint16         = 1 // nolint:revive
+	MediaErrorTypePolicy_Domain     uint16         = 2 // nolint:revive
+	MediaErrorTypePolicy_Moderation uint16         = 3 // nolint:revive
 
 	// MediaErrorTypeInterrupt: file(s) not downloaded due to interrupt (i.e. context errors).
 	MediaErrorTypeInterrupt MediaErrorType = 2
@@ -115,17 +116,17 @@ func (d MediaErrorDetails) SupportsRetry() bool {
 	case MediaErrorTypeHTTP:
 		switch code := d.Details(); {
 
+		// More likely to be
+		// a temporary error.
+		case code >= 500:
+			return true
+
 		// 400-403 type errors (e.g. auth, forbidden, bad request)
 		// *can* be transient e.g. due to bugs. Others in the 4xx
 		// range are generally more permanent (e.g. not found).
 		case code >= 404:
 			return false
 
-		// More likely to be
-		// a temporary error.
-		case code >= 500:
-			return true
-
 		// All else
 		// we deny.
 		default:
@@ -148,6 +149,8 @@ func (d MediaErrorDetails) String() string {
 			return "file size limit reached"
 		case MediaErrorTypePolicy_Domain:
 			return "domain media policy"
+		case MediaErrorTypePolicy_Moderation:
+			return "rejected by moderation"
 		default:
 			return "configuration policy"
 		}
@@ -35,6 +35,22 @@ type Notification struct {
 	StatusOrEditID   string           `bun:"status_id,type:CHAR(26),nullzero"`                            // If the notification pertains to a status or a status edit event, what is the database ID of the status or status edit?
 	Status           *Status          `bun:"-"`                                                           // Status corresponding to StatusOrEditID. Can be nil, always check first + select using ID if necessary.
 	Read             *bool            `bun:",nullzero,notnull,default:false"`                             // Notification has been seen/read
+	GroupKey         string           `bun:",nullzero"`                                                   // Key of the notification group this notification belongs to, if any.
+}
+
+// NotificationUngroupedPrefix is prefixed to the ID of
+// notifications that do not belong to any notification
+// group, to give them a unique group key for the API.
+const NotificationUngroupedPrefix = "ungrouped-"
+
+// GetGroupKey returns the group key of this notification,
+// or a unique key derived from the notification ID if the
+// notification does not belong to any notification group.
+func (n *Notification) GetGroupKey() string {
+	if n.GroupKey != "" {
+		return n.GroupKey
+	}
+	return NotificationUngroupedPrefix + n.ID
 }
 
 // NotificationType describes the
@@ -57,7 +73,9 @@ type Notification struct {
 	NotificationPendingReblog NotificationType = 11 // NotificationPendingReblog -- Someone has boosted a status of yours, which requires approval by you.
 	NotificationAdminReport   NotificationType = 12 // NotificationAdminReport -- someone has submitted a new report to the instance.
 	NotificationUpdate        NotificationType = 13 // NotificationUpdate -- someone has edited their status.
-	NotificationTypeNumValues NotificationType = 14 // NotificationTypeNumValues -- 1 + number of max notification type
+	NotificationPollMilestone NotificationType = 14 // NotificationPollMilestone -- a poll you created has reached a milestone number of voters.
+	NotificationMove          NotificationType = 15 // NotificationMove -- an account you followed has moved to a new account.
+	NotificationTypeNumValues NotificationType = 16 // NotificationTypeNumValues -- 1 + number of max notification type
 )
 
 // String returns a stringified, frontend API compatible form of NotificationType.
@@ -89,11 +107,30 @@ func (t NotificationType) String() string {
 		return "admin.report"
 	case NotificationUpdate:
 		return "update"
+	case NotificationPollMilestone:
+		return "poll.milestone"
+	case NotificationMove:
+		return "move"
 	default:
 		panic("invalid notification type")
 	}
 }
 
+// Groupable returns true if notifications of this type
+// may be grouped together with other notifications of the
+// same type, ie., favourites and boosts of the same status,
+// or follows, received within a short window of time.
+func (t NotificationType) Groupable() bool {
+	switch t {
+	case NotificationFavourite,
+		NotificationReblog,
+		NotificationFollow:
+		return true
+	default:
+		return false
+	}
+}
+
 // ParseNotificationType returns a notification type from the given value.
 func ParseNotificationType(in string) NotificationType {
 	switch strings.ToLower(in) {
@@ -123,6 +160,10 @@ func ParseNotificationType(in string) NotificationType {
 		return NotificationAdminReport
 	case "update":
 		return NotificationUpdate
+	case "poll.milestone":
+		return NotificationPollMilestone
+	case "move":
+		return NotificationMove
 	default:
 		return NotificationUnknown
 	}
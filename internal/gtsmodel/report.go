@@ -17,7 +17,10 @@
 
 package gtsmodel
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // Report models a user-created reported about an account, which should be reviewed
 // and acted upon by instance admins.
@@ -26,22 +29,94 @@
 // or another instance, OR a report that was created remotely (on another instance)
 // about a user on this instance, and received via the federated (s2s) API.
 type Report struct {
-	ID                     string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
-	CreatedAt              time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
-	UpdatedAt              time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
-	URI                    string    `bun:",unique,nullzero,notnull"`                                    // activitypub URI of this report
-	AccountID              string    `bun:"type:CHAR(26),nullzero,notnull"`                              // which account created this report
-	Account                *Account  `bun:"-"`                                                           // account corresponding to AccountID
-	TargetAccountID        string    `bun:"type:CHAR(26),nullzero,notnull"`                              // which account is targeted by this report
-	TargetAccount          *Account  `bun:"-"`                                                           // account corresponding to TargetAccountID
-	Comment                string    `bun:",nullzero"`                                                   // comment / explanation for this report, by the reporter
-	StatusIDs              []string  `bun:"statuses,array"`                                              // database IDs of any statuses referenced by this report
-	Statuses               []*Status `bun:"-"`                                                           // statuses corresponding to StatusIDs
-	RuleIDs                []string  `bun:"rules,array"`                                                 // database IDs of any rules referenced by this report
-	Rules                  []*Rule   `bun:"-"`                                                           // rules corresponding to RuleIDs
-	Forwarded              *bool     `bun:",nullzero,notnull,default:false"`                             // flag to indicate report should be forwarded to remote instance
-	ActionTaken            string    `bun:",nullzero"`                                                   // string description of what action was taken in response to this report
-	ActionTakenAt          time.Time `bun:"type:timestamptz,nullzero"`                                   // time at which action was taken, if any
-	ActionTakenByAccountID string    `bun:"type:CHAR(26),nullzero"`                                      // database ID of account which took action, if any
-	ActionTakenByAccount   *Account  `bun:"-"`                                                           // account corresponding to ActionTakenByID, if any
+	ID                     string         `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt              time.Time      `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt              time.Time      `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	URI                    string         `bun:",unique,nullzero,notnull"`                                    // activitypub URI of this report
+	AccountID              string         `bun:"type:CHAR(26),nullzero,notnull"`                              // which account created this report
+	Account                *Account       `bun:"-"`                                                           // account corresponding to AccountID
+	TargetAccountID        string         `bun:"type:CHAR(26),nullzero,notnull"`                              // which account is targeted by this report
+	TargetAccount          *Account       `bun:"-"`                                                           // account corresponding to TargetAccountID
+	Comment                string         `bun:",nullzero"`                                                   // comment / explanation for this report, by the reporter
+	StatusIDs              []string       `bun:"statuses,array"`                                              // database IDs of any statuses referenced by this report
+	Statuses               []*Status      `bun:"-"`                                                           // statuses corresponding to StatusIDs
+	RuleIDs                []string       `bun:"rules,array"`                                                 // database IDs of any rules referenced by this report
+	Rules                  []*Rule        `bun:"-"`                                                           // rules corresponding to RuleIDs
+	Forwarded              *bool          `bun:",nullzero,notnull,default:false"`                             // flag to indicate report should be forwarded to remote instance
+	ActionTaken            string         `bun:",nullzero"`                                                   // string description of what action was taken in response to this report
+	ActionTakenAt          time.Time      `bun:"type:timestamptz,nullzero"`                                   // time at which action was taken, if any
+	ActionTakenByAccountID string         `bun:"type:CHAR(26),nullzero"`                                      // database ID of account which took action, if any
+	ActionTakenByAccount   *Account       `bun:"-"`                                                           // account corresponding to ActionTakenByID, if any
+	Category               ReportCategory `bun:",nullzero,notnull,default:1"`                                 // category of this report, as given by the reporter or an admin
+	AssignedAccountID      string         `bun:"type:CHAR(26),nullzero"`                                      // database ID of admin / moderator account assigned to handle this report, if any
+	AssignedAccount        *Account       `bun:"-"`                                                           // account corresponding to AssignedAccountID, if any
+}
+
+// ReportCategory describes the category of a report,
+// ie., broadly what kind of problem is being reported.
+type ReportCategory enumType
+
+const (
+	ReportCategoryUnknown ReportCategory = 0
+
+	// ReportCategoryOther is for
+	// anything not covered below.
+	ReportCategoryOther ReportCategory = 1
+
+	// ReportCategorySpam is for
+	// spam and unwanted advertising.
+	ReportCategorySpam ReportCategory = 2
+
+	// ReportCategoryLegal is for content
+	// that is illegal in the instance's
+	// or reporter's jurisdiction.
+	ReportCategoryLegal ReportCategory = 3
+
+	// ReportCategoryViolation is for
+	// violations of the instance rules.
+	ReportCategoryViolation ReportCategory = 4
+)
+
+// String returns a stringified, frontend
+// API compatible form of ReportCategory.
+func (c ReportCategory) String() string {
+	switch c {
+	case ReportCategorySpam:
+		return "spam"
+	case ReportCategoryLegal:
+		return "legal"
+	case ReportCategoryViolation:
+		return "violation"
+	default:
+		return "other"
+	}
+}
+
+// ParseReportCategory returns a report
+// category from the given value.
+func ParseReportCategory(in string) ReportCategory {
+	switch strings.ToLower(in) {
+	case "other":
+		return ReportCategoryOther
+	case "spam":
+		return ReportCategorySpam
+	case "legal":
+		return ReportCategoryLegal
+	case "violation":
+		return ReportCategoryViolation
+	default:
+		return ReportCategoryUnknown
+	}
+}
+
+// ReportNote models an internal note made by an admin or
+// moderator on a report, visible only to other admins and
+// moderators, so that they can coordinate handling a report.
+type ReportNote struct {
+	ID        string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	ReportID  string    `bun:"type:CHAR(26),nullzero,notnull"`                              // which report this note was made on
+	AccountID string    `bun:"type:CHAR(26),nullzero,notnull"`                              // which account created this note
+	Account   *Account  `bun:"-"`                                                           // account corresponding to AccountID
+	Content   string    `bun:",nullzero,notnull"`                                           // content of this note
 }
@@ -32,6 +32,8 @@ type Status struct {
 	EditedAt                 time.Time          `bun:"type:timestamptz,nullzero"`                                           // when this status was last edited (if set)
 	FetchedAt                time.Time          `bun:"type:timestamptz,nullzero"`                                           // when was item (remote) last fetched.
 	PinnedAt                 time.Time          `bun:"type:timestamptz,nullzero"`                                           // Status was pinned by owning account at this time.
+	DeletedAt                time.Time          `bun:"type:timestamptz,nullzero"`                                           // Status was deleted by owning account at this time, and is in the trash (if set).
+	ExpiresAt                time.Time          `bun:"type:timestamptz,nullzero"`                                           // Status will be automatically deleted at this time (if set).
 	URI                      string             `bun:",unique,nullzero,notnull"`                                            // activitypub URI of this status
 	URL                      string             `bun:",nullzero"`                                                           // web url for viewing this status
 	Content                  string             `bun:""`                                                                    // Content HTML for this status.
@@ -67,6 +69,7 @@ type Status struct {
 	Visibility               Visibility         `bun:",nullzero,notnull"`                                                   // visibility entry for this status
 	Sensitive                *bool              `bun:",nullzero,notnull,default:false"`                                     // mark the status as sensitive?
 	Language                 string             `bun:",nullzero"`                                                           // what language is this status written in?
+	LanguageDetected         *bool              `bun:",nullzero,notnull,default:false"`                                     // was the language of this status detected by us, rather than set by the author?
 	CreatedWithApplicationID string             `bun:"type:CHAR(26),nullzero"`                                              // Which application was used to create this status?
 	CreatedWithApplication   *Application       `bun:"rel:belongs-to"`                                                      // application corresponding to createdWithApplicationID
 	ActivityStreamsType      string             `bun:",nullzero,notnull"`                                                   // What is the activitystreams type of this status? See: https://www.w3.org/TR/activitystreams-vocabulary/#object-types. Will probably almost always be Note but who knows!.
@@ -77,6 +80,9 @@ type Status struct {
 	PendingApproval          *bool              `bun:",nullzero,notnull,default:false"`                                     // If true then status is a reply or boost wrapper that must be Approved by the reply-ee or boost-ee before being fully distributed.
 	PreApproved              bool               `bun:"-"`                                                                   // If true, then status is a reply to or boost wrapper of a status on our instance, has permission to do the interaction, and an Accept should be sent out for it immediately. Field not stored in the DB.
 	ApprovedByURI            string             `bun:",nullzero"`                                                           // URI of *either* an Accept Activity, or a ReplyAuthorization or AnnounceAuthorization, which approves the Announce, Create or interaction request Activity that this status was/will be attached to.
+	RepliesCount             *int               `bun:",nullzero"`                                                           // Denormalized number of stored direct replies to this status. Null if not yet counted.
+	BoostsCount              *int               `bun:",nullzero"`                                                           // Denormalized number of stored boosts of this status. Null if not yet counted.
+	FavesCount               *int               `bun:",nullzero"`                                                           // Denormalized number of stored faves of this status. Null if not yet counted.
 }
 
 // GetID implements timeline.Timelineable{}.
@@ -290,6 +296,13 @@ func (s *Status) IsLocalOnly() bool {
 	return s.Federated == nil || !*s.Federated
 }
 
+// InTrash returns true if this status has been
+// deleted by its author, but not yet permanently
+// deleted, ie., it is within the trash window.
+func (s *Status) InTrash() bool {
+	return !s.DeletedAt.IsZero()
+}
+
 // AllAttachmentIDs gathers ALL media attachment IDs from both
 // the receiving Status{}, and any historical Status{}.Edits.
 func (s *Status) AllAttachmentIDs() []string {
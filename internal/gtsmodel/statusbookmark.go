@@ -30,4 +30,16 @@ type StatusBookmark struct {
 	TargetAccount   *Account  `bun:"rel:belongs-to"`                                              // account owning the bookmarked status
 	StatusID        string    `bun:"type:CHAR(26),nullzero,notnull"`                              // database id of the status that has been bookmarked
 	Status          *Status   `bun:"rel:belongs-to"`                                              // the bookmarked status
+	CollectionID    string    `bun:"type:CHAR(26),nullzero"`                                      // id of the bookmark collection this bookmark is filed in, if any
+}
+
+// BookmarkCollection refers to a named collection
+// (i.e. folder) that one account's bookmarks may be filed in.
+type BookmarkCollection struct {
+	ID        string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                             // id of this item in the database
+	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`          // when was item created
+	UpdatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`          // when was item last updated
+	Title     string    `bun:",nullzero,notnull,unique:bookmarkcollectionaccounttitle"`              // Title of this collection.
+	AccountID string    `bun:"type:CHAR(26),notnull,nullzero,unique:bookmarkcollectionaccounttitle"` // Account that created/owns the collection.
+	Account   *Account  `bun:"-"`                                                                    // Account corresponding to accountID.
 }
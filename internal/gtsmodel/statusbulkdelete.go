@@ -0,0 +1,47 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// StatusBulkDelete represents a bulk deletion of statuses
+// owned by an account, matching the stored filters. Statuses
+// are deleted one at a time, so progress is kept here in order
+// for deletion to be resumed after a restart.
+type StatusBulkDelete struct {
+	ID              string     `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt       time.Time  `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt       time.Time  `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	AccountID       string     `bun:"type:CHAR(26),nullzero,notnull"`                              // ID of the account whose statuses are being deleted.
+	MinID           string     `bun:"type:CHAR(26),nullzero"`                                      // Only delete statuses with ID >= this, if set.
+	MaxID           string     `bun:"type:CHAR(26),nullzero,notnull"`                              // Only delete statuses with ID < this.
+	Visibility      Visibility `bun:",nullzero"`                                                   // Only delete statuses with this visibility, if set.
+	TagID           string     `bun:"type:CHAR(26),nullzero"`                                      // Only delete statuses using this tag, if set.
+	Total           int        `bun:",notnull,default:0"`                                          // Number of statuses matching filters when bulk delete was started.
+	Deleted         int        `bun:",notnull,default:0"`                                          // Number of statuses deleted so far.
+	PendingStatusID string     `bun:"type:CHAR(26),nullzero"`                                      // ID of status whose deletion has been queued but not yet completed.
+	PendingChecks   int        `bun:",notnull,default:0"`                                          // Number of steps on which pending status deletion was found not yet completed.
+	Skipped         int        `bun:",notnull,default:0"`                                          // Number of statuses skipped because their deletion never completed.
+	CompletedAt     time.Time  `bun:"type:timestamptz,nullzero"`                                   // When bulk delete completed or was aborted.
+	Aborted         *bool      `bun:",nullzero,notnull,default:false"`                             // Bulk delete was aborted by the account.
+}
+
+// Running returns whether the bulk delete is still in progress.
+func (b *StatusBulkDelete) Running() bool {
+	return b.CompletedAt.IsZero()
+}
@@ -0,0 +1,102 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import (
+	"fmt"
+	"time"
+)
+
+// Suggestion represents an account suggested to a local
+// account to follow, as computed from the social graph.
+type Suggestion struct {
+	ID              string            `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                      // id of this item in the database
+	CreatedAt       time.Time         `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`   // when was item created
+	AccountID       string            `bun:"type:CHAR(26),unique:suggestionaccounttarget,nullzero,notnull"` // id of the local account this suggestion is for
+	TargetAccountID string            `bun:"type:CHAR(26),unique:suggestionaccounttarget,nullzero,notnull"` // id of the suggested account
+	TargetAccount   *Account          `bun:"-"`                                                             // the suggested account
+	Sources         SuggestionSources `bun:",notnull,default:0"`                                            // reasons the target account is suggested
+	Score           int               `bun:",notnull,default:0"`                                            // weight of this suggestion, higher is better
+	Dismissed       *bool             `bun:",nullzero,notnull,default:false"`                               // account has dismissed this suggestion, don't suggest target again
+}
+
+// SuggestionSource represents one reason
+// for suggesting an account to follow.
+//
+// These are used as bit-field masks to
+// determine which are set in SuggestionSources.
+type SuggestionSource bitFieldType
+
+const (
+	// SuggestionSourceFriendsOfFriends means the suggested
+	// account is followed by accounts the account follows.
+	SuggestionSourceFriendsOfFriends SuggestionSource = 1 << 0
+
+	// SuggestionSourceInteractions means the account has
+	// recently interacted with the suggested account's
+	// statuses, by faving, boosting or replying to them.
+	SuggestionSourceInteractions SuggestionSource = 1 << 1
+
+	// SuggestionSourceHashtags means the suggested account
+	// has recently posted using hashtags that the account
+	// follows, or has itself recently posted with.
+	SuggestionSourceHashtags SuggestionSource = 1 << 2
+)
+
+// String returns the API form of SuggestionSource.
+func (src SuggestionSource) String() string {
+	switch src {
+	case SuggestionSourceFriendsOfFriends:
+		return "friends_of_friends"
+	case SuggestionSourceInteractions:
+		return "past_interactions"
+	case SuggestionSourceHashtags:
+		return "similar_hashtags"
+	default:
+		panic(fmt.Sprintf("invalid suggestion source: %d", src))
+	}
+}
+
+// SuggestionSources stores multiple
+// SuggestionSource values as bits in an int.
+type SuggestionSources bitFieldType
+
+// Has returns whether given SuggestionSource is set.
+func (srcs SuggestionSources) Has(src SuggestionSource) bool {
+	return srcs&SuggestionSources(src) != 0
+}
+
+// Set will set the given SuggestionSource bit.
+func (srcs *SuggestionSources) Set(src SuggestionSource) {
+	*srcs |= SuggestionSources(src)
+}
+
+// Strings returns the API form of each set SuggestionSource.
+func (srcs SuggestionSources) Strings() []string {
+	strs := make([]string, 0, 3)
+	for _, src := range []SuggestionSource{
+		SuggestionSourceFriendsOfFriends,
+		SuggestionSourceInteractions,
+		SuggestionSourceHashtags,
+	} {
+		if srcs.Has(src) {
+			strs = append(strs, src.String())
+		}
+	}
+	return strs
+}
@@ -38,3 +38,14 @@ type FollowedTag struct {
 	// ID of the tag.
 	TagID string `bun:"type:CHAR(26),pk,nullzero"`
 }
+
+// MutedTag represents a user muting a tag,
+// hiding statuses that contain it from their
+// home and list timelines.
+type MutedTag struct {
+	// ID of the account that mutes the tag.
+	AccountID string `bun:"type:CHAR(26),pk,nullzero"`
+
+	// ID of the tag.
+	TagID string `bun:"type:CHAR(26),pk,nullzero"`
+}
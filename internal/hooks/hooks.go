@@ -0,0 +1,216 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package hooks
+
+import (
+	"context"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
+)
+
+// Point identifies a point during processing
+// of content at which moderation hooks are called.
+type Point string
+
+const (
+	// PreStatusCreate is called before creating
+	// or editing a status from a local account.
+	PreStatusCreate Point = "pre-status-create"
+
+	// PreFederationIngest is called before handling
+	// an activity POSTed to an inbox by a remote instance.
+	PreFederationIngest Point = "pre-federation-ingest"
+
+	// PreMediaStore is called after processing
+	// media, before it is written to storage.
+	PreMediaStore Point = "pre-media-store"
+)
+
+// Hook is a single moderation hook, called with
+// details of content about to be processed, which
+// returns a verdict on whether to reject the content.
+type Hook interface {
+	Call(ctx context.Context, req *Request) (*Response, error)
+}
+
+// Request contains details of content
+// passed to a Hook. Only the field(s)
+// relevant to the hook Point are set.
+type Request struct {
+	// Hook point being called.
+	Point Point `json:"hook"`
+
+	// URI of the account that
+	// authored or sent the content.
+	Account string `json:"account"`
+
+	// Status about to be created or
+	// edited, set for PreStatusCreate.
+	Status *Status `json:"status,omitempty"`
+
+	// Serialized activity about to
+	// be handled, set for PreFederationIngest.
+	Activity map[string]any `json:"activity,omitempty"`
+
+	// Media about to be stored,
+	// set for PreMediaStore.
+	Media *Media `json:"media,omitempty"`
+}
+
+// Status contains details of a
+// status about to be created, or
+// the new content of a status
+// about to be edited.
+type Status struct {
+	EditOfID       string   `json:"edit_of_id,omitempty"`
+	Content        string   `json:"content"`
+	ContentWarning string   `json:"content_warning,omitempty"`
+	Visibility     string   `json:"visibility,omitempty"`
+	Language       string   `json:"language,omitempty"`
+	Sensitive      bool     `json:"sensitive"`
+	InReplyToID    string   `json:"in_reply_to_id,omitempty"`
+	MediaIDs       []string `json:"media_ids,omitempty"`
+}
+
+// Media contains details of
+// media about to be stored.
+type Media struct {
+	ID          string `json:"id"`
+	Type        string `json:"type"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+	RemoteURL   string `json:"remote_url,omitempty"`
+}
+
+// Response is the verdict
+// returned from a Hook.
+type Response struct {
+	// Reject indicates the
+	// content should be rejected.
+	Reject bool `json:"reject"`
+
+	// Reason is an optional reason
+	// for rejecting the content.
+	Reason string `json:"reason,omitempty"`
+}
+
+// RejectError is returned by Hooks.Call() when a
+// hook has indicated the content should be rejected.
+type RejectError struct {
+	Point  Point
+	Reason string
+}
+
+func (err *RejectError) Error() string {
+	if err.Reason == "" {
+		return string(err.Point) + " hook rejected content"
+	}
+	return string(err.Point) + " hook rejected content: " + err.Reason
+}
+
+// Hooks manages the moderation hooks configured for each
+// hook Point, calling them with configured timeout and
+// fail-open / fail-closed policy. A nil Hooks is safe to
+// use, and simply allows all content through.
+type Hooks struct {
+	hooks      map[Point]Hook
+	timeout    time.Duration
+	failClosed bool
+}
+
+// New returns a new Hooks instance, with HTTP hooks
+// for each of the hook Points with a configured URL,
+// making requests using the given HTTP client.
+func New(client *httpclient.Client) *Hooks {
+	h := &Hooks{
+		hooks:      make(map[Point]Hook),
+		timeout:    config.GetModerationHookTimeout(),
+		failClosed: config.GetModerationHookFailClosed(),
+	}
+	for point, url := range map[Point]string{
+		PreStatusCreate:     config.GetModerationHookStatusCreateURL(),
+		PreFederationIngest: config.GetModerationHookFederationIngestURL(),
+		PreMediaStore:       config.GetModerationHookMediaStoreURL(),
+	} {
+		if url != "" {
+			h.hooks[point] = NewHTTPHook(client, url)
+		}
+	}
+	return h
+}
+
+// Set sets the Hook to call at given hook Point,
+// replacing any existing. A nil Hook unsets it.
+func (h *Hooks) Set(point Point, hook Hook) {
+	if hook == nil {
+		delete(h.hooks, point)
+		return
+	}
+	if h.hooks == nil {
+		h.hooks = make(map[Point]Hook)
+	}
+	h.hooks[point] = hook
+}
+
+// Enabled returns whether a Hook
+// is set for the given hook Point.
+func (h *Hooks) Enabled(point Point) bool {
+	return h != nil && h.hooks[point] != nil
+}
+
+// Call calls the Hook set for req.Point (if any), returning
+// a *RejectError if the hook rejected the content. If the
+// hook could not be called, or returned an error, then an
+// error is only returned when configured to fail-closed.
+func (h *Hooks) Call(ctx context.Context, req *Request) error {
+	if !h.Enabled(req.Point) {
+		// Nothing
+		// to call.
+		return nil
+	}
+
+	// Call hook with configured timeout.
+	hookCtx, cncl := context.WithTimeout(ctx, h.timeout)
+	rsp, err := h.hooks[req.Point].Call(hookCtx, req)
+	cncl()
+
+	if err != nil {
+		err := gtserror.Newf("error calling %s hook: %w", req.Point, err)
+		if h.failClosed {
+			return err
+		}
+
+		// Failing open, log
+		// and allow through.
+		log.Warn(ctx, err)
+		return nil
+	}
+
+	if rsp.Reject {
+		return &RejectError{
+			Point:  req.Point,
+			Reason: rsp.Reason,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package hooks_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/hooks"
+	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	errorsv2 "codeberg.org/gruf/go-errors/v2"
+	"github.com/stretchr/testify/suite"
+)
+
+// hookFunc is a Hook
+// implementation for tests.
+type hookFunc func(context.Context, *hooks.Request) (*hooks.Response, error)
+
+func (f hookFunc) Call(ctx context.Context, req *hooks.Request) (*hooks.Response, error) {
+	return f(ctx, req)
+}
+
+type HooksTestSuite struct {
+	suite.Suite
+}
+
+func (suite *HooksTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+}
+
+func (suite *HooksTestSuite) TestNilHooks() {
+	var h *hooks.Hooks
+	suite.False(h.Enabled(hooks.PreStatusCreate))
+	suite.NoError(h.Call(suite.T().Context(), &hooks.Request{
+		Point: hooks.PreStatusCreate,
+	}))
+}
+
+func (suite *HooksTestSuite) TestReject() {
+	h := hooks.New(nil)
+	h.Set(hooks.PreStatusCreate, hookFunc(func(_ context.Context, req *hooks.Request) (*hooks.Response, error) {
+		return &hooks.Response{Reject: req.Status.Content == "spam", Reason: "looks like spam"}, nil
+	}))
+
+	// Only set hook point is enabled.
+	suite.True(h.Enabled(hooks.PreStatusCreate))
+	suite.False(h.Enabled(hooks.PreMediaStore))
+
+	err := h.Call(suite.T().Context(), &hooks.Request{
+		Point:  hooks.PreStatusCreate,
+		Status: &hooks.Status{Content: "spam"},
+	})
+	rejectErr := errorsv2.AsV2[*hooks.RejectError](err)
+	suite.NotNil(rejectErr)
+	suite.Equal("looks like spam", rejectErr.Reason)
+	suite.EqualError(err, "pre-status-create hook rejected content: looks like spam")
+
+	err = h.Call(suite.T().Context(), &hooks.Request{
+		Point:  hooks.PreStatusCreate,
+		Status: &hooks.Status{Content: "hello world"},
+	})
+	suite.NoError(err)
+}
+
+func (suite *HooksTestSuite) TestFailOpen() {
+	h := hooks.New(nil)
+	h.Set(hooks.PreMediaStore, hookFunc(func(context.Context, *hooks.Request) (*hooks.Response, error) {
+		return nil, errors.New("connection refused")
+	}))
+
+	// Failing open, errors allow content through.
+	suite.NoError(h.Call(suite.T().Context(), &hooks.Request{
+		Point: hooks.PreMediaStore,
+	}))
+}
+
+func (suite *HooksTestSuite) TestFailClosed() {
+	config.SetModerationHookFailClosed(true)
+
+	h := hooks.New(nil)
+	h.Set(hooks.PreMediaStore, hookFunc(func(context.Context, *hooks.Request) (*hooks.Response, error) {
+		return nil, errors.New("connection refused")
+	}))
+
+	// Failing closed, errors are returned but aren't rejections.
+	err := h.Call(suite.T().Context(), &hooks.Request{
+		Point: hooks.PreMediaStore,
+	})
+	suite.ErrorContains(err, "connection refused")
+	suite.Nil(errorsv2.AsV2[*hooks.RejectError](err))
+}
+
+func (suite *HooksTestSuite) TestTimeout() {
+	config.SetModerationHookTimeout(1)
+
+	h := hooks.New(nil)
+	h.Set(hooks.PreFederationIngest, hookFunc(func(ctx context.Context, _ *hooks.Request) (*hooks.Response, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}))
+
+	// Hook context is cancelled after
+	// timeout, and content allowed through.
+	suite.NoError(h.Call(suite.T().Context(), &hooks.Request{
+		Point: hooks.PreFederationIngest,
+	}))
+}
+
+func (suite *HooksTestSuite) TestHTTPHook() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req hooks.Request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var rsp hooks.Response
+		if req.Point == hooks.PreFederationIngest &&
+			req.Activity["type"] == "Create" {
+			rsp.Reject = true
+			rsp.Reason = "no creates allowed"
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(rsp)
+	}))
+	defer server.Close()
+
+	// Prepare httpclient allowing localhost test server.
+	client := httpclient.New(httpclient.Config{
+		AllowRanges: []netip.Prefix{
+			netip.MustParsePrefix("127.0.0.1/32"),
+			netip.MustParsePrefix("::1/128"),
+		},
+	})
+
+	h := hooks.New(nil)
+	h.Set(hooks.PreFederationIngest, hooks.NewHTTPHook(client, server.URL))
+
+	err := h.Call(suite.T().Context(), &hooks.Request{
+		Point:    hooks.PreFederationIngest,
+		Account:  "http://fossbros-anonymous.io/users/foss_satan",
+		Activity: map[string]any{"type": "Create"},
+	})
+	suite.EqualError(err, "pre-federation-ingest hook rejected content: no creates allowed")
+
+	err = h.Call(suite.T().Context(), &hooks.Request{
+		Point:    hooks.PreFederationIngest,
+		Account:  "http://fossbros-anonymous.io/users/foss_satan",
+		Activity: map[string]any{"type": "Like"},
+	})
+	suite.NoError(err)
+}
+
+func TestHooksTestSuite(t *testing.T) {
+	suite.Run(t, new(HooksTestSuite))
+}
@@ -0,0 +1,103 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
+)
+
+// maxResponseSize is the max size of
+// response body read from an HTTP hook.
+const maxResponseSize = 64 * 1024
+
+// httpHook is a Hook implementation that POSTs the
+// hook Request as JSON to an external HTTP service,
+// and parses the returned JSON as the hook Response.
+type httpHook struct {
+	client    *http.Client
+	url       string
+	userAgent string
+}
+
+// NewHTTPHook returns a new Hook calling out
+// to the external HTTP service at given URL.
+func NewHTTPHook(client *httpclient.Client, url string) Hook {
+	return &httpHook{
+		client: &http.Client{
+			// Pass in our wrapped httpclient.Client{}
+			// type as http.Transport{} in order to take
+			// advantage of retries, SSF protection etc.
+			Transport: client,
+		},
+		url: url,
+		userAgent: fmt.Sprintf("gotosocial/%s (+%s://%s)",
+			config.GetSoftwareVersion(),
+			config.GetProtocol(),
+			config.GetHost(),
+		),
+	}
+}
+
+func (h *httpHook) Call(ctx context.Context, req *Request) (*Response, error) {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return nil, gtserror.Newf("error marshaling request: %w", err)
+	}
+
+	r, err := http.NewRequestWithContext(ctx,
+		http.MethodPost,
+		h.url,
+		bytes.NewReader(b),
+	)
+	if err != nil {
+		return nil, gtserror.Newf("error building request: %w", err)
+	}
+
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("Accept", "application/json")
+	r.Header.Set("User-Agent", h.userAgent)
+
+	rsp, err := h.client.Do(r)
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode < 200 || rsp.StatusCode > 299 {
+		return nil, gtserror.NewFromResponse(rsp)
+	}
+
+	var res Response
+
+	// Decode (limited) response body as hook response.
+	lr := io.LimitReader(rsp.Body, maxResponseSize)
+	if err := json.NewDecoder(lr).Decode(&res); err != nil {
+		return nil, gtserror.Newf("error decoding response: %w", err)
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,123 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package integrity_test
+
+import (
+	"crypto/ed25519"
+	"strings"
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/integrity"
+	"github.com/stretchr/testify/suite"
+)
+
+type IntegrityTestSuite struct {
+	suite.Suite
+}
+
+func (suite *IntegrityTestSuite) TestCanonicalize() {
+	for _, testCase := range []struct {
+		in     any
+		expect string
+	}{
+		{
+			// Members sorted, no whitespace.
+			in:     map[string]any{"b": true, "a": nil, "c": []any{"x", 1}},
+			expect: `{"a":null,"b":true,"c":["x",1]}`,
+		},
+		{
+			// Numbers as per ECMAScript.
+			in:     []any{1e21, 1e-7, 0.000001, 4.5, -0.0, 333333333.3333333},
+			expect: `[1e+21,1e-7,0.000001,4.5,0,333333333.3333333]`,
+		},
+		{
+			// Minimal string escaping.
+			in:     map[string]any{"s": "<€>\"\n\u001f"},
+			expect: `{"s":"<€>\"\n\u001f"}`,
+		},
+		{
+			// Keys sorted by UTF-16 code units.
+			in:     map[string]any{"דּ": 1, "\U0001f600": 2},
+			expect: "{\"\U0001f600\":2,\"דּ\":1}",
+		},
+	} {
+		b, err := integrity.Canonicalize(testCase.in)
+		suite.NoError(err)
+		suite.Equal(testCase.expect, string(b))
+	}
+}
+
+func (suite *IntegrityTestSuite) TestMultikey() {
+	pub, _, err := ed25519.GenerateKey(nil)
+	suite.NoError(err)
+
+	encoded := integrity.EncodeMultikey(pub)
+	suite.True(strings.HasPrefix(encoded, "z6Mk"))
+
+	decoded, err := integrity.DecodeMultikey(encoded)
+	suite.NoError(err)
+	suite.True(pub.Equal(decoded))
+
+	_, err = integrity.DecodeMultikey("not a multikey")
+	suite.Error(err)
+}
+
+func (suite *IntegrityTestSuite) TestSignVerify() {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	suite.NoError(err)
+
+	const vm = "http://localhost:8080/users/the_mighty_zork#ed25519-key"
+	doc := map[string]any{
+		"@context": "https://www.w3.org/ns/activitystreams",
+		"id":       "http://localhost:8080/users/the_mighty_zork/activity/01J0000000000000000000000",
+		"type":     "Create",
+		"actor":    "http://localhost:8080/users/the_mighty_zork",
+		"object":   "http://localhost:8080/users/the_mighty_zork/statuses/01J0000000000000000000000",
+	}
+
+	now := time.Now()
+	err = integrity.Sign(doc, priv, vm, now)
+	suite.NoError(err)
+	suite.Equal([]any{
+		"https://www.w3.org/ns/activitystreams",
+		integrity.ContextDataIntegrity,
+	}, doc["@context"])
+
+	gotVM, err := integrity.VerificationMethod(doc)
+	suite.NoError(err)
+	suite.Equal(vm, gotVM)
+
+	created, err := integrity.Created(doc)
+	suite.NoError(err)
+	suite.Equal(now.Truncate(time.Second).UTC(), created)
+	suite.NoError(integrity.Verify(doc, pub))
+
+	// A different key should not verify.
+	otherPub, _, err := ed25519.GenerateKey(nil)
+	suite.NoError(err)
+	suite.Error(integrity.Verify(doc, otherPub))
+
+	// Nor should a tampered document.
+	doc["object"] = "http://localhost:8080/users/the_mighty_zork/statuses/01J0000000000000000000001"
+	suite.Error(integrity.Verify(doc, pub))
+}
+
+func TestIntegrityTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrityTestSuite))
+}
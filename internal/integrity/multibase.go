@@ -0,0 +1,132 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package integrity
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+const (
+	// base58btc alphabet, as used by the 'z' multibase prefix.
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+	// multibasePrefix is the multibase
+	// prefix character for base58btc.
+	multibasePrefix = 'z'
+)
+
+// ed25519Multicodec is the multicodec
+// prefix for an Ed25519 public key.
+var ed25519Multicodec = []byte{0xed, 0x01}
+
+// EncodeMultikey encodes the given public key as
+// a base58btc multibase value, suitable for use as
+// the `publicKeyMultibase` of a Multikey.
+func EncodeMultikey(key ed25519.PublicKey) string {
+	b := make([]byte, 0, len(ed25519Multicodec)+len(key))
+	b = append(b, ed25519Multicodec...)
+	b = append(b, key...)
+	return string(multibasePrefix) + base58Encode(b)
+}
+
+// DecodeMultikey decodes a base58btc multibase
+// `publicKeyMultibase` value of a Multikey,
+// returning an error if it isn't an Ed25519 key.
+func DecodeMultikey(s string) (ed25519.PublicKey, error) {
+	b, err := decodeMultibase(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.HasPrefix(b, ed25519Multicodec) {
+		return nil, errors.New("multikey is not an ed25519 public key")
+	}
+
+	b = b[len(ed25519Multicodec):]
+	if len(b) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid ed25519 public key length %d", len(b))
+	}
+
+	return ed25519.PublicKey(b), nil
+}
+
+// decodeMultibase decodes a base58btc multibase value.
+func decodeMultibase(s string) ([]byte, error) {
+	if len(s) < 2 || s[0] != multibasePrefix {
+		return nil, errors.New("value is not base58btc multibase")
+	}
+	return base58Decode(s[1:])
+}
+
+// base58Encode encodes b with the base58btc alphabet.
+func base58Encode(b []byte) string {
+	n := new(big.Int).SetBytes(b)
+	radix := big.NewInt(58)
+	mod := new(big.Int)
+
+	var out []byte
+	for n.Sign() > 0 {
+		n.DivMod(n, radix, mod)
+		out = append(out, base58Alphabet[mod.Int64()])
+	}
+
+	// Leading zero bytes are
+	// encoded as leading '1's.
+	for _, c := range b {
+		if c != 0 {
+			break
+		}
+		out = append(out, base58Alphabet[0])
+	}
+
+	// Digits were produced least
+	// significant first, so reverse.
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+
+	return string(out)
+}
+
+// base58Decode decodes s with the base58btc alphabet.
+func base58Decode(s string) ([]byte, error) {
+	n := new(big.Int)
+	radix := big.NewInt(58)
+
+	for i := 0; i < len(s); i++ {
+		idx := bytes.IndexByte([]byte(base58Alphabet), s[i])
+		if idx < 0 {
+			return nil, fmt.Errorf("invalid base58 character %q", s[i])
+		}
+		n.Mul(n, radix)
+		n.Add(n, big.NewInt(int64(idx)))
+	}
+
+	// Leading '1's are
+	// leading zero bytes.
+	var zeros int
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+
+	return append(make([]byte, zeros), n.Bytes()...), nil
+}
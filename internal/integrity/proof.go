@@ -0,0 +1,235 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package integrity
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+)
+
+const (
+	// ContextDataIntegrity is the JSON-LD context
+	// defining the `proof` property and its terms.
+	ContextDataIntegrity = "https://w3id.org/security/data-integrity/v1"
+
+	// ContextMultikey is the JSON-LD context
+	// defining the Multikey verification method.
+	ContextMultikey = "https://w3id.org/security/multikey/v1"
+
+	// ProofType is the `type` of an integrity proof.
+	ProofType = "DataIntegrityProof"
+
+	// Cryptosuite is the only supported `cryptosuite`.
+	Cryptosuite = "eddsa-jcs-2022"
+
+	// ProofPurpose is the `proofPurpose` of proofs
+	// made by an actor over the objects it publishes.
+	ProofPurpose = "assertionMethod"
+
+	// MultikeyType is the `type` of a Multikey
+	// verification method in an assertionMethod.
+	MultikeyType = "Multikey"
+)
+
+// Sign creates an eddsa-jcs-2022 integrity proof over the
+// given JSON document using key, and adds it to the document
+// as its `proof` property. The data integrity context is added
+// to the document's `@context` beforehand, if not present.
+//
+// See: https://codeberg.org/fediverse/fep/src/branch/main/fep/8b32/fep-8b32.md
+func Sign(
+	doc map[string]any,
+	key ed25519.PrivateKey,
+	verificationMethod string,
+	created time.Time,
+) error {
+	if _, ok := doc["proof"]; ok {
+		return errors.New("document already has a proof")
+	}
+
+	// Ensure the document's context defines the proof.
+	AddContext(doc, ContextDataIntegrity)
+
+	proof := map[string]any{
+		"type":               ProofType,
+		"cryptosuite":        Cryptosuite,
+		"verificationMethod": verificationMethod,
+		"proofPurpose":       ProofPurpose,
+		"created":            created.UTC().Format(time.RFC3339),
+	}
+
+	if ctx, ok := doc["@context"]; ok {
+		proof["@context"] = ctx
+	}
+
+	data, err := hashData(doc, proof)
+	if err != nil {
+		return err
+	}
+
+	sig := ed25519.Sign(key, data)
+	proof["proofValue"] = string(multibasePrefix) + base58Encode(sig)
+	doc["proof"] = proof
+	return nil
+}
+
+// VerificationMethod returns the `verificationMethod`
+// of the integrity proof on the given JSON document, so
+// that callers can look up the key to pass to Verify.
+func VerificationMethod(doc map[string]any) (string, error) {
+	proof, err := getProof(doc)
+	if err != nil {
+		return "", err
+	}
+
+	vm, _ := proof["verificationMethod"].(string)
+	if vm == "" {
+		return "", errors.New("proof has no verificationMethod")
+	}
+
+	return vm, nil
+}
+
+// Verify checks the eddsa-jcs-2022 integrity proof
+// on the given JSON document against key, returning
+// an error if it is missing, malformed or invalid.
+func Verify(doc map[string]any, key ed25519.PublicKey) error {
+	proof, err := getProof(doc)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case proof["type"] != ProofType:
+		return fmt.Errorf("unsupported proof type %v", proof["type"])
+	case proof["cryptosuite"] != Cryptosuite:
+		return fmt.Errorf("unsupported cryptosuite %v", proof["cryptosuite"])
+	case proof["proofPurpose"] != ProofPurpose:
+		return fmt.Errorf("unsupported proofPurpose %v", proof["proofPurpose"])
+	}
+
+	value, _ := proof["proofValue"].(string)
+	sig, err := decodeMultibase(value)
+	if err != nil {
+		return fmt.Errorf("invalid proofValue: %w", err)
+	}
+
+	// The proof config is
+	// the proof minus its value.
+	config := maps.Clone(proof)
+	delete(config, "proofValue")
+
+	// The unsecured document
+	// is the doc minus its proof.
+	unsecured := maps.Clone(doc)
+	delete(unsecured, "proof")
+
+	data, err := hashData(unsecured, config)
+	if err != nil {
+		return err
+	}
+
+	if !ed25519.Verify(key, data, sig) {
+		return errors.New("proof signature invalid")
+	}
+
+	return nil
+}
+
+// Created returns the `created` time of the
+// integrity proof on the given JSON document.
+func Created(doc map[string]any) (time.Time, error) {
+	proof, err := getProof(doc)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	created, _ := proof["created"].(string)
+	if created == "" {
+		return time.Time{}, errors.New("proof has no created time")
+	}
+
+	t, err := time.Parse(time.RFC3339, created)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid proof created time: %w", err)
+	}
+
+	return t, nil
+}
+
+// AddContext appends the given JSON-LD context
+// to the document's `@context`, if not present.
+func AddContext(doc map[string]any, context string) {
+	switch ctx := doc["@context"].(type) {
+	case nil:
+		doc["@context"] = context
+
+	case string:
+		if ctx != context {
+			doc["@context"] = []any{ctx, context}
+		}
+
+	case []any:
+		if !slices.Contains(ctx, any(context)) {
+			// Clip to ensure we never write
+			// to another document's context.
+			doc["@context"] = append(slices.Clip(ctx), context)
+		}
+
+	default:
+		// Single embedded context object.
+		doc["@context"] = []any{ctx, context}
+	}
+}
+
+// getProof returns the single proof
+// object on the given JSON document.
+func getProof(doc map[string]any) (map[string]any, error) {
+	switch proof := doc["proof"].(type) {
+	case nil:
+		return nil, errors.New("document has no proof")
+	case map[string]any:
+		return proof, nil
+	default:
+		return nil, fmt.Errorf("unsupported proof value %T", proof)
+	}
+}
+
+// hashData returns the data to sign for a document and
+// proof config, ie., the SHA-256 hash of the canonical
+// proof config followed by that of the canonical document.
+func hashData(doc map[string]any, config map[string]any) ([]byte, error) {
+	canonConfig, err := Canonicalize(config)
+	if err != nil {
+		return nil, fmt.Errorf("error canonicalizing proof config: %w", err)
+	}
+
+	canonDoc, err := Canonicalize(doc)
+	if err != nil {
+		return nil, fmt.Errorf("error canonicalizing document: %w", err)
+	}
+
+	configHash := sha256.Sum256(canonConfig)
+	docHash := sha256.Sum256(canonDoc)
+	return append(configHash[:], docHash[:]...), nil
+}
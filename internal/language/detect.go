@@ -0,0 +1,243 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package language
+
+import (
+	"strings"
+	"unicode"
+)
+
+// minDetectLetters is the minimum number of
+// letters that text must contain before any
+// attempt at detecting its language is made.
+const minDetectLetters = 16
+
+// minStopwordHits is the minimum number of common
+// words of one language that latin-script text must
+// contain before that language is considered detected.
+const minStopwordHits = 2
+
+// scriptLangs maps unicode scripts
+// used only (or near enough only) by
+// one language to that language's tag.
+var scriptLangs = []struct {
+	script *unicode.RangeTable
+	tag    string
+}{
+	{unicode.Hangul, "ko"},
+	{unicode.Greek, "el"},
+	{unicode.Hebrew, "he"},
+	{unicode.Thai, "th"},
+	{unicode.Devanagari, "hi"},
+	{unicode.Bengali, "bn"},
+	{unicode.Tamil, "ta"},
+	{unicode.Armenian, "hy"},
+	{unicode.Georgian, "ka"},
+}
+
+// stopwords contains, per language, a selection of very
+// common short words used to tell latin-script languages
+// apart. Words shared between languages are fine, as
+// only the best scoring language is picked.
+var stopwords = func() map[string][]string {
+	langWords := map[string]string{
+		"en": "the and is are was were of to that this it with for you not have be but they what would there just",
+		"de": "der die das und ist nicht ich sie es ein eine mit auf den dem zu auch sich wir aber noch wie sind für von",
+		"fr": "le la les et est un une des du que qui pas je il elle nous vous dans pour sur avec ce mais sont",
+		"es": "el la los las es un una que de en por con para no se lo pero muy como está son del al yo",
+		"it": "il lo la gli le è un una che di del della non per con sono ma anche questo molto ho mi",
+		"pt": "os as é um uma que de do da dos das não em no na para com por mas muito eu você são",
+		"nl": "de het een en is van niet ik je dat die op te met voor zijn maar ook wel nog er heb naar",
+		"sv": "och att det är en ett som på av för med jag inte har till den de om men var så kan",
+		"pl": "na nie jest się to że do jak ale co tak jestem już dla są mnie czy tylko",
+	}
+
+	stopwords := make(map[string][]string)
+	for tag, words := range langWords {
+		for _, word := range strings.Fields(words) {
+			stopwords[word] = append(stopwords[word], tag)
+		}
+	}
+
+	return stopwords
+}()
+
+// Detect attempts to detect the language of the given
+// (plain) text, returning the detected BCP47 language
+// tag, or an empty string if no language could be
+// detected with reasonable confidence.
+//
+// Detection is a simple heuristic based on the writing
+// script of the text, and for latin-script text, on the
+// common words it contains, so it's most reliable for
+// longer texts. Mentions, hashtags, emoji shortcodes and
+// links are ignored, as they don't tell us much.
+func Detect(text string) string {
+	var (
+		// Total letters.
+		letters int
+
+		// Letters per script.
+		latin    int
+		cyrillic int
+		arabic   int
+		han      int
+		kana     int
+		scripts  = make([]int, len(scriptLangs))
+
+		// Language-specific letters
+		// of shared scripts.
+		ukrainian bool
+		persian   bool
+
+		// Lowercased words
+		// of latin text.
+		words []string
+	)
+
+	for _, field := range strings.Fields(text) {
+		if strings.HasPrefix(field, "@") ||
+			strings.HasPrefix(field, "#") ||
+			strings.HasPrefix(field, ":") ||
+			strings.Contains(field, "://") {
+			// Skip mentions, hashtags,
+			// emoji shortcodes, links.
+			continue
+		}
+
+		for _, r := range field {
+			if !unicode.IsLetter(r) {
+				continue
+			}
+
+			letters++
+
+			switch {
+			case unicode.Is(unicode.Latin, r):
+				latin++
+			case unicode.Is(unicode.Cyrillic, r):
+				cyrillic++
+				ukrainian = ukrainian || strings.ContainsRune("іїєґІЇЄҐ", r)
+			case unicode.Is(unicode.Arabic, r):
+				arabic++
+				persian = persian || strings.ContainsRune("پچژگ", r)
+			case unicode.Is(unicode.Han, r):
+				han++
+			case unicode.Is(unicode.Hiragana, r),
+				unicode.Is(unicode.Katakana, r):
+				kana++
+			default:
+				for i, sl := range scriptLangs {
+					if unicode.Is(sl.script, r) {
+						scripts[i]++
+						break
+					}
+				}
+			}
+		}
+
+		// Split field into words on
+		// anything that's not a letter.
+		words = append(words, strings.FieldsFunc(
+			strings.ToLower(field),
+			func(r rune) bool { return !unicode.IsLetter(r) },
+		)...)
+	}
+
+	if letters < minDetectLetters {
+		// Not enough to go on.
+		return ""
+	}
+
+	// majority returns whether count
+	// makes up most letters of text.
+	majority := func(count int) bool {
+		return count*2 > letters
+	}
+
+	switch {
+	// Japanese mixes kana with kanji,
+	// so any notable amount of kana
+	// in CJK text is a good indicator.
+	case majority(han+kana) && kana*10 >= han+kana:
+		return "ja"
+
+	case majority(han):
+		return "zh"
+
+	case majority(cyrillic):
+		if ukrainian {
+			return "uk"
+		}
+		return "ru"
+
+	case majority(arabic):
+		if persian {
+			return "fa"
+		}
+		return "ar"
+
+	case majority(latin):
+		return detectLatin(words)
+	}
+
+	for i, count := range scripts {
+		if majority(count) {
+			return scriptLangs[i].tag
+		}
+	}
+
+	return ""
+}
+
+// detectLatin picks the language that the given
+// lowercased words of latin-script text have the
+// most stopwords of, if this is a clear winner.
+func detectLatin(words []string) string {
+	hits := make(map[string]int)
+	for _, word := range words {
+		for _, tag := range stopwords[word] {
+			hits[tag]++
+		}
+	}
+
+	var (
+		best     string
+		bestHits int
+		nextHits int
+	)
+
+	for tag, count := range hits {
+		switch {
+		case count > bestHits:
+			best, bestHits, nextHits = tag, count, bestHits
+		case count > nextHits:
+			nextHits = count
+		}
+	}
+
+	// Require a minimum number of hits, and
+	// for the best language to score at least
+	// half again as much as the runner up.
+	if bestHits < minStopwordHits ||
+		bestHits*2 < nextHits*3 {
+		return ""
+	}
+
+	return best
+}
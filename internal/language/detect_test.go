@@ -0,0 +1,89 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package language_test
+
+import (
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/language"
+)
+
+func TestDetect(t *testing.T) {
+	for _, test := range []struct {
+		text     string
+		expected string
+	}{
+		{
+			text:     "The quick brown fox jumps over the lazy dog and it was not amused",
+			expected: "en",
+		},
+		{
+			text:     "Ich habe heute keine Zeit, aber wir sehen uns morgen auf dem Markt",
+			expected: "de",
+		},
+		{
+			text:     "Je ne sais pas pourquoi il fait si froid dans la maison ce matin",
+			expected: "fr",
+		},
+		{
+			text:     "Hoy no tengo tiempo para ir al mercado con mis amigos pero mañana sí",
+			expected: "es",
+		},
+		{
+			text:     "今日はとても良い天気ですね。散歩に行きましょう。",
+			expected: "ja",
+		},
+		{
+			text:     "今天天气很好，我们一起去公园散步吧，好不好",
+			expected: "zh",
+		},
+		{
+			text:     "Сегодня очень хорошая погода, пойдём гулять",
+			expected: "ru",
+		},
+		{
+			text:     "Сьогодні дуже гарна погода, ходімо гуляти в парк",
+			expected: "uk",
+		},
+		{
+			text:     "오늘은 날씨가 정말 좋네요 산책하러 갈까요",
+			expected: "ko",
+		},
+		{
+			// Too short to tell.
+			text:     "hello!",
+			expected: "",
+		},
+		{
+			// Nothing but mentions,
+			// hashtags and links.
+			text:     "@someone@example.org #SomeHashtag https://example.org/some/long/path",
+			expected: "",
+		},
+		{
+			// Latin text without
+			// any known stopwords.
+			text:     "Lorem ipsum dolor sit amet consectetur adipiscing",
+			expected: "",
+		},
+	} {
+		if detected := language.Detect(test.text); detected != test.expected {
+			t.Errorf("expected %q for %q, got %q", test.expected, test.text, detected)
+		}
+	}
+}
@@ -107,6 +107,56 @@ func ffmpegGenerateWebpThumb(ctx context.Context, inpath, outpath string, width,
 	)
 }
 
+// ffmpegGenerateWebpSprite generates a webp sprite sheet from input video, made up of
+// given number of frames, evenly spaced throughout the video's duration, each scaled
+// to given dimensions, and tiled in a grid of given number of columns and rows.
+func ffmpegGenerateWebpSprite(
+	ctx context.Context,
+	inpath, outpath string,
+	width, height int,
+	columns, rows int,
+	frames int,
+	duration float64,
+) error {
+	// Rate at which to sample frames from
+	// the video to get requested count.
+	fps := strconv.FormatFloat(float64(frames)/duration, 'f', -1, 64)
+
+	return ffmpeg(ctx, inpath, outpath,
+
+		// Only log errors.
+		"-loglevel", "error",
+
+		// Input file.
+		"-i", inpath,
+
+		// Encode using libwebp.
+		// (NOT as libwebp_anim).
+		"-codec:v", "libwebp",
+
+		// Only one (tiled) frame.
+		"-frames:v", "1",
+
+		// Sample frames at calculated rate
+		// (fps filter: https://ffmpeg.org/ffmpeg-filters.html#fps)
+		"-filter:v", "fps="+fps+","+
+
+			// Scale to dimensions
+			// (scale filter: https://ffmpeg.org/ffmpeg-filters.html#scale)
+			"scale="+strconv.Itoa(width)+":"+strconv.Itoa(height)+","+
+
+			// Tile frames into grid
+			// (tile filter: https://ffmpeg.org/ffmpeg-filters.html#tile)
+			"tile="+strconv.Itoa(columns)+"x"+strconv.Itoa(rows),
+
+		// Overwrite.
+		"-y",
+
+		// Output.
+		outpath,
+	)
+}
+
 // ffmpegGenerateStatic generates a static png from input image of any type, useful for emoji.
 func ffmpegGenerateStatic(ctx context.Context, inpath string) (string, error) {
 	var outpath string
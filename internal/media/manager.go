@@ -423,6 +423,12 @@ func (m *Manager) createOrUpdateEmoji(
 	if info.CategoryID != nil {
 		emoji.CategoryID = *info.CategoryID
 	}
+	if info.License != nil {
+		emoji.License = *info.License
+	}
+	if info.Attribution != nil {
+		emoji.Attribution = *info.Attribution
+	}
 	if reason := info.RejectReason; reason != nil {
 		// If a predefined reject reason was provided,
 		// don't download and return early with error.
@@ -24,9 +24,11 @@
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	gtsmodel "code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/media"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
@@ -37,6 +39,7 @@
 	"codeberg.org/gruf/go-iotools"
 	"codeberg.org/gruf/go-storage/disk"
 	"github.com/stretchr/testify/suite"
+	"golang.org/x/image/webp"
 )
 
 type ManagerTestSuite struct {
@@ -299,6 +302,56 @@ func (suite *ManagerTestSuite) TestSimpleJpegProcess() {
 	equalFiles(suite.T(), suite.state.Storage, dbAttachment.Thumbnail.Path, "./test/test-jpeg-thumbnail.jpeg")
 }
 
+func (suite *ManagerTestSuite) TestSimpleJpegProcessWebpThumb() {
+	ctx := suite.T().Context()
+
+	// Configure thumbs to always be webp.
+	config.SetMediaThumbFormat(config.MediaThumbFormatWebP)
+	defer config.SetMediaThumbFormat(config.MediaThumbFormatJPEG)
+
+	data := func(_ context.Context) (io.ReadCloser, error) {
+		// load bytes from a test image
+		b, err := os.ReadFile("./test/test-jpeg.jpg")
+		if err != nil {
+			panic(err)
+		}
+		return io.NopCloser(bytes.NewBuffer(b)), nil
+	}
+
+	accountID := "01FS1X72SK9ZPW0J1QQ68BD264"
+
+	// process the media with no additional info provided
+	processing, err := suite.manager.CreateMedia(ctx,
+		accountID,
+		data,
+		media.AdditionalMediaInfo{},
+	)
+	suite.NoError(err)
+	suite.NotNil(processing)
+
+	// do a blocking call to fetch the attachment
+	attachment, err := processing.Load(ctx)
+	suite.NoError(err)
+	suite.NotNil(attachment)
+
+	// original should be untouched,
+	// but thumbnail should be webp.
+	suite.EqualValues(gtsmodel.Small{
+		Width: 512, Height: 288, Size: 147456, Aspect: 1.7777777777777777,
+	}, attachment.FileMeta.Small)
+	suite.Equal("image/jpeg", attachment.File.ContentType)
+	suite.Equal("image/webp", attachment.Thumbnail.ContentType)
+	suite.True(strings.HasSuffix(attachment.Thumbnail.Path, ".webp"))
+	suite.True(strings.HasSuffix(attachment.Thumbnail.URL, ".webp"))
+	suite.Less(attachment.Thumbnail.FileSize, 22858) // smaller than jpeg thumb
+	suite.NotEmpty(attachment.Blurhash)
+
+	// ensure thumbnail made it to storage.
+	thumb, err := suite.state.Storage.Get(ctx, attachment.Thumbnail.Path)
+	suite.NoError(err)
+	suite.Len(thumb, attachment.Thumbnail.FileSize)
+}
+
 func (suite *ManagerTestSuite) TestSimpleJpegProcessTooLarge() {
 	ctx := suite.T().Context()
 
@@ -649,6 +702,66 @@ func (suite *ManagerTestSuite) TestBirdnestMp4Process() {
 	equalFiles(suite.T(), suite.state.Storage, dbAttachment.Thumbnail.Path, "./test/birdnest-thumbnail.webp")
 }
 
+func (suite *ManagerTestSuite) TestBirdnestMp4ProcessSprite() {
+	ctx := suite.T().Context()
+
+	// Enable video preview sprite sheets.
+	config.SetMediaVideoSpriteFrames(15)
+	defer config.SetMediaVideoSpriteFrames(0)
+
+	data := func(_ context.Context) (io.ReadCloser, error) {
+		// load bytes from a test video
+		b, err := os.ReadFile("./test/birdnest-original.mp4")
+		if err != nil {
+			panic(err)
+		}
+		return io.NopCloser(bytes.NewBuffer(b)), nil
+	}
+
+	accountID := "01FS1X72SK9ZPW0J1QQ68BD264"
+
+	// process the media with no additional info provided
+	processing, err := suite.manager.CreateMedia(ctx,
+		accountID,
+		data,
+		media.AdditionalMediaInfo{},
+	)
+	suite.NoError(err)
+	suite.NotNil(processing)
+
+	// do a blocking call to fetch the attachment
+	attachment, err := processing.Load(ctx)
+	suite.NoError(err)
+	suite.NotNil(attachment)
+
+	// sprite sheet should be generated
+	// with frames scaled to fit within
+	// 160px, tiled in 10 columns max.
+	suite.Equal("image/webp", attachment.Sprite.ContentType)
+	suite.Equal(15, attachment.Sprite.Frames)
+	suite.Equal(10, attachment.Sprite.Columns)
+	suite.Equal(89, attachment.Sprite.Width)
+	suite.Equal(160, attachment.Sprite.Height)
+	suite.Positive(attachment.Sprite.FileSize)
+	suite.True(strings.HasSuffix(attachment.Sprite.Path, "/attachment/sprite/"+attachment.ID+".webp"))
+	suite.True(strings.HasSuffix(attachment.Sprite.URL, "/attachment/sprite/"+attachment.ID+".webp"))
+
+	// now make sure the attachment is in the database
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, attachment.ID)
+	suite.NoError(err)
+	suite.NotNil(dbAttachment)
+	suite.Equal(attachment.Sprite, dbAttachment.Sprite)
+
+	// ensure the sprite sheet is a single
+	// webp image containing the frame grid.
+	b, err := suite.state.Storage.Get(ctx, dbAttachment.Sprite.Path)
+	suite.NoError(err)
+	cfg, err := webp.DecodeConfig(bytes.NewReader(b))
+	suite.NoError(err)
+	suite.Equal(10*89, cfg.Width)
+	suite.Equal(2*160, cfg.Height)
+}
+
 func (suite *ManagerTestSuite) TestOpusProcess() {
 	ctx := suite.T().Context()
 
@@ -80,7 +80,7 @@ func (p *ProcessingEmoji) MustLoad(ctx context.Context) (*gtsmodel.Emoji, error)
 	emoji, done, err := p.load(ctx)
 	if !done {
 		// On a context-canceled error (marked as !done), requeue for loading.
-		p.mgr.state.Workers.Dereference.Queue.Push(func(ctx context.Context) {
+		p.mgr.state.Workers.Dereference.Push(ctx, func(ctx context.Context) {
 			log.Warnf(ctx, "reprocessing emoji %s after canceled ctx", p.emoji.ShortcodeDomain())
 			if _, _, err := p.load(ctx); err != nil {
 				log.Errorf(ctx, "error loading emoji %s: %v", p.emoji.ShortcodeDomain(), err)
@@ -304,7 +304,7 @@ func (p *ProcessingEmoji) store(ctx context.Context) error {
 	p.emoji.ImageStaticContentType = "image/png"
 
 	// Copy temporary file into storage at path.
-	filesz, err := p.mgr.state.Storage.PutFile(ctx,
+	filesz, _, err := p.mgr.state.Storage.PutFile(ctx,
 		p.emoji.ImagePath,
 		temppath,
 		p.emoji.ImageContentType,
@@ -314,7 +314,7 @@ func (p *ProcessingEmoji) store(ctx context.Context) error {
 	}
 
 	// Copy static emoji file into storage at path.
-	staticsz, err := p.mgr.state.Storage.PutFile(ctx,
+	staticsz, _, err := p.mgr.state.Storage.PutFile(ctx,
 		p.emoji.ImageStaticPath,
 		staticpath,
 		p.emoji.ImageStaticContentType,
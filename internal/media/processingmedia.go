@@ -20,6 +20,7 @@
 import (
 	"context"
 	"os"
+	"time"
 
 	"codeberg.org/gruf/go-errors/v2"
 	errorsv2 "codeberg.org/gruf/go-errors/v2"
@@ -27,8 +28,10 @@
 
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/hooks"
 	"code.superseriousbusiness.org/gotosocial/internal/storage"
 	"code.superseriousbusiness.org/gotosocial/internal/uris"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
@@ -77,7 +80,7 @@ func (p *ProcessingMedia) MustLoad(ctx context.Context) (*gtsmodel.MediaAttachme
 	if !done {
 		// On a context-canceled error (marked as !done), requeue for loading.
 		log.Warnf(ctx, "reprocessing media %s after canceled ctx", p.media.ID)
-		p.mgr.state.Workers.Dereference.Queue.Push(func(ctx context.Context) {
+		p.mgr.state.Workers.Dereference.Push(ctx, func(ctx context.Context) {
 			if _, _, err := p.load(ctx); err != nil {
 				log.Errorf(ctx, "error loading media: %v", err)
 			}
@@ -128,6 +131,10 @@ func (p *ProcessingMedia) load(ctx context.Context) (
 			return
 		}
 
+		// Whether we actually
+		// attempted to fetch.
+		var attempted bool
+
 		defer func() {
 			// This is only done when ctx NOT cancelled.
 			if done = (err == nil || !errorsv2.IsV2(err,
@@ -157,6 +164,10 @@ func (p *ProcessingMedia) load(ctx context.Context) (
 				if isStubError(p.media.Error) {
 					log.Warnf(ctx, "stubbed %s due to: %v", p.media.RemoteURL, p.err)
 					err = nil // don't return stub errors
+				} else if attempted && p.media.IsRemote() {
+					// Update recache backoff
+					// state on remote media.
+					p.updateRecacheState()
 				}
 
 				// Update with latest details, whatever happened.
@@ -178,6 +189,7 @@ func (p *ProcessingMedia) load(ctx context.Context) (
 		// full-size media attachment details.
 		//
 		// This will update p.media as it goes.
+		attempted = true
 		err = p.store(ctx)
 		return
 	})
@@ -210,12 +222,13 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 		// predefine temporary media
 		// file path variables so we
 		// can remove them on error.
-		temppath  string
-		thumbpath string
+		temppath   string
+		thumbpath  string
+		spritepath string
 	)
 
 	defer func() {
-		if err := remove(temppath, thumbpath); err != nil {
+		if err := remove(temppath, thumbpath, spritepath); err != nil {
 			log.Errorf(ctx, "error(s) cleaning up files: %v", err)
 		}
 	}()
@@ -310,6 +323,7 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 			thumbHeight,
 			result.orientation,
 			result.PixFmt(),
+			config.GetMediaThumbFormat(),
 			needBlurhash,
 		)
 		if err != nil {
@@ -323,6 +337,46 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 			// Set newly determined blurhash.
 			p.media.Blurhash = newBlurhash
 		}
+
+		// Check whether video preview sprite sheets are enabled.
+		frames := config.GetMediaVideoSpriteFrames()
+
+		if p.media.Type == gtsmodel.FileTypeVideo &&
+			frames > 0 && result.duration > 0 {
+
+			// Determine sprite frame dimens to use.
+			frameWidth, frameHeight := thumbSize(
+				spriteMaxPixels,
+				width,
+				height,
+				aspect,
+			)
+
+			// Generate preview sprite sheet from temp media. Sprite sheets
+			// are a nice-to-have, so on error we log and continue without.
+			var columns int
+			spritepath, columns, err = generateSprite(ctx, temppath,
+				frameWidth,
+				frameHeight,
+				frames,
+				result.duration,
+			)
+			if err != nil {
+				log.Warnf(ctx, "error generating video sprite: %v", err)
+			} else {
+				// Set generated sprite sheet details.
+				p.media.Sprite.ContentType = "image/webp"
+				p.media.Sprite.Frames = frames
+				p.media.Sprite.Columns = columns
+				p.media.Sprite.Width = frameWidth
+				p.media.Sprite.Height = frameHeight
+			}
+		}
+	}
+
+	// Pass media through any configured moderation hook.
+	if err := p.callStoreHook(ctx, temppath); err != nil {
+		return err
 	}
 
 	// Calculate final media attachment file path.
@@ -335,7 +389,7 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 	)
 
 	// Copy temporary file into storage at path.
-	filesz, err := p.mgr.state.Storage.PutFile(ctx,
+	filesz, filesum, err := p.mgr.state.Storage.PutFile(ctx,
 		p.media.File.Path,
 		temppath,
 		p.media.File.ContentType,
@@ -344,8 +398,9 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 		return gtserror.Newf("error writing media to storage: %w", err)
 	}
 
-	// Set final determined file size.
+	// Set final determined file size + checksum.
 	p.media.File.FileSize = int(filesz)
+	p.media.File.Checksum = filesum
 
 	if thumbpath != "" {
 		// Determine final thumbnail ext.
@@ -361,7 +416,7 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 		)
 
 		// Copy thumbnail file into storage at path.
-		thumbsz, err := p.mgr.state.Storage.PutFile(ctx,
+		thumbsz, thumbsum, err := p.mgr.state.Storage.PutFile(ctx,
 			p.media.Thumbnail.Path,
 			thumbpath,
 			p.media.Thumbnail.ContentType,
@@ -370,8 +425,9 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 			return gtserror.Newf("error writing thumb to storage: %w", err)
 		}
 
-		// Set final determined thumbnail size.
+		// Set final determined thumbnail size + checksum.
 		p.media.Thumbnail.FileSize = int(thumbsz)
+		p.media.Thumbnail.Checksum = thumbsum
 
 		// Generate a media attachment thumbnail URL.
 		p.media.Thumbnail.URL = uris.URIForAttachment(
@@ -383,6 +439,40 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 		)
 	}
 
+	if spritepath != "" {
+		// Calculate final media attachment sprite sheet path.
+		p.media.Sprite.Path = uris.StoragePathForAttachment(
+			p.media.AccountID,
+			string(TypeAttachment),
+			string(SizeSprite),
+			p.media.ID,
+			"webp",
+		)
+
+		// Copy sprite sheet file into storage at path.
+		spritesz, spritesum, err := p.mgr.state.Storage.PutFile(ctx,
+			p.media.Sprite.Path,
+			spritepath,
+			p.media.Sprite.ContentType,
+		)
+		if err != nil {
+			return gtserror.Newf("error writing sprite to storage: %w", err)
+		}
+
+		// Set final determined sprite sheet size + checksum.
+		p.media.Sprite.FileSize = int(spritesz)
+		p.media.Sprite.Checksum = spritesum
+
+		// Generate a media attachment sprite sheet URL.
+		p.media.Sprite.URL = uris.URIForAttachment(
+			p.media.AccountID,
+			string(TypeAttachment),
+			string(SizeSprite),
+			p.media.ID,
+			"webp",
+		)
+	}
+
 	// Generate a media attachment URL.
 	p.media.URL = uris.URIForAttachment(
 		p.media.AccountID,
@@ -399,6 +489,78 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 	return nil
 }
 
+// callStoreHook calls any configured pre-media-store moderation
+// hook with details of processed media at given temporary path,
+// returning a stub error if the hook rejected the media.
+func (p *ProcessingMedia) callStoreHook(ctx context.Context, temppath string) error {
+	if !p.mgr.state.Hooks.Enabled(hooks.PreMediaStore) {
+		return nil
+	}
+
+	// Get size of processed media file.
+	stat, err := os.Stat(temppath)
+	if err != nil {
+		return gtserror.Newf("error statting %s: %w", temppath, err)
+	}
+
+	// Fetch owning account for its URI.
+	account, err := p.mgr.state.DB.GetAccountByID(
+		gtscontext.SetBarebones(ctx),
+		p.media.AccountID,
+	)
+	if err != nil {
+		return gtserror.Newf("error getting account %s: %w", p.media.AccountID, err)
+	}
+
+	err = p.mgr.state.Hooks.Call(ctx, &hooks.Request{
+		Point:   hooks.PreMediaStore,
+		Account: account.URI,
+		Media: &hooks.Media{
+			ID:          p.media.ID,
+			Type:        p.media.Type.String(),
+			ContentType: p.media.File.ContentType,
+			Size:        stat.Size(),
+			RemoteURL:   p.media.RemoteURL,
+		},
+	})
+
+	if errorsv2.AsV2[*hooks.RejectError](err) != nil {
+		// On rejection return a stub error
+		// that doesn't get returned to the
+		// caller, but indicates details.
+		return withDetails(err, gtsmodel.NewMediaErrorDetails(
+			gtsmodel.MediaErrorTypePolicy,
+			gtsmodel.MediaErrorTypePolicy_Moderation,
+		))
+	}
+
+	return err
+}
+
+// updateRecacheState updates the recache backoff state
+// on the remote media after an attempt to (re)cache it,
+// either resetting it on success, or increasing the backoff.
+func (p *ProcessingMedia) updateRecacheState() {
+	RecacheMetrics.Attempts.Add(1)
+
+	if p.err == nil {
+		// Success, reset
+		// backoff state.
+		p.media.RecacheFailures = 0
+		p.media.RecacheFailedAt = time.Time{}
+		p.media.RecacheBackoff = 0
+		return
+	}
+
+	RecacheMetrics.Failures.Add(1)
+
+	// Failed, increase backoff
+	// before next attempt.
+	p.media.RecacheFailures++
+	p.media.RecacheFailedAt = time.Now()
+	p.media.RecacheBackoff = recacheBackoff(p.media.RecacheFailures)
+}
+
 // cleanup will remove any traces of processing media from storage.
 // and perform any other necessary cleanup steps after failure.
 func (p *ProcessingMedia) cleanup(ctx context.Context) {
@@ -418,6 +580,14 @@ func (p *ProcessingMedia) cleanup(ctx context.Context) {
 		}
 	}
 
+	if p.media.Sprite.Path != "" {
+		// Ensure media sprite sheet at path is deleted from storage.
+		err := p.mgr.state.Storage.Delete(ctx, p.media.Sprite.Path)
+		if err != nil && !storage.IsNotFound(err) {
+			log.Errorf(ctx, "error deleting %s: %v", p.media.Sprite.Path, err)
+		}
+	}
+
 	// Unset fields.
 	p.media.Stub()
 
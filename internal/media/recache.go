@@ -0,0 +1,68 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media
+
+import (
+	"math/rand/v2"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// recacheBackoffMin is the backoff after
+	// a first failed attempt to (re)cache
+	// remote media, doubling every failure.
+	recacheBackoffMin = time.Minute
+
+	// recacheBackoffMax is the maximum
+	// backoff between attempts to
+	// (re)cache remote media.
+	recacheBackoffMax = 12 * time.Hour
+)
+
+// RecacheMetrics contains running counts of attempts
+// to (re)cache remote media, for use in instance metrics.
+var RecacheMetrics struct {
+
+	// Attempts is the number of attempts
+	// made to (re)cache remote media.
+	Attempts atomic.Int64
+
+	// Failures is the number of
+	// Attempts that then failed.
+	Failures atomic.Int64
+
+	// Skipped is the number of attempts
+	// not made due to an ongoing backoff.
+	Skipped atomic.Int64
+}
+
+// recacheBackoff returns a jittered backoff duration
+// for given number of consecutive recache failures.
+func recacheBackoff(failures int) time.Duration {
+	// Double backoff for each failure,
+	// (limiting shift to prevent overflow).
+	shift := min(max(failures-1, 0), 16)
+	backoff := min(recacheBackoffMin<<shift, recacheBackoffMax)
+
+	// Add +/- 25% jitter, so that media from the same
+	// origin (i.e. all failed around the same time)
+	// don't all then go on to be retried at once.
+	jitter := rand.N(backoff/2) - backoff/4 // #nosec G404 -- not security sensitive
+	return backoff + jitter
+}
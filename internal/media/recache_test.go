@@ -0,0 +1,48 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRecacheBackoff(t *testing.T) {
+	for _, test := range []struct {
+		failures int
+		expect   time.Duration
+	}{
+		{failures: 1, expect: time.Minute},
+		{failures: 2, expect: 2 * time.Minute},
+		{failures: 5, expect: 16 * time.Minute},
+		{failures: 11, expect: recacheBackoffMax},
+		{failures: 1000, expect: recacheBackoffMax},
+	} {
+		// Backoff should be within +/- 25% jitter of expected.
+		lower := test.expect - test.expect/4
+		upper := test.expect + test.expect/4
+
+		for range 100 {
+			backoff := recacheBackoff(test.failures)
+			if backoff < lower || backoff > upper {
+				t.Fatalf("backoff %s for %d failures outside of range [%s, %s]",
+					backoff, test.failures, lower, upper)
+			}
+		}
+	}
+}
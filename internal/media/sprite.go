@@ -0,0 +1,86 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media
+
+import (
+	"context"
+	"strings"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+)
+
+const (
+	// spriteMaxPixels is the max size in pixels
+	// of any one dimension of a single sprite frame.
+	spriteMaxPixels = 160
+
+	// spriteMaxColumns is the max number
+	// of frame columns in a sprite sheet.
+	spriteMaxColumns = 10
+)
+
+// spriteLayout returns the number of columns and rows
+// to use for a sprite sheet of the given frame count.
+func spriteLayout(frames int) (columns, rows int) {
+	columns = min(frames, spriteMaxColumns)
+	rows = (frames + columns - 1) / columns
+	return
+}
+
+// generateSprite generates a webp sprite sheet for the
+// input video file at path, containing given number of
+// frames spaced evenly through the video's duration, each
+// scaled to the given frame dimensions. Returns the path
+// of the generated sprite sheet, and the columns in it.
+func generateSprite(
+	ctx context.Context,
+	filepath string,
+	width, height int,
+	frames int,
+	duration float64,
+) (
+	outpath string,
+	columns int,
+	err error,
+) {
+	// Generate sprite sheet output path REPLACING extension.
+	if i := strings.IndexByte(filepath, '.'); i != -1 {
+		outpath = filepath[:i] + "_sprite.webp"
+	} else {
+		return "", 0, gtserror.New("input file missing extension")
+	}
+
+	// Determine sprite sheet frame grid layout.
+	columns, rows := spriteLayout(frames)
+
+	// Generate the sprite sheet with ffmpeg.
+	if err := ffmpegGenerateWebpSprite(ctx,
+		filepath,
+		outpath,
+		width,
+		height,
+		columns,
+		rows,
+		frames,
+		duration,
+	); err != nil {
+		return "", 0, gtserror.Newf("error generating sprite: %w", err)
+	}
+
+	return outpath, columns, nil
+}
@@ -27,6 +27,7 @@
 	"strings"
 
 	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"github.com/buckket/go-blurhash"
 	"golang.org/x/image/webp"
@@ -70,12 +71,18 @@ func thumbSize(max, width, height int, aspect float32) (int, int) {
 // Go libraries for generating thumbnails, else
 // always falling back to slower but much more
 // widely supportive ffmpeg.
+//
+// The format determines the thumbnail encoding,
+// (see config.MediaThumbFormat*), though jpeg
+// still falls back to webp for any input that
+// can't be natively thumbnailed as jpeg.
 func generateThumb(
 	ctx context.Context,
 	filepath string,
 	width, height int,
 	orientation int,
 	pixfmt string,
+	format string,
 	needBlurhash bool,
 ) (
 	outpath string,
@@ -101,6 +108,11 @@ func generateThumb(
 	// us to generate thumbs natively.
 	switch {
 
+	// Native thumbnails are only
+	// ever encoded as jpeg, so skip
+	// them for any other format.
+	case format != config.MediaThumbFormatJPEG:
+
 	case ext == "jpeg":
 		// Replace the "webp" with "jpeg", as we'll
 		// use our native Go thumbnailing generation.
@@ -30,6 +30,7 @@
 	SizeSmall    Size = "small"    // SizeSmall is the key for small/thumbnail versions of media
 	SizeOriginal Size = "original" // SizeOriginal is the key for original/fullsize versions of media and emoji
 	SizeStatic   Size = "static"   // SizeStatic is the key for static (non-animated) versions of emoji
+	SizeSprite   Size = "sprite"   // SizeSprite is the key for preview frame sprite sheets of video media
 )
 
 type Type string
@@ -118,6 +119,14 @@ type AdditionalEmojiInfo struct {
 	// should be placed in; defaults to "".
 	CategoryID *string
 
+	// License under which this
+	// emoji is made available.
+	License *string
+
+	// Attribution / credit for the
+	// creator(s) of this emoji.
+	Attribution *string
+
 	// Set this if media should be rejected due to
 	// some predetermined reason, e.g. domain policy.
 	RejectReason *gtsmodel.MediaErrorDetails
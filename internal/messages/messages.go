@@ -25,6 +25,7 @@
 
 	"code.superseriousbusiness.org/activity/streams"
 	"code.superseriousbusiness.org/activity/streams/vocab"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"codeberg.org/gruf/go-structr"
@@ -54,6 +55,21 @@ type FromClientAPI struct {
 	// Target is the account that
 	// this message is targeting.
 	Target *gtsmodel.Account
+
+	// trace information carried
+	// from the queueing context.
+	trace *gtscontext.Trace
+}
+
+// SetTrace sets trace information to carry with message
+// across the worker queue, see gtscontext.CarryTrace().
+func (msg *FromClientAPI) SetTrace(trace *gtscontext.Trace) {
+	msg.trace = trace
+}
+
+// Trace returns trace information carried with message.
+func (msg *FromClientAPI) Trace() *gtscontext.Trace {
+	return msg.trace
 }
 
 // fromClientAPI is an internal type
@@ -194,6 +210,21 @@ type FromFediAPI struct {
 	// Local account which owns the inbox
 	// that this Activity was posted to.
 	Receiving *gtsmodel.Account
+
+	// trace information carried
+	// from the queueing context.
+	trace *gtscontext.Trace
+}
+
+// SetTrace sets trace information to carry with message
+// across the worker queue, see gtscontext.CarryTrace().
+func (msg *FromFediAPI) SetTrace(trace *gtscontext.Trace) {
+	msg.trace = trace
+}
+
+// Trace returns trace information carried with message.
+func (msg *FromFediAPI) Trace() *gtscontext.Trace {
+	return msg.trace
 }
 
 // fromFediAPI is an internal type
@@ -0,0 +1,178 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+
+	"code.superseriousbusiness.org/gotosocial/internal/cache"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/oauth"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/gin-gonic/gin"
+
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+)
+
+// AccountRateLimit returns a gin middleware that will rate limit
+// authenticated accounts performing specific actions, counting
+// each action against an hourly budget kept in the given cache.
+//
+// Routes is a map of "METHOD /full/route/path" to the hourly
+// limit for regular users for that route, for example:
+//
+//	"POST /api/v1/statuses": 100
+//
+// Limits for moderators and admins are scaled by the configured
+// role multipliers, with a multiplier <= 0 exempting that role.
+//
+// Limited responses are enriched with the same X-RateLimit-*
+// headers as set by RateLimit, overwriting any existing values
+// where this per-account limit has fewer requests remaining.
+//
+// If the limit is exceeded, the request is aborted and an
+// HTTP 429 TooManyRequests status is returned.
+//
+// This middleware relies on TokenCheck having already populated
+// the authorized user + account on the gin context, and should
+// therefore be attached after it. Unauthenticated requests are
+// passed through untouched; handlers will reject them anyway.
+//
+// If no route has a limit > 0, then a noop
+// handler will be returned, which performs no rate limiting.
+func AccountRateLimit(limits *cache.AccountRateLimits, routes map[string]int) gin.HandlerFunc {
+	// Drop any routes
+	// with no limit set.
+	limited := make(map[string]int, len(routes))
+	for route, limit := range routes {
+		if limit > 0 {
+			limited[route] = limit
+		}
+	}
+
+	if len(limited) == 0 {
+		// Rate limiting is disabled.
+		// Return noop middleware.
+		return nil
+	}
+
+	var (
+		modMultiplier   = config.GetAccountsRateLimitModeratorMultiplier()
+		adminMultiplier = config.GetAccountsRateLimitAdminMultiplier()
+	)
+
+	return func(c *gin.Context) {
+		// Check if this route is limited, using the matched
+		// route path (ie., with :id placeholders), so that
+		// all requests to the same endpoint share a budget.
+		route := c.Request.Method + " " + c.FullPath()
+		limit, ok := limited[route]
+		if !ok {
+			c.Next()
+			return
+		}
+
+		// Get the authorized user
+		// and account, if any.
+		user, account := authorizedUserAccount(c)
+		if user == nil || account == nil {
+			c.Next()
+			return
+		}
+
+		// Scale limit by role.
+		switch {
+		case util.PtrOrZero(user.Admin):
+			limit *= adminMultiplier
+		case util.PtrOrZero(user.Moderator):
+			limit *= modMultiplier
+		}
+
+		if limit <= 0 {
+			// Role is exempt.
+			c.Next()
+			return
+		}
+
+		// Increment the counter for this account + route.
+		context, err := limits.Increment(c, account.ID, route, limit)
+		if err != nil {
+			// Counters are in-memory, so this
+			// shouldn't be able to error, but
+			// handle it nicely just in case.
+			errWithCode := gtserror.NewErrorInternalError(err)
+
+			// Set error on gin context so it'll
+			// be picked up by logging middleware.
+			c.Error(errWithCode) //nolint:errcheck
+
+			// Bail with 500.
+			c.AbortWithStatusJSON(
+				errWithCode.Code(),
+				gin.H{"error": errWithCode.Safe()},
+			)
+			return
+		}
+
+		// Only overwrite any headers set by the IP
+		// rate limiter if this limit is the tighter
+		// of the two, so clients see the real budget.
+		remaining, err := strconv.ParseInt(c.Writer.Header().Get("X-RateLimit-Remaining"), 10, 64)
+		if err != nil || context.Remaining <= remaining {
+			setRateLimitHeaders(c, context)
+		}
+
+		if context.Reached {
+			// Return JSON error message for
+			// consistency with other endpoints.
+			apiutil.Data(c,
+				http.StatusTooManyRequests,
+				apiutil.AppJSON,
+				apiutil.ErrorRateLimited,
+			)
+			c.Abort()
+			return
+		}
+
+		// Allow the request
+		// to continue.
+		c.Next()
+	}
+}
+
+// authorizedUserAccount returns the user and account
+// set on the gin context by TokenCheck, if any.
+func authorizedUserAccount(c *gin.Context) (*gtsmodel.User, *gtsmodel.Account) {
+	var (
+		user    *gtsmodel.User
+		account *gtsmodel.Account
+	)
+
+	if i, ok := c.Get(oauth.SessionAuthorizedUser); ok {
+		user, _ = i.(*gtsmodel.User)
+	}
+
+	if i, ok := c.Get(oauth.SessionAuthorizedAccount); ok {
+		account, _ = i.(*gtsmodel.Account)
+	}
+
+	return user, account
+}
@@ -0,0 +1,177 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/cache"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/middleware"
+	"code.superseriousbusiness.org/gotosocial/internal/oauth"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+)
+
+type AccountRateLimitTestSuite struct {
+	suite.Suite
+}
+
+func (suite *AccountRateLimitTestSuite) TestAccountRateLimit() {
+	// Suppress warnings about debug mode.
+	gin.SetMode(gin.ReleaseMode)
+
+	config.SetAccountsRateLimitModeratorMultiplier(2)
+	config.SetAccountsRateLimitAdminMultiplier(0)
+	defer func() {
+		config.SetAccountsRateLimitModeratorMultiplier(0)
+		config.SetAccountsRateLimitAdminMultiplier(0)
+	}()
+
+	const limit = 2
+
+	var limits cache.AccountRateLimits
+	limits.Init()
+
+	rlMiddleware := middleware.AccountRateLimit(&limits, map[string]int{
+		"POST /api/v1/statuses": limit,
+	})
+	suite.NotNil(rlMiddleware)
+
+	type rlTest struct {
+		user      *gtsmodel.User
+		account   *gtsmodel.Account
+		path      string
+		allowed   int // requests allowed before limiting, -1 = unlimited
+		remaining bool
+	}
+
+	newUser := func(admin bool, moderator bool) *gtsmodel.User {
+		return &gtsmodel.User{
+			Admin:     util.Ptr(admin),
+			Moderator: util.Ptr(moderator),
+		}
+	}
+
+	for _, test := range []rlTest{
+		{
+			// Regular user gets the base limit.
+			user:    newUser(false, false),
+			account: &gtsmodel.Account{ID: "01F8MH1H7YV1Z7D2C8K2730QBF"},
+			path:    "/api/v1/statuses",
+			allowed: limit,
+		},
+		{
+			// Another user has their own budget.
+			user:    newUser(false, false),
+			account: &gtsmodel.Account{ID: "01F8MH5NBDF2MV7CTC4Q5128HF"},
+			path:    "/api/v1/statuses",
+			allowed: limit,
+		},
+		{
+			// Moderator budget is multiplied.
+			user:    newUser(false, true),
+			account: &gtsmodel.Account{ID: "01F8MH17FWEB39HZJ76B6VXSKF"},
+			path:    "/api/v1/statuses",
+			allowed: limit * 2,
+		},
+		{
+			// Admin is exempt.
+			user:    newUser(true, false),
+			account: &gtsmodel.Account{ID: "01F8MH0BBE4FHXPH513MBVFHB0"},
+			path:    "/api/v1/statuses",
+			allowed: -1,
+		},
+		{
+			// Unauthenticated requests pass through.
+			path:    "/api/v1/statuses",
+			allowed: -1,
+		},
+		{
+			// Unlimited routes pass through.
+			user:    newUser(false, false),
+			account: &gtsmodel.Account{ID: "01F8MH1H7YV1Z7D2C8K2730QBF"},
+			path:    "/api/v1/reports",
+			allowed: -1,
+		},
+	} {
+		e := gin.New()
+		handlers := []gin.HandlerFunc{
+			func(c *gin.Context) {
+				if test.user != nil {
+					c.Set(oauth.SessionAuthorizedUser, test.user)
+					c.Set(oauth.SessionAuthorizedAccount, test.account)
+				}
+			},
+			rlMiddleware,
+			func(c *gin.Context) { c.Status(http.StatusOK) },
+		}
+		e.POST("/api/v1/statuses", handlers...)
+		e.POST("/api/v1/reports", handlers...)
+
+		// Make requests up to +
+		// just over the limit.
+		for requestsCount := 1; requestsCount <= limit*2+1; requestsCount++ {
+			recorder := httptest.NewRecorder()
+			e.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, test.path, nil))
+
+			remainingStr := recorder.Header().Get("X-RateLimit-Remaining")
+
+			if test.allowed < 0 {
+				// Request should be allowed through,
+				// no rate-limit headers should be written.
+				suite.Equal(http.StatusOK, recorder.Code)
+				suite.Empty(remainingStr)
+				continue
+			}
+
+			suite.Equal(strconv.Itoa(test.allowed), recorder.Header().Get("X-RateLimit-Limit"))
+
+			if requestsCount <= test.allowed {
+				// Request should be allowed through.
+				suite.Equal(http.StatusOK, recorder.Code)
+				suite.Equal(strconv.Itoa(test.allowed-requestsCount), remainingStr)
+				continue
+			}
+
+			// Request should be denied.
+			suite.Equal(http.StatusTooManyRequests, recorder.Code)
+			suite.Equal("0", remainingStr)
+		}
+	}
+}
+
+func (suite *AccountRateLimitTestSuite) TestAccountRateLimitDisabled() {
+	var limits cache.AccountRateLimits
+	limits.Init()
+
+	rlMiddleware := middleware.AccountRateLimit(&limits, map[string]int{
+		"POST /api/v1/statuses": 0,
+		"POST /api/v1/reports":  -1,
+	})
+	suite.Nil(rlMiddleware)
+}
+
+func TestAccountRateLimitTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountRateLimitTestSuite))
+}
@@ -18,6 +18,7 @@
 package middleware
 
 import (
+	"slices"
 	"strings"
 
 	"codeberg.org/gruf/go-debug"
@@ -163,3 +164,73 @@ func BuildContentSecurityPolicy(extraURIs ...string) string {
 	// So join each policy directive appropriately.
 	return strings.Join(policyDirectives, "; ")
 }
+
+// ExtendContentSecurityPolicy extends the given policy, as
+// built by BuildContentSecurityPolicy, to additionally allow
+// scripts, styles, frames and connections from the given
+// sources. This is intended for individual pages which need
+// to embed a third-party widget (eg., a captcha), without
+// loosening the policy for every other page.
+func ExtendContentSecurityPolicy(csp string, sources ...string) string {
+	if len(sources) == 0 {
+		return csp
+	}
+
+	const self = "'self'"
+
+	// Split the policy into directives.
+	directives := strings.Split(csp, "; ")
+
+	for _, directive := range []string{
+		"script-src",
+		"style-src",
+		"frame-src",
+		"connect-src",
+	} {
+		found := false
+		for i, d := range directives {
+			if d == directive || strings.HasPrefix(d, directive+" ") {
+				// Directive already exists,
+				// just append the sources.
+				directives[i] = d + " " + strings.Join(sources, " ")
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			// Directive doesn't exist yet, so would
+			// fall back to default-src; add it with
+			// a restrictive 'self' policy + sources.
+			directives = append(directives,
+				directive+" "+self+" "+strings.Join(sources, " "),
+			)
+		}
+	}
+
+	return strings.Join(directives, "; ")
+}
+
+// AllowFrameAncestors modifies the given policy, as built
+// by BuildContentSecurityPolicy, to allow the page to be
+// embedded in an iframe by the given ancestor sources,
+// instead of the default of 'none'. This is intended only
+// for individual pages which are explicitly embeddable.
+func AllowFrameAncestors(csp string, ancestors ...string) string {
+	const directive = "frame-ancestors"
+
+	// Split the policy into directives.
+	directives := strings.Split(csp, "; ")
+
+	// Drop existing frame-ancestors
+	// directive, we're replacing it.
+	directives = slices.DeleteFunc(directives, func(d string) bool {
+		return d == directive || strings.HasPrefix(d, directive+" ")
+	})
+
+	directives = append(directives,
+		directive+" "+strings.Join(ancestors, " "),
+	)
+
+	return strings.Join(directives, "; ")
+}
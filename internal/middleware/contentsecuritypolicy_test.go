@@ -73,3 +73,41 @@ type cspTest struct {
 		}
 	}
 }
+
+func TestExtendContentSecurityPolicy(t *testing.T) {
+	csp := middleware.BuildContentSecurityPolicy()
+
+	// No sources should be a no-op.
+	if extended := middleware.ExtendContentSecurityPolicy(csp); extended != csp {
+		t.Logf("expected '%s', got '%s'", csp, extended)
+		t.Fail()
+	}
+
+	const expected = "default-src 'self'; connect-src 'self' https://api.listenbrainz.org/1/user/ https://hcaptcha.com https://*.hcaptcha.com; object-src 'none'; img-src 'self' blob:; media-src 'self'; script-src 'self' https://hcaptcha.com https://*.hcaptcha.com; style-src 'self' https://hcaptcha.com https://*.hcaptcha.com; frame-src 'self' https://hcaptcha.com https://*.hcaptcha.com"
+	extended := middleware.ExtendContentSecurityPolicy(csp,
+		"https://hcaptcha.com",
+		"https://*.hcaptcha.com",
+	)
+	if extended != expected {
+		t.Logf("expected '%s', got '%s'", expected, extended)
+		t.Fail()
+	}
+}
+
+func TestAllowFrameAncestors(t *testing.T) {
+	csp := middleware.BuildContentSecurityPolicy()
+
+	const expected = "default-src 'self'; connect-src 'self' https://api.listenbrainz.org/1/user/; object-src 'none'; img-src 'self' blob:; media-src 'self'; frame-ancestors 'self' https:"
+	allowed := middleware.AllowFrameAncestors(csp, "'self'", "https:")
+	if allowed != expected {
+		t.Logf("expected '%s', got '%s'", expected, allowed)
+		t.Fail()
+	}
+
+	// Existing frame-ancestors
+	// directive should be replaced.
+	if again := middleware.AllowFrameAncestors(allowed, "'self'", "https:"); again != expected {
+		t.Logf("expected '%s', got '%s'", expected, again)
+		t.Fail()
+	}
+}
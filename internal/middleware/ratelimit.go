@@ -56,13 +56,18 @@ func RateLimit(limit int, except []netip.Prefix) gin.HandlerFunc {
 		return nil
 	}
 
-	limiter := limiter.New(
-		memory.NewStore(),
-		limiter.Rate{
-			Period: rateLimitPeriod,
-			Limit:  int64(limit),
-		},
+	return DynamicRateLimit(
+		func() int { return limit },
+		func() []netip.Prefix { return except },
 	)
+}
+
+// DynamicRateLimit is like RateLimit, but fetches the limit and
+// exceptions on each request, so that they may be changed while
+// running (eg., on config reload). Requests are not rate limited
+// while the returned limit is <= 0.
+func DynamicRateLimit(limit func() int, except func() []netip.Prefix) gin.HandlerFunc {
+	store := memory.NewStore()
 
 	// It's prettymuch impossible to effectively
 	// rate limit the immense IPv6 address space
@@ -76,6 +81,14 @@ func RateLimit(limit int, except []netip.Prefix) gin.HandlerFunc {
 	ipv6Mask := net.CIDRMask(64, 128)
 
 	return func(c *gin.Context) {
+		limit := limit()
+		if limit <= 0 {
+			// Rate limiting
+			// currently disabled.
+			c.Next()
+			return
+		}
+
 		// Use Gin's heuristic for determining
 		// clientIP, which accounts for reverse
 		// proxies and trusted proxies setting.
@@ -107,7 +120,7 @@ func RateLimit(limit int, except []netip.Prefix) gin.HandlerFunc {
 
 		// Check if this IP is exempt from rate
 		// limits and skip further checks if so.
-		for _, prefix := range except {
+		for _, prefix := range except() {
 			if prefix.Contains(ip) {
 				c.Next()
 				return
@@ -126,7 +139,10 @@ func RateLimit(limit int, except []netip.Prefix) gin.HandlerFunc {
 		}
 
 		// Fetch rate limit info for this (masked) clientIP.
-		context, err := limiter.Get(c, ip.String())
+		context, err := store.Get(c, ip.String(), limiter.Rate{
+			Period: rateLimitPeriod,
+			Limit:  int64(limit),
+		})
 		if err != nil {
 			// Since we use an in-memory cache now,
 			// it's actually impossible for this to
@@ -146,18 +162,8 @@ func RateLimit(limit int, except []netip.Prefix) gin.HandlerFunc {
 			return
 		}
 
-		// Provide reset in same format used by
-		// Mastodon. There's no real standard as
-		// to what format X-RateLimit-Reset SHOULD
-		// use, but since most clients interacting
-		// with us will expect the Mastodon version,
-		// it makes sense to take this.
-		resetT := time.Unix(context.Reset, 0)
-		reset := util.FormatISO8601(resetT)
-
-		c.Header("X-RateLimit-Limit", strconv.FormatInt(context.Limit, 10))
-		c.Header("X-RateLimit-Remaining", strconv.FormatInt(context.Remaining, 10))
-		c.Header("X-RateLimit-Reset", reset)
+		// Set rate limit info headers.
+		setRateLimitHeaders(c, context)
 
 		if context.Reached {
 			// Return JSON error message for
@@ -176,3 +182,20 @@ func RateLimit(limit int, except []netip.Prefix) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// setRateLimitHeaders sets the X-RateLimit-* headers
+// on the response from the given rate limit context.
+func setRateLimitHeaders(c *gin.Context, context limiter.Context) {
+	// Provide reset in same format used by
+	// Mastodon. There's no real standard as
+	// to what format X-RateLimit-Reset SHOULD
+	// use, but since most clients interacting
+	// with us will expect the Mastodon version,
+	// it makes sense to take this.
+	resetT := time.Unix(context.Reset, 0)
+	reset := util.FormatISO8601(resetT)
+
+	c.Header("X-RateLimit-Limit", strconv.FormatInt(context.Limit, 10))
+	c.Header("X-RateLimit-Remaining", strconv.FormatInt(context.Remaining, 10))
+	c.Header("X-RateLimit-Reset", reset)
+}
@@ -0,0 +1,82 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"github.com/gin-gonic/gin"
+)
+
+// ReadOnly returns a gin middleware which, while the instance
+// is in read-only mode (see state.ReadOnly), aborts requests
+// that may write to the database with code 503: Service
+// Unavailable, and the given Retry-After value.
+//
+// Requests with "safe" methods (GET, HEAD, OPTIONS) are always
+// let through, so that web and API reads continue to be served.
+// All other requests, including federated deliveries to inboxes,
+// are rejected, except for those to any of the given exempt paths
+// (eg., the admin endpoint used to toggle read-only mode itself).
+//
+// Useful links:
+//
+//   - https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Retry-After
+//   - https://developer.mozilla.org/en-US/docs/Web/HTTP/Status/503
+func ReadOnly(state *state.State, retryAfter time.Duration, exemptPaths ...string) gin.HandlerFunc {
+	if retryAfter < 0 {
+		retryAfter = 0
+	}
+
+	retryAfterStr := strconv.FormatUint(uint64(retryAfter/time.Second), 10) // #nosec G115 -- Checked right above
+
+	return func(c *gin.Context) {
+		if !state.ReadOnly.Enabled() {
+			// Not in read-only
+			// mode, nothing to do.
+			return
+		}
+
+		switch c.Request.Method {
+		case http.MethodGet,
+			http.MethodHead,
+			http.MethodOptions:
+			// Safe method,
+			// let it through.
+			return
+		}
+
+		if slices.Contains(exemptPaths, c.Request.URL.Path) {
+			// Explicitly allowed.
+			return
+		}
+
+		c.Header("Retry-After", retryAfterStr)
+		apiutil.Data(c,
+			http.StatusServiceUnavailable,
+			apiutil.AppJSON,
+			apiutil.ErrorReadOnly,
+		)
+		c.Abort()
+	}
+}
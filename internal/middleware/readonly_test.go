@@ -0,0 +1,77 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/middleware"
+	"code.superseriousbusiness.org/gotosocial/internal/readonly"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadOnlyMiddleware(t *testing.T) {
+	var state state.State
+	testrig.StartNoopWorkers(&state)
+	defer testrig.StopWorkers(&state)
+	state.ReadOnly = readonly.New(&state.Workers)
+
+	e := gin.New()
+	e.Use(middleware.ReadOnly(&state, time.Minute, "/api/v1/admin/read_only"))
+	e.Handle(http.MethodGet, "/api/v1/timelines/home", func(c *gin.Context) { c.Status(http.StatusOK) })
+	e.Handle(http.MethodPost, "/api/v1/statuses", func(c *gin.Context) { c.Status(http.StatusOK) })
+	e.Handle(http.MethodPost, "/users/:username/inbox", func(c *gin.Context) { c.Status(http.StatusAccepted) })
+	e.Handle(http.MethodPost, "/api/v1/admin/read_only", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	do := func(method, path string) *httptest.ResponseRecorder {
+		r := httptest.NewRecorder()
+		e.ServeHTTP(r, httptest.NewRequest(method, path, nil))
+		return r
+	}
+
+	// Disabled: everything let through.
+	assert.Equal(t, http.StatusOK, do(http.MethodPost, "/api/v1/statuses").Code)
+	assert.Equal(t, http.StatusAccepted, do(http.MethodPost, "/users/the_mighty_zork/inbox").Code)
+
+	state.ReadOnly.Enable()
+
+	// Enabled: reads and exempt paths let through.
+	assert.Equal(t, http.StatusOK, do(http.MethodGet, "/api/v1/timelines/home").Code)
+	assert.Equal(t, http.StatusOK, do(http.MethodPost, "/api/v1/admin/read_only").Code)
+
+	// Enabled: writes and inbox deliveries rejected.
+	r := do(http.MethodPost, "/api/v1/statuses")
+	assert.Equal(t, http.StatusServiceUnavailable, r.Code)
+	assert.Equal(t, "60", r.Header().Get("Retry-After"))
+	assert.Equal(t, `{"error":"instance is in read-only mode for maintenance"}`, r.Body.String())
+
+	r = do(http.MethodPost, "/users/the_mighty_zork/inbox")
+	assert.Equal(t, http.StatusServiceUnavailable, r.Code)
+	assert.Equal(t, "60", r.Header().Get("Retry-After"))
+
+	state.ReadOnly.Disable()
+
+	// Disabled again: writes let through.
+	assert.Equal(t, http.StatusOK, do(http.MethodPost, "/api/v1/statuses").Code)
+}
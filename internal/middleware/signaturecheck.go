@@ -19,11 +19,15 @@
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/url"
 
 	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/msgsig"
 
 	"code.superseriousbusiness.org/httpsig"
 	"github.com/gin-gonic/gin"
@@ -46,14 +50,44 @@
 // blocked, the handler will set the key verifier and the signature in the
 // context for use down the line.
 //
+// Both RFC 9421 message signatures and draft-cavage http signatures
+// are accepted, with RFC 9421 signatures taking precedence if both
+// are present. Every response advertises RFC 9421 support to the
+// caller using the Accept-Signature header.
+//
 // In case of an error, the request will be aborted with http code 500.
 func SignatureCheck(uriBlocked func(context.Context, *url.URL) (bool, error)) func(*gin.Context) {
 	return func(c *gin.Context) {
 		ctx := c.Request.Context()
 
-		// Create the signature verifier from the request;
-		// this will error if the request wasn't signed.
-		verifier, err := httpsig.NewVerifier(c.Request)
+		// Let remotes know we
+		// understand RFC 9421.
+		c.Header(msgsig.AcceptSignatureHeader, msgsig.AcceptSignature)
+
+		var (
+			verifier  httpsig.VerifierWithOptions
+			signature string
+			err       error
+		)
+
+		if msgsig.IsSigned(c.Request.Header) {
+			// Request is signed using RFC 9421.
+			verifier, signature, err = rfc9421Verifier(c.Writer, c.Request)
+			if errors.As(err, new(*http.MaxBytesError)) {
+				log.Debugf(ctx, "message signed body too large: %s", err)
+				c.AbortWithStatus(http.StatusRequestEntityTooLarge)
+				return
+			} else if err != nil {
+				log.Debugf(ctx, "message signature was present but invalid: %s", err)
+				c.AbortWithStatus(http.StatusUnauthorized)
+				return
+			}
+		} else {
+			// Create the signature verifier from the request;
+			// this will error if the request wasn't signed.
+			verifier, err = httpsig.NewVerifier(c.Request)
+		}
+
 		if err != nil {
 			// Only actually *abort* the request with 401
 			// if a signature was present but malformed.
@@ -99,11 +133,13 @@ func SignatureCheck(uriBlocked func(context.Context, *url.URL) (bool, error)) fu
 			return
 		}
 
-		// Assume signature was set on Signature header,
-		// but fall back to Authorization header if necessary.
-		signature := c.GetHeader(sigHeader)
 		if signature == "" {
-			signature = c.GetHeader(authHeader)
+			// Assume signature was set on Signature header,
+			// but fall back to Authorization header if necessary.
+			signature = c.GetHeader(sigHeader)
+			if signature == "" {
+				signature = c.GetHeader(authHeader)
+			}
 		}
 
 		// Set relevant values on the request context
@@ -117,3 +153,26 @@ func SignatureCheck(uriBlocked func(context.Context, *url.URL) (bool, error)) fu
 		c.Request = c.Request.WithContext(ctx)
 	}
 }
+
+// rfc9421Verifier prepares an RFC 9421 message signature
+// verifier for the request, checking any Content-Digest
+// header against the request body as it goes, reading
+// at most ap.MaxInboxPostSize bytes of it. Returned
+// string is the raw Signature-Input header, for logging.
+func rfc9421Verifier(w http.ResponseWriter, r *http.Request) (httpsig.VerifierWithOptions, string, error) {
+	verifier, err := msgsig.NewVerifier(r, config.GetProtocol())
+	if err != nil {
+		return nil, "", err
+	}
+
+	if r.Body != nil {
+		// Bound body read when checking digest.
+		r.Body = http.MaxBytesReader(w, r.Body, ap.MaxInboxPostSize)
+	}
+
+	if err := verifier.VerifyContentDigest(r); err != nil {
+		return nil, "", err
+	}
+
+	return verifier, r.Header.Get(msgsig.SignatureInputHeader), nil
+}
@@ -0,0 +1,92 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/middleware"
+	"code.superseriousbusiness.org/gotosocial/internal/msgsig"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignatureCheckMessageSignatureBody(t *testing.T) {
+	config.SetProtocol("https")
+	defer config.SetProtocol("http")
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	notBlocked := func(context.Context, *url.URL) (bool, error) { return false, nil }
+
+	e := gin.New()
+	e.Use(middleware.SignatureCheck(notBlocked))
+	e.Handle(http.MethodPost, "/users/:username/inbox", func(c *gin.Context) {
+		// Body should still be readable in full.
+		b, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.Status(http.StatusInternalServerError)
+			return
+		}
+		c.String(http.StatusAccepted, "%d", len(b))
+	})
+
+	do := func(body []byte) *httptest.ResponseRecorder {
+		const target = "https://example.org/users/someone/inbox"
+
+		out, err := http.NewRequest(http.MethodPost, target, bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = msgsig.SignRequest(out, key, "https://example.com/users/me#main-key", body, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		in := httptest.NewRequest(http.MethodPost, target, bytes.NewReader(body))
+		in.Header = out.Header.Clone()
+
+		r := httptest.NewRecorder()
+		e.ServeHTTP(r, in)
+		return r
+	}
+
+	// Body within limit accepted.
+	r := do([]byte(`{"type":"Follow"}`))
+	assert.Equal(t, http.StatusAccepted, r.Code)
+	assert.Equal(t, "17", r.Body.String())
+
+	// Body over limit rejected before reading it all.
+	r = do(bytes.Repeat([]byte{' '}, int(ap.MaxInboxPostSize)+1))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, r.Code)
+}
@@ -0,0 +1,124 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package msgsig_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/msgsig"
+	"github.com/stretchr/testify/suite"
+)
+
+type MsgSigTestSuite struct {
+	suite.Suite
+	key *rsa.PrivateKey
+}
+
+func (suite *MsgSigTestSuite) SetupSuite() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.NoError(err)
+	suite.key = key
+}
+
+// signedRequest returns a signed outgoing request to an
+// inbox, converted to how it would be received by a server.
+func (suite *MsgSigTestSuite) signedRequest(body []byte, created time.Time) *http.Request {
+	const target = "https://example.org/users/someone/inbox?page=true"
+
+	out, err := http.NewRequest(http.MethodPost, target, bytes.NewReader(body))
+	suite.NoError(err)
+
+	err = msgsig.SignRequest(out, suite.key, "https://example.com/users/me#main-key", body, created)
+	suite.NoError(err)
+
+	in := httptest.NewRequest(http.MethodPost, target, bytes.NewReader(body))
+	in.URL.Scheme = ""
+	in.URL.Host = ""
+	in.Header = out.Header.Clone()
+	return in
+}
+
+func (suite *MsgSigTestSuite) TestSignVerify() {
+	body := []byte(`{"type":"Follow"}`)
+	r := suite.signedRequest(body, time.Now())
+	suite.True(msgsig.IsSigned(r.Header))
+
+	v, err := msgsig.NewVerifier(r, "https")
+	suite.NoError(err)
+	suite.Equal("https://example.com/users/me#main-key", v.KeyId())
+	suite.True(v.Covers("content-digest"))
+	suite.NoError(v.Verify(&suite.key.PublicKey, ""))
+	suite.NoError(v.VerifyContentDigest(r))
+
+	// Body should still be readable afterwards.
+	b, err := io.ReadAll(r.Body)
+	suite.NoError(err)
+	suite.Equal(body, b)
+
+	// Another key should not verify.
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.NoError(err)
+	suite.Error(v.Verify(&other.PublicKey, ""))
+}
+
+func (suite *MsgSigTestSuite) TestVerifyWrongScheme() {
+	r := suite.signedRequest([]byte(`{}`), time.Now())
+
+	// Target URI differs, so should not verify.
+	v, err := msgsig.NewVerifier(r, "http")
+	suite.NoError(err)
+	suite.Error(v.Verify(&suite.key.PublicKey, ""))
+}
+
+func (suite *MsgSigTestSuite) TestVerifyTamperedBody() {
+	r := suite.signedRequest([]byte(`{"type":"Follow"}`), time.Now())
+	r.Body = io.NopCloser(bytes.NewReader([]byte(`{"type":"Block"}`)))
+
+	v, err := msgsig.NewVerifier(r, "https")
+	suite.NoError(err)
+	suite.NoError(v.Verify(&suite.key.PublicKey, ""))
+	suite.Error(v.VerifyContentDigest(r))
+}
+
+func (suite *MsgSigTestSuite) TestVerifyTooOld() {
+	r := suite.signedRequest([]byte(`{}`), time.Now().Add(-24*time.Hour))
+
+	v, err := msgsig.NewVerifier(r, "https")
+	suite.NoError(err)
+	suite.ErrorContains(v.Verify(&suite.key.PublicKey, ""), "too old")
+}
+
+func (suite *MsgSigTestSuite) TestVerifyMissingComponents() {
+	r := httptest.NewRequest(http.MethodGet, "/users/someone", nil)
+	r.Header.Set(msgsig.SignatureInputHeader, `sig1=("@method");created=1700000000;keyid="https://example.com/users/me#main-key"`)
+	r.Header.Set(msgsig.SignatureHeader, `sig1=:aGVsbG8=:`)
+
+	_, err := msgsig.NewVerifier(r, "https")
+	suite.ErrorContains(err, "must cover @method and @target-uri")
+}
+
+func TestMsgSigTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgSigTestSuite))
+}
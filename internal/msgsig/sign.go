@@ -0,0 +1,76 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package msgsig
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SignRequest signs the given outgoing request with an RSA private key,
+// using rsa-v1_5-sha256 as is commonplace for ActivityPub actor keys.
+//
+// The signature covers the request method and target URI, and for
+// requests with a body, a Content-Digest header which is set here.
+func SignRequest(
+	r *http.Request,
+	key *rsa.PrivateKey,
+	keyID string,
+	body []byte,
+	now time.Time,
+) error {
+	components := []string{"@method", "@target-uri"}
+
+	if body != nil {
+		r.Header.Set(ContentDigestHeader, ContentDigest(body))
+		components = append(components, "content-digest")
+	}
+
+	// Serialize the signature params, which are
+	// both included in the base and sent as-is.
+	quoted := make([]string, len(components))
+	for i, c := range components {
+		quoted[i] = quoteString(c)
+	}
+	params := "(" + strings.Join(quoted, " ") + ")" +
+		";created=" + strconv.FormatInt(now.Unix(), 10) +
+		";keyid=" + quoteString(keyID) +
+		";alg=" + quoteString(AlgorithmRSAv15SHA256)
+
+	base, err := signatureBase(r, "https", components, params)
+	if err != nil {
+		return err
+	}
+
+	sum := sha256.Sum256(base)
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, sum[:])
+	if err != nil {
+		return err
+	}
+
+	r.Header.Set(SignatureInputHeader, Label+"="+params)
+	r.Header.Set(SignatureHeader, Label+"=:"+base64.StdEncoding.EncodeToString(sig)+":")
+	return nil
+}
@@ -0,0 +1,382 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package msgsig
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.superseriousbusiness.org/httpsig"
+)
+
+const (
+	// maxAge is the maximum age of a
+	// signature's created time we accept.
+	maxAge = 12 * time.Hour
+
+	// clockSkew is the leeway given for
+	// signatures created in the future.
+	clockSkew = time.Hour
+)
+
+// Verifier verifies an RFC 9421 signature on an incoming request.
+//
+// It fulfils httpsig.VerifierWithOptions so that it can be used in
+// place of a draft-cavage verifier, but note that algorithms and
+// options passed to it are ignored, as RFC 9421 signatures declare
+// their own algorithm and covered components.
+type Verifier struct {
+	keyID      string
+	alg        string
+	created    time.Time
+	expires    time.Time
+	components []string
+	base       []byte
+	sig        []byte
+}
+
+var _ httpsig.VerifierWithOptions = (*Verifier)(nil)
+
+// NewVerifier parses the RFC 9421 signature on the given incoming
+// request, which was received over scheme. If the request has more
+// than one signature, only the first is considered. An error is
+// returned if the signature is malformed, or doesn't cover at least
+// the method and target URI of the request.
+func NewVerifier(r *http.Request, scheme string) (*Verifier, error) {
+	inputs, labels, err := parseDictionary(strings.Join(r.Header.Values(SignatureInputHeader), ", "))
+	if err != nil {
+		return nil, err
+	}
+
+	sigs, _, err := parseDictionary(strings.Join(r.Header.Values(SignatureHeader), ", "))
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the first labelled
+	// signature with an input.
+	var label string
+	for _, l := range labels {
+		if _, ok := sigs[l]; ok {
+			label = l
+			break
+		}
+	}
+
+	if label == "" {
+		return nil, fmt.Errorf("%w: no signature matching input", errMalformed)
+	}
+
+	v := new(Verifier)
+
+	// Parse the signature itself, a byte sequence.
+	sig := sigs[label]
+	if len(sig) < 2 || sig[0] != ':' || sig[len(sig)-1] != ':' {
+		return nil, fmt.Errorf("%w: signature not a byte sequence", errMalformed)
+	}
+	v.sig, err = base64.StdEncoding.DecodeString(sig[1 : len(sig)-1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errMalformed, err)
+	}
+
+	// Parse the covered components and params.
+	input := inputs[label]
+	var params map[string]string
+	v.components, params, err = parseInnerList(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if !v.Covers("@method") || !v.Covers("@target-uri") {
+		return nil, errors.New("signature must cover @method and @target-uri")
+	}
+
+	if r.ContentLength != 0 && !v.Covers("content-digest") {
+		return nil, errors.New("signature must cover content-digest of request body")
+	}
+
+	v.keyID = params["keyid"]
+	if v.keyID == "" {
+		return nil, fmt.Errorf("%w: no keyid", errMalformed)
+	}
+
+	v.alg = params["alg"]
+
+	created, err := strconv.ParseInt(params["created"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid created", errMalformed)
+	}
+	v.created = time.Unix(created, 0)
+
+	if expires, ok := params["expires"]; ok {
+		e, err := strconv.ParseInt(expires, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid expires", errMalformed)
+		}
+		v.expires = time.Unix(e, 0)
+	}
+
+	// The signature params are included in
+	// the signature base exactly as received.
+	v.base, err = signatureBase(r, scheme, v.components, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// KeyId returns the key ID the signature was made with.
+func (v *Verifier) KeyId() string {
+	return v.keyID
+}
+
+// Covers returns whether the signature covers the named component.
+func (v *Verifier) Covers(component string) bool {
+	return slices.Contains(v.components, component)
+}
+
+// Verify verifies the signature with the given public key,
+// ignoring algo. See VerifyWithOptions.
+func (v *Verifier) Verify(pKey crypto.PublicKey, _ httpsig.Algorithm) error {
+	return v.VerifyWithOptions(pKey, "", httpsig.SignatureOption{})
+}
+
+// VerifyWithOptions verifies the signature with the given public key,
+// ignoring algo and opts. The algorithm used is that given in the
+// signature's alg param, or otherwise derived from the type of key.
+func (v *Verifier) VerifyWithOptions(pKey crypto.PublicKey, _ httpsig.Algorithm, _ httpsig.SignatureOption) error {
+	now := time.Now()
+	switch {
+	case v.created.After(now.Add(clockSkew)):
+		return errors.New("signature created in the future")
+	case v.created.Before(now.Add(-maxAge)):
+		return errors.New("signature too old")
+	case !v.expires.IsZero() && v.expires.Before(now):
+		return errors.New("signature expired")
+	}
+
+	switch key := pKey.(type) {
+	case *rsa.PublicKey:
+		switch v.alg {
+		case "", AlgorithmRSAv15SHA256:
+			sum := sha256.Sum256(v.base)
+			return rsa.VerifyPKCS1v15(key, crypto.SHA256, sum[:], v.sig)
+
+		case AlgorithmRSAPSSSHA512:
+			sum := sha512.Sum512(v.base)
+			return rsa.VerifyPSS(key, crypto.SHA512, sum[:], v.sig, nil)
+		}
+
+	case ed25519.PublicKey:
+		if v.alg == "" || v.alg == AlgorithmEd25519 {
+			if !ed25519.Verify(key, v.base, v.sig) {
+				return errors.New("ed25519 signature invalid")
+			}
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unsupported algorithm %q for key type %T", v.alg, pKey)
+}
+
+// VerifyContentDigest reads the body of the given request,
+// checking it against a covered Content-Digest header. The
+// body is replaced so that it can be read again afterwards.
+// Requests whose signature doesn't cover content-digest are
+// left untouched.
+func (v *Verifier) VerifyContentDigest(r *http.Request) error {
+	if !v.Covers("content-digest") {
+		return nil
+	}
+
+	var body []byte
+	if r.Body != nil {
+		var err error
+		body, err = io.ReadAll(r.Body)
+		_ = r.Body.Close()
+		if err != nil {
+			return fmt.Errorf("error reading request body: %w", err)
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	// Look for a sha-256 digest amongst those
+	// given (the only algorithm we support).
+	digests, _, err := parseDictionary(r.Header.Get(ContentDigestHeader))
+	if err != nil {
+		return err
+	}
+
+	digest, ok := digests["sha-256"]
+	if !ok {
+		return errors.New("no sha-256 content digest")
+	}
+
+	if digest != strings.TrimPrefix(ContentDigest(body), "sha-256=") {
+		return errors.New("content digest does not match body")
+	}
+
+	return nil
+}
+
+// parseDictionary splits a structured field dictionary into its
+// (unparsed) member values keyed by name, and the order of names.
+func parseDictionary(s string) (map[string]string, []string, error) {
+	members := make(map[string]string)
+	var order []string
+
+	for _, member := range splitOutside(s, ',') {
+		member = strings.TrimSpace(member)
+		if member == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(member, "=")
+		if !ok {
+			// Boolean member,
+			// not used by us.
+			continue
+		}
+
+		if _, ok := members[key]; !ok {
+			order = append(order, key)
+		}
+		members[key] = value
+	}
+
+	if len(members) == 0 {
+		return nil, nil, fmt.Errorf("%w: empty dictionary", errMalformed)
+	}
+
+	return members, order, nil
+}
+
+// parseInnerList parses a structured field inner list of
+// strings, followed by its params, as in Signature-Input.
+func parseInnerList(s string) ([]string, map[string]string, error) {
+	if !strings.HasPrefix(s, "(") {
+		return nil, nil, fmt.Errorf("%w: expected inner list", errMalformed)
+	}
+
+	// Find end of inner list,
+	// ignoring quoted parens.
+	parts := splitOutside(s[1:], ')')
+	if len(parts) < 2 {
+		return nil, nil, fmt.Errorf("%w: unterminated inner list", errMalformed)
+	}
+	list := parts[0]
+	rest := strings.Join(parts[1:], ")")
+
+	var items []string
+	for _, item := range splitOutside(list, ' ') {
+		if item == "" {
+			continue
+		}
+
+		if strings.Contains(item, ";") {
+			return nil, nil, errors.New("component parameters are not supported")
+		}
+
+		str, err := unquoteString(item)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		items = append(items, str)
+	}
+
+	params := make(map[string]string)
+	for _, param := range splitOutside(rest, ';') {
+		if param == "" {
+			continue
+		}
+
+		key, value, _ := strings.Cut(param, "=")
+		if strings.HasPrefix(value, `"`) {
+			var err error
+			value, err = unquoteString(value)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		params[key] = value
+	}
+
+	return items, params, nil
+}
+
+// splitOutside splits s on sep, ignoring
+// any occurrences within quoted strings.
+func splitOutside(s string, sep byte) []string {
+	var parts []string
+	var quoted, escaped bool
+	start := 0
+
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case escaped:
+			escaped = false
+		case quoted && c == '\\':
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+		case !quoted && c == sep:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(parts, s[start:])
+}
+
+// unquoteString parses a structured field string.
+func unquoteString(s string) (string, error) {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return "", fmt.Errorf("%w: expected string", errMalformed)
+	}
+
+	var b strings.Builder
+	for i := 1; i < len(s)-1; i++ {
+		c := s[i]
+		if c == '\\' {
+			i++
+			if i == len(s)-1 {
+				return "", fmt.Errorf("%w: bad escape", errMalformed)
+			}
+			c = s[i]
+		}
+		b.WriteByte(c)
+	}
+
+	return b.String(), nil
+}
@@ -22,14 +22,19 @@
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"code.superseriousbusiness.org/gotosocial/internal/cache/timeline"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/media"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"code.superseriousbusiness.org/gotosocial/internal/transport/delivery"
 
 	"github.com/gin-gonic/gin"
 	"go.opentelemetry.io/contrib/exporters/autoexport"
 	"go.opentelemetry.io/contrib/instrumentation/runtime"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	sdk "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/exemplar"
@@ -261,9 +266,169 @@ func InitializeMetrics(ctx context.Context, state *state.State) error {
 		return err
 	}
 
+	_, err = meter.Int64ObservableUpDownCounter(
+		"gotosocial.workers.delivery.backlog",
+		metric.WithDescription("Current number of failed deliveries awaiting retry"),
+		metric.WithInt64Callback(func(ctx context.Context, o metric.Int64Observer) error {
+			o.Observe(delivery.Metrics.Backlog.Load())
+			return nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.Int64ObservableCounter(
+		"gotosocial.federation.delivery.attempts",
+		metric.WithDescription("Total number of outgoing delivery attempts, by target domain and outcome"),
+		metric.WithInt64Callback(func(ctx context.Context, o metric.Int64Observer) error {
+			delivery.RangeDomains(func(domain string, m *delivery.DomainMetrics) {
+				o.Observe(m.Successes.Load(), metric.WithAttributes(
+					attribute.String("domain", domain),
+					attribute.String("outcome", "success"),
+				))
+				o.Observe(m.Failures.Load(), metric.WithAttributes(
+					attribute.String("domain", domain),
+					attribute.String("outcome", "failure"),
+				))
+				o.Observe(m.Skipped.Load(), metric.WithAttributes(
+					attribute.String("domain", domain),
+					attribute.String("outcome", "skipped"),
+				))
+			})
+			return nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.Int64ObservableCounter(
+		"gotosocial.timelines.cache.hits",
+		metric.WithDescription("Total number of timeline page loads served entirely from cache"),
+		metric.WithInt64Callback(func(ctx context.Context, o metric.Int64Observer) error {
+			o.Observe(timeline.Metrics.Hits.Load())
+			return nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.Int64ObservableCounter(
+		"gotosocial.timelines.cache.misses",
+		metric.WithDescription("Total number of timeline page loads that fell back to the database"),
+		metric.WithInt64Callback(func(ctx context.Context, o metric.Int64Observer) error {
+			o.Observe(timeline.Metrics.Misses.Load())
+			return nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.Int64ObservableCounter(
+		"gotosocial.media.recache.attempts",
+		metric.WithDescription("Total number of attempts to (re)cache remote media"),
+		metric.WithInt64Callback(func(ctx context.Context, o metric.Int64Observer) error {
+			o.Observe(media.RecacheMetrics.Attempts.Load())
+			return nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.Int64ObservableCounter(
+		"gotosocial.media.recache.failures",
+		metric.WithDescription("Total number of failed attempts to (re)cache remote media"),
+		metric.WithInt64Callback(func(ctx context.Context, o metric.Int64Observer) error {
+			o.Observe(media.RecacheMetrics.Failures.Load())
+			return nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.Int64ObservableCounter(
+		"gotosocial.media.recache.skipped",
+		metric.WithDescription("Total number of attempts to recache remote media skipped due to backoff after failure"),
+		metric.WithInt64Callback(func(ctx context.Context, o metric.Int64Observer) error {
+			o.Observe(media.RecacheMetrics.Skipped.Load())
+			return nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func MetricsMiddleware() gin.HandlerFunc {
 	return ginMiddleware()
 }
+
+// dereferenceDuration is created from the global meter
+// provider so that it may be used before InitializeMetrics,
+// recordings being delegated once the provider is set. If
+// metrics are disabled this remains a noop instrument.
+var dereferenceDuration, _ = otel.Meter(serviceName).Float64Histogram(
+	"gotosocial.federation.dereference.duration",
+	metric.WithDescription("Duration of remote ActivityPub dereferences, by kind and outcome"),
+	metric.WithUnit("s"),
+)
+
+// RecordDereference records the duration of a remote
+// dereference of given kind (eg., "account", "status")
+// started at given time, with outcome determined by err.
+func RecordDereference(ctx context.Context, kind string, start time.Time, err error) {
+	outcome := "success"
+	if err != nil {
+		outcome = "failure"
+	}
+	dereferenceDuration.Record(ctx,
+		time.Since(start).Seconds(),
+		metric.WithAttributes(
+			attribute.String("kind", kind),
+			attribute.String("outcome", outcome),
+		),
+	)
+}
+
+// threadLimits is created from the global meter
+// provider for the same reasons as dereferenceDuration.
+var threadLimits, _ = otel.Meter(serviceName).Int64Counter(
+	"gotosocial.federation.dereference.thread_limits",
+	metric.WithDescription("Total number of remote thread dereferences cut short by a configured limit, by limit (depth or budget)"),
+)
+
+// RecordThreadLimit records that dereferencing a remote
+// thread was cut short by given limit ("depth", "budget").
+func RecordThreadLimit(ctx context.Context, limit string) {
+	threadLimits.Add(ctx, 1,
+		metric.WithAttributes(
+			attribute.String("limit", limit),
+		),
+	)
+}
+
+// emojiFetches is created from the global meter
+// provider for the same reasons as dereferenceDuration.
+var emojiFetches, _ = otel.Meter(serviceName).Int64Counter(
+	"gotosocial.federation.dereference.emoji_fetches",
+	metric.WithDescription("Total number of emoji fetches requested while dereferencing remote statuses and accounts, by whether they were deduplicated against an identical in-flight fetch"),
+)
+
+// RecordEmojiFetch records a fetch of a remote emoji while
+// dereferencing, and whether it was deduplicated, ie., it
+// joined an identical fetch already in-flight. Together these
+// give the dedupe hit rate of concurrent emoji dereferences.
+func RecordEmojiFetch(ctx context.Context, deduplicated bool) {
+	emojiFetches.Add(ctx, 1,
+		metric.WithAttributes(
+			attribute.Bool("deduplicated", deduplicated),
+		),
+	)
+}
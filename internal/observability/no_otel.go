@@ -21,6 +21,7 @@
 
 import (
 	"context"
+	"time"
 
 	"code.superseriousbusiness.org/gotosocial/internal/state"
 	"github.com/gin-gonic/gin"
@@ -31,6 +32,12 @@ func InitializeMetrics(ctx context.Context, state *state.State) error {
 	return nil
 }
 
+func RecordDereference(ctx context.Context, kind string, start time.Time, err error) {}
+
+func RecordThreadLimit(ctx context.Context, limit string) {}
+
+func RecordEmojiFetch(ctx context.Context, deduplicated bool) {}
+
 func InitializeTracing(ctx context.Context) error {
 	return nil
 }
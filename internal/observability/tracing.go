@@ -33,6 +33,7 @@
 	"go.opentelemetry.io/contrib/exporters/autoexport"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/propagation"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
@@ -83,6 +84,40 @@ func InitializeTracing(ctx context.Context) error {
 		return kvs
 	})
 
+	// Carry spans across worker queues,
+	// so that async processing of eg. a
+	// status create appears in its trace.
+	tracer := tp.Tracer(
+		tracerName,
+		trace.WithInstrumentationVersion(config.GetSoftwareVersion()),
+	)
+	gtscontext.SetTracePropagator(
+		func(ctx context.Context) any {
+			sc := trace.SpanContextFromContext(ctx)
+			if !sc.IsValid() {
+				return nil
+			}
+			return sc
+		},
+		func(ctx context.Context, span any, name string) (context.Context, func(error)) {
+			sc, ok := span.(trace.SpanContext)
+			if !ok {
+				return ctx, func(error) {}
+			}
+			ctx = trace.ContextWithSpanContext(ctx, sc)
+			ctx, child := tracer.Start(ctx, name,
+				trace.WithSpanKind(trace.SpanKindConsumer),
+			)
+			return ctx, func(err error) {
+				if err != nil {
+					child.RecordError(err)
+					child.SetStatus(codes.Error, err.Error())
+				}
+				child.End()
+			}
+		},
+	)
+
 	return nil
 }
 
@@ -124,6 +124,26 @@ func MaxShortcodeDomain(max string) Boundary {
 	}
 }
 
+// MinShortcode returns a boundary with the given minimum emoji
+// shortcode, and the "min_shortcode" query key set.
+func MinShortcode(min string) Boundary {
+	return Boundary{
+		Name:  "min_shortcode",
+		Value: min,
+		Order: OrderAscending,
+	}
+}
+
+// MaxShortcode returns a boundary with the given maximum emoji
+// shortcode, and the "max_shortcode" query key set.
+func MaxShortcode(max string) Boundary {
+	return Boundary{
+		Name:  "max_shortcode",
+		Value: max,
+		Order: OrderDescending,
+	}
+}
+
 // Boundary represents the upper or lower limit in a page slice.
 type Boundary struct {
 	Name  string // i.e. query key
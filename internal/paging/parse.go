@@ -104,6 +104,36 @@ func ParseShortcodeDomainPage(c *gin.Context, min, max, _default int) (*Page, gt
 	}, nil
 }
 
+// ParseShortcodePage parses a local emoji shortcode Page from a request context, returning BadRequest
+// on error parsing. The min, max and default parameters define the page size limit minimum, maximum and default
+// value where a non-zero default will enforce paging for the endpoint on which this is called. While conversely,
+// a zero default limit will not enforce paging, returning a nil page value.
+func ParseShortcodePage(c *gin.Context, min, max, _default int) (*Page, gtserror.WithCode) {
+	// Extract request query parameters.
+	minShortcode, haveMin := c.GetQuery("min_shortcode")
+	maxShortcode, haveMax := c.GetQuery("max_shortcode")
+
+	// Extract request limit parameter.
+	limit, errWithCode := ParseLimit(c, min, max, _default)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if !haveMin &&
+		!haveMax &&
+		limit == 0 {
+		// No shortcode paging params provided, and no default
+		// limit value which indicates paging not enforced.
+		return nil, nil
+	}
+
+	return &Page{
+		Min:   MinShortcode(minShortcode),
+		Max:   MaxShortcode(maxShortcode),
+		Limit: limit,
+	}, nil
+}
+
 // ParseLimit parses the limit query parameter from a request context, returning BadRequest on error parsing and _default if zero limit given.
 func ParseLimit(c *gin.Context, min, max, _default int) (int, gtserror.WithCode) {
 	// Get limit query param.
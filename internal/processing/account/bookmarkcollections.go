@@ -0,0 +1,156 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+)
+
+// BookmarkCollectionsGet returns all bookmark
+// collections owned by the requesting account.
+func (p *Processor) BookmarkCollectionsGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+) ([]*apimodel.BookmarkCollection, gtserror.WithCode) {
+	collections, err := p.state.DB.GetBookmarkCollectionsByAccountID(ctx, requester.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting bookmark collections: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiCollections := make([]*apimodel.BookmarkCollection, 0, len(collections))
+	for _, collection := range collections {
+		apiCollection, errWithCode := p.apiBookmarkCollection(ctx, collection)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		apiCollections = append(apiCollections, apiCollection)
+	}
+
+	return apiCollections, nil
+}
+
+// BookmarkCollectionGet returns one bookmark collection
+// with the given ID, owned by the requesting account.
+func (p *Processor) BookmarkCollectionGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	collectionID string,
+) (*apimodel.BookmarkCollection, gtserror.WithCode) {
+	collection, errWithCode := p.c.GetOwnBookmarkCollection(ctx, requester, collectionID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiBookmarkCollection(ctx, collection)
+}
+
+// BookmarkCollectionCreate creates a new bookmark collection with the given
+// title for the requesting account. Title should already be validated.
+func (p *Processor) BookmarkCollectionCreate(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	title string,
+) (*apimodel.BookmarkCollection, gtserror.WithCode) {
+	collection := &gtsmodel.BookmarkCollection{
+		ID:        id.NewULID(),
+		Title:     title,
+		AccountID: requester.ID,
+		Account:   requester,
+	}
+
+	if err := p.state.DB.PutBookmarkCollection(ctx, collection); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err = errors.New("you already have a bookmark collection with this title")
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiBookmarkCollection(ctx, collection)
+}
+
+// BookmarkCollectionUpdate renames the bookmark collection with the given
+// ID, owned by the requesting account. Title should already be validated.
+func (p *Processor) BookmarkCollectionUpdate(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	collectionID string,
+	title string,
+) (*apimodel.BookmarkCollection, gtserror.WithCode) {
+	collection, errWithCode := p.c.GetOwnBookmarkCollection(ctx, requester, collectionID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if collection.Title == title {
+		// Nothing to change.
+		return p.apiBookmarkCollection(ctx, collection)
+	}
+
+	collection.Title = title
+	if err := p.state.DB.UpdateBookmarkCollection(ctx, collection, "title"); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err = errors.New("you already have a bookmark collection with this title")
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiBookmarkCollection(ctx, collection)
+}
+
+// BookmarkCollectionDelete deletes the bookmark collection with the given
+// ID, owned by the requesting account. Bookmarks filed in the collection
+// are not deleted, they're just no longer part of any collection.
+func (p *Processor) BookmarkCollectionDelete(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	collectionID string,
+) gtserror.WithCode {
+	collection, errWithCode := p.c.GetOwnBookmarkCollection(ctx, requester, collectionID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.state.DB.DeleteBookmarkCollectionByID(ctx, collection.ID); err != nil {
+		err := gtserror.Newf("db error deleting bookmark collection: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// apiBookmarkCollection is a shortcut to return the API version of the given
+// bookmark collection, or return an appropriate error if conversion fails.
+func (p *Processor) apiBookmarkCollection(ctx context.Context, collection *gtsmodel.BookmarkCollection) (*apimodel.BookmarkCollection, gtserror.WithCode) {
+	apiCollection, err := p.converter.BookmarkCollectionToAPIBookmarkCollection(ctx, collection)
+	if err != nil {
+		err := gtserror.Newf("error converting bookmark collection to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiCollection, nil
+}
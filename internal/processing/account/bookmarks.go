@@ -30,9 +30,25 @@
 )
 
 // BookmarksGet returns a pageable response of statuses that are bookmarked by requestingAccount.
-// Paging for this response is done based on bookmark ID rather than status ID.
-func (p *Processor) BookmarksGet(ctx context.Context, requestingAccount *gtsmodel.Account, limit int, maxID string, minID string) (*apimodel.PageableResponse, gtserror.WithCode) {
-	bookmarks, err := p.state.DB.GetStatusBookmarks(ctx, requestingAccount.ID, limit, maxID, minID)
+// Paging for this response is done based on bookmark ID rather than status ID. If collectionID
+// is set, only bookmarks filed in that collection (owned by requestingAccount) are returned.
+func (p *Processor) BookmarksGet(ctx context.Context, requestingAccount *gtsmodel.Account, collectionID string, limit int, maxID string, minID string) (*apimodel.PageableResponse, gtserror.WithCode) {
+	var extraQueryParams []string
+
+	if collectionID != "" {
+		// Ensure collection exists and is owned by requester.
+		if _, errWithCode := p.c.GetOwnBookmarkCollection(ctx,
+			requestingAccount,
+			collectionID,
+		); errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		// Keep collection in next / prev links.
+		extraQueryParams = []string{"collection_id=" + collectionID}
+	}
+
+	bookmarks, err := p.state.DB.GetStatusBookmarks(ctx, requestingAccount.ID, collectionID, limit, maxID, minID)
 	if err != nil && !errors.Is(err, db.ErrNoEntries) {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
@@ -84,10 +100,11 @@ func (p *Processor) BookmarksGet(ctx context.Context, requestingAccount *gtsmode
 	}
 
 	return util.PackagePageableResponse(util.PageableResponseParams{
-		Items:          items,
-		Path:           "/api/v1/bookmarks",
-		NextMaxIDValue: nextMaxIDValue,
-		PrevMinIDValue: prevMinIDValue,
-		Limit:          limit,
+		Items:            items,
+		Path:             "/api/v1/bookmarks",
+		NextMaxIDValue:   nextMaxIDValue,
+		PrevMinIDValue:   prevMinIDValue,
+		Limit:            limit,
+		ExtraQueryParams: extraQueryParams,
 	})
 }
@@ -153,6 +153,12 @@ func (p *Processor) deleteUserAndTokensForAccount(
 		log.Errorf("error deleting account web push subscriptions: %v", err)
 	}
 
+	// Expire any invites created by this account, so
+	// they can't be used to sign up after deletion.
+	if err := p.state.DB.ExpireInvitesByAccountID(ctx, account.ID); err != nil {
+		log.Errorf("error expiring account invites: %v", err)
+	}
+
 	// To prevent the user being created again,
 	// the user will be stubbed out to an unusable state
 	// with no identifying info remaining, but NOT deleted.
@@ -455,15 +461,40 @@ func (p *Processor) deleteAccountPeripheral(
 			log.Errorf("error deleting followed tags by account: %v", err)
 		}
 
+		// Delete all muted tags owned by given account, only for local.
+		if err := p.state.DB.DeleteMutedTagsByAccountID(ctx, account.ID); err != nil {
+			log.Errorf("error deleting muted tags by account: %v", err)
+		}
+
 		// Delete stats model stored for given account, only for local.
 		if err := p.state.DB.DeleteAccountStats(ctx, account.ID); err != nil {
 			log.Errorf("error deleting stats for account: %v", err)
 		}
 
+		// Delete all antennas owned by given account, only for local.
+		if err := p.state.DB.DeleteAntennasByAccountID(ctx, account.ID); err != nil {
+			log.Errorf("error deleting antennas for account: %v", err)
+		}
+
+		// Delete all bookmark collections owned by given account, only for local.
+		if err := p.state.DB.DeleteBookmarkCollectionsByAccountID(ctx, account.ID); err != nil {
+			log.Errorf("error deleting bookmark collections for account: %v", err)
+		}
+
 		// Delete statuses scheduled by given account, only for local.
 		if err := p.state.DB.DeleteScheduledStatusesByAccountID(ctx, account.ID); err != nil {
 			log.Errorf("error deleting scheduled statuses for account: %v", err)
 		}
+
+		// Delete drafts saved by given account, only for local.
+		if err := p.state.DB.DeleteDraftsByAccountID(ctx, account.ID); err != nil {
+			log.Errorf("error deleting drafts for account: %v", err)
+		}
+
+		// Delete status bulk deletes by given account, only for local.
+		if err := p.state.DB.DeleteStatusBulkDeletesByAccountID(ctx, account.ID); err != nil {
+			log.Errorf("error deleting status bulk deletes for account: %v", err)
+		}
 	}
 
 	// Delete all bookmarks targeting given account, local and remote.
@@ -478,6 +509,18 @@ func (p *Processor) deleteAccountPeripheral(
 		log.Errorf("error deleting faves targeting account: %v", err)
 	}
 
+	// Delete all emoji reactions targeting given account, local and remote.
+	if err := p.state.DB.DeleteStatusReactions(ctx, account.ID, ""); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		log.Errorf("error deleting reactions targeting account: %v", err)
+	}
+
+	// Delete all emoji reactions by given account, local and remote.
+	if err := p.state.DB.DeleteStatusReactions(ctx, "", account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		log.Errorf("error deleting reactions by account: %v", err)
+	}
+
 	// Delete all poll votes owned by given account, local and remote.
 	if err := p.state.DB.DeletePollVotesByAccountID(ctx, account.ID); // nocollapse
 	err != nil && !errors.Is(err, db.ErrNoEntries) {
@@ -489,6 +532,11 @@ func (p *Processor) deleteAccountPeripheral(
 	err != nil && !errors.Is(err, db.ErrNoEntries) {
 		log.Errorf("error deleting interaction requests by account: %v", err)
 	}
+
+	// Delete all follow suggestions for and of given account, local and remote.
+	if err := p.state.DB.DeleteSuggestionsByAccountID(ctx, account.ID); err != nil {
+		log.Errorf("error deleting suggestions for account: %v", err)
+	}
 }
 
 // processSideEffect will process the given side effect details,
@@ -561,6 +609,7 @@ func stubbifyAccount(account *gtsmodel.Account, origin string) []string {
 	account.Indexable = util.Ptr(false)
 	account.SuspendedAt = now
 	account.SuspensionOrigin = origin
+	account.OwnerAccountID = ""
 
 	return []string{
 		"fetched_at",
@@ -579,6 +628,7 @@ func stubbifyAccount(account *gtsmodel.Account, origin string) []string {
 		"discoverable",
 		"suspended_at",
 		"suspension_origin",
+		"owner_account_id",
 	}
 }
 
@@ -0,0 +1,130 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gopkg/xslices"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// FamiliarFollowersGet returns, for each of the given target account IDs,
+// the accounts followed by the requesting account which also follow that
+// target account. Unknown or invisible target accounts have no familiar
+// followers, and followers which hide their collections are excluded.
+func (p *Processor) FamiliarFollowersGet(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	targetAccountIDs []string,
+) ([]*apimodel.FamiliarFollowers, gtserror.WithCode) {
+	targetAccountIDs = xslices.Deduplicate(targetAccountIDs)
+	familiars := make([]*apimodel.FamiliarFollowers, 0, len(targetAccountIDs))
+
+	for _, targetAccountID := range targetAccountIDs {
+		accounts, errWithCode := p.familiarFollowers(ctx,
+			requestingAccount,
+			targetAccountID,
+		)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		familiars = append(familiars, &apimodel.FamiliarFollowers{
+			ID:       targetAccountID,
+			Accounts: accounts,
+		})
+	}
+
+	return familiars, nil
+}
+
+func (p *Processor) familiarFollowers(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	targetAccountID string,
+) ([]*apimodel.Account, gtserror.WithCode) {
+	if targetAccountID == requestingAccount.ID {
+		// Requester's own followers
+		// aren't familiar followers.
+		return []*apimodel.Account{}, nil
+	}
+
+	targetAccount, err := p.state.DB.GetAccountByID(ctx, targetAccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting account %s: %w", targetAccountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if targetAccount == nil {
+		// Unknown account.
+		return []*apimodel.Account{}, nil
+	}
+
+	visible, err := p.visFilter.AccountVisible(ctx, requestingAccount, targetAccount)
+	if err != nil {
+		err := gtserror.Newf("error checking visibility: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if !visible {
+		return []*apimodel.Account{}, nil
+	}
+
+	follows, err := p.state.DB.GetFamiliarFollowers(ctx, requestingAccount.ID, targetAccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting familiar followers: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Drop local followers that have hidden their
+	// collections, as listing them here would reveal
+	// who they follow.
+	follows = slices.DeleteFunc(follows, func(follow *gtsmodel.Follow) bool {
+		if !follow.Account.IsLocal() {
+			return false
+		}
+
+		settings, err := p.state.DB.GetAccountSettings(ctx, follow.AccountID)
+		if err != nil {
+			log.Errorf(ctx, "error getting settings for account %s: %v", follow.AccountID, err)
+			return true
+		}
+
+		return util.PtrOrZero(settings.HideCollections)
+	})
+
+	// Func to fetch follow source at index.
+	getIdx := func(i int) *gtsmodel.Account {
+		return follows[i].Account
+	}
+
+	// Get a filtered slice of public API account models.
+	return p.c.GetVisibleAPIAccounts(ctx,
+		requestingAccount,
+		getIdx,
+		len(follows),
+	), nil
+}
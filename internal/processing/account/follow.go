@@ -119,7 +119,7 @@ func(columns ...string) error { return p.state.DB.UpdateFollowRequest(ctx, follo
 	}
 
 	// Handle side effects async.
-	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+	p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 		APObjectType:   ap.ActivityFollow,
 		APActivityType: ap.ActivityCreate,
 		GTSModel:       fr,
@@ -144,7 +144,7 @@ func (p *Processor) FollowRemove(ctx context.Context, requestingAccount *gtsmode
 	}
 
 	// Batch queue accreted client api messages.
-	p.state.Workers.Client.Queue.Push(msgs...)
+	p.state.Workers.Client.Push(ctx, msgs...)
 
 	return p.RelationshipGet(ctx, requestingAccount, targetAccountID)
 }
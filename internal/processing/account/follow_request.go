@@ -40,7 +40,7 @@ func (p *Processor) FollowRequestAccept(ctx context.Context, requestingAccount *
 	if follow.Account != nil {
 		// Only enqueue work in the case we have a request creating account stored.
 		// NOTE: due to how AcceptFollowRequest works, the inverse shouldn't be possible.
-		p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 			APObjectType:   ap.ActivityFollow,
 			APActivityType: ap.ActivityAccept,
 			GTSModel:       follow,
@@ -67,7 +67,7 @@ func (p *Processor) FollowRequestReject(ctx context.Context, requestingAccount *
 	if followRequest.Account != nil {
 		// Only enqueue work in the case we have a request creating account stored.
 		// NOTE: due to how GetFollowRequest works, the inverse shouldn't be possible.
-		p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 			APObjectType:   ap.ActivityFollow,
 			APActivityType: ap.ActivityReject,
 			GTSModel:       followRequest,
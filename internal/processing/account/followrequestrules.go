@@ -0,0 +1,153 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// FollowRequestRulesMaxDomains is the maximum
+// number of domains that can be set for
+// automatic approval of follow requests.
+const FollowRequestRulesMaxDomains = 100
+
+// FollowRequestRulesGet returns the follow
+// request rules set by the requesting account.
+func (p *Processor) FollowRequestRulesGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+) (*apimodel.FollowRequestRules, gtserror.WithCode) {
+	// Ensure account settings populated.
+	if err := p.populateAccountSettings(ctx, requester); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	rules := requester.Settings.FollowRequestRules
+	if rules == nil {
+		// No rules set,
+		// use zero value.
+		rules = new(gtsmodel.FollowRequestRules)
+	}
+
+	// Always return an array
+	// for approve domains, even
+	// if it's empty.
+	domains := rules.ApproveDomains
+	if domains == nil {
+		domains = []string{}
+	}
+
+	return &apimodel.FollowRequestRules{
+		ApproveFollowersOfFollowers: rules.ApproveFollowersOfFollowers,
+		ApproveDomains:              domains,
+		RejectLimited:               rules.RejectLimited,
+	}, nil
+}
+
+// FollowRequestRulesUpdate updates the follow request
+// rules set by the requesting account with any fields
+// set in the given form, returning the new rules.
+func (p *Processor) FollowRequestRulesUpdate(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	form *apimodel.UpdateFollowRequestRulesRequest,
+) (*apimodel.FollowRequestRules, gtserror.WithCode) {
+	// Lock on this account as we're modifying its Settings.
+	unlock := p.state.ProcessingLocks.Lock(requester.URI)
+	defer unlock()
+
+	// Ensure account settings populated.
+	if err := p.populateAccountSettings(ctx, requester); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Take a copy of any existing rules
+	// so we don't modify cached settings.
+	rules := new(gtsmodel.FollowRequestRules)
+	if existing := requester.Settings.FollowRequestRules; existing != nil {
+		*rules = *existing
+	}
+
+	if form.ApproveFollowersOfFollowers != nil {
+		rules.ApproveFollowersOfFollowers = *form.ApproveFollowersOfFollowers
+	}
+
+	if form.RejectLimited != nil {
+		rules.RejectLimited = *form.RejectLimited
+	}
+
+	if form.ApproveDomains != nil {
+		domains, errWithCode := parseApproveDomains(*form.ApproveDomains)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		rules.ApproveDomains = domains
+	}
+
+	if !rules.ApproveFollowersOfFollowers &&
+		len(rules.ApproveDomains) == 0 &&
+		!rules.RejectLimited {
+		// No rules left,
+		// just unset.
+		rules = nil
+	}
+
+	requester.Settings.FollowRequestRules = rules
+	if err := p.state.DB.UpdateAccountSettings(ctx,
+		requester.Settings,
+		"follow_request_rules",
+	); err != nil {
+		err := gtserror.Newf("db error updating settings: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.FollowRequestRulesGet(ctx, requester)
+}
+
+// parseApproveDomains validates and punifies the given
+// domains for automatic follow request approval, returning
+// a deduplicated slice, or a 422 error if any are invalid.
+func parseApproveDomains(in []string) ([]string, gtserror.WithCode) {
+	if len(in) > FollowRequestRulesMaxDomains {
+		const text = "too many domains"
+		err := fmt.Errorf("%s, max %d", text, FollowRequestRulesMaxDomains)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	domains := make([]string, 0, len(in))
+	for _, domain := range in {
+		punified, err := util.PunifySafely(domain)
+		if err != nil || punified == "" {
+			err := fmt.Errorf("invalid domain %q", domain)
+			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+
+		if !slices.Contains(domains, punified) {
+			domains = append(domains, punified)
+		}
+	}
+
+	return domains, nil
+}
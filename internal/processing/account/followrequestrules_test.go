@@ -0,0 +1,107 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"net/http"
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type FollowRequestRulesTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *FollowRequestRulesTestSuite) TestFollowRequestRulesUpdate() {
+	var (
+		ctx       = suite.T().Context()
+		requester = suite.testAccounts["local_account_2"]
+	)
+
+	// No rules set to begin with.
+	rules, errWithCode := suite.accountProcessor.FollowRequestRulesGet(ctx, requester)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.False(rules.ApproveFollowersOfFollowers)
+	suite.Empty(rules.ApproveDomains)
+	suite.False(rules.RejectLimited)
+
+	// Set some rules, including a duplicate
+	// and non-normalized approve domain.
+	rules, errWithCode = suite.accountProcessor.FollowRequestRulesUpdate(ctx,
+		requester,
+		&apimodel.UpdateFollowRequestRulesRequest{
+			ApproveFollowersOfFollowers: util.Ptr(true),
+			ApproveDomains:              &[]string{"Example.org", "example.org", "fossbros-anonymous.io"},
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.True(rules.ApproveFollowersOfFollowers)
+	suite.Equal([]string{"example.org", "fossbros-anonymous.io"}, rules.ApproveDomains)
+	suite.False(rules.RejectLimited)
+
+	// Update only one rule, others
+	// should be left as they were.
+	rules, errWithCode = suite.accountProcessor.FollowRequestRulesUpdate(ctx,
+		requester,
+		&apimodel.UpdateFollowRequestRulesRequest{
+			RejectLimited: util.Ptr(true),
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.True(rules.ApproveFollowersOfFollowers)
+	suite.Equal([]string{"example.org", "fossbros-anonymous.io"}, rules.ApproveDomains)
+	suite.True(rules.RejectLimited)
+
+	// Rules should be stored in the db.
+	settings, err := suite.state.DB.GetAccountSettings(ctx, requester.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotNil(settings.FollowRequestRules)
+	suite.True(settings.FollowRequestRules.ApprovesDomain("social.example.org"))
+	suite.False(settings.FollowRequestRules.ApprovesDomain("notexample.org"))
+}
+
+func (suite *FollowRequestRulesTestSuite) TestFollowRequestRulesUpdateInvalidDomain() {
+	var (
+		ctx       = suite.T().Context()
+		requester = suite.testAccounts["local_account_2"]
+	)
+
+	_, errWithCode := suite.accountProcessor.FollowRequestRulesUpdate(ctx,
+		requester,
+		&apimodel.UpdateFollowRequestRulesRequest{
+			ApproveDomains: &[]string{"not a domain!"},
+		},
+	)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+}
+
+func TestFollowRequestRulesTestSuite(t *testing.T) {
+	suite.Run(t, new(FollowRequestRulesTestSuite))
+}
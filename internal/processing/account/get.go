@@ -21,13 +21,16 @@
 	"context"
 	"errors"
 	"net/url"
+	"strings"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/text"
 )
 
 // Get processes the given request for account information.
@@ -122,8 +125,12 @@ func (p *Processor) GetWeb(ctx context.Context, username string) (*apimodel.WebA
 }
 
 // GetCustomCSSForUsername returns custom css for the given local username.
+//
+// This includes the account's accent color (if set) as CSS variables,
+// followed by the account's custom CSS (if allowed on this instance),
+// sanitized according to the instance's custom CSS settings.
 func (p *Processor) GetCustomCSSForUsername(ctx context.Context, username string) (string, gtserror.WithCode) {
-	customCSS, err := p.state.DB.GetAccountCustomCSSByUsername(ctx, username)
+	account, err := p.state.DB.GetAccountByUsernameDomain(ctx, username, "")
 	if err != nil {
 		if errors.Is(err, db.ErrNoEntries) {
 			return "", gtserror.NewErrorNotFound(gtserror.New("account not found"))
@@ -131,5 +138,34 @@ func (p *Processor) GetCustomCSSForUsername(ctx context.Context, username string
 		return "", gtserror.NewErrorInternalError(gtserror.Newf("db error: %w", err))
 	}
 
-	return customCSS, nil
+	// Ensure settings populated, in case
+	// barebones context was passed.
+	if account.Settings == nil {
+		account.Settings, err = p.state.DB.GetAccountSettings(ctx, account.ID)
+		if err != nil {
+			return "", gtserror.NewErrorInternalError(gtserror.Newf("db error: %w", err))
+		}
+	}
+
+	var buf strings.Builder
+
+	if accentColor := account.Settings.AccentColor; accentColor != "" {
+		// Expose accent color as a variable for
+		// custom CSS to use, and apply it to the
+		// (non-text) accent used by the themes.
+		buf.WriteString(":root {\n")
+		buf.WriteString("\t--profile-accent: " + accentColor + ";\n")
+		buf.WriteString("\t--border-accent: var(--profile-accent);\n")
+		buf.WriteString("}\n")
+	}
+
+	if config.GetAccountsAllowCustomCSS() {
+		buf.WriteString(text.SanitizeCSS(
+			account.Settings.CustomCSS,
+			config.GetAccountsCustomCSSStrict(),
+			config.GetAccountsCustomCSSAllowedProperties(),
+		))
+	}
+
+	return buf.String(), nil
 }
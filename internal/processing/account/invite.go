@@ -0,0 +1,159 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"time"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+)
+
+// InvitesGet returns a page of invites created by the given user.
+func (p *Processor) InvitesGet(
+	ctx context.Context,
+	user *gtsmodel.User,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	invites, err := p.state.DB.GetInvites(ctx, user.AccountID, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting invites: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(invites)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	var (
+		// Get the lowest and highest
+		// ID values, used for paging.
+		lo = invites[count-1].ID
+		hi = invites[0].ID
+
+		// Best-guess items length.
+		items = make([]interface{}, 0, count)
+	)
+
+	for _, invite := range invites {
+		items = append(items, p.converter.InviteToAPIInvite(invite))
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/invites",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
+}
+
+// InviteCreate creates a new invite for the given user,
+// provided they're permitted to create invites.
+func (p *Processor) InviteCreate(
+	ctx context.Context,
+	user *gtsmodel.User,
+	form *apimodel.InviteCreateRequest,
+) (*apimodel.Invite, gtserror.WithCode) {
+	// Admins and moderators can always create
+	// invites, other users only if enabled.
+	if !*user.Admin && !*user.Moderator &&
+		!config.GetAccountsAllowUserInvites() {
+		const text = "invites can only be created by admins and moderators on this instance"
+		return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	now := time.Now()
+	invite := &gtsmodel.Invite{
+		ID:                 id.NewULID(),
+		CreatedAt:          now,
+		UpdatedAt:          now,
+		Code:               rand.Text(),
+		CreatedByAccountID: user.AccountID,
+		CreatedByAccount:   user.Account,
+	}
+
+	if form.MaxUses != nil {
+		if *form.MaxUses <= 0 {
+			const text = "max_uses must be a positive number"
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+		invite.MaxUses = *form.MaxUses
+	}
+
+	if form.ExpiresIn != nil {
+		if *form.ExpiresIn <= 0 {
+			const text = "expires_in must be a positive number of seconds"
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+		invite.ExpiresAt = now.Add(time.Duration(*form.ExpiresIn) * time.Second)
+	}
+
+	if err := p.state.DB.PutInvite(ctx, invite); err != nil {
+		err := gtserror.Newf("db error inserting invite: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.InviteToAPIInvite(invite), nil
+}
+
+// InviteRevoke revokes the invite with the given ID, so
+// that it can no longer be used to sign up. Users can
+// revoke their own invites, admins can revoke any invite.
+func (p *Processor) InviteRevoke(
+	ctx context.Context,
+	user *gtsmodel.User,
+	inviteID string,
+) (*apimodel.Invite, gtserror.WithCode) {
+	invite, err := p.state.DB.GetInviteByID(ctx, inviteID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting invite %s: %w", inviteID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if invite == nil ||
+		(invite.CreatedByAccountID != user.AccountID && !*user.Admin) {
+		// Don't reveal existence of
+		// other users' invites.
+		err := gtserror.Newf("invite %s not found", inviteID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	now := time.Now()
+	if invite.Expired(now) {
+		// Already unusable,
+		// nothing to do.
+		return p.converter.InviteToAPIInvite(invite), nil
+	}
+
+	invite.ExpiresAt = now
+	if err := p.state.DB.UpdateInvite(ctx, invite, "expires_at"); err != nil {
+		err := gtserror.Newf("db error updating invite %s: %w", inviteID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.InviteToAPIInvite(invite), nil
+}
@@ -0,0 +1,105 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"net/http"
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type InviteTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *InviteTestSuite) TestInviteCreateAndRevoke() {
+	var (
+		ctx  = suite.T().Context()
+		user = suite.testUsers["local_account_1"]
+	)
+
+	invite, errWithCode := suite.accountProcessor.InviteCreate(ctx,
+		user,
+		&apimodel.InviteCreateRequest{
+			MaxUses:   util.Ptr(5),
+			ExpiresIn: util.Ptr(86400),
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.NotEmpty(invite.Code)
+	suite.Equal("http://localhost:8080/signup?invite="+invite.Code, invite.URL)
+	suite.Equal(user.AccountID, invite.CreatedBy)
+	suite.Equal(util.Ptr(5), invite.MaxUses)
+	suite.NotNil(invite.ExpiresAt)
+	suite.True(invite.Usable)
+
+	// Invite should be listed for the user.
+	resp, errWithCode := suite.accountProcessor.InvitesGet(ctx, user, nil)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(resp.Items, 1)
+
+	// Another (non-admin) user can't revoke it.
+	_, errWithCode = suite.accountProcessor.InviteRevoke(ctx,
+		suite.testUsers["local_account_2"],
+		invite.ID,
+	)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// But its creator can.
+	invite, errWithCode = suite.accountProcessor.InviteRevoke(ctx, user, invite.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.False(invite.Usable)
+}
+
+func (suite *InviteTestSuite) TestInviteCreateNotAllowed() {
+	ctx := suite.T().Context()
+
+	config.SetAccountsAllowUserInvites(false)
+	defer config.SetAccountsAllowUserInvites(true)
+
+	// Regular users can't create invites.
+	_, errWithCode := suite.accountProcessor.InviteCreate(ctx,
+		suite.testUsers["local_account_1"],
+		&apimodel.InviteCreateRequest{},
+	)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	// But admins still can.
+	if _, errWithCode := suite.accountProcessor.InviteCreate(ctx,
+		suite.testUsers["admin_account"],
+		&apimodel.InviteCreateRequest{},
+	); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+}
+
+func TestInviteTestSuite(t *testing.T) {
+	suite.Run(t, new(InviteTestSuite))
+}
@@ -333,7 +333,7 @@ func (p *Processor) MoveSelf(
 	}
 
 	// Everything seems OK, process Move side effects async.
-	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+	p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 		APObjectType:   ap.ActorPerson,
 		APActivityType: ap.ActivityMove,
 		GTSModel:       move,
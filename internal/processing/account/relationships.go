@@ -165,3 +165,96 @@ func (p *Processor) RelationshipGet(ctx context.Context, requestingAccount *gtsm
 
 	return r, nil
 }
+
+// WebFollowersGet fetches a page of the target local account's
+// followers, for serving via the unauthenticated web view.
+//
+// If the target account hides its collections, or is
+// not a local account, then ErrorNotFound is returned.
+func (p *Processor) WebFollowersGet(ctx context.Context, targetAccountID string, page *paging.Page) (*apimodel.PageableResponse, gtserror.WithCode) {
+	return p.webRelationshipsGet(ctx, targetAccountID, page, true)
+}
+
+// WebFollowingGet fetches a page of the accounts that the target
+// local account follows, for serving via the unauthenticated web view.
+//
+// If the target account hides its collections, or is
+// not a local account, then ErrorNotFound is returned.
+func (p *Processor) WebFollowingGet(ctx context.Context, targetAccountID string, page *paging.Page) (*apimodel.PageableResponse, gtserror.WithCode) {
+	return p.webRelationshipsGet(ctx, targetAccountID, page, false)
+}
+
+func (p *Processor) webRelationshipsGet(
+	ctx context.Context,
+	targetAccountID string,
+	page *paging.Page,
+	followers bool,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	targetAccount, err := p.state.DB.GetAccountByID(ctx, targetAccountID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("account %s not found", targetAccountID)
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		err := gtserror.Newf("db error getting account %s: %w", targetAccountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Only local, non-instance accounts that don't
+	// hide their collections have these web pages.
+	if !targetAccount.IsLocal() ||
+		targetAccount.IsInstance() ||
+		*targetAccount.Settings.HideCollections {
+		err := gtserror.Newf("account %s collections not visible via the web", targetAccountID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	var (
+		follows []*gtsmodel.Follow
+		getIdx  func(int) *gtsmodel.Account
+		path    = "/@" + targetAccount.Username
+	)
+
+	if followers {
+		// Fetch known accounts that follow the target account.
+		follows, err = p.state.DB.GetAccountFollowers(ctx, targetAccountID, page)
+		getIdx = func(i int) *gtsmodel.Account { return follows[i].Account }
+		path += "/followers"
+	} else {
+		// Fetch known accounts that the target account follows.
+		follows, err = p.state.DB.GetAccountFollows(ctx, targetAccountID, page)
+		getIdx = func(i int) *gtsmodel.Account { return follows[i].TargetAccount }
+		path += "/following"
+	}
+
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting follows: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Check for empty response.
+	count := len(follows)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := follows[count-1].ID
+	hi := follows[0].ID
+
+	// Get a filtered slice of public API account
+	// models, as visible to an unauthed requester.
+	items := p.c.GetVisibleAPIAccountsPaged(ctx,
+		nil,
+		getIdx,
+		count,
+	)
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  path,
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
+}
@@ -0,0 +1,363 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/xslices"
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
+	"code.superseriousbusiness.org/gotosocial/internal/uris"
+)
+
+// BulkRelationshipsMax is the maximum number of target
+// accounts that may be given in one bulk relationship request.
+const BulkRelationshipsMax = 40
+
+// BulkUnfollow removes follows and follow requests from
+// requester to each of the given target accounts, and
+// returns the requester's new relationship with each target.
+//
+// If any target account is invalid, no changes are made.
+func (p *Processor) BulkUnfollow(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetAccountIDs []string,
+) ([]*apimodel.Relationship, gtserror.WithCode) {
+	targets, errWithCode := p.getBulkTargets(ctx, requester, targetAccountIDs)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Unfollow all targets and deal with side effects.
+	msgs, err := p.unfollowMany(ctx, requester, targets, false)
+	if err != nil {
+		err := gtserror.Newf("error unfollowing: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Batch queue accreted client api messages.
+	p.state.Workers.Client.Push(ctx, msgs...)
+
+	return p.bulkRelationships(ctx, requester, targets)
+}
+
+// BulkBlock blocks each of the given target accounts
+// from requester, and returns the requester's new
+// relationship with each target. As with BlockCreate,
+// follows in either direction are removed.
+//
+// If any target account is invalid, no changes are made.
+func (p *Processor) BulkBlock(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetAccountIDs []string,
+) ([]*apimodel.Relationship, gtserror.WithCode) {
+	targets, errWithCode := p.getBulkTargets(ctx, requester, targetAccountIDs)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	var (
+		blocks  = make([]*gtsmodel.Block, 0, len(targets))
+		blocked = make([]*gtsmodel.Account, 0, len(targets))
+	)
+
+	for _, target := range targets {
+		existing, err := p.state.DB.GetBlock(ctx, requester.ID, target.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error checking existing block: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if existing != nil {
+			// Block already
+			// exists, skip.
+			continue
+		}
+
+		blockID := id.NewULID()
+		blocks = append(blocks, &gtsmodel.Block{
+			ID:              blockID,
+			URI:             uris.GenerateURIForBlock(requester.Username, blockID),
+			AccountID:       requester.ID,
+			Account:         requester,
+			TargetAccountID: target.ID,
+			TargetAccount:   target,
+		})
+		blocked = append(blocked, target)
+	}
+
+	if len(blocks) == 0 {
+		// Nothing to do.
+		return p.bulkRelationships(ctx, requester, targets)
+	}
+
+	// Store all new blocks.
+	if err := p.state.DB.PutBlocks(ctx, blocks...); err != nil {
+		err := gtserror.Newf("db error creating blocks: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Ensure each newly blocked account and requester
+	// unfollow one another. As in BlockCreate, we only
+	// process unfollow side effects from requester.
+	msgs, err := p.unfollowMany(ctx, requester, blocked, true)
+	if err != nil {
+		err := gtserror.Newf("error unfollowing: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Process block side effects (federation etc).
+	for _, block := range blocks {
+		msgs = append(msgs, &messages.FromClientAPI{
+			APObjectType:   ap.ActivityBlock,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       block,
+			Origin:         requester,
+			Target:         block.TargetAccount,
+		})
+	}
+
+	// Batch queue accreted client api messages.
+	p.state.Workers.Client.Push(ctx, msgs...)
+
+	return p.bulkRelationships(ctx, requester, targets)
+}
+
+// BulkMute creates or updates mutes from requester on each
+// of the given target accounts, using the parameters in the
+// given (normalized) form, and returns the requester's new
+// relationship with each target.
+//
+// If any target account is invalid, no changes are made.
+func (p *Processor) BulkMute(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetAccountIDs []string,
+	form *apimodel.UserMuteCreateUpdateRequest,
+) ([]*apimodel.Relationship, gtserror.WithCode) {
+	targets, errWithCode := p.getBulkTargets(ctx, requester, targetAccountIDs)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	var expiresAt time.Time
+	if form.Duration != nil {
+		expiresAt = time.Now().Add(time.Second * time.Duration(*form.Duration))
+	}
+
+	mutes := make([]*gtsmodel.UserMute, 0, len(targets))
+	for _, target := range targets {
+		existing, err := p.state.DB.GetMute(ctx, requester.ID, target.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error checking existing mute: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		// Create a new mute or update an existing one.
+		mute := &gtsmodel.UserMute{
+			AccountID:       requester.ID,
+			Account:         requester,
+			TargetAccountID: target.ID,
+			TargetAccount:   target,
+			Notifications:   form.Notifications,
+			ExpiresAt:       expiresAt,
+		}
+		if existing != nil {
+			mute.ID = existing.ID
+		} else {
+			mute.ID = id.NewULID()
+		}
+
+		mutes = append(mutes, mute)
+	}
+
+	// Store all new / updated mutes.
+	if err := p.state.DB.PutMutes(ctx, mutes...); err != nil {
+		err := gtserror.Newf("db error creating or updating mutes: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.bulkRelationships(ctx, requester, targets)
+}
+
+// getBulkTargets fetches the deduplicated target accounts
+// with given IDs for a bulk relationship request, checking
+// that the number of targets is within limits, that none of
+// the targets is the requester, and that all targets exist.
+func (p *Processor) getBulkTargets(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetAccountIDs []string,
+) ([]*gtsmodel.Account, gtserror.WithCode) {
+	targetAccountIDs = xslices.Deduplicate(targetAccountIDs)
+
+	switch l := len(targetAccountIDs); {
+	case l == 0:
+		const text = "no account id(s) specified"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+
+	case l > BulkRelationshipsMax:
+		text := fmt.Sprintf("too many account ids specified, maximum is %d", BulkRelationshipsMax)
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	for _, targetAccountID := range targetAccountIDs {
+		if targetAccountID == requester.ID {
+			const text = "account cannot target itself"
+			return nil, gtserror.NewErrorNotAcceptable(errors.New(text), text)
+		}
+	}
+
+	targets, err := p.state.DB.GetAccountsByIDs(ctx, targetAccountIDs)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting target accounts: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if len(targets) != len(targetAccountIDs) {
+		// One or more accounts not found,
+		// reject the whole batch so that
+		// nothing is partially applied.
+		const text = "one or more target accounts not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	return targets, nil
+}
+
+// unfollowMany is like unfollow, but gathers and removes follows
+// and follow requests from requester to each of the given targets
+// in one go. If both is true, follows and follow requests from
+// each target to requester will be removed too, though messages
+// will only be returned for requester -> target removals.
+func (p *Processor) unfollowMany(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targets []*gtsmodel.Account,
+	both bool,
+) ([]*messages.FromClientAPI, error) {
+	var (
+		msgs         []*messages.FromClientAPI
+		followIDs    []string
+		followReqIDs []string
+	)
+
+	// gather appends any follow / follow request from
+	// origin to target to the slices of IDs to delete,
+	// returning messages for side effects if wanted.
+	gather := func(origin, target *gtsmodel.Account, wantMsgs bool) error {
+		follow, err := p.state.DB.GetFollow(ctx, origin.ID, target.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("error getting follow from %s targeting %s: %w", origin.ID, target.ID, err)
+		}
+
+		if follow != nil {
+			followIDs = append(followIDs, follow.ID)
+			if wantMsgs {
+				msgs = append(msgs, &messages.FromClientAPI{
+					APObjectType:   ap.ActivityFollow,
+					APActivityType: ap.ActivityUndo,
+					GTSModel:       follow,
+					Origin:         origin,
+					Target:         target,
+				})
+			}
+		}
+
+		followReq, err := p.state.DB.GetFollowRequest(ctx, origin.ID, target.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("error getting follow request from %s targeting %s: %w", origin.ID, target.ID, err)
+		}
+
+		if followReq != nil {
+			followReqIDs = append(followReqIDs, followReq.ID)
+			if wantMsgs {
+				msgs = append(msgs, &messages.FromClientAPI{
+					APObjectType:   ap.ActivityFollow,
+					APActivityType: ap.ActivityUndo,
+					// Dummy out a follow to undo,
+					// based on the follow request.
+					GTSModel: &gtsmodel.Follow{
+						AccountID:       origin.ID,
+						Account:         origin,
+						TargetAccountID: target.ID,
+						TargetAccount:   target,
+						URI:             followReq.URI,
+					},
+					Origin: origin,
+					Target: target,
+				})
+			}
+		}
+
+		return nil
+	}
+
+	for _, target := range targets {
+		if err := gather(requester, target, true); err != nil {
+			return nil, err
+		}
+
+		if both {
+			if err := gather(target, requester, false); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Delete all gathered follows.
+	if err := p.state.DB.DeleteFollowsByIDs(ctx, followIDs...); err != nil {
+		return nil, gtserror.Newf("error deleting follows: %w", err)
+	}
+
+	// Delete all gathered follow requests.
+	if err := p.state.DB.DeleteFollowRequestsByIDs(ctx, followReqIDs...); err != nil {
+		return nil, gtserror.Newf("error deleting follow requests: %w", err)
+	}
+
+	return msgs, nil
+}
+
+// bulkRelationships returns the relationship
+// of requester with each of the given targets.
+func (p *Processor) bulkRelationships(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targets []*gtsmodel.Account,
+) ([]*apimodel.Relationship, gtserror.WithCode) {
+	relationships := make([]*apimodel.Relationship, 0, len(targets))
+	for _, target := range targets {
+		relationship, errWithCode := p.RelationshipGet(ctx, requester, target.ID)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		relationships = append(relationships, relationship)
+	}
+	return relationships, nil
+}
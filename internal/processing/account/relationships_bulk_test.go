@@ -0,0 +1,179 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"net/http"
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type RelationshipsBulkTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *RelationshipsBulkTestSuite) TestBulkUnfollow() {
+	var (
+		ctx       = suite.T().Context()
+		requester = suite.testAccounts["local_account_1"]
+		target1   = suite.testAccounts["admin_account"]
+		target2   = suite.testAccounts["local_account_2"]
+	)
+
+	relationships, errWithCode := suite.accountProcessor.BulkUnfollow(ctx,
+		requester,
+		[]string{target1.ID, target2.ID, target1.ID},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Duplicate ID should be ignored.
+	suite.Len(relationships, 2)
+	for _, relationship := range relationships {
+		suite.False(relationship.Following)
+
+		// Follows in the other direction are untouched.
+		suite.True(relationship.FollowedBy)
+	}
+
+	// Follows should be gone from the db.
+	for _, target := range []string{target1.ID, target2.ID} {
+		following, err := suite.state.DB.IsFollowing(ctx, requester.ID, target)
+		suite.NoError(err)
+		suite.False(following)
+	}
+}
+
+func (suite *RelationshipsBulkTestSuite) TestBulkBlock() {
+	var (
+		ctx       = suite.T().Context()
+		requester = suite.testAccounts["local_account_1"]
+		target1   = suite.testAccounts["admin_account"]
+		target2   = suite.testAccounts["remote_account_1"]
+	)
+
+	relationships, errWithCode := suite.accountProcessor.BulkBlock(ctx,
+		requester,
+		[]string{target1.ID, target2.ID},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Len(relationships, 2)
+	for _, relationship := range relationships {
+		suite.True(relationship.Blocking)
+		suite.False(relationship.Following)
+		suite.False(relationship.FollowedBy)
+	}
+
+	// Blocking again should be a no-op.
+	relationships, errWithCode = suite.accountProcessor.BulkBlock(ctx,
+		requester,
+		[]string{target1.ID, target2.ID},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(relationships, 2)
+}
+
+func (suite *RelationshipsBulkTestSuite) TestBulkMute() {
+	var (
+		ctx       = suite.T().Context()
+		requester = suite.testAccounts["local_account_1"]
+		target1   = suite.testAccounts["admin_account"]
+		target2   = suite.testAccounts["local_account_2"]
+	)
+
+	relationships, errWithCode := suite.accountProcessor.BulkMute(ctx,
+		requester,
+		[]string{target1.ID, target2.ID},
+		&apimodel.UserMuteCreateUpdateRequest{
+			Notifications: util.Ptr(true),
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Len(relationships, 2)
+	for _, relationship := range relationships {
+		suite.True(relationship.Muting)
+		suite.True(relationship.MutingNotifications)
+	}
+
+	// Update mutes to no longer include notifications.
+	relationships, errWithCode = suite.accountProcessor.BulkMute(ctx,
+		requester,
+		[]string{target1.ID, target2.ID},
+		&apimodel.UserMuteCreateUpdateRequest{
+			Notifications: util.Ptr(false),
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	for _, relationship := range relationships {
+		suite.True(relationship.Muting)
+		suite.False(relationship.MutingNotifications)
+	}
+}
+
+func (suite *RelationshipsBulkTestSuite) TestBulkInvalidTargets() {
+	var (
+		ctx       = suite.T().Context()
+		requester = suite.testAccounts["local_account_1"]
+		target    = suite.testAccounts["admin_account"]
+	)
+
+	// One target doesn't exist,
+	// so nothing should change.
+	_, errWithCode := suite.accountProcessor.BulkBlock(ctx,
+		requester,
+		[]string{target.ID, "01JBRAGD1VQAM2PX0RDJVQ1XBK"},
+	)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	blocked, err := suite.state.DB.IsBlocked(ctx, requester.ID, target.ID)
+	suite.NoError(err)
+	suite.False(blocked)
+
+	// Requester can't target itself.
+	_, errWithCode = suite.accountProcessor.BulkBlock(ctx,
+		requester,
+		[]string{target.ID, requester.ID},
+	)
+	suite.Equal(http.StatusNotAcceptable, errWithCode.Code())
+
+	// Empty batch is a bad request.
+	_, errWithCode = suite.accountProcessor.BulkBlock(ctx,
+		requester,
+		nil,
+	)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func TestRelationshipsBulkTestSuite(t *testing.T) {
+	suite.Run(t, new(RelationshipsBulkTestSuite))
+}
@@ -0,0 +1,146 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"net/http"
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type RelationshipsTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func accountIDs(items []interface{}) []string {
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.(*apimodel.Account).ID)
+	}
+	return ids
+}
+
+func (suite *RelationshipsTestSuite) TestWebFollowersFollowingGet() {
+	var (
+		ctx     = suite.T().Context()
+		target  = suite.testAccounts["local_account_1"]
+		admin   = suite.testAccounts["admin_account"]
+		account = suite.testAccounts["local_account_2"]
+	)
+
+	followers, errWithCode := suite.accountProcessor.WebFollowersGet(ctx, target.ID, &paging.Page{Limit: 40})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Subset(accountIDs(followers.Items), []string{admin.ID, account.ID})
+
+	following, errWithCode := suite.accountProcessor.WebFollowingGet(ctx, target.ID, &paging.Page{Limit: 40})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Subset(accountIDs(following.Items), []string{admin.ID, account.ID})
+}
+
+func (suite *RelationshipsTestSuite) TestWebFollowersGetHidden() {
+	var (
+		ctx    = suite.T().Context()
+		target = suite.testAccounts["local_account_1"]
+	)
+
+	// Hide collections for the target account.
+	settings, err := suite.state.DB.GetAccountSettings(ctx, target.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	settings = util.Ptr(*settings)
+	settings.HideCollections = util.Ptr(true)
+	if err := suite.state.DB.UpdateAccountSettings(ctx, settings, "hide_collections"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, errWithCode := suite.accountProcessor.WebFollowersGet(ctx, target.ID, &paging.Page{Limit: 40})
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	_, errWithCode = suite.accountProcessor.WebFollowingGet(ctx, target.ID, &paging.Page{Limit: 40})
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *RelationshipsTestSuite) TestFamiliarFollowersGet() {
+	var (
+		ctx     = suite.T().Context()
+		admin   = suite.testAccounts["admin_account"]
+		zork    = suite.testAccounts["local_account_1"]
+		turtle  = suite.testAccounts["local_account_2"]
+		unknown = "01J9SRCJ4Q4C1NSAEG3CRZYKDZ"
+	)
+
+	// Admin follows zork, who follows turtle,
+	// so zork is a familiar follower of turtle.
+	familiars, errWithCode := suite.accountProcessor.FamiliarFollowersGet(ctx, admin, []string{
+		turtle.ID,
+		turtle.ID,
+		admin.ID,
+		unknown,
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Duplicate IDs should be dropped, and the
+	// requester + unknown accounts should be empty.
+	if suite.Len(familiars, 3) {
+		suite.Equal(turtle.ID, familiars[0].ID)
+		if suite.Len(familiars[0].Accounts, 1) {
+			suite.Equal(zork.ID, familiars[0].Accounts[0].ID)
+		}
+		suite.Equal(admin.ID, familiars[1].ID)
+		suite.Empty(familiars[1].Accounts)
+		suite.Equal(unknown, familiars[2].ID)
+		suite.Empty(familiars[2].Accounts)
+	}
+
+	// Hide collections for zork.
+	settings, err := suite.state.DB.GetAccountSettings(ctx, zork.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	settings = util.Ptr(*settings)
+	settings.HideCollections = util.Ptr(true)
+	if err := suite.state.DB.UpdateAccountSettings(ctx, settings, "hide_collections"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Zork should no longer be revealed.
+	familiars, errWithCode = suite.accountProcessor.FamiliarFollowersGet(ctx, admin, []string{turtle.ID})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	if suite.Len(familiars, 1) {
+		suite.Empty(familiars[0].Accounts)
+	}
+}
+
+func TestRelationshipsTestSuite(t *testing.T) {
+	suite.Run(t, new(RelationshipsTestSuite))
+}
@@ -19,9 +19,12 @@
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/subtle"
 	"errors"
 	"time"
 
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
@@ -43,7 +46,11 @@
 //
 // If the account has not yet posted an RSS-eligible status, the returned last-modified
 // time will be zero, and the GetRSSFeed func will return a valid RSS xml with no items.
-func (p *Processor) GetRSSFeedForUsername(ctx context.Context, username string, page *paging.Page) (GetRSSFeed, time.Time, gtserror.WithCode) {
+//
+// If token is set, it must match the account's private feed token, and the returned
+// feed will be the account's private feed, which also includes followers-only posts.
+// The private feed is available regardless of whether the public feed is enabled.
+func (p *Processor) GetRSSFeedForUsername(ctx context.Context, username string, token string, page *paging.Page) (GetRSSFeed, time.Time, gtserror.WithCode) {
 
 	// Fetch local (i.e. empty domain) account from database by username.
 	account, err := p.state.DB.GetAccountByUsernameDomain(ctx, username, "")
@@ -58,8 +65,22 @@ func (p *Processor) GetRSSFeedForUsername(ctx context.Context, username string,
 		return nil, never, gtserror.NewErrorNotFound(err)
 	}
 
-	// Ensure account has rss feed enabled.
-	if !*account.Settings.EnableRSS {
+	// Check whether a private feed was requested.
+	private := (token != "")
+
+	if private {
+		// Ensure token matches account's private feed token.
+		// Return not found rather than unauthorized, so as
+		// not to leak whether the account has a private feed.
+		feedToken := account.Settings.RSSFeedToken
+		if feedToken == "" || subtle.ConstantTimeCompare(
+			[]byte(token), []byte(feedToken),
+		) != 1 {
+			err := gtserror.New("account RSS feed token invalid")
+			return nil, never, gtserror.NewErrorNotFound(err)
+		}
+	} else if !*account.Settings.EnableRSS {
+		// Ensure account has rss feed enabled.
 		err := gtserror.New("account RSS feed not enabled")
 		return nil, never, gtserror.NewErrorNotFound(err)
 	}
@@ -139,20 +160,25 @@ func (p *Processor) GetRSSFeedForUsername(ctx context.Context, username string,
 		feed.Updated = lastPostAt
 
 		// Retrieve latest statuses as they'd be shown
-		// on the web view of the account profile.
+		// on the web view of the account profile, or
+		// for the private feed (incl. followers-only).
 		//
 		// Take into account whether the user wants
 		// their web view laid out in gallery mode.
 		mediaOnly := (account.Settings != nil &&
 			account.Settings.WebLayout == gtsmodel.WebLayoutGallery)
-		statuses, err := p.state.DB.GetAccountWebStatuses(
+		getStatuses := p.state.DB.GetAccountWebStatuses
+		if private {
+			getStatuses = p.state.DB.GetAccountPrivateFeedStatuses
+		}
+		statuses, err := getStatuses(
 			ctx,
 			account,
 			page,
 			mediaOnly,
 		)
 		if err != nil && !errors.Is(err, db.ErrNoEntries) {
-			err := gtserror.Newf("db error getting account web statuses: %w", err)
+			err := gtserror.Newf("db error getting account feed statuses: %w", err)
 			return nil, gtserror.NewErrorInternalError(err)
 		}
 
@@ -185,3 +211,40 @@ func (p *Processor) GetRSSFeedForUsername(ctx context.Context, username string,
 		return feed, nil
 	}, lastPostAt, nil
 }
+
+// RSSFeedTokenCreate generates a new private RSS feed token
+// for the given account, replacing (and so revoking) any
+// existing token. Returns the updated account, the source
+// of which contains the new token.
+func (p *Processor) RSSFeedTokenCreate(ctx context.Context, account *gtsmodel.Account) (*apimodel.Account, gtserror.WithCode) {
+	return p.setRSSFeedToken(ctx, account, rand.Text())
+}
+
+// RSSFeedTokenDelete revokes the private RSS feed token of the
+// given account, if set. Returns the updated account.
+func (p *Processor) RSSFeedTokenDelete(ctx context.Context, account *gtsmodel.Account) (*apimodel.Account, gtserror.WithCode) {
+	return p.setRSSFeedToken(ctx, account, "")
+}
+
+func (p *Processor) setRSSFeedToken(ctx context.Context, account *gtsmodel.Account, token string) (*apimodel.Account, gtserror.WithCode) {
+	if account.Settings == nil {
+		// Ensure account settings populated.
+		var err error
+		account.Settings, err = p.state.DB.GetAccountSettings(ctx, account.ID)
+		if err != nil {
+			err := gtserror.Newf("db error getting account settings: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	account.Settings.RSSFeedToken = token
+	if err := p.state.DB.UpdateAccountSettings(ctx,
+		account.Settings,
+		"rss_feed_token",
+	); err != nil {
+		err := gtserror.Newf("db error updating account settings: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.c.GetAPIAccountSensitive(ctx, account)
+}
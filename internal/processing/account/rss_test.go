@@ -18,10 +18,14 @@
 package account_test
 
 import (
+	"net/http"
 	"testing"
 	"time"
 
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"code.superseriousbusiness.org/gotosocial/testrig"
 	"github.com/gorilla/feeds"
 	"github.com/stretchr/testify/suite"
 )
@@ -356,10 +360,152 @@ func (suite *GetRSSTestSuite) TestGetAccountRSSZorkNoPosts() {
 </rss>`)
 }
 
+func (suite *GetRSSTestSuite) TestGetAccountPrivateFeed() {
+	ctx := suite.T().Context()
+	account := suite.testAccounts["local_account_1"]
+
+	// Set a private feed token, and disable
+	// the public feed; the private feed should
+	// still be available with correct token.
+	settings, err := suite.state.DB.GetAccountSettings(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	settings.EnableRSS = util.Ptr(false)
+	settings.RSSFeedToken = "some_secret_token"
+	if err := suite.state.DB.UpdateAccountSettings(ctx, settings,
+		"enable_rss", "rss_feed_token",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Public feed should now be unavailable.
+	_, _, errWithCode := suite.accountProcessor.GetRSSFeedForUsername(ctx, account.Username, "", &paging.Page{Limit: 20})
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// As should private feed with bad token.
+	_, _, errWithCode = suite.accountProcessor.GetRSSFeedForUsername(ctx, account.Username, "not_the_token", &paging.Page{Limit: 20})
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// Private feed with correct token should work.
+	getFeed, _, errWithCode := suite.accountProcessor.GetRSSFeedForUsername(ctx, account.Username, "some_secret_token", &paging.Page{Limit: 20})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	feed, errWithCode := getFeed()
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Gather URLs of statuses in feed.
+	feedURLs := make(map[string]bool, len(feed.Items))
+	for _, item := range feed.Items {
+		feedURLs[item.Link.Href] = true
+	}
+
+	// Followers-only top-level statuses
+	// should be included in private feed.
+	var followersOnly int
+	for _, status := range testrig.NewTestStatuses() {
+		if status.AccountID != account.ID ||
+			status.Visibility != gtsmodel.VisibilityFollowersOnly ||
+			status.InReplyToURI != "" ||
+			status.BoostOfID != "" ||
+			status.IsLocalOnly() {
+			continue
+		}
+		suite.True(feedURLs[status.URL], status.URL)
+		followersOnly++
+	}
+	suite.NotZero(followersOnly)
+}
+
+func (suite *GetRSSTestSuite) TestGetAccountFeedsTrashedStatus() {
+	ctx := suite.T().Context()
+	account := suite.testAccounts["local_account_1"]
+
+	// Give the account a private feed token.
+	settings, err := suite.state.DB.GetAccountSettings(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	settings.RSSFeedToken = "some_secret_token"
+	if err := suite.state.DB.UpdateAccountSettings(ctx, settings, "rss_feed_token"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Move a public status to the trash.
+	status := suite.testStatuses["local_account_1_status_1"]
+	status.DeletedAt = time.Now()
+	if err := suite.state.DB.UpdateStatus(ctx, status, "deleted_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Trashed status should be in neither
+	// the public nor the private feed.
+	for _, token := range []string{"", "some_secret_token"} {
+		getFeed, _, errWithCode := suite.accountProcessor.GetRSSFeedForUsername(ctx, account.Username, token, &paging.Page{Limit: 20})
+		if errWithCode != nil {
+			suite.FailNow(errWithCode.Error())
+		}
+
+		feed, errWithCode := getFeed()
+		if errWithCode != nil {
+			suite.FailNow(errWithCode.Error())
+		}
+
+		suite.NotEmpty(feed.Items)
+		for _, item := range feed.Items {
+			suite.NotEqual(status.URL, item.Link.Href)
+		}
+	}
+}
+
+func (suite *GetRSSTestSuite) TestRSSFeedTokenCreateDelete() {
+	ctx := suite.T().Context()
+	account := new(gtsmodel.Account)
+	*account = *suite.testAccounts["local_account_1"]
+	account.Settings = nil
+
+	// Generate a token.
+	apiAccount, errWithCode := suite.accountProcessor.RSSFeedTokenCreate(ctx, account)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	token := apiAccount.Source.RSSFeedToken
+	suite.NotEmpty(token)
+
+	// Regenerating should replace the token.
+	apiAccount, errWithCode = suite.accountProcessor.RSSFeedTokenCreate(ctx, account)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.NotEmpty(apiAccount.Source.RSSFeedToken)
+	suite.NotEqual(token, apiAccount.Source.RSSFeedToken)
+
+	// Old token should no longer work.
+	_, _, errWithCode = suite.accountProcessor.GetRSSFeedForUsername(ctx, account.Username, token, &paging.Page{Limit: 20})
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// Revoke the token.
+	apiAccount, errWithCode = suite.accountProcessor.RSSFeedTokenDelete(ctx, account)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(apiAccount.Source.RSSFeedToken)
+
+	settings, err := suite.state.DB.GetAccountSettings(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(settings.RSSFeedToken)
+}
+
 // func (suite *GetRSSTestSuite) testGetAccountRSSPaging(username string, page *paging.Page, expectIDs []string) {
 // 	ctx := suite.T().Context()
 
-// 	getFeed, _, errWithCode := suite.accountProcessor.GetRSSFeedForUsername(ctx, username, page)
+// 	getFeed, _, errWithCode := suite.accountProcessor.GetRSSFeedForUsername(ctx, username, "", page)
 // 	suite.NoError(errWithCode)
 
 // 	feed, errWithCode := getFeed()
@@ -370,7 +516,7 @@ func (suite *GetRSSTestSuite) TestGetAccountRSSZorkNoPosts() {
 func (suite *GetRSSTestSuite) testGetFeedSerializedAs(username string, page *paging.Page, serialize func(*feeds.Feed) (string, error), expectLastMod int64, expectSerialized string) {
 	ctx := suite.T().Context()
 
-	getFeed, lastMod, errWithCode := suite.accountProcessor.GetRSSFeedForUsername(ctx, username, page)
+	getFeed, lastMod, errWithCode := suite.accountProcessor.GetRSSFeedForUsername(ctx, username, "", page)
 	suite.NoError(errWithCode)
 	suite.Equal(expectLastMod, lastMod.Unix())
 
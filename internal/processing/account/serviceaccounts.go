@@ -0,0 +1,222 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
+	"code.superseriousbusiness.org/gotosocial/internal/text"
+	"code.superseriousbusiness.org/gotosocial/internal/validate"
+)
+
+// ServiceAccountsGet returns the service
+// accounts owned by the given account.
+func (p *Processor) ServiceAccountsGet(
+	ctx context.Context,
+	owner *gtsmodel.Account,
+) ([]*apimodel.Account, gtserror.WithCode) {
+	accounts, err := p.state.DB.GetAccountsByOwnerAccountID(ctx, owner.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting service accounts: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAccounts := make([]*apimodel.Account, 0, len(accounts))
+	for _, account := range accounts {
+		apiAccount, err := p.converter.AccountToAPIAccountSensitive(ctx, account)
+		if err != nil {
+			err := gtserror.Newf("error converting account %s: %w", account.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		apiAccounts = append(apiAccounts, apiAccount)
+	}
+
+	return apiAccounts, nil
+}
+
+// ServiceAccountCreate creates a new service account owned by
+// the given account, provided the owner is permitted to do so.
+func (p *Processor) ServiceAccountCreate(
+	ctx context.Context,
+	owner *gtsmodel.Account,
+	app *gtsmodel.Application,
+	form *apimodel.ServiceAccountCreateRequest,
+) (*apimodel.Account, gtserror.WithCode) {
+	// Service accounts cannot
+	// own service accounts.
+	if owner.IsServiceAccount() {
+		const text = "service accounts cannot create service accounts"
+		return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	maxServiceAccounts := config.GetAccountsMaxServiceAccounts()
+	if maxServiceAccounts <= 0 {
+		const text = "service accounts are not enabled on this instance"
+		return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	owned, err := p.state.DB.GetAccountsByOwnerAccountID(ctx, owner.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting service accounts: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if len(owned) >= maxServiceAccounts {
+		text := fmt.Sprintf("you have reached the limit of %d service accounts", maxServiceAccounts)
+		return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	if err := validate.Username(form.Username); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if err := validate.DisplayName(form.DisplayName); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	usernameAvailable, err := p.state.DB.IsUsernameAvailable(ctx, form.Username)
+	if err != nil {
+		err := gtserror.Newf("db error checking username availability: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	if !usernameAvailable {
+		err := fmt.Errorf("username %s is not available", form.Username)
+		return nil, gtserror.NewErrorConflict(err, err.Error())
+	}
+
+	// Service accounts have no email address
+	// and a random password, so they can only
+	// be used via tokens minted by their owner.
+	user, err := p.state.DB.NewSignup(ctx, gtsmodel.NewSignup{
+		Username:      form.Username,
+		Password:      rand.Text() + rand.Text(),
+		PreApproved:   true,
+		EmailVerified: true,
+		AppID:         app.ID,
+	})
+	if err != nil {
+		err := gtserror.Newf("db error creating service account: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	account := user.Account
+	account.ActorType = gtsmodel.AccountActorTypeService
+	account.OwnerAccountID = owner.ID
+	columns := []string{"actor_type", "owner_account_id"}
+
+	if form.DisplayName != "" {
+		account.DisplayName = text.StripHTMLFromText(form.DisplayName)
+		columns = append(columns, "display_name")
+	}
+
+	if err := p.state.DB.UpdateAccount(ctx, account, columns...); err != nil {
+		err := gtserror.Newf("db error updating service account: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAccount, err := p.converter.AccountToAPIAccountSensitive(ctx, account)
+	if err != nil {
+		err := gtserror.Newf("error converting account %s: %w", account.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiAccount, nil
+}
+
+// ServiceAccountUser returns the user for the service account with
+// the given ID, provided it's owned by the given owner account.
+//
+// The returned user can be used to generate a token with which
+// the owner can act as the service account through the client API.
+func (p *Processor) ServiceAccountUser(
+	ctx context.Context,
+	owner *gtsmodel.Account,
+	targetAccountID string,
+) (*gtsmodel.User, gtserror.WithCode) {
+	target, errWithCode := p.getOwnedServiceAccount(ctx, owner, targetAccountID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	user, err := p.state.DB.GetUserByAccountID(ctx, target.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting service account user: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return user, nil
+}
+
+// ServiceAccountDelete deletes the service account with the
+// given ID, provided it's owned by the given owner account.
+func (p *Processor) ServiceAccountDelete(
+	ctx context.Context,
+	owner *gtsmodel.Account,
+	targetAccountID string,
+) gtserror.WithCode {
+	target, errWithCode := p.getOwnedServiceAccount(ctx, owner, targetAccountID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	// Process the delete side effects asynchronously,
+	// the same as for a local user deleting themself.
+	p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
+		APObjectType:   ap.ObjectProfile,
+		APActivityType: ap.ActivityDelete,
+		Origin:         owner,
+		Target:         target,
+	})
+
+	return nil
+}
+
+// getOwnedServiceAccount fetches the account with the given ID,
+// returning 404 if it doesn't exist or isn't owned by owner.
+func (p *Processor) getOwnedServiceAccount(
+	ctx context.Context,
+	owner *gtsmodel.Account,
+	targetAccountID string,
+) (*gtsmodel.Account, gtserror.WithCode) {
+	target, err := p.state.DB.GetAccountByID(ctx, targetAccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting account %s: %w", targetAccountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if target == nil ||
+		target.OwnerAccountID != owner.ID ||
+		target.IsSuspended() {
+		// Don't leak existence of accounts
+		// not owned by the requester.
+		const text = "service account not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	return target, nil
+}
@@ -0,0 +1,215 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"github.com/stretchr/testify/suite"
+)
+
+type ServiceAccountsTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *ServiceAccountsTestSuite) TestServiceAccountCreateGetDelete() {
+	var (
+		ctx   = suite.T().Context()
+		owner = suite.testAccounts["local_account_1"]
+		app   = suite.testApplications["application_1"]
+	)
+
+	apiAccount, errWithCode := suite.accountProcessor.ServiceAccountCreate(ctx,
+		owner,
+		app,
+		&apimodel.ServiceAccountCreateRequest{
+			Username:    "the_bot",
+			DisplayName: "The Bot",
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("the_bot", apiAccount.Username)
+	suite.Equal("The Bot", apiAccount.DisplayName)
+	suite.True(apiAccount.Bot)
+
+	// Check stored account is owned service account.
+	dbAccount, err := suite.state.DB.GetAccountByID(ctx, apiAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.AccountActorTypeService, dbAccount.ActorType)
+	suite.Equal(owner.ID, dbAccount.OwnerAccountID)
+	suite.True(dbAccount.IsServiceAccount())
+
+	// Service account user should be usable straight away.
+	user, errWithCode := suite.accountProcessor.ServiceAccountUser(ctx, owner, apiAccount.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.False(user.ConfirmedAt.IsZero())
+	suite.True(*user.Approved)
+	suite.Empty(user.Email)
+
+	// It should be listed for the owner.
+	apiAccounts, errWithCode := suite.accountProcessor.ServiceAccountsGet(ctx, owner)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(apiAccounts, 1)
+	suite.Equal(apiAccount.ID, apiAccounts[0].ID)
+
+	// Other accounts can't access or delete it.
+	other := suite.testAccounts["local_account_2"]
+	_, errWithCode = suite.accountProcessor.ServiceAccountUser(ctx, other, apiAccount.ID)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	errWithCode = suite.accountProcessor.ServiceAccountDelete(ctx, other, apiAccount.ID)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// But the owner can delete it.
+	if errWithCode := suite.accountProcessor.ServiceAccountDelete(ctx, owner, apiAccount.ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+}
+
+func (suite *ServiceAccountsTestSuite) TestServiceAccountCreateNotAllowed() {
+	var (
+		ctx   = suite.T().Context()
+		owner = suite.testAccounts["local_account_1"]
+		app   = suite.testApplications["application_1"]
+	)
+
+	config.SetAccountsMaxServiceAccounts(1)
+	defer config.SetAccountsMaxServiceAccounts(5)
+
+	apiAccount, errWithCode := suite.accountProcessor.ServiceAccountCreate(ctx,
+		owner,
+		app,
+		&apimodel.ServiceAccountCreateRequest{Username: "first_bot"},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Limit reached.
+	_, errWithCode = suite.accountProcessor.ServiceAccountCreate(ctx,
+		owner,
+		app,
+		&apimodel.ServiceAccountCreateRequest{Username: "second_bot"},
+	)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	// Service accounts can't own service accounts.
+	service, err := suite.state.DB.GetAccountByID(ctx, apiAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	_, errWithCode = suite.accountProcessor.ServiceAccountCreate(ctx,
+		service,
+		app,
+		&apimodel.ServiceAccountCreateRequest{Username: "bot_bot"},
+	)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	// Taken usernames are rejected.
+	_, errWithCode = suite.accountProcessor.ServiceAccountCreate(ctx,
+		suite.testAccounts["local_account_2"],
+		app,
+		&apimodel.ServiceAccountCreateRequest{Username: "the_mighty_zork"},
+	)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+}
+
+func (suite *ServiceAccountsTestSuite) TestServiceAccountCreateAfterDelete() {
+	var (
+		ctx   = suite.T().Context()
+		owner = suite.testAccounts["local_account_1"]
+		app   = suite.testApplications["application_1"]
+	)
+
+	config.SetAccountsMaxServiceAccounts(1)
+	defer config.SetAccountsMaxServiceAccounts(5)
+
+	// Create up to the limit.
+	apiAccount, errWithCode := suite.accountProcessor.ServiceAccountCreate(ctx,
+		owner,
+		app,
+		&apimodel.ServiceAccountCreateRequest{Username: "first_bot"},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Delete the service account.
+	if errWithCode := suite.accountProcessor.ServiceAccountDelete(ctx, owner, apiAccount.ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Process the queued delete
+	// as the client worker would.
+	msg, ok := suite.getClientMsg(5 * time.Second)
+	if !ok {
+		suite.FailNow("timed out waiting for delete message")
+	}
+	target := msg.Target
+	suite.Equal(apiAccount.ID, target.ID)
+	if err := suite.accountProcessor.Delete(ctx, target, owner.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Deleted account should no longer be owned.
+	dbAccount, err := suite.state.DB.GetAccountByID(ctx, apiAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(dbAccount.IsSuspended())
+	suite.Empty(dbAccount.OwnerAccountID)
+
+	// Nor should it be listed.
+	apiAccounts, errWithCode := suite.accountProcessor.ServiceAccountsGet(ctx, owner)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(apiAccounts)
+
+	// So creating another is allowed.
+	_, errWithCode = suite.accountProcessor.ServiceAccountCreate(ctx,
+		owner,
+		app,
+		&apimodel.ServiceAccountCreateRequest{Username: "second_bot"},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+}
+
+func TestServiceAccountsTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceAccountsTestSuite))
+}
@@ -19,6 +19,8 @@
 
 import (
 	"cmp"
+	"context"
+	"errors"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -28,7 +30,10 @@
 	"code.superseriousbusiness.org/gopkg/log"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/storage"
 	"codeberg.org/gruf/go-bytesize"
 )
 
@@ -37,9 +42,73 @@
 	themeDescriptionRegex = regexp.MustCompile(`(?m)^\ *theme-description:(.*)$`)
 )
 
-// GetThemes returns available account css themes.
-func (p *Processor) ThemesGet() []apimodel.Theme {
-	return p.converter.ThemesToAPIThemes(p.themes.SortedByTitle)
+// ThemesGet returns available account css themes, including
+// both themes bundled in the web assets themes directory,
+// and themes uploaded to this instance by an admin.
+func (p *Processor) ThemesGet(ctx context.Context) ([]apimodel.Theme, gtserror.WithCode) {
+	uploaded, err := p.state.DB.GetThemes(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting uploaded themes: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	themes := make([]*gtsmodel.Theme, 0, len(p.themes.SortedByTitle)+len(uploaded))
+	themes = append(themes, p.themes.SortedByTitle...)
+	themes = append(themes, uploaded...)
+	sortThemes(themes)
+
+	return p.converter.ThemesToAPIThemes(themes), nil
+}
+
+// ThemeGetUploadedCSS returns the CSS of
+// the admin-uploaded theme with file name.
+func (p *Processor) ThemeGetUploadedCSS(ctx context.Context, fileName string) ([]byte, gtserror.WithCode) {
+	theme, err := p.state.DB.GetThemeByFileName(ctx, fileName)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			const text = "theme not found"
+			return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+		}
+		err := gtserror.Newf("db error getting theme %s: %w", fileName, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	css, err := p.state.Storage.Get(ctx, theme.StoragePath())
+	if err != nil {
+		if storage.IsNotFound(err) {
+			const text = "theme not found"
+			return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+		}
+		err := gtserror.Newf("storage error getting theme %s: %w", fileName, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return css, nil
+}
+
+// IsBundledTheme returns whether the theme with the given
+// file name is bundled in the web assets themes directory.
+func (p *Processor) IsBundledTheme(fileName string) bool {
+	_, ok := p.themes.ByFileName[fileName]
+	return ok
+}
+
+// themeExists returns whether the theme with the
+// given file name is either bundled or uploaded.
+func (p *Processor) themeExists(ctx context.Context, fileName string) (bool, error) {
+	if p.IsBundledTheme(fileName) {
+		return true, nil
+	}
+
+	_, err := p.state.DB.GetThemeByFileName(ctx, fileName)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, db.ErrNoEntries):
+		return false, nil
+	default:
+		return false, err
+	}
 }
 
 // Themes represents an in-memory
@@ -63,16 +132,17 @@ func PopulateThemes() *Themes {
 	}
 
 	themesAbsFilePath := filepath.Join(webAssetsAbsFilePath, "themes")
-	themesFiles, err := os.ReadDir(themesAbsFilePath)
-	if err != nil {
-		log.Warnf(nil, "error reading themes at %s: %v", themesAbsFilePath, err)
-		return nil
-	}
 
 	themes := &Themes{
 		ByFileName: make(map[string]*gtsmodel.Theme),
 	}
 
+	themesFiles, err := os.ReadDir(themesAbsFilePath)
+	if err != nil {
+		log.Warnf(nil, "error reading themes at %s: %v", themesAbsFilePath, err)
+		return themes
+	}
+
 	for _, f := range themesFiles {
 		// Ignore nested directories.
 		if f.IsDir() {
@@ -113,39 +183,49 @@ func PopulateThemes() *Themes {
 			continue
 		}
 
-		// Try to parse a title and description
-		// for this theme from the file itself.
-		var themeTitle string
-		titleMatches := themeTitleRegex.FindSubmatch(contents)
-		if len(titleMatches) == 2 {
-			themeTitle = strings.TrimSpace(string(titleMatches[1]))
-		} else {
-			// Fall back to file name
-			// without `.css` suffix.
-			themeTitle = strings.TrimSuffix(fileName, ".css")
-		}
+		theme := ParseTheme(fileName, contents)
+		themes.SortedByTitle = append(themes.SortedByTitle, theme)
+		themes.ByFileName[fileName] = theme
+	}
 
-		var themeDescription string
-		descMatches := themeDescriptionRegex.FindSubmatch(contents)
-		if len(descMatches) == 2 {
-			themeDescription = strings.TrimSpace(string(descMatches[1]))
-		}
+	sortThemes(themes.SortedByTitle)
+	return themes
+}
 
-		theme := &gtsmodel.Theme{
-			Title:       themeTitle,
-			Description: themeDescription,
-			FileName:    fileName,
-		}
+// ParseTheme parses a title and description for the
+// theme with given file name from the file contents,
+// falling back to the file name (without `.css`) as
+// title if no title is given in the file itself.
+func ParseTheme(fileName string, contents []byte) *gtsmodel.Theme {
+	var themeTitle string
+	titleMatches := themeTitleRegex.FindSubmatch(contents)
+	if len(titleMatches) == 2 {
+		themeTitle = strings.TrimSpace(string(titleMatches[1]))
+	}
 
-		themes.SortedByTitle = append(themes.SortedByTitle, theme)
-		themes.ByFileName[fileName] = theme
+	if themeTitle == "" {
+		// Fall back to file name
+		// without `.css` suffix.
+		themeTitle = strings.TrimSuffix(fileName, ".css")
 	}
 
-	// Sort themes alphabetically
-	// by title (case insensitive).
-	slices.SortFunc(themes.SortedByTitle, func(a, b *gtsmodel.Theme) int {
+	var themeDescription string
+	descMatches := themeDescriptionRegex.FindSubmatch(contents)
+	if len(descMatches) == 2 {
+		themeDescription = strings.TrimSpace(string(descMatches[1]))
+	}
+
+	return &gtsmodel.Theme{
+		Title:       themeTitle,
+		Description: themeDescription,
+		FileName:    fileName,
+	}
+}
+
+// sortThemes sorts themes alphabetically
+// by title (case insensitive).
+func sortThemes(themes []*gtsmodel.Theme) {
+	slices.SortFunc(themes, func(a, b *gtsmodel.Theme) int {
 		return cmp.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
 	})
-
-	return themes
 }
@@ -23,6 +23,8 @@
 	"fmt"
 	"io"
 	"mime/multipart"
+	"slices"
+	"strings"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
@@ -30,8 +32,10 @@
 	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
 	"code.superseriousbusiness.org/gotosocial/internal/media"
 	"code.superseriousbusiness.org/gotosocial/internal/messages"
+	"code.superseriousbusiness.org/gotosocial/internal/relme"
 	"code.superseriousbusiness.org/gotosocial/internal/text"
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
@@ -47,6 +51,10 @@ func (p *Processor) selectNoteFormatter(contentType string) text.FormatFunc {
 	return p.formatter.FromPlain
 }
 
+// autoDeleteMaxDays is the maximum number of days
+// that can be set for automatic deletion of statuses.
+const autoDeleteMaxDays = 3650
+
 // Update processes the update of an account with the given form.
 func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form *apimodel.UpdateCredentialsRequest) (*apimodel.Account, gtserror.WithCode) {
 	// Ensure account populated; we'll need settings.
@@ -134,6 +142,28 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 		}...)
 	}
 
+	if form.FieldsAttributes != nil && form.FieldsOrder != nil {
+		const text = "fields_attributes and fields_order cannot be combined"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if form.FieldsOrder != nil {
+		// Fields will need
+		// reprocessing.
+		textChanged = true
+
+		if err := p.reorderFields(
+			account,
+			*form.FieldsOrder,
+		); err != nil {
+			return nil, err
+		}
+		acctColumns = append(acctColumns, []string{
+			"fields",
+			"fields_raw",
+		}...)
+	}
+
 	if form.FieldsAttributes != nil {
 		// Field text is changing.
 		textChanged = true
@@ -281,7 +311,10 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 				return nil, gtserror.NewErrorBadRequest(err, err.Error())
 			}
 
-			account.Settings.StatusContentType = *form.Source.StatusContentType
+			// Store normalized form, so shorthand
+			// like "markdown" becomes "text/markdown".
+			contentType := typeutils.APIContentTypeToContentType(apimodel.StatusContentType(*form.Source.StatusContentType))
+			account.Settings.StatusContentType = string(typeutils.ContentTypeToAPIContentType(contentType))
 			settingsColumns = append(settingsColumns, "status_content_type")
 		}
 	}
@@ -292,9 +325,15 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 			// Empty is easy, just clear this.
 			account.Settings.Theme = ""
 		} else {
-			// Theme was provided, check
-			// against known available themes.
-			if _, ok := p.themes.ByFileName[theme]; !ok {
+			// Theme was provided, check against known
+			// available bundled and uploaded themes.
+			exists, err := p.themeExists(ctx, theme)
+			if err != nil {
+				err := gtserror.Newf("db error checking theme %s: %w", theme, err)
+				return nil, gtserror.NewErrorInternalError(err)
+			}
+
+			if !exists {
 				err := fmt.Errorf("theme %s not available on this instance, see /api/v1/accounts/themes for available themes", theme)
 				return nil, gtserror.NewErrorBadRequest(err, err.Error())
 			}
@@ -313,6 +352,16 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 		settingsColumns = append(settingsColumns, "custom_css")
 	}
 
+	if form.AccentColor != nil {
+		accentColor := *form.AccentColor
+		if err := validate.AccentColor(accentColor); err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		account.Settings.AccentColor = strings.ToLower(accentColor)
+		settingsColumns = append(settingsColumns, "accent_color")
+	}
+
 	if form.EnableRSS != nil {
 		account.Settings.EnableRSS = form.EnableRSS
 		settingsColumns = append(settingsColumns, "enable_rss")
@@ -323,6 +372,33 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 		settingsColumns = append(settingsColumns, "hide_collections")
 	}
 
+	if form.DirectoryOptIn != nil {
+		account.Settings.DirectoryOptIn = form.DirectoryOptIn
+		settingsColumns = append(settingsColumns, "directory_opt_in")
+	}
+
+	if form.ShowNoteIndicators != nil {
+		account.Settings.ShowNoteIndicators = form.ShowNoteIndicators
+		settingsColumns = append(settingsColumns, "show_note_indicators")
+	}
+
+	if form.AllowEmbeds != nil {
+		account.Settings.AllowEmbeds = form.AllowEmbeds
+		settingsColumns = append(settingsColumns, "allow_embeds")
+	}
+
+	if form.AutoDeleteAfterDays != nil {
+		days := *form.AutoDeleteAfterDays
+		if days < 0 || days > autoDeleteMaxDays {
+			text := fmt.Sprintf("auto_delete_after_days must be between 0 and %d", autoDeleteMaxDays)
+			err := errors.New(text)
+			return nil, gtserror.NewErrorBadRequest(err, text)
+		}
+
+		account.Settings.AutoDeleteAfterDays = days
+		settingsColumns = append(settingsColumns, "auto_delete_after_days")
+	}
+
 	if form.WebLayout != nil {
 		webLayout := gtsmodel.ParseWebLayout(*form.WebLayout)
 		if webLayout == gtsmodel.WebLayoutUnknown {
@@ -353,13 +429,24 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 	}
 
 	// Send out Update message over the s2s (fedi) API.
-	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+	p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 		APObjectType:   ap.ActorPerson,
 		APActivityType: ap.ActivityUpdate,
 		GTSModel:       account,
 		Origin:         account,
 	})
 
+	if form.FieldsAttributes != nil &&
+		p.state.RelMe != nil &&
+		relme.NeedsVerify(account.Fields) {
+		// Verify any new links in fields async,
+		// as this requires fetching linked pages.
+		accountID := account.ID
+		p.state.Workers.Dereference.Push(ctx, func(ctx context.Context) {
+			p.state.RelMe.VerifyAccount(ctx, p.state.DB, accountID)
+		})
+	}
+
 	acctSensitive, err := p.converter.AccountToAPIAccountSensitive(ctx, account)
 	if err != nil {
 		err := gtserror.Newf("error converting account: %w", err)
@@ -379,6 +466,10 @@ func (p *Processor) updateFields(
 	var (
 		fieldsLen = len(fieldsAttributes)
 		fieldsRaw = make([]*gtsmodel.Field, 0, fieldsLen)
+
+		// Existing fields already
+		// matched to an updated field.
+		matched = make(map[*gtsmodel.Field]struct{}, fieldsLen)
 	)
 
 	for _, updateField := range fieldsAttributes {
@@ -400,6 +491,23 @@ func (p *Processor) updateFields(
 			Name:  text.StripHTMLFromText(name),
 			Value: text.StripHTMLFromText(value),
 		}
+
+		// Look for an existing field that this
+		// updates, to keep its ID, and verification
+		// of its link if the value is unchanged.
+		prev := matchField(account.FieldsRaw, matched, updateField.ID, fieldRaw)
+		if prev != nil {
+			matched[prev] = struct{}{}
+			fieldRaw.ID = prev.ID
+			if prev.Value == fieldRaw.Value {
+				fieldRaw.VerifiedAt = prev.VerifiedAt
+			}
+		}
+
+		if fieldRaw.ID == "" {
+			fieldRaw.ID = id.NewULID()
+		}
+
 		fieldsRaw = append(fieldsRaw, fieldRaw)
 	}
 
@@ -414,6 +522,68 @@ func (p *Processor) updateFields(
 	return nil
 }
 
+// matchField returns the field in fields that the given
+// updated field is an update of, ie., the field with given
+// ID if set, else the field with the same name and value.
+// Fields already in matched are skipped.
+func matchField(
+	fields []*gtsmodel.Field,
+	matched map[*gtsmodel.Field]struct{},
+	fieldID *string,
+	updated *gtsmodel.Field,
+) *gtsmodel.Field {
+	for _, field := range fields {
+		if _, ok := matched[field]; ok {
+			continue
+		}
+
+		if fieldID != nil {
+			if field.ID != "" && field.ID == *fieldID {
+				return field
+			}
+		} else if field.Name == updated.Name &&
+			field.Value == updated.Value {
+			return field
+		}
+	}
+	return nil
+}
+
+// reorderFields reorders FieldsRaw on the given account
+// according to the given field IDs, which must contain
+// the ID of each of the account's fields exactly once.
+// Account.Fields still needs reprocessing afterwards.
+func (p *Processor) reorderFields(
+	account *gtsmodel.Account,
+	order []string,
+) gtserror.WithCode {
+	if len(order) != len(account.FieldsRaw) {
+		const text = "fields_order must contain the ID of every profile field"
+		return gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	fieldsRaw := make([]*gtsmodel.Field, 0, len(order))
+	for _, fieldID := range order {
+		i := slices.IndexFunc(account.FieldsRaw, func(f *gtsmodel.Field) bool {
+			return f.ID == fieldID
+		})
+		if i < 0 {
+			text := fmt.Sprintf("fields_order contains unknown field ID %s", fieldID)
+			return gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		if slices.Contains(fieldsRaw, account.FieldsRaw[i]) {
+			text := fmt.Sprintf("fields_order contains field ID %s more than once", fieldID)
+			return gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		fieldsRaw = append(fieldsRaw, account.FieldsRaw[i])
+	}
+
+	account.FieldsRaw = fieldsRaw
+	return nil
+}
+
 // processAccountText processes the raw versions of the given
 // account's display name, note, and fields, and sets those
 // processed versions on the account, while also updating the
@@ -449,7 +619,10 @@ func (p *Processor) processAccountText(
 	// Process raw fields.
 	account.Fields = make([]*gtsmodel.Field, 0, len(account.FieldsRaw))
 	for _, fieldRaw := range account.FieldsRaw {
-		field := &gtsmodel.Field{}
+		field := &gtsmodel.Field{
+			ID:         fieldRaw.ID,
+			VerifiedAt: fieldRaw.VerifiedAt,
+		}
 
 		// Name stays plain, but we still need to
 		// see if there are any emojis set in it.
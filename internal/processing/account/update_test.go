@@ -25,6 +25,7 @@
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"code.superseriousbusiness.org/gotosocial/testrig"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -245,6 +246,14 @@ func (suite *AccountUpdateTestSuite) TestAccountUpdateWithFields() {
 		suite.FailNow(errWithCode.Error())
 	}
 
+	// New fields should have been given IDs.
+	for i := range fieldsExpectedRaw {
+		suite.NotEmpty(apiAccount.Source.Fields[i].ID)
+		suite.Equal(apiAccount.Source.Fields[i].ID, apiAccount.Fields[i].ID)
+		fieldsExpectedRaw[i].ID = apiAccount.Source.Fields[i].ID
+		fieldsExpectedParsed[i].ID = apiAccount.Fields[i].ID
+	}
+
 	// Returned profile should be updated.
 	suite.EqualValues(fieldsExpectedRaw, apiAccount.Source.Fields)
 	suite.EqualValues(fieldsExpectedParsed, apiAccount.Fields)
@@ -278,6 +287,178 @@ func (suite *AccountUpdateTestSuite) TestAccountUpdateWithFields() {
 	suite.Equal(fieldsExpectedRaw[1].Value, dbAccount.FieldsRaw[1].Value)
 }
 
+func (suite *AccountUpdateTestSuite) TestAccountUpdateFieldsKeepVerified() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+
+	// Account already has a verified website field.
+	verifiedAt := testrig.TimeMustParse("2024-01-10T10:00:00Z")
+	testAccount.FieldsRaw = []*gtsmodel.Field{
+		{
+			Name:       "my website",
+			Value:      "https://example.org",
+			VerifiedAt: verifiedAt,
+		},
+	}
+
+	// Update fields, keeping website but adding
+	// another link which hasn't been verified.
+	updateFields := []apimodel.UpdateField{
+		{
+			Name:  util.Ptr("my website"),
+			Value: util.Ptr("https://example.org"),
+		},
+		{
+			Name:  util.Ptr("my other website"),
+			Value: util.Ptr("https://example.com"),
+		},
+	}
+
+	apiAccount, errWithCode := suite.accountProcessor.Update(suite.T().Context(), testAccount, &apimodel.UpdateCredentialsRequest{
+		FieldsAttributes: &updateFields,
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Unchanged field should still be verified.
+	suite.Equal(util.Ptr(util.FormatISO8601(verifiedAt)), apiAccount.Fields[0].VerifiedAt)
+	suite.Equal(util.Ptr(util.FormatISO8601(verifiedAt)), apiAccount.Source.Fields[0].VerifiedAt)
+
+	// New field should not be.
+	suite.Nil(apiAccount.Fields[1].VerifiedAt)
+	suite.Nil(apiAccount.Source.Fields[1].VerifiedAt)
+
+	// We should have an update in the client api channel.
+	msg, _ := suite.getClientMsg(5 * time.Second)
+	suite.Equal(ap.ActivityUpdate, msg.APActivityType)
+}
+
+func (suite *AccountUpdateTestSuite) TestAccountUpdateFieldsKeepIDs() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_2"]
+
+	// Rename the first field by ID, keep
+	// the second as-is, and add a new one.
+	updateFields := []apimodel.UpdateField{
+		{
+			ID:    util.Ptr("01JC0Z1F6Y4R6XW3C5V0K6D7QA"),
+			Name:  util.Ptr("should you follow me??"),
+			Value: util.Ptr("maybe!"),
+		},
+		{
+			Name:  util.Ptr("age"),
+			Value: util.Ptr("120"),
+		},
+		{
+			Name:  util.Ptr("pronouns"),
+			Value: util.Ptr("she/her"),
+		},
+	}
+
+	apiAccount, errWithCode := suite.accountProcessor.Update(suite.T().Context(), testAccount, &apimodel.UpdateCredentialsRequest{
+		FieldsAttributes: &updateFields,
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Existing fields should keep their IDs.
+	suite.Equal("01JC0Z1F6Y4R6XW3C5V0K6D7QA", apiAccount.Fields[0].ID)
+	suite.Equal("should you follow me??", apiAccount.Fields[0].Name)
+	suite.Equal("01JC0Z1F6Y4R6XW3C5V0K6D7QB", apiAccount.Fields[1].ID)
+
+	// New field should get a new ID.
+	suite.NotEmpty(apiAccount.Fields[2].ID)
+	suite.NotEqual("01JC0Z1F6Y4R6XW3C5V0K6D7QA", apiAccount.Fields[2].ID)
+	suite.NotEqual("01JC0Z1F6Y4R6XW3C5V0K6D7QB", apiAccount.Fields[2].ID)
+
+	// We should have an update in the client api channel.
+	msg, _ := suite.getClientMsg(5 * time.Second)
+	suite.Equal(ap.ActivityUpdate, msg.APActivityType)
+}
+
+func (suite *AccountUpdateTestSuite) TestAccountUpdateFieldsOrder() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_2"]
+
+	var (
+		ctx   = suite.T().Context()
+		order = []string{
+			"01JC0Z1F6Y4R6XW3C5V0K6D7QB",
+			"01JC0Z1F6Y4R6XW3C5V0K6D7QA",
+		}
+	)
+
+	apiAccount, errWithCode := suite.accountProcessor.Update(ctx, testAccount, &apimodel.UpdateCredentialsRequest{
+		FieldsOrder: &order,
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Fields should be swapped around,
+	// without being otherwise changed.
+	suite.Equal([]apimodel.Field{
+		{
+			ID:    "01JC0Z1F6Y4R6XW3C5V0K6D7QB",
+			Name:  "age",
+			Value: "120",
+		},
+		{
+			ID:    "01JC0Z1F6Y4R6XW3C5V0K6D7QA",
+			Name:  "should you follow me?",
+			Value: "maybe!",
+		},
+	}, apiAccount.Source.Fields)
+	suite.Equal("age", apiAccount.Fields[0].Name)
+	suite.Equal("should you follow me?", apiAccount.Fields[1].Name)
+
+	// We should have an update in the client api channel.
+	msg, _ := suite.getClientMsg(5 * time.Second)
+	suite.Equal(ap.ActivityUpdate, msg.APActivityType)
+
+	// Check database model of account as well.
+	dbAccount, err := suite.db.GetAccountByID(ctx, testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("01JC0Z1F6Y4R6XW3C5V0K6D7QB", dbAccount.FieldsRaw[0].ID)
+	suite.Equal("01JC0Z1F6Y4R6XW3C5V0K6D7QB", dbAccount.Fields[0].ID)
+	suite.Equal("01JC0Z1F6Y4R6XW3C5V0K6D7QA", dbAccount.FieldsRaw[1].ID)
+	suite.Equal("01JC0Z1F6Y4R6XW3C5V0K6D7QA", dbAccount.Fields[1].ID)
+}
+
+func (suite *AccountUpdateTestSuite) TestAccountUpdateFieldsOrderInvalid() {
+	for _, test := range []struct {
+		order  []string
+		expect string
+	}{
+		{
+			order:  []string{"01JC0Z1F6Y4R6XW3C5V0K6D7QA"},
+			expect: "Bad Request: fields_order must contain the ID of every profile field",
+		},
+		{
+			order:  []string{"01JC0Z1F6Y4R6XW3C5V0K6D7QA", "01JC0Z1F6Y4R6XW3C5V0K6D7QA"},
+			expect: "Bad Request: fields_order contains field ID 01JC0Z1F6Y4R6XW3C5V0K6D7QA more than once",
+		},
+		{
+			order:  []string{"01JC0Z1F6Y4R6XW3C5V0K6D7QA", "01JC0Z1F6Y4R6XW3C5V0K6D7QC"},
+			expect: "Bad Request: fields_order contains unknown field ID 01JC0Z1F6Y4R6XW3C5V0K6D7QC",
+		},
+	} {
+		testAccount := &gtsmodel.Account{}
+		*testAccount = *suite.testAccounts["local_account_2"]
+
+		_, errWithCode := suite.accountProcessor.Update(suite.T().Context(), testAccount, &apimodel.UpdateCredentialsRequest{
+			FieldsOrder: &test.order,
+		})
+		if suite.NotNil(errWithCode) {
+			suite.Equal(test.expect, errWithCode.Safe())
+		}
+	}
+}
+
 func (suite *AccountUpdateTestSuite) TestAccountUpdateNoteNotFields() {
 	// local_account_2 already has some fields set.
 	// We want to ensure that the fields don't change
@@ -331,6 +512,40 @@ func (suite *AccountUpdateTestSuite) TestAccountUpdateNoteNotFields() {
 	suite.Equal(fieldsBefore, len(dbAccount.Fields))
 }
 
+func (suite *AccountUpdateTestSuite) TestAccountUpdateContentTypeShorthand() {
+	// Copy zork.
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+
+	// Copy zork's settings.
+	settings := &gtsmodel.AccountSettings{}
+	*settings = *suite.testAccounts["local_account_1"].Settings
+	testAccount.Settings = settings
+
+	ctx := suite.T().Context()
+
+	apiAccount, errWithCode := suite.accountProcessor.Update(ctx, testAccount, &apimodel.UpdateCredentialsRequest{
+		Source: &apimodel.UpdateSource{
+			StatusContentType: util.Ptr("markdown"),
+		},
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Shorthand should be stored + returned normalized.
+	suite.Equal("text/markdown", apiAccount.Source.StatusContentType)
+
+	// Drain the profile update msg.
+	_, _ = suite.getClientMsg(5 * time.Second)
+
+	dbSettings, err := suite.db.GetAccountSettings(ctx, testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("text/markdown", dbSettings.StatusContentType)
+}
+
 func (suite *AccountUpdateTestSuite) TestAccountUpdateBotNotBot() {
 	testAccount := &gtsmodel.Account{}
 	*testAccount = *suite.testAccounts["local_account_1"]
@@ -389,6 +604,93 @@ func (suite *AccountUpdateTestSuite) TestAccountUpdateBotNotBot() {
 	suite.False(dbAccount.ActorType.IsBot())
 }
 
+func (suite *AccountUpdateTestSuite) TestAccountUpdateAutoDelete() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+	testAccount.Settings = &gtsmodel.AccountSettings{}
+	*testAccount.Settings = *suite.testAccounts["local_account_1"].Settings
+	ctx := suite.T().Context()
+
+	// Out of range value should be rejected.
+	_, errWithCode := suite.accountProcessor.Update(
+		ctx,
+		testAccount,
+		&apimodel.UpdateCredentialsRequest{
+			AutoDeleteAfterDays: util.Ptr(-1),
+		},
+	)
+	suite.NotNil(errWithCode)
+
+	apiAccount, errWithCode := suite.accountProcessor.Update(
+		ctx,
+		testAccount,
+		&apimodel.UpdateCredentialsRequest{
+			AutoDeleteAfterDays: util.Ptr(90),
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Returned source should be updated.
+	suite.Equal(90, apiAccount.Source.AutoDeleteAfterDays)
+
+	// We should have an update in the client api channel.
+	msg, _ := suite.getClientMsg(5 * time.Second)
+	suite.NotNil(msg)
+
+	// Check database model of settings as well.
+	settings, err := suite.db.GetAccountSettings(ctx, testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(90, settings.AutoDeleteAfterDays)
+}
+
+func (suite *AccountUpdateTestSuite) TestAccountUpdateAccentColor() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+	testAccount.Settings = &gtsmodel.AccountSettings{}
+	*testAccount.Settings = *suite.testAccounts["local_account_1"].Settings
+	ctx := suite.T().Context()
+
+	// Non-hex color should be rejected.
+	_, errWithCode := suite.accountProcessor.Update(
+		ctx,
+		testAccount,
+		&apimodel.UpdateCredentialsRequest{
+			AccentColor: util.Ptr("hotpink"),
+		},
+	)
+	suite.NotNil(errWithCode)
+
+	apiAccount, errWithCode := suite.accountProcessor.Update(
+		ctx,
+		testAccount,
+		&apimodel.UpdateCredentialsRequest{
+			AccentColor: util.Ptr("#FF00AA"),
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Returned account should be updated.
+	suite.Equal("#ff00aa", apiAccount.AccentColor)
+
+	// We should have an update in the client api channel.
+	msg, _ := suite.getClientMsg(5 * time.Second)
+	suite.NotNil(msg)
+
+	// Accent color should now be
+	// exposed via the account's CSS.
+	customCSS, errWithCode := suite.accountProcessor.GetCustomCSSForUsername(ctx, testAccount.Username)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Contains(customCSS, "--profile-accent: #ff00aa;")
+}
+
 func TestAccountUpdateTestSuite(t *testing.T) {
 	suite.Run(t, new(AccountUpdateTestSuite))
 }
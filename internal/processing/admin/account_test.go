@@ -76,6 +76,75 @@ func (suite *AccountTestSuite) TestAccountActionSuspend() {
 	suite.NotZero(targetAcct.SuspendedAt)
 }
 
+func (suite *AccountTestSuite) TestAccountActionRotateKeys() {
+	var (
+		ctx       = suite.T().Context()
+		adminAcct = suite.testAccounts["admin_account"]
+		oldAcct   = suite.testAccounts["local_account_1"]
+		request   = &apimodel.AdminActionRequest{
+			Category: gtsmodel.AdminActionCategoryAccount.String(),
+			Type:     gtsmodel.AdminActionRotateKeys.String(),
+			TargetID: oldAcct.ID,
+		}
+	)
+
+	actionID, errWithCode := suite.adminProcessor.AccountAction(
+		ctx,
+		adminAcct,
+		request,
+	)
+	suite.NoError(errWithCode)
+	suite.NotEmpty(actionID)
+
+	// Wait for action to finish.
+	if !testrig.WaitFor(func() bool {
+		return suite.state.AdminActions.TotalRunning() == 0
+	}) {
+		suite.FailNow("timed out waiting for admin action(s) to finish")
+	}
+
+	adminAction, err := suite.db.GetAdminAction(ctx, actionID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.NotZero(adminAction.CompletedAt)
+	suite.Empty(adminAction.Errors)
+
+	// Ensure target account has new keys,
+	// with the old public key retained.
+	targetAcct, err := suite.db.GetAccountByID(ctx, request.TargetID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.False(oldAcct.PublicKey.Equal(targetAcct.PublicKey))
+	suite.True(oldAcct.PublicKey.Equal(targetAcct.PreviousPublicKey))
+	suite.Equal(oldAcct.PublicKeyURI, targetAcct.PreviousPublicKeyURI)
+	suite.Regexp(`^http://localhost:8080/users/the_mighty_zork/main-key/[0-9A-Z]{26}$`, targetAcct.PublicKeyURI)
+	suite.True(targetAcct.PreviousPubKeyValid())
+}
+
+func (suite *AccountTestSuite) TestAccountActionRotateKeysRemote() {
+	var (
+		ctx       = suite.T().Context()
+		adminAcct = suite.testAccounts["admin_account"]
+		request   = &apimodel.AdminActionRequest{
+			Category: gtsmodel.AdminActionCategoryAccount.String(),
+			Type:     gtsmodel.AdminActionRotateKeys.String(),
+			TargetID: suite.testAccounts["remote_account_1"].ID,
+		}
+	)
+
+	actionID, errWithCode := suite.adminProcessor.AccountAction(
+		ctx,
+		adminAcct,
+		request,
+	)
+	suite.ErrorContains(errWithCode, "is not a local account")
+	suite.Empty(actionID)
+}
+
 func (suite *AccountTestSuite) TestAccountActionUnsupported() {
 	var (
 		ctx       = suite.T().Context()
@@ -93,7 +162,7 @@ func (suite *AccountTestSuite) TestAccountActionUnsupported() {
 		adminAcct,
 		request,
 	)
-	suite.EqualError(errWithCode, "admin action type pee pee poo poo is not supported for this endpoint, currently supported types are: [\"suspend\"]")
+	suite.EqualError(errWithCode, "admin action type pee pee poo poo is not supported for this endpoint, currently supported types are: [\"suspend\" \"rotate-keys\"]")
 	suite.Empty(actionID)
 }
 
@@ -44,11 +44,15 @@ func (p *Processor) AccountAction(
 	case gtsmodel.AdminActionSuspend:
 		return p.accountActionSuspend(ctx, adminAcct, targetAcct, request.Text)
 
+	case gtsmodel.AdminActionRotateKeys:
+		return p.accountActionRotateKeys(ctx, adminAcct, targetAcct, request.Text)
+
 	default:
 		// TODO: add more types to this slice when adding
 		//       more types to the switch statement above.
 		supportedTypes := []string{
 			gtsmodel.AdminActionSuspend.String(),
+			gtsmodel.AdminActionRotateKeys.String(),
 		}
 
 		err := fmt.Errorf(
@@ -100,3 +104,50 @@ func(ctx context.Context) gtserror.MultiError {
 
 	return actionID, errWithCode
 }
+
+func (p *Processor) accountActionRotateKeys(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	targetAcct *gtsmodel.Account,
+	text string,
+) (string, gtserror.WithCode) {
+	if targetAcct.IsRemote() {
+		err := fmt.Errorf("account %s is not a local account", targetAcct.ID)
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	actionID := id.NewULID()
+
+	errWithCode := p.state.AdminActions.Run(
+		ctx,
+		&gtsmodel.AdminAction{
+			ID:             actionID,
+			TargetCategory: gtsmodel.AdminActionCategoryAccount,
+			TargetID:       targetAcct.ID,
+			Target:         targetAcct,
+			Type:           gtsmodel.AdminActionRotateKeys,
+			AccountID:      adminAcct.ID,
+			Text:           text,
+		},
+		func(ctx context.Context) gtserror.MultiError {
+			if err := p.state.DB.RotateAccountKeys(ctx, targetAcct); err != nil {
+				errs := gtserror.NewMultiError(1)
+				errs.Append(err)
+				return errs
+			}
+
+			// Federate the updated key out
+			// to remotes with an actor Update.
+			p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
+				APObjectType:   ap.ActorPerson,
+				APActivityType: ap.ActivityUpdate,
+				GTSModel:       targetAcct,
+				Origin:         targetAcct,
+			})
+
+			return nil
+		},
+	)
+
+	return actionID, errWithCode
+}
@@ -21,10 +21,12 @@
 	"code.superseriousbusiness.org/gotosocial/internal/cleaner"
 	"code.superseriousbusiness.org/gotosocial/internal/email"
 	"code.superseriousbusiness.org/gotosocial/internal/federation"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/media"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/common"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
 	"code.superseriousbusiness.org/gotosocial/internal/subscriptions"
+	"code.superseriousbusiness.org/gotosocial/internal/text"
 	"code.superseriousbusiness.org/gotosocial/internal/transport"
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
 )
@@ -41,6 +43,8 @@ type Processor struct {
 	media         *media.Manager
 	transport     transport.Controller
 	email         email.Sender
+	formatter     *text.Formatter
+	parseMention  gtsmodel.ParseMentionFunc
 }
 
 // New returns a new admin processor.
@@ -54,6 +58,7 @@ func New(
 	mediaManager *media.Manager,
 	transportController transport.Controller,
 	emailSender email.Sender,
+	parseMention gtsmodel.ParseMentionFunc,
 ) Processor {
 	return Processor{
 		c:             common,
@@ -65,5 +70,7 @@ func New(
 		media:         mediaManager,
 		transport:     transportController,
 		email:         emailSender,
+		formatter:     text.NewFormatter(state.DB),
+		parseMention:  parseMention,
 	}
 }
@@ -0,0 +1,60 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+)
+
+// DebugRetention returns the current size of data subject
+// to retention settings, ie., stored notifications and
+// cached home timelines, to help admins tune those settings.
+func (p *Processor) DebugRetention(ctx context.Context) (*apimodel.DebugRetentionResponse, gtserror.WithCode) {
+	maxAge := config.GetNotificationsMaxAge()
+
+	total, err := p.state.DB.CountNotifications(ctx, time.Time{})
+	if err != nil {
+		err := gtserror.Newf("db error counting notifications: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	var prunable int
+	if maxAge > 0 {
+		prunable, err = p.state.DB.CountNotifications(ctx, time.Now().Add(-maxAge))
+		if err != nil {
+			err := gtserror.Newf("db error counting prunable notifications: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	timelines, entries := p.state.Caches.Timelines.Home.Stats()
+
+	return &apimodel.DebugRetentionResponse{
+		NotificationsTotal:     total,
+		NotificationsPrunable:  prunable,
+		NotificationsMaxAge:    maxAge.String(),
+		HomeTimelines:          timelines,
+		HomeTimelineEntries:    entries,
+		HomeTimelineMaxEntries: config.GetCacheHomeTimelineMaxEntries(),
+	}, nil
+}
@@ -0,0 +1,73 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"runtime"
+	"time"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// DebugRuntime returns current Go runtime statistics,
+// and the sizes of in-memory caches, to help admins
+// diagnose memory growth on a running instance.
+func (p *Processor) DebugRuntime(ctx context.Context) *apimodel.DebugRuntimeResponse {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	var lastGC string
+	if mem.LastGC != 0 {
+		lastGC = util.FormatISO8601(time.Unix(0, int64(mem.LastGC))) // #nosec G115 -- nanoseconds since epoch
+	}
+
+	sizes := p.state.Caches.Sizes()
+	caches := make(map[string]apimodel.DebugRuntimeCache, len(sizes))
+	for name, size := range sizes {
+		caches[name] = apimodel.DebugRuntimeCache{
+			Len: size.Len,
+			Cap: size.Cap,
+		}
+	}
+
+	return &apimodel.DebugRuntimeResponse{
+		GoVersion:  runtime.Version(),
+		NumCPU:     runtime.NumCPU(),
+		GOMAXPROCS: runtime.GOMAXPROCS(0),
+		Goroutines: runtime.NumGoroutine(),
+		Memory: apimodel.DebugRuntimeMemory{
+			HeapAlloc:    mem.HeapAlloc,
+			HeapInuse:    mem.HeapInuse,
+			HeapIdle:     mem.HeapIdle,
+			HeapReleased: mem.HeapReleased,
+			HeapObjects:  mem.HeapObjects,
+			StackInuse:   mem.StackInuse,
+			Sys:          mem.Sys,
+		},
+		GC: apimodel.DebugRuntimeGC{
+			NumGC:       mem.NumGC,
+			NextGC:      mem.NextGC,
+			LastGC:      lastGC,
+			PauseTotal:  time.Duration(mem.PauseTotalNs).String(), // #nosec G115 -- won't overflow in practice
+			CPUFraction: mem.GCCPUFraction,
+		},
+		Caches: caches,
+	}
+}
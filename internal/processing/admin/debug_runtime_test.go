@@ -0,0 +1,61 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DebugRuntimeTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *DebugRuntimeTestSuite) TestDebugRuntime() {
+	ctx := suite.T().Context()
+
+	// Fetch an account so the
+	// account cache isn't empty.
+	account := suite.testAccounts["local_account_1"]
+	if _, err := suite.state.DB.GetAccountByID(ctx, account.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	resp := suite.adminProcessor.DebugRuntime(ctx)
+	suite.Equal(runtime.Version(), resp.GoVersion)
+	suite.Equal(runtime.NumCPU(), resp.NumCPU)
+	suite.Equal(runtime.GOMAXPROCS(0), resp.GOMAXPROCS)
+	suite.Positive(resp.Goroutines)
+	suite.NotZero(resp.Memory.HeapAlloc)
+	suite.NotZero(resp.Memory.Sys)
+	suite.NotEmpty(resp.GC.PauseTotal)
+
+	// Every cache should be included, with
+	// the account cache now holding entries.
+	suite.Len(resp.Caches, len(suite.state.Caches.Sizes()))
+	accountCache, ok := resp.Caches["DB.Account"]
+	suite.True(ok)
+	suite.Positive(accountCache.Len)
+	suite.Positive(accountCache.Cap)
+}
+
+func TestDebugRuntimeTestSuite(t *testing.T) {
+	suite.Run(t, new(DebugRuntimeTestSuite))
+}
@@ -0,0 +1,197 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/cache/domain"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// DomainHierarchyGet returns the tree of all blocked and
+// limited domains on this instance, where subdomains are
+// nested beneath their closest blocked / limited parent.
+//
+// Entries that are shadowed by an entry on a parent domain
+// (or, for limits, by a block) are marked as such, using
+// the same matching logic as the domain caches, so that
+// admins can find and clean up redundant entries.
+func (p *Processor) DomainHierarchyGet(ctx context.Context) ([]*apimodel.DomainHierarchyNode, gtserror.WithCode) {
+	blocks, err := p.state.DB.GetDomainBlocks(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting domain blocks: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	limits, err := p.state.DB.GetDomainLimits(ctx, nil)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting domain limits: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Gather a node for each
+	// blocked / limited domain,
+	// keyed by stored domain.
+	var (
+		nodes        = make(map[string]*apimodel.DomainHierarchyNode)
+		blockDomains = make([]string, 0, len(blocks))
+		limitDomains = make([]string, 0, len(limits))
+	)
+
+	getNode := func(d string) (*apimodel.DomainHierarchyNode, error) {
+		if node, ok := nodes[d]; ok {
+			return node, nil
+		}
+
+		display, err := util.DePunify(d)
+		if err != nil {
+			return nil, gtserror.Newf("error de-punifying %s: %w", d, err)
+		}
+
+		node := &apimodel.DomainHierarchyNode{Domain: display}
+		nodes[d] = node
+		return node, nil
+	}
+
+	for _, block := range blocks {
+		node, err := getNode(block.Domain)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		node.BlockID = block.ID
+		blockDomains = append(blockDomains, block.Domain)
+	}
+
+	for _, limit := range limits {
+		node, err := getNode(limit.Domain)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		node.LimitID = limit.ID
+		limitDomains = append(limitDomains, limit.Domain)
+	}
+
+	// Load domains into local domain caches, so we
+	// match entries the same way as the DB caches,
+	// where the highest-level matching domain wins.
+	var blocked, limited domain.Cache
+	loadBlocks := func() ([]string, error) { return blockDomains, nil }
+	loadLimits := func() ([]string, error) { return limitDomains, nil }
+
+	for d, node := range nodes {
+		if node.BlockID != "" {
+			matchedOn, err := blocked.MatchesOn(d, loadBlocks)
+			if err != nil {
+				err := gtserror.Newf("error matching block %s: %w", d, err)
+				return nil, gtserror.NewErrorInternalError(err)
+			}
+
+			if matchedOn != d {
+				node.BlockShadowedBy, err = util.DePunify(matchedOn)
+				if err != nil {
+					err := gtserror.Newf("error de-punifying %s: %w", matchedOn, err)
+					return nil, gtserror.NewErrorInternalError(err)
+				}
+			}
+		}
+
+		if node.LimitID != "" {
+			// Limits have no effect on blocked
+			// domains, so check if this domain
+			// is blocked first (accounting for
+			// explicit domain allows).
+			isBlocked, err := p.state.DB.IsDomainBlocked(ctx, d)
+			if err != nil {
+				err := gtserror.Newf("db error checking domain block %s: %w", d, err)
+				return nil, gtserror.NewErrorInternalError(err)
+			}
+
+			var matchedOn string
+			if isBlocked {
+				matchedOn, err = blocked.MatchesOn(d, loadBlocks)
+			} else {
+				matchedOn, err = limited.MatchesOn(d, loadLimits)
+			}
+			if err != nil {
+				err := gtserror.Newf("error matching limit %s: %w", d, err)
+				return nil, gtserror.NewErrorInternalError(err)
+			}
+
+			if isBlocked || matchedOn != d {
+				node.LimitShadowedBy, err = util.DePunify(matchedOn)
+				if err != nil {
+					err := gtserror.Newf("error de-punifying %s: %w", matchedOn, err)
+					return nil, gtserror.NewErrorInternalError(err)
+				}
+			}
+		}
+	}
+
+	// Nest each node beneath its closest
+	// blocked / limited parent domain, if any.
+	roots := make([]*apimodel.DomainHierarchyNode, 0)
+	for d, node := range nodes {
+		if parent := closestParent(d, nodes); parent != nil {
+			parent.Children = append(parent.Children, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+
+	sortDomainHierarchy(roots)
+	return roots, nil
+}
+
+// closestParent returns the node for the closest parent
+// domain of d that's present in nodes, or nil if none.
+func closestParent(
+	d string,
+	nodes map[string]*apimodel.DomainHierarchyNode,
+) *apimodel.DomainHierarchyNode {
+	for {
+		_, parent, ok := strings.Cut(d, ".")
+		if !ok {
+			return nil
+		}
+
+		if node, ok := nodes[parent]; ok {
+			return node
+		}
+
+		d = parent
+	}
+}
+
+// sortDomainHierarchy recursively sorts
+// the given nodes alphabetically by domain.
+func sortDomainHierarchy(nodes []*apimodel.DomainHierarchyNode) {
+	slices.SortFunc(nodes, func(a, b *apimodel.DomainHierarchyNode) int {
+		return strings.Compare(a.Domain, b.Domain)
+	})
+
+	for _, node := range nodes {
+		sortDomainHierarchy(node.Children)
+	}
+}
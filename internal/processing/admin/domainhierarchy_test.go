@@ -0,0 +1,123 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type DomainHierarchyTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *DomainHierarchyTestSuite) TestDomainHierarchyGet() {
+	var (
+		ctx     = suite.T().Context()
+		adminID = suite.testAccounts["admin_account"].ID
+	)
+
+	// Add blocks beneath the existing
+	// fossbros-anonymous.io limit, plus
+	// limits shadowed by existing entries.
+	for _, block := range []*gtsmodel.DomainBlock{
+		{
+			ID:     "01K9E4V3M8N2B7Q5X1C6Z0D9F4",
+			Domain: "bad.fossbros-anonymous.io",
+		},
+		{
+			ID:     "01K9E4V3M8H6F1J9D3K5S8A2W7",
+			Domain: "very.bad.fossbros-anonymous.io",
+		},
+	} {
+		block.CreatedByAccountID = adminID
+		block.Obfuscate = util.Ptr(false)
+		if err := suite.db.PutDomainBlock(ctx, block); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	for _, limit := range []*gtsmodel.DomainLimit{
+		{
+			ID:     "01K9E4V3M8QJ2W6T4R9Y1H5K7N",
+			Domain: "sub.fossbros-anonymous.io",
+		},
+		{
+			ID:     "01K9E4V3M8X5P3L8G2S7V4B1A6",
+			Domain: "social.replyguys.com",
+		},
+	} {
+		limit.CreatedByAccountID = adminID
+		limit.MediaPolicy = gtsmodel.MediaPolicyReject
+		limit.FollowsPolicy = gtsmodel.FollowsPolicyNoAction
+		limit.StatusesPolicy = gtsmodel.StatusesPolicyNoAction
+		limit.AccountsPolicy = gtsmodel.AccountsPolicyNoAction
+		if err := suite.db.PutDomainLimit(ctx, limit); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	hierarchy, errWithCode := suite.adminProcessor.DomainHierarchyGet(ctx)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Equal([]*apimodel.DomainHierarchyNode{
+		{
+			Domain:  "fossbros-anonymous.io",
+			LimitID: "01K8TE4ES467FGYGRKVPDM6RF6",
+			Children: []*apimodel.DomainHierarchyNode{
+				{
+					Domain:  "bad.fossbros-anonymous.io",
+					BlockID: "01K9E4V3M8N2B7Q5X1C6Z0D9F4",
+					Children: []*apimodel.DomainHierarchyNode{
+						{
+							Domain:          "very.bad.fossbros-anonymous.io",
+							BlockID:         "01K9E4V3M8H6F1J9D3K5S8A2W7",
+							BlockShadowedBy: "bad.fossbros-anonymous.io",
+						},
+					},
+				},
+				{
+					Domain:          "sub.fossbros-anonymous.io",
+					LimitID:         "01K9E4V3M8QJ2W6T4R9Y1H5K7N",
+					LimitShadowedBy: "fossbros-anonymous.io",
+				},
+			},
+		},
+		{
+			Domain:  "replyguys.com",
+			BlockID: "01FF22EQM7X8E3RX1XGPN7S87D",
+			Children: []*apimodel.DomainHierarchyNode{
+				{
+					Domain:          "social.replyguys.com",
+					LimitID:         "01K9E4V3M8X5P3L8G2S7V4B1A6",
+					LimitShadowedBy: "replyguys.com",
+				},
+			},
+		},
+	}, hierarchy)
+}
+
+func TestDomainHierarchyTestSuite(t *testing.T) {
+	suite.Run(t, new(DomainHierarchyTestSuite))
+}
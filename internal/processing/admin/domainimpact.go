@@ -0,0 +1,112 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// domainImpactWindow is how far back to
+// look when counting recent interactions.
+const domainImpactWindow = 30 * 24 * time.Hour
+
+// DomainImpactGet returns an overview of how much local
+// activity involves the given remote domain (and its
+// subdomains), to help evaluate the fallout of a block.
+//
+// Results are cached for a few minutes, as the
+// underlying counts can be expensive to compute.
+func (p *Processor) DomainImpactGet(
+	ctx context.Context,
+	domain string,
+) (*apimodel.DomainImpact, gtserror.WithCode) {
+	domain, err := util.Punify(domain)
+	if err != nil {
+		err := gtserror.Newf("error punifying domain %s: %w", domain, err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if domain == config.GetHost() || domain == config.GetAccountDomain() {
+		err := errors.New("provided domain was this domain, but must be a remote domain")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if impact, ok := p.state.Caches.DomainImpact.Get(domain); ok {
+		return impact, nil
+	}
+
+	impact, err := p.domainImpact(ctx, domain)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.state.Caches.DomainImpact.Set(domain, impact)
+	return impact, nil
+}
+
+// domainImpact computes a fresh DomainImpact for domain.
+func (p *Processor) domainImpact(
+	ctx context.Context,
+	domain string,
+) (*apimodel.DomainImpact, error) {
+	now := time.Now()
+	impact := &apimodel.DomainImpact{
+		Domain:     domain,
+		ComputedAt: util.FormatISO8601(now),
+	}
+
+	var err error
+
+	impact.AccountsCount, err = p.state.DB.CountDomainAccounts(ctx, domain)
+	if err != nil {
+		return nil, gtserror.Newf("error counting accounts: %w", err)
+	}
+
+	impact.StatusesCount, err = p.state.DB.CountDomainStatuses(ctx, domain)
+	if err != nil {
+		return nil, gtserror.Newf("error counting statuses: %w", err)
+	}
+
+	impact.MediaCount, impact.MediaSize, err = p.state.DB.CountDomainMedia(ctx, domain)
+	if err != nil {
+		return nil, gtserror.Newf("error counting media: %w", err)
+	}
+
+	impact.LocalFollowersCount, impact.RemoteFollowersCount, err = p.state.DB.CountDomainFollows(ctx, domain)
+	if err != nil {
+		return nil, gtserror.Newf("error counting follows: %w", err)
+	}
+
+	impact.RecentInteractionsFromLocal, impact.RecentInteractionsToLocal, err = p.state.DB.CountDomainInteractions(
+		ctx,
+		domain,
+		now.Add(-domainImpactWindow),
+	)
+	if err != nil {
+		return nil, gtserror.Newf("error counting interactions: %w", err)
+	}
+
+	return impact, nil
+}
@@ -0,0 +1,84 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"github.com/stretchr/testify/suite"
+)
+
+type DomainImpactTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *DomainImpactTestSuite) TestDomainImpactGet() {
+	var (
+		ctx       = suite.T().Context()
+		zork      = suite.testAccounts["local_account_1"]
+		fossSatan = suite.testAccounts["remote_account_1"]
+	)
+
+	// Have zork follow foss_satan.
+	if err := suite.db.PutFollow(ctx, &gtsmodel.Follow{
+		ID:              "01J9SRCJ4Q4C1NSAEG3CRZYKDZ",
+		URI:             "http://localhost:8080/users/the_mighty_zork/follow/01J9SRCJ4Q4C1NSAEG3CRZYKDZ",
+		AccountID:       zork.ID,
+		TargetAccountID: fossSatan.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	impact, errWithCode := suite.adminProcessor.DomainImpactGet(ctx, "fossbros-anonymous.io")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Equal("fossbros-anonymous.io", impact.Domain)
+	suite.Equal(1, impact.AccountsCount)
+	suite.Equal(1, impact.LocalFollowersCount)
+	suite.Equal(0, impact.RemoteFollowersCount)
+	suite.Equal(4, impact.StatusesCount)
+	suite.Equal(1, impact.MediaCount)
+	suite.Equal(39705, impact.MediaSize)
+	suite.Equal(0, impact.RecentInteractionsFromLocal)
+	suite.Equal(0, impact.RecentInteractionsToLocal)
+	suite.NotEmpty(impact.ComputedAt)
+
+	// A second call should be served from cache.
+	cached, errWithCode := suite.adminProcessor.DomainImpactGet(ctx, "fossbros-anonymous.io")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Same(impact, cached)
+}
+
+func (suite *DomainImpactTestSuite) TestDomainImpactGetLocal() {
+	ctx := suite.T().Context()
+
+	_, errWithCode := suite.adminProcessor.DomainImpactGet(ctx, config.GetHost())
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func TestDomainImpactTestSuite(t *testing.T) {
+	suite.Run(t, new(DomainImpactTestSuite))
+}
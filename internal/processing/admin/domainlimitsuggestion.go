@@ -0,0 +1,236 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+)
+
+// DomainLimitSuggestionGet returns one
+// domain limit suggestion with the given id.
+func (p *Processor) DomainLimitSuggestionGet(
+	ctx context.Context,
+	id string,
+) (*apimodel.DomainLimitSuggestion, gtserror.WithCode) {
+	suggestion, errWithCode := p.getDomainLimitSuggestion(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiDomainLimitSuggestion(ctx, suggestion)
+}
+
+// DomainLimitSuggestionsGet returns a page of
+// DomainLimitSuggestions with the given parameters.
+func (p *Processor) DomainLimitSuggestionsGet(
+	ctx context.Context,
+	subscriptionID string,
+	domain string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	suggestions, err := p.state.DB.GetDomainLimitSuggestions(
+		ctx,
+		subscriptionID,
+		domain,
+		page,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(suggestions)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := suggestions[count-1].ID
+	hi := suggestions[0].ID
+
+	// Convert each suggestion to API model.
+	items := make([]any, len(suggestions))
+	for i, suggestion := range suggestions {
+		apiSuggestion, errWithCode := p.apiDomainLimitSuggestion(ctx, suggestion)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		items[i] = apiSuggestion
+	}
+
+	// Assemble next/prev page queries.
+	query := make(url.Values, 2)
+	if subscriptionID != "" {
+		query.Set(apiutil.DomainPermissionSubscriptionIDKey, subscriptionID)
+	}
+	if domain != "" {
+		query.Set(apiutil.DomainPermissionDomainKey, domain)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/admin/domain_limit_suggestions",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+		Query: query,
+	}), nil
+}
+
+// DomainLimitSuggestionAccept turns the domain limit suggestion
+// with the given id into an enforced domain limit, removing the
+// suggestion. Returns the newly created domain limit.
+func (p *Processor) DomainLimitSuggestionAccept(
+	ctx context.Context,
+	acct *gtsmodel.Account,
+	suggestionID string,
+) (*apimodel.DomainLimit, gtserror.WithCode) {
+	suggestion, errWithCode := p.getDomainLimitSuggestion(ctx, suggestionID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Create + store domain limit
+	// using the suggested policies.
+	domainLimit := &gtsmodel.DomainLimit{
+		ID:                 id.NewULID(),
+		Domain:             suggestion.Domain,
+		CreatedByAccountID: acct.ID,
+		CreatedByAccount:   acct,
+		PublicComment:      suggestion.PublicComment,
+		MediaPolicy:        suggestion.MediaPolicy,
+		FollowsPolicy:      suggestion.FollowsPolicy,
+		StatusesPolicy:     suggestion.StatusesPolicy,
+		AccountsPolicy:     suggestion.AccountsPolicy,
+	}
+
+	switch err := p.state.DB.PutDomainLimit(ctx, domainLimit); {
+	case err == nil:
+		// No problem.
+
+	case errors.Is(err, db.ErrAlreadyExists):
+		text := "limit with domain " + suggestion.Domain + " already exists"
+		return nil, gtserror.NewErrorConflict(errors.New(text), text)
+
+	default:
+		err := gtserror.Newf("db error storing domain limit: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Suggestion has been actioned, remove it. Any
+	// other pending suggestions for this domain will
+	// be retracted on next subscriptions refresh.
+	if err := p.state.DB.DeleteDomainLimitSuggestion(ctx, suggestion.ID); err != nil {
+		err := gtserror.Newf("db error deleting domain limit suggestion: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiDomainLimit, err := p.converter.DomainLimitToAPIDomainLimit(ctx, domainLimit)
+	if err != nil {
+		err := gtserror.Newf("error converting domain limit: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiDomainLimit, nil
+}
+
+// DomainLimitSuggestionRemove removes the domain limit suggestion
+// with the given id without applying it, optionally excluding its
+// domain from all future domain permission subscription actions.
+func (p *Processor) DomainLimitSuggestionRemove(
+	ctx context.Context,
+	acct *gtsmodel.Account,
+	id string,
+	excludeTarget bool,
+) (*apimodel.DomainLimitSuggestion, gtserror.WithCode) {
+	suggestion, errWithCode := p.getDomainLimitSuggestion(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Convert to API model *before* doing the deletion.
+	apiSuggestion, errWithCode := p.apiDomainLimitSuggestion(ctx, suggestion)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Delete the suggestion.
+	if err := p.state.DB.DeleteDomainLimitSuggestion(ctx, suggestion.ID); err != nil {
+		err := gtserror.Newf("db error deleting domain limit suggestion: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if excludeTarget {
+		// Add a domain permission exclude
+		// targeting the suggestion's domain.
+		_, err := p.DomainPermissionExcludeCreate(
+			ctx,
+			acct,
+			suggestion.Domain,
+			"",
+		)
+		if err != nil && !errors.Is(err, db.ErrAlreadyExists) {
+			err := gtserror.Newf("db error creating domain permission exclude: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	return apiSuggestion, nil
+}
+
+func (p *Processor) getDomainLimitSuggestion(
+	ctx context.Context,
+	id string,
+) (*gtsmodel.DomainLimitSuggestion, gtserror.WithCode) {
+	suggestion, err := p.state.DB.GetDomainLimitSuggestionByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting domain limit suggestion %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if suggestion == nil {
+		err := fmt.Errorf("domain limit suggestion %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	return suggestion, nil
+}
+
+func (p *Processor) apiDomainLimitSuggestion(
+	ctx context.Context,
+	suggestion *gtsmodel.DomainLimitSuggestion,
+) (*apimodel.DomainLimitSuggestion, gtserror.WithCode) {
+	apiSuggestion, err := p.converter.DomainLimitSuggestionToAPIDomainLimitSuggestion(ctx, suggestion)
+	if err != nil {
+		err := gtserror.Newf("error converting domain limit suggestion: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiSuggestion, nil
+}
@@ -0,0 +1,220 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// DomainLimitTest reports what would happen if a domain limit
+// with the given policies were created for the given domain,
+// without actually creating it. This accounts for existing
+// limits on the domain itself, on parent domains (which take
+// precedence), and on subdomains (which would be superseded),
+// as well as any domain block covering the domain.
+//
+// The result also previews how the limit would rewrite
+// a status with the given sample content warning.
+func (p *Processor) DomainLimitTest(
+	ctx context.Context,
+	domain string,
+	mediaPolicy apimodel.MediaPolicy,
+	followsPolicy apimodel.FollowsPolicy,
+	statusesPolicy apimodel.StatusesPolicy,
+	accountsPolicy apimodel.AccountsPolicy,
+	contentWarning string,
+	sampleContentWarning string,
+) (*apimodel.DomainLimitTest, gtserror.WithCode) {
+	// Parse policies.
+	mp, errWithCode := parseMediaPolicy(mediaPolicy)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	fp, errWithCode := parseFollowsPolicy(followsPolicy)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	sp, errWithCode := parseStatusesPolicy(statusesPolicy)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	ap, errWithCode := parseAccountsPolicy(accountsPolicy)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Normalize domain as it would be
+	// stored by DomainLimitCreate, which
+	// also rejects wildcards and the like.
+	domain, err := util.PunifySafely(domain)
+	if err != nil {
+		err := gtserror.Newf("error punifying domain %s: %w", domain, err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if domain == config.GetHost() || domain == config.GetAccountDomain() {
+		err := errors.New("provided domain was this domain, but must be a remote domain")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	test := &apimodel.DomainLimitTest{
+		Domain:           domain,
+		WouldApply:       true,
+		SupersededLimits: []*apimodel.DomainLimit{},
+		CoveredDomains:   []string{},
+		Conflicts:        []string{},
+	}
+
+	// Check for an existing limit
+	// covering this domain already.
+	matched, err := p.state.DB.MatchDomainLimit(ctx, domain)
+	if err != nil {
+		err := gtserror.Newf("db error matching domain limit: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if matched != nil {
+		test.WouldApply = false
+		test.MatchedLimit, err = p.converter.DomainLimitToAPIDomainLimit(ctx, matched)
+		if err != nil {
+			err := gtserror.Newf("error converting domain limit: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if matched.Domain == domain {
+			test.Conflicts = append(test.Conflicts,
+				"limit already exists for "+domain+", update it instead",
+			)
+		} else {
+			test.Conflicts = append(test.Conflicts,
+				"existing limit on "+matched.Domain+" already covers "+
+					domain+", and takes precedence",
+			)
+		}
+	}
+
+	// Check for existing limits on subdomains,
+	// which would stop taking effect since the
+	// highest-level matching limit always wins.
+	limits, err := p.state.DB.GetDomainLimits(ctx, nil)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting domain limits: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	for _, limit := range limits {
+		if !isSubdomain(limit.Domain, domain) {
+			continue
+		}
+
+		apiLimit, err := p.converter.DomainLimitToAPIDomainLimit(ctx, limit)
+		if err != nil {
+			err := gtserror.Newf("error converting domain limit: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		test.SupersededLimits = append(test.SupersededLimits, apiLimit)
+
+		if test.WouldApply {
+			test.Conflicts = append(test.Conflicts,
+				"existing limit on "+limit.Domain+" would be superseded by limit on "+domain,
+			)
+		}
+	}
+
+	slices.SortFunc(
+		test.SupersededLimits,
+		func(a *apimodel.DomainLimit, b *apimodel.DomainLimit) int {
+			return strings.Compare(a.Domain, b.Domain)
+		},
+	)
+
+	// Gather known instances that
+	// would be covered by the limit.
+	instances, err := p.state.DB.GetInstancePeers(ctx, true)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting instances: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	for _, instance := range instances {
+		if instance.Domain == domain || isSubdomain(instance.Domain, domain) {
+			test.CoveredDomains = append(test.CoveredDomains, instance.Domain)
+		}
+	}
+
+	slices.Sort(test.CoveredDomains)
+
+	// Limits don't do anything for
+	// domains that are blocked anyway.
+	test.Blocked, err = p.state.DB.IsDomainBlocked(ctx, domain)
+	if err != nil {
+		err := gtserror.Newf("db error checking domain block: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if test.Blocked {
+		test.WouldApply = false
+		test.Conflicts = append(test.Conflicts,
+			domain+" is blocked, limits have no effect while the block is in place",
+		)
+	}
+
+	// Preview how a status from the domain
+	// would look with the limit applied.
+	limit := &gtsmodel.DomainLimit{
+		MediaPolicy:    mp,
+		ContentWarning: contentWarning,
+	}
+	rewritten := limit.ApplyContentWarning(sampleContentWarning)
+	test.ContentWarningPreview = apimodel.DomainLimitContentWarningPreview{
+		Original:  sampleContentWarning,
+		Rewritten: rewritten,
+		Sensitive: rewritten != "" || limit.MediaMarkSensitive(),
+	}
+
+	if mp == gtsmodel.MediaPolicyNoAction &&
+		fp == gtsmodel.FollowsPolicyNoAction &&
+		sp == gtsmodel.StatusesPolicyNoAction &&
+		ap == gtsmodel.AccountsPolicyNoAction &&
+		contentWarning == "" {
+		test.Conflicts = append(test.Conflicts,
+			"no policies or content warning set, limit would have no effect",
+		)
+	}
+
+	return test, nil
+}
+
+// isSubdomain returns whether domain is a
+// (strict) subdomain of the given parent.
+func isSubdomain(domain string, parent string) bool {
+	return strings.HasSuffix(domain, "."+parent)
+}
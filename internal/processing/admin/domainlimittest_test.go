@@ -0,0 +1,176 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"github.com/stretchr/testify/suite"
+)
+
+type DomainLimitTestTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *DomainLimitTestTestSuite) test(domain string) (*apimodel.DomainLimitTest, int) {
+	test, errWithCode := suite.adminProcessor.DomainLimitTest(
+		suite.T().Context(),
+		domain,
+		apimodel.MediaPolicyReject,
+		apimodel.FollowsPolicyNoAction,
+		apimodel.StatusesPolicyNoAction,
+		apimodel.AccountsPolicyNoAction,
+		"",
+		"",
+	)
+	if errWithCode != nil {
+		return nil, errWithCode.Code()
+	}
+	return test, http.StatusOK
+}
+
+func (suite *DomainLimitTestTestSuite) TestDomainLimitTestExisting() {
+	test, code := suite.test("fossbros-anonymous.io")
+	suite.Equal(http.StatusOK, code)
+	suite.False(test.WouldApply)
+	suite.Equal("fossbros-anonymous.io", test.MatchedLimit.Domain)
+	suite.Equal([]string{"fossbros-anonymous.io"}, test.CoveredDomains)
+	suite.Equal([]string{
+		"limit already exists for fossbros-anonymous.io, update it instead",
+	}, test.Conflicts)
+}
+
+func (suite *DomainLimitTestTestSuite) TestDomainLimitTestParentLimited() {
+	test, code := suite.test("sub.fossbros-anonymous.io")
+	suite.Equal(http.StatusOK, code)
+	suite.False(test.WouldApply)
+	suite.Equal("fossbros-anonymous.io", test.MatchedLimit.Domain)
+	suite.Empty(test.CoveredDomains)
+	suite.Equal([]string{
+		"existing limit on fossbros-anonymous.io already covers sub.fossbros-anonymous.io, and takes precedence",
+	}, test.Conflicts)
+}
+
+func (suite *DomainLimitTestTestSuite) TestDomainLimitTestSupersedes() {
+	if err := suite.db.PutDomainLimit(suite.T().Context(), &gtsmodel.DomainLimit{
+		ID:                 "01K9DQ3ZQ2Y1Q8XW6V3F8S5N0A",
+		Domain:             "social.example.org",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		MediaPolicy:        gtsmodel.MediaPolicyMarkSensitive,
+		FollowsPolicy:      gtsmodel.FollowsPolicyNoAction,
+		StatusesPolicy:     gtsmodel.StatusesPolicyNoAction,
+		AccountsPolicy:     gtsmodel.AccountsPolicyNoAction,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	test, code := suite.test("example.org")
+	suite.Equal(http.StatusOK, code)
+	suite.True(test.WouldApply)
+	suite.Nil(test.MatchedLimit)
+	suite.Len(test.SupersededLimits, 1)
+	suite.Equal("social.example.org", test.SupersededLimits[0].Domain)
+	suite.Equal([]string{"example.org"}, test.CoveredDomains)
+	suite.False(test.Blocked)
+	suite.Equal([]string{
+		"existing limit on social.example.org would be superseded by limit on example.org",
+	}, test.Conflicts)
+}
+
+func (suite *DomainLimitTestTestSuite) TestDomainLimitTestBlocked() {
+	test, code := suite.test("replyguys.com")
+	suite.Equal(http.StatusOK, code)
+	suite.False(test.WouldApply)
+	suite.True(test.Blocked)
+	suite.Equal([]string{
+		"replyguys.com is blocked, limits have no effect while the block is in place",
+	}, test.Conflicts)
+}
+
+func (suite *DomainLimitTestTestSuite) TestDomainLimitTestBadDomain() {
+	_, code := suite.test("*.example.org")
+	suite.Equal(http.StatusBadRequest, code)
+
+	_, code = suite.test(config.GetHost())
+	suite.Equal(http.StatusBadRequest, code)
+}
+
+func (suite *DomainLimitTestTestSuite) TestDomainLimitTestContentWarningPreview() {
+	for _, testCase := range []struct {
+		mediaPolicy    apimodel.MediaPolicy
+		contentWarning string
+		sample         string
+		expected       apimodel.DomainLimitContentWarningPreview
+	}{
+		{
+			// No limit CW, no sample CW.
+			mediaPolicy: apimodel.MediaPolicyNoAction,
+			expected:    apimodel.DomainLimitContentWarningPreview{},
+		},
+		{
+			// No limit CW, but media marked sensitive.
+			mediaPolicy: apimodel.MediaPolicyMarkSensitive,
+			expected: apimodel.DomainLimitContentWarningPreview{
+				Sensitive: true,
+			},
+		},
+		{
+			// Limit CW, no sample CW.
+			mediaPolicy:    apimodel.MediaPolicyNoAction,
+			contentWarning: "from example.org",
+			expected: apimodel.DomainLimitContentWarningPreview{
+				Rewritten: "from example.org",
+				Sensitive: true,
+			},
+		},
+		{
+			// Limit CW prepended to sample CW.
+			mediaPolicy:    apimodel.MediaPolicyNoAction,
+			contentWarning: "from example.org",
+			sample:         "spoilers",
+			expected: apimodel.DomainLimitContentWarningPreview{
+				Original:  "spoilers",
+				Rewritten: "from example.org; spoilers",
+				Sensitive: true,
+			},
+		},
+	} {
+		test, errWithCode := suite.adminProcessor.DomainLimitTest(
+			suite.T().Context(),
+			"example.org",
+			testCase.mediaPolicy,
+			apimodel.FollowsPolicyNoAction,
+			apimodel.StatusesPolicyNoAction,
+			apimodel.AccountsPolicyNoAction,
+			testCase.contentWarning,
+			testCase.sample,
+		)
+		if errWithCode != nil {
+			suite.FailNow(errWithCode.Error())
+		}
+		suite.Equal(testCase.expected, test.ContentWarningPreview)
+	}
+}
+
+func TestDomainLimitTestTestSuite(t *testing.T) {
+	suite.Run(t, new(DomainLimitTestTestSuite))
+}
@@ -298,6 +298,13 @@ func (p *Processor) DomainPermissionSubscriptionRemove(
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	// Pending limit suggestions are meaningless
+	// without their subscription, so remove them.
+	if err := p.state.DB.DeleteDomainLimitSuggestionsBySubscriptionID(ctx, id); err != nil {
+		err := gtserror.Newf("db error deleting domain limit suggestions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
 	return apiPermSub, nil
 }
 
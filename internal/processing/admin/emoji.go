@@ -71,6 +71,8 @@ func (p *Processor) EmojiCreate(
 	emoji, errWithCode := p.createEmoji(ctx,
 		form.Shortcode,
 		form.CategoryName,
+		form.License,
+		form.Attribution,
 		data,
 	)
 	if errWithCode != nil {
@@ -236,13 +238,13 @@ func (p *Processor) EmojiUpdate(
 	switch form.Type {
 
 	case apimodel.EmojiUpdateCopy:
-		return p.emojiUpdateCopy(ctx, emoji, form.Shortcode, form.CategoryName)
+		return p.emojiUpdateCopy(ctx, emoji, form.Shortcode, form.CategoryName, form.License, form.Attribution)
 
 	case apimodel.EmojiUpdateDisable:
 		return p.emojiUpdateDisable(ctx, emoji)
 
 	case apimodel.EmojiUpdateModify:
-		return p.emojiUpdateModify(ctx, emoji, form.Image, form.CategoryName)
+		return p.emojiUpdateModify(ctx, emoji, form.Image, form.CategoryName, form.License, form.Attribution)
 
 	default:
 		const text = "unrecognized emoji update action type"
@@ -281,6 +283,8 @@ func (p *Processor) emojiUpdateCopy(
 	target *gtsmodel.Emoji,
 	shortcode *string,
 	categoryName *string,
+	license *string,
+	attribution *string,
 ) (*apimodel.AdminEmoji, gtserror.WithCode) {
 	if target.IsLocal() {
 		const text = "target emoji is not remote; cannot copy to local"
@@ -322,6 +326,8 @@ func (p *Processor) emojiUpdateCopy(
 	emoji, errWithCode := p.createEmoji(ctx,
 		util.PtrOrZero(shortcode),
 		util.PtrOrZero(categoryName),
+		util.PtrOrZero(license),
+		util.PtrOrZero(attribution),
 		data,
 	)
 	if errWithCode != nil {
@@ -384,6 +390,8 @@ func (p *Processor) emojiUpdateModify(
 	emoji *gtsmodel.Emoji,
 	image *multipart.FileHeader,
 	categoryName *string,
+	license *string,
+	attribution *string,
 ) (*apimodel.AdminEmoji, gtserror.WithCode) {
 	if !emoji.IsLocal() {
 		const text = "cannot modify remote emoji"
@@ -391,7 +399,8 @@ func (p *Processor) emojiUpdateModify(
 	}
 
 	// Ensure there's actually something to update.
-	if image == nil && categoryName == nil {
+	if image == nil && categoryName == nil &&
+		license == nil && attribution == nil {
 		const text = "no changes were provided"
 		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
 	}
@@ -425,19 +434,37 @@ func (p *Processor) emojiUpdateModify(
 		}
 	}
 
+	// Gather columns for any
+	// non-image changes made.
+	var columns []string
+
+	if newCategoryID != nil {
+		columns = append(columns, "category_id")
+	}
+
+	if license != nil && *license != emoji.License {
+		columns = append(columns, "license")
+		emoji.License = *license
+	}
+
+	if attribution != nil && *attribution != emoji.Attribution {
+		columns = append(columns, "attribution")
+		emoji.Attribution = *attribution
+	}
+
 	// Check whether any image changes were requested.
 	imageUpdated := (image != nil && image.Size > 0)
 
-	if !imageUpdated && newCategoryID != nil {
-		// Only updating category; only a single database update required.
-		if err := p.state.DB.UpdateEmoji(ctx, emoji, "category_id"); err != nil {
+	if !imageUpdated && len(columns) > 0 {
+		// Only updating metadata; only a single database update required.
+		if err := p.state.DB.UpdateEmoji(ctx, emoji, columns...); err != nil {
 			err := gtserror.Newf("error updating emoji in db: %w", err)
 			return nil, gtserror.NewErrorInternalError(err)
 		}
 	} else if imageUpdated {
 		var err error
 
-		// Updating image and maybe categoryID.
+		// Updating image and maybe metadata.
 		// We can do both at the same time :)
 
 		// Get maximum supported local emoji size.
@@ -463,10 +490,12 @@ func (p *Processor) emojiUpdateModify(
 			return rc, nil
 		}
 
-		// Include category ID
-		// update if necessary.
+		// Include category ID and
+		// license updates if necessary.
 		ai := media.AdditionalEmojiInfo{}
 		ai.CategoryID = newCategoryID
+		ai.License = license
+		ai.Attribution = attribution
 
 		// Prepare emoji model for update+recache from new data.
 		processing, err := p.media.UpdateEmoji(ctx, emoji, data, ai)
@@ -494,11 +523,13 @@ func (p *Processor) emojiUpdateModify(
 
 // createEmoji will create a new local emoji
 // with the given shortcode, attached category
-// name (if any) and data source function.
+// name, license info (if any) and data source function.
 func (p *Processor) createEmoji(
 	ctx context.Context,
 	shortcode string,
 	categoryName string,
+	license string,
+	attribution string,
 	data media.DataFunc,
 ) (
 	*gtsmodel.Emoji,
@@ -539,7 +570,9 @@ func (p *Processor) createEmoji(
 		shortcode,
 		data,
 		media.AdditionalEmojiInfo{
-			CategoryID: categoryID,
+			CategoryID:  categoryID,
+			License:     &license,
+			Attribution: &attribution,
 		},
 	)
 }
@@ -0,0 +1,424 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strings"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/validate"
+	"codeberg.org/gruf/go-iotools"
+)
+
+const (
+	// emojiPackManifest is the name of the
+	// manifest file in a Pleroma emoji pack.
+	emojiPackManifest = "pack.json"
+
+	// maxEmojiPackFiles is the maximum number of
+	// emojis we'll import or export in one pack.
+	maxEmojiPackFiles = 1000
+)
+
+// emojiPack models the pack.json manifest
+// of an emoji pack, in Pleroma's format.
+//
+// See: https://docs-develop.pleroma.social/backend/API/admin_api/#emoji-packs
+type emojiPack struct {
+	// Files maps emoji shortcodes
+	// to image paths in the pack.
+	Files map[string]string `json:"files"`
+
+	// FilesCount is the
+	// number of files.
+	FilesCount int `json:"files_count,omitempty"`
+
+	// Pack contains
+	// pack metadata.
+	Pack emojiPackMeta `json:"pack"`
+}
+
+// emojiPackMeta models metadata of an emoji pack.
+type emojiPackMeta struct {
+	Description string `json:"description,omitempty"`
+	License     string `json:"license,omitempty"`
+	Homepage    string `json:"homepage,omitempty"`
+	ShareFiles  bool   `json:"share-files"`
+	CanDownload bool   `json:"can-download"`
+}
+
+// EmojiPackImport imports custom emojis from the given
+// zipped emoji pack, in Pleroma's emoji pack format.
+//
+// Emojis which can't be imported, eg., because their
+// shortcode is taken or invalid, are skipped rather
+// than failing the whole import, and are reported in
+// the returned result alongside imported emojis.
+func (p *Processor) EmojiPackImport(
+	ctx context.Context,
+	form *apimodel.EmojiPackImportRequest,
+) (*apimodel.EmojiPackImportResult, gtserror.WithCode) {
+	// Get maximum supported local emoji size.
+	maxsz := config.GetMediaEmojiLocalMaxSize()
+	maxszInt64 := int64(maxsz) // #nosec G115 -- Already validated.
+
+	// Ensure the pack as a whole is within sensible bounds.
+	if form.File.Size > maxszInt64*maxEmojiPackFiles {
+		text := fmt.Sprintf("emoji pack exceeds max size: %d emojis of %s", maxEmojiPackFiles, maxsz)
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	// Open multipart file reader.
+	mpfile, err := form.File.Open()
+	if err != nil {
+		err := gtserror.Newf("error opening multipart file: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	defer mpfile.Close()
+
+	// Open the pack as a zip archive.
+	archive, err := zip.NewReader(mpfile, form.File.Size)
+	if err != nil {
+		const text = "emoji pack is not a valid zip archive"
+		return nil, gtserror.NewErrorBadRequest(err, text)
+	}
+
+	// Read the manifest from the archive.
+	pack, dir, errWithCode := readEmojiPack(archive)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if len(pack.Files) > maxEmojiPackFiles {
+		text := fmt.Sprintf("emoji pack contains more than %d emojis", maxEmojiPackFiles)
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	// Default category to the
+	// name of the uploaded pack.
+	categoryName := form.CategoryName
+	if categoryName == "" {
+		categoryName = strings.TrimSuffix(
+			path.Base(form.File.Filename),
+			path.Ext(form.File.Filename),
+		)
+	}
+
+	if err := validate.EmojiCategory(categoryName); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// Pack license and homepage apply to every
+	// emoji, but only use them if they're valid.
+	license := pack.Pack.License
+	if validate.EmojiLicense(license) != nil {
+		license = ""
+	}
+	attribution := pack.Pack.Homepage
+	if validate.EmojiAttribution(attribution) != nil {
+		attribution = ""
+	}
+
+	// Index zip files by name.
+	files := make(map[string]*zip.File, len(archive.File))
+	for _, file := range archive.File {
+		files[path.Clean(file.Name)] = file
+	}
+
+	// Import in a determinate order.
+	shortcodes := make([]string, 0, len(pack.Files))
+	for shortcode := range pack.Files {
+		shortcodes = append(shortcodes, shortcode)
+	}
+	slices.Sort(shortcodes)
+
+	result := &apimodel.EmojiPackImportResult{
+		Imported: make([]*apimodel.Emoji, 0, len(shortcodes)),
+		Skipped:  make(map[string]string),
+	}
+
+	for _, shortcode := range shortcodes {
+		if err := validate.EmojiShortcode(shortcode); err != nil {
+			result.Skipped[shortcode] = err.Error()
+			continue
+		}
+
+		file, ok := files[path.Join(dir, pack.Files[shortcode])]
+		if !ok {
+			result.Skipped[shortcode] = "image file not found in emoji pack"
+			continue
+		}
+
+		if file.UncompressedSize64 > uint64(maxszInt64) { // #nosec G115 -- Always positive.
+			result.Skipped[shortcode] = fmt.Sprintf("emoji exceeds configured max size: %s", maxsz)
+			continue
+		}
+
+		data := func(context.Context) (io.ReadCloser, error) {
+			rc, err := file.Open()
+			if err != nil {
+				return nil, err
+			}
+
+			// Wrap the file reader to ensure it's limited to max,
+			// as the zip header's uncompressed size can't be trusted.
+			rc, _, _ = iotools.UpdateReadCloserLimit(rc, maxszInt64)
+			return rc, nil
+		}
+
+		// Attempt to create the new local emoji.
+		emoji, errWithCode := p.createEmoji(ctx,
+			shortcode,
+			categoryName,
+			license,
+			attribution,
+			data,
+		)
+		if errWithCode != nil {
+			if errWithCode.Code() >= 500 {
+				// Log unexpected errors
+				// for the admin to look into.
+				log.Errorf(ctx, "error importing emoji %s: %v", shortcode, errWithCode)
+			}
+			result.Skipped[shortcode] = errWithCode.Safe()
+			continue
+		}
+
+		apiEmoji, err := p.converter.EmojiToAPIEmoji(ctx, emoji)
+		if err != nil {
+			err := gtserror.Newf("error converting emoji: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		result.Imported = append(result.Imported, &apiEmoji)
+	}
+
+	return result, nil
+}
+
+// readEmojiPack reads and parses the manifest of the given
+// emoji pack archive, returning it along with the directory
+// within the archive that the manifest's file paths are
+// relative to. Usually this is the root of the archive, but
+// packs are sometimes zipped up inside a top-level directory.
+func readEmojiPack(archive *zip.Reader) (*emojiPack, string, gtserror.WithCode) {
+	var manifest *zip.File
+	for _, file := range archive.File {
+		if path.Base(file.Name) != emojiPackManifest {
+			continue
+		}
+
+		// Prefer the manifest closest to the root.
+		if manifest == nil || strings.Count(file.Name, "/") < strings.Count(manifest.Name, "/") {
+			manifest = file
+		}
+	}
+
+	if manifest == nil {
+		const text = "emoji pack does not contain " + emojiPackManifest
+		return nil, "", gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	rc, err := manifest.Open()
+	if err != nil {
+		const text = "emoji pack contains invalid " + emojiPackManifest
+		return nil, "", gtserror.NewErrorBadRequest(err, text)
+	}
+	defer rc.Close()
+
+	pack := new(emojiPack)
+	if err := json.NewDecoder(rc).Decode(pack); err != nil {
+		const text = "emoji pack contains invalid " + emojiPackManifest
+		return nil, "", gtserror.NewErrorBadRequest(err, text)
+	}
+
+	if len(pack.Files) == 0 {
+		const text = "emoji pack contains no emojis"
+		return nil, "", gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	return pack, path.Dir(manifest.Name), nil
+}
+
+// EmojiPackExport prepares an export of local, enabled custom
+// emojis as a zipped emoji pack in Pleroma's emoji pack format,
+// optionally only including emojis in the given category.
+//
+// The returned function streams the pack to the given writer,
+// reading emoji images directly from storage as it goes.
+func (p *Processor) EmojiPackExport(
+	ctx context.Context,
+	categoryName string,
+) (func(io.Writer) error, gtserror.WithCode) {
+	var categoryID string
+
+	if categoryName != "" {
+		category, err := p.state.DB.GetEmojiCategoryByName(ctx, categoryName)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("error fetching emoji category from db: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if category == nil {
+			const text = "emoji category not found"
+			return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+		}
+
+		categoryID = category.ID
+	}
+
+	emojis, err := p.state.DB.GetEmojisBy(ctx,
+		"",    // local only
+		false, // exclude disabled
+		true,  // include enabled
+		"",    // any shortcode
+		"",    // no max
+		"",    // no min
+		0,     // no limit
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if categoryID != "" {
+		// Drop emojis not in requested category.
+		emojis = slices.DeleteFunc(emojis, func(emoji *gtsmodel.Emoji) bool {
+			return emoji.CategoryID != categoryID
+		})
+	}
+
+	if len(emojis) == 0 {
+		const text = "no emojis to export"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	if len(emojis) > maxEmojiPackFiles {
+		text := fmt.Sprintf("too many emojis to export, limit is %d", maxEmojiPackFiles)
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	// Prepare manifest for the pack.
+	pack := &emojiPack{
+		Files:      make(map[string]string, len(emojis)),
+		FilesCount: len(emojis),
+		Pack: emojiPackMeta{
+			Description: "Custom emojis exported from " + config.GetHost(),
+			Homepage:    config.GetProtocol() + "://" + config.GetHost(),
+			ShareFiles:  true,
+			CanDownload: true,
+		},
+	}
+
+	// Pleroma's license is pack-wide, so
+	// only set it if all emojis agree.
+	license := emojis[0].License
+	for _, emoji := range emojis {
+		if emoji.License != license {
+			license = ""
+			break
+		}
+	}
+	pack.Pack.License = license
+
+	for _, emoji := range emojis {
+		pack.Files[emoji.Shortcode] = emojiPackFilename(emoji)
+	}
+
+	return func(w io.Writer) error {
+		zw := zip.NewWriter(w)
+
+		// Write the manifest first.
+		mw, err := zw.Create(emojiPackManifest)
+		if err != nil {
+			return gtserror.Newf("error creating manifest: %w", err)
+		}
+
+		enc := json.NewEncoder(mw)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(pack); err != nil {
+			return gtserror.Newf("error encoding manifest: %w", err)
+		}
+
+		// Stream each emoji
+		// image from storage.
+		for _, emoji := range emojis {
+			if err := p.writeEmojiPackFile(ctx, zw, emoji); err != nil {
+				return err
+			}
+		}
+
+		return zw.Close()
+	}, nil
+}
+
+// writeEmojiPackFile streams the original image
+// of the given emoji from storage into the zip.
+func (p *Processor) writeEmojiPackFile(
+	ctx context.Context,
+	zw *zip.Writer,
+	emoji *gtsmodel.Emoji,
+) error {
+	rc, err := p.state.Storage.GetStream(ctx, emoji.ImagePath)
+	if err != nil {
+		return gtserror.Newf("error getting emoji %s from storage: %w", emoji.ID, err)
+	}
+	defer rc.Close()
+
+	// Images are already compressed,
+	// so just store them in the zip.
+	fw, err := zw.CreateHeader(&zip.FileHeader{
+		Name:     emojiPackFilename(emoji),
+		Method:   zip.Store,
+		Modified: emoji.UpdatedAt,
+	})
+	if err != nil {
+		return gtserror.Newf("error creating zip entry for emoji %s: %w", emoji.ID, err)
+	}
+
+	if _, err := io.Copy(fw, rc); err != nil {
+		return gtserror.Newf("error writing emoji %s to zip: %w", emoji.ID, err)
+	}
+
+	return nil
+}
+
+// emojiPackFilename returns the filename to
+// use for the given emoji in an emoji pack.
+func emojiPackFilename(emoji *gtsmodel.Emoji) string {
+	ext := path.Ext(emoji.ImagePath)
+	if ext == "" {
+		// Derive extension from content-type.
+		_, sub, _ := strings.Cut(emoji.ImageContentType, "/")
+		ext = "." + sub
+	}
+	return emoji.Shortcode + ext
+}
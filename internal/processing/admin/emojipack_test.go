@@ -0,0 +1,197 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"os"
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"github.com/stretchr/testify/suite"
+)
+
+type EmojiPackTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *EmojiPackTestSuite) TestEmojiPackExport() {
+	ctx := suite.T().Context()
+
+	writeTo, errWithCode := suite.adminProcessor.EmojiPackExport(ctx, "")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	buf := new(bytes.Buffer)
+	if err := writeTo(buf); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Manifest should list our one local emoji.
+	var pack struct {
+		Files map[string]string `json:"files"`
+	}
+	if err := json.Unmarshal(suite.readZipFile(archive, "pack.json"), &pack); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(map[string]string{"rainbow": "rainbow.png"}, pack.Files)
+
+	// Image in the zip should match storage.
+	expected, err := suite.storage.Get(ctx, suite.testEmojis["rainbow"].ImagePath)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(expected, suite.readZipFile(archive, "rainbow.png"))
+
+	// Unknown category should 404.
+	_, errWithCode = suite.adminProcessor.EmojiPackExport(ctx, "nope")
+	suite.EqualError(errWithCode, "emoji category not found")
+}
+
+func (suite *EmojiPackTestSuite) TestEmojiPackImport() {
+	ctx := suite.T().Context()
+
+	image, err := os.ReadFile("../../../testrig/media/rainbow-original.png")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	file := suite.emojiPackFileHeader("blobs.zip", map[string][]byte{
+		"pack.json": []byte(`{
+			"files": {
+				"blobcat": "blobcat.png",
+				"rainbow": "rainbow.png",
+				"not a shortcode": "blobcat.png",
+				"missing": "missing.png"
+			},
+			"pack": {
+				"license": "CC0-1.0",
+				"homepage": "https://example.org/blobs"
+			}
+		}`),
+		"blobcat.png": image,
+		"rainbow.png": image,
+	})
+
+	result, errWithCode := suite.adminProcessor.EmojiPackImport(ctx, &apimodel.EmojiPackImportRequest{
+		File: file,
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Only blobcat should be imported, into
+	// a category named after the pack file.
+	if suite.Len(result.Imported, 1) {
+		suite.Equal("blobcat", result.Imported[0].Shortcode)
+		suite.Equal("blobs", result.Imported[0].Category)
+	}
+
+	suite.Equal(map[string]string{
+		"rainbow":         "Conflict: emoji with shortcode already exists",
+		"not a shortcode": "shortcode not a shortcode did not pass validation, must be between 1 and 30 characters, letters, numbers, and underscores only",
+		"missing":         "image file not found in emoji pack",
+	}, result.Skipped)
+
+	// Pack metadata should be set on the emoji.
+	emoji, err := suite.db.GetEmojiByShortcodeDomain(ctx, "blobcat", "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("CC0-1.0", emoji.License)
+	suite.Equal("https://example.org/blobs", emoji.Attribution)
+}
+
+func (suite *EmojiPackTestSuite) TestEmojiPackImportNoManifest() {
+	file := suite.emojiPackFileHeader("blobs.zip", map[string][]byte{
+		"blobcat.png": []byte("not really a png"),
+	})
+
+	_, errWithCode := suite.adminProcessor.EmojiPackImport(suite.T().Context(), &apimodel.EmojiPackImportRequest{
+		File: file,
+	})
+	suite.EqualError(errWithCode, "emoji pack does not contain pack.json")
+}
+
+// emojiPackFileHeader zips the given files, and
+// returns them as an uploaded multipart file.
+func (suite *EmojiPackTestSuite) emojiPackFileHeader(filename string, files map[string][]byte) *multipart.FileHeader {
+	zipBuf := new(bytes.Buffer)
+	zw := zip.NewWriter(zipBuf)
+	for name, data := range files {
+		fw, err := zw.Create(name)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		if _, err := fw.Write(data); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+	if err := zw.Close(); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	formBuf := new(bytes.Buffer)
+	w := multipart.NewWriter(formBuf)
+	fw, err := w.CreateFormFile("file", filename)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if _, err := fw.Write(zipBuf.Bytes()); err != nil {
+		suite.FailNow(err.Error())
+	}
+	if err := w.Close(); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	form, err := multipart.NewReader(formBuf, w.Boundary()).ReadForm(1 << 20)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return form.File["file"][0]
+}
+
+func (suite *EmojiPackTestSuite) readZipFile(archive *zip.Reader, name string) []byte {
+	f, err := archive.Open(name)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	defer f.Close()
+
+	b, err := io.ReadAll(f)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return b
+}
+
+func TestEmojiPackTestSuite(t *testing.T) {
+	suite.Run(t, &EmojiPackTestSuite{})
+}
@@ -0,0 +1,161 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
+)
+
+// nodeInfoDerefInterval is the minimum time between
+// nodeinfo dereferences when refreshing known
+// instances, so as not to hammer remotes.
+const nodeInfoDerefInterval = time.Second
+
+// InstancesGet returns all known remote instances, sorted by
+// domain, optionally filtered by domain substring, software
+// name (case-insensitive), and/or suspended status.
+func (p *Processor) InstancesGet(
+	ctx context.Context,
+	domain string,
+	software string,
+	suspended *bool,
+) ([]*apimodel.AdminInstance, gtserror.WithCode) {
+	instances, err := p.state.DB.GetInstancePeers(ctx, true)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting instances: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	domain = strings.ToLower(domain)
+	apiInstances := make([]*apimodel.AdminInstance, 0, len(instances))
+
+	for _, instance := range instances {
+		if software != "" && !strings.EqualFold(instance.Software, software) {
+			continue
+		}
+
+		if suspended != nil && *suspended != !instance.SuspendedAt.IsZero() {
+			continue
+		}
+
+		apiInstance, err := typeutils.InstanceToAdminAPIInstance(instance)
+		if err != nil {
+			log.Errorf(ctx, "error converting instance %s: %v", instance.Domain, err)
+			continue
+		}
+
+		// Match domain on the de-punified
+		// version, as that's what admins see.
+		if domain != "" && !strings.Contains(apiInstance.Domain, domain) {
+			continue
+		}
+
+		apiInstances = append(apiInstances, apiInstance)
+	}
+
+	slices.SortFunc(
+		apiInstances,
+		func(a, b *apimodel.AdminInstance) int {
+			return strings.Compare(a.Domain, b.Domain)
+		},
+	)
+
+	return apiInstances, nil
+}
+
+// ScheduleNodeInfoRefresh schedules regular refreshes
+// of the software name and version of known instances
+// via their nodeinfo endpoints, if enabled. Else no-op.
+func (p *Processor) ScheduleNodeInfoRefresh() {
+	refreshEvery := config.GetInstanceNodeInfoRefreshEvery()
+	if refreshEvery <= 0 {
+		// Disabled.
+		return
+	}
+
+	log.Infof(nil,
+		"scheduling instance nodeinfo refresh to run every %s",
+		refreshEvery,
+	)
+
+	// Schedule the refresh to run soon after startup, then regularly.
+	if !p.state.Workers.Scheduler.AddRecurring(
+		"@nodeinforefresh",
+		time.Now().Add(10*time.Minute),
+		refreshEvery,
+		func(ctx context.Context, start time.Time) {
+			log.Info(ctx, "starting instance nodeinfo refresh")
+			if err := p.NodeInfoRefresh(ctx); err != nil {
+				log.Errorf(ctx, "error refreshing instance nodeinfo: %v", err)
+			}
+			log.Infof(ctx, "finished instance nodeinfo refresh after %s", time.Since(start))
+		},
+	) {
+		panic("failed to schedule @nodeinforefresh")
+	}
+}
+
+// NodeInfoRefresh fetches nodeinfo for each known, non-suspended
+// instance that hasn't had nodeinfo fetched within the configured
+// refresh period, and stores the reported software name + version.
+func (p *Processor) NodeInfoRefresh(ctx context.Context) error {
+	instances, err := p.state.DB.GetInstancePeers(ctx, false)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting instances: %w", err)
+	}
+
+	// Only refresh instances which
+	// weren't refreshed recently.
+	staleBefore := time.Now().Add(-config.GetInstanceNodeInfoRefreshEvery())
+
+	// Pace dereferences.
+	ticker := time.NewTicker(nodeInfoDerefInterval)
+	defer ticker.Stop()
+
+	for _, instance := range instances {
+		if instance.NodeInfoFetchedAt.After(staleBefore) {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		// Use the instance account transport
+		// (empty username), and always fetch,
+		// since we've already checked freshness.
+		if err := p.federator.RefreshInstanceSoftware(ctx, "", instance, nil); err != nil {
+			log.Debugf(ctx, "error refreshing nodeinfo for %s: %v", instance.Domain, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type InstanceTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *InstanceTestSuite) TestNodeInfoRefresh() {
+	ctx := suite.T().Context()
+
+	// Testrig only serves nodeinfo for
+	// fossbros-anonymous.io over https.
+	instance, err := suite.db.GetInstance(ctx, "fossbros-anonymous.io")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	instance.URI = "https://fossbros-anonymous.io"
+	if err := suite.db.UpdateInstance(ctx, instance, "uri"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.adminProcessor.NodeInfoRefresh(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	instance, err = suite.db.GetInstance(ctx, "fossbros-anonymous.io")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("Hellsoft", instance.Software)
+	suite.Equal("6.6.6", instance.Version)
+	suite.False(instance.NodeInfoFetchedAt.IsZero())
+
+	// Nodeinfo isn't served for example.org,
+	// so it should be left as it was.
+	instance, err = suite.db.GetInstance(ctx, "example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(instance.Software)
+	suite.True(instance.NodeInfoFetchedAt.IsZero())
+
+	// Filter known instances by software.
+	instances, errWithCode := suite.adminProcessor.InstancesGet(ctx, "", "hellsoft", nil)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(instances, 1)
+	suite.Equal("fossbros-anonymous.io", instances[0].Domain)
+	suite.Equal("Hellsoft", instances[0].Software)
+	suite.Equal("6.6.6", instances[0].Version)
+	suite.NotEmpty(instances[0].NodeInfoFetchedAt)
+}
+
+func (suite *InstanceTestSuite) TestInstancesGet() {
+	ctx := suite.T().Context()
+
+	instances, errWithCode := suite.adminProcessor.InstancesGet(ctx, "", "", nil)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(instances, 2)
+	suite.Equal("example.org", instances[0].Domain)
+	suite.Equal("fossbros-anonymous.io", instances[1].Domain)
+
+	instances, errWithCode = suite.adminProcessor.InstancesGet(ctx, "fossbros", "", nil)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(instances, 1)
+	suite.Equal("fossbros-anonymous.io", instances[0].Domain)
+
+	instances, errWithCode = suite.adminProcessor.InstancesGet(ctx, "", "", util.Ptr(true))
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(instances)
+}
+
+func TestInstanceTestSuite(t *testing.T) {
+	suite.Run(t, new(InstanceTestSuite))
+}
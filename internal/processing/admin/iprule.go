@@ -0,0 +1,268 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// IPRulesGet returns all sign up / sign in IP rules stored on this instance.
+func (p *Processor) IPRulesGet(ctx context.Context) ([]*apimodel.AdminIPRule, gtserror.WithCode) {
+	rules, err := p.state.DB.GetIPRules(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting ip rules: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiRules := make([]*apimodel.AdminIPRule, len(rules))
+	for i := range rules {
+		apiRules[i] = toAPIIPRule(rules[i])
+	}
+
+	return apiRules, nil
+}
+
+// IPRuleGet returns one IP rule with the given ID.
+func (p *Processor) IPRuleGet(ctx context.Context, id string) (*apimodel.AdminIPRule, gtserror.WithCode) {
+	rule, errWithCode := p.getIPRule(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return toAPIIPRule(rule), nil
+}
+
+// IPRuleCreate creates a new sign up / sign in IP
+// rule, marking as authored by the provided admin.
+func (p *Processor) IPRuleCreate(
+	ctx context.Context,
+	admin *gtsmodel.Account,
+	form *apimodel.AdminIPRuleCreateRequest,
+) (*apimodel.AdminIPRule, gtserror.WithCode) {
+	ip, err := parseIPRuleIP(form.IP)
+	if err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	severity := gtsmodel.ParseIPRuleSeverity(form.Severity)
+	if severity == gtsmodel.IPRuleSeverityUnknown {
+		err := fmt.Errorf("invalid severity %s", form.Severity)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	now := time.Now()
+	rule := &gtsmodel.IPRule{
+		ID:        id.NewULID(),
+		CreatedAt: now,
+		UpdatedAt: now,
+		IP:        ip,
+		Severity:  severity,
+		Comment:   strings.TrimSpace(form.Comment),
+		AuthorID:  admin.ID,
+		Author:    admin,
+	}
+
+	if form.ExpiresIn != nil {
+		if *form.ExpiresIn <= 0 {
+			const text = "expires_in must be a positive number of seconds"
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+		rule.ExpiresAt = now.Add(time.Duration(*form.ExpiresIn) * time.Second)
+	}
+
+	if err := p.state.DB.PutIPRule(ctx, rule); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err := fmt.Errorf("an ip rule for %s already exists", ip)
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+		err := gtserror.Newf("db error inserting ip rule: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return toAPIIPRule(rule), nil
+}
+
+// IPRuleUpdate updates the given fields of an existing IP rule.
+func (p *Processor) IPRuleUpdate(
+	ctx context.Context,
+	id string,
+	form *apimodel.AdminIPRuleUpdateRequest,
+) (*apimodel.AdminIPRule, gtserror.WithCode) {
+	rule, errWithCode := p.getIPRule(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	var cols []string
+
+	if form.IP != nil {
+		ip, err := parseIPRuleIP(*form.IP)
+		if err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		rule.IP = ip
+		cols = append(cols, "ip")
+	}
+
+	if form.Severity != nil {
+		severity := gtsmodel.ParseIPRuleSeverity(*form.Severity)
+		if severity == gtsmodel.IPRuleSeverityUnknown {
+			err := fmt.Errorf("invalid severity %s", *form.Severity)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		rule.Severity = severity
+		cols = append(cols, "severity")
+	}
+
+	if form.Comment != nil {
+		rule.Comment = strings.TrimSpace(*form.Comment)
+		cols = append(cols, "comment")
+	}
+
+	if form.ExpiresIn != nil {
+		switch {
+		case *form.ExpiresIn < 0:
+			const text = "expires_in must not be negative"
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		case *form.ExpiresIn == 0:
+			rule.ExpiresAt = time.Time{}
+		default:
+			rule.ExpiresAt = time.Now().Add(time.Duration(*form.ExpiresIn) * time.Second)
+		}
+		cols = append(cols, "expires_at")
+	}
+
+	if len(cols) == 0 {
+		// Nothing to update.
+		return toAPIIPRule(rule), nil
+	}
+
+	if err := p.state.DB.UpdateIPRule(ctx, rule, cols...); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err := fmt.Errorf("an ip rule for %s already exists", rule.IP)
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+		err := gtserror.Newf("db error updating ip rule: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return toAPIIPRule(rule), nil
+}
+
+// IPRuleDelete deletes an existing IP rule, returning the deleted rule.
+func (p *Processor) IPRuleDelete(ctx context.Context, id string) (*apimodel.AdminIPRule, gtserror.WithCode) {
+	rule, errWithCode := p.getIPRule(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteIPRuleByID(ctx, id); err != nil {
+		err := gtserror.Newf("db error deleting ip rule: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return toAPIIPRule(rule), nil
+}
+
+func (p *Processor) getIPRule(ctx context.Context, id string) (*gtsmodel.IPRule, gtserror.WithCode) {
+	rule, err := p.state.DB.GetIPRuleByID(ctx, id)
+	switch {
+	case err == nil:
+		return rule, nil
+
+	case errors.Is(err, db.ErrNoEntries):
+		const text = "ip rule not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+
+	default:
+		err := gtserror.Newf("db error getting ip rule: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+}
+
+// parseIPRuleIP parses the given single IP address
+// or CIDR range, returning it in normalized CIDR form.
+func parseIPRuleIP(in string) (string, error) {
+	in = strings.TrimSpace(in)
+	if in == "" {
+		return "", errors.New("ip must be set")
+	}
+
+	var prefix netip.Prefix
+	if strings.Contains(in, "/") {
+		var err error
+		prefix, err = netip.ParsePrefix(in)
+		if err != nil {
+			return "", fmt.Errorf("invalid ip range %s: %w", in, err)
+		}
+	} else {
+		addr, err := netip.ParseAddr(in)
+		if err != nil {
+			return "", fmt.Errorf("invalid ip address %s: %w", in, err)
+		}
+
+		// Single address, so cover just this one.
+		addr = addr.Unmap()
+		prefix = netip.PrefixFrom(addr, addr.BitLen())
+	}
+
+	if prefix.Addr().Is4In6() {
+		return "", fmt.Errorf("invalid ip range %s: use plain ipv4 notation", in)
+	}
+
+	// Zero out host bits so that
+	// equivalent ranges are stored
+	// identically, eg. 192.0.2.1/24
+	// becomes 192.0.2.0/24.
+	return prefix.Masked().String(), nil
+}
+
+// toAPIIPRule performs a simple conversion of database model IPRule to API model.
+func toAPIIPRule(rule *gtsmodel.IPRule) *apimodel.AdminIPRule {
+	apiRule := &apimodel.AdminIPRule{
+		ID:        rule.ID,
+		IP:        rule.IP,
+		Severity:  rule.Severity.String(),
+		Comment:   rule.Comment,
+		CreatedBy: rule.AuthorID,
+		CreatedAt: util.FormatISO8601(rule.CreatedAt),
+		Hits:      rule.Hits,
+	}
+
+	if !rule.ExpiresAt.IsZero() {
+		apiRule.ExpiresAt = util.Ptr(util.FormatISO8601(rule.ExpiresAt))
+	}
+
+	if !rule.LastHitAt.IsZero() {
+		apiRule.LastHitAt = util.Ptr(util.FormatISO8601(rule.LastHitAt))
+	}
+
+	return apiRule
+}
@@ -0,0 +1,120 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type IPRuleTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *IPRuleTestSuite) TestIPRuleCreateUpdateDelete() {
+	var (
+		ctx       = suite.T().Context()
+		adminAcct = suite.testAccounts["admin_account"]
+	)
+
+	// Create a rule with a non-masked range,
+	// it should be stored in normalized form.
+	rule, errWithCode := suite.adminProcessor.IPRuleCreate(ctx, adminAcct,
+		&apimodel.AdminIPRuleCreateRequest{
+			IP:       "192.0.2.15/24",
+			Severity: "sign_up_block",
+			Comment:  "  spammy range  ",
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("192.0.2.0/24", rule.IP)
+	suite.Equal("sign_up_block", rule.Severity)
+	suite.Equal("spammy range", rule.Comment)
+	suite.Equal(adminAcct.ID, rule.CreatedBy)
+	suite.Nil(rule.ExpiresAt)
+	suite.Zero(rule.Hits)
+
+	// Creating an equivalent rule should conflict.
+	_, errWithCode = suite.adminProcessor.IPRuleCreate(ctx, adminAcct,
+		&apimodel.AdminIPRuleCreateRequest{
+			IP:       "192.0.2.0/24",
+			Severity: "no_access",
+		},
+	)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+
+	// Update severity and add expiry.
+	rule, errWithCode = suite.adminProcessor.IPRuleUpdate(ctx, rule.ID,
+		&apimodel.AdminIPRuleUpdateRequest{
+			Severity:  util.Ptr("no_access"),
+			ExpiresIn: util.Ptr(3600),
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("192.0.2.0/24", rule.IP)
+	suite.Equal("no_access", rule.Severity)
+	suite.NotNil(rule.ExpiresAt)
+
+	rules, errWithCode := suite.adminProcessor.IPRulesGet(ctx)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(rules, 1)
+
+	// Delete the rule.
+	_, errWithCode = suite.adminProcessor.IPRuleDelete(ctx, rule.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	_, errWithCode = suite.adminProcessor.IPRuleGet(ctx, rule.ID)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *IPRuleTestSuite) TestIPRuleCreateInvalid() {
+	var (
+		ctx       = suite.T().Context()
+		adminAcct = suite.testAccounts["admin_account"]
+	)
+
+	for _, form := range []*apimodel.AdminIPRuleCreateRequest{
+		{IP: "", Severity: "sign_up_block"},
+		{IP: "not an ip", Severity: "sign_up_block"},
+		{IP: "192.0.2.0/33", Severity: "sign_up_block"},
+		{IP: "192.0.2.1", Severity: "nuke_from_orbit"},
+		{IP: "192.0.2.1", Severity: "sign_up_block", ExpiresIn: util.Ptr(-1)},
+	} {
+		_, errWithCode := suite.adminProcessor.IPRuleCreate(ctx, adminAcct, form)
+		suite.NotNil(errWithCode)
+		suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	}
+}
+
+func TestIPRuleTestSuite(t *testing.T) {
+	suite.Run(t, &IPRuleTestSuite{})
+}
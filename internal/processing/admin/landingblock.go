@@ -0,0 +1,197 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
+	"code.superseriousbusiness.org/gotosocial/internal/validate"
+)
+
+// LandingBlocksGet returns all landing page blocks stored on this instance, in page order.
+func (p *Processor) LandingBlocksGet(ctx context.Context) ([]*apimodel.LandingBlock, gtserror.WithCode) {
+	blocks, err := p.state.DB.GetLandingBlocks(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting landing blocks: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiBlocks := make([]*apimodel.LandingBlock, len(blocks))
+	for i := range blocks {
+		apiBlocks[i] = typeutils.LandingBlockToAPILandingBlock(blocks[i])
+	}
+
+	return apiBlocks, nil
+}
+
+// LandingBlockGet returns one landing page block with the given ID.
+func (p *Processor) LandingBlockGet(ctx context.Context, id string) (*apimodel.LandingBlock, gtserror.WithCode) {
+	block, errWithCode := p.getLandingBlock(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return typeutils.LandingBlockToAPILandingBlock(block), nil
+}
+
+// LandingBlockCreate creates a new landing page block,
+// rendering the given markdown text to HTML content.
+func (p *Processor) LandingBlockCreate(
+	ctx context.Context,
+	form *apimodel.LandingBlockCreateRequest,
+) (*apimodel.LandingBlock, gtserror.WithCode) {
+	title := strings.TrimSpace(form.Title)
+	if err := validate.LandingBlockTitle(title); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if err := validate.LandingBlockText(form.Text); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	var position int
+	if form.Position != nil {
+		position = *form.Position
+	} else {
+		// No position given, so place
+		// block after any existing ones.
+		blocks, err := p.state.DB.GetLandingBlocks(ctx)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting landing blocks: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if len(blocks) != 0 {
+			position = blocks[len(blocks)-1].Position + 1
+		}
+	}
+
+	now := time.Now()
+	block := &gtsmodel.LandingBlock{
+		ID:        id.NewULID(),
+		CreatedAt: now,
+		UpdatedAt: now,
+		Title:     title,
+		Content:   p.renderLandingBlock(ctx, form.Text),
+		Text:      form.Text,
+		Position:  position,
+	}
+
+	if err := p.state.DB.PutLandingBlock(ctx, block); err != nil {
+		err := gtserror.Newf("db error inserting landing block: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return typeutils.LandingBlockToAPILandingBlock(block), nil
+}
+
+// LandingBlockUpdate updates the given fields of an existing landing page block.
+func (p *Processor) LandingBlockUpdate(
+	ctx context.Context,
+	id string,
+	form *apimodel.LandingBlockUpdateRequest,
+) (*apimodel.LandingBlock, gtserror.WithCode) {
+	block, errWithCode := p.getLandingBlock(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	var cols []string
+
+	if form.Title != nil {
+		title := strings.TrimSpace(*form.Title)
+		if err := validate.LandingBlockTitle(title); err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		block.Title = title
+		cols = append(cols, "title")
+	}
+
+	if form.Text != nil {
+		if err := validate.LandingBlockText(*form.Text); err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		block.Text = *form.Text
+		block.Content = p.renderLandingBlock(ctx, block.Text)
+		cols = append(cols, "text", "content")
+	}
+
+	if form.Position != nil {
+		block.Position = *form.Position
+		cols = append(cols, "position")
+	}
+
+	if len(cols) == 0 {
+		// Nothing to update.
+		return typeutils.LandingBlockToAPILandingBlock(block), nil
+	}
+
+	if err := p.state.DB.UpdateLandingBlock(ctx, block, cols...); err != nil {
+		err := gtserror.Newf("db error updating landing block: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return typeutils.LandingBlockToAPILandingBlock(block), nil
+}
+
+// LandingBlockDelete deletes an existing landing page block, returning the deleted block.
+func (p *Processor) LandingBlockDelete(ctx context.Context, id string) (*apimodel.LandingBlock, gtserror.WithCode) {
+	block, errWithCode := p.getLandingBlock(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteLandingBlockByID(ctx, id); err != nil {
+		err := gtserror.Newf("db error deleting landing block: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return typeutils.LandingBlockToAPILandingBlock(block), nil
+}
+
+func (p *Processor) getLandingBlock(ctx context.Context, id string) (*gtsmodel.LandingBlock, gtserror.WithCode) {
+	block, err := p.state.DB.GetLandingBlockByID(ctx, id)
+	switch {
+	case err == nil:
+		return block, nil
+
+	case errors.Is(err, db.ErrNoEntries):
+		const text = "landing block not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+
+	default:
+		err := gtserror.Newf("db error getting landing block: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+}
+
+// renderLandingBlock renders the given markdown text of a
+// landing block to sanitized HTML, in the same way as the
+// instance description and terms.
+func (p *Processor) renderLandingBlock(ctx context.Context, text string) string {
+	return p.formatter.FromMarkdown(ctx, p.parseMention, "", "", text).HTML
+}
@@ -0,0 +1,123 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type LandingBlockTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *LandingBlockTestSuite) TestLandingBlockCreateUpdateDelete() {
+	ctx := suite.T().Context()
+
+	// Create two blocks without positions,
+	// they should be placed one after another.
+	block1, errWithCode := suite.adminProcessor.LandingBlockCreate(ctx,
+		&apimodel.LandingBlockCreateRequest{
+			Title: "  Who we are  ",
+			Text:  "We're a small instance for **gardeners**.",
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("Who we are", block1.Title)
+	suite.Equal("<p>We're a small instance for <strong>gardeners</strong>.</p>", block1.Content)
+	suite.Equal(0, block1.Position)
+
+	block2, errWithCode := suite.adminProcessor.LandingBlockCreate(ctx,
+		&apimodel.LandingBlockCreateRequest{
+			Text: "Come and say hi!",
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(block2.Title)
+	suite.Equal(1, block2.Position)
+
+	// Move the second block to the top,
+	// and change text of the first one.
+	block2, errWithCode = suite.adminProcessor.LandingBlockUpdate(ctx, block2.ID,
+		&apimodel.LandingBlockUpdateRequest{
+			Position: util.Ptr(-1),
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("Come and say hi!", block2.Text)
+	suite.Equal(-1, block2.Position)
+
+	block1, errWithCode = suite.adminProcessor.LandingBlockUpdate(ctx, block1.ID,
+		&apimodel.LandingBlockUpdateRequest{
+			Text: util.Ptr("We're a small instance for *gardeners*."),
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("Who we are", block1.Title)
+	suite.Equal("<p>We're a small instance for <em>gardeners</em>.</p>", block1.Content)
+
+	// Blocks should be returned in page order.
+	blocks, errWithCode := suite.adminProcessor.LandingBlocksGet(ctx)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	if suite.Len(blocks, 2) {
+		suite.Equal(block2.ID, blocks[0].ID)
+		suite.Equal(block1.ID, blocks[1].ID)
+	}
+
+	// Delete a block.
+	_, errWithCode = suite.adminProcessor.LandingBlockDelete(ctx, block1.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	_, errWithCode = suite.adminProcessor.LandingBlockGet(ctx, block1.ID)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *LandingBlockTestSuite) TestLandingBlockCreateInvalid() {
+	ctx := suite.T().Context()
+
+	for _, form := range []*apimodel.LandingBlockCreateRequest{
+		{Text: ""},
+		{Title: strings.Repeat("a", 201), Text: "hello"},
+	} {
+		_, errWithCode := suite.adminProcessor.LandingBlockCreate(ctx, form)
+		suite.NotNil(errWithCode)
+		suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	}
+}
+
+func TestLandingBlockTestSuite(t *testing.T) {
+	suite.Run(t, &LandingBlockTestSuite{})
+}
@@ -0,0 +1,73 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// ReadOnlyGet returns the current
+// state of read-only maintenance mode.
+func (p *Processor) ReadOnlyGet(ctx context.Context) *apimodel.AdminReadOnlyMode {
+	mode := &apimodel.AdminReadOnlyMode{
+		Enabled:    p.state.ReadOnly.Enabled(),
+		RetryAfter: int(config.GetReadOnlyRetryAfter() / time.Second),
+	}
+
+	if since := p.state.ReadOnly.Since(); !since.IsZero() {
+		mode.Since = util.FormatISO8601(since)
+	}
+
+	return mode
+}
+
+// ReadOnlySet enables or disables read-only maintenance
+// mode, pausing or resuming background workers accordingly,
+// and returns the resulting state of read-only mode.
+//
+// Enabling waits for currently running worker tasks to finish.
+func (p *Processor) ReadOnlySet(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	enabled bool,
+) (*apimodel.AdminReadOnlyMode, gtserror.WithCode) {
+	if p.state.ReadOnly == nil {
+		const text = "read-only mode not available"
+		return nil, gtserror.NewErrorNotFound(gtserror.New(text), text)
+	}
+
+	if enabled {
+		if p.state.ReadOnly.Enable() {
+			log.Infof(ctx, "read-only mode enabled by @%s", adminAcct.Username)
+		}
+	} else {
+		if p.state.ReadOnly.Disable() {
+			log.Infof(ctx, "read-only mode disabled by @%s", adminAcct.Username)
+		}
+	}
+
+	return p.ReadOnlyGet(ctx), nil
+}
@@ -22,6 +22,7 @@
 	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 	"strconv"
 	"time"
 
@@ -33,6 +34,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/messages"
 	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
 )
 
 // ReportsGet returns reports stored on this
@@ -43,13 +45,26 @@ func (p *Processor) ReportsGet(
 	resolved *bool,
 	accountID string,
 	targetAccountID string,
+	category string,
+	assignedAccountID string,
 	page *paging.Page,
 ) (*apimodel.PageableResponse, gtserror.WithCode) {
+	var reportCategory gtsmodel.ReportCategory
+	if category != "" {
+		reportCategory = gtsmodel.ParseReportCategory(category)
+		if reportCategory == gtsmodel.ReportCategoryUnknown {
+			err := fmt.Errorf("category %s not recognized, valid categories are: other, spam, legal, violation", category)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+	}
+
 	reports, err := p.state.DB.GetReports(
 		ctx,
 		resolved,
 		accountID,
 		targetAccountID,
+		reportCategory,
+		assignedAccountID,
 		page,
 	)
 	if err != nil && !errors.Is(err, db.ErrNoEntries) {
@@ -78,7 +93,7 @@ func (p *Processor) ReportsGet(
 	}
 
 	// Assemble next/prev page queries.
-	query := make(url.Values, 3)
+	query := make(url.Values, 5)
 	if resolved != nil {
 		query.Set(apiutil.ResolvedKey, strconv.FormatBool(*resolved))
 	}
@@ -88,6 +103,12 @@ func (p *Processor) ReportsGet(
 	if targetAccountID != "" {
 		query.Set(apiutil.TargetAccountIDKey, targetAccountID)
 	}
+	if category != "" {
+		query.Set(apiutil.AdminCategoryKey, category)
+	}
+	if assignedAccountID != "" {
+		query.Set(apiutil.AdminAssignedIDKey, assignedAccountID)
+	}
 
 	return paging.PackageResponse(paging.ResponseParams{
 		Items: items,
@@ -120,13 +141,30 @@ func (p *Processor) ReportGet(ctx context.Context, account *gtsmodel.Account, id
 // and stores the provided actionTakenComment (if not null).
 // If the report creator is from this instance, an email will
 // be sent to them to let them know that the report is resolved.
-func (p *Processor) ReportResolve(ctx context.Context, account *gtsmodel.Account, id string, actionTakenComment *string) (*apimodel.AdminReport, gtserror.WithCode) {
-	report, err := p.state.DB.GetReportByID(ctx, id)
-	if err != nil {
-		if err == db.ErrNoEntries {
-			return nil, gtserror.NewErrorNotFound(err)
+// If the report was not assigned to anyone, it will be assigned
+// to the resolving account.
+//
+// If form.Forward is set, the report is also forwarded as a Flag
+// to the instance of the (remote) reported account, including
+// only the selected statuses and the (optionally redacted) comment.
+func (p *Processor) ReportResolve(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	id string,
+	form *apimodel.AdminReportResolveRequest,
+) (*apimodel.AdminReport, gtserror.WithCode) {
+	report, errWithCode := p.getReport(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	var forward *gtsmodel.Report
+	if form.Forward {
+		// Prepare forwarded copy of report.
+		forward, errWithCode = forwardReport(report, form)
+		if errWithCode != nil {
+			return nil, errWithCode
 		}
-		return nil, gtserror.NewErrorInternalError(err)
 	}
 
 	columns := []string{
@@ -137,18 +175,31 @@ func (p *Processor) ReportResolve(ctx context.Context, account *gtsmodel.Account
 	report.ActionTakenAt = time.Now()
 	report.ActionTakenByAccountID = account.ID
 
-	if actionTakenComment != nil {
-		report.ActionTaken = *actionTakenComment
+	if form.ActionTakenComment != nil {
+		report.ActionTaken = *form.ActionTakenComment
 		columns = append(columns, "action_taken")
 	}
 
-	err = p.state.DB.UpdateReport(ctx, report, columns...)
+	if forward != nil {
+		report.Forwarded = util.Ptr(true)
+		columns = append(columns, "forwarded")
+	}
+
+	if report.AssignedAccountID == "" {
+		// Nobody was assigned, so consider
+		// report assigned to the resolver.
+		report.AssignedAccountID = account.ID
+		report.AssignedAccount = account
+		columns = append(columns, "assigned_account_id")
+	}
+
+	err := p.state.DB.UpdateReport(ctx, report, columns...)
 	if err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
 	// Process side effects of closing the report.
-	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+	p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 		APObjectType:   ap.ActivityFlag,
 		APActivityType: ap.ActivityUpdate,
 		GTSModel:       report,
@@ -156,6 +207,118 @@ func (p *Processor) ReportResolve(ctx context.Context, account *gtsmodel.Account
 		Target:         report.Account,
 	})
 
+	if forward != nil {
+		// Forward report to remote instance.
+		p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
+			APObjectType:   ap.ActivityFlag,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       forward,
+			Origin:         account,
+			Target:         report.TargetAccount,
+		})
+	}
+
+	apimodelReport, err := p.converter.ReportToAdminAPIReport(ctx, report, account)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apimodelReport, nil
+}
+
+// forwardReport returns a copy of the given report for forwarding to
+// the instance of the reported account, containing only the statuses
+// selected in the given form, and with the reporter's comment replaced
+// by the form's forward comment, if set.
+func forwardReport(
+	report *gtsmodel.Report,
+	form *apimodel.AdminReportResolveRequest,
+) (*gtsmodel.Report, gtserror.WithCode) {
+	if report.TargetAccount.IsLocal() {
+		const text = "cannot forward report about a local account"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if *report.Forwarded {
+		const text = "report was already forwarded"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	forward := new(gtsmodel.Report)
+	*forward = *report
+
+	if form.ForwardComment != nil {
+		// Replace reporter's comment
+		// with (redacted) version.
+		forward.Comment = *form.ForwardComment
+	}
+
+	if form.ForwardStatusIDs != nil {
+		// Include only the selected statuses.
+		forward.StatusIDs = make([]string, 0, len(form.ForwardStatusIDs))
+		forward.Statuses = make([]*gtsmodel.Status, 0, len(form.ForwardStatusIDs))
+
+		for _, statusID := range form.ForwardStatusIDs {
+			i := slices.IndexFunc(report.Statuses, func(s *gtsmodel.Status) bool {
+				return s.ID == statusID
+			})
+			if i == -1 {
+				err := fmt.Errorf("status %s is not attached to report %s", statusID, report.ID)
+				return nil, gtserror.NewErrorBadRequest(err, err.Error())
+			}
+
+			forward.StatusIDs = append(forward.StatusIDs, statusID)
+			forward.Statuses = append(forward.Statuses, report.Statuses[i])
+		}
+	}
+
+	return forward, nil
+}
+
+// ReportUpdate updates the category and / or assignee
+// of the report with the given id, using the given form.
+func (p *Processor) ReportUpdate(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	id string,
+	form *apimodel.AdminReportUpdateRequest,
+) (*apimodel.AdminReport, gtserror.WithCode) {
+	report, errWithCode := p.getReport(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	var columns []string
+
+	if form.Category != nil {
+		category := gtsmodel.ParseReportCategory(*form.Category)
+		if category == gtsmodel.ReportCategoryUnknown {
+			err := fmt.Errorf("category %s not recognized, valid categories are: other, spam, legal, violation", *form.Category)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		report.Category = category
+		columns = append(columns, "category")
+	}
+
+	if form.AssignedAccountID != nil {
+		if errWithCode := p.setReportAssignee(ctx,
+			report,
+			*form.AssignedAccountID,
+		); errWithCode != nil {
+			return nil, errWithCode
+		}
+		columns = append(columns, "assigned_account_id")
+	}
+
+	if len(columns) == 0 {
+		const text = "empty form submitted"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if err := p.state.DB.UpdateReport(ctx, report, columns...); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
 	apimodelReport, err := p.converter.ReportToAdminAPIReport(ctx, report, account)
 	if err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
@@ -163,3 +326,64 @@ func (p *Processor) ReportResolve(ctx context.Context, account *gtsmodel.Account
 
 	return apimodelReport, nil
 }
+
+// ReportAssign assigns the report with the given id to the
+// admin / moderator account with given assigneeID, so that
+// other admins / moderators know who is handling the report.
+// An empty assigneeID unassigns the report.
+func (p *Processor) ReportAssign(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	id string,
+	assigneeID string,
+) (*apimodel.AdminReport, gtserror.WithCode) {
+	return p.ReportUpdate(ctx, account, id,
+		&apimodel.AdminReportUpdateRequest{
+			AssignedAccountID: &assigneeID,
+		},
+	)
+}
+
+// setReportAssignee sets the assignee of given report to account
+// with given ID, checking that it belongs to a local admin or
+// moderator. Empty assigneeID unassigns the report.
+func (p *Processor) setReportAssignee(
+	ctx context.Context,
+	report *gtsmodel.Report,
+	assigneeID string,
+) gtserror.WithCode {
+	if assigneeID == "" {
+		// Unassign report.
+		report.AssignedAccountID = ""
+		report.AssignedAccount = nil
+		return nil
+	}
+
+	user, err := p.state.DB.GetUserByAccountID(ctx, assigneeID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting user for account %s: %w", assigneeID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if user == nil || (!*user.Admin && !*user.Moderator) {
+		err := fmt.Errorf("account %s is not a local admin or moderator", assigneeID)
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	report.AssignedAccountID = assigneeID
+	report.AssignedAccount = user.Account
+	return nil
+}
+
+// getReport gets the report with
+// given id, or returns a 404 error.
+func (p *Processor) getReport(ctx context.Context, id string) (*gtsmodel.Report, gtserror.WithCode) {
+	report, err := p.state.DB.GetReportByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	return report, nil
+}
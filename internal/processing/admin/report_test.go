@@ -0,0 +1,197 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReportTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *ReportTestSuite) TestReportUpdateCategoryAndAssign() {
+	var (
+		ctx       = suite.T().Context()
+		adminAcct = suite.testAccounts["admin_account"]
+		reportID  = "01GP3AWY4CRDVRNZKW0TEAMB5R"
+	)
+
+	report, errWithCode := suite.adminProcessor.ReportUpdate(ctx, adminAcct, reportID,
+		&apimodel.AdminReportUpdateRequest{
+			Category:          util.Ptr("spam"),
+			AssignedAccountID: util.Ptr(adminAcct.ID),
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("spam", report.Category)
+	suite.NotNil(report.AssignedAccount)
+	suite.Equal(adminAcct.ID, report.AssignedAccount.ID)
+
+	// Filter reports by the new category + assignee.
+	resp, errWithCode := suite.adminProcessor.ReportsGet(ctx, adminAcct,
+		nil, "", "", "spam", adminAcct.ID, nil,
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(resp.Items, 1)
+
+	// Unassign the report again.
+	report, errWithCode = suite.adminProcessor.ReportAssign(ctx, adminAcct, reportID, "")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Nil(report.AssignedAccount)
+}
+
+func (suite *ReportTestSuite) TestReportAssignNotStaff() {
+	var (
+		ctx       = suite.T().Context()
+		adminAcct = suite.testAccounts["admin_account"]
+		userAcct  = suite.testAccounts["local_account_1"]
+	)
+
+	_, errWithCode := suite.adminProcessor.ReportAssign(ctx, adminAcct, "01GP3AWY4CRDVRNZKW0TEAMB5R", userAcct.ID)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func (suite *ReportTestSuite) TestReportUpdateBadCategory() {
+	var (
+		ctx       = suite.T().Context()
+		adminAcct = suite.testAccounts["admin_account"]
+	)
+
+	_, errWithCode := suite.adminProcessor.ReportUpdate(ctx, adminAcct, "01GP3AWY4CRDVRNZKW0TEAMB5R",
+		&apimodel.AdminReportUpdateRequest{
+			Category: util.Ptr("bad vibes"),
+		},
+	)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func (suite *ReportTestSuite) TestReportNotes() {
+	var (
+		ctx       = suite.T().Context()
+		adminAcct = suite.testAccounts["admin_account"]
+		otherAcct = suite.testAccounts["local_account_1"]
+		reportID  = "01GP3AWY4CRDVRNZKW0TEAMB5R"
+	)
+
+	note, errWithCode := suite.adminProcessor.ReportNoteCreate(ctx, adminAcct, reportID, "on it, looks like spam")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("on it, looks like spam", note.Content)
+	suite.Equal(adminAcct.ID, note.Account.ID)
+
+	notes, errWithCode := suite.adminProcessor.ReportNotesGet(ctx, reportID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(notes, 1)
+
+	// Only the author can delete a note.
+	errWithCode = suite.adminProcessor.ReportNoteDelete(ctx, otherAcct, reportID, note.ID)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	errWithCode = suite.adminProcessor.ReportNoteDelete(ctx, adminAcct, reportID, note.ID)
+	suite.Nil(errWithCode)
+
+	notes, errWithCode = suite.adminProcessor.ReportNotesGet(ctx, reportID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(notes)
+}
+
+func (suite *ReportTestSuite) TestReportResolveForward() {
+	var (
+		ctx        = suite.T().Context()
+		adminAcct  = suite.testAccounts["admin_account"]
+		targetAcct = suite.testAccounts["remote_account_1"]
+		status     = suite.testStatuses["remote_account_1_status_1"]
+	)
+
+	// Put a new, unforwarded report
+	// about a remote account's status.
+	report := &gtsmodel.Report{
+		ID:              "01JAN0JW8Y4B5Q4JT8XKQK0VJW",
+		URI:             "http://localhost:8080/reports/01JAN0JW8Y4B5Q4JT8XKQK0VJW",
+		AccountID:       suite.testAccounts["local_account_1"].ID,
+		TargetAccountID: targetAcct.ID,
+		Comment:         "i'm local_account_1 and this post annoyed me personally",
+		StatusIDs:       []string{status.ID},
+		Forwarded:       util.Ptr(false),
+	}
+	if err := suite.state.DB.PutReport(ctx, report); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiReport, errWithCode := suite.adminProcessor.ReportResolve(ctx, adminAcct, report.ID,
+		&apimodel.AdminReportResolveRequest{
+			ActionTakenComment: util.Ptr("forwarded to their admins"),
+			Forward:            true,
+			ForwardStatusIDs:   []string{status.ID},
+			ForwardComment:     util.Ptr("this post is annoying"),
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.True(apiReport.ActionTaken)
+	suite.True(apiReport.Forwarded)
+
+	// Original comment should be kept locally.
+	suite.Equal(report.Comment, apiReport.Comment)
+
+	// Report can't be forwarded twice.
+	_, errWithCode = suite.adminProcessor.ReportResolve(ctx, adminAcct, report.ID,
+		&apimodel.AdminReportResolveRequest{
+			Forward: true,
+		},
+	)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func (suite *ReportTestSuite) TestReportResolveForwardLocal() {
+	var (
+		ctx       = suite.T().Context()
+		adminAcct = suite.testAccounts["admin_account"]
+	)
+
+	// Report about local_account_2, can't be forwarded.
+	_, errWithCode := suite.adminProcessor.ReportResolve(ctx, adminAcct, "01GP3DFY9XQ1TJMZT5BGAZPXX7",
+		&apimodel.AdminReportResolveRequest{
+			Forward: true,
+		},
+	)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func TestReportTestSuite(t *testing.T) {
+	suite.Run(t, &ReportTestSuite{})
+}
@@ -0,0 +1,142 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+)
+
+// maxReportNoteChars is the maximum
+// length of a report note's content.
+const maxReportNoteChars = 5000
+
+// ReportNotesGet returns all internal notes made on
+// the report with the given id, oldest first.
+func (p *Processor) ReportNotesGet(
+	ctx context.Context,
+	reportID string,
+) ([]*apimodel.AdminReportNote, gtserror.WithCode) {
+	if _, errWithCode := p.getReport(ctx, reportID); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	notes, err := p.state.DB.GetReportNotes(ctx, reportID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting report notes: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiNotes := make([]*apimodel.AdminReportNote, 0, len(notes))
+	for _, note := range notes {
+		apiNote, err := p.converter.ReportNoteToAdminAPIReportNote(ctx, note)
+		if err != nil {
+			err := gtserror.Newf("error converting report note to api: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		apiNotes = append(apiNotes, apiNote)
+	}
+
+	return apiNotes, nil
+}
+
+// ReportNoteCreate creates an internal note on the report
+// with the given id, authored by the given admin account.
+func (p *Processor) ReportNoteCreate(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	reportID string,
+	content string,
+) (*apimodel.AdminReportNote, gtserror.WithCode) {
+	if content == "" {
+		const text = "content must be set"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if length := len([]rune(content)); length > maxReportNoteChars {
+		err := fmt.Errorf("content length %d exceeds maximum of %d characters", length, maxReportNoteChars)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if _, errWithCode := p.getReport(ctx, reportID); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	note := &gtsmodel.ReportNote{
+		ID:        id.NewULID(),
+		CreatedAt: time.Now(),
+		ReportID:  reportID,
+		AccountID: account.ID,
+		Account:   account,
+		Content:   content,
+	}
+
+	if err := p.state.DB.PutReportNote(ctx, note); err != nil {
+		err := gtserror.Newf("db error putting report note: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiNote, err := p.converter.ReportNoteToAdminAPIReportNote(ctx, note)
+	if err != nil {
+		err := gtserror.Newf("error converting report note to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiNote, nil
+}
+
+// ReportNoteDelete deletes the internal note with the given
+// id from the report with the given id. Only the author of
+// a note is allowed to delete it.
+func (p *Processor) ReportNoteDelete(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	reportID string,
+	noteID string,
+) gtserror.WithCode {
+	note, err := p.state.DB.GetReportNoteByID(ctx, noteID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting report note: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if note == nil || note.ReportID != reportID {
+		err := fmt.Errorf("note %s not found on report %s", noteID, reportID)
+		return gtserror.NewErrorNotFound(err)
+	}
+
+	if note.AccountID != account.ID {
+		const text = "only the author of a note can delete it"
+		return gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	if err := p.state.DB.DeleteReportNoteByID(ctx, noteID); err != nil {
+		err := gtserror.Newf("db error deleting report note: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
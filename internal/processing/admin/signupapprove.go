@@ -54,7 +54,7 @@ func (p *Processor) SignupApprove(
 
 	if !*user.Approved {
 		// Process approval side effects asynschronously.
-		p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 			// Use ap.ObjectProfile here to
 			// distinguish this message (user model)
 			// from ap.ActorPerson (account model).
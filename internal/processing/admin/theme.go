@@ -0,0 +1,202 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/processing/account"
+	"code.superseriousbusiness.org/gotosocial/internal/storage"
+	"codeberg.org/gruf/go-bytesize"
+)
+
+// themeFileNameRegex matches permitted
+// file names for uploaded themes.
+var themeFileNameRegex = regexp.MustCompile(`^[a-z0-9_\-]{1,64}\.css$`)
+
+// maxThemeSize is the maximum size of an uploaded theme,
+// matching the limit for themes in the web assets dir.
+const maxThemeSize = int64(bytesize.MiB)
+
+// ThemesGet returns all themes uploaded to this instance by admins.
+func (p *Processor) ThemesGet(ctx context.Context) ([]apimodel.Theme, gtserror.WithCode) {
+	themes, err := p.state.DB.GetThemes(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting themes: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.ThemesToAPIThemes(themes), nil
+}
+
+// ThemeCreate uploads a new theme from the given form, storing
+// its CSS in storage and making it available for accounts
+// on this instance to select for their profile.
+func (p *Processor) ThemeCreate(
+	ctx context.Context,
+	admin *gtsmodel.Account,
+	form *apimodel.AdminThemeCreateRequest,
+) (*apimodel.Theme, gtserror.WithCode) {
+	if form.Theme == nil || form.Theme.Size == 0 {
+		const text = "no theme file given"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if form.Theme.Size > maxThemeSize {
+		err := fmt.Errorf("theme too large: theme is %dKB but size limit for themes is %dKB", form.Theme.Size/1024, maxThemeSize/1024)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	fileName := strings.ToLower(path.Base(form.Theme.Filename))
+	if !themeFileNameRegex.MatchString(fileName) {
+		err := fmt.Errorf("theme file name %s must be 1-64 lowercase letters, numbers, underscores or hyphens, followed by .css", fileName)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// Make sure file name is not yet taken,
+	// either by a bundled or uploaded theme.
+	taken, err := p.themeFileNameTaken(ctx, fileName)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if taken {
+		err := fmt.Errorf("a theme with file name %s already exists", fileName)
+		return nil, gtserror.NewErrorConflict(err, err.Error())
+	}
+
+	// Read the theme CSS.
+	f, err := form.Theme.Open()
+	if err != nil {
+		err := gtserror.Newf("error opening theme file: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	defer f.Close()
+
+	contents, err := io.ReadAll(io.LimitReader(f, maxThemeSize))
+	if err != nil {
+		err := gtserror.Newf("error reading theme file: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Parse title + description from the
+	// CSS itself, preferring form values.
+	theme := account.ParseTheme(fileName, contents)
+	if title := strings.TrimSpace(form.Title); title != "" {
+		theme.Title = title
+	}
+	if desc := strings.TrimSpace(form.Description); desc != "" {
+		theme.Description = desc
+	}
+	theme.ID = id.NewULID()
+	theme.CreatedAt = time.Now()
+	theme.CreatedByAccountID = admin.ID
+
+	if _, err := p.state.Storage.Put(ctx, theme.StoragePath(), contents); err != nil {
+		err := gtserror.Newf("error storing theme %s: %w", fileName, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.PutTheme(ctx, theme); err != nil {
+		// Tidy up stored CSS, as
+		// it won't be reachable.
+		if err := p.state.Storage.Delete(ctx, theme.StoragePath()); err != nil {
+			log.Errorf(ctx, "error deleting stored theme %s: %v", fileName, err)
+		}
+
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err := fmt.Errorf("a theme with file name %s already exists", fileName)
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+		err := gtserror.Newf("db error inserting theme: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return &p.converter.ThemesToAPIThemes([]*gtsmodel.Theme{theme})[0], nil
+}
+
+// ThemeDelete deletes an uploaded theme with the given ID,
+// removing it from storage, and returning the deleted theme.
+// Accounts still using the theme fall back to the default.
+func (p *Processor) ThemeDelete(ctx context.Context, id string) (*apimodel.Theme, gtserror.WithCode) {
+	theme, err := p.state.DB.GetThemeByID(ctx, id)
+	switch {
+	case err == nil:
+		// Found.
+
+	case errors.Is(err, db.ErrNoEntries):
+		const text = "theme not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+
+	default:
+		err := gtserror.Newf("db error getting theme: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.DeleteThemeByID(ctx, id); err != nil {
+		err := gtserror.Newf("db error deleting theme: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.Storage.Delete(ctx, theme.StoragePath()); err != nil && !storage.IsNotFound(err) {
+		err := gtserror.Newf("error deleting stored theme %s: %w", theme.FileName, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return &p.converter.ThemesToAPIThemes([]*gtsmodel.Theme{theme})[0], nil
+}
+
+// themeFileNameTaken returns whether the given file name is in use
+// by either a theme in the web assets dir, or an uploaded theme.
+func (p *Processor) themeFileNameTaken(ctx context.Context, fileName string) (bool, error) {
+	webAssetsAbsFilePath, err := filepath.Abs(config.GetWebAssetBaseDir())
+	if err != nil {
+		return false, gtserror.Newf("error getting abs path for web assets: %w", err)
+	}
+
+	bundledPath := filepath.Join(webAssetsAbsFilePath, "themes", fileName)
+	if _, err := os.Stat(bundledPath); err == nil {
+		return true, nil
+	}
+
+	_, err = p.state.DB.GetThemeByFileName(ctx, fileName)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, db.ErrNoEntries):
+		return false, nil
+	default:
+		return false, gtserror.Newf("db error checking theme %s: %w", fileName, err)
+	}
+}
@@ -0,0 +1,167 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"code.superseriousbusiness.org/gotosocial/internal/validate"
+)
+
+// WebfingerAliasesGet returns all webfinger aliases stored on this instance.
+func (p *Processor) WebfingerAliasesGet(ctx context.Context) ([]*apimodel.AdminWebfingerAlias, gtserror.WithCode) {
+	aliases, err := p.state.DB.GetWebfingerAliases(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting webfinger aliases: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAliases := make([]*apimodel.AdminWebfingerAlias, len(aliases))
+	for i := range aliases {
+		apiAliases[i] = toAPIWebfingerAlias(aliases[i])
+	}
+
+	return apiAliases, nil
+}
+
+// WebfingerAliasCreate creates a new webfinger alias mapping
+// username@domain to an existing local account, marking
+// as authored by the provided admin.
+func (p *Processor) WebfingerAliasCreate(
+	ctx context.Context,
+	admin *gtsmodel.Account,
+	form *apimodel.AdminWebfingerAliasCreateRequest,
+) (*apimodel.AdminWebfingerAlias, gtserror.WithCode) {
+	username := strings.ToLower(strings.TrimSpace(form.Username))
+	if err := validate.Username(username); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	domain := strings.ToLower(strings.TrimSpace(form.Domain))
+	if !isWebfingerDomain(domain) {
+		err := fmt.Errorf("domain %s is not a host, account domain, or webfinger alias domain of this instance", domain)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// Make sure the target account exists and is ours.
+	account, err := p.state.DB.GetAccountByID(ctx, form.AccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting account %s: %w", form.AccountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if account == nil || !account.IsLocal() || account.IsInstance() {
+		const text = "account_id must be the id of an existing local account"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	// An alias must not shadow the name of another
+	// local account, as that would make webfinger
+	// resolution of the real account ambiguous.
+	if domain == config.GetHost() || domain == config.GetAccountDomain() {
+		existing, err := p.state.DB.GetAccountByUsernameDomain(ctx, username, "")
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error checking username %s: %w", username, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if existing != nil {
+			err := fmt.Errorf("username %s is already taken by a local account", username)
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+	}
+
+	alias := &gtsmodel.WebfingerAlias{
+		ID:        id.NewULID(),
+		CreatedAt: time.Now(),
+		Username:  username,
+		Domain:    domain,
+		AccountID: account.ID,
+		Account:   account,
+		AuthorID:  admin.ID,
+	}
+
+	if err := p.state.DB.PutWebfingerAlias(ctx, alias); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err := fmt.Errorf("a webfinger alias for %s@%s already exists", username, domain)
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+		err := gtserror.Newf("db error inserting webfinger alias: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return toAPIWebfingerAlias(alias), nil
+}
+
+// WebfingerAliasDelete deletes an existing webfinger
+// alias, returning the deleted alias.
+func (p *Processor) WebfingerAliasDelete(ctx context.Context, id string) (*apimodel.AdminWebfingerAlias, gtserror.WithCode) {
+	alias, err := p.state.DB.GetWebfingerAliasByID(ctx, id)
+	switch {
+	case err == nil:
+		// Found.
+
+	case errors.Is(err, db.ErrNoEntries):
+		const text = "webfinger alias not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+
+	default:
+		err := gtserror.Newf("db error getting webfinger alias: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.DeleteWebfingerAliasByID(ctx, id); err != nil {
+		err := gtserror.Newf("db error deleting webfinger alias: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return toAPIWebfingerAlias(alias), nil
+}
+
+// isWebfingerDomain returns whether the given domain is
+// one that this instance answers webfinger requests for.
+func isWebfingerDomain(domain string) bool {
+	return domain != "" && (domain == config.GetHost() ||
+		domain == config.GetAccountDomain() ||
+		slices.Contains(config.GetInstanceWebfingerAliasDomains(), domain))
+}
+
+// toAPIWebfingerAlias performs a simple conversion of
+// database model WebfingerAlias to API model.
+func toAPIWebfingerAlias(alias *gtsmodel.WebfingerAlias) *apimodel.AdminWebfingerAlias {
+	return &apimodel.AdminWebfingerAlias{
+		ID:        alias.ID,
+		Username:  alias.Username,
+		Domain:    alias.Domain,
+		AccountID: alias.AccountID,
+		CreatedBy: alias.AuthorID,
+		CreatedAt: util.FormatISO8601(alias.CreatedAt),
+	}
+}
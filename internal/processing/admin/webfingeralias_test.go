@@ -0,0 +1,127 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"github.com/stretchr/testify/suite"
+)
+
+type WebfingerAliasTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *WebfingerAliasTestSuite) TestWebfingerAliasCreateDelete() {
+	var (
+		ctx       = suite.T().Context()
+		adminAcct = suite.testAccounts["admin_account"]
+		target    = suite.testAccounts["local_account_1"]
+	)
+
+	config.SetInstanceWebfingerAliasDomains([]string{"gts.example"})
+
+	// Create an alias, username
+	// should be stored lowercase.
+	alias, errWithCode := suite.adminProcessor.WebfingerAliasCreate(ctx, adminAcct,
+		&apimodel.AdminWebfingerAliasCreateRequest{
+			Username:  "Zork",
+			Domain:    "gts.example",
+			AccountID: target.ID,
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("zork", alias.Username)
+	suite.Equal("gts.example", alias.Domain)
+	suite.Equal(target.ID, alias.AccountID)
+	suite.Equal(adminAcct.ID, alias.CreatedBy)
+
+	// Creating the same alias again should conflict.
+	_, errWithCode = suite.adminProcessor.WebfingerAliasCreate(ctx, adminAcct,
+		&apimodel.AdminWebfingerAliasCreateRequest{
+			Username:  "zork",
+			Domain:    "gts.example",
+			AccountID: adminAcct.ID,
+		},
+	)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+
+	aliases, errWithCode := suite.adminProcessor.WebfingerAliasesGet(ctx)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(aliases, 1)
+
+	// Delete the alias.
+	_, errWithCode = suite.adminProcessor.WebfingerAliasDelete(ctx, alias.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	_, errWithCode = suite.adminProcessor.WebfingerAliasDelete(ctx, alias.ID)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *WebfingerAliasTestSuite) TestWebfingerAliasCreateInvalid() {
+	var (
+		ctx        = suite.T().Context()
+		adminAcct  = suite.testAccounts["admin_account"]
+		target     = suite.testAccounts["local_account_1"]
+		remoteAcct = suite.testAccounts["remote_account_1"]
+	)
+
+	config.SetInstanceWebfingerAliasDomains([]string{"gts.example"})
+
+	for _, form := range []*apimodel.AdminWebfingerAliasCreateRequest{
+		// Invalid username.
+		{Username: "not a username!", Domain: "gts.example", AccountID: target.ID},
+		// Domain not ours.
+		{Username: "zork", Domain: "example.org", AccountID: target.ID},
+		// Remote account.
+		{Username: "zork", Domain: "gts.example", AccountID: remoteAcct.ID},
+		// Nonexistent account.
+		{Username: "zork", Domain: "gts.example", AccountID: "01HZZZZZZZZZZZZZZZZZZZZZZZ"},
+	} {
+		_, errWithCode := suite.adminProcessor.WebfingerAliasCreate(ctx, adminAcct, form)
+		suite.NotNil(errWithCode)
+		suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	}
+
+	// Shadowing another local account's
+	// username on our own host should conflict.
+	_, errWithCode := suite.adminProcessor.WebfingerAliasCreate(ctx, adminAcct,
+		&apimodel.AdminWebfingerAliasCreateRequest{
+			Username:  adminAcct.Username,
+			Domain:    config.GetHost(),
+			AccountID: target.ID,
+		},
+	)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+}
+
+func TestWebfingerAliasTestSuite(t *testing.T) {
+	suite.Run(t, &WebfingerAliasTestSuite{})
+}
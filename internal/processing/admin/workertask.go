@@ -219,7 +219,7 @@ func (p *Processor) PersistWorkerQueues(ctx context.Context) error {
 		return gtserror.Newf("error putting tasks in db: %w", err)
 	}
 
-	// Log recovered tasks.
+	// Log persisted tasks.
 	log.WithContext(ctx).
 		WithField("delivery", delivery).
 		WithField("federator", federator).
@@ -227,6 +227,24 @@ func (p *Processor) PersistWorkerQueues(ctx context.Context) error {
 		WithField("errors", errors).
 		Info("persisted queued tasks")
 
+	// Tasks queued to remaining worker pools
+	// are function ptrs which can't be persisted,
+	// so log how many of these are being dropped.
+	var (
+		dereference = p.state.Workers.Dereference.Queue.Len()
+		processing  = p.state.Workers.Processing.Queue.Len()
+		webpush     = p.state.Workers.WebPush.Queue.Len()
+		surfacing   = p.state.Workers.Surfacing.Queue.Len()
+	)
+	if dereference+processing+webpush+surfacing > 0 {
+		log.WithContext(ctx).
+			WithField("dereference", dereference).
+			WithField("processing", processing).
+			WithField("webpush", webpush).
+			WithField("surfacing", surfacing).
+			Warn("dropped unpersistable queued tasks")
+	}
+
 	return nil
 }
 
@@ -0,0 +1,83 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package antenna
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+)
+
+// maxAntennas is the maximum number of antennas
+// that may be owned by any one account. As every
+// incoming public status is matched against every
+// antenna on the instance, this is kept quite low.
+const maxAntennas = 20
+
+// Create creates a new antenna for the given account, using the provided parameters.
+// Title and rule lengths should have already been validated by the time they reach
+// this function; rules are normalized and checked for overall validity here.
+func (p *Processor) Create(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	form *apimodel.AntennaCreateRequest,
+) (*apimodel.Antenna, gtserror.WithCode) {
+	// Ensure account is not already at antenna limit.
+	count, err := p.state.DB.CountAntennasByAccountID(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error counting antennas: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if count >= maxAntennas {
+		text := fmt.Sprintf("you may have no more than %d antennas", maxAntennas)
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	antenna := &gtsmodel.Antenna{
+		ID:          id.NewULID(),
+		Title:       form.Title,
+		AccountID:   account.ID,
+		WithReplies: &form.WithReplies,
+	}
+
+	// Normalize + set the antenna rules.
+	if errWithCode := setRules(antenna,
+		form.Keywords,
+		form.ExcludeKeywords,
+		form.Domains,
+		form.AccountPatterns,
+	); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.PutAntenna(ctx, antenna); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err = errors.New("you already have an antenna with this title")
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiAntenna(ctx, antenna)
+}
@@ -0,0 +1,69 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package antenna
+
+import (
+	"context"
+	"errors"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// Get returns the api model of one antenna with the given ID.
+func (p *Processor) Get(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.Antenna, gtserror.WithCode) {
+	antenna, errWithCode := p.getAntenna(
+		// Use barebones ctx; no embedded
+		// structs necessary for this call.
+		gtscontext.SetBarebones(ctx),
+		account.ID,
+		id,
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiAntenna(ctx, antenna)
+}
+
+// GetAll returns all antennas created by the given account, sorted newest first.
+func (p *Processor) GetAll(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.Antenna, gtserror.WithCode) {
+	antennas, err := p.state.DB.GetAntennasByAccountID(
+
+		// Use barebones ctx; no embedded
+		// structs necessary for simple GET.
+		gtscontext.SetBarebones(ctx),
+		account.ID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAntennas := make([]*apimodel.Antenna, 0, len(antennas))
+	for _, antenna := range antennas {
+		apiAntenna, errWithCode := p.apiAntenna(ctx, antenna)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		apiAntennas = append(apiAntennas, apiAntenna)
+	}
+
+	return apiAntennas, nil
+}
@@ -0,0 +1,87 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package antenna
+
+import (
+	"context"
+	"errors"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// Update updates one antenna for the given account, using the provided parameters.
+// The rule sets of the antenna are always replaced by those in the given form.
+func (p *Processor) Update(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	id string,
+	form *apimodel.AntennaUpdateRequest,
+) (*apimodel.Antenna, gtserror.WithCode) {
+	antenna, errWithCode := p.getAntenna(
+		// Use barebones ctx; no embedded
+		// structs necessary for this call.
+		gtscontext.SetBarebones(ctx),
+		account.ID,
+		id,
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Rule columns are always updated,
+	// other columns only when provided.
+	columns := []string{
+		"keywords",
+		"exclude_keywords",
+		"domains",
+		"account_patterns",
+	}
+
+	if form.Title != nil {
+		antenna.Title = *form.Title
+		columns = append(columns, "title")
+	}
+
+	if form.WithReplies != nil {
+		antenna.WithReplies = form.WithReplies
+		columns = append(columns, "with_replies")
+	}
+
+	// Normalize + set the antenna rules.
+	if errWithCode := setRules(antenna,
+		form.Keywords,
+		form.ExcludeKeywords,
+		form.Domains,
+		form.AccountPatterns,
+	); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.UpdateAntenna(ctx, antenna, columns...); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err = errors.New("you already have an antenna with this title")
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiAntenna(ctx, antenna)
+}
@@ -0,0 +1,126 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package antenna
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"code.superseriousbusiness.org/gopkg/xslices"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	antennafilter "code.superseriousbusiness.org/gotosocial/internal/filter/antenna"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// getAntenna is a shortcut to get one antenna from the database and
+// check that it's owned by the given accountID. Will return
+// appropriate errors so caller doesn't need to bother.
+func (p *Processor) getAntenna(ctx context.Context, accountID string, antennaID string) (*gtsmodel.Antenna, gtserror.WithCode) {
+	antenna, err := p.state.DB.GetAntennaByID(ctx, antennaID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting antenna: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if antenna == nil {
+		const text = "antenna not found"
+		return nil, gtserror.NewErrorNotFound(
+			errors.New(text),
+			text,
+		)
+	}
+
+	if antenna.AccountID != accountID {
+		const text = "antenna not found"
+		return nil, gtserror.NewErrorNotFound(
+			errors.New("antenna does not belong to account"),
+			text,
+		)
+	}
+
+	return antenna, nil
+}
+
+// apiAntenna is a shortcut to return the API version of the given
+// antenna, or return an appropriate error if conversion fails.
+func (p *Processor) apiAntenna(ctx context.Context, antenna *gtsmodel.Antenna) (*apimodel.Antenna, gtserror.WithCode) {
+	apiAntenna, err := p.converter.AntennaToAPIAntenna(ctx, antenna)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting antenna to api: %w", err))
+	}
+
+	return apiAntenna, nil
+}
+
+// setRules normalizes the given rule sets and sets them on antenna,
+// returning a bad request error if any rule is invalid, or if no
+// keyword, domain or account pattern rules were provided at all.
+func setRules(
+	antenna *gtsmodel.Antenna,
+	keywords []string,
+	excludeKeywords []string,
+	domains []string,
+	accountPatterns []string,
+) gtserror.WithCode {
+	antenna.Keywords = normalizeRules(keywords)
+	antenna.ExcludeKeywords = normalizeRules(excludeKeywords)
+	antenna.Domains = normalizeRules(domains)
+	antenna.AccountPatterns = normalizeRules(accountPatterns)
+
+	for i, domain := range antenna.Domains {
+		domain, err := util.PunifySafely(domain)
+		if err != nil || domain == "" {
+			text := fmt.Sprintf("invalid antenna domain: %s", antenna.Domains[i])
+			return gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+		antenna.Domains[i] = domain
+	}
+
+	for _, pattern := range antenna.AccountPatterns {
+		if !antennafilter.ValidAccountPattern(pattern) {
+			text := fmt.Sprintf("invalid antenna account pattern: %s", pattern)
+			return gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+	}
+
+	if len(antenna.Keywords) == 0 &&
+		len(antenna.Domains) == 0 &&
+		len(antenna.AccountPatterns) == 0 {
+		const text = "antenna must have at least one of keywords, domains or account_patterns"
+		return gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	return nil
+}
+
+// normalizeRules lowercases and trims the given
+// rules, dropping any duplicate or empty entries.
+func normalizeRules(rules []string) []string {
+	out := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		rule = strings.ToLower(strings.TrimSpace(rule))
+		if rule != "" {
+			out = append(out, rule)
+		}
+	}
+	return xslices.Deduplicate(out)
+}
@@ -0,0 +1,64 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"context"
+	"errors"
+
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// GetOwnBookmarkCollection fetches the bookmark collection with given ID,
+// checking that it's owned by the given requester. Will return appropriate
+// errors so caller doesn't need to bother.
+func (p *Processor) GetOwnBookmarkCollection(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	collectionID string,
+) (*gtsmodel.BookmarkCollection, gtserror.WithCode) {
+	collection, err := p.state.DB.GetBookmarkCollectionByID(
+		gtscontext.SetBarebones(ctx),
+		collectionID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting bookmark collection: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if collection == nil {
+		const text = "bookmark collection not found"
+		return nil, gtserror.NewErrorNotFound(
+			errors.New(text),
+			text,
+		)
+	}
+
+	if collection.AccountID != requester.ID {
+		const text = "bookmark collection not found"
+		return nil, gtserror.NewErrorNotFound(
+			errors.New("bookmark collection does not belong to account"),
+			text,
+		)
+	}
+
+	return collection, nil
+}
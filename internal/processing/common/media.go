@@ -63,6 +63,13 @@ func (p *Processor) StoreLocalMedia(
 		err := gtserror.Newf("error processing media: %w", err)
 		return nil, gtserror.NewErrorUnprocessableEntity(err, text)
 
+	case attachment.Error == gtsmodel.NewMediaErrorDetails(
+		gtsmodel.MediaErrorTypePolicy,
+		gtsmodel.MediaErrorTypePolicy_Moderation,
+	):
+		const text = "media rejected by moderation"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+
 	case attachment.Type == gtsmodel.FileTypeUnknown:
 		text := fmt.Sprintf("could not process %s type media", attachment.File.ContentType)
 		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
@@ -27,6 +27,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/filter/visibility"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/processing/stream"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
 )
@@ -34,6 +35,7 @@
 type Processor struct {
 	state        *state.State
 	converter    *typeutils.Converter
+	stream       *stream.Processor
 	visFilter    *visibility.Filter
 	muteFilter   *mutes.Filter
 	statusFilter *status.Filter
@@ -42,6 +44,7 @@ type Processor struct {
 func New(
 	state *state.State,
 	converter *typeutils.Converter,
+	stream *stream.Processor,
 	visFilter *visibility.Filter,
 	muteFilter *mutes.Filter,
 	statusFilter *status.Filter,
@@ -49,6 +52,7 @@ func New(
 	return Processor{
 		state:        state,
 		converter:    converter,
+		stream:       stream,
 		visFilter:    visFilter,
 		muteFilter:   muteFilter,
 		statusFilter: statusFilter,
@@ -35,6 +35,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/media"
 	"code.superseriousbusiness.org/gotosocial/internal/messages"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/conversations"
+	"code.superseriousbusiness.org/gotosocial/internal/processing/stream"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
 	"code.superseriousbusiness.org/gotosocial/internal/storage"
 	"code.superseriousbusiness.org/gotosocial/internal/transport"
@@ -57,6 +58,7 @@ type ConversationsTestSuite struct {
 	sentEmails          map[string]string
 	visFilter           *visibility.Filter
 	muteFilter          *mutes.Filter
+	streamProcessor     stream.Processor
 
 	// standard suite models
 	testTokens       map[string]*gtsmodel.Token
@@ -119,7 +121,8 @@ func (suite *ConversationsTestSuite) SetupTest() {
 	suite.sentEmails = make(map[string]string)
 	suite.emailSender = testrig.NewEmailSender("../../../web/template/", suite.sentEmails)
 
-	suite.conversationsProcessor = conversations.New(&suite.state, suite.tc, suite.visFilter, suite.muteFilter, status.NewFilter(&suite.state))
+	suite.streamProcessor = stream.New(&suite.state, testrig.NewTestOauthServer(&suite.state))
+	suite.conversationsProcessor = conversations.New(&suite.state, suite.tc, &suite.streamProcessor, suite.visFilter, suite.muteFilter, status.NewFilter(&suite.state))
 	testrig.StandardDBSetup(suite.db, nil)
 	testrig.StandardStorageSetup(suite.storage, "../../../testrig/media")
 
@@ -27,10 +27,14 @@
 	"code.superseriousbusiness.org/gotosocial/internal/util"
 )
 
+// Read marks the conversation with given ID as read up to and including
+// the status with lastReadID, or the last status if lastReadID is empty.
+// The read marker will only ever be moved forward by this function.
 func (p *Processor) Read(
 	ctx context.Context,
 	requestingAccount *gtsmodel.Account,
 	id string,
+	lastReadID string,
 ) (*apimodel.Conversation, gtserror.WithCode) {
 	// Get the conversation, including participating accounts and last status.
 	conversation, errWithCode := p.getConversationOwnedBy(ctx, id, requestingAccount)
@@ -38,10 +42,63 @@ func (p *Processor) Read(
 		return nil, errWithCode
 	}
 
-	// Mark the conversation as read.
-	conversation.Read = util.Ptr(true)
-	if err := p.state.DB.UpsertConversation(ctx, conversation, "read"); err != nil {
-		err = gtserror.Newf("DB error updating conversation %s: %w", id, err)
+	if lastReadID == "" || lastReadID > conversation.LastStatusID {
+		// Default (and cap) the
+		// marker to last status.
+		lastReadID = conversation.LastStatusID
+	}
+
+	if lastReadID > conversation.LastReadStatusID {
+		// Only ever move the read marker forward, so that
+		// a client lagging behind can't undo another's read.
+		conversation.LastReadStatusID = lastReadID
+	}
+
+	// Conversation is read once the marker reaches last status.
+	read := (conversation.LastReadStatusID >= conversation.LastStatusID)
+	conversation.Read = util.Ptr(read)
+
+	return p.updateReadState(ctx, requestingAccount, conversation)
+}
+
+// Unread marks the conversation with given ID as unread, moving
+// the read marker back if it covered the last status.
+func (p *Processor) Unread(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	id string,
+) (*apimodel.Conversation, gtserror.WithCode) {
+	// Get the conversation, including participating accounts and last status.
+	conversation, errWithCode := p.getConversationOwnedBy(ctx, id, requestingAccount)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if conversation.LastReadStatusID >= conversation.LastStatusID {
+		// Marker covers the last status,
+		// so it must be cleared to unread.
+		conversation.LastReadStatusID = ""
+	}
+
+	// Mark the conversation as unread.
+	conversation.Read = util.Ptr(false)
+
+	return p.updateReadState(ctx, requestingAccount, conversation)
+}
+
+// updateReadState stores the updated read state of given conversation,
+// and streams the change to any of the owner's open streams so that
+// their other clients can sync it, returning the API model.
+func (p *Processor) updateReadState(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	conversation *gtsmodel.Conversation,
+) (*apimodel.Conversation, gtserror.WithCode) {
+	if err := p.state.DB.UpsertConversation(ctx, conversation,
+		"read",
+		"last_read_status_id",
+	); err != nil {
+		err = gtserror.Newf("DB error updating conversation %s: %w", conversation.ID, err)
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
@@ -60,12 +117,15 @@ func (p *Processor) Read(
 		requestingAccount,
 	)
 	if err != nil {
-		err = gtserror.Newf("error converting conversation %s to API representation: %w", id, err)
+		err = gtserror.Newf("error converting conversation %s to API representation: %w", conversation.ID, err)
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
 	// Set filter results on attached status model.
 	apiConversation.LastStatus.Filtered = filtered
 
+	// Stream updated read state to the owner's other clients.
+	p.stream.Conversation(ctx, requestingAccount.ID, apiConversation)
+
 	return apiConversation, nil
 }
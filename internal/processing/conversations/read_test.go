@@ -18,6 +18,8 @@
 package conversations_test
 
 import (
+	"time"
+
 	"code.superseriousbusiness.org/gotosocial/internal/util"
 )
 
@@ -25,8 +27,54 @@ func (suite *ConversationsTestSuite) TestRead() {
 	conversation := suite.NewTestConversation(suite.testAccount, 0)
 
 	suite.False(util.PtrOrValue(conversation.Read, false))
-	apiConversation, err := suite.conversationsProcessor.Read(suite.T().Context(), suite.testAccount, conversation.ID)
+	apiConversation, err := suite.conversationsProcessor.Read(suite.T().Context(), suite.testAccount, conversation.ID, "")
+	if suite.NoError(err) {
+		suite.False(apiConversation.Unread)
+		suite.Equal(conversation.LastStatusID, apiConversation.LastReadID)
+	}
+}
+
+func (suite *ConversationsTestSuite) TestReadUpToStatus() {
+	conversation := suite.NewTestConversation(suite.testAccount, 0)
+	firstStatus := conversation.LastStatus
+
+	// Add a newer reply as the last status.
+	reply := suite.NewTestStatus(suite.testAccount, conversation.ThreadID, 1*time.Second, firstStatus)
+	conversation = suite.SetLastStatus(conversation, reply)
+
+	// Reading up to the first status should
+	// move the marker, but leave it unread.
+	apiConversation, err := suite.conversationsProcessor.Read(suite.T().Context(), suite.testAccount, conversation.ID, firstStatus.ID)
+	if suite.NoError(err) {
+		suite.True(apiConversation.Unread)
+		suite.Equal(firstStatus.ID, apiConversation.LastReadID)
+	}
+
+	// Reading up to the reply should mark it read.
+	apiConversation, err = suite.conversationsProcessor.Read(suite.T().Context(), suite.testAccount, conversation.ID, reply.ID)
 	if suite.NoError(err) {
 		suite.False(apiConversation.Unread)
+		suite.Equal(reply.ID, apiConversation.LastReadID)
+	}
+
+	// A lagging client reading up to the first
+	// status should not move the marker back.
+	apiConversation, err = suite.conversationsProcessor.Read(suite.T().Context(), suite.testAccount, conversation.ID, firstStatus.ID)
+	if suite.NoError(err) {
+		suite.False(apiConversation.Unread)
+		suite.Equal(reply.ID, apiConversation.LastReadID)
+	}
+}
+
+func (suite *ConversationsTestSuite) TestUnread() {
+	conversation := suite.NewTestConversation(suite.testAccount, 0)
+
+	_, err := suite.conversationsProcessor.Read(suite.T().Context(), suite.testAccount, conversation.ID, "")
+	suite.NoError(err)
+
+	apiConversation, err := suite.conversationsProcessor.Unread(suite.T().Context(), suite.testAccount, conversation.ID)
+	if suite.NoError(err) {
+		suite.True(apiConversation.Unread)
+		suite.Empty(apiConversation.LastReadID)
 	}
 }
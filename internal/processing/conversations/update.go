@@ -140,6 +140,10 @@ func (p *Processor) UpdateConversationsForStatus(ctx context.Context, status *gt
 		// If the conversation is read but this status might not have been, mark the conversation as unread.
 		if !statusAuthoredByConversationOwner {
 			conversation.Read = util.Ptr(false)
+		} else if status.ID > conversation.LastReadStatusID {
+			// Owner has read their own status,
+			// so move the read marker up to it.
+			conversation.LastReadStatusID = status.ID
 		}
 
 		// Create or update the conversation.
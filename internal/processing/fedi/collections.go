@@ -26,6 +26,7 @@
 	"code.superseriousbusiness.org/activity/streams/vocab"
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/paging"
@@ -49,7 +50,8 @@ func (p *Processor) InboxPost(ctx context.Context, w http.ResponseWriter, r *htt
 
 // OutboxGet returns the serialized ActivityPub
 // collection of a local account's outbox, which
-// contains links to PUBLIC posts by this account.
+// contains links to PUBLIC posts by this account,
+// and optionally to PUBLIC boosts by this account.
 func (p *Processor) OutboxGet(
 	ctx context.Context,
 	requestedUser string,
@@ -75,6 +77,9 @@ func (p *Processor) OutboxGet(
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	// Check if boosts should be listed.
+	boosts := config.GetInstanceOutboxIncludeBoosts()
+
 	var obj vocab.Type
 
 	// Start the AS collection params.
@@ -108,7 +113,7 @@ func (p *Processor) OutboxGet(
 			receiver.ID,
 			page.GetLimit(), // limit
 			true,            // excludeReplies
-			true,            // excludeReblogs
+			!boosts,         // excludeReblogs
 			page.GetMax(),   // maxID
 			page.GetMin(),   // minID
 			false,           // mediaOnly
@@ -129,6 +134,11 @@ func (p *Processor) OutboxGet(
 			hi = statuses[0].ID
 		}
 
+		// Check whether the database returned
+		// fewer statuses than requested, in which
+		// case there is nothing further to page to.
+		end := len(statuses) < page.GetLimit()
+
 		// Reslice statuses dropping all those invisible to requester
 		// (eg., local-only statuses, if the requester is remote).
 		statuses, err = p.visFilter.StatusesVisible(
@@ -150,15 +160,33 @@ func (p *Processor) OutboxGet(
 		pageParams.Current = page
 		pageParams.Count = len(statuses)
 
-		// Set linked next/prev parameters.
-		pageParams.Next = page.Next(lo, hi)
-		pageParams.Prev = page.Prev(lo, hi)
+		// Set linked next/prev parameters, omitting
+		// whichever direction we've reached the end of.
+		if !end || page.GetMin() != "" {
+			pageParams.Next = page.Next(lo, hi)
+		}
+		if !end || page.GetMin() == "" {
+			pageParams.Prev = page.Prev(lo, hi)
+		}
 
 		// Set the collection item property builder function.
 		pageParams.Append = func(i int, itemsProp ap.ItemsPropertyBuilder) {
 			// Get status at index.
 			status := statuses[i]
 
+			if status.BoostOfID != "" {
+				// Derive announce from boost wrapper status.
+				announce, err := p.converter.BoostToAS(ctx, status)
+				if err != nil {
+					log.Errorf(ctx, "error converting %s to announce: %v", status.URI, err)
+					return
+				}
+
+				// Add to item property.
+				itemsProp.AppendActivityStreamsAnnounce(announce)
+				return
+			}
+
 			// Derive statusable from status.
 			statusable, err := p.converter.StatusToAS(ctx, status)
 			if err != nil {
@@ -430,6 +458,18 @@ func (p *Processor) FeaturedCollectionGet(ctx context.Context, requestedUser str
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	// Drop pinned statuses invisible to requester
+	// (eg., local-only statuses, if the requester is remote).
+	statuses, err = p.visFilter.StatusesVisible(
+		ctx,
+		auth.requester,
+		statuses,
+	)
+	if err != nil {
+		err := gtserror.Newf("error filtering pinned statuses: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
 	collection, err := p.converter.StatusesToASFeaturedCollection(ctx, receiver.FeaturedCollectionURI, statuses)
 	if err != nil {
 		err := gtserror.Newf("error converting pinned statuses: %w", err)
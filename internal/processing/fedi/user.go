@@ -25,6 +25,8 @@
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/uris"
 )
 
 // UserGet handles getting an AP representation of an account.
@@ -72,9 +74,13 @@ func (p *Processor) UserGet(
 // UserGetMinimal returns a minimal AP representation
 // of the requested account, containing just the public
 // key, without doing authentication.
+//
+// If keyID is set, the key with that ID is served,
+// which may be a previous key retained after rotation.
 func (p *Processor) UserGetMinimal(
 	ctx context.Context,
 	requestedUser string,
+	keyID string,
 ) (any, gtserror.WithCode) {
 	acct, err := p.state.DB.GetAccountByUsernameDomain(
 		gtscontext.SetBarebones(ctx),
@@ -90,6 +96,35 @@ func (p *Processor) UserGetMinimal(
 		return nil, gtserror.NewErrorNotFound(err)
 	}
 
+	// Select the requested public key.
+	keyURI := acct.URI + "/" + uris.PublicKeyPath
+	if keyID != "" {
+		keyURI += "/" + keyID
+	}
+
+	switch {
+	case keyURI == acct.PublicKeyURI:
+		// Current key.
+
+	case keyURI == acct.PreviousPublicKeyURI &&
+		acct.PreviousPubKeyValid():
+		// Key retained after rotation, still within its
+		// grace period, so serve it in place of current.
+		prev := new(gtsmodel.Account)
+		*prev = *acct
+		prev.PublicKey = acct.PreviousPublicKey
+		prev.PublicKeyURI = acct.PreviousPublicKeyURI
+		acct = prev
+
+	case keyID == "":
+		// Original key location of a
+		// rotated account, serve current.
+
+	default:
+		err := gtserror.Newf("key %s not found for account %s", keyID, requestedUser)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
 	// Generate minimal AP representation.
 	accountable, err := p.converter.AccountToASMinimal(ctx, acct)
 	if err != nil {
@@ -19,12 +19,16 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
 )
 
 const (
@@ -162,7 +166,10 @@ func getNodeInfoMetadata(instance *gtsmodel.Instance) map[string]any {
 
 	// Contact related info.
 	contactField := make(map[string]string, 2)
-	if instance.ContactAccount != nil {
+	if instance.ContactAccount != nil &&
+		util.PtrOrZero(instance.ContactAccount.Indexable) {
+		// Only expose contact account
+		// if it's opted into indexing.
 		contactField["name"] = "@" + instance.ContactAccount.Username + "@" + config.GetAccountDomain()
 	}
 	if instance.ContactEmail != "" {
@@ -210,20 +217,31 @@ func (p *Processor) HostMetaGet() *apimodel.HostMeta {
 }
 
 // WebfingerGet handles the GET for a webfinger resource. Most commonly, it will be used for returning account lookups.
-func (p *Processor) WebfingerGet(ctx context.Context, requestedUser string) (*apimodel.WellKnownResponse, gtserror.WithCode) {
+//
+// The requested host may be this instance's host or account domain, or one of the configured
+// webfinger alias domains. Names are checked against admin-managed webfinger aliases first,
+// before falling back to a local account with the requested username.
+func (p *Processor) WebfingerGet(ctx context.Context, requestedUser string, requestedHost string) (*apimodel.WellKnownResponse, gtserror.WithCode) {
 	// Get the local account the request is referring to.
-	requestedAccount, err := p.state.DB.GetAccountByUsernameDomain(ctx, requestedUser, "")
-	if err != nil {
-		err := gtserror.Newf("db error getting account %s: %s", requestedUser, err)
-		return nil, gtserror.NewErrorNotFound(err)
+	requestedAccount, aliased, errWithCode := p.webfingerAccount(ctx, requestedUser, requestedHost)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	aliases := []string{
+		requestedAccount.URI,
+		requestedAccount.URL,
+	}
+
+	if aliased {
+		// Include the name that was requested,
+		// since it differs from the subject.
+		aliases = append(aliases, webfingerAccount+":"+requestedUser+"@"+requestedHost)
 	}
 
 	return &apimodel.WellKnownResponse{
 		Subject: webfingerAccount + ":" + requestedAccount.Username + "@" + config.GetAccountDomain(),
-		Aliases: []string{
-			requestedAccount.URI,
-			requestedAccount.URL,
-		},
+		Aliases: aliases,
 		Links: []apimodel.Link{
 			{
 				Rel:  webfingerProfilePage,
@@ -238,3 +256,35 @@ func (p *Processor) WebfingerGet(ctx context.Context, requestedUser string) (*ap
 		},
 	}, nil
 }
+
+// webfingerAccount returns the local account that requestedUser@requestedHost resolves
+// to, along with whether that name differs from the account's canonical webfinger name.
+func (p *Processor) webfingerAccount(ctx context.Context, requestedUser string, requestedHost string) (*gtsmodel.Account, bool, gtserror.WithCode) {
+	alias, err := p.state.DB.GetWebfingerAliasByUsernameDomain(ctx,
+		strings.ToLower(requestedUser),
+		strings.ToLower(requestedHost),
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting webfinger alias %s@%s: %w", requestedUser, requestedHost, err)
+		return nil, false, gtserror.NewErrorInternalError(err)
+	}
+
+	if alias != nil {
+		account, err := p.state.DB.GetAccountByID(ctx, alias.AccountID)
+		if err != nil {
+			err := gtserror.Newf("db error getting account %s for webfinger alias %s: %w", alias.AccountID, alias.ID, err)
+			return nil, false, gtserror.NewErrorNotFound(err)
+		}
+		return account, true, nil
+	}
+
+	account, err := p.state.DB.GetAccountByUsernameDomain(ctx, requestedUser, "")
+	if err != nil {
+		err := gtserror.Newf("db error getting account %s: %s", requestedUser, err)
+		return nil, false, gtserror.NewErrorNotFound(err)
+	}
+
+	aliased := requestedHost != config.GetHost() &&
+		requestedHost != config.GetAccountDomain()
+	return account, aliased, nil
+}
@@ -132,6 +132,11 @@ func (p *Processor) InstancePeersGet(
 	}
 
 	if includeOpen {
+		// Only include metadata if configured to,
+		// and if a non-flat response was requested.
+		includeMetadata := !flatten &&
+			config.GetInstancePeersGranularity() == config.InstancePeersGranularityMetadata
+
 		instances, err := p.state.DB.GetInstancePeers(ctx, false)
 		if err != nil && !errors.Is(err, db.ErrNoEntries) {
 			err = gtserror.Newf("db error getting instance peers: %w", err)
@@ -148,12 +153,18 @@ func (p *Processor) InstancePeersGet(
 				continue
 			}
 
-			apiDomains = append(
-				apiDomains,
-				&apimodel.Domain{
-					Domain: depunied,
-				},
-			)
+			apiDomain := &apimodel.Domain{
+				Domain: depunied,
+			}
+
+			if includeMetadata {
+				// Include software info
+				// gleaned from nodeinfo.
+				apiDomain.Software = instance.Software
+				apiDomain.Version = instance.Version
+			}
+
+			apiDomains = append(apiDomains, apiDomain)
 		}
 	}
 
@@ -196,6 +207,23 @@ func (p *Processor) InstanceGetRules(ctx context.Context) ([]apimodel.InstanceRu
 	return typeutils.InstanceRulesToAPIRules(i.Rules), nil
 }
 
+// InstanceGetLandingBlocks returns all blocks
+// to show on the instance landing page, in order.
+func (p *Processor) InstanceGetLandingBlocks(ctx context.Context) ([]*apimodel.LandingBlock, gtserror.WithCode) {
+	blocks, err := p.state.DB.GetLandingBlocks(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting landing blocks: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiBlocks := make([]*apimodel.LandingBlock, len(blocks))
+	for i := range blocks {
+		apiBlocks[i] = typeutils.LandingBlockToAPILandingBlock(blocks[i])
+	}
+
+	return apiBlocks, nil
+}
+
 func (p *Processor) InstancePatch(ctx context.Context, form *apimodel.InstanceSettingsUpdateRequest) (*apimodel.InstanceV1, gtserror.WithCode) {
 	// Fetch this instance from the db for processing.
 	instance, err := p.getThisInstance(ctx)
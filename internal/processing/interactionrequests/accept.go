@@ -147,7 +147,7 @@ func (p *Processor) acceptLike(
 
 	// Send the accepted request off through the
 	// client API processor to handle side effects.
-	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+	p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 		APObjectType:   ap.ActivityLike,
 		APActivityType: ap.ActivityAccept,
 		GTSModel:       req,
@@ -188,7 +188,7 @@ func (p *Processor) acceptReply(
 
 	// Send the accepted request off through the
 	// client API processor to handle side effects.
-	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+	p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 		APObjectType:   ap.ObjectNote,
 		APActivityType: ap.ActivityAccept,
 		GTSModel:       req,
@@ -229,7 +229,7 @@ func (p *Processor) acceptAnnounce(
 
 	// Send the accepted request off through the
 	// client API processor to handle side effects.
-	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+	p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 		APObjectType:   ap.ActivityAnnounce,
 		APActivityType: ap.ActivityAccept,
 		GTSModel:       req,
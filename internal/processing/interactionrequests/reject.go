@@ -82,7 +82,7 @@ func (p *Processor) Reject(
 	case gtsmodel.InteractionLike:
 		// Send the rejected request off through the
 		// client API processor to handle side effects.
-		p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 			APObjectType:   ap.ActivityLike,
 			APActivityType: ap.ActivityReject,
 			GTSModel:       req,
@@ -93,7 +93,7 @@ func (p *Processor) Reject(
 	case gtsmodel.InteractionReply:
 		// Send the rejected request off through the
 		// client API processor to handle side effects.
-		p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 			APObjectType:   ap.ObjectNote,
 			APActivityType: ap.ActivityReject,
 			GTSModel:       req,
@@ -104,7 +104,7 @@ func (p *Processor) Reject(
 	case gtsmodel.InteractionAnnounce:
 		// Send the rejected request off through the
 		// client API processor to handle side effects.
-		p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 			APObjectType:   ap.ActivityAnnounce,
 			APActivityType: ap.ActivityReject,
 			GTSModel:       req,
@@ -0,0 +1,148 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package list
+
+import (
+	"context"
+	"errors"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// GetListEntry returns the list entry for targetAccountID
+// in the given list, including any per-member overrides.
+func (p *Processor) GetListEntry(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	listID string,
+	targetAccountID string,
+) (*apimodel.ListEntry, gtserror.WithCode) {
+	entry, errWithCode := p.getListEntry(ctx, account, listID, targetAccountID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiListEntry(ctx, entry)
+}
+
+// UpdateListEntry updates the per-member overrides of the list
+// entry for targetAccountID in the given list. An empty replies
+// policy clears the override, so the list's own policy applies.
+// These params should have already been validated by the time
+// they reach this function.
+func (p *Processor) UpdateListEntry(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	listID string,
+	targetAccountID string,
+	showReblogs *bool,
+	repliesPolicy *gtsmodel.RepliesPolicy,
+) (*apimodel.ListEntry, gtserror.WithCode) {
+	entry, errWithCode := p.getListEntry(ctx, account, listID, targetAccountID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Only update columns we're told to update.
+	columns := make([]string, 0, 2)
+
+	if showReblogs != nil {
+		entry.ShowReblogs = showReblogs
+		columns = append(columns, "show_reblogs")
+	}
+
+	if repliesPolicy != nil {
+		entry.RepliesPolicy = *repliesPolicy
+		columns = append(columns, "replies_policy")
+	}
+
+	if err := p.state.DB.UpdateListEntry(ctx, entry, columns...); err != nil {
+		err := gtserror.Newf("db error updating list entry: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiListEntry(ctx, entry)
+}
+
+// getListEntry is a shortcut to get the list entry for
+// targetAccountID in the list with ID, checking that the
+// list is owned by given account. Will return appropriate
+// errors so caller doesn't need to bother.
+func (p *Processor) getListEntry(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	listID string,
+	targetAccountID string,
+) (*gtsmodel.ListEntry, gtserror.WithCode) {
+	// Ensure this list exists + account owns it.
+	_, errWithCode := p.getList(
+		gtscontext.SetBarebones(ctx),
+		account.ID,
+		listID,
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Get the follow to target account.
+	follow, err := p.state.DB.GetFollow(
+		gtscontext.SetBarebones(ctx),
+		account.ID,
+		targetAccountID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting follow: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if follow == nil {
+		const text = "account not in list"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	// Get the list entry for this follow.
+	entry, err := p.state.DB.GetListEntry(ctx, listID, follow.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting list entry: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if entry == nil {
+		const text = "account not in list"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	entry.Follow = follow
+	return entry, nil
+}
+
+// apiListEntry is a shortcut to return the API version of the given
+// list entry, or return an appropriate error if conversion fails.
+func (p *Processor) apiListEntry(ctx context.Context, entry *gtsmodel.ListEntry) (*apimodel.ListEntry, gtserror.WithCode) {
+	apiEntry, err := p.converter.ListEntryToAPIListEntry(ctx, entry)
+	if err != nil {
+		err := gtserror.Newf("error converting list entry to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiEntry, nil
+}
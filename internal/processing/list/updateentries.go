@@ -30,8 +30,21 @@
 	"code.superseriousbusiness.org/gotosocial/internal/util"
 )
 
-// AddToList adds targetAccountIDs to the given list, if valid.
-func (p *Processor) AddToList(ctx context.Context, account *gtsmodel.Account, listID string, targetAccountIDs []string) gtserror.WithCode {
+// AddToList adds targetAccountIDs to the given list, if valid,
+// with the given (optional) per-member overrides. These params
+// should have already been validated by the time they reach here.
+func (p *Processor) AddToList(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	listID string,
+	targetAccountIDs []string,
+	showReblogs *bool,
+	repliesPolicy gtsmodel.RepliesPolicy,
+) gtserror.WithCode {
+	if showReblogs == nil {
+		// Show boosts by default.
+		showReblogs = util.Ptr(true)
+	}
 
 	// Ensure this list exists + account owns it.
 	_, errWithCode := p.getList(ctx, account.ID, listID)
@@ -91,9 +104,11 @@ func (p *Processor) AddToList(ctx context.Context, account *gtsmodel.Account, li
 
 		// Generate new entry for this follow in list.
 		entries = append(entries, &gtsmodel.ListEntry{
-			ID:       id.NewULID(),
-			ListID:   listID,
-			FollowID: follow.ID,
+			ID:            id.NewULID(),
+			ListID:        listID,
+			FollowID:      follow.ID,
+			ShowReblogs:   util.Ptr(*showReblogs),
+			RepliesPolicy: repliesPolicy,
 		})
 	}
 
@@ -31,7 +31,7 @@
 
 // Get returns an API model for the markers of the requested timelines.
 // If a timeline marker hasn't been set yet, it's not included in the response.
-func (p *Processor) Get(ctx context.Context, account *gtsmodel.Account, names []apimodel.MarkerName) (*apimodel.Marker, gtserror.WithCode) {
+func (p *Processor) Get(ctx context.Context, account *gtsmodel.Account, names []apimodel.MarkerName) (apimodel.Marker, gtserror.WithCode) {
 	markers := make([]*gtsmodel.Marker, 0, len(names))
 	for _, name := range names {
 		marker, err := p.state.DB.GetMarker(ctx, account.ID, typeutils.APIMarkerNameToMarkerName(name))
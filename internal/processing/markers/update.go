@@ -21,15 +21,25 @@
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 )
 
 // Update updates the given markers and returns an API model for them.
-func (p *Processor) Update(ctx context.Context, markers []*gtsmodel.Marker) (*apimodel.Marker, gtserror.WithCode) {
+func (p *Processor) Update(ctx context.Context, markers []*gtsmodel.Marker) (apimodel.Marker, gtserror.WithCode) {
+	for _, marker := range markers {
+		// Ensure the timeline this
+		// marker is for actually exists.
+		if errWithCode := p.checkTimeline(ctx, marker); errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
 	for _, marker := range markers {
 		if err := p.state.DB.UpdateMarker(ctx, marker); err != nil {
 			if errors.Is(err, db.ErrAlreadyExists) {
@@ -46,3 +56,36 @@ func (p *Processor) Update(ctx context.Context, markers []*gtsmodel.Marker) (*ap
 
 	return apiMarker, nil
 }
+
+// checkTimeline checks that the list or tag timeline the given
+// marker is for exists, and in the case of a list, is owned by
+// the marker account. Other timelines always exist.
+func (p *Processor) checkTimeline(ctx context.Context, marker *gtsmodel.Marker) gtserror.WithCode {
+	if listID, ok := strings.CutPrefix(string(marker.Name), gtsmodel.MarkerNameListPrefix); ok {
+		list, err := p.state.DB.GetListByID(gtscontext.SetBarebones(ctx), listID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting list %s: %w", listID, err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		if list == nil || list.AccountID != marker.AccountID {
+			const text = "list not found"
+			return gtserror.NewErrorNotFound(errors.New(text), text)
+		}
+	}
+
+	if tagID, ok := strings.CutPrefix(string(marker.Name), gtsmodel.MarkerNameTagPrefix); ok {
+		tag, err := p.state.DB.GetTag(ctx, tagID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting tag %s: %w", tagID, err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		if tag == nil {
+			const text = "tag not found"
+			return gtserror.NewErrorNotFound(errors.New(text), text)
+		}
+	}
+
+	return nil
+}
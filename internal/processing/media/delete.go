@@ -49,6 +49,13 @@ func (p *Processor) Delete(ctx context.Context, mediaAttachmentID string) gtserr
 		}
 	}
 
+	// delete the sprite sheet from storage
+	if attachment.Sprite.Path != "" {
+		if err := p.state.Storage.Delete(ctx, attachment.Sprite.Path); err != nil && !storage.IsNotFound(err) {
+			errs = append(errs, fmt.Sprintf("remove sprite at path %s: %s", attachment.Sprite.Path, err))
+		}
+	}
+
 	// delete the file from storage
 	if attachment.File.Path != "" {
 		if err := p.state.Storage.Delete(ctx, attachment.File.Path); err != nil && !storage.IsNotFound(err) {
@@ -18,23 +18,32 @@
 package media
 
 import (
+	"cmp"
 	"context"
-	"fmt"
+	// nolint:gosec
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
 )
 
 // GetCustomEmojis returns a list of all useable local custom emojis stored on this instance.
 // 'useable' in this context means visible and picker, and not disabled.
 func (p *Processor) GetCustomEmojis(ctx context.Context) ([]*apimodel.Emoji, gtserror.WithCode) {
-	emojis, err := p.state.DB.GetUseableEmojis(ctx)
-	if err != nil {
-		if err != db.ErrNoEntries {
-			return nil, gtserror.NewErrorNotFound(fmt.Errorf("db error retrieving custom emojis: %s", err))
-		}
+	emojis, errWithCode := p.getUseableEmojis(ctx)
+	if errWithCode != nil {
+		return nil, errWithCode
 	}
 
 	apiEmojis := make([]*apimodel.Emoji, 0, len(emojis))
@@ -49,3 +58,152 @@ func (p *Processor) GetCustomEmojis(ctx context.Context) ([]*apimodel.Emoji, gts
 
 	return apiEmojis, nil
 }
+
+// GetCustomEmojisPage returns a page of useable local custom emojis,
+// arranged alphabetically by shortcode, optionally filtered to only
+// those emojis in the category with the given name.
+func (p *Processor) GetCustomEmojisPage(
+	ctx context.Context,
+	category string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	emojis, errWithCode := p.getUseableEmojis(ctx)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if category != "" {
+		// Drop emojis not in the requested category.
+		emojis = slices.DeleteFunc(emojis, func(emoji *gtsmodel.Emoji) bool {
+			return emoji.Category == nil ||
+				!strings.EqualFold(emoji.Category.Name, category)
+		})
+	}
+
+	// Page the emojis by shortcode.
+	emojis = pageEmojis(emojis, page)
+
+	count := len(emojis)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	items := make([]interface{}, 0, count)
+	for _, gtsEmoji := range emojis {
+		apiEmoji, err := p.converter.EmojiToAPIEmoji(ctx, gtsEmoji)
+		if err != nil {
+			log.Errorf(ctx, "error converting emoji with id %s: %s", gtsEmoji.ID, err)
+			continue
+		}
+		items = append(items, apiEmoji)
+	}
+
+	// Preserve category
+	// filter in links.
+	var query url.Values
+	if category != "" {
+		query = url.Values{"category": []string{category}}
+	}
+
+	// Emojis are arranged a-z, so the next page
+	// starts after the last shortcode, and the
+	// previous page ends before the first one.
+	var (
+		last  = emojis[count-1].Shortcode
+		first = emojis[0].Shortcode
+	)
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/custom_emojis",
+		Next:  page.Next(last, first),
+		Prev:  page.Prev(last, first),
+		Query: query,
+	}), nil
+}
+
+// GetCustomEmojisETag returns a strong ETag for the current set of
+// useable local custom emojis. As this is generated from the cached
+// emoji models, it's cheap to check against a request's If-None-Match
+// header before converting and serializing all emojis to the frontend.
+func (p *Processor) GetCustomEmojisETag(ctx context.Context) (string, gtserror.WithCode) {
+	emojis, errWithCode := p.getUseableEmojis(ctx)
+	if errWithCode != nil {
+		return "", errWithCode
+	}
+
+	hash := sha1.New() // nolint:gosec
+	for _, emoji := range emojis {
+		// Any change to an emoji bumps its updated_at,
+		// but category renames don't touch the emoji.
+		var categoryName string
+		if emoji.Category != nil {
+			categoryName = emoji.Category.Name
+		}
+
+		_, _ = io.WriteString(hash, emoji.ID)
+		_, _ = io.WriteString(hash, strconv.FormatInt(emoji.UpdatedAt.UnixNano(), 10))
+		_, _ = io.WriteString(hash, categoryName)
+		_, _ = io.WriteString(hash, "\n")
+	}
+
+	return `"` + hex.EncodeToString(hash.Sum(nil)) + `"`, nil
+}
+
+// getUseableEmojis returns all useable local emojis,
+// sorted alphabetically (case-insensitive) by shortcode.
+func (p *Processor) getUseableEmojis(ctx context.Context) ([]*gtsmodel.Emoji, gtserror.WithCode) {
+	emojis, err := p.state.DB.GetUseableEmojis(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error retrieving custom emojis: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Sort here rather than relying on database
+	// collation, so that paging by shortcode is
+	// consistent with the comparisons we make.
+	slices.SortFunc(emojis, func(a, b *gtsmodel.Emoji) int {
+		return cmp.Compare(
+			strings.ToLower(a.Shortcode),
+			strings.ToLower(b.Shortcode),
+		)
+	})
+
+	return emojis, nil
+}
+
+// pageEmojis pages the given a-z sorted emojis according
+// to the shortcode boundaries and limit of the given page.
+func pageEmojis(emojis []*gtsmodel.Emoji, page *paging.Page) []*gtsmodel.Emoji {
+	if page == nil {
+		// no paging.
+		return emojis
+	}
+
+	if max := strings.ToLower(page.GetMax()); max != "" {
+		// Drop emojis up to and including max.
+		emojis = slices.DeleteFunc(emojis, func(emoji *gtsmodel.Emoji) bool {
+			return strings.ToLower(emoji.Shortcode) <= max
+		})
+	}
+
+	min := strings.ToLower(page.GetMin())
+	if min != "" {
+		// Drop emojis from min onwards.
+		emojis = slices.DeleteFunc(emojis, func(emoji *gtsmodel.Emoji) bool {
+			return strings.ToLower(emoji.Shortcode) >= min
+		})
+	}
+
+	if limit := page.GetLimit(); limit > 0 && limit < len(emojis) {
+		if min != "" {
+			// Paging backwards, so
+			// take those nearest min.
+			emojis = emojis[len(emojis)-limit:]
+		} else {
+			emojis = emojis[:limit]
+		}
+	}
+
+	return emojis
+}
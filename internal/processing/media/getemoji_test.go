@@ -20,6 +20,10 @@
 import (
 	"testing"
 
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/testrig"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -35,6 +39,118 @@ func (suite *GetEmojiTestSuite) TestGetCustomEmojis() {
 	suite.Equal("rainbow", emojis[0].Shortcode)
 }
 
+func (suite *GetEmojiTestSuite) TestGetCustomEmojisPage() {
+	ctx := suite.T().Context()
+
+	// Add a few more local
+	// emojis to page through.
+	for _, e := range []struct {
+		id        string
+		shortcode string
+	}{
+		{"01JQ8RR2KW46QK9QAKHN1AB2XA", "blobcat"},
+		{"01JQ8RR2KW46QK9QAKHN1AB2XB", "Aardvark"},
+		{"01JQ8RR2KW46QK9QAKHN1AB2XC", "zebra"},
+	} {
+		emoji := new(gtsmodel.Emoji)
+		*emoji = *testrig.NewTestEmojis()["rainbow"]
+		emoji.ID = e.id
+		emoji.Shortcode = e.shortcode
+		emoji.URI = "http://localhost:8080/emoji/" + e.id
+		emoji.ImageURL = "http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/emoji/original/" + e.id + ".png"
+		emoji.ImageStaticURL = "http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/emoji/static/" + e.id + ".png"
+		emoji.CategoryID = ""
+		if err := suite.db.PutEmoji(ctx, emoji); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	shortcodes := func(resp *apimodel.PageableResponse) []string {
+		shortcodes := make([]string, 0, len(resp.Items))
+		for _, item := range resp.Items {
+			shortcodes = append(shortcodes, item.(apimodel.Emoji).Shortcode)
+		}
+		return shortcodes
+	}
+
+	// No paging, all emojis a-z.
+	resp, errWithCode := suite.mediaProcessor.GetCustomEmojisPage(ctx, "", nil)
+	suite.NoError(errWithCode)
+	suite.Equal([]string{"Aardvark", "blobcat", "rainbow", "zebra"}, shortcodes(resp))
+	suite.Empty(resp.LinkHeader)
+
+	// First page.
+	resp, errWithCode = suite.mediaProcessor.GetCustomEmojisPage(ctx, "", &paging.Page{
+		Min:   paging.MinShortcode(""),
+		Max:   paging.MaxShortcode(""),
+		Limit: 2,
+	})
+	suite.NoError(errWithCode)
+	suite.Equal([]string{"Aardvark", "blobcat"}, shortcodes(resp))
+	suite.Equal(`<http://localhost:8080/api/v1/custom_emojis?limit=2&max_shortcode=blobcat>; rel="next", <http://localhost:8080/api/v1/custom_emojis?limit=2&min_shortcode=Aardvark>; rel="prev"`, resp.LinkHeader)
+
+	// Next page.
+	resp, errWithCode = suite.mediaProcessor.GetCustomEmojisPage(ctx, "", &paging.Page{
+		Min:   paging.MinShortcode(""),
+		Max:   paging.MaxShortcode("blobcat"),
+		Limit: 2,
+	})
+	suite.NoError(errWithCode)
+	suite.Equal([]string{"rainbow", "zebra"}, shortcodes(resp))
+
+	// Previous page from the end.
+	resp, errWithCode = suite.mediaProcessor.GetCustomEmojisPage(ctx, "", &paging.Page{
+		Min:   paging.MinShortcode("zebra"),
+		Max:   paging.MaxShortcode(""),
+		Limit: 2,
+	})
+	suite.NoError(errWithCode)
+	suite.Equal([]string{"blobcat", "rainbow"}, shortcodes(resp))
+
+	// Filtered by category.
+	resp, errWithCode = suite.mediaProcessor.GetCustomEmojisPage(ctx, "Reactions", nil)
+	suite.NoError(errWithCode)
+	suite.Equal([]string{"rainbow"}, shortcodes(resp))
+
+	// Unknown category.
+	resp, errWithCode = suite.mediaProcessor.GetCustomEmojisPage(ctx, "nope", nil)
+	suite.NoError(errWithCode)
+	suite.Empty(resp.Items)
+}
+
+func (suite *GetEmojiTestSuite) TestGetCustomEmojisETag() {
+	ctx := suite.T().Context()
+
+	eTag1, errWithCode := suite.mediaProcessor.GetCustomEmojisETag(ctx)
+	suite.NoError(errWithCode)
+	suite.NotEmpty(eTag1)
+
+	// Should be stable when nothing changed.
+	eTag2, errWithCode := suite.mediaProcessor.GetCustomEmojisETag(ctx)
+	suite.NoError(errWithCode)
+	suite.Equal(eTag1, eTag2)
+
+	// Hide the emoji from the picker.
+	emoji, err := suite.db.GetEmojiByShortcodeDomain(ctx, "rainbow", "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	emoji.VisibleInPicker = new(bool)
+	if err := suite.db.UpdateEmoji(ctx, emoji, "visible_in_picker"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// ETag should now be different.
+	eTag3, errWithCode := suite.mediaProcessor.GetCustomEmojisETag(ctx)
+	suite.NoError(errWithCode)
+	suite.NotEqual(eTag1, eTag3)
+
+	// And emoji no longer listed.
+	emojis, errWithCode := suite.mediaProcessor.GetCustomEmojis(ctx)
+	suite.NoError(errWithCode)
+	suite.Empty(emojis)
+}
+
 func TestGetEmojiTestSuite(t *testing.T) {
 	suite.Run(t, &GetEmojiTestSuite{})
 }
@@ -166,8 +166,11 @@ func (p *Processor) getAttachmentContent(
 	}
 
 	// Unknown file types indicate no *locally*
-	// stored data we can serve. Handle separately.
-	if attach.Type == gtsmodel.FileTypeUnknown {
+	// stored data we can serve. Handle separately,
+	// unless this is remote media that previously
+	// failed to cache in a way that supports retry.
+	if attach.Type == gtsmodel.FileTypeUnknown &&
+		!retryableRemote(attach) {
 		return handleUnknown(attach)
 	}
 
@@ -183,23 +186,39 @@ func (p *Processor) getAttachmentContent(
 	// values depending on requested media size.
 	var content apimodel.Content
 	var mediaPath func(*gtsmodel.MediaAttachment) string
+	var mediaInfo func(*gtsmodel.MediaAttachment) (string, int64)
 	switch sizeStr {
 
 	// Original media size.
 	case media.SizeOriginal:
-		content.ContentType = attach.File.ContentType
-		content.ContentLength = int64(attach.File.FileSize)
 		mediaPath = func(a *gtsmodel.MediaAttachment) string {
 			return a.File.Path
 		}
+		mediaInfo = func(a *gtsmodel.MediaAttachment) (string, int64) {
+			return a.File.ContentType, int64(a.File.FileSize)
+		}
 
 	// Thumbnail media size.
 	case media.SizeSmall:
-		content.ContentType = attach.Thumbnail.ContentType
-		content.ContentLength = int64(attach.Thumbnail.FileSize)
 		mediaPath = func(a *gtsmodel.MediaAttachment) string {
 			return a.Thumbnail.Path
 		}
+		mediaInfo = func(a *gtsmodel.MediaAttachment) (string, int64) {
+			return a.Thumbnail.ContentType, int64(a.Thumbnail.FileSize)
+		}
+
+	// Video preview sprite sheet.
+	case media.SizeSprite:
+		if attach.Sprite.URL == "" {
+			const text = "media sprite not found"
+			return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+		}
+		mediaPath = func(a *gtsmodel.MediaAttachment) string {
+			return a.Sprite.Path
+		}
+		mediaInfo = func(a *gtsmodel.MediaAttachment) (string, int64) {
+			return a.Sprite.ContentType, int64(a.Sprite.FileSize)
+		}
 
 	default:
 		const text = "invalid media size"
@@ -209,6 +228,10 @@ func (p *Processor) getAttachmentContent(
 		)
 	}
 
+	// Set content details from attachment, these
+	// will be updated below if media gets recached.
+	content.ContentType, content.ContentLength = mediaInfo(attach)
+
 	// Attachment file
 	// stream from storage.
 	var rc io.ReadCloser
@@ -233,8 +256,17 @@ func (p *Processor) getAttachmentContent(
 				"local media file not found")
 		}
 
+		// If a recent attempt to recache this media
+		// failed, don't hammer the remote with another
+		// attempt until backoff has passed, just serve
+		// the placeholder for uncached media instead.
+		if attach.RecacheBackingOff() {
+			media.RecacheMetrics.Skipped.Add(1)
+			return handleUnknown(attach)
+		}
+
 		// Attempt to recache this remote media.
-		attach, err = p.federator.RefreshMedia(ctx,
+		recached, err := p.federator.RefreshMedia(ctx,
 			requestUser,
 			attach,
 			media.AdditionalMediaInfo{},
@@ -245,6 +277,24 @@ func (p *Processor) getAttachmentContent(
 			err := gtserror.Newf("error recaching media %s: %w", attach.RemoteURL, err)
 			return nil, gtserror.WrapWithCode(http.StatusNotFound, err)
 		}
+		attach = recached
+
+		if !attach.Cached() {
+			// Recache didn't error but media
+			// still isn't cached, e.g. stubbed.
+			return handleUnknown(attach)
+		}
+
+		if mediaPath(attach) == "" {
+			// Media was recached, but without
+			// this size, e.g. sprite sheets were
+			// disabled since first being cached.
+			const text = "media size not found"
+			return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+		}
+
+		// Update content details from recached media.
+		content.ContentType, content.ContentLength = mediaInfo(attach)
 
 		// Check storage for media at determined fileserver path.
 		rc, err = p.state.Storage.GetStream(ctx, mediaPath(attach))
@@ -427,6 +477,14 @@ func handleUnknown(
 	return &apimodel.Content{URL: url}, nil
 }
 
+// retryableRemote returns whether given attachment is remote
+// media that failed to cache in a way that supports retry.
+func retryableRemote(attach *gtsmodel.MediaAttachment) bool {
+	return attach.IsRemote() &&
+		attach.Error != 0 &&
+		attach.Error.SupportsRetry()
+}
+
 func parseType(s string) (media.Type, error) {
 	switch s {
 	case string(media.TypeAttachment):
@@ -449,6 +507,8 @@ func parseSize(s string) (media.Size, error) {
 		return media.SizeOriginal, nil
 	case string(media.SizeStatic):
 		return media.SizeStatic, nil
+	case string(media.SizeSprite):
+		return media.SizeSprite, nil
 	}
 	return "", fmt.Errorf("%s not a recognized media.Size", s)
 }
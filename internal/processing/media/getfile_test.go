@@ -22,6 +22,7 @@
 	"net/http"
 	"path"
 	"testing"
+	"time"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
@@ -167,6 +168,129 @@ func (suite *GetFileTestSuite) TestGetRemoteFileUncachedInterrupted() {
 	suite.Equal(suite.testRemoteAttachments[testAttachment.RemoteURL].Data, refreshedBytes)
 }
 
+func (suite *GetFileTestSuite) TestGetRemoteFileUncachedBackingOff() {
+	ctx := suite.T().Context()
+
+	// uncache the file from local
+	testAttachment := suite.testAttachments["remote_account_1_status_1_attachment_1"]
+
+	err := suite.storage.Delete(ctx, testAttachment.File.Path)
+	suite.NoError(err)
+	err = suite.storage.Delete(ctx, testAttachment.Thumbnail.Path)
+	suite.NoError(err)
+
+	fileName := path.Base(testAttachment.File.Path)
+	testAttachment.File.Path = ""
+	testAttachment.Thumbnail.Path = ""
+
+	// mark a recent failed attempt to recache
+	testAttachment.RecacheFailures = 1
+	testAttachment.RecacheFailedAt = time.Now()
+	testAttachment.RecacheBackoff = time.Hour
+
+	err = suite.db.UpdateAttachment(ctx, testAttachment,
+		"thumbnail_path",
+		"file_path",
+		"recache_failures",
+		"recache_failed_at",
+		"recache_backoff",
+	)
+	suite.NoError(err)
+
+	skipped := media.RecacheMetrics.Skipped.Load()
+
+	// now fetch it
+	requestingAccount := suite.testAccounts["local_account_1"]
+	content, errWithCode := suite.mediaProcessor.GetFile(ctx, requestingAccount, &apimodel.GetContentRequestForm{
+		AccountID: testAttachment.AccountID,
+		MediaType: string(media.TypeAttachment),
+		MediaSize: string(media.SizeOriginal),
+		FileName:  fileName,
+	})
+	suite.NoError(errWithCode)
+	suite.NotNil(content)
+
+	// recache should have been skipped,
+	// with request forwarded to remote.
+	suite.Nil(content.Content)
+	suite.Equal(testAttachment.RemoteURL, content.URL.String())
+	suite.Equal(skipped+1, media.RecacheMetrics.Skipped.Load())
+
+	// the attachment should still be uncached
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, testAttachment.ID)
+	suite.NoError(err)
+	suite.False(dbAttachment.Cached())
+}
+
+func (suite *GetFileTestSuite) TestGetRemoteFileUncachedBackoffElapsed() {
+	ctx := suite.T().Context()
+
+	// uncache the file from local
+	testAttachment := suite.testAttachments["remote_account_1_status_1_attachment_1"]
+
+	err := suite.storage.Delete(ctx, testAttachment.File.Path)
+	suite.NoError(err)
+	err = suite.storage.Delete(ctx, testAttachment.Thumbnail.Path)
+	suite.NoError(err)
+
+	fileName := path.Base(testAttachment.File.Path)
+	testAttachment.File.Path = ""
+	testAttachment.Thumbnail.Path = ""
+
+	// mark as having failed to recache due to
+	// a (retryable) server error, a while ago.
+	testAttachment.Type = gtsmodel.FileTypeUnknown
+	testAttachment.Error = gtsmodel.NewMediaErrorDetails(
+		gtsmodel.MediaErrorTypeHTTP,
+		http.StatusServiceUnavailable,
+	)
+	testAttachment.RecacheFailures = 3
+	testAttachment.RecacheFailedAt = time.Now().Add(-2 * time.Hour)
+	testAttachment.RecacheBackoff = time.Hour
+
+	err = suite.db.UpdateAttachment(ctx, testAttachment,
+		"thumbnail_path",
+		"file_path",
+		"type",
+		"error",
+		"recache_failures",
+		"recache_failed_at",
+		"recache_backoff",
+	)
+	suite.NoError(err)
+
+	// now fetch it
+	requestingAccount := suite.testAccounts["local_account_1"]
+	content, errWithCode := suite.mediaProcessor.GetFile(ctx, requestingAccount, &apimodel.GetContentRequestForm{
+		AccountID: testAttachment.AccountID,
+		MediaType: string(media.TypeAttachment),
+		MediaSize: string(media.SizeOriginal),
+		FileName:  fileName,
+	})
+	suite.NoError(errWithCode)
+	suite.NotNil(content)
+
+	// backoff elapsed, so should be recached.
+	b, err := io.ReadAll(content.Content)
+	suite.NoError(err)
+	suite.NoError(content.Content.Close())
+
+	suite.Equal(suite.testRemoteAttachments[testAttachment.RemoteURL].Data, b)
+	suite.Equal(suite.testRemoteAttachments[testAttachment.RemoteURL].ContentType, content.ContentType)
+	suite.EqualValues(len(suite.testRemoteAttachments[testAttachment.RemoteURL].Data), content.ContentLength)
+
+	// the attachment should be updated in the
+	// database, with backoff state reset.
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, testAttachment.ID)
+	suite.NoError(err)
+	suite.True(dbAttachment.Cached())
+	suite.Equal(gtsmodel.FileTypeImage, dbAttachment.Type)
+	suite.Zero(dbAttachment.Error)
+	suite.Zero(dbAttachment.RecacheFailures)
+	suite.Zero(dbAttachment.RecacheFailedAt)
+	suite.Zero(dbAttachment.RecacheBackoff)
+}
+
 func (suite *GetFileTestSuite) TestGetRemoteFileThumbnailUncached() {
 	ctx := suite.T().Context()
 	testAttachment := suite.testAttachments["remote_account_1_status_1_attachment_1"]
@@ -24,6 +24,7 @@
 	"testing"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/filter/mutes"
 	"code.superseriousbusiness.org/gotosocial/internal/filter/status"
 	"code.superseriousbusiness.org/gotosocial/internal/filter/visibility"
@@ -214,6 +215,40 @@ func (suite *PollTestSuite) testPollVote(ctx context.Context, requester *gtsmode
 	}
 }
 
+func (suite *PollTestSuite) TestPollVoters() {
+	ctx := suite.T().Context()
+	testrig.StandardDBSetup(suite.state.DB, nil)
+
+	var (
+		author = testrig.NewTestAccounts()["local_account_1"]
+		voter  = testrig.NewTestAccounts()["local_account_2"]
+		poll   = testrig.NewTestPolls()["local_account_1_status_6_poll"]
+	)
+
+	// Disabled by default, so should 404.
+	_, errWithCode := suite.polls.PollVoters(ctx, author, poll.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	config.SetStatusesPollLocalVoters(true)
+	defer config.SetStatusesPollLocalVoters(false)
+
+	// Only the poll author may view voters.
+	_, errWithCode = suite.polls.PollVoters(ctx, voter, poll.ID)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	voters, errWithCode := suite.polls.PollVoters(ctx, author, poll.ID)
+	suite.NoError(errWithCode)
+	suite.Len(voters, len(poll.Options))
+
+	// Both the local and the remote vote are for option
+	// 0, but only the local voter should be included.
+	suite.Equal("good", voters[0].Title)
+	suite.Len(voters[0].Accounts, 1)
+	suite.Equal(voter.ID, voters[0].Accounts[0].ID)
+	suite.Empty(voters[1].Accounts)
+	suite.Empty(voters[2].Accounts)
+}
+
 // voteChoicesAreValid is a utility function to check whether choices are valid for poll.
 func voteChoicesAreValid(poll *gtsmodel.Poll, choices []int) bool {
 	if len(choices) == 0 || !*poll.Multiple && len(choices) > 1 {
@@ -0,0 +1,94 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package polls
+
+import (
+	"context"
+	"errors"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// PollVoters returns, for each option of the poll with given ID,
+// the local accounts that voted for that option. Only the author
+// of the poll may see this, and only when enabled in config.
+func (p *Processor) PollVoters(ctx context.Context, requester *gtsmodel.Account, pollID string) ([]apimodel.PollOptionVoters, gtserror.WithCode) {
+	if !config.GetStatusesPollLocalVoters() {
+		const text = "viewing poll voters is not enabled on this instance"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	// Get (+ check visibility of) requested poll with ID.
+	poll, errWithCode := p.getTargetPoll(ctx, requester, pollID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if requester.ID != poll.Status.AccountID {
+		const text = "only the poll author can view poll voters"
+		return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	// Fetch all votes in the poll, with voting accounts.
+	votes, err := p.state.DB.GetPollVotes(ctx, poll.ID)
+	if err != nil {
+		err := gtserror.Newf("error getting poll %s votes: %w", poll.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Prepare one entry per poll option, in option order.
+	voters := make([]apimodel.PollOptionVoters, len(poll.Options))
+	for i, title := range poll.Options {
+		voters[i] = apimodel.PollOptionVoters{
+			Title:    title,
+			Accounts: make([]*apimodel.Account, 0),
+		}
+	}
+
+	for _, vote := range votes {
+		if vote.Account == nil || vote.Account.IsRemote() {
+			// Only local
+			// voters are shown.
+			continue
+		}
+
+		apiAccount, err := p.converter.AccountToAPIAccountPublic(ctx, vote.Account)
+		if err != nil {
+			log.Errorf(ctx, "error converting account %s to api model: %v", vote.AccountID, err)
+			continue
+		}
+
+		for _, choice := range vote.Choices {
+			if choice < 0 || choice >= len(voters) {
+				// Out of range, skip.
+				continue
+			}
+
+			voters[choice].Accounts = append(
+				voters[choice].Accounts,
+				apiAccount,
+			)
+		}
+	}
+
+	return voters, nil
+}
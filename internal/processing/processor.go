@@ -31,6 +31,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/processing/account"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/admin"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/advancedmigrations"
+	"code.superseriousbusiness.org/gotosocial/internal/processing/antenna"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/application"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/common"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/conversations"
@@ -46,8 +47,10 @@
 	"code.superseriousbusiness.org/gotosocial/internal/processing/push"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/report"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/search"
+	"code.superseriousbusiness.org/gotosocial/internal/processing/sitemap"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/status"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/stream"
+	"code.superseriousbusiness.org/gotosocial/internal/processing/suggestions"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/tags"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/timeline"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/user"
@@ -86,6 +89,7 @@ type Processor struct {
 	account             account.Processor
 	admin               admin.Processor
 	advancedmigrations  advancedmigrations.Processor
+	antenna             antenna.Processor
 	application         application.Processor
 	conversations       conversations.Processor
 	fedi                fedi.Processor
@@ -99,8 +103,10 @@ type Processor struct {
 	push                push.Processor
 	report              report.Processor
 	search              search.Processor
+	sitemap             sitemap.Processor
 	status              status.Processor
 	stream              stream.Processor
+	suggestions         suggestions.Processor
 	tags                tags.Processor
 	timeline            timeline.Processor
 	user                user.Processor
@@ -119,6 +125,10 @@ func (p *Processor) AdvancedMigrations() *advancedmigrations.Processor {
 	return &p.advancedmigrations
 }
 
+func (p *Processor) Antenna() *antenna.Processor {
+	return &p.antenna
+}
+
 func (p *Processor) Application() *application.Processor {
 	return &p.application
 }
@@ -175,10 +185,18 @@ func (p *Processor) Status() *status.Processor {
 	return &p.status
 }
 
+func (p *Processor) Sitemap() *sitemap.Processor {
+	return &p.sitemap
+}
+
 func (p *Processor) Stream() *stream.Processor {
 	return &p.stream
 }
 
+func (p *Processor) Suggestions() *suggestions.Processor {
+	return &p.suggestions
+}
+
 func (p *Processor) Tags() *tags.Processor {
 	return &p.tags
 }
@@ -223,7 +241,7 @@ func NewProcessor(
 
 	// Instantiate sub processors used by other sub-processors.
 	processor.stream = stream.New(state, oauthServer)
-	processor.conversations = conversations.New(state, converter, visFilter, muteFilter, statusFilter)
+	processor.conversations = conversations.New(state, converter, &processor.stream, visFilter, muteFilter, statusFilter)
 	surfacer := surfacing.New(state, converter, &processor.stream, visFilter, muteFilter, statusFilter, emailSender, webPushSender, &processor.conversations)
 	common := common.New(state, mediaManager, converter, federator, visFilter, muteFilter, statusFilter, surfacer)
 	processor.account = account.New(&common, state, converter, mediaManager, federator, visFilter, statusFilter, parseMentionFunc)
@@ -233,7 +251,8 @@ func NewProcessor(
 	// Instantiate the rest of the sub
 	// processors + pin them to this struct.
 	processor.account = account.New(&common, state, converter, mediaManager, federator, visFilter, statusFilter, parseMentionFunc)
-	processor.admin = admin.New(&common, state, cleaner, subscriptions, federator, converter, mediaManager, federator.TransportController(), emailSender)
+	processor.admin = admin.New(&common, state, cleaner, subscriptions, federator, converter, mediaManager, federator.TransportController(), emailSender, parseMentionFunc)
+	processor.antenna = antenna.New(state, converter)
 	processor.application = application.New(state, converter)
 	processor.fedi = fedi.New(state, &common, converter, federator, visFilter)
 	processor.filtersv1 = filtersv1.New(state, converter, filterCommon)
@@ -244,9 +263,11 @@ func NewProcessor(
 	processor.polls = polls.New(&common, state, converter)
 	processor.push = push.New(state, converter)
 	processor.report = report.New(state, converter)
+	processor.suggestions = suggestions.New(state, converter, federator, visFilter)
 	processor.tags = tags.New(state, converter)
-	processor.timeline = timeline.New(state, converter, visFilter, muteFilter, statusFilter)
+	processor.timeline = timeline.New(state, converter, visFilter, muteFilter, statusFilter, federator.TransportController())
 	processor.search = search.New(state, federator, converter, visFilter, surfacer)
+	processor.sitemap = sitemap.New(state)
 	processor.status = status.New(state, &common, &processor.polls, &processor.interactionRequests, federator, converter, visFilter, intFilter, parseMentionFunc)
 	processor.user = user.New(state, converter, oauthServer, emailSender)
 
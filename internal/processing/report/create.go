@@ -71,6 +71,19 @@ func (p *Processor) Create(ctx context.Context, account *gtsmodel.Account, form
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	// Parse report category, defaulting to
+	// a rule violation if any rules given.
+	category := gtsmodel.ReportCategoryOther
+	if form.Category != "" {
+		category = gtsmodel.ParseReportCategory(form.Category)
+		if category == gtsmodel.ReportCategoryUnknown {
+			err = fmt.Errorf("category %s not recognized, valid categories are: other, spam, legal, violation", form.Category)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+	} else if len(form.RuleIDs) != 0 {
+		category = gtsmodel.ReportCategoryViolation
+	}
+
 	reportID := id.NewULID()
 	report := &gtsmodel.Report{
 		ID:              reportID,
@@ -85,13 +98,14 @@ func (p *Processor) Create(ctx context.Context, account *gtsmodel.Account, form
 		RuleIDs:         form.RuleIDs,
 		Rules:           rules,
 		Forwarded:       &form.Forward,
+		Category:        category,
 	}
 
 	if err := p.state.DB.PutReport(ctx, report); err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
-	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+	p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 		APObjectType:   ap.ActorPerson,
 		APActivityType: ap.ActivityFlag,
 		GTSModel:       report,
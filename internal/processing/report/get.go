@@ -69,6 +69,8 @@ func (p *Processor) GetMultiple(
 		resolved,
 		account.ID,
 		targetAccountID,
+		gtsmodel.ReportCategoryUnknown,
+		"",
 		page,
 	)
 	if err != nil && !errors.Is(err, db.ErrNoEntries) {
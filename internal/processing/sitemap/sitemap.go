@@ -0,0 +1,253 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package sitemap
+
+import (
+	"bytes"
+	"context"
+	// nolint:gosec
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+)
+
+const (
+	// maxAccounts is the maximum number of
+	// accounts to include in the sitemap.
+	maxAccounts = 1000
+
+	// maxStatusesPerAccount is the maximum number
+	// of recent statuses to include per account.
+	//
+	// Together with maxAccounts, this keeps us
+	// below the sitemap protocol's 50,000 URLs
+	// per sitemap limit, without needing an index.
+	maxStatusesPerAccount = 40
+)
+
+// Sitemap is a generated sitemap.xml document.
+type Sitemap struct {
+	// XML document,
+	// ready to serve.
+	XML []byte
+
+	// Strong ETag of XML.
+	ETag string
+
+	// When the sitemap
+	// was generated.
+	Generated time.Time
+}
+
+type Processor struct {
+	state *state.State
+
+	// Most recently generated sitemap.
+	sitemap *atomic.Pointer[Sitemap]
+
+	// Prevents concurrent generations.
+	genMu *sync.Mutex
+}
+
+func New(state *state.State) Processor {
+	return Processor{
+		state:   state,
+		sitemap: new(atomic.Pointer[Sitemap]),
+		genMu:   new(sync.Mutex),
+	}
+}
+
+// ScheduleSitemapGenerate schedules regular regeneration of
+// the sitemap, if it's enabled in the config. Else no-op.
+func (p *Processor) ScheduleSitemapGenerate() {
+	if !config.GetInstanceExposeSitemap() {
+		// Not enabled.
+		return
+	}
+
+	regenerateEvery := config.GetInstanceSitemapRegenerateEvery()
+
+	log.Infof(nil,
+		"scheduling sitemap generation to run every %s",
+		regenerateEvery,
+	)
+
+	// Schedule generation to run soon after startup, then regularly.
+	if !p.state.Workers.Scheduler.AddRecurring(
+		"@sitemapgenerate",
+		time.Now().Add(time.Minute),
+		regenerateEvery,
+		func(ctx context.Context, start time.Time) {
+			if _, err := p.Generate(ctx); err != nil {
+				log.Errorf(ctx, "error generating sitemap: %v", err)
+				return
+			}
+			log.Infof(ctx, "generated sitemap in %s", time.Since(start))
+		},
+	) {
+		panic("failed to schedule @sitemapgenerate")
+	}
+}
+
+// Get returns the most recently generated sitemap,
+// generating one now if this hasn't been done yet.
+func (p *Processor) Get(ctx context.Context) (*Sitemap, gtserror.WithCode) {
+	if !config.GetInstanceExposeSitemap() {
+		const text = "sitemap not enabled on this instance"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	if sitemap := p.sitemap.Load(); sitemap != nil {
+		return sitemap, nil
+	}
+
+	sitemap, err := p.Generate(ctx)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return sitemap, nil
+}
+
+// Generate (re)generates the sitemap, listing
+// the profiles of discoverable + indexable local
+// accounts, and their recent public statuses.
+func (p *Processor) Generate(ctx context.Context) (*Sitemap, error) {
+	p.genMu.Lock()
+	defer p.genMu.Unlock()
+
+	accounts, err := p.state.DB.GetIndexableAccounts(ctx, maxAccounts)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting indexable accounts: %w", err)
+	}
+
+	set := urlSet{
+		XMLNS: "http://www.sitemaps.org/schemas/sitemap/0.9",
+		URLs:  make([]sitemapURL, 0, len(accounts)),
+	}
+
+	for _, account := range accounts {
+		urls, err := p.accountURLs(ctx, account)
+		if err != nil {
+			// Don't let one account
+			// break the whole sitemap.
+			log.Errorf(ctx, "error getting sitemap urls for account %s: %v", account.ID, err)
+			continue
+		}
+		set.URLs = append(set.URLs, urls...)
+	}
+
+	buf := bytes.NewBufferString(xml.Header)
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(set); err != nil {
+		return nil, gtserror.Newf("error encoding sitemap: %w", err)
+	}
+
+	sum := sha1.Sum(buf.Bytes()) // nolint:gosec
+	sitemap := &Sitemap{
+		XML:       buf.Bytes(),
+		ETag:      `"` + hex.EncodeToString(sum[:]) + `"`,
+		Generated: time.Now(),
+	}
+
+	p.sitemap.Store(sitemap)
+	return sitemap, nil
+}
+
+// accountURLs returns sitemap URLs for the profile
+// of the given account, and its recent public statuses.
+func (p *Processor) accountURLs(ctx context.Context, account *gtsmodel.Account) ([]sitemapURL, error) {
+	if err := p.state.DB.PopulateAccountStats(ctx, account); err != nil {
+		return nil, gtserror.Newf("db error getting account stats: %w", err)
+	}
+
+	// Profile page changes whenever the
+	// account is updated or posts something.
+	lastMod := account.UpdatedAt
+	if account.Stats.LastStatusAt.After(lastMod) {
+		lastMod = account.Stats.LastStatusAt
+	}
+
+	urls := []sitemapURL{{
+		Loc:     account.URL,
+		LastMod: formatLastMod(lastMod),
+	}}
+
+	// Get the same statuses as are shown on
+	// the account's profile page on the web.
+	statuses, err := p.state.DB.GetAccountWebStatuses(ctx,
+		account,
+		&paging.Page{Limit: maxStatusesPerAccount},
+		false,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting web statuses: %w", err)
+	}
+
+	for _, status := range statuses {
+		if status.Visibility != gtsmodel.VisibilityPublic {
+			// Only list public
+			// statuses, not unlisted.
+			continue
+		}
+
+		urls = append(urls, sitemapURL{
+			Loc:     status.URL,
+			LastMod: formatLastMod(status.UpdatedAt()),
+		})
+	}
+
+	return urls, nil
+}
+
+// formatLastMod formats the given time
+// as a W3C datetime for use in a sitemap.
+func formatLastMod(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// urlSet is the root
+// element of a sitemap.
+type urlSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+// sitemapURL is one
+// entry in a sitemap.
+type sitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
@@ -0,0 +1,97 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package sitemap_test
+
+import (
+	"net/http"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/processing/sitemap"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/stretchr/testify/suite"
+)
+
+type SitemapTestSuite struct {
+	suite.Suite
+	state   state.State
+	sitemap sitemap.Processor
+
+	testAccounts map[string]*gtsmodel.Account
+	testStatuses map[string]*gtsmodel.Status
+}
+
+func (suite *SitemapTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+	suite.state.Caches.Init()
+	testrig.StartNoopWorkers(&suite.state)
+	testrig.NewTestDB(&suite.state)
+	testrig.StandardDBSetup(suite.state.DB, nil)
+	suite.testAccounts = testrig.NewTestAccounts()
+	suite.testStatuses = testrig.NewTestStatuses()
+	suite.sitemap = sitemap.New(&suite.state)
+}
+
+func (suite *SitemapTestSuite) TearDownTest() {
+	testrig.StopWorkers(&suite.state)
+	testrig.StandardDBTeardown(suite.state.DB)
+}
+
+func (suite *SitemapTestSuite) TestSitemapGet() {
+	ctx := suite.T().Context()
+
+	sitemap, errWithCode := suite.sitemap.Get(ctx)
+	suite.NoError(errWithCode)
+	suite.NotEmpty(sitemap.ETag)
+
+	xml := string(sitemap.XML)
+
+	// Discoverable + indexable
+	// accounts should be included.
+	suite.Contains(xml, "<loc>"+suite.testAccounts["local_account_1"].URL+"</loc>")
+	suite.Contains(xml, "<loc>"+suite.testAccounts["admin_account"].URL+"</loc>")
+
+	// Public status of local_account_1 should be included.
+	suite.Contains(xml, "<loc>"+suite.testStatuses["local_account_1_status_1"].URL+"</loc>")
+
+	// Followers-only status shouldn't be.
+	suite.NotContains(xml, suite.testStatuses["local_account_1_status_5"].URL)
+
+	// Non-indexable account shouldn't be included.
+	suite.NotContains(xml, suite.testAccounts["local_account_2"].URL)
+
+	// Sitemap should be cached
+	// until next regeneration.
+	again, errWithCode := suite.sitemap.Get(ctx)
+	suite.NoError(errWithCode)
+	suite.Same(sitemap, again)
+}
+
+func (suite *SitemapTestSuite) TestSitemapGetDisabled() {
+	config.SetInstanceExposeSitemap(false)
+
+	_, errWithCode := suite.sitemap.Get(suite.T().Context())
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestSitemapTestSuite(t *testing.T) {
+	suite.Run(t, new(SitemapTestSuite))
+}
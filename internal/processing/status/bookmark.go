@@ -30,13 +30,34 @@
 )
 
 // BookmarkCreate adds a bookmark for the requestingAccount, targeting the given status (no-op if bookmark already exists).
-func (p *Processor) BookmarkCreate(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string) (*apimodel.Status, gtserror.WithCode) {
+// If collectionID is set, the bookmark is filed in that collection, moving an existing bookmark if necessary.
+func (p *Processor) BookmarkCreate(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string, collectionID string) (*apimodel.Status, gtserror.WithCode) {
 	targetStatus, existing, errWithCode := p.getBookmarkableStatus(ctx, requestingAccount, targetStatusID)
 	if errWithCode != nil {
 		return nil, errWithCode
 	}
 
+	if collectionID != "" {
+		// Ensure collection exists and is owned by requester.
+		if _, errWithCode := p.c.GetOwnBookmarkCollection(ctx,
+			requestingAccount,
+			collectionID,
+		); errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
 	if existing != nil {
+		if collectionID != "" && existing.CollectionID != collectionID {
+			// Status is already bookmarked,
+			// but needs moving to collection.
+			existing.CollectionID = collectionID
+			if err := p.state.DB.UpdateStatusBookmark(ctx, existing, "collection_id"); err != nil {
+				err = gtserror.Newf("error updating bookmark in database: %w", err)
+				return nil, gtserror.NewErrorInternalError(err)
+			}
+		}
+
 		// Status is already bookmarked.
 		return p.c.GetAPIStatus(ctx, requestingAccount, targetStatus)
 	}
@@ -50,6 +71,7 @@ func (p *Processor) BookmarkCreate(ctx context.Context, requestingAccount *gtsmo
 		TargetAccount:   targetStatus.Account,
 		StatusID:        targetStatus.ID,
 		Status:          targetStatus,
+		CollectionID:    collectionID,
 	}
 
 	if err := p.state.DB.PutStatusBookmark(ctx, gtsBookmark); err != nil {
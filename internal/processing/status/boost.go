@@ -152,7 +152,7 @@ func (p *Processor) BoostCreate(
 		// must target a status with an interaction
 		// policy that requires approval for announces.
 		// Queue up Create AnnounceRequest side effects.
-		p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 			APObjectType:   ap.ActivityAnnounceRequest,
 			APActivityType: ap.ActivityCreate,
 			GTSModel:       boost,
@@ -162,7 +162,7 @@ func (p *Processor) BoostCreate(
 	} else {
 		// "Normal" boost with no explicit approval
 		// required, queue Create Announce side effects.
-		p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 			APObjectType:   ap.ActivityAnnounce,
 			APActivityType: ap.ActivityCreate,
 			GTSModel:       boost,
@@ -236,7 +236,7 @@ func (p *Processor) BoostRemove(
 	}
 
 	// Status was boosted. Process unboost side effects asynchronously.
-	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+	p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 		APObjectType:   ap.ActivityAnnounce,
 		APActivityType: ap.ActivityUndo,
 		GTSModel:       boost,
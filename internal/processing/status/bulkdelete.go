@@ -0,0 +1,571 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
+	"code.superseriousbusiness.org/gotosocial/internal/text"
+	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// bulkDeleteInterval is the interval between each status
+// deletion during a bulk delete, so that federated Deletes
+// get sent out gradually over time, instead of flooding
+// both our own workers and remote instances all at once.
+const bulkDeleteInterval = 2 * time.Second
+
+// bulkDeleteBatchSize is the number of matching status
+// IDs selected at once during a bulk delete step, when
+// looking for the next status to delete.
+const bulkDeleteBatchSize = 20
+
+// bulkDeleteMaxPendingChecks is the number of steps on
+// which a queued status deletion may be found not yet
+// completed, before giving up on it and skipping it, so
+// a status that fails to delete can't stall bulk delete.
+const bulkDeleteMaxPendingChecks = 30
+
+// BulkDelete selects statuses owned by the requester using the filters in
+// the given form, and starts deleting them in the background, at a rate
+// limited pace. If form.Preview is set, no statuses are deleted, and only
+// the number of statuses that would be deleted is returned.
+//
+// Only one bulk deletion may run at a time per account.
+func (p *Processor) BulkDelete(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	form *apimodel.StatusBulkDeleteRequest,
+) (*apimodel.StatusBulkDelete, gtserror.WithCode) {
+	// Parse filters from the form into a new bulk delete.
+	bulkDelete, match, errWithCode := p.bulkDeleteFromForm(ctx,
+		requester,
+		form,
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	var total int
+	if match {
+		// Count requester's statuses matching filters.
+		var err error
+		total, err = p.state.DB.CountAccountStatusesForBulkDelete(ctx,
+			bulkDelete.AccountID,
+			bulkDelete.MinID,
+			bulkDelete.MaxID,
+			bulkDelete.Visibility,
+			bulkDelete.TagID,
+		)
+		if err != nil {
+			err := gtserror.Newf("db error counting statuses: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if form.Preview {
+		// Only a preview was requested,
+		// return number of statuses
+		// that *would* be deleted.
+		return &apimodel.StatusBulkDelete{
+			State: "preview",
+			Total: total,
+		}, nil
+	}
+
+	p.bulkDeleteMu.Lock()
+	defer p.bulkDeleteMu.Unlock()
+
+	// Check for existing running bulk delete for requester.
+	latest, err := p.state.DB.GetLatestStatusBulkDelete(ctx, requester.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting bulk delete: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if latest != nil && latest.Running() {
+		const text = "a bulk deletion is already running for this account"
+		return nil, gtserror.NewErrorConflict(errors.New(text), text)
+	}
+
+	bulkDelete.Total = total
+	if bulkDelete.Total == 0 {
+		// Nothing to
+		// do, finished.
+		bulkDelete.CompletedAt = time.Now()
+	}
+
+	// Store the bulk delete, so progress can be
+	// looked up, and deletion resumed on restart.
+	if err := p.state.DB.PutStatusBulkDelete(ctx, bulkDelete); err != nil {
+		err := gtserror.Newf("db error putting bulk delete: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if bulkDelete.Running() {
+		// Start deleting in background.
+		p.bulkDeleteEnqueue(bulkDelete.ID, false)
+	}
+
+	return bulkDeleteToAPI(bulkDelete), nil
+}
+
+// BulkDeleteGet returns the progress of the
+// requester's current or most recent bulk deletion.
+func (p *Processor) BulkDeleteGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+) (*apimodel.StatusBulkDelete, gtserror.WithCode) {
+	bulkDelete, err := p.state.DB.GetLatestStatusBulkDelete(ctx, requester.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting bulk delete: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if bulkDelete == nil {
+		const text = "no bulk deletion found for this account"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	return bulkDeleteToAPI(bulkDelete), nil
+}
+
+// BulkDeleteAbort aborts the requester's currently running bulk
+// deletion. Statuses already deleted will not be restored.
+func (p *Processor) BulkDeleteAbort(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+) (*apimodel.StatusBulkDelete, gtserror.WithCode) {
+	p.bulkDeleteMu.Lock()
+	defer p.bulkDeleteMu.Unlock()
+
+	bulkDelete, err := p.state.DB.GetLatestStatusBulkDelete(ctx, requester.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting bulk delete: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if bulkDelete == nil || !bulkDelete.Running() {
+		const text = "no running bulk deletion found for this account"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	// Cancel the next scheduled step, any step
+	// already running will find the bulk delete
+	// completed when it next fetches it, and stop.
+	p.state.Workers.Scheduler.Cancel(bulkDelete.ID)
+
+	bulkDelete.Aborted = util.Ptr(true)
+	bulkDelete.CompletedAt = time.Now()
+	if err := p.state.DB.UpdateStatusBulkDelete(ctx,
+		bulkDelete,
+		"aborted",
+		"completed_at",
+	); err != nil {
+		err := gtserror.Newf("db error updating bulk delete: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return bulkDeleteToAPI(bulkDelete), nil
+}
+
+// BulkDeletesScheduleAll resumes all status bulk deletes
+// that were still running when the instance was stopped.
+func (p *Processor) BulkDeletesScheduleAll(ctx context.Context) error {
+	bulkDeletes, err := p.state.DB.GetRunningStatusBulkDeletes(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting bulk deletes: %w", err)
+	}
+
+	for _, bulkDelete := range bulkDeletes {
+		// Any status deletion queued before
+		// the restart was dropped along with
+		// the in-memory worker queues, so
+		// it must be queued again.
+		p.bulkDeleteEnqueue(bulkDelete.ID, true)
+	}
+
+	if len(bulkDeletes) > 0 {
+		log.Infof(ctx, "resumed %d status bulk deletes", len(bulkDeletes))
+	}
+
+	return nil
+}
+
+// bulkDeleteEnqueue queues the next step of bulk delete
+// with ID on the processing worker queue. See bulkDeleteStep.
+func (p *Processor) bulkDeleteEnqueue(id string, requeue bool) {
+	p.state.Workers.Processing.Queue.Push(func(ctx context.Context) {
+		p.bulkDeleteStep(ctx, id, requeue)
+	})
+}
+
+// bulkDeleteSchedule schedules the next step of bulk delete with
+// ID to be queued after bulkDeleteInterval. See bulkDeleteStep.
+func (p *Processor) bulkDeleteSchedule(ctx context.Context, id string) {
+	// Drop the task entry of the previous
+	// step, which is not removed once run.
+	p.state.Workers.Scheduler.Cancel(id)
+
+	if !p.state.Workers.Scheduler.AddOnce(
+		id,
+		time.Now().Add(bulkDeleteInterval),
+		func(context.Context, time.Time) {
+			p.bulkDeleteEnqueue(id, false)
+		},
+	) {
+		log.Errorf(ctx, "failed scheduling bulk delete %s", id)
+	}
+}
+
+// bulkDeleteStep performs a single step of the bulk delete with
+// ID: if a previously queued status deletion has completed, it
+// is counted, and deletion of the next matching status is queued.
+// Only one status deletion is pending at a time, so statuses are
+// counted as deleted only once their deletion has been processed.
+// If requeue is set, a pending status deletion is queued again.
+// A status still pending after bulkDeleteMaxPendingChecks steps
+// is counted as skipped instead, and the bulk delete moves on.
+func (p *Processor) bulkDeleteStep(ctx context.Context, id string, requeue bool) {
+	bulkDelete, err := p.state.DB.GetStatusBulkDeleteByID(ctx, id)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			log.Errorf(ctx, "db error getting bulk delete %s: %v", id, err)
+		}
+		return
+	}
+
+	if !bulkDelete.Running() {
+		// Aborted
+		// meanwhile.
+		return
+	}
+
+	requester, err := p.state.DB.GetAccountByID(
+		gtscontext.SetBarebones(ctx),
+		bulkDelete.AccountID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		log.Errorf(ctx, "db error getting account %s: %v", bulkDelete.AccountID, err)
+		p.bulkDeleteSchedule(ctx, id)
+		return
+	}
+
+	if requester == nil || requester.IsSuspended() {
+		// Account is being deleted,
+		// its statuses along with it.
+		p.bulkDeleteComplete(ctx, bulkDelete)
+		return
+	}
+
+	if bulkDelete.PendingStatusID != "" {
+		// Check whether pending status deletion has been processed.
+		status, err := p.state.DB.GetStatusByID(
+			gtscontext.SetBarebones(ctx),
+			bulkDelete.PendingStatusID,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			log.Errorf(ctx, "db error getting status %s: %v", bulkDelete.PendingStatusID, err)
+			p.bulkDeleteSchedule(ctx, id)
+			return
+		}
+
+		switch {
+		case status == nil:
+			// Status is gone, count it.
+			bulkDelete.Deleted++
+
+		case bulkDelete.PendingChecks+1 < bulkDeleteMaxPendingChecks:
+			if requeue {
+				// Queue deletion again.
+				p.bulkDeleteStatus(ctx,
+					requester,
+					status,
+				)
+			}
+
+			// Still pending, check
+			// again after interval.
+			bulkDelete.PendingChecks++
+			if err := p.state.DB.UpdateStatusBulkDelete(ctx,
+				bulkDelete,
+				"pending_checks",
+			); err != nil {
+				log.Errorf(ctx, "db error updating bulk delete %s: %v", id, err)
+			}
+			p.bulkDeleteSchedule(ctx, id)
+			return
+
+		default:
+			// Deletion is taking too long, most likely
+			// failing, so give up on this status. Any
+			// statuses above it were handled already,
+			// so move max ID down to avoid reselecting it.
+			log.Warnf(ctx, "skipping status %s in bulk delete %s: deletion not completed after %d checks",
+				status.ID, id, bulkDeleteMaxPendingChecks)
+			bulkDelete.Skipped++
+			bulkDelete.MaxID = status.ID
+		}
+
+		bulkDelete.PendingStatusID = ""
+		bulkDelete.PendingChecks = 0
+	}
+
+	// Select remaining statuses to delete in batches,
+	// until we find one that can be queued for deletion.
+	maxID := bulkDelete.MaxID
+	for bulkDelete.PendingStatusID == "" {
+		statusIDs, err := p.bulkDeleteStatusIDs(ctx, bulkDelete, maxID)
+		if err != nil {
+			log.Errorf(ctx, "db error getting statuses for bulk delete %s: %v", id, err)
+			p.bulkDeleteSchedule(ctx, id)
+			return
+		}
+
+		if len(statusIDs) == 0 {
+			// None left.
+			break
+		}
+
+		for _, statusID := range statusIDs {
+			status, err := p.state.DB.GetStatusByID(ctx, statusID)
+			if err != nil && !errors.Is(err, db.ErrNoEntries) {
+				log.Errorf(ctx, "db error getting status %s: %v", statusID, err)
+				continue
+			}
+
+			if status != nil {
+				// Queue deletion of status,
+				// counted on a later step.
+				p.bulkDeleteStatus(ctx,
+					requester,
+					status,
+				)
+				bulkDelete.PendingStatusID = status.ID
+				break
+			}
+		}
+
+		// Page down to next batch.
+		maxID = statusIDs[len(statusIDs)-1]
+	}
+
+	if bulkDelete.PendingStatusID == "" {
+		// All done!
+		p.bulkDeleteComplete(ctx, bulkDelete)
+		return
+	}
+
+	if err := p.state.DB.UpdateStatusBulkDelete(ctx,
+		bulkDelete,
+		"max_id",
+		"deleted",
+		"skipped",
+		"pending_status_id",
+		"pending_checks",
+	); err != nil {
+		log.Errorf(ctx, "db error updating bulk delete %s: %v", id, err)
+	}
+
+	p.bulkDeleteSchedule(ctx, id)
+}
+
+// bulkDeleteStatus queues deletion of the given status owned by requester.
+func (p *Processor) bulkDeleteStatus(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	status *gtsmodel.Status,
+) {
+	p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityDelete,
+		GTSModel:       status,
+		Origin:         requester,
+		Target:         requester,
+	})
+}
+
+// bulkDeleteComplete marks the given bulk delete as completed.
+func (p *Processor) bulkDeleteComplete(ctx context.Context, bulkDelete *gtsmodel.StatusBulkDelete) {
+	bulkDelete.PendingStatusID = ""
+	bulkDelete.PendingChecks = 0
+	bulkDelete.CompletedAt = time.Now()
+	if err := p.state.DB.UpdateStatusBulkDelete(ctx,
+		bulkDelete,
+		"max_id",
+		"deleted",
+		"skipped",
+		"pending_status_id",
+		"pending_checks",
+		"completed_at",
+	); err != nil {
+		log.Errorf(ctx, "db error updating bulk delete %s: %v", bulkDelete.ID, err)
+	}
+}
+
+// bulkDeleteToAPI converts the bulk delete to its API model representation.
+func bulkDeleteToAPI(bulkDelete *gtsmodel.StatusBulkDelete) *apimodel.StatusBulkDelete {
+	apiBulkDelete := &apimodel.StatusBulkDelete{
+		Total:     bulkDelete.Total,
+		Deleted:   bulkDelete.Deleted,
+		Skipped:   bulkDelete.Skipped,
+		CreatedAt: util.FormatISO8601(bulkDelete.CreatedAt),
+	}
+
+	switch {
+	case bulkDelete.Running():
+		apiBulkDelete.State = "running"
+	case *bulkDelete.Aborted:
+		apiBulkDelete.State = "aborted"
+	default:
+		apiBulkDelete.State = "completed"
+	}
+
+	if !bulkDelete.CompletedAt.IsZero() {
+		apiBulkDelete.CompletedAt = util.FormatISO8601(bulkDelete.CompletedAt)
+	}
+
+	return apiBulkDelete
+}
+
+// bulkDeleteFromForm validates the given bulk delete form, returning
+// a new bulk delete for requester with the form's filters, and whether
+// the filters can match any statuses at all (ie., filter tag is known).
+func (p *Processor) bulkDeleteFromForm(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	form *apimodel.StatusBulkDeleteRequest,
+) (*gtsmodel.StatusBulkDelete, bool, gtserror.WithCode) {
+	if form.MinDate == "" && form.MaxDate == "" &&
+		form.Visibility == "" && form.Tag == "" {
+		const text = "at least one of min_date, max_date, visibility, or tag must be provided"
+		return nil, false, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	bulkDelete := &gtsmodel.StatusBulkDelete{
+		ID:        id.NewULID(),
+		AccountID: requester.ID,
+		Aborted:   util.Ptr(false),
+	}
+
+	var minDate time.Time
+	if form.MinDate != "" {
+		var err error
+		minDate, err = parseBulkDeleteDate(form.MinDate)
+		if err != nil {
+			const text = "min_date must be a date (2006-01-02) or ISO 8601 datetime"
+			return nil, false, gtserror.NewErrorBadRequest(err, text)
+		}
+		bulkDelete.MinID = id.ZeroULIDForTime(minDate)
+	}
+
+	if form.MaxDate != "" {
+		maxDate, err := parseBulkDeleteDate(form.MaxDate)
+		if err != nil {
+			const text = "max_date must be a date (2006-01-02) or ISO 8601 datetime"
+			return nil, false, gtserror.NewErrorBadRequest(err, text)
+		}
+
+		if !minDate.IsZero() && !maxDate.After(minDate) {
+			const text = "max_date must be after min_date"
+			return nil, false, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+		bulkDelete.MaxID = id.ZeroULIDForTime(maxDate)
+	}
+
+	if bulkDelete.MaxID == "" || bulkDelete.MaxID > bulkDelete.ID {
+		// Never delete statuses created
+		// after the bulk delete started.
+		bulkDelete.MaxID = bulkDelete.ID
+	}
+
+	if form.Visibility != "" {
+		bulkDelete.Visibility = typeutils.APIVisToVis(form.Visibility)
+		if bulkDelete.Visibility == 0 || bulkDelete.Visibility == gtsmodel.VisibilityNone {
+			const text = "visibility must be one of public, unlisted, private, mutuals_only, direct"
+			return nil, false, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+	}
+
+	if form.Tag != "" {
+		name, ok := text.NormalizeHashtag(form.Tag)
+		if !ok {
+			const text = "tag was not a valid hashtag"
+			return nil, false, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		tag, err := p.state.DB.GetTagByName(ctx, name)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting tag %s: %w", name, err)
+			return nil, false, gtserror.NewErrorInternalError(err)
+		}
+
+		if tag == nil {
+			// Tag not known, so no
+			// statuses can match it.
+			return bulkDelete, false, nil
+		}
+
+		bulkDelete.TagID = tag.ID
+	}
+
+	return bulkDelete, true, nil
+}
+
+// bulkDeleteStatusIDs returns IDs of the next batch of remaining statuses
+// owned by the bulk delete account that match its filters, below maxID.
+func (p *Processor) bulkDeleteStatusIDs(
+	ctx context.Context,
+	bulkDelete *gtsmodel.StatusBulkDelete,
+	maxID string,
+) ([]string, error) {
+	statusIDs, err := p.state.DB.GetAccountStatusIDsForBulkDelete(ctx,
+		bulkDelete.AccountID,
+		bulkDelete.MinID,
+		maxID,
+		bulkDelete.Visibility,
+		bulkDelete.TagID,
+		bulkDeleteBatchSize,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, err
+	}
+
+	return statusIDs, nil
+}
+
+// parseBulkDeleteDate parses the given string as
+// either a date, or an RFC3339 (ISO 8601) datetime.
+func parseBulkDeleteDate(s string) (time.Time, error) {
+	if t, err := time.Parse(util.ISO8601Date, s); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
@@ -0,0 +1,281 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
+	"github.com/stretchr/testify/suite"
+)
+
+type StatusBulkDeleteTestSuite struct {
+	StatusStandardTestSuite
+
+	// ID of a status whose
+	// deletion always fails.
+	undeletableStatusID string
+}
+
+func (suite *StatusBulkDeleteTestSuite) SetupTest() {
+	suite.StatusStandardTestSuite.SetupTest()
+	suite.undeletableStatusID = ""
+
+	// Actually delete statuses when
+	// client deletes are processed,
+	// so bulk deletes can progress.
+	suite.state.Workers.Client.Process = func(ctx context.Context, msg *messages.FromClientAPI) error {
+		if msg.APActivityType == ap.ActivityDelete {
+			status := msg.GTSModel.(*gtsmodel.Status)
+			if status.ID == suite.undeletableStatusID {
+				return errors.New("status deletion failed")
+			}
+			return suite.db.DeleteStatusByID(ctx, status.ID)
+		}
+		return nil
+	}
+	suite.state.Workers.Client.Start(1)
+	suite.state.Workers.Processing.Start(1)
+}
+
+func (suite *StatusBulkDeleteTestSuite) TestBulkDeleteNoFilters() {
+	ctx := suite.T().Context()
+	requester := suite.testAccounts["local_account_1"]
+
+	apiBulkDelete, errWithCode := suite.status.BulkDelete(ctx, requester, &apimodel.StatusBulkDeleteRequest{
+		Preview: true,
+	})
+	suite.Nil(apiBulkDelete)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	suite.Equal("Bad Request: at least one of min_date, max_date, visibility, or tag must be provided", errWithCode.Safe())
+}
+
+func (suite *StatusBulkDeleteTestSuite) TestBulkDeleteBadDates() {
+	ctx := suite.T().Context()
+	requester := suite.testAccounts["local_account_1"]
+
+	_, errWithCode := suite.status.BulkDelete(ctx, requester, &apimodel.StatusBulkDeleteRequest{
+		MinDate: "2023-01-01",
+		MaxDate: "2022-01-01",
+		Preview: true,
+	})
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	suite.Equal("Bad Request: max_date must be after min_date", errWithCode.Safe())
+}
+
+func (suite *StatusBulkDeleteTestSuite) TestBulkDeletePreview() {
+	ctx := suite.T().Context()
+	requester := suite.testAccounts["admin_account"]
+	status := suite.testStatuses["admin_account_status_1"]
+
+	apiBulkDelete, errWithCode := suite.status.BulkDelete(ctx, requester, &apimodel.StatusBulkDeleteRequest{
+		Tag:     "#Welcome",
+		Preview: true,
+	})
+	suite.NoError(errWithCode)
+	suite.Equal(&apimodel.StatusBulkDelete{
+		State: "preview",
+		Total: 1,
+	}, apiBulkDelete)
+
+	// Nothing should have been deleted.
+	_, err := suite.db.GetStatusByID(ctx, status.ID)
+	suite.NoError(err)
+
+	// And no bulk delete should be stored.
+	_, errWithCode = suite.status.BulkDeleteGet(ctx, requester)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *StatusBulkDeleteTestSuite) TestBulkDeleteTag() {
+	ctx := suite.T().Context()
+	requester := suite.testAccounts["admin_account"]
+
+	apiBulkDelete, errWithCode := suite.status.BulkDelete(ctx, requester, &apimodel.StatusBulkDeleteRequest{
+		Tag: "welcome",
+	})
+	suite.NoError(errWithCode)
+	suite.Equal(1, apiBulkDelete.Total)
+	suite.NotEmpty(apiBulkDelete.CreatedAt)
+
+	// Only one status, so bulk
+	// delete should complete quickly.
+	if !suite.Eventually(func() bool {
+		apiBulkDelete, errWithCode = suite.status.BulkDeleteGet(ctx, requester)
+		return errWithCode == nil && apiBulkDelete.State == "completed"
+	}, 10*time.Second, 100*time.Millisecond) {
+		suite.FailNow("timed out waiting for bulk delete to complete")
+	}
+
+	suite.Equal(1, apiBulkDelete.Deleted)
+	suite.NotEmpty(apiBulkDelete.CompletedAt)
+
+	// Status should actually be deleted.
+	_, err := suite.db.GetStatusByID(ctx, suite.testStatuses["admin_account_status_1"].ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Should be able to start another now.
+	_, errWithCode = suite.status.BulkDelete(ctx, requester, &apimodel.StatusBulkDeleteRequest{
+		Visibility: apimodel.VisibilityPublic,
+	})
+	suite.NoError(errWithCode)
+}
+
+func (suite *StatusBulkDeleteTestSuite) TestBulkDeleteAbort() {
+	ctx := suite.T().Context()
+	requester := suite.testAccounts["local_account_1"]
+
+	apiBulkDelete, errWithCode := suite.status.BulkDelete(ctx, requester, &apimodel.StatusBulkDeleteRequest{
+		MaxDate: time.Now().Format(time.RFC3339),
+	})
+	suite.NoError(errWithCode)
+	suite.Equal("running", apiBulkDelete.State)
+	suite.Greater(apiBulkDelete.Total, 1)
+
+	// Starting another while this one
+	// is still running should conflict.
+	_, errWithCode = suite.status.BulkDelete(ctx, requester, &apimodel.StatusBulkDeleteRequest{
+		Visibility: apimodel.VisibilityPublic,
+	})
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+
+	apiBulkDelete, errWithCode = suite.status.BulkDeleteAbort(ctx, requester)
+	suite.NoError(errWithCode)
+	suite.Equal("aborted", apiBulkDelete.State)
+	suite.Less(apiBulkDelete.Deleted, apiBulkDelete.Total)
+	suite.NotEmpty(apiBulkDelete.CompletedAt)
+
+	// Can't abort again.
+	_, errWithCode = suite.status.BulkDeleteAbort(ctx, requester)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// Aborted state should persist.
+	apiBulkDelete, errWithCode = suite.status.BulkDeleteGet(ctx, requester)
+	suite.NoError(errWithCode)
+	suite.Equal("aborted", apiBulkDelete.State)
+	deleted := apiBulkDelete.Deleted
+
+	// No further statuses should get deleted.
+	time.Sleep(3 * time.Second)
+	apiBulkDelete, errWithCode = suite.status.BulkDeleteGet(ctx, requester)
+	suite.NoError(errWithCode)
+	suite.Equal(deleted, apiBulkDelete.Deleted)
+}
+
+func (suite *StatusBulkDeleteTestSuite) TestBulkDeleteResume() {
+	ctx := suite.T().Context()
+	requester := suite.testAccounts["admin_account"]
+	status := suite.testStatuses["admin_account_status_1"]
+
+	// Store a running bulk delete, as if
+	// the instance was stopped with deletion
+	// of a status queued but not processed.
+	tag, err := suite.db.GetTagByName(ctx, "welcome")
+	suite.NoError(err)
+	bulkDelete := &gtsmodel.StatusBulkDelete{
+		ID:              "01K7Z4DJ0C6T4HXE4YNVJ1J6WQ",
+		AccountID:       requester.ID,
+		MaxID:           "01K7Z4DJ0C6T4HXE4YNVJ1J6WQ",
+		TagID:           tag.ID,
+		Total:           1,
+		PendingStatusID: status.ID,
+	}
+	suite.NoError(suite.db.PutStatusBulkDelete(ctx, bulkDelete))
+
+	suite.NoError(suite.status.BulkDeletesScheduleAll(ctx))
+
+	// Pending deletion should be queued again, and
+	// only counted once it has actually completed.
+	if !suite.Eventually(func() bool {
+		apiBulkDelete, errWithCode := suite.status.BulkDeleteGet(ctx, requester)
+		return errWithCode == nil && apiBulkDelete.State == "completed"
+	}, 10*time.Second, 100*time.Millisecond) {
+		suite.FailNow("timed out waiting for bulk delete to complete")
+	}
+
+	apiBulkDelete, errWithCode := suite.status.BulkDeleteGet(ctx, requester)
+	suite.NoError(errWithCode)
+	suite.Equal(1, apiBulkDelete.Deleted)
+
+	_, err = suite.db.GetStatusByID(ctx, status.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *StatusBulkDeleteTestSuite) TestBulkDeleteSkipUndeletable() {
+	ctx := suite.T().Context()
+	requester := suite.testAccounts["admin_account"]
+	maxID := "01K7Z4DJ0C6T4HXE4YNVJ1J6WQ"
+
+	// Select the three newest statuses,
+	// making the newest undeletable.
+	statusIDs, err := suite.db.GetAccountStatusIDsForBulkDelete(ctx,
+		requester.ID, "", maxID, 0, "", 3,
+	)
+	suite.NoError(err)
+	suite.Len(statusIDs, 3)
+	suite.undeletableStatusID = statusIDs[0]
+
+	// Store a running bulk delete, as if
+	// deletion of the undeletable status
+	// has been pending for a long time.
+	bulkDelete := &gtsmodel.StatusBulkDelete{
+		ID:              maxID,
+		AccountID:       requester.ID,
+		MinID:           statusIDs[2],
+		MaxID:           maxID,
+		Total:           3,
+		PendingStatusID: statusIDs[0],
+		PendingChecks:   1000,
+	}
+	suite.NoError(suite.db.PutStatusBulkDelete(ctx, bulkDelete))
+
+	suite.NoError(suite.status.BulkDeletesScheduleAll(ctx))
+
+	// The undeletable status should be skipped,
+	// and the remaining statuses still deleted.
+	if !suite.Eventually(func() bool {
+		apiBulkDelete, errWithCode := suite.status.BulkDeleteGet(ctx, requester)
+		return errWithCode == nil && apiBulkDelete.State == "completed"
+	}, 15*time.Second, 100*time.Millisecond) {
+		suite.FailNow("timed out waiting for bulk delete to complete")
+	}
+
+	apiBulkDelete, errWithCode := suite.status.BulkDeleteGet(ctx, requester)
+	suite.NoError(errWithCode)
+	suite.Equal(2, apiBulkDelete.Deleted)
+	suite.Equal(1, apiBulkDelete.Skipped)
+
+	_, err = suite.db.GetStatusByID(ctx, statusIDs[0])
+	suite.NoError(err)
+	for _, statusID := range statusIDs[1:] {
+		_, err = suite.db.GetStatusByID(ctx, statusID)
+		suite.ErrorIs(err, db.ErrNoEntries)
+	}
+}
+
+func TestStatusBulkDeleteTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusBulkDeleteTestSuite))
+}
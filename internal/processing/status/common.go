@@ -30,6 +30,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/id"
+	gtslanguage "code.superseriousbusiness.org/gotosocial/internal/language"
 	"code.superseriousbusiness.org/gotosocial/internal/text"
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
 	"code.superseriousbusiness.org/gotosocial/internal/validate"
@@ -95,16 +96,17 @@ func validateStatusContent(
 // an easily returnable type, without needing to allocate
 // an entire gtsmodel.Status{} model.
 type statusContent struct {
-	Content        string
-	ContentWarning string
-	PollOptions    []string
-	Language       string
-	MentionIDs     []string
-	Mentions       []*gtsmodel.Mention
-	EmojiIDs       []string
-	Emojis         []*gtsmodel.Emoji
-	TagIDs         []string
-	Tags           []*gtsmodel.Tag
+	Content          string
+	ContentWarning   string
+	PollOptions      []string
+	Language         string
+	LanguageDetected bool
+	MentionIDs       []string
+	Mentions         []*gtsmodel.Mention
+	EmojiIDs         []string
+	Emojis           []*gtsmodel.Emoji
+	TagIDs           []string
+	Tags             []*gtsmodel.Tag
 }
 
 // Returns the final content type to use when creating or editing a status.
@@ -148,6 +150,17 @@ func (p *Processor) processContent(
 	*statusContent,
 	gtserror.WithCode,
 ) {
+	var langDetected bool
+
+	if language == "" && config.GetStatusesDetectLanguage() {
+		// No language given, try to
+		// detect it from status text.
+		language = gtslanguage.Detect(
+			content + " " + contentWarning,
+		)
+		langDetected = (language != "")
+	}
+
 	if language == "" {
 		// Ensure we have a status language.
 		language = author.Settings.Language
@@ -205,6 +218,7 @@ func (p *Processor) processContent(
 	// needing to alloc a whole gtsmodel.Status{}.
 	var status statusContent
 	status.Language = language
+	status.LanguageDetected = langDetected
 
 	// formatInput is a shorthand function to format the given input string with the
 	// currently set 'formatFunc', passing in all required args and returning result.
@@ -26,6 +26,7 @@
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
 )
 
 // internalThreadContext is like
@@ -309,9 +310,18 @@ func (p *Processor) ContextGet(
 // The returned statuses in the ThreadContext will be
 // populated with ThreadMeta annotations for more easily
 // positioning the status in a web view of a thread.
+//
+// The "main" thread is always returned in full, but only
+// up to repliesLimit visible replies are returned, starting
+// from repliesOffset (ie., the index within visible replies).
+// A negative repliesOffset means start from the page of replies
+// containing the target status (or the first page, if the target
+// status is not a reply). A repliesLimit <= 0 means no limit.
 func (p *Processor) WebContextGet(
 	ctx context.Context,
 	targetStatusID string,
+	repliesOffset int,
+	repliesLimit int,
 ) (*apimodel.WebThreadContext, gtserror.WithCode) {
 	// Retrieve the internal thread context.
 	iCtx, errWithCode := p.contextGet(ctx,
@@ -340,13 +350,31 @@ func (p *Processor) WebContextGet(
 
 	// Start preparing web context.
 	wCtx := &apimodel.WebThreadContext{
-		Statuses:  make([]*apimodel.WebStatus, 0, len(wholeThread)),
 		Indexable: true,
 	}
 
+	// webThreadEntry is a visible
+	// status in the thread, along
+	// with its position information.
+	type webThreadEntry struct {
+		status *gtsmodel.Status
+		indent int
+		reply  bool
+	}
+
 	var (
 		threadLength = len(wholeThread)
 
+		// Visible statuses in the thread,
+		// to be converted for the web view.
+		entries = make([]webThreadEntry, 0, threadLength)
+
+		// Number of visible replies, and
+		// index of the target status within
+		// those (if the target is a reply).
+		visibleReplies int
+		targetReplyIdx = -1
+
 		// Track how much each reply status
 		// should be indented (if at all).
 		statusIndents = make(map[string]int, threadLength)
@@ -363,10 +391,6 @@ func (p *Processor) WebContextGet(
 		// where replies begin.
 		firstReplyIdx int
 
-		// We should mark the next **VISIBLE**
-		// reply as the first reply.
-		markNextVisibleAsFirstReply bool
-
 		// Map of statuses that didn't pass visi
 		// checks and won't be shown via the web.
 		hiddenStatuses = make(map[string]struct{})
@@ -391,7 +415,6 @@ func (p *Processor) WebContextGet(
 				// account. So, replies start here.
 				inReplies = true
 				firstReplyIdx = idx
-				markNextVisibleAsFirstReply = true
 			}
 		}
 
@@ -444,20 +467,29 @@ func (p *Processor) WebContextGet(
 			continue
 		}
 
-		// Prepare visible status to add to thread context.
-		webStatus, err := p.converter.StatusToWebStatus(ctx, status)
-		if err != nil {
-			hiddenStatuses[status.ID] = struct{}{}
-			continue
+		// Replies from accounts on a domain whose
+		// domain limit filters statuses (warn or
+		// hide) are omitted from the web view, as
+		// there's no requester to click through a
+		// warning, and nobody here follows them.
+		if inReplies && status.Account.IsRemote() {
+			limit, err := p.state.DB.MatchDomainLimit(ctx, status.Account.Domain)
+			if err != nil {
+				err := gtserror.Newf("error matching domain limit: %w", err)
+				return nil, gtserror.NewErrorInternalError(err)
+			}
+
+			if limit.StatusesFilter() {
+				wCtx.ThreadRepliesHidden++
+				wCtx.ThreadRepliesLimited++
+				hiddenStatuses[status.ID] = struct{}{}
+				continue
+			}
 		}
 
-		if markNextVisibleAsFirstReply {
-			// This is the first visible
-			// "reply / comment", so the
-			// little "x amount of replies"
-			// header should go above this.
-			webStatus.ThreadFirstReply = true
-			markNextVisibleAsFirstReply = false
+		entry := webThreadEntry{
+			status: status,
+			reply:  inReplies,
 		}
 
 		// If this is a reply, work out the indent of
@@ -468,44 +500,146 @@ func (p *Processor) WebContextGet(
 			case !ok:
 				// No parent with
 				// indent, start at 0.
-				webStatus.Indent = 0
+				entry.indent = 0
 
 			case isSelfReply(status, status.AccountID):
 				// Self reply, so indent at same
 				// level as own replied-to status.
-				webStatus.Indent = parentIndent
+				entry.indent = parentIndent
 
 			case parentIndent == 5:
 				// Already indented as far as we
 				// can go to keep things readable
 				// on thin screens, so just keep
 				// parent's indent.
-				webStatus.Indent = parentIndent
+				entry.indent = parentIndent
 
 			default:
 				// Reply to someone else who's
 				// indented, but not to TO THE MAX.
 				// Indent by another one.
-				webStatus.Indent = parentIndent + 1
+				entry.indent = parentIndent + 1
 			}
 
 			// Store the indent for this status.
-			statusIndents[status.ID] = webStatus.Indent
+			statusIndents[status.ID] = entry.indent
+
+			if status.ID == targetStatusID {
+				// Target status is a reply,
+				// note where it is in replies.
+				targetReplyIdx = visibleReplies
+			}
+
+			visibleReplies++
+		}
+
+		// If this status isn't indexable, mark the thread as not indexable.
+		// This applies to all visible statuses, even those not on this page.
+		if !util.PtrOrZero(status.Account.Indexable) {
+			wCtx.Indexable = false
+		}
+
+		entries = append(entries, entry)
+	}
+
+	// Work out which page of
+	// visible replies to show.
+	switch {
+	case repliesOffset >= 0:
+		// Use given offset, but
+		// don't go past the end.
+		repliesOffset = min(repliesOffset, visibleReplies)
+
+	case targetReplyIdx >= 0 && repliesLimit > 0:
+		// Start from the page of
+		// replies containing target.
+		repliesOffset = targetReplyIdx - (targetReplyIdx % repliesLimit)
+
+	default:
+		// Start from the beginning.
+		repliesOffset = 0
+	}
+
+	repliesEnd := visibleReplies
+	if repliesLimit > 0 && repliesOffset+repliesLimit < visibleReplies {
+		// More replies after this page.
+		repliesEnd = repliesOffset + repliesLimit
+		wCtx.ThreadRepliesNextOffset = repliesEnd
+	}
+
+	if repliesOffset > 0 {
+		// More replies before this page.
+		wCtx.ThreadRepliesPrevOffset = max(0, repliesOffset-max(repliesLimit, 0))
+	}
+
+	wCtx.ThreadRepliesOffset = repliesOffset
+	wCtx.Statuses = make([]*apimodel.WebStatus, 0, len(entries)-visibleReplies+repliesEnd-repliesOffset)
+
+	var replyIdx int
+	for _, entry := range entries {
+		isTarget := (entry.status.ID == targetStatusID)
+
+		if entry.reply {
+			// Only include replies on the requested page.
+			inPage := (replyIdx >= repliesOffset && replyIdx < repliesEnd)
+			firstInPage := (replyIdx == repliesOffset)
+			replyIdx++
+
+			if !inPage {
+				if isTarget {
+					// Target not on this page, but we
+					// still need it for the web context.
+					webStatus, err := p.converter.StatusToWebStatus(ctx, entry.status)
+					if err != nil {
+						err := gtserror.Newf("error converting target status: %w", err)
+						return nil, gtserror.NewErrorInternalError(err)
+					}
+					webStatus.ThreadContextStatus = true
+					wCtx.Status = webStatus
+				}
+				continue
+			}
+
+			// Prepare visible reply to add to thread context.
+			webStatus, err := p.converter.StatusToWebStatus(ctx, entry.status)
+			if err != nil {
+				continue
+			}
+
+			if firstInPage {
+				// This is the first visible
+				// "reply / comment" shown, so
+				// the little "x amount of replies"
+				// header should go above this.
+				webStatus.ThreadFirstReply = true
+			}
+
+			webStatus.Indent = entry.indent
+
+			if isTarget {
+				// This is the og
+				// thread context status.
+				webStatus.ThreadContextStatus = true
+				wCtx.Status = webStatus
+			}
+
+			wCtx.Statuses = append(wCtx.Statuses, webStatus)
+			continue
+		}
+
+		// Prepare visible "main" thread status to add to thread context.
+		webStatus, err := p.converter.StatusToWebStatus(ctx, entry.status)
+		if err != nil {
+			continue
 		}
 
-		if webStatus.ID == targetStatusID {
+		if isTarget {
 			// This is the og
 			// thread context status.
 			webStatus.ThreadContextStatus = true
 			wCtx.Status = webStatus
 		}
 
-		// If this status isn't indexable, mark the thread as not indexable.
-		// webStatus.Account from StatusToWebStatus() should always be non-nil.
-		if !webStatus.Account.Indexable {
-			wCtx.Indexable = false
-		}
-
 		wCtx.Statuses = append(wCtx.Statuses, webStatus)
 	}
 
@@ -265,7 +265,7 @@ func (suite *webContextGetTestSuite) TestAllVisibleIndexable() {
 
 	op := suite.createStatus(suite.indexableAccount1, gtsmodel.VisibilityPublic, nil)
 	_ = suite.createStatus(suite.indexableAccount2, gtsmodel.VisibilityPublic, op)
-	webContext, err := suite.status.WebContextGet(ctx, op.ID)
+	webContext, err := suite.status.WebContextGet(ctx, op.ID, -1, 0)
 	if err != nil {
 		suite.FailNow(err.Error())
 		return
@@ -283,7 +283,7 @@ func (suite *webContextGetTestSuite) TestOneVisibleNonindexable() {
 	op := suite.createStatus(suite.indexableAccount1, gtsmodel.VisibilityPublic, nil)
 	_ = suite.createStatus(suite.indexableAccount2, gtsmodel.VisibilityPublic, op)
 	_ = suite.createStatus(suite.nonindexableAccount1, gtsmodel.VisibilityPublic, op)
-	webContext, err := suite.status.WebContextGet(ctx, op.ID)
+	webContext, err := suite.status.WebContextGet(ctx, op.ID, -1, 0)
 	if err != nil {
 		suite.FailNow(err.Error())
 		return
@@ -301,7 +301,7 @@ func (suite *webContextGetTestSuite) TestOneInvisibleNonindexable() {
 	op := suite.createStatus(suite.indexableAccount1, gtsmodel.VisibilityPublic, nil)
 	_ = suite.createStatus(suite.indexableAccount2, gtsmodel.VisibilityPublic, op)
 	_ = suite.createStatus(suite.nonindexableAccount1, gtsmodel.VisibilityUnlocked, op)
-	webContext, err := suite.status.WebContextGet(ctx, op.ID)
+	webContext, err := suite.status.WebContextGet(ctx, op.ID, -1, 0)
 	if err != nil {
 		suite.FailNow(err.Error())
 		return
@@ -312,6 +312,107 @@ func (suite *webContextGetTestSuite) TestOneInvisibleNonindexable() {
 	suite.True(webContext.Indexable)
 }
 
+// Replies from a domain with a domain limit that filters
+// statuses should be omitted, along with their replies.
+func (suite *webContextGetTestSuite) TestRepliesFromLimitedDomainHidden() {
+	ctx := suite.T().Context()
+
+	// Test fixtures have a filter_warn
+	// domain limit on this account's domain.
+	remoteAccount := suite.testAccounts["remote_account_1"]
+
+	op := suite.createStatus(suite.indexableAccount1, gtsmodel.VisibilityPublic, nil)
+	_ = suite.createStatus(suite.indexableAccount2, gtsmodel.VisibilityPublic, op)
+	limitedReply := suite.createStatus(remoteAccount, gtsmodel.VisibilityPublic, op)
+	_ = suite.createStatus(suite.indexableAccount2, gtsmodel.VisibilityPublic, limitedReply)
+	webContext, err := suite.status.WebContextGet(ctx, op.ID, -1, 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+		return
+	}
+
+	suite.Len(webContext.Statuses, 2)
+	suite.Equal(3, webContext.ThreadReplies)
+	suite.Equal(1, webContext.ThreadRepliesShown)
+	suite.Equal(2, webContext.ThreadRepliesHidden)
+	suite.Equal(1, webContext.ThreadRepliesLimited)
+}
+
+// Replies should be split into pages, with the
+// main thread always shown in full on each page.
+func (suite *webContextGetTestSuite) TestRepliesPaged() {
+	ctx := suite.T().Context()
+
+	op := suite.createStatus(suite.indexableAccount1, gtsmodel.VisibilityPublic, nil)
+	replyIDs := make([]string, 5)
+	for i := range replyIDs {
+		replyIDs[i] = suite.createStatus(suite.indexableAccount2, gtsmodel.VisibilityPublic, op).ID
+	}
+
+	// Page through all replies, two at a time.
+	var (
+		seenIDs []string
+		offset  int
+		pages   int
+	)
+	for {
+		webContext, err := suite.status.WebContextGet(ctx, op.ID, offset, 2)
+		if err != nil {
+			suite.FailNow(err.Error())
+			return
+		}
+		pages++
+
+		// Main thread is always shown.
+		suite.Equal(op.ID, webContext.Status.ID)
+		suite.Equal(op.ID, webContext.Statuses[0].ID)
+		suite.True(webContext.Statuses[0].ThreadLastMain)
+		suite.True(webContext.Statuses[1].ThreadFirstReply)
+
+		// Counts are for the whole thread.
+		suite.Equal(5, webContext.ThreadRepliesShown)
+		suite.Equal(offset, webContext.ThreadRepliesOffset)
+		suite.Equal(max(0, offset-2), webContext.ThreadRepliesPrevOffset)
+
+		for _, status := range webContext.Statuses[1:] {
+			seenIDs = append(seenIDs, status.ID)
+		}
+
+		if webContext.ThreadRepliesNextOffset == 0 {
+			break
+		}
+		offset = webContext.ThreadRepliesNextOffset
+	}
+
+	suite.Equal(3, pages)
+	suite.ElementsMatch(replyIDs, seenIDs)
+}
+
+// The target status should be returned
+// even if it's not on the requested page.
+func (suite *webContextGetTestSuite) TestRepliesPagedTargetNotOnPage() {
+	ctx := suite.T().Context()
+
+	op := suite.createStatus(suite.indexableAccount1, gtsmodel.VisibilityPublic, nil)
+	reply := suite.createStatus(suite.indexableAccount2, gtsmodel.VisibilityPublic, op)
+	for range 3 {
+		_ = suite.createStatus(suite.indexableAccount1, gtsmodel.VisibilityPublic, reply)
+	}
+
+	webContext, err := suite.status.WebContextGet(ctx, reply.ID, 2, 2)
+	if err != nil {
+		suite.FailNow(err.Error())
+		return
+	}
+	suite.Len(webContext.Statuses, 3)
+	suite.Equal(reply.ID, webContext.Status.ID)
+	suite.Equal(4, webContext.ThreadRepliesShown)
+	suite.Zero(webContext.ThreadRepliesNextOffset)
+	for _, status := range webContext.Statuses {
+		suite.NotEqual(reply.ID, status.ID)
+	}
+}
+
 func TestWebContextGetTestSuite(t *testing.T) {
 	suite.Run(t, &webContextGetTestSuite{})
 }
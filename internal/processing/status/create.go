@@ -20,6 +20,8 @@
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"time"
 
 	"code.superseriousbusiness.org/gopkg/log"
@@ -30,11 +32,13 @@
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/hooks"
 	"code.superseriousbusiness.org/gotosocial/internal/id"
 	"code.superseriousbusiness.org/gotosocial/internal/messages"
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
 	"code.superseriousbusiness.org/gotosocial/internal/uris"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
+	errorsv2 "codeberg.org/gruf/go-errors/v2"
 )
 
 // Create processes the given form to create a new status, returning the api model representation of that status if it's OK.
@@ -61,6 +65,19 @@ func (p *Processor) Create(
 		log.Errorf(ctx, "error(s) populating account, will continue: %s", err)
 	}
 
+	// Pass status through any configured moderation hook.
+	if errWithCode := p.callStatusHook(ctx, requester, &hooks.Status{
+		Content:        form.Status,
+		ContentWarning: form.SpoilerText,
+		Visibility:     string(form.Visibility),
+		Language:       form.Language,
+		Sensitive:      form.Sensitive,
+		InReplyToID:    form.InReplyToID,
+		MediaIDs:       form.MediaIDs,
+	}); errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	// Generate new ID for status.
 	statusID := id.NewULID()
 
@@ -91,6 +108,12 @@ func (p *Processor) Create(
 	// time as creation time.
 	createdAt := now
 
+	// Process status expiry time, if set.
+	expiresAt, errWithCode := processExpiresAt(form, now)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	// Handle backfilled/scheduled statuses.
 	backfill := false
 
@@ -170,7 +193,8 @@ func (p *Processor) Create(
 		CreatedWithApplicationID: application.ID,
 
 		// Set validated language.
-		Language: content.Language,
+		Language:         content.Language,
+		LanguageDetected: &content.LanguageDetected,
 
 		// Set formatted status content.
 		Content:        content.Content,
@@ -194,6 +218,9 @@ func (p *Processor) Create(
 		AttachmentIDs: form.MediaIDs,
 		Attachments:   media,
 
+		// Set expiry time, if any.
+		ExpiresAt: expiresAt,
+
 		// Assume not pending approval; this may
 		// change when permissivity is checked.
 		PendingApproval: util.Ptr(false),
@@ -314,7 +341,7 @@ func (p *Processor) Create(
 		// must be a reply to a status with an interaction
 		// policy that requires approval for replies.
 		// Queue up Create ReplyRequest side effects.
-		p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 			APObjectType:   ap.ActivityReplyRequest,
 			APActivityType: ap.ActivityCreate,
 			GTSModel:       status,
@@ -324,7 +351,7 @@ func (p *Processor) Create(
 	default:
 		// "Normal" status with no explicit approval
 		// required, queue Create Status side effects.
-		p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 			APObjectType:   ap.ObjectNote,
 			APActivityType: ap.ActivityCreate,
 			GTSModel:       status,
@@ -467,6 +494,41 @@ func (p *Processor) processInReplyTo(
 	return nil
 }
 
+// processExpiresAt validates the expires_in
+// field on the given form, returning the time
+// at which the status should be automatically
+// deleted, or zero time if it shouldn't be.
+func processExpiresAt(
+	form *apimodel.StatusCreateRequest,
+	now time.Time,
+) (time.Time, gtserror.WithCode) {
+	const (
+		minExpiresIn = 5 * time.Minute
+		maxExpiresIn = 366 * 24 * time.Hour
+	)
+
+	switch expiresIn := form.ExpiresIn; {
+	case expiresIn == 0:
+		// No expiry set.
+		return time.Time{}, nil
+
+	case form.ScheduledAt != nil && form.ScheduledAt.After(now):
+		const errText = "expires_in cannot be used with scheduled statuses"
+		return time.Time{}, gtserror.NewErrorUnprocessableEntity(gtserror.New(errText), errText)
+
+	case expiresIn < int(minExpiresIn.Seconds()):
+		errText := fmt.Sprintf("expires_in must be at least %d seconds", int(minExpiresIn.Seconds()))
+		return time.Time{}, gtserror.NewErrorUnprocessableEntity(errors.New(errText), errText)
+
+	case expiresIn > int(maxExpiresIn.Seconds()):
+		errText := fmt.Sprintf("expires_in must be at most %d seconds", int(maxExpiresIn.Seconds()))
+		return time.Time{}, gtserror.NewErrorUnprocessableEntity(errors.New(errText), errText)
+
+	default:
+		return now.Add(time.Duration(expiresIn) * time.Second), nil
+	}
+}
+
 func processVisibility(
 	form *apimodel.StatusCreateRequest,
 	accountDefaultVis gtsmodel.Visibility,
@@ -670,3 +732,38 @@ func (p *Processor) processScheduledStatus(
 
 	return apiScheduledStatus, nil
 }
+
+// callStatusHook calls any configured pre-status-create moderation
+// hook with the given status details, for a status being created
+// or edited, returning an appropriate error if the hook rejected
+// the status, or failed (when configured to fail closed).
+func (p *Processor) callStatusHook(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	status *hooks.Status,
+) gtserror.WithCode {
+	if !p.state.Hooks.Enabled(hooks.PreStatusCreate) {
+		return nil
+	}
+
+	err := p.state.Hooks.Call(ctx, &hooks.Request{
+		Point:   hooks.PreStatusCreate,
+		Account: requester.URI,
+		Status:  status,
+	})
+
+	if rejectErr := errorsv2.AsV2[*hooks.RejectError](err); rejectErr != nil {
+		text := "status rejected by moderation"
+		if rejectErr.Reason != "" {
+			text += ": " + rejectErr.Reason
+		}
+		return gtserror.NewErrorUnprocessableEntity(err, text)
+	}
+
+	if err != nil {
+		const text = "moderation service unavailable, please try again later"
+		return gtserror.NewWithCodeSafe(http.StatusServiceUnavailable, err, text)
+	}
+
+	return nil
+}
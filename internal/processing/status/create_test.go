@@ -18,13 +18,16 @@
 package status_test
 
 import (
+	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/hooks"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
 	"github.com/stretchr/testify/suite"
 )
@@ -33,6 +36,14 @@ type StatusCreateTestSuite struct {
 	StatusStandardTestSuite
 }
 
+// hookFunc is a hooks.Hook
+// implementation for tests.
+type hookFunc func(context.Context, *hooks.Request) (*hooks.Response, error)
+
+func (f hookFunc) Call(ctx context.Context, req *hooks.Request) (*hooks.Response, error) {
+	return f(ctx, req)
+}
+
 func (suite *StatusCreateTestSuite) TestProcessContentWarningWithQuotationMarks() {
 	ctx := suite.T().Context()
 
@@ -150,6 +161,47 @@ func (suite *StatusCreateTestSuite) TestProcessMediaDescriptionTooShort() {
 	suite.Nil(apiStatus)
 }
 
+func (suite *StatusCreateTestSuite) TestProcessModerationHookReject() {
+	ctx := suite.T().Context()
+
+	// Set a moderation hook rejecting some content.
+	suite.state.Hooks = hooks.New(nil)
+	defer func() { suite.state.Hooks = nil }()
+	var hookReq *hooks.Request
+	suite.state.Hooks.Set(hooks.PreStatusCreate, hookFunc(func(_ context.Context, req *hooks.Request) (*hooks.Response, error) {
+		hookReq = req
+		return &hooks.Response{Reject: true, Reason: "no poopoo allowed"}, nil
+	}))
+
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	statusCreateForm := &apimodel.StatusCreateRequest{
+		Status:      "poopoo peepee",
+		MediaIDs:    []string{},
+		Poll:        nil,
+		InReplyToID: "",
+		Sensitive:   false,
+		SpoilerText: "",
+		Visibility:  apimodel.VisibilityPublic,
+		LocalOnly:   util.Ptr(false),
+		ScheduledAt: nil,
+		Language:    "en",
+		ContentType: apimodel.StatusContentTypePlain,
+	}
+
+	apiStatus, errWithCode := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm, nil)
+	suite.Nil(apiStatus)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.Equal("Unprocessable Entity: status rejected by moderation: no poopoo allowed", errWithCode.Safe())
+
+	// Check the hook was passed the status details.
+	suite.Equal(creatingAccount.URI, hookReq.Account)
+	suite.Equal("poopoo peepee", hookReq.Status.Content)
+	suite.Equal("public", hookReq.Status.Visibility)
+	suite.Equal("en", hookReq.Status.Language)
+}
+
 func (suite *StatusCreateTestSuite) TestProcessLanguageWithScriptPart() {
 	ctx := suite.T().Context()
 
@@ -247,6 +299,76 @@ func (suite *StatusCreateTestSuite) TestProcessNoContentTypeUsesDefault() {
 	suite.Equal(apimodel.StatusContentTypeDefault, apiStatus.ContentType)
 }
 
+func (suite *StatusCreateTestSuite) TestProcessMarkdownShorthandContentType() {
+	ctx := suite.T().Context()
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	statusCreateForm := &apimodel.StatusCreateRequest{
+		Status:      "this is **very** important",
+		Visibility:  apimodel.VisibilityPublic,
+		LocalOnly:   util.Ptr(false),
+		Language:    "en",
+		ContentType: "markdown",
+	}
+
+	apiStatusAny, errWithCode := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm, nil)
+	suite.NoError(errWithCode)
+	apiStatus := apiStatusAny.(*apimodel.Status)
+
+	// Shorthand should be parsed as markdown,
+	// and reported back in its normalized form.
+	suite.Equal("<p>this is <strong>very</strong> important</p>", apiStatus.Content)
+	suite.Equal(apimodel.StatusContentTypeMarkdown, apiStatus.ContentType)
+}
+
+func (suite *StatusCreateTestSuite) TestProcessExpiresIn() {
+	ctx := suite.T().Context()
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	statusCreateForm := &apimodel.StatusCreateRequest{
+		Status:     "this status will self destruct",
+		Visibility: apimodel.VisibilityPublic,
+		LocalOnly:  util.Ptr(false),
+		Language:   "en",
+		ExpiresIn:  3600,
+	}
+
+	apiStatusAny, errWithCode := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm, nil)
+	suite.NoError(errWithCode)
+	apiStatus := apiStatusAny.(*apimodel.Status)
+
+	// Remaining lifetime should be reported.
+	suite.NotNil(apiStatus.ExpiresAt)
+	suite.NotNil(apiStatus.ExpiresIn)
+	suite.InDelta(3600, *apiStatus.ExpiresIn, 5)
+
+	// Expiry should be stored on the status.
+	dbStatus, err := suite.db.GetStatusByID(ctx, apiStatus.ID)
+	suite.NoError(err)
+	suite.WithinDuration(time.Now().Add(time.Hour), dbStatus.ExpiresAt, 5*time.Second)
+}
+
+func (suite *StatusCreateTestSuite) TestProcessExpiresInTooShort() {
+	ctx := suite.T().Context()
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	statusCreateForm := &apimodel.StatusCreateRequest{
+		Status:     "this status will self destruct",
+		Visibility: apimodel.VisibilityPublic,
+		LocalOnly:  util.Ptr(false),
+		Language:   "en",
+		ExpiresIn:  60,
+	}
+
+	apiStatus, errWithCode := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm, nil)
+	suite.Nil(apiStatus)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.Equal("Unprocessable Entity: expires_in must be at least 300 seconds", errWithCode.Safe())
+}
+
 func (suite *StatusCreateTestSuite) TestProcessInvalidVisibility() {
 	ctx := suite.T().Context()
 	creatingAccount := suite.testAccounts["local_account_1"]
@@ -21,15 +21,18 @@
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/messages"
 )
 
 // Delete processes the delete of a given status, returning the deleted status if the delete goes through.
+// If the statuses trash window is configured, the status will be moved to the trash rather than deleted.
 func (p *Processor) Delete(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string) (*apimodel.Status, gtserror.WithCode) {
 	targetStatus, err := p.state.DB.GetStatusByID(ctx, targetStatusID)
 	if err != nil {
@@ -57,8 +60,23 @@ func (p *Processor) Delete(ctx context.Context, requestingAccount *gtsmodel.Acco
 		apiStatus.SpoilerText = targetStatus.ContentWarningText
 	}
 
+	if window := config.GetStatusesTrashWindow(); window > 0 &&
+		targetStatus.BoostOfID == "" {
+		// Trash is enabled, so rather than being deleted
+		// immediately, move the status to the trash, from
+		// where it can be restored until the window passes.
+		targetStatus.DeletedAt = time.Now()
+		if err := p.state.DB.UpdateStatus(ctx,
+			targetStatus,
+			"deleted_at",
+		); err != nil {
+			err := gtserror.Newf("db error moving status to trash: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
 	// Process delete side effects.
-	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+	p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 		APObjectType:   ap.ObjectNote,
 		APActivityType: ap.ActivityDelete,
 		GTSModel:       targetStatus,
@@ -0,0 +1,367 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"code.superseriousbusiness.org/gotosocial/internal/validate"
+)
+
+// DraftsGetPage returns a page of drafts saved by the requester.
+func (p *Processor) DraftsGetPage(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	drafts, err := p.state.DB.GetDraftsForAccount(ctx, requester.ID, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting drafts: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(drafts)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	var (
+		// Get the lowest and highest
+		// ID values, used for paging.
+		lo = drafts[count-1].ID
+		hi = drafts[0].ID
+
+		// Best-guess items length.
+		items = make([]interface{}, 0, count)
+	)
+
+	for _, draft := range drafts {
+		apiDraft, err := p.converter.DraftToAPIDraft(ctx, draft)
+		if err != nil {
+			log.Errorf(ctx, "error converting draft to api draft: %v", err)
+			continue
+		}
+
+		// Append draft to return items.
+		items = append(items, apiDraft)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/drafts",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
+}
+
+// DraftGet returns one draft with the given ID, saved by the requester.
+func (p *Processor) DraftGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	id string,
+) (*apimodel.Draft, gtserror.WithCode) {
+	draft, errWithCode := p.getOwnDraft(ctx, requester, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiDraft(ctx, draft)
+}
+
+// DraftCreate saves a new draft for the requester.
+func (p *Processor) DraftCreate(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	form *apimodel.DraftRequest,
+) (*apimodel.Draft, gtserror.WithCode) {
+	now := time.Now()
+	draft := &gtsmodel.Draft{
+		ID:        id.NewULID(),
+		CreatedAt: now,
+		UpdatedAt: now,
+		AccountID: requester.ID,
+		Account:   requester,
+	}
+
+	if errWithCode := p.processDraftForm(ctx, draft, form); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.PutDraft(ctx, draft); err != nil {
+		err := gtserror.Newf("db error inserting draft: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiDraft(ctx, draft)
+}
+
+// DraftUpdate replaces the contents of an
+// existing draft saved by the requester.
+func (p *Processor) DraftUpdate(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	id string,
+	form *apimodel.DraftRequest,
+) (*apimodel.Draft, gtserror.WithCode) {
+	draft, errWithCode := p.getOwnDraft(ctx, requester, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if errWithCode := p.processDraftForm(ctx, draft, form); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	draft.UpdatedAt = time.Now()
+	if err := p.state.DB.UpdateDraft(ctx, draft); err != nil {
+		err := gtserror.Newf("db error updating draft: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiDraft(ctx, draft)
+}
+
+// DraftDelete deletes a draft saved by the requester.
+func (p *Processor) DraftDelete(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	id string,
+) gtserror.WithCode {
+	if _, errWithCode := p.getOwnDraft(ctx, requester, id); errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.state.DB.DeleteDraftByID(ctx, id); err != nil {
+		err := gtserror.Newf("db error deleting draft: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// DraftPublish creates a new status from a draft saved by the
+// requester, using the normal status creation process, and then
+// deletes the draft. The draft is left untouched if creation fails.
+func (p *Processor) DraftPublish(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	application *gtsmodel.Application,
+	id string,
+) (any, gtserror.WithCode) {
+	draft, errWithCode := p.getOwnDraft(ctx, requester, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	form := &apimodel.StatusCreateRequest{
+		Status:      draft.Text,
+		MediaIDs:    draft.MediaIDs,
+		InReplyToID: draft.InReplyToID,
+		Sensitive:   util.PtrOrZero(draft.Sensitive),
+		SpoilerText: draft.SpoilerText,
+		LocalOnly:   draft.LocalOnly,
+		Language:    draft.Language,
+		ContentType: apimodel.StatusContentType(draft.ContentType),
+	}
+
+	if draft.Visibility != 0 {
+		form.Visibility = typeutils.VisToAPIVis(draft.Visibility)
+	}
+
+	if len(draft.Poll.Options) > 0 {
+		form.Poll = &apimodel.PollRequest{
+			Options:    draft.Poll.Options,
+			ExpiresIn:  draft.Poll.ExpiresIn,
+			Multiple:   util.PtrOrZero(draft.Poll.Multiple),
+			HideTotals: util.PtrOrZero(draft.Poll.HideTotals),
+		}
+	}
+
+	apiStatus, errWithCode := p.Create(ctx, requester, application, form, nil)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Status was created, so the draft is no longer needed.
+	if err := p.state.DB.DeleteDraftByID(ctx, draft.ID); err != nil {
+		log.Errorf(ctx, "db error deleting published draft %s: %v", draft.ID, err)
+	}
+
+	return apiStatus, nil
+}
+
+// getOwnDraft fetches the draft with the given
+// ID, checking that it was saved by requester.
+func (p *Processor) getOwnDraft(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	id string,
+) (*gtsmodel.Draft, gtserror.WithCode) {
+	draft, err := p.state.DB.GetDraftByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting draft: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if draft == nil {
+		err := gtserror.New("draft not found")
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	if draft.AccountID != requester.ID {
+		err := gtserror.Newf(
+			"draft %s is not authored by account %s",
+			draft.ID, requester.ID,
+		)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return draft, nil
+}
+
+// processDraftForm performs light validation of the given
+// draft form, setting its values on draft. Drafts are allowed
+// to be incomplete, so only things that would never be valid
+// are rejected here; the rest is checked on publish.
+func (p *Processor) processDraftForm(
+	ctx context.Context,
+	draft *gtsmodel.Draft,
+	form *apimodel.DraftRequest,
+) gtserror.WithCode {
+	if max := config.GetStatusesMaxChars(); len([]rune(form.Status))+len([]rune(form.SpoilerText)) > max {
+		text := fmt.Sprintf("draft text + spoiler text exceeds max chars (%d)", max)
+		return gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if max := config.GetStatusesMediaMaxFiles(); len(form.MediaIDs) > max {
+		text := fmt.Sprintf("too many media attached (max %d)", max)
+		return gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	media := make([]*gtsmodel.MediaAttachment, 0, len(form.MediaIDs))
+	for _, id := range form.MediaIDs {
+		attachment, err := p.state.DB.GetAttachmentByID(ctx, id)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("error getting media from db: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		// Check media exists and is owned by author
+		// (this masks finding out media ownership info).
+		if attachment == nil || attachment.AccountID != draft.AccountID {
+			text := fmt.Sprintf("media not found: %s", id)
+			return gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		// Check media isn't already attached to a status.
+		if attachment.StatusID != "" || attachment.ScheduledStatusID != "" {
+			text := fmt.Sprintf("media already attached to status: %s", id)
+			return gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		media = append(media, attachment)
+	}
+
+	localOnly := util.PtrOrZero(form.LocalOnly)
+
+	var visibility gtsmodel.Visibility
+	if form.Visibility == apimodel.VisibilityLocal {
+		// "local" visibility is shorthand
+		// for a public, local-only status.
+		visibility = gtsmodel.VisibilityPublic
+		localOnly = true
+	} else if form.Visibility != "" {
+		visibility = typeutils.APIVisToVis(form.Visibility)
+		if visibility == 0 {
+			text := fmt.Sprintf("invalid visibility %s", form.Visibility)
+			return gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+	}
+
+	var contentType string
+	if form.ContentType != "" {
+		ct := typeutils.APIContentTypeToContentType(form.ContentType)
+		if ct == 0 {
+			text := fmt.Sprintf("invalid content type %s", form.ContentType)
+			return gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+		contentType = string(typeutils.ContentTypeToAPIContentType(ct))
+	}
+
+	language := form.Language
+	if language != "" {
+		var err error
+		language, err = validate.Language(language)
+		if err != nil {
+			text := fmt.Sprintf("invalid language tag: %v", err)
+			return gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+	}
+
+	var poll gtsmodel.ScheduledStatusPoll
+	if form.Poll != nil && len(form.Poll.Options) > 0 {
+		if max := config.GetStatusesPollMaxOptions(); len(form.Poll.Options) > max {
+			text := fmt.Sprintf("too many poll options (max %d)", max)
+			return gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		poll = gtsmodel.ScheduledStatusPoll{
+			Options:    form.Poll.Options,
+			ExpiresIn:  form.Poll.ExpiresIn,
+			Multiple:   util.Ptr(form.Poll.Multiple),
+			HideTotals: util.Ptr(form.Poll.HideTotals),
+		}
+	}
+
+	draft.Text = form.Status
+	draft.SpoilerText = form.SpoilerText
+	draft.MediaIDs = form.MediaIDs
+	draft.MediaAttachments = media
+	draft.Poll = poll
+	draft.InReplyToID = form.InReplyToID
+	draft.Sensitive = util.Ptr(form.Sensitive)
+	draft.Visibility = visibility
+	draft.Language = language
+	draft.LocalOnly = util.Ptr(localOnly)
+	draft.ContentType = contentType
+
+	return nil
+}
+
+// apiDraft converts the given draft to its API model.
+func (p *Processor) apiDraft(ctx context.Context, draft *gtsmodel.Draft) (*apimodel.Draft, gtserror.WithCode) {
+	apiDraft, err := p.converter.DraftToAPIDraft(ctx, draft)
+	if err != nil {
+		err := gtserror.Newf("error converting draft to api draft: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	return apiDraft, nil
+}
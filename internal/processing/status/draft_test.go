@@ -0,0 +1,177 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"github.com/stretchr/testify/suite"
+)
+
+type DraftTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *DraftTestSuite) TestDraftCreateUpdateGet() {
+	var (
+		ctx        = suite.T().Context()
+		requester  = suite.testAccounts["local_account_1"]
+		attachment = suite.testAttachments["local_account_1_unattached_1"]
+	)
+
+	// Save a draft with an attachment + poll.
+	draft, errWithCode := suite.status.DraftCreate(ctx, requester, &apimodel.DraftRequest{
+		Status:      "some *unfinished*",
+		MediaIDs:    []string{attachment.ID},
+		Visibility:  apimodel.VisibilityUnlisted,
+		ContentType: "markdown",
+		Language:    "en",
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("some *unfinished*", draft.Params.Text)
+	suite.Equal(apimodel.VisibilityUnlisted, draft.Params.Visibility)
+	suite.Equal(apimodel.StatusContentTypeMarkdown, draft.Params.ContentType)
+	suite.Len(draft.MediaAttachments, 1)
+
+	// Media should now be marked as belonging to the draft.
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, attachment.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(draft.ID, dbAttachment.DraftID)
+
+	// Replace the draft contents, dropping the media.
+	draft, errWithCode = suite.status.DraftUpdate(ctx, requester, draft.ID, &apimodel.DraftRequest{
+		Status: "some *unfinished* thought",
+		Poll: &apimodel.PollRequest{
+			Options:   []string{"yes", "no"},
+			ExpiresIn: 3600,
+		},
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("some *unfinished* thought", draft.Params.Text)
+	suite.Empty(draft.Params.Visibility)
+	suite.Empty(draft.MediaAttachments)
+	suite.Equal([]string{"yes", "no"}, draft.Params.Poll.Options)
+
+	// Should be able to fetch the draft again.
+	fetched, errWithCode := suite.status.DraftGet(ctx, requester, draft.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(draft, fetched)
+
+	resp, errWithCode := suite.status.DraftsGetPage(ctx, requester, &paging.Page{Limit: 20})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(resp.Items, 1)
+
+	// Other accounts should not see the draft.
+	_, errWithCode = suite.status.DraftGet(ctx, suite.testAccounts["local_account_2"], draft.ID)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *DraftTestSuite) TestDraftCreateInvalid() {
+	var (
+		ctx       = suite.T().Context()
+		requester = suite.testAccounts["local_account_1"]
+	)
+
+	for _, form := range []*apimodel.DraftRequest{
+		{Visibility: "everyone"},
+		{ContentType: "text/html"},
+		{MediaIDs: []string{suite.testAttachments["admin_account_status_1_attachment_1"].ID}},
+		{MediaIDs: []string{suite.testAttachments["local_account_1_status_4_attachment_1"].ID}},
+	} {
+		_, errWithCode := suite.status.DraftCreate(ctx, requester, form)
+		suite.NotNil(errWithCode)
+		suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	}
+}
+
+func (suite *DraftTestSuite) TestDraftPublish() {
+	var (
+		ctx         = suite.T().Context()
+		requester   = suite.testAccounts["local_account_1"]
+		application = suite.testApplications["application_1"]
+		attachment  = suite.testAttachments["local_account_1_unattached_1"]
+	)
+
+	draft, errWithCode := suite.status.DraftCreate(ctx, requester, &apimodel.DraftRequest{
+		Status:      "this is **done** now",
+		MediaIDs:    []string{attachment.ID},
+		Visibility:  apimodel.VisibilityPrivate,
+		ContentType: apimodel.StatusContentTypeMarkdown,
+		Language:    "en",
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	apiStatusAny, errWithCode := suite.status.DraftPublish(ctx, requester, application, draft.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	apiStatus := apiStatusAny.(*apimodel.Status)
+
+	// Status should be created from the draft.
+	suite.Equal("<p>this is <strong>done</strong> now</p>", apiStatus.Content)
+	suite.Equal(apimodel.VisibilityPrivate, apiStatus.Visibility)
+	suite.Len(apiStatus.MediaAttachments, 1)
+	suite.Equal(attachment.ID, apiStatus.MediaAttachments[0].ID)
+
+	// Draft should be gone.
+	_, err := suite.db.GetDraftByID(ctx, draft.ID)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func (suite *DraftTestSuite) TestDraftPublishInvalidKeepsDraft() {
+	var (
+		ctx         = suite.T().Context()
+		requester   = suite.testAccounts["local_account_1"]
+		application = suite.testApplications["application_1"]
+	)
+
+	// An empty draft isn't a valid status.
+	draft, errWithCode := suite.status.DraftCreate(ctx, requester, &apimodel.DraftRequest{})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	_, errWithCode = suite.status.DraftPublish(ctx, requester, application, draft.ID)
+	suite.NotNil(errWithCode)
+
+	// Draft should still be there.
+	_, err := suite.db.GetDraftByID(ctx, draft.ID)
+	suite.NoError(err)
+}
+
+func TestDraftTestSuite(t *testing.T) {
+	suite.Run(t, new(DraftTestSuite))
+}
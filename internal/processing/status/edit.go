@@ -32,8 +32,11 @@
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/hooks"
 	"code.superseriousbusiness.org/gotosocial/internal/id"
 	"code.superseriousbusiness.org/gotosocial/internal/messages"
+	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
 )
 
 // Edit ...
@@ -84,6 +87,20 @@ func (p *Processor) Edit(
 		return nil, errWithCode
 	}
 
+	// Pass new content through any configured moderation hook.
+	if errWithCode := p.callStatusHook(ctx, requester, &hooks.Status{
+		EditOfID:       status.ID,
+		Content:        form.Status,
+		ContentWarning: form.SpoilerText,
+		Visibility:     string(typeutils.VisToAPIVis(status.Visibility)),
+		Language:       form.Language,
+		Sensitive:      form.Sensitive,
+		InReplyToID:    status.InReplyToID,
+		MediaIDs:       form.MediaIDs,
+	}); errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	// Process incoming content type.
 	contentType := processContentType(
 		form.ContentType,
@@ -154,7 +171,7 @@ func (p *Processor) Edit(
 
 	// Track status columns we
 	// need to update in database.
-	cols := make([]string, 2, 13)
+	cols := make([]string, 2, 14)
 	cols[0] = "edited_at"
 	cols[1] = "edits"
 
@@ -166,6 +183,7 @@ func (p *Processor) Edit(
 		// we can save current version.
 		cols = append(cols, "content")
 		cols = append(cols, "text")
+		cols = append(cols, "content_type")
 	}
 
 	if warningChanged {
@@ -186,6 +204,11 @@ func (p *Processor) Edit(
 		cols = append(cols, "language")
 	}
 
+	if util.PtrOrZero(status.LanguageDetected) != content.LanguageDetected {
+		// Update whether status language was detected.
+		cols = append(cols, "language_detected")
+	}
+
 	if *status.Sensitive != form.Sensitive {
 		// Update status sensitivity pref.
 		//
@@ -309,6 +332,7 @@ func (p *Processor) Edit(
 	status.Text = form.Status // raw
 	status.ContentType = contentType
 	status.Language = content.Language
+	status.LanguageDetected = &content.LanguageDetected
 	status.Sensitive = &form.Sensitive
 	status.AttachmentIDs = form.MediaIDs
 	status.Attachments = media
@@ -348,7 +372,7 @@ func (p *Processor) Edit(
 	}
 
 	// Send it to the client API worker for async side-effects.
-	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+	p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 		APObjectType:   ap.ObjectNote,
 		APActivityType: ap.ActivityUpdate,
 		GTSModel:       status,
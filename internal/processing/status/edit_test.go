@@ -26,6 +26,7 @@
 	"code.superseriousbusiness.org/gopkg/xslices"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/hooks"
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
 	"github.com/stretchr/testify/suite"
@@ -98,6 +99,49 @@ func (suite *StatusEditTestSuite) TestSimpleEdit() {
 	suite.Equal(status.UpdatedAt(), previousEdit.CreatedAt)
 }
 
+func (suite *StatusEditTestSuite) TestEditModerationHookReject() {
+	ctx := suite.T().Context()
+
+	// Set a moderation hook rejecting some content.
+	suite.state.Hooks = hooks.New(nil)
+	defer func() { suite.state.Hooks = nil }()
+	var hookReq *hooks.Request
+	suite.state.Hooks.Set(hooks.PreStatusCreate, hookFunc(func(_ context.Context, req *hooks.Request) (*hooks.Response, error) {
+		hookReq = req
+		return &hooks.Response{Reject: true, Reason: "no poopoo allowed"}, nil
+	}))
+
+	// Get a local account to use as test requester.
+	requester := suite.testAccounts["local_account_1"]
+	requester, _ = suite.state.DB.GetAccountByID(ctx, requester.ID)
+
+	// Get requester's existing status to perform an edit on.
+	status := suite.testStatuses["local_account_1_status_9"]
+	status, _ = suite.state.DB.GetStatusByID(ctx, status.ID)
+
+	form := &apimodel.StatusEditRequest{
+		Status:   "poopoo peepee",
+		Language: "en",
+	}
+
+	apiStatus, errWithCode := suite.status.Edit(ctx, requester, status.ID, form)
+	suite.Nil(apiStatus)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.Equal("Unprocessable Entity: status rejected by moderation: no poopoo allowed", errWithCode.Safe())
+
+	// Check the hook was passed the edit details.
+	suite.Equal(requester.URI, hookReq.Account)
+	suite.Equal(status.ID, hookReq.Status.EditOfID)
+	suite.Equal("poopoo peepee", hookReq.Status.Content)
+	suite.Equal(string(typeutils.VisToAPIVis(status.Visibility)), hookReq.Status.Visibility)
+
+	// Status should not have been edited.
+	latestStatus, err := suite.state.DB.GetStatusByID(ctx, status.ID)
+	suite.NoError(err)
+	suite.Equal(status.Text, latestStatus.Text)
+	suite.Equal(status.EditIDs, latestStatus.EditIDs)
+}
+
 func (suite *StatusEditTestSuite) TestEditChangeContentType() {
 	// Create cancellable context to use for test.
 	ctx, cncl := context.WithCancel(suite.T().Context())
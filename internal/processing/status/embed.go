@@ -0,0 +1,195 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"html"
+	"strconv"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/uris"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+const (
+	// Default and maximum width
+	// of an embedded status iframe.
+	oEmbedWidth = 400
+
+	// Suggested cache lifetime
+	// of oEmbed responses (1 day).
+	oEmbedCacheAge = 86400
+)
+
+// EmbedGet returns the web view of the given status,
+// for embedding on another website in an iframe.
+//
+// Only public, local statuses by accounts that
+// haven't opted out of embeds can be embedded.
+func (p *Processor) EmbedGet(
+	ctx context.Context,
+	statusID string,
+) (*apimodel.WebStatus, gtserror.WithCode) {
+	status, errWithCode := p.embeddableStatus(ctx, func() (*gtsmodel.Status, error) {
+		return p.state.DB.GetStatusByID(ctx, statusID)
+	})
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	webStatus, err := p.converter.StatusToWebStatus(ctx, status)
+	if err != nil {
+		err := gtserror.Newf("error converting status: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return webStatus, nil
+}
+
+// OEmbedGet returns an oEmbed response for the status
+// at the given URL (or URI), suitable for embedding the
+// status on another website. maxWidth and maxHeight are
+// optional, and will be ignored if <= 0.
+func (p *Processor) OEmbedGet(
+	ctx context.Context,
+	statusURL string,
+	maxWidth int,
+	maxHeight int,
+) (*apimodel.OEmbed, gtserror.WithCode) {
+	status, errWithCode := p.embeddableStatus(ctx, func() (*gtsmodel.Status, error) {
+		// Status web URL is most likely
+		// to be given, but try URI too.
+		status, err := p.state.DB.GetStatusByURL(ctx, statusURL)
+		if errors.Is(err, db.ErrNoEntries) {
+			status, err = p.state.DB.GetStatusByURI(ctx, statusURL)
+		}
+		return status, err
+	})
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	authorName := status.Account.DisplayName
+	if authorName == "" {
+		authorName = status.Account.Username
+	}
+
+	width := oEmbedWidth
+	if maxWidth > 0 && maxWidth < width {
+		width = maxWidth
+	}
+
+	// Leave height up to the embedder
+	// unless they've given a maximum.
+	var height *int
+	if maxHeight > 0 {
+		height = util.Ptr(maxHeight)
+	}
+
+	// Build the iframe. No scripts are needed in
+	// the embed, so sandbox it as tightly as possible,
+	// just allowing links to be opened in a new tab.
+	iframe := `<iframe src="` + html.EscapeString(uris.GenerateURLForEmbed(status.ID)) + `"` +
+		` class="gotosocial-embed"` +
+		` style="max-width: 100%; border: 0"` +
+		` width="` + strconv.Itoa(width) + `"`
+	if height != nil {
+		iframe += ` height="` + strconv.Itoa(*height) + `"`
+	}
+	iframe += ` sandbox="allow-popups allow-popups-to-escape-sandbox"` +
+		` loading="lazy"></iframe>`
+
+	return &apimodel.OEmbed{
+		Type:         "rich",
+		Version:      "1.0",
+		Title:        "Post by @" + status.Account.Username + "@" + config.GetAccountDomain(),
+		AuthorName:   authorName,
+		AuthorURL:    status.Account.URL,
+		ProviderName: config.GetAccountDomain(),
+		ProviderURL:  config.GetProtocol() + "://" + config.GetHost() + "/",
+		CacheAge:     oEmbedCacheAge,
+		HTML:         iframe,
+		Width:        width,
+		Height:       height,
+	}, nil
+}
+
+// embeddableStatus fetches a status using the given
+// database function, and checks that it can be embedded,
+// returning a not found error if not (or if not found).
+func (p *Processor) embeddableStatus(
+	ctx context.Context,
+	getStatusFromDB func() (*gtsmodel.Status, error),
+) (*gtsmodel.Status, gtserror.WithCode) {
+	const text = "status not found"
+
+	status, err := getStatusFromDB()
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting status: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if status == nil {
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	// Only local, public, non-boost statuses
+	// by non-suspended accounts can be embedded.
+	if !status.IsLocal() ||
+		status.BoostOfID != "" ||
+		status.Visibility != gtsmodel.VisibilityPublic ||
+		status.Account == nil ||
+		status.Account.IsSuspended() {
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	// Check account hasn't opted out of embeds.
+	settings := status.Account.Settings
+	if settings == nil {
+		settings, err = p.state.DB.GetAccountSettings(ctx, status.AccountID)
+		if err != nil {
+			err := gtserror.Newf("db error getting account settings: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if !util.PtrOrValue(settings.AllowEmbeds, true) {
+		err := gtserror.Newf("account %s has opted out of embeds", status.AccountID)
+		return nil, gtserror.NewErrorNotFound(err, text)
+	}
+
+	// Finally, make sure the status would be
+	// visible to an unauthenticated web visitor.
+	visible, err := p.visFilter.StatusVisible(ctx, nil, status)
+	if err != nil {
+		err := gtserror.Newf("error checking status visibility: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if !visible {
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	return status, nil
+}
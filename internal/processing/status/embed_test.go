@@ -0,0 +1,99 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"net/http"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type StatusEmbedTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusEmbedTestSuite) TestEmbedGet() {
+	ctx := suite.T().Context()
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	webStatus, errWithCode := suite.status.EmbedGet(ctx, targetStatus.ID)
+	suite.NoError(errWithCode)
+	suite.Equal(targetStatus.ID, webStatus.ID)
+}
+
+func (suite *StatusEmbedTestSuite) TestEmbedGetNotPublic() {
+	ctx := suite.T().Context()
+
+	// Followers-only status can't be embedded.
+	targetStatus := suite.testStatuses["local_account_1_status_5"]
+
+	_, errWithCode := suite.status.EmbedGet(ctx, targetStatus.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *StatusEmbedTestSuite) TestEmbedGetRemote() {
+	ctx := suite.T().Context()
+
+	// Remote status can't be embedded.
+	targetStatus := suite.testStatuses["remote_account_1_status_1"]
+
+	_, errWithCode := suite.status.EmbedGet(ctx, targetStatus.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *StatusEmbedTestSuite) TestEmbedGetOptedOut() {
+	ctx := suite.T().Context()
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	// Opt the author out of embeds.
+	settings, err := suite.state.DB.GetAccountSettings(ctx, targetStatus.AccountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	settings.AllowEmbeds = util.Ptr(false)
+	if err := suite.state.DB.UpdateAccountSettings(ctx, settings, "allow_embeds"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, errWithCode := suite.status.EmbedGet(ctx, targetStatus.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	_, errWithCode = suite.status.OEmbedGet(ctx, targetStatus.URL, 0, 0)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *StatusEmbedTestSuite) TestOEmbedGet() {
+	ctx := suite.T().Context()
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	oEmbed, errWithCode := suite.status.OEmbedGet(ctx, targetStatus.URL, 300, 0)
+	suite.NoError(errWithCode)
+	suite.Equal("rich", oEmbed.Type)
+	suite.Equal("1.0", oEmbed.Version)
+	suite.Equal("http://localhost:8080/@the_mighty_zork", oEmbed.AuthorURL)
+	suite.Equal("localhost:8080", oEmbed.ProviderName)
+	suite.Equal(300, oEmbed.Width)
+	suite.Nil(oEmbed.Height)
+	suite.Equal(`<iframe src="http://localhost:8080/embed/01F8MHAMCHF6Y650WCRSCP4WMY" class="gotosocial-embed" style="max-width: 100%; border: 0" width="300" sandbox="allow-popups allow-popups-to-escape-sandbox" loading="lazy"></iframe>`, oEmbed.HTML)
+}
+
+func TestStatusEmbedTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusEmbedTestSuite))
+}
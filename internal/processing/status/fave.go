@@ -182,7 +182,7 @@ func (p *Processor) FaveCreate(
 		// must target a status with an interaction
 		// policy that requires approval for faves.
 		// Queue up Create LikeRequest side effects.
-		p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 			APObjectType:   ap.ActivityLikeRequest,
 			APActivityType: ap.ActivityCreate,
 			GTSModel:       gtsFave,
@@ -192,7 +192,7 @@ func (p *Processor) FaveCreate(
 	} else {
 		// "Normal" fave with no explicit approval
 		// required, queue Create Like side effects.
-		p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 			APObjectType:   ap.ActivityLike,
 			APActivityType: ap.ActivityCreate,
 			GTSModel:       gtsFave,
@@ -223,7 +223,7 @@ func (p *Processor) FaveRemove(ctx context.Context, requestingAccount *gtsmodel.
 	}
 
 	// Process remove status fave side effects.
-	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+	p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 		APObjectType:   ap.ActivityLike,
 		APActivityType: ap.ActivityUndo,
 		GTSModel:       existingFave,
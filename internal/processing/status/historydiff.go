@@ -0,0 +1,250 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"slices"
+	"unicode"
+	"unicode/utf8"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/text"
+)
+
+const (
+	diffOpEqual  = "equal"
+	diffOpInsert = "insert"
+	diffOpDelete = "delete"
+
+	// diffMaxCells is the maximum size of the LCS table
+	// we're willing to allocate when diffing two revisions.
+	// Beyond this the changed region is simply returned as
+	// one deletion followed by one insertion.
+	diffMaxCells = 1 << 20
+)
+
+// HistoryDiffGet gets computed diffs between consecutive revisions
+// of the target status, ordered from oldest to newest, taking
+// account of privacy settings and blocks etc.
+func (p *Processor) HistoryDiffGet(ctx context.Context, requester *gtsmodel.Account, targetStatusID string) ([]*apimodel.StatusEditDiff, gtserror.WithCode) {
+	editHistory, errWithCode := p.HistoryGet(ctx, requester, targetStatusID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if len(editHistory) < 2 {
+		// Never edited,
+		// nothing to diff.
+		return []*apimodel.StatusEditDiff{}, nil
+	}
+
+	diffs := make([]*apimodel.StatusEditDiff, 0, len(editHistory)-1)
+	for i := 1; i < len(editHistory); i++ {
+		diffs = append(diffs, diffStatusEdits(
+			editHistory[i-1],
+			editHistory[i],
+		))
+	}
+
+	return diffs, nil
+}
+
+// diffStatusEdits computes the changes between
+// revision 'from' and the following revision 'to'.
+func diffStatusEdits(from, to *apimodel.StatusEdit) *apimodel.StatusEditDiff {
+	added, removed := diffAttachmentIDs(
+		from.MediaAttachments,
+		to.MediaAttachments,
+	)
+
+	return &apimodel.StatusEditDiff{
+		FromCreatedAt: from.CreatedAt,
+		ToCreatedAt:   to.CreatedAt,
+		Content: diffWords(
+			text.ParseHTMLToPlain(from.Content),
+			text.ParseHTMLToPlain(to.Content),
+		),
+		SpoilerText:             diffWords(from.SpoilerText, to.SpoilerText),
+		SensitiveChanged:        from.Sensitive != to.Sensitive,
+		MediaAttachmentsAdded:   added,
+		MediaAttachmentsRemoved: removed,
+		PollChanged:             !pollOptionsEqual(from.Poll, to.Poll),
+	}
+}
+
+// diffAttachmentIDs returns IDs of attachments present
+// in 'to' but not 'from', and present in 'from' but not 'to'.
+func diffAttachmentIDs(from, to []*apimodel.Attachment) (added, removed []string) {
+	hasID := func(attachs []*apimodel.Attachment, id string) bool {
+		return slices.ContainsFunc(attachs, func(a *apimodel.Attachment) bool {
+			return a.ID == id
+		})
+	}
+
+	added, removed = []string{}, []string{}
+
+	for _, a := range to {
+		if !hasID(from, a.ID) {
+			added = append(added, a.ID)
+		}
+	}
+
+	for _, a := range from {
+		if !hasID(to, a.ID) {
+			removed = append(removed, a.ID)
+		}
+	}
+
+	return added, removed
+}
+
+// pollOptionsEqual returns whether polls
+// a and b have the same option titles.
+func pollOptionsEqual(a, b *apimodel.Poll) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return slices.EqualFunc(a.Options, b.Options,
+		func(x, y apimodel.PollOption) bool {
+			return x.Title == y.Title
+		},
+	)
+}
+
+// diffWords computes a word-level diff transforming a into b.
+// Whitespace is kept as its own token, so concatenating the
+// 'equal' and 'delete' segments reproduces a, and concatenating
+// the 'equal' and 'insert' segments reproduces b.
+func diffWords(a, b string) []apimodel.DiffSegment {
+	at, bt := splitWords(a), splitWords(b)
+	segs := make([]apimodel.DiffSegment, 0, 4)
+
+	// Appends token to segs, merging it
+	// into the last segment if ops match.
+	appendTok := func(op, tok string) {
+		if l := len(segs); l > 0 && segs[l-1].Op == op {
+			segs[l-1].Text += tok
+			return
+		}
+		segs = append(segs, apimodel.DiffSegment{Op: op, Text: tok})
+	}
+
+	// Trim common prefix + suffix so the
+	// LCS only has to cover the changed region.
+	var pre int
+	for pre < len(at) && pre < len(bt) && at[pre] == bt[pre] {
+		pre++
+	}
+
+	var suf int
+	for suf < len(at)-pre && suf < len(bt)-pre &&
+		at[len(at)-1-suf] == bt[len(bt)-1-suf] {
+		suf++
+	}
+
+	for _, tok := range at[:pre] {
+		appendTok(diffOpEqual, tok)
+	}
+
+	am := at[pre : len(at)-suf]
+	bm := bt[pre : len(bt)-suf]
+
+	if len(am)*len(bm) > diffMaxCells {
+		// Too large to diff in detail,
+		// replace the region wholesale.
+		for _, tok := range am {
+			appendTok(diffOpDelete, tok)
+		}
+		for _, tok := range bm {
+			appendTok(diffOpInsert, tok)
+		}
+	} else {
+		// lcs[i*w+j] holds length of the longest
+		// common subsequence of am[i:] and bm[j:].
+		w := len(bm) + 1
+		lcs := make([]int32, (len(am)+1)*w)
+		for i := len(am) - 1; i >= 0; i-- {
+			for j := len(bm) - 1; j >= 0; j-- {
+				if am[i] == bm[j] {
+					lcs[i*w+j] = lcs[(i+1)*w+j+1] + 1
+				} else {
+					lcs[i*w+j] = max(lcs[(i+1)*w+j], lcs[i*w+j+1])
+				}
+			}
+		}
+
+		var i, j int
+		for i < len(am) && j < len(bm) {
+			switch {
+			case am[i] == bm[j]:
+				appendTok(diffOpEqual, am[i])
+				i++
+				j++
+			case lcs[(i+1)*w+j] >= lcs[i*w+j+1]:
+				appendTok(diffOpDelete, am[i])
+				i++
+			default:
+				appendTok(diffOpInsert, bm[j])
+				j++
+			}
+		}
+		for ; i < len(am); i++ {
+			appendTok(diffOpDelete, am[i])
+		}
+		for ; j < len(bm); j++ {
+			appendTok(diffOpInsert, bm[j])
+		}
+	}
+
+	for _, tok := range at[len(at)-suf:] {
+		appendTok(diffOpEqual, tok)
+	}
+
+	return segs
+}
+
+// splitWords splits s into alternating
+// runs of whitespace and non-whitespace.
+func splitWords(s string) []string {
+	var (
+		toks  []string
+		start int
+	)
+
+	for i, r := range s {
+		if i == start {
+			continue
+		}
+
+		prev, _ := utf8.DecodeLastRuneInString(s[:i])
+		if unicode.IsSpace(prev) != unicode.IsSpace(r) {
+			toks = append(toks, s[start:i])
+			start = i
+		}
+	}
+
+	if start < len(s) {
+		toks = append(toks, s[start:])
+	}
+
+	return toks
+}
@@ -0,0 +1,100 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"net/http"
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"github.com/stretchr/testify/suite"
+)
+
+type StatusHistoryDiffTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusHistoryDiffTestSuite) TestHistoryDiff() {
+	var (
+		ctx          = suite.T().Context()
+		requester    = suite.testAccounts["local_account_1"]
+		targetStatus = suite.testStatuses["local_account_1_status_9"]
+	)
+
+	diffs, errWithCode := suite.status.HistoryDiffGet(ctx, requester, targetStatus.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Original + 2 edits = 2 diffs.
+	suite.Len(diffs, 2)
+
+	first := diffs[0]
+	suite.Equal([]apimodel.DiffSegment{
+		{Op: "equal", Text: "this is the "},
+		{Op: "delete", Text: "original"},
+		{Op: "insert", Text: "first"},
+		{Op: "equal", Text: " status"},
+		{Op: "insert", Text: " edit! now with content-warning"},
+	}, first.Content)
+	suite.Equal([]apimodel.DiffSegment{
+		{Op: "insert", Text: "edited status"},
+	}, first.SpoilerText)
+	suite.False(first.SensitiveChanged)
+	suite.False(first.PollChanged)
+	suite.Empty(first.MediaAttachmentsAdded)
+	suite.Empty(first.MediaAttachmentsRemoved)
+
+	// Second diff should carry spoiler
+	// text through unchanged, and each
+	// diff should chain onto the last.
+	second := diffs[1]
+	suite.Equal([]apimodel.DiffSegment{
+		{Op: "equal", Text: "edited status"},
+	}, second.SpoilerText)
+	suite.Equal(first.ToCreatedAt, second.FromCreatedAt)
+}
+
+func (suite *StatusHistoryDiffTestSuite) TestHistoryDiffNotEdited() {
+	var (
+		ctx          = suite.T().Context()
+		requester    = suite.testAccounts["local_account_1"]
+		targetStatus = suite.testStatuses["local_account_1_status_1"]
+	)
+
+	diffs, errWithCode := suite.status.HistoryDiffGet(ctx, requester, targetStatus.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(diffs)
+}
+
+func (suite *StatusHistoryDiffTestSuite) TestHistoryDiffNotFound() {
+	var (
+		ctx       = suite.T().Context()
+		requester = suite.testAccounts["local_account_1"]
+	)
+
+	_, errWithCode := suite.status.HistoryDiffGet(ctx, requester, "01HZZZZZZZZZZZZZZZZZZZZZZZ")
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestStatusHistoryDiffTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusHistoryDiffTestSuite))
+}
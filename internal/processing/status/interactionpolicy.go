@@ -0,0 +1,97 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
+	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
+)
+
+// InteractionPolicyUpdate replaces the interaction policy of the
+// given status, owned by requester, with the given policy, and
+// federates the change out to remote instances.
+//
+// The new policy only applies to interactions made after the
+// update; interactions already approved are left untouched.
+func (p *Processor) InteractionPolicyUpdate(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	statusID string,
+	policy *apimodel.InteractionPolicy,
+) (*apimodel.Status, gtserror.WithCode) {
+	if policy == nil {
+		const text = "interaction_policy must be set"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	// Fetch status and ensure it's owned by requesting account.
+	status, errWithCode := p.c.GetOwnStatus(ctx, requester, statusID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Ensure this isn't a boost.
+	if status.BoostOfID != "" {
+		return nil, gtserror.NewErrorNotFound(
+			errors.New("status is a boost wrapper"),
+			"target status not found",
+		)
+	}
+
+	// Convert the policy, ensuring it's
+	// feasible for the status visibility.
+	interactionPolicy, err := typeutils.APIInteractionPolicyToInteractionPolicy(
+		policy,
+		typeutils.VisToAPIVis(status.Visibility),
+	)
+	if err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if !status.InteractionPolicy.DifferentFrom(interactionPolicy) {
+		// Nothing to
+		// update, return.
+		return p.c.GetAPIStatus(ctx, requester, status)
+	}
+
+	// Update the status model in the database.
+	status.InteractionPolicy = interactionPolicy
+	if err := p.state.DB.UpdateStatus(ctx, status, "interaction_policy"); err != nil {
+		err := gtserror.Newf("error updating status in db: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Send it to the client API worker for async side-effects,
+	// ie., federating the updated policy to remote instances.
+	p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
+		APObjectType:   ap.ObjectInteractionPolicy,
+		APActivityType: ap.ActivityUpdate,
+		GTSModel:       status,
+		Origin:         requester,
+	})
+
+	// Return an API model of the updated status.
+	return p.c.GetAPIStatus(ctx, requester, status)
+}
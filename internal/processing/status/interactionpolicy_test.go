@@ -0,0 +1,107 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"github.com/stretchr/testify/suite"
+)
+
+type StatusInteractionPolicyTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusInteractionPolicyTestSuite) TestUpdateInteractionPolicy() {
+	// Create cancellable context to use for test.
+	ctx, cncl := context.WithCancel(suite.T().Context())
+	defer cncl()
+
+	// Get a local account to use as test requester.
+	requester := suite.testAccounts["local_account_1"]
+	requester, _ = suite.state.DB.GetAccountByID(ctx, requester.ID)
+
+	// Get requester's existing public status to update policy of.
+	status := suite.testStatuses["local_account_1_status_1"]
+	status, _ = suite.state.DB.GetStatusByID(ctx, status.ID)
+
+	// Only allow followers to reply,
+	// and require approval for boosts.
+	policy := &apimodel.InteractionPolicy{
+		CanFavourite: apimodel.PolicyRules{
+			AutomaticApproval: []apimodel.PolicyValue{apimodel.PolicyValuePublic},
+		},
+		CanReply: apimodel.PolicyRules{
+			AutomaticApproval: []apimodel.PolicyValue{apimodel.PolicyValueFollowers},
+		},
+		CanReblog: apimodel.PolicyRules{
+			ManualApproval: []apimodel.PolicyValue{apimodel.PolicyValuePublic},
+		},
+	}
+
+	apiStatus, errWithCode := suite.status.InteractionPolicyUpdate(ctx, requester, status.ID, policy)
+	suite.NoError(errWithCode)
+	suite.NotNil(apiStatus)
+
+	// Fetch the latest version of status from the database.
+	latestStatus, err := suite.state.DB.GetStatusByID(ctx, status.ID)
+	suite.NoError(err)
+
+	// Check stored policy against input policy, noting
+	// that "author" and "mentioned" are always included.
+	suite.Equal(
+		gtsmodel.PolicyValues{
+			gtsmodel.PolicyValueFollowers,
+			gtsmodel.PolicyValueAuthor,
+			gtsmodel.PolicyValueMentioned,
+		},
+		latestStatus.InteractionPolicy.CanReply.AutomaticApproval,
+	)
+	suite.Equal(
+		gtsmodel.PolicyValues{gtsmodel.PolicyValuePublic},
+		latestStatus.InteractionPolicy.CanAnnounce.ManualApproval,
+	)
+
+	// Policy update isn't an edit.
+	suite.Equal(len(status.EditIDs), len(latestStatus.EditIDs))
+}
+
+func (suite *StatusInteractionPolicyTestSuite) TestUpdateInteractionPolicyNil() {
+	// Create cancellable context to use for test.
+	ctx, cncl := context.WithCancel(suite.T().Context())
+	defer cncl()
+
+	// Get a local account to use as test requester.
+	requester := suite.testAccounts["local_account_1"]
+	requester, _ = suite.state.DB.GetAccountByID(ctx, requester.ID)
+
+	// Get requester's existing status to update policy of.
+	status := suite.testStatuses["local_account_1_status_1"]
+
+	apiStatus, errWithCode := suite.status.InteractionPolicyUpdate(ctx, requester, status.ID, nil)
+	suite.Nil(apiStatus)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func TestStatusInteractionPolicyTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusInteractionPolicyTestSuite))
+}
@@ -23,9 +23,11 @@
 	"fmt"
 	"time"
 
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
 )
 
 const allowedPinnedCount = 10
@@ -115,6 +117,14 @@ func (p *Processor) PinCreate(ctx context.Context, requestingAccount *gtsmodel.A
 	// account model before returning it.
 	*requestingAccount.Stats.StatusesPinnedCount++
 
+	// Federate the new pin (Add to featured) asynchronously.
+	p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityAdd,
+		GTSModel:       targetStatus,
+		Origin:         requestingAccount,
+	})
+
 	return p.c.GetAPIStatus(ctx, requestingAccount, targetStatus)
 }
 
@@ -166,5 +176,13 @@ func (p *Processor) PinRemove(ctx context.Context, requestingAccount *gtsmodel.A
 		*requestingAccount.Stats.StatusesPinnedCount = 0
 	}
 
+	// Federate the unpin (Remove from featured) asynchronously.
+	p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityRemove,
+		GTSModel:       targetStatus,
+		Origin:         requestingAccount,
+	})
+
 	return p.c.GetAPIStatus(ctx, requestingAccount, targetStatus)
 }
@@ -0,0 +1,294 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
+	"code.superseriousbusiness.org/gotosocial/internal/regexes"
+	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
+	"code.superseriousbusiness.org/gotosocial/internal/uris"
+)
+
+// getReactableStatus fetches the target status
+// visible to requester, unwrapping it if a boost.
+func (p *Processor) getReactableStatus(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetID string,
+) (*gtsmodel.Status, gtserror.WithCode) {
+	target, errWithCode := p.c.GetVisibleTargetStatus(
+		ctx,
+		requester,
+		targetID,
+		nil, // default freshness
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.c.UnwrapIfBoost(ctx, requester, target)
+}
+
+// parseReactionName parses the given reaction name, which
+// may be either a single unicode emoji, or the shortcode of
+// a custom emoji known to this instance, optionally followed
+// by @domain for remote emoji. It returns the content to use
+// for the reaction, and the custom emoji used, if any.
+func (p *Processor) parseReactionName(
+	ctx context.Context,
+	name string,
+) (
+	string,
+	*gtsmodel.Emoji,
+	gtserror.WithCode,
+) {
+	name = strings.TrimSpace(name)
+	if regexes.UnicodeEmojiValidator.MatchString(name) {
+		// Plain old unicode emoji.
+		return name, nil, nil
+	}
+
+	// Not unicode, so this must be
+	// a custom emoji, eg "blobcat",
+	// ":blobcat:" or "blobcat@example.org".
+	shortcode, domain, _ := strings.Cut(strings.Trim(name, ":"), "@")
+	if !regexes.EmojiValidator.MatchString(shortcode) {
+		const text = "reaction must be a single unicode emoji or a custom emoji shortcode"
+		return "", nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	emoji, err := p.state.DB.GetEmojiByShortcodeDomain(ctx, shortcode, domain)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting emoji %s: %w", name, err)
+		return "", nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if emoji == nil || *emoji.Disabled {
+		const text = "custom emoji not found"
+		return "", nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	return ":" + emoji.Shortcode + ":", emoji, nil
+}
+
+// ReactionAdd adds an emoji reaction from the requester
+// to the given status, using the given unicode or custom
+// emoji (no-op if the reaction already exists).
+func (p *Processor) ReactionAdd(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetStatusID string,
+	name string,
+) (*apimodel.Status, gtserror.WithCode) {
+	status, errWithCode := p.getReactableStatus(ctx, requester, targetStatusID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	content, emoji, errWithCode := p.parseReactionName(ctx, name)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	existing, err := p.state.DB.GetStatusReaction(ctx, requester.ID, status.ID, content)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error checking existing reaction: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if existing != nil {
+		// Already reacted with this emoji.
+		return p.c.GetAPIStatus(ctx, requester, status)
+	}
+
+	// Reactions are treated like faves for
+	// the purposes of interaction policy, but
+	// since there's no approval flow for them,
+	// only allow reactions that don't need it.
+	policyResult, err := p.intFilter.StatusLikeable(ctx,
+		requester,
+		status,
+	)
+	if err != nil {
+		err := gtserror.Newf("error seeing if status %s is likeable: %w", status.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if !policyResult.AutomaticApproval() {
+		const errText = "you do not have permission to react to this status"
+		err := gtserror.New(errText)
+		return nil, gtserror.NewErrorForbidden(err, errText)
+	}
+
+	reactionID := id.NewULID()
+	reaction := &gtsmodel.StatusReaction{
+		ID:              reactionID,
+		AccountID:       requester.ID,
+		Account:         requester,
+		TargetAccountID: status.AccountID,
+		TargetAccount:   status.Account,
+		StatusID:        status.ID,
+		Status:          status,
+		Content:         content,
+		URI:             uris.GenerateURIForReaction(requester.Username, reactionID),
+	}
+
+	if emoji != nil {
+		reaction.EmojiID = emoji.ID
+		reaction.Emoji = emoji
+	}
+
+	if err := p.state.DB.PutStatusReaction(ctx, reaction); err != nil {
+		err := gtserror.Newf("db error putting reaction: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Queue Create EmojiReact side effects.
+	p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
+		APObjectType:   ap.ActivityEmojiReact,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       reaction,
+		Origin:         requester,
+		Target:         status.Account,
+	})
+
+	return p.c.GetAPIStatus(ctx, requester, status)
+}
+
+// ReactionRemove removes an emoji reaction from the requester
+// to the given status, using the given unicode or custom emoji
+// (no-op if the reaction doesn't exist).
+func (p *Processor) ReactionRemove(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetStatusID string,
+	name string,
+) (*apimodel.Status, gtserror.WithCode) {
+	status, errWithCode := p.getReactableStatus(ctx, requester, targetStatusID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	content, _, errWithCode := p.parseReactionName(ctx, name)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	existing, err := p.state.DB.GetStatusReaction(ctx, requester.ID, status.ID, content)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error checking existing reaction: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if existing == nil {
+		// Not reacted with this emoji.
+		return p.c.GetAPIStatus(ctx, requester, status)
+	}
+
+	if err := p.state.DB.DeleteStatusReactionByID(ctx, existing.ID); err != nil {
+		err := gtserror.Newf("db error deleting reaction: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Queue Undo EmojiReact side effects.
+	p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
+		APObjectType:   ap.ActivityEmojiReact,
+		APActivityType: ap.ActivityUndo,
+		GTSModel:       existing,
+		Origin:         requester,
+		Target:         status.Account,
+	})
+
+	return p.c.GetAPIStatus(ctx, requester, status)
+}
+
+// ReactionsGet returns the emoji reactions to the given status,
+// grouped by emoji, including the accounts that reacted with each.
+// Accounts that block or are blocked by the requester are omitted.
+func (p *Processor) ReactionsGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetStatusID string,
+) ([]*apimodel.StatusReaction, gtserror.WithCode) {
+	status, errWithCode := p.c.GetVisibleTargetStatus(ctx,
+		requester,
+		targetStatusID,
+		nil, // default freshness
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	reactions, err := p.state.DB.GetStatusReactions(ctx, status.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting reactions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiReactions := make([]*apimodel.StatusReaction, 0, len(reactions))
+	indices := make(map[string]int, len(reactions))
+	for _, reaction := range reactions {
+		blocked, err := p.state.DB.IsEitherBlocked(ctx, requester.ID, reaction.AccountID)
+		if err != nil {
+			err := gtserror.Newf("db error checking blocks: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if blocked || reaction.Account == nil {
+			continue
+		}
+
+		apiAccount, err := p.converter.AccountToAPIAccountPublic(ctx, reaction.Account)
+		if err != nil {
+			err := gtserror.Newf("error converting account %s: %w", reaction.AccountID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		name := typeutils.StatusReactionName(reaction)
+		i, ok := indices[name]
+		if !ok {
+			// First reaction with
+			// this emoji, add a group.
+			apiReaction := &apimodel.StatusReaction{Name: name}
+			if reaction.Emoji != nil {
+				apiReaction.URL = reaction.Emoji.ImageURL
+				apiReaction.StaticURL = reaction.Emoji.ImageStaticURL
+			}
+
+			i = len(apiReactions)
+			indices[name] = i
+			apiReactions = append(apiReactions, apiReaction)
+		}
+
+		apiReactions[i].Count++
+		apiReactions[i].Me = apiReactions[i].Me || reaction.AccountID == requester.ID
+		apiReactions[i].Accounts = append(apiReactions[i].Accounts, apiAccount)
+	}
+
+	return apiReactions, nil
+}
@@ -0,0 +1,109 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"net/http"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/stretchr/testify/suite"
+)
+
+type StatusReactionTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusReactionTestSuite) TestReactUnicode() {
+	ctx := suite.T().Context()
+
+	requester := suite.testAccounts["local_account_1"]
+	target := suite.testStatuses["admin_account_status_1"]
+
+	apiStatus, errWithCode := suite.status.ReactionAdd(ctx, requester, target.ID, "🦥")
+	suite.NoError(errWithCode)
+	suite.Len(apiStatus.EmojiReactions, 1)
+	suite.Equal("🦥", apiStatus.EmojiReactions[0].Name)
+	suite.Equal(1, apiStatus.EmojiReactions[0].Count)
+	suite.True(apiStatus.EmojiReactions[0].Me)
+	suite.Empty(apiStatus.EmojiReactions[0].URL)
+
+	// Reacting again with the same emoji is a no-op.
+	apiStatus, errWithCode = suite.status.ReactionAdd(ctx, requester, target.ID, "🦥")
+	suite.NoError(errWithCode)
+	suite.Len(apiStatus.EmojiReactions, 1)
+	suite.Equal(1, apiStatus.EmojiReactions[0].Count)
+
+	// Another account's view shouldn't say "me".
+	apiStatus, errWithCode = suite.status.Get(ctx, suite.testAccounts["local_account_2"], target.ID)
+	suite.NoError(errWithCode)
+	suite.Len(apiStatus.EmojiReactions, 1)
+	suite.False(apiStatus.EmojiReactions[0].Me)
+
+	apiStatus, errWithCode = suite.status.ReactionRemove(ctx, requester, target.ID, "🦥")
+	suite.NoError(errWithCode)
+	suite.Empty(apiStatus.EmojiReactions)
+}
+
+func (suite *StatusReactionTestSuite) TestReactCustomEmoji() {
+	ctx := suite.T().Context()
+
+	target := suite.testStatuses["admin_account_status_1"]
+	emoji := testrig.NewTestEmojis()["rainbow"]
+
+	for _, name := range []string{"rainbow", ":rainbow:"} {
+		_, errWithCode := suite.status.ReactionAdd(ctx, suite.testAccounts["local_account_1"], target.ID, name)
+		suite.NoError(errWithCode)
+	}
+
+	_, errWithCode := suite.status.ReactionAdd(ctx, suite.testAccounts["local_account_2"], target.ID, "rainbow")
+	suite.NoError(errWithCode)
+
+	apiReactions, errWithCode := suite.status.ReactionsGet(ctx, suite.testAccounts["admin_account"], target.ID)
+	suite.NoError(errWithCode)
+	suite.Len(apiReactions, 1)
+	suite.Equal("rainbow", apiReactions[0].Name)
+	suite.Equal(2, apiReactions[0].Count)
+	suite.False(apiReactions[0].Me)
+	suite.Equal(emoji.ImageURL, apiReactions[0].URL)
+	suite.Equal(emoji.ImageStaticURL, apiReactions[0].StaticURL)
+	suite.Len(apiReactions[0].Accounts, 2)
+}
+
+func (suite *StatusReactionTestSuite) TestReactInvalid() {
+	ctx := suite.T().Context()
+
+	requester := suite.testAccounts["local_account_1"]
+	target := suite.testStatuses["admin_account_status_1"]
+
+	for name, code := range map[string]int{
+		"not an emoji":    http.StatusBadRequest,
+		"🦥🦥":              http.StatusBadRequest,
+		"does_not_exist":  http.StatusNotFound,
+		"rainbow@foo.bar": http.StatusNotFound,
+	} {
+		_, errWithCode := suite.status.ReactionAdd(ctx, requester, target.ID, name)
+		if suite.Error(errWithCode, name) {
+			suite.Equal(code, errWithCode.Code(), name)
+		}
+	}
+}
+
+func TestStatusReactionTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusReactionTestSuite))
+}
@@ -0,0 +1,87 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
+)
+
+// Restore processes the restore of a given status from the trash,
+// returning the restored status if the restore goes through.
+//
+// Only statuses belonging to the requester, which were
+// deleted within the statuses trash window, can be restored.
+func (p *Processor) Restore(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetStatusID string,
+) (*apimodel.Status, gtserror.WithCode) {
+	targetStatus, err := p.state.DB.GetStatusByID(ctx, targetStatusID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting status %s: %w", targetStatusID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if targetStatus == nil ||
+		targetStatus.AccountID != requester.ID ||
+		!targetStatus.InTrash() {
+		// Status doesn't exist, isn't
+		// ours, or isn't in the trash.
+		const text = "status not found in trash"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	if time.Since(targetStatus.DeletedAt) >= config.GetStatusesTrashWindow() {
+		// Status is due to be permanently
+		// deleted, it's too late to restore.
+		const text = "status trash window has passed"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	// Take status out of the trash.
+	targetStatus.DeletedAt = time.Time{}
+	if err := p.state.DB.UpdateStatus(ctx,
+		targetStatus,
+		"deleted_at",
+	); err != nil {
+		err := gtserror.Newf("db error restoring status from trash: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Process restore side effects,
+	// ie., undoing the status delete.
+	p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityUndo,
+		GTSModel:       targetStatus,
+		Origin:         requester,
+		Target:         requester,
+	})
+
+	return p.c.GetAPIStatus(ctx, requester, targetStatus)
+}
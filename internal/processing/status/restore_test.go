@@ -0,0 +1,135 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"github.com/stretchr/testify/suite"
+)
+
+type StatusRestoreTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusRestoreTestSuite) TestDeleteAndRestore() {
+	var (
+		ctx          = suite.T().Context()
+		requester    = suite.testAccounts["local_account_1"]
+		targetStatus = suite.testStatuses["local_account_1_status_1"]
+	)
+
+	// Enable the trash.
+	config.SetStatusesTrashWindow(time.Hour)
+
+	// Delete the status.
+	if _, errWithCode := suite.status.Delete(ctx, requester, targetStatus.ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Status should be in the trash.
+	dbStatus, err := suite.db.GetStatusByID(ctx, targetStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(dbStatus.InTrash())
+
+	// Status shouldn't be visible,
+	// even to its own author.
+	_, errWithCode := suite.status.Get(ctx, requester, targetStatus.ID)
+	suite.NotNil(errWithCode)
+
+	// Restore the status.
+	apiStatus, errWithCode := suite.status.Restore(ctx, requester, targetStatus.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(targetStatus.ID, apiStatus.ID)
+
+	// Status should be out of the trash.
+	dbStatus, err = suite.db.GetStatusByID(ctx, targetStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(dbStatus.InTrash())
+
+	// Status can't be restored twice.
+	_, errWithCode = suite.status.Restore(ctx, requester, targetStatus.ID)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *StatusRestoreTestSuite) TestRestoreWindowPassed() {
+	var (
+		ctx          = suite.T().Context()
+		requester    = suite.testAccounts["local_account_1"]
+		targetStatus = suite.testStatuses["local_account_1_status_1"]
+	)
+
+	// Enable the trash.
+	config.SetStatusesTrashWindow(time.Hour)
+
+	// Put status in the trash two hours ago.
+	dbStatus, err := suite.db.GetStatusByID(ctx, targetStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	dbStatus.DeletedAt = time.Now().Add(-2 * time.Hour)
+	if err := suite.db.UpdateStatus(ctx, dbStatus, "deleted_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Trash window has passed so
+	// status should not be restorable.
+	_, errWithCode := suite.status.Restore(ctx, requester, targetStatus.ID)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *StatusRestoreTestSuite) TestRestoreNotOwn() {
+	var (
+		ctx          = suite.T().Context()
+		requester    = suite.testAccounts["local_account_2"]
+		targetStatus = suite.testStatuses["local_account_1_status_1"]
+	)
+
+	// Enable the trash.
+	config.SetStatusesTrashWindow(time.Hour)
+
+	// Put status in the trash.
+	dbStatus, err := suite.db.GetStatusByID(ctx, targetStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	dbStatus.DeletedAt = time.Now()
+	if err := suite.db.UpdateStatus(ctx, dbStatus, "deleted_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Someone else's status can't be restored.
+	_, errWithCode := suite.status.Restore(ctx, requester, targetStatus.ID)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestStatusRestoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusRestoreTestSuite))
+}
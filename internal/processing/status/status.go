@@ -18,6 +18,8 @@
 package status
 
 import (
+	"sync"
+
 	"code.superseriousbusiness.org/gotosocial/internal/federation"
 	"code.superseriousbusiness.org/gotosocial/internal/filter/interaction"
 	"code.superseriousbusiness.org/gotosocial/internal/filter/visibility"
@@ -45,6 +47,10 @@ type Processor struct {
 	// other processors
 	polls   *polls.Processor
 	intReqs *interactionrequests.Processor
+
+	// serializes starting
+	// of bulk deletions.
+	bulkDeleteMu *sync.Mutex
 }
 
 // New returns a new status processor.
@@ -70,5 +76,6 @@ func New(
 		parseMention: parseMention,
 		polls:        polls,
 		intReqs:      intReqs,
+		bulkDeleteMu: new(sync.Mutex),
 	}
 }
@@ -0,0 +1,156 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/cache"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/translate"
+	"code.superseriousbusiness.org/gotosocial/internal/validate"
+)
+
+// Translate translates the content of the target status into the given
+// language (defaulting to requester's posting language), using the
+// configured translation backend. Only public and unlisted statuses may
+// be translated, to avoid leaking private content to external services.
+func (p *Processor) Translate(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetStatusID string,
+	lang string,
+) (*apimodel.Translation, gtserror.WithCode) {
+	if !p.state.Translator.Enabled() {
+		const text = "translation not enabled on this instance"
+		return nil, gtserror.NewErrorNotImplemented(errors.New(text), text)
+	}
+
+	if lang == "" && requester.Settings != nil {
+		// Default to requester's posting language.
+		lang = requester.Settings.Language
+	}
+
+	lang, err := validate.Language(lang)
+	if err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	target, errWithCode := p.c.GetVisibleTargetStatus(ctx,
+		requester,
+		targetStatusID,
+		nil, // default freshness
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if target.Visibility != gtsmodel.VisibilityPublic &&
+		target.Visibility != gtsmodel.VisibilityUnlocked {
+		const text = "only public or unlisted statuses can be translated"
+		return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	if target.Language != "" && baseLang(target.Language) == baseLang(lang) {
+		const text = "status is already in target language"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	// Check for an up-to-date cached translation.
+	key := cache.TranslationKey(target.ID, lang)
+	cached, ok := p.state.Caches.Translation.Get(key)
+	if ok && cached.StatusEditedAt.Equal(target.EditedAt) {
+		return cached.Translation, nil
+	}
+
+	// Gather all translatable texts of the status,
+	// in order: content, content warning, poll
+	// options, then media attachment descriptions.
+	texts := []string{target.Content, target.ContentWarning}
+	if target.Poll != nil {
+		texts = append(texts, target.Poll.Options...)
+	}
+	for _, attachment := range target.Attachments {
+		texts = append(texts, attachment.Description)
+	}
+
+	res, err := p.state.Translator.Translate(ctx,
+		texts,
+		target.Language,
+		lang,
+	)
+	switch {
+	case errors.Is(err, translate.ErrRateLimited):
+		const text = "translation rate limit reached, try again later"
+		return nil, gtserror.NewWithCode(http.StatusTooManyRequests, text)
+
+	case err != nil:
+		err := gtserror.Newf("error translating status %s: %w", target.ID, err)
+		return nil, gtserror.NewErrorBadGateway(err, "error contacting translation service")
+	}
+
+	translation := &apimodel.Translation{
+		Content:                res.Texts[0],
+		SpoilerText:            res.Texts[1],
+		MediaAttachments:       make([]apimodel.TranslationAttachment, 0, len(target.Attachments)),
+		DetectedSourceLanguage: res.DetectedSource,
+		Language:               lang,
+		Provider:               p.state.Translator.Provider(),
+	}
+	texts = res.Texts[2:]
+
+	if target.Poll != nil {
+		translation.Poll = &apimodel.TranslationPoll{
+			ID:      target.Poll.ID,
+			Options: make([]apimodel.TranslationPollOption, len(target.Poll.Options)),
+		}
+		for i := range target.Poll.Options {
+			translation.Poll.Options[i].Title = texts[i]
+		}
+		texts = texts[len(target.Poll.Options):]
+	}
+
+	for i, attachment := range target.Attachments {
+		translation.MediaAttachments = append(translation.MediaAttachments,
+			apimodel.TranslationAttachment{
+				ID:          attachment.ID,
+				Description: texts[i],
+			},
+		)
+	}
+
+	// Cache translation against current status edit time.
+	p.state.Caches.Translation.Set(key, &cache.CachedTranslation{
+		StatusEditedAt: target.EditedAt,
+		Translation:    translation,
+	})
+
+	return translation, nil
+}
+
+// baseLang returns the base language
+// of given BCP47 tag, e.g. "en-US" -> "en".
+func baseLang(tag string) string {
+	base, _, _ := strings.Cut(tag, "-")
+	return strings.ToLower(base)
+}
@@ -42,5 +42,6 @@ func (p *Processor) Notify(ctx context.Context, account *gtsmodel.Account, notif
 			stream.TimelineNotifications,
 			stream.TimelineHome,
 		},
+		NotificationType: notif.Type,
 	})
 }
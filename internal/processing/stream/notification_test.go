@@ -89,6 +89,43 @@ func (suite *NotificationTestSuite) TestStreamNotification() {
 }`, dst.String())
 }
 
+func (suite *NotificationTestSuite) TestStreamNotificationFiltered() {
+	account := suite.testAccounts["local_account_1"]
+
+	openStream, errWithCode := suite.streamProcessor.Open(suite.T().Context(), account, "user")
+	suite.NoError(errWithCode)
+
+	// Don't stream faves.
+	openStream.FilterNotifications(nil, []string{"favourite"})
+
+	for _, notification := range []*apimodel.Notification{
+		{ID: "01FH57SJCMDWQGEAJ0X08CE3WV", Type: "favourite"},
+		{ID: "01FH57SJCMDWQGEAJ0X08CE3WW", Type: "follow"},
+	} {
+		suite.streamProcessor.Notify(suite.T().Context(), account, notification)
+	}
+
+	// Only the follow should be streamed.
+	msg, ok := openStream.Recv(suite.T().Context())
+	suite.True(ok)
+	suite.Contains(msg.Payload, `"type":"follow"`)
+
+	// Stream only faves.
+	openStream.FilterNotifications([]string{"favourite"}, nil)
+
+	for _, notification := range []*apimodel.Notification{
+		{ID: "01FH57SJCMDWQGEAJ0X08CE3WX", Type: "follow"},
+		{ID: "01FH57SJCMDWQGEAJ0X08CE3WY", Type: "favourite"},
+	} {
+		suite.streamProcessor.Notify(suite.T().Context(), account, notification)
+	}
+
+	// Only the fave should be streamed.
+	msg, ok = openStream.Recv(suite.T().Context())
+	suite.True(ok)
+	suite.Contains(msg.Payload, `"type":"favourite"`)
+}
+
 func TestNotificationTestSuite(t *testing.T) {
 	suite.Run(t, &NotificationTestSuite{})
 }
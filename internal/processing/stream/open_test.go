@@ -34,6 +34,22 @@ func (suite *OpenStreamTestSuite) TestOpenStream() {
 	suite.NoError(errWithCode)
 }
 
+func (suite *OpenStreamTestSuite) TestStreamingAccountIDs() {
+	account := suite.testAccounts["local_account_1"]
+	suite.Empty(suite.streamProcessor.StreamingAccountIDs())
+
+	stream, errWithCode := suite.streamProcessor.Open(suite.T().Context(), account, "user")
+	suite.NoError(errWithCode)
+	suite.Equal([]string{account.ID}, suite.streamProcessor.StreamingAccountIDs())
+	suite.True(suite.streamProcessor.IsStreaming(account.ID))
+
+	// Account should be dropped once
+	// its only open stream is closed.
+	stream.Close()
+	suite.Empty(suite.streamProcessor.StreamingAccountIDs())
+	suite.False(suite.streamProcessor.IsStreaming(account.ID))
+}
+
 func TestOpenStreamTestSuite(t *testing.T) {
 	suite.Run(t, &OpenStreamTestSuite{})
 }
@@ -30,6 +30,12 @@
 
 // StatusUpdate streams the given edited status to any open, appropriate streams belonging to the given account.
 func (p *Processor) StatusUpdate(ctx context.Context, account *gtsmodel.Account, status *apimodel.Status, streamType string) {
+	if status == nil {
+		// Status could not be prepared
+		// for account, nothing to send.
+		return
+	}
+
 	b, err := json.Marshal(status)
 	if err != nil {
 		log.Errorf(ctx, "error marshaling json: %v", err)
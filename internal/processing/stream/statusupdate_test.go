@@ -131,6 +131,7 @@ func (suite *StatusUpdateTestSuite) TestStreamNotification() {
   "mentions": [],
   "tags": [],
   "emojis": [],
+  "emoji_reactions": [],
   "card": null,
   "poll": null,
   "interaction_policy": {
@@ -36,3 +36,15 @@ func New(state *state.State, oauthServer oauth.Server) Processor {
 		streams:     stream.Streams{},
 	}
 }
+
+// IsStreaming returns whether the account with
+// given ID has at least one currently open stream.
+func (p *Processor) IsStreaming(accountID string) bool {
+	return p.streams.Streaming(accountID)
+}
+
+// StreamingAccountIDs returns the IDs of all
+// accounts with at least one currently open stream.
+func (p *Processor) StreamingAccountIDs() []string {
+	return p.streams.AccountIDs()
+}
@@ -30,6 +30,12 @@
 
 // Update streams the given update to any open, appropriate streams belonging to the given account.
 func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, status *apimodel.Status, streamType string) {
+	if status == nil {
+		// Status could not be prepared
+		// for account, nothing to send.
+		return
+	}
+
 	b, err := json.Marshal(status)
 	if err != nil {
 		log.Errorf(ctx, "error marshaling json: %v", err)
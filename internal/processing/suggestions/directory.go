@@ -0,0 +1,202 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package suggestions
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/transport"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// directoryDerefInterval is the minimum time
+// between account dereferences when consuming
+// the directory, so as not to hammer remotes.
+const directoryDerefInterval = time.Second
+
+// ScheduleDirectorySync schedules publishing
+// to and consuming from the configured instance
+// directory, if one is set. Else no-op.
+func (p *Processor) ScheduleDirectorySync() {
+	if config.GetInstanceDirectoryURL() == "" {
+		// Not configured.
+		return
+	}
+
+	syncEvery := config.GetInstanceDirectorySyncEvery()
+
+	log.Infof(nil,
+		"scheduling instance directory sync to run every %s",
+		syncEvery,
+	)
+
+	// Schedule the sync to run soon after startup, then regularly.
+	if !p.state.Workers.Scheduler.AddRecurring(
+		"@directorysync",
+		time.Now().Add(time.Minute),
+		syncEvery,
+		func(ctx context.Context, start time.Time) {
+			log.Info(ctx, "starting instance directory sync")
+			if err := p.DirectorySync(ctx); err != nil {
+				log.Errorf(ctx, "error syncing instance directory: %v", err)
+			}
+			log.Infof(ctx, "finished instance directory sync after %s", time.Since(start))
+		},
+	) {
+		panic("failed to schedule @directorysync")
+	}
+}
+
+// DirectorySync publishes opted-in local accounts to the
+// configured instance directory, and then consumes accounts
+// listed in the directory for use as follow suggestions.
+func (p *Processor) DirectorySync(ctx context.Context) error {
+	directoryURL := config.GetInstanceDirectoryURL()
+	if directoryURL == "" {
+		// Not configured.
+		return nil
+	}
+
+	// Use the instance account transport
+	// to sign outgoing directory requests.
+	tsport, err := p.federator.TransportController().NewTransportForUsername(ctx, "")
+	if err != nil {
+		return gtserror.Newf("error getting transport: %w", err)
+	}
+
+	// Publishing failures shouldn't
+	// stop us from consuming the
+	// directory, so just log them.
+	if err := p.publishDirectory(ctx, tsport, directoryURL); err != nil {
+		log.Errorf(ctx, "error publishing to instance directory: %v", err)
+	}
+
+	return p.consumeDirectory(ctx, tsport, directoryURL)
+}
+
+func (p *Processor) publishDirectory(
+	ctx context.Context,
+	tsport transport.Transport,
+	directoryURL string,
+) error {
+	accounts, err := p.state.DB.GetDirectoryAccounts(ctx,
+		config.GetInstanceDirectoryMaxAccounts(),
+	)
+	if err != nil {
+		return gtserror.Newf("db error getting directory accounts: %w", err)
+	}
+
+	// Always publish, even if we have no
+	// accounts, so that accounts which have
+	// since opted out are removed from directory.
+	dir := &transport.Directory{
+		Host:     config.GetHost(),
+		Accounts: make([]transport.DirectoryAccount, 0, len(accounts)),
+	}
+
+	for _, account := range accounts {
+		dir.Accounts = append(dir.Accounts, transport.DirectoryAccount{
+			URI:         account.URI,
+			URL:         account.URL,
+			Username:    account.Username,
+			DisplayName: account.DisplayName,
+			Note:        account.Note,
+			Locked:      util.PtrOrZero(account.Locked),
+			Bot:         account.ActorType.IsBot(),
+			CreatedAt:   account.CreatedAt,
+		})
+	}
+
+	return tsport.PublishDirectory(ctx, directoryURL, dir)
+}
+
+func (p *Processor) consumeDirectory(
+	ctx context.Context,
+	tsport transport.Transport,
+	directoryURL string,
+) error {
+	dir, err := tsport.DereferenceDirectory(ctx, directoryURL)
+	if err != nil {
+		return gtserror.Newf("error dereferencing directory: %w", err)
+	}
+
+	var (
+		maxAccounts = config.GetInstanceDirectoryMaxAccounts()
+		accountIDs  = make([]string, 0, min(maxAccounts, len(dir.Accounts)))
+		host        = config.GetHost()
+	)
+
+	// Pace dereferences.
+	ticker := time.NewTicker(directoryDerefInterval)
+	defer ticker.Stop()
+
+	for _, entry := range dir.Accounts {
+		if len(accountIDs) >= maxAccounts {
+			break
+		}
+
+		uri, err := url.Parse(entry.URI)
+		if err != nil || (uri.Scheme != "https" && uri.Scheme != "http") {
+			log.Debugf(ctx, "skipping invalid directory account uri %q", entry.URI)
+			continue
+		}
+
+		if uri.Host == host {
+			// Skip our own accounts,
+			// they're already known.
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		// Fetch + update the account as the instance
+		// account. This also takes care of domain blocks.
+		account, _, err := p.federator.GetAccountByURI(
+			gtscontext.SetFastFail(ctx),
+			"",
+			uri,
+			false,
+		)
+		if err != nil {
+			log.Debugf(ctx, "error dereferencing directory account %s: %v", uri, err)
+			continue
+		}
+
+		// Only include accounts which are
+		// (still) discoverable and not suspended.
+		if account.IsSuspended() || !util.PtrOrZero(account.Discoverable) {
+			continue
+		}
+
+		accountIDs = append(accountIDs, account.ID)
+	}
+
+	// Replace previously consumed directory.
+	p.directory.Store(&accountIDs)
+	return nil
+}
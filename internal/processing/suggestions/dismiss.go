@@ -0,0 +1,54 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package suggestions
+
+import (
+	"context"
+	"errors"
+
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// SuggestionDismiss removes the account with given ID from
+// the requester's follow suggestions, and ensures it is
+// not suggested to the requester again in future.
+func (p *Processor) SuggestionDismiss(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetAccountID string,
+) gtserror.WithCode {
+	target, err := p.state.DB.GetAccountByID(ctx, targetAccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting account %s: %w", targetAccountID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if target == nil {
+		err := gtserror.Newf("account %s not found", targetAccountID)
+		return gtserror.NewErrorNotFound(err)
+	}
+
+	if err := p.state.DB.DismissSuggestion(ctx, requester.ID, target.ID); err != nil {
+		err := gtserror.Newf("db error dismissing suggestion: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package suggestions
+
+import (
+	"context"
+	"errors"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// SuggestionsGet returns up to limit follow suggestions for the requester,
+// drawn first from suggestions computed from the requester's social graph,
+// and then from accounts consumed from the configured instance directory.
+//
+// Accounts the requester already follows (or has requested to follow),
+// blocks, mutes, has dismissed, or cannot see are not included.
+func (p *Processor) SuggestionsGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	limit int,
+) ([]*apimodel.Suggestion, gtserror.WithCode) {
+	suggestions := make([]*apimodel.Suggestion, 0, limit)
+	seen := make(map[string]struct{}, limit)
+
+	// add appends the given account as a suggestion
+	// with given sources, if not already included
+	// and if it may be suggested to the requester.
+	add := func(account *gtsmodel.Account, source string, sources []string) {
+		if _, ok := seen[account.ID]; ok {
+			return
+		}
+		seen[account.ID] = struct{}{}
+
+		ok, err := p.suggestible(ctx, requester, account)
+		if err != nil {
+			log.Errorf(ctx, "error checking suggestibility of %s: %v", account.URI, err)
+			return
+		}
+
+		if !ok {
+			return
+		}
+
+		apiAccount, err := p.converter.AccountToAPIAccountPublic(ctx, account)
+		if err != nil {
+			log.Errorf(ctx, "error converting account %s: %v", account.URI, err)
+			return
+		}
+
+		suggestions = append(suggestions, &apimodel.Suggestion{
+			Source:  source,
+			Sources: sources,
+			Account: apiAccount,
+		})
+	}
+
+	// Start with suggestions computed from the
+	// social graph, these are most personal.
+	stored, err := p.state.DB.GetSuggestions(ctx, requester.ID, limit)
+	if err != nil {
+		err := gtserror.Newf("db error getting suggestions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	for _, suggestion := range stored {
+		if len(suggestions) >= limit {
+			return suggestions, nil
+		}
+
+		source := "global"
+		if suggestion.Sources.Has(gtsmodel.SuggestionSourceInteractions) {
+			source = "past_interactions"
+		}
+
+		add(suggestion.TargetAccount, source, suggestion.Sources.Strings())
+	}
+
+	ids := p.directory.Load()
+	if ids == nil || len(*ids) == 0 {
+		// Nothing consumed
+		// (yet), or at all.
+		return suggestions, nil
+	}
+
+	// Fill up with accounts from the instance directory.
+	accounts, err := p.state.DB.GetAccountsByIDs(ctx, *ids)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting directory accounts: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	for _, account := range accounts {
+		if len(suggestions) >= limit {
+			break
+		}
+
+		add(account, "global", []string{"featured"})
+	}
+
+	return suggestions, nil
+}
+
+// suggestible returns whether given
+// account may be suggested to requester.
+func (p *Processor) suggestible(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	account *gtsmodel.Account,
+) (bool, error) {
+	if account.ID == requester.ID ||
+		account.IsSuspended() ||
+		account.IsMoving() {
+		return false, nil
+	}
+
+	if following, err := p.state.DB.IsFollowing(ctx, requester.ID, account.ID); err != nil || following {
+		return false, err
+	}
+
+	if requested, err := p.state.DB.IsFollowRequested(ctx, requester.ID, account.ID); err != nil || requested {
+		return false, err
+	}
+
+	if blocked, err := p.state.DB.IsEitherBlocked(ctx, requester.ID, account.ID); err != nil || blocked {
+		return false, err
+	}
+
+	if muted, err := p.state.DB.IsMuted(ctx, requester.ID, account.ID); err != nil || muted {
+		return false, err
+	}
+
+	if dismissed, err := p.state.DB.IsSuggestionDismissed(ctx, requester.ID, account.ID); err != nil || dismissed {
+		return false, err
+	}
+
+	return p.visFilter.AccountVisible(ctx, requester, account)
+}
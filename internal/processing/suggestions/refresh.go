@@ -0,0 +1,210 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package suggestions
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+const (
+	// refreshEvery is how often follow suggestions
+	// are recomputed from the social graph.
+	refreshEvery = 24 * time.Hour
+
+	// refreshLookback is how far back to look for
+	// interactions and hashtag use when computing
+	// follow suggestions from the social graph.
+	refreshLookback = 30 * 24 * time.Hour
+
+	// candidatesMax is the maximum number of candidate
+	// accounts to consider from each suggestion source.
+	candidatesMax = 200
+
+	// suggestionsMax is the maximum number of
+	// suggestions to store for each account.
+	suggestionsMax = 80
+)
+
+// sourceWeights are the weights given per-count to
+// candidates from each source when scoring suggestions.
+var sourceWeights = map[gtsmodel.SuggestionSource]int{
+	gtsmodel.SuggestionSourceFriendsOfFriends: 2,
+	gtsmodel.SuggestionSourceInteractions:     3,
+	gtsmodel.SuggestionSourceHashtags:         1,
+}
+
+// ScheduleSuggestionsRefresh schedules regular
+// recomputing of follow suggestions for all local
+// accounts, from the social graph.
+func (p *Processor) ScheduleSuggestionsRefresh() {
+	log.Infof(nil,
+		"scheduling follow suggestions refresh to run every %s",
+		refreshEvery,
+	)
+
+	// Schedule the refresh to run soon after startup, then regularly.
+	if !p.state.Workers.Scheduler.AddRecurring(
+		"@suggestionsrefresh",
+		time.Now().Add(5*time.Minute),
+		refreshEvery,
+		func(ctx context.Context, start time.Time) {
+			log.Info(ctx, "starting follow suggestions refresh")
+			if err := p.SuggestionsRefresh(ctx); err != nil {
+				log.Errorf(ctx, "error refreshing follow suggestions: %v", err)
+			}
+			log.Infof(ctx, "finished follow suggestions refresh after %s", time.Since(start))
+		},
+	) {
+		panic("failed to schedule @suggestionsrefresh")
+	}
+}
+
+// SuggestionsRefresh recomputes and stores follow
+// suggestions for all active local accounts.
+func (p *Processor) SuggestionsRefresh(ctx context.Context) error {
+	users, err := p.state.DB.GetAllUsers(ctx)
+	if err != nil {
+		return gtserror.Newf("db error getting users: %w", err)
+	}
+
+	for _, user := range users {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if user.Account == nil ||
+			user.Account.IsSuspended() ||
+			!util.PtrOrZero(user.Approved) ||
+			util.PtrOrZero(user.Disabled) {
+			// Not an active account,
+			// no need to suggest.
+			continue
+		}
+
+		if err := p.refreshSuggestions(ctx, user.Account); err != nil {
+			log.Errorf(ctx, "error refreshing suggestions for %s: %v", user.Account.Username, err)
+		}
+	}
+
+	return nil
+}
+
+// refreshSuggestions scores candidate accounts for given
+// account from each suggestion source, and replaces the
+// account's stored suggestions with the highest scoring.
+func (p *Processor) refreshSuggestions(ctx context.Context, account *gtsmodel.Account) error {
+	since := time.Now().Add(-refreshLookback)
+
+	fof, err := p.state.DB.CountFollowsOfFollows(ctx, account.ID, candidatesMax)
+	if err != nil {
+		return gtserror.Newf("db error counting follows of follows: %w", err)
+	}
+
+	interacted, err := p.state.DB.CountInteractedAccounts(ctx, account.ID, since, candidatesMax)
+	if err != nil {
+		return gtserror.Newf("db error counting interacted accounts: %w", err)
+	}
+
+	tagged, err := p.state.DB.CountTagOverlapAccounts(ctx, account.ID, since, candidatesMax)
+	if err != nil {
+		return gtserror.Newf("db error counting tag overlap accounts: %w", err)
+	}
+
+	// Score each candidate by weighted count from each source.
+	candidates := make(map[string]*gtsmodel.Suggestion, len(fof)+len(interacted)+len(tagged))
+	for src, counts := range map[gtsmodel.SuggestionSource]map[string]int{
+		gtsmodel.SuggestionSourceFriendsOfFriends: fof,
+		gtsmodel.SuggestionSourceInteractions:     interacted,
+		gtsmodel.SuggestionSourceHashtags:         tagged,
+	} {
+		for targetAccountID, count := range counts {
+			candidate, ok := candidates[targetAccountID]
+			if !ok {
+				candidate = &gtsmodel.Suggestion{
+					AccountID:       account.ID,
+					TargetAccountID: targetAccountID,
+				}
+				candidates[targetAccountID] = candidate
+			}
+			candidate.Score += count * sourceWeights[src]
+			candidate.Sources.Set(src)
+		}
+	}
+
+	targets, err := p.state.DB.GetAccountsByIDs(
+		gtscontext.SetBarebones(ctx),
+		slices.Collect(maps.Keys(candidates)),
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting candidate accounts: %w", err)
+	}
+
+	suggestions := make([]*gtsmodel.Suggestion, 0, min(len(targets), suggestionsMax))
+	for _, target := range targets {
+		candidate := candidates[target.ID]
+
+		// Only suggest accounts that haven't opted in to
+		// discovery if the account has interacted with them.
+		if !util.PtrOrZero(target.Discoverable) &&
+			!candidate.Sources.Has(gtsmodel.SuggestionSourceInteractions) {
+			continue
+		}
+
+		ok, err := p.suggestible(ctx, account, target)
+		if err != nil {
+			log.Errorf(ctx, "error checking suggestibility of %s: %v", target.URI, err)
+			continue
+		}
+
+		if !ok {
+			continue
+		}
+
+		suggestions = append(suggestions, candidate)
+	}
+
+	// Keep only the highest scoring suggestions.
+	slices.SortFunc(suggestions, func(a, b *gtsmodel.Suggestion) int {
+		return cmp.Or(
+			cmp.Compare(b.Score, a.Score),
+			cmp.Compare(a.TargetAccountID, b.TargetAccountID),
+		)
+	})
+	if len(suggestions) > suggestionsMax {
+		suggestions = suggestions[:suggestionsMax]
+	}
+
+	for _, suggestion := range suggestions {
+		suggestion.ID = id.NewULID()
+	}
+
+	return p.state.DB.ReplaceSuggestions(ctx, account.ID, suggestions)
+}
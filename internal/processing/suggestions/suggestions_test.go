@@ -0,0 +1,212 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package suggestions_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/filter/visibility"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/media"
+	"code.superseriousbusiness.org/gotosocial/internal/processing/suggestions"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"code.superseriousbusiness.org/gotosocial/internal/transport"
+	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/stretchr/testify/suite"
+)
+
+const directoryURL = "https://directory.example.org/accounts"
+
+type SuggestionsTestSuite struct {
+	suite.Suite
+	state       state.State
+	httpClient  *testrig.MockHTTPClient
+	suggestions suggestions.Processor
+
+	testAccounts map[string]*gtsmodel.Account
+}
+
+func (suite *SuggestionsTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+	suite.state.Caches.Init()
+	testrig.StartNoopWorkers(&suite.state)
+	testrig.NewTestDB(&suite.state)
+	testrig.StandardDBSetup(suite.state.DB, nil)
+	suite.testAccounts = testrig.NewTestAccounts()
+
+	converter := typeutils.NewConverter(&suite.state)
+	suite.httpClient = testrig.NewMockHTTPClient(nil, "../../../testrig/media")
+	controller := testrig.NewTestTransportController(&suite.state, suite.httpClient)
+	mediaMgr := media.NewManager(&suite.state)
+	federator := testrig.NewTestFederator(&suite.state, controller, mediaMgr)
+	visFilter := visibility.NewFilter(&suite.state)
+	suite.suggestions = suggestions.New(&suite.state, converter, federator, visFilter)
+}
+
+func (suite *SuggestionsTestSuite) TearDownTest() {
+	testrig.StopWorkers(&suite.state)
+	testrig.StandardDBTeardown(suite.state.DB)
+}
+
+func (suite *SuggestionsTestSuite) TestSuggestionsGetNoDirectory() {
+	ctx := suite.T().Context()
+	requester := suite.testAccounts["local_account_1"]
+
+	// No directory sync has
+	// run, so expect nothing.
+	suggestions, errWithCode := suite.suggestions.SuggestionsGet(ctx, requester, 40)
+	suite.NoError(errWithCode)
+	suite.Empty(suggestions)
+}
+
+func (suite *SuggestionsTestSuite) TestDirectorySync() {
+	ctx := suite.T().Context()
+	config.SetInstanceDirectoryURL(directoryURL)
+
+	// Opt zork in to the directory.
+	zork := suite.testAccounts["local_account_1"]
+	settings, err := suite.state.DB.GetAccountSettings(ctx, zork.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	settings.DirectoryOptIn = util.Ptr(true)
+	if err := suite.state.DB.UpdateAccountSettings(ctx, settings, "directory_opt_in"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.suggestions.DirectorySync(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Only zork should have been published;
+	// other local accounts haven't opted in.
+	sent, ok := suite.httpClient.SentMessages.Load(directoryURL)
+	if !ok {
+		suite.FailNow("expected directory to be published")
+	}
+
+	var dir transport.Directory
+	if err := json.Unmarshal(sent.([][]byte)[0], &dir); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(config.GetHost(), dir.Host)
+	if suite.Len(dir.Accounts, 1) {
+		suite.Equal(zork.URI, dir.Accounts[0].URI)
+		suite.Equal(zork.Username, dir.Accounts[0].Username)
+	}
+
+	// Our own (zork) and invalid entries should be skipped
+	// when consuming, leaving only the remote accounts.
+	suggestions, errWithCode := suite.suggestions.SuggestionsGet(ctx, zork, 40)
+	suite.NoError(errWithCode)
+
+	uris := make([]string, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		uris = append(uris, suggestion.Account.URL)
+	}
+	suite.ElementsMatch([]string{
+		"https://unknown-instance.com/@brand_new_person",
+		"http://fossbros-anonymous.io/@foss_satan",
+	}, uris)
+
+	// Limit should be respected.
+	suggestions, errWithCode = suite.suggestions.SuggestionsGet(ctx, zork, 1)
+	suite.NoError(errWithCode)
+	suite.Len(suggestions, 1)
+
+	// Once zork blocks foss_satan, they
+	// should no longer be suggested to zork.
+	if err := suite.state.DB.PutBlock(ctx, &gtsmodel.Block{
+		ID:              "01J9SRCJ4Q4C1NSAEG3CRZYKDZ",
+		URI:             "http://localhost:8080/users/the_mighty_zork/blocks/01J9SRCJ4Q4C1NSAEG3CRZYKDZ",
+		AccountID:       zork.ID,
+		TargetAccountID: suite.testAccounts["remote_account_1"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suggestions, errWithCode = suite.suggestions.SuggestionsGet(ctx, zork, 40)
+	suite.NoError(errWithCode)
+	if suite.Len(suggestions, 1) {
+		suite.Equal("https://unknown-instance.com/@brand_new_person", suggestions[0].Account.URL)
+	}
+}
+
+func (suite *SuggestionsTestSuite) TestSuggestionsRefresh() {
+	ctx := suite.T().Context()
+	zork := suite.testAccounts["local_account_1"]
+	turtle := suite.testAccounts["local_account_2"]
+	fossSatan := suite.testAccounts["remote_account_1"]
+
+	// Make foss_satan discoverable,
+	// and have turtle follow them.
+	fossSatan.Discoverable = util.Ptr(true)
+	if err := suite.state.DB.UpdateAccount(ctx, fossSatan, "discoverable"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.state.DB.PutFollow(ctx, &gtsmodel.Follow{
+		ID:              "01J9SRCJ4Q4C1NSAEG3CRZYKDZ",
+		URI:             "http://localhost:8080/users/1happyturtle/follow/01J9SRCJ4Q4C1NSAEG3CRZYKDZ",
+		AccountID:       turtle.ID,
+		TargetAccountID: fossSatan.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.suggestions.SuggestionsRefresh(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// zork follows turtle, so foss_satan
+	// should now be suggested to zork.
+	suggested := func() []string {
+		suggestions, errWithCode := suite.suggestions.SuggestionsGet(ctx, zork, 40)
+		suite.NoError(errWithCode)
+
+		var sources []string
+		for _, suggestion := range suggestions {
+			if suggestion.Account.ID == fossSatan.ID {
+				sources = suggestion.Sources
+			}
+		}
+		return sources
+	}
+	suite.Contains(suggested(), "friends_of_friends")
+
+	// Once dismissed, foss_satan should no longer
+	// be suggested to zork, even after a refresh.
+	if errWithCode := suite.suggestions.SuggestionDismiss(ctx, zork, fossSatan.ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(suggested())
+
+	if err := suite.suggestions.SuggestionsRefresh(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(suggested())
+}
+
+func TestSuggestionsTestSuite(t *testing.T) {
+	suite.Run(t, new(SuggestionsTestSuite))
+}
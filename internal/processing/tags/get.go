@@ -28,7 +28,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
 )
 
-// Get gets the tag with the given name, including whether it's followed by the given account.
+// Get gets the tag with the given name, including whether it's followed / muted by the given account.
 func (p *Processor) Get(
 	ctx context.Context,
 	account *gtsmodel.Account,
@@ -54,6 +54,14 @@ func (p *Processor) Get(
 		)
 	}
 
+	muting, err := p.state.DB.IsAccountMutingTag(ctx, account.ID, tag.ID)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(
+			gtserror.Newf("DB error checking whether account %s mutes tag %s: %w", account.ID, tag.ID, err),
+		)
+	}
+
 	apiTag := typeutils.TagToAPITag(tag, true, &following)
+	apiTag.Muting = &muting
 	return &apiTag, nil
 }
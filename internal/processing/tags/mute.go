@@ -0,0 +1,144 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package tags
+
+import (
+	"context"
+	"errors"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// Mute mutes the tag with the given name as the given account,
+// hiding statuses containing it from home and list timelines.
+// If there is no tag with that name, it creates a tag.
+func (p *Processor) Mute(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	name string,
+) (*apimodel.Tag, gtserror.WithCode) {
+	// Try to get an existing tag with that name.
+	tag, err := p.state.DB.GetTagByName(ctx, name)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(
+			gtserror.Newf("DB error getting tag with name %s: %w", name, err),
+		)
+	}
+
+	// If there is no such tag, create it.
+	if tag == nil {
+		tag = &gtsmodel.Tag{
+			ID:   id.NewULID(),
+			Name: name,
+		}
+		if err := p.state.DB.PutTag(ctx, tag); err != nil {
+			return nil, gtserror.NewErrorInternalError(
+				gtserror.Newf("DB error creating tag with name %s: %w", name, err),
+			)
+		}
+	}
+
+	// Mute the tag.
+	if err := p.state.DB.PutMutedTag(ctx, account.ID, tag.ID); err != nil {
+		return nil, gtserror.NewErrorInternalError(
+			gtserror.Newf("DB error muting tag %s: %w", tag.ID, err),
+		)
+	}
+
+	// Statuses are filtered before being
+	// cached in timelines, so clear these.
+	p.clearTimelines(ctx, account)
+
+	return p.apiMutedTag(ctx, account, tag, true)
+}
+
+// Unmute unmutes the tag with the given name as the given account.
+func (p *Processor) Unmute(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	name string,
+) (*apimodel.Tag, gtserror.WithCode) {
+	// Try to get an existing tag with that name.
+	tag, err := p.state.DB.GetTagByName(ctx, name)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(
+			gtserror.Newf("DB error getting tag with name %s: %w", name, err),
+		)
+	}
+	if tag == nil {
+		return nil, gtserror.NewErrorNotFound(
+			gtserror.Newf("couldn't find tag with name %s: %w", name, err),
+		)
+	}
+
+	// Unmute the tag.
+	if err := p.state.DB.DeleteMutedTag(ctx, account.ID, tag.ID); err != nil {
+		return nil, gtserror.NewErrorInternalError(
+			gtserror.Newf("DB error unmuting tag %s: %w", tag.ID, err),
+		)
+	}
+
+	// Clear timelines so that any previously
+	// hidden statuses get reloaded into them.
+	p.clearTimelines(ctx, account)
+
+	return p.apiMutedTag(ctx, account, tag, false)
+}
+
+// apiMutedTag converts tag to its API model,
+// including given muting and the follow status.
+func (p *Processor) apiMutedTag(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	tag *gtsmodel.Tag,
+	muting bool,
+) (*apimodel.Tag, gtserror.WithCode) {
+	following, err := p.state.DB.IsAccountFollowingTag(ctx, account.ID, tag.ID)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(
+			gtserror.Newf("DB error checking whether account %s follows tag %s: %w", account.ID, tag.ID, err),
+		)
+	}
+
+	apiTag := typeutils.TagToAPITag(tag, true, &following)
+	apiTag.Muting = util.Ptr(muting)
+	return &apiTag, nil
+}
+
+// clearTimelines clears the cached home
+// and list timelines owned by account.
+func (p *Processor) clearTimelines(ctx context.Context, account *gtsmodel.Account) {
+	p.state.Caches.Timelines.Home.Clear(account.ID)
+
+	listIDs, err := p.state.DB.GetListIDsByAccountID(ctx, account.ID)
+	if err != nil {
+		log.Errorf(ctx, "error getting lists for account %s: %v", account.ID, err)
+		return
+	}
+
+	for _, listID := range listIDs {
+		p.state.Caches.Timelines.List.Clear(listID)
+	}
+}
@@ -0,0 +1,137 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package timeline
+
+import (
+	"context"
+	"errors"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	antennafilter "code.superseriousbusiness.org/gotosocial/internal/filter/antenna"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+)
+
+// AntennaTimelineGet gets a pageable timeline of statuses
+// in the antenna timeline of ID by the requesting account.
+//
+// Antennas have no timeline table of their own; on cache
+// miss, pages of the public timeline are loaded from the
+// database and matched against the antenna's rules, while
+// new statuses are inserted as they arrive by surfacing.
+func (p *Processor) AntennaTimelineGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	antennaID string,
+	page *paging.Page,
+) (
+	*apimodel.PageableResponse,
+	gtserror.WithCode,
+) {
+	// Fetch the requested antenna with ID.
+	antenna, err := p.state.DB.GetAntennaByID(
+		gtscontext.SetBarebones(ctx),
+		antennaID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Check exists.
+	if antenna == nil {
+		const text = "antenna not found"
+		return nil, gtserror.NewErrorNotFound(
+			errors.New(text),
+			text,
+		)
+	}
+
+	// Check antenna owned by auth'd account.
+	if antenna.AccountID != requester.ID {
+		err := gtserror.New("antenna does not belong to account")
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	// Fetch status timeline for antenna.
+	return p.getStatusTimeline(ctx,
+
+		// Auth'd
+		// account.
+		requester,
+
+		// Keyed-by-antenna-ID, antenna timeline cache.
+		p.state.Caches.Timelines.Antenna.MustGet(antennaID),
+
+		// Current
+		// page.
+		page,
+
+		// Antenna timeline ID's endpoint.
+		"/api/v1/timelines/antenna/"+antennaID,
+
+		// No page
+		// query.
+		nil,
+
+		// Status filter context.
+		gtsmodel.FilterContextPublic,
+
+		// Database load function.
+		func(pg *paging.Page) (statuses []*gtsmodel.Status, err error) {
+			return p.state.DB.GetPublicTimeline(ctx, pg)
+		},
+
+		// Filtering function,
+		// i.e. filter before caching.
+		func(s *gtsmodel.Status) bool {
+
+			// Check status matches the antenna rules.
+			if !antennafilter.NewMatcher(s).Match(antenna) {
+				return true
+			}
+
+			// Check the visibility of passed status to requesting user.
+			ok, err := p.visFilter.StatusPublicTimelineable(ctx, requester, s)
+			if err != nil {
+				log.Errorf(ctx, "error checking status %s visibility: %v", s.URI, err)
+				return true // default assume not visible
+			} else if !ok {
+				return true
+			}
+
+			// Check if status been muted by requester from timelines.
+			muted, err := p.muteFilter.StatusMuted(ctx, requester, s)
+			if err != nil {
+				log.Errorf(ctx, "error checking status %s mutes: %v", s.URI, err)
+				return true // default assume muted
+			} else if muted {
+				return true
+			}
+
+			return false
+		},
+
+		// Post filtering funtion,
+		// i.e. filter after caching.
+		nil,
+	)
+}
@@ -105,6 +105,15 @@ func(s *gtsmodel.Status) bool {
 				return true
 			}
 
+			// Check if status contains a tag muted by requester.
+			muted, err = p.muteFilter.StatusTagMuted(ctx, requester, s)
+			if err != nil {
+				log.Errorf(ctx, "error checking status %s tag mutes: %v", s.URI, err)
+				return true // default assume muted
+			} else if muted {
+				return true
+			}
+
 			return false
 		},
 
@@ -126,6 +126,54 @@ func (suite *HomeTestSuite) TestHomeTimelineGetHideFiltered() {
 	suite.False(filteredStatusFound)
 }
 
+// A status containing a tag muted by the requester should not be in their home timeline.
+func (suite *HomeTestSuite) TestHomeTimelineGetHideTagMuted() {
+	var (
+		ctx          = suite.T().Context()
+		requester    = suite.testAccounts["local_account_1"]
+		mutedStatus  = suite.testStatuses["admin_account_status_1"]
+		limit        = 40
+		local        = false
+		containsMute = func() bool {
+			resp, errWithCode := suite.timeline.HomeTimelineGet(
+				ctx,
+				requester,
+				&paging.Page{
+					Max:   paging.MaxID(""),
+					Limit: limit,
+				},
+				local,
+			)
+			if errWithCode != nil {
+				suite.FailNow(errWithCode.Error())
+			}
+			for _, item := range resp.Items {
+				if item.(*apimodel.Status).ID == mutedStatus.ID {
+					return true
+				}
+			}
+			return false
+		}
+	)
+
+	// Make sure the status we're going to
+	// mute is in that section of the timeline.
+	if !containsMute() {
+		suite.FailNow("precondition failed: status we would mute isn't present in unmuted timeline")
+	}
+
+	// Clear the timeline to drop all cached statuses.
+	suite.state.Caches.Timelines.Home.Clear(requester.ID)
+
+	// Mute the tag contained in the status.
+	if err := suite.db.PutMutedTag(ctx, requester.ID, mutedStatus.TagIDs[0]); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// The status should now be hidden.
+	suite.False(containsMute())
+}
+
 func TestHomeTestSuite(t *testing.T) {
 	suite.Run(t, new(HomeTestSuite))
 }
@@ -20,6 +20,7 @@
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
@@ -28,6 +29,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
 )
 
 // ListTimelineGet gets a pageable timeline of statuses
@@ -91,7 +93,14 @@ func (p *Processor) ListTimelineGet(
 
 		// Database load function.
 		func(pg *paging.Page) (statuses []*gtsmodel.Status, err error) {
-			return p.state.DB.GetListTimeline(ctx, listID, pg)
+			statuses, err = p.state.DB.GetListTimeline(ctx, listID, pg)
+			if err != nil {
+				return nil, err
+			}
+
+			// Drop boosts from any members
+			// that have boosts hidden in list.
+			return p.dropHiddenListBoosts(ctx, listID, statuses)
 		},
 
 		// Filtering function,
@@ -116,6 +125,15 @@ func(s *gtsmodel.Status) bool {
 				return true
 			}
 
+			// Check if status contains a tag muted by requester.
+			muted, err = p.muteFilter.StatusTagMuted(ctx, requester, s)
+			if err != nil {
+				log.Errorf(ctx, "error checking status %s tag mutes: %v", s.URI, err)
+				return true // default assume muted
+			} else if muted {
+				return true
+			}
+
 			return false
 		},
 
@@ -124,3 +142,47 @@ func(s *gtsmodel.Status) bool {
 		nil,
 	)
 }
+
+// dropHiddenListBoosts drops any boosts from the given
+// list timeline statuses that were authored by members
+// of the list with ID, who have boosts hidden in that list.
+func (p *Processor) dropHiddenListBoosts(
+	ctx context.Context,
+	listID string,
+	statuses []*gtsmodel.Status,
+) ([]*gtsmodel.Status, error) {
+	// Get all entries in the list.
+	entries, err := p.state.DB.GetListEntries(ctx, listID)
+	if err != nil {
+		return nil, gtserror.Newf("error getting list entries: %w", err)
+	}
+
+	// Gather follow IDs of any
+	// entries with boosts hidden.
+	var followIDs []string
+	for _, entry := range entries {
+		if !*entry.ShowReblogs {
+			followIDs = append(followIDs, entry.FollowID)
+		}
+	}
+
+	if len(followIDs) == 0 {
+		// Nothing
+		// to drop.
+		return statuses, nil
+	}
+
+	// Fetch the follows to get the account IDs of these members.
+	follows, err := p.state.DB.GetFollowsByIDs(gtscontext.SetBarebones(ctx), followIDs)
+	if err != nil {
+		return nil, gtserror.Newf("error getting list follows: %w", err)
+	}
+
+	hidden := util.ToSetFunc(follows, func(follow *gtsmodel.Follow) string {
+		return follow.TargetAccountID
+	})
+
+	return slices.DeleteFunc(statuses, func(s *gtsmodel.Status) bool {
+		return s.BoostOfID != "" && hidden.Has(s.AccountID)
+	}), nil
+}
@@ -68,76 +68,11 @@ func (p *Processor) NotificationsGet(
 	)
 
 	for _, n := range notifs {
-		visible, err := p.notifVisible(ctx, n, requester)
-		if err != nil {
-			log.Debugf(ctx, "skipping notification %s because of an error checking notification visibility: %v", n.ID, err)
-			continue
-		}
-
-		if !visible {
-			continue
-		}
-
-		// Check whether notification origin account is muted.
-		muted, err := p.muteFilter.AccountNotificationsMuted(ctx,
-			requester.ID,
-			n.OriginAccountID,
-		)
-		if err != nil {
-			log.Errorf(ctx, "error checking account mute: %v", err)
-			continue
-		}
-
-		if muted {
+		item := p.prepareNotif(ctx, requester, n)
+		if item == nil {
 			continue
 		}
 
-		var filtered []apimodel.FilterResult
-
-		if n.Status != nil {
-			var hide bool
-
-			// Check whether notification status is muted by requester.
-			muted, err = p.muteFilter.StatusNotificationsMuted(ctx,
-				requester,
-				n.Status,
-			)
-			if err != nil {
-				log.Errorf(ctx, "error checking status mute: %v", err)
-				continue
-			}
-
-			if muted {
-				continue
-			}
-
-			// Check whether notification status is filtered by requester in notifs.
-			filtered, hide, err = p.statusFilter.StatusFilterResultsInContext(ctx,
-				requester,
-				n.Status,
-				gtsmodel.FilterContextNotifications,
-			)
-			if err != nil {
-				log.Errorf(ctx, "error checking status filtering: %v", err)
-				continue
-			}
-
-			if hide {
-				continue
-			}
-		}
-
-		item, err := p.converter.NotificationToAPINotification(ctx, n)
-		if err != nil {
-			continue
-		}
-
-		if item.Status != nil {
-			// Set filter results on status,
-			// in case any were set above.
-			item.Status.Filtered = filtered
-		}
-
 		items = append(items, item)
 	}
 
@@ -201,6 +136,88 @@ func (p *Processor) NotificationsClear(ctx context.Context, authed *apiutil.Auth
 	return nil
 }
 
+// prepareNotif checks whether the given notification
+// should be shown to the requester in notification
+// timelines, returning it converted to its API model
+// if so, or nil if the notification should be skipped.
+func (p *Processor) prepareNotif(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	n *gtsmodel.Notification,
+) *apimodel.Notification {
+	visible, err := p.notifVisible(ctx, n, requester)
+	if err != nil {
+		log.Debugf(ctx, "skipping notification %s because of an error checking notification visibility: %v", n.ID, err)
+		return nil
+	}
+
+	if !visible {
+		return nil
+	}
+
+	// Check whether notification origin account is muted.
+	muted, err := p.muteFilter.AccountNotificationsMuted(ctx,
+		requester.ID,
+		n.OriginAccountID,
+	)
+	if err != nil {
+		log.Errorf(ctx, "error checking account mute: %v", err)
+		return nil
+	}
+
+	if muted {
+		return nil
+	}
+
+	var filtered []apimodel.FilterResult
+
+	if n.Status != nil {
+		var hide bool
+
+		// Check whether notification status is muted by requester.
+		muted, err = p.muteFilter.StatusNotificationsMuted(ctx,
+			requester,
+			n.Status,
+		)
+		if err != nil {
+			log.Errorf(ctx, "error checking status mute: %v", err)
+			return nil
+		}
+
+		if muted {
+			return nil
+		}
+
+		// Check whether notification status is filtered by requester in notifs.
+		filtered, hide, err = p.statusFilter.StatusFilterResultsInContext(ctx,
+			requester,
+			n.Status,
+			gtsmodel.FilterContextNotifications,
+		)
+		if err != nil {
+			log.Errorf(ctx, "error checking status filtering: %v", err)
+			return nil
+		}
+
+		if hide {
+			return nil
+		}
+	}
+
+	item, err := p.converter.NotificationToAPINotification(ctx, n)
+	if err != nil {
+		return nil
+	}
+
+	if item.Status != nil {
+		// Set filter results on status,
+		// in case any were set above.
+		item.Status.Filtered = filtered
+	}
+
+	return item
+}
+
 func (p *Processor) notifVisible(
 	ctx context.Context,
 	n *gtsmodel.Notification,
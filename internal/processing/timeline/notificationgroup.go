@@ -0,0 +1,490 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package timeline
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+	"strings"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+const (
+	// maxNotifGroupBatches is the maximum number of batches
+	// of notifications to fetch from the database when
+	// trying to fill a page of grouped notifications.
+	maxNotifGroupBatches = 4
+
+	// maxNotifGroupSampleAccounts is the maximum
+	// number of sample accounts to include for
+	// a group in grouped notifications results.
+	maxNotifGroupSampleAccounts = 8
+
+	// maxNotifGroupAccounts is the maximum number
+	// of accounts to return for a notification group.
+	maxNotifGroupAccounts = 80
+)
+
+// notifGroup is a group of
+// prepared notifications, newest
+// first, and their API models.
+type notifGroup struct {
+	key       string
+	notifs    []*gtsmodel.Notification
+	apiNotifs []*apimodel.Notification
+}
+
+// add adds the given notification and
+// its API model to this notification group.
+func (g *notifGroup) add(n *gtsmodel.Notification, apiNotif *apimodel.Notification) {
+	g.notifs = append(g.notifs, n)
+	g.apiNotifs = append(g.apiNotifs, apiNotif)
+}
+
+// notifGroupKey returns the key of the notification group
+// that the given notification should be returned in, given
+// the notification types the caller would like grouped.
+// If groupedTypes is nil, all grouped notification types
+// are returned in their groups.
+func notifGroupKey(n *gtsmodel.Notification, groupedTypes []gtsmodel.NotificationType) string {
+	if groupedTypes != nil &&
+		!slices.Contains(groupedTypes, n.NotificationType) {
+		// Caller doesn't want these grouped.
+		return gtsmodel.NotificationUngroupedPrefix + n.ID
+	}
+	return n.GetGroupKey()
+}
+
+// NotificationGroupsGet returns a page of notifications targeting
+// the requester, grouped by their notification group key. The page
+// limit is applied to the number of groups returned, not to the
+// number of notifications, and each group is returned in the page
+// containing its most recent notification. Paging values for the
+// next and previous pages are notification IDs.
+func (p *Processor) NotificationGroupsGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	page *paging.Page,
+	types []gtsmodel.NotificationType,
+	excludeTypes []gtsmodel.NotificationType,
+	groupedTypes []gtsmodel.NotificationType,
+) (*apimodel.GroupedNotificationsResponse, gtserror.WithCode) {
+	var (
+		limit  = page.GetLimit()
+		groups []*notifGroup
+
+		// Groups by key, and keys of
+		// groups skipped as they were
+		// already returned in a newer page.
+		byKey   = make(map[string]*notifGroup)
+		skipped = make(map[string]struct{})
+
+		// The lowest and highest
+		// notification IDs consumed,
+		// used for paging.
+		lo, hi string
+
+		// Current batch page.
+		batch = page
+	)
+
+batches:
+	for i := 0; i < maxNotifGroupBatches; i++ {
+		notifs, err := p.state.DB.GetAccountNotifications(ctx,
+			requester.ID,
+			batch,
+			types,
+			excludeTypes,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting notifications: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		for _, n := range notifs {
+			key := notifGroupKey(n, groupedTypes)
+			group := byKey[key]
+
+			if group == nil && limit > 0 && len(groups) == limit {
+				// Page is full, leave the rest
+				// of the notifications unconsumed.
+				break batches
+			}
+
+			// Consume notification.
+			if lo == "" || n.ID < lo {
+				lo = n.ID
+			}
+			if hi == "" || n.ID > hi {
+				hi = n.ID
+			}
+
+			if _, ok := skipped[key]; ok {
+				continue
+			}
+
+			if group == nil && page.GetMax() != "" &&
+				!strings.HasPrefix(key, gtsmodel.NotificationUngroupedPrefix) {
+				// Check whether this group has newer notifications
+				// above the page maximum, in which case the group
+				// was already returned in a newer page.
+				newer, err := p.state.DB.GetNotificationGroup(ctx,
+					requester.ID,
+					key,
+					&paging.Page{Min: paging.MinID(n.ID), Limit: 1},
+				)
+				if err != nil && !errors.Is(err, db.ErrNoEntries) {
+					err := gtserror.Newf("db error getting notification group: %w", err)
+					return nil, gtserror.NewErrorInternalError(err)
+				}
+
+				if len(newer) != 0 {
+					skipped[key] = struct{}{}
+					continue
+				}
+			}
+
+			apiNotif := p.prepareNotif(ctx, requester, n)
+			if apiNotif == nil {
+				continue
+			}
+
+			if group == nil {
+				group = &notifGroup{key: key}
+				byKey[key] = group
+				groups = append(groups, group)
+			}
+
+			group.add(n, apiNotif)
+		}
+
+		if limit == 0 || len(notifs) < limit ||
+			page.GetOrder() == paging.OrderAscending {
+			// Either there's nothing more
+			// to fetch, or we don't fetch
+			// further batches when paging up.
+			break
+		}
+
+		// Fetch next (older) batch.
+		batch = &paging.Page{
+			Min:   page.Min,
+			Max:   paging.MaxID(lo),
+			Limit: limit,
+		}
+	}
+
+	results, errWithCode := p.notifGroupsToAPI(ctx, requester, groups)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if lo == "" {
+		// Nothing consumed,
+		// so no paging links.
+		return &apimodel.GroupedNotificationsResponse{
+			Results: results,
+		}, nil
+	}
+
+	// Build type query string.
+	query := make(url.Values)
+	for _, typ := range types {
+		query.Add("types[]", typ.String())
+	}
+	for _, typ := range excludeTypes {
+		query.Add("exclude_types[]", typ.String())
+	}
+	for _, typ := range groupedTypes {
+		query.Add("grouped_types[]", typ.String())
+	}
+
+	resp := paging.PackageResponse(paging.ResponseParams{
+		Path:  "/api/v2/notifications",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+		Query: query,
+	})
+
+	return &apimodel.GroupedNotificationsResponse{
+		Results:    results,
+		LinkHeader: resp.LinkHeader,
+	}, nil
+}
+
+// NotificationGroupGet returns the notification group with
+// the given key targeting the requester, including up to
+// maxNotifGroupSampleAccounts of its most recent notifications.
+func (p *Processor) NotificationGroupGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	groupKey string,
+) (*apimodel.GroupedNotificationsResults, gtserror.WithCode) {
+	notifs, errWithCode := p.getNotifGroup(ctx,
+		requester,
+		groupKey,
+		maxNotifGroupSampleAccounts,
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// NOTE: as with notifications fetched
+	// directly by ID, we don't do any
+	// filtering or mute checking here.
+	group := &notifGroup{key: groupKey}
+	for _, n := range notifs {
+		apiNotif, err := p.converter.NotificationToAPINotification(ctx, n)
+		if err != nil {
+			err := gtserror.Newf("error converting to api model: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		group.add(n, apiNotif)
+	}
+
+	return p.notifGroupsToAPI(ctx, requester, []*notifGroup{group})
+}
+
+// NotificationGroupAccountsGet returns the accounts that
+// performed the actions which generated notifications in
+// the notification group with the given key, newest first.
+func (p *Processor) NotificationGroupAccountsGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	groupKey string,
+) ([]*apimodel.Account, gtserror.WithCode) {
+	notifs, errWithCode := p.getNotifGroup(ctx,
+		requester,
+		groupKey,
+		maxNotifGroupAccounts,
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	accounts := make([]*apimodel.Account, 0, len(notifs))
+	for _, n := range notifs {
+		account, err := p.converter.AccountToAPIAccountPublic(ctx, n.OriginAccount)
+		if err != nil {
+			err := gtserror.Newf("error converting account to api: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
+
+// NotificationGroupDismiss deletes all notifications
+// in the notification group with the given key
+// targeting the requester.
+func (p *Processor) NotificationGroupDismiss(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	groupKey string,
+) gtserror.WithCode {
+	notifs, errWithCode := p.getNotifGroup(ctx, requester, groupKey, 1)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	var err error
+	if strings.HasPrefix(groupKey, gtsmodel.NotificationUngroupedPrefix) {
+		// Ungrouped, just delete the one notification.
+		err = p.state.DB.DeleteNotificationByID(ctx, notifs[0].ID)
+	} else {
+		err = p.state.DB.DeleteNotificationGroup(ctx, requester.ID, groupKey)
+	}
+
+	if err != nil {
+		err := gtserror.Newf("db error deleting notifications: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// getNotifGroup returns up to limit of the most recent
+// notifications in the notification group with the given
+// key targeting the requester, or a 404 if there are none.
+func (p *Processor) getNotifGroup(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	groupKey string,
+	limit int,
+) ([]*gtsmodel.Notification, gtserror.WithCode) {
+	var (
+		notifs []*gtsmodel.Notification
+		err    error
+	)
+
+	if notifID, ok := strings.CutPrefix(groupKey, gtsmodel.NotificationUngroupedPrefix); ok {
+		// Single ungrouped notification.
+		var notif *gtsmodel.Notification
+		notif, err = p.state.DB.GetNotificationByID(ctx, notifID)
+		if notif != nil && notif.TargetAccountID == requester.ID {
+			notifs = []*gtsmodel.Notification{notif}
+		}
+	} else {
+		notifs, err = p.state.DB.GetNotificationGroup(ctx,
+			requester.ID,
+			groupKey,
+			&paging.Page{Limit: limit},
+		)
+	}
+
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting notification group: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if len(notifs) == 0 {
+		const text = "notification group not found"
+		return nil, gtserror.NewErrorNotFound(
+			errors.New(text),
+			text,
+		)
+	}
+
+	return notifs, nil
+}
+
+// notifGroupsToAPI converts the given notification
+// groups to grouped notifications results, including
+// each referenced account and status only once.
+func (p *Processor) notifGroupsToAPI(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	groups []*notifGroup,
+) (*apimodel.GroupedNotificationsResults, gtserror.WithCode) {
+	var (
+		results = &apimodel.GroupedNotificationsResults{
+			Accounts:           make([]*apimodel.Account, 0),
+			Statuses:           make([]*apimodel.Status, 0),
+			NotificationGroups: make([]*apimodel.NotificationGroup, 0, len(groups)),
+		}
+
+		// IDs of accounts and statuses
+		// already added to the results.
+		accountIDs = make(map[string]struct{})
+		statusIDs  = make(map[string]struct{})
+	)
+
+	addAccount := func(group *apimodel.NotificationGroup, account *apimodel.Account) {
+		if slices.Contains(group.SampleAccountIDs, account.ID) {
+			return
+		}
+
+		group.SampleAccountIDs = append(group.SampleAccountIDs, account.ID)
+
+		if _, ok := accountIDs[account.ID]; !ok {
+			accountIDs[account.ID] = struct{}{}
+			results.Accounts = append(results.Accounts, account)
+		}
+	}
+
+	for _, g := range groups {
+		var (
+			newest = g.notifs[0]
+			oldest = g.notifs[len(g.notifs)-1]
+		)
+
+		group := &apimodel.NotificationGroup{
+			GroupKey:                 g.key,
+			NotificationsCount:       len(g.notifs),
+			Type:                     newest.NotificationType.String(),
+			MostRecentNotificationID: newest.ID,
+			PageMinID:                oldest.ID,
+			PageMaxID:                newest.ID,
+			LatestPageNotificationAt: util.FormatISO8601(newest.CreatedAt),
+			SampleAccountIDs:         make([]string, 0, maxNotifGroupSampleAccounts),
+		}
+
+		for _, apiNotif := range g.apiNotifs {
+			if len(group.SampleAccountIDs) == maxNotifGroupSampleAccounts {
+				break
+			}
+			addAccount(group, apiNotif.Account)
+		}
+
+		if !strings.HasPrefix(g.key, gtsmodel.NotificationUngroupedPrefix) {
+			// Notifications in this group may extend
+			// beyond the current page, get total count.
+			count, err := p.state.DB.CountNotificationGroup(ctx,
+				requester.ID,
+				g.key,
+			)
+			if err != nil {
+				err := gtserror.Newf("db error counting notification group: %w", err)
+				return nil, gtserror.NewErrorInternalError(err)
+			}
+
+			if count > group.NotificationsCount {
+				group.NotificationsCount = count
+
+				if len(group.SampleAccountIDs) < maxNotifGroupSampleAccounts {
+					// Fill up sample accounts from
+					// older notifications in this group.
+					older, err := p.state.DB.GetNotificationGroup(ctx,
+						requester.ID,
+						g.key,
+						&paging.Page{
+							Max:   paging.MaxID(oldest.ID),
+							Limit: maxNotifGroupSampleAccounts,
+						},
+					)
+					if err != nil && !errors.Is(err, db.ErrNoEntries) {
+						err := gtserror.Newf("db error getting notification group: %w", err)
+						return nil, gtserror.NewErrorInternalError(err)
+					}
+
+					for _, n := range older {
+						if len(group.SampleAccountIDs) == maxNotifGroupSampleAccounts {
+							break
+						}
+
+						if apiNotif := p.prepareNotif(ctx, requester, n); apiNotif != nil {
+							addAccount(group, apiNotif.Account)
+						}
+					}
+				}
+			}
+		}
+
+		if status := g.apiNotifs[0].Status; status != nil {
+			group.StatusID = status.ID
+
+			if _, ok := statusIDs[status.ID]; !ok {
+				statusIDs[status.ID] = struct{}{}
+				results.Statuses = append(results.Statuses, status)
+			}
+		}
+
+		results.NotificationGroups = append(results.NotificationGroups, group)
+	}
+
+	return results, nil
+}
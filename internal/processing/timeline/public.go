@@ -19,9 +19,12 @@
 
 import (
 	"context"
+	"net/url"
+	"strconv"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	timelinepkg "code.superseriousbusiness.org/gotosocial/internal/cache/timeline"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/paging"
@@ -31,16 +34,23 @@
 // for the given requesting account. It ensures that each status
 // in timeline is visible to the account before returning it.
 //
-// The local argument limits this to local-only statuses.
+// The local argument limits this to local-only statuses,
+// and a non-empty lang argument limits this to statuses
+// in the given (normalized BCP47) language, served from
+// a timeline cache specific to that language.
 func (p *Processor) PublicTimelineGet(
 	ctx context.Context,
 	requester *gtsmodel.Account,
 	page *paging.Page,
 	local bool,
+	lang string,
 ) (
 	*apimodel.PageableResponse,
 	gtserror.WithCode,
 ) {
+	if lang != "" {
+		return p.languageTimelineGet(ctx, requester, page, local, lang)
+	}
 	if local {
 		return p.localTimelineGet(ctx, requester, page)
 	}
@@ -182,3 +192,92 @@ func(s *gtsmodel.Status) bool {
 		},
 	)
 }
+
+func (p *Processor) languageTimelineGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	page *paging.Page,
+	local bool,
+	lang string,
+) (
+	*apimodel.PageableResponse,
+	gtserror.WithCode,
+) {
+	// Select the appropriate language-sharded
+	// timeline cache and database load function.
+	var (
+		cache    *timelinepkg.StatusTimeline
+		loadPage func(*paging.Page) ([]*gtsmodel.Status, error)
+	)
+	if local {
+		cache = p.state.Caches.Timelines.LocalLanguage.MustGet(lang)
+		loadPage = func(pg *paging.Page) ([]*gtsmodel.Status, error) {
+			return p.state.DB.GetLocalLanguageTimeline(ctx, lang, pg)
+		}
+	} else {
+		cache = p.state.Caches.Timelines.PublicLanguage.MustGet(lang)
+		loadPage = func(pg *paging.Page) ([]*gtsmodel.Status, error) {
+			return p.state.DB.GetPublicLanguageTimeline(ctx, lang, pg)
+		}
+	}
+
+	return p.getStatusTimeline(ctx,
+
+		// Auth acconut,
+		// can be nil.
+		requester,
+
+		// Keyed-by-language,
+		// timeline cache.
+		cache,
+
+		// Current
+		// page.
+		page,
+
+		// Public timeline endpoint.
+		"/api/v1/timelines/public",
+
+		// Set local-only and language
+		// timeline page query flags.
+		url.Values{
+			"local":    {strconv.FormatBool(local)},
+			"language": {lang},
+		},
+
+		// Status filter context.
+		gtsmodel.FilterContextPublic,
+
+		// Database load function.
+		loadPage,
+
+		// Pre-filtering function,
+		// i.e. filter before caching.
+		nil,
+
+		// Post filtering funtion,
+		// i.e. filter after caching.
+		func(s *gtsmodel.Status) bool {
+
+			// Check the visibility of passed status to requesting user.
+			ok, err := p.visFilter.StatusPublicTimelineable(ctx, requester, s)
+			if err != nil {
+				log.Errorf(ctx, "error checking status %s visibility: %v", s.URI, err)
+				return true // default assume not visible
+			} else if !ok {
+				return true
+			}
+
+			// Check if status been muted by requester from timelines.
+			muted, err := p.muteFilter.StatusMuted(ctx, requester, s)
+			if err != nil {
+				log.Errorf(ctx, "error checking status %s mutes: %v", s.URI, err)
+				return true // default assume muted
+			} else if muted {
+				return true
+			}
+
+			return false
+		},
+	)
+}
@@ -51,6 +51,7 @@ func (suite *PublicTestSuite) TestPublicTimelineGet() {
 			Limit: limit,
 		},
 		local,
+		"", // any language
 	)
 
 	// We should have some statuses,
@@ -85,6 +86,7 @@ func (suite *PublicTestSuite) TestPublicTimelineGetNotEmpty() {
 			Limit: limit,
 		},
 		local,
+		"", // any language
 	)
 
 	// We should have a status even though
@@ -96,6 +98,65 @@ func (suite *PublicTestSuite) TestPublicTimelineGetNotEmpty() {
 	suite.Equal("http://localhost:8080/api/v1/timelines/public?limit=1&local=false&min_id=01F8MHCP5P2NWYQ416SBA0XSEV", resp.PrevLink)
 }
 
+func (suite *PublicTestSuite) TestPublicTimelineGetLanguage() {
+	var (
+		ctx       = suite.T().Context()
+		requester = suite.testAccounts["local_account_1"]
+		limit     = 40
+		local     = false
+		lang      = "en"
+	)
+
+	resp, errWithCode := suite.timeline.PublicTimelineGet(
+		ctx,
+		requester,
+		&paging.Page{
+			Max:   paging.MaxID(""),
+			Limit: limit,
+		},
+		local,
+		lang,
+	)
+	suite.NoError(errWithCode)
+	suite.NotEmpty(resp.Items)
+
+	// Every status should be in
+	// the requested language.
+	for _, item := range resp.Items {
+		status := item.(*apimodel.Status)
+		if suite.NotNil(status.Language) {
+			suite.Equal(lang, *status.Language)
+		}
+	}
+
+	// Paging links should keep the language.
+	suite.Contains(resp.NextLink, "language=en")
+	suite.Contains(resp.PrevLink, "language=en")
+}
+
+func (suite *PublicTestSuite) TestPublicTimelineGetLanguageNoStatuses() {
+	var (
+		ctx       = suite.T().Context()
+		requester = suite.testAccounts["local_account_1"]
+		limit     = 40
+		local     = true
+		lang      = "de"
+	)
+
+	resp, errWithCode := suite.timeline.PublicTimelineGet(
+		ctx,
+		requester,
+		&paging.Page{
+			Max:   paging.MaxID(""),
+			Limit: limit,
+		},
+		local,
+		lang,
+	)
+	suite.NoError(errWithCode)
+	suite.Empty(resp.Items)
+}
+
 // A timeline containing a status hidden due to filtering should return other statuses with no error.
 func (suite *PublicTestSuite) TestPublicTimelineGetHideFiltered() {
 	var (
@@ -136,6 +197,7 @@ func (suite *PublicTestSuite) TestPublicTimelineGetHideFiltered() {
 			Limit: limit,
 		},
 		local,
+		"", // any language
 	)
 	suite.NoError(errWithCode)
 	for _, item := range resp.Items {
@@ -168,6 +230,7 @@ func (suite *PublicTestSuite) TestPublicTimelineGetHideFiltered() {
 			Limit: limit,
 		},
 		local,
+		"", // any language
 	)
 
 	// We should have some statuses even though one status was filtered out.
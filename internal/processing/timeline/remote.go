@@ -0,0 +1,212 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package timeline
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/text"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// RemoteTimelineGet fetches the local public timeline of
+// the instance at the given domain, on behalf of requester.
+//
+// The fetched statuses are cached briefly per-domain, and
+// sanitized + adjusted according to any domain limit before
+// being returned. Note that IDs of the returned statuses and
+// accounts are those of the remote instance; clients should
+// use the URI to resolve them locally before interacting.
+func (p *Processor) RemoteTimelineGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	domain string,
+	limit int,
+) ([]*apimodel.Status, gtserror.WithCode) {
+	if !config.GetInstanceRemoteTimelinesEnabled() {
+		const text = "remote timelines are not enabled on this instance"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	// Normalize the given domain.
+	domain, err := util.PunifySafely(domain)
+	if err != nil || domain == "" || strings.ContainsAny(domain, "/:@?#") {
+		const text = "invalid domain"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if domain == config.GetHost() || domain == config.GetAccountDomain() {
+		const text = "use the public timeline endpoint to view this instance's timeline"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	// Never fetch from blocked domains.
+	blocked, err := p.state.DB.IsDomainBlocked(ctx, domain)
+	if err != nil {
+		err := gtserror.Newf("error checking domain block: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if blocked {
+		const text = "domain is blocked"
+		return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	// Check for a domain limit, which
+	// may refuse or adjust the timeline.
+	dLimit, err := p.state.DB.MatchDomainLimit(ctx, domain)
+	if err != nil {
+		err := gtserror.Newf("error matching domain limit: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if dLimit.AccountsMute() ||
+		(dLimit != nil && dLimit.StatusesPolicy == gtsmodel.StatusesPolicyFilterHide) {
+		const text = "domain is limited"
+		return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	// Check for a recently cached copy
+	// of this domain's timeline, else fetch.
+	statuses, ok := p.state.Caches.RemoteTimeline.Get(domain)
+	if !ok {
+		// Use the instance account transport,
+		// so as not to leak the requester.
+		tsport, err := p.transport.NewTransportForUsername(ctx, "")
+		if err != nil {
+			err := gtserror.Newf("error getting transport: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		// Always fetch the max, as the
+		// cached copy is shared by all.
+		statuses, err = tsport.DereferencePublicTimeline(ctx, domain, remoteTimelineMaxLimit)
+		if err != nil {
+			log.Debugf(ctx, "error dereferencing public timeline of %s: %v", domain, err)
+			const text = "could not fetch timeline from remote instance"
+			return nil, gtserror.NewErrorBadGateway(err, text)
+		}
+
+		// Sanitize before caching.
+		statuses = sanitizeRemoteStatuses(domain, statuses)
+		p.state.Caches.RemoteTimeline.Set(domain, statuses)
+	}
+
+	if limit <= 0 || limit > len(statuses) {
+		limit = len(statuses)
+	}
+
+	// Return adjusted copies, so the
+	// cached models are never modified.
+	apiStatuses := make([]*apimodel.Status, 0, limit)
+	for _, status := range statuses[:limit] {
+		apiStatuses = append(apiStatuses, applyRemoteDomainLimit(status, dLimit))
+	}
+
+	return apiStatuses, nil
+}
+
+// remoteTimelineMaxLimit is the maximum number
+// of statuses fetched from a remote timeline.
+const remoteTimelineMaxLimit = 40
+
+// sanitizeRemoteStatuses filters the given statuses fetched
+// from domain down to public, non-boost statuses authored on
+// domain, and sanitizes any remote-provided HTML.
+func sanitizeRemoteStatuses(domain string, statuses []*apimodel.Status) []*apimodel.Status {
+	sanitized := make([]*apimodel.Status, 0, len(statuses))
+	for _, status := range statuses {
+		if status == nil ||
+			status.Account == nil ||
+			status.Reblog != nil ||
+			status.Visibility != apimodel.VisibilityPublic {
+			continue
+		}
+
+		// Ensure account is namespaced to
+		// the remote domain, as its "acct"
+		// will be relative to that instance.
+		account := status.Account
+		if i := strings.IndexByte(account.Acct, '@'); i >= 0 {
+			account.Acct = account.Acct[:i]
+		}
+		account.Acct = account.Acct + "@" + domain
+		account.DisplayName = text.StripHTMLFromText(account.DisplayName)
+		account.Note = text.SanitizeHTML(account.Note)
+		account.Source = nil
+		account.Role = nil
+		account.Moved = nil
+
+		// Sanitize status text.
+		status.Content = text.SanitizeHTML(status.Content)
+		status.SpoilerText = text.StripHTMLFromText(status.SpoilerText)
+		status.Text = ""
+
+		// Clear requester-specific fields,
+		// these were generated as seen by
+		// an unauthenticated remote viewer.
+		status.Favourited = false
+		status.Reblogged = false
+		status.Muted = false
+		status.Bookmarked = false
+		status.Pinned = false
+		status.AccountNoted = false
+		status.Filtered = nil
+		if status.Poll != nil {
+			status.Poll.Voted = nil
+			status.Poll.OwnVotes = nil
+		}
+
+		sanitized = append(sanitized, status)
+	}
+	return sanitized
+}
+
+// applyRemoteDomainLimit returns a shallow copy of
+// status, adjusted according to the given domain limit.
+func applyRemoteDomainLimit(status *apimodel.Status, limit *gtsmodel.DomainLimit) *apimodel.Status {
+	status2 := new(apimodel.Status)
+	*status2 = *status
+
+	if limit == nil {
+		return status2
+	}
+
+	if limit.ContentWarning != "" {
+		status2.SpoilerText = limit.ApplyContentWarning(status2.SpoilerText)
+		status2.Sensitive = true
+	}
+
+	if limit.StatusesPolicy == gtsmodel.StatusesPolicyFilterWarn ||
+		limit.MediaMarkSensitive() {
+		status2.Sensitive = true
+	}
+
+	if limit.MediaReject() {
+		status2.MediaAttachments = nil
+	}
+
+	return status2
+}
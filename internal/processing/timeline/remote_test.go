@@ -0,0 +1,139 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package timeline_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/filter/mutes"
+	"code.superseriousbusiness.org/gotosocial/internal/filter/status"
+	"code.superseriousbusiness.org/gotosocial/internal/filter/visibility"
+	"code.superseriousbusiness.org/gotosocial/internal/processing/timeline"
+	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/stretchr/testify/suite"
+)
+
+type RemoteTestSuite struct {
+	TimelineStandardTestSuite
+}
+
+func (suite *RemoteTestSuite) TestRemoteTimelineGetDisabled() {
+	var (
+		ctx       = suite.T().Context()
+		requester = suite.testAccounts["local_account_1"]
+	)
+
+	_, errWithCode := suite.timeline.RemoteTimelineGet(ctx, requester, "example.org", 20)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *RemoteTestSuite) TestRemoteTimelineGetInvalidDomain() {
+	var (
+		ctx       = suite.T().Context()
+		requester = suite.testAccounts["local_account_1"]
+	)
+	config.SetInstanceRemoteTimelinesEnabled(true)
+
+	for _, domain := range []string{
+		"",
+		"example.org/api",
+		config.GetHost(),
+	} {
+		_, errWithCode := suite.timeline.RemoteTimelineGet(ctx, requester, domain, 20)
+		suite.Equal(http.StatusBadRequest, errWithCode.Code(), domain)
+	}
+}
+
+func (suite *RemoteTestSuite) TestRemoteTimelineGetBlocked() {
+	var (
+		ctx       = suite.T().Context()
+		requester = suite.testAccounts["local_account_1"]
+	)
+	config.SetInstanceRemoteTimelinesEnabled(true)
+
+	_, errWithCode := suite.timeline.RemoteTimelineGet(ctx, requester, "replyguys.com", 20)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+}
+
+func (suite *RemoteTestSuite) TestRemoteTimelineGet() {
+	var (
+		ctx       = suite.T().Context()
+		requester = suite.testAccounts["local_account_1"]
+		fetches   = 0
+	)
+	config.SetInstanceRemoteTimelinesEnabled(true)
+
+	// Serve a remote timeline containing one public
+	// status with some nasty HTML, one unlisted status,
+	// and one boost, only the first should be returned.
+	httpClient := testrig.NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/api/v1/timelines/public" {
+			return &http.Response{
+				Request:    req,
+				StatusCode: http.StatusNotFound,
+				Body:       io.NopCloser(strings.NewReader("")),
+			}, nil
+		}
+
+		fetches++
+		suite.Equal("somewhere.example", req.URL.Host)
+		suite.Equal("true", req.URL.Query().Get("local"))
+
+		return &http.Response{
+			Request:    req,
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body: io.NopCloser(strings.NewReader(`[
+				{"id":"1","visibility":"public","content":"<p>hello</p><script>alert(1)</script>","favourited":true,"account":{"id":"a","username":"someone","acct":"someone"}},
+				{"id":"2","visibility":"unlisted","content":"<p>quiet</p>","account":{"id":"a","username":"someone","acct":"someone"}},
+				{"id":"3","visibility":"public","content":"","reblog":{"id":"4"},"account":{"id":"a","username":"someone","acct":"someone"}}
+			]`)),
+		}, nil
+	}, "../../../testrig/media")
+
+	processor := timeline.New(
+		&suite.state,
+		typeutils.NewConverter(&suite.state),
+		visibility.NewFilter(&suite.state),
+		mutes.NewFilter(&suite.state),
+		status.NewFilter(&suite.state),
+		testrig.NewTestTransportController(&suite.state, httpClient),
+	)
+
+	statuses, errWithCode := processor.RemoteTimelineGet(ctx, requester, "somewhere.example", 20)
+	suite.NoError(errWithCode)
+	suite.Len(statuses, 1)
+	suite.Equal("1", statuses[0].ID)
+	suite.Equal("<p>hello</p>", statuses[0].Content)
+	suite.Equal("someone@somewhere.example", statuses[0].Account.Acct)
+	suite.False(statuses[0].Favourited)
+
+	// A second fetch should be served from cache.
+	_, errWithCode = processor.RemoteTimelineGet(ctx, requester, "somewhere.example", 20)
+	suite.NoError(errWithCode)
+	suite.Equal(1, fetches)
+}
+
+func TestRemoteTestSuite(t *testing.T) {
+	suite.Run(t, new(RemoteTestSuite))
+}
@@ -20,10 +20,15 @@
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
+	"slices"
 
 	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gopkg/xslices"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	timelinepkg "code.superseriousbusiness.org/gotosocial/internal/cache/timeline"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
@@ -31,14 +36,25 @@
 	"code.superseriousbusiness.org/gotosocial/internal/text"
 )
 
+// maxTagCombination is the maximum number of tags
+// accepted in each of the any / all / none params.
+const maxTagCombination = 4
+
 // TagTimelineGet gets a pageable timeline for the given
 // tagName and given paging parameters. It will ensure
 // that each status in the timeline is actually visible
 // to requestingAcct before returning it.
+//
+// The timeline can optionally be combined with other
+// tags: statuses must use tagName or any of anyTags,
+// and all of allTags, and none of noneTags.
 func (p *Processor) TagTimelineGet(
 	ctx context.Context,
 	requester *gtsmodel.Account,
 	tagName string,
+	anyTags []string,
+	allTags []string,
+	noneTags []string,
 	page *paging.Page,
 ) (
 	*apimodel.PageableResponse,
@@ -61,6 +77,54 @@ func (p *Processor) TagTimelineGet(
 	// Get tag ID.
 	tagID := tag.ID
 
+	// Fetch IDs of tags to combine with, tracking
+	// the given names for use in page query.
+	pageQuery := make(url.Values)
+	anyTagIDs, _, errWithCode := p.getTagIDs(ctx, pageQuery, "any[]", anyTags)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+	allTagIDs, allMissing, errWithCode := p.getTagIDs(ctx, pageQuery, "all[]", allTags)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+	noneTagIDs, _, errWithCode := p.getTagIDs(ctx, pageQuery, "none[]", noneTags)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if allMissing {
+		// A required tag isn't known
+		// or listable on this instance,
+		// so nothing can ever match.
+		return paging.EmptyResponse(), nil
+	}
+
+	// Statuses may use the main tag, or any other.
+	anyTagIDs = xslices.Deduplicate(append([]string{tagID}, anyTagIDs...))
+
+	var (
+		timeline *timelinepkg.StatusTimeline
+		loadPage func(*paging.Page) ([]*gtsmodel.Status, error)
+	)
+
+	if len(anyTagIDs) == 1 {
+		// Only the main tag, we can use its keyed-by-tag-ID
+		// timeline cache, and check any all / none tags
+		// in the post-filter against each status' tag IDs.
+		timeline = p.state.Caches.Timelines.Tag.MustGet(tagID)
+		loadPage = func(pg *paging.Page) ([]*gtsmodel.Status, error) {
+			return p.state.DB.GetTagTimeline(ctx, tagID, pg)
+		}
+	} else {
+		// Union of multiple tag timelines, these aren't
+		// cached so we load from the database, where the
+		// tag combination is performed by set intersection.
+		loadPage = func(pg *paging.Page) ([]*gtsmodel.Status, error) {
+			return p.state.DB.GetTagsTimeline(ctx, anyTagIDs, allTagIDs, noneTagIDs, pg)
+		}
+	}
+
 	// Fetch status timeline for tag.
 	return p.getStatusTimeline(ctx,
 
@@ -68,8 +132,9 @@ func (p *Processor) TagTimelineGet(
 		// account.
 		requester,
 
-		// Keyed-by-tag-ID, tag timeline cache.
-		p.state.Caches.Timelines.Tag.MustGet(tagID),
+		// Tag timeline cache,
+		// nil if uncached.
+		timeline,
 
 		// Current
 		// page.
@@ -78,17 +143,15 @@ func (p *Processor) TagTimelineGet(
 		// Tag timeline name's endpoint.
 		"/api/v1/timelines/tag/"+tagName,
 
-		// No page
-		// query.
-		nil,
+		// Any tag
+		// combination.
+		pageQuery,
 
 		// Status filter context.
 		gtsmodel.FilterContextPublic,
 
 		// Database load function.
-		func(pg *paging.Page) (statuses []*gtsmodel.Status, err error) {
-			return p.state.DB.GetTagTimeline(ctx, tagID, pg)
-		},
+		loadPage,
 
 		// Filtering function,
 		// i.e. filter before caching.
@@ -98,6 +161,11 @@ func(pg *paging.Page) (statuses []*gtsmodel.Status, err error) {
 		// i.e. filter after caching.
 		func(s *gtsmodel.Status) bool {
 
+			// Check status uses all / none of the combined tags.
+			if !hasTagCombination(s, allTagIDs, noneTagIDs) {
+				return true
+			}
+
 			// Check the visibility of passed status to requesting user.
 			ok, err := p.visFilter.StatusPublicTimelineable(ctx, requester, s)
 			if err != nil {
@@ -121,6 +189,61 @@ func(s *gtsmodel.Status) bool {
 	)
 }
 
+// getTagIDs fetches the IDs of useable and listable tags with given names,
+// adding each name to query under key. The returned bool
+// indicates whether any of the named tags could not be found.
+func (p *Processor) getTagIDs(
+	ctx context.Context,
+	query url.Values,
+	key string,
+	tagNames []string,
+) ([]string, bool, gtserror.WithCode) {
+	if len(tagNames) > maxTagCombination {
+		text := fmt.Sprintf("%s may contain at most %d tags", key, maxTagCombination)
+		return nil, false, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	var (
+		tagIDs  = make([]string, 0, len(tagNames))
+		missing bool
+	)
+
+	for _, tagName := range tagNames {
+		tag, errWithCode := p.getTag(ctx, tagName)
+		if errWithCode != nil {
+			return nil, false, errWithCode
+		}
+
+		// Keep tag name for paging links.
+		query.Add(key, tagName)
+
+		if tag == nil || !*tag.Useable || !*tag.Listable {
+			missing = true
+			continue
+		}
+
+		tagIDs = append(tagIDs, tag.ID)
+	}
+
+	return tagIDs, missing, nil
+}
+
+// hasTagCombination returns whether status
+// uses all of allTagIDs, and none of noneTagIDs.
+func hasTagCombination(status *gtsmodel.Status, allTagIDs, noneTagIDs []string) bool {
+	for _, tagID := range allTagIDs {
+		if !slices.Contains(status.TagIDs, tagID) {
+			return false
+		}
+	}
+	for _, tagID := range noneTagIDs {
+		if slices.Contains(status.TagIDs, tagID) {
+			return false
+		}
+	}
+	return true
+}
+
 func (p *Processor) getTag(ctx context.Context, tagName string) (*gtsmodel.Tag, gtserror.WithCode) {
 	// Normalize and validate provided tag name.
 	normal, ok := text.NormalizeHashtag(tagName)
@@ -0,0 +1,118 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package timeline_test
+
+import (
+	"net/http"
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"github.com/stretchr/testify/suite"
+)
+
+type TagTestSuite struct {
+	TimelineStandardTestSuite
+}
+
+func (suite *TagTestSuite) TestTagTimelineGetCombination() {
+	var (
+		ctx           = suite.T().Context()
+		requester     = suite.testAccounts["local_account_1"]
+		welcomeStatus = suite.testStatuses["admin_account_status_1"]
+		hashtagID     = "01FCT9SGYA71487N8D0S1M638G"
+	)
+
+	// Create a status using #hashtag.
+	hashtagStatus := new(gtsmodel.Status)
+	*hashtagStatus = *suite.testStatuses["local_account_2_status_1"]
+	hashtagStatus.ID = id.NewULID()
+	hashtagStatus.URI = "http://localhost:8080/users/1happyturtle/statuses/" + hashtagStatus.ID
+	hashtagStatus.TagIDs = []string{hashtagID}
+	hashtagStatus.Tags = nil
+	if err := suite.db.PutStatus(ctx, hashtagStatus); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	getIDs := func(anyTags, allTags, noneTags []string) ([]string, *apimodel.PageableResponse) {
+		resp, errWithCode := suite.timeline.TagTimelineGet(
+			ctx,
+			requester,
+			"welcome",
+			anyTags,
+			allTags,
+			noneTags,
+			&paging.Page{
+				Max:   paging.MaxID(""),
+				Limit: 40,
+			},
+		)
+		if errWithCode != nil {
+			suite.FailNow(errWithCode.Error())
+		}
+
+		var ids []string
+		for _, item := range resp.Items {
+			ids = append(ids, item.(*apimodel.Status).ID)
+		}
+		return ids, resp
+	}
+
+	// Just the main tag.
+	ids, _ := getIDs(nil, nil, nil)
+	suite.Equal([]string{welcomeStatus.ID}, ids)
+
+	// Main tag or #hashtag.
+	ids, resp := getIDs([]string{"hashtag"}, nil, nil)
+	suite.Equal([]string{hashtagStatus.ID, welcomeStatus.ID}, ids)
+	suite.Contains(resp.NextLink, "any%5B%5D=hashtag")
+
+	// Main tag and #hashtag.
+	ids, _ = getIDs(nil, []string{"hashtag"}, nil)
+	suite.Empty(ids)
+
+	// Main tag or #hashtag, but not #hashtag.
+	ids, _ = getIDs([]string{"hashtag"}, nil, []string{"hashtag"})
+	suite.Equal([]string{welcomeStatus.ID}, ids)
+
+	// Main tag, and a tag that doesn't exist.
+	ids, _ = getIDs(nil, []string{"nonexistent"}, nil)
+	suite.Empty(ids)
+
+	// Too many tags.
+	_, errWithCode := suite.timeline.TagTimelineGet(
+		ctx,
+		requester,
+		"welcome",
+		[]string{"a", "b", "c", "d", "e"},
+		nil,
+		nil,
+		&paging.Page{
+			Max:   paging.MaxID(""),
+			Limit: 40,
+		},
+	)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func TestTagTestSuite(t *testing.T) {
+	suite.Run(t, new(TagTestSuite))
+}
@@ -33,6 +33,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/id"
 	"code.superseriousbusiness.org/gotosocial/internal/paging"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"code.superseriousbusiness.org/gotosocial/internal/transport"
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
 )
 
@@ -51,6 +52,7 @@ type Processor struct {
 	visFilter    *visibility.Filter
 	muteFilter   *mutes.Filter
 	statusFilter *status.Filter
+	transport    transport.Controller
 }
 
 func New(
@@ -59,6 +61,7 @@ func New(
 	visFilter *visibility.Filter,
 	muteFilter *mutes.Filter,
 	statusFilter *status.Filter,
+	transportController transport.Controller,
 ) Processor {
 	return Processor{
 		state:        state,
@@ -66,6 +69,7 @@ func New(
 		visFilter:    visFilter,
 		muteFilter:   muteFilter,
 		statusFilter: statusFilter,
+		transport:    transportController,
 	}
 }
 
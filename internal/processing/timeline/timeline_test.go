@@ -66,6 +66,7 @@ func (suite *TimelineStandardTestSuite) SetupTest() {
 		visibility.NewFilter(&suite.state),
 		mutes.NewFilter(&suite.state),
 		status.NewFilter(&suite.state),
+		testrig.NewTestTransportController(&suite.state, testrig.NewMockHTTPClient(nil, "../../../testrig/media")),
 	)
 
 	testrig.StandardDBSetup(suite.db, suite.testAccounts)
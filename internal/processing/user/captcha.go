@@ -0,0 +1,53 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"errors"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/captcha"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+)
+
+// verifyCaptcha verifies the captcha response
+// submitted with the given sign up form, if a
+// captcha provider is configured.
+func (p *Processor) verifyCaptcha(ctx context.Context, form *apimodel.AccountCreateRequest) gtserror.WithCode {
+	var remoteIP string
+	if form.IP != nil {
+		remoteIP = form.IP.String()
+	}
+
+	err := p.state.Captcha.Verify(ctx, form.CaptchaResponse, remoteIP)
+	switch {
+	case err == nil:
+		return nil
+
+	case errors.Is(err, captcha.ErrVerificationFailed):
+		const text = "captcha verification failed, please try again"
+		return gtserror.NewErrorBadRequest(err, text)
+
+	default:
+		// Provider couldn't be reached
+		// or returned something weird.
+		err := gtserror.Newf("error verifying captcha: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+}
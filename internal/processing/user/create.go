@@ -44,11 +44,52 @@ func (p *Processor) Create(
 	app *gtsmodel.Application,
 	form *apimodel.AccountCreateRequest,
 ) (*gtsmodel.User, gtserror.WithCode) {
+	// Ensure sign ups are permitted
+	// from the requester's IP address.
+	if errWithCode := p.CheckSignUpIP(ctx, form.IP); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Sign ups must pass captcha verification, if enabled.
+	if p.state.Captcha.Enabled() {
+		if app != nil {
+			// Only the instance's own sign up form (ie.,
+			// without an app) can present a captcha, so
+			// refuse sign ups made via the client API.
+			const text = "sign ups via the client API are disabled on this server, please use the sign up form"
+			return nil, gtserror.NewErrorForbidden(gtserror.New(text), text)
+		}
+
+		if errWithCode := p.verifyCaptcha(ctx, form); errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
+	// Fetch + check invite, if given.
+	invite, staffInvite, errWithCode := p.signUpInvite(ctx, form.InviteCode)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if invite == nil && !config.GetAccountsRegistrationOpen() {
+		// Should already have been caught by form validation,
+		// but make sure as this is the only way around it.
+		const text = "registration is not open for this server"
+		return nil, gtserror.NewErrorForbidden(gtserror.New(text), text)
+	}
+
 	var (
 		usersPerDay = config.GetAccountsRegistrationDailyLimit()
 		regBacklog  = config.GetAccountsRegistrationBacklogLimit()
 	)
 
+	if staffInvite {
+		// Invites created by admins
+		// and moderators bypass limits.
+		usersPerDay = 0
+		regBacklog = 0
+	}
+
 	// If usersPerDay limit is in place,
 	// ensure no more than usersPerDay
 	// have registered in the last 24h.
@@ -118,7 +159,7 @@ func (p *Processor) Create(
 		}
 	}
 
-	user, err := p.state.DB.NewSignup(ctx, gtsmodel.NewSignup{
+	newSignup := gtsmodel.NewSignup{
 		Username: form.Username,
 		Email:    form.Email,
 		Password: form.Password,
@@ -126,7 +167,20 @@ func (p *Processor) Create(
 		SignUpIP: form.IP,
 		Locale:   form.Locale,
 		AppID:    app.ID,
-	})
+	}
+
+	if invite != nil {
+		// Use up the invite only once we're
+		// otherwise sure the sign up is valid.
+		if errWithCode := p.consumeInvite(ctx, invite); errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		newSignup.InviteID = invite.ID
+		newSignup.PreApproved = staffInvite
+	}
+
+	user, err := p.state.DB.NewSignup(ctx, newSignup)
 	if err != nil {
 		err := fmt.Errorf("db error creating new signup: %w", err)
 		return nil, gtserror.NewErrorInternalError(err)
@@ -134,7 +188,7 @@ func (p *Processor) Create(
 
 	// There are side effects for creating a new user+account
 	// (confirmation emails etc), perform these async.
-	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+	p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 		// Use ap.ObjectProfile here to
 		// distinguish this message (user model)
 		// from ap.ActorPerson (account model).
@@ -18,13 +18,35 @@
 package user_test
 
 import (
+	"context"
 	"net"
+	"net/http"
 	"testing"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/captcha"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"github.com/stretchr/testify/suite"
 )
 
+// testCaptcha is a captcha.Provider implementation
+// for tests, accepting only the response "passed".
+type testCaptcha struct{}
+
+func (testCaptcha) Name() string          { return "test" }
+func (testCaptcha) ScriptURL() string     { return "https://captcha.example.org/api.js" }
+func (testCaptcha) WidgetClass() string   { return "test-captcha" }
+func (testCaptcha) ResponseField() string { return "test-captcha-response" }
+func (testCaptcha) CSPSources() []string  { return []string{"https://captcha.example.org"} }
+
+func (testCaptcha) Verify(_ context.Context, response string, _ string) error {
+	if response != "passed" {
+		return captcha.ErrVerificationFailed
+	}
+	return nil
+}
+
 type CreateTestSuite struct {
 	UserStandardTestSuite
 }
@@ -68,6 +90,104 @@ func (suite *CreateTestSuite) TestCreateOK() {
 	suite.Equal("Bearer", userAccessToken.TokenType)
 }
 
+func (suite *CreateTestSuite) TestCreateCaptcha() {
+	ctx := suite.T().Context()
+
+	suite.state.Captcha = captcha.NewWithProvider(testCaptcha{}, "site-key")
+	defer func() { suite.state.Captcha = nil }()
+
+	form := &apimodel.AccountCreateRequest{
+		Username:        "someone_new",
+		Email:           "someone_new@example.org",
+		Password:        "a long enough password for this endpoint",
+		Agreement:       true,
+		IP:              net.ParseIP("192.0.2.128"),
+		CaptchaResponse: "failed",
+	}
+
+	// Sign up via the web form (no app)
+	// should fail with a bad captcha.
+	_, errWithCode := suite.user.Create(ctx, nil, form)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+
+	// Sign up via the client API should be
+	// refused, even with a good captcha.
+	form.CaptchaResponse = "passed"
+	_, errWithCode = suite.user.Create(ctx, suite.testApps["application_1"], form)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	// Sign up via the web form should
+	// succeed with a good captcha.
+	if _, errWithCode := suite.user.Create(ctx, nil, form); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+}
+
+func (suite *CreateTestSuite) TestCreateInvite() {
+	ctx := suite.T().Context()
+
+	// Close registration, so
+	// only invites can be used.
+	config.SetAccountsRegistrationOpen(false)
+	defer config.SetAccountsRegistrationOpen(true)
+
+	// Create a single-use
+	// invite from the admin.
+	admin := suite.testUsers["admin_account"]
+	invite := &gtsmodel.Invite{
+		ID:                 "01J5QVYB2Z8XKRMZB3WHHM0Q2F",
+		Code:               "SOMEINVITECODE",
+		CreatedByAccountID: admin.AccountID,
+		MaxUses:            1,
+	}
+	if err := suite.state.DB.PutInvite(ctx, invite); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	form := &apimodel.AccountCreateRequest{
+		Reason:     "a long enough explanation of why I am doing api calls",
+		Username:   "someone_new",
+		Email:      "someone_new@example.org",
+		Password:   "a long enough password for this endpoint",
+		Agreement:  true,
+		IP:         net.ParseIP("192.0.2.128"),
+		InviteCode: "SOMEINVITECODE",
+	}
+
+	// Sign up with the invite should succeed,
+	// and the user should be pre-approved as
+	// the invite was created by an admin.
+	user, errWithCode := suite.user.Create(ctx, nil, form)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(invite.ID, user.InviteID)
+	suite.True(*user.Approved)
+
+	// The invite should now be used up.
+	dbInvite, err := suite.state.DB.GetInviteByID(ctx, invite.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(1, dbInvite.Uses)
+
+	// So using it again should fail.
+	form.Username = "someone_else_new"
+	form.Email = "someone_else_new@example.org"
+	_, errWithCode = suite.user.Create(ctx, nil, form)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+
+	// As should signing up with no
+	// invite, as registration is closed.
+	form.InviteCode = ""
+	_, errWithCode = suite.user.Create(ctx, nil, form)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+}
+
 func TestCreateTestSuite(t *testing.T) {
 	suite.Run(t, &CreateTestSuite{})
 }
@@ -35,7 +35,7 @@
 // out the account's bits and bobs, and stubbify it.
 func (p *Processor) DeleteSelf(ctx context.Context, account *gtsmodel.Account) gtserror.WithCode {
 	// Process the delete side effects asynchronously.
-	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+	p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 		// Use ap.ObjectProfile here to
 		// distinguish this message (user model)
 		// from ap.ActorPerson (account model).
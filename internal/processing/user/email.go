@@ -99,7 +99,7 @@ func (p *Processor) EmailChange(
 	}
 
 	// Add email sending job to the queue.
-	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+	p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
 		// Use ap.ObjectProfile here to
 		// distinguish this message (user model)
 		// from ap.ActorPerson (account model).
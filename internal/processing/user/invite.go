@@ -0,0 +1,99 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// signUpInvite fetches the invite with the given code, checking
+// that it can still be used to sign up. It also returns whether
+// the invite was created by an admin or moderator, in which case
+// sign up limits don't apply and the new account is pre-approved.
+//
+// If code is empty, returns nil, false, nil.
+func (p *Processor) signUpInvite(
+	ctx context.Context,
+	code string,
+) (*gtsmodel.Invite, bool, gtserror.WithCode) {
+	if code == "" {
+		// No invite.
+		return nil, false, nil
+	}
+
+	const text = "invite code is invalid, expired, or has already been used"
+
+	invite, err := p.state.DB.GetInviteByCode(ctx, code)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting invite: %w", err)
+		return nil, false, gtserror.NewErrorInternalError(err)
+	}
+
+	if invite == nil || !invite.Usable(time.Now()) {
+		err := gtserror.Newf("invite code %s not usable", code)
+		return nil, false, gtserror.NewErrorUnprocessableEntity(err, text)
+	}
+
+	// Check whether the invite creator is
+	// (still) an admin or moderator, as only
+	// their invites bypass approval + limits.
+	creator, err := p.state.DB.GetUserByAccountID(ctx, invite.CreatedByAccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting invite creator: %w", err)
+		return nil, false, gtserror.NewErrorInternalError(err)
+	}
+
+	if creator == nil || *creator.Disabled {
+		// Invites from deleted or disabled
+		// accounts shouldn't be usable.
+		err := gtserror.Newf("invite %s creator not usable", invite.ID)
+		return nil, false, gtserror.NewErrorUnprocessableEntity(err, text)
+	}
+
+	staff := *creator.Admin || *creator.Moderator
+	return invite, staff, nil
+}
+
+// consumeInvite records a use of the given invite,
+// returning an error if it has become unusable
+// since it was fetched by signUpInvite().
+func (p *Processor) consumeInvite(
+	ctx context.Context,
+	invite *gtsmodel.Invite,
+) gtserror.WithCode {
+	err := p.state.DB.ConsumeInvite(ctx, invite.ID)
+	if errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("invite %s no longer usable", invite.ID)
+		return gtserror.NewErrorUnprocessableEntity(err,
+			"invite code is invalid, expired, or has already been used",
+		)
+	}
+
+	if err != nil {
+		err := gtserror.Newf("db error consuming invite: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
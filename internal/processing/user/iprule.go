@@ -0,0 +1,109 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"net"
+	"net/netip"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// CheckSignUpIP checks the given IP address against
+// admin-managed IP rules, returning an error if sign
+// ups from this address are not permitted.
+func (p *Processor) CheckSignUpIP(ctx context.Context, ip net.IP) gtserror.WithCode {
+	return p.checkIP(ctx, ip, true)
+}
+
+// CheckSignInIP checks the given IP address against
+// admin-managed IP rules, returning an error if sign
+// ins from this address are not permitted.
+func (p *Processor) CheckSignInIP(ctx context.Context, ip net.IP) gtserror.WithCode {
+	return p.checkIP(ctx, ip, false)
+}
+
+func (p *Processor) checkIP(ctx context.Context, ip net.IP, signUp bool) gtserror.WithCode {
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		// No (valid) IP
+		// to check against.
+		return nil
+	}
+
+	rules, err := p.state.DB.MatchIPRules(ctx, addr)
+	if err != nil {
+		err := gtserror.Newf("db error matching ip rules: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	var (
+		block   *gtsmodel.IPRule
+		allowed bool
+	)
+
+	for _, rule := range rules {
+		switch rule.Severity {
+
+		// No access blocks both sign
+		// ups and sign ins, regardless
+		// of any other matching rules.
+		case gtsmodel.IPRuleSeverityNoAccess:
+			block = rule
+
+		// Sign up blocks only apply to sign ups,
+		// and only if no sign up allow matches.
+		case gtsmodel.IPRuleSeveritySignUpBlock:
+			if signUp && block == nil {
+				block = rule
+			}
+
+		case gtsmodel.IPRuleSeveritySignUpAllow:
+			allowed = true
+		}
+
+		if block != nil &&
+			block.Severity == gtsmodel.IPRuleSeverityNoAccess {
+			break
+		}
+	}
+
+	if block == nil ||
+		(allowed && block.Severity != gtsmodel.IPRuleSeverityNoAccess) {
+		// Not blocked.
+		return nil
+	}
+
+	// Record this blocked attempt against the rule.
+	if err := p.state.DB.IncrementIPRuleHits(ctx, block.ID); err != nil {
+		log.Errorf(ctx, "db error incrementing ip rule %s hits: %v", block.ID, err)
+	}
+
+	var text string
+	if signUp {
+		text = "sign ups are not permitted from your IP address"
+	} else {
+		text = "sign ins are not permitted from your IP address"
+	}
+
+	err = gtserror.Newf("ip %s blocked by ip rule %s", addr, block.ID)
+	return gtserror.NewErrorForbidden(err, text)
+}
@@ -0,0 +1,114 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user_test
+
+import (
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"github.com/stretchr/testify/suite"
+)
+
+type IPRuleTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *IPRuleTestSuite) putRule(id string, ip string, severity gtsmodel.IPRuleSeverity) *gtsmodel.IPRule {
+	rule := &gtsmodel.IPRule{
+		ID:       id,
+		IP:       ip,
+		Severity: severity,
+		AuthorID: "01F8MH17FWEB39HZJ76B6VXSKF",
+	}
+	if err := suite.db.PutIPRule(suite.T().Context(), rule); err != nil {
+		suite.FailNow(err.Error())
+	}
+	return rule
+}
+
+func (suite *IPRuleTestSuite) TestCheckIPNoRules() {
+	ctx := suite.T().Context()
+	ip := net.ParseIP("192.0.2.1")
+	suite.Nil(suite.user.CheckSignUpIP(ctx, ip))
+	suite.Nil(suite.user.CheckSignInIP(ctx, ip))
+}
+
+func (suite *IPRuleTestSuite) TestCheckIPSignUpBlock() {
+	ctx := suite.T().Context()
+	rule := suite.putRule("01K7QZ3P1TDB8Y6X4NQ4W7RZ2A", "192.0.2.0/24", gtsmodel.IPRuleSeveritySignUpBlock)
+
+	// Sign ups from within the range are blocked.
+	errWithCode := suite.user.CheckSignUpIP(ctx, net.ParseIP("192.0.2.1"))
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	// IPv4-mapped IPv6 addresses are blocked too.
+	suite.NotNil(suite.user.CheckSignUpIP(ctx, net.ParseIP("::ffff:192.0.2.1")))
+
+	// Sign ins, and sign ups from outside the range, are fine.
+	suite.Nil(suite.user.CheckSignInIP(ctx, net.ParseIP("192.0.2.1")))
+	suite.Nil(suite.user.CheckSignUpIP(ctx, net.ParseIP("198.51.100.1")))
+
+	// Blocked attempts should have been counted.
+	dbRule, err := suite.db.GetIPRuleByID(ctx, rule.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(2, dbRule.Hits)
+	suite.WithinDuration(time.Now(), dbRule.LastHitAt, time.Minute)
+}
+
+func (suite *IPRuleTestSuite) TestCheckIPSignUpAllow() {
+	ctx := suite.T().Context()
+	suite.putRule("01K7QZ3P1TDB8Y6X4NQ4W7RZ2A", "192.0.2.0/24", gtsmodel.IPRuleSeveritySignUpBlock)
+	suite.putRule("01K7QZ3P1TDB8Y6X4NQ4W7RZ2B", "192.0.2.128/25", gtsmodel.IPRuleSeveritySignUpAllow)
+
+	// Allow rule carves out an exception.
+	suite.Nil(suite.user.CheckSignUpIP(ctx, net.ParseIP("192.0.2.200")))
+	suite.NotNil(suite.user.CheckSignUpIP(ctx, net.ParseIP("192.0.2.1")))
+}
+
+func (suite *IPRuleTestSuite) TestCheckIPNoAccess() {
+	ctx := suite.T().Context()
+	suite.putRule("01K7QZ3P1TDB8Y6X4NQ4W7RZ2A", "2001:db8::/32", gtsmodel.IPRuleSeverityNoAccess)
+	suite.putRule("01K7QZ3P1TDB8Y6X4NQ4W7RZ2B", "2001:db8::/48", gtsmodel.IPRuleSeveritySignUpAllow)
+
+	// No access can't be overridden by an allow.
+	ip := net.ParseIP("2001:db8::1")
+	suite.NotNil(suite.user.CheckSignUpIP(ctx, ip))
+	suite.NotNil(suite.user.CheckSignInIP(ctx, ip))
+}
+
+func (suite *IPRuleTestSuite) TestCheckIPExpired() {
+	ctx := suite.T().Context()
+	rule := suite.putRule("01K7QZ3P1TDB8Y6X4NQ4W7RZ2A", "192.0.2.0/24", gtsmodel.IPRuleSeverityNoAccess)
+
+	rule.ExpiresAt = time.Now().Add(-time.Minute)
+	if err := suite.db.UpdateIPRule(ctx, rule, "expires_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Nil(suite.user.CheckSignInIP(ctx, net.ParseIP("192.0.2.1")))
+}
+
+func TestIPRuleTestSuite(t *testing.T) {
+	suite.Run(t, &IPRuleTestSuite{})
+}
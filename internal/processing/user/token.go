@@ -0,0 +1,127 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/oauth"
+	"code.superseriousbusiness.org/oauth2/v4/models"
+	"github.com/google/uuid"
+)
+
+// TokenCreate mints a new personal access token for the
+// given user, with the scopes requested in form.
+//
+// Each requested scope must be permitted by hasScope, ie.,
+// the scope of the token used to make the request, so that
+// a token can't be used to mint one with wider permissions.
+//
+// The token belongs to a new application managed by the
+// user, so it shows up (and can be removed) alongside the
+// user's other applications. Like other tokens, it doesn't
+// expire until it's invalidated.
+func (p *Processor) TokenCreate(
+	ctx context.Context,
+	user *gtsmodel.User,
+	hasScope string,
+	form *apimodel.TokenCreateRequest,
+) (*apimodel.Token, gtserror.WithCode) {
+	var (
+		hasScopes   = strings.Split(hasScope, " ")
+		wantsScopes = strings.Fields(form.Scopes)
+	)
+
+	if len(wantsScopes) == 0 {
+		const text = "scopes must contain at least one scope"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	for _, wantsScope := range wantsScopes {
+		wants := apiutil.Scope(wantsScope)
+
+		if !wants.Known() {
+			text := fmt.Sprintf("unknown scope %s", wantsScope)
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		if wants.IsAdmin() && !*user.Admin && !*user.Moderator {
+			text := fmt.Sprintf("scope %s can only be granted to admins and moderators", wantsScope)
+			return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+		}
+
+		if !slices.ContainsFunc(
+			hasScopes,
+			func(hasScope string) bool {
+				return apiutil.Scope(hasScope).Permits(wants)
+			},
+		) {
+			text := fmt.Sprintf("scope %s is not permitted by the token used for this request", wantsScope)
+			return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+		}
+	}
+
+	scopes := strings.Join(wantsScopes, " ")
+
+	app := &gtsmodel.Application{
+		ID:              id.NewULID(),
+		Name:            form.Name,
+		RedirectURIs:    []string{oauth.OOBURI},
+		ClientID:        id.NewRandomULID(),
+		ClientSecret:    uuid.NewString(),
+		Scopes:          scopes,
+		ManagedByUserID: user.ID,
+	}
+	if err := p.state.DB.PutApplication(ctx, app); err != nil {
+		err := gtserror.Newf("db error inserting application: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Generate the token as though it were
+	// requested by the new app via the OOB flow.
+	accessToken, err := p.oauthServer.GenerateUserAccessToken(
+		ctx,
+		&models.Token{
+			ClientID:    app.ClientID,
+			RedirectURI: oauth.OOBURI,
+			Scope:       scopes,
+		},
+		app.ClientSecret,
+		user.ID,
+	)
+	if err != nil {
+		err := gtserror.Newf("error creating new access token for user %s: %w", user.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return &apimodel.Token{
+		AccessToken: accessToken.GetAccess(),
+		TokenType:   "Bearer",
+		Scope:       accessToken.GetScope(),
+		CreatedAt:   accessToken.GetAccessCreateAt().Unix(),
+	}, nil
+}
@@ -0,0 +1,121 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user_test
+
+import (
+	"net/http"
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"github.com/stretchr/testify/suite"
+)
+
+type TokenTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *TokenTestSuite) TestTokenCreate() {
+	var (
+		ctx  = suite.T().Context()
+		user = suite.testUsers["local_account_1"]
+	)
+
+	token, errWithCode := suite.user.TokenCreate(ctx,
+		user,
+		"read write push",
+		&apimodel.TokenCreateRequest{
+			Name:   "my bot",
+			Scopes: "read:statuses  write:media",
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.NotEmpty(token.AccessToken)
+	suite.Equal("Bearer", token.TokenType)
+	suite.Equal("read:statuses write:media", token.Scope)
+
+	// Token should be stored for the user,
+	// under a new app managed by the user.
+	dbToken, err := suite.state.DB.GetTokenByAccess(ctx, token.AccessToken)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(user.ID, dbToken.UserID)
+	suite.Equal("read:statuses write:media", dbToken.Scope)
+	suite.True(dbToken.AccessExpiresAt.IsZero())
+
+	app, err := suite.state.DB.GetApplicationByClientID(ctx, dbToken.ClientID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("my bot", app.Name)
+	suite.Equal(user.ID, app.ManagedByUserID)
+	suite.Equal("read:statuses write:media", app.Scopes)
+}
+
+func (suite *TokenTestSuite) TestTokenCreateNotPermitted() {
+	var (
+		ctx  = suite.T().Context()
+		user = suite.testUsers["local_account_1"]
+	)
+
+	for _, test := range []struct {
+		hasScope   string
+		wantsScope string
+		expectCode int
+	}{
+		// Unknown scope.
+		{"read write", "read:nonsense", http.StatusBadRequest},
+		// No scopes.
+		{"read write", " ", http.StatusBadRequest},
+		// Wider than requesting token.
+		{"read:accounts write:accounts", "read", http.StatusForbidden},
+		{"read", "write:statuses", http.StatusForbidden},
+		// Admin scope for non-admin.
+		{"read write admin", "admin:read:reports", http.StatusForbidden},
+	} {
+		_, errWithCode := suite.user.TokenCreate(ctx,
+			user,
+			test.hasScope,
+			&apimodel.TokenCreateRequest{
+				Name:   "my bot",
+				Scopes: test.wantsScope,
+			},
+		)
+		if suite.NotNil(errWithCode, test.wantsScope) {
+			suite.Equal(test.expectCode, errWithCode.Code(), test.wantsScope)
+		}
+	}
+
+	// Admins can have admin scopes.
+	if _, errWithCode := suite.user.TokenCreate(ctx,
+		suite.testUsers["admin_account"],
+		"read write admin",
+		&apimodel.TokenCreateRequest{
+			Name:   "report bot",
+			Scopes: "admin:read:reports",
+		},
+	); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+}
+
+func TestTokenTestSuite(t *testing.T) {
+	suite.Run(t, new(TokenTestSuite))
+}
@@ -287,6 +287,92 @@ func (f *federate) UpdateStatus(ctx context.Context, status *gtsmodel.Status) er
 	return nil
 }
 
+func (f *federate) PinStatus(ctx context.Context, status *gtsmodel.Status) error {
+	// Do nothing if the status
+	// shouldn't be federated.
+	if status.IsLocalOnly() {
+		return nil
+	}
+
+	// Do nothing if this
+	// isn't our status.
+	if !*status.Local {
+		return nil
+	}
+
+	// Ensure the status model is fully populated.
+	if err := f.state.DB.PopulateStatus(ctx, status); err != nil {
+		return gtserror.Newf("error populating status: %w", err)
+	}
+
+	// Parse the outbox URI of the status author.
+	outboxIRI, err := parseURI(status.Account.OutboxURI)
+	if err != nil {
+		return err
+	}
+
+	// Create an Add activity targeting the featured collection.
+	add, err := f.converter.StatusToASAdd(ctx, status)
+	if err != nil {
+		return gtserror.Newf("error creating Add: %w", err)
+	}
+
+	// Send the Add via the Actor's outbox.
+	if _, err := f.FederatingActor().Send(
+		ctx, outboxIRI, add,
+	); err != nil {
+		return gtserror.Newf(
+			"error sending activity %T via outbox %s: %w",
+			add, outboxIRI, err,
+		)
+	}
+
+	return nil
+}
+
+func (f *federate) UnpinStatus(ctx context.Context, status *gtsmodel.Status) error {
+	// Do nothing if the status
+	// shouldn't be federated.
+	if status.IsLocalOnly() {
+		return nil
+	}
+
+	// Do nothing if this
+	// isn't our status.
+	if !*status.Local {
+		return nil
+	}
+
+	// Ensure the status model is fully populated.
+	if err := f.state.DB.PopulateStatus(ctx, status); err != nil {
+		return gtserror.Newf("error populating status: %w", err)
+	}
+
+	// Parse the outbox URI of the status author.
+	outboxIRI, err := parseURI(status.Account.OutboxURI)
+	if err != nil {
+		return err
+	}
+
+	// Create a Remove activity targeting the featured collection.
+	remove, err := f.converter.StatusToASRemove(ctx, status)
+	if err != nil {
+		return gtserror.Newf("error creating Remove: %w", err)
+	}
+
+	// Send the Remove via the Actor's outbox.
+	if _, err := f.FederatingActor().Send(
+		ctx, outboxIRI, remove,
+	); err != nil {
+		return gtserror.Newf(
+			"error sending activity %T via outbox %s: %w",
+			remove, outboxIRI, err,
+		)
+	}
+
+	return nil
+}
+
 func (f *federate) Follow(ctx context.Context, follow *gtsmodel.Follow) error {
 	// Populate model.
 	if err := f.state.DB.PopulateFollow(ctx, follow); err != nil {
@@ -707,6 +793,104 @@ func (f *federate) Like(ctx context.Context, fave *gtsmodel.StatusFave) error {
 	return nil
 }
 
+// EmojiReact sends the given emoji reaction out
+// to the owner of the reacted-to status.
+func (f *federate) EmojiReact(ctx context.Context, reaction *gtsmodel.StatusReaction) error {
+	// Populate model.
+	if err := f.state.DB.PopulateStatusReaction(ctx, reaction); err != nil {
+		return gtserror.Newf("error populating reaction: %w", err)
+	}
+
+	// Do nothing if both accounts are local.
+	if reaction.Account.IsLocal() &&
+		reaction.TargetAccount.IsLocal() {
+		return nil
+	}
+
+	// Create the LitePub EmojiReact.
+	react, err := f.converter.StatusReactionToASEmojiReact(ctx, reaction)
+	if err != nil {
+		return gtserror.Newf("error converting reaction to AS EmojiReact: %w", err)
+	}
+
+	// Parse relevant URI(s).
+	outboxIRI, err := parseURI(reaction.Account.OutboxURI)
+	if err != nil {
+		return err
+	}
+
+	// Send the EmojiReact via the Actor's outbox.
+	if _, err := f.FederatingActor().Send(
+		ctx, outboxIRI, react,
+	); err != nil {
+		return gtserror.Newf(
+			"error sending activity %T via outbox %s: %w",
+			react, outboxIRI, err,
+		)
+	}
+
+	return nil
+}
+
+// UndoEmojiReact sends an Undo of the given emoji
+// reaction out to the owner of the reacted-to status.
+func (f *federate) UndoEmojiReact(ctx context.Context, reaction *gtsmodel.StatusReaction) error {
+	// Populate model.
+	if err := f.state.DB.PopulateStatusReaction(ctx, reaction); err != nil {
+		return gtserror.Newf("error populating reaction: %w", err)
+	}
+
+	// Do nothing if both accounts are local.
+	if reaction.Account.IsLocal() &&
+		reaction.TargetAccount.IsLocal() {
+		return nil
+	}
+
+	// Parse relevant URI(s).
+	outboxIRI, err := parseURI(reaction.Account.OutboxURI)
+	if err != nil {
+		return err
+	}
+
+	targetAccountIRI, err := parseURI(reaction.TargetAccount.URI)
+	if err != nil {
+		return err
+	}
+
+	// Recreate the LitePub EmojiReact.
+	react, err := f.converter.StatusReactionToASEmojiReact(ctx, reaction)
+	if err != nil {
+		return gtserror.Newf("error converting reaction to AS: %w", err)
+	}
+
+	// Create a new Undo, with the same
+	// Actor as the EmojiReact, and the
+	// whole EmojiReact set as its object.
+	undo := streams.NewActivityStreamsUndo()
+	undo.SetActivityStreamsActor(react.GetActivityStreamsActor())
+
+	undoObject := streams.NewActivityStreamsObjectProperty()
+	undoObject.AppendLitePubEmojiReact(react)
+	undo.SetActivityStreamsObject(undoObject)
+
+	// Address the Undo To the target account.
+	undoTo := streams.NewActivityStreamsToProperty()
+	undoTo.AppendIRI(targetAccountIRI)
+	undo.SetActivityStreamsTo(undoTo)
+
+	// Send the Undo via the Actor's outbox.
+	if _, err := f.FederatingActor().Send(
+		ctx, outboxIRI, undo,
+	); err != nil {
+		return gtserror.Newf(
+			"error sending activity %T via outbox %s: %w",
+			undo, outboxIRI, err,
+		)
+	}
+
+	return nil
+}
+
 // Announce sends the given boost out to relevant
 // recipients with the Outbox of the status creator.
 func (f *federate) Announce(ctx context.Context, boost *gtsmodel.Status) error {
@@ -20,9 +20,11 @@
 import (
 	"context"
 	"errors"
+	"time"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
@@ -100,6 +102,10 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, cMsg *messages.Fro
 		case ap.ActivityLike:
 			return p.clientAPI.CreateLike(ctx, cMsg)
 
+		// CREATE EMOJI REACTION
+		case ap.ActivityEmojiReact:
+			return p.clientAPI.CreateEmojiReact(ctx, cMsg)
+
 		// CREATE LIKE REQUEST
 		case ap.ActivityLikeRequest:
 			return p.clientAPI.CreateLikeRequest(ctx, cMsg)
@@ -115,6 +121,10 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, cMsg *messages.Fro
 		// CREATE BLOCK
 		case ap.ActivityBlock:
 			return p.clientAPI.CreateBlock(ctx, cMsg)
+
+		// CREATE FLAG (ie., forward report to remote instance)
+		case ap.ActivityFlag:
+			return p.clientAPI.ForwardReport(ctx, cMsg)
 		}
 
 	// UPDATE SOMETHING
@@ -125,6 +135,10 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, cMsg *messages.Fro
 		case ap.ObjectNote:
 			return p.clientAPI.UpdateStatus(ctx, cMsg)
 
+		// UPDATE NOTE/STATUS INTERACTION POLICY
+		case ap.ObjectInteractionPolicy:
+			return p.clientAPI.UpdateStatusInteractionPolicy(ctx, cMsg)
+
 		// UPDATE ACCOUNT (ie., bio, settings, etc)
 		case ap.ActorPerson:
 			return p.clientAPI.UpdateAccount(ctx, cMsg)
@@ -138,6 +152,24 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, cMsg *messages.Fro
 			return p.clientAPI.UpdateUser(ctx, cMsg)
 		}
 
+	// ADD SOMETHING
+	case ap.ActivityAdd:
+		switch cMsg.APObjectType { //nolint:gocritic
+
+		// ADD NOTE/STATUS (ie., pin to featured)
+		case ap.ObjectNote:
+			return p.clientAPI.PinStatus(ctx, cMsg)
+		}
+
+	// REMOVE SOMETHING
+	case ap.ActivityRemove:
+		switch cMsg.APObjectType { //nolint:gocritic
+
+		// REMOVE NOTE/STATUS (ie., unpin from featured)
+		case ap.ObjectNote:
+			return p.clientAPI.UnpinStatus(ctx, cMsg)
+		}
+
 	// ACCEPT SOMETHING
 	case ap.ActivityAccept:
 		switch cMsg.APObjectType { //nolint:gocritic
@@ -204,9 +236,17 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, cMsg *messages.Fro
 		case ap.ActivityLike:
 			return p.clientAPI.UndoFave(ctx, cMsg)
 
+		// UNDO EMOJI REACTION
+		case ap.ActivityEmojiReact:
+			return p.clientAPI.UndoEmojiReact(ctx, cMsg)
+
 		// UNDO ANNOUNCE/BOOST
 		case ap.ActivityAnnounce:
 			return p.clientAPI.UndoAnnounce(ctx, cMsg)
+
+		// UNDO NOTE/STATUS DELETE (restore from trash)
+		case ap.ObjectNote:
+			return p.clientAPI.RestoreStatus(ctx, cMsg)
 		}
 
 	// DELETE SOMETHING
@@ -411,6 +451,11 @@ func (p *clientAPI) CreatePollVote(ctx context.Context, cMsg *messages.FromClien
 		if err := p.federate.UpdateStatus(ctx, status); err != nil {
 			log.Errorf(ctx, "error federating status update: %v", err)
 		}
+
+		// Notify the poll author if this vote reached a milestone.
+		if err := p.surfacer.NotifyPollMilestone(ctx, vote); err != nil {
+			log.Errorf(ctx, "error notifying poll milestone: %v", err)
+		}
 	} else {
 		// These are votes in a remote poll, federate to origin the new poll vote(s).
 		if err := p.federate.CreatePollVote(ctx, vote.Poll, vote); err != nil {
@@ -428,9 +473,27 @@ func (p *clientAPI) CreateFollowReq(ctx context.Context, cMsg *messages.FromClie
 	}
 
 	// If target is a local, unlocked account,
-	// we can skip side effects for the follow
-	// request and accept the follow immediately.
-	if cMsg.Target.IsLocal() && !*cMsg.Target.Locked {
+	// or target has a follow request rule that
+	// approves this request, we can skip side
+	// effects for the follow request and accept
+	// the follow immediately.
+	accept := cMsg.Target.IsLocal() && !*cMsg.Target.Locked
+	if !accept && cMsg.Target.IsLocal() {
+		var err error
+
+		// Local requesters have no domain limit,
+		// so only approval rules are relevant here.
+		accept, _, err = p.utils.followRequestRules(ctx,
+			cMsg.Origin,
+			cMsg.Target,
+			nil,
+		)
+		if err != nil {
+			log.Errorf(ctx, "error evaluating follow request rules: %v", err)
+		}
+	}
+
+	if accept {
 		// Accept the FR first to get the Follow.
 		follow, err := p.state.DB.AcceptFollowRequest(
 			ctx,
@@ -490,6 +553,19 @@ func (p *clientAPI) CreateLike(ctx context.Context, cMsg *messages.FromClientAPI
 	return nil
 }
 
+func (p *clientAPI) CreateEmojiReact(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	reaction, ok := cMsg.GTSModel.(*gtsmodel.StatusReaction)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.StatusReaction", cMsg.GTSModel)
+	}
+
+	if err := p.federate.EmojiReact(ctx, reaction); err != nil {
+		log.Errorf(ctx, "error federating emoji reaction: %v", err)
+	}
+
+	return nil
+}
+
 func (p *clientAPI) CreateLikeRequest(ctx context.Context, cMsg *messages.FromClientAPI) error {
 	fave, ok := cMsg.GTSModel.(*gtsmodel.StatusFave)
 	if !ok {
@@ -766,6 +842,51 @@ func (p *clientAPI) UpdateStatus(ctx context.Context, cMsg *messages.FromClientA
 	return nil
 }
 
+func (p *clientAPI) UpdateStatusInteractionPolicy(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	// Cast the updated Status model attached to msg.
+	status, ok := cMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return gtserror.Newf("cannot cast %T -> *gtsmodel.Status", cMsg.GTSModel)
+	}
+
+	// Federate the updated status policy out remotely.
+	//
+	// Only the policy changed, not the status content,
+	// so this isn't an edit: no edit notifications
+	// are sent, and nothing is streamed as an edit.
+	if err := p.federate.UpdateStatus(ctx, status); err != nil {
+		log.Errorf(ctx, "error federating status update: %v", err)
+	}
+
+	return nil
+}
+
+func (p *clientAPI) PinStatus(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	status, ok := cMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return gtserror.Newf("cannot cast %T -> *gtsmodel.Status", cMsg.GTSModel)
+	}
+
+	if err := p.federate.PinStatus(ctx, status); err != nil {
+		log.Errorf(ctx, "error federating status pin: %v", err)
+	}
+
+	return nil
+}
+
+func (p *clientAPI) UnpinStatus(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	status, ok := cMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return gtserror.Newf("cannot cast %T -> *gtsmodel.Status", cMsg.GTSModel)
+	}
+
+	if err := p.federate.UnpinStatus(ctx, status); err != nil {
+		log.Errorf(ctx, "error federating status unpin: %v", err)
+	}
+
+	return nil
+}
+
 func (p *clientAPI) UpdateAccount(ctx context.Context, cMsg *messages.FromClientAPI) error {
 	account, ok := cMsg.GTSModel.(*gtsmodel.Account)
 	if !ok {
@@ -915,6 +1036,19 @@ func (p *clientAPI) UndoFave(ctx context.Context, cMsg *messages.FromClientAPI)
 	return nil
 }
 
+func (p *clientAPI) UndoEmojiReact(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	reaction, ok := cMsg.GTSModel.(*gtsmodel.StatusReaction)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.StatusReaction", cMsg.GTSModel)
+	}
+
+	if err := p.federate.UndoEmojiReact(ctx, reaction); err != nil {
+		log.Errorf(ctx, "error federating emoji reaction undo: %v", err)
+	}
+
+	return nil
+}
+
 func (p *clientAPI) UndoAnnounce(ctx context.Context, cMsg *messages.FromClientAPI) error {
 	status, ok := cMsg.GTSModel.(*gtsmodel.Status)
 	if !ok {
@@ -937,6 +1071,34 @@ func (p *clientAPI) DeleteStatus(ctx context.Context, cMsg *messages.FromClientA
 		return gtserror.Newf("%T not parseable as *gtsmodel.Status", cMsg.GTSModel)
 	}
 
+	if status.InTrash() {
+		// Status was moved to the trash, check it's still
+		// there (ie., it wasn't restored in the meantime),
+		// and whether it's due to be permanently deleted.
+		current, err := p.state.DB.GetStatusByID(
+			gtscontext.SetBarebones(ctx),
+			status.ID,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("db error getting status: %w", err)
+		}
+
+		if current == nil || !current.InTrash() {
+			// Status already gone,
+			// or restored, nothing
+			// else to do here.
+			return nil
+		}
+
+		if time.Since(current.DeletedAt) < config.GetStatusesTrashWindow() {
+			// Still within trash window, just hide the
+			// status. Delete will be federated when the
+			// status is permanently deleted by the cleaner.
+			p.utils.trashStatus(ctx, status)
+			return nil
+		}
+	}
+
 	// Try to populate status structs if possible,
 	// in order to more thoroughly remove them.
 	if err := p.state.DB.PopulateStatus(
@@ -984,6 +1146,37 @@ func (p *clientAPI) DeleteStatus(ctx context.Context, cMsg *messages.FromClientA
 	return nil
 }
 
+func (p *clientAPI) RestoreStatus(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	status, ok := cMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.Status", cMsg.GTSModel)
+	}
+
+	// Check the status wasn't put
+	// back in the trash in the meantime.
+	current, err := p.state.DB.GetStatusByID(
+		gtscontext.SetBarebones(ctx),
+		status.ID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting status: %w", err)
+	}
+
+	if current == nil || current.InTrash() {
+		// Nothing to do.
+		return nil
+	}
+
+	// Put the status back in timelines. Any notifications
+	// for the status were left alone when it was trashed,
+	// so these will not be duplicated here.
+	if err := p.surfacer.TimelineAndNotifyStatus(ctx, status); err != nil {
+		log.Errorf(ctx, "error timelining and notifying restored status: %v", err)
+	}
+
+	return nil
+}
+
 func (p *clientAPI) DeleteAccountOrUser(ctx context.Context, cMsg *messages.FromClientAPI) error {
 	// The originID of the delete, one of:
 	//   - ID of a domain block, for which
@@ -1038,6 +1231,24 @@ func (p *clientAPI) DeleteAccountOrUser(ctx context.Context, cMsg *messages.From
 		p.state.Caches.Timelines.List.Delete(listID)
 	}
 
+	// Service accounts can't outlive their owner,
+	// so enqueue deletes for any owned by account.
+	if account.IsLocal() {
+		owned, err := p.state.DB.GetAccountsByOwnerAccountID(ctx, account.ID)
+		if err != nil {
+			log.Errorf(ctx, "error getting service accounts for account %s: %v", account.ID, err)
+		}
+
+		for _, owned := range owned {
+			p.state.Workers.Client.Push(ctx, &messages.FromClientAPI{
+				APObjectType:   ap.ObjectProfile,
+				APActivityType: ap.ActivityDelete,
+				Origin:         owned,
+				Target:         owned,
+			})
+		}
+	}
+
 	// Federate out a delete activity targeting account to remote servers.
 	if err := p.federate.DeleteAccount(ctx, cMsg.Target); err != nil {
 		log.Errorf(ctx, "error federating account delete: %v", err)
@@ -1072,6 +1283,21 @@ func (p *clientAPI) ReportAccount(ctx context.Context, cMsg *messages.FromClient
 	return nil
 }
 
+func (p *clientAPI) ForwardReport(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	report, ok := cMsg.GTSModel.(*gtsmodel.Report)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.Report", cMsg.GTSModel)
+	}
+
+	// Federate the (possibly redacted)
+	// report to the remote instance.
+	if err := p.federate.Flag(ctx, report); err != nil {
+		return gtserror.Newf("error federating flag: %w", err)
+	}
+
+	return nil
+}
+
 func (p *clientAPI) MoveAccount(ctx context.Context, cMsg *messages.FromClientAPI) error {
 	// Redirect each local follower of
 	// OriginAccount to follow move target.
@@ -851,6 +851,211 @@ func (suite *FromClientAPITestSuite) TestProcessCreateStatusReplyListRepliesPoli
 	suite.checkNotWebPushed(testStructs.WebPushSender, receivingAccount.ID)
 }
 
+func (suite *FromClientAPITestSuite) TestProcessCreateStatusReplyListEntryRepliesPolicyNone() {
+	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
+	defer testrig.TearDownTestStructs(testStructs)
+
+	// We're modifying the test list entry so take a copy.
+	testEntry := new(gtsmodel.ListEntry)
+	*testEntry = *suite.testListEntries["local_account_1_list_1_entry_2"]
+
+	var (
+		ctx              = suite.T().Context()
+		postingAccount   = suite.testAccounts["admin_account"]
+		receivingAccount = suite.testAccounts["local_account_1"]
+		testList         = suite.testLists["local_account_1_list_1"]
+		streams          = suite.openStreams(ctx, testStructs.Processor, receivingAccount, []string{testList.ID})
+		homeStream       = streams[stream.TimelineHome]
+		listStream       = streams[stream.TimelineList+":"+testList.ID]
+
+		// Admin account posts a reply to turtle.
+		status = suite.newStatus(
+			ctx,
+			testStructs.State,
+			postingAccount,
+			gtsmodel.VisibilityPublic,
+			suite.testStatuses["local_account_2_status_1"],
+			nil,
+			nil,
+			false,
+			nil,
+		)
+	)
+
+	// Override the replies policy for the admin
+	// account's entry in the test list. The list
+	// itself still shows replies to followed users,
+	// but the override should hide this reply.
+	testEntry.RepliesPolicy = gtsmodel.RepliesPolicyNone
+	if err := testStructs.State.DB.UpdateListEntry(ctx, testEntry, "replies_policy"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Process the new status.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       status,
+			Origin:         postingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	statusJSON := suite.statusJSON(
+		ctx,
+		testStructs.TypeConverter,
+		status,
+		receivingAccount,
+	)
+
+	// Check message in home stream.
+	suite.checkStreamed(
+		homeStream,
+		true,
+		statusJSON,
+		stream.EventTypeUpdate,
+	)
+
+	// Check message NOT in list stream.
+	suite.checkStreamed(
+		listStream,
+		false,
+		"",
+		"",
+	)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessCreateBoostListEntryHideReblogs() {
+	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
+	defer testrig.TearDownTestStructs(testStructs)
+
+	// We're modifying the test list entry so take a copy.
+	testEntry := new(gtsmodel.ListEntry)
+	*testEntry = *suite.testListEntries["local_account_1_list_1_entry_2"]
+
+	var (
+		ctx              = suite.T().Context()
+		boostingAccount  = suite.testAccounts["admin_account"]
+		receivingAccount = suite.testAccounts["local_account_1"]
+		testList         = suite.testLists["local_account_1_list_1"]
+		streams          = suite.openStreams(ctx, testStructs.Processor, receivingAccount, []string{testList.ID})
+		listStream       = streams[stream.TimelineList+":"+testList.ID]
+
+		// Admin account boosts a status by turtle.
+		boost = suite.newStatus(
+			ctx,
+			testStructs.State,
+			boostingAccount,
+			gtsmodel.VisibilityPublic,
+			nil,
+			suite.testStatuses["local_account_2_status_1"],
+			nil,
+			false,
+			nil,
+		)
+	)
+
+	// Hide boosts by the admin
+	// account in the test list.
+	testEntry.ShowReblogs = util.Ptr(false)
+	if err := testStructs.State.DB.UpdateListEntry(ctx, testEntry, "show_reblogs"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Process the boost.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ActivityAnnounce,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       boost,
+			Origin:         boostingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Check boost NOT in list stream.
+	suite.checkStreamed(
+		listStream,
+		false,
+		"",
+		"",
+	)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessCreateStatusLanguageStream() {
+	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
+	defer testrig.TearDownTestStructs(testStructs)
+
+	var (
+		ctx              = suite.T().Context()
+		postingAccount   = suite.testAccounts["admin_account"]
+		receivingAccount = suite.testAccounts["local_account_1"]
+
+		// postingAccount posts a new public status not mentioning anyone.
+		status = suite.newStatus(
+			ctx,
+			testStructs.State,
+			postingAccount,
+			gtsmodel.VisibilityPublic,
+			nil,
+			nil,
+			nil,
+			false,
+			nil,
+		)
+	)
+
+	// Mark the status as being in German.
+	status.Language = "de"
+	if err := testStructs.State.DB.UpdateStatus(ctx, status, "language"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Open language-specific public timeline streams.
+	deStream, errWithCode := testStructs.Processor.Stream().Open(ctx, receivingAccount, stream.TimelinePublic+":de")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	frStream, errWithCode := testStructs.Processor.Stream().Open(ctx, receivingAccount, stream.TimelinePublic+":fr")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Process the new status.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       status,
+			Origin:         postingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Check status in German stream.
+	suite.checkStreamed(
+		deStream,
+		true,
+		"",
+		stream.EventTypeUpdate,
+	)
+
+	// Check status not in French stream.
+	suite.checkStreamed(
+		frStream,
+		false,
+		"",
+		"",
+	)
+}
+
 func (suite *FromClientAPITestSuite) TestProcessCreateStatusBoost() {
 	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
 	defer testrig.TearDownTestStructs(testStructs)
@@ -2077,6 +2282,79 @@ func (suite *FromClientAPITestSuite) TestProcessUpdateStatusWithFollowedHashtag(
 	suite.checkNotWebPushed(testStructs.WebPushSender, receivingAccount.ID)
 }
 
+// A public status update from an account on a list should be streamed to
+// the receiving account's public, home and list timelines, with the status
+// prepared once and shared between each of those timelines.
+func (suite *FromClientAPITestSuite) TestProcessUpdateStatusWithAuthorOnList() {
+	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
+	defer testrig.TearDownTestStructs(testStructs)
+
+	var (
+		ctx              = suite.T().Context()
+		postingAccount   = suite.testAccounts["local_account_2"]
+		receivingAccount = suite.testAccounts["local_account_1"]
+		testList         = suite.testLists["local_account_1_list_1"]
+		streams          = suite.openStreams(ctx,
+			testStructs.Processor,
+			receivingAccount,
+			[]string{testList.ID},
+		)
+		publicStream = streams[stream.TimelinePublic]
+		homeStream   = streams[stream.TimelineHome]
+		listStream   = streams[stream.TimelineList+":"+testList.ID]
+
+		// postingAccount posts a new public status not mentioning anyone.
+		status = suite.newStatus(
+			ctx,
+			testStructs.State,
+			postingAccount,
+			gtsmodel.VisibilityPublic,
+			nil,
+			nil,
+			nil,
+			false,
+			nil,
+		)
+	)
+
+	// Update the status.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityUpdate,
+			GTSModel:       status,
+			Origin:         postingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Check status update in public stream.
+	suite.checkStreamed(
+		publicStream,
+		true,
+		"",
+		stream.EventTypeStatusUpdate,
+	)
+
+	// Check status update in home stream.
+	suite.checkStreamed(
+		homeStream,
+		true,
+		"",
+		stream.EventTypeStatusUpdate,
+	)
+
+	// Check status update in list stream.
+	suite.checkStreamed(
+		listStream,
+		true,
+		"",
+		stream.EventTypeStatusUpdate,
+	)
+}
+
 // Test that when someone edits a status that's been interacted with,
 // the interacter gets a notification that the status has been edited.
 func (suite *FromClientAPITestSuite) TestProcessUpdateStatusInteractedWith() {
@@ -2167,6 +2445,77 @@ func (suite *FromClientAPITestSuite) TestProcessUpdateStatusInteractedWith() {
 	)
 }
 
+func (suite *FromClientAPITestSuite) TestProcessUpdateStatusInteractionPolicy() {
+	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
+	defer testrig.TearDownTestStructs(testStructs)
+
+	var (
+		ctx              = suite.T().Context()
+		postingAccount   = suite.testAccounts["local_account_1"]
+		receivingAccount = suite.testAccounts["admin_account"]
+		streams          = suite.openStreams(ctx,
+			testStructs.Processor,
+			receivingAccount,
+			nil,
+		)
+		notifStream = streams[stream.TimelineNotifications]
+	)
+
+	// Copy the test status.
+	//
+	// This is one that the receiving account
+	// has interacted with (by replying).
+	testStatus := new(gtsmodel.Status)
+	*testStatus = *suite.testStatuses["local_account_1_status_1"]
+
+	// Create + store an edit, so
+	// the status has been edited
+	// before the policy update.
+	edit := &gtsmodel.StatusEdit{
+		ID:       "01JTR74W15VS6A6MK15N5JVJ55",
+		StatusID: testStatus.ID,
+	}
+
+	if err := testStructs.State.DB.PutStatusEdit(ctx, edit); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	testStatus.EditIDs = []string{edit.ID}
+	testStatus.Edits = []*gtsmodel.StatusEdit{edit}
+
+	// Update the status interaction policy.
+	testStatus.InteractionPolicy = gtsmodel.DefaultInteractionPolicyFollowersOnly()
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectInteractionPolicy,
+			APActivityType: ap.ActivityUpdate,
+			GTSModel:       testStatus,
+			Origin:         postingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// A policy update isn't an edit,
+	// so nothing should be notified.
+	suite.checkStreamed(
+		notifStream,
+		false,
+		"",
+		"",
+	)
+
+	_, err := testStructs.State.DB.GetNotification(
+		ctx,
+		gtsmodel.NotificationUpdate,
+		receivingAccount.ID,
+		postingAccount.ID,
+		edit.ID,
+	)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
 func (suite *FromClientAPITestSuite) TestProcessStatusDelete() {
 	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
 	defer testrig.TearDownTestStructs(testStructs)
@@ -28,6 +28,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/federation/dereferencing"
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/media"
 	"code.superseriousbusiness.org/gotosocial/internal/surfacing"
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
 	"code.superseriousbusiness.org/gotosocial/internal/uris"
@@ -104,6 +105,10 @@ func (p *Processor) ProcessFromFediAPI(ctx context.Context, fMsg *messages.FromF
 		case ap.ActivityLikeRequest:
 			return p.fediAPI.CreateLikeRequest(ctx, fMsg)
 
+		// CREATE EMOJI REACTION
+		case ap.ActivityEmojiReact:
+			return p.fediAPI.CreateEmojiReact(ctx, fMsg)
+
 		// CREATE ANNOUNCE/BOOST
 		case ap.ActivityAnnounce:
 			return p.fediAPI.CreateAnnounce(ctx, fMsg)
@@ -188,6 +193,22 @@ func (p *Processor) ProcessFromFediAPI(ctx context.Context, fMsg *messages.FromF
 			return p.fediAPI.RejectAnnounce(ctx, fMsg)
 		}
 
+	// ADD SOMETHING
+	case ap.ActivityAdd:
+
+		// ADD NOTE/STATUS (ie., pin to featured)
+		if fMsg.APObjectType == ap.ObjectNote {
+			return p.fediAPI.PinStatus(ctx, fMsg)
+		}
+
+	// REMOVE SOMETHING
+	case ap.ActivityRemove:
+
+		// REMOVE NOTE/STATUS (ie., unpin from featured)
+		if fMsg.APObjectType == ap.ObjectNote {
+			return p.fediAPI.UnpinStatus(ctx, fMsg)
+		}
+
 	// DELETE SOMETHING
 	case ap.ActivityDelete:
 		switch fMsg.APObjectType {
@@ -229,6 +250,10 @@ func (p *Processor) ProcessFromFediAPI(ctx context.Context, fMsg *messages.FromF
 		// UNDO LIKE
 		case ap.ActivityLike:
 			return p.fediAPI.UndoFave(ctx, fMsg)
+
+		// UNDO EMOJI REACTION
+		case ap.ActivityEmojiReact:
+			return p.fediAPI.UndoEmojiReact(ctx, fMsg)
 		}
 	}
 
@@ -557,6 +582,11 @@ func (p *fediAPI) CreatePollVote(ctx context.Context, fMsg *messages.FromFediAPI
 		if err := p.federate.UpdateStatus(ctx, status); err != nil {
 			log.Errorf(ctx, "error federating status update: %v", err)
 		}
+
+		// Notify the poll author if this vote reached a milestone.
+		if err := p.surfacer.NotifyPollMilestone(ctx, vote); err != nil {
+			log.Errorf(ctx, "error notifying poll milestone: %v", err)
+		}
 	}
 
 	return nil
@@ -676,6 +706,45 @@ func (p *fediAPI) CreateFollowReq(ctx context.Context, fMsg *messages.FromFediAP
 			// Reject out of hand.
 			reject = true
 		}
+
+		// Record what the follows policy decided,
+		// so admins can see why a follow request
+		// from a limited domain was held/rejected.
+		switch {
+		case reject:
+			p.recordFollowReqLimited(ctx, followReq, limit, gtsmodel.AdminActionRejectFollow)
+		case limit.FollowsPolicy == gtsmodel.FollowsPolicyManualApproval:
+			p.recordFollowReqLimited(ctx, followReq, limit, gtsmodel.AdminActionHoldFollow)
+		}
+	}
+
+	if !reject {
+		// Check if the target account has set
+		// any rules for automatically handling
+		// follow requests, which may override
+		// our default decision to notify.
+		approve, rejectByRules, err := p.utils.followRequestRules(ctx,
+			followReq.Account,
+			followReq.TargetAccount,
+			limit,
+		)
+		if err != nil {
+			log.Errorf(ctx, "error evaluating follow request rules: %v", err)
+		}
+
+		switch {
+		case rejectByRules:
+			// Target doesn't want
+			// this follow request.
+			reject = true
+
+		case approve && (limit == nil ||
+			limit.FollowsPolicy != gtsmodel.FollowsPolicyManualApproval):
+			// Target wants this follow request
+			// approved, and there's no domain
+			// limit requiring manual approval.
+			accept = true
+		}
 	}
 
 	if reject {
@@ -735,6 +804,89 @@ func (p *fediAPI) CreateFollowReq(ctx context.Context, fMsg *messages.FromFediAP
 	return nil
 }
 
+// recordFollowReqLimited logs that the given follow request was
+// rejected or held for manual approval due to the given domain
+// limit, and records it in the admin action log. Only a single
+// admin action is kept per limited domain and action type, which
+// is updated on each decision, so that a busy limited domain
+// doesn't flood the log with automatic entries.
+func (p *fediAPI) recordFollowReqLimited(
+	ctx context.Context,
+	followReq *gtsmodel.FollowRequest,
+	limit *gtsmodel.DomainLimit,
+	actionType gtsmodel.AdminActionType,
+) {
+	var text string
+	switch actionType {
+	case gtsmodel.AdminActionRejectFollow:
+		text = "rejecting follow requests per domain limit follows policy"
+	case gtsmodel.AdminActionHoldFollow:
+		text = "holding follow requests for manual approval per domain limit follows policy"
+	}
+
+	log.WithContext(ctx).WithFields(kv.Fields{
+		{"limit", limit.Domain},
+		{"follower", followReq.Account.URI},
+		{"followee", followReq.TargetAccount.URI},
+	}...).Info(text)
+
+	text += "; most recently from " + followReq.Account.URI +
+		" to " + followReq.TargetAccount.URI
+
+	// Lock on this domain + action type so concurrent
+	// decisions don't both create a new admin action.
+	unlock := p.state.ProcessingLocks.Lock(limit.Domain + " " + actionType.String())
+	defer unlock()
+
+	action, err := p.state.DB.GetLatestAdminActionForTarget(ctx,
+		gtsmodel.AdminActionCategoryDomain,
+		limit.Domain,
+		actionType,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		log.Errorf(ctx, "db error getting admin action: %v", err)
+		return
+	}
+
+	now := time.Now()
+
+	if action != nil {
+		// Update the existing action
+		// with the latest decision.
+		action.Text = text
+		action.CompletedAt = now
+		if err := p.state.DB.UpdateAdminAction(ctx,
+			action,
+			"text",
+			"completed_at",
+		); err != nil {
+			log.Errorf(ctx, "db error updating admin action: %v", err)
+		}
+		return
+	}
+
+	// Automatic actions are recorded
+	// as taken by the instance account.
+	instanceAcct, err := p.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		log.Errorf(ctx, "db error getting instance account: %v", err)
+		return
+	}
+
+	if err := p.state.DB.PutAdminAction(ctx, &gtsmodel.AdminAction{
+		ID:             id.NewULID(),
+		CreatedAt:      now,
+		TargetCategory: gtsmodel.AdminActionCategoryDomain,
+		TargetID:       limit.Domain,
+		Type:           actionType,
+		AccountID:      instanceAcct.ID,
+		Text:           text,
+		CompletedAt:    now,
+	}); err != nil {
+		log.Errorf(ctx, "db error storing admin action: %v", err)
+	}
+}
+
 // CreateLike handles an impolite Like, ie., a Like sent directly.
 // This is different from the CreateLikeRequest function, which handles polite LikeRequests.
 func (p *fediAPI) CreateLike(ctx context.Context, fMsg *messages.FromFediAPI) error {
@@ -837,6 +989,67 @@ func (p *fediAPI) CreateLike(ctx context.Context, fMsg *messages.FromFediAPI) er
 	return nil
 }
 
+// CreateEmojiReact handles an emoji reaction to a local status,
+// dereferencing any custom emoji used before storing the reaction.
+func (p *fediAPI) CreateEmojiReact(ctx context.Context, fMsg *messages.FromFediAPI) error {
+	reaction, ok := fMsg.GTSModel.(*gtsmodel.StatusReaction)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.StatusReaction", fMsg.GTSModel)
+	}
+
+	if reaction.Emoji != nil && reaction.EmojiID == "" {
+		// Custom emoji placeholder, check
+		// whether the emoji's domain has
+		// media rejected before fetching.
+		limit, err := p.state.DB.MatchDomainLimit(ctx, reaction.Emoji.Domain)
+		if err != nil {
+			return gtserror.Newf("error matching domain limit: %w", err)
+		}
+
+		var rejectReason *gtsmodel.MediaErrorDetails
+		if limit.MediaReject() {
+			rejectReason = new(gtsmodel.MediaErrorDetails)
+			*rejectReason = gtsmodel.NewMediaErrorDetails(
+				gtsmodel.MediaErrorTypePolicy,
+				gtsmodel.MediaErrorTypePolicy_Domain,
+			)
+		}
+
+		placeholder := reaction.Emoji
+		emoji, err := p.federate.GetEmoji(ctx,
+			placeholder.Shortcode,
+			placeholder.Domain,
+			placeholder.ImageRemoteURL,
+			media.AdditionalEmojiInfo{
+				URI:                  &placeholder.URI,
+				ImageRemoteURL:       &placeholder.ImageRemoteURL,
+				ImageStaticRemoteURL: &placeholder.ImageStaticRemoteURL,
+				RejectReason:         rejectReason,
+			},
+			false, // refresh
+			true,  // async
+		)
+		if emoji == nil {
+			return gtserror.Newf("error fetching emoji %s: %w", placeholder.URI, err)
+		}
+
+		reaction.Emoji = emoji
+		reaction.EmojiID = emoji.ID
+	}
+
+	if err := p.state.DB.PutStatusReaction(ctx, reaction); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			// Another thread (or an earlier,
+			// differently-addressed activity)
+			// already stored this reaction.
+			return nil
+		}
+		return gtserror.Newf("db error inserting status reaction: %w", err)
+	}
+
+	return nil
+}
+
 // CreateLikeRequest handles a polite LikeRequest, as
 // opposed to CreateLike, which handles *impolite* like
 // requests (ie., Likes sent directly).
@@ -1468,6 +1681,85 @@ func (p *fediAPI) UpdateStatus(ctx context.Context, fMsg *messages.FromFediAPI)
 	return nil
 }
 
+// PinStatus handles a remote account adding one of
+// its statuses to its featured collection, by fetching
+// the status (if necessary) and marking it as pinned.
+func (p *fediAPI) PinStatus(ctx context.Context, fMsg *messages.FromFediAPI) error {
+	if fMsg.APIRI == nil {
+		return gtserror.New("APIRI not set")
+	}
+
+	// Fetch the featured status, dereferencing it if we
+	// don't have it yet. This also performs visibility
+	// and domain permission checks on the way in.
+	status, _, _, err := p.federate.GetStatusByURI(ctx,
+		fMsg.Receiving.Username,
+		fMsg.APIRI,
+		nil,
+	)
+	if err != nil {
+		return gtserror.Newf("error dereferencing featured status %s: %w", fMsg.APIRI, err)
+	}
+
+	if status.AccountID != fMsg.Requesting.ID {
+		// Accounts can only
+		// pin their own statuses.
+		log.Debugf(ctx, "status %s not owned by %s, ignoring pin", status.URI, fMsg.Requesting.URI)
+		return nil
+	}
+
+	if status.BoostOfID != "" ||
+		status.Visibility == gtsmodel.VisibilityDirect {
+		// Mirror our own restrictions on what
+		// may be pinned (see processing/status).
+		log.Debugf(ctx, "status %s not pinnable, ignoring pin", status.URI)
+		return nil
+	}
+
+	if !status.PinnedAt.IsZero() {
+		// Already pinned.
+		return nil
+	}
+
+	status.PinnedAt = time.Now()
+	if err := p.state.DB.UpdateStatus(ctx, status, "pinned_at"); err != nil {
+		return gtserror.Newf("db error pinning status: %w", err)
+	}
+
+	return nil
+}
+
+// UnpinStatus handles a remote account removing one of
+// its statuses from its featured collection. Statuses
+// we don't already have stored are simply ignored.
+func (p *fediAPI) UnpinStatus(ctx context.Context, fMsg *messages.FromFediAPI) error {
+	if fMsg.APIRI == nil {
+		return gtserror.New("APIRI not set")
+	}
+
+	status, err := p.state.DB.GetStatusByURI(
+		gtscontext.SetBarebones(ctx),
+		fMsg.APIRI.String(),
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting status %s: %w", fMsg.APIRI, err)
+	}
+
+	if status == nil ||
+		status.AccountID != fMsg.Requesting.ID ||
+		status.PinnedAt.IsZero() {
+		// Nothing to do.
+		return nil
+	}
+
+	status.PinnedAt = time.Time{}
+	if err := p.state.DB.UpdateStatus(ctx, status, "pinned_at"); err != nil {
+		return gtserror.Newf("db error unpinning status: %w", err)
+	}
+
+	return nil
+}
+
 func (p *fediAPI) DeleteStatus(ctx context.Context, fMsg *messages.FromFediAPI) error {
 	status, ok := fMsg.GTSModel.(*gtsmodel.Status)
 	if !ok {
@@ -1739,3 +2031,17 @@ func (p *fediAPI) UndoFave(ctx context.Context, fMsg *messages.FromFediAPI) erro
 
 	return nil
 }
+
+func (p *fediAPI) UndoEmojiReact(ctx context.Context, fMsg *messages.FromFediAPI) error {
+	reaction, ok := fMsg.GTSModel.(*gtsmodel.StatusReaction)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.StatusReaction", fMsg.GTSModel)
+	}
+
+	// The reaction has already been
+	// removed by the federating db,
+	// nothing more to do here.
+	_ = reaction
+
+	return nil
+}
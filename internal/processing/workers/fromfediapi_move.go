@@ -376,6 +376,24 @@ func (p *fediAPI) MoveAccount(ctx context.Context, fMsg *messages.FromFediAPI) e
 		looks valid and we should process it.
 	*/
 
+	// Mark originAcct as moved to targetAcct,
+	// if this wasn't already federated to us
+	// via the actor's movedTo property, so that
+	// anyone looking at the old account (or
+	// notified of the Move) is sent to the new.
+	if originAcct.MovedToURI == "" {
+		originAcct.MovedToURI = targetAcct.URI
+		originAcct.MovedTo = targetAcct
+		if err := p.state.DB.UpdateAccount(ctx,
+			originAcct, "moved_to_uri",
+		); err != nil {
+			return gtserror.Newf(
+				"db error marking originAcct as moved: %w",
+				err,
+			)
+		}
+	}
+
 	// Transfer originAcct's followers
 	// on this instance to targetAcct.
 	redirectOK := p.utils.redirectFollowers(
@@ -35,6 +35,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/id"
 	"code.superseriousbusiness.org/gotosocial/internal/messages"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
 	"code.superseriousbusiness.org/gotosocial/internal/stream"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
 	"code.superseriousbusiness.org/gotosocial/testrig"
@@ -580,6 +581,55 @@ func (suite *FromFediAPITestSuite) TestProcessFollowRequestReject() {
 	}) {
 		suite.FailNow("timed out waiting for message")
 	}
+
+	// The rejection should be recorded
+	// as an admin action on the domain.
+	action := suite.checkFollowReqLimitedAction(ctx,
+		testStructs.State,
+		domainLimit.Domain,
+		gtsmodel.AdminActionRejectFollow,
+		targetAccount,
+	)
+
+	// Send another follow request from the limited
+	// domain through, this time to another account.
+	otherTarget := suite.testAccounts["local_account_2"]
+	otherReq := &gtsmodel.FollowRequest{
+		ID:              "01FGRYAVAWWPP926J175QGM0WW",
+		AccountID:       originAccount.ID,
+		Account:         originAccount,
+		TargetAccountID: otherTarget.ID,
+		TargetAccount:   otherTarget,
+		ShowReblogs:     util.Ptr(true),
+		URI:             originAccount.URI + "/follows/01FGRYAVAWWPP926J175QGM0WW",
+		Notify:          util.Ptr(false),
+	}
+	if err := testStructs.State.DB.Put(ctx, otherReq); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := testStructs.Processor.Workers().ProcessFromFediAPI(
+		ctx,
+		&messages.FromFediAPI{
+			APObjectType:   ap.ActivityFollow,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       otherReq,
+			Receiving:      otherTarget,
+			Requesting:     originAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// The same admin action should have
+	// been updated, rather than a new one.
+	updated := suite.checkFollowReqLimitedAction(ctx,
+		testStructs.State,
+		domainLimit.Domain,
+		gtsmodel.AdminActionRejectFollow,
+		otherTarget,
+	)
+	suite.Equal(action.ID, updated.ID)
 }
 
 func (suite *FromFediAPITestSuite) TestProcessFollowRequestManuallyApprove() {
@@ -659,6 +709,194 @@ func (suite *FromFediAPITestSuite) TestProcessFollowRequestManuallyApprove() {
 	// No messages should have been sent out, since
 	// we didn't need to federate accept or reject.
 	suite.Empty(testStructs.HTTPClient.SentMessages)
+
+	// The hold should be recorded as
+	// an admin action on the domain.
+	suite.checkFollowReqLimitedAction(suite.T().Context(),
+		testStructs.State,
+		domainLimit.Domain,
+		gtsmodel.AdminActionHoldFollow,
+		targetAccount,
+	)
+}
+
+// checkFollowReqLimitedAction checks that an admin action of the
+// given type was stored against the given domain, taken automatically
+// on behalf of the instance account, noting the given followee.
+func (suite *FromFediAPITestSuite) checkFollowReqLimitedAction(
+	ctx context.Context,
+	st *state.State,
+	domain string,
+	actionType gtsmodel.AdminActionType,
+	followee *gtsmodel.Account,
+) *gtsmodel.AdminAction {
+	action, err := st.DB.GetLatestAdminActionForTarget(ctx,
+		gtsmodel.AdminActionCategoryDomain,
+		domain,
+		actionType,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	instanceAcct, err := st.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(instanceAcct.ID, action.AccountID)
+	suite.Contains(action.Text, suite.testAccounts["remote_account_1"].URI)
+	suite.Contains(action.Text, followee.URI)
+	suite.False(action.CompletedAt.IsZero())
+	return action
+}
+
+func (suite *FromFediAPITestSuite) TestProcessFollowRequestRulesApproveDomain() {
+	var (
+		ctx           = suite.T().Context()
+		testStructs   = testrig.SetupTestStructs(rMediaPath, rTemplatePath)
+		originAccount = suite.testAccounts["remote_account_1"]
+		targetAccount = new(gtsmodel.Account)
+	)
+	defer testrig.TearDownTestStructs(testStructs)
+
+	// Copy locked local_account_2 and
+	// its settings so we can set rules.
+	*targetAccount = *suite.testAccounts["local_account_2"]
+	targetAccount.Settings = new(gtsmodel.AccountSettings)
+	*targetAccount.Settings = *suite.testAccounts["local_account_2"].Settings
+
+	// Update domain limit on fossbros-anonymous.io to no-op for this test.
+	domainLimit := new(gtsmodel.DomainLimit)
+	*domainLimit = *suite.testDomainLimits["fossbros-anonymous.io"]
+	domainLimit.FollowsPolicy = gtsmodel.FollowsPolicyNoAction
+	if err := testStructs.State.DB.UpdateDomainLimit(ctx, domainLimit); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Set rule to approve follow
+	// requests from the origin domain.
+	targetAccount.Settings.FollowRequestRules = &gtsmodel.FollowRequestRules{
+		ApproveDomains: []string{"fossbros-anonymous.io"},
+	}
+	if err := testStructs.State.DB.UpdateAccountSettings(ctx, targetAccount.Settings); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Put follow req in the db as though it
+	// had passed through the federating db already.
+	followReq := &gtsmodel.FollowRequest{
+		ID:              "01FGRYAVAWWPP926J175QGM0WV",
+		AccountID:       originAccount.ID,
+		Account:         originAccount,
+		TargetAccountID: targetAccount.ID,
+		TargetAccount:   targetAccount,
+		ShowReblogs:     util.Ptr(true),
+		URI:             originAccount.URI + "/follows/01FGRYAVAWWPP926J175QGM0WV",
+		Notify:          util.Ptr(false),
+	}
+	if err := testStructs.State.DB.Put(ctx, followReq); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Send follow request through to the worker.
+	if err := testStructs.Processor.Workers().ProcessFromFediAPI(
+		ctx,
+		&messages.FromFediAPI{
+			APObjectType:   ap.ActivityFollow,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       followReq,
+			Receiving:      targetAccount,
+			Requesting:     originAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Follow request should have
+	// been automatically approved.
+	following, err := testStructs.State.DB.IsFollowing(ctx, originAccount.ID, targetAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(following)
+}
+
+func (suite *FromFediAPITestSuite) TestProcessFollowRequestRulesRejectLimited() {
+	var (
+		ctx           = suite.T().Context()
+		testStructs   = testrig.SetupTestStructs(rMediaPath, rTemplatePath)
+		originAccount = suite.testAccounts["remote_account_1"]
+		targetAccount = new(gtsmodel.Account)
+	)
+	defer testrig.TearDownTestStructs(testStructs)
+
+	// Copy local_account_1 and its
+	// settings so we can set rules.
+	*targetAccount = *suite.testAccounts["local_account_1"]
+	targetAccount.Settings = new(gtsmodel.AccountSettings)
+	*targetAccount.Settings = *suite.testAccounts["local_account_1"].Settings
+
+	// Update domain limit on fossbros-anonymous.io to no-op for this test.
+	domainLimit := new(gtsmodel.DomainLimit)
+	*domainLimit = *suite.testDomainLimits["fossbros-anonymous.io"]
+	domainLimit.FollowsPolicy = gtsmodel.FollowsPolicyNoAction
+	if err := testStructs.State.DB.UpdateDomainLimit(ctx, domainLimit); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Set rule to reject follow
+	// requests from limited domains.
+	targetAccount.Settings.FollowRequestRules = &gtsmodel.FollowRequestRules{
+		RejectLimited: true,
+	}
+	if err := testStructs.State.DB.UpdateAccountSettings(ctx, targetAccount.Settings); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Put follow req in the db as though it
+	// had passed through the federating db already.
+	followReq := &gtsmodel.FollowRequest{
+		ID:              "01FGRYAVAWWPP926J175QGM0WV",
+		AccountID:       originAccount.ID,
+		Account:         originAccount,
+		TargetAccountID: targetAccount.ID,
+		TargetAccount:   targetAccount,
+		ShowReblogs:     util.Ptr(true),
+		URI:             originAccount.URI + "/follows/01FGRYAVAWWPP926J175QGM0WV",
+		Notify:          util.Ptr(false),
+	}
+	if err := testStructs.State.DB.Put(ctx, followReq); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Send follow request through to the worker.
+	if err := testStructs.Processor.Workers().ProcessFromFediAPI(
+		ctx,
+		&messages.FromFediAPI{
+			APObjectType:   ap.ActivityFollow,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       followReq,
+			Receiving:      targetAccount,
+			Requesting:     originAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Follow request should have been rejected,
+	// even though the target account is unlocked.
+	requested, err := testStructs.State.DB.IsFollowRequested(ctx, originAccount.ID, targetAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(requested)
+
+	following, err := testStructs.State.DB.IsFollowing(ctx, originAccount.ID, targetAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(following)
 }
 
 func (suite *FromFediAPITestSuite) TestProcessFollowRequestUnlocked() {
@@ -867,6 +1105,27 @@ func (suite *FromFediAPITestSuite) TestMoveAccount() {
 
 	// Move should be marked as completed.
 	suite.WithinDuration(time.Now(), move.SucceededAt, 1*time.Minute)
+
+	// Zork should have been notified of the Move.
+	if !testrig.WaitFor(func() bool {
+		_, err := testStructs.State.DB.GetNotification(
+			ctx,
+			gtsmodel.NotificationMove,
+			receivingAcct.ID,
+			requestingAcct.ID,
+			"",
+		)
+		return err == nil
+	}) {
+		suite.FailNow("timed out waiting for zork to be notified of move")
+	}
+
+	// foss_satan should now be marked as moved.
+	dbAcct, err := testStructs.State.DB.GetAccountByID(ctx, requestingAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(targetAcct.URI, dbAcct.MovedToURI)
 }
 
 func (suite *FromFediAPITestSuite) TestUndoAnnounce() {
@@ -142,6 +142,11 @@ func (u *utils) wipeStatus(
 		errs.Appendf("error deleting status faves: %w", err)
 	}
 
+	// Delete all emoji reactions to this status.
+	if err := u.state.DB.DeleteStatusReactionsForStatus(ctx, status.ID); err != nil {
+		errs.Appendf("error deleting status reactions: %w", err)
+	}
+
 	if id := status.PollID; id != "" {
 		// Delete this poll by ID from the database.
 		if err := u.state.DB.DeletePollByID(ctx, id); err != nil {
@@ -191,8 +196,43 @@ func (u *utils) wipeStatus(
 	return errs.Combine()
 }
 
+// trashStatus encapsulates common logic used to
+// hide a status that has been moved to the trash,
+// removing it and any boosts of it from timelines.
+//
+// Unlike wipeStatus, nothing is deleted, so
+// the status can be restored from the trash.
+func (u *utils) trashStatus(
+	ctx context.Context,
+	status *gtsmodel.Status,
+) {
+	// Get all boosts of this status so
+	// that we can remove them from timelines.
+	boosts, err := u.state.DB.GetStatusBoosts(
+		gtscontext.SetBarebones(ctx),
+		status.ID,
+	)
+	if err != nil {
+		log.Errorf(ctx, "error fetching status boosts: %v", err)
+	}
+
+	for _, boost := range boosts {
+		// Boost visibility depends on the boosted
+		// status, which the visibility cache can't
+		// know about, so invalidate it by hand.
+		u.state.Caches.Visibility.Invalidate("ItemID", boost.ID)
+
+		// Remove the boost from any and all timelines.
+		u.surfacer.DeleteStatusFromTimelines(ctx, boost.ID)
+	}
+
+	// Remove the status itself from any and all timelines.
+	u.surfacer.DeleteStatusFromTimelines(ctx, status.ID)
+}
+
 // redirectFollowers redirects all local
-// followers of originAcct to targetAcct.
+// followers of originAcct to targetAcct,
+// and notifies each of them of the move.
 //
 // Both accounts must be fully dereferenced
 // already, and the Move must be valid.
@@ -280,6 +320,23 @@ func (u *utils) redirectFollowers(
 			)
 			return false
 		}
+
+		// Let the follower know their follow has
+		// been moved over to the new account. The
+		// move was carried out regardless, so just
+		// log if this goes wrong.
+		if err := u.surfacer.Notify(ctx,
+			gtsmodel.NotificationMove,
+			follow.Account,
+			originAcct,
+			nil,
+			nil,
+		); err != nil {
+			log.Errorf(ctx,
+				"error notifying account %s of move: %v",
+				follow.AccountID, err,
+			)
+		}
 	}
 
 	return true
@@ -315,3 +372,74 @@ func (u *utils) storeInteractionRequest(
 
 	return nil
 }
+
+// followRequestRules evaluates the follow request
+// rules set by the given local target account against
+// a follow request from the given requester, returning
+// whether the request should be automatically approved,
+// or automatically rejected. Approval rules are only
+// evaluated if target's account is locked, since follow
+// requests to unlocked accounts are approved anyway.
+//
+// The given limit should be the domain limit in place
+// for the requester's domain, if any.
+func (u *utils) followRequestRules(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	target *gtsmodel.Account,
+	limit *gtsmodel.DomainLimit,
+) (approve bool, reject bool, err error) {
+	if !target.IsLocal() {
+		// Only local accounts
+		// have settings / rules.
+		return false, false, nil
+	}
+
+	if target.Settings == nil {
+		target.Settings, err = u.state.DB.GetAccountSettings(ctx, target.ID)
+		if err != nil {
+			err := gtserror.Newf("db error getting settings for account %s: %w", target.ID, err)
+			return false, false, err
+		}
+	}
+
+	rules := target.Settings.FollowRequestRules
+	if rules == nil {
+		// No rules set.
+		return false, false, nil
+	}
+
+	if rules.RejectLimited && limit != nil {
+		// Requester is on a limited
+		// domain, reject out of hand.
+		return false, true, nil
+	}
+
+	if !*target.Locked {
+		// No need to check
+		// approval rules.
+		return false, false, nil
+	}
+
+	if !requester.IsLocal() &&
+		rules.ApprovesDomain(requester.Domain) {
+		// Requester is on
+		// an approved domain.
+		return true, false, nil
+	}
+
+	if rules.ApproveFollowersOfFollowers {
+		// Check if requester follows
+		// any of target's followers.
+		approve, err = u.state.DB.IsFollowingFollowerOf(ctx,
+			requester.ID,
+			target.ID,
+		)
+		if err != nil {
+			err := gtserror.Newf("db error checking followers of followers: %w", err)
+			return false, false, err
+		}
+	}
+
+	return approve, false, nil
+}
@@ -0,0 +1,113 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package readonly
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/workers"
+)
+
+// Mode manages the instance's read-only mode, in
+// which write requests and incoming federation are
+// rejected, and background workers that may write
+// to the database are paused, so that admins can
+// safely perform database maintenance while the
+// instance continues to serve read-only traffic.
+//
+// A nil Mode is safe to use, and is never enabled.
+type Mode struct {
+	workers *workers.Workers
+	since   atomic.Pointer[time.Time]
+	mu      sync.Mutex
+}
+
+// New returns a new (disabled) Mode,
+// which will pause and resume the given
+// workers as read-only mode is toggled.
+func New(workers *workers.Workers) *Mode {
+	return &Mode{workers: workers}
+}
+
+// Enabled returns whether read-only mode is enabled.
+func (m *Mode) Enabled() bool {
+	return m != nil && m.since.Load() != nil
+}
+
+// Since returns the time at which read-only
+// mode was enabled, or zero time if disabled.
+func (m *Mode) Since() time.Time {
+	if m == nil {
+		return time.Time{}
+	}
+	if since := m.since.Load(); since != nil {
+		return *since
+	}
+	return time.Time{}
+}
+
+// Enable enables read-only mode, pausing
+// workers once any currently running tasks
+// have finished. Returns false if read-only
+// mode was already enabled.
+func (m *Mode) Enable() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.since.Load() != nil {
+		// Already enabled.
+		return false
+	}
+
+	// Mark as enabled *before* pausing
+	// workers, so that no further writes
+	// are accepted while they finish up.
+	now := time.Now()
+	m.since.Store(&now)
+
+	log.Info(nil, "enabling read-only mode, pausing workers")
+	m.workers.Pause()
+
+	return true
+}
+
+// Disable disables read-only mode, resuming
+// paused workers. Returns false if read-only
+// mode was not enabled.
+func (m *Mode) Disable() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.since.Load() == nil {
+		// Already disabled.
+		return false
+	}
+
+	log.Info(nil, "disabling read-only mode, resuming workers")
+	m.workers.Resume()
+
+	// Mark as disabled only once workers
+	// are running again to process any
+	// work queued while they were paused.
+	m.since.Store(nil)
+
+	return true
+}
@@ -0,0 +1,88 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package readonly_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
+	"code.superseriousbusiness.org/gotosocial/internal/readonly"
+	"code.superseriousbusiness.org/gotosocial/internal/workers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNilMode(t *testing.T) {
+	var mode *readonly.Mode
+	assert.False(t, mode.Enabled())
+	assert.True(t, mode.Since().IsZero())
+}
+
+func TestModePausesWorkers(t *testing.T) {
+	var w workers.Workers
+	w.Client.Process = func(context.Context, *messages.FromClientAPI) error { return nil }
+	w.Federator.Process = func(context.Context, *messages.FromFediAPI) error { return nil }
+	w.Client.Init(messages.ClientMsgIndices())
+	w.Federator.Init(messages.FederatorMsgIndices())
+	w.Client.Start(1)
+	w.Federator.Start(1)
+	w.Dereference.Start(1)
+	w.Processing.Start(1)
+	w.WebPush.Start(1)
+	w.Surfacing.Start(1)
+	defer w.Stop()
+
+	mode := readonly.New(&w)
+	assert.False(t, mode.Enabled())
+
+	// Enabling should pause processing
+	// workers, but leave Web Push running.
+	assert.True(t, mode.Enable())
+	assert.False(t, mode.Enable())
+	assert.True(t, mode.Enabled())
+	assert.False(t, mode.Since().IsZero())
+	assert.Zero(t, w.Processing.Len())
+	assert.Zero(t, w.Client.Len())
+	assert.NotZero(t, w.WebPush.Len())
+
+	// Work queued while paused
+	// should not be processed.
+	done := make(chan struct{})
+	w.Processing.Queue.Push(func(context.Context) { close(done) })
+
+	select {
+	case <-done:
+		t.Fatal("task processed while in read-only mode")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Disabling should resume workers,
+	// and process the queued work.
+	assert.True(t, mode.Disable())
+	assert.False(t, mode.Disable())
+	assert.False(t, mode.Enabled())
+	assert.True(t, mode.Since().IsZero())
+	assert.NotZero(t, w.Processing.Len())
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for queued task")
+	}
+}
@@ -39,6 +39,7 @@
 	blocks    = "blocks"
 	reports   = "reports"
 	accepts   = "accepts"
+	reactions = "reactions"
 
 	alphaNumeric             = `\p{L}\p{M}*|\p{N}`                                       // A single number or script character in any language, including chars with accents.
 	usernameGrp              = `(?:` + alphaNumeric + `|\.|\-|\_|` + unicodeEmoji + `)`  // Non-capturing group that matches against a single valid username character, including emojis.
@@ -48,12 +49,14 @@
 	emojiShortcode           = `\w{1,30}`                                                // Pattern for emoji shortcodes. maximumEmojiShortcodeLength = 30
 	emojiFinder              = `(?:\b)?:(` + emojiShortcode + `):(?:\b)?`                // Extract all emoji shortcodes from a text.
 	emojiValidator           = `^` + emojiShortcode + `$`                                // Validate a single emoji shortcode.
+	unicodeEmojiValidator    = `^(?:` + unicodeEmoji + `)$`                              // Validate a single unicode emoji.
 	doubleSpaceFinder        = `(?:\b)?(?:\s|^) (?:\b)?`                                 // Extract all double whitespaces from a text.
 	usernameStrict           = `^[a-z0-9_]{1,64}$`                                       // Pattern for usernames on THIS instance. maximumUsernameLength = 64
 	usernameRelaxed          = `[a-z0-9_\.]{1,}`                                         // Relaxed version of username that can match instance accounts too.
 	misskeyReportNotesFinder = `(?m)(?:^Note: ((?:http|https):\/\/.*)$)`                 // Extract reported Note URIs from the text of a Misskey report/flag.
 	ulid                     = `[0123456789ABCDEFGHJKMNPQRSTVWXYZ]{26}`                  // Pattern for ULID.
 	ulidValidate             = `^` + ulid + `$`                                          // Validate one ULID.
+	hexColor                 = `^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`                    // Validate one #rgb or #rrggbb hex color.
 
 	/*
 		Path parts / capture.
@@ -74,6 +77,7 @@
 	statusesPath      = userPathPrefix + `/` + statuses + `/(` + ulid + `)$`
 	acceptsPath       = userPathPrefix + `/` + accepts + `/(` + ulid + `)$`
 	blockPath         = userPathPrefix + `/` + blocks + `/(` + ulid + `)$`
+	reactionPath      = userPathPrefix + `/` + reactions + `/(` + ulid + `)$`
 	reportPath        = `^/?` + reports + `/(` + ulid + `)$`
 	filePath          = `^/?(` + ulid + `)/([a-z]+)/([a-z]+)/(` + ulid + `)\.([a-z0-9]+)$`
 )
@@ -96,6 +100,9 @@
 	// EmojiValidator validates an emoji shortcode.
 	EmojiValidator = regexp.MustCompile(emojiValidator)
 
+	// UnicodeEmojiValidator validates a single unicode emoji.
+	UnicodeEmojiValidator = regexp.MustCompile(unicodeEmojiValidator)
+
 	// EmojiFinder extracts emoji strings from a piece of text.
 	// See: https://regex101.com/r/478XGM/1
 	EmojiFinder = regexp.MustCompile(emojiFinder)
@@ -137,6 +144,9 @@
 	// ULID parses and validate a ULID.
 	ULID = regexp.MustCompile(ulidValidate)
 
+	// HexColor validates a short or long form hex color, eg #f0a or #ff00aa.
+	HexColor = regexp.MustCompile(hexColor)
+
 	// FollowPath parses a path that validates and captures the username part and the ulid part
 	// from eg /users/example_username/follow/01F7XT5JZW1WMVSW1KADS8PVDH
 	FollowPath = regexp.MustCompile(followPath)
@@ -154,6 +164,10 @@
 	// from eg /users/example_username/blocks/01F7XT5JZW1WMVSW1KADS8PVDH
 	BlockPath = regexp.MustCompile(blockPath)
 
+	// ReactionPath parses a path that validates and captures the username part and the ulid part
+	// from eg /users/example_username/reactions/01F7XT5JZW1WMVSW1KADS8PVDH
+	ReactionPath = regexp.MustCompile(reactionPath)
+
 	// ReportPath parses a path that validates and captures the ulid part
 	// from eg /reports/01GP3AWY4CRDVRNZKW0TEAMB5R
 	ReportPath = regexp.MustCompile(reportPath)
@@ -0,0 +1,343 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package relme
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// maxPageSize is the max size of a
+// linked page we're willing to read.
+const maxPageSize = 1024 * 1024
+
+// Verifier verifies links in account profile fields,
+// by checking that the linked page contains a rel="me"
+// link back to the account's profile.
+//
+// A nil Verifier is safe to use, and verifies nothing.
+type Verifier struct {
+	client    *http.Client
+	userAgent string
+}
+
+// New returns a new Verifier making
+// requests with the given transport.
+func New(rt http.RoundTripper) *Verifier {
+	return &Verifier{
+		client: &http.Client{
+			// Pass in our wrapped httpclient.Client{}
+			// type as http.Transport{} in order to take
+			// advantage of retries, SSF protection etc.
+			Transport: rt,
+		},
+		userAgent: fmt.Sprintf("gotosocial/%s (+%s://%s)",
+			config.GetSoftwareVersion(),
+			config.GetProtocol(),
+			config.GetHost(),
+		),
+	}
+}
+
+// VerifyAccount checks all unverified link fields of the
+// account with given ID, updating the account in the
+// database with the verification time of any verified.
+//
+// This makes outgoing HTTP requests, so should be
+// called from a worker and not a request handler.
+func (v *Verifier) VerifyAccount(ctx context.Context, database db.DB, accountID string) {
+	if v == nil {
+		return
+	}
+
+	// Fetch latest copy of account,
+	// to not overwrite any changes.
+	account, err := database.GetAccountByID(ctx, accountID)
+	if err != nil {
+		log.Errorf(ctx, "error getting account %s: %v", accountID, err)
+		return
+	}
+
+	// Addresses that a link back
+	// to the profile can point to.
+	profileURLs := []string{account.URI}
+	if account.URL != "" {
+		profileURLs = append(profileURLs, account.URL)
+	}
+
+	var verified bool
+	for i, field := range account.Fields {
+		if !field.VerifiedAt.IsZero() {
+			// Already done.
+			continue
+		}
+
+		page := FieldURL(field.Value)
+		if page == nil {
+			// Not a link.
+			continue
+		}
+
+		ok, err := v.Verify(ctx, page, profileURLs)
+		if err != nil {
+			log.Debugf(ctx, "error verifying %s for account %s: %v", page, account.URI, err)
+			continue
+		}
+
+		if !ok {
+			// No link back.
+			continue
+		}
+
+		field.VerifiedAt = time.Now()
+		verified = true
+
+		if i < len(account.FieldsRaw) {
+			// Set on raw copy of field too,
+			// so it survives reprocessing.
+			account.FieldsRaw[i].VerifiedAt = field.VerifiedAt
+		}
+	}
+
+	if !verified {
+		// Nothing changed.
+		return
+	}
+
+	columns := []string{"fields"}
+	if account.IsLocal() {
+		columns = append(columns, "fields_raw")
+	}
+
+	if err := database.UpdateAccount(ctx, account, columns...); err != nil {
+		log.Errorf(ctx, "error updating account %s: %v", account.URI, err)
+	}
+}
+
+// Verify fetches the page at given URL, and returns
+// whether it contains a rel="me" link (either an <a>
+// or <link> element) to any of the given profile URLs.
+func (v *Verifier) Verify(ctx context.Context, page *url.URL, profileURLs []string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx,
+		http.MethodGet,
+		page.String(),
+		nil,
+	)
+	if err != nil {
+		return false, gtserror.Newf("error building request: %w", err)
+	}
+
+	req.Header.Set("Accept", "text/html")
+	req.Header.Set("User-Agent", v.userAgent)
+
+	rsp, err := v.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode < 200 || rsp.StatusCode > 299 {
+		return false, gtserror.NewFromResponse(rsp)
+	}
+
+	ct := rsp.Header.Get("Content-Type")
+	if !strings.HasPrefix(ct, "text/html") {
+		return false, gtserror.Newf("unexpected content type %q", ct)
+	}
+
+	// Parse (limited) response body as HTML.
+	lr := io.LimitReader(rsp.Body, maxPageSize)
+	doc, err := html.Parse(lr)
+	if err != nil {
+		return false, gtserror.Newf("error parsing html: %w", err)
+	}
+
+	// Base for resolving relative links,
+	// taking any redirects into account.
+	base := rsp.Request.URL
+
+	for node := range doc.Descendants() {
+		if node.Type != html.ElementNode ||
+			(node.DataAtom != atom.A && node.DataAtom != atom.Link) {
+			continue
+		}
+
+		var rel, href string
+		for _, attr := range node.Attr {
+			switch attr.Key {
+			case "rel":
+				rel = attr.Val
+			case "href":
+				href = attr.Val
+			}
+		}
+
+		if !slices.Contains(strings.Fields(strings.ToLower(rel)), "me") {
+			// Not a rel="me" link.
+			continue
+		}
+
+		link, err := base.Parse(href)
+		if err != nil {
+			continue
+		}
+
+		if matchesAny(link.String(), profileURLs) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// FieldURL returns the http(s) URL linked to by given
+// profile field value, which may be either a plain URL
+// (as in raw local fields) or an HTML link whose text
+// matches its href (as in processed or remote fields).
+// Returns nil if the value isn't just a single link.
+func FieldURL(value string) *url.URL {
+	value = strings.TrimSpace(value)
+
+	if strings.HasPrefix(value, "<") {
+		// Extract single link from HTML.
+		href, ok := linkHref(value)
+		if !ok {
+			return nil
+		}
+		value = href
+	}
+
+	u, err := url.Parse(value)
+	if err != nil ||
+		(u.Scheme != "http" && u.Scheme != "https") ||
+		u.Host == "" {
+		return nil
+	}
+
+	return u
+}
+
+// CarryVerified copies the verification time of any
+// fields in prev to fields in next with same name and
+// value, ie., fields that are unchanged by an update.
+// Returns whether any fields in next were not in prev.
+func CarryVerified(prev, next []*gtsmodel.Field) (changed bool) {
+outer:
+	for _, field := range next {
+		for _, old := range prev {
+			if field.Name == old.Name &&
+				field.Value == old.Value {
+				field.VerifiedAt = old.VerifiedAt
+				continue outer
+			}
+		}
+		changed = true
+	}
+	return changed
+}
+
+// NeedsVerify returns whether any of the
+// given fields are unverified links.
+func NeedsVerify(fields []*gtsmodel.Field) bool {
+	for _, field := range fields {
+		if field.VerifiedAt.IsZero() &&
+			FieldURL(field.Value) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// linkHref returns the href of the single <a> element
+// in given HTML fragment, only if its text content is
+// the same URL (ignoring scheme), to prevent verifying
+// links hidden behind some other misleading text.
+func linkHref(fragment string) (string, bool) {
+	nodes, err := html.ParseFragment(
+		strings.NewReader(fragment),
+		&html.Node{
+			Type:     html.ElementNode,
+			Data:     "body",
+			DataAtom: atom.Body,
+		},
+	)
+	if err != nil || len(nodes) != 1 {
+		return "", false
+	}
+
+	a := nodes[0]
+	if a.Type != html.ElementNode || a.DataAtom != atom.A {
+		return "", false
+	}
+
+	var href string
+	for _, attr := range a.Attr {
+		if attr.Key == "href" {
+			href = attr.Val
+		}
+	}
+
+	// Gather all text content of link.
+	var text strings.Builder
+	for node := range a.Descendants() {
+		if node.Type == html.TextNode {
+			text.WriteString(node.Data)
+		}
+	}
+
+	if trimScheme(text.String()) != trimScheme(href) {
+		return "", false
+	}
+
+	return href, true
+}
+
+// matchesAny returns whether link matches any of the
+// given URLs, ignoring case and any trailing slash.
+func matchesAny(link string, urls []string) bool {
+	link = strings.TrimSuffix(link, "/")
+	for _, u := range urls {
+		if strings.EqualFold(link, strings.TrimSuffix(u, "/")) {
+			return true
+		}
+	}
+	return false
+}
+
+// trimScheme trims any http(s) scheme
+// and trailing slash from given URL.
+func trimScheme(u string) string {
+	u = strings.TrimSpace(u)
+	u = strings.TrimPrefix(u, "https://")
+	u = strings.TrimPrefix(u, "http://")
+	return strings.TrimSuffix(u, "/")
+}
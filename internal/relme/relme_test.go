@@ -0,0 +1,151 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package relme_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/relme"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldURL(t *testing.T) {
+	for _, test := range []struct {
+		value  string
+		expect string
+	}{
+		{
+			value:  "https://example.org",
+			expect: "https://example.org",
+		},
+		{
+			value:  "they/them",
+			expect: "",
+		},
+		{
+			value:  "ftp://example.org",
+			expect: "",
+		},
+		{
+			// Processed local field.
+			value:  `<a href="https://example.org" rel="nofollow noreferrer noopener" target="_blank">https://example.org</a>`,
+			expect: "https://example.org",
+		},
+		{
+			// Remote Mastodon field.
+			value:  `<a href="https://example.org/about" rel="me nofollow noopener" target="_blank"><span class="invisible">https://</span><span class="">example.org/about</span><span class="invisible"></span></a>`,
+			expect: "https://example.org/about",
+		},
+		{
+			// Link text doesn't match href.
+			value:  `<a href="https://example.org">https://example.com</a>`,
+			expect: "",
+		},
+		{
+			// More than just a link.
+			value:  `see <a href="https://example.org">https://example.org</a>`,
+			expect: "",
+		},
+	} {
+		var got string
+		if u := relme.FieldURL(test.value); u != nil {
+			got = u.String()
+		}
+		assert.Equal(t, test.expect, got, test.value)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	testrig.InitTestConfig()
+
+	const profile = "http://localhost:8080/@the_mighty_zork"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/linked", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte(`<html><head><link rel="me" href="` + profile + `/"></head><body></body></html>`))
+	})
+	mux.HandleFunc("/anchor", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte(`<html><body><a rel="nofollow Me" href="` + profile + `">zork</a></body></html>`))
+	})
+	mux.HandleFunc("/notme", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte(`<html><body><a href="` + profile + `">zork</a></body></html>`))
+	})
+	mux.HandleFunc("/json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"rel":"me"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	verifier := relme.New(http.DefaultTransport)
+
+	for _, test := range []struct {
+		path   string
+		expect bool
+		err    bool
+	}{
+		{path: "/linked", expect: true},
+		{path: "/anchor", expect: true},
+		{path: "/notme", expect: false},
+		{path: "/json", err: true},
+		{path: "/missing", err: true},
+	} {
+		page := testrig.URLMustParse(server.URL + test.path)
+		ok, err := verifier.Verify(t.Context(), page, []string{profile})
+		if test.err {
+			assert.Error(t, err, test.path)
+			continue
+		}
+		assert.NoError(t, err, test.path)
+		assert.Equal(t, test.expect, ok, test.path)
+	}
+}
+
+func TestCarryVerified(t *testing.T) {
+	verifiedAt := time.Now()
+
+	prev := []*gtsmodel.Field{
+		{Name: "website", Value: "https://example.org", VerifiedAt: verifiedAt},
+		{Name: "pronouns", Value: "they/them"},
+	}
+
+	// Same fields, just reordered.
+	next := []*gtsmodel.Field{
+		{Name: "pronouns", Value: "they/them"},
+		{Name: "website", Value: "https://example.org"},
+	}
+	assert.False(t, relme.CarryVerified(prev, next))
+	assert.Equal(t, verifiedAt, next[1].VerifiedAt)
+	assert.False(t, relme.NeedsVerify(next))
+
+	// Changed website.
+	next = []*gtsmodel.Field{
+		{Name: "website", Value: "https://example.com"},
+	}
+	assert.True(t, relme.CarryVerified(prev, next))
+	assert.True(t, next[0].VerifiedAt.IsZero())
+	assert.True(t, relme.NeedsVerify(next))
+}
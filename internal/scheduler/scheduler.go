@@ -33,6 +33,10 @@ type Scheduler struct {
 	sch sched.Scheduler
 	ts  map[string]*task
 	mu  sync.Mutex
+
+	// paused tasks.
+	paused bool
+	held   map[string]func()
 }
 
 // Start attempts to start the scheduler. Returns false if already running.
@@ -49,11 +53,38 @@ func (sch *Scheduler) Start() bool {
 func (sch *Scheduler) Stop() bool {
 	if sch.sch.Stop() {
 		sch.ts = nil
+		sch.mu.Lock()
+		sch.paused = false
+		sch.held = nil
+		sch.mu.Unlock()
 		return true
 	}
 	return false
 }
 
+// Pause will pause the running of scheduled tasks. Any task
+// due while paused is held until Resume() is called, at which
+// point it will be run, once only, even if recurring.
+func (sch *Scheduler) Pause() {
+	sch.mu.Lock()
+	sch.paused = true
+	sch.mu.Unlock()
+}
+
+// Resume will resume the running of scheduled tasks
+// after a call to Pause(), running any held tasks.
+func (sch *Scheduler) Resume() {
+	sch.mu.Lock()
+	held := sch.held
+	sch.paused = false
+	sch.held = nil
+	sch.mu.Unlock()
+
+	for _, fn := range held {
+		go fn()
+	}
+}
+
 // AddOnce schedules the given task to run at time, registered under the given ID. Returns false if task already exists for id.
 func (sch *Scheduler) AddOnce(id string, start time.Time, fn func(context.Context, time.Time)) bool {
 	return sch.schedule(id, fn, (*sched.Once)(&start))
@@ -71,6 +102,7 @@ func (sch *Scheduler) Cancel(id string) bool {
 	sch.mu.Lock()
 	task, ok := sch.ts[id]
 	delete(sch.ts, id)
+	delete(sch.held, id)
 	sch.mu.Unlock()
 
 	if !ok {
@@ -105,6 +137,11 @@ func (sch *Scheduler) schedule(id string, fn func(context.Context, time.Time), t
 	// Create a new job to hold task function with
 	// timing, passing in the current sched context.
 	job := sched.NewJob(func(now time.Time) {
+		if sch.hold(id, func() { fn(ctx, now) }) {
+			// paused, task
+			// run on resume.
+			return
+		}
 		fn(ctx, now)
 	}).With(t)
 
@@ -119,6 +156,24 @@ func (sch *Scheduler) schedule(id string, fn func(context.Context, time.Time), t
 	return true
 }
 
+// hold will store task function under id to be run on
+// Resume() if currently paused, returning whether held.
+func (sch *Scheduler) hold(id string, fn func()) bool {
+	sch.mu.Lock()
+	defer sch.mu.Unlock()
+
+	if !sch.paused {
+		return false
+	}
+
+	if sch.held == nil {
+		sch.held = make(map[string]func())
+	}
+
+	sch.held[id] = fn
+	return true
+}
+
 // task simply wraps together a scheduled
 // job, and the matching cancel function.
 type task struct {
@@ -20,8 +20,13 @@
 import (
 	"code.superseriousbusiness.org/gotosocial/internal/admin"
 	"code.superseriousbusiness.org/gotosocial/internal/cache"
+	"code.superseriousbusiness.org/gotosocial/internal/captcha"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/hooks"
+	"code.superseriousbusiness.org/gotosocial/internal/readonly"
+	"code.superseriousbusiness.org/gotosocial/internal/relme"
 	"code.superseriousbusiness.org/gotosocial/internal/storage"
+	"code.superseriousbusiness.org/gotosocial/internal/translate"
 	"code.superseriousbusiness.org/gotosocial/internal/workers"
 	"codeberg.org/gruf/go-mutexes"
 )
@@ -69,6 +74,31 @@ type State struct {
 	// actions (and locks thereupon).
 	AdminActions *admin.Actions
 
+	// Hooks provides access to any
+	// configured moderation hooks.
+	// A nil value allows everything.
+	Hooks *hooks.Hooks
+
+	// Translator provides access to any
+	// configured translation backend.
+	// A nil value disables translation.
+	Translator *translate.Translator
+
+	// Captcha provides access to any
+	// configured sign-up captcha provider.
+	// A nil value disables captcha.
+	Captcha *captcha.Captcha
+
+	// RelMe provides verification of
+	// links in account profile fields.
+	// A nil value disables verification.
+	RelMe *relme.Verifier
+
+	// ReadOnly manages the instance's
+	// read-only maintenance mode.
+	// A nil value is never enabled.
+	ReadOnly *readonly.Mode
+
 	// prevent pass-by-value.
 	_ nocopy
 }
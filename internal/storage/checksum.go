@@ -0,0 +1,101 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+)
+
+// checksumReader wraps an io.Reader to
+// calculate a SHA-256 checksum of all
+// data as it gets read from the reader.
+type checksumReader struct {
+	io.Reader
+	hash hash.Hash
+}
+
+// newChecksumReader wraps reader in a new checksumReader.
+func newChecksumReader(r io.Reader) *checksumReader {
+	return &checksumReader{Reader: r, hash: sha256.New()}
+}
+
+// Read implements io.Reader, hashing read data.
+func (r *checksumReader) Read(b []byte) (int, error) {
+	n, err := r.Reader.Read(b)
+	_, _ = r.hash.Write(b[:n])
+	return n, err
+}
+
+// Sum returns the hex encoded checksum of all data read.
+func (r *checksumReader) Sum() string {
+	return hex.EncodeToString(r.hash.Sum(nil))
+}
+
+// checksumCopyFn wraps a disk.Config{}.CopyFn, such that
+// when the source stream is a *checksumReader{} the given
+// copy function is performed on the underlying reader,
+// while also writing to the hash. The copy function's
+// buffer pooling is still used, but as all data has to
+// pass through the hash, wrapping the destination in an
+// io.MultiWriter{} disables any io.ReaderFrom / sendfile
+// fast paths dependent on the destination writer type.
+func checksumCopyFn(copyFn func(io.Writer, io.Reader) (int64, error)) func(io.Writer, io.Reader) (int64, error) {
+	return func(w io.Writer, r io.Reader) (int64, error) {
+		if cr, ok := r.(*checksumReader); ok {
+			w = io.MultiWriter(w, cr.hash)
+			r = cr.Reader
+		}
+		return copyFn(w, r)
+	}
+}
+
+// Verify reads the value at key in the storage, comparing its
+// SHA-256 checksum to the given hex encoded checksum, returning
+// whether they match. Returns storage not-found errors as-is.
+func (d *Driver) Verify(ctx context.Context, key string, checksum string) (bool, error) {
+
+	// Open a read stream for key.
+	rc, err := d.GetStream(ctx, key)
+	if err != nil {
+		return false, err
+	}
+
+	// Wrap the read stream to calculate checksum.
+	cr := newChecksumReader(rc)
+
+	// Read the entire stream into the hash.
+	_, err = io.Copy(io.Discard, cr)
+
+	// Close the stream: done with it.
+	if e := rc.Close(); e != nil {
+		log.Errorf(ctx, "error closing stream %s: %v", key, e)
+	}
+
+	if err != nil {
+		return false, gtserror.Newf("error reading %s: %w", key, err)
+	}
+
+	return (cr.Sum() == checksum), nil
+}
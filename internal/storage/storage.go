@@ -22,6 +22,7 @@
 import (
 	"context"
 	"fmt"
+	"io"
 	"mime"
 	"net/url"
 	"os"
@@ -52,6 +53,10 @@ type Driver struct {
 	// Underlying storage
 	Storage storage.Storage
 
+	// Checksums enables calculating
+	// SHA-256 checksums in PutFile().
+	Checksums bool
+
 	// S3-only parameters
 	Proxy          bool
 	Bucket         string
@@ -60,15 +65,31 @@ type Driver struct {
 }
 
 // PutFile moves the contents of file at path, to storage.Driver{} under given key (with content-type if supported).
-func (d *Driver) PutFile(ctx context.Context, key, filepath, contentType string) (int64, error) {
+// If checksums are enabled on the driver, this also returns the hex encoded SHA-256 checksum of the written file.
+func (d *Driver) PutFile(ctx context.Context, key, filepath, contentType string) (int64, string, error) {
 
 	// Open file at path for reading.
 	file, err := os.Open(filepath)
 	if err != nil {
-		return 0, gtserror.Newf("error opening file %s: %w", filepath, err)
+		return 0, "", gtserror.Newf("error opening file %s: %w", filepath, err)
 	}
 
-	var sz int64
+	var (
+		sz  int64
+		sum string
+
+		// Reader for
+		// file data.
+		rd io.Reader = file
+	)
+
+	// If checksums are enabled wrap
+	// the file to calculate checksum.
+	var cr *checksumReader
+	if d.Checksums {
+		cr = newChecksumReader(file)
+		rd = cr
+	}
 
 	switch d := d.Storage.(type) {
 	case *s3.S3Storage:
@@ -77,7 +98,7 @@ func (d *Driver) PutFile(ctx context.Context, key, filepath, contentType string)
 		// For S3 storage, write the file but specifically pass in the
 		// content-type as an extra option. This handles the case of media
 		// being served via CDN redirect (where we don't handle content-type).
-		info, err = d.PutObject(ctx, key, file, minio.PutObjectOptions{
+		info, err = d.PutObject(ctx, key, rd, minio.PutObjectOptions{
 			ContentType: contentType,
 		})
 
@@ -89,12 +110,14 @@ func (d *Driver) PutFile(ctx context.Context, key, filepath, contentType string)
 		// Write the file data to storage under key. Note
 		// that for disk.DiskStorage{} this should end up
 		// being a highly optimized Linux sendfile syscall.
-		sz, err = d.WriteStream(ctx, key, file)
+		sz, err = d.WriteStream(ctx, key, rd)
 	}
 
 	// Wrap write error.
 	if err != nil {
 		err = gtserror.Newf("error writing file %s: %w", key, err)
+	} else if cr != nil {
+		sum = cr.Sum()
 	}
 
 	// Close the file: done with it.
@@ -102,7 +125,7 @@ func (d *Driver) PutFile(ctx context.Context, key, filepath, contentType string)
 		log.Errorf(ctx, "error closing file %s: %v", filepath, e)
 	}
 
-	return sz, err
+	return sz, sum, err
 }
 
 // URL will return a presigned GET object URL, but only if running on S3 storage with proxying disabled.
@@ -98,6 +98,8 @@ func AutoConfig() (*Driver, error) {
 		return NewS3Storage()
 	case "local":
 		return NewFileStorage()
+	case "webdav":
+		return NewWebDAVStorage()
 	default:
 		return nil, fmt.Errorf("invalid storage backend: %s", backend)
 	}
@@ -117,11 +119,37 @@ func NewFileStorage() (*Driver, error) {
 	diskCfg := disk.DefaultConfig()
 	diskCfg.CopyFn = fastcopy.Copy
 
+	// Check if file checksums were requested.
+	checksums := config.GetStorageLocalChecksums()
+	if checksums {
+
+		// Wrap copy function to calculate
+		// checksum on copy where necessary.
+		diskCfg.CopyFn = checksumCopyFn(diskCfg.CopyFn)
+	}
+
 	// Open the disk storage implementation
 	disk, err := disk.Open(basePath, &diskCfg)
 	if err != nil {
 		return nil, fmt.Errorf("error opening disk storage: %w", err)
 	}
 
-	return &Driver{Storage: disk}, nil
+	return &Driver{
+		Storage:   disk,
+		Checksums: checksums,
+	}, nil
+}
+
+func NewWebDAVStorage() (*Driver, error) {
+	// Open the webdav storage implementation
+	webdav, err := OpenWebDAV(
+		config.GetStorageWebDAVURL(),
+		config.GetStorageWebDAVUsername(),
+		config.GetStorageWebDAVPassword(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error opening webdav storage: %w", err)
+	}
+
+	return &Driver{Storage: webdav}, nil
 }
@@ -22,6 +22,7 @@
 import (
 	"context"
 	"errors"
+	"io"
 	"os"
 
 	"code.superseriousbusiness.org/gopkg/log"
@@ -31,25 +32,51 @@
 
 // Driver wraps a disk or memory storage.Storage
 // to provide optimized write operations.
-type Driver struct{ Storage storage.Storage }
+type Driver struct {
+
+	// Underlying storage
+	Storage storage.Storage
+
+	// Checksums enables calculating
+	// SHA-256 checksums in PutFile().
+	Checksums bool
+}
 
 // PutFile: see PutFile() in storage.go.
-func (d *Driver) PutFile(ctx context.Context, key, filepath, _ string) (int64, error) {
+func (d *Driver) PutFile(ctx context.Context, key, filepath, _ string) (int64, string, error) {
 
 	// Open file at path for reading.
 	file, err := os.Open(filepath)
 	if err != nil {
-		return 0, gtserror.Newf("error opening file %s: %w", filepath, err)
+		return 0, "", gtserror.Newf("error opening file %s: %w", filepath, err)
+	}
+
+	var (
+		sum string
+
+		// Reader for
+		// file data.
+		rd io.Reader = file
+	)
+
+	// If checksums are enabled wrap
+	// the file to calculate checksum.
+	var cr *checksumReader
+	if d.Checksums {
+		cr = newChecksumReader(file)
+		rd = cr
 	}
 
 	// Write the file data to storage under key. Note
 	// that for disk.DiskStorage{} this should end up
 	// being a highly optimized Linux sendfile syscall.
-	sz, err := d.Storage.WriteStream(ctx, key, file)
+	sz, err := d.Storage.WriteStream(ctx, key, rd)
 
 	// Wrap write error.
 	if err != nil {
 		err = gtserror.Newf("error writing file %s: %w", key, err)
+	} else if cr != nil {
+		sum = cr.Sum()
 	}
 
 	// Close the file: done with it.
@@ -57,7 +84,7 @@ func (d *Driver) PutFile(ctx context.Context, key, filepath, _ string) (int64, e
 		log.Errorf(ctx, "error closing file %s: %v", filepath, e)
 	}
 
-	return sz, err
+	return sz, sum, err
 }
 
 // URL: not implemented for 'nos3'.
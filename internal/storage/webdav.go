@@ -0,0 +1,505 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"codeberg.org/gruf/go-storage"
+)
+
+// propfindBody is the request body sent with PROPFIND
+// requests, asking only for properties we make use of.
+const propfindBody = `<?xml version="1.0" encoding="utf-8"?>` +
+	`<D:propfind xmlns:D="DAV:"><D:prop>` +
+	`<D:resourcetype/><D:getcontentlength/><D:getlastmodified/>` +
+	`</D:prop></D:propfind>`
+
+// WebDAVStorage implements storage.Storage{}
+// on top of a remote WebDAV server collection,
+// using only the core methods from RFC 4918.
+type WebDAVStorage struct {
+	client   *http.Client
+	base     *url.URL
+	username string
+	password string
+
+	// dirs caches collection paths
+	// known to exist on the server,
+	// to save on MKCOL requests.
+	dirs sync.Map
+}
+
+// OpenWebDAV opens a new WebDAVStorage{} rooted at the collection
+// at given URL, using basic authentication if username is set.
+// The root collection will be created if it does not yet exist.
+func OpenWebDAV(rawURL, username, password string) (*WebDAVStorage, error) {
+	base, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid webdav url: %w", err)
+	}
+
+	if base.Scheme != "http" && base.Scheme != "https" {
+		return nil, fmt.Errorf("invalid webdav url scheme: %s", base.Scheme)
+	}
+
+	// Ensure the base is treated as
+	// a collection when joining keys.
+	if !strings.HasSuffix(base.Path, "/") {
+		base.Path += "/"
+	}
+
+	st := &WebDAVStorage{
+		client:   &http.Client{},
+		base:     base,
+		username: username,
+		password: password,
+	}
+
+	// Check the root collection exists, creating it if not.
+	if err := st.mkcol(context.Background(), ""); err != nil {
+		return nil, fmt.Errorf("error opening webdav root collection: %w", err)
+	}
+
+	return st, nil
+}
+
+// ReadBytes: implements Storage.ReadBytes().
+func (st *WebDAVStorage) ReadBytes(ctx context.Context, key string) ([]byte, error) {
+	rc, err := st.ReadStream(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+// ReadStream: implements Storage.ReadStream().
+func (st *WebDAVStorage) ReadStream(ctx context.Context, key string) (io.ReadCloser, error) {
+	u, err := st.keyURL(key)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := st.do(ctx, http.MethodGet, u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	switch rsp.StatusCode {
+	case http.StatusOK:
+		return rsp.Body, nil
+	case http.StatusNotFound:
+		_ = rsp.Body.Close()
+		return nil, storage.ErrNotFound
+	default:
+		return nil, statusError(rsp)
+	}
+}
+
+// WriteBytes: implements Storage.WriteBytes().
+func (st *WebDAVStorage) WriteBytes(ctx context.Context, key string, value []byte) (int, error) {
+	n, err := st.WriteStream(ctx, key, bytes.NewReader(value))
+	return int(n), err
+}
+
+// WriteStream: implements Storage.WriteStream().
+func (st *WebDAVStorage) WriteStream(ctx context.Context, key string, r io.Reader) (int64, error) {
+	u, err := st.keyURL(key)
+	if err != nil {
+		return 0, err
+	}
+
+	// Ensure all parent collections
+	// exist, as PUT won't create them.
+	if dir := path.Dir(key); dir != "." {
+		if err := st.mkcolAll(ctx, dir); err != nil {
+			return 0, err
+		}
+	}
+
+	// Wrap reader to count
+	// written data length.
+	cr := &countReader{r: r}
+
+	rsp, err := st.do(ctx, http.MethodPut, u, nil, cr)
+	if err != nil {
+		return 0, err
+	}
+
+	switch rsp.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusNoContent:
+		_ = rsp.Body.Close()
+		return cr.n, nil
+	default:
+		return 0, statusError(rsp)
+	}
+}
+
+// Stat: implements Storage.Stat().
+func (st *WebDAVStorage) Stat(ctx context.Context, key string) (*storage.Entry, error) {
+	u, err := st.keyURL(key)
+	if err != nil {
+		return nil, err
+	}
+
+	resps, err := st.propfind(ctx, u, "0")
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	for _, resp := range resps {
+		prop, ok := resp.prop()
+		if !ok || prop.isCollection() {
+			continue
+		}
+
+		return &storage.Entry{
+			Key:      key,
+			Size:     prop.size(),
+			Modified: prop.modified(),
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// Remove: implements Storage.Remove().
+func (st *WebDAVStorage) Remove(ctx context.Context, key string) error {
+	u, err := st.keyURL(key)
+	if err != nil {
+		return err
+	}
+
+	rsp, err := st.do(ctx, http.MethodDelete, u, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	switch rsp.StatusCode {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+		_ = rsp.Body.Close()
+		return nil
+	case http.StatusNotFound:
+		_ = rsp.Body.Close()
+		return storage.ErrNotFound
+	default:
+		return statusError(rsp)
+	}
+}
+
+// Clean: implements Storage.Clean().
+func (st *WebDAVStorage) Clean(ctx context.Context) error {
+	// Empty collections are left in place, as
+	// they will most likely be reused on next
+	// write, and removing them is not atomic.
+	return nil
+}
+
+// WalkKeys: implements Storage.WalkKeys().
+func (st *WebDAVStorage) WalkKeys(ctx context.Context, opts storage.WalkKeysOpts) error {
+	if opts.Step == nil {
+		panic("nil step fn")
+	}
+
+	// Start walking from the deepest
+	// collection contained in prefix.
+	dir := opts.Prefix
+	if i := strings.LastIndexByte(dir, '/'); i >= 0 {
+		dir = dir[:i]
+	} else {
+		dir = ""
+	}
+
+	return st.walk(ctx, dir, opts)
+}
+
+// walk recursively walks the collection
+// at dir, calling opts.Step() on entries.
+func (st *WebDAVStorage) walk(ctx context.Context, dir string, opts storage.WalkKeysOpts) error {
+	u := st.base
+	if dir != "" {
+		u = st.base.JoinPath(dir + "/")
+	}
+
+	resps, err := st.propfind(ctx, u, "1")
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	for _, resp := range resps {
+		prop, ok := resp.prop()
+		if !ok {
+			continue
+		}
+
+		// Get storage key from href.
+		key, ok := st.hrefKey(resp.Href)
+		if !ok || key == dir {
+			// Skip unknown
+			// and self.
+			continue
+		}
+
+		if prop.isCollection() {
+			// Only descend into collections that
+			// could contain keys matching prefix.
+			if strings.HasPrefix(key+"/", opts.Prefix) ||
+				strings.HasPrefix(opts.Prefix, key+"/") {
+				if err := st.walk(ctx, key, opts); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if !strings.HasPrefix(key, opts.Prefix) {
+			continue
+		}
+
+		if opts.Filter != nil && !opts.Filter(key) {
+			continue
+		}
+
+		if err := opts.Step(storage.Entry{
+			Key:      key,
+			Size:     prop.size(),
+			Modified: prop.modified(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mkcolAll ensures that the collection
+// at dir, and all of its parents, exist.
+func (st *WebDAVStorage) mkcolAll(ctx context.Context, dir string) error {
+	if _, ok := st.dirs.Load(dir); ok {
+		return nil
+	}
+
+	if parent := path.Dir(dir); parent != "." {
+		if err := st.mkcolAll(ctx, parent); err != nil {
+			return err
+		}
+	}
+
+	if err := st.mkcol(ctx, dir); err != nil {
+		return err
+	}
+
+	st.dirs.Store(dir, struct{}{})
+	return nil
+}
+
+// mkcol creates the collection at dir,
+// returning nil if it already exists.
+func (st *WebDAVStorage) mkcol(ctx context.Context, dir string) error {
+	u := st.base
+	if dir != "" {
+		u = st.base.JoinPath(dir + "/")
+	}
+
+	rsp, err := st.do(ctx, "MKCOL", u, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	switch rsp.StatusCode {
+	case http.StatusCreated,
+		// 405 Method Not Allowed is
+		// returned for existing paths.
+		http.StatusMethodNotAllowed:
+		_ = rsp.Body.Close()
+		return nil
+	default:
+		return statusError(rsp)
+	}
+}
+
+// propfind performs a PROPFIND request
+// on the given URL at given depth.
+func (st *WebDAVStorage) propfind(ctx context.Context, u *url.URL, depth string) ([]davResponse, error) {
+	hdr := http.Header{}
+	hdr.Set("Depth", depth)
+	hdr.Set("Content-Type", "application/xml; charset=utf-8")
+
+	rsp, err := st.do(ctx, "PROPFIND", u, hdr, strings.NewReader(propfindBody))
+	if err != nil {
+		return nil, err
+	}
+
+	switch rsp.StatusCode {
+	case http.StatusMultiStatus:
+	case http.StatusNotFound:
+		_ = rsp.Body.Close()
+		return nil, storage.ErrNotFound
+	default:
+		return nil, statusError(rsp)
+	}
+
+	defer rsp.Body.Close()
+
+	var ms davMultistatus
+	if err := xml.NewDecoder(rsp.Body).Decode(&ms); err != nil {
+		return nil, fmt.Errorf("error decoding PROPFIND response: %w", err)
+	}
+
+	return ms.Responses, nil
+}
+
+// do performs an HTTP request with given
+// method on URL, adding authorization.
+func (st *WebDAVStorage) do(ctx context.Context, method string, u *url.URL, hdr http.Header, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	if hdr != nil {
+		req.Header = hdr
+	}
+
+	if st.username != "" {
+		req.SetBasicAuth(st.username, st.password)
+	}
+
+	return st.client.Do(req)
+}
+
+// keyURL returns the resource URL for
+// key, ensuring the key is well formed.
+func (st *WebDAVStorage) keyURL(key string) (*url.URL, error) {
+	if key == "" || path.IsAbs(key) ||
+		path.Clean(key) != key ||
+		strings.HasPrefix(key, "../") {
+		return nil, storage.ErrInvalidKey
+	}
+	return st.base.JoinPath(key), nil
+}
+
+// hrefKey converts a href from a PROPFIND response
+// into a storage key relative to the base collection.
+func (st *WebDAVStorage) hrefKey(href string) (string, bool) {
+	u, err := url.Parse(href)
+	if err != nil {
+		return "", false
+	}
+
+	key, ok := strings.CutPrefix(u.Path, st.base.Path)
+	if !ok {
+		// Also accept the base
+		// without trailing slash.
+		if u.Path+"/" == st.base.Path {
+			return "", true
+		}
+		return "", false
+	}
+
+	return strings.TrimSuffix(key, "/"), true
+}
+
+// statusError drains and closes the response
+// body, returning an error with response status.
+func statusError(rsp *http.Response) error {
+	_, _ = io.Copy(io.Discard, io.LimitReader(rsp.Body, 4096))
+	_ = rsp.Body.Close()
+	return fmt.Errorf("webdav: %s %s: %s", rsp.Request.Method, rsp.Request.URL.Path, rsp.Status)
+}
+
+// countReader wraps an io.Reader
+// to count the number of bytes read.
+type countReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countReader) Read(b []byte) (int, error) {
+	n, err := r.r.Read(b)
+	r.n += int64(n)
+	return n, err
+}
+
+// davMultistatus models the relevant parts
+// of a WebDAV multistatus response body.
+type davMultistatus struct {
+	Responses []davResponse `xml:"DAV: response"`
+}
+
+type davResponse struct {
+	Href     string        `xml:"DAV: href"`
+	Propstat []davPropstat `xml:"DAV: propstat"`
+}
+
+// prop returns the successfully
+// returned properties of response.
+func (r *davResponse) prop() (*davProp, bool) {
+	for i := range r.Propstat {
+		if strings.Contains(r.Propstat[i].Status, " 200 ") {
+			return &r.Propstat[i].Prop, true
+		}
+	}
+	return nil, false
+}
+
+type davPropstat struct {
+	Prop   davProp `xml:"DAV: prop"`
+	Status string  `xml:"DAV: status"`
+}
+
+type davProp struct {
+	ResourceType struct {
+		Collection *struct{} `xml:"DAV: collection"`
+	} `xml:"DAV: resourcetype"`
+	ContentLength string `xml:"DAV: getcontentlength"`
+	LastModified  string `xml:"DAV: getlastmodified"`
+}
+
+func (p *davProp) isCollection() bool {
+	return p.ResourceType.Collection != nil
+}
+
+func (p *davProp) size() int64 {
+	sz, _ := strconv.ParseInt(p.ContentLength, 10, 64)
+	return sz
+}
+
+func (p *davProp) modified() time.Time {
+	t, _ := http.ParseTime(p.LastModified)
+	return t
+}
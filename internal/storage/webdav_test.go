@@ -0,0 +1,272 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package storage_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/storage"
+	gostorage "codeberg.org/gruf/go-storage"
+	"github.com/stretchr/testify/suite"
+)
+
+type WebDAVTestSuite struct {
+	suite.Suite
+	server  *httptest.Server
+	webdav  *storage.WebDAVStorage
+	handler *fakeWebDAV
+}
+
+func (suite *WebDAVTestSuite) SetupTest() {
+	suite.handler = &fakeWebDAV{
+		files: make(map[string][]byte),
+		dirs:  map[string]bool{"/": true},
+	}
+	suite.server = httptest.NewServer(suite.handler)
+
+	var err error
+	suite.webdav, err = storage.OpenWebDAV(suite.server.URL+"/gts", "", "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *WebDAVTestSuite) TearDownTest() {
+	suite.server.Close()
+}
+
+func (suite *WebDAVTestSuite) TestReadWriteRemove() {
+	ctx := suite.T().Context()
+	const key = "01F8MH1H7YV1Z7D2C8K2730QBF/attachment/original/01F8MH6NEM8D7527KZAECTCR76.jpg"
+
+	// Write creates all parent collections.
+	n, err := suite.webdav.WriteBytes(ctx, key, []byte("hello world"))
+	suite.NoError(err)
+	suite.Equal(11, n)
+	suite.True(suite.handler.dirs["/gts/01F8MH1H7YV1Z7D2C8K2730QBF/attachment/original/"])
+
+	b, err := suite.webdav.ReadBytes(ctx, key)
+	suite.NoError(err)
+	suite.Equal("hello world", string(b))
+
+	entry, err := suite.webdav.Stat(ctx, key)
+	suite.NoError(err)
+	suite.NotNil(entry)
+	suite.Equal(key, entry.Key)
+	suite.Equal(int64(11), entry.Size)
+
+	err = suite.webdav.Remove(ctx, key)
+	suite.NoError(err)
+
+	_, err = suite.webdav.ReadBytes(ctx, key)
+	suite.ErrorIs(err, gostorage.ErrNotFound)
+
+	entry, err = suite.webdav.Stat(ctx, key)
+	suite.NoError(err)
+	suite.Nil(entry)
+
+	err = suite.webdav.Remove(ctx, key)
+	suite.ErrorIs(err, gostorage.ErrNotFound)
+}
+
+func (suite *WebDAVTestSuite) TestInvalidKey() {
+	ctx := suite.T().Context()
+
+	for _, key := range []string{
+		"",
+		"/absolute/key.jpg",
+		"../escape.jpg",
+		"some/../../escape.jpg",
+	} {
+		_, err := suite.webdav.WriteBytes(ctx, key, []byte("nope"))
+		suite.ErrorIs(err, gostorage.ErrInvalidKey, key)
+	}
+}
+
+func (suite *WebDAVTestSuite) TestWalkKeys() {
+	ctx := suite.T().Context()
+
+	for _, key := range []string{
+		"account1/attachment/original/1.jpg",
+		"account1/attachment/small/1.webp",
+		"account1/emoji/original/2.png",
+		"account2/attachment/original/3.jpg",
+	} {
+		_, err := suite.webdav.WriteBytes(ctx, key, []byte(key))
+		suite.NoError(err)
+	}
+
+	walk := func(opts gostorage.WalkKeysOpts) []string {
+		var keys []string
+		opts.Step = func(e gostorage.Entry) error {
+			keys = append(keys, e.Key)
+			return nil
+		}
+		err := suite.webdav.WalkKeys(ctx, opts)
+		suite.NoError(err)
+		sort.Strings(keys)
+		return keys
+	}
+
+	suite.Equal([]string{
+		"account1/attachment/original/1.jpg",
+		"account1/attachment/small/1.webp",
+		"account1/emoji/original/2.png",
+		"account2/attachment/original/3.jpg",
+	}, walk(gostorage.WalkKeysOpts{}))
+
+	suite.Equal([]string{
+		"account1/attachment/original/1.jpg",
+		"account1/attachment/small/1.webp",
+	}, walk(gostorage.WalkKeysOpts{Prefix: "account1/attachment/"}))
+
+	suite.Equal([]string{
+		"account1/emoji/original/2.png",
+	}, walk(gostorage.WalkKeysOpts{Prefix: "account1/emo"}))
+
+	suite.Equal([]string{
+		"account2/attachment/original/3.jpg",
+	}, walk(gostorage.WalkKeysOpts{Filter: func(key string) bool {
+		return strings.HasSuffix(key, "3.jpg")
+	}}))
+
+	suite.Empty(walk(gostorage.WalkKeysOpts{Prefix: "account3/"}))
+}
+
+func TestWebDAVTestSuite(t *testing.T) {
+	suite.Run(t, new(WebDAVTestSuite))
+}
+
+// fakeWebDAV is a minimal in-memory WebDAV
+// server, implementing only what is needed
+// to test the WebDAVStorage{} implementation.
+type fakeWebDAV struct {
+	mu    sync.Mutex
+	files map[string][]byte
+	dirs  map[string]bool
+}
+
+func (f *fakeWebDAV) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	p := r.URL.Path
+	switch r.Method {
+	case http.MethodGet:
+		b, ok := f.files[p]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(b)
+
+	case http.MethodPut:
+		if !f.dirs[parentDir(p)] {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		b, _ := io.ReadAll(r.Body)
+		f.files[p] = b
+		w.WriteHeader(http.StatusCreated)
+
+	case http.MethodDelete:
+		if _, ok := f.files[p]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(f.files, p)
+		w.WriteHeader(http.StatusNoContent)
+
+	case "MKCOL":
+		if f.dirs[p] {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if !f.dirs[parentDir(p)] {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		f.dirs[p] = true
+		w.WriteHeader(http.StatusCreated)
+
+	case "PROPFIND":
+		var entries []string
+
+		switch {
+		case f.files[p] != nil:
+			entries = append(entries, propEntry(p, f.files[p]))
+
+		case f.dirs[p]:
+			entries = append(entries, propEntry(p, nil))
+			if r.Header.Get("Depth") == "1" {
+				for dir := range f.dirs {
+					if dir != p && parentDir(dir) == p {
+						entries = append(entries, propEntry(dir, nil))
+					}
+				}
+				for file, b := range f.files {
+					if parentDir(file) == p {
+						entries = append(entries, propEntry(file, b))
+					}
+				}
+			}
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+		w.WriteHeader(http.StatusMultiStatus)
+		_, _ = fmt.Fprintf(w, `<?xml version="1.0" encoding="utf-8"?><D:multistatus xmlns:D="DAV:">%s</D:multistatus>`,
+			strings.Join(entries, ""))
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// parentDir returns the parent
+// collection path of given path.
+func parentDir(p string) string {
+	dir := path.Dir(strings.TrimSuffix(p, "/"))
+	if dir != "/" {
+		dir += "/"
+	}
+	return dir
+}
+
+func propEntry(p string, b []byte) string {
+	var prop string
+	if b == nil {
+		prop = `<D:resourcetype><D:collection/></D:resourcetype>`
+	} else {
+		prop = fmt.Sprintf(`<D:resourcetype/><D:getcontentlength>%d</D:getcontentlength>`+
+			`<D:getlastmodified>Mon, 12 Jan 2026 15:04:05 GMT</D:getlastmodified>`, len(b))
+	}
+	return `<D:response><D:href>` + p + `</D:href><D:propstat><D:prop>` + prop +
+		`</D:prop><D:status>HTTP/1.1 200 OK</D:status></D:propstat></D:response>`
+}
@@ -79,6 +79,10 @@
 	// TimelineList:
 	// Updates to a specific list.
 	TimelineList = "list"
+
+	// TimelineAntenna:
+	// Updates to a specific antenna.
+	TimelineAntenna = "antenna"
 )
 
 // AllStatusTimelines contains all Timelines
@@ -90,6 +94,7 @@
 	TimelineHome,
 	TimelineDirect,
 	TimelineList,
+	TimelineAntenna,
 }
 
 type Streams struct {
@@ -136,7 +141,13 @@ func (s *Streams) Open(accountID string, streamTypes ...string) *Stream {
 		strs = slices.DeleteFunc(strs, func(s *Stream) bool {
 			return s == str // remove 'str' ptr
 		})
-		s.streams[accountID] = strs
+		if len(strs) == 0 {
+			// Drop account entirely, so only accounts
+			// with open streams are kept in the map.
+			delete(s.streams, accountID)
+		} else {
+			s.streams[accountID] = strs
+		}
 		s.mutex.Unlock()
 	}
 
@@ -146,6 +157,24 @@ func (s *Streams) Open(accountID string, streamTypes ...string) *Stream {
 	return str
 }
 
+// AccountIDs returns the IDs of all accounts
+// with at least one currently open stream.
+func (s *Streams) AccountIDs() []string {
+	s.mutex.Lock()
+	ids := slices.Collect(maps.Keys(s.streams))
+	s.mutex.Unlock()
+	return ids
+}
+
+// Streaming returns whether the account with
+// given ID has at least one currently open stream.
+func (s *Streams) Streaming(accountID string) bool {
+	s.mutex.Lock()
+	_, ok := s.streams[accountID]
+	s.mutex.Unlock()
+	return ok
+}
+
 // Post will post the given message to all streams of given account ID matching type.
 func (s *Streams) Post(ctx context.Context, accountID string, msg Message) bool {
 	var deferred []func() bool
@@ -157,7 +186,8 @@ func (s *Streams) Post(ctx context.Context, accountID string, msg Message) bool
 	for _, str := range s.streams[accountID] {
 
 		// Check whether stream supports any of our message targets.
-		if stype := str.getStreamType(msg.Stream...); stype != "" {
+		if stype := str.getStreamType(msg.Stream...); stype != "" &&
+			str.allowsMessage(msg) {
 
 			// Rescope var
 			// to prevent
@@ -208,7 +238,8 @@ func (s *Streams) PostAll(ctx context.Context, msg Message) bool {
 		for _, str := range strs {
 
 			// Check whether stream supports any of our message targets.
-			if stype := str.getStreamType(msg.Stream...); stype != "" {
+			if stype := str.getStreamType(msg.Stream...); stype != "" &&
+				str.allowsMessage(msg) {
 
 				// Rescope var
 				// to prevent
@@ -257,6 +288,11 @@ type Stream struct {
 	// gets updated via CAS operations in .cas().
 	types atomic.Pointer[map[string]struct{}]
 
+	// atomically updated ptr to a read-only
+	// filter on notification types streamed
+	// to this stream, nil if not filtered.
+	notifFilter atomic.Pointer[notificationFilter]
+
 	// protects stream close.
 	done chan struct{}
 
@@ -290,6 +326,70 @@ func (s *Stream) Unsubscribe(streamType string) {
 	})
 }
 
+// notificationFilter filters the
+// types of notifications that
+// are streamed to a stream.
+type notificationFilter struct {
+	types        map[string]struct{}
+	excludeTypes map[string]struct{}
+}
+
+// FilterNotifications sets which types of notifications
+// (eg., "favourite", "follow") are streamed to this stream,
+// replacing any previously set filter. If types is not empty,
+// only notifications of those types are streamed. Notifications
+// of any of excludeTypes are never streamed. If both are empty,
+// all notifications are streamed.
+func (s *Stream) FilterNotifications(types []string, excludeTypes []string) {
+	if len(types) == 0 && len(excludeTypes) == 0 {
+		// No filtering.
+		s.notifFilter.Store(nil)
+		return
+	}
+
+	toSet := func(in []string) map[string]struct{} {
+		if len(in) == 0 {
+			return nil
+		}
+		m := make(map[string]struct{}, len(in))
+		for _, v := range in {
+			m[v] = struct{}{}
+		}
+		return m
+	}
+
+	s.notifFilter.Store(&notificationFilter{
+		types:        toSet(types),
+		excludeTypes: toSet(excludeTypes),
+	})
+}
+
+// allowsMessage returns whether given message passes
+// this stream's filters; currently this only filters
+// notification messages by their notification type.
+func (s *Stream) allowsMessage(msg Message) bool {
+	if msg.Event != EventTypeNotification ||
+		msg.NotificationType == "" {
+		// Not filterable.
+		return true
+	}
+
+	filter := s.notifFilter.Load()
+	if filter == nil {
+		// No filter set.
+		return true
+	}
+
+	if filter.types != nil {
+		if _, ok := filter.types[msg.NotificationType]; !ok {
+			return false
+		}
+	}
+
+	_, excluded := filter.excludeTypes[msg.NotificationType]
+	return !excluded
+}
+
 // getStreamType returns the first stream type in given list that stream supports.
 func (s *Stream) getStreamType(streamTypes ...string) string {
 	if ptr := s.types.Load(); ptr != nil {
@@ -386,4 +486,9 @@ type Message struct {
 	// The actual payload of the message. In case of an
 	// update or notification, this will be a JSON string.
 	Payload string `json:"payload"`
+
+	// The type of notification in the payload, if
+	// event type is notification. Used only to filter
+	// notifications per stream, never sent to clients.
+	NotificationType string `json:"-"`
 }
@@ -0,0 +1,203 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package subscriptions
+
+import (
+	"context"
+	"errors"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+)
+
+// limitFromSeverity returns a domain limit suggestion for the
+// given Mastodon-style severity + reject_media values, or nil
+// if they don't correspond to anything we can limit.
+func limitFromSeverity(
+	domain string,
+	severity string,
+	rejectMedia bool,
+) *gtsmodel.DomainLimitSuggestion {
+	limit := &gtsmodel.DomainLimitSuggestion{
+		Domain:         domain,
+		MediaPolicy:    gtsmodel.MediaPolicyNoAction,
+		FollowsPolicy:  gtsmodel.FollowsPolicyNoAction,
+		StatusesPolicy: gtsmodel.StatusesPolicyNoAction,
+		AccountsPolicy: gtsmodel.AccountsPolicyNoAction,
+	}
+
+	switch severity {
+	case "silence", "limit":
+		// Approximate Mastodon's "limit": accounts
+		// are hidden from non-followers, and follows
+		// from the domain must be manually approved.
+		limit.FollowsPolicy = gtsmodel.FollowsPolicyManualApproval
+		limit.StatusesPolicy = gtsmodel.StatusesPolicyFilterHide
+		limit.AccountsPolicy = gtsmodel.AccountsPolicyMute
+
+	case "noop", "":
+		// Only worth suggesting
+		// if media is rejected.
+		if !rejectMedia {
+			return nil
+		}
+
+	default:
+		return nil
+	}
+
+	if rejectMedia {
+		limit.MediaPolicy = gtsmodel.MediaPolicyReject
+	}
+
+	return limit
+}
+
+// processLimitSuggestions diffs the given wanted domain limits
+// from a (block) domain permission subscription against any
+// pending limit suggestions previously made by it, creating,
+// updating, or retracting pending suggestions as necessary.
+//
+// Domains that are excluded, already blocked, or already
+// limited will not have a limit suggestion created for them.
+//
+// Error will only be returned in case of an actual database
+// error, else the error will be logged and nil returned.
+func (s *Subscriptions) processLimitSuggestions(
+	ctx context.Context,
+	l log.Entry,
+	permSub *gtsmodel.DomainPermissionSubscription,
+	wantedLimits []*gtsmodel.DomainLimitSuggestion,
+) error {
+	// Get all pending suggestions
+	// made by this subscription.
+	existing, err := s.state.DB.GetDomainLimitSuggestions(ctx, permSub.ID, "", nil)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	existingByDomain := make(map[string]*gtsmodel.DomainLimitSuggestion, len(existing))
+	for _, suggestion := range existing {
+		existingByDomain[suggestion.Domain] = suggestion
+	}
+
+	// Keep track of domains that
+	// should remain suggested.
+	keep := make(map[string]struct{}, len(wantedLimits))
+
+	for _, wanted := range wantedLimits {
+		l := l.WithField("domain", wanted.Domain)
+
+		suggest, err := s.shouldSuggestLimit(ctx, wanted.Domain)
+		if err != nil {
+			return err
+		}
+
+		if !suggest {
+			l.Debug("domain is excluded, blocked, or limited already, skipping limit suggestion")
+			continue
+		}
+
+		keep[wanted.Domain] = struct{}{}
+
+		suggestion, ok := existingByDomain[wanted.Domain]
+		if ok {
+			if suggestion.SamePolicies(wanted) &&
+				suggestion.PublicComment == wanted.PublicComment {
+				// Nothing changed.
+				continue
+			}
+
+			// Update pending suggestion to
+			// reflect changes in the list.
+			l.Debug("updating limit suggestion")
+			suggestion.PublicComment = wanted.PublicComment
+			suggestion.MediaPolicy = wanted.MediaPolicy
+			suggestion.FollowsPolicy = wanted.FollowsPolicy
+			suggestion.StatusesPolicy = wanted.StatusesPolicy
+			suggestion.AccountsPolicy = wanted.AccountsPolicy
+			if err := s.state.DB.UpdateDomainLimitSuggestion(
+				ctx,
+				suggestion,
+				"public_comment",
+				"media_policy",
+				"follows_policy",
+				"statuses_policy",
+				"accounts_policy",
+			); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		// New suggestion from this list.
+		l.Debug("creating limit suggestion")
+		wanted.ID = id.NewULID()
+		wanted.SubscriptionID = permSub.ID
+		if err := s.state.DB.PutDomainLimitSuggestion(ctx, wanted); err != nil &&
+			!errors.Is(err, db.ErrAlreadyExists) {
+			return err
+		}
+	}
+
+	// Retract pending suggestions
+	// no longer present in the list.
+	for _, suggestion := range existing {
+		if _, ok := keep[suggestion.Domain]; ok {
+			continue
+		}
+
+		l.WithField("domain", suggestion.Domain).Debug("retracting limit suggestion")
+		if err := s.state.DB.DeleteDomainLimitSuggestion(ctx, suggestion.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// shouldSuggestLimit returns whether it's worth
+// suggesting a domain limit for the given domain.
+func (s *Subscriptions) shouldSuggestLimit(
+	ctx context.Context,
+	domain string,
+) (bool, error) {
+	excluded, err := s.state.DB.IsDomainPermissionExcluded(ctx, domain)
+	if err != nil || excluded {
+		return false, err
+	}
+
+	block, err := s.state.DB.GetDomainBlock(ctx, domain)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, err
+	}
+
+	if block != nil {
+		return false, nil
+	}
+
+	limit, err := s.state.DB.GetDomainLimitByDomain(ctx, domain)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, err
+	}
+
+	return limit == nil, nil
+}
@@ -284,17 +284,24 @@ func (s *Subscriptions) ProcessDomainPermissionSubscription(
 	// from the URI, so we've got a live body!
 	// Try to parse the body as a list of wantedPerms
 	// that the subscription wants to create.
-	var wantedPerms []gtsmodel.DomainPermission
+	//
+	// Block lists may also contain entries for
+	// domains that should be limited rather than
+	// blocked, which are parsed into wantedLimits.
+	var (
+		wantedPerms  []gtsmodel.DomainPermission
+		wantedLimits []*gtsmodel.DomainLimitSuggestion
+	)
 
 	switch permSub.ContentType {
 
 	// text/csv
 	case gtsmodel.DomainPermSubContentTypeCSV:
-		wantedPerms, err = permsFromCSV(l, permSub.PermissionType, resp.Body)
+		wantedPerms, wantedLimits, err = permsFromCSV(l, permSub.PermissionType, resp.Body)
 
 	// application/json
 	case gtsmodel.DomainPermSubContentTypeJSON:
-		wantedPerms, err = permsFromJSON(l, permSub.PermissionType, resp.Body)
+		wantedPerms, wantedLimits, err = permsFromJSON(l, permSub.PermissionType, resp.Body)
 
 	// text/plain
 	case gtsmodel.DomainPermSubContentTypePlain:
@@ -312,7 +319,7 @@ func (s *Subscriptions) ProcessDomainPermissionSubscription(
 		return nil, false, nil
 	}
 
-	if len(wantedPerms) == 0 {
+	if len(wantedPerms) == 0 && len(wantedLimits) == 0 {
 		// Fetch was OK, and parsing was, on the surface at
 		// least, OK, but we didn't get any perms. Consider
 		// this an error as users will probably want to know.
@@ -361,6 +368,19 @@ func (s *Subscriptions) ProcessDomainPermissionSubscription(
 		return createdPerms, false, nil
 	}
 
+	// Surface any changes to wanted domain
+	// limits as pending limit suggestions.
+	if permSub.PermissionType == gtsmodel.DomainPermissionBlock {
+		if err := s.processLimitSuggestions(
+			ctx, l,
+			permSub,
+			wantedLimits,
+		); err != nil {
+			// Proper db error.
+			return nil, false, err
+		}
+	}
+
 	// Process any retractions since
 	// the last time list was checked.
 	//
@@ -588,19 +608,20 @@ func permsFromCSV(
 	l log.Entry,
 	permType gtsmodel.DomainPermissionType,
 	body io.ReadCloser,
-) ([]gtsmodel.DomainPermission, error) {
+) ([]gtsmodel.DomainPermission, []*gtsmodel.DomainLimitSuggestion, error) {
 	csvReader := csv.NewReader(body)
 
 	// Read and validate column headers.
 	columnHeaders, err := csvReader.Read()
 	if err != nil {
 		body.Close()
-		return nil, gtserror.NewfAt(3, "error decoding csv column headers: %w", err)
+		return nil, nil, gtserror.NewfAt(3, "error decoding csv column headers: %w", err)
 	}
 
 	var (
 		domainI        *int
 		severityI      *int
+		rejectMediaI   *int
 		publicCommentI *int
 		obfuscateI     *int
 	)
@@ -617,7 +638,7 @@ func permsFromCSV(
 			if domainI != nil {
 				body.Close()
 				err := gtserror.NewfAt(3, "duplicate domain column header in csv: %+v", columnHeaders)
-				return nil, err
+				return nil, nil, err
 			}
 			domainI = &i
 
@@ -625,15 +646,23 @@ func permsFromCSV(
 			if severityI != nil {
 				body.Close()
 				err := gtserror.NewfAt(3, "duplicate severity column header in csv: %+v", columnHeaders)
-				return nil, err
+				return nil, nil, err
 			}
 			severityI = &i
 
+		case columnHeader == "reject_media":
+			if rejectMediaI != nil {
+				body.Close()
+				err := gtserror.NewfAt(3, "duplicate reject_media column header in csv: %+v", columnHeaders)
+				return nil, nil, err
+			}
+			rejectMediaI = &i
+
 		case columnHeader == "public_comment" || columnHeader == "comment":
 			if publicCommentI != nil {
 				body.Close()
 				err := gtserror.NewfAt(3, "duplicate public_comment or comment column header in csv: %+v", columnHeaders)
-				return nil, err
+				return nil, nil, err
 			}
 			publicCommentI = &i
 
@@ -641,7 +670,7 @@ func permsFromCSV(
 			if obfuscateI != nil {
 				body.Close()
 				err := gtserror.NewfAt(3, "duplicate obfuscate column header in csv: %+v", columnHeaders)
-				return nil, err
+				return nil, nil, err
 			}
 			obfuscateI = &i
 		}
@@ -652,7 +681,7 @@ func permsFromCSV(
 	if domainI == nil {
 		body.Close()
 		err := gtserror.NewfAt(3, "no domain column header in csv: %+v", columnHeaders)
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Read remaining CSV records.
@@ -665,32 +694,40 @@ func permsFromCSV(
 	// Check for decode error.
 	if err != nil {
 		err := gtserror.NewfAt(3, "error decoding body into csv: %w", err)
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Make sure we actually
 	// have some records.
 	if len(records) == 0 {
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	// Convert records to permissions slice.
-	perms := make([]gtsmodel.DomainPermission, 0, len(records))
+	var (
+		perms  = make([]gtsmodel.DomainPermission, 0, len(records))
+		limits []*gtsmodel.DomainLimitSuggestion
+	)
+
 	for _, record := range records {
 		if len(record) != 6 {
 			l.Warnf("skipping invalid-length record: %+v", record)
 			continue
 		}
 
-		// Skip records that specify severity
-		// that's not "suspend" (we don't support
-		// "silence" or "limit" or whatever yet).
+		// Records without severity
+		// are taken as "suspend".
+		severity := "suspend"
 		if severityI != nil {
-			severity := record[*severityI]
-			if severity != "suspend" {
-				l.Warnf("skipping non-suspend record: %+v", record)
-				continue
-			}
+			severity = record[*severityI]
+		}
+
+		// Skip records that specify severity that's
+		// not "suspend", unless this is a block list,
+		// in which case they may be limit suggestions.
+		if severity != "suspend" && permType != gtsmodel.DomainPermissionBlock {
+			l.Warnf("skipping non-suspend record: %+v", record)
+			continue
 		}
 
 		// Normalize + validate domain.
@@ -701,6 +738,30 @@ func permsFromCSV(
 			continue
 		}
 
+		if severity != "suspend" {
+			var rejectMedia bool
+			if rejectMediaI != nil {
+				rejectMedia, err = strconv.ParseBool(record[*rejectMediaI])
+				if err != nil {
+					l.Warnf("couldn't parse reject_media field of record: %+v", record)
+					continue
+				}
+			}
+
+			limit := limitFromSeverity(domain, severity, rejectMedia)
+			if limit == nil {
+				l.Warnf("skipping record with unsupported severity: %+v", record)
+				continue
+			}
+
+			if publicCommentI != nil {
+				limit.PublicComment = record[*publicCommentI]
+			}
+
+			limits = append(limits, limit)
+			continue
+		}
+
 		// Instantiate the permission
 		// as either block or allow.
 		var perm gtsmodel.DomainPermission
@@ -731,24 +792,24 @@ func permsFromCSV(
 		perms = append(perms, perm)
 	}
 
-	return perms, nil
+	return perms, limits, nil
 }
 
 func permsFromJSON(
 	l log.Entry,
 	permType gtsmodel.DomainPermissionType,
 	body io.ReadCloser,
-) ([]gtsmodel.DomainPermission, error) {
+) ([]gtsmodel.DomainPermission, []*gtsmodel.DomainLimitSuggestion, error) {
 	var (
 		dec      = json.NewDecoder(body)
-		apiPerms = make([]*apimodel.DomainPermission, 0)
+		apiPerms = make([]*jsonDomainPermission, 0)
 	)
 
 	// Read body into memory as
 	// slice of domain permissions.
 	if err := dec.Decode(&apiPerms); err != nil {
 		_ = body.Close() // ensure closed.
-		return nil, gtserror.NewfAt(3, "error decoding into json: %w", err)
+		return nil, nil, gtserror.NewfAt(3, "error decoding into json: %w", err)
 	}
 
 	// Perform a secondary decode just to ensure we drained the
@@ -756,14 +817,18 @@ func permsFromJSON(
 	// trailing garbage, or multiple JSON values (invalid data).
 	if err := dec.Decode(&struct{}{}); err != io.EOF {
 		_ = body.Close() // ensure closed.
-		return nil, gtserror.NewfAt(3, "data remaining after json")
+		return nil, nil, gtserror.NewfAt(3, "data remaining after json")
 	}
 
 	// Done with body.
 	_ = body.Close()
 
 	// Convert apimodel perms to barebones internal perms.
-	perms := make([]gtsmodel.DomainPermission, 0, len(apiPerms))
+	var (
+		perms  = make([]gtsmodel.DomainPermission, 0, len(apiPerms))
+		limits []*gtsmodel.DomainLimitSuggestion
+	)
+
 	for _, apiPerm := range apiPerms {
 
 		// Normalize + validate domain.
@@ -774,6 +839,27 @@ func permsFromJSON(
 			continue
 		}
 
+		// Block list entries with a severity
+		// other than "suspend" may instead
+		// be suggestions to limit the domain.
+		publicComment := cmp.Or(apiPerm.PublicComment, apiPerm.Comment)
+		if permType == gtsmodel.DomainPermissionBlock &&
+			apiPerm.Severity != "" && apiPerm.Severity != "suspend" {
+			limit := limitFromSeverity(
+				domain,
+				apiPerm.Severity,
+				util.PtrOrZero(apiPerm.RejectMedia),
+			)
+			if limit == nil {
+				l.Warnf("skipping entry with unsupported severity: %s", apiPerm.Severity)
+				continue
+			}
+
+			limit.PublicComment = util.PtrOrZero(publicComment)
+			limits = append(limits, limit)
+			continue
+		}
+
 		// Instantiate the permission
 		// as either block or allow.
 		var perm gtsmodel.DomainPermission
@@ -785,7 +871,6 @@ func permsFromJSON(
 		}
 
 		// Set remaining fields.
-		publicComment := cmp.Or(apiPerm.PublicComment, apiPerm.Comment)
 		perm.SetPublicComment(util.PtrOrZero(publicComment))
 		perm.SetObfuscate(util.Ptr(util.PtrOrZero(apiPerm.Obfuscate)))
 
@@ -793,7 +878,18 @@ func permsFromJSON(
 		perms = append(perms, perm)
 	}
 
-	return perms, nil
+	return perms, limits, nil
+}
+
+// jsonDomainPermission wraps the apimodel domain
+// permission with extra fields that may be present
+// in Mastodon-style domain block JSON exports.
+type jsonDomainPermission struct {
+	apimodel.DomainPermission
+
+	// Reject media from domain, set
+	// with severity "silence" or "noop".
+	RejectMedia *bool `json:"reject_media"`
 }
 
 func permsFromPlain(
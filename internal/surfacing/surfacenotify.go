@@ -20,7 +20,9 @@
 import (
 	"context"
 	"errors"
+	"strconv"
 	"strings"
+	"time"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
@@ -469,6 +471,82 @@ func (s *Surfacer) notifyableAnnounce(
 	return true, nil
 }
 
+// NotifyPollMilestone notifies the local author of the
+// poll the given vote is in, if this vote brought the
+// number of voters in the poll up to a milestone.
+//
+// The vote's poll is expected to already have its
+// counts incremented to include the given vote.
+func (s *Surfacer) NotifyPollMilestone(
+	ctx context.Context,
+	vote *gtsmodel.PollVote,
+) error {
+	poll := vote.Poll
+	if poll.Voters == nil || !isPollMilestone(*poll.Voters) {
+		// Nothing to do.
+		return nil
+	}
+
+	// Ensure the poll's status is fully populated.
+	if err := s.state.DB.PopulateStatus(ctx, poll.Status); err != nil {
+		return gtserror.Newf("error populating status %s: %w", poll.Status.ID, err)
+	}
+
+	if poll.Status.Account.IsRemote() {
+		// no need to notify
+		// remote accounts.
+		return nil
+	}
+
+	// Remove any notification of an earlier milestone in
+	// this poll, so that the new one replaces it rather
+	// than being dropped as a duplicate by Notify().
+	notif, err := s.state.DB.GetNotification(
+		gtscontext.SetBarebones(ctx),
+		gtsmodel.NotificationPollMilestone,
+		poll.Status.AccountID,
+		poll.Status.AccountID,
+		poll.Status.ID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error getting poll milestone notification: %w", err)
+	}
+
+	if notif != nil {
+		if err := s.state.DB.DeleteNotificationByID(ctx, notif.ID); err != nil {
+			return gtserror.Newf("error deleting poll milestone notification: %w", err)
+		}
+	}
+
+	// notify poll author of the milestone. This is
+	// sent from the author themself, as with poll
+	// close notifications, so as not to reveal the
+	// identity of the voter who brought it about.
+	if err := s.Notify(ctx,
+		gtsmodel.NotificationPollMilestone,
+		poll.Status.Account,
+		poll.Status.Account,
+		poll.Status,
+		nil,
+	); err != nil {
+		return gtserror.Newf("error notifying poll author %s: %w", poll.Status.AccountID, err)
+	}
+
+	return nil
+}
+
+// isPollMilestone returns whether the given number of
+// voters is a milestone worth notifying the author of,
+// i.e. 10, 50, 100, 500, 1000, 5000 and so on.
+func isPollMilestone(voters int) bool {
+	for n := 10; n > 0 && n <= voters; n *= 10 {
+		if voters == n || voters == 5*n {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Surfacer) notifyPollClose(ctx context.Context, status *gtsmodel.Status) error {
 	// Beforehand, ensure the passed status is fully populated.
 	if err := s.state.DB.PopulateStatus(ctx, status); err != nil {
@@ -578,6 +656,59 @@ func getNotifyLockURI(
 	return builder.String()
 }
 
+// notifGroupSpan is the maximum span of time between
+// grouped notifications; a notification received after
+// this long since the last one in the group starts a
+// new group instead.
+const notifGroupSpan = 12 * time.Hour
+
+// setNotificationGroupKey sets the group key on the given
+// (not yet stored) notification, if it is of a groupable
+// type. If a recent notification of the same type, target
+// and status has a group key, that group key is reused,
+// else a new key is derived from the hour of creation.
+func (s *Surfacer) setNotificationGroupKey(
+	ctx context.Context,
+	notif *gtsmodel.Notification,
+) error {
+	if !notif.NotificationType.Groupable() {
+		// Not grouped.
+		return nil
+	}
+
+	createdAt, err := id.TimeFromULID(notif.ID)
+	if err != nil {
+		return gtserror.Newf("error parsing notification id: %w", err)
+	}
+
+	// Look for a recent group to add this notification to.
+	groupKey, err := s.state.DB.GetNotificationGroupKey(ctx,
+		notif.NotificationType,
+		notif.TargetAccountID,
+		notif.StatusOrEditID,
+		createdAt.Add(-notifGroupSpan),
+	)
+	if err != nil {
+		return gtserror.Newf("error getting notification group key: %w", err)
+	}
+
+	if groupKey == "" {
+		// No recent group, start a new one keyed
+		// to the hour this notification was created,
+		// so concurrently created notifications still
+		// end up in the same group.
+		hour := strconv.FormatInt(createdAt.Unix()/3600, 10)
+		if notif.StatusOrEditID != "" {
+			groupKey = notif.NotificationType.String() + "-" + notif.StatusOrEditID + "-" + hour
+		} else {
+			groupKey = notif.NotificationType.String() + "-" + hour
+		}
+	}
+
+	notif.GroupKey = groupKey
+	return nil
+}
+
 // Notify creates, inserts, and streams a new
 // notification to the target account if it
 // doesn't yet exist with the given parameters.
@@ -655,6 +786,12 @@ func (s *Surfacer) Notify(
 		StatusOrEditID:   statusOrEditID,
 	}
 
+	// Set the notification group key, if
+	// this type of notification is grouped.
+	if err := s.setNotificationGroupKey(ctx, notif); err != nil {
+		return err
+	}
+
 	if err := s.state.DB.PutNotification(ctx, notif); err != nil {
 		return gtserror.Newf("error putting notification in database: %w", err)
 	}
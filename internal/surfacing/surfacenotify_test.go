@@ -22,6 +22,7 @@
 	"testing"
 	"time"
 
+	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/filter/mutes"
 	"code.superseriousbusiness.org/gotosocial/internal/filter/visibility"
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
@@ -131,6 +132,217 @@ func (suite *SurfacingTestSuite) TestSpamNotifs() {
 	}
 }
 
+func (suite *SurfacingTestSuite) TestPollMilestoneNotif() {
+	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
+	defer testrig.TearDownTestStructs(testStructs)
+
+	surface := surfacing.New(
+		testStructs.State,
+		testStructs.TypeConverter,
+		testStructs.Processor.Stream(),
+		visibility.NewFilter(testStructs.State),
+		mutes.NewFilter(testStructs.State),
+		testStructs.StatusFilter,
+		testStructs.EmailSender,
+		testStructs.WebPushSender,
+		testStructs.Processor.Conversations(),
+	)
+
+	var (
+		ctx    = suite.T().Context()
+		author = suite.testAccounts["local_account_1"]
+		poll   = testrig.NewTestPolls()["local_account_1_status_6_poll"]
+		status = testrig.NewTestStatuses()["local_account_1_status_6"]
+	)
+	poll.Status = status
+
+	var lastNotifID string
+	for _, test := range []struct {
+		voters   int
+		voter    *gtsmodel.Account
+		expectOK bool
+	}{
+		{voters: 9, voter: suite.testAccounts["local_account_2"], expectOK: false},
+		{voters: 10, voter: suite.testAccounts["local_account_3"], expectOK: true},
+		{voters: 11, voter: suite.testAccounts["admin_account"], expectOK: false},
+		{voters: 50, voter: suite.testAccounts["remote_account_1"], expectOK: true},
+	} {
+		poll.Voters = &test.voters
+
+		if err := surface.NotifyPollMilestone(ctx, &gtsmodel.PollVote{
+			AccountID: test.voter.ID,
+			Account:   test.voter,
+			PollID:    poll.ID,
+			Poll:      poll,
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		// Milestone notifs should never
+		// reveal the voter who reached it.
+		_, err := testStructs.State.DB.GetNotification(
+			gtscontext.SetBarebones(ctx),
+			gtsmodel.NotificationPollMilestone,
+			author.ID,
+			test.voter.ID,
+			status.ID,
+		)
+		suite.ErrorIs(err, db.ErrNoEntries, "notif from voter at %d voters", test.voters)
+
+		// Notif should instead come from the author,
+		// with each milestone replacing the previous.
+		notif, err := testStructs.State.DB.GetNotification(
+			gtscontext.SetBarebones(ctx),
+			gtsmodel.NotificationPollMilestone,
+			author.ID,
+			author.ID,
+			status.ID,
+		)
+		switch {
+		case test.expectOK:
+			if suite.NoError(err, "expected notif at %d voters", test.voters) {
+				suite.NotEqual(lastNotifID, notif.ID, "expected new notif at %d voters", test.voters)
+				lastNotifID = notif.ID
+			}
+		case lastNotifID == "":
+			suite.ErrorIs(err, db.ErrNoEntries, "unexpected notif at %d voters", test.voters)
+		default:
+			if suite.NoError(err) {
+				suite.Equal(lastNotifID, notif.ID, "unexpected new notif at %d voters", test.voters)
+			}
+		}
+	}
+}
+
+func (suite *SurfacingTestSuite) TestRemotePollCloseNotif() {
+	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
+	defer testrig.TearDownTestStructs(testStructs)
+
+	surface := surfacing.New(
+		testStructs.State,
+		testStructs.TypeConverter,
+		testStructs.Processor.Stream(),
+		visibility.NewFilter(testStructs.State),
+		mutes.NewFilter(testStructs.State),
+		testStructs.StatusFilter,
+		testStructs.EmailSender,
+		testStructs.WebPushSender,
+		testStructs.Processor.Conversations(),
+	)
+
+	var (
+		ctx    = suite.T().Context()
+		author = suite.testAccounts["remote_account_1"]
+		status = testrig.NewTestStatuses()["remote_account_1_status_2"]
+		poll   = testrig.NewTestPolls()["remote_account_1_status_2_poll"]
+	)
+
+	// Mark the remote poll as having just closed,
+	// as if received in an Update with closed time.
+	poll.ClosedAt = time.Now()
+	poll.Closing = true
+	status.Poll = poll
+
+	if err := surface.TimelineAndNotifyStatusUpdate(ctx, status); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Both local voters in the remote
+	// poll should have been notified.
+	for _, voter := range []*gtsmodel.Account{
+		suite.testAccounts["local_account_1"],
+		suite.testAccounts["local_account_2"],
+	} {
+		_, err := testStructs.State.DB.GetNotification(
+			gtscontext.SetBarebones(ctx),
+			gtsmodel.NotificationPoll,
+			voter.ID,
+			author.ID,
+			status.ID,
+		)
+		suite.NoError(err, "expected poll notif for %s", voter.Username)
+	}
+}
+
+func (suite *SurfacingTestSuite) TestGroupedNotifs() {
+	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
+	defer testrig.TearDownTestStructs(testStructs)
+
+	surface := surfacing.New(
+		testStructs.State,
+		testStructs.TypeConverter,
+		testStructs.Processor.Stream(),
+		visibility.NewFilter(testStructs.State),
+		mutes.NewFilter(testStructs.State),
+		testStructs.StatusFilter,
+		testStructs.EmailSender,
+		testStructs.WebPushSender,
+		testStructs.Processor.Conversations(),
+	)
+
+	var (
+		ctx           = suite.T().Context()
+		targetAccount = suite.testAccounts["local_account_1"]
+		status        = testrig.NewTestStatuses()["local_account_1_status_1"]
+	)
+
+	// Fave the same status from two accounts.
+	groupKeys := make([]string, 0, 2)
+	for _, origin := range []*gtsmodel.Account{
+		suite.testAccounts["local_account_2"],
+		suite.testAccounts["local_account_3"],
+	} {
+		if err := surface.Notify(ctx,
+			gtsmodel.NotificationFavourite,
+			targetAccount,
+			origin,
+			status,
+			nil,
+		); err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		notif, err := testStructs.State.DB.GetNotification(
+			gtscontext.SetBarebones(ctx),
+			gtsmodel.NotificationFavourite,
+			targetAccount.ID,
+			origin.ID,
+			status.ID,
+		)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		groupKeys = append(groupKeys, notif.GroupKey)
+	}
+
+	// Both faves should be in the same group.
+	suite.NotEmpty(groupKeys[0])
+	suite.Equal(groupKeys[0], groupKeys[1])
+
+	// Mentions shouldn't be grouped.
+	if err := surface.Notify(ctx,
+		gtsmodel.NotificationMention,
+		targetAccount,
+		suite.testAccounts["local_account_2"],
+		status,
+		nil,
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	notif, err := testStructs.State.DB.GetNotification(
+		gtscontext.SetBarebones(ctx),
+		gtsmodel.NotificationMention,
+		targetAccount.ID,
+		suite.testAccounts["local_account_2"].ID,
+		status.ID,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(notif.GroupKey)
+}
+
 func TestSurfaceNotifyTestSuite(t *testing.T) {
 	suite.Run(t, new(SurfacingTestSuite))
 }
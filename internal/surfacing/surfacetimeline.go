@@ -21,11 +21,13 @@
 	"context"
 	"errors"
 	"slices"
+	"sync"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gopkg/xslices"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
+	antennafilter "code.superseriousbusiness.org/gotosocial/internal/filter/antenna"
 	"code.superseriousbusiness.org/gotosocial/internal/filter/visibility"
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
@@ -44,46 +46,59 @@ func (s *Surfacer) TimelineAndNotifyStatus(ctx context.Context, status *gtsmodel
 		return gtserror.Newf("error populating status with id %s: %w", status.ID, err)
 	}
 
-	// Local and public timeline caches
-	// are global, i.e. *not* per-user,
-	// so we only want to insert once.
-	var localOnce, publicOnce bool
+	if status.Visibility == gtsmodel.VisibilityPublic &&
+		status.BoostOfID == "" {
+		// Local and public timeline caches are global,
+		// i.e. *not* per-user, and are filtered for each
+		// requester on load, so insert here just once.
+		s.timelineStatusForPublicCaches(status)
+	}
+
+	// Prepare status once per account for
+	// all the timelines it gets surfaced to.
+	prep := s.newTimelinePreparer(status)
 
 	// Timeline the status for local users
 	// on the public and local timelines.
-	s.timelineStatusForPublic(ctx, status,
+	s.timelineStatusForPublic(ctx, prep,
 
 		// local timelining and streaming function
 		func(account *gtsmodel.Account, apiStatus *apimodel.Status) {
-			if !localOnce {
-				localOnce = true
-
-				// Insert the status into the local timeline cache.
-				_ = s.state.Caches.Timelines.Local.InsertOne(status)
-			}
-
 			// Stream the status model as local timeline update event.
 			s.stream.Update(ctx, account, apiStatus, stream.TimelineLocal)
+
+			if status.Language != "" {
+				// Stream the status model as language-specific local timeline update event.
+				s.stream.Update(ctx, account, apiStatus, stream.TimelineLocal+":"+status.Language)
+			}
 		},
 
 		// public timelining and streaming function
 		func(account *gtsmodel.Account, apiStatus *apimodel.Status) {
-			if !publicOnce {
-				publicOnce = true
+			// Stream the status model as public timeline update event.
+			s.stream.Update(ctx, account, apiStatus, stream.TimelinePublic)
 
-				// Insert the status into the public timeline cache.
-				_ = s.state.Caches.Timelines.Public.InsertOne(status)
+			if status.Language != "" {
+				// Stream the status model as language-specific public timeline update event.
+				s.stream.Update(ctx, account, apiStatus, stream.TimelinePublic+":"+status.Language)
 			}
+		},
 
-			// Stream the status model as public timeline update event.
-			s.stream.Update(ctx, account, apiStatus, stream.TimelinePublic)
+		// antenna timelining and streaming function
+		func(antenna *gtsmodel.Antenna, account *gtsmodel.Account, apiStatus *apimodel.Status) {
+			// Insert status into the antenna timeline cache.
+			_ = s.state.Caches.Timelines.Antenna.InsertOne(antenna.ID, status)
+
+			// Stream the status model as antenna timeline update event.
+			streamType := stream.TimelineAntenna + ":" + antenna.ID
+			s.stream.Update(ctx, account, apiStatus, streamType)
 		},
 	)
 
 	// Timeline the status for each local follower of account, and each
 	// local follower of any hashtags attached to status. This will also
 	// handle notifying any followers with the 'notify' flag set.
-	s.timelineAndNotifyStatusForFollowers(ctx, status,
+	s.timelineAndNotifyStatusForFollowers(ctx, prep,
 
 		// home timelining and streaming function
 		func(account *gtsmodel.Account, apiStatus *apimodel.Status) {
@@ -182,7 +197,11 @@ func (s *Surfacer) TimelineAndNotifyStatusUpdate(ctx context.Context, status *gt
 		// status for, as we can notify for both those
 		// having interacted with status, AND those
 		// that follow account with 'notify' flag set.
+		//
+		// This is guarded by a mutex as followers
+		// are notified concurrently during fan-out.
 		notified := make(map[string]struct{})
+		var notifiedMu sync.Mutex
 
 		// Don't ever notify the status author.
 		notified[status.AccountID] = struct{}{}
@@ -190,12 +209,15 @@ func (s *Surfacer) TimelineAndNotifyStatusUpdate(ctx context.Context, status *gt
 		// Get latest edit and notify for passed account.
 		latestEdit := status.Edits[len(status.Edits)-1]
 		notifyAccount = func(account *gtsmodel.Account) {
+			notifiedMu.Lock()
 			if _, ok := notified[account.ID]; ok {
+				notifiedMu.Unlock()
 				return
 			}
 
 			// Mark account has already notified.
 			notified[account.ID] = struct{}{}
+			notifiedMu.Unlock()
 
 			// Send notif for account.
 			if err := s.Notify(ctx,
@@ -210,15 +232,27 @@ func (s *Surfacer) TimelineAndNotifyStatusUpdate(ctx context.Context, status *gt
 		}
 	}
 
+	// Prepare status once per account for all the
+	// timelines it gets surfaced to, so that a local
+	// follower receiving the update on LOCAL, PUBLIC,
+	// HOME and several LIST timelines only incurs
+	// a single mute check and API model conversion.
+	prep := s.newTimelinePreparer(status)
+
 	// Timeline the status for local users
 	// on the public and local timelines.
-	s.timelineStatusForPublic(ctx, status,
+	s.timelineStatusForPublic(ctx, prep,
 
 		// local timelining and streaming function
 		func(account *gtsmodel.Account, apiStatus *apimodel.Status) {
 			// NOTE: timeline invalidation is handled separately
 			// as we don't need to perform it per user account.
 			s.stream.StatusUpdate(ctx, account, apiStatus, stream.TimelineLocal)
+
+			if status.Language != "" {
+				// Stream the status update to language-specific local timeline.
+				s.stream.StatusUpdate(ctx, account, apiStatus, stream.TimelineLocal+":"+status.Language)
+			}
 		},
 
 		// public timelining and streaming function
@@ -226,12 +260,25 @@ func(account *gtsmodel.Account, apiStatus *apimodel.Status) {
 			// NOTE: timeline invalidation is handled separately
 			// as we don't need to perform it per user account.
 			s.stream.StatusUpdate(ctx, account, apiStatus, stream.TimelinePublic)
+
+			if status.Language != "" {
+				// Stream the status update to language-specific public timeline.
+				s.stream.StatusUpdate(ctx, account, apiStatus, stream.TimelinePublic+":"+status.Language)
+			}
+		},
+
+		// antenna timelining and streaming function
+		func(antenna *gtsmodel.Antenna, account *gtsmodel.Account, apiStatus *apimodel.Status) {
+			// NOTE: timeline invalidation is handled separately
+			// as we don't need to perform it per account or antenna.
+			streamType := stream.TimelineAntenna + ":" + antenna.ID
+			s.stream.StatusUpdate(ctx, account, apiStatus, streamType)
 		},
 	)
 
 	// Timeline the status update for each local follower of account,
 	// and each local follower of any hashtags attached to status.
-	s.timelineAndNotifyStatusForFollowers(ctx, status,
+	s.timelineAndNotifyStatusForFollowers(ctx, prep,
 
 		// home timelining and streaming function
 		func(account *gtsmodel.Account, apiStatus *apimodel.Status) {
@@ -295,20 +342,25 @@ func(list *gtsmodel.List, account *gtsmodel.Account, apiStatus *apimodel.Status)
 }
 
 // timelineStatusForPublic timelines the given status
-// to LOCAL and PUBLIC (i.e. federated) timelines.
+// to LOCAL and PUBLIC (i.e. federated) timelines, and
+// to the timelines of any ANTENNAS that it matches.
 //
 // much of the core logic is handled by functions passed as arguments
 // to usage of this function with both creation and update events.
 func (s *Surfacer) timelineStatusForPublic(
 	ctx context.Context,
-	status *gtsmodel.Status,
+	prep *timelinePreparer,
 	localTimelineFn func(*gtsmodel.Account, *apimodel.Status),
 	publicTimelineFn func(*gtsmodel.Account, *apimodel.Status),
+	antennaTimelineFn func(*gtsmodel.Antenna, *gtsmodel.Account, *apimodel.Status),
 ) {
-	if localTimelineFn == nil || publicTimelineFn == nil {
+	if localTimelineFn == nil || publicTimelineFn == nil ||
+		antennaTimelineFn == nil {
 		panic("nil timeline func(s)")
 	}
 
+	status := prep.status
+
 	if status.Visibility != gtsmodel.VisibilityPublic ||
 		status.BoostOfID != "" {
 		// Fast code path, if it's not "public"
@@ -316,40 +368,129 @@ func (s *Surfacer) timelineStatusForPublic(
 		return
 	}
 
-	// Get a list of all local instance users.
-	users, err := s.state.DB.GetAllUsers(ctx)
+	// Gather antennas matched by status,
+	// grouped by their owner account ID.
+	antennas := s.matchAntennas(ctx, status)
+
+	// Rather than scanning every local user, we only
+	// need to consider those accounts which currently
+	// have open streams (as LOCAL and PUBLIC timelining
+	// is otherwise only a matter of streaming), and
+	// those with antennas matching this status.
+	accountIDs := s.stream.StreamingAccountIDs()
+	for accountID := range antennas {
+		if !slices.Contains(accountIDs, accountID) {
+			accountIDs = append(accountIDs, accountID)
+		}
+	}
+
+	if len(accountIDs) == 0 {
+		// No accounts to
+		// timeline for.
+		return
+	}
+
+	// Fetch account models for gathered IDs.
+	accounts, err := s.state.DB.GetAccountsByIDs(ctx, accountIDs)
 	if err != nil {
-		log.Errorf(ctx, "db error getting local users: %v", err)
+		log.Errorf(ctx, "db error getting accounts: %v", err)
 		return
 	}
 
-	// Iterate our list of users.
+	// Fan-out across our list of accounts.
 	isLocal := status.IsLocal()
-	for _, user := range users {
-		// Try to prepare status for timelining for local user account.
-		apiStatus, timelineable, err := s.prepareStatusForTimeline(ctx,
-			user.Account,
-			status,
+	s.fanOut(ctx, len(accounts), func(i int) {
+		account := accounts[i]
+
+		// Try to prepare status for timelining for local account.
+		apiStatus, timelineable, err := prep.prepare(ctx,
+			account,
 			gtsmodel.FilterContextPublic,
 			(*visibility.Filter).StatusPublicTimelineable,
 		)
 		if err != nil {
-			log.Errorf(ctx, "error preparing status %s for local user %s: %v", status.URI, user.Account.URI, err)
-			continue
+			log.Errorf(ctx, "error preparing status %s for local account %s: %v", status.URI, account.URI, err)
+			return
 		}
 
 		if !timelineable {
-			continue
+			return
 		}
 
 		if isLocal {
 			// This is local status, send it to local.
-			localTimelineFn(user.Account, apiStatus)
+			localTimelineFn(account, apiStatus)
 		}
 
 		// Both local and remote get sent to public.
-		publicTimelineFn(user.Account, apiStatus)
+		publicTimelineFn(account, apiStatus)
+
+		// Send to any of account's matching antennas.
+		for _, antenna := range antennas[account.ID] {
+			antennaTimelineFn(antenna, account, apiStatus)
+		}
+	})
+}
+
+// timelineStatusForPublicCaches inserts the given status into the
+// global LOCAL (if a local status) and PUBLIC timeline caches.
+func (s *Surfacer) timelineStatusForPublicCaches(status *gtsmodel.Status) {
+	if status.IsLocal() {
+		// Insert the status into the local timeline cache.
+		_ = s.state.Caches.Timelines.Local.InsertOne(status)
+
+		if status.Language != "" {
+			// Insert into the local timeline cache for this language.
+			_ = s.state.Caches.Timelines.LocalLanguage.InsertOne(status.Language, status)
+		}
+	}
+
+	// Insert the status into the public timeline cache.
+	_ = s.state.Caches.Timelines.Public.InsertOne(status)
+
+	if status.Language != "" {
+		// Insert into the public timeline cache for this language.
+		_ = s.state.Caches.Timelines.PublicLanguage.InsertOne(status.Language, status)
+	}
+}
+
+// matchAntennas returns all antennas on the instance
+// that the given status matches, keyed by owner account
+// ID. Visibility and mutes of the status for each owner
+// are left to be checked by the caller.
+func (s *Surfacer) matchAntennas(
+	ctx context.Context,
+	status *gtsmodel.Status,
+) map[string][]*gtsmodel.Antenna {
+
+	// Get all instance antennas, we don't need
+	// them populated as the owning accounts are
+	// fetched alongside streaming accounts by
+	// the caller.
+	all, err := s.state.DB.GetAllAntennas(
+		gtscontext.SetBarebones(ctx),
+	)
+	if err != nil {
+		log.Errorf(ctx, "db error getting antennas: %v", err)
+		return nil
 	}
+
+	if len(all) == 0 {
+		// Nothing to match.
+		return nil
+	}
+
+	// Prepare status for matching just once.
+	matcher := antennafilter.NewMatcher(status)
+
+	matched := make(map[string][]*gtsmodel.Antenna)
+	for _, a := range all {
+		if matcher.Match(a) {
+			matched[a.AccountID] = append(matched[a.AccountID], a)
+		}
+	}
+
+	return matched
 }
 
 // timelineAndNotifyStatusForFollowers timelines and notifies (where
@@ -360,7 +501,7 @@ func (s *Surfacer) timelineStatusForPublic(
 // to usage of this function with both creation and update events.
 func (s *Surfacer) timelineAndNotifyStatusForFollowers(
 	ctx context.Context,
-	status *gtsmodel.Status,
+	prep *timelinePreparer,
 	homeTimelineFn func(*gtsmodel.Account, *apimodel.Status),
 	listTimelineFn func(*gtsmodel.List, *gtsmodel.Account, *apimodel.Status),
 	notifyFn func(*gtsmodel.Account), // optional
@@ -369,6 +510,8 @@ func (s *Surfacer) timelineAndNotifyStatusForFollowers(
 		panic("nil timeline func(s)")
 	}
 
+	status := prep.status
+
 	// Get all local followers of the account that posted the status.
 	follows, err := s.state.DB.GetAccountLocalFollowers(ctx, status.AccountID)
 	if err != nil {
@@ -401,25 +544,37 @@ func (s *Surfacer) timelineAndNotifyStatusForFollowers(
 	// - exists => already timelined OR not visible / muted
 	// - empty  => not yet processed for home timeline
 	processed := make(map[string]struct{}, len(follows))
+	var processedMu sync.Mutex
+
+	// markProcessed marks account ID as processed.
+	markProcessed := func(accountID string) {
+		processedMu.Lock()
+		processed[accountID] = struct{}{}
+		processedMu.Unlock()
+	}
+
+	// Fan-out across the follows, as for accounts
+	// with many local followers doing this serially
+	// can significantly delay streaming the status.
+	s.fanOut(ctx, len(follows), func(i int) {
+		follow := follows[i]
 
-	for _, follow := range follows {
 		// Try to prepare this status for timelining for follow's account.
-		apiStatus, timelineable, err := s.prepareStatusForTimeline(ctx,
+		apiStatus, timelineable, err := prep.prepare(ctx,
 			follow.Account,
-			status,
 			gtsmodel.FilterContextHome,
 			(*visibility.Filter).StatusHomeTimelineable,
 		)
 		if err != nil {
 			log.Error(ctx, err)
-			continue
+			return
 		}
 
 		if !timelineable {
 			// This status should not be timelined
 			// for this account's home timelines.
-			processed[follow.AccountID] = struct{}{}
-			continue
+			markProcessed(follow.AccountID)
+			return
 		}
 
 		// Get all lists that contain this given follow.
@@ -429,13 +584,13 @@ func (s *Surfacer) timelineAndNotifyStatusForFollowers(
 		)
 		if err != nil {
 			log.Errorf(ctx, "error getting lists for follow %s: %v", follow.URI, err)
-			continue
+			return
 		}
 
 		var exclusive bool
 		for _, list := range lists {
 			// Check whether list is eligible for this status.
-			eligible, err := s.isListEligible(ctx, list, status)
+			eligible, err := prep.listEligible(ctx, list, follow)
 			if err != nil {
 				log.Errorf(ctx, "error checking list eligibility for status %s: %v", status.URI, err)
 				continue
@@ -461,26 +616,26 @@ func (s *Surfacer) timelineAndNotifyStatusForFollowers(
 			homeTimelineFn(follow.Account, apiStatus)
 
 			// Mark as processed for home timeline in map.
-			processed[follow.AccountID] = struct{}{}
+			markProcessed(follow.AccountID)
 		}
 
 		if !*follow.Notify {
 			// This follower doesn't have notifs
 			// set for this account's new posts.
-			continue
+			return
 		}
 
 		if boost || reply {
 			// Don't notify for
 			// boosts or replies.
-			continue
+			return
 		}
 
 		if notifyFn != nil {
 			// Notify for this follow.
 			notifyFn(follow.Account)
 		}
-	}
+	})
 
 	// From here, status has been sent to home and
 	// list timelines based on follow relationships.
@@ -543,26 +698,61 @@ func(accountID string) bool {
 		return
 	}
 
-	for _, account := range accounts {
+	s.fanOut(ctx, len(accounts), func(i int) {
+		account := accounts[i]
+
 		// Try to prepare status for timelining for tag follow's account.
-		apiStatus, timelineable, err := s.prepareStatusForTimeline(ctx,
+		apiStatus, timelineable, err := prep.prepare(ctx,
 			account,
-			status,
 			gtsmodel.FilterContextHome,
 			(*visibility.Filter).StatusVisible,
 		)
 		if err != nil {
 			log.Errorf(ctx, "error preparing status %s for tag follower %s: %v", status.URI, account.URI, err)
-			continue
+			return
 		}
 
 		if !timelineable {
-			continue
+			return
 		}
 
 		// Add to account's home timeline.
 		homeTimelineFn(account, apiStatus)
+	})
+}
+
+// fanOut calls fn for each index in [0, n), spread across
+// the bounded surfacing worker pool, returning only once
+// all calls have completed. If the pool isn't running, or
+// there's only a single call, these are made serially.
+//
+// Functions passed here must NOT themselves call fanOut,
+// as this could exhaust the pool of workers, deadlocking.
+func (s *Surfacer) fanOut(ctx context.Context, n int, fn func(i int)) {
+	pool := &s.state.Workers.Surfacing
+	if n <= 1 || pool.Len() == 0 {
+		for i := 0; i < n; i++ {
+			fn(i)
+		}
+		return
 	}
+
+	var wg sync.WaitGroup
+	wg.Add(n)
+
+	// Wrap fn calls as worker funcs.
+	fns := make([]func(context.Context), n)
+	for i := range fns {
+		fns[i] = func(context.Context) {
+			defer wg.Done()
+			fn(i)
+		}
+	}
+
+	// Queue for workers
+	// and wait on them.
+	pool.Push(ctx, fns...)
+	wg.Wait()
 }
 
 // timelineStatusForTags attempts to insert given status into relevant tag timeline caches.
@@ -593,15 +783,64 @@ func(tag *gtsmodel.Tag) (string, bool) {
 	}
 }
 
-// prepareStatusForTimeline attempts to prepare the given status for
-// a timeline owned by the given account, first passing it through
-// appropriate visibility function, mute checks and status filtering
-// checks applicable in the given filter context. finally, it will
-// return a prepared frontend API model for timeline insertion.
-func (s *Surfacer) prepareStatusForTimeline(
+// timelinePreparer prepares a single status for timelining
+// across all of the local accounts it is being surfaced to.
+//
+// Results that don't depend on the timeline the status is
+// being inserted into, i.e. mute checks, frontend API model
+// conversion and list owner follow checks, are computed once
+// per account and reused across the LOCAL, PUBLIC, HOME, LIST
+// and tag-follow timelines of that same account.
+//
+// A timelinePreparer is safe for concurrent use across different
+// accounts, and should only be used for the lifetime of one status
+// surfacing event.
+type timelinePreparer struct {
+	s      *Surfacer
+	status *gtsmodel.Status
+
+	// prepared contains per-account
+	// preparation results, by account ID.
+	prepared map[string]*preparedStatus
+
+	// followsReplied contains whether account, by
+	// ID, follows the replied-to status account.
+	followsReplied map[string]bool
+
+	// mu protects the above maps.
+	mu sync.Mutex
+}
+
+// preparedStatus contains the timeline-independent
+// results of preparing a status for one account.
+type preparedStatus struct {
+	muted    bool
+	tagMuted bool
+
+	// apiStatus is only set if the account
+	// had open streams at time of preparing.
+	apiStatus *apimodel.Status
+}
+
+// newTimelinePreparer returns a new timelinePreparer for status.
+func (s *Surfacer) newTimelinePreparer(status *gtsmodel.Status) *timelinePreparer {
+	return &timelinePreparer{
+		s:              s,
+		status:         status,
+		prepared:       make(map[string]*preparedStatus),
+		followsReplied: make(map[string]bool),
+	}
+}
+
+// prepare attempts to prepare the status for a timeline owned
+// by the given account, first passing it through appropriate
+// visibility function, mute checks and status filtering checks
+// applicable in the given filter context. finally, it will
+// return a prepared frontend API model for timeline insertion,
+// though this will be nil if account has no open streams.
+func (p *timelinePreparer) prepare(
 	ctx context.Context,
 	account *gtsmodel.Account,
-	status *gtsmodel.Status,
 	filterCtx gtsmodel.FilterContext,
 	isVisibleFn func(*visibility.Filter, context.Context, *gtsmodel.Account, *gtsmodel.Status) (bool, error),
 ) (
@@ -610,79 +849,162 @@ func (s *Surfacer) prepareStatusForTimeline(
 	err error,
 ) {
 	// Check status visibility for account's appropriate timeline.
-	visible, err := isVisibleFn(s.visFilter, ctx, account, status)
+	visible, err := isVisibleFn(p.s.visFilter, ctx, account, p.status)
 	if err != nil {
-		return nil, false, gtserror.Newf("error checking status %s visibility: %w", status.URI, err)
+		return nil, false, gtserror.Newf("error checking status %s visibility: %w", p.status.URI, err)
 	}
 
 	if !visible {
 		return nil, false, nil
 	}
 
-	// Check if the status muted by this account.
-	muted, err := s.muteFilter.StatusMuted(ctx,
-		account,
-		status,
-	)
+	// Load timeline-independent
+	// results for this account.
+	prepared, err := p.load(ctx, account)
 	if err != nil {
-		return nil, false, gtserror.Newf("error checking status %s mute: %w", status.URI, err)
+		return nil, false, err
 	}
 
-	if muted {
+	if prepared.muted {
+		return nil, false, nil
+	}
+
+	if prepared.tagMuted && filterCtx == gtsmodel.FilterContextHome {
+		// Tag mutes only apply
+		// to home / list timelines.
 		return nil, false, nil
 	}
 
 	// Check whether status is filtered in this context by timeline account.
-	filtered, hide, err := s.statusFilter.StatusFilterResultsInContext(ctx,
+	filtered, hide, err := p.s.statusFilter.StatusFilterResultsInContext(ctx,
 		account,
-		status,
+		p.status,
 		filterCtx,
 	)
 	if err != nil {
-		return nil, false, gtserror.Newf("error filtering status %s: %w", status.URI, err)
+		return nil, false, gtserror.Newf("error filtering status %s: %w", p.status.URI, err)
 	}
 
 	if hide {
 		return nil, false, nil
 	}
 
-	// Attempt to convert status to frontend API model.
-	apiStatus, err = s.converter.StatusToAPIStatus(ctx,
-		status,
+	if prepared.apiStatus == nil {
+		// Not converted, or conversion
+		// failed, still timelineable,
+		// just can't be streamed to
+		// the account.
+		return nil, true, nil
+	}
+
+	// Take a shallow copy of the shared
+	// API model, and attach any filter
+	// results for this context to it.
+	apiStatus = new(apimodel.Status)
+	*apiStatus = *prepared.apiStatus
+	apiStatus.Filtered = filtered
+
+	return apiStatus, true, nil
+}
+
+// load returns the timeline-independent preparation results
+// of the status for account, computing them on first call.
+func (p *timelinePreparer) load(ctx context.Context, account *gtsmodel.Account) (*preparedStatus, error) {
+	p.mu.Lock()
+	prepared, ok := p.prepared[account.ID]
+	p.mu.Unlock()
+	if ok {
+		return prepared, nil
+	}
+
+	// Check if the status muted by this account.
+	muted, err := p.s.muteFilter.StatusMuted(ctx,
+		account,
+		p.status,
+	)
+	if err != nil {
+		return nil, gtserror.Newf("error checking status %s mute: %w", p.status.URI, err)
+	}
+
+	// Check if the status contains a tag muted by this account.
+	tagMuted, err := p.s.muteFilter.StatusTagMuted(ctx,
 		account,
+		p.status,
 	)
 	if err != nil {
-		log.Error(ctx, "error converting status %s to frontend: %v", status.URI, err)
-	} else {
+		return nil, gtserror.Newf("error checking status %s tag mute: %w", p.status.URI, err)
+	}
 
-		// Attach any filter results.
-		apiStatus.Filtered = filtered
+	prepared = &preparedStatus{
+		muted:    muted,
+		tagMuted: tagMuted,
 	}
 
-	return apiStatus, true, nil
+	// Only bother converting to frontend API model when the
+	// account actually has an open stream to send it to, as
+	// this is the most expensive part of preparation and is
+	// otherwise wasted on accounts that aren't connected.
+	if !muted && p.s.stream.IsStreaming(account.ID) {
+		// Attempt to convert status to frontend API model.
+		prepared.apiStatus, err = p.s.converter.StatusToAPIStatus(ctx,
+			p.status,
+			account,
+		)
+		if err != nil {
+			log.Errorf(ctx, "error converting status %s to frontend: %v", p.status.URI, err)
+		}
+	}
+
+	p.mu.Lock()
+	p.prepared[account.ID] = prepared
+	p.mu.Unlock()
+	return prepared, nil
 }
 
-// listEligible checks if the given status is eligible
-// for inclusion in the list that that the given listEntry
-// belongs to, based on the replies policy of the list.
-func (s *Surfacer) isListEligible(
+// listEligible checks if the status is eligible for
+// inclusion in the given list, based on the replies
+// policy of the list, and any overrides set on the
+// list entry for given follow.
+func (p *timelinePreparer) listEligible(
 	ctx context.Context,
 	list *gtsmodel.List,
-	status *gtsmodel.Status,
+	follow *gtsmodel.Follow,
 ) (bool, error) {
-	if status.InReplyToURI == "" {
-		// If status is not a reply,
-		// then it's all gravy baby.
+	status := p.status
+
+	var (
+		boost = (status.BoostOfID != "")
+		reply = (status.InReplyToURI != "")
+	)
+
+	if !boost && !reply {
+		// If status is not a boost or
+		// reply, then it's all gravy baby.
 		return true, nil
 	}
 
+	// Fetch the list entry for this follow,
+	// to check for any per-member overrides.
+	entry, err := p.s.state.DB.GetListEntry(ctx, list.ID, follow.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting list entry: %w", err)
+		return false, err
+	}
+
+	if boost {
+		// Boosts may be hidden
+		// for this list member.
+		return entry == nil ||
+			*entry.ShowReblogs, nil
+	}
+
 	if status.InReplyToID == "" {
 		// Status is a reply but we don't
 		// have the replied-to account!
 		return false, nil
 	}
 
-	switch list.RepliesPolicy {
+	switch entry.GetRepliesPolicy(list) {
 	case gtsmodel.RepliesPolicyNone:
 		// This list should not show
 		// replies at all, so skip it.
@@ -694,7 +1016,7 @@ func (s *Surfacer) isListEligible(
 		//
 		// Check if replied-to account is
 		// also included in this list.
-		in, err := s.state.DB.IsAccountInList(ctx,
+		in, err := p.s.state.DB.IsAccountInList(ctx,
 			list.ID,
 			status.InReplyToAccountID,
 		)
@@ -709,9 +1031,19 @@ func (s *Surfacer) isListEligible(
 		// only to people that the list
 		// owner also follows.
 		//
+		// This is the same for every list
+		// owned by the account, so check
+		// for an earlier result first.
+		p.mu.Lock()
+		follows, ok := p.followsReplied[list.AccountID]
+		p.mu.Unlock()
+		if ok {
+			return follows, nil
+		}
+
 		// Check if replied-to account is
 		// followed by list owner account.
-		follows, err := s.state.DB.IsFollowing(ctx,
+		follows, err := p.s.state.DB.IsFollowing(ctx,
 			list.AccountID,
 			status.InReplyToAccountID,
 		)
@@ -719,10 +1051,14 @@ func (s *Surfacer) isListEligible(
 			err := gtserror.Newf("db error checking if account followed: %w", err)
 			return false, err
 		}
+
+		p.mu.Lock()
+		p.followsReplied[list.AccountID] = follows
+		p.mu.Unlock()
 		return follows, nil
 
 	default:
-		panic("unknown reply policy: " + list.RepliesPolicy)
+		panic("unknown reply policy: " + entry.GetRepliesPolicy(list))
 	}
 }
 
@@ -733,7 +1069,10 @@ func (s *Surfacer) DeleteStatusFromTimelines(ctx context.Context, statusID strin
 	s.state.Caches.Timelines.Local.RemoveByStatusIDs(statusID)
 	s.state.Caches.Timelines.Home.RemoveByStatusIDs(statusID)
 	s.state.Caches.Timelines.List.RemoveByStatusIDs(statusID)
+	s.state.Caches.Timelines.Antenna.RemoveByStatusIDs(statusID)
 	s.state.Caches.Timelines.Tag.RemoveByStatusIDs(statusID)
+	s.state.Caches.Timelines.PublicLanguage.RemoveByStatusIDs(statusID)
+	s.state.Caches.Timelines.LocalLanguage.RemoveByStatusIDs(statusID)
 	s.stream.Delete(ctx, statusID)
 }
 
@@ -743,7 +1082,10 @@ func (s *Surfacer) RemoveTimelineEntriesByAccount(accountID string) {
 	s.state.Caches.Timelines.Local.RemoveByAccountIDs(accountID)
 	s.state.Caches.Timelines.Home.RemoveByAccountIDs(accountID)
 	s.state.Caches.Timelines.List.RemoveByAccountIDs(accountID)
+	s.state.Caches.Timelines.Antenna.RemoveByAccountIDs(accountID)
 	s.state.Caches.Timelines.Tag.RemoveByAccountIDs(accountID)
+	s.state.Caches.Timelines.PublicLanguage.RemoveByAccountIDs(accountID)
+	s.state.Caches.Timelines.LocalLanguage.RemoveByAccountIDs(accountID)
 }
 
 func (s *Surfacer) RemoveRelationshipFromTimelines(ctx context.Context, timelineAccountID string, targetAccountID string) {
@@ -765,4 +1107,20 @@ func (s *Surfacer) RemoveRelationshipFromTimelines(ctx context.Context, timeline
 		s.state.Caches.Timelines.List.MustGet(listID).
 			RemoveByAccountIDs(targetAccountID)
 	}
+
+	// Get the IDs of all the antennas owned by the given account ID.
+	antennas, err := s.state.DB.GetAntennasByAccountID(
+		gtscontext.SetBarebones(ctx),
+		timelineAccountID,
+	)
+	if err != nil {
+		log.Errorf(ctx, "error getting antennas for account %s: %v", timelineAccountID, err)
+	}
+
+	for _, antenna := range antennas {
+		// Remove all statuses by target account
+		// from given account's antenna timelines.
+		s.state.Caches.Timelines.Antenna.MustGet(antenna.ID).
+			RemoveByAccountIDs(targetAccountID)
+	}
 }
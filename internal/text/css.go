@@ -0,0 +1,485 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package text
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/css/scanner"
+)
+
+// SanitizeCSS sanitizes the given user-provided CSS, returning
+// a rewritten stylesheet containing only rules and declarations
+// that are considered safe to serve from this instance.
+//
+// Regardless of mode, this drops comments, statement at-rules
+// such as @import and @charset, unknown block at-rules, nested
+// blocks inside declarations, expression() values, javascript:
+// and vbscript: URLs, and the behavior + -moz-binding properties.
+// Only @media, @supports, @keyframes and @font-face are kept.
+//
+// If strict is true, then @font-face and any url() references
+// are also dropped, and only properties contained in the given
+// allowedProperties (or custom properties, ie., "--*") are kept.
+func SanitizeCSS(in string, strict bool, allowedProperties []string) string {
+	s := cssSanitizer{
+		strict:  strict,
+		allowed: make(map[string]struct{}, len(allowedProperties)),
+	}
+
+	for _, prop := range allowedProperties {
+		s.allowed[strings.ToLower(prop)] = struct{}{}
+	}
+
+	// Tokenize the input, dropping anything that has no
+	// meaning in a stylesheet. If the scanner gives up on
+	// the input, just use whatever we managed to read.
+	sc := scanner.New(in)
+	for {
+		tok := sc.Next()
+		switch tok.Type {
+		case scanner.TokenEOF, scanner.TokenError:
+			var buf strings.Builder
+			s.rules(&buf, false)
+			return strings.TrimSpace(buf.String())
+
+		case scanner.TokenComment,
+			scanner.TokenCDO,
+			scanner.TokenCDC,
+			scanner.TokenBOM:
+			continue
+		}
+
+		s.toks = append(s.toks, tok)
+	}
+}
+
+// cssSanitizer walks a slice of
+// CSS tokens, writing out only
+// those rules deemed to be safe.
+type cssSanitizer struct {
+	toks    []*scanner.Token
+	pos     int
+	strict  bool
+	allowed map[string]struct{}
+}
+
+// rules writes out sanitized rules until end of
+// input, or until the end of the current block
+// (which is consumed) if nested is true.
+func (s *cssSanitizer) rules(buf *strings.Builder, nested bool) {
+	for {
+		s.skipSpace()
+		if s.pos >= len(s.toks) {
+			return
+		}
+
+		tok := s.toks[s.pos]
+		switch {
+		case isCSSChar(tok, "}"):
+			// End of current block,
+			// or stray closing brace.
+			s.pos++
+			if nested {
+				return
+			}
+
+		case tok.Type == scanner.TokenAtKeyword:
+			s.pos++
+			s.atRule(buf, tok)
+
+		default:
+			s.qualifiedRule(buf)
+		}
+	}
+}
+
+// atRule writes out the at-rule beginning
+// with the given (already consumed) keyword,
+// if it's a permitted and safe block at-rule.
+func (s *cssSanitizer) atRule(buf *strings.Builder, keyword *scanner.Token) {
+	prelude, end := s.prelude()
+	if !isCSSChar(end, "{") {
+		// Statement at-rules like @import,
+		// @charset and @namespace are never
+		// allowed, so just drop these.
+		return
+	}
+
+	if !s.safeValue(prelude) {
+		s.skipBlock()
+		return
+	}
+
+	name := strings.ToLower(cssUnescape(keyword.Value[1:]))
+	switch name {
+	case "media", "supports",
+		"keyframes", "-webkit-keyframes":
+		if len(prelude) == 0 {
+			// Needs condition or name.
+			s.skipBlock()
+			return
+		}
+
+		// Conditional groups + keyframes
+		// contain further (qualified) rules.
+		var inner strings.Builder
+		s.rules(&inner, true)
+		writeCSSBlock(buf, "@"+name+" "+serializeCSS(prelude), &inner)
+
+	case "font-face":
+		if s.strict {
+			// Font faces only serve
+			// to load remote fonts.
+			s.skipBlock()
+			return
+		}
+
+		var inner strings.Builder
+		s.declarations(&inner)
+		writeCSSBlock(buf, "@font-face", &inner)
+
+	default:
+		// Unknown or unsupported.
+		s.skipBlock()
+	}
+}
+
+// qualifiedRule writes out the qualified rule
+// (ie., selectors + declarations) at the current
+// position, if the rule's selectors are safe.
+func (s *cssSanitizer) qualifiedRule(buf *strings.Builder) {
+	prelude, end := s.prelude()
+	if !isCSSChar(end, "{") {
+		// Junk with no block, drop it.
+		return
+	}
+
+	if len(prelude) == 0 || !s.safeValue(prelude) {
+		s.skipBlock()
+		return
+	}
+
+	var inner strings.Builder
+	s.declarations(&inner)
+	writeCSSBlock(buf, serializeCSS(prelude), &inner)
+}
+
+// declarations writes out the safe declarations of
+// the current block, consuming the end of the block.
+func (s *cssSanitizer) declarations(buf *strings.Builder) {
+	for s.pos < len(s.toks) {
+		var (
+			start   = s.pos
+			depth   int
+			invalid bool
+			end     *scanner.Token
+		)
+
+		for ; s.pos < len(s.toks); s.pos++ {
+			tok := s.toks[s.pos]
+
+			if isCSSChar(tok, "{") {
+				// Nested blocks inside declarations
+				// are not supported, skip the whole
+				// block and the declaration it's in.
+				s.pos++
+				s.skipBlock()
+				s.pos--
+				invalid = true
+				continue
+			}
+
+			if isCSSChar(tok, "}") ||
+				(depth == 0 && isCSSChar(tok, ";")) {
+				end = tok
+				break
+			}
+
+			switch {
+			case tok.Type == scanner.TokenFunction,
+				isCSSChar(tok, "("):
+				depth++
+			case isCSSChar(tok, ")") && depth > 0:
+				depth--
+			}
+		}
+
+		decl := s.toks[start:s.pos]
+		if end != nil {
+			// Consume ; or }.
+			s.pos++
+		}
+
+		if !invalid {
+			s.declaration(buf, decl)
+		}
+
+		if end == nil || isCSSChar(end, "}") {
+			return
+		}
+	}
+}
+
+// declaration writes out the given
+// single declaration, if it is safe.
+func (s *cssSanitizer) declaration(buf *strings.Builder, decl []*scanner.Token) {
+	decl = trimCSSSpace(decl)
+
+	colon := -1
+	for i, tok := range decl {
+		if isCSSChar(tok, ":") {
+			colon = i
+			break
+		}
+	}
+
+	if colon < 1 {
+		// No property name.
+		return
+	}
+
+	// Custom properties are tokenized as
+	// a "-" char followed by an identifier,
+	// so rebuild the name from both kinds.
+	var name strings.Builder
+	for _, tok := range trimCSSSpace(decl[:colon]) {
+		if tok.Type != scanner.TokenIdent && !isCSSChar(tok, "-") {
+			return
+		}
+		name.WriteString(tok.Value)
+	}
+
+	value := trimCSSSpace(decl[colon+1:])
+	if len(value) == 0 {
+		return
+	}
+
+	prop := strings.ToLower(cssUnescape(name.String()))
+	if !s.allowedProperty(prop) || !s.safeValue(value) {
+		return
+	}
+
+	buf.WriteString("\t" + name.String() + ": " + serializeCSS(value) + ";\n")
+}
+
+// allowedProperty returns whether the given
+// (lowercase, unescaped) property name is allowed.
+func (s *cssSanitizer) allowedProperty(prop string) bool {
+	if strings.HasPrefix(prop, "--") {
+		// Custom properties are only
+		// useful through var(), so the
+		// values themselves are checked.
+		return true
+	}
+
+	switch prop {
+	case "":
+		return false
+
+	case "behavior", "-moz-binding":
+		// Legacy ways of running script.
+		return false
+	}
+
+	if !s.strict {
+		return true
+	}
+
+	_, ok := s.allowed[prop]
+	return ok
+}
+
+// safeValue returns whether the given tokens, be
+// they a prelude or a declaration value, are safe.
+func (s *cssSanitizer) safeValue(toks []*scanner.Token) bool {
+	var raw strings.Builder
+	for _, tok := range toks {
+		switch tok.Type {
+		case scanner.TokenURI:
+			if s.strict {
+				return false
+			}
+
+		case scanner.TokenFunction:
+			switch strings.ToLower(cssUnescape(tok.Value)) {
+			case "url(", "src(", "image(",
+				"image-set(", "-webkit-image-set(":
+				if s.strict {
+					return false
+				}
+			}
+		}
+		raw.WriteString(tok.Value)
+	}
+
+	// Check the unescaped form, in case
+	// escapes or dropped comments have
+	// been used to try to sneak these in.
+	lower := strings.ToLower(cssUnescape(raw.String()))
+	for _, bad := range []string{
+		"expression(",
+		"javascript:",
+		"vbscript:",
+		"-moz-binding",
+	} {
+		if strings.Contains(lower, bad) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// prelude returns the tokens from the current
+// position up to a "{", ";" or "}", along with
+// the token that ended it (nil on end of input).
+// A "{" or ";" is consumed, a "}" is not.
+func (s *cssSanitizer) prelude() ([]*scanner.Token, *scanner.Token) {
+	start := s.pos
+	for ; s.pos < len(s.toks); s.pos++ {
+		tok := s.toks[s.pos]
+		switch {
+		case isCSSChar(tok, "{"), isCSSChar(tok, ";"):
+			s.pos++
+			return trimCSSSpace(s.toks[start : s.pos-1]), tok
+
+		case isCSSChar(tok, "}"):
+			return trimCSSSpace(s.toks[start:s.pos]), tok
+		}
+	}
+	return trimCSSSpace(s.toks[start:]), nil
+}
+
+// skipBlock skips tokens up to and
+// including the "}" ending the current
+// block, whose "{" was already consumed.
+func (s *cssSanitizer) skipBlock() {
+	for depth := 1; s.pos < len(s.toks) && depth > 0; s.pos++ {
+		switch tok := s.toks[s.pos]; {
+		case isCSSChar(tok, "{"):
+			depth++
+		case isCSSChar(tok, "}"):
+			depth--
+		}
+	}
+}
+
+// skipSpace skips whitespace
+// from the current position.
+func (s *cssSanitizer) skipSpace() {
+	for s.pos < len(s.toks) &&
+		s.toks[s.pos].Type == scanner.TokenS {
+		s.pos++
+	}
+}
+
+// writeCSSBlock writes out the given prelude
+// and block contents, if contents not empty.
+func writeCSSBlock(buf *strings.Builder, prelude string, inner *strings.Builder) {
+	if inner.Len() == 0 {
+		return
+	}
+	buf.WriteString(prelude + " {\n")
+	buf.WriteString(inner.String())
+	buf.WriteString("}\n")
+}
+
+// isCSSChar returns whether tok
+// is the given single character.
+func isCSSChar(tok *scanner.Token, char string) bool {
+	return tok != nil &&
+		tok.Type == scanner.TokenChar &&
+		tok.Value == char
+}
+
+// trimCSSSpace trims leading and
+// trailing whitespace tokens.
+func trimCSSSpace(toks []*scanner.Token) []*scanner.Token {
+	for len(toks) > 0 && toks[0].Type == scanner.TokenS {
+		toks = toks[1:]
+	}
+	for len(toks) > 0 && toks[len(toks)-1].Type == scanner.TokenS {
+		toks = toks[:len(toks)-1]
+	}
+	return toks
+}
+
+// serializeCSS writes the given tokens back
+// out, collapsing whitespace to single spaces.
+func serializeCSS(toks []*scanner.Token) string {
+	var buf strings.Builder
+	for _, tok := range toks {
+		if tok.Type == scanner.TokenS {
+			buf.WriteByte(' ')
+			continue
+		}
+		buf.WriteString(tok.Value)
+	}
+	return buf.String()
+}
+
+// cssUnescape resolves CSS backslash
+// escapes in the given string, eg., "\75 rl("
+// becomes "url(", so that it may be checked.
+func cssUnescape(in string) string {
+	if !strings.Contains(in, `\`) {
+		return in
+	}
+
+	var buf strings.Builder
+	for i := 0; i < len(in); i++ {
+		if in[i] != '\\' || i+1 >= len(in) {
+			buf.WriteByte(in[i])
+			continue
+		}
+
+		// Read up to 6 hex digits.
+		j := i + 1
+		for j < len(in) && j < i+7 && isHexDigit(in[j]) {
+			j++
+		}
+
+		if j == i+1 {
+			// Not a hex escape,
+			// take next char as-is.
+			buf.WriteByte(in[j])
+			i = j
+			continue
+		}
+
+		r, _ := strconv.ParseUint(in[i+1:j], 16, 32)
+		buf.WriteRune(rune(r)) // #nosec G115 -- Max 6 hex digits.
+
+		// A single whitespace
+		// char may end the escape.
+		if j < len(in) && strings.IndexByte(" \t\n\r\f", in[j]) >= 0 {
+			j++
+		}
+		i = j - 1
+	}
+
+	return buf.String()
+}
+
+func isHexDigit(c byte) bool {
+	return ('0' <= c && c <= '9') ||
+		('a' <= c && c <= 'f') ||
+		('A' <= c && c <= 'F')
+}
@@ -0,0 +1,101 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package text_test
+
+import (
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/text"
+	"github.com/stretchr/testify/suite"
+)
+
+const customCSS = `/* Lovely colors. */
+@import url("https://example.org/evil.css");
+@charset "utf-8";
+:root { --My-Accent: #ff00aa; }
+.profile .profile-header {
+	background: url("https://example.org/bg.png") no-repeat;
+	color: var(--My-Accent) !important;
+	width: expression(alert(1));
+	-moz-binding: url("https://example.org/xbl.xml#evil");
+	border-radius: 1rem;
+}
+a:hover > .status[lang="en"] { b\65havior: url(evil.htc); text-decoration: underline }
+@media (max-width: 40rem) {
+	.status { font-size: 1.2em; margin: 0 }
+}
+@font-face { font-family: "Evil Sans"; src: url("https://example.org/evil.woff2"); }
+@page { margin: 1cm }
+.sneaky { background-image: \75 rl(javascript:alert(1)); color: red; nested { color: blue } }
+`
+
+type CSSTestSuite struct {
+	suite.Suite
+}
+
+func (suite *CSSTestSuite) TestSanitizeCSS() {
+	suite.Equal(`:root {
+	--My-Accent: #ff00aa;
+}
+.profile .profile-header {
+	background: url("https://example.org/bg.png") no-repeat;
+	color: var(--My-Accent) !important;
+	border-radius: 1rem;
+}
+a:hover > .status[lang="en"] {
+	text-decoration: underline;
+}
+@media (max-width: 40rem) {
+.status {
+	font-size: 1.2em;
+	margin: 0;
+}
+}
+@font-face {
+	font-family: "Evil Sans";
+	src: url("https://example.org/evil.woff2");
+}
+.sneaky {
+	color: red;
+}`, text.SanitizeCSS(customCSS, false, nil))
+}
+
+func (suite *CSSTestSuite) TestSanitizeCSSStrict() {
+	suite.Equal(`:root {
+	--My-Accent: #ff00aa;
+}
+.profile .profile-header {
+	color: var(--My-Accent) !important;
+}
+@media (max-width: 40rem) {
+.status {
+	font-size: 1.2em;
+}
+}
+.sneaky {
+	color: red;
+}`, text.SanitizeCSS(customCSS, true, []string{"Color", "background", "font-size"}))
+}
+
+func (suite *CSSTestSuite) TestSanitizeCSSJunk() {
+	suite.Empty(text.SanitizeCSS(`}}} ;; { color: red } <!-- --> @media {`, false, nil))
+}
+
+func TestCSSTestSuite(t *testing.T) {
+	suite.Run(t, new(CSSTestSuite))
+}
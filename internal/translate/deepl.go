@@ -0,0 +1,122 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
+)
+
+// deepLURL is the default DeepL API base URL.
+const deepLURL = "https://api.deepl.com"
+
+// deepL is a Backend implementation
+// using the DeepL API, as documented at
+// https://developers.deepl.com/docs.
+type deepL struct {
+	httpBackend
+	url    string
+	apiKey string
+}
+
+// NewDeepL returns a new Backend using the DeepL API at given
+// base URL (defaulting to DeepL Pro API), with given auth key.
+func NewDeepL(client *httpclient.Client, url string, apiKey string) Backend {
+	if url == "" {
+		url = deepLURL
+	}
+	return &deepL{
+		httpBackend: newHTTPBackend(client),
+		url:         strings.TrimSuffix(url, "/"),
+		apiKey:      apiKey,
+	}
+}
+
+func (d *deepL) Provider() string {
+	return "DeepL.com"
+}
+
+func (d *deepL) Translate(ctx context.Context, texts []string, source string, target string) (*Result, error) {
+	req := struct {
+		Text        []string `json:"text"`
+		SourceLang  string   `json:"source_lang,omitempty"`
+		TargetLang  string   `json:"target_lang"`
+		TagHandling string   `json:"tag_handling"`
+	}{
+		Text:        texts,
+		TargetLang:  deepLTarget(target),
+		TagHandling: "html",
+	}
+
+	if source != "" {
+		// DeepL only takes base
+		// language for the source.
+		req.SourceLang = strings.ToUpper(baseLang(source))
+	}
+
+	var rsp struct {
+		Translations []struct {
+			DetectedSourceLanguage string `json:"detected_source_language"`
+			Text                   string `json:"text"`
+		} `json:"translations"`
+	}
+
+	header := make(http.Header, 1)
+	header.Set("Authorization", "DeepL-Auth-Key "+d.apiKey)
+
+	if err := d.postJSON(ctx,
+		d.url+"/v2/translate",
+		header, req, &rsp,
+	); err != nil {
+		return nil, err
+	}
+
+	res := &Result{
+		Texts: make([]string, len(rsp.Translations)),
+	}
+
+	for i, translation := range rsp.Translations {
+		res.Texts[i] = translation.Text
+	}
+
+	if len(rsp.Translations) > 0 {
+		// Use language detected for first text,
+		// i.e. the main content of the status.
+		res.DetectedSource = strings.ToLower(rsp.Translations[0].DetectedSourceLanguage)
+	}
+
+	return res, nil
+}
+
+// deepLTarget returns the DeepL target language
+// code for the given BCP47 language tag. DeepL
+// requires a variant for English and Portuguese
+// targets, so sensible defaults are used for these.
+func deepLTarget(tag string) string {
+	target := strings.ToUpper(tag)
+	switch target {
+	case "EN":
+		return "EN-US"
+	case "PT":
+		return "PT-BR"
+	}
+	return target
+}
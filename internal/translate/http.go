@@ -0,0 +1,102 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
+)
+
+// maxResponseSize is the max size of response
+// body read from a translation service.
+const maxResponseSize = 1024 * 1024
+
+// httpBackend contains the common HTTP logic
+// shared by translation Backend implementations.
+type httpBackend struct {
+	client    *http.Client
+	userAgent string
+}
+
+func newHTTPBackend(client *httpclient.Client) httpBackend {
+	return httpBackend{
+		client: &http.Client{
+			// Pass in our wrapped httpclient.Client{}
+			// type as http.Transport{} in order to take
+			// advantage of retries, SSF protection etc.
+			Transport: client,
+		},
+		userAgent: fmt.Sprintf("gotosocial/%s (+%s://%s)",
+			config.GetSoftwareVersion(),
+			config.GetProtocol(),
+			config.GetHost(),
+		),
+	}
+}
+
+// postJSON POSTs the JSON encoded body to the given URL
+// with given extra headers, and decodes JSON response to out.
+func (b *httpBackend) postJSON(ctx context.Context, url string, header http.Header, body any, out any) error {
+	bb, err := json.Marshal(body)
+	if err != nil {
+		return gtserror.Newf("error marshaling request: %w", err)
+	}
+
+	r, err := http.NewRequestWithContext(ctx,
+		http.MethodPost,
+		url,
+		bytes.NewReader(bb),
+	)
+	if err != nil {
+		return gtserror.Newf("error building request: %w", err)
+	}
+
+	for key, values := range header {
+		r.Header[key] = values
+	}
+
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("Accept", "application/json")
+	r.Header.Set("User-Agent", b.userAgent)
+
+	rsp, err := b.client.Do(r)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode < 200 || rsp.StatusCode > 299 {
+		return gtserror.NewFromResponse(rsp)
+	}
+
+	// Decode (limited) response body into out.
+	lr := io.LimitReader(rsp.Body, maxResponseSize)
+	if err := json.NewDecoder(lr).Decode(out); err != nil {
+		return gtserror.Newf("error decoding response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate
+
+import (
+	"context"
+	"strings"
+
+	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
+)
+
+// libreTranslate is a Backend implementation
+// using the LibreTranslate API, as documented
+// at https://libretranslate.com/docs.
+type libreTranslate struct {
+	httpBackend
+	url    string
+	apiKey string
+}
+
+// NewLibreTranslate returns a new Backend using the LibreTranslate
+// instance at given base URL, with (optional) API key.
+func NewLibreTranslate(client *httpclient.Client, url string, apiKey string) Backend {
+	return &libreTranslate{
+		httpBackend: newHTTPBackend(client),
+		url:         strings.TrimSuffix(url, "/"),
+		apiKey:      apiKey,
+	}
+}
+
+func (l *libreTranslate) Provider() string {
+	return "LibreTranslate"
+}
+
+func (l *libreTranslate) Translate(ctx context.Context, texts []string, source string, target string) (*Result, error) {
+	if source == "" {
+		source = "auto"
+	} else {
+		source = baseLang(source)
+	}
+
+	req := struct {
+		Q      []string `json:"q"`
+		Source string   `json:"source"`
+		Target string   `json:"target"`
+		Format string   `json:"format"`
+		APIKey string   `json:"api_key,omitempty"`
+	}{
+		Q:      texts,
+		Source: source,
+		Target: baseLang(target),
+		Format: "html",
+		APIKey: l.apiKey,
+	}
+
+	// When translating multiple texts, LibreTranslate
+	// returns a translation and (when auto-detecting
+	// source) a detected language for each.
+	var rsp struct {
+		TranslatedText   []string `json:"translatedText"`
+		DetectedLanguage []struct {
+			Language string `json:"language"`
+		} `json:"detectedLanguage"`
+	}
+
+	if err := l.postJSON(ctx,
+		l.url+"/translate",
+		nil, req, &rsp,
+	); err != nil {
+		return nil, err
+	}
+
+	res := &Result{
+		Texts:          rsp.TranslatedText,
+		DetectedSource: req.Source,
+	}
+
+	if len(rsp.DetectedLanguage) > 0 {
+		// Use language detected for first text,
+		// i.e. the main content of the status.
+		res.DetectedSource = rsp.DetectedLanguage[0].Language
+	}
+
+	return res, nil
+}
@@ -0,0 +1,179 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
+)
+
+// ErrRateLimited is returned by Translator.Translate() when
+// the configured per-instance rate limit has been reached.
+var ErrRateLimited = errors.New("translation rate limit reached")
+
+// Backend is a single translation service backend.
+type Backend interface {
+	// Provider returns the name of the translation
+	// service provider, as shown to clients.
+	Provider() string
+
+	// Translate translates the given HTML texts into the target
+	// language, returning the translated texts in the same order.
+	// Source may be empty to let the service detect the language.
+	Translate(ctx context.Context, texts []string, source string, target string) (*Result, error)
+}
+
+// Result is the result of
+// a translation by a Backend.
+type Result struct {
+	// Translated texts, in same
+	// order as the input texts.
+	Texts []string
+
+	// Source language of the texts, as
+	// detected by the translation service
+	// (or just the given source language).
+	DetectedSource string
+}
+
+// Translator wraps a translation Backend with a per-instance rate
+// limit on requests made to the translation service. A nil Translator
+// is safe to use, and simply reports that translation is disabled.
+type Translator struct {
+	backend Backend
+	limit   int
+
+	// rate limit window
+	// start and count.
+	mu     sync.Mutex
+	window time.Time
+	count  int
+}
+
+// New returns a new Translator using the configured translation
+// backend, making requests using the given HTTP client. If no
+// translation backend is configured, this returns nil.
+func New(client *httpclient.Client) *Translator {
+	var backend Backend
+
+	switch config.GetTranslationBackend() {
+	case "libretranslate":
+		backend = NewLibreTranslate(client,
+			config.GetTranslationURL(),
+			config.GetTranslationAPIKey(),
+		)
+
+	case "deepl":
+		backend = NewDeepL(client,
+			config.GetTranslationURL(),
+			config.GetTranslationAPIKey(),
+		)
+
+	default:
+		return nil
+	}
+
+	return NewWithBackend(backend, config.GetTranslationRateLimit())
+}
+
+// NewWithBackend returns a new Translator using the given Backend,
+// allowing up to limit requests to it per minute (<= 0 is no limit).
+func NewWithBackend(backend Backend, limit int) *Translator {
+	return &Translator{
+		backend: backend,
+		limit:   limit,
+	}
+}
+
+// Enabled returns whether translation is enabled.
+func (t *Translator) Enabled() bool {
+	return t != nil && t.backend != nil
+}
+
+// Provider returns the name of the translation service
+// provider, or empty string if translation is disabled.
+func (t *Translator) Provider() string {
+	if !t.Enabled() {
+		return ""
+	}
+	return t.backend.Provider()
+}
+
+// Translate translates the given HTML texts from source language (may be
+// empty if unknown) into target language, returning ErrRateLimited if the
+// per-instance rate limit on requests to the translation service is reached.
+func (t *Translator) Translate(ctx context.Context, texts []string, source string, target string) (*Result, error) {
+	if !t.Enabled() {
+		return nil, gtserror.New("translation not enabled")
+	}
+
+	if !t.allow(time.Now()) {
+		return nil, ErrRateLimited
+	}
+
+	res, err := t.backend.Translate(ctx, texts, source, target)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Texts) != len(texts) {
+		return nil, gtserror.Newf("expected %d translated texts, got %d", len(texts), len(res.Texts))
+	}
+
+	return res, nil
+}
+
+// allow returns whether another request to the
+// translation service is allowed at given time,
+// counting it towards the rate limit if so.
+func (t *Translator) allow(now time.Time) bool {
+	if t.limit <= 0 {
+		// No limit.
+		return true
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if now.Sub(t.window) >= time.Minute {
+		// Start new window.
+		t.window = now
+		t.count = 0
+	}
+
+	if t.count >= t.limit {
+		return false
+	}
+
+	t.count++
+	return true
+}
+
+// baseLang returns the base language
+// of given BCP47 tag, e.g. "en-US" -> "en".
+func baseLang(tag string) string {
+	base, _, _ := strings.Cut(tag, "-")
+	return strings.ToLower(base)
+}
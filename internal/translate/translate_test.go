@@ -0,0 +1,189 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
+	"code.superseriousbusiness.org/gotosocial/internal/translate"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/stretchr/testify/suite"
+)
+
+// backendFunc is a Backend
+// implementation for tests.
+type backendFunc func(context.Context, []string, string, string) (*translate.Result, error)
+
+func (f backendFunc) Provider() string {
+	return "test"
+}
+
+func (f backendFunc) Translate(ctx context.Context, texts []string, source string, target string) (*translate.Result, error) {
+	return f(ctx, texts, source, target)
+}
+
+// upper "translates" texts to uppercase.
+var upper = backendFunc(func(_ context.Context, texts []string, source string, _ string) (*translate.Result, error) {
+	res := &translate.Result{DetectedSource: source}
+	for _, text := range texts {
+		res.Texts = append(res.Texts, strings.ToUpper(text))
+	}
+	return res, nil
+})
+
+type TranslateTestSuite struct {
+	suite.Suite
+}
+
+func (suite *TranslateTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+}
+
+func (suite *TranslateTestSuite) TestNilTranslator() {
+	var t *translate.Translator
+	suite.False(t.Enabled())
+	suite.Empty(t.Provider())
+
+	_, err := t.Translate(suite.T().Context(), []string{"hello"}, "en", "de")
+	suite.Error(err)
+}
+
+func (suite *TranslateTestSuite) TestRateLimit() {
+	t := translate.NewWithBackend(upper, 2)
+	suite.True(t.Enabled())
+	suite.Equal("test", t.Provider())
+
+	for range 2 {
+		res, err := t.Translate(suite.T().Context(), []string{"hello"}, "en", "de")
+		suite.NoError(err)
+		suite.Equal([]string{"HELLO"}, res.Texts)
+	}
+
+	// Limit reached for this minute.
+	_, err := t.Translate(suite.T().Context(), []string{"hello"}, "en", "de")
+	suite.ErrorIs(err, translate.ErrRateLimited)
+}
+
+func (suite *TranslateTestSuite) TestMissingTexts() {
+	t := translate.NewWithBackend(backendFunc(func(context.Context, []string, string, string) (*translate.Result, error) {
+		return &translate.Result{Texts: []string{"only one"}}, nil
+	}), 0)
+
+	_, err := t.Translate(suite.T().Context(), []string{"one", "two"}, "", "de")
+	suite.ErrorContains(err, "expected 2 translated texts, got 1")
+}
+
+func (suite *TranslateTestSuite) TestLibreTranslate() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Q      []string `json:"q"`
+			Source string   `json:"source"`
+			Target string   `json:"target"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil ||
+			r.URL.Path != "/translate" ||
+			req.Source != "auto" ||
+			req.Target != "de" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+
+		rsp := map[string]any{
+			"translatedText": []string{"<p>hallo welt</p>", ""},
+			"detectedLanguage": []map[string]any{
+				{"language": "en", "confidence": 90},
+				{"language": "en", "confidence": 0},
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(rsp)
+	}))
+	defer server.Close()
+
+	t := translate.NewWithBackend(
+		translate.NewLibreTranslate(testClient(), server.URL+"/", ""),
+		0,
+	)
+
+	res, err := t.Translate(suite.T().Context(), []string{"<p>hello world</p>", ""}, "", "de-DE")
+	suite.NoError(err)
+	suite.Equal([]string{"<p>hallo welt</p>", ""}, res.Texts)
+	suite.Equal("en", res.DetectedSource)
+}
+
+func (suite *TranslateTestSuite) TestDeepL() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Text       []string `json:"text"`
+			SourceLang string   `json:"source_lang"`
+			TargetLang string   `json:"target_lang"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil ||
+			r.URL.Path != "/v2/translate" ||
+			r.Header.Get("Authorization") != "DeepL-Auth-Key some-key" ||
+			req.SourceLang != "DE" ||
+			req.TargetLang != "EN-US" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+
+		rsp := map[string]any{
+			"translations": []map[string]any{
+				{"detected_source_language": "DE", "text": "<p>hello world</p>"},
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(rsp)
+	}))
+	defer server.Close()
+
+	t := translate.NewWithBackend(
+		translate.NewDeepL(testClient(), server.URL, "some-key"),
+		0,
+	)
+
+	res, err := t.Translate(suite.T().Context(), []string{"<p>hallo welt</p>"}, "de-AT", "en")
+	suite.NoError(err)
+	suite.Equal([]string{"<p>hello world</p>"}, res.Texts)
+	suite.Equal("de", res.DetectedSource)
+}
+
+// testClient returns an httpclient
+// allowing localhost test servers.
+func testClient() *httpclient.Client {
+	return httpclient.New(httpclient.Config{
+		AllowRanges: []netip.Prefix{
+			netip.MustParsePrefix("127.0.0.1/32"),
+			netip.MustParsePrefix("::1/128"),
+		},
+	})
+}
+
+func TestTranslateTestSuite(t *testing.T) {
+	suite.Run(t, new(TranslateTestSuite))
+}
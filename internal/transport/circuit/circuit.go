@@ -0,0 +1,301 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package circuit provides a per-domain circuit breaker for outgoing
+// federation requests. Consecutive request failures to a domain are
+// tracked, and once a domain has been failing for long enough it is
+// marked as unreachable, and deliveries to it are skipped. Occasional
+// probe deliveries are then permitted with exponential backoff, until
+// one of them (or any other request to the domain) succeeds.
+package circuit
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+)
+
+const (
+	// openMinFailures is the minimum number of
+	// consecutive request failures to a domain
+	// before it may be marked as unreachable.
+	openMinFailures = 25
+
+	// openMinDuration is the minimum amount of
+	// time a domain must have been continuously
+	// failing before it may be marked unreachable,
+	// so that short outages don't open the circuit.
+	openMinDuration = time.Hour
+
+	// probeBackoffMin is the backoff before the
+	// first probe of an unreachable domain,
+	// doubling every subsequent failed probe.
+	probeBackoffMin = 5 * time.Minute
+
+	// probeBackoffMax is the maximum backoff
+	// between probes of an unreachable domain.
+	probeBackoffMax = 24 * time.Hour
+)
+
+// Breaker tracks request failures per domain, marking
+// domains as unreachable after enough failures, and
+// persisting this state on the domain's instance model
+// so that it survives restarts. A nil Breaker is valid,
+// and permits all requests without tracking anything.
+type Breaker struct {
+	db      db.DB
+	mu      sync.Mutex
+	domains map[string]*domain
+}
+
+// domain contains the circuit
+// state of a single domain.
+type domain struct {
+	// failures is the number of consecutive
+	// failed requests made to this domain.
+	failures int
+
+	// failingSince is the time
+	// of first consecutive failure.
+	failingSince time.Time
+
+	// unreachableAt is the time at which
+	// the circuit for this domain was opened,
+	// zero if the domain is reachable.
+	unreachableAt time.Time
+
+	// nextProbe is the time after which the
+	// next probe of this domain is permitted,
+	// when the domain is marked unreachable.
+	nextProbe time.Time
+}
+
+// New returns a new Breaker
+// persisting state to given db.
+func New(db db.DB) *Breaker {
+	return &Breaker{
+		db:      db,
+		domains: make(map[string]*domain),
+	}
+}
+
+// Load loads the state of domains previously marked
+// as unreachable from the database. This should
+// be called on startup, before requests are made.
+func (b *Breaker) Load(ctx context.Context) error {
+	instances, err := b.db.GetInstancePeers(
+		gtscontext.SetBarebones(ctx),
+		true,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, instance := range instances {
+		if instance.UnreachableAt.IsZero() {
+			continue
+		}
+
+		b.domains[instance.Domain] = &domain{
+			failures:      instance.RequestFailures,
+			failingSince:  instance.UnreachableAt,
+			unreachableAt: instance.UnreachableAt,
+			nextProbe:     instance.NextProbeAt,
+		}
+	}
+
+	return nil
+}
+
+// Allow returns whether deliveries to the given domain
+// may currently be attempted. For domains marked as
+// unreachable this returns false, except once per probe
+// backoff, so that the domain may be checked for recovery.
+func (b *Breaker) Allow(ctx context.Context, host string) bool {
+	if b == nil {
+		return true
+	}
+
+	b.mu.Lock()
+
+	d := b.domains[host]
+	if d == nil || d.unreachableAt.IsZero() {
+		// Reachable.
+		b.mu.Unlock()
+		return true
+	}
+
+	now := time.Now()
+	if now.Before(d.nextProbe) {
+		// Still backing off.
+		b.mu.Unlock()
+		return false
+	}
+
+	// Permit a probe, pushing back
+	// the next probe in case this
+	// one never reports a result.
+	d.nextProbe = now.Add(probeBackoff(d.failures))
+	b.mu.Unlock()
+
+	log.Infof(ctx, "probing unreachable domain %s", host)
+	return true
+}
+
+// Success marks a request to the
+// given domain as having succeeded,
+// marking it reachable if it wasn't.
+func (b *Breaker) Success(ctx context.Context, host string) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+
+	d := b.domains[host]
+	if d == nil {
+		// Nothing to reset.
+		b.mu.Unlock()
+		return
+	}
+
+	// Drop domain state.
+	delete(b.domains, host)
+	b.mu.Unlock()
+
+	if d.unreachableAt.IsZero() {
+		// Was never marked
+		// as unreachable.
+		return
+	}
+
+	log.Infof(ctx, "domain %s is reachable again", host)
+	b.persist(ctx, host, 0, time.Time{}, time.Time{})
+}
+
+// Failure marks a request to the given domain
+// as having failed, marking it as unreachable
+// if it has now been failing for long enough.
+func (b *Breaker) Failure(ctx context.Context, host string) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+
+	d := b.domains[host]
+	if d == nil {
+		d = new(domain)
+		b.domains[host] = d
+	}
+
+	now := time.Now()
+	if d.failures == 0 {
+		d.failingSince = now
+	}
+	d.failures++
+
+	switch {
+	case !d.unreachableAt.IsZero():
+		// Already unreachable, this
+		// was (likely) a failed probe.
+		d.nextProbe = now.Add(probeBackoff(d.failures))
+
+	case d.failures >= openMinFailures &&
+		now.Sub(d.failingSince) >= openMinDuration:
+		// Open the circuit.
+		d.unreachableAt = now
+		d.nextProbe = now.Add(probeBackoff(d.failures))
+		log.Warnf(ctx, "marking domain %s unreachable after %d failures", host, d.failures)
+
+	default:
+		// Not yet unreachable,
+		// nothing to persist.
+		b.mu.Unlock()
+		return
+	}
+
+	var (
+		failures      = d.failures
+		unreachableAt = d.unreachableAt
+		nextProbe     = d.nextProbe
+	)
+
+	b.mu.Unlock()
+
+	b.persist(ctx, host, failures, unreachableAt, nextProbe)
+}
+
+// persist stores the given circuit state on the instance model
+// for domain, if one exists. Errors are logged, not returned, as
+// the in-memory state remains correct regardless.
+func (b *Breaker) persist(
+	ctx context.Context,
+	host string,
+	failures int,
+	unreachableAt time.Time,
+	nextProbe time.Time,
+) {
+	// Don't let the request's context
+	// cancel our database operations.
+	ctx = context.WithoutCancel(ctx)
+
+	instance, err := b.db.GetInstance(gtscontext.SetBarebones(ctx), host)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			log.Errorf(ctx, "db error getting instance %s: %v", host, err)
+		}
+		return
+	}
+
+	instance.RequestFailures = failures
+	instance.UnreachableAt = unreachableAt
+	instance.NextProbeAt = nextProbe
+
+	if err := b.db.UpdateInstance(ctx,
+		instance,
+		"request_failures",
+		"unreachable_at",
+		"next_probe_at",
+	); err != nil {
+		log.Errorf(ctx, "db error updating instance %s: %v", host, err)
+	}
+}
+
+// probeBackoff returns a jittered backoff duration between
+// probes, given number of consecutive request failures.
+func probeBackoff(failures int) time.Duration {
+	// Double backoff for each failed probe,
+	// (limiting shift to prevent overflow).
+	shift := min(max(failures-openMinFailures, 0), 16)
+	backoff := min(probeBackoffMin<<shift, probeBackoffMax)
+
+	// Add +/- 25% jitter, so that domains that became
+	// unreachable at the same time (e.g. a shared host)
+	// don't all then go on to be probed at once.
+	jitter := rand.N(backoff/2) - backoff/4 // #nosec G404 -- not security sensitive
+	return backoff + jitter
+}
@@ -0,0 +1,185 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package circuit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// instanceDB implements the few db.DB
+// functions used by Breaker, storing
+// instances in a map keyed by domain.
+type instanceDB struct {
+	db.DB
+	instances map[string]*gtsmodel.Instance
+}
+
+func (d *instanceDB) GetInstance(_ context.Context, domain string) (*gtsmodel.Instance, error) {
+	instance, ok := d.instances[domain]
+	if !ok {
+		return nil, db.ErrNoEntries
+	}
+	i := *instance
+	return &i, nil
+}
+
+func (d *instanceDB) UpdateInstance(_ context.Context, instance *gtsmodel.Instance, _ ...string) error {
+	i := *instance
+	d.instances[instance.Domain] = &i
+	return nil
+}
+
+func (d *instanceDB) GetInstancePeers(_ context.Context, _ bool) ([]*gtsmodel.Instance, error) {
+	var instances []*gtsmodel.Instance
+	for _, instance := range d.instances {
+		instances = append(instances, instance)
+	}
+	return instances, nil
+}
+
+func TestBreaker(t *testing.T) {
+	const host = "example.org"
+	ctx := t.Context()
+
+	idb := &instanceDB{instances: map[string]*gtsmodel.Instance{
+		host: {Domain: host},
+	}}
+	b := New(idb)
+
+	// Fail enough times to open circuit,
+	// but not for long enough. Domain
+	// should still be reachable.
+	for range openMinFailures {
+		b.Failure(ctx, host)
+	}
+	if !b.Allow(ctx, host) {
+		t.Fatal("domain unreachable before failing for long enough")
+	}
+
+	// Pretend domain has been failing
+	// for a while; next failure should
+	// mark it as unreachable.
+	b.domains[host].failingSince = time.Now().Add(-2 * openMinDuration)
+	b.Failure(ctx, host)
+	if b.Allow(ctx, host) {
+		t.Fatal("domain reachable after failing for long enough")
+	}
+
+	// Circuit state should be persisted.
+	instance := idb.instances[host]
+	if instance.UnreachableAt.IsZero() ||
+		instance.NextProbeAt.IsZero() ||
+		instance.RequestFailures != openMinFailures+1 {
+		t.Fatalf("unexpected persisted instance state: %+v", instance)
+	}
+
+	// Once probe backoff has passed,
+	// a single probe should be allowed.
+	b.domains[host].nextProbe = time.Now()
+	if !b.Allow(ctx, host) {
+		t.Fatal("probe not allowed after backoff")
+	}
+	if b.Allow(ctx, host) {
+		t.Fatal("second probe allowed before backoff")
+	}
+
+	// Success should mark domain
+	// as reachable again, and
+	// clear persisted state.
+	b.Success(ctx, host)
+	if !b.Allow(ctx, host) {
+		t.Fatal("domain unreachable after success")
+	}
+
+	instance = idb.instances[host]
+	if !instance.UnreachableAt.IsZero() ||
+		!instance.NextProbeAt.IsZero() ||
+		instance.RequestFailures != 0 {
+		t.Fatalf("unexpected persisted instance state: %+v", instance)
+	}
+}
+
+func TestBreakerLoad(t *testing.T) {
+	ctx := t.Context()
+
+	idb := &instanceDB{instances: map[string]*gtsmodel.Instance{
+		"example.org": {
+			Domain: "example.org",
+		},
+		"dead.example.org": {
+			Domain:          "dead.example.org",
+			RequestFailures: openMinFailures,
+			UnreachableAt:   time.Now().Add(-time.Hour),
+			NextProbeAt:     time.Now().Add(time.Hour),
+		},
+	}}
+
+	b := New(idb)
+	if err := b.Load(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if !b.Allow(ctx, "example.org") {
+		t.Fatal("reachable domain not allowed after load")
+	}
+
+	if b.Allow(ctx, "dead.example.org") {
+		t.Fatal("unreachable domain allowed after load")
+	}
+}
+
+func TestNilBreaker(t *testing.T) {
+	var b *Breaker
+	ctx := t.Context()
+
+	b.Failure(ctx, "example.org")
+	b.Success(ctx, "example.org")
+	if !b.Allow(ctx, "example.org") {
+		t.Fatal("nil breaker should allow all requests")
+	}
+}
+
+func TestProbeBackoff(t *testing.T) {
+	for _, test := range []struct {
+		failures int
+		expect   time.Duration
+	}{
+		{failures: openMinFailures, expect: probeBackoffMin},
+		{failures: openMinFailures + 1, expect: 2 * probeBackoffMin},
+		{failures: openMinFailures + 3, expect: 8 * probeBackoffMin},
+		{failures: openMinFailures + 9, expect: probeBackoffMax},
+		{failures: 1000, expect: probeBackoffMax},
+	} {
+		// Backoff should be within +/- 25% jitter of expected.
+		lower := test.expect - test.expect/4
+		upper := test.expect + test.expect/4
+
+		for range 100 {
+			backoff := probeBackoff(test.failures)
+			if backoff < lower || backoff > upper {
+				t.Fatalf("backoff %s for %d failures outside of range [%s, %s]",
+					backoff, test.failures, lower, upper)
+			}
+		}
+	}
+}
@@ -29,14 +29,17 @@
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
 
 	"code.superseriousbusiness.org/activity/pub"
+	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/federation/federatingdb"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/msgsig"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
 	"codeberg.org/gruf/go-byteutil"
@@ -57,6 +60,7 @@ type controller struct {
 	fedDB     *federatingdb.DB
 	client    pub.HttpClient
 	trspCache cache.TTLCache[string, *transport]
+	sigCaps   cache.TTLCache[string, bool]
 	userAgent string
 }
 
@@ -76,9 +80,54 @@ func NewController(state *state.State, federatingDB *federatingdb.DB, client pub
 		userAgent: fmt.Sprintf("gotosocial/%s (+%s://%s)", version, proto, host),
 	}
 
+	// Initiate remote http signature capabilities cache.
+	c.sigCaps = cache.NewTTL[string, bool](0, 1000, 0)
+	c.sigCaps.SetTTL(24*time.Hour, false)
+	if !c.sigCaps.Start(time.Minute) {
+		log.Panic(nil, "failed to start transport controller cache")
+	}
+
 	return c
 }
 
+// supportsRFC9421 returns whether host is known
+// to accept RFC 9421 http message signatures.
+func (c *controller) supportsRFC9421(host string) bool {
+	ok, _ := c.sigCaps.Get(host)
+	return ok
+}
+
+// updateSigCaps updates the cached http signature capabilities
+// of host according to the response to a request sent to it.
+// A host rejecting an RFC 9421 signed request is marked as not
+// supporting them until the cache entry expires, otherwise a
+// host advertising an Accept-Signature header is marked as
+// supporting them.
+func (c *controller) updateSigCaps(host string, rsp *http.Response, rfc9421 bool) {
+	switch {
+	case rfc9421 && rsp.StatusCode == http.StatusUnauthorized:
+		c.sigCaps.Set(host, false)
+
+	case rsp.Header.Get(msgsig.AcceptSignatureHeader) != "":
+		c.sigCaps.Add(host, true)
+	}
+}
+
+// updateCircuit informs the delivery circuit breaker of
+// the outcome of given request, so that dereference failures
+// also count towards marking a domain unreachable, and any
+// success marks it reachable again. Our own context
+// cancellations aren't counted as failures.
+func (c *controller) updateCircuit(r *http.Request, err error) {
+	breaker := c.state.Workers.Delivery.Breaker
+	switch {
+	case err == nil:
+		breaker.Success(r.Context(), r.URL.Host)
+	case r.Context().Err() == nil:
+		breaker.Failure(r.Context(), r.URL.Host)
+	}
+}
+
 func (c *controller) NewTransport(pubKeyID string, privkey *rsa.PrivateKey) (Transport, error) {
 	// Generate public key string for cache key
 	//
@@ -21,15 +21,22 @@
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
+	"maps"
 	"net/http"
 	"net/url"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
 
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
+	"code.superseriousbusiness.org/gotosocial/internal/integrity"
 	"code.superseriousbusiness.org/gotosocial/internal/transport/delivery"
 )
 
@@ -47,9 +54,9 @@ func (t *transport) BatchDeliver(ctx context.Context, obj map[string]interface{}
 	)
 
 	// Marshal object as JSON.
-	b, err := json.Marshal(obj)
+	b, err := t.marshal(ctx, obj)
 	if err != nil {
-		return gtserror.Newf("error marshaling json: %w", err)
+		return err
 	}
 
 	// Extract object IDs.
@@ -94,9 +101,9 @@ func (t *transport) Deliver(ctx context.Context, obj map[string]interface{}, to
 	}
 
 	// Marshal object as JSON.
-	b, err := json.Marshal(obj)
+	b, err := t.marshal(ctx, obj)
 	if err != nil {
-		return gtserror.Newf("error marshaling json: %w", err)
+		return err
 	}
 
 	// Prepare http client request.
@@ -117,6 +124,56 @@ func (t *transport) Deliver(ctx context.Context, obj map[string]interface{}, to
 	return nil
 }
 
+// marshal will marshal the given object as JSON for
+// delivery, first adding an object integrity proof
+// made by the object's actor, if enabled.
+func (t *transport) marshal(ctx context.Context, obj map[string]interface{}) ([]byte, error) {
+	if config.GetInstanceFederationIntegrityProofs() {
+		// Sign a shallow copy, so
+		// caller's obj is untouched.
+		obj = maps.Clone(obj)
+		t.addProof(ctx, obj)
+	}
+
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, gtserror.Newf("error marshaling json: %w", err)
+	}
+
+	return b, nil
+}
+
+// addProof adds an object integrity proof to obj, made with
+// the Ed25519 key of its actor. Nothing is added if the actor
+// is not a local account with a key, eg., for forwarded objects.
+func (t *transport) addProof(ctx context.Context, obj map[string]interface{}) {
+	actorID := getActorID(obj)
+	if actorID == "" {
+		return
+	}
+
+	actor, err := t.controller.state.DB.GetAccountByURI(
+		gtscontext.SetBarebones(ctx),
+		actorID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		log.Errorf(ctx, "db error getting actor %s: %v", actorID, err)
+		return
+	}
+
+	if actor == nil || !actor.IsLocal() || actor.Ed25519PrivateKey == nil {
+		return
+	}
+
+	if err := integrity.Sign(obj,
+		actor.Ed25519PrivateKey,
+		actor.Ed25519PublicKeyURI,
+		time.Now(),
+	); err != nil {
+		log.Errorf(ctx, "error signing integrity proof for %s: %v", actorID, err)
+	}
+}
+
 // prepare will prepare a POST http.Request{}
 // to recipient at 'to', wrapping in a queued
 // request object with signing function.
@@ -132,7 +189,7 @@ func (t *transport) prepare(
 	error,
 ) {
 	// Prepare POST signer.
-	sign := t.signPOST(data)
+	sign := t.signPOST(data, nil)
 
 	// Use *bytes.Reader for request body,
 	// as NewRequest() automatically will
@@ -193,7 +250,7 @@ func (t *transport) SignDelivery(dlv *delivery.Delivery) error {
 
 	// Get signing function for POST data.
 	// (note that delivery is ALWAYS POST).
-	sign := t.signPOST(data)
+	sign := t.signPOST(data, nil)
 
 	// Extract delivery context.
 	ctx := dlv.Request.Context()
@@ -0,0 +1,76 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package delivery
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Metrics contains running counts of delivery
+// attempts by target domain, and the current
+// size of worker retry backlogs, for use in
+// instance metrics.
+var Metrics struct {
+
+	// Backlog is the total number of deliveries
+	// currently awaiting retry in worker backlogs.
+	Backlog atomic.Int64
+
+	// domains contains *DomainMetrics{}
+	// stored under target domain keys.
+	domains sync.Map
+}
+
+// DomainMetrics contains running counts
+// of delivery attempts to a single domain.
+type DomainMetrics struct {
+
+	// Successes is the number of delivery
+	// attempts that completed successfully.
+	Successes atomic.Int64
+
+	// Failures is the number of delivery
+	// attempts that failed, including those
+	// that will later be retried.
+	Failures atomic.Int64
+
+	// Skipped is the number of deliveries
+	// not attempted as the domain was marked
+	// unreachable by the circuit breaker.
+	Skipped atomic.Int64
+}
+
+// RangeDomains calls fn for each domain that deliveries
+// have been attempted to, with its current delivery counts.
+func RangeDomains(fn func(domain string, m *DomainMetrics)) {
+	Metrics.domains.Range(func(k, v any) bool {
+		fn(k.(string), v.(*DomainMetrics))
+		return true
+	})
+}
+
+// domainMetrics returns the
+// *DomainMetrics{} for domain.
+func domainMetrics(domain string) *DomainMetrics {
+	v, ok := Metrics.domains.Load(domain)
+	if !ok {
+		v, _ = Metrics.domains.LoadOrStore(domain, new(DomainMetrics))
+	}
+	return v.(*DomainMetrics)
+}
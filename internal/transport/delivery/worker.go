@@ -27,6 +27,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
 	"code.superseriousbusiness.org/gotosocial/internal/queue"
+	"code.superseriousbusiness.org/gotosocial/internal/transport/circuit"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
 	"codeberg.org/gruf/go-runners"
 	"codeberg.org/gruf/go-structr"
@@ -40,6 +41,10 @@ type WorkerPool struct {
 	// passed to each of delivery pool Worker{}s.
 	Client *httpclient.Client
 
+	// Breaker is the optional circuit.Breaker{}
+	// passed to each of delivery pool Worker{}s.
+	Breaker *circuit.Breaker
+
 	// Queue is the embedded queue.StructQueue{}
 	// passed to each of delivery pool Worker{}s.
 	Queue queue.StructQueue[*Delivery]
@@ -78,6 +83,7 @@ func (p *WorkerPool) Start(n int) {
 		// Allocate new Worker{}.
 		p.workers[i] = new(Worker)
 		p.workers[i].Client = p.Client
+		p.workers[i].Breaker = p.Breaker
 		p.workers[i].Queue = &p.Queue
 
 		// Attempt to start worker.
@@ -125,6 +131,11 @@ type Worker struct {
 	// delivery worker will use for requests.
 	Client *httpclient.Client
 
+	// Breaker is the circuit.Breaker{} used to
+	// skip deliveries to unreachable domains,
+	// and informed of delivery outcomes. May be nil.
+	Breaker *circuit.Breaker
+
 	// Queue is the Delivery{} message queue
 	// that delivery worker will feed from.
 	Queue *queue.StructQueue[*Delivery]
@@ -207,11 +218,32 @@ func (w *Worker) process(ctx context.Context) bool {
 			}
 		}
 
+		// Skip deliveries to domains
+		// currently marked unreachable.
+		host := dlv.Request.URL.Host
+		domain := domainMetrics(host)
+		if !w.Breaker.Allow(ctx, host) {
+			log.Debugf(ctx, "skipping delivery to unreachable domain %s", host)
+			domain.Skipped.Add(1)
+			continue loop
+		}
+
 		// Attempt delivery of AP request.
 		rsp, retry, err := w.Client.DoOnce(
 			dlv.Request,
 		)
 
+		// Update delivery counts and circuit state
+		// for target domain, not including our own
+		// context cancellations.
+		if err == nil {
+			domain.Successes.Add(1)
+			w.Breaker.Success(ctx, host)
+		} else if ctx.Err() == nil {
+			domain.Failures.Add(1)
+			w.Breaker.Failure(ctx, host)
+		}
+
 		switch {
 		case err == nil:
 			// Ensure body closed.
@@ -290,6 +322,7 @@ func (w *Worker) popBacklog() *Delivery {
 
 	// Pop from backlog.
 	dlv := w.backlog[0]
+	Metrics.Backlog.Add(-1)
 
 	// Shift backlog down by one.
 	copy(w.backlog, w.backlog[1:])
@@ -301,6 +334,7 @@ func (w *Worker) popBacklog() *Delivery {
 // pushBacklog pushes the given delivery to backlog.
 func (w *Worker) pushBacklog(dlv *Delivery) {
 	w.backlog = append(w.backlog, dlv)
+	Metrics.Backlog.Add(1)
 }
 
 // sortDeliveries sorts deliveries according
@@ -43,8 +43,8 @@ func (suite *DereferenceTestSuite) TestDerefLocalUser() {
 	defer resp.Body.Close()
 
 	suite.Equal(http.StatusOK, resp.StatusCode)
-	suite.EqualValues(2146, resp.ContentLength)
-	suite.Equal("2146", resp.Header.Get("Content-Length"))
+	suite.EqualValues(2209, resp.ContentLength)
+	suite.Equal("2209", resp.Header.Get("Content-Length"))
 	suite.Equal(apiutil.AppActivityLDJSON, resp.Header.Get("Content-Type"))
 
 	b, err := io.ReadAll(resp.Body)
@@ -70,6 +70,7 @@ func (suite *DereferenceTestSuite) TestDerefLocalUser() {
       },
       "indexable": "toot:indexable",
       "manuallyApprovesFollowers": "as:manuallyApprovesFollowers",
+      "noindex": "https://misskey-hub.net/ns#noindex",
       "toot": "http://joinmastodon.org/ns#"
     }
   ],
@@ -96,6 +97,7 @@ func (suite *DereferenceTestSuite) TestDerefLocalUser() {
   "indexable": true,
   "manuallyApprovesFollowers": false,
   "name": "original zork (he/they)",
+  "noindex": false,
   "outbox": "http://localhost:8080/users/the_mighty_zork/outbox",
   "preferredUsername": "the_mighty_zork",
   "publicKey": {
@@ -26,6 +26,7 @@
 	"net/url"
 	"slices"
 	"strings"
+	"time"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
@@ -83,6 +84,29 @@ func (t *transport) DereferenceInstance(ctx context.Context, iri *url.URL) (*gts
 	}, nil
 }
 
+func (t *transport) DereferenceNodeInfo(ctx context.Context, iri *url.URL) (*apimodel.Nodeinfo, error) {
+	// Try to fetch robots.txt to check
+	// if we're allowed to try endpoints.
+	robotsTxt, err := t.DereferenceRobots(ctx, iri.Scheme, iri.Host)
+	if err != nil {
+		log.Debugf(ctx, "couldn't fetch robots.txt from %s: %v", iri.Host, err)
+	}
+
+	// Retrieve the nodeinfo IRI from .well-known/nodeinfo.
+	niIRI, err := t.callNodeInfoWellKnown(ctx, iri, robotsTxt)
+	if err != nil {
+		return nil, gtserror.Newf("error during initial call to .well-known: %w", err)
+	}
+
+	// Use the returned nodeinfo IRI to make a followup call.
+	ni, err := t.callNodeInfo(ctx, niIRI, robotsTxt)
+	if err != nil {
+		return nil, gtserror.Newf("error during call to %s: %w", niIRI.String(), err)
+	}
+
+	return ni, nil
+}
+
 func (t *transport) dereferenceByAPIV1Instance(
 	ctx context.Context,
 	iri *url.URL,
@@ -251,14 +275,9 @@ func (t *transport) dereferenceByNodeInfo(
 	i.ContactEmail = contactEmail
 	i.ContactAccountUsername = contactAccountUsername
 
-	var software string
-	if ni.Software.Name != "" {
-		software = ni.Software.Name
-	}
-	if ni.Software.Version != "" {
-		software = software + " " + ni.Software.Version
-	}
-	i.Version = software
+	i.Software = ni.Software.Name
+	i.Version = ni.Software.Version
+	i.NodeInfoFetchedAt = time.Now()
 
 	return i, nil
 }
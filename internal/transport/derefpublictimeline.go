@@ -0,0 +1,101 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+)
+
+// maxPublicTimelineSize is the maximum size
+// of a remote public timeline we'll read: 2MiB.
+const maxPublicTimelineSize = 2 << 20
+
+func (t *transport) DereferencePublicTimeline(ctx context.Context, domain string, limit int) ([]*apimodel.Status, error) {
+	const path = "api/v1/timelines/public"
+
+	// Try to fetch robots.txt to check
+	// if we're allowed to fetch the timeline.
+	robotsTxt, err := t.DereferenceRobots(ctx, "https", domain)
+	if err != nil {
+		log.Debugf(ctx, "couldn't fetch robots.txt from %s: %v", domain, err)
+	}
+
+	// Bail if we're not allowed to fetch this endpoint.
+	if robotsTxt != nil && !robotsTxt.TestAgent("/"+path, t.controller.userAgent) {
+		err := gtserror.Newf("can't fetch %s: robots.txt disallows it", path)
+		return nil, gtserror.SetNotPermitted(err)
+	}
+
+	iri := &url.URL{
+		Scheme: "https",
+		Host:   domain,
+		Path:   path,
+		RawQuery: url.Values{
+			"local": []string{"true"},
+			"limit": []string{strconv.Itoa(limit)},
+		}.Encode(),
+	}
+
+	// Prepare new HTTP request to endpoint.
+	req, err := http.NewRequestWithContext(ctx, "GET", iri.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Accept", string(apiutil.AppJSON))
+
+	// Perform the HTTP request.
+	rsp, err := t.GET(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// If we have an unexpected / error response,
+	// wrap + return as error. This will also drain
+	// and close the response body for us.
+	if rsp.StatusCode != http.StatusOK {
+		return nil, gtserror.NewFromResponse(rsp)
+	}
+	defer rsp.Body.Close()
+
+	// Ensure that the incoming request content-type is expected.
+	if ct := rsp.Header.Get("Content-Type"); !apiutil.JSONContentType(ct) {
+		err := gtserror.Newf("non json response type: %s", ct)
+		return nil, gtserror.SetMalformed(err)
+	}
+
+	// Decode the statuses, limiting the
+	// amount we'll read into memory.
+	var statuses []*apimodel.Status
+	body := io.LimitReader(rsp.Body, maxPublicTimelineSize)
+	if err := json.NewDecoder(body).Decode(&statuses); err != nil {
+		err := gtserror.Newf("error decoding public timeline: %w", err)
+		return nil, gtserror.SetMalformed(err)
+	}
+
+	return statuses, nil
+}
@@ -0,0 +1,117 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+)
+
+// maxDirectorySize is the maximum size
+// of a directory listing we'll read: 4MiB.
+const maxDirectorySize = 4 << 20
+
+// DirectoryAccount models one account
+// entry in an external account directory.
+type DirectoryAccount struct {
+	URI         string    `json:"uri"`
+	URL         string    `json:"url,omitempty"`
+	Username    string    `json:"username,omitempty"`
+	DisplayName string    `json:"display_name,omitempty"`
+	Note        string    `json:"note,omitempty"`
+	Locked      bool      `json:"locked"`
+	Bot         bool      `json:"bot"`
+	CreatedAt   time.Time `json:"created_at,omitzero"`
+}
+
+// Directory models the body of a directory
+// publish request, or a directory listing.
+type Directory struct {
+	Host     string             `json:"host,omitempty"`
+	Accounts []DirectoryAccount `json:"accounts"`
+}
+
+func (t *transport) PublishDirectory(ctx context.Context, directoryURL string, dir *Directory) error {
+	b, err := json.Marshal(dir)
+	if err != nil {
+		return gtserror.Newf("error marshaling directory: %w", err)
+	}
+
+	// Prepare new HTTP request to endpoint.
+	req, err := http.NewRequestWithContext(ctx, "POST", directoryURL, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	// Perform the HTTP request.
+	rsp, err := t.POST(req, b)
+	if err != nil {
+		return err
+	}
+
+	// Wrap unexpected status
+	// codes as error; this will
+	// also drain + close body.
+	if rsp.StatusCode < 200 || rsp.StatusCode > 299 {
+		return gtserror.NewFromResponse(rsp)
+	}
+
+	_ = rsp.Body.Close()
+	return nil
+}
+
+func (t *transport) DereferenceDirectory(ctx context.Context, directoryURL string) (*Directory, error) {
+	// Prepare new HTTP request to endpoint.
+	req, err := http.NewRequestWithContext(ctx, "GET", directoryURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Perform the HTTP request.
+	rsp, err := t.GET(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// If we have an unexpected / error response,
+	// wrap + return as error. This will also drain
+	// and close the response body for us.
+	if rsp.StatusCode != http.StatusOK {
+		return nil, gtserror.NewFromResponse(rsp)
+	}
+	defer rsp.Body.Close()
+
+	// Decode the directory listing, limiting
+	// the amount we'll read into memory.
+	var dir Directory
+	body := io.LimitReader(rsp.Body, maxDirectorySize)
+	if err := json.NewDecoder(body).Decode(&dir); err != nil {
+		return nil, gtserror.Newf("error decoding directory: %w", err)
+	}
+
+	return &dir, nil
+}
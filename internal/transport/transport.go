@@ -20,6 +20,7 @@
 import (
 	"context"
 	"crypto"
+	"crypto/rsa"
 	"errors"
 	"io"
 	"net/http"
@@ -27,9 +28,11 @@
 	"sync"
 	"time"
 
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
+	"code.superseriousbusiness.org/gotosocial/internal/msgsig"
 	"code.superseriousbusiness.org/gotosocial/internal/transport/delivery"
 	"code.superseriousbusiness.org/httpsig"
 )
@@ -61,6 +64,10 @@ type Transport interface {
 	// BatchDeliver sends an ActivityStreams object to multiple recipients.
 	BatchDeliver(ctx context.Context, obj map[string]interface{}, recipients []*url.URL) error
 
+	// PublishDirectory posts the given directory
+	// of local accounts to the given directory URL.
+	PublishDirectory(ctx context.Context, directoryURL string, dir *Directory) error
+
 	/*
 		GET functions
 	*/
@@ -78,6 +85,10 @@ type Transport interface {
 	// DereferenceInstance dereferences remote instance information, first by checking /api/v1/instance, and then by checking /.well-known/nodeinfo.
 	DereferenceInstance(ctx context.Context, iri *url.URL) (*gtsmodel.Instance, error)
 
+	// DereferenceNodeInfo dereferences the nodeinfo document of the instance
+	// at the given IRI, by way of its /.well-known/nodeinfo endpoint.
+	DereferenceNodeInfo(ctx context.Context, iri *url.URL) (*apimodel.Nodeinfo, error)
+
 	// DereferenceDomainPermissions dereferences the
 	// permissions list present at the given permSub's URI.
 	//
@@ -92,6 +103,15 @@ type Transport interface {
 		skipCache bool,
 	) (*DereferenceDomainPermissionsResp, error)
 
+	// DereferenceDirectory fetches the
+	// account directory at the given URL.
+	DereferenceDirectory(ctx context.Context, directoryURL string) (*Directory, error)
+
+	// DereferencePublicTimeline fetches up to limit statuses from
+	// the local public timeline of the instance at the given domain,
+	// via its Mastodon-compatible client API.
+	DereferencePublicTimeline(ctx context.Context, domain string, limit int) ([]*apimodel.Status, error)
+
 	// Finger performs a webfinger request with the given username and domain, and returns the bytes from the response body.
 	Finger(ctx context.Context, targetUsername string, targetDomain string) ([]byte, error)
 }
@@ -115,9 +135,10 @@ func (t *transport) GET(r *http.Request) (*http.Response, error) {
 	}
 
 	// Prepare HTTP GET signing func with opts.
+	var rfc9421 bool
 	sign := t.signGET(httpsig.SignatureOption{
 		ExcludeQueryStringFromPathPseudoHeader: false,
-	})
+	}, &rfc9421)
 
 	ctx := r.Context() // update with signing details.
 	ctx = gtscontext.SetOutgoingPublicKeyID(ctx, t.pubKeyID)
@@ -129,10 +150,34 @@ func (t *transport) GET(r *http.Request) (*http.Response, error) {
 
 	// Pass to underlying HTTP client.
 	resp, err := t.controller.client.Do(r)
-	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+
+	// Note remote's reachability.
+	t.controller.updateCircuit(r, err)
+
+	if err != nil {
 		return resp, err
 	}
 
+	// Note remote's signature capabilities.
+	t.controller.updateSigCaps(r.URL.Host, resp, rfc9421)
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		return resp, nil
+	}
+
+	if rfc9421 {
+		// Ignore this response.
+		_ = resp.Body.Close()
+
+		// Try again, now that the host is marked
+		// as not supporting RFC 9421 signatures
+		// this will fall back to draft-cavage.
+		resp, err = t.controller.client.Do(r)
+		if err != nil || resp.StatusCode != http.StatusUnauthorized {
+			return resp, err
+		}
+	}
+
 	// Ignore this response.
 	_ = resp.Body.Close()
 
@@ -140,7 +185,7 @@ func (t *transport) GET(r *http.Request) (*http.Response, error) {
 	// the HTTP signature for better compatibility.
 	sign = t.signGET(httpsig.SignatureOption{
 		ExcludeQueryStringFromPathPseudoHeader: true,
-	})
+	}, nil)
 
 	ctx = r.Context() // update with signing details.
 	ctx = gtscontext.SetHTTPClientSignFunc(ctx, sign)
@@ -156,7 +201,8 @@ func (t *transport) POST(r *http.Request, body []byte) (*http.Response, error) {
 	}
 
 	// Prepare POST signer.
-	sign := t.signPOST(body)
+	var rfc9421 bool
+	sign := t.signPOST(body, &rfc9421)
 
 	ctx := r.Context() // update with signing details.
 	ctx = gtscontext.SetOutgoingPublicKeyID(ctx, t.pubKeyID)
@@ -167,12 +213,43 @@ func (t *transport) POST(r *http.Request, body []byte) (*http.Response, error) {
 	r.Header.Set("User-Agent", t.controller.userAgent)
 
 	// Pass to underlying HTTP client.
+	resp, err := t.controller.client.Do(r)
+	if err != nil {
+		return resp, err
+	}
+
+	// Note remote's signature capabilities.
+	t.controller.updateSigCaps(r.URL.Host, resp, rfc9421)
+
+	if !rfc9421 || resp.StatusCode != http.StatusUnauthorized ||
+		r.GetBody == nil {
+		return resp, nil
+	}
+
+	// Rewind the request body.
+	rbody, err := r.GetBody()
+	if err != nil {
+		return resp, nil
+	}
+
+	// Ignore this response.
+	_ = resp.Body.Close()
+	r.Body = rbody
+
+	// Try again, now that the host is marked
+	// as not supporting RFC 9421 signatures
+	// this will fall back to draft-cavage.
 	return t.controller.client.Do(r)
 }
 
-// signGET will safely sign an HTTP GET request.
-func (t *transport) signGET(opts httpsig.SignatureOption) httpclient.SignFunc {
+// signGET will safely sign an HTTP GET request. If rfc9421
+// is non-nil it will be set to whether the last signing used
+// an RFC 9421 message signature rather than draft-cavage.
+func (t *transport) signGET(opts httpsig.SignatureOption, rfc9421 *bool) httpclient.SignFunc {
 	return func(r *http.Request) (err error) {
+		if ok, err := t.signRFC9421(r, nil, rfc9421); ok {
+			return err
+		}
 		t.safesign(func() {
 			err = t.getSigner.SignRequestWithOptions(t.privkey, t.pubKeyID, r, nil, opts)
 		})
@@ -181,8 +258,19 @@ func (t *transport) signGET(opts httpsig.SignatureOption) httpclient.SignFunc {
 }
 
 // signPOST will safely sign an HTTP POST request for given body.
-func (t *transport) signPOST(body []byte) httpclient.SignFunc {
+// If rfc9421 is non-nil it will be set to whether the last signing
+// used an RFC 9421 message signature rather than draft-cavage.
+func (t *transport) signPOST(body []byte, rfc9421 *bool) httpclient.SignFunc {
+	// RFC 9421 signing only includes a
+	// content digest for non-nil body.
+	digestBody := body
+	if digestBody == nil {
+		digestBody = []byte{}
+	}
 	return func(r *http.Request) (err error) {
+		if ok, err := t.signRFC9421(r, digestBody, rfc9421); ok {
+			return err
+		}
 		t.safesign(func() {
 			err = t.postSigner.SignRequest(t.privkey, t.pubKeyID, r, body)
 		})
@@ -190,6 +278,21 @@ func (t *transport) signPOST(body []byte) httpclient.SignFunc {
 	}
 }
 
+// signRFC9421 will sign the request using an RFC 9421 message
+// signature if the remote host is known to support them. Returns
+// false if the request should instead be signed with draft-cavage.
+func (t *transport) signRFC9421(r *http.Request, body []byte, used *bool) (bool, error) {
+	key, ok := t.privkey.(*rsa.PrivateKey)
+	ok = ok && t.controller.supportsRFC9421(r.URL.Host)
+	if used != nil {
+		*used = ok
+	}
+	if !ok {
+		return false, nil
+	}
+	return true, msgsig.SignRequest(r, key, t.pubKeyID, body, time.Now())
+}
+
 // safesign will perform sign function within mutex protection,
 // and ensured that httpsig.Signers are up-to-date.
 func (t *transport) safesign(sign func()) {
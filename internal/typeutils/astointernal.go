@@ -22,6 +22,7 @@
 	"context"
 	"errors"
 	"net/url"
+	"strings"
 	"time"
 
 	"code.superseriousbusiness.org/gopkg/log"
@@ -164,6 +165,16 @@ func (c *Converter) ASRepresentationToAccount(
 
 	// Extract account indexability (default = false).
 	indexable := ap.GetIndexable(accountable)
+
+	// Also respect noindex, as used by Misskey and forks.
+	// If set, this opts out of indexing even if indexable
+	// is true, and stands in for indexable if that's unset.
+	if with, ok := accountable.(ap.WithUnknownProperties); ok {
+		if noindex, ok := ap.GetNoIndex(with); ok &&
+			(noindex || accountable.GetTootIndexable() == nil) {
+			indexable = !noindex
+		}
+	}
 	acct.Indexable = &indexable
 
 	// Extract the URL property.
@@ -248,6 +259,11 @@ func (c *Converter) ASRepresentationToAccount(
 	acct.PublicKey = pkey
 	acct.PublicKeyURI = pkeyURL.String()
 
+	// Extract any Ed25519 key used for object integrity proofs.
+	if with, ok := accountable.(ap.WithUnknownProperties); ok {
+		acct.Ed25519PublicKey, acct.Ed25519PublicKeyURI = ap.ExtractAssertionMethodKey(with, acct.URI)
+	}
+
 	// Web visibility for statuses.
 	acct.HidesToPublicFromUnauthedWeb = util.Ptr(ap.GetHidesToPublicFromUnauthedWeb(accountable))
 	acct.HidesCcPublicFromUnauthedWeb = util.Ptr(ap.GetHidesCcPublicFromUnauthedWeb(accountable))
@@ -459,6 +475,10 @@ func (c *Converter) ASStatusToStatus(ctx context.Context, statusable ap.Statusab
 	// change when permissivity is checked.
 	status.PendingApproval = util.Ptr(false)
 
+	// Language of remote statuses is taken
+	// as given by the origin, not detected.
+	status.LanguageDetected = util.Ptr(false)
+
 	// status.Sensitive
 	sensitive := ap.ExtractSensitive(statusable)
 	status.Sensitive = &sensitive
@@ -567,6 +587,80 @@ func (c *Converter) ASLikeToFave(ctx context.Context, likeable ap.Likeable) (*gt
 	}, nil
 }
 
+// ASReactToStatusReaction converts a remote activity streams emoji reaction
+// (either an 'EmojiReact', or a 'Like' with content) into a gts model reaction.
+//
+// If the reaction uses a custom emoji, the Emoji field of the returned reaction
+// will be set to a placeholder, which should be dereferenced by the caller.
+func (c *Converter) ASReactToStatusReaction(ctx context.Context, reactable ap.Reactable) (*gtsmodel.StatusReaction, error) {
+	uriObj := ap.GetJSONLDId(reactable)
+	if uriObj == nil {
+		err := gtserror.New("unusable iri property")
+		return nil, gtserror.SetMalformed(err)
+	}
+
+	// Stringify uri obj.
+	uri := uriObj.String()
+
+	// Extract the reaction emoji from content.
+	content := ap.ExtractContent(reactable).Content
+	content = strings.TrimSpace(content)
+	if content == "" {
+		err := gtserror.Newf("empty content for reaction %s", uri)
+		return nil, gtserror.SetMalformed(err)
+	}
+
+	origin, err := c.getASActorAccount(ctx, uri, reactable)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := c.getASObjectStatus(ctx, uri, reactable)
+	if err != nil {
+		return nil, err
+	}
+
+	reaction := &gtsmodel.StatusReaction{
+		AccountID:       origin.ID,
+		Account:         origin,
+		TargetAccountID: target.AccountID,
+		TargetAccount:   target.Account,
+		StatusID:        target.ID,
+		Status:          target,
+		Content:         content,
+		URI:             uri,
+	}
+
+	if !strings.HasPrefix(content, ":") ||
+		!strings.HasSuffix(content, ":") {
+		// Unicode emoji, nothing more to do.
+		return reaction, nil
+	}
+
+	// Custom emoji, look for a matching
+	// emoji tag, using origin domain as host.
+	emojis, err := ap.ExtractEmojis(reactable, origin.Domain)
+	if err != nil {
+		err := gtserror.Newf("error extracting emoji for reaction %s: %w", uri, err)
+		return nil, gtserror.SetMalformed(err)
+	}
+
+	shortcode := strings.Trim(content, ":")
+	for _, emoji := range emojis {
+		if emoji.Shortcode == shortcode {
+			reaction.Emoji = emoji
+			break
+		}
+	}
+
+	if reaction.Emoji == nil {
+		err := gtserror.Newf("no emoji tag for %s in reaction %s", content, uri)
+		return nil, gtserror.SetMalformed(err)
+	}
+
+	return reaction, nil
+}
+
 // ASBlockToBlock converts a remote activity streams 'block' representation into a gts model block.
 func (c *Converter) ASBlockToBlock(ctx context.Context, blockable ap.Blockable) (*gtsmodel.Block, error) {
 	uriObj := ap.GetJSONLDId(blockable)
@@ -693,6 +787,10 @@ func (c *Converter) ASAnnounceToStatus(
 	// change when permissivity is checked.
 	boost.PendingApproval = util.Ptr(false)
 
+	// Boosts take their language from
+	// the target status; none detected.
+	boost.LanguageDetected = util.Ptr(false)
+
 	// Remaining fields on the boost will be
 	// taken from the target status; it's not
 	// our job to do all that dereferencing here.
@@ -772,6 +772,31 @@ func (suite *ASToInternalTestSuite) TestParseAccountableWithPreferredUsername()
 	suite.Equal(acc.Username, preferredUsername)
 }
 
+func (suite *ASToInternalTestSuite) TestParseAccountableWithNoIndex() {
+	ctx, cncl := context.WithCancel(suite.T().Context())
+	defer cncl()
+
+	testPerson := suite.testPeople["https://unknown-instance.com/users/brand_new_person"]
+
+	// With indexable unset, noindex
+	// false should opt into indexing.
+	testPerson.SetTootIndexable(nil)
+	ap.SetNoIndex(testPerson, false)
+
+	acc, err := suite.typeconverter.ASRepresentationToAccount(ctx, testPerson, "", "")
+	suite.NoError(err)
+	suite.True(*acc.Indexable)
+
+	// Noindex true should opt out
+	// even when indexable is true.
+	ap.SetIndexable(testPerson, true)
+	ap.SetNoIndex(testPerson, true)
+
+	acc, err = suite.typeconverter.ASRepresentationToAccount(ctx, testPerson, "", "")
+	suite.NoError(err)
+	suite.False(*acc.Indexable)
+}
+
 func TestASToInternalTestSuite(t *testing.T) {
 	suite.Run(t, new(ASToInternalTestSuite))
 }
@@ -21,6 +21,7 @@
 	"fmt"
 	"net/url"
 	"slices"
+	"strings"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
@@ -44,11 +45,13 @@ func APIVisToVis(m apimodel.Visibility) gtsmodel.Visibility {
 	return 0
 }
 
+// APIContentTypeToContentType converts the given API content type to its
+// internal equivalent. Shorthand "plain" and "markdown" are also accepted.
 func APIContentTypeToContentType(m apimodel.StatusContentType) gtsmodel.StatusContentType {
 	switch m {
-	case apimodel.StatusContentTypePlain:
+	case apimodel.StatusContentTypePlain, "plain":
 		return gtsmodel.StatusContentTypePlain
-	case apimodel.StatusContentTypeMarkdown:
+	case apimodel.StatusContentTypeMarkdown, "markdown":
 		return gtsmodel.StatusContentTypeMarkdown
 	}
 	return 0
@@ -60,6 +63,16 @@ func APIMarkerNameToMarkerName(m apimodel.MarkerName) gtsmodel.MarkerName {
 		return gtsmodel.MarkerNameHome
 	case apimodel.MarkerNameNotifications:
 		return gtsmodel.MarkerNameNotifications
+	case apimodel.MarkerNamePublic:
+		return gtsmodel.MarkerNamePublic
+	case apimodel.MarkerNameLocal:
+		return gtsmodel.MarkerNameLocal
+	}
+	if listID, ok := strings.CutPrefix(string(m), apimodel.MarkerNameListPrefix); ok {
+		return gtsmodel.MarkerNameListPrefix + gtsmodel.MarkerName(listID)
+	}
+	if tagID, ok := strings.CutPrefix(string(m), apimodel.MarkerNameTagPrefix); ok {
+		return gtsmodel.MarkerNameTagPrefix + gtsmodel.MarkerName(tagID)
 	}
 	return ""
 }
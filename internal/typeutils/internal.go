@@ -87,6 +87,9 @@ func (c *Converter) StatusToBoost(
 		// Boosts are not considered sensitive even if their target is.
 		Sensitive: util.Ptr(false),
 
+		// Boosts carry no language of their own to detect.
+		LanguageDetected: util.Ptr(false),
+
 		// Remaining fields all
 		// taken from boosted status.
 		ActivityStreamsType: target.ActivityStreamsType,
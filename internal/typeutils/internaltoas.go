@@ -24,6 +24,7 @@
 	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -204,6 +205,13 @@ func (c *Converter) AccountToAS(
 	indexableProp.Set(*a.Indexable)
 	accountable.SetTootIndexable(indexableProp)
 
+	// noindex
+	// Opposite sense of indexable, for implementations
+	// which only understand this (eg., Misskey and forks).
+	if with, ok := accountable.(ap.WithUnknownProperties); ok {
+		ap.SetNoIndex(with, !*a.Indexable)
+	}
+
 	// devices
 	// NOT IMPLEMENTED, probably won't implement
 
@@ -274,6 +282,14 @@ func (c *Converter) AccountToAS(
 	// set the public key property on the Person
 	accountable.SetW3IDSecurityV1PublicKey(publicKeyProp)
 
+	// assertionMethod
+	// Used for verifying object integrity proofs.
+	if a.Ed25519PublicKey != nil {
+		if with, ok := accountable.(ap.WithUnknownProperties); ok {
+			ap.SetAssertionMethodKey(with, a.Ed25519PublicKeyURI, a.URI, a.Ed25519PublicKey)
+		}
+	}
+
 	// tags
 	tagProp := streams.NewActivityStreamsTagProperty()
 
@@ -487,6 +503,14 @@ func (c *Converter) AccountToASMinimal(
 	// set the public key property on the Person
 	accountable.SetW3IDSecurityV1PublicKey(publicKeyProp)
 
+	// assertionMethod
+	// Used for verifying object integrity proofs.
+	if a.Ed25519PublicKey != nil {
+		if with, ok := accountable.(ap.WithUnknownProperties); ok {
+			ap.SetAssertionMethodKey(with, a.Ed25519PublicKeyURI, a.URI, a.Ed25519PublicKey)
+		}
+	}
+
 	return accountable, nil
 }
 
@@ -920,6 +944,92 @@ func (c *Converter) StatusToASDelete(ctx context.Context, s *gtsmodel.Status) (v
 	return delete, nil
 }
 
+// StatusToASAdd converts a pinned gts model status into an
+// activity streams Add, targeting the featured collection of
+// the status author, suitable for federating a new pin.
+func (c *Converter) StatusToASAdd(ctx context.Context, s *gtsmodel.Status) (vocab.ActivityStreamsAdd, error) {
+	add := streams.NewActivityStreamsAdd()
+	if err := c.statusToASFeaturedActivity(ctx, s, add); err != nil {
+		return nil, gtserror.Newf("error creating Add: %w", err)
+	}
+	return add, nil
+}
+
+// StatusToASRemove converts an unpinned gts model status into
+// an activity streams Remove, targeting the featured collection
+// of the status author, suitable for federating an unpin.
+func (c *Converter) StatusToASRemove(ctx context.Context, s *gtsmodel.Status) (vocab.ActivityStreamsRemove, error) {
+	remove := streams.NewActivityStreamsRemove()
+	if err := c.statusToASFeaturedActivity(ctx, s, remove); err != nil {
+		return nil, gtserror.Newf("error creating Remove: %w", err)
+	}
+	return remove, nil
+}
+
+// statusToASFeaturedActivity sets actor, object, target, and
+// addressing on the given Add or Remove activity, such that it
+// describes adding / removing status s to / from the featured
+// collection of its author.
+func (c *Converter) statusToASFeaturedActivity(
+	ctx context.Context,
+	s *gtsmodel.Status,
+	activity interface {
+		ap.WithActor
+		ap.WithObject
+		ap.WithTarget
+		ap.WithTo
+		ap.WithCc
+	},
+) error {
+	if s.Account == nil {
+		var err error
+		s.Account, err = c.state.DB.GetAccountByID(ctx, s.AccountID)
+		if err != nil {
+			return gtserror.Newf("db error getting author account: %w", err)
+		}
+	}
+
+	actorIRI, err := url.Parse(s.Account.URI)
+	if err != nil {
+		return gtserror.Newf("error parsing actor uri %s: %w", s.Account.URI, err)
+	}
+
+	statusIRI, err := url.Parse(s.URI)
+	if err != nil {
+		return gtserror.Newf("error parsing status uri %s: %w", s.URI, err)
+	}
+
+	featuredIRI, err := url.Parse(s.Account.FeaturedCollectionURI)
+	if err != nil {
+		return gtserror.Newf("error parsing featured uri %s: %w", s.Account.FeaturedCollectionURI, err)
+	}
+
+	followersIRI, err := url.Parse(s.Account.FollowersURI)
+	if err != nil {
+		return gtserror.Newf("error parsing followers uri %s: %w", s.Account.FollowersURI, err)
+	}
+
+	// Set only the status IRI as object, there's
+	// no need to serialize the whole status here,
+	// receivers can dereference it if they need.
+	ap.AppendActorIRIs(activity, actorIRI)
+	ap.AppendObjectIRIs(activity, statusIRI)
+	ap.AppendTargetIRIs(activity, featuredIRI)
+
+	// Address public and unlisted pins to the
+	// public and followers, anything else
+	// (ie., followers-only) to followers only.
+	switch s.Visibility {
+	case gtsmodel.VisibilityPublic, gtsmodel.VisibilityUnlocked:
+		ap.AppendTo(activity, ap.PublicIRI())
+		ap.AppendCc(activity, followersIRI)
+	default:
+		ap.AppendTo(activity, followersIRI)
+	}
+
+	return nil
+}
+
 // FollowToASFollow converts a gts model Follow into an activity streams Follow, suitable for federation
 func (c *Converter) FollowToAS(ctx context.Context, f *gtsmodel.Follow) (vocab.ActivityStreamsFollow, error) {
 	if err := c.state.DB.PopulateFollow(ctx, f); err != nil {
@@ -1258,6 +1368,76 @@ func (c *Converter) FaveToAS(ctx context.Context, f *gtsmodel.StatusFave) (vocab
 	return like, nil
 }
 
+// StatusReactionToASEmojiReact converts a gts model status reaction
+// into a LitePub EmojiReact, suitable for federation.
+//
+// Result will look something like:
+//
+//	{
+//	  "@context": ["https://www.w3.org/ns/activitystreams", "http://litepub.social/ns#"],
+//	  "actor": "http://localhost:8080/users/the_mighty_zork",
+//	  "content": ":rainbow:",
+//	  "id": "http://localhost:8080/users/the_mighty_zork/reactions/01G74JJ1KS331G2JXHRMZCE0ER",
+//	  "object": "http://fossbros-anonymous.io/users/foss_satan/statuses/01FVW7JHQFSFK166WWKR8CBA6M",
+//	  "tag": [{"icon": {...}, "id": "http://localhost:8080/emoji/01F8MH9H8E4VG3KDYJR9EGPXCQ", "name": ":rainbow:", "type": "Emoji"}],
+//	  "to": "http://fossbros-anonymous.io/users/foss_satan",
+//	  "type": "EmojiReact"
+//	}
+func (c *Converter) StatusReactionToASEmojiReact(ctx context.Context, r *gtsmodel.StatusReaction) (vocab.LitePubEmojiReact, error) {
+	// Ensure the status reaction model is fully populated.
+	if err := c.state.DB.PopulateStatusReaction(ctx, r); err != nil {
+		return nil, gtserror.Newf("error populating status reaction: %w", err)
+	}
+
+	// Start building the EmojiReact.
+	react := streams.NewLitePubEmojiReact()
+
+	// `id` property.
+	if err := ap.SetJSONLDIdStr(react, r.URI); err != nil {
+		return nil, gtserror.Newf("error setting id: %w", err)
+	}
+
+	// `actor` property is the reacting account URI.
+	actorIRI, err := url.Parse(r.Account.URI)
+	if err != nil {
+		return nil, gtserror.Newf("error parsing actor uri: %w", err)
+	}
+	ap.AppendActorIRIs(react, actorIRI)
+
+	// `object` property is the target status URI.
+	targetStatusIRI, err := url.Parse(r.Status.URI)
+	if err != nil {
+		return nil, gtserror.Newf("error parsing status uri: %w", err)
+	}
+	ap.AppendObjectIRIs(react, targetStatusIRI)
+
+	// `to` is the owner of the target status.
+	toIRI, err := url.Parse(r.TargetAccount.URI)
+	if err != nil {
+		return nil, gtserror.Newf("error parsing account uri: %w", err)
+	}
+	ap.AppendTo(react, toIRI)
+
+	// `content` is the unicode emoji or :shortcode:.
+	contentProp := streams.NewActivityStreamsContentProperty()
+	contentProp.AppendXMLSchemaString(r.Content)
+	react.SetActivityStreamsContent(contentProp)
+
+	// `tag` contains the custom emoji, if any.
+	if r.Emoji != nil {
+		asEmoji, err := c.EmojiToAS(ctx, r.Emoji)
+		if err != nil {
+			return nil, gtserror.Newf("error converting emoji: %w", err)
+		}
+
+		tagProp := streams.NewActivityStreamsTagProperty()
+		tagProp.AppendTootEmoji(asEmoji)
+		react.SetActivityStreamsTag(tagProp)
+	}
+
+	return react, nil
+}
+
 // BoostToAS converts a *gtsmodel.Status boost wrapper into
 // an ActivityStreams Announce activity, suitable for federation.
 //
@@ -1729,9 +1909,11 @@ func (c *Converter) ReportToASFlag(ctx context.Context, r *gtsmodel.Report) (voc
 	return flag, nil
 }
 
-// PollVoteToASCreate converts a vote on a poll into a Create
-// activity, suitable for federation, with each choice in the
-// vote appended as a Note to the Create's Object field.
+// PollVoteToASCreates converts a vote on a poll into Create
+// activities, suitable for federation, with one Create (wrapping
+// one Note) for each distinct choice in the vote. IDs of both the
+// Create and the Note are derived from the chosen option index,
+// so each option voted for has a stable, distinct ID.
 //
 // TODO: as soon as other AP server implementations support
 // the use of multiple objects in a single create, update this
@@ -1778,16 +1960,22 @@ func (c *Converter) PollVoteToASCreates(
 		return nil, gtserror.Newf("invalid account uri: %w", err)
 	}
 
+	// Drop any duplicate choices, so
+	// each option is only voted once.
+	choices := slices.Clone(vote.Choices)
+	slices.Sort(choices)
+	choices = slices.Compact(choices)
+
 	// Parse each choice to a Note and add it to the list of Creates.
-	creates := make([]vocab.ActivityStreamsCreate, len(vote.Choices))
-	for i, choice := range vote.Choices {
+	creates := make([]vocab.ActivityStreamsCreate, len(choices))
+	for i, choice := range choices {
 
 		// Allocate Create activity and address 'To' poll author.
 		create := streams.NewActivityStreamsCreate()
 		ap.AppendTo(create, pollAuthorIRI)
 
-		// Create ID formatted as: {$voterIRI}/activity#vote{$index}/{$statusIRI}.
-		createID := author.URI + "/activity#vote" + strconv.Itoa(i) + "/" + poll.Status.URI
+		// Create ID formatted as: {$voterIRI}/activity#vote{$choice}/{$statusIRI}.
+		createID := author.URI + "/activity#vote" + strconv.Itoa(choice) + "/" + poll.Status.URI
 		ap.MustSet(ap.SetJSONLDIdStr, ap.WithJSONLDId(create), createID)
 
 		// Set Create actor appropriately.
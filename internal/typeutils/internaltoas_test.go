@@ -61,6 +61,7 @@ func (suite *InternalToASTestSuite) TestAccountToAS() {
       },
       "indexable": "toot:indexable",
       "manuallyApprovesFollowers": "as:manuallyApprovesFollowers",
+      "noindex": "https://misskey-hub.net/ns#noindex",
       "toot": "http://joinmastodon.org/ns#"
     }
   ],
@@ -87,6 +88,7 @@ func (suite *InternalToASTestSuite) TestAccountToAS() {
   "indexable": true,
   "manuallyApprovesFollowers": false,
   "name": "original zork (he/they)",
+  "noindex": false,
   "outbox": "http://localhost:8080/users/the_mighty_zork/outbox",
   "preferredUsername": "the_mighty_zork",
   "publicKey": {
@@ -133,6 +135,7 @@ func (suite *InternalToASTestSuite) TestAccountToASBot() {
       },
       "indexable": "toot:indexable",
       "manuallyApprovesFollowers": "as:manuallyApprovesFollowers",
+      "noindex": "https://misskey-hub.net/ns#noindex",
       "toot": "http://joinmastodon.org/ns#"
     }
   ],
@@ -159,6 +162,7 @@ func (suite *InternalToASTestSuite) TestAccountToASBot() {
   "indexable": true,
   "manuallyApprovesFollowers": false,
   "name": "original zork (he/they)",
+  "noindex": false,
   "outbox": "http://localhost:8080/users/the_mighty_zork/outbox",
   "preferredUsername": "the_mighty_zork",
   "publicKey": {
@@ -200,6 +204,7 @@ func (suite *InternalToASTestSuite) TestAccountToASWithFields() {
       },
       "indexable": "toot:indexable",
       "manuallyApprovesFollowers": "as:manuallyApprovesFollowers",
+      "noindex": "https://misskey-hub.net/ns#noindex",
       "schema": "http://schema.org#",
       "toot": "http://joinmastodon.org/ns#",
       "value": "schema:value"
@@ -228,6 +233,7 @@ func (suite *InternalToASTestSuite) TestAccountToASWithFields() {
   "indexable": false,
   "manuallyApprovesFollowers": true,
   "name": "happy little turtle :3",
+  "noindex": true,
   "outbox": "http://localhost:8080/users/1happyturtle/outbox",
   "preferredUsername": "1happyturtle",
   "publicKey": {
@@ -285,6 +291,7 @@ func (suite *InternalToASTestSuite) TestAccountToASAliasedAndMoved() {
         "@id": "as:movedTo",
         "@type": "@id"
       },
+      "noindex": "https://misskey-hub.net/ns#noindex",
       "toot": "http://joinmastodon.org/ns#"
     }
   ],
@@ -315,6 +322,7 @@ func (suite *InternalToASTestSuite) TestAccountToASAliasedAndMoved() {
   "manuallyApprovesFollowers": false,
   "movedTo": "http://localhost:8080/users/1happyturtle",
   "name": "original zork (he/they)",
+  "noindex": false,
   "outbox": "http://localhost:8080/users/the_mighty_zork/outbox",
   "preferredUsername": "the_mighty_zork",
   "publicKey": {
@@ -358,6 +366,7 @@ func (suite *InternalToASTestSuite) TestAccountToASWithOneField() {
       },
       "indexable": "toot:indexable",
       "manuallyApprovesFollowers": "as:manuallyApprovesFollowers",
+      "noindex": "https://misskey-hub.net/ns#noindex",
       "schema": "http://schema.org#",
       "toot": "http://joinmastodon.org/ns#",
       "value": "schema:value"
@@ -381,6 +390,7 @@ func (suite *InternalToASTestSuite) TestAccountToASWithOneField() {
   "indexable": false,
   "manuallyApprovesFollowers": true,
   "name": "happy little turtle :3",
+  "noindex": true,
   "outbox": "http://localhost:8080/users/1happyturtle/outbox",
   "preferredUsername": "1happyturtle",
   "publicKey": {
@@ -423,6 +433,7 @@ func (suite *InternalToASTestSuite) TestAccountToASWithEmoji() {
       },
       "indexable": "toot:indexable",
       "manuallyApprovesFollowers": "as:manuallyApprovesFollowers",
+      "noindex": "https://misskey-hub.net/ns#noindex",
       "toot": "http://joinmastodon.org/ns#"
     }
   ],
@@ -449,6 +460,7 @@ func (suite *InternalToASTestSuite) TestAccountToASWithEmoji() {
   "indexable": true,
   "manuallyApprovesFollowers": false,
   "name": "original zork (he/they)",
+  "noindex": false,
   "outbox": "http://localhost:8080/users/the_mighty_zork/outbox",
   "preferredUsername": "the_mighty_zork",
   "publicKey": {
@@ -502,6 +514,7 @@ func (suite *InternalToASTestSuite) TestAccountToASWithSharedInbox() {
       },
       "indexable": "toot:indexable",
       "manuallyApprovesFollowers": "as:manuallyApprovesFollowers",
+      "noindex": "https://misskey-hub.net/ns#noindex",
       "toot": "http://joinmastodon.org/ns#"
     }
   ],
@@ -531,6 +544,7 @@ func (suite *InternalToASTestSuite) TestAccountToASWithSharedInbox() {
   "indexable": true,
   "manuallyApprovesFollowers": false,
   "name": "original zork (he/they)",
+  "noindex": false,
   "outbox": "http://localhost:8080/users/the_mighty_zork/outbox",
   "preferredUsername": "the_mighty_zork",
   "publicKey": {
@@ -1191,6 +1205,49 @@ func (suite *InternalToASTestSuite) TestStatusToASDeletePublicReplyOriginalDelet
 }`, string(bytes))
 }
 
+func (suite *InternalToASTestSuite) TestStatusToASAddRemove() {
+	testStatus := suite.testStatuses["admin_account_status_1"]
+	ctx := suite.T().Context()
+
+	asAdd, err := suite.typeconverter.StatusToASAdd(ctx, testStatus)
+	suite.NoError(err)
+
+	ser, err := ap.Serialize(asAdd)
+	suite.NoError(err)
+
+	bytes, err := json.MarshalIndent(ser, "", "  ")
+	suite.NoError(err)
+
+	suite.Equal(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "actor": "http://localhost:8080/users/admin",
+  "cc": "http://localhost:8080/users/admin/followers",
+  "object": "http://localhost:8080/users/admin/statuses/01F8MH75CBF9JFX4ZAD54N0W0R",
+  "target": "http://localhost:8080/users/admin/collections/featured",
+  "to": "https://www.w3.org/ns/activitystreams#Public",
+  "type": "Add"
+}`, string(bytes))
+
+	asRemove, err := suite.typeconverter.StatusToASRemove(ctx, testStatus)
+	suite.NoError(err)
+
+	ser, err = ap.Serialize(asRemove)
+	suite.NoError(err)
+
+	bytes, err = json.MarshalIndent(ser, "", "  ")
+	suite.NoError(err)
+
+	suite.Equal(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "actor": "http://localhost:8080/users/admin",
+  "cc": "http://localhost:8080/users/admin/followers",
+  "object": "http://localhost:8080/users/admin/statuses/01F8MH75CBF9JFX4ZAD54N0W0R",
+  "target": "http://localhost:8080/users/admin/collections/featured",
+  "to": "https://www.w3.org/ns/activitystreams#Public",
+  "type": "Remove"
+}`, string(bytes))
+}
+
 func (suite *InternalToASTestSuite) TestStatusToASDeletePublic() {
 	testStatus := suite.testStatuses["admin_account_status_1"]
 	ctx := suite.T().Context()
@@ -1468,7 +1525,7 @@ func (suite *InternalToASTestSuite) TestPollVoteToASCreate() {
 	suite.Equal(`{
   "@context": "https://www.w3.org/ns/activitystreams",
   "actor": "http://localhost:8080/users/the_mighty_zork",
-  "id": "http://localhost:8080/users/the_mighty_zork/activity#vote0/http://fossbros-anonymous.io/users/foss_satan/statuses/01HEN2QRFA8H3C6QPN7RD4KSR6",
+  "id": "http://localhost:8080/users/the_mighty_zork/activity#vote1/http://fossbros-anonymous.io/users/foss_satan/statuses/01HEN2QRFA8H3C6QPN7RD4KSR6",
   "object": {
     "attributedTo": "http://localhost:8080/users/the_mighty_zork",
     "id": "http://localhost:8080/users/the_mighty_zork#01HEN2R65468ZG657C4ZPHJ4EX/votes/1",
@@ -1485,7 +1542,7 @@ func (suite *InternalToASTestSuite) TestPollVoteToASCreate() {
 	suite.Equal(`{
   "@context": "https://www.w3.org/ns/activitystreams",
   "actor": "http://localhost:8080/users/the_mighty_zork",
-  "id": "http://localhost:8080/users/the_mighty_zork/activity#vote1/http://fossbros-anonymous.io/users/foss_satan/statuses/01HEN2QRFA8H3C6QPN7RD4KSR6",
+  "id": "http://localhost:8080/users/the_mighty_zork/activity#vote2/http://fossbros-anonymous.io/users/foss_satan/statuses/01HEN2QRFA8H3C6QPN7RD4KSR6",
   "object": {
     "attributedTo": "http://localhost:8080/users/the_mighty_zork",
     "id": "http://localhost:8080/users/the_mighty_zork#01HEN2R65468ZG657C4ZPHJ4EX/votes/2",
@@ -31,6 +31,7 @@
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/federation/quirks"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/id"
@@ -162,6 +163,11 @@ func (c *Converter) AccountToAPIAccountSensitive(ctx context.Context, a *gtsmode
 		Fields:              c.fieldsToAPIFields(a.FieldsRaw),
 		FollowRequestsCount: *a.Stats.FollowRequestsCount,
 		AlsoKnownAsURIs:     a.AlsoKnownAsURIs,
+		DirectoryOptIn:      util.PtrOrZero(a.Settings.DirectoryOptIn),
+		ShowNoteIndicators:  util.PtrOrZero(a.Settings.ShowNoteIndicators),
+		AllowEmbeds:         util.PtrOrValue(a.Settings.AllowEmbeds, true),
+		AutoDeleteAfterDays: a.Settings.AutoDeleteAfterDays,
+		RSSFeedToken:        a.Settings.RSSFeedToken,
 	}
 
 	return apiAccount, nil
@@ -326,7 +332,7 @@ func (c *Converter) accountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 	// Bits that vary between remote + local accounts:
 	//   - Account (acct) string.
 	//   - Role.
-	//   - Settings things (enableRSS, theme, customCSS, hideCollections).
+	//   - Settings things (enableRSS, theme, customCSS, accentColor, hideCollections).
 
 	var (
 		acct            string
@@ -334,6 +340,7 @@ func (c *Converter) accountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 		enableRSS       bool
 		theme           string
 		customCSS       string
+		accentColor     string
 		hideCollections bool
 	)
 
@@ -362,6 +369,7 @@ func (c *Converter) accountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 			enableRSS = *a.Settings.EnableRSS
 			theme = a.Settings.Theme
 			customCSS = a.Settings.CustomCSS
+			accentColor = a.Settings.AccentColor
 			hideCollections = *a.Settings.HideCollections
 		}
 
@@ -407,6 +415,7 @@ func (c *Converter) accountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 		Suspended:         !a.SuspendedAt.IsZero(),
 		Theme:             theme,
 		CustomCSS:         customCSS,
+		AccentColor:       accentColor,
 		EnableRSS:         enableRSS,
 		HideCollections:   hideCollections,
 		Roles:             roles,
@@ -476,6 +485,7 @@ func (c *Converter) fieldsToAPIFields(f []*gtsmodel.Field) []apimodel.Field {
 
 	for i, field := range f {
 		mField := apimodel.Field{
+			ID:    field.ID,
 			Name:  field.Name,
 			Value: field.Value,
 		}
@@ -564,6 +574,7 @@ func (c *Converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		disabled               bool
 		role                   = *c.APIAccountDisplayRoleToAPIAccountRoleSensitive(nil)
 		createdByApplicationID string
+		invitedByAccountID     string
 	)
 
 	if err := c.state.DB.PopulateAccount(ctx, a); err != nil {
@@ -610,6 +621,19 @@ func (c *Converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		approved = *user.Approved
 		disabled = *user.Disabled
 		createdByApplicationID = user.CreatedByApplicationID
+
+		if user.InviteID != "" {
+			// User signed up via an invite,
+			// look up who created the invite.
+			invite, err := c.state.DB.GetInviteByID(ctx, user.InviteID)
+			if err != nil && !errors.Is(err, db.ErrNoEntries) {
+				return nil, fmt.Errorf("AccountToAdminAPIAccount: error getting invite %s from database: %w", user.InviteID, err)
+			}
+
+			if invite != nil {
+				invitedByAccountID = invite.CreatedByAccountID
+			}
+		}
 	}
 
 	apiAccount, err := c.AccountToAPIAccountPublic(ctx, a)
@@ -635,7 +659,7 @@ func (c *Converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		Suspended:              !a.SuspendedAt.IsZero(),
 		Account:                apiAccount,
 		CreatedByApplicationID: createdByApplicationID,
-		InvitedByAccountID:     "", // not implemented (yet)
+		InvitedByAccountID:     invitedByAccountID,
 	}, nil
 }
 
@@ -757,6 +781,24 @@ func AttachmentToAPIAttachment(media *gtsmodel.MediaAttachment) apimodel.Attachm
 		}
 	}
 
+	if media.Sprite.URL != "" && api.Meta != nil &&
+		media.Sprite.Frames > 0 && media.Sprite.Columns > 0 {
+		// If sprite URL is set, a preview frame
+		// sprite sheet was generated for video.
+		api.Meta.Sprite = &apimodel.MediaSprite{
+			URL:     media.Sprite.URL,
+			Frames:  media.Sprite.Frames,
+			Columns: media.Sprite.Columns,
+			Rows:    (media.Sprite.Frames + media.Sprite.Columns - 1) / media.Sprite.Columns,
+			Width:   media.Sprite.Width,
+			Height:  media.Sprite.Height,
+
+			// Frames are evenly spaced through video duration.
+			Interval: util.PtrOrZero(media.FileMeta.Original.Duration) /
+				float32(media.Sprite.Frames),
+		}
+	}
+
 	return api
 }
 
@@ -814,6 +856,8 @@ func (c *Converter) EmojiToAPIEmoji(ctx context.Context, emoji *gtsmodel.Emoji)
 		StaticURL:       emoji.ImageStaticURL,
 		VisibleInPicker: *emoji.VisibleInPicker,
 		Category:        category,
+		License:         emoji.License,
+		Attribution:     emoji.Attribution,
 	}, nil
 }
 
@@ -1215,42 +1259,40 @@ func (c *Converter) baseStatusToFrontend(
 		return nil, gtserror.Newf("error matching domain limit: %w", err)
 	}
 
-	// Build content warning for this post.
-	contentWarning := status.ContentWarning
-	if limit != nil && limit.ContentWarning != "" {
-		if contentWarning == "" {
-			// Set content warning
-			// to limit content warning.
-			contentWarning = limit.ContentWarning
-		} else {
-			// Prepend content warning
-			// with limit content warning.
-			contentWarning = limit.ContentWarning + "; " + contentWarning
-		}
-	}
+	// Build content warning for this post,
+	// prepending limit content warning if set.
+	contentWarning := limit.ApplyContentWarning(status.ContentWarning)
 
 	// Post is sensitive if there's a content
 	// warning, or it's explicitly marked as
 	// sensitive, or a domain limit says so.
 	sensitive := contentWarning != "" || *status.Sensitive || limit.MediaMarkSensitive()
 
-	repliesCount, err := c.state.DB.CountStatusReplies(ctx, status.ID)
-	if err != nil {
-		return nil, gtserror.Newf("error counting replies: %w", err)
+	if status.RepliesCount == nil ||
+		status.BoostsCount == nil ||
+		status.FavesCount == nil {
+		// Interaction counts not yet stored (or
+		// were reset), so count them from scratch.
+		if err := c.state.DB.RegenerateStatusCounts(ctx, status); err != nil {
+			return nil, gtserror.Newf("error counting interactions: %w", err)
+		}
 	}
 
-	reblogsCount, err := c.state.DB.CountStatusBoosts(ctx, status.ID)
-	if err != nil {
-		return nil, gtserror.Newf("error counting reblogs: %w", err)
-	}
-
-	favesCount, err := c.state.DB.CountStatusFaves(ctx, status.ID)
-	if err != nil {
-		return nil, gtserror.Newf("error counting faves: %w", err)
-	}
+	repliesCount := *status.RepliesCount
+	reblogsCount := *status.BoostsCount
+	favesCount := *status.FavesCount
 
 	apiAttachments := c.attachmentsToAPI(ctx, status.Attachments, status.AttachmentIDs)
 
+	// If media from this domain is rejected, then
+	// any local copies of attachments won't be served
+	// anyway, so mark all attachments as rejected. This
+	// ensures they're replaced with direct links to the
+	// remote media when placeholding attachments.
+	if limit.MediaReject() {
+		rejectAttachments(apiAttachments)
+	}
+
 	apiEmojis := c.emojisToAPI(ctx, status.Emojis, status.EmojiIDs)
 
 	apiMentions := c.mentionsToAPI(ctx, status.Mentions, status.MentionIDs)
@@ -1307,9 +1349,20 @@ func (c *Converter) baseStatusToFrontend(
 		apiStatus.EditedAt = util.Ptr(timestamp)
 	}
 
+	if at := status.ExpiresAt; !at.IsZero() {
+		timestamp := util.FormatISO8601(at)
+		apiStatus.ExpiresAt = util.Ptr(timestamp)
+
+		// Remaining lifetime, clamped to zero, as an
+		// expired status may linger until it's deleted.
+		remaining := max(int64(time.Until(at)/time.Second), 0)
+		apiStatus.ExpiresIn = &remaining
+	}
+
 	apiStatus.InReplyToID = util.PtrIf(status.InReplyToID)
 	apiStatus.InReplyToAccountID = util.PtrIf(status.InReplyToAccountID)
 	apiStatus.Language = util.PtrIf(status.Language)
+	apiStatus.LanguageDetected = util.PtrOrZero(status.LanguageDetected)
 
 	switch {
 	case status.CreatedWithApplication != nil:
@@ -1359,8 +1412,12 @@ func (c *Converter) baseStatusToFrontend(
 		apiStatus.Muted = interacts.Muted
 		apiStatus.Reblogged = interacts.Reblogged
 		apiStatus.Pinned = interacts.Pinned
+		apiStatus.AccountNoted = interacts.AccountNoted
 	}
 
+	// Emoji reactions to this status.
+	apiStatus.EmojiReactions = c.statusReactionsToAPI(ctx, status.ID, requester)
+
 	// If web URL is empty for whatever
 	// reason, provide AP URI as fallback.
 	if apiStatus.URL == "" {
@@ -1568,6 +1625,55 @@ func InstanceRuleToAdminAPIRule(r *gtsmodel.Rule) *apimodel.AdminInstanceRule {
 	}
 }
 
+// LandingBlockToAPILandingBlock converts a landing page block into its api equivalent for serving at /api/v1/admin/instance/landing_blocks/:id
+func LandingBlockToAPILandingBlock(b *gtsmodel.LandingBlock) *apimodel.LandingBlock {
+	return &apimodel.LandingBlock{
+		ID:        b.ID,
+		Title:     b.Title,
+		Content:   b.Content,
+		Text:      b.Text,
+		Position:  b.Position,
+		CreatedAt: util.FormatISO8601(b.CreatedAt),
+		UpdatedAt: util.FormatISO8601(b.UpdatedAt),
+	}
+}
+
+// InstanceToAdminAPIInstance converts a remote instance into its api equivalent for serving at /api/v1/admin/instances
+func InstanceToAdminAPIInstance(i *gtsmodel.Instance) (*apimodel.AdminInstance, error) {
+	// Domain may be in Punycode,
+	// de-punify it just in case.
+	domain, err := util.DePunify(i.Domain)
+	if err != nil {
+		return nil, gtserror.Newf("error de-punifying %s: %w", i.Domain, err)
+	}
+
+	apiInstance := &apimodel.AdminInstance{
+		ID:        i.ID,
+		Domain:    domain,
+		Title:     i.Title,
+		Software:  i.Software,
+		Version:   i.Version,
+		Quirks:    quirks.For(i.Software).Names(),
+		CreatedAt: util.FormatISO8601(i.CreatedAt),
+	}
+
+	if !i.SuspendedAt.IsZero() {
+		apiInstance.SuspendedAt = util.FormatISO8601(i.SuspendedAt)
+	}
+
+	if !i.NodeInfoFetchedAt.IsZero() {
+		apiInstance.NodeInfoFetchedAt = util.FormatISO8601(i.NodeInfoFetchedAt)
+	}
+
+	if !i.UnreachableAt.IsZero() {
+		apiInstance.UnreachableAt = util.FormatISO8601(i.UnreachableAt)
+		apiInstance.NextProbeAt = util.FormatISO8601(i.NextProbeAt)
+		apiInstance.RequestFailures = i.RequestFailures
+	}
+
+	return apiInstance, nil
+}
+
 // InstanceToAPIV1Instance converts a gts instance into its api equivalent for serving at /api/v1/instance
 func (c *Converter) InstanceToAPIV1Instance(ctx context.Context, i *gtsmodel.Instance) (*apimodel.InstanceV1, error) {
 	domain := i.Domain
@@ -1589,8 +1695,8 @@ func (c *Converter) InstanceToAPIV1Instance(ctx context.Context, i *gtsmodel.Ins
 		Version:              config.GetSoftwareVersion(),
 		Languages:            config.GetInstanceLanguages().TagStrs(),
 		Registrations:        config.GetAccountsRegistrationOpen(),
-		ApprovalRequired:     true,                               // approval always required
-		InvitesEnabled:       false,                              // todo: not supported yet
+		ApprovalRequired:     true, // approval always required
+		InvitesEnabled:       config.GetAccountsAllowUserInvites(),
 		MaxTootChars:         uint(config.GetStatusesMaxChars()), // #nosec G115 -- Already validated.
 		Rules:                InstanceRulesToAPIRules(i.Rules),
 		Terms:                i.Terms,
@@ -1820,6 +1926,7 @@ func (c *Converter) InstanceToAPIV2Instance(ctx context.Context, i *gtsmodel.Ins
 	instance.Configuration.Accounts.MaxProfileFields = config.GetAccountsMaxProfileFields()
 	instance.Configuration.Emojis.EmojiSizeLimit = int(config.GetMediaEmojiLocalMaxSize()) // #nosec G115 -- Already validated.
 	instance.Configuration.OIDCEnabled = config.GetOIDCEnabled()
+	instance.Configuration.Translation.Enabled = c.state.Translator.Enabled()
 
 	vapidKeyPair, err := c.state.DB.GetVAPIDKeyPair(ctx)
 	if err != nil {
@@ -1926,8 +2033,9 @@ func (c *Converter) ConversationToAPIConversation(
 	requester *gtsmodel.Account,
 ) (*apimodel.Conversation, error) {
 	apiConversation := &apimodel.Conversation{
-		ID:     conversation.ID,
-		Unread: !*conversation.Read,
+		ID:         conversation.ID,
+		Unread:     !*conversation.Read,
+		LastReadID: conversation.LastReadStatusID,
 	}
 
 	// Populate most recent status in convo;
@@ -2110,7 +2218,7 @@ func (c *Converter) ReportToAPIReport(ctx context.Context, r *gtsmodel.Report) (
 		ID:          r.ID,
 		CreatedAt:   util.FormatISO8601(r.CreatedAt),
 		ActionTaken: !r.ActionTakenAt.IsZero(),
-		Category:    "other", // todo: only support default 'other' category right now
+		Category:    r.Category.String(),
 		Comment:     r.Comment,
 		Forwarded:   *r.Forwarded,
 		StatusIDs:   r.StatusIDs,
@@ -2143,6 +2251,30 @@ func (c *Converter) ReportToAPIReport(ctx context.Context, r *gtsmodel.Report) (
 	return report, nil
 }
 
+// ReportNoteToAdminAPIReportNote converts a gts model report note into an admin view report note.
+func (c *Converter) ReportNoteToAdminAPIReportNote(ctx context.Context, n *gtsmodel.ReportNote) (*apimodel.AdminReportNote, error) {
+	var err error
+
+	if n.Account == nil {
+		n.Account, err = c.state.DB.GetAccountByID(ctx, n.AccountID)
+		if err != nil {
+			return nil, gtserror.Newf("error getting account with id %s from the db: %w", n.AccountID, err)
+		}
+	}
+
+	account, err := c.AccountToAdminAPIAccount(ctx, n.Account)
+	if err != nil {
+		return nil, gtserror.Newf("error converting account with id %s to adminAPIAccount: %w", n.AccountID, err)
+	}
+
+	return &apimodel.AdminReportNote{
+		ID:        n.ID,
+		CreatedAt: util.FormatISO8601(n.CreatedAt),
+		Account:   account,
+		Content:   n.Content,
+	}, nil
+}
+
 // ReportToAdminAPIReport converts a gts model report into an admin view report, for serving at /api/v1/admin/reports
 func (c *Converter) ReportToAdminAPIReport(ctx context.Context, r *gtsmodel.Report, requestingAccount *gtsmodel.Account) (*apimodel.AdminReport, error) {
 	var (
@@ -2150,6 +2282,7 @@ func (c *Converter) ReportToAdminAPIReport(ctx context.Context, r *gtsmodel.Repo
 		actionTakenAt        *string
 		actionTakenComment   *string
 		actionTakenByAccount *apimodel.AdminAccountInfo
+		assignedAccount      *apimodel.AdminAccountInfo
 	)
 
 	if !r.ActionTakenAt.IsZero() {
@@ -2193,6 +2326,20 @@ func (c *Converter) ReportToAdminAPIReport(ctx context.Context, r *gtsmodel.Repo
 		}
 	}
 
+	if r.AssignedAccountID != "" {
+		if r.AssignedAccount == nil {
+			r.AssignedAccount, err = c.state.DB.GetAccountByID(ctx, r.AssignedAccountID)
+			if err != nil {
+				return nil, fmt.Errorf("ReportToAdminAPIReport: error getting assigned account with id %s from the db: %w", r.AssignedAccountID, err)
+			}
+		}
+
+		assignedAccount, err = c.AccountToAdminAPIAccount(ctx, r.AssignedAccount)
+		if err != nil {
+			return nil, fmt.Errorf("ReportToAdminAPIReport: error converting assigned account with id %s to adminAPIAccount: %w", r.AssignedAccountID, err)
+		}
+	}
+
 	statuses := make([]*apimodel.Status, 0, len(r.StatusIDs))
 	if len(r.StatusIDs) != 0 && len(r.Statuses) == 0 {
 		r.Statuses, err = c.state.DB.GetStatusesByIDs(ctx, r.StatusIDs)
@@ -2240,14 +2387,14 @@ func (c *Converter) ReportToAdminAPIReport(ctx context.Context, r *gtsmodel.Repo
 		ID:                   r.ID,
 		ActionTaken:          !r.ActionTakenAt.IsZero(),
 		ActionTakenAt:        actionTakenAt,
-		Category:             "other", // todo: only support default 'other' category right now
+		Category:             r.Category.String(),
 		Comment:              r.Comment,
 		Forwarded:            *r.Forwarded,
 		CreatedAt:            util.FormatISO8601(r.CreatedAt),
 		UpdatedAt:            util.FormatISO8601(r.UpdatedAt),
 		Account:              account,
 		TargetAccount:        targetAccount,
-		AssignedAccount:      actionTakenByAccount,
+		AssignedAccount:      assignedAccount,
 		ActionTakenByAccount: actionTakenByAccount,
 		ActionTakenComment:   actionTakenComment,
 		Statuses:             statuses,
@@ -2265,23 +2412,67 @@ func (c *Converter) ListToAPIList(ctx context.Context, l *gtsmodel.List) (*apimo
 	}, nil
 }
 
+// AntennaToAPIAntenna converts one gts model antenna into an api model antenna.
+func (c *Converter) AntennaToAPIAntenna(ctx context.Context, a *gtsmodel.Antenna) (*apimodel.Antenna, error) {
+	// Ensure unset rule sets are
+	// serialized as empty arrays.
+	rules := func(r []string) []string {
+		if r == nil {
+			return []string{}
+		}
+		return r
+	}
+
+	return &apimodel.Antenna{
+		ID:              a.ID,
+		Title:           a.Title,
+		Keywords:        rules(a.Keywords),
+		ExcludeKeywords: rules(a.ExcludeKeywords),
+		Domains:         rules(a.Domains),
+		AccountPatterns: rules(a.AccountPatterns),
+		WithReplies:     util.PtrOrZero(a.WithReplies),
+	}, nil
+}
+
+// BookmarkCollectionToAPIBookmarkCollection converts one gts model bookmark collection into an api model bookmark collection.
+func (c *Converter) BookmarkCollectionToAPIBookmarkCollection(ctx context.Context, bc *gtsmodel.BookmarkCollection) (*apimodel.BookmarkCollection, error) {
+	return &apimodel.BookmarkCollection{
+		ID:    bc.ID,
+		Title: bc.Title,
+	}, nil
+}
+
+// ListEntryToAPIListEntry converts one gts model list entry into an api model list entry.
+// The entry's Follow must be set, as it's used to determine the member account ID.
+func (c *Converter) ListEntryToAPIListEntry(ctx context.Context, e *gtsmodel.ListEntry) (*apimodel.ListEntry, error) {
+	if e.Follow == nil {
+		return nil, gtserror.New("list entry follow not set")
+	}
+
+	var repliesPolicy *string
+	if e.RepliesPolicy != "" {
+		repliesPolicy = util.Ptr(string(e.RepliesPolicy))
+	}
+
+	return &apimodel.ListEntry{
+		AccountID:     e.Follow.TargetAccountID,
+		ShowReblogs:   *e.ShowReblogs,
+		RepliesPolicy: repliesPolicy,
+	}, nil
+}
+
 // MarkersToAPIMarker converts several gts model markers into an api marker, for serving at /api/v1/markers
-func (c *Converter) MarkersToAPIMarker(ctx context.Context, markers []*gtsmodel.Marker) (*apimodel.Marker, error) {
-	apiMarker := &apimodel.Marker{}
+func (c *Converter) MarkersToAPIMarker(ctx context.Context, markers []*gtsmodel.Marker) (apimodel.Marker, error) {
+	apiMarker := make(apimodel.Marker, len(markers))
 	for _, marker := range markers {
-		apiTimelineMarker := &apimodel.TimelineMarker{
+		if marker.Name == "" {
+			return nil, fmt.Errorf("empty marker timeline name")
+		}
+		apiMarker[apimodel.MarkerName(marker.Name)] = &apimodel.TimelineMarker{
 			LastReadID: marker.LastReadID,
 			UpdatedAt:  util.FormatISO8601(marker.UpdatedAt),
 			Version:    marker.Version,
 		}
-		switch apimodel.MarkerName(marker.Name) {
-		case apimodel.MarkerNameHome:
-			apiMarker.Home = apiTimelineMarker
-		case apimodel.MarkerNameNotifications:
-			apiMarker.Notifications = apiTimelineMarker
-		default:
-			return nil, fmt.Errorf("unknown marker timeline name: %s", marker.Name)
-		}
 	}
 	return apiMarker, nil
 }
@@ -2518,6 +2709,7 @@ func (c *Converter) ThemesToAPIThemes(themes []*gtsmodel.Theme) []apimodel.Theme
 	apiThemes := make([]apimodel.Theme, len(themes))
 	for i, theme := range themes {
 		apiThemes[i] = apimodel.Theme{
+			ID:          theme.ID,
 			Title:       theme.Title,
 			Description: theme.Description,
 			FileName:    theme.FileName,
@@ -2893,6 +3085,31 @@ func (c *Converter) TokenToAPITokenInfo(ctx context.Context, token *gtsmodel.Tok
 	}, nil
 }
 
+// InviteToAPIInvite converts a gts model
+// invite into its api (frontend) representation.
+func (c *Converter) InviteToAPIInvite(invite *gtsmodel.Invite) *apimodel.Invite {
+	apiInvite := &apimodel.Invite{
+		ID:        invite.ID,
+		Code:      invite.Code,
+		URL:       config.GetProtocol() + "://" + config.GetHost() + "/signup?invite=" + invite.Code,
+		CreatedBy: invite.CreatedByAccountID,
+		CreatedAt: util.FormatISO8601(invite.CreatedAt),
+		Uses:      invite.Uses,
+		Usable:    invite.Usable(time.Now()),
+	}
+
+	if !invite.ExpiresAt.IsZero() {
+		expiresAt := util.FormatISO8601(invite.ExpiresAt)
+		apiInvite.ExpiresAt = &expiresAt
+	}
+
+	if invite.MaxUses > 0 {
+		apiInvite.MaxUses = util.Ptr(invite.MaxUses)
+	}
+
+	return apiInvite
+}
+
 func (c *Converter) ScheduledStatusToAPIScheduledStatus(ctx context.Context, status *gtsmodel.ScheduledStatus) (*apimodel.ScheduledStatus, error) {
 	scheduledAt := util.FormatISO8601(status.ScheduledAt)
 
@@ -2935,6 +3152,41 @@ func (c *Converter) ScheduledStatusToAPIScheduledStatus(ctx context.Context, sta
 	return apiScheduledStatus, nil
 }
 
+// DraftToAPIDraft converts a status draft into its API model representation.
+func (c *Converter) DraftToAPIDraft(ctx context.Context, draft *gtsmodel.Draft) (*apimodel.Draft, error) {
+	apiDraft := &apimodel.Draft{
+		ID:        draft.ID,
+		CreatedAt: util.FormatISO8601(draft.CreatedAt),
+		UpdatedAt: util.FormatISO8601(draft.UpdatedAt),
+		Params: &apimodel.DraftParams{
+			Text:        draft.Text,
+			MediaIDs:    draft.MediaIDs,
+			Sensitive:   util.PtrOrZero(draft.Sensitive),
+			SpoilerText: draft.SpoilerText,
+			InReplyToID: draft.InReplyToID,
+			Language:    draft.Language,
+			LocalOnly:   util.PtrOrZero(draft.LocalOnly),
+			ContentType: apimodel.StatusContentType(draft.ContentType),
+		},
+		MediaAttachments: c.attachmentsToAPI(ctx, draft.MediaAttachments, draft.MediaIDs),
+	}
+
+	if draft.Visibility != 0 {
+		apiDraft.Params.Visibility = VisToAPIVis(draft.Visibility)
+	}
+
+	if len(draft.Poll.Options) > 0 {
+		apiDraft.Params.Poll = &apimodel.ScheduledStatusParamsPoll{
+			Options:    draft.Poll.Options,
+			ExpiresIn:  draft.Poll.ExpiresIn,
+			Multiple:   util.PtrOrZero(draft.Poll.Multiple),
+			HideTotals: util.PtrOrZero(draft.Poll.HideTotals),
+		}
+	}
+
+	return apiDraft, nil
+}
+
 func (c *Converter) DomainLimitToAPIDomainLimit(
 	ctx context.Context,
 	domainLimit *gtsmodel.DomainLimit,
@@ -2954,9 +3206,87 @@ func (c *Converter) DomainLimitToAPIDomainLimit(
 		return nil, err
 	}
 
+	// Derive API policies.
+	mediaPolicy, followsPolicy, statusesPolicy, accountsPolicy, err := domainLimitPoliciesToAPI(
+		domainLimit.MediaPolicy,
+		domainLimit.FollowsPolicy,
+		domainLimit.StatusesPolicy,
+		domainLimit.AccountsPolicy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apimodel.DomainLimit{
+		ID:             domainLimit.ID,
+		Domain:         domain,
+		MediaPolicy:    mediaPolicy,
+		FollowsPolicy:  followsPolicy,
+		StatusesPolicy: statusesPolicy,
+		AccountsPolicy: accountsPolicy,
+		ContentWarning: domainLimit.ContentWarning,
+		PublicComment:  util.PtrIf(domainLimit.PublicComment),
+		PrivateComment: util.PtrIf(domainLimit.PrivateComment),
+		CreatedBy:      domainLimit.CreatedByAccountID,
+		CreatedAt:      util.FormatISO8601(createdAt),
+	}, nil
+}
+
+// DomainLimitSuggestionToAPIDomainLimitSuggestion converts
+// the given domain limit suggestion to its API representation.
+func (c *Converter) DomainLimitSuggestionToAPIDomainLimitSuggestion(
+	ctx context.Context,
+	suggestion *gtsmodel.DomainLimitSuggestion,
+) (*apimodel.DomainLimitSuggestion, error) {
+
+	// Domain may be in Punycode,
+	// de-punify it just in case.
+	domain, err := util.DePunify(suggestion.Domain)
+	if err != nil {
+		return nil, gtserror.Newf("error de-punifying %s: %w", suggestion.Domain, err)
+	}
+
+	// Derive API policies.
+	mediaPolicy, followsPolicy, statusesPolicy, accountsPolicy, err := domainLimitPoliciesToAPI(
+		suggestion.MediaPolicy,
+		suggestion.FollowsPolicy,
+		suggestion.StatusesPolicy,
+		suggestion.AccountsPolicy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apimodel.DomainLimitSuggestion{
+		ID:             suggestion.ID,
+		Domain:         domain,
+		SubscriptionID: suggestion.SubscriptionID,
+		MediaPolicy:    mediaPolicy,
+		FollowsPolicy:  followsPolicy,
+		StatusesPolicy: statusesPolicy,
+		AccountsPolicy: accountsPolicy,
+		PublicComment:  util.PtrIf(suggestion.PublicComment),
+		CreatedAt:      util.FormatISO8601(suggestion.CreatedAt),
+		UpdatedAt:      util.FormatISO8601(suggestion.UpdatedAt),
+	}, nil
+}
+
+// domainLimitPoliciesToAPI converts the given
+// domain limit policies to their API equivalents.
+func domainLimitPoliciesToAPI(
+	mp gtsmodel.MediaPolicy,
+	fp gtsmodel.FollowsPolicy,
+	sp gtsmodel.StatusesPolicy,
+	ap gtsmodel.AccountsPolicy,
+) (
+	mediaPolicy apimodel.MediaPolicy,
+	followsPolicy apimodel.FollowsPolicy,
+	statusesPolicy apimodel.StatusesPolicy,
+	accountsPolicy apimodel.AccountsPolicy,
+	err error,
+) {
 	// Derive media policy.
-	var mediaPolicy apimodel.MediaPolicy
-	switch p := domainLimit.MediaPolicy; p {
+	switch mp {
 	case gtsmodel.MediaPolicyNoAction:
 		mediaPolicy = apimodel.MediaPolicyNoAction
 	case gtsmodel.MediaPolicyMarkSensitive:
@@ -2964,13 +3294,12 @@ func (c *Converter) DomainLimitToAPIDomainLimit(
 	case gtsmodel.MediaPolicyReject:
 		mediaPolicy = apimodel.MediaPolicyReject
 	default:
-		err := gtserror.Newf("unknown media policy %d", p)
-		return nil, err
+		err = gtserror.Newf("unknown media policy %d", mp)
+		return
 	}
 
 	// Derive follows policy.
-	var followsPolicy apimodel.FollowsPolicy
-	switch p := domainLimit.FollowsPolicy; p {
+	switch fp {
 	case gtsmodel.FollowsPolicyNoAction:
 		followsPolicy = apimodel.FollowsPolicyNoAction
 	case gtsmodel.FollowsPolicyManualApproval:
@@ -2980,49 +3309,35 @@ func (c *Converter) DomainLimitToAPIDomainLimit(
 	case gtsmodel.FollowsPolicyRejectAll:
 		followsPolicy = apimodel.FollowsPolicyRejectAll
 	default:
-		err := gtserror.Newf("unknown follows policy %d", p)
-		return nil, err
+		err = gtserror.Newf("unknown follows policy %d", fp)
+		return
 	}
 
 	// Derive statuses policy.
-	var statusesPolicy apimodel.StatusesPolicy
-	switch p := domainLimit.StatusesPolicy; p {
+	switch sp {
 	case gtsmodel.StatusesPolicyNoAction:
 		statusesPolicy = apimodel.StatusesPolicyNoAction
 	case gtsmodel.StatusesPolicyFilterWarn:
 		statusesPolicy = apimodel.StatusesPolicyFilterWarn
 	case gtsmodel.StatusesPolicyFilterHide:
-		statusesPolicy = apimodel.StatusesPolicyFilterWarn
+		statusesPolicy = apimodel.StatusesPolicyFilterHide
 	default:
-		err := gtserror.Newf("unknown accounts policy %d", p)
-		return nil, err
+		err = gtserror.Newf("unknown statuses policy %d", sp)
+		return
 	}
 
 	// Derive accounts policy.
-	var accountsPolicy apimodel.AccountsPolicy
-	switch p := domainLimit.AccountsPolicy; p {
+	switch ap {
 	case gtsmodel.AccountsPolicyNoAction:
 		accountsPolicy = apimodel.AccountsPolicyNoAction
 	case gtsmodel.AccountsPolicyMute:
 		accountsPolicy = apimodel.AccountsPolicyMute
 	default:
-		err := gtserror.Newf("unknown accounts policy %d", p)
-		return nil, err
+		err = gtserror.Newf("unknown accounts policy %d", ap)
+		return
 	}
 
-	return &apimodel.DomainLimit{
-		ID:             domainLimit.ID,
-		Domain:         domain,
-		MediaPolicy:    mediaPolicy,
-		FollowsPolicy:  followsPolicy,
-		StatusesPolicy: statusesPolicy,
-		AccountsPolicy: accountsPolicy,
-		ContentWarning: domainLimit.ContentWarning,
-		PublicComment:  util.PtrIf(domainLimit.PublicComment),
-		PrivateComment: util.PtrIf(domainLimit.PrivateComment),
-		CreatedBy:      domainLimit.CreatedByAccountID,
-		CreatedAt:      util.FormatISO8601(createdAt),
-	}, nil
+	return
 }
 
 func DomainLimitToAPIFilterV1(domainLimit *gtsmodel.DomainLimit) *apimodel.FilterV1 {
@@ -3137,6 +3452,66 @@ func (c *Converter) emojisToAPI(
 	return apiModels
 }
 
+// statusReactionsToAPI fetches the emoji reactions to the given status,
+// and converts them to frontend API models grouped by emoji, in order
+// of first use. all errors are caught and logged, with the calling
+// function name as a prefix.
+func (c *Converter) statusReactionsToAPI(
+	ctx context.Context,
+	statusID string,
+	requester *gtsmodel.Account,
+) []apimodel.StatusReaction {
+	reactions, err := c.state.DB.GetStatusReactions(ctx, statusID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		log.Error(ctx, gtserror.NewfAt(3, "error getting reactions: %w", err))
+		return []apimodel.StatusReaction{}
+	}
+
+	apiModels := make([]apimodel.StatusReaction, 0, len(reactions))
+	indices := make(map[string]int, len(reactions))
+	for _, reaction := range reactions {
+		name := StatusReactionName(reaction)
+
+		i, ok := indices[name]
+		if !ok {
+			// First reaction with
+			// this emoji, add a group.
+			apiModel := apimodel.StatusReaction{Name: name}
+			if reaction.Emoji != nil {
+				apiModel.URL = reaction.Emoji.ImageURL
+				apiModel.StaticURL = reaction.Emoji.ImageStaticURL
+			}
+
+			i = len(apiModels)
+			indices[name] = i
+			apiModels = append(apiModels, apiModel)
+		}
+
+		apiModels[i].Count++
+		if requester != nil && reaction.AccountID == requester.ID {
+			apiModels[i].Me = true
+		}
+	}
+
+	return apiModels
+}
+
+// StatusReactionName returns the name used to identify
+// the emoji of the given reaction through the client API,
+// ie., the unicode emoji itself, or the shortcode of a
+// custom emoji followed by @domain if the emoji is remote.
+func StatusReactionName(reaction *gtsmodel.StatusReaction) string {
+	if reaction.Emoji == nil {
+		return reaction.Content
+	}
+
+	if reaction.Emoji.IsLocal() {
+		return reaction.Emoji.Shortcode
+	}
+
+	return reaction.Emoji.Shortcode + "@" + reaction.Emoji.Domain
+}
+
 // mentionsToAPI converts database model mentions (fetching using IDs if
 // necessary) to frontend API mention models. all errors are caught and
 // logged, with the calling function name as a prefix.
@@ -139,7 +139,11 @@ func (suite *InternalToFrontendTestSuite) TestAccountToFrontendAliasedAndMoved()
     "follow_requests_count": 0,
     "also_known_as_uris": [
       "http://localhost:8080/users/1happyturtle"
-    ]
+    ],
+    "directory_opt_in": false,
+    "show_note_indicators": false,
+    "allow_embeds": true,
+    "auto_delete_after_days": 0
   },
   "enable_rss": true,
   "role": {
@@ -174,11 +178,13 @@ func (suite *InternalToFrontendTestSuite) TestAccountToFrontendAliasedAndMoved()
     "emojis": [],
     "fields": [
       {
+        "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QA",
         "name": "should you follow me?",
         "value": "maybe!",
         "verified_at": null
       },
       {
+        "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QB",
         "name": "age",
         "value": "120",
         "verified_at": null
@@ -341,7 +347,11 @@ func (suite *InternalToFrontendTestSuite) TestAccountToFrontendSensitive() {
     "status_content_type": "text/plain",
     "note": "hey yo this is my profile!",
     "fields": [],
-    "follow_requests_count": 0
+    "follow_requests_count": 0,
+    "directory_opt_in": false,
+    "show_note_indicators": false,
+    "allow_embeds": true,
+    "auto_delete_after_days": 0
   },
   "enable_rss": true,
   "role": {
@@ -595,6 +605,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontend() {
       "category": "reactions"
     }
   ],
+  "emoji_reactions": [],
   "card": null,
   "poll": null,
   "text": "hello world! #welcome ! first post on the instance :rainbow: !",
@@ -745,6 +756,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontendHTMLContentWarning
       "category": "reactions"
     }
   ],
+  "emoji_reactions": [],
   "card": null,
   "poll": null,
   "text": "hello world! #welcome ! first post on the instance :rainbow: !",
@@ -897,6 +909,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontendApplicationDeleted
       "category": "reactions"
     }
   ],
+  "emoji_reactions": [],
   "card": null,
   "poll": null,
   "text": "hello world! #welcome ! first post on the instance :rainbow: !",
@@ -1026,6 +1039,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontendUnknownAttachments
   ],
   "tags": [],
   "emojis": [],
+  "emoji_reactions": [],
   "card": null,
   "poll": null,
   "interaction_policy": {
@@ -1105,6 +1119,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToWebStatus() {
   ],
   "tags": [],
   "emojis": [],
+  "emoji_reactions": [],
   "card": null,
   "poll": null,
   "interaction_policy": {
@@ -1350,6 +1365,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontendUnknownLanguage()
       "category": "reactions"
     }
   ],
+  "emoji_reactions": [],
   "card": null,
   "poll": null,
   "text": "hello world! #welcome ! first post on the instance :rainbow: !",
@@ -1451,6 +1467,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontendPartialInteraction
   "mentions": [],
   "tags": [],
   "emojis": [],
+  "emoji_reactions": [],
   "card": null,
   "poll": null,
   "text": "this is a very personal post that I don't want anyone to interact with at all, and i only want mutuals to see it",
@@ -1492,6 +1509,75 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontendMarkSensitive() {
 	suite.True(apiStatus.Sensitive)
 }
 
+func (suite *InternalToFrontendTestSuite) TestStatusToFrontendRejectMedia() {
+	var (
+		ctx               = suite.T().Context()
+		testStatus        = suite.testStatuses["remote_account_1_status_1"]
+		requestingAccount = suite.testAccounts["admin_account"]
+		domainLimit       = suite.testDomainLimits["fossbros-anonymous.io"]
+	)
+
+	// Update the domain limit on fossbros-anonymous.io to reject media.
+	domainLimit.MediaPolicy = gtsmodel.MediaPolicyReject
+	if err := suite.db.UpdateDomainLimit(ctx, domainLimit, "media_policy"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// The status attachment should now be replaced
+	// by a direct link to the remote media file.
+	apiStatus, err := suite.typeconverter.StatusToAPIStatus(ctx, testStatus, requestingAccount)
+	suite.NoError(err)
+	suite.Empty(apiStatus.MediaAttachments)
+	suite.Contains(apiStatus.Content, `gts-placeholder-attachments`)
+	suite.Contains(apiStatus.Content, `http://fossbros-anonymous.io/attachments/original/13bbc3f8-2b5e-46ea-9531-40b4974d9912.jpg`)
+	suite.Contains(apiStatus.Content, `(error: domain media policy)`)
+}
+
+func (suite *InternalToFrontendTestSuite) TestStatusToFrontendAccountNoted() {
+	var (
+		ctx               = suite.T().Context()
+		testStatus        = suite.testStatuses["admin_account_status_1"]
+		requestingAccount = suite.testAccounts["local_account_1"]
+	)
+
+	// Set a private note on the status author.
+	if err := suite.db.PutNote(ctx, &gtsmodel.AccountNote{
+		ID:              "01JBR5SJ3V4YVZ7M0TQ4FJ8F9Q",
+		AccountID:       requestingAccount.ID,
+		TargetAccountID: testStatus.AccountID,
+		Comment:         "met at the turnip convention",
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Indicators not enabled yet,
+	// so the status shouldn't say.
+	apiStatus, err := suite.typeconverter.StatusToAPIStatus(ctx, testStatus, requestingAccount)
+	suite.NoError(err)
+	suite.False(apiStatus.AccountNoted)
+
+	// Enable note indicators for the requester.
+	settings, err := suite.db.GetAccountSettings(ctx, requestingAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	settings.ShowNoteIndicators = util.Ptr(true)
+	if err := suite.db.UpdateAccountSettings(ctx, settings, "show_note_indicators"); err != nil {
+		suite.FailNow(err.Error())
+	}
+	requestingAccount.Settings = settings
+
+	// Status should now be marked as noted.
+	apiStatus, err = suite.typeconverter.StatusToAPIStatus(ctx, testStatus, requestingAccount)
+	suite.NoError(err)
+	suite.True(apiStatus.AccountNoted)
+
+	// Status by a non-noted account should not be.
+	apiStatus, err = suite.typeconverter.StatusToAPIStatus(ctx, suite.testStatuses["local_account_2_status_1"], requestingAccount)
+	suite.NoError(err)
+	suite.False(apiStatus.AccountNoted)
+}
+
 func (suite *InternalToFrontendTestSuite) TestStatusToAPIStatusPendingApproval() {
 	var (
 		testStatus        = suite.testStatuses["admin_account_status_5"]
@@ -1588,6 +1674,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToAPIStatusPendingApproval()
   ],
   "tags": [],
   "emojis": [],
+  "emoji_reactions": [],
   "card": null,
   "poll": null,
   "text": "Hi @1happyturtle, can I reply?",
@@ -1693,7 +1780,7 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV1ToFrontend() {
   ],
   "registrations": true,
   "approval_required": true,
-  "invites_enabled": false,
+  "invites_enabled": true,
   "configuration": {
     "statuses": {
       "max_characters": 5000,
@@ -2117,11 +2204,13 @@ func (suite *InternalToFrontendTestSuite) TestReportToFrontend2() {
     "emojis": [],
     "fields": [
       {
+        "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QA",
         "name": "should you follow me?",
         "value": "maybe!",
         "verified_at": null
       },
       {
+        "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QB",
         "name": "age",
         "value": "120",
         "verified_at": null
@@ -2246,11 +2335,13 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
       "emojis": [],
       "fields": [
         {
+          "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QA",
           "name": "should you follow me?",
           "value": "maybe!",
           "verified_at": null
         },
         {
+          "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QB",
           "name": "age",
           "value": "120",
           "verified_at": null
@@ -2261,64 +2352,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
     },
     "created_by_application_id": "01F8MGY43H3N2C8EWPR2FPYEXG"
   },
-  "assigned_account": {
-    "id": "01F8MH17FWEB39HZJ76B6VXSKF",
-    "username": "admin",
-    "domain": null,
-    "created_at": "2022-05-17T13:10:59.000Z",
-    "email": "admin@example.org",
-    "ip": null,
-    "ips": [],
-    "locale": "en",
-    "invite_request": null,
-    "role": {
-      "id": "admin",
-      "name": "admin",
-      "color": "",
-      "permissions": "546033",
-      "highlighted": true
-    },
-    "confirmed": true,
-    "approved": true,
-    "disabled": false,
-    "silenced": false,
-    "suspended": false,
-    "account": {
-      "id": "01F8MH17FWEB39HZJ76B6VXSKF",
-      "username": "admin",
-      "acct": "admin",
-      "display_name": "",
-      "locked": false,
-      "discoverable": true,
-      "indexable": true,
-      "noindex": false,
-      "bot": false,
-      "created_at": "2022-05-17T13:10:59.000Z",
-      "note": "",
-      "url": "http://localhost:8080/@admin",
-      "avatar": "",
-      "avatar_static": "",
-      "header": "http://localhost:8080/assets/default_header.webp",
-      "header_static": "http://localhost:8080/assets/default_header.webp",
-      "header_description": "Flat gray background (default header).",
-      "followers_count": 1,
-      "following_count": 1,
-      "statuses_count": 4,
-      "last_status_at": "2021-10-20",
-      "emojis": [],
-      "fields": [],
-      "enable_rss": true,
-      "roles": [
-        {
-          "id": "admin",
-          "name": "admin",
-          "color": ""
-        }
-      ],
-      "group": false
-    },
-    "created_by_application_id": "01F8MGXQRHYF5QPMTMXP78QC2F"
-  },
+  "assigned_account": null,
   "action_taken_by_account": {
     "id": "01F8MH17FWEB39HZJ76B6VXSKF",
     "username": "admin",
@@ -2333,7 +2367,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
       "id": "admin",
       "name": "admin",
       "color": "",
-      "permissions": "546033",
+      "permissions": "613617",
       "highlighted": true
     },
     "confirmed": true,
@@ -2447,11 +2481,13 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend2() {
       "emojis": [],
       "fields": [
         {
+          "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QA",
           "name": "should you follow me?",
           "value": "maybe!",
           "verified_at": null
         },
         {
+          "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QB",
           "name": "age",
           "value": "120",
           "verified_at": null
@@ -2596,6 +2632,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend2() {
       "mentions": [],
       "tags": [],
       "emojis": [],
+      "emoji_reactions": [],
       "card": null,
       "poll": null,
       "interaction_policy": {
@@ -2776,64 +2813,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontendSuspendedLoca
       "group": false
     }
   },
-  "assigned_account": {
-    "id": "01F8MH17FWEB39HZJ76B6VXSKF",
-    "username": "admin",
-    "domain": null,
-    "created_at": "2022-05-17T13:10:59.000Z",
-    "email": "admin@example.org",
-    "ip": null,
-    "ips": [],
-    "locale": "en",
-    "invite_request": null,
-    "role": {
-      "id": "admin",
-      "name": "admin",
-      "color": "",
-      "permissions": "546033",
-      "highlighted": true
-    },
-    "confirmed": true,
-    "approved": true,
-    "disabled": false,
-    "silenced": false,
-    "suspended": false,
-    "account": {
-      "id": "01F8MH17FWEB39HZJ76B6VXSKF",
-      "username": "admin",
-      "acct": "admin",
-      "display_name": "",
-      "locked": false,
-      "discoverable": true,
-      "indexable": true,
-      "noindex": false,
-      "bot": false,
-      "created_at": "2022-05-17T13:10:59.000Z",
-      "note": "",
-      "url": "http://localhost:8080/@admin",
-      "avatar": "",
-      "avatar_static": "",
-      "header": "http://localhost:8080/assets/default_header.webp",
-      "header_static": "http://localhost:8080/assets/default_header.webp",
-      "header_description": "Flat gray background (default header).",
-      "followers_count": 1,
-      "following_count": 1,
-      "statuses_count": 4,
-      "last_status_at": "2021-10-20",
-      "emojis": [],
-      "fields": [],
-      "enable_rss": true,
-      "roles": [
-        {
-          "id": "admin",
-          "name": "admin",
-          "color": ""
-        }
-      ],
-      "group": false
-    },
-    "created_by_application_id": "01F8MGXQRHYF5QPMTMXP78QC2F"
-  },
+  "assigned_account": null,
   "action_taken_by_account": {
     "id": "01F8MH17FWEB39HZJ76B6VXSKF",
     "username": "admin",
@@ -2848,7 +2828,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontendSuspendedLoca
       "id": "admin",
       "name": "admin",
       "color": "",
-      "permissions": "546033",
+      "permissions": "613617",
       "highlighted": true
     },
     "confirmed": true,
@@ -3091,11 +3071,13 @@ func (suite *InternalToFrontendTestSuite) TestIntReqToAPI() {
       "emojis": [],
       "fields": [
         {
+          "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QA",
           "name": "should you follow me?",
           "value": "maybe!",
           "verified_at": null
         },
         {
+          "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QB",
           "name": "age",
           "value": "120",
           "verified_at": null
@@ -3108,6 +3090,7 @@ func (suite *InternalToFrontendTestSuite) TestIntReqToAPI() {
     "mentions": [],
     "tags": [],
     "emojis": [],
+    "emoji_reactions": [],
     "card": null,
     "poll": null,
     "text": "🐢 i don't mind people sharing and liking this one but I want to moderate replies to it 🐢",
@@ -3209,6 +3192,7 @@ func (suite *InternalToFrontendTestSuite) TestIntReqToAPI() {
     ],
     "tags": [],
     "emojis": [],
+    "emoji_reactions": [],
     "card": null,
     "poll": null,
     "text": "Hi @1happyturtle, can I reply?",
@@ -3369,6 +3353,7 @@ func (suite *InternalToFrontendTestSuite) TestConversationToAPISelfConvo() {
     "mentions": [],
     "tags": [],
     "emojis": [],
+    "emoji_reactions": [],
     "card": null,
     "poll": null,
     "text": "hello everyone!",
@@ -3465,11 +3450,13 @@ func (suite *InternalToFrontendTestSuite) TestConversationToAPI() {
       "emojis": [],
       "fields": [
         {
+          "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QA",
           "name": "should you follow me?",
           "value": "maybe!",
           "verified_at": null
         },
         {
+          "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QB",
           "name": "age",
           "value": "120",
           "verified_at": null
@@ -3539,6 +3526,7 @@ func (suite *InternalToFrontendTestSuite) TestConversationToAPI() {
     "mentions": [],
     "tags": [],
     "emojis": [],
+    "emoji_reactions": [],
     "card": null,
     "poll": null,
     "text": "hello everyone!",
@@ -3619,11 +3607,13 @@ func (suite *InternalToFrontendTestSuite) TestStatusToAPIEdits() {
             "emojis": [],
             "fields": [
                 {
+                    "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QA",
                     "name": "should you follow me?",
                     "value": "maybe!",
                     "verified_at": null
                 },
                 {
+                    "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QB",
                     "name": "age",
                     "value": "120",
                     "verified_at": null
@@ -3666,11 +3656,13 @@ func (suite *InternalToFrontendTestSuite) TestStatusToAPIEdits() {
             "emojis": [],
             "fields": [
                 {
+                    "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QA",
                     "name": "should you follow me?",
                     "value": "maybe!",
                     "verified_at": null
                 },
                 {
+                    "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QB",
                     "name": "age",
                     "value": "120",
                     "verified_at": null
@@ -3727,11 +3719,13 @@ func (suite *InternalToFrontendTestSuite) TestStatusToAPIEdits() {
             "emojis": [],
             "fields": [
                 {
+                    "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QA",
                     "name": "should you follow me?",
                     "value": "maybe!",
                     "verified_at": null
                 },
                 {
+                    "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QB",
                     "name": "age",
                     "value": "120",
                     "verified_at": null
@@ -3774,11 +3768,13 @@ func (suite *InternalToFrontendTestSuite) TestStatusToAPIEdits() {
             "emojis": [],
             "fields": [
                 {
+                    "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QA",
                     "name": "should you follow me?",
                     "value": "maybe!",
                     "verified_at": null
                 },
                 {
+                    "id": "01JC0Z1F6Y4R6XW3C5V0K6D7QB",
                     "name": "age",
                     "value": "120",
                     "verified_at": null
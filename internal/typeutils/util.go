@@ -31,11 +31,13 @@
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/language"
 	"code.superseriousbusiness.org/gotosocial/internal/regexes"
 	"code.superseriousbusiness.org/gotosocial/internal/text"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
 )
 
 // toAPISize converts a set of media dimensions
@@ -64,6 +66,10 @@ type statusInteractions struct {
 	Bookmarked bool
 	Reblogged  bool
 	Pinned     bool
+
+	// Not an interaction as such, but
+	// requester-specific so kept here.
+	AccountNoted bool
 }
 
 func (c *Converter) interactionsWithStatusForAccount(ctx context.Context, s *gtsmodel.Status, requestingAccount *gtsmodel.Account) (si statusInteractions, err error) {
@@ -93,10 +99,51 @@ func (c *Converter) interactionsWithStatusForAccount(ctx context.Context, s *gts
 		if s.AccountID == requestingAccount.ID {
 			si.Pinned = !s.PinnedAt.IsZero()
 		}
+
+		si.AccountNoted, err = c.accountNotedBy(ctx, s.AccountID, requestingAccount)
+		if err != nil {
+			return si, gtserror.Newf("error checking if requesting account has noted status author: %s", err)
+		}
 	}
 	return si, nil
 }
 
+// accountNotedBy returns whether requester has a private note
+// set on the given target account, and has enabled indicating
+// so on statuses. This lookup is served from the note cache.
+func (c *Converter) accountNotedBy(ctx context.Context, targetAccountID string, requester *gtsmodel.Account) (bool, error) {
+	if requester.ID == targetAccountID ||
+		!requester.IsLocal() {
+		// No need to check.
+		return false, nil
+	}
+
+	settings := requester.Settings
+	if settings == nil {
+		var err error
+		settings, err = c.state.DB.GetAccountSettings(ctx, requester.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return false, err
+		}
+	}
+
+	if settings == nil || !util.PtrOrZero(settings.ShowNoteIndicators) {
+		// Indicators not enabled.
+		return false, nil
+	}
+
+	note, err := c.state.DB.GetNote(
+		gtscontext.SetBarebones(ctx),
+		requester.ID,
+		targetAccountID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, err
+	}
+
+	return note != nil && note.Comment != "", nil
+}
+
 func misskeyReportInlineURLs(content string) []*url.URL {
 	m := regexes.MisskeyReportNotes.FindAllStringSubmatch(content, -1)
 	urls := make([]*url.URL, 0, len(m))
@@ -109,6 +156,32 @@ func misskeyReportInlineURLs(content string) []*url.URL {
 	return urls
 }
 
+// rejectAttachments marks each of the given attachments as not
+// locally stored due to domain media policy, clearing any local
+// URLs and metadata, so that they will be placeholdered with a
+// direct link to the remote media instead of being shown inline.
+func rejectAttachments(arr []*apimodel.Attachment) {
+	errStr := gtsmodel.NewMediaErrorDetails(
+		gtsmodel.MediaErrorTypePolicy,
+		gtsmodel.MediaErrorTypePolicy_Domain,
+	).String()
+
+	for _, a := range arr {
+		if a.RemoteURL == nil {
+			// Nothing to
+			// link to.
+			continue
+		}
+
+		a.Type = gtsmodel.FileTypeUnknown.String()
+		a.URL = nil
+		a.TextURL = nil
+		a.PreviewURL = nil
+		a.Meta = nil
+		a.Error = &errStr
+	}
+}
+
 // placeholderAttachments separates any attachments with missing local URL
 // out of the given slice, and returns a piece of text containing links to
 // those attachments, as well as the slice of remaining "known" attachments.
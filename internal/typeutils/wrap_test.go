@@ -207,6 +207,7 @@ func (suite *WrapTestSuite) TestWrapAccountableInUpdate() {
     "indexable": true,
     "manuallyApprovesFollowers": false,
     "name": "original zork (he/they)",
+    "noindex": false,
     "outbox": "http://localhost:8080/users/the_mighty_zork/outbox",
     "preferredUsername": "the_mighty_zork",
     "publicKey": {
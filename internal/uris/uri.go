@@ -37,12 +37,15 @@
 	CollectionsPath      = "collections"       // CollectionsPath represents the activitypub collections location
 	FeaturedPath         = "featured"          // FeaturedPath represents the activitypub featured location
 	PublicKeyPath        = "main-key"          // PublicKeyPath is for serving an account's public key
+	Ed25519KeyFragment   = "ed25519-key"       // Ed25519KeyFragment is the fragment identifying an account's Ed25519 key on the account
 	FollowPath           = "follow"            // FollowPath used to generate the URI for an individual follow or follow request
 	UpdatePath           = "updates"           // UpdatePath is used to generate the URI for an account update
 	BlocksPath           = "blocks"            // BlocksPath is used to generate the URI for a block
+	ReactionsPath        = "reactions"         // ReactionsPath is used to generate the URI for an emoji reaction
 	MovesPath            = "moves"             // MovesPath is used to generate the URI for a move
 	ReportsPath          = "reports"           // ReportsPath is used to generate the URI for a report/flag
 	ConfirmEmailPath     = "confirm_email"     // ConfirmEmailPath is used to generate the URI for an email confirmation link
+	EmbedPath            = "embed"             // EmbedPath is used to generate the URL for an embeddable status view
 	FileserverPath       = "fileserver"        // FileserverPath is a path component for serving attachments + media
 	EmojiPath            = "emoji"             // EmojiPath represents the activitypub emoji location
 	TagsPath             = "tags"              // TagsPath represents the activitypub tags location
@@ -105,6 +108,10 @@ type UserURIs struct {
 	// The URI for this user's public key,
 	// eg., https://example.org/users/example_user/publickey
 	PublicKeyURI string
+
+	// The URI for this user's Ed25519 integrity proof key,
+	// eg., https://example.org/users/example_user#ed25519-key
+	Ed25519PublicKeyURI string
 }
 
 // GenerateURIForFollow returns the AP URI for a new follow -- something like:
@@ -135,6 +142,20 @@ func GenerateURIForLike(username string, thisFavedID string) string {
 	)
 }
 
+// GenerateURIForReaction returns the AP URI for a new emoji reaction -- something like:
+// https://example.org/users/whatever_user/reactions/01F7XTH1QGBAPMGF49WJZ91XGC
+func GenerateURIForReaction(username string, thisReactionID string) string {
+	proto := config.GetProtocol()
+	host := config.GetHost()
+	return buildURL4(proto,
+		host,
+		UsersPath,
+		username,
+		ReactionsPath,
+		thisReactionID,
+	)
+}
+
 // GenerateURIForUpdate returns the AP URI for a new update activity -- something like:
 // https://example.org/users/whatever_user#updates/01F7XTH1QGBAPMGF49WJZ91XGC
 func GenerateURIForUpdate(username string, thisUpdateID string) string {
@@ -200,6 +221,14 @@ func GenerateURIForEmailConfirm(token string) string {
 	return buildURL1(proto, host, ConfirmEmailPath) + "?token=" + token
 }
 
+// GenerateURLForEmbed returns the URL of the embeddable view of a status -- something like:
+// https://example.org/embed/01F7XTH1QGBAPMGF49WJZ91XGC
+func GenerateURLForEmbed(statusID string) string {
+	proto := config.GetProtocol()
+	host := config.GetHost()
+	return buildURL2(proto, host, EmbedPath, statusID)
+}
+
 // GenerateURIForAccept returns the AP URI for a new Accept activity -- something like:
 // https://example.org/users/whatever_user/accepts/01F7XTH1QGBAPMGF49WJZ91XGC
 func GenerateURIForAccept(username string, thisAcceptID string) string {
@@ -306,6 +335,7 @@ func GenerateURIsForAccount(username string) UserURIs {
 	likedURI := userURI + "/" + LikedPath
 	collectionURI := userURI + "/" + CollectionsPath + "/" + FeaturedPath
 	publicKeyURI := userURI + "/" + PublicKeyPath
+	ed25519PublicKeyURI := userURI + "#" + Ed25519KeyFragment
 
 	return UserURIs{
 		HostURL:     hostURL,
@@ -321,6 +351,7 @@ func GenerateURIsForAccount(username string) UserURIs {
 		LikedURI:              likedURI,
 		FeaturedCollectionURI: collectionURI,
 		PublicKeyURI:          publicKeyURI,
+		Ed25519PublicKeyURI:   ed25519PublicKeyURI,
 	}
 }
 
@@ -443,6 +474,11 @@ func IsLikePath(id *url.URL) bool {
 	return regexes.LikePath.MatchString(id.Path)
 }
 
+// IsReactionPath returns true if the given URL path corresponds to eg /users/example_username/reactions/SOME_ULID_OF_A_REACTION
+func IsReactionPath(id *url.URL) bool {
+	return regexes.ReactionPath.MatchString(id.Path)
+}
+
 // IsStatusesPath returns true if the given URL path corresponds to eg /users/example_username/statuses/SOME_ULID_OF_A_STATUS
 func IsStatusesPath(id *url.URL) bool {
 	return regexes.StatusesPath.MatchString(id.Path)
@@ -558,6 +594,18 @@ func ParseLikedPath(id *url.URL) (username string, ulid string, err error) {
 	return
 }
 
+// ParseReactionPath returns the username and ulid from a path such as /users/example_username/reactions/SOME_ULID_OF_A_REACTION
+func ParseReactionPath(id *url.URL) (username string, ulid string, err error) {
+	matches := regexes.ReactionPath.FindStringSubmatch(id.Path)
+	if len(matches) != 3 {
+		err = fmt.Errorf("expected 3 matches but matches length was %d", len(matches))
+		return
+	}
+	username = matches[1]
+	ulid = matches[2]
+	return
+}
+
 // ParseBlockPath returns the username and ulid from a path such as /users/example_username/blocks/SOME_ULID_OF_A_BLOCK
 func ParseBlockPath(id *url.URL) (username string, ulid string, err error) {
 	matches := regexes.BlockPath.FindStringSubmatch(id.Path)
@@ -21,6 +21,7 @@
 	"errors"
 	"fmt"
 	"net/mail"
+	"strings"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
@@ -31,20 +32,28 @@
 )
 
 const (
-	maximumPasswordLength         = 72 // 72 bytes is the maximum length afforded by bcrypt. See https://pkg.go.dev/golang.org/x/crypto/bcrypt#GenerateFromPassword.
-	minimumPasswordEntropy        = 60 // Heuristic for password strength. See https://github.com/wagslane/go-password-validator.
-	minimumReasonLength           = 40
-	maximumReasonLength           = 500
-	maximumSiteTitleLength        = 40
-	maximumShortDescriptionLength = 500
-	maximumDescriptionLength      = 5000
-	maximumSiteTermsLength        = 5000
-	maximumUsernameLength         = 64
-	maximumEmojiCategoryLength    = 64
-	maximumProfileFieldLength     = 255
-	maximumListTitleLength        = 200
-	maximumFilterKeywordLength    = 40
-	maximumFilterTitleLength      = 200
+	maximumPasswordLength                = 72 // 72 bytes is the maximum length afforded by bcrypt. See https://pkg.go.dev/golang.org/x/crypto/bcrypt#GenerateFromPassword.
+	minimumPasswordEntropy               = 60 // Heuristic for password strength. See https://github.com/wagslane/go-password-validator.
+	minimumReasonLength                  = 40
+	maximumReasonLength                  = 500
+	maximumSiteTitleLength               = 40
+	maximumShortDescriptionLength        = 500
+	maximumDescriptionLength             = 5000
+	maximumSiteTermsLength               = 5000
+	maximumUsernameLength                = 64
+	maximumEmojiCategoryLength           = 64
+	maximumEmojiLicenseLength            = 255
+	maximumEmojiAttributionLength        = 500
+	maximumProfileFieldLength            = 255
+	maximumListTitleLength               = 200
+	maximumFilterKeywordLength           = 40
+	maximumFilterTitleLength             = 200
+	maximumAntennaTitleLength            = 200
+	maximumAntennaRuleLength             = 100
+	maximumAntennaRules                  = 50
+	maximumBookmarkCollectionTitleLength = 200
+	maximumLandingBlockTitleLength       = 200
+	maximumLandingBlockTextLength        = 5000
 )
 
 // Password returns a helpful error if the given password
@@ -169,7 +178,8 @@ func StatusContentType(statusContentType string) error {
 		return fmt.Errorf("empty string for status format not allowed")
 	}
 	switch apimodel.StatusContentType(statusContentType) {
-	case apimodel.StatusContentTypePlain, apimodel.StatusContentTypeMarkdown:
+	case apimodel.StatusContentTypePlain, apimodel.StatusContentTypeMarkdown,
+		"plain", "markdown":
 		return nil
 	}
 	return fmt.Errorf("status content type '%s' was not recognized, valid options are 'text/plain', 'text/markdown'", statusContentType)
@@ -188,6 +198,21 @@ func CustomCSS(customCSS string) error {
 	return nil
 }
 
+// AccentColor validates the given profile accent
+// color, which must be a #rgb or #rrggbb hex color.
+// Empty string is allowed, as this unsets the color.
+func AccentColor(accentColor string) error {
+	if accentColor == "" {
+		return nil
+	}
+
+	if !regexes.HexColor.MatchString(accentColor) {
+		return fmt.Errorf("accent_color %s was not a valid hex color, eg., #ff00aa", accentColor)
+	}
+
+	return nil
+}
+
 func InstanceCustomCSS(customCSS string) error {
 
 	maximumCustomCSSLength := config.GetAccountsCustomCSSLength()
@@ -216,6 +241,22 @@ func EmojiCategory(category string) error {
 	return nil
 }
 
+// EmojiLicense validates the length of the given emoji license string.
+func EmojiLicense(license string) error {
+	if length := len([]rune(license)); length > maximumEmojiLicenseLength {
+		return fmt.Errorf("emoji license should be no more than %d chars but given license was %d", maximumEmojiLicenseLength, length)
+	}
+	return nil
+}
+
+// EmojiAttribution validates the length of the given emoji attribution string.
+func EmojiAttribution(attribution string) error {
+	if length := len([]rune(attribution)); length > maximumEmojiAttributionLength {
+		return fmt.Errorf("emoji attribution should be no more than %d chars but given attribution was %d", maximumEmojiAttributionLength, length)
+	}
+	return nil
+}
+
 // SiteTitle ensures that the given site title is within spec.
 func SiteTitle(siteTitle string) error {
 	if length := len([]rune(siteTitle)); length > maximumSiteTitleLength {
@@ -314,16 +355,102 @@ func ListRepliesPolicy(repliesPolicy gtsmodel.RepliesPolicy) error {
 	}
 }
 
+// AntennaTitle validates the title of a new or updated antenna.
+func AntennaTitle(title string) error {
+	if title == "" {
+		return fmt.Errorf("antenna title must be provided, and must be no more than %d chars", maximumAntennaTitleLength)
+	}
+
+	if length := len([]rune(title)); length > maximumAntennaTitleLength {
+		return fmt.Errorf("antenna title length must be no more than %d chars, provided title was %d chars", maximumAntennaTitleLength, length)
+	}
+
+	return nil
+}
+
+// BookmarkCollectionTitle validates the title of a new or updated bookmark collection.
+func BookmarkCollectionTitle(title string) error {
+	if title == "" {
+		return fmt.Errorf("bookmark collection title must be provided, and must be no more than %d chars", maximumBookmarkCollectionTitleLength)
+	}
+
+	if length := len([]rune(title)); length > maximumBookmarkCollectionTitleLength {
+		return fmt.Errorf("bookmark collection title length must be no more than %d chars, provided title was %d chars", maximumBookmarkCollectionTitleLength, length)
+	}
+
+	return nil
+}
+
+// LandingBlockTitle validates the (optional) title of a new or updated landing block.
+func LandingBlockTitle(title string) error {
+	if length := len([]rune(title)); length > maximumLandingBlockTitleLength {
+		return fmt.Errorf("landing block title length must be no more than %d chars, provided title was %d chars", maximumLandingBlockTitleLength, length)
+	}
+
+	return nil
+}
+
+// LandingBlockText validates the markdown text of a new or updated landing block.
+func LandingBlockText(text string) error {
+	if text == "" {
+		return fmt.Errorf("landing block text must be provided, and must be no more than %d chars", maximumLandingBlockTextLength)
+	}
+
+	if length := len([]rune(text)); length > maximumLandingBlockTextLength {
+		return fmt.Errorf("landing block text length must be no more than %d chars, provided text was %d chars", maximumLandingBlockTextLength, length)
+	}
+
+	return nil
+}
+
+// AntennaRules validates one set of rules (i.e. keywords,
+// domains, or account patterns) of a new or updated antenna.
+// Name is used to describe the set of rules in any error.
+func AntennaRules(rules []string, name string) error {
+	if count := len(rules); count > maximumAntennaRules {
+		return fmt.Errorf("antenna %s must contain no more than %d entries, provided %d", name, maximumAntennaRules, count)
+	}
+
+	for _, rule := range rules {
+		if rule == "" {
+			return fmt.Errorf("antenna %s must not contain empty entries", name)
+		}
+
+		if length := len([]rune(rule)); length > maximumAntennaRuleLength {
+			return fmt.Errorf("antenna %s entries must be no more than %d chars, provided entry was %d chars", name, maximumAntennaRuleLength, length)
+		}
+	}
+
+	return nil
+}
+
 // MarkerName checks that the desired marker timeline name is valid.
 func MarkerName(name string) error {
 	if name == "" {
 		return fmt.Errorf("empty string for marker timeline name not allowed")
 	}
 	switch apimodel.MarkerName(name) {
-	case apimodel.MarkerNameHome, apimodel.MarkerNameNotifications:
+	case apimodel.MarkerNameHome,
+		apimodel.MarkerNameNotifications,
+		apimodel.MarkerNamePublic,
+		apimodel.MarkerNameLocal:
 		return nil
 	}
-	return fmt.Errorf("marker timeline name '%s' was not recognized, valid options are '%s', '%s'", name, apimodel.MarkerNameHome, apimodel.MarkerNameNotifications)
+	if listID, ok := strings.CutPrefix(name, apimodel.MarkerNameListPrefix); ok {
+		return ULID(listID, "marker list ID")
+	}
+	if tagID, ok := strings.CutPrefix(name, apimodel.MarkerNameTagPrefix); ok {
+		return ULID(tagID, "marker tag ID")
+	}
+	return fmt.Errorf("marker timeline name '%s' was not recognized, valid options are '%s', '%s', '%s', '%s', '%s<list_id>', '%s<tag_id>'",
+		name,
+		apimodel.MarkerNameHome,
+		apimodel.MarkerNameNotifications,
+		apimodel.MarkerNamePublic,
+		apimodel.MarkerNameLocal,
+		apimodel.MarkerNameListPrefix,
+		apimodel.MarkerNameTagPrefix,
+	)
 }
 
 // FilterKeyword validates a filter keyword.
@@ -406,7 +533,10 @@ func CreateAccount(form *apimodel.AccountCreateRequest) error {
 		return errors.New("form was nil")
 	}
 
-	if !config.GetAccountsRegistrationOpen() {
+	// Sign ups with an invite code are allowed even
+	// when registration is closed; the invite itself
+	// is checked for validity later on in processing.
+	if !config.GetAccountsRegistrationOpen() && form.InviteCode == "" {
 		return errors.New("registration is not open for this server")
 	}
 
@@ -385,6 +385,27 @@ type testStruct struct {
 	}
 }
 
+func (suite *ValidationTestSuite) TestValidateMarkerName() {
+	for name, ok := range map[string]bool{
+		"":                                false,
+		"home":                            true,
+		"notifications":                   true,
+		"public":                          true,
+		"local":                           true,
+		"federated":                       false,
+		"list:01F8MH82FYRXD2RC6108DAJ5HB": true,
+		"tag:01F8MH82FYRXD2RC6108DAJ5HB":  true,
+		"list:":                           false,
+		"list:not-a-ulid":                 false,
+		"tag:cats":                        false,
+	} {
+		err := validate.MarkerName(name)
+		if !suite.Equal(ok, err == nil) {
+			suite.T().Logf("fail on %s", name)
+		}
+	}
+}
+
 func TestValidationTestSuite(t *testing.T) {
 	suite.Run(t, new(ValidationTestSuite))
 }
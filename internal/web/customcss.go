@@ -21,7 +21,6 @@
 	"net/http"
 
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
-	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"github.com/gin-gonic/gin"
 )
@@ -40,16 +39,13 @@ func (m *Module) customCSSGETHandler(c *gin.Context) {
 		return
 	}
 
-	// Retrieve customCSS if enabled on the instance.
-	// Else use an empty string, to help with caching
-	// when custom CSS gets toggled on or off.
-	var customCSS string
-	if config.GetAccountsAllowCustomCSS() {
-		customCSS, errWithCode = m.processor.Account().GetCustomCSSForUsername(c.Request.Context(), requestedUser)
-		if errWithCode != nil {
-			apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
-			return
-		}
+	// Retrieve customCSS for the account. This will only include the
+	// account's own CSS if enabled on the instance, but always served
+	// (even if empty), to help with caching when custom CSS is toggled.
+	customCSS, errWithCode := m.processor.Account().GetCustomCSSForUsername(c.Request.Context(), requestedUser)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
 	}
 
 	c.Header(cacheControlHeader, cacheControlNoCache)
@@ -74,3 +70,29 @@ func (m *Module) instanceCustomCSSGETHandler(c *gin.Context) {
 	c.Header(cacheControlHeader, cacheControlNoCache)
 	c.Data(http.StatusOK, textCSSUTF8, []byte(instanceCustomCSS))
 }
+
+func (m *Module) uploadedThemeGETHandler(c *gin.Context) {
+	if _, err := apiutil.NegotiateAccept(c, apiutil.TextCSS); err != nil {
+		apiutil.WebErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	themeCSS, errWithCode := m.processor.Account().ThemeGetUploadedCSS(c.Request.Context(), c.Param(themeFileNameKey))
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	c.Header(cacheControlHeader, cacheControlNoCache)
+	c.Data(http.StatusOK, textCSSUTF8, themeCSS)
+}
+
+// themePath returns the path at which the
+// theme with given file name is served,
+// depending on whether it's bundled or uploaded.
+func (m *Module) themePath(theme string) string {
+	if m.processor.Account().IsBundledTheme(theme) {
+		return themesPathPrefix + "/" + theme
+	}
+	return uploadedThemesPathPrefix + "/" + theme
+}
@@ -0,0 +1,79 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package web
+
+import (
+	"net/http"
+
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+func (m *Module) embedGETHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	instance, errWithCode := m.processor.InstanceGetV1(ctx)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	statusID, errWithCode := apiutil.ParseID(c.Param(apiutil.IDKey))
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	status, errWithCode := m.processor.Status().EmbedGet(ctx, statusID)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	// This page, and only this page, may be framed by
+	// other websites. Drop the default X-Frame-Options
+	// header, and allow only secure (https) ancestors.
+	const cspHeader = "Content-Security-Policy"
+	c.Writer.Header().Del("X-Frame-Options")
+	csp := c.Writer.Header().Get(cspHeader)
+	csp = middleware.AllowFrameAncestors(csp, "'self'", "https:")
+	c.Header(cspHeader, csp)
+
+	// Stylesheets for the embed; the user's
+	// custom CSS is deliberately not included.
+	stylesheets := []string{
+		cssFA,
+		cssStatus,
+		cssEmbed,
+	}
+
+	// User-selected theme if set.
+	if theme := status.Account.Theme; theme != "" {
+		stylesheets = append(
+			stylesheets,
+			m.themePath(theme),
+		)
+	}
+
+	c.HTML(http.StatusOK, "embed.tmpl", map[string]any{
+		"instance":    instance,
+		"status":      status,
+		"stylesheets": stylesheets,
+	})
+}
@@ -55,6 +55,14 @@ func (m *Module) indexHandler(c *gin.Context) {
 		return
 	}
 
+	// Fetch any admin-set content blocks to
+	// show on the page in addition to defaults.
+	landingBlocks, errWithCode := m.processor.InstanceGetLandingBlocks(c.Request.Context())
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
 	page := apiutil.WebPage{
 		Template:    "index.tmpl",
 		Instance:    instance,
@@ -67,6 +75,9 @@ func (m *Module) indexHandler(c *gin.Context) {
 			// Show "log in" button
 			// in top-right corner.
 			"showLoginButton": true,
+			// Render admin-set content
+			// blocks, if there are any.
+			"landingBlocks": landingBlocks,
 		},
 	}
 
@@ -230,7 +230,7 @@ func (m *Module) profileMicroblog(c *gin.Context, p *profile) {
 	if theme := p.account.Theme; theme != "" {
 		stylesheets = append(
 			stylesheets,
-			themesPathPrefix+"/"+theme,
+			m.themePath(theme),
 		)
 	}
 
@@ -303,7 +303,7 @@ func (m *Module) profileGallery(c *gin.Context, p *profile) {
 	if theme := p.account.Theme; theme != "" {
 		stylesheets = append(
 			stylesheets,
-			themesPathPrefix+"/"+theme,
+			m.themePath(theme),
 		)
 	}
 
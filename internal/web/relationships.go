@@ -0,0 +1,168 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package web
+
+import (
+	"context"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	followersPath = profileGroupPath + "/followers"
+	followingPath = profileGroupPath + "/following"
+)
+
+// followersGETHandler serves a paged web
+// listing of the target account's followers.
+func (m *Module) followersGETHandler(c *gin.Context) {
+	m.relationshipsGET(c, true)
+}
+
+// followingGETHandler serves a paged web listing
+// of the accounts the target account follows.
+func (m *Module) followingGETHandler(c *gin.Context) {
+	m.relationshipsGET(c, false)
+}
+
+func (m *Module) relationshipsGET(c *gin.Context, followers bool) {
+	ctx := c.Request.Context()
+
+	// We'll need the instance later, and we can also use it
+	// before then to make it easier to return a web error.
+	instance, errWithCode := m.processor.InstanceGetV1(ctx)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	// Return instance we already got from the db,
+	// don't try to fetch it again when erroring.
+	instanceGet := func(ctx context.Context) (*apimodel.InstanceV1, gtserror.WithCode) {
+		return instance, nil
+	}
+
+	// We only serve text/html at this endpoint.
+	if _, err := apiutil.NegotiateAccept(c, apiutil.TextHTML); err != nil {
+		errWithCode := gtserror.NewErrorNotAcceptable(err, err.Error())
+		apiutil.WebErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
+	// Parse + normalize account username from the URL.
+	requestedUser, errWithCode := apiutil.ParseUsername(c.Param(apiutil.UsernameKey))
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
+	account, errWithCode := m.processor.Account().GetWeb(ctx, requestedUser)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
+	// If target account is suspended,
+	// this page should not be visible.
+	if account.Suspended {
+		err := gtserror.Newf("target account %s is suspended", requestedUser)
+		apiutil.WebErrorHandler(c, gtserror.NewErrorNotFound(err), instanceGet)
+		return
+	}
+
+	page, errWithCode := paging.ParseIDPage(c,
+		1,  // min limit
+		80, // max limit
+		40, // default limit
+	)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
+	// Fetch the requested page of accounts. This
+	// 404s if the account hides its collections.
+	var (
+		resp  *apimodel.PageableResponse
+		title string
+	)
+	if followers {
+		resp, errWithCode = m.processor.Account().WebFollowersGet(ctx, account.ID, page)
+		title = "Followed by"
+	} else {
+		resp, errWithCode = m.processor.Account().WebFollowingGet(ctx, account.ID, page)
+		title = "Following"
+	}
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
+	// Prepare stylesheets for page.
+	stylesheets := make([]string, 0, 4)
+
+	// Basic page stylesheets.
+	stylesheets = append(
+		stylesheets,
+		[]string{
+			cssFA,
+			cssProfileRelationships,
+		}...,
+	)
+
+	// User-selected theme if set.
+	if theme := account.Theme; theme != "" {
+		stylesheets = append(
+			stylesheets,
+			m.themePath(theme),
+		)
+	}
+
+	// Custom CSS for this user last in cascade.
+	stylesheets = append(
+		stylesheets,
+		"/@"+account.Username+"/custom.css",
+	)
+
+	webPage := apiutil.WebPage{
+		Template:    "profile-relationships.tmpl",
+		Instance:    instance,
+		OGMeta:      apiutil.OGAccount(instance, account),
+		Stylesheets: stylesheets,
+		Javascript: []apiutil.JavascriptEntry{
+			{
+				Src:   jsFrontend,
+				Async: true,
+				Defer: true,
+			},
+		},
+		Extra: map[string]any{
+			"account":       account,
+			"title":         title,
+			"accounts":      resp.Items,
+			"accounts_next": resp.NextLink,
+			"accounts_prev": resp.PrevLink,
+		},
+	}
+
+	apiutil.TemplateWebPage(c, webPage)
+}
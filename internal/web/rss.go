@@ -66,9 +66,13 @@ func (m *Module) rssFeedGETHandler(c *gin.Context) {
 		return
 	}
 
+	// Private feed token, if given.
+	token := c.Query("token")
+
 	getFunc, lastPostAt, errWithCode := m.processor.Account().GetRSSFeedForUsername(
 		c.Request.Context(),
 		username,
+		token,
 		page,
 	)
 	if errWithCode != nil {
@@ -80,6 +84,12 @@ func (m *Module) rssFeedGETHandler(c *gin.Context) {
 
 	// Key to use in etag cache (note content-type suffix).
 	cacheKey := c.Request.URL.Path + "#" + contentType
+	if token != "" {
+		// Private feed has different contents,
+		// so it can't share public feed's entry.
+		// Token was already checked as valid.
+		cacheKey += "#private"
+	}
 
 	// Check etag cache for an existing entry under key.
 	cacheEntry, wasCached := m.eTagCache.Get(cacheKey)
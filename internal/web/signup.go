@@ -26,6 +26,8 @@
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/middleware"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
 	"code.superseriousbusiness.org/gotosocial/internal/validate"
 	"github.com/gin-gonic/gin"
 )
@@ -61,9 +63,30 @@ func (m *Module) signupGETHandler(c *gin.Context) {
 			"oidcEnabled":      config.GetOIDCEnabled(),
 			"registrationOpen": config.GetAccountsRegistrationOpen(),
 			"reasonRequired":   config.GetAccountsReasonRequired(),
+			"inviteCode":       c.Query("invite"),
 		},
 	}
 
+	// If captcha is enabled, include the provider's
+	// widget JS, and allow the provider's sources
+	// in the Content-Security-Policy for this page.
+	if provider := m.captcha.Provider(); provider != nil {
+		page.Extra["captchaWidgetClass"] = provider.WidgetClass()
+		page.Extra["captchaSiteKey"] = m.captcha.SiteKey()
+		page.Javascript = []apiutil.JavascriptEntry{
+			{
+				Src:   provider.ScriptURL(),
+				Async: true,
+				Defer: true,
+			},
+		}
+
+		const cspHeader = "Content-Security-Policy"
+		csp := c.Writer.Header().Get(cspHeader)
+		csp = middleware.ExtendContentSecurityPolicy(csp, provider.CSPSources()...)
+		c.Header(cspHeader, csp)
+	}
+
 	apiutil.TemplateWebPage(c, page)
 }
 
@@ -110,6 +133,12 @@ func (m *Module) signupPOSTHandler(c *gin.Context) {
 	}
 	form.IP = signUpIP
 
+	// Fetch the captcha response, if enabled, from
+	// the form field used by the captcha provider.
+	if provider := m.captcha.Provider(); provider != nil {
+		form.CaptchaResponse = c.PostForm(provider.ResponseField())
+	}
+
 	// We have all the info we need, call user+account create
 	// (this will also trigger side effects like sending emails etc).
 	user, errWithCode := m.processor.User().Create(
@@ -133,6 +162,7 @@ func (m *Module) signupPOSTHandler(c *gin.Context) {
 		Extra: map[string]any{
 			"email":    user.UnconfirmedEmail,
 			"username": user.Account.Username,
+			"approved": util.PtrOrZero(user.Approved),
 		},
 	}
 
@@ -19,14 +19,21 @@
 
 import (
 	"context"
+	"math"
 	"net/http"
+	"net/url"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/api/oembed"
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"github.com/gin-gonic/gin"
 )
 
+// threadRepliesPerPage is the max number of
+// replies to show per page in a web thread view.
+const threadRepliesPerPage = 40
+
 func (m *Module) threadGETHandler(c *gin.Context) {
 	ctx := c.Request.Context()
 
@@ -93,8 +100,23 @@ func (m *Module) threadGETHandler(c *gin.Context) {
 		return
 	}
 
+	// Parse which page of replies to show, if given.
+	// By default (-1) we show the page containing the status.
+	repliesOffset, errWithCode := apiutil.ParseWebRepliesOffset(
+		c.Query(apiutil.WebRepliesOffsetKey),
+		-1, math.MaxInt32, 0,
+	)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
 	// Get the thread context. This will fetch the status as well.
-	context, errWithCode := m.processor.Status().WebContextGet(ctx, statusID)
+	context, errWithCode := m.processor.Status().WebContextGet(ctx,
+		statusID,
+		repliesOffset,
+		threadRepliesPerPage,
+	)
 	if errWithCode != nil {
 		apiutil.WebErrorHandler(c, errWithCode, instanceGet)
 		return
@@ -130,7 +152,7 @@ func (m *Module) threadGETHandler(c *gin.Context) {
 	if theme := acct.Theme; theme != "" {
 		stylesheets = append(
 			stylesheets,
-			themesPathPrefix+"/"+theme,
+			m.themePath(theme),
 		)
 	}
 
@@ -140,6 +162,14 @@ func (m *Module) threadGETHandler(c *gin.Context) {
 		"/@"+acct.Username+"/custom.css",
 	)
 
+	// If the status is public, advertise the
+	// oEmbed endpoint so it can be embedded.
+	// (Endpoint itself checks opt-outs etc).
+	var oEmbedURL string
+	if context.Status.Visibility == apimodel.VisibilityPublic {
+		oEmbedURL = "/" + oembed.BasePath + "?" + oembed.URLKey + "=" + url.QueryEscape(context.Status.URL)
+	}
+
 	page := apiutil.WebPage{
 		Template:    "thread.tmpl",
 		Instance:    instance,
@@ -159,6 +189,7 @@ func (m *Module) threadGETHandler(c *gin.Context) {
 		Extra: map[string]any{
 			"context":    context,
 			"robotsMeta": robotsMeta,
+			"oEmbedURL":  oEmbedURL,
 		},
 	}
 
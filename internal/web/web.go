@@ -23,6 +23,7 @@
 	"net/url"
 
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/captcha"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/middleware"
 	"code.superseriousbusiness.org/gotosocial/internal/processing"
@@ -43,12 +44,16 @@
 	assetsPathPrefix         = "/assets"
 	distPathPrefix           = assetsPathPrefix + "/dist"
 	themesPathPrefix         = assetsPathPrefix + "/themes"
+	uploadedThemesPathPrefix = "/themes"
+	uploadedThemePath        = uploadedThemesPathPrefix + "/:" + themeFileNameKey
+	themeFileNameKey         = "file_name"
 	settingsPathPrefix       = "/settings"
 	settingsPanelGlob        = settingsPathPrefix + "/*panel"
 	userPanelPath            = settingsPathPrefix + "/user"
 	adminPanelPath           = settingsPathPrefix + "/admin"
 	signupPath               = "/signup"
 	authorizeInteractionPath = "/authorize_interaction"
+	embedPath                = "/" + uris.EmbedPath + "/:" + apiutil.IDKey
 
 	cacheControlHeader    = "Cache-Control"     // https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Cache-Control
 	cacheControlNoCache   = "no-cache"          // https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Cache-Control#response_directives
@@ -57,16 +62,18 @@
 	eTagHeader            = "ETag"              // https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/ETag
 	lastModifiedHeader    = "Last-Modified"     // https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Last-Modified
 
-	cssFA             = assetsPathPrefix + "/Fork-Awesome/css/fork-awesome.min.css"
-	cssAbout          = distPathPrefix + "/about.css"
-	cssIndex          = distPathPrefix + "/index.css"
-	cssLoginInfo      = distPathPrefix + "/login-info.css"
-	cssStatus         = distPathPrefix + "/status.css"
-	cssThread         = distPathPrefix + "/thread.css"
-	cssProfile        = distPathPrefix + "/profile.css"
-	cssProfileGallery = distPathPrefix + "/profile-gallery.css"
-	cssSettings       = distPathPrefix + "/settings-style.css"
-	cssTag            = distPathPrefix + "/tag.css"
+	cssFA                   = assetsPathPrefix + "/Fork-Awesome/css/fork-awesome.min.css"
+	cssAbout                = distPathPrefix + "/about.css"
+	cssEmbed                = distPathPrefix + "/embed.css"
+	cssIndex                = distPathPrefix + "/index.css"
+	cssLoginInfo            = distPathPrefix + "/login-info.css"
+	cssStatus               = distPathPrefix + "/status.css"
+	cssThread               = distPathPrefix + "/thread.css"
+	cssProfile              = distPathPrefix + "/profile.css"
+	cssProfileGallery       = distPathPrefix + "/profile-gallery.css"
+	cssProfileRelationships = distPathPrefix + "/profile-relationships.css"
+	cssSettings             = distPathPrefix + "/settings-style.css"
+	cssTag                  = distPathPrefix + "/tag.css"
 
 	jsFrontend          = distPathPrefix + "/frontend.js"           // Progressive enhancement frontend JS.
 	jsFrontendPrerender = distPathPrefix + "/frontend_prerender.js" // Frontend JS that should run before page renders.
@@ -78,14 +85,23 @@ type Module struct {
 	eTagCache    cache.Cache[string, eTagCacheEntry]
 	cookiePolicy apiutil.CookiePolicy
 	isURIBlocked func(context.Context, *url.URL) (bool, error)
+	captcha      *captcha.Captcha
 }
 
-func New(db db.DB, processor *processing.Processor, cookiePolicy apiutil.CookiePolicy) *Module {
+// New returns a new web Module. It is safe
+// to pass a nil captcha if captcha is disabled.
+func New(
+	db db.DB,
+	processor *processing.Processor,
+	cookiePolicy apiutil.CookiePolicy,
+	captcha *captcha.Captcha,
+) *Module {
 	return &Module{
 		processor:    processor,
 		eTagCache:    newETagCache(),
 		cookiePolicy: cookiePolicy,
 		isURIBlocked: db.IsURIBlocked,
+		captcha:      captcha,
 	}
 }
 
@@ -119,16 +135,21 @@ func (m *Module) Route(r *router.Router, mi ...gin.HandlerFunc) {
 	everythingElseGroup.Handle(http.MethodGet, settingsPanelGlob, m.SettingsPanelHandler)
 	everythingElseGroup.Handle(http.MethodGet, customCSSPath, m.customCSSGETHandler)
 	everythingElseGroup.Handle(http.MethodGet, instanceCustomCSSPath, m.instanceCustomCSSGETHandler)
+	everythingElseGroup.Handle(http.MethodGet, uploadedThemePath, m.uploadedThemeGETHandler)
 	everythingElseGroup.Handle(http.MethodGet, rssFeedPath, m.rssFeedGETHandler)
+	everythingElseGroup.Handle(http.MethodGet, followersPath, m.followersGETHandler)
+	everythingElseGroup.Handle(http.MethodGet, followingPath, m.followingGETHandler)
 	everythingElseGroup.Handle(http.MethodGet, confirmEmailPath, m.confirmEmailGETHandler)
 	everythingElseGroup.Handle(http.MethodPost, confirmEmailPath, m.confirmEmailPOSTHandler)
 	everythingElseGroup.Handle(http.MethodGet, aboutPath, m.aboutGETHandler)
 	everythingElseGroup.Handle(http.MethodGet, loginPath, m.loginGETHandler)
 	everythingElseGroup.Handle(http.MethodGet, domainBlocklistPath, m.domainBlocklistGETHandler)
 	everythingElseGroup.Handle(http.MethodGet, domainAllowlistPath, m.domainAllowlistGETHandler)
+	everythingElseGroup.Handle(http.MethodGet, emojisPath, m.emojisGETHandler)
 	everythingElseGroup.Handle(http.MethodGet, tagsPath, m.tagGETHandler)
 	everythingElseGroup.Handle(http.MethodGet, signupPath, m.signupGETHandler)
 	everythingElseGroup.Handle(http.MethodGet, authorizeInteractionPath, m.authorizeInteractionGETHandler)
+	everythingElseGroup.Handle(http.MethodGet, embedPath, m.embedGETHandler)
 	everythingElseGroup.Handle(http.MethodPost, signupPath, m.signupPOSTHandler)
 
 	// Redirects from old endpoints for back compat.
@@ -191,6 +191,10 @@ func (r *realSender) sendToSubscription(
 
 		// responseBodyMaxLen limits how much of the Web Push server response we read for error messages.
 		responseBodyMaxLen = 1024
+
+		// payloadMaxLen limits the size of the encoded notification, leaving
+		// room within recordSize for the encryption header and padding.
+		payloadMaxLen = recordSize - 128
 	)
 
 	// Get the associated access token.
@@ -216,6 +220,7 @@ func (r *realSender) sendToSubscription(
 		Icon:             apiNotification.Account.Avatar,
 		PreferredLocale:  targetAccountSettings.Language,
 		AccessToken:      token.Access,
+		PollResults:      formatPollResults(notification, apiNotification),
 	}
 
 	// Encode the push notification as JSON.
@@ -224,6 +229,30 @@ func (r *realSender) sendToSubscription(
 		return gtserror.Newf("error encoding Web Push notification: %w", err)
 	}
 
+	if over := len(pushNotificationBytes) - payloadMaxLen; over > 0 &&
+		pushNotification.PollResults != nil {
+		// Poll results are more useful than a long body
+		// preview, so try shortening the body to fit them.
+		bodyLen := max(0, len(pushNotification.Body)-over)
+		pushNotification.Body = firstNBytesTrimSpace(pushNotification.Body, bodyLen)
+
+		pushNotificationBytes, err = json.Marshal(pushNotification)
+		if err != nil {
+			return gtserror.Newf("error encoding Web Push notification: %w", err)
+		}
+
+		if len(pushNotificationBytes) > payloadMaxLen {
+			// Still too big (lots of options?),
+			// so send without the poll results.
+			pushNotification.PollResults = nil
+
+			pushNotificationBytes, err = json.Marshal(pushNotification)
+			if err != nil {
+				return gtserror.Newf("error encoding Web Push notification: %w", err)
+			}
+		}
+	}
+
 	// Send push notification.
 	resp, err := webpushgo.SendNotificationWithContext(
 		ctx,
@@ -344,6 +373,10 @@ func formatNotificationTitle(
 		return displayNameOrAcct + " submitted a report"
 	case gtsmodel.NotificationUpdate:
 		return displayNameOrAcct + " updated their post"
+	case gtsmodel.NotificationPollMilestone:
+		return "Your poll has reached a new number of voters"
+	case gtsmodel.NotificationMove:
+		return displayNameOrAcct + " moved to a new account"
 	default:
 		log.Warnf(ctx, "Unknown notification type: %d", notification.NotificationType)
 		return displayNameOrAcct + " did something (unknown notification type)"
@@ -372,6 +405,28 @@ func formatNotificationBody(apiNotification *apimodel.Notification) string {
 	return firstNBytesTrimSpace(body, bodyMaxLen)
 }
 
+// formatPollResults creates a summary of the final results
+// of a poll for a Web Push notification, if the notification
+// is for an ended poll. Otherwise it returns nil.
+func formatPollResults(
+	notification *gtsmodel.Notification,
+	apiNotification *apimodel.Notification,
+) *apimodel.WebPushPollResults {
+	if notification.NotificationType != gtsmodel.NotificationPoll ||
+		apiNotification.Status == nil ||
+		apiNotification.Status.Poll == nil {
+		return nil
+	}
+
+	poll := apiNotification.Status.Poll
+	return &apimodel.WebPushPollResults{
+		PollID:      poll.ID,
+		VotesCount:  poll.VotesCount,
+		VotersCount: poll.VotersCount,
+		Options:     poll.Options,
+	}
+}
+
 // firstNBytesTrimSpace returns the first N bytes of a string, trimming leading and trailing whitespace.
 func firstNBytesTrimSpace(s string, n int) string {
 	return strings.TrimSpace(text.FirstNBytesByWords(strings.TrimSpace(s), n))
@@ -19,6 +19,7 @@
 
 import (
 	"context"
+	"sync/atomic"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
@@ -31,12 +32,17 @@
 // a singular struct for easy multi start / stop.
 type FnWorkerPool struct {
 
+	// Name is the name of this worker
+	// pool, used when naming trace spans.
+	Name string
+
 	// Queue is embedded queue.SimpleQueue{}
 	// passed to each of the pool Worker{}s.
 	Queue queue.SimpleQueue[func(context.Context)]
 
 	// internal fields.
 	workers []*FnWorker
+	active  atomic.Int32
 }
 
 // Start will attempt to start 'n' FnWorker{}s.
@@ -62,6 +68,9 @@ func (p *FnWorkerPool) Start(n int) {
 		// false = already running.
 		_ = p.workers[i].Start()
 	}
+
+	// Set active worker count.
+	p.active.Store(int32(n))
 }
 
 // Stop will attempt to stop contained FnWorker{}s.
@@ -73,6 +82,9 @@ func (p *FnWorkerPool) Stop() {
 		return
 	}
 
+	// Unset active worker count.
+	p.active.Store(0)
+
 	// Stop all running workers.
 	for i := range p.workers {
 
@@ -86,9 +98,28 @@ func (p *FnWorkerPool) Stop() {
 	p.workers = p.workers[:0]
 }
 
-// Len returns number of currently active workers.
+// Len returns number of currently active workers,
+// safe to call concurrently with Start() and Stop().
 func (p *FnWorkerPool) Len() int {
-	return len(p.workers)
+	return int(p.active.Load())
+}
+
+// Push will push given functions to the worker queue, carrying
+// the trace information of ctx across with each of them, such
+// that their execution continues the trace of the caller.
+func (p *FnWorkerPool) Push(ctx context.Context, fns ...func(context.Context)) {
+	if trace := gtscontext.CarryTrace(ctx); trace != nil {
+		for i, fn := range fns {
+			fns[i] = func(ctx context.Context) {
+				ctx, end := gtscontext.ResumeTrace(ctx, trace, spanName(p.Name))
+				defer end(nil)
+				fn(ctx)
+			}
+		}
+	}
+	for _, fn := range fns {
+		p.Queue.Push(fn)
+	}
 }
 
 // FnWorker wraps a queue.SimpleQueue{} which
@@ -20,6 +20,7 @@
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
@@ -33,6 +34,10 @@
 // a singular struct for easy multi start / stop.
 type MsgWorkerPool[Msg any] struct {
 
+	// Name is the name of this worker
+	// pool, used when naming trace spans.
+	Name string
+
 	// Process handles queued message types.
 	Process func(context.Context, Msg) error
 
@@ -42,6 +47,7 @@ type MsgWorkerPool[Msg any] struct {
 
 	// internal fields.
 	workers []*MsgWorker[Msg]
+	active  atomic.Int32
 }
 
 // Init will initialize the worker pool queue with given struct indices.
@@ -64,6 +70,7 @@ func (p *MsgWorkerPool[T]) Start(n int) {
 
 		// Allocate new MsgWorker[T]{}.
 		p.workers[i] = new(MsgWorker[T])
+		p.workers[i].Name = p.Name
 		p.workers[i].Process = p.Process
 		p.workers[i].Queue = &p.Queue
 
@@ -73,6 +80,9 @@ func (p *MsgWorkerPool[T]) Start(n int) {
 		// false = already running.
 		_ = p.workers[i].Start()
 	}
+
+	// Set active worker count.
+	p.active.Store(int32(n))
 }
 
 // Stop will attempt to stop contained Worker{}s.
@@ -84,6 +94,9 @@ func (p *MsgWorkerPool[T]) Stop() {
 		return
 	}
 
+	// Unset active worker count.
+	p.active.Store(0)
+
 	// Stop all running workers.
 	for i := range p.workers {
 
@@ -97,9 +110,32 @@ func (p *MsgWorkerPool[T]) Stop() {
 	p.workers = p.workers[:0]
 }
 
-// Len returns number of currently active workers.
+// Len returns number of currently active workers,
+// safe to call concurrently with Start() and Stop().
 func (p *MsgWorkerPool[T]) Len() int {
-	return len(p.workers)
+	return int(p.active.Load())
+}
+
+// Push will push given messages to the worker queue, carrying
+// the trace information of ctx across with each of them, such
+// that their processing continues the trace of the caller.
+func (p *MsgWorkerPool[T]) Push(ctx context.Context, msgs ...T) {
+	if trace := gtscontext.CarryTrace(ctx); trace != nil {
+		for _, msg := range msgs {
+			if msg, ok := any(msg).(traceCarrier); ok {
+				msg.SetTrace(trace)
+			}
+		}
+	}
+	p.Queue.Push(msgs...)
+}
+
+// traceCarrier is implemented by message
+// types able to carry trace information
+// with them across the worker queue.
+type traceCarrier interface {
+	SetTrace(*gtscontext.Trace)
+	Trace() *gtscontext.Trace
 }
 
 // MsgWorker wraps a processing function to
@@ -108,6 +144,10 @@ func (p *MsgWorkerPool[T]) Len() int {
 // with state management utilities.
 type MsgWorker[Msg any] struct {
 
+	// Name is the name of the worker
+	// pool, used when naming trace spans.
+	Name string
+
 	// Process handles queued message types.
 	Process func(context.Context, Msg) error
 
@@ -157,10 +197,15 @@ func (w *MsgWorker[T]) process(ctx context.Context) {
 			return
 		}
 
+		// Resume any trace carried with message.
+		msgCtx, end := w.resumeTrace(ctx, msg)
+
 		// Attempt to process message.
-		err := w.Process(ctx, msg)
+		err := w.Process(msgCtx, msg)
+		end(err)
+
 		if err != nil {
-			log.Errorf(ctx, "%p: error processing: %v", w, err)
+			log.Errorf(msgCtx, "%p: error processing: %v", w, err)
 
 			if errors.Is(err, context.Canceled) &&
 				ctx.Err() != nil {
@@ -179,3 +224,14 @@ func (w *MsgWorker[T]) process(ctx context.Context) {
 		}
 	}
 }
+
+// resumeTrace resumes the trace carried with msg (if any),
+// returning the context to process msg with, and a function
+// to call with the processing result once finished.
+func (w *MsgWorker[T]) resumeTrace(ctx context.Context, msg T) (context.Context, func(error)) {
+	carrier, ok := any(msg).(traceCarrier)
+	if !ok {
+		return ctx, func(error) {}
+	}
+	return gtscontext.ResumeTrace(ctx, carrier.Trace(), spanName(w.Name))
+}
@@ -18,7 +18,9 @@
 package workers
 
 import (
+	"context"
 	"runtime"
+	"time"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
@@ -58,6 +60,11 @@ type Workers struct {
 	// delivering Web Push notifications.
 	WebPush FnWorkerPool
 
+	// Surfacing provides a worker pool for
+	// fanning out the surfacing of a status
+	// to many local accounts' timelines.
+	Surfacing FnWorkerPool
+
 	// prevent pass-by-value.
 	_ nocopy
 }
@@ -79,37 +86,126 @@ func (w *Workers) Start() {
 	w.Delivery.Start(n)
 	log.Infof(nil, "started %d delivery workers", n)
 
+	n = maxprocs
+	w.WebPush.Name = "webpush"
+	w.WebPush.Start(n)
+	log.Infof(nil, "started %d Web Push workers", n)
+
+	w.startPausable(maxprocs)
+}
+
+// Stop will stop all of the contained
+// worker pools (and global scheduler).
+func (w *Workers) Stop() {
+	_ = w.Scheduler.Stop()
+	// false = not running
+	log.Info(nil, "stopped scheduler")
+
+	w.Delivery.Stop()
+	log.Info(nil, "stopped delivery workers")
+
+	w.WebPush.Stop()
+	log.Info(nil, "stopped WebPush workers")
+
+	w.stopPausable()
+}
+
+// Drain waits until all worker queues are empty, or the
+// context is canceled, returning whether all were emptied.
+// Note this only checks queue lengths, so tasks already
+// popped from a queue may still be running, though these
+// will be waited upon by a following call to Stop().
+func (w *Workers) Drain(ctx context.Context) bool {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	// Keep checking queue lengths, as
+	// running tasks may themselves queue
+	// further tasks, eg., a client worker
+	// task queueing outgoing deliveries.
+	for w.queued() > 0 {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+	}
+
+	return true
+}
+
+// queued returns the total number
+// of tasks queued across all pools.
+func (w *Workers) queued() int {
+	return w.Delivery.Queue.Len() +
+		w.Client.Queue.Len() +
+		w.Federator.Queue.Len() +
+		w.Dereference.Queue.Len() +
+		w.Processing.Queue.Len() +
+		w.WebPush.Queue.Len() +
+		w.Surfacing.Queue.Len()
+}
+
+// Pause will stop the worker pools that process
+// queued work which may write to the database, ie.,
+// client, federator, dereference, processing and
+// surfacing. Their queues are left intact, so any
+// work queued in the meantime will be processed
+// once the pools are started again with Resume().
+//
+// The scheduler is also paused, any tasks falling due
+// in the meantime being run once on Resume(). Delivery
+// and Web Push workers keep running.
+func (w *Workers) Pause() {
+	w.Scheduler.Pause()
+	log.Info(nil, "paused scheduler")
+
+	w.stopPausable()
+}
+
+// Resume will start the worker pools
+// and scheduler paused by Pause() again.
+func (w *Workers) Resume() {
+	w.startPausable(runtime.GOMAXPROCS(0))
+
+	w.Scheduler.Resume()
+	log.Info(nil, "resumed scheduler")
+}
+
+// startPausable starts those worker pools
+// which may be stopped by a call to Pause().
+func (w *Workers) startPausable(maxprocs int) {
+	var n int
+
 	n = 4 * maxprocs
+	w.Client.Name = "client"
 	w.Client.Start(n)
 	log.Infof(nil, "started %d client workers", n)
 
 	n = 4 * maxprocs
+	w.Federator.Name = "federator"
 	w.Federator.Start(n)
 	log.Infof(nil, "started %d federator workers", n)
 
 	n = 4 * maxprocs
+	w.Dereference.Name = "dereference"
 	w.Dereference.Start(n)
 	log.Infof(nil, "started %d dereference workers", n)
 
 	n = maxprocs
+	w.Processing.Name = "processing"
 	w.Processing.Start(n)
 	log.Infof(nil, "started %d processing workers", n)
 
-	n = maxprocs
-	w.WebPush.Start(n)
-	log.Infof(nil, "started %d Web Push workers", n)
+	n = 4 * maxprocs
+	w.Surfacing.Name = "surfacing"
+	w.Surfacing.Start(n)
+	log.Infof(nil, "started %d surfacing workers", n)
 }
 
-// Stop will stop all of the contained
-// worker pools (and global scheduler).
-func (w *Workers) Stop() {
-	_ = w.Scheduler.Stop()
-	// false = not running
-	log.Info(nil, "stopped scheduler")
-
-	w.Delivery.Stop()
-	log.Info(nil, "stopped delivery workers")
-
+// stopPausable stops those worker pools
+// which may be stopped by a call to Pause().
+func (w *Workers) stopPausable() {
 	w.Client.Stop()
 	log.Info(nil, "stopped client workers")
 
@@ -122,8 +218,11 @@ func (w *Workers) Stop() {
 	w.Processing.Stop()
 	log.Info(nil, "stopped processing workers")
 
-	w.WebPush.Stop()
-	log.Info(nil, "stopped WebPush workers")
+	// Stopped last, as client and federator
+	// workers may be waiting on surfacing
+	// tasks to finish before they can stop.
+	w.Surfacing.Stop()
+	log.Info(nil, "stopped surfacing workers")
 }
 
 // nocopy when embedded will signal linter to
@@ -142,3 +241,12 @@ func deliveryWorkers(maxprocs int) int {
 	}
 	return n * maxprocs
 }
+
+// spanName returns the trace span
+// name for given worker pool name.
+func spanName(pool string) string {
+	if pool == "" {
+		return "worker"
+	}
+	return pool + " worker"
+}
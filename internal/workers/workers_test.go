@@ -0,0 +1,129 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
+	"code.superseriousbusiness.org/gotosocial/internal/workers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrain(t *testing.T) {
+	var w workers.Workers
+
+	// Queue a task with no
+	// running workers to pop it.
+	done := make(chan struct{})
+	w.Processing.Queue.Push(func(context.Context) { close(done) })
+
+	// Draining should time out.
+	ctx, cncl := context.WithTimeout(t.Context(), 250*time.Millisecond)
+	defer cncl()
+	assert.False(t, w.Drain(ctx))
+
+	// Start workers to process the queue.
+	w.Processing.Start(1)
+	defer w.Processing.Stop()
+
+	// Draining should now succeed.
+	ctx, cncl = context.WithTimeout(t.Context(), 5*time.Second)
+	defer cncl()
+	assert.True(t, w.Drain(ctx))
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for queued task")
+	}
+}
+
+func TestPoolLen(t *testing.T) {
+	var w workers.Workers
+
+	// Check worker counts concurrently
+	// with pools being started and stopped,
+	// as done during Pause() / Resume().
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			_ = w.Surfacing.Len()
+			_ = w.Processing.Len()
+		}
+	}()
+
+	for i := 0; i < 10; i++ {
+		w.Surfacing.Start(2)
+		w.Processing.Start(2)
+		w.Surfacing.Stop()
+		w.Processing.Stop()
+	}
+	<-done
+
+	w.Surfacing.Start(2)
+	defer w.Surfacing.Stop()
+	assert.Equal(t, 2, w.Surfacing.Len())
+	assert.Equal(t, 0, w.Processing.Len())
+}
+
+func TestPauseScheduler(t *testing.T) {
+	var w workers.Workers
+	w.Client.Process = func(context.Context, *messages.FromClientAPI) error { return nil }
+	w.Federator.Process = func(context.Context, *messages.FromFediAPI) error { return nil }
+
+	w.StartScheduler()
+	defer w.Stop()
+
+	w.Pause()
+
+	// Schedule a task (and a recurring one)
+	// to fall due while scheduler is paused.
+	ran := make(chan string, 16)
+	w.Scheduler.AddOnce("once", time.Now(), func(context.Context, time.Time) {
+		ran <- "once"
+	})
+	w.Scheduler.AddRecurring("recurring", time.Now(), 10*time.Millisecond, func(context.Context, time.Time) {
+		ran <- "recurring"
+	})
+
+	// Neither should run while paused.
+	select {
+	case id := <-ran:
+		t.Fatalf("task %s ran while paused", id)
+	case <-time.After(250 * time.Millisecond):
+	}
+
+	// Stop recurring task being re-run after
+	// resume, then check held tasks both run.
+	w.Resume()
+	w.Scheduler.Cancel("recurring")
+
+	got := make(map[string]bool)
+	for len(got) < 2 {
+		select {
+		case id := <-ran:
+			got[id] = true
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for held tasks, got: %v", got)
+		}
+	}
+}
@@ -6,8 +6,24 @@ EXPECT=$(cat << "EOF"
 {
     "account-domain": "peepee",
     "accounts-allow-custom-css": true,
+    "accounts-allow-user-invites": true,
+    "accounts-captcha-provider": "hcaptcha",
+    "accounts-captcha-secret-key": "",
+    "accounts-captcha-site-key": "some-site-key",
+    "accounts-custom-css-allowed-properties": [
+        "color",
+        "font-family"
+    ],
     "accounts-custom-css-length": 5000,
+    "accounts-custom-css-strict": true,
     "accounts-max-profile-fields": 8,
+    "accounts-max-service-accounts": 3,
+    "accounts-policy": "",
+    "accounts-rate-limit-admin-multiplier": 0,
+    "accounts-rate-limit-follows": 50,
+    "accounts-rate-limit-moderator-multiplier": 2,
+    "accounts-rate-limit-reports": 5,
+    "accounts-rate-limit-statuses": 100,
     "accounts-reason-required": false,
     "accounts-registration-backlog-limit": 100,
     "accounts-registration-daily-limit": 50,
@@ -23,15 +39,23 @@ EXPECT=$(cat << "EOF"
     "advanced-sender-multiplier": -1,
     "advanced-throttling-multiplier": -1,
     "advanced-throttling-retry-after": 10000000000,
+    "api-token": "",
+    "api-token-file": "",
+    "api-url": "",
     "application-name": "gts",
     "bind-address": "127.0.0.1",
     "cache-account-mem-ratio": 5,
     "cache-account-note-mem-ratio": 1,
     "cache-account-settings-mem-ratio": 0.1,
     "cache-account-stats-mem-ratio": 2,
+    "cache-antenna-ids-mem-ratio": 0.5,
+    "cache-antenna-mem-ratio": 0.5,
+    "cache-antenna-timeline-timeout": 7200000000000,
     "cache-application-mem-ratio": 0.1,
     "cache-block-ids-mem-ratio": 3,
     "cache-block-mem-ratio": 2,
+    "cache-bookmark-collection-ids-mem-ratio": 0.5,
+    "cache-bookmark-collection-mem-ratio": 0.5,
     "cache-boost-of-ids-mem-ratio": 3,
     "cache-client-mem-ratio": 0.1,
     "cache-conversation-last-status-ids-mem-ratio": 2,
@@ -51,10 +75,12 @@ EXPECT=$(cat << "EOF"
     "cache-follow-request-mem-ratio": 2,
     "cache-following-tag-ids-mem-ratio": 2,
     "cache-home-account-ids-mem-ratio": 2,
+    "cache-home-timeline-max-entries": 800,
     "cache-home-timeline-timeout": 21600000000000,
     "cache-in-reply-to-ids-mem-ratio": 3,
     "cache-instance-mem-ratio": 1,
     "cache-interaction-request-mem-ratio": 1,
+    "cache-language-timeline-timeout": 600000000000,
     "cache-list-ids-mem-ratio": 2,
     "cache-list-mem-ratio": 1,
     "cache-list-timeline-timeout": 7200000000000,
@@ -64,6 +90,7 @@ EXPECT=$(cat << "EOF"
     "cache-memory-target": "100MiB",
     "cache-mention-mem-ratio": 2,
     "cache-move-mem-ratio": 0.1,
+    "cache-muted-tag-ids-mem-ratio": 0.5,
     "cache-mutes-mem-ratio": 2,
     "cache-notification-mem-ratio": 2,
     "cache-poll-mem-ratio": 1,
@@ -80,6 +107,8 @@ EXPECT=$(cat << "EOF"
     "cache-status-fave-mem-ratio": 2,
     "cache-status-filter-mem-ratio": 7,
     "cache-status-mem-ratio": 5,
+    "cache-status-reaction-ids-mem-ratio": 1,
+    "cache-status-reaction-mem-ratio": 1,
     "cache-tag-mem-ratio": 2,
     "cache-tag-timeline-timeout": 600000000000,
     "cache-thread-mute-mem-ratio": 0.2,
@@ -89,16 +118,23 @@ EXPECT=$(cat << "EOF"
     "cache-user-mute-ids-mem-ratio": 3,
     "cache-user-mute-mem-ratio": 2,
     "cache-visibility-mem-ratio": 2,
+    "cache-visibility-sweep-frequency": 3600000000000,
     "cache-web-push-subscription-ids-mem-ratio": 1,
     "cache-web-push-subscription-mem-ratio": 1,
     "cache-webfinger-mem-ratio": 0.1,
+    "category": "",
     "config-path": "internal/config/testdata/test.yaml",
+    "content-warning": "",
     "db-address": ":memory:",
     "db-database": "gotosocial_prod",
     "db-max-open-conns-multiplier": 3,
     "db-password": "hunter2",
     "db-port": 6969,
     "db-postgres-connection-string": "",
+    "db-postgres-replica-connection-string": "",
+    "db-sqlite-backup-dir": "/root/backups",
+    "db-sqlite-backup-every": 86400000000000,
+    "db-sqlite-backup-keep": 3,
     "db-sqlite-busy-timeout": 1000000000,
     "db-sqlite-cache-size": "0B",
     "db-sqlite-journal-mode": "DELETE",
@@ -107,8 +143,11 @@ EXPECT=$(cat << "EOF"
     "db-tls-mode": "disable",
     "db-type": "sqlite",
     "db-user": "sex-haver",
+    "debug-endpoints-enabled": true,
+    "domain": "",
     "dry-run": true,
     "email": "",
+    "follows-policy": "",
     "host": "example.com",
     "http-client-allow-ips": [],
     "http-client-block-ips": [],
@@ -117,6 +156,9 @@ EXPECT=$(cat << "EOF"
     "http-client-tls-insecure-skip-verify": false,
     "instance-allow-backdating-statuses": true,
     "instance-deliver-to-shared-inboxes": false,
+    "instance-directory-max-accounts": 100,
+    "instance-directory-sync-every": 43200000000000,
+    "instance-directory-url": "https://directory.example.org/accounts",
     "instance-expose-allowlist": true,
     "instance-expose-allowlist-web": true,
     "instance-expose-blocklist": true,
@@ -124,16 +166,28 @@ EXPECT=$(cat << "EOF"
     "instance-expose-custom-emojis": true,
     "instance-expose-peers": true,
     "instance-expose-public-timeline": true,
+    "instance-expose-sitemap": false,
+    "instance-federation-integrity-proofs": true,
     "instance-federation-mode": "allowlist",
     "instance-federation-spam-filter": true,
+    "instance-federation-thread-fetch-budget": 250,
+    "instance-federation-thread-max-depth": 50,
     "instance-inject-mastodon-version": true,
     "instance-languages": [
         "nl",
         "en-GB"
     ],
+    "instance-nodeinfo-refresh-every": 43200000000000,
+    "instance-outbox-include-boosts": true,
+    "instance-peers-granularity": "metadata",
+    "instance-remote-timelines-enabled": true,
+    "instance-sitemap-regenerate-every": 3600000000000,
     "instance-stats-mode": "baffle",
     "instance-subscriptions-process-every": 86400000000000,
     "instance-subscriptions-process-from": "23:00",
+    "instance-webfinger-alias-domains": [
+        "gts.example"
+    ],
     "landing-page-user": "admin",
     "letsencrypt-cert-dir": "/gotosocial/storage/certs",
     "letsencrypt-email-address": "",
@@ -154,11 +208,20 @@ EXPECT=$(cat << "EOF"
     "media-ffmpeg-pool-size": 8,
     "media-image-size-hint": "5.00MiB",
     "media-local-max-size": "420B",
+    "media-policy": "",
     "media-remote-cache-days": 30,
     "media-remote-max-size": "420B",
+    "media-thumb-format": "webp",
     "media-thumb-max-pixels": 42069,
     "media-video-size-hint": "40.0MiB",
+    "media-video-sprite-frames": 50,
     "metrics-enabled": false,
+    "moderation-hook-fail-closed": true,
+    "moderation-hook-federation-ingest-url": "http://localhost:8081/federation",
+    "moderation-hook-media-store-url": "",
+    "moderation-hook-status-create-url": "http://localhost:8081/status",
+    "moderation-hook-timeout": 3000000000,
+    "notifications-max-age": 7776000000000000,
     "oidc-admin-groups": [
         "steamy"
     ],
@@ -180,10 +243,16 @@ EXPECT=$(cat << "EOF"
     "path": "",
     "port": 6969,
     "protocol": "http",
+    "public-comment": "",
+    "read-only-mode": true,
+    "read-only-retry-after": 600000000000,
+    "reason": "",
     "remote-only": false,
     "request-id-header": "X-Trace-Id",
+    "resolved": false,
     "scheduled-statuses-max-daily": 25,
     "scheduled-statuses-max-total": 300,
+    "shutdown-drain-timeout": 60000000000,
     "skip-db-setup": false,
     "skip-db-teardown": false,
     "smtp-disclose-recipients": true,
@@ -193,12 +262,17 @@ EXPECT=$(cat << "EOF"
     "smtp-port": 4269,
     "smtp-username": "sex-haver",
     "software-version": "",
+    "statuses-detect-language": true,
     "statuses-max-chars": 69,
     "statuses-media-max-files": 1,
+    "statuses-policy": "",
+    "statuses-poll-local-voters": true,
     "statuses-poll-max-options": 1,
     "statuses-poll-option-max-chars": 50,
+    "statuses-trash-window": 86400000000000,
     "storage-backend": "local",
     "storage-local-base-path": "/root/store",
+    "storage-local-checksums": false,
     "storage-s3-access-key": "minio",
     "storage-s3-bucket": "gts",
     "storage-s3-bucket-lookup": "auto",
@@ -208,12 +282,19 @@ EXPECT=$(cat << "EOF"
     "storage-s3-redirect-url": "",
     "storage-s3-secret-key": "miniostorage",
     "storage-s3-use-ssl": false,
+    "storage-webdav-password": "",
+    "storage-webdav-url": "",
+    "storage-webdav-username": "",
     "syslog-address": "127.0.0.1:6969",
     "syslog-enabled": true,
     "syslog-protocol": "udp",
     "tls-certificate-chain": "",
     "tls-certificate-key": "",
     "tracing-enabled": false,
+    "translation-api-key": "",
+    "translation-backend": "libretranslate",
+    "translation-rate-limit": 10,
+    "translation-url": "http://localhost:5000",
     "trusted-proxies": [
         "127.0.0.1/32",
         "docker.host.local"
@@ -252,11 +333,15 @@ GTS_DB_SQLITE_JOURNAL_MODE='DELETE' \
 GTS_DB_SQLITE_SYNCHRONOUS='FULL' \
 GTS_DB_SQLITE_CACHE_SIZE=0 \
 GTS_DB_SQLITE_BUSY_TIMEOUT='1s' \
+GTS_DB_SQLITE_BACKUP_EVERY='24h' \
+GTS_DB_SQLITE_BACKUP_DIR='/root/backups' \
+GTS_DB_SQLITE_BACKUP_KEEP=3 \
 GTS_TLS_MODE='' \
 GTS_DB_TLS_CA_CERT='' \
 GTS_WEB_TEMPLATE_BASE_DIR='/root' \
 GTS_WEB_ASSET_BASE_DIR='/root' \
 GTS_INSTANCE_EXPOSE_PEERS=true \
+GTS_INSTANCE_PEERS_GRANULARITY='metadata' \
 GTS_INSTANCE_EXPOSE_BLOCKLIST=true \
 GTS_INSTANCE_EXPOSE_BLOCKLIST_WEB=true \
 GTS_INSTANCE_EXPOSE_ALLOWLIST=true \
@@ -265,14 +350,36 @@ GTS_INSTANCE_EXPOSE_CUSTOM_EMOJIS=true \
 GTS_INSTANCE_EXPOSE_PUBLIC_TIMELINE=true \
 GTS_INSTANCE_FEDERATION_MODE='allowlist' \
 GTS_INSTANCE_FEDERATION_SPAM_FILTER=true \
+GTS_INSTANCE_FEDERATION_INTEGRITY_PROOFS=true \
+GTS_INSTANCE_FEDERATION_THREAD_MAX_DEPTH=50 \
+GTS_INSTANCE_FEDERATION_THREAD_FETCH_BUDGET=250 \
 GTS_INSTANCE_DELIVER_TO_SHARED_INBOXES=false \
 GTS_INSTANCE_INJECT_MASTODON_VERSION=true \
 GTS_INSTANCE_LANGUAGES="nl,en-gb" \
 GTS_INSTANCE_STATS_MODE="baffle" \
+GTS_INSTANCE_DIRECTORY_URL='https://directory.example.org/accounts' \
+GTS_INSTANCE_DIRECTORY_SYNC_EVERY='12h' \
+GTS_INSTANCE_DIRECTORY_MAX_ACCOUNTS=100 \
+GTS_INSTANCE_NODEINFO_REFRESH_EVERY='12h' \
+GTS_INSTANCE_REMOTE_TIMELINES_ENABLED=true \
+GTS_INSTANCE_OUTBOX_INCLUDE_BOOSTS=true \
+GTS_INSTANCE_WEBFINGER_ALIAS_DOMAINS='gts.example' \
+GTS_INSTANCE_EXPOSE_SITEMAP=false \
+GTS_INSTANCE_SITEMAP_REGENERATE_EVERY='1h' \
 GTS_ACCOUNTS_ALLOW_CUSTOM_CSS=true \
 GTS_ACCOUNTS_CUSTOM_CSS_LENGTH=5000 \
+GTS_ACCOUNTS_CUSTOM_CSS_STRICT=true \
+GTS_ACCOUNTS_CUSTOM_CSS_ALLOWED_PROPERTIES='color,font-family' \
 GTS_ACCOUNTS_MAX_PROFILE_FIELDS=8 \
+GTS_ACCOUNTS_MAX_SERVICE_ACCOUNTS=3 \
+GTS_ACCOUNTS_RATE_LIMIT_STATUSES=100 \
+GTS_ACCOUNTS_RATE_LIMIT_FOLLOWS=50 \
+GTS_ACCOUNTS_RATE_LIMIT_REPORTS=5 \
+GTS_ACCOUNTS_RATE_LIMIT_MODERATOR_MULTIPLIER=2 \
 GTS_ACCOUNTS_REGISTRATION_BACKLOG_LIMIT=100 \
+GTS_ACCOUNTS_ALLOW_USER_INVITES=true \
+GTS_ACCOUNTS_CAPTCHA_PROVIDER=hcaptcha \
+GTS_ACCOUNTS_CAPTCHA_SITE_KEY=some-site-key \
 GTS_ACCOUNTS_REGISTRATION_DAILY_LIMIT=50 \
 GTS_ACCOUNTS_REGISTRATION_OPEN=true \
 GTS_ACCOUNTS_REASON_REQUIRED=false \
@@ -286,8 +393,21 @@ GTS_MEDIA_EMOJI_LOCAL_MAX_SIZE=420 \
 GTS_MEDIA_EMOJI_REMOTE_MAX_SIZE=420 \
 GTS_MEDIA_FFMPEG_POOL_SIZE=8 \
 GTS_MEDIA_VIDEO_SIZE_HINT='40MiB' \
+GTS_MEDIA_VIDEO_SPRITE_FRAMES=50 \
+GTS_MEDIA_THUMB_FORMAT=webp \
 GTS_MEDIA_THUMB_MAX_PIXELS=42069 \
 GTS_METRICS_ENABLED=false \
+GTS_DEBUG_ENDPOINTS_ENABLED=true \
+GTS_MODERATION_HOOK_STATUS_CREATE_URL='http://localhost:8081/status' \
+GTS_MODERATION_HOOK_FEDERATION_INGEST_URL='http://localhost:8081/federation' \
+GTS_MODERATION_HOOK_TIMEOUT=3s \
+GTS_MODERATION_HOOK_FAIL_CLOSED=true \
+GTS_TRANSLATION_BACKEND='libretranslate' \
+GTS_TRANSLATION_URL='http://localhost:5000' \
+GTS_TRANSLATION_RATE_LIMIT=10 \
+GTS_READ_ONLY_MODE=true \
+GTS_READ_ONLY_RETRY_AFTER=10m \
+GTS_SHUTDOWN_DRAIN_TIMEOUT=1m \
 GTS_STORAGE_BACKEND='local' \
 GTS_STORAGE_LOCAL_BASE_PATH='/root/store' \
 GTS_STORAGE_S3_ACCESS_KEY='minio' \
@@ -301,8 +421,12 @@ GTS_STORAGE_S3_BUCKET='gts' \
 GTS_STATUSES_MAX_CHARS=69 \
 GTS_STATUSES_CW_MAX_CHARS=420 \
 GTS_STATUSES_POLL_MAX_OPTIONS=1 \
+GTS_STATUSES_POLL_LOCAL_VOTERS=true \
 GTS_STATUSES_POLL_OPTIONS_MAX_CHARS=69 \
 GTS_STATUSES_MEDIA_MAX_FILES=1 \
+GTS_STATUSES_TRASH_WINDOW=24h \
+GTS_STATUSES_DETECT_LANGUAGE=true \
+GTS_NOTIFICATIONS_MAX_AGE=2160h \
 GTS_LETS_ENCRYPT_ENABLED=false \
 GTS_LETS_ENCRYPT_PORT=8080 \
 GTS_LETS_ENCRYPT_CERT_DIR='/root/certs' \
@@ -43,4 +43,5 @@ GTS_DB_PORT=${DB_PORT} \
 GTS_DB_USER=${DB_USER} \
 GTS_DB_PASSWORD=${DB_PASS} \
 GTS_DB_DATABASE=${DB_NAME} \
-go test -p 1 ${ARGS}
\ No newline at end of file
+GTS_TESTRIG_DB_SCHEMA_PER_SUITE=true \
+go test ${ARGS}
\ No newline at end of file
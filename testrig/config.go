@@ -82,19 +82,23 @@ func testDefaults() config.Configuration {
 		DbSqliteSynchronous:        "NORMAL",
 		DbSqliteCacheSize:          8 * bytesize.MiB,
 		DbSqliteBusyTimeout:        time.Minute * 5,
+		DbSqliteBackupKeep:         7,
 
 		WebTemplateBaseDir: "./web/template/",
 		WebAssetBaseDir:    "./web/assets/",
 
-		InstanceFederationMode:         config.InstanceFederationModeDefault,
-		InstanceFederationSpamFilter:   true,
-		InstanceExposePeers:            true,
-		InstanceExposeBlocklist:        true,
-		InstanceExposeBlocklistWeb:     true,
-		InstanceExposeAllowlist:        true,
-		InstanceExposeAllowlistWeb:     true,
-		InstanceExposeCustomEmojis:     true,
-		InstanceDeliverToSharedInboxes: true,
+		InstanceFederationMode:              config.InstanceFederationModeDefault,
+		InstanceFederationSpamFilter:        true,
+		InstanceFederationThreadMaxDepth:    100,
+		InstanceFederationThreadFetchBudget: 500,
+		InstanceExposePeers:                 true,
+		InstancePeersGranularity:            config.InstancePeersGranularityOpen,
+		InstanceExposeBlocklist:             true,
+		InstanceExposeBlocklistWeb:          true,
+		InstanceExposeAllowlist:             true,
+		InstanceExposeAllowlistWeb:          true,
+		InstanceExposeCustomEmojis:          true,
+		InstanceDeliverToSharedInboxes:      true,
 		InstanceLanguages: language.Languages{
 			{
 				TagStr: "nl",
@@ -105,15 +109,25 @@ func testDefaults() config.Configuration {
 		},
 		InstanceSubscriptionsProcessFrom:  "23:00",        // 11pm,
 		InstanceSubscriptionsProcessEvery: 24 * time.Hour, // 1/day.
+		InstanceDirectoryURL:              "",
+		InstanceDirectorySyncEvery:        6 * time.Hour,
+		InstanceDirectoryMaxAccounts:      200,
+		InstanceNodeInfoRefreshEvery:      24 * time.Hour,
 		InstanceAllowBackdatingStatuses:   true,
+		InstanceExposeSitemap:             true,
+		InstanceSitemapRegenerateEvery:    6 * time.Hour,
 
-		AccountsRegistrationOpen:         true,
-		AccountsReasonRequired:           true,
-		AccountsRegistrationDailyLimit:   10,
-		AccountsRegistrationBacklogLimit: 20,
-		AccountsAllowCustomCSS:           true,
-		AccountsCustomCSSLength:          10000,
-		AccountsMaxProfileFields:         8,
+		AccountsRegistrationOpen:           true,
+		AccountsReasonRequired:             true,
+		AccountsRegistrationDailyLimit:     10,
+		AccountsRegistrationBacklogLimit:   20,
+		AccountsAllowUserInvites:           true,
+		AccountsAllowCustomCSS:             true,
+		AccountsCustomCSSLength:            10000,
+		AccountsCustomCSSStrict:            false,
+		AccountsCustomCSSAllowedProperties: config.Defaults.AccountsCustomCSSAllowedProperties,
+		AccountsMaxProfileFields:           8,
+		AccountsMaxServiceAccounts:         5,
 
 		Media: config.MediaConfiguration{
 			DescriptionMinChars: 0,
@@ -126,6 +140,8 @@ func testDefaults() config.Configuration {
 			CleanupFrom:         "00:00",        // midnight.
 			CleanupEvery:        24 * time.Hour, // 1/day.
 			ThumbMaxPixels:      512,
+			ThumbFormat:         config.MediaThumbFormatJPEG,
+			VideoSpriteFrames:   0,
 		},
 
 		// the testrig uses in-memory storage by default, so we can
@@ -138,6 +154,7 @@ func testDefaults() config.Configuration {
 		StatusesPollMaxOptions:     6,
 		StatusesPollOptionMaxChars: 50,
 		StatusesMediaMaxFiles:      6,
+		StatusesTrashWindow:        0,
 
 		ScheduledStatusesMaxTotal: 300,
 		ScheduledStatusesMaxDaily: 25,
@@ -172,6 +189,22 @@ func testDefaults() config.Configuration {
 		SyslogProtocol: "udp",
 		SyslogAddress:  "localhost:514",
 
+		ModerationHookStatusCreateURL:     "",
+		ModerationHookFederationIngestURL: "",
+		ModerationHookMediaStoreURL:       "",
+		ModerationHookTimeout:             5 * time.Second,
+		ModerationHookFailClosed:          false,
+
+		TranslationBackend:   "",
+		TranslationURL:       "",
+		TranslationAPIKey:    "",
+		TranslationRateLimit: 30,
+
+		ReadOnlyMode:       false,
+		ReadOnlyRetryAfter: 5 * time.Minute,
+
+		ShutdownDrainTimeout: 30 * time.Second,
+
 		Advanced: config.AdvancedConfig{
 			CookiesSamesite:  "lax",
 			SenderMultiplier: 0, // 1 sender only, regardless of CPU
@@ -33,9 +33,12 @@
 	&gtsmodel.AccountNote{},
 	&gtsmodel.AccountSettings{},
 	&gtsmodel.AccountToEmoji{},
+	&gtsmodel.Antenna{},
+	&gtsmodel.BookmarkCollection{},
 	&gtsmodel.Application{},
 	&gtsmodel.Block{},
 	&gtsmodel.DomainBlock{},
+	&gtsmodel.Draft{},
 	&gtsmodel.EmailDomainBlock{},
 	&gtsmodel.Filter{},
 	&gtsmodel.FilterKeyword{},
@@ -43,11 +46,15 @@
 	&gtsmodel.Follow{},
 	&gtsmodel.FollowRequest{},
 	&gtsmodel.InteractionRequest{},
+	&gtsmodel.IPRule{},
+	&gtsmodel.LandingBlock{},
+	&gtsmodel.Invite{},
 	&gtsmodel.List{},
 	&gtsmodel.ListEntry{},
 	&gtsmodel.Marker{},
 	&gtsmodel.MediaAttachment{},
 	&gtsmodel.Mention{},
+	&gtsmodel.MutedTag{},
 	&gtsmodel.Poll{},
 	&gtsmodel.PollVote{},
 	&gtsmodel.Status{},
@@ -55,22 +62,29 @@
 	&gtsmodel.StatusToTag{},
 	&gtsmodel.StatusEdit{},
 	&gtsmodel.StatusFave{},
+	&gtsmodel.StatusReaction{},
+	&gtsmodel.Suggestion{},
 	&gtsmodel.StatusBookmark{},
+	&gtsmodel.StatusBulkDelete{},
 	&gtsmodel.Tag{},
+	&gtsmodel.Theme{},
 	&gtsmodel.Thread{},
 	&gtsmodel.ThreadMute{},
 	&gtsmodel.User{},
 	&gtsmodel.UserMute{},
 	&gtsmodel.VAPIDKeyPair{},
+	&gtsmodel.WebfingerAlias{},
 	&gtsmodel.WebPushSubscription{},
 	&gtsmodel.Emoji{},
 	&gtsmodel.Instance{},
+	&gtsmodel.InboxActivity{},
 	&gtsmodel.Notification{},
 	&gtsmodel.RouterSession{},
 	&gtsmodel.Token{},
 	&gtsmodel.EmojiCategory{},
 	&gtsmodel.Tombstone{},
 	&gtsmodel.Report{},
+	&gtsmodel.ReportNote{},
 	&gtsmodel.Rule{},
 	&gtsmodel.WorkerTask{},
 }
@@ -85,14 +99,28 @@
 //
 // If the environment variable GTS_DB_PORT is set, it will take that
 // value as the port instead.
+//
+// If the database type is postgres, and the environment variable
+// GTS_TESTRIG_DB_SCHEMA_PER_SUITE is set to true, the database will
+// be created in a new uniquely named schema, which is dropped again
+// by StandardDBTeardown. This allows test packages to run against
+// the same postgres database in parallel.
 func NewTestDB(state *state.State) db.DB {
 	state.Caches.Init()
 
+	// Create isolated schema, if enabled.
+	schema := newPGSchema()
+
 	testDB, err := bundb.NewBunDBService(context.Background(), state)
 	if err != nil {
 		log.Panic(nil, err)
 	}
 
+	if schema != nil {
+		// Store for teardown.
+		pgSchemas.Store(testDB, schema)
+	}
+
 	state.DB = testDB
 
 	return testDB
@@ -398,4 +426,7 @@ func StandardDBTeardown(db db.DB) {
 			log.Error(ctx, err)
 		}
 	}
+
+	// Drop isolated schema, if any.
+	dropPGSchema(db)
 }
@@ -0,0 +1,170 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package testrig
+
+import (
+	"context"
+	"database/sql"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+)
+
+// pgSchemaEnv is the environment variable which,
+// when set to true and running against postgres,
+// isolates each test database in its own schema.
+const pgSchemaEnv = "GTS_TESTRIG_DB_SCHEMA_PER_SUITE"
+
+// pgSchema is a postgres schema created
+// for a test database by newPGSchema().
+type pgSchema struct {
+	name string // schema name
+	dsn  string // connection string, without search_path
+}
+
+// pgSchemas stores the postgres schema
+// of each test database, keyed by db.DB,
+// so they can be dropped on teardown.
+var pgSchemas sync.Map
+
+// newPGSchema creates a new uniquely named postgres schema, and
+// updates the configured postgres connection string to use it,
+// such that the next opened database is isolated within it.
+//
+// This is a no-op returning nil, unless the database type is
+// postgres and the GTS_TESTRIG_DB_SCHEMA_PER_SUITE environment
+// variable is set to true.
+func newPGSchema() *pgSchema {
+	if !strings.EqualFold(config.GetDbType(), "postgres") {
+		return nil
+	}
+
+	if ok, _ := strconv.ParseBool(os.Getenv(pgSchemaEnv)); !ok {
+		return nil
+	}
+
+	dsn, err := pgDSN()
+	if err != nil {
+		log.Panicf(nil, "error deriving postgres connection string: %v", err)
+	}
+
+	schema := &pgSchema{
+		name: "testrig_" + strings.ToLower(id.NewULID()),
+		dsn:  dsn,
+	}
+
+	// Create the schema for this suite to use.
+	schema.exec("CREATE SCHEMA " + strconv.Quote(schema.name))
+
+	// Point new connections at the schema.
+	u, _ := url.Parse(dsn)
+	q := u.Query()
+	q.Set("search_path", schema.name)
+	u.RawQuery = q.Encode()
+	config.SetDbPostgresConnectionString(u.String())
+
+	return schema
+}
+
+// dropPGSchema drops the postgres schema created
+// for given test database (and everything in it),
+// if any. Must be called before the db is closed.
+func dropPGSchema(db db.DB) {
+	v, ok := pgSchemas.LoadAndDelete(db)
+	if !ok {
+		return
+	}
+
+	schema := v.(*pgSchema)
+	schema.exec("DROP SCHEMA IF EXISTS " + strconv.Quote(schema.name) + " CASCADE")
+}
+
+// exec executes given query on a
+// short-lived postgres connection.
+func (s *pgSchema) exec(query string) {
+	sqldb, err := sql.Open("pgx-gts", s.dsn)
+	if err != nil {
+		log.Panicf(nil, "error opening postgres db: %v", err)
+	}
+	defer sqldb.Close()
+
+	if _, err := sqldb.ExecContext(context.Background(), query); err != nil {
+		log.Panicf(nil, "error executing %q: %v", query, err)
+	}
+}
+
+// pgDSN returns the configured postgres connection string, stripped
+// of any search_path, else one built from the individual db settings.
+func pgDSN() (string, error) {
+	if dsn := config.GetDbPostgresConnectionString(); dsn != "" {
+		u, err := url.Parse(dsn)
+		if err != nil {
+			return "", err
+		}
+
+		// Drop any search_path
+		// set by a previous call.
+		q := u.Query()
+		q.Del("search_path")
+		u.RawQuery = q.Encode()
+		return u.String(), nil
+	}
+
+	host := config.GetDbAddress()
+	if port := config.GetDbPort(); port > 0 {
+		host = net.JoinHostPort(host, strconv.Itoa(port))
+	}
+
+	u := &url.URL{
+		Scheme: "postgres",
+		Host:   host,
+		Path:   "/" + config.GetDbDatabase(),
+	}
+
+	if user := config.GetDbUser(); user != "" {
+		if pass := config.GetDbPassword(); pass != "" {
+			u.User = url.UserPassword(user, pass)
+		} else {
+			u.User = url.User(user)
+		}
+	}
+
+	q := url.Values{}
+	switch config.GetDbTLSMode() {
+	case "", "disable":
+		q.Set("sslmode", "disable")
+	case "enable":
+		q.Set("sslmode", "require")
+	case "require":
+		q.Set("sslmode", "verify-full")
+		if cert := config.GetDbTLSCACert(); cert != "" {
+			q.Set("sslrootcert", cert)
+		}
+	}
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
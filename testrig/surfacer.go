@@ -40,16 +40,17 @@ func NewTestSurfacer(
 	visFilter := visibility.NewFilter(state)
 	muteFilter := mutes.NewFilter(state)
 	statusFilter := status.NewFilter(state)
+	streamProcessor := util.Ptr(stream.New(state, NewTestOauthServer(state)))
 
 	return surfacing.New(
 		state,
 		converter,
-		util.Ptr(stream.New(state, NewTestOauthServer(state))),
+		streamProcessor,
 		visFilter,
 		muteFilter,
 		statusFilter,
 		emailSender,
 		webPushSender,
-		util.Ptr(conversations.New(state, converter, visFilter, muteFilter, statusFilter)),
+		util.Ptr(conversations.New(state, converter, streamProcessor, visFilter, muteFilter, statusFilter)),
 	)
 }
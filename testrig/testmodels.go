@@ -393,20 +393,24 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			DisplayName: "happy little turtle :3",
 			Fields: []*gtsmodel.Field{
 				{
+					ID:    "01JC0Z1F6Y4R6XW3C5V0K6D7QA",
 					Name:  "should you follow me?",
 					Value: "maybe!",
 				},
 				{
+					ID:    "01JC0Z1F6Y4R6XW3C5V0K6D7QB",
 					Name:  "age",
 					Value: "120",
 				},
 			},
 			FieldsRaw: []*gtsmodel.Field{
 				{
+					ID:    "01JC0Z1F6Y4R6XW3C5V0K6D7QA",
 					Name:  "should you follow me?",
 					Value: "maybe!",
 				},
 				{
+					ID:    "01JC0Z1F6Y4R6XW3C5V0K6D7QB",
 					Name:  "age",
 					Value: "120",
 				},
@@ -440,20 +444,24 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			HeaderMediaAttachmentID: "01JPHRB7F2RXPTEQFRYC85EPD9",
 			Fields: []*gtsmodel.Field{
 				{
+					ID:    "01JC0Z1F6Y4R6XW3C5V0K6D7QC",
 					Name:  "I'm going to post a lot of",
 					Value: "media!",
 				},
 				{
+					ID:    "01JC0Z1F6Y4R6XW3C5V0K6D7QD",
 					Name:  "and there's nothing",
 					Value: "you can do about it",
 				},
 			},
 			FieldsRaw: []*gtsmodel.Field{
 				{
+					ID:    "01JC0Z1F6Y4R6XW3C5V0K6D7QC",
 					Name:  "I'm going to post a lot of",
 					Value: "media!",
 				},
 				{
+					ID:    "01JC0Z1F6Y4R6XW3C5V0K6D7QD",
 					Name:  "and there's nothing",
 					Value: "you can do about it",
 				},
@@ -641,59 +649,74 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 func NewTestAccountSettings() map[string]*gtsmodel.AccountSettings {
 	return map[string]*gtsmodel.AccountSettings{
 		"unconfirmed_account": {
-			AccountID:       "01F8MH0BBE4FHXPH513MBVFHB0",
-			CreatedAt:       TimeMustParse("2022-06-04T13:12:00Z"),
-			UpdatedAt:       TimeMustParse("2022-06-04T13:12:00Z"),
-			Privacy:         gtsmodel.VisibilityPublic,
-			Sensitive:       util.Ptr(false),
-			Language:        "en",
-			EnableRSS:       util.Ptr(false),
-			HideCollections: util.Ptr(false),
-			WebLayout:       gtsmodel.WebLayoutMicroblog,
+			AccountID:          "01F8MH0BBE4FHXPH513MBVFHB0",
+			CreatedAt:          TimeMustParse("2022-06-04T13:12:00Z"),
+			UpdatedAt:          TimeMustParse("2022-06-04T13:12:00Z"),
+			Privacy:            gtsmodel.VisibilityPublic,
+			Sensitive:          util.Ptr(false),
+			Language:           "en",
+			EnableRSS:          util.Ptr(false),
+			HideCollections:    util.Ptr(false),
+			DirectoryOptIn:     util.Ptr(false),
+			ShowNoteIndicators: util.Ptr(false),
+			AllowEmbeds:        util.Ptr(true),
+			WebLayout:          gtsmodel.WebLayoutMicroblog,
 		},
 		"admin_account": {
-			AccountID:       "01F8MH17FWEB39HZJ76B6VXSKF",
-			CreatedAt:       TimeMustParse("2022-05-17T13:10:59Z"),
-			UpdatedAt:       TimeMustParse("2022-05-17T13:10:59Z"),
-			Privacy:         gtsmodel.VisibilityPublic,
-			Sensitive:       util.Ptr(false),
-			Language:        "en",
-			EnableRSS:       util.Ptr(true),
-			HideCollections: util.Ptr(false),
-			WebLayout:       gtsmodel.WebLayoutMicroblog,
+			AccountID:          "01F8MH17FWEB39HZJ76B6VXSKF",
+			CreatedAt:          TimeMustParse("2022-05-17T13:10:59Z"),
+			UpdatedAt:          TimeMustParse("2022-05-17T13:10:59Z"),
+			Privacy:            gtsmodel.VisibilityPublic,
+			Sensitive:          util.Ptr(false),
+			Language:           "en",
+			EnableRSS:          util.Ptr(true),
+			HideCollections:    util.Ptr(false),
+			DirectoryOptIn:     util.Ptr(false),
+			ShowNoteIndicators: util.Ptr(false),
+			AllowEmbeds:        util.Ptr(true),
+			WebLayout:          gtsmodel.WebLayoutMicroblog,
 		},
 		"local_account_1": {
-			AccountID:       "01F8MH1H7YV1Z7D2C8K2730QBF",
-			CreatedAt:       TimeMustParse("2022-05-20T11:09:18Z"),
-			UpdatedAt:       TimeMustParse("2022-05-20T11:09:18Z"),
-			Privacy:         gtsmodel.VisibilityPublic,
-			Sensitive:       util.Ptr(false),
-			Language:        "en",
-			EnableRSS:       util.Ptr(true),
-			HideCollections: util.Ptr(false),
-			WebLayout:       gtsmodel.WebLayoutMicroblog,
+			AccountID:          "01F8MH1H7YV1Z7D2C8K2730QBF",
+			CreatedAt:          TimeMustParse("2022-05-20T11:09:18Z"),
+			UpdatedAt:          TimeMustParse("2022-05-20T11:09:18Z"),
+			Privacy:            gtsmodel.VisibilityPublic,
+			Sensitive:          util.Ptr(false),
+			Language:           "en",
+			EnableRSS:          util.Ptr(true),
+			HideCollections:    util.Ptr(false),
+			DirectoryOptIn:     util.Ptr(false),
+			ShowNoteIndicators: util.Ptr(false),
+			AllowEmbeds:        util.Ptr(true),
+			WebLayout:          gtsmodel.WebLayoutMicroblog,
 		},
 		"local_account_2": {
-			AccountID:       "01F8MH5NBDF2MV7CTC4Q5128HF",
-			CreatedAt:       TimeMustParse("2022-06-04T13:12:00Z"),
-			UpdatedAt:       TimeMustParse("2022-06-04T13:12:00Z"),
-			Privacy:         gtsmodel.VisibilityFollowersOnly,
-			Sensitive:       util.Ptr(true),
-			Language:        "fr",
-			EnableRSS:       util.Ptr(false),
-			HideCollections: util.Ptr(true),
-			WebLayout:       gtsmodel.WebLayoutMicroblog,
+			AccountID:          "01F8MH5NBDF2MV7CTC4Q5128HF",
+			CreatedAt:          TimeMustParse("2022-06-04T13:12:00Z"),
+			UpdatedAt:          TimeMustParse("2022-06-04T13:12:00Z"),
+			Privacy:            gtsmodel.VisibilityFollowersOnly,
+			Sensitive:          util.Ptr(true),
+			Language:           "fr",
+			EnableRSS:          util.Ptr(false),
+			HideCollections:    util.Ptr(true),
+			DirectoryOptIn:     util.Ptr(false),
+			ShowNoteIndicators: util.Ptr(false),
+			AllowEmbeds:        util.Ptr(true),
+			WebLayout:          gtsmodel.WebLayoutMicroblog,
 		},
 		"local_account_3": {
-			AccountID:       "01JPCMD83Y4WR901094YES3QC5",
-			CreatedAt:       TimeMustParse("2025-03-15T11:08:00Z"),
-			UpdatedAt:       TimeMustParse("2025-03-15T11:08:00Z"),
-			Privacy:         gtsmodel.VisibilityPublic,
-			Sensitive:       util.Ptr(true),
-			Language:        "en",
-			EnableRSS:       util.Ptr(true),
-			HideCollections: util.Ptr(false),
-			WebLayout:       gtsmodel.WebLayoutGallery,
+			AccountID:          "01JPCMD83Y4WR901094YES3QC5",
+			CreatedAt:          TimeMustParse("2025-03-15T11:08:00Z"),
+			UpdatedAt:          TimeMustParse("2025-03-15T11:08:00Z"),
+			Privacy:            gtsmodel.VisibilityPublic,
+			Sensitive:          util.Ptr(true),
+			Language:           "en",
+			EnableRSS:          util.Ptr(true),
+			HideCollections:    util.Ptr(false),
+			DirectoryOptIn:     util.Ptr(false),
+			ShowNoteIndicators: util.Ptr(false),
+			AllowEmbeds:        util.Ptr(true),
+			WebLayout:          gtsmodel.WebLayoutGallery,
 		},
 	}
 }
@@ -3214,18 +3237,20 @@ func NewTestLists() map[string]*gtsmodel.List {
 func NewTestListEntries() map[string]*gtsmodel.ListEntry {
 	return map[string]*gtsmodel.ListEntry{
 		"local_account_1_list_1_entry_1": {
-			ID:        "01H0G89MWVQE0M58VD2HQYMQWH",
-			CreatedAt: TimeMustParse("2022-05-14T13:21:09+02:00"),
-			UpdatedAt: TimeMustParse("2022-05-14T13:21:09+02:00"),
-			ListID:    "01H0G8E4Q2J3FE3JDWJVWEDCD1",
-			FollowID:  "01F8PYDCE8XE23GRE5DPZJDZDP",
+			ID:          "01H0G89MWVQE0M58VD2HQYMQWH",
+			CreatedAt:   TimeMustParse("2022-05-14T13:21:09+02:00"),
+			UpdatedAt:   TimeMustParse("2022-05-14T13:21:09+02:00"),
+			ListID:      "01H0G8E4Q2J3FE3JDWJVWEDCD1",
+			FollowID:    "01F8PYDCE8XE23GRE5DPZJDZDP",
+			ShowReblogs: util.Ptr(true),
 		},
 		"local_account_1_list_1_entry_2": {
-			ID:        "01H0G8FFM1AGQDRNGBGGX8CYJQ",
-			CreatedAt: TimeMustParse("2022-05-14T13:21:09+02:00"),
-			UpdatedAt: TimeMustParse("2022-05-14T13:21:09+02:00"),
-			ListID:    "01H0G8E4Q2J3FE3JDWJVWEDCD1",
-			FollowID:  "01F8PY8RHWRQZV038T4E8T9YK8",
+			ID:          "01H0G8FFM1AGQDRNGBGGX8CYJQ",
+			CreatedAt:   TimeMustParse("2022-05-14T13:21:09+02:00"),
+			UpdatedAt:   TimeMustParse("2022-05-14T13:21:09+02:00"),
+			ListID:      "01H0G8E4Q2J3FE3JDWJVWEDCD1",
+			FollowID:    "01F8PY8RHWRQZV038T4E8T9YK8",
+			ShowReblogs: util.Ptr(true),
 		},
 	}
 }
@@ -3275,6 +3300,7 @@ func NewTestReports() map[string]*gtsmodel.Report {
 			StatusIDs:       []string{"01FVW7JHQFSFK166WWKR8CBA6M"},
 			Forwarded:       util.Ptr(true),
 			RuleIDs:         []string{"01GP3AWY4CRDVRNZKW0TEAMB51", "01GP3DFY9XQ1TJMZT5BGAZPXX3"},
+			Category:        gtsmodel.ReportCategoryOther,
 		},
 		"remote_account_1_report_local_account_2": {
 			ID:                     "01GP3DFY9XQ1TJMZT5BGAZPXX7",
@@ -3290,6 +3316,7 @@ func NewTestReports() map[string]*gtsmodel.Report {
 			ActionTaken:            "user was warned not to be a turtle anymore",
 			ActionTakenAt:          TimeMustParse("2022-05-15T17:01:56+02:00"),
 			ActionTakenByAccountID: "01F8MH17FWEB39HZJ76B6VXSKF",
+			Category:               gtsmodel.ReportCategoryOther,
 		},
 	}
 }
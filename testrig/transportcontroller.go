@@ -140,6 +140,8 @@ func NewMockHTTPClient(do func(req *http.Request) (*http.Response, error), relat
 			responseCode, responseBytes, responseContentType, responseContentLength, extraHeaders = NodeInfoResponse(req)
 		} else if strings.Contains(reqURLString, "lists.example.org") {
 			responseCode, responseBytes, responseContentType, responseContentLength, extraHeaders = DomainPermissionSubscriptionResponse(req)
+		} else if strings.Contains(reqURLString, "directory.example.org") {
+			responseCode, responseBytes, responseContentType, responseContentLength, extraHeaders = DirectoryResponse(req)
 		} else if note, ok := mockHTTPClient.TestRemoteStatuses[reqURLString]; ok {
 			// the request is for a note that we have stored
 			noteI, err := streams.Serialize(note)
@@ -773,3 +775,47 @@ func DomainPermissionSubscriptionResponse(req *http.Request) (
 
 	return
 }
+
+func DirectoryResponse(req *http.Request) (
+	responseCode int,
+	responseBytes []byte,
+	responseContentType string,
+	responseContentLength int,
+	extraHeaders map[string]string,
+) {
+	const directoryResp = `{
+  "accounts": [
+    {
+      "uri": "https://unknown-instance.com/users/brand_new_person",
+      "username": "brand_new_person"
+    },
+    {
+      "uri": "http://localhost:8080/users/the_mighty_zork",
+      "username": "the_mighty_zork"
+    },
+    {
+      "uri": "http://fossbros-anonymous.io/users/foss_satan",
+      "username": "foss_satan"
+    },
+    {
+      "uri": "not a valid uri lol"
+    }
+  ]
+}`
+
+	switch req.URL.String() {
+	case "https://directory.example.org/accounts":
+		responseBytes = []byte(directoryResp)
+		responseContentType = applicationJSON
+		responseCode = http.StatusOK
+		responseContentLength = len(responseBytes)
+
+	default:
+		responseCode = http.StatusNotFound
+		responseBytes = []byte(`{"error":"not found"}`)
+		responseContentType = applicationJSON
+		responseContentLength = len(responseBytes)
+	}
+
+	return
+}
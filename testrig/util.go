@@ -79,6 +79,7 @@ func StartWorkers(state *state.State, processor *workers.Processor) {
 	state.Workers.Dereference.Start(1)
 	state.Workers.Processing.Start(1)
 	state.Workers.WebPush.Start(1)
+	state.Workers.Surfacing.Start(1)
 }
 
 func StopWorkers(state *state.State) {
@@ -88,6 +89,7 @@ func StopWorkers(state *state.State) {
 	state.Workers.Dereference.Stop()
 	state.Workers.Processing.Stop()
 	state.Workers.WebPush.Stop()
+	state.Workers.Surfacing.Stop()
 }
 
 // EqualRequestURIs checks whether inputs have equal request URIs,
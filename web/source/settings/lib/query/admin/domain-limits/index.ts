@@ -20,6 +20,7 @@
 import type {
 	DomainLimit,
 	DomainLimitCreateParams,
+	DomainLimitTest,
 	DomainLimitUpdateParams,
 	MappedDomainLimits
 } from "../../../types/domain";
@@ -48,6 +49,16 @@ const extended = gtsApi.injectEndpoints({
 			...replaceCacheOnMutation("domainLimits"),
 		}),
 
+		testDomainLimit: build.mutation<DomainLimitTest, DomainLimitCreateParams>({
+			query: (formData) => ({
+				method: "POST",
+				url: `/api/v1/admin/domain_limits/test`,
+				asForm: true,
+				body: formData,
+				discardEmpty: true
+			}),
+		}),
+
 		updateDomainLimit: build.mutation<DomainLimit, {id: string} & DomainLimitUpdateParams>({
 			query: ({ id, ...formData}) => ({
 				method: "PUT",
@@ -87,6 +98,12 @@ const useGetAllDomainLimitsQuery = extended.useDomainLimitsQuery;
  */
 const useCreateDomainLimitMutation = extended.useCreateDomainLimitMutation;
 
+/**
+ * Test what would happen if a domain limit were created,
+ * by POSTing to `/api/v1/admin/domain_limits/test`.
+ */
+const useTestDomainLimitMutation = extended.useTestDomainLimitMutation;
+
 /**
  * Update a domain limit by PUTing to `/api/v1/admin/domain_limits/{id}`.
  */
@@ -100,6 +117,7 @@ const useRemoveDomainLimitMutation = extended.useRemoveDomainLimitMutation;
 export {
 	useGetAllDomainLimitsQuery,
 	useCreateDomainLimitMutation,
+	useTestDomainLimitMutation,
 	useUpdateDomainLimitMutation,
 	useRemoveDomainLimitMutation,
 };
@@ -56,6 +56,22 @@ const extended = gtsApi.injectEndpoints({
 			}),
 			...replaceCacheOnMutation("verifyCredentials")
 		}),
+
+		createRSSToken: build.mutation<Account, void>({
+			query: (_) => ({
+				method: "POST",
+				url: `/api/v1/accounts/rss_token`,
+			}),
+			...replaceCacheOnMutation("verifyCredentials")
+		}),
+
+		deleteRSSToken: build.mutation<Account, void>({
+			query: (_) => ({
+				method: "DELETE",
+				url: `/api/v1/accounts/rss_token`,
+			}),
+			...replaceCacheOnMutation("verifyCredentials")
+		}),
 		
 		user: build.query<User, void>({
 			query: () => ({url: `/api/v1/user`}),
@@ -142,6 +158,8 @@ export const {
 	useUpdateCredentialsMutation,
 	useDeleteHeaderMutation,
 	useDeleteAvatarMutation,
+	useCreateRSSTokenMutation,
+	useDeleteRSSTokenMutation,
 	useUserQuery,
 	usePasswordChangeMutation,
 	useEmailChangeMutation,
@@ -66,6 +66,7 @@ export interface Account {
 	emojis: CustomEmoji[],
 	fields: [],
 	enable_rss: boolean,
+	accent_color?: string,
 	role: any,
 	suspended?: boolean,
 	source?: AccountSource;
@@ -81,6 +82,11 @@ export interface AccountSource {
 	status_content_type: string;
 	web_visibility: string;
 	web_layout: string;
+	directory_opt_in: boolean;
+	show_note_indicators: boolean;
+	allow_embeds: boolean;
+	auto_delete_after_days: number;
+	rss_feed_token?: string;
 }
 
 export interface SearchAccountParams {
@@ -24,6 +24,8 @@ export interface CustomEmoji {
 	static_url: string;
 	visible_in_picker: boolean;
 	category?: string;
+	license?: string;
+	attribution?: string;
 	disabled: boolean;
 	updated_at: string;
 	total_file_size: number;
@@ -156,6 +156,75 @@ export interface DomainLimitCreateParams extends DomainLimitUpdateParams {
 	domain: string;
 }
 
+/**
+ * The result of testing what would happen
+ * if a domain limit were created for a domain.
+ */
+export interface DomainLimitTest {
+	/**
+	 * The tested domain, normalized as it would be stored.
+	 */
+	domain: string;
+
+	/**
+	 * Whether creating a limit for the domain would take effect.
+	 */
+	would_apply: boolean;
+
+	/**
+	 * Existing limit currently covering the domain, if any,
+	 * either on the domain itself or on a parent domain.
+	 */
+	matched_limit?: DomainLimit;
+
+	/**
+	 * Existing limits on subdomains that would no longer take effect.
+	 */
+	superseded_limits: DomainLimit[];
+
+	/**
+	 * Known instances that the limit would cover.
+	 */
+	covered_domains: string[];
+
+	/**
+	 * Whether the domain is currently blocked.
+	 */
+	blocked: boolean;
+
+	/**
+	 * Human-readable descriptions of any conflicts.
+	 */
+	conflicts: string[];
+
+	/**
+	 * Preview of how the limit would rewrite
+	 * the content warning of a post from the domain.
+	 */
+	content_warning_preview: DomainLimitContentWarningPreview;
+}
+
+/**
+ * Preview of how a domain limit would rewrite
+ * the content warning of a sample post.
+ */
+export interface DomainLimitContentWarningPreview {
+	/**
+	 * Content warning of the sample post, as given.
+	 */
+	original: string;
+
+	/**
+	 * Content warning of the sample post as it would be shown.
+	 */
+	rewritten: string;
+
+	/**
+	 * Whether the sample post would be marked sensitive.
+	 */
+	sensitive: boolean;
+}
+
 /**
  * Type of a domain permission entry.
  */
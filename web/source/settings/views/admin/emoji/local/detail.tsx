@@ -19,13 +19,13 @@
 
 import React, { useEffect, useMemo } from "react";
 import { Redirect, useParams } from "wouter";
-import { useComboBoxInput, useFileInput, useValue } from "../../../../lib/form";
+import { useComboBoxInput, useFileInput, useTextInput, useValue } from "../../../../lib/form";
 import useFormSubmit from "../../../../lib/form/submit";
 import { useBaseUrl } from "../../../../lib/navigation/util";
 import { FakeStatus } from "../../../../components/status";
 import FormWithData from "../../../../lib/form/form-with-data";
 import Loading from "../../../../components/loading";
-import { FileInput } from "../../../../components/form/inputs";
+import { FileInput, TextInput } from "../../../../components/form/inputs";
 import MutationButton from "../../../../components/form/mutation-button";
 import { Error } from "../../../../components/error";
 import { useGetEmojiQuery, useEditEmojiMutation, useDeleteEmojiMutation } from "../../../../lib/query/admin/custom-emoji";
@@ -54,6 +54,8 @@ function EmojiDetailForm({ data: emoji }) {
 	const form = {
 		id: useValue("id", emoji.id),
 		category: useComboBoxInput("category", { source: emoji }),
+		license: useTextInput("license", { source: emoji }),
+		attribution: useTextInput("attribution", { source: emoji }),
 		image: useFileInput("image", {
 			withPreview: true,
 			maxSize: emojiMaxSize
@@ -115,6 +117,28 @@ function EmojiDetailForm({ data: emoji }) {
 					</CategorySelect>
 				</div>
 
+				<div className="update-license">
+					<TextInput
+						field={form.license}
+						label="License, eg., CC-BY-SA-4.0"
+						maxLength={255}
+					/>
+
+					<TextInput
+						field={form.attribution}
+						label="Attribution, eg., the name of the emoji's creator"
+						maxLength={500}
+					/>
+
+					<MutationButton
+						name="license"
+						label="Save license info"
+						showError={false}
+						result={result}
+						disabled={!form.license.hasChanged() && !form.attribution.hasChanged()}
+					/>
+				</div>
+
 				<div className="update-image">
 					<FileInput
 						field={form.image}
@@ -18,7 +18,7 @@
 */
 
 import React, { useMemo, useEffect, ReactNode } from "react";
-import { useFileInput, useComboBoxInput } from "../../../../lib/form";
+import { useFileInput, useComboBoxInput, useTextInput } from "../../../../lib/form";
 import useShortcode from "./use-shortcode";
 import useFormSubmit from "../../../../lib/form/submit";
 import { TextInput, FileInput } from "../../../../components/form/inputs";
@@ -46,6 +46,8 @@ export default function NewEmojiForm() {
 			maxSize: emojiMaxSize
 		}),
 		category: useComboBoxInput("category"),
+		license: useTextInput("license"),
+		attribution: useTextInput("attribution"),
 	};
 
 	const [submitForm, result] = useFormSubmit(
@@ -59,6 +61,8 @@ export default function NewEmojiForm() {
 				form.shortcode.reset();
 				form.image.reset();
 				form.category.reset();
+				form.license.reset();
+				form.attribution.reset();
 			}
 		},
 	);
@@ -126,6 +130,18 @@ export default function NewEmojiForm() {
 					field={form.category}
 				/>
 
+				<TextInput
+					field={form.license}
+					label="License (optional), eg., CC-BY-SA-4.0"
+					maxLength={255}
+				/>
+
+				<TextInput
+					field={form.attribution}
+					label="Attribution (optional), eg., the name of the emoji's creator"
+					maxLength={500}
+				/>
+
 				<MutationButton
 					disabled={form.image.previewValue === undefined || form.shortcode.value?.length === 0}
 					label="Upload emoji"
@@ -25,12 +25,20 @@ import {
 	useCreateDomainLimitMutation,
 	useGetAllDomainLimitsQuery,
 	useRemoveDomainLimitMutation,
+	useTestDomainLimitMutation,
 	useUpdateDomainLimitMutation
 } from "../../../lib/query/admin/domain-limits";
 import Loading from "../../../components/loading";
 import { Error } from "../../../components/error";
 import BackButton from "../../../components/back-button";
-import { DomainLimit } from "../../../lib/types/domain";
+import type {
+	DomainLimit,
+	DomainLimitAccountsPolicy,
+	DomainLimitFollowsPolicy,
+	DomainLimitMediaPolicy,
+	DomainLimitStatusesPolicy,
+	DomainLimitTest,
+} from "../../../lib/types/domain";
 import UsernameLozenge from "../../../components/username-lozenge";
 import { formDomainValidator } from "../../../lib/util/formvalidators";
 import { useRadioInput, useTextInput } from "../../../lib/form";
@@ -223,6 +231,20 @@ function CreateOrUpdateDomainLimit({ defaultDomain, limit }: CreateOrUpdateDomai
 		},
 	);
 
+	// Allow testing what would happen if a new limit
+	// were created, before actually creating it.
+	const [ testTrigger, testResult ] = useTestDomainLimitMutation();
+	function onTest() {
+		testTrigger({
+			domain: form.domain.value ?? "",
+			media_policy: form.mediaPolicy.value as DomainLimitMediaPolicy,
+			follows_policy: form.followsPolicy.value as DomainLimitFollowsPolicy,
+			statuses_policy: form.statusesPolicy.value as DomainLimitStatusesPolicy,
+			accounts_policy: form.accountsPolicy.value as DomainLimitAccountsPolicy,
+			content_warning: form.contentWarning.value,
+		});
+	}
+
 	const [location, setLocation] = useLocation();
 	function onSubmit(e: FormSubmitEvent) {
 		// Adding a new domain limit happens on a url like
@@ -385,6 +407,17 @@ function CreateOrUpdateDomainLimit({ defaultDomain, limit }: CreateOrUpdateDomai
 					}
 				/>
 
+				{ !isExistingLimit &&
+					<button
+						type="button"
+						onClick={onTest}
+						className="button"
+						disabled={testResult.isLoading}
+					>
+						Test Limit
+					</button>
+				}
+
 				{ isExistingLimit &&
 					<button
 						type="button"
@@ -399,7 +432,64 @@ function CreateOrUpdateDomainLimit({ defaultDomain, limit }: CreateOrUpdateDomai
 			<>
 				{createOrUpdateResult.error && <Error error={createOrUpdateResult.error} />}
 				{removeResult.error && <Error error={removeResult.error} />}
+				{testResult.error && <Error error={testResult.error} />}
 			</>
+
+			{ !isExistingLimit && testResult.data &&
+				<DomainLimitTestResult test={testResult.data} />
+			}
 		</form>
 	);
 }
+
+function DomainLimitTestResult({ test }: { test: DomainLimitTest }) {
+	return (
+		<div className="domain-limit-test">
+			<h3>Test result for {test.domain}</h3>
+			<p>
+				{ test.would_apply
+					? "This limit would take effect."
+					: "This limit would not take effect."
+				}
+			</p>
+			{ test.conflicts.length > 0 &&
+				<ul>
+					{test.conflicts.map((conflict) => <li key={conflict}>{conflict}</li>)}
+				</ul>
+			}
+			<dl className="info-list">
+				<div className="info-list-entry">
+					<dt>Known instances covered</dt>
+					<dd>
+						{ test.covered_domains.length > 0
+							? test.covered_domains.join(", ")
+							: "none"
+						}
+					</dd>
+				</div>
+				<div className="info-list-entry">
+					<dt>Subdomain limits superseded</dt>
+					<dd>
+						{ test.superseded_limits.length > 0
+							? test.superseded_limits.map((limit) => limit.domain).join(", ")
+							: "none"
+						}
+					</dd>
+				</div>
+				<div className="info-list-entry">
+					<dt>Content warning on posts</dt>
+					<dd>
+						{ test.content_warning_preview.rewritten
+							? test.content_warning_preview.rewritten
+							: "none"
+						}
+					</dd>
+				</div>
+				<div className="info-list-entry">
+					<dt>Posts marked sensitive</dt>
+					<dd>{ test.content_warning_preview.sensitive ? "yes" : "no" }</dd>
+				</div>
+			</dl>
+		</div>
+	);
+}
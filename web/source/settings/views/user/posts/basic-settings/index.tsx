@@ -18,9 +18,9 @@
 */
 
 import React from "react";
-import { useTextInput, useBoolInput } from "../../../../lib/form";
+import { useTextInput, useBoolInput, useNumberInput } from "../../../../lib/form";
 import useFormSubmit from "../../../../lib/form/submit";
-import { Select, Checkbox } from "../../../../components/form/inputs";
+import { Select, Checkbox, NumberInput } from "../../../../components/form/inputs";
 import Languages from "../../../../components/languages";
 import MutationButton from "../../../../components/form/mutation-button";
 import { useUpdateCredentialsMutation } from "../../../../lib/query/user";
@@ -32,12 +32,14 @@ export default function BasicSettings({ account }: { account: Account }) {
 		- bool source[sensitive]
 		- string source[language]
 		- string source[status_content_type]
+		- number auto_delete_after_days
 	 */
 	const form = {
 		defaultPrivacy: useTextInput("source[privacy]", { source: account, defaultValue: "unlisted" }),
 		isSensitive: useBoolInput("source[sensitive]", { source: account }),
 		language: useTextInput("source[language]", { source: account, valueSelector: (s: Account) => s.source?.language?.toUpperCase() ?? "EN" }),
 		statusContentType: useTextInput("source[status_content_type]", { source: account, defaultValue: "text/plain" }),
+		autoDeleteAfterDays: useNumberInput("auto_delete_after_days", { source: account, valueSelector: (s: Account) => s.source?.auto_delete_after_days ?? 0 }),
 	};
 	
 	const [submitForm, result] = useFormSubmit(form, useUpdateCredentialsMutation());
@@ -78,6 +80,13 @@ export default function BasicSettings({ account }: { account: Account }) {
 				field={form.isSensitive}
 				label="Mark my posts as sensitive by default"
 			/>
+			<NumberInput
+				field={form.autoDeleteAfterDays}
+				label="Automatically delete my posts after this many days, unless pinned, bookmarked, or favourited by me (0 to disable)"
+				type="number"
+				min="0"
+				max="3650"
+			/>
 			<MutationButton
 				disabled={false}
 				label="Save settings"
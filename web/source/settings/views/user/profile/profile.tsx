@@ -45,6 +45,8 @@ import {
 	useAccountThemesQuery,
 	useDeleteAvatarMutation,
 	useDeleteHeaderMutation,
+	useCreateRSSTokenMutation,
+	useDeleteRSSTokenMutation,
 } from "../../../lib/query/user";
 import { useUpdateCredentialsMutation } from "../../../lib/query/user";
 import { useVerifyCredentialsQuery } from "../../../lib/query/login";
@@ -111,6 +113,9 @@ function ProfileForm({ data: profile }: ProfileFormProps) {
 		indexable: useBoolInput("indexable", { source: profile}),
 		enableRSS: useBoolInput("enable_rss", { source: profile }),
 		hideCollections: useBoolInput("hide_collections", { source: profile }),
+		directoryOptIn: useBoolInput("directory_opt_in", { source: profile, valueSelector: (p: Account) => p.source?.directory_opt_in }),
+		showNoteIndicators: useBoolInput("show_note_indicators", { source: profile, valueSelector: (p: Account) => p.source?.show_note_indicators }),
+		allowEmbeds: useBoolInput("allow_embeds", { source: profile, valueSelector: (p: Account) => p.source?.allow_embeds }),
 		webVisibility: useTextInput("web_visibility", { source: profile, valueSelector: (p: Account) => p.source?.web_visibility }),
 		webLayout: useTextInput("web_layout", { source: profile, valueSelector: (p: Account) => p.source?.web_layout }),
 		fields: useFieldArrayInput("fields_attributes", {
@@ -119,12 +124,16 @@ function ProfileForm({ data: profile }: ProfileFormProps) {
 		}),
 		customCSS: useTextInput("custom_css", { source: profile, nosubmit: !instanceConfig.allowCustomCSS }),
 		theme: useTextInput("theme", { source: profile }),
+		accentColor: useTextInput("accent_color", { source: profile }),
 	};
 
 	const [ noHeader, setNoHeader ] = useState(!profile.header_media_id);
 	const [ deleteHeader, deleteHeaderRes ] = useDeleteHeaderMutation();
 	const [ noAvatar, setNoAvatar ] = useState(!profile.avatar_media_id);
 	const [ deleteAvatar, deleteAvatarRes ] = useDeleteAvatarMutation();
+	const [ createRSSToken, createRSSTokenRes ] = useCreateRSSTokenMutation();
+	const [ deleteRSSToken, deleteRSSTokenRes ] = useDeleteRSSTokenMutation();
+	const rssFeedToken = profile.source?.rss_feed_token;
 
 	const [submitForm, result] = useFormSubmit(form, useUpdateCredentialsMutation(), {
 		changedOnly: true,
@@ -237,6 +246,13 @@ function ProfileForm({ data: profile }: ProfileFormProps) {
 					options={<>{themeOptions}</>}
 				/>
 
+				<TextInput
+					field={form.accentColor}
+					label="Accent color for the web view of your profile (hex color, eg., #ff00aa; leave blank to use the theme's default)"
+					placeholder="#ff00aa"
+					pattern="#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})"
+				/>
+
 				<Select
 					field={form.webLayout}
 					label="Layout for the web view of your profile"
@@ -323,10 +339,54 @@ function ProfileForm({ data: profile }: ProfileFormProps) {
 				field={form.enableRSS}
 				label="Enable RSS feed of posts."
 			/>
+			<div className="private-rss-feed">
+				{rssFeedToken
+					? <span>
+						Private RSS feed URL, which also includes followers-only posts.
+						Anyone with this URL can read the feed, so keep it secret:
+						<br/><code>{`${profile.url}/feed.rss?token=${rssFeedToken}`}</code>
+					</span>
+					: <span>
+						You can generate a private RSS feed URL, which also includes followers-only posts.
+					</span>
+				}
+				<MutationButton
+					label={rssFeedToken ? "Regenerate private feed URL" : "Generate private feed URL"}
+					result={createRSSTokenRes}
+					submit={false}
+					disabled={false}
+					onClick={(e) => {
+						e.preventDefault();
+						createRSSToken();
+					}}
+				/>
+				<MutationButton
+					label="Revoke private feed URL"
+					result={deleteRSSTokenRes}
+					submit={false}
+					disabled={!rssFeedToken}
+					onClick={(e) => {
+						e.preventDefault();
+						deleteRSSToken();
+					}}
+				/>
+			</div>
 			<Checkbox
 				field={form.hideCollections}
 				label="Hide who you follow / are followed by."
 			/>
+			<Checkbox
+				field={form.directoryOptIn}
+				label="Publish account to the instance directory (if configured, and account is discoverable)."
+			/>
+			<Checkbox
+				field={form.showNoteIndicators}
+				label="Indicate on posts when you have a private note set on the author (client must support this)."
+			/>
+			<Checkbox
+				field={form.allowEmbeds}
+				label="Allow your public posts to be embedded on other websites."
+			/>
 
 			<div className="form-section-docs">
 				<h3>Advanced</h3>